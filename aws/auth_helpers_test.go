@@ -744,6 +744,11 @@ func awsMetadataApiMock(endpoints []*endpoint) func() {
 		w.Header().Set("Content-Type", "text/plain")
 		w.Header().Add("Server", "MockEC2")
 		log.Printf("[DEBUG] Mocker server received request to %q", r.RequestURI)
+		if r.Method == "PUT" && r.RequestURI == "/latest/api/token" {
+			w.Header().Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", "21600")
+			fmt.Fprint(w, "mock-token")
+			return
+		}
 		for _, e := range endpoints {
 			if r.RequestURI == e.Uri {
 				fmt.Fprintln(w, e.Body)
@@ -798,7 +803,7 @@ var instanceIdEndpoint = &endpoint{
 
 var securityCredentialsEndpoints = []*endpoint{
 	&endpoint{
-		Uri:  "/latest/meta-data/iam/security-credentials",
+		Uri:  "/latest/meta-data/iam/security-credentials/",
 		Body: "test_role",
 	},
 	&endpoint{
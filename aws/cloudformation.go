@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Shared helpers for the aws_cloudformation_stack_set* family of resources.
+
+func cloudFormationStackSetCallAsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  cloudformation.CallAsSelf,
+		ValidateFunc: validation.StringInSlice([]string{
+			cloudformation.CallAsDelegatedAdmin,
+			cloudformation.CallAsSelf,
+		}, false),
+	}
+}
+
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(0),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+				},
+				"failure_tolerance_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(0, 100),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+				},
+				"max_concurrent_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+				},
+				"max_concurrent_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+				},
+				"region_concurrency_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.RegionConcurrencyTypeParallel,
+						cloudformation.RegionConcurrencyTypeSequential,
+					}, false),
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFormationStackSetOperationPreferences(l []interface{}) *cloudformation.StackSetOperationPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	preferences := &cloudformation.StackSetOperationPreferences{}
+
+	// The zero value is meaningful for the failure tolerance, so the count is
+	// only omitted when a percentage has been configured instead.
+	if v, ok := m["failure_tolerance_percentage"].(int); ok && v != 0 {
+		preferences.FailureTolerancePercentage = aws.Int64(int64(v))
+	} else if v, ok := m["failure_tolerance_count"].(int); ok {
+		preferences.FailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_concurrent_count"].(int); ok && v != 0 {
+		preferences.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_concurrent_percentage"].(int); ok && v != 0 {
+		preferences.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["region_concurrency_type"].(string); ok && v != "" {
+		preferences.RegionConcurrencyType = aws.String(v)
+	}
+
+	if v, ok := m["region_order"].([]interface{}); ok && len(v) > 0 {
+		preferences.RegionOrder = expandStringList(v)
+	}
+
+	return preferences
+}
+
+func decodeCloudFormationStackSetInstanceID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected <stack-set-name>,<account-id or organizational-unit-ids>,<region>", id)
+	}
+
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+func cloudFormationStackSetOperationRefreshFunc(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &cloudformation.DescribeStackSetOperationInput{
+			CallAs:       aws.String(callAs),
+			OperationId:  aws.String(operationID),
+			StackSetName: aws.String(stackSetName),
+		}
+
+		output, err := conn.DescribeStackSetOperation(input)
+
+		if isAWSErr(err, cloudformation.ErrCodeOperationNotFoundException, "") {
+			return nil, cloudformation.StackSetOperationStatusRunning, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading CloudFormation StackSet (%s) operation (%s): %s", stackSetName, operationID, err)
+		}
+
+		if output == nil || output.StackSetOperation == nil {
+			return nil, cloudformation.StackSetOperationStatusRunning, nil
+		}
+
+		operation := output.StackSetOperation
+
+		switch status := aws.StringValue(operation.Status); status {
+		case cloudformation.StackSetOperationStatusFailed, cloudformation.StackSetOperationStatusStopped:
+			reasons, err := cloudFormationStackSetOperationFailureReasons(conn, stackSetName, operationID, callAs)
+			if err != nil {
+				return operation, status, fmt.Errorf("CloudFormation StackSet (%s) operation (%s) %s: %s", stackSetName, operationID, status, aws.StringValue(operation.StatusReason))
+			}
+			return operation, status, fmt.Errorf("CloudFormation StackSet (%s) operation (%s) %s: %s", stackSetName, operationID, status, strings.Join(reasons, "; "))
+		}
+
+		return operation, aws.StringValue(operation.Status), nil
+	}
+}
+
+func cloudFormationStackSetOperationFailureReasons(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string) ([]string, error) {
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		CallAs:       aws.String(callAs),
+		OperationId:  aws.String(operationID),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	var reasons []string
+
+	err := conn.ListStackSetOperationResultsPages(input, func(page *cloudformation.ListStackSetOperationResultsOutput, lastPage bool) bool {
+		for _, summary := range page.Summaries {
+			if aws.StringValue(summary.Status) != cloudformation.StackSetOperationResultStatusFailed {
+				continue
+			}
+
+			reasons = append(reasons, fmt.Sprintf("account %s in %s: %s", aws.StringValue(summary.Account), aws.StringValue(summary.Region), aws.StringValue(summary.StatusReason)))
+		}
+
+		return !lastPage
+	})
+
+	return reasons, err
+}
+
+func waitForCloudFormationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackSetOperationStatusQueued,
+			cloudformation.StackSetOperationStatusRunning,
+			cloudformation.StackSetOperationStatusStopping,
+		},
+		Target:     []string{cloudformation.StackSetOperationStatusSucceeded},
+		Refresh:    cloudFormationStackSetOperationRefreshFunc(conn, stackSetName, operationID, callAs),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -15,12 +15,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -37,10 +40,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -54,37 +60,66 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/inspector2"
 	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/simpledb"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-cleanhttp"
@@ -143,6 +178,7 @@ type Config struct {
 }
 
 type AWSClient struct {
+	backupconn            *backup.Backup
 	cfconn                *cloudformation.CloudFormation
 	cloud9conn            *cloud9.Cloud9
 	cloudfrontconn        *cloudfront.CloudFront
@@ -153,10 +189,13 @@ type AWSClient struct {
 	cognitoconn           *cognitoidentity.CognitoIdentity
 	cognitoidpconn        *cognitoidentityprovider.CognitoIdentityProvider
 	configconn            *configservice.ConfigService
+	datasyncconn          *datasync.DataSync
 	daxconn               *dax.DAX
 	devicefarmconn        *devicefarm.DeviceFarm
 	dmsconn               *databasemigrationservice.DatabaseMigrationService
 	dsconn                *directoryservice.DirectoryService
+	dlmconn               *dlm.DLM
+	docdbconn             *docdb.DocDB
 	dynamodbconn          *dynamodb.DynamoDB
 	ec2conn               *ec2.EC2
 	ecrconn               *ecr.ECR
@@ -165,40 +204,62 @@ type AWSClient struct {
 	elbconn               *elb.ELB
 	elbv2conn             *elbv2.ELBV2
 	emrconn               *emr.EMR
+	fsxconn               *fsx.FSx
 	esconn                *elasticsearch.ElasticsearchService
+	accessanalyzerconn    *accessanalyzer.AccessAnalyzer
 	acmconn               *acm.ACM
+	amplifyconn           *amplify.Amplify
 	apigateway            *apigateway.APIGateway
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
 	autoscalingconn       *autoscaling.AutoScaling
 	s3conn                *s3.S3
 	scconn                *servicecatalog.ServiceCatalog
+	secretsmanagerconn    *secretsmanager.SecretsManager
+	securityhubconn       *securityhub.SecurityHub
 	sesConn               *ses.SES
+	sesv2conn             *sesv2.SESV2
+	shieldconn            *shield.Shield
 	simpledbconn          *simpledb.SimpleDB
 	sqsconn               *sqs.SQS
 	snsconn               *sns.SNS
+	storagegatewayconn    *storagegateway.StorageGateway
 	stsconn               *sts.STS
+	timestreamqueryconn   *timestreamquery.TimestreamQuery
+	timestreamwriteconn   *timestreamwrite.TimestreamWrite
+	transferconn          *transfer.Transfer
 	redshiftconn          *redshift.Redshift
+	resourcegroupsconn    *resourcegroups.ResourceGroups
 	r53conn               *route53.Route53
 	partition             string
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	qldbconn              *qldb.QLDB
+	ramconn               *ram.RAM
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
+	keyspacesconn         *keyspaces.Keyspaces
 	kinesisconn           *kinesis.Kinesis
 	kmsconn               *kms.KMS
 	gameliftconn          *gamelift.GameLift
 	firehoseconn          *firehose.Firehose
+	fmsconn               *fms.FMS
 	inspectorconn         *inspector.Inspector
+	inspector2conn        *inspector2.Inspector2
 	elasticacheconn       *elasticache.ElastiCache
 	elasticbeanstalkconn  *elasticbeanstalk.ElasticBeanstalk
 	elastictranscoderconn *elastictranscoder.ElasticTranscoder
 	lambdaconn            *lambda.Lambda
 	lightsailconn         *lightsail.Lightsail
+	macie2conn            *macie2.Macie2
 	mqconn                *mq.MQ
+	neptuneconn           *neptune.Neptune
+	networkfirewallconn   *networkfirewall.NetworkFirewall
 	opsworksconn          *opsworks.OpsWorks
 	organizationsconn     *organizations.Organizations
+	pinpointconn          *pinpoint.Pinpoint
 	glacierconn           *glacier.Glacier
+	globalacceleratorconn *globalaccelerator.GlobalAccelerator
 	guarddutyconn         *guardduty.GuardDuty
 	codebuildconn         *codebuild.CodeBuild
 	codedeployconn        *codedeploy.CodeDeploy
@@ -207,14 +268,23 @@ type AWSClient struct {
 	sdconn                *servicediscovery.ServiceDiscovery
 	sfnconn               *sfn.SFN
 	ssmconn               *ssm.SSM
+	ssoadminconn          *ssoadmin.SSOAdmin
 	wafconn               *waf.WAF
 	wafregionalconn       *wafregional.WAFRegional
+	wafv2conn             *wafv2.WAFV2
+	workspacesconn        *workspaces.WorkSpaces
+	xrayconn              *xray.XRay
+	identitystoreconn     *identitystore.IdentityStore
+	imagebuilderconn      *imagebuilder.Imagebuilder
 	iotconn               *iot.IoT
 	batchconn             *batch.Batch
 	glueconn              *glue.Glue
+	lakeformationconn     *lakeformation.LakeFormation
 	athenaconn            *athena.Athena
+	sagemakerconn         *sagemaker.SageMaker
 	dxconn                *directconnect.DirectConnect
 	mediastoreconn        *mediastore.MediaStore
+	memorydbconn          *memorydb.MemoryDB
 	appsyncconn           *appsync.AppSync
 	lexmodelconn          *lexmodelbuildingservice.LexModelBuildingService
 }
@@ -338,6 +408,14 @@ func (c *Config) Client() (interface{}, error) {
 	// changes if that resource is ever opened up to more regions.
 	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
 
+	// Global Accelerator is a global service whose API is only served from
+	// us-west-2, regardless of where the accelerated endpoints live.
+	globalacceleratorSess := sess.Copy(&aws.Config{Region: aws.String("us-west-2")})
+
+	// Shield Advanced is a global service whose API is only served from
+	// us-east-1, regardless of where the protected resources live.
+	shieldSess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
+
 	// Some services have user-configurable endpoints
 	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
 	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
@@ -406,10 +484,13 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	client.accessanalyzerconn = accessanalyzer.New(sess)
 	client.acmconn = acm.New(awsAcmSess)
+	client.amplifyconn = amplify.New(sess)
 	client.apigateway = apigateway.New(awsApigatewaySess)
 	client.appautoscalingconn = applicationautoscaling.New(sess)
 	client.autoscalingconn = autoscaling.New(sess)
+	client.backupconn = backup.New(sess)
 	client.cloud9conn = cloud9.New(sess)
 	client.cfconn = cloudformation.New(awsCfSess)
 	client.cloudfrontconn = cloudfront.New(sess)
@@ -424,9 +505,12 @@ func (c *Config) Client() (interface{}, error) {
 	client.cognitoconn = cognitoidentity.New(sess)
 	client.cognitoidpconn = cognitoidentityprovider.New(sess)
 	client.codepipelineconn = codepipeline.New(sess)
+	client.datasyncconn = datasync.New(sess)
 	client.daxconn = dax.New(awsDynamoSess)
 	client.dmsconn = databasemigrationservice.New(sess)
 	client.dsconn = directoryservice.New(sess)
+	client.dlmconn = dlm.New(sess)
+	client.docdbconn = docdb.New(sess)
 	client.dynamodbconn = dynamodb.New(awsDynamoSess)
 	client.ecrconn = ecr.New(awsEcrSess)
 	client.ecsconn = ecs.New(awsEcsSess)
@@ -439,38 +523,67 @@ func (c *Config) Client() (interface{}, error) {
 	client.emrconn = emr.New(sess)
 	client.esconn = elasticsearch.New(awsEsSess)
 	client.firehoseconn = firehose.New(sess)
+	client.fmsconn = fms.New(sess)
+	client.fsxconn = fsx.New(sess)
 	client.inspectorconn = inspector.New(sess)
+	client.inspector2conn = inspector2.New(sess)
 	client.gameliftconn = gamelift.New(sess)
 	client.glacierconn = glacier.New(sess)
+	client.globalacceleratorconn = globalaccelerator.New(globalacceleratorSess)
 	client.guarddutyconn = guardduty.New(sess)
+	client.identitystoreconn = identitystore.New(sess)
+	client.imagebuilderconn = imagebuilder.New(sess)
 	client.iotconn = iot.New(sess)
+	client.keyspacesconn = keyspaces.New(sess)
 	client.kinesisconn = kinesis.New(awsKinesisSess)
 	client.kmsconn = kms.New(awsKmsSess)
 	client.lambdaconn = lambda.New(awsLambdaSess)
 	client.lexmodelconn = lexmodelbuildingservice.New(sess)
 	client.lightsailconn = lightsail.New(sess)
+	client.macie2conn = macie2.New(sess)
 	client.mqconn = mq.New(sess)
+	client.neptuneconn = neptune.New(sess)
+	client.networkfirewallconn = networkfirewall.New(sess)
 	client.opsworksconn = opsworks.New(sess)
 	client.organizationsconn = organizations.New(sess)
+	client.pinpointconn = pinpoint.New(sess)
+	client.qldbconn = qldb.New(sess)
 	client.r53conn = route53.New(r53Sess)
+	client.ramconn = ram.New(sess)
 	client.rdsconn = rds.New(awsRdsSess)
 	client.redshiftconn = redshift.New(sess)
+	client.resourcegroupsconn = resourcegroups.New(sess)
 	client.simpledbconn = simpledb.New(sess)
 	client.s3conn = s3.New(awsS3Sess)
 	client.scconn = servicecatalog.New(sess)
+	client.secretsmanagerconn = secretsmanager.New(sess)
+	client.securityhubconn = securityhub.New(sess)
 	client.sdconn = servicediscovery.New(sess)
 	client.sesConn = ses.New(sess)
+	client.sesv2conn = sesv2.New(sess)
+	client.shieldconn = shield.New(shieldSess)
 	client.sfnconn = sfn.New(sess)
 	client.snsconn = sns.New(awsSnsSess)
 	client.sqsconn = sqs.New(awsSqsSess)
 	client.ssmconn = ssm.New(sess)
+	client.ssoadminconn = ssoadmin.New(sess)
+	client.storagegatewayconn = storagegateway.New(sess)
+	client.timestreamqueryconn = timestreamquery.New(sess)
+	client.timestreamwriteconn = timestreamwrite.New(sess)
+	client.transferconn = transfer.New(sess)
 	client.wafconn = waf.New(sess)
 	client.wafregionalconn = wafregional.New(sess)
+	client.wafv2conn = wafv2.New(sess)
+	client.workspacesconn = workspaces.New(sess)
+	client.xrayconn = xray.New(sess)
 	client.batchconn = batch.New(sess)
 	client.glueconn = glue.New(sess)
+	client.lakeformationconn = lakeformation.New(sess)
 	client.athenaconn = athena.New(sess)
+	client.sagemakerconn = sagemaker.New(sess)
 	client.dxconn = directconnect.New(sess)
 	client.mediastoreconn = mediastore.New(sess)
+	client.memorydbconn = memorydb.New(sess)
 	client.appsyncconn = appsync.New(sess)
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_config_conformance_pack and aws_config_organization_* resources.
+
+const (
+	configConformancePackCreateTimeout         = 10 * time.Minute
+	configConformancePackDeleteTimeout         = 10 * time.Minute
+	configOrganizationConfigRuleCreateTimeout  = 5 * time.Minute
+	configOrganizationConfigRuleDeleteTimeout  = 5 * time.Minute
+	configOrganizationConfigRuleUpdateTimeout  = 5 * time.Minute
+	configOrganizationConfigRuleStatusNotFound = "NotFound"
+	configConformancePackStatusNotFound        = "NotFound"
+)
+
+func configDescribeConformancePack(conn *configservice.ConfigService, name string) (*configservice.ConformancePackDetail, error) {
+	output, err := conn.DescribeConformancePacks(&configservice.DescribeConformancePacksInput{
+		ConformancePackNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pack := range output.ConformancePackDetails {
+		if aws.StringValue(pack.ConformancePackName) == name {
+			return pack, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func configConformancePackStatusRefreshFunc(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeConformancePackStatus(&configservice.DescribeConformancePackStatusInput{
+			ConformancePackNames: []*string{aws.String(name)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+			return 42, configConformancePackStatusNotFound, nil
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Config Conformance Pack (%s) status: %s", name, err)
+		}
+
+		for _, status := range output.ConformancePackStatusDetails {
+			if aws.StringValue(status.ConformancePackName) != name {
+				continue
+			}
+
+			state := aws.StringValue(status.ConformancePackState)
+			switch state {
+			case configservice.ConformancePackStateCreateFailed, configservice.ConformancePackStateDeleteFailed:
+				return status, state, fmt.Errorf("%s: %s", state, aws.StringValue(status.ConformancePackStatusReason))
+			}
+
+			return status, state, nil
+		}
+
+		return 42, configConformancePackStatusNotFound, nil
+	}
+}
+
+func waitForConfigConformancePackStateCreateComplete(conn *configservice.ConfigService, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateCreateInProgress},
+		Target:  []string{configservice.ConformancePackStateCreateComplete},
+		Refresh: configConformancePackStatusRefreshFunc(conn, name),
+		Timeout: configConformancePackCreateTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForConfigConformancePackStateDeleted(conn *configservice.ConfigService, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateDeleteInProgress},
+		Target:  []string{configConformancePackStatusNotFound},
+		Refresh: configConformancePackStatusRefreshFunc(conn, name),
+		Timeout: configConformancePackDeleteTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func configDescribeOrganizationConfigRule(conn *configservice.ConfigService, name string) (*configservice.OrganizationConfigRule, error) {
+	output, err := conn.DescribeOrganizationConfigRules(&configservice.DescribeOrganizationConfigRulesInput{
+		OrganizationConfigRuleNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range output.OrganizationConfigRules {
+		if aws.StringValue(rule.OrganizationConfigRuleName) == name {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func configOrganizationConfigRuleStatusRefreshFunc(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeOrganizationConfigRuleStatuses(&configservice.DescribeOrganizationConfigRuleStatusesInput{
+			OrganizationConfigRuleNames: []*string{aws.String(name)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
+			return 42, configOrganizationConfigRuleStatusNotFound, nil
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Config Organization Rule (%s) status: %s", name, err)
+		}
+
+		for _, status := range output.OrganizationConfigRuleStatuses {
+			if aws.StringValue(status.OrganizationConfigRuleName) != name {
+				continue
+			}
+
+			state := aws.StringValue(status.OrganizationRuleStatus)
+			switch state {
+			case configservice.OrganizationRuleStatusCreateFailed, configservice.OrganizationRuleStatusDeleteFailed, configservice.OrganizationRuleStatusUpdateFailed:
+				return status, state, configOrganizationConfigRuleStatusError(conn, name, status)
+			}
+
+			return status, state, nil
+		}
+
+		return 42, configOrganizationConfigRuleStatusNotFound, nil
+	}
+}
+
+// configOrganizationConfigRuleStatusError includes the per-account failures in the
+// returned error, as the organization-level status rarely says what went wrong.
+func configOrganizationConfigRuleStatusError(conn *configservice.ConfigService, name string, status *configservice.OrganizationConfigRuleStatus) error {
+	err := fmt.Errorf("%s: %s: %s", aws.StringValue(status.OrganizationRuleStatus), aws.StringValue(status.ErrorCode), aws.StringValue(status.ErrorMessage))
+
+	var failures []string
+	listErr := conn.GetOrganizationConfigRuleDetailedStatusPages(&configservice.GetOrganizationConfigRuleDetailedStatusInput{
+		OrganizationConfigRuleName: aws.String(name),
+	}, func(page *configservice.GetOrganizationConfigRuleDetailedStatusOutput, lastPage bool) bool {
+		for _, s := range page.OrganizationConfigRuleDetailedStatus {
+			switch aws.StringValue(s.MemberAccountRuleStatus) {
+			case configservice.MemberAccountRuleStatusCreateFailed, configservice.MemberAccountRuleStatusDeleteFailed, configservice.MemberAccountRuleStatusUpdateFailed:
+				failures = append(failures, fmt.Sprintf("Account ID (%s): %s: %s", aws.StringValue(s.AccountId), aws.StringValue(s.ErrorCode), aws.StringValue(s.ErrorMessage)))
+			}
+		}
+		return !lastPage
+	})
+	if listErr != nil || len(failures) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%s\n%s", err, strings.Join(failures, "\n"))
+}
+
+func waitForConfigOrganizationConfigRuleStatus(conn *configservice.ConfigService, name string, pending, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pending},
+		Target:  []string{target},
+		Refresh: configOrganizationConfigRuleStatusRefreshFunc(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForConfigOrganizationConfigRuleStatusCreateSuccessful(conn *configservice.ConfigService, name string) error {
+	return waitForConfigOrganizationConfigRuleStatus(conn, name, configservice.OrganizationRuleStatusCreateInProgress, configservice.OrganizationRuleStatusCreateSuccessful, configOrganizationConfigRuleCreateTimeout)
+}
+
+func waitForConfigOrganizationConfigRuleStatusUpdateSuccessful(conn *configservice.ConfigService, name string) error {
+	return waitForConfigOrganizationConfigRuleStatus(conn, name, configservice.OrganizationRuleStatusUpdateInProgress, configservice.OrganizationRuleStatusUpdateSuccessful, configOrganizationConfigRuleUpdateTimeout)
+}
+
+func waitForConfigOrganizationConfigRuleStatusDeleteSuccessful(conn *configservice.ConfigService, name string) error {
+	return waitForConfigOrganizationConfigRuleStatus(conn, name, configservice.OrganizationRuleStatusDeleteInProgress, configOrganizationConfigRuleStatusNotFound, configOrganizationConfigRuleDeleteTimeout)
+}
+
+func configDeleteOrganizationConfigRule(conn *configservice.ConfigService, name string) error {
+	input := &configservice.DeleteOrganizationConfigRuleInput{
+		OrganizationConfigRuleName: aws.String(name),
+	}
+
+	_, err := conn.DeleteOrganizationConfigRule(input)
+	if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Config Organization Rule (%s): %s", name, err)
+	}
+
+	if err := waitForConfigOrganizationConfigRuleStatusDeleteSuccessful(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Rule (%s) deletion: %s", name, err)
+	}
+
+	return nil
+}
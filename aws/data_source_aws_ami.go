@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -24,6 +25,12 @@ func dataSourceAwsAmi() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"include_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -47,10 +54,18 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"boot_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,6 +74,10 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imds_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,6 +138,10 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tpm_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -188,7 +211,9 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("One of executable_users, filters, name_regex, or owners must be assigned")
 	}
 
-	params := &ec2.DescribeImagesInput{}
+	params := &ec2.DescribeImagesInput{
+		IncludeDeprecated: aws.Bool(d.Get("include_deprecated").(bool)),
+	}
 	if executableUsersOk {
 		params.ExecutableUsers = expandStringList(executableUsers.([]interface{}))
 	}
@@ -263,12 +288,15 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 	// Simple attributes first
 	d.SetId(*image.ImageId)
 	d.Set("architecture", image.Architecture)
+	d.Set("boot_mode", image.BootMode)
 	d.Set("creation_date", image.CreationDate)
+	d.Set("deprecation_time", image.DeprecationTime)
 	if image.Description != nil {
 		d.Set("description", image.Description)
 	}
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_id", image.ImageId)
+	d.Set("imds_support", image.ImdsSupport)
 	d.Set("image_location", image.ImageLocation)
 	if image.ImageOwnerAlias != nil {
 		d.Set("image_owner_alias", image.ImageOwnerAlias)
@@ -295,6 +323,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 		d.Set("sriov_net_support", image.SriovNetSupport)
 	}
 	d.Set("state", image.State)
+	d.Set("tpm_support", image.TpmSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 	// Complex types get their own functions
 	if err := d.Set("block_device_mappings", amiBlockDeviceMappings(image.BlockDeviceMappings)); err != nil {
@@ -174,6 +174,23 @@ func TestAccAWSAmiDataSource_localNameFilter(t *testing.T) {
 	})
 }
 
+func TestAccAWSAmiDataSource_includeDeprecated(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsAmiDataSourceIncludeDeprecatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAmiDataSourceID("data.aws_ami.test"),
+					resource.TestCheckResourceAttr("data.aws_ami.test", "include_deprecated", "true"),
+					resource.TestMatchResourceAttr("data.aws_ami.test", "deprecation_time", regexp.MustCompile("^20[0-9]{2}-")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsAmiDataSourceDestroy(s *terraform.State) error {
 	return nil
 }
@@ -300,3 +317,17 @@ data "aws_ami" "name_regex_filtered_ami" {
   name_regex = "^amzn-ami-\\d{3}[5].*-ecs-optimized"
 }
 `
+
+// The Amazon Linux AMI reached its end of life, so its images are deprecated.
+const testAccCheckAwsAmiDataSourceIncludeDeprecatedConfig = `
+data "aws_ami" "test" {
+  include_deprecated = true
+  most_recent        = true
+  owners             = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+`
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCloudwatchLogGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudwatchLogGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"log_group_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_group_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudwatchLogGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+
+	if v, ok := d.GetOk("log_group_name_prefix"); ok {
+		input.LogGroupNamePrefix = aws.String(v.(string))
+	}
+
+	var arns, names []*string
+	err := conn.DescribeLogGroupsPages(input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		for _, logGroup := range page.LogGroups {
+			if logGroup == nil {
+				continue
+			}
+
+			// The API returns the log group ARN with a trailing ":*".
+			arns = append(arns, aws.String(strings.TrimSuffix(aws.StringValue(logGroup.Arn), ":*")))
+			names = append(names, logGroup.LogGroupName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Log Groups: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("arns", flattenStringList(arns)); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("log_group_names", flattenStringList(names)); err != nil {
+		return fmt.Errorf("error setting log_group_names: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsCloudwatchLogGroups_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudwatch_log_groups.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsCloudwatchLogGroupsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsCloudwatchLogGroupsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test1" {
+  name = "/%[1]s/1"
+}
+
+resource "aws_cloudwatch_log_group" "test2" {
+  name = "/%[1]s/2"
+}
+
+data "aws_cloudwatch_log_groups" "test" {
+  log_group_name_prefix = "/%[1]s"
+
+  depends_on = ["aws_cloudwatch_log_group.test1", "aws_cloudwatch_log_group.test2"]
+}
+`, rName)
+}
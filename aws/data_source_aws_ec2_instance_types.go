@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsEc2InstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2InstanceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.ArchitectureType_Values(), false),
+				},
+				Set: schema.HashString,
+			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_instance_types": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							Set:           schema.HashString,
+							ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
+						},
+						"bare_metal": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+						},
+						"burstable_performance": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+						},
+						"cpu_manufacturers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+							},
+							Set: schema.HashString,
+						},
+						"excluded_instance_types": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							Set:           schema.HashString,
+							ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
+						},
+						"instance_generations": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+							},
+							Set: schema.HashString,
+						},
+						"local_storage": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+						},
+						"memory_gib_per_vcpu": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+									"min": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"memory_mib": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"require_hibernate_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"vcpu_count": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"virtualization_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.VirtualizationType_Values(), false),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2InstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+		ArchitectureTypes:    expandStringSet(d.Get("architecture_types").(*schema.Set)),
+		InstanceRequirements: expandEc2InstanceRequirementsRequest(d.Get("instance_requirements").([]interface{})),
+		VirtualizationTypes:  expandStringSet(d.Get("virtualization_types").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Instance Types: %s", input)
+
+	var instanceTypes []string
+	err := conn.GetInstanceTypesFromInstanceRequirementsPages(input, func(page *ec2.GetInstanceTypesFromInstanceRequirementsOutput, lastPage bool) bool {
+		for _, instanceType := range page.InstanceTypes {
+			if instanceType == nil {
+				continue
+			}
+
+			instanceTypes = append(instanceTypes, aws.StringValue(instanceType.InstanceType))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance Types: %s", err)
+	}
+
+	sort.Strings(instanceTypes)
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("instance_types", instanceTypes); err != nil {
+		return fmt.Errorf("error setting instance_types: %s", err)
+	}
+
+	return nil
+}
+
+func expandEc2InstanceRequirementsRequest(l []interface{}) *ec2.InstanceRequirementsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	requirements := &ec2.InstanceRequirementsRequest{}
+
+	if v, ok := m["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		requirements.AllowedInstanceTypes = expandStringSet(v)
+	}
+
+	if v, ok := m["bare_metal"].(string); ok && v != "" {
+		requirements.BareMetal = aws.String(v)
+	}
+
+	if v, ok := m["burstable_performance"].(string); ok && v != "" {
+		requirements.BurstablePerformance = aws.String(v)
+	}
+
+	if v, ok := m["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		requirements.CpuManufacturers = expandStringSet(v)
+	}
+
+	if v, ok := m["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		requirements.ExcludedInstanceTypes = expandStringSet(v)
+	}
+
+	if v, ok := m["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
+		requirements.InstanceGenerations = expandStringSet(v)
+	}
+
+	if v, ok := m["local_storage"].(string); ok && v != "" {
+		requirements.LocalStorage = aws.String(v)
+	}
+
+	if v, ok := m["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		requirements.MemoryGiBPerVCpu = &ec2.MemoryGiBPerVCpuRequest{}
+
+		if v, ok := tfMap["max"].(float64); ok && v != 0 {
+			requirements.MemoryGiBPerVCpu.Max = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["min"].(float64); ok && v != 0 {
+			requirements.MemoryGiBPerVCpu.Min = aws.Float64(v)
+		}
+	}
+
+	if v, ok := m["memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		requirements.MemoryMiB = &ec2.MemoryMiBRequest{
+			Min: aws.Int64(int64(tfMap["min"].(int))),
+		}
+
+		if v, ok := tfMap["max"].(int); ok && v != 0 {
+			requirements.MemoryMiB.Max = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := m["require_hibernate_support"].(bool); ok && v {
+		requirements.RequireHibernateSupport = aws.Bool(v)
+	}
+
+	if v, ok := m["vcpu_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		requirements.VCpuCount = &ec2.VCpuCountRangeRequest{
+			Min: aws.Int64(int64(tfMap["min"].(int))),
+		}
+
+		if v, ok := tfMap["max"].(int); ok && v != 0 {
+			requirements.VCpuCount.Max = aws.Int64(int64(v))
+		}
+	}
+
+	return requirements
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAwsEc2InstanceTypes_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_instance_types.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2InstanceTypesConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2InstanceTypesNotEmpty(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsEc2InstanceTypes_excludedInstanceTypes(t *testing.T) {
+	dataSourceName := "data.aws_ec2_instance_types.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2InstanceTypesConfigExcludedInstanceTypes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2InstanceTypesNotEmpty(dataSourceName),
+					testAccCheckAwsEc2InstanceTypesExcludes(dataSourceName, "m5.large"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2InstanceTypesNotEmpty(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if v := rs.Primary.Attributes["instance_types.#"]; v == "" || v == "0" {
+			return fmt.Errorf("%s: expected at least one instance type", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsEc2InstanceTypesExcludes(n, instanceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if k != "instance_types.#" && v == instanceType {
+				return fmt.Errorf("%s: instance type %s was not excluded", n, instanceType)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccDataSourceAwsEc2InstanceTypesConfigBasic = `
+data "aws_ec2_instance_types" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    memory_mib {
+      min = 4096
+      max = 8192
+    }
+
+    vcpu_count {
+      min = 2
+      max = 2
+    }
+  }
+}
+`
+
+const testAccDataSourceAwsEc2InstanceTypesConfigExcludedInstanceTypes = `
+data "aws_ec2_instance_types" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    bare_metal              = "excluded"
+    burstable_performance   = "excluded"
+    excluded_instance_types = ["m5.*", "r5*"]
+
+    memory_mib {
+      min = 8192
+    }
+
+    vcpu_count {
+      min = 2
+      max = 4
+    }
+  }
+}
+`
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -29,6 +30,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"override_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -37,9 +46,17 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"sid": {
@@ -64,8 +81,9 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"test": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIamPolicyConditionOperator,
 									},
 									"variable": {
 										Type:     schema.TypeString,
@@ -102,6 +120,34 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// merge in source_policy_documents; Sids must be unique across them
+	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		sourceSids := make(map[string]bool)
+
+		for i, sourceJson := range v.([]interface{}) {
+			if sourceJson == nil || sourceJson.(string) == "" {
+				continue
+			}
+
+			sourceDoc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(sourceJson.(string)), sourceDoc); err != nil {
+				return fmt.Errorf("error unmarshalling source_policy_documents[%d]: %s", i, err)
+			}
+
+			for _, stmt := range sourceDoc.Statements {
+				if stmt.Sid == "" {
+					continue
+				}
+				if sourceSids[stmt.Sid] {
+					return fmt.Errorf("duplicate Sid (%s) found in source_policy_documents[%d]", stmt.Sid, i)
+				}
+				sourceSids[stmt.Sid] = true
+			}
+
+			mergedDoc.Merge(sourceDoc)
+		}
+	}
+
 	// process the current document
 	doc := &IAMPolicyDoc{}
 
@@ -171,6 +217,22 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		mergedDoc.Merge(overrideDoc)
 	}
 
+	// merge in override_policy_documents, each overriding the last
+	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		for i, overrideJson := range v.([]interface{}) {
+			if overrideJson == nil || overrideJson.(string) == "" {
+				continue
+			}
+
+			overrideDoc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(overrideJson.(string)), overrideDoc); err != nil {
+				return fmt.Errorf("error unmarshalling override_policy_documents[%d]: %s", i, err)
+			}
+
+			mergedDoc.Merge(overrideDoc)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
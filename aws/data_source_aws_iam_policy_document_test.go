@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -90,6 +91,66 @@ func TestAccAWSDataSourceIAMPolicyDocument_override(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourcePolicyDocuments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_source_policy_documents", "json",
+						testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_sourcePolicyDocumentsDuplicateSid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentSourcePolicyDocumentsDuplicateSidConfig,
+				ExpectError: regexp.MustCompile(`duplicate Sid \(DuplicateSid\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_overridePolicyDocuments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_override_policy_documents", "json",
+						testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_invalidConditionOperator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentInvalidConditionOperatorConfig,
+				ExpectError: regexp.MustCompile(`invalid IAM condition operator "StringEqual"`),
+			},
+		},
+	})
+}
+
 func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -510,3 +571,159 @@ var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
     }
   ]
 }`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "source_one" {
+  statement {
+    sid       = "SourceOne"
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "OverriddenByStatement"
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "source_two" {
+  statement {
+    sid       = "SourceTwo"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_policy_documents" {
+  source_policy_documents = [
+    "${data.aws_iam_policy_document.source_one.json}",
+    "${data.aws_iam_policy_document.source_two.json}",
+  ]
+
+  statement {
+    sid       = "OverriddenByStatement"
+    actions   = ["iam:GetRole"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "SourceOne",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "OverriddenByStatement",
+      "Effect": "Allow",
+      "Action": "iam:GetRole",
+      "Resource": "*"
+    },
+    {
+      "Sid": "SourceTwo",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsDuplicateSidConfig = `
+data "aws_iam_policy_document" "source_one" {
+  statement {
+    sid       = "DuplicateSid"
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "source_two" {
+  statement {
+    sid       = "DuplicateSid"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_policy_documents_duplicate_sid" {
+  source_policy_documents = [
+    "${data.aws_iam_policy_document.source_one.json}",
+    "${data.aws_iam_policy_document.source_two.json}",
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "override_one" {
+  statement {
+    sid       = "SidToOverride"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "override_two" {
+  statement {
+    sid       = "SidToOverride"
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_override_policy_documents" {
+  override_policy_documents = [
+    "${data.aws_iam_policy_document.override_one.json}",
+    "${data.aws_iam_policy_document.override_two.json}",
+  ]
+
+  statement {
+    sid       = "SidToOverride"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "Untouched"
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "SidToOverride",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Untouched",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentInvalidConditionOperatorConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "aws:username"
+      values   = ["example"]
+    }
+  }
+}
+`
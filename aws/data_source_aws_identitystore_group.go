@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIdentityStoreGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIdentityStoreGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": dataSourceAwsIdentityStoreFiltersSchema(),
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+		},
+	}
+}
+
+func dataSourceAwsIdentityStoreGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).identitystoreconn
+	identityStoreID := d.Get("identity_store_id").(string)
+
+	input := &identitystore.ListGroupsInput{
+		Filters:         expandIdentityStoreFilters(d.Get("filter").(*schema.Set).List()),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	groupID := d.Get("group_id").(string)
+
+	var results []*identitystore.Group
+
+	log.Printf("[DEBUG] Reading Identity Store Groups: %s", input)
+	err := conn.ListGroupsPages(input, func(page *identitystore.ListGroupsOutput, lastPage bool) bool {
+		for _, group := range page.Groups {
+			if group == nil {
+				continue
+			}
+
+			if groupID != "" && aws.StringValue(group.GroupId) != groupID {
+				continue
+			}
+
+			results = append(results, group)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store (%s) Groups: %s", identityStoreID, err)
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("no Identity Store Group found matching criteria; try different search")
+	}
+
+	if len(results) > 1 {
+		return fmt.Errorf("multiple Identity Store Groups found matching criteria; try different search")
+	}
+
+	group := results[0]
+
+	d.SetId(aws.StringValue(group.GroupId))
+	d.Set("display_name", group.DisplayName)
+	d.Set("group_id", group.GroupId)
+
+	return nil
+}
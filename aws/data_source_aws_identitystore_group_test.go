@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccPreCheckAWSIdentityStoreGroupName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME") == "" {
+		t.Skip("Environment variable AWS_IDENTITY_STORE_GROUP_NAME is not set")
+	}
+}
+
+func TestAccDataSourceAwsIdentityStoreGroup_basic(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_group.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsIdentityStoreGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsIdentityStoreGroup_NonExistent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsIdentityStoreGroupConfig("does-not-exist"),
+				ExpectError: regexp.MustCompile(`no Identity Store Group found matching criteria`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsIdentityStoreGroupConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  identity_store_id = "${data.aws_ssoadmin_instances.test.identity_store_ids[0]}"
+
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+}
+`, name)
+}
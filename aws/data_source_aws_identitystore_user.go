@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIdentityStoreUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIdentityStoreUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": dataSourceAwsIdentityStoreFiltersSchema(),
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIdentityStoreUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).identitystoreconn
+	identityStoreID := d.Get("identity_store_id").(string)
+
+	input := &identitystore.ListUsersInput{
+		Filters:         expandIdentityStoreFilters(d.Get("filter").(*schema.Set).List()),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	userID := d.Get("user_id").(string)
+
+	var results []*identitystore.User
+
+	log.Printf("[DEBUG] Reading Identity Store Users: %s", input)
+	err := conn.ListUsersPages(input, func(page *identitystore.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			if user == nil {
+				continue
+			}
+
+			if userID != "" && aws.StringValue(user.UserId) != userID {
+				continue
+			}
+
+			results = append(results, user)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store (%s) Users: %s", identityStoreID, err)
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("no Identity Store User found matching criteria; try different search")
+	}
+
+	if len(results) > 1 {
+		return fmt.Errorf("multiple Identity Store Users found matching criteria; try different search")
+	}
+
+	user := results[0]
+
+	d.SetId(aws.StringValue(user.UserId))
+	d.Set("display_name", user.DisplayName)
+	d.Set("user_id", user.UserId)
+	d.Set("user_name", user.UserName)
+
+	return nil
+}
+
+func dataSourceAwsIdentityStoreFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_path": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"attribute_value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandIdentityStoreFilters(l []interface{}) []*identitystore.Filter {
+	filters := make([]*identitystore.Filter, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		filters = append(filters, &identitystore.Filter{
+			AttributePath:  aws.String(m["attribute_path"].(string)),
+			AttributeValue: aws.String(m["attribute_value"].(string)),
+		})
+	}
+
+	return filters
+}
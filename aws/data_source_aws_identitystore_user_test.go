@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccPreCheckAWSIdentityStoreUserName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_USER_NAME") == "" {
+		t.Skip("Environment variable AWS_IDENTITY_STORE_USER_NAME is not set")
+	}
+}
+
+func TestAccDataSourceAwsIdentityStoreUser_basic(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_user.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreUserName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsIdentityStoreUserConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsIdentityStoreUser_NonExistent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsIdentityStoreUserConfig("does-not-exist"),
+				ExpectError: regexp.MustCompile(`no Identity Store User found matching criteria`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsIdentityStoreUserConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_user" "test" {
+  identity_store_id = "${data.aws_ssoadmin_instances.test.identity_store_ids[0]}"
+
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = %[1]q
+  }
+}
+`, name)
+}
@@ -50,6 +50,21 @@ func dataSourceAwsLbTargetGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"target_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"healthy_target_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"unhealthy_target_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"stickiness": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -151,5 +166,31 @@ func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) er
 	targetGroup := describeResp.TargetGroups[0]
 
 	d.SetId(*targetGroup.TargetGroupArn)
-	return flattenAwsLbTargetGroupResource(d, meta, targetGroup)
+	if err := flattenAwsLbTargetGroupResource(d, meta, targetGroup); err != nil {
+		return err
+	}
+
+	healthResp, err := elbconn.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroup.TargetGroupArn,
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error retrieving LB Target Group health: {{err}}", err)
+	}
+
+	var healthy, unhealthy int
+	for _, desc := range healthResp.TargetHealthDescriptions {
+		if desc.TargetHealth == nil {
+			continue
+		}
+		switch aws.StringValue(desc.TargetHealth.State) {
+		case elbv2.TargetHealthStateEnumHealthy:
+			healthy++
+		case elbv2.TargetHealthStateEnumUnhealthy:
+			unhealthy++
+		}
+	}
+	d.Set("healthy_target_count", healthy)
+	d.Set("unhealthy_target_count", unhealthy)
+
+	return nil
 }
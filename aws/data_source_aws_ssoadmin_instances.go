@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSsoAdminInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSsoAdminInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"identity_store_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSsoAdminInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	var arns, identityStoreIDs []string
+
+	err := conn.ListInstancesPages(&ssoadmin.ListInstancesInput{}, func(page *ssoadmin.ListInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.Instances {
+			if instance == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(instance.InstanceArn))
+			identityStoreIDs = append(identityStoreIDs, aws.StringValue(instance.IdentityStoreId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Instances: %s", err)
+	}
+
+	if len(arns) == 0 {
+		return fmt.Errorf("no SSO Instances found")
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %s", err)
+	}
+
+	if err := d.Set("identity_store_ids", identityStoreIDs); err != nil {
+		return fmt.Errorf("error setting identity_store_ids: %s", err)
+	}
+
+	return nil
+}
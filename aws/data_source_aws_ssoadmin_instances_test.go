@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSsoAdminInstances_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssoadmin_instances.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSsoAdminInstancesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "arns.0", regexp.MustCompile(`^arn:[^:]+:sso:::instance/ssoins-.+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "identity_store_ids.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "identity_store_ids.0", regexp.MustCompile(`^d-[0-9a-f]{10}$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsSsoAdminInstancesConfig = `
+data "aws_ssoadmin_instances" "test" {}
+`
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsStorageGatewayLocalDisk() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsStorageGatewayLocalDiskRead,
+
+		Schema: map[string]*schema.Schema{
+			"disk_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_node": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disk_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func dataSourceAwsStorageGatewayLocalDiskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+	gatewayARN := d.Get("gateway_arn").(string)
+
+	diskNode, diskNodeOk := d.GetOk("disk_node")
+	diskPath, diskPathOk := d.GetOk("disk_path")
+
+	if !diskNodeOk && !diskPathOk {
+		return fmt.Errorf("one of disk_node or disk_path must be configured")
+	}
+
+	output, err := conn.ListLocalDisks(&storagegateway.ListLocalDisksInput{
+		GatewayARN: aws.String(gatewayARN),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway local disks: %s", err)
+	}
+
+	var disk *storagegateway.Disk
+	for _, v := range output.Disks {
+		if diskNodeOk && aws.StringValue(v.DiskNode) != diskNode.(string) {
+			continue
+		}
+		if diskPathOk && aws.StringValue(v.DiskPath) != diskPath.(string) {
+			continue
+		}
+
+		disk = v
+		break
+	}
+
+	if disk == nil {
+		return fmt.Errorf("no matching Storage Gateway local disk found")
+	}
+
+	d.SetId(aws.StringValue(disk.DiskId))
+
+	d.Set("disk_id", disk.DiskId)
+	d.Set("disk_node", disk.DiskNode)
+	d.Set("disk_path", disk.DiskPath)
+
+	return nil
+}
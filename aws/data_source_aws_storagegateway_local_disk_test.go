@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsStorageGatewayLocalDisk_DiskPath(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_storagegateway_local_disk.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsStorageGatewayLocalDiskConfigDiskPath(rName, "/dev/xvdz"),
+				ExpectError: regexp.MustCompile(`no matching Storage Gateway local disk found`),
+			},
+			{
+				Config: testAccDataSourceAwsStorageGatewayLocalDiskConfigDiskPath(rName, "${aws_volume_attachment.test.device_name}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "disk_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "disk_path", "aws_volume_attachment.test", "device_name"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAWSStorageGatewayLocalDiskConfigBase attaches an additional EBS
+// volume to a volume gateway appliance for use as cache or upload buffer.
+func testAccAWSStorageGatewayLocalDiskConfigBase(rName, gatewayType string) string {
+	return testAccAWSStorageGatewayGatewayConfigGatewayType(rName, gatewayType) + fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = "10"
+  type              = "gp2"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "test" {
+  device_name  = "/dev/xvdb"
+  force_detach = true
+  instance_id  = "${aws_instance.test.id}"
+  volume_id    = "${aws_ebs_volume.test.id}"
+}
+`, rName)
+}
+
+func testAccDataSourceAwsStorageGatewayLocalDiskConfigDiskPath(rName, diskPath string) string {
+	return testAccAWSStorageGatewayLocalDiskConfigBase(rName, "CACHED") + fmt.Sprintf(`
+data "aws_storagegateway_local_disk" "test" {
+  disk_path   = %[1]q
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+`, diskPath)
+}
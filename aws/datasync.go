@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_datasync_* family of resources.
+
+// isDataSyncNotFound reports whether err is the InvalidRequestException that
+// DataSync returns for a location or task that no longer exists.
+func isDataSyncNotFound(err error) bool {
+	return isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") ||
+		isAWSErr(err, datasync.ErrCodeInvalidRequestException, "does not exist")
+}
+
+// dataSyncLocationSubdirectoryFromURI extracts the subdirectory from a
+// location URI such as s3://bucket/prefix/, as the Describe APIs do not
+// return it on its own.
+func dataSyncLocationSubdirectoryFromURI(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("error parsing DataSync location URI (%s): %s", uri, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("unexpected format of DataSync location URI (%s)", uri)
+	}
+
+	subdirectory := u.Path
+	if subdirectory == "" {
+		subdirectory = "/"
+	}
+
+	return subdirectory, nil
+}
+
+func listDataSyncTags(conn *datasync.DataSync, arn string) ([]*datasync.TagListEntry, error) {
+	input := &datasync.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+	var tags []*datasync.TagListEntry
+
+	for {
+		output, err := conn.ListTagsForResource(input)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, output.Tags...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return tags, nil
+}
+
+// dataSyncSubdirectoryDiffSuppress ignores the trailing slash DataSync
+// appends to subdirectories.
+func dataSyncSubdirectoryDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+}
+
+func expandDataSyncEc2Config(l []interface{}) *datasync.Ec2Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.Ec2Config{
+		SecurityGroupArns: expandStringSet(m["security_group_arns"].(*schema.Set)),
+		SubnetArn:         aws.String(m["subnet_arn"].(string)),
+	}
+}
+
+func flattenDataSyncEc2Config(config *datasync.Ec2Config) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_arns": schema.NewSet(schema.HashString, flattenStringList(config.SecurityGroupArns)),
+		"subnet_arn":          aws.StringValue(config.SubnetArn),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDataSyncOnPremConfig(l []interface{}) *datasync.OnPremConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.OnPremConfig{
+		AgentArns: expandStringSet(m["agent_arns"].(*schema.Set)),
+	}
+}
+
+func flattenDataSyncOnPremConfig(config *datasync.OnPremConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"agent_arns": schema.NewSet(schema.HashString, flattenStringList(config.AgentArns)),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDataSyncS3Config(l []interface{}) *datasync.S3Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.S3Config{
+		BucketAccessRoleArn: aws.String(m["bucket_access_role_arn"].(string)),
+	}
+}
+
+func flattenDataSyncS3Config(config *datasync.S3Config) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket_access_role_arn": aws.StringValue(config.BucketAccessRoleArn),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDataSyncNfsMountOptions(l []interface{}) *datasync.NfsMountOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.NfsMountOptions{
+		Version: aws.String(m["version"].(string)),
+	}
+}
+
+func flattenDataSyncNfsMountOptions(options *datasync.NfsMountOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"version": aws.StringValue(options.Version),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDataSyncSmbMountOptions(l []interface{}) *datasync.SmbMountOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.SmbMountOptions{
+		Version: aws.String(m["version"].(string)),
+	}
+}
+
+func flattenDataSyncSmbMountOptions(options *datasync.SmbMountOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"version": aws.StringValue(options.Version),
+	}
+
+	return []interface{}{m}
+}
+
+func deleteDataSyncLocation(conn *datasync.DataSync, arn, locationType string) error {
+	log.Printf("[DEBUG] Deleting DataSync %s location: %s", locationType, arn)
+	_, err := conn.DeleteLocation(&datasync.DeleteLocationInput{
+		LocationArn: aws.String(arn),
+	})
+	if isDataSyncNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting DataSync %s location (%s): %s", locationType, arn, err)
+	}
+
+	return nil
+}
+
+func expandDataSyncOptions(l []interface{}) *datasync.Options {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	options := &datasync.Options{}
+
+	if v, ok := m["atime"].(string); ok && v != "" {
+		options.Atime = aws.String(v)
+	}
+	if v, ok := m["bytes_per_second"].(int); ok && v != 0 {
+		options.BytesPerSecond = aws.Int64(int64(v))
+	}
+	if v, ok := m["gid"].(string); ok && v != "" {
+		options.Gid = aws.String(v)
+	}
+	if v, ok := m["log_level"].(string); ok && v != "" {
+		options.LogLevel = aws.String(v)
+	}
+	if v, ok := m["mtime"].(string); ok && v != "" {
+		options.Mtime = aws.String(v)
+	}
+	if v, ok := m["overwrite_mode"].(string); ok && v != "" {
+		options.OverwriteMode = aws.String(v)
+	}
+	if v, ok := m["posix_permissions"].(string); ok && v != "" {
+		options.PosixPermissions = aws.String(v)
+	}
+	if v, ok := m["preserve_deleted_files"].(string); ok && v != "" {
+		options.PreserveDeletedFiles = aws.String(v)
+	}
+	if v, ok := m["preserve_devices"].(string); ok && v != "" {
+		options.PreserveDevices = aws.String(v)
+	}
+	if v, ok := m["task_queueing"].(string); ok && v != "" {
+		options.TaskQueueing = aws.String(v)
+	}
+	if v, ok := m["transfer_mode"].(string); ok && v != "" {
+		options.TransferMode = aws.String(v)
+	}
+	if v, ok := m["uid"].(string); ok && v != "" {
+		options.Uid = aws.String(v)
+	}
+	if v, ok := m["verify_mode"].(string); ok && v != "" {
+		options.VerifyMode = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenDataSyncOptions(options *datasync.Options) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"atime":                  aws.StringValue(options.Atime),
+		"bytes_per_second":       int(aws.Int64Value(options.BytesPerSecond)),
+		"gid":                    aws.StringValue(options.Gid),
+		"log_level":              aws.StringValue(options.LogLevel),
+		"mtime":                  aws.StringValue(options.Mtime),
+		"overwrite_mode":         aws.StringValue(options.OverwriteMode),
+		"posix_permissions":      aws.StringValue(options.PosixPermissions),
+		"preserve_deleted_files": aws.StringValue(options.PreserveDeletedFiles),
+		"preserve_devices":       aws.StringValue(options.PreserveDevices),
+		"task_queueing":          aws.StringValue(options.TaskQueueing),
+		"transfer_mode":          aws.StringValue(options.TransferMode),
+		"uid":                    aws.StringValue(options.Uid),
+		"verify_mode":            aws.StringValue(options.VerifyMode),
+	}
+
+	return []interface{}{m}
+}
+
+// expandDataSyncFilterRules always returns a non-nil slice so that an empty
+// configuration clears any existing filter on update.
+func expandDataSyncFilterRules(l []interface{}) []*datasync.FilterRule {
+	rules := []*datasync.FilterRule{}
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, &datasync.FilterRule{
+			FilterType: aws.String(m["filter_type"].(string)),
+			Value:      aws.String(m["value"].(string)),
+		})
+	}
+
+	return rules
+}
+
+func flattenDataSyncFilterRules(rules []*datasync.FilterRule) []interface{} {
+	l := []interface{}{}
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"filter_type": aws.StringValue(rule.FilterType),
+			"value":       aws.StringValue(rule.Value),
+		})
+	}
+
+	return l
+}
+
+func expandDataSyncTaskSchedule(l []interface{}) *datasync.TaskSchedule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.TaskSchedule{
+		ScheduleExpression: aws.String(m["schedule_expression"].(string)),
+	}
+}
+
+func flattenDataSyncTaskSchedule(schedule *datasync.TaskSchedule) []interface{} {
+	if schedule == nil || aws.StringValue(schedule.ScheduleExpression) == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"schedule_expression": aws.StringValue(schedule.ScheduleExpression),
+	}
+
+	return []interface{}{m}
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"log"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/awspolicyequivalence"
+	"gopkg.in/yaml.v2"
 )
 
 func suppressEquivalentAwsPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
@@ -60,6 +63,37 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+func suppressEquivalentYamlDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var ov interface{}
+	if err := yaml.Unmarshal([]byte(old), &ov); err != nil {
+		return false
+	}
+
+	var nv interface{}
+	if err := yaml.Unmarshal([]byte(new), &nv); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(ov, nv)
+}
+
+// suppressEquivalentRFC3339Timestamps suppresses differences between RFC3339
+// timestamps that represent the same instant, e.g. "2021-06-01T00:00:00Z" and
+// "2021-06-01T00:00:00.000Z".
+func suppressEquivalentRFC3339Timestamps(k, old, new string, d *schema.ResourceData) bool {
+	ot, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	nt, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return ot.Equal(nt)
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
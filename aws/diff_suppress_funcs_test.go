@@ -29,3 +29,77 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentYamlDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "schemaVersion: '2.2'\ndescription: test\n",
+			new:        "description: test\nschemaVersion: '2.2'\n",
+			equivalent: true,
+		},
+		{
+			old:        "mainSteps:\n- action: aws:runShellScript\n  name: example\n",
+			new:        "mainSteps:\n  -   name: example\n      action: aws:runShellScript\n",
+			equivalent: true,
+		},
+		{
+			old:        "description: test\n",
+			new:        "description: tested\n",
+			equivalent: false,
+		},
+		{
+			old:        "description: test\n",
+			new:        "description: [test\n",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if got := suppressEquivalentYamlDiffs("", tc.old, tc.new, d); got != tc.equivalent {
+			t.Errorf("%d: expected suppressEquivalentYamlDiffs to return %t for %q and %q, got %t", i, tc.equivalent, tc.old, tc.new, got)
+		}
+	}
+}
+
+func TestSuppressEquivalentRFC3339Timestamps(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2021-06-01T00:00:00.000Z",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2021-06-01T02:00:00+02:00",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2021-06-01T00:00:00.000Z",
+			new:        "2021-06-01T00:01:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if got := suppressEquivalentRFC3339Timestamps("", tc.old, tc.new, d); got != tc.equivalent {
+			t.Errorf("%d: expected suppressEquivalentRFC3339Timestamps to return %t for %q and %q, got %t", i, tc.equivalent, tc.old, tc.new, got)
+		}
+	}
+}
@@ -0,0 +1,441 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_dx_gateway* and aws_dx_*_virtual_interface
+// families of resources.
+
+// dxVirtualInterfaceArn returns the ARN of a virtual interface owned by the
+// current account in the current region.
+func dxVirtualInterfaceArn(meta interface{}, vifId string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Service:   "directconnect",
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dxvif/%s", vifId),
+	}.String()
+}
+
+func dxDescribeGateway(conn *directconnect.DirectConnect, id string) (*directconnect.Gateway, error) {
+	input := &directconnect.DescribeDirectConnectGatewaysInput{
+		DirectConnectGatewayId: aws.String(id),
+	}
+
+	output, err := conn.DescribeDirectConnectGateways(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gateway := range output.DirectConnectGateways {
+		if aws.StringValue(gateway.DirectConnectGatewayId) == id {
+			return gateway, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dxGatewayRefreshFunc(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gateway, err := dxDescribeGateway(conn, id)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Direct Connect Gateway (%s): %s", id, err)
+		}
+
+		if gateway == nil {
+			return "", directconnect.GatewayStateDeleted, nil
+		}
+
+		if aws.StringValue(gateway.StateChangeError) != "" {
+			return gateway, aws.StringValue(gateway.DirectConnectGatewayState), fmt.Errorf("%s", aws.StringValue(gateway.StateChangeError))
+		}
+
+		return gateway, aws.StringValue(gateway.DirectConnectGatewayState), nil
+	}
+}
+
+func waitForDxGatewayCreation(conn *directconnect.DirectConnect, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directconnect.GatewayStatePending},
+		Target:     []string{directconnect.GatewayStateAvailable},
+		Refresh:    dxGatewayRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Gateway (%s) availability", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForDxGatewayDeletion(conn *directconnect.DirectConnect, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directconnect.GatewayStatePending, directconnect.GatewayStateAvailable, directconnect.GatewayStateDeleting},
+		Target:     []string{directconnect.GatewayStateDeleted},
+		Refresh:    dxGatewayRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Gateway (%s) deletion", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func dxDescribeGatewayAssociation(conn *directconnect.DirectConnect, associationId string) (*directconnect.GatewayAssociation, error) {
+	input := &directconnect.DescribeDirectConnectGatewayAssociationsInput{
+		AssociationId: aws.String(associationId),
+	}
+
+	output, err := conn.DescribeDirectConnectGatewayAssociations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range output.DirectConnectGatewayAssociations {
+		if aws.StringValue(association.AssociationId) == associationId {
+			return association, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// dxDescribeGatewayAssociationByGateways returns the association between a
+// Direct Connect gateway and a virtual private gateway or transit gateway.
+func dxDescribeGatewayAssociationByGateways(conn *directconnect.DirectConnect, dxGatewayId, associatedGatewayId string) (*directconnect.GatewayAssociation, error) {
+	input := &directconnect.DescribeDirectConnectGatewayAssociationsInput{
+		AssociatedGatewayId:    aws.String(associatedGatewayId),
+		DirectConnectGatewayId: aws.String(dxGatewayId),
+	}
+
+	output, err := conn.DescribeDirectConnectGatewayAssociations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range output.DirectConnectGatewayAssociations {
+		if association.AssociatedGateway != nil && aws.StringValue(association.AssociatedGateway.Id) == associatedGatewayId {
+			return association, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dxGatewayAssociationRefreshFunc(conn *directconnect.DirectConnect, associationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := dxDescribeGatewayAssociation(conn, associationId)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Direct Connect Gateway Association (%s): %s", associationId, err)
+		}
+
+		if association == nil {
+			return "", directconnect.GatewayAssociationStateDisassociated, nil
+		}
+
+		if aws.StringValue(association.StateChangeError) != "" {
+			return association, aws.StringValue(association.AssociationState), fmt.Errorf("%s", aws.StringValue(association.StateChangeError))
+		}
+
+		return association, aws.StringValue(association.AssociationState), nil
+	}
+}
+
+func waitForDxGatewayAssociationAssociated(conn *directconnect.DirectConnect, associationId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directconnect.GatewayAssociationStateAssociating, directconnect.GatewayAssociationStateUpdating},
+		Target:     []string{directconnect.GatewayAssociationStateAssociated},
+		Refresh:    dxGatewayAssociationRefreshFunc(conn, associationId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Gateway Association (%s) to be associated", associationId)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForDxGatewayAssociationDisassociated(conn *directconnect.DirectConnect, associationId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directconnect.GatewayAssociationStateAssociated, directconnect.GatewayAssociationStateDisassociating},
+		Target:     []string{directconnect.GatewayAssociationStateDisassociated},
+		Refresh:    dxGatewayAssociationRefreshFunc(conn, associationId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Gateway Association (%s) to be disassociated", associationId)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func dxDescribeGatewayAssociationProposal(conn *directconnect.DirectConnect, proposalId string) (*directconnect.GatewayAssociationProposal, error) {
+	input := &directconnect.DescribeDirectConnectGatewayAssociationProposalsInput{
+		ProposalId: aws.String(proposalId),
+	}
+
+	output, err := conn.DescribeDirectConnectGatewayAssociationProposals(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposal := range output.DirectConnectGatewayAssociationProposals {
+		if aws.StringValue(proposal.ProposalId) == proposalId {
+			return proposal, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dxDescribeVirtualInterface(conn *directconnect.DirectConnect, id string) (*directconnect.VirtualInterface, error) {
+	input := &directconnect.DescribeVirtualInterfacesInput{
+		VirtualInterfaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeVirtualInterfaces(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vif := range output.VirtualInterfaces {
+		if aws.StringValue(vif.VirtualInterfaceId) == id {
+			return vif, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dxVirtualInterfaceRefreshFunc(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vif, err := dxDescribeVirtualInterface(conn, id)
+
+		if isNoSuchDxVirtualInterfaceErr(err) {
+			return "", directconnect.VirtualInterfaceStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Direct Connect Virtual Interface (%s): %s", id, err)
+		}
+
+		if vif == nil {
+			return "", directconnect.VirtualInterfaceStateDeleted, nil
+		}
+
+		return vif, aws.StringValue(vif.VirtualInterfaceState), nil
+	}
+}
+
+// waitForDxVirtualInterfaceAvailable waits for a virtual interface to finish
+// provisioning. An interface whose BGP session is not yet established reports
+// "down", which is considered available as far as the API is concerned.
+// Public virtual interfaces stay "verifying" until AWS has confirmed ownership
+// of the advertised prefixes.
+func waitForDxVirtualInterfaceAvailable(conn *directconnect.DirectConnect, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.VirtualInterfaceStatePending,
+			directconnect.VirtualInterfaceStateVerifying,
+		},
+		Target: []string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateDown,
+		},
+		Refresh:    dxVirtualInterfaceRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Virtual Interface (%s) availability", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForDxVirtualInterfaceDeletion(conn *directconnect.DirectConnect, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateConfirming,
+			directconnect.VirtualInterfaceStateDeleting,
+			directconnect.VirtualInterfaceStateDown,
+			directconnect.VirtualInterfaceStatePending,
+			directconnect.VirtualInterfaceStateRejected,
+			directconnect.VirtualInterfaceStateVerifying,
+		},
+		Target: []string{
+			directconnect.VirtualInterfaceStateDeleted,
+		},
+		Refresh:    dxVirtualInterfaceRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Direct Connect Virtual Interface (%s) deletion", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// dxVirtualInterfaceRead sets the attributes shared by all virtual interface
+// types. It returns a nil interface if the virtual interface is gone.
+func dxVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) (*directconnect.VirtualInterface, error) {
+	conn := meta.(*AWSClient).dxconn
+
+	vif, err := dxDescribeVirtualInterface(conn, d.Id())
+
+	if isNoSuchDxVirtualInterfaceErr(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Direct Connect Virtual Interface (%s): %s", d.Id(), err)
+	}
+
+	if vif == nil {
+		return nil, nil
+	}
+
+	switch aws.StringValue(vif.VirtualInterfaceState) {
+	case directconnect.VirtualInterfaceStateDeleting, directconnect.VirtualInterfaceStateDeleted, directconnect.VirtualInterfaceStateRejected:
+		return nil, nil
+	}
+
+	arn := dxVirtualInterfaceArn(meta, d.Id())
+
+	d.Set("address_family", vif.AddressFamily)
+	d.Set("amazon_address", vif.AmazonAddress)
+	d.Set("arn", arn)
+	d.Set("aws_device", vif.AwsDeviceV2)
+	d.Set("bgp_asn", vif.Asn)
+	d.Set("bgp_auth_key", vif.AuthKey)
+	d.Set("connection_id", vif.ConnectionId)
+	d.Set("customer_address", vif.CustomerAddress)
+	d.Set("name", vif.VirtualInterfaceName)
+	d.Set("vlan", vif.Vlan)
+
+	if err := getTagsDX(conn, d, arn); err != nil {
+		return nil, fmt.Errorf("error reading Direct Connect Virtual Interface (%s) tags: %s", d.Id(), err)
+	}
+
+	return vif, nil
+}
+
+// dxVirtualInterfaceUpdate applies the attribute changes supported by
+// UpdateVirtualInterfaceAttributes and updates tags.
+func dxVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	if d.HasChange("mtu") || d.HasChange("sitelink_enabled") {
+		input := &directconnect.UpdateVirtualInterfaceAttributesInput{
+			VirtualInterfaceId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("mtu") {
+			input.Mtu = aws.Int64(int64(d.Get("mtu").(int)))
+		}
+
+		if d.HasChange("sitelink_enabled") {
+			input.EnableSiteLink = aws.Bool(d.Get("sitelink_enabled").(bool))
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect Virtual Interface (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateVirtualInterfaceAttributes(input); err != nil {
+			return fmt.Errorf("error updating Direct Connect Virtual Interface (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDxVirtualInterfaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Direct Connect Virtual Interface (%s) availability: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDX(conn, d, dxVirtualInterfaceArn(meta, d.Id())); err != nil {
+		return fmt.Errorf("error updating Direct Connect Virtual Interface (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func dxVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.DeleteVirtualInterfaceInput{
+		VirtualInterfaceId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect Virtual Interface (%s): %s", d.Id(), input)
+	_, err := conn.DeleteVirtualInterface(input)
+
+	if isNoSuchDxVirtualInterfaceErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect Virtual Interface (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDxVirtualInterfaceDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Virtual Interface (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func isNoSuchDxVirtualInterfaceErr(err error) bool {
+	return isAWSErr(err, directconnect.ErrCodeClientException, "does not exist")
+}
+
+func isNoSuchDxGatewayErr(err error) bool {
+	return isAWSErr(err, directconnect.ErrCodeClientException, "does not exist")
+}
+
+func expandDxRouteFilterPrefixes(l []interface{}) []*directconnect.RouteFilterPrefix {
+	prefixes := make([]*directconnect.RouteFilterPrefix, 0, len(l))
+
+	for _, v := range l {
+		prefixes = append(prefixes, &directconnect.RouteFilterPrefix{
+			Cidr: aws.String(v.(string)),
+		})
+	}
+
+	return prefixes
+}
+
+func flattenDxRouteFilterPrefixes(prefixes []*directconnect.RouteFilterPrefix) []interface{} {
+	l := make([]interface{}, 0, len(prefixes))
+
+	for _, prefix := range prefixes {
+		if prefix == nil {
+			continue
+		}
+
+		l = append(l, aws.StringValue(prefix.Cidr))
+	}
+
+	return l
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ec2_capacity_reservation and
+// aws_ec2_capacity_reservation_group resources.
+
+const (
+	// Resource group configuration types that make a resource group usable as
+	// an On-Demand Capacity Reservation group.
+	resourceGroupConfigurationTypeCapacityReservationPool = "AWS::EC2::CapacityReservationPool"
+	resourceGroupConfigurationTypeGeneric                 = "AWS::ResourceGroups::Generic"
+
+	resourceGroupConfigurationParameterAllowedResourceTypes = "allowed-resource-types"
+	resourceGroupResourceTypeCapacityReservation            = "AWS::EC2::CapacityReservation"
+)
+
+func ec2DescribeCapacityReservation(conn *ec2.EC2, capacityReservationID string) (*ec2.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []*string{aws.String(capacityReservationID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Capacity Reservation (%s): %s", capacityReservationID, input)
+	output, err := conn.DescribeCapacityReservations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reservation := range output.CapacityReservations {
+		if reservation != nil && aws.StringValue(reservation.CapacityReservationId) == capacityReservationID {
+			return reservation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ec2GetCapacityReservationGroupArns returns the ARNs of the resource groups
+// the Capacity Reservation belongs to.
+func ec2GetCapacityReservationGroupArns(conn *ec2.EC2, capacityReservationID string) ([]string, error) {
+	input := &ec2.GetGroupsForCapacityReservationInput{
+		CapacityReservationId: aws.String(capacityReservationID),
+	}
+
+	var arns []string
+	err := conn.GetGroupsForCapacityReservationPages(input, func(page *ec2.GetGroupsForCapacityReservationOutput, lastPage bool) bool {
+		for _, group := range page.CapacityReservationGroups {
+			if group != nil {
+				arns = append(arns, aws.StringValue(group.GroupArn))
+			}
+		}
+		return !lastPage
+	})
+
+	return arns, err
+}
+
+func ec2CapacityReservationRefreshFunc(conn *ec2.EC2, capacityReservationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		reservation, err := ec2DescribeCapacityReservation(conn, capacityReservationID)
+
+		if isAWSErr(err, "InvalidCapacityReservationId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Capacity Reservation (%s): %s", capacityReservationID, err)
+		}
+
+		if reservation == nil {
+			return nil, "", nil
+		}
+
+		return reservation, aws.StringValue(reservation.State), nil
+	}
+}
+
+func waitForEc2CapacityReservationActive(conn *ec2.EC2, capacityReservationID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationStatePending},
+		Target:  []string{ec2.CapacityReservationStateActive},
+		Refresh: ec2CapacityReservationRefreshFunc(conn, capacityReservationID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Capacity Reservation (%s) to become active", capacityReservationID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func resourceGroupsGroupResource(conn *resourcegroups.ResourceGroups, groupArn, resourceArn string) error {
+	input := &resourcegroups.GroupResourcesInput{
+		Group:        aws.String(groupArn),
+		ResourceArns: aws.StringSlice([]string{resourceArn}),
+	}
+
+	log.Printf("[DEBUG] Adding resource (%s) to Resource Group (%s): %s", resourceArn, groupArn, input)
+	output, err := conn.GroupResources(input)
+	if err != nil {
+		return err
+	}
+
+	for _, failed := range output.Failed {
+		if failed != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+		}
+	}
+
+	return nil
+}
+
+func resourceGroupsUngroupResource(conn *resourcegroups.ResourceGroups, groupArn, resourceArn string) error {
+	input := &resourcegroups.UngroupResourcesInput{
+		Group:        aws.String(groupArn),
+		ResourceArns: aws.StringSlice([]string{resourceArn}),
+	}
+
+	log.Printf("[DEBUG] Removing resource (%s) from Resource Group (%s): %s", resourceArn, groupArn, input)
+	output, err := conn.UngroupResources(input)
+	if err != nil {
+		return err
+	}
+
+	for _, failed := range output.Failed {
+		if failed != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+		}
+	}
+
+	return nil
+}
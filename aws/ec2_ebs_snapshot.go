@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ebs_snapshot_copy and aws_ebs_snapshot_import resources.
+
+const (
+	// Import snapshot task statuses are not modelled as SDK constants.
+	ec2ImportSnapshotTaskStatusActive    = "active"
+	ec2ImportSnapshotTaskStatusCompleted = "completed"
+	ec2ImportSnapshotTaskStatusDeleting  = "deleting"
+	ec2ImportSnapshotTaskStatusDeleted   = "deleted"
+)
+
+func ec2DescribeSnapshot(conn *ec2.EC2, snapshotID string) (*ec2.Snapshot, error) {
+	input := &ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(snapshotID)},
+	}
+
+	log.Printf("[DEBUG] Reading EBS Snapshot (%s): %s", snapshotID, input)
+	output, err := conn.DescribeSnapshots(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range output.Snapshots {
+		if snapshot != nil && aws.StringValue(snapshot.SnapshotId) == snapshotID {
+			return snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2SnapshotRefreshFunc(conn *ec2.EC2, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := ec2DescribeSnapshot(conn, snapshotID)
+
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EBS Snapshot (%s): %s", snapshotID, err)
+		}
+
+		if snapshot == nil {
+			return nil, "", nil
+		}
+
+		state := aws.StringValue(snapshot.State)
+		if state == ec2.SnapshotStateError {
+			return snapshot, state, fmt.Errorf("EBS Snapshot (%s) failed: %s", snapshotID, aws.StringValue(snapshot.StateMessage))
+		}
+
+		log.Printf("[DEBUG] EBS Snapshot (%s) progress: %s", snapshotID, aws.StringValue(snapshot.Progress))
+
+		return snapshot, state, nil
+	}
+}
+
+func waitForEc2SnapshotCompleted(conn *ec2.EC2, snapshotID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.SnapshotStatePending},
+		Target:     []string{ec2.SnapshotStateCompleted},
+		Refresh:    ec2SnapshotRefreshFunc(conn, snapshotID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EBS Snapshot (%s) to complete", snapshotID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func ec2DescribeImportSnapshotTask(conn *ec2.EC2, importTaskID string) (*ec2.ImportSnapshotTask, error) {
+	input := &ec2.DescribeImportSnapshotTasksInput{
+		ImportTaskIds: []*string{aws.String(importTaskID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Import Snapshot Task (%s): %s", importTaskID, input)
+	output, err := conn.DescribeImportSnapshotTasks(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range output.ImportSnapshotTasks {
+		if task != nil && aws.StringValue(task.ImportTaskId) == importTaskID {
+			return task, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2ImportSnapshotTaskRefreshFunc(conn *ec2.EC2, importTaskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := ec2DescribeImportSnapshotTask(conn, importTaskID)
+
+		if isAWSErr(err, "InvalidConversionTaskId.Malformed", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Import Snapshot Task (%s): %s", importTaskID, err)
+		}
+
+		if task == nil || task.SnapshotTaskDetail == nil {
+			return nil, "", nil
+		}
+
+		detail := task.SnapshotTaskDetail
+		status := aws.StringValue(detail.Status)
+		switch status {
+		case ec2ImportSnapshotTaskStatusDeleting, ec2ImportSnapshotTaskStatusDeleted:
+			return task, status, fmt.Errorf("EC2 Import Snapshot Task (%s) %s: %s", importTaskID, status, aws.StringValue(detail.StatusMessage))
+		}
+
+		log.Printf("[DEBUG] EC2 Import Snapshot Task (%s) progress: %s (%s)", importTaskID, aws.StringValue(detail.Progress), aws.StringValue(detail.StatusMessage))
+
+		return task, status, nil
+	}
+}
+
+func waitForEc2ImportSnapshotTaskCompleted(conn *ec2.EC2, importTaskID string, timeout time.Duration) (*ec2.ImportSnapshotTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2ImportSnapshotTaskStatusActive},
+		Target:     []string{ec2ImportSnapshotTaskStatusCompleted},
+		Refresh:    ec2ImportSnapshotTaskRefreshFunc(conn, importTaskID),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Import Snapshot Task (%s) to complete", importTaskID)
+	outputRaw, err := stateConf.WaitForState()
+
+	if task, ok := outputRaw.(*ec2.ImportSnapshotTask); ok {
+		return task, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ebs_volume resource.
+
+func ec2DescribeVolumeModification(conn *ec2.EC2, volumeID string) (*ec2.VolumeModification, error) {
+	input := &ec2.DescribeVolumesModificationsInput{
+		VolumeIds: []*string{aws.String(volumeID)},
+	}
+
+	log.Printf("[DEBUG] Reading EBS Volume (%s) modification: %s", volumeID, input)
+	output, err := conn.DescribeVolumesModifications(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, modification := range output.VolumesModifications {
+		if modification != nil && aws.StringValue(modification.VolumeId) == volumeID {
+			return modification, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2VolumeModificationRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		modification, err := ec2DescribeVolumeModification(conn, volumeID)
+
+		if isAWSErr(err, "InvalidVolumeModification.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EBS Volume (%s) modification: %s", volumeID, err)
+		}
+
+		if modification == nil {
+			return nil, "", nil
+		}
+
+		state := aws.StringValue(modification.ModificationState)
+		if state == ec2.VolumeModificationStateFailed {
+			return modification, state, fmt.Errorf("EBS Volume (%s) modification failed: %s", volumeID, aws.StringValue(modification.StatusMessage))
+		}
+
+		return modification, state, nil
+	}
+}
+
+// waitForEc2VolumeModificationComplete waits until a volume modification is
+// usable. The volume is ready for use once it enters the "optimizing" state;
+// optimization can take several more hours and is not waited for.
+func waitForEc2VolumeModificationComplete(conn *ec2.EC2, volumeID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VolumeModificationStateModifying},
+		Target:     []string{ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted},
+		Refresh:    ec2VolumeModificationRefreshFunc(conn, volumeID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EBS Volume (%s) modification to complete", volumeID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ec2_host resource.
+
+func ec2DescribeHost(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	input := &ec2.DescribeHostsInput{
+		HostIds: []*string{aws.String(hostID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Host (%s): %s", hostID, input)
+	output, err := conn.DescribeHosts(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range output.Hosts {
+		if host != nil && aws.StringValue(host.HostId) == hostID {
+			return host, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ec2UnsuccessfulItemsError returns an error describing the first failed item
+// of a batch EC2 API call, or nil if every item succeeded.
+func ec2UnsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item == nil || item.Error == nil {
+			continue
+		}
+
+		return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+	}
+
+	return nil
+}
+
+func ec2HostRefreshFunc(conn *ec2.EC2, hostID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := ec2DescribeHost(conn, hostID)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Host (%s): %s", hostID, err)
+		}
+
+		if host == nil {
+			return nil, "", nil
+		}
+
+		return host, aws.StringValue(host.State), nil
+	}
+}
+
+func waitForEc2HostAvailable(conn *ec2.EC2, hostID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: ec2HostRefreshFunc(conn, hostID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) to become available", hostID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2HostReleased(conn *ec2.EC2, hostID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStateAvailable, ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure},
+		Refresh: ec2HostRefreshFunc(conn, hostID),
+		Timeout: 20 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) to be released", hostID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
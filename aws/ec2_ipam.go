@@ -0,0 +1,422 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_vpc_ipam* family of resources.
+
+// ec2IpamNotFoundState is reported by the IPAM refresh functions once the
+// requested object can no longer be found.
+const ec2IpamNotFoundState = "not-found"
+
+func decodeEc2IpamPoolCidrID(id string) (string, string, error) {
+	idParts := strings.Split(id, "_")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected <cidr>_<ipam-pool-id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// resourceAwsEc2Ipv4IpamPoolCustomizeDiff rejects, at plan time, an aws_vpc or
+// aws_subnet whose ipv4_netmask_length is set without an IPAM pool to
+// allocate the CIDR block from.
+//
+// cidr_block is Optional and Computed, so an omitted cidr_block cannot be told
+// apart from one that is not known until apply (e.g. a cidrsubnet() of a new
+// VPC). A missing cidr_block and IPAM pool is therefore only caught on create.
+func resourceAwsEc2Ipv4IpamPoolCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	// A pool ID that is not known yet is reported as changed but not set.
+	if _, ok := diff.GetOk("ipv4_ipam_pool_id"); ok || len(diff.GetChangedKeysPrefix("ipv4_ipam_pool_id")) > 0 {
+		return nil
+	}
+
+	if _, ok := diff.GetOk("ipv4_netmask_length"); ok {
+		return fmt.Errorf("ipv4_netmask_length requires ipv4_ipam_pool_id")
+	}
+
+	return nil
+}
+
+// vpcIpamIDFromArn extracts the IPAM identifier from an IPAM ARN, e.g.
+// arn:aws:ec2::123456789012:ipam/ipam-0123456789abcdef0.
+func vpcIpamIDFromArn(ipamArn string) (string, error) {
+	return vpcIpamResourceIDFromArn(ipamArn, "ipam")
+}
+
+// vpcIpamScopeIDFromArn extracts the IPAM scope identifier from an IPAM scope
+// ARN, e.g. arn:aws:ec2::123456789012:ipam-scope/ipam-scope-0123456789abcdef0.
+func vpcIpamScopeIDFromArn(ipamScopeArn string) (string, error) {
+	return vpcIpamResourceIDFromArn(ipamScopeArn, "ipam-scope")
+}
+
+func vpcIpamResourceIDFromArn(v, resourceType string) (string, error) {
+	parsedArn, err := arn.Parse(v)
+	if err != nil {
+		return "", fmt.Errorf("error parsing EC2 IPAM ARN (%s): %s", v, err)
+	}
+
+	resourceParts := strings.Split(parsedArn.Resource, "/")
+	if len(resourceParts) != 2 || resourceParts[0] != resourceType {
+		return "", fmt.Errorf("unexpected format of EC2 IPAM ARN (%s), expected %s/<id>", v, resourceType)
+	}
+
+	return resourceParts[1], nil
+}
+
+func ec2DescribeIpam(conn *ec2.EC2, ipamID string) (*ec2.Ipam, error) {
+	input := &ec2.DescribeIpamsInput{
+		IpamIds: []*string{aws.String(ipamID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 IPAM (%s): %s", ipamID, input)
+	output, err := conn.DescribeIpams(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipam := range output.Ipams {
+		if ipam != nil && aws.StringValue(ipam.IpamId) == ipamID {
+			return ipam, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeIpamScope(conn *ec2.EC2, ipamScopeID string) (*ec2.IpamScope, error) {
+	input := &ec2.DescribeIpamScopesInput{
+		IpamScopeIds: []*string{aws.String(ipamScopeID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 IPAM Scope (%s): %s", ipamScopeID, input)
+	output, err := conn.DescribeIpamScopes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipamScope := range output.IpamScopes {
+		if ipamScope != nil && aws.StringValue(ipamScope.IpamScopeId) == ipamScopeID {
+			return ipamScope, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeIpamPool(conn *ec2.EC2, ipamPoolID string) (*ec2.IpamPool, error) {
+	input := &ec2.DescribeIpamPoolsInput{
+		IpamPoolIds: []*string{aws.String(ipamPoolID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 IPAM Pool (%s): %s", ipamPoolID, input)
+	output, err := conn.DescribeIpamPools(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipamPool := range output.IpamPools {
+		if ipamPool != nil && aws.StringValue(ipamPool.IpamPoolId) == ipamPoolID {
+			return ipamPool, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ec2DescribeIpamPoolCidr returns the CIDR provisioned to the given pool that
+// matches either the CIDR itself or the IPAM pool CIDR identifier. The latter
+// is used while a CIDR requested by netmask length has not been resolved yet.
+func ec2DescribeIpamPoolCidr(conn *ec2.EC2, ipamPoolID, cidr, ipamPoolCidrID string) (*ec2.IpamPoolCidr, error) {
+	input := &ec2.GetIpamPoolCidrsInput{
+		IpamPoolId: aws.String(ipamPoolID),
+	}
+
+	var result *ec2.IpamPoolCidr
+
+	log.Printf("[DEBUG] Reading EC2 IPAM Pool (%s) CIDRs: %s", ipamPoolID, input)
+	err := conn.GetIpamPoolCidrsPages(input, func(page *ec2.GetIpamPoolCidrsOutput, lastPage bool) bool {
+		for _, ipamPoolCidr := range page.IpamPoolCidrs {
+			if ipamPoolCidr == nil {
+				continue
+			}
+
+			if (cidr != "" && aws.StringValue(ipamPoolCidr.Cidr) == cidr) ||
+				(ipamPoolCidrID != "" && aws.StringValue(ipamPoolCidr.IpamPoolCidrId) == ipamPoolCidrID) {
+				result = ipamPoolCidr
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ec2IpamRefreshFunc(conn *ec2.EC2, ipamID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ipam, err := ec2DescribeIpam(conn, ipamID)
+
+		if isAWSErr(err, "InvalidIpamId.NotFound", "") {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 IPAM (%s): %s", ipamID, err)
+		}
+
+		if ipam == nil {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		return ipam, aws.StringValue(ipam.State), nil
+	}
+}
+
+func ec2IpamScopeRefreshFunc(conn *ec2.EC2, ipamScopeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ipamScope, err := ec2DescribeIpamScope(conn, ipamScopeID)
+
+		if isAWSErr(err, "InvalidIpamScopeId.NotFound", "") {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 IPAM Scope (%s): %s", ipamScopeID, err)
+		}
+
+		if ipamScope == nil {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		return ipamScope, aws.StringValue(ipamScope.State), nil
+	}
+}
+
+func ec2IpamPoolRefreshFunc(conn *ec2.EC2, ipamPoolID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ipamPool, err := ec2DescribeIpamPool(conn, ipamPoolID)
+
+		if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 IPAM Pool (%s): %s", ipamPoolID, err)
+		}
+
+		if ipamPool == nil {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		switch state := aws.StringValue(ipamPool.State); state {
+		case ec2.IpamPoolStateCreateFailed, ec2.IpamPoolStateModifyFailed, ec2.IpamPoolStateDeleteFailed:
+			return ipamPool, state, fmt.Errorf("EC2 IPAM Pool (%s) in %s state: %s", ipamPoolID, state, aws.StringValue(ipamPool.StateMessage))
+		}
+
+		return ipamPool, aws.StringValue(ipamPool.State), nil
+	}
+}
+
+func ec2IpamPoolCidrRefreshFunc(conn *ec2.EC2, ipamPoolID, cidr, ipamPoolCidrID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ipamPoolCidr, err := ec2DescribeIpamPoolCidr(conn, ipamPoolID, cidr, ipamPoolCidrID)
+
+		if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 IPAM Pool (%s) CIDR: %s", ipamPoolID, err)
+		}
+
+		if ipamPoolCidr == nil {
+			return false, ec2IpamNotFoundState, nil
+		}
+
+		switch state := aws.StringValue(ipamPoolCidr.State); state {
+		case ec2.IpamPoolCidrStateFailedProvision, ec2.IpamPoolCidrStateFailedDeprovision, ec2.IpamPoolCidrStateFailedImport:
+			var reason string
+			if ipamPoolCidr.FailureReason != nil {
+				reason = aws.StringValue(ipamPoolCidr.FailureReason.Message)
+			}
+			return ipamPoolCidr, state, fmt.Errorf("EC2 IPAM Pool (%s) CIDR in %s state: %s", ipamPoolID, state, reason)
+		}
+
+		return ipamPoolCidr, aws.StringValue(ipamPoolCidr.State), nil
+	}
+}
+
+func waitForEc2IpamCreation(conn *ec2.EC2, ipamID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamStateCreateInProgress},
+		Target:  []string{ec2.IpamStateCreateComplete},
+		Refresh: ec2IpamRefreshFunc(conn, ipamID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM (%s) availability", ipamID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamModification(conn *ec2.EC2, ipamID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamStateModifyInProgress},
+		Target:  []string{ec2.IpamStateModifyComplete},
+		Refresh: ec2IpamRefreshFunc(conn, ipamID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM (%s) modification", ipamID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamDeletion(conn *ec2.EC2, ipamID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.IpamStateCreateComplete,
+			ec2.IpamStateModifyComplete,
+			ec2.IpamStateDeleteInProgress,
+		},
+		Target:  []string{ec2.IpamStateDeleteComplete, ec2IpamNotFoundState},
+		Refresh: ec2IpamRefreshFunc(conn, ipamID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM (%s) deletion", ipamID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamScopeCreation(conn *ec2.EC2, ipamScopeID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamScopeStateCreateInProgress},
+		Target:  []string{ec2.IpamScopeStateCreateComplete},
+		Refresh: ec2IpamScopeRefreshFunc(conn, ipamScopeID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Scope (%s) availability", ipamScopeID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamScopeDeletion(conn *ec2.EC2, ipamScopeID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.IpamScopeStateCreateComplete,
+			ec2.IpamScopeStateModifyComplete,
+			ec2.IpamScopeStateDeleteInProgress,
+		},
+		Target:  []string{ec2.IpamScopeStateDeleteComplete, ec2IpamNotFoundState},
+		Refresh: ec2IpamScopeRefreshFunc(conn, ipamScopeID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Scope (%s) deletion", ipamScopeID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamPoolCreation(conn *ec2.EC2, ipamPoolID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamPoolStateCreateInProgress},
+		Target:  []string{ec2.IpamPoolStateCreateComplete},
+		Refresh: ec2IpamPoolRefreshFunc(conn, ipamPoolID),
+		Timeout: 20 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Pool (%s) availability", ipamPoolID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamPoolModification(conn *ec2.EC2, ipamPoolID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamPoolStateModifyInProgress},
+		Target:  []string{ec2.IpamPoolStateModifyComplete},
+		Refresh: ec2IpamPoolRefreshFunc(conn, ipamPoolID),
+		Timeout: 20 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Pool (%s) modification", ipamPoolID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamPoolDeletion(conn *ec2.EC2, ipamPoolID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.IpamPoolStateCreateComplete,
+			ec2.IpamPoolStateModifyComplete,
+			ec2.IpamPoolStateDeleteInProgress,
+		},
+		Target:  []string{ec2.IpamPoolStateDeleteComplete, ec2IpamNotFoundState},
+		Refresh: ec2IpamPoolRefreshFunc(conn, ipamPoolID),
+		Timeout: 20 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Pool (%s) deletion", ipamPoolID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2IpamPoolCidrProvisioning(conn *ec2.EC2, ipamPoolID, cidr, ipamPoolCidrID string) (*ec2.IpamPoolCidr, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.IpamPoolCidrStatePendingProvision},
+		Target:  []string{ec2.IpamPoolCidrStateProvisioned},
+		Refresh: ec2IpamPoolCidrRefreshFunc(conn, ipamPoolID, cidr, ipamPoolCidrID),
+		Timeout: 30 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Pool (%s) CIDR provisioning", ipamPoolID)
+	outputRaw, err := stateConf.WaitForState()
+
+	if ipamPoolCidr, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
+		return ipamPoolCidr, err
+	}
+
+	return nil, err
+}
+
+func waitForEc2IpamPoolCidrDeprovisioning(conn *ec2.EC2, ipamPoolID, cidr string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.IpamPoolCidrStateProvisioned,
+			ec2.IpamPoolCidrStatePendingDeprovision,
+		},
+		Target:  []string{ec2.IpamPoolCidrStateDeprovisioned, ec2IpamNotFoundState},
+		Refresh: ec2IpamPoolCidrRefreshFunc(conn, ipamPoolID, cidr, ""),
+		Timeout: 30 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 IPAM Pool (%s) CIDR (%s) deprovisioning", ipamPoolID, cidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
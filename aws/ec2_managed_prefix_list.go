@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ec2_managed_prefix_list resource.
+
+func ec2DescribeManagedPrefixList(conn *ec2.EC2, prefixListID string) (*ec2.ManagedPrefixList, error) {
+	input := &ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []*string{aws.String(prefixListID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Managed Prefix List (%s): %s", prefixListID, input)
+	output, err := conn.DescribeManagedPrefixLists(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prefixList := range output.PrefixLists {
+		if prefixList != nil && aws.StringValue(prefixList.PrefixListId) == prefixListID {
+			return prefixList, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2GetManagedPrefixListEntries(conn *ec2.EC2, prefixListID string) ([]*ec2.PrefixListEntry, error) {
+	input := &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(prefixListID),
+	}
+
+	var entries []*ec2.PrefixListEntry
+	err := conn.GetManagedPrefixListEntriesPages(input, func(page *ec2.GetManagedPrefixListEntriesOutput, lastPage bool) bool {
+		entries = append(entries, page.Entries...)
+		return !lastPage
+	})
+
+	return entries, err
+}
+
+func ec2ManagedPrefixListRefreshFunc(conn *ec2.EC2, prefixListID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		prefixList, err := ec2DescribeManagedPrefixList(conn, prefixListID)
+
+		if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+			return false, ec2.PrefixListStateDeleteComplete, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Managed Prefix List (%s): %s", prefixListID, err)
+		}
+
+		if prefixList == nil {
+			return false, ec2.PrefixListStateDeleteComplete, nil
+		}
+
+		switch state := aws.StringValue(prefixList.State); state {
+		case ec2.PrefixListStateCreateFailed, ec2.PrefixListStateModifyFailed, ec2.PrefixListStateDeleteFailed:
+			return prefixList, state, errors.New(aws.StringValue(prefixList.StateMessage))
+		}
+
+		return prefixList, aws.StringValue(prefixList.State), nil
+	}
+}
+
+func waitForEc2ManagedPrefixListCreation(conn *ec2.EC2, prefixListID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.PrefixListStateCreateInProgress},
+		Target:  []string{ec2.PrefixListStateCreateComplete},
+		Refresh: ec2ManagedPrefixListRefreshFunc(conn, prefixListID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Managed Prefix List (%s) creation", prefixListID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ManagedPrefixListModification(conn *ec2.EC2, prefixListID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.PrefixListStateModifyInProgress},
+		Target:  []string{ec2.PrefixListStateModifyComplete},
+		Refresh: ec2ManagedPrefixListRefreshFunc(conn, prefixListID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Managed Prefix List (%s) modification", prefixListID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ManagedPrefixListDeletion(conn *ec2.EC2, prefixListID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.PrefixListStateDeleteInProgress},
+		Target:  []string{ec2.PrefixListStateDeleteComplete},
+		Refresh: ec2ManagedPrefixListRefreshFunc(conn, prefixListID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Managed Prefix List (%s) deletion", prefixListID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
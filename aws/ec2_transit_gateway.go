@@ -0,0 +1,593 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ec2_transit_gateway* family of resources.
+
+func decodeEc2TransitGatewayRouteID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected tgw-rtb-ID_DESTINATION", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func decodeEc2TransitGatewayRouteTableAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected tgw-rtb-ID_tgw-attach-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func decodeEc2TransitGatewayRouteTablePropagationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected tgw-rtb-ID_tgw-attach-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func ec2DescribeTransitGateway(conn *ec2.EC2, transitGatewayID string) (*ec2.TransitGateway, error) {
+	input := &ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: []*string{aws.String(transitGatewayID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway (%s): %s", transitGatewayID, input)
+	output, err := conn.DescribeTransitGateways(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transitGateway := range output.TransitGateways {
+		if transitGateway != nil && aws.StringValue(transitGateway.TransitGatewayId) == transitGatewayID {
+			return transitGateway, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayRouteTable(conn *ec2.EC2, transitGatewayRouteTableID string) (*ec2.TransitGatewayRouteTable, error) {
+	input := &ec2.DescribeTransitGatewayRouteTablesInput{
+		TransitGatewayRouteTableIds: []*string{aws.String(transitGatewayRouteTableID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Route Table (%s): %s", transitGatewayRouteTableID, input)
+	output, err := conn.DescribeTransitGatewayRouteTables(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transitGatewayRouteTable := range output.TransitGatewayRouteTables {
+		if transitGatewayRouteTable != nil && aws.StringValue(transitGatewayRouteTable.TransitGatewayRouteTableId) == transitGatewayRouteTableID {
+			return transitGatewayRouteTable, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayRoute(conn *ec2.EC2, transitGatewayRouteTableID, destination string) (*ec2.TransitGatewayRoute, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		// As of the time of writing, the EC2 API reference documentation (nor any
+		// other source) does not state whether or not an "exact-match" filter
+		// can be combined with other filters, so exact-match is used alone.
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("route-search.exact-match"),
+				Values: []*string{aws.String(destination)},
+			},
+		},
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Searching EC2 Transit Gateway Route Table (%s): %s", transitGatewayRouteTableID, input)
+	output, err := conn.SearchTransitGatewayRoutes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range output.Routes {
+		if route != nil && aws.StringValue(route.DestinationCidrBlock) == destination {
+			return route, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayRouteTableAssociation(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) (*ec2.TransitGatewayRouteTableAssociation, error) {
+	if transitGatewayRouteTableID == "" {
+		return nil, nil
+	}
+
+	input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("transit-gateway-attachment-id"),
+				Values: []*string{aws.String(transitGatewayAttachmentID)},
+			},
+		},
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := conn.GetTransitGatewayRouteTableAssociations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range output.Associations {
+		if association != nil && aws.StringValue(association.TransitGatewayAttachmentId) == transitGatewayAttachmentID {
+			return association, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayRouteTablePropagation(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) (*ec2.TransitGatewayRouteTablePropagation, error) {
+	if transitGatewayRouteTableID == "" {
+		return nil, nil
+	}
+
+	input := &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("transit-gateway-attachment-id"),
+				Values: []*string{aws.String(transitGatewayAttachmentID)},
+			},
+		},
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := conn.GetTransitGatewayRouteTablePropagations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, propagation := range output.TransitGatewayRouteTablePropagations {
+		if propagation != nil && aws.StringValue(propagation.TransitGatewayAttachmentId) == transitGatewayAttachmentID {
+			return propagation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayVpcAttachment(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayVpcAttachment, error) {
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: []*string{aws.String(transitGatewayAttachmentID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway VPC Attachment (%s): %s", transitGatewayAttachmentID, input)
+	output, err := conn.DescribeTransitGatewayVpcAttachments(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range output.TransitGatewayVpcAttachments {
+		if attachment != nil && aws.StringValue(attachment.TransitGatewayAttachmentId) == transitGatewayAttachmentID {
+			return attachment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTransitGatewayPeeringAttachment(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayPeeringAttachment, error) {
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		TransitGatewayAttachmentIds: []*string{aws.String(transitGatewayAttachmentID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Peering Attachment (%s): %s", transitGatewayAttachmentID, input)
+	output, err := conn.DescribeTransitGatewayPeeringAttachments(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range output.TransitGatewayPeeringAttachments {
+		if attachment != nil && aws.StringValue(attachment.TransitGatewayAttachmentId) == transitGatewayAttachmentID {
+			return attachment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2TransitGatewayRefreshFunc(conn *ec2.EC2, transitGatewayID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+
+		if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
+			return false, ec2.TransitGatewayStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+		}
+
+		if transitGateway == nil {
+			return false, ec2.TransitGatewayStateDeleted, nil
+		}
+
+		return transitGateway, aws.StringValue(transitGateway.State), nil
+	}
+}
+
+func ec2TransitGatewayRouteTableRefreshFunc(conn *ec2.EC2, transitGatewayRouteTableID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayRouteTable, err := ec2DescribeTransitGatewayRouteTable(conn, transitGatewayRouteTableID)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			return false, ec2.TransitGatewayRouteTableStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s): %s", transitGatewayRouteTableID, err)
+		}
+
+		if transitGatewayRouteTable == nil {
+			return false, ec2.TransitGatewayRouteTableStateDeleted, nil
+		}
+
+		return transitGatewayRouteTable, aws.StringValue(transitGatewayRouteTable.State), nil
+	}
+}
+
+func ec2TransitGatewayRouteTableAssociationRefreshFunc(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := ec2DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			return false, ec2.TransitGatewayAssociationStateDisassociated, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) Association (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+		}
+
+		if association == nil {
+			return false, ec2.TransitGatewayAssociationStateDisassociated, nil
+		}
+
+		return association, aws.StringValue(association.State), nil
+	}
+}
+
+func ec2TransitGatewayVpcAttachmentRefreshFunc(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := ec2DescribeTransitGatewayVpcAttachment(conn, transitGatewayAttachmentID)
+
+		if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+			return false, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway VPC Attachment (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		if attachment == nil {
+			return false, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		return attachment, aws.StringValue(attachment.State), nil
+	}
+}
+
+func ec2TransitGatewayPeeringAttachmentRefreshFunc(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := ec2DescribeTransitGatewayPeeringAttachment(conn, transitGatewayAttachmentID)
+
+		if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+			return false, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Peering Attachment (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		if attachment == nil {
+			return false, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		if aws.StringValue(attachment.State) == ec2.TransitGatewayAttachmentStateFailed && attachment.Status != nil {
+			return attachment, ec2.TransitGatewayAttachmentStateFailed, errors.New(aws.StringValue(attachment.Status.Message))
+		}
+
+		return attachment, aws.StringValue(attachment.State), nil
+	}
+}
+
+func waitForEc2TransitGatewayCreation(conn *ec2.EC2, transitGatewayID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayStatePending},
+		Target:  []string{ec2.TransitGatewayStateAvailable},
+		Refresh: ec2TransitGatewayRefreshFunc(conn, transitGatewayID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway (%s) availability", transitGatewayID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayModification(conn *ec2.EC2, transitGatewayID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayStateModifying},
+		Target:  []string{ec2.TransitGatewayStateAvailable},
+		Refresh: ec2TransitGatewayRefreshFunc(conn, transitGatewayID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway (%s) modification", transitGatewayID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayDeletion(conn *ec2.EC2, transitGatewayID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayStateAvailable,
+			ec2.TransitGatewayStateDeleting,
+		},
+		Target:  []string{ec2.TransitGatewayStateDeleted},
+		Refresh: ec2TransitGatewayRefreshFunc(conn, transitGatewayID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway (%s) deletion", transitGatewayID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableCreation(conn *ec2.EC2, transitGatewayRouteTableID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableStatePending},
+		Target:  []string{ec2.TransitGatewayRouteTableStateAvailable},
+		Refresh: ec2TransitGatewayRouteTableRefreshFunc(conn, transitGatewayRouteTableID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Route Table (%s) availability", transitGatewayRouteTableID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableDeletion(conn *ec2.EC2, transitGatewayRouteTableID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayRouteTableStateAvailable,
+			ec2.TransitGatewayRouteTableStateDeleting,
+		},
+		Target:  []string{ec2.TransitGatewayRouteTableStateDeleted},
+		Refresh: ec2TransitGatewayRouteTableRefreshFunc(conn, transitGatewayRouteTableID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Route Table (%s) deletion", transitGatewayRouteTableID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableAssociationCreation(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAssociationStateAssociating},
+		Target:  []string{ec2.TransitGatewayAssociationStateAssociated},
+		Refresh: ec2TransitGatewayRouteTableAssociationRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Route Table (%s) association: %s", transitGatewayRouteTableID, transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableAssociationDeletion(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAssociationStateAssociated,
+			ec2.TransitGatewayAssociationStateDisassociating,
+		},
+		Target:  []string{ec2.TransitGatewayAssociationStateDisassociated},
+		Refresh: ec2TransitGatewayRouteTableAssociationRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Route Table (%s) disassociation: %s", transitGatewayRouteTableID, transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayVpcAttachmentCreation(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target: []string{
+			ec2.TransitGatewayAttachmentStatePendingAcceptance,
+			ec2.TransitGatewayAttachmentStateAvailable,
+		},
+		Refresh: ec2TransitGatewayVpcAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway VPC Attachment (%s) availability", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayVpcAttachmentModification(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStateModifying},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: ec2TransitGatewayVpcAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway VPC Attachment (%s) modification", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayVpcAttachmentDeletion(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target:  []string{ec2.TransitGatewayAttachmentStateDeleted},
+		Refresh: ec2TransitGatewayVpcAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway VPC Attachment (%s) deletion", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayPeeringAttachmentCreation(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateInitiatingRequest,
+			ec2.TransitGatewayAttachmentStatePending,
+		},
+		Target: []string{
+			ec2.TransitGatewayAttachmentStatePendingAcceptance,
+			ec2.TransitGatewayAttachmentStateAvailable,
+		},
+		Refresh: ec2TransitGatewayPeeringAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Peering Attachment (%s) availability", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayPeeringAttachmentAcceptance(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStatePending,
+			ec2.TransitGatewayAttachmentStatePendingAcceptance,
+		},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: ec2TransitGatewayPeeringAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Peering Attachment (%s) acceptance", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayPeeringAttachmentDeletion(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStatePendingAcceptance,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target: []string{
+			ec2.TransitGatewayAttachmentStateDeleted,
+			ec2.TransitGatewayAttachmentStateRejected,
+		},
+		Refresh: ec2TransitGatewayPeeringAttachmentRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Peering Attachment (%s) deletion", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func ec2TransitGatewayRouteTableAssociate(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	input := &ec2.AssociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Associating EC2 Transit Gateway Route Table: %s", input)
+	if _, err := conn.AssociateTransitGatewayRouteTable(input); err != nil {
+		return fmt.Errorf("error associating EC2 Transit Gateway Route Table (%s) with Attachment (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	if err := waitForEc2TransitGatewayRouteTableAssociationCreation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) association (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	return nil
+}
+
+func ec2TransitGatewayRouteTableDisassociate(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	input := &ec2.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Disassociating EC2 Transit Gateway Route Table: %s", input)
+	if _, err := conn.DisassociateTransitGatewayRouteTable(input); err != nil {
+		return fmt.Errorf("error disassociating EC2 Transit Gateway Route Table (%s) from Attachment (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	if err := waitForEc2TransitGatewayRouteTableAssociationDeletion(conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) disassociation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	return nil
+}
+
+func ec2TransitGatewayRouteTablePropagationEnable(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	input := &ec2.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Enabling EC2 Transit Gateway Route Table propagation: %s", input)
+	if _, err := conn.EnableTransitGatewayRouteTablePropagation(input); err != nil {
+		return fmt.Errorf("error enabling EC2 Transit Gateway Route Table (%s) propagation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	return nil
+}
+
+func ec2TransitGatewayRouteTablePropagationDisable(conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	input := &ec2.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Disabling EC2 Transit Gateway Route Table propagation: %s", input)
+	if _, err := conn.DisableTransitGatewayRouteTablePropagation(input); err != nil {
+		return fmt.Errorf("error disabling EC2 Transit Gateway Route Table (%s) propagation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	return nil
+}
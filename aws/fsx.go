@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_fsx_*_file_system family of resources.
+
+func describeFsxFileSystem(conn *fsx.FSx, id string) (*fsx.FileSystem, error) {
+	output, err := conn.DescribeFileSystems(&fsx.DescribeFileSystemsInput{
+		FileSystemIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fs := range output.FileSystems {
+		if aws.StringValue(fs.FileSystemId) == id {
+			return fs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func refreshFsxFileSystemLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fs, err := describeFsxFileSystem(conn, id)
+		if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+			return "", "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fs == nil {
+			return "", "NotFound", nil
+		}
+
+		return fs, aws.StringValue(fs.Lifecycle), nil
+	}
+}
+
+// refreshFsxFileSystemAdministrativeActionStatus reports the status of the
+// most recent administrative action of the given type. Updates to capacity or
+// configuration are queued as administrative actions and may not start until
+// earlier actions, or the weekly maintenance window, allow it.
+func refreshFsxFileSystemAdministrativeActionStatus(conn *fsx.FSx, id, actionType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fs, err := describeFsxFileSystem(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fs == nil {
+			return nil, "", fmt.Errorf("FSx file system (%s) not found", id)
+		}
+
+		if aws.StringValue(fs.Lifecycle) == fsx.FileSystemLifecycleFailed {
+			return fs, fsx.StatusFailed, nil
+		}
+
+		var action *fsx.AdministrativeAction
+		for _, a := range fs.AdministrativeActions {
+			if aws.StringValue(a.AdministrativeActionType) != actionType {
+				continue
+			}
+			if action == nil || aws.TimeValue(a.RequestTime).After(aws.TimeValue(action.RequestTime)) {
+				action = a
+			}
+		}
+
+		// No record of the action means it has already been cleaned up
+		// after completing.
+		if action == nil {
+			return fs, fsx.StatusCompleted, nil
+		}
+
+		status := aws.StringValue(action.Status)
+		if status == fsx.StatusFailed && action.FailureDetails != nil {
+			return action, status, errors.New(aws.StringValue(action.FailureDetails.Message))
+		}
+
+		return action, status, nil
+	}
+}
+
+func waitForFsxFileSystemCreation(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{fsx.FileSystemLifecycleCreating},
+		Target:     []string{fsx.FileSystemLifecycleAvailable},
+		Refresh:    refreshFsxFileSystemLifecycle(conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for FSx file system (%s) to become available", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxFileSystemUpdate(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.StatusPending, fsx.StatusInProgress},
+		Target: []string{
+			fsx.StatusCompleted,
+			fsx.StatusUpdatedOptimizing,
+		},
+		Refresh:    refreshFsxFileSystemAdministrativeActionStatus(conn, id, fsx.AdministrativeActionTypeFileSystemUpdate),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for FSx file system (%s) update to complete", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxFileSystemDeletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{fsx.FileSystemLifecycleAvailable, fsx.FileSystemLifecycleDeleting},
+		Target:     []string{"NotFound"},
+		Refresh:    refreshFsxFileSystemLifecycle(conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for FSx file system (%s) to delete", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
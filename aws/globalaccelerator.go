@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_globalaccelerator_* family of resources.
+
+// globalAcceleratorRoute53ZoneID is the Route 53 hosted zone ID shared by all
+// accelerator DNS names, for use in alias records.
+const globalAcceleratorRoute53ZoneID = "Z2BJ6XQ5FK7U4H"
+
+// globalAcceleratorAcceleratorArnFromChildArn returns the ARN of the
+// accelerator that owns a listener or endpoint group, e.g.
+// arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz.
+func globalAcceleratorAcceleratorArnFromChildArn(childArn string) (string, error) {
+	parts := strings.Split(childArn, "/listener/")
+	if len(parts) < 2 || parts[0] == "" {
+		return "", fmt.Errorf("unexpected format of Global Accelerator Listener or Endpoint Group ARN (%s)", childArn)
+	}
+
+	return parts[0], nil
+}
+
+// globalAcceleratorListenerArnFromEndpointGroupArn returns the ARN of the
+// listener that owns an endpoint group.
+func globalAcceleratorListenerArnFromEndpointGroupArn(endpointGroupArn string) (string, error) {
+	parts := strings.Split(endpointGroupArn, "/endpoint-group/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format of Global Accelerator Endpoint Group ARN (%s)", endpointGroupArn)
+	}
+
+	return parts[0], nil
+}
+
+func globalAcceleratorDescribeAccelerator(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.Accelerator, error) {
+	input := &globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAccelerator(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Accelerator, nil
+}
+
+func globalAcceleratorDescribeListener(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.Listener, error) {
+	input := &globalaccelerator.DescribeListenerInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeListener(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Listener, nil
+}
+
+func globalAcceleratorDescribeEndpointGroup(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.EndpointGroup, error) {
+	input := &globalaccelerator.DescribeEndpointGroupInput{
+		EndpointGroupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEndpointGroup(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.EndpointGroup, nil
+}
+
+func globalAcceleratorAcceleratorRefreshFunc(conn *globalaccelerator.GlobalAccelerator, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accelerator, err := globalAcceleratorDescribeAccelerator(conn, arn)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Global Accelerator Accelerator (%s): %s", arn, err)
+		}
+
+		if accelerator == nil {
+			return nil, "", fmt.Errorf("error reading Global Accelerator Accelerator (%s): empty response", arn)
+		}
+
+		return accelerator, aws.StringValue(accelerator.Status), nil
+	}
+}
+
+// waitForGlobalAcceleratorAcceleratorDeployment waits for any pending change
+// to an accelerator, its listeners or its endpoint groups to be deployed.
+func waitForGlobalAcceleratorAcceleratorDeployment(conn *globalaccelerator.GlobalAccelerator, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.AcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.AcceleratorStatusDeployed},
+		Refresh: globalAcceleratorAcceleratorRefreshFunc(conn, arn),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for Global Accelerator Accelerator (%s) deployment", arn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
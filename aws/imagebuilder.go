@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Shared schema and helpers for the aws_imagebuilder_* family of resources.
+
+func imageBuilderComponentConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"component_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validateArn,
+				},
+				"parameter": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func imageBuilderBlockDeviceMappingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"device_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"ebs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_on_termination": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+								Default:  true,
+							},
+							"encrypted": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"iops": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(100, 64000),
+							},
+							"kms_key_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"snapshot_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"throughput": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(125, 1000),
+							},
+							"volume_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(1, 16000),
+							},
+							"volume_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(imagebuilder.EbsVolumeType_Values(), false),
+							},
+						},
+					},
+				},
+				"no_device": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"virtual_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+// imageBuilderTargetContainerRepositorySchema returns the target_repository
+// schema. Container recipes cannot be updated, so forceNew is set there.
+func imageBuilderTargetContainerRepositorySchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"repository_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringInSlice(imagebuilder.ContainerRepositoryService_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandImageBuilderComponentConfigurations(l []interface{}) []*imagebuilder.ComponentConfiguration {
+	configurations := make([]*imagebuilder.ComponentConfiguration, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configuration := &imagebuilder.ComponentConfiguration{
+			ComponentArn: aws.String(m["component_arn"].(string)),
+		}
+
+		if v, ok := m["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			for _, rawParameter := range v.List() {
+				parameter := rawParameter.(map[string]interface{})
+				configuration.Parameters = append(configuration.Parameters, &imagebuilder.ComponentParameter{
+					Name:  aws.String(parameter["name"].(string)),
+					Value: aws.StringSlice([]string{parameter["value"].(string)}),
+				})
+			}
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+func flattenImageBuilderComponentConfigurations(configurations []*imagebuilder.ComponentConfiguration) []interface{} {
+	l := make([]interface{}, 0, len(configurations))
+
+	for _, configuration := range configurations {
+		if configuration == nil {
+			continue
+		}
+
+		parameters := make([]interface{}, 0, len(configuration.Parameters))
+		for _, parameter := range configuration.Parameters {
+			if parameter == nil || len(parameter.Value) == 0 {
+				continue
+			}
+
+			parameters = append(parameters, map[string]interface{}{
+				"name":  aws.StringValue(parameter.Name),
+				"value": aws.StringValue(parameter.Value[0]),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"component_arn": aws.StringValue(configuration.ComponentArn),
+			"parameter":     parameters,
+		})
+	}
+
+	return l
+}
+
+func expandImageBuilderBlockDeviceMappings(l []interface{}) []*imagebuilder.InstanceBlockDeviceMapping {
+	mappings := make([]*imagebuilder.InstanceBlockDeviceMapping, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		mapping := &imagebuilder.InstanceBlockDeviceMapping{}
+
+		if v, ok := m["device_name"].(string); ok && v != "" {
+			mapping.DeviceName = aws.String(v)
+		}
+
+		if v, ok := m["ebs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mapping.Ebs = expandImageBuilderEbsBlockDeviceSpecification(v[0].(map[string]interface{}))
+		}
+
+		// NoDevice suppresses the device mapping when set to an empty string.
+		if v, ok := m["no_device"].(bool); ok && v {
+			mapping.NoDevice = aws.String("")
+		}
+
+		if v, ok := m["virtual_name"].(string); ok && v != "" {
+			mapping.VirtualName = aws.String(v)
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+func expandImageBuilderEbsBlockDeviceSpecification(m map[string]interface{}) *imagebuilder.EbsInstanceBlockDeviceSpecification {
+	ebs := &imagebuilder.EbsInstanceBlockDeviceSpecification{
+		DeleteOnTermination: aws.Bool(m["delete_on_termination"].(bool)),
+	}
+
+	if v, ok := m["encrypted"].(bool); ok && v {
+		ebs.Encrypted = aws.Bool(v)
+	}
+
+	if v, ok := m["iops"].(int); ok && v != 0 {
+		ebs.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		ebs.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := m["snapshot_id"].(string); ok && v != "" {
+		ebs.SnapshotId = aws.String(v)
+	}
+
+	if v, ok := m["throughput"].(int); ok && v != 0 {
+		ebs.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["volume_size"].(int); ok && v != 0 {
+		ebs.VolumeSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["volume_type"].(string); ok && v != "" {
+		ebs.VolumeType = aws.String(v)
+	}
+
+	return ebs
+}
+
+func flattenImageBuilderBlockDeviceMappings(mappings []*imagebuilder.InstanceBlockDeviceMapping) []interface{} {
+	l := make([]interface{}, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		if mapping == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"device_name":  aws.StringValue(mapping.DeviceName),
+			"no_device":    mapping.NoDevice != nil,
+			"virtual_name": aws.StringValue(mapping.VirtualName),
+		}
+
+		if ebs := mapping.Ebs; ebs != nil {
+			m["ebs"] = []interface{}{
+				map[string]interface{}{
+					"delete_on_termination": aws.BoolValue(ebs.DeleteOnTermination),
+					"encrypted":             aws.BoolValue(ebs.Encrypted),
+					"iops":                  int(aws.Int64Value(ebs.Iops)),
+					"kms_key_id":            aws.StringValue(ebs.KmsKeyId),
+					"snapshot_id":           aws.StringValue(ebs.SnapshotId),
+					"throughput":            int(aws.Int64Value(ebs.Throughput)),
+					"volume_size":           int(aws.Int64Value(ebs.VolumeSize)),
+					"volume_type":           aws.StringValue(ebs.VolumeType),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func expandImageBuilderTargetContainerRepository(l []interface{}) *imagebuilder.TargetContainerRepository {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &imagebuilder.TargetContainerRepository{
+		RepositoryName: aws.String(m["repository_name"].(string)),
+		Service:        aws.String(m["service"].(string)),
+	}
+}
+
+func flattenImageBuilderTargetContainerRepository(repository *imagebuilder.TargetContainerRepository) []interface{} {
+	if repository == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"repository_name": aws.StringValue(repository.RepositoryName),
+		"service":         aws.StringValue(repository.Service),
+	}
+
+	return []interface{}{m}
+}
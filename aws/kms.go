@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_kms_* family of resources.
+
+func kmsKeyStateRefreshFunc(conn *kms.KMS, keyID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(keyID),
+		})
+
+		// KMS is eventually consistent, so new keys may not be visible yet.
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.KeyMetadata, aws.StringValue(output.KeyMetadata.KeyState), nil
+	}
+}
+
+func waitForKmsKeyState(conn *kms.KMS, keyID string, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: kmsKeyStateRefreshFunc(conn, keyID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for KMS key (%s) to reach state %v", keyID, target)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKmsKeyDeletion(conn *kms.KMS, keyID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.KeyStateEnabled, kms.KeyStateDisabled, kms.KeyStatePendingImport},
+		Target:  []string{kms.KeyStatePendingDeletion, kms.KeyStatePendingReplicaDeletion},
+		Refresh: kmsKeyStateRefreshFunc(conn, keyID),
+		Timeout: 20 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for KMS key (%s) deletion", keyID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_networkfirewall_* family of resources.
+
+// networkFirewallNotFoundStatus is reported by the refresh functions once the
+// requested object can no longer be found.
+const networkFirewallNotFoundStatus = "NOT_FOUND"
+
+func networkFirewallDescribeFirewall(conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeFirewallOutput, error) {
+	input := &networkfirewall.DescribeFirewallInput{
+		FirewallArn: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Reading NetworkFirewall Firewall (%s): %s", arn, input)
+	return conn.DescribeFirewall(input)
+}
+
+func networkFirewallDescribeFirewallPolicy(conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeFirewallPolicyOutput, error) {
+	input := &networkfirewall.DescribeFirewallPolicyInput{
+		FirewallPolicyArn: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Reading NetworkFirewall Firewall Policy (%s): %s", arn, input)
+	return conn.DescribeFirewallPolicy(input)
+}
+
+func networkFirewallDescribeRuleGroup(conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeRuleGroupOutput, error) {
+	input := &networkfirewall.DescribeRuleGroupInput{
+		RuleGroupArn: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Reading NetworkFirewall Rule Group (%s): %s", arn, input)
+	return conn.DescribeRuleGroup(input)
+}
+
+func networkFirewallDescribeLoggingConfiguration(conn *networkfirewall.NetworkFirewall, firewallArn string) (*networkfirewall.DescribeLoggingConfigurationOutput, error) {
+	input := &networkfirewall.DescribeLoggingConfigurationInput{
+		FirewallArn: aws.String(firewallArn),
+	}
+
+	log.Printf("[DEBUG] Reading NetworkFirewall Logging Configuration (%s): %s", firewallArn, input)
+	return conn.DescribeLoggingConfiguration(input)
+}
+
+func networkFirewallFirewallRefreshFunc(conn *networkfirewall.NetworkFirewall, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := networkFirewallDescribeFirewall(conn, arn)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading NetworkFirewall Firewall (%s): %s", arn, err)
+		}
+
+		if output == nil || output.FirewallStatus == nil {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		return output, aws.StringValue(output.FirewallStatus.Status), nil
+	}
+}
+
+func networkFirewallFirewallPolicyRefreshFunc(conn *networkfirewall.NetworkFirewall, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := networkFirewallDescribeFirewallPolicy(conn, arn)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading NetworkFirewall Firewall Policy (%s): %s", arn, err)
+		}
+
+		if output == nil || output.FirewallPolicyResponse == nil {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		return output, aws.StringValue(output.FirewallPolicyResponse.FirewallPolicyStatus), nil
+	}
+}
+
+func networkFirewallRuleGroupRefreshFunc(conn *networkfirewall.NetworkFirewall, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := networkFirewallDescribeRuleGroup(conn, arn)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading NetworkFirewall Rule Group (%s): %s", arn, err)
+		}
+
+		if output == nil || output.RuleGroupResponse == nil {
+			return false, networkFirewallNotFoundStatus, nil
+		}
+
+		return output, aws.StringValue(output.RuleGroupResponse.RuleGroupStatus), nil
+	}
+}
+
+func waitForNetworkFirewallFirewallReady(conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkfirewall.FirewallStatusValueProvisioning},
+		Target:  []string{networkfirewall.FirewallStatusValueReady},
+		Refresh: networkFirewallFirewallRefreshFunc(conn, arn),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for NetworkFirewall Firewall (%s) to be ready", arn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForNetworkFirewallFirewallDeletion(conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkfirewall.FirewallStatusValueDeleting},
+		Target:  []string{networkFirewallNotFoundStatus},
+		Refresh: networkFirewallFirewallRefreshFunc(conn, arn),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for NetworkFirewall Firewall (%s) deletion", arn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForNetworkFirewallFirewallPolicyDeletion(conn *networkfirewall.NetworkFirewall, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkfirewall.ResourceStatusDeleting},
+		Target:  []string{networkFirewallNotFoundStatus},
+		Refresh: networkFirewallFirewallPolicyRefreshFunc(conn, arn),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for NetworkFirewall Firewall Policy (%s) deletion", arn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForNetworkFirewallRuleGroupDeletion(conn *networkfirewall.NetworkFirewall, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkfirewall.ResourceStatusDeleting},
+		Target:  []string{networkFirewallNotFoundStatus},
+		Refresh: networkFirewallRuleGroupRefreshFunc(conn, arn),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for NetworkFirewall Rule Group (%s) deletion", arn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// networkFirewallCustomActionSchema returns the schema shared by the
+// stateless custom actions of firewall policies and rule groups.
+func networkFirewallCustomActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action_definition": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"publish_metric_action": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimension": {
+											Type:     schema.TypeSet,
+											Required: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"action_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkFirewallCustomActions(l []interface{}) []*networkfirewall.CustomAction {
+	customActions := make([]*networkfirewall.CustomAction, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		customAction := &networkfirewall.CustomAction{
+			ActionDefinition: &networkfirewall.ActionDefinition{},
+			ActionName:       aws.String(tfMap["action_name"].(string)),
+		}
+
+		if v, ok := tfMap["action_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			actionDefinition := v[0].(map[string]interface{})
+
+			if v, ok := actionDefinition["publish_metric_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				publishMetricAction := v[0].(map[string]interface{})
+				dimensions := publishMetricAction["dimension"].(*schema.Set).List()
+
+				customAction.ActionDefinition.PublishMetricAction = &networkfirewall.PublishMetricAction{
+					Dimensions: make([]*networkfirewall.Dimension, 0, len(dimensions)),
+				}
+
+				for _, dimensionRaw := range dimensions {
+					dimension := dimensionRaw.(map[string]interface{})
+					customAction.ActionDefinition.PublishMetricAction.Dimensions = append(customAction.ActionDefinition.PublishMetricAction.Dimensions, &networkfirewall.Dimension{
+						Value: aws.String(dimension["value"].(string)),
+					})
+				}
+			}
+		}
+
+		customActions = append(customActions, customAction)
+	}
+
+	return customActions
+}
+
+func flattenNetworkFirewallCustomActions(customActions []*networkfirewall.CustomAction) []interface{} {
+	l := make([]interface{}, 0, len(customActions))
+
+	for _, customAction := range customActions {
+		if customAction == nil {
+			continue
+		}
+
+		var actionDefinition []interface{}
+		if customAction.ActionDefinition != nil && customAction.ActionDefinition.PublishMetricAction != nil {
+			dimensions := make([]interface{}, 0, len(customAction.ActionDefinition.PublishMetricAction.Dimensions))
+			for _, dimension := range customAction.ActionDefinition.PublishMetricAction.Dimensions {
+				dimensions = append(dimensions, map[string]interface{}{
+					"value": aws.StringValue(dimension.Value),
+				})
+			}
+
+			actionDefinition = []interface{}{
+				map[string]interface{}{
+					"publish_metric_action": []interface{}{
+						map[string]interface{}{
+							"dimension": dimensions,
+						},
+					},
+				},
+			}
+		}
+
+		l = append(l, map[string]interface{}{
+			"action_definition": actionDefinition,
+			"action_name":       aws.StringValue(customAction.ActionName),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_organizations_* family of resources.
+
+const (
+	// organizationsPolicyTypeStatusDisabled is reported by the root policy
+	// type refresh function when the policy type is not listed on the root.
+	organizationsPolicyTypeStatusDisabled = "DISABLED"
+
+	// organizationsNotFoundState is reported by the refresh functions once the
+	// requested object can no longer be found.
+	organizationsNotFoundState = "not-found"
+)
+
+func decodeOrganizationsPolicyAttachmentID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <target-id>:<policy-id>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func decodeOrganizationsDelegatedAdministratorID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <account-id>/<service-principal>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func organizationsListRoots(conn *organizations.Organizations) ([]*organizations.Root, error) {
+	var roots []*organizations.Root
+
+	err := conn.ListRootsPages(&organizations.ListRootsInput{}, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		roots = append(roots, page.Roots...)
+		return !lastPage
+	})
+
+	return roots, err
+}
+
+func organizationsDescribeRoot(conn *organizations.Organizations, rootID string) (*organizations.Root, error) {
+	roots, err := organizationsListRoots(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, root := range roots {
+		if root != nil && aws.StringValue(root.Id) == rootID {
+			return root, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func organizationsListAccounts(conn *organizations.Organizations) ([]*organizations.Account, error) {
+	var accounts []*organizations.Account
+
+	err := conn.ListAccountsPages(&organizations.ListAccountsInput{}, func(page *organizations.ListAccountsOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+		return !lastPage
+	})
+
+	return accounts, err
+}
+
+func organizationsListAccountsForParent(conn *organizations.Organizations, parentID string) ([]*organizations.Account, error) {
+	input := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	var accounts []*organizations.Account
+
+	err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+		return !lastPage
+	})
+
+	return accounts, err
+}
+
+// organizationsParentID returns the identifier of the root or organizational
+// unit that directly contains the given account or organizational unit.
+func organizationsParentID(conn *organizations.Organizations, childID string) (string, error) {
+	input := &organizations.ListParentsInput{
+		ChildId: aws.String(childID),
+	}
+
+	var parents []*organizations.Parent
+
+	err := conn.ListParentsPages(input, func(page *organizations.ListParentsOutput, lastPage bool) bool {
+		parents = append(parents, page.Parents...)
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(parents) == 0 || parents[0] == nil {
+		return "", fmt.Errorf("no parents found for %s", childID)
+	}
+
+	return aws.StringValue(parents[0].Id), nil
+}
+
+func flattenOrganizationsAccounts(accounts []*organizations.Account) []interface{} {
+	l := make([]interface{}, 0, len(accounts))
+
+	for _, account := range accounts {
+		if account == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"arn":    aws.StringValue(account.Arn),
+			"email":  aws.StringValue(account.Email),
+			"id":     aws.StringValue(account.Id),
+			"name":   aws.StringValue(account.Name),
+			"status": aws.StringValue(account.Status),
+		})
+	}
+
+	return l
+}
+
+func organizationsRootPolicyTypeRefreshFunc(conn *organizations.Organizations, rootID, policyType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		root, err := organizationsDescribeRoot(conn, rootID)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Organizations Root (%s): %s", rootID, err)
+		}
+
+		if root == nil {
+			return nil, "", fmt.Errorf("Organizations Root (%s) not found", rootID)
+		}
+
+		for _, policyTypeSummary := range root.PolicyTypes {
+			if policyTypeSummary != nil && aws.StringValue(policyTypeSummary.Type) == policyType {
+				return root, aws.StringValue(policyTypeSummary.Status), nil
+			}
+		}
+
+		return root, organizationsPolicyTypeStatusDisabled, nil
+	}
+}
+
+func organizationsCreateAccountStatusRefreshFunc(conn *organizations.Organizations, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &organizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: aws.String(requestID),
+		}
+
+		output, err := conn.DescribeCreateAccountStatus(input)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Organizations Account creation status (%s): %s", requestID, err)
+		}
+
+		if output == nil || output.CreateAccountStatus == nil {
+			return nil, "", fmt.Errorf("error reading Organizations Account creation status (%s): empty response", requestID)
+		}
+
+		status := output.CreateAccountStatus
+
+		if state := aws.StringValue(status.State); state == organizations.CreateAccountStateFailed {
+			return status, state, fmt.Errorf("Organizations Account creation (%s) failed: %s", requestID, aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.State), nil
+	}
+}
+
+func organizationsAccountStatusRefreshFunc(conn *organizations.Organizations, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &organizations.DescribeAccountInput{
+			AccountId: aws.String(accountID),
+		}
+
+		output, err := conn.DescribeAccount(input)
+
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			return false, organizationsNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Organizations Account (%s): %s", accountID, err)
+		}
+
+		if output == nil || output.Account == nil {
+			return false, organizationsNotFoundState, nil
+		}
+
+		return output.Account, aws.StringValue(output.Account.Status), nil
+	}
+}
+
+func waitForOrganizationsPolicyTypeEnabled(conn *organizations.Organizations, rootID, policyType string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationsPolicyTypeStatusDisabled, organizations.PolicyTypeStatusPendingEnable},
+		Target:  []string{organizations.PolicyTypeStatusEnabled},
+		Refresh: organizationsRootPolicyTypeRefreshFunc(conn, rootID, policyType),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Organizations Root (%s) policy type (%s) to be enabled", rootID, policyType)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForOrganizationsPolicyTypeDisabled(conn *organizations.Organizations, rootID, policyType string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizations.PolicyTypeStatusEnabled, organizations.PolicyTypeStatusPendingDisable},
+		Target:  []string{organizationsPolicyTypeStatusDisabled},
+		Refresh: organizationsRootPolicyTypeRefreshFunc(conn, rootID, policyType),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Organizations Root (%s) policy type (%s) to be disabled", rootID, policyType)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForOrganizationsAccountCreation(conn *organizations.Organizations, requestID string) (*organizations.CreateAccountStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{organizations.CreateAccountStateInProgress},
+		Target:       []string{organizations.CreateAccountStateSucceeded},
+		Refresh:      organizationsCreateAccountStatusRefreshFunc(conn, requestID),
+		Timeout:      10 * time.Minute,
+		PollInterval: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Organizations Account creation (%s)", requestID)
+	outputRaw, err := stateConf.WaitForState()
+
+	if status, ok := outputRaw.(*organizations.CreateAccountStatus); ok {
+		return status, err
+	}
+
+	return nil, err
+}
+
+func waitForOrganizationsAccountClosure(conn *organizations.Organizations, accountID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizations.AccountStatusActive, organizations.AccountStatusPendingClosure},
+		Target:  []string{organizations.AccountStatusSuspended, organizationsNotFoundState},
+		Refresh: organizationsAccountStatusRefreshFunc(conn, accountID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Organizations Account (%s) closure", accountID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
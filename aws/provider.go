@@ -171,12 +171,14 @@ func Provider() terraform.ResourceProvider {
 			"aws_canonical_user_id":                dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_stack":             dataSourceAwsCloudFormationStack(),
 			"aws_cloudtrail_service_account":       dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cloudwatch_log_groups":            dataSourceAwsCloudwatchLogGroups(),
 			"aws_db_instance":                      dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
 			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
 			"aws_ebs_snapshot":                     dataSourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_ids":                 dataSourceAwsEbsSnapshotIds(),
 			"aws_ebs_volume":                       dataSourceAwsEbsVolume(),
+			"aws_ec2_instance_types":               dataSourceAwsEc2InstanceTypes(),
 			"aws_ecr_repository":                   dataSourceAwsEcrRepository(),
 			"aws_ecs_cluster":                      dataSourceAwsEcsCluster(),
 			"aws_ecs_container_definition":         dataSourceAwsEcsContainerDefinition(),
@@ -201,6 +203,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_user":                         dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                 dataSourceAwsInternetGateway(),
 			"aws_inspector_rules_packages":         dataSourceAwsInspectorRulesPackages(),
+			"aws_identitystore_group":              dataSourceAwsIdentityStoreGroup(),
+			"aws_identitystore_user":               dataSourceAwsIdentityStoreUser(),
 			"aws_instance":                         dataSourceAwsInstance(),
 			"aws_instances":                        dataSourceAwsInstances(),
 			"aws_ip_ranges":                        dataSourceAwsIPRanges(),
@@ -222,6 +226,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_object":                 dataSourceAwsS3BucketObject(),
 			"aws_sns_topic":                        dataSourceAwsSnsTopic(),
 			"aws_ssm_parameter":                    dataSourceAwsSsmParameter(),
+			"aws_ssoadmin_instances":               dataSourceAwsSsoAdminInstances(),
+			"aws_storagegateway_local_disk":        dataSourceAwsStorageGatewayLocalDisk(),
 			"aws_subnet":                           dataSourceAwsSubnet(),
 			"aws_subnet_ids":                       dataSourceAwsSubnetIDs(),
 			"aws_security_group":                   dataSourceAwsSecurityGroup(),
@@ -241,339 +247,545 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
-			"aws_ami":                                      resourceAwsAmi(),
-			"aws_ami_copy":                                 resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                    resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                      resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                      resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                   resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":            resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":           resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                   resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_documentation_part":           resourceAwsApiGatewayDocumentationPart(),
-			"aws_api_gateway_documentation_version":        resourceAwsApiGatewayDocumentationVersion(),
-			"aws_api_gateway_domain_name":                  resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":             resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                  resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":         resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                       resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":              resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":              resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                        resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":            resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                     resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                     resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                     resourceAwsApiGatewayVpcLink(),
-			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
-			"aws_appautoscaling_scheduled_action":          resourceAwsAppautoscalingScheduledAction(),
-			"aws_appsync_datasource":                       resourceAwsAppsyncDatasource(),
-			"aws_appsync_graphql_api":                      resourceAwsAppsyncGraphqlApi(),
-			"aws_athena_database":                          resourceAwsAthenaDatabase(),
-			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
-			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
-			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
-			"aws_cloud9_environment_ec2":                   resourceAwsCloud9EnvironmentEc2(),
-			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudtrail":                               resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_permission":              resourceAwsCloudWatchEventPermission(),
-			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":        resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                     resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":             resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":           resourceAwsCloudWatchLogResourcePolicy(),
-			"aws_cloudwatch_log_stream":                    resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":       resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
-			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
-			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
-			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
-			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
-			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
-			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
-			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":             resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
-			"aws_codepipeline":                             resourceAwsCodePipeline(),
-			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
-			"aws_dax_cluster":                              resourceAwsDaxCluster(),
-			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
-			"aws_db_instance":                              resourceAwsDbInstance(),
-			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                              resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                       resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
-			"aws_dms_certificate":                          resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
-			"aws_dx_lag":                                   resourceAwsDxLag(),
-			"aws_dx_connection":                            resourceAwsDxConnection(),
-			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
-			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
-			"aws_dynamodb_table_item":                      resourceAwsDynamoDbTableItem(),
-			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
-			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
-			"aws_ebs_volume":                               resourceAwsEbsVolume(),
-			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
-			"aws_ecr_repository":                           resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
-			"aws_ecs_service":                              resourceAwsEcsService(),
-			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                          resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                         resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                      resourceAwsEip(),
-			"aws_eip_association":                          resourceAwsEipAssociation(),
-			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":               resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                 resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":            resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":    resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template": resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":            resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                     resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":              resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":               resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                 resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                      resourceAwsElb(),
-			"aws_elb_attachment":                           resourceAwsElbAttachment(),
-			"aws_emr_cluster":                              resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                 resourceAwsFlowLog(),
-			"aws_gamelift_alias":                           resourceAwsGameliftAlias(),
-			"aws_gamelift_build":                           resourceAwsGameliftBuild(),
-			"aws_gamelift_fleet":                           resourceAwsGameliftFleet(),
-			"aws_glacier_vault":                            resourceAwsGlacierVault(),
-			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
-			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
-			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
-			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
-			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
-			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                resourceAwsIamGroup(),
-			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                               resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                          resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                 resourceAwsIamRole(),
-			"aws_iam_saml_provider":                        resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                   resourceAwsIAMServerCertificate(),
-			"aws_iam_user_policy_attachment":               resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                          resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                         resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                 resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                   resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":              resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":            resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                 resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                 resourceAwsInstance(),
-			"aws_internet_gateway":                         resourceAwsInternetGateway(),
-			"aws_iot_certificate":                          resourceAwsIotCertificate(),
-			"aws_iot_policy":                               resourceAwsIotPolicy(),
-			"aws_iot_thing":                                resourceAwsIotThing(),
-			"aws_iot_thing_type":                           resourceAwsIotThingType(),
-			"aws_iot_topic_rule":                           resourceAwsIotTopicRule(),
-			"aws_key_pair":                                 resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
-			"aws_kms_alias":                                resourceAwsKmsAlias(),
-			"aws_kms_grant":                                resourceAwsKmsGrant(),
-			"aws_kms_key":                                  resourceAwsKmsKey(),
-			"aws_lambda_function":                          resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
-			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                resourceAwsLBSSLNegotiationPolicy(),
-			"aws_main_route_table_association":             resourceAwsMainRouteTableAssociation(),
-			"aws_mq_broker":                                resourceAwsMqBroker(),
-			"aws_mq_configuration":                         resourceAwsMqConfiguration(),
-			"aws_media_store_container":                    resourceAwsMediaStoreContainer(),
-			"aws_nat_gateway":                              resourceAwsNatGateway(),
-			"aws_network_acl":                              resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                      resourceAwsDefaultNetworkAcl(),
-			"aws_network_acl_rule":                         resourceAwsNetworkAclRule(),
-			"aws_network_interface":                        resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":             resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                     resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                           resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                  resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                   resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                   resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                 resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                 resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                     resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                   resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                    resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                 resourceAwsOpsworksRdsDbInstance(),
-			"aws_organizations_organization":               resourceAwsOrganizationsOrganization(),
-			"aws_placement_group":                          resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                              resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                     resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":              resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                         resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                  resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                 resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
-			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
-			"aws_route53_query_log":                        resourceAwsRoute53QueryLog(),
-			"aws_route53_record":                           resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                             resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
-			"aws_route":                                    resourceAwsRoute(),
-			"aws_route_table":                              resourceAwsRouteTable(),
-			"aws_default_route_table":                      resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
-			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
-			"aws_ses_domain_dkim":                          resourceAwsSesDomainDkim(),
-			"aws_ses_domain_mail_from":                     resourceAwsSesDomainMailFrom(),
-			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                     resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                    resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                    resourceAwsSesEventDestination(),
-			"aws_ses_template":                             resourceAwsSesTemplate(),
-			"aws_s3_bucket":                                resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
-			"aws_s3_bucket_metric":                         resourceAwsS3BucketMetric(),
-			"aws_security_group":                           resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
-			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
-			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
-			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
-			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
-			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                           resourceAwsSsmActivation(),
-			"aws_ssm_association":                          resourceAwsSsmAssociation(),
-			"aws_ssm_document":                             resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                   resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":            resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":              resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                       resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                          resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                            resourceAwsSsmParameter(),
-			"aws_ssm_resource_data_sync":                   resourceAwsSsmResourceDataSync(),
-			"aws_spot_datafeed_subscription":               resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                    resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                       resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                 resourceAwsSnsPlatformApplication(),
-			"aws_sns_topic":                                resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                             resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                           resourceAwsDefaultSubnet(),
-			"aws_subnet":                                   resourceAwsSubnet(),
-			"aws_volume_attachment":                        resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                 resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_default_vpc":                              resourceAwsDefaultVpc(),
-			"aws_vpc":                                      resourceAwsVpc(),
-			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_connection_notification":     resourceAwsVpcEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":          resourceAwsVpcEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
-			"aws_vpn_connection":                           resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                resourceAwsWafIPSet(),
-			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
-			"aws_waf_regex_match_set":                      resourceAwsWafRegexMatchSet(),
-			"aws_waf_regex_pattern_set":                    resourceAwsWafRegexPatternSet(),
-			"aws_waf_rule":                                 resourceAwsWafRule(),
-			"aws_waf_rule_group":                           resourceAwsWafRuleGroup(),
-			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
-			"aws_waf_geo_match_set":                        resourceAwsWafGeoMatchSet(),
-			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_geo_match_set":                resourceAwsWafRegionalGeoMatchSet(),
-			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
-			"aws_wafregional_rate_based_rule":              resourceAwsWafRegionalRateBasedRule(),
-			"aws_wafregional_regex_match_set":              resourceAwsWafRegionalRegexMatchSet(),
-			"aws_wafregional_regex_pattern_set":            resourceAwsWafRegionalRegexPatternSet(),
-			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
-			"aws_wafregional_rule_group":                   resourceAwsWafRegionalRuleGroup(),
-			"aws_wafregional_size_constraint_set":          resourceAwsWafRegionalSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":      resourceAwsWafRegionalSqlInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                resourceAwsWafRegionalXssMatchSet(),
-			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
-			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
-			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
-			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
+			"aws_accessanalyzer_analyzer":                             resourceAwsAccessAnalyzerAnalyzer(),
+			"aws_accessanalyzer_archive_rule":                         resourceAwsAccessAnalyzerArchiveRule(),
+			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
+			"aws_ami":                                                 resourceAwsAmi(),
+			"aws_ami_copy":                                            resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
+			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_amplify_app":                                         resourceAwsAmplifyApp(),
+			"aws_amplify_branch":                                      resourceAwsAmplifyBranch(),
+			"aws_amplify_domain_association":                          resourceAwsAmplifyDomainAssociation(),
+			"aws_amplify_webhook":                                     resourceAwsAmplifyWebhook(),
+			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                       resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                      resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                              resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_documentation_part":                      resourceAwsApiGatewayDocumentationPart(),
+			"aws_api_gateway_documentation_version":                   resourceAwsApiGatewayDocumentationVersion(),
+			"aws_api_gateway_domain_name":                             resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                        resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                             resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":                    resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                                  resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                         resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                         resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                                   resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                       resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                                resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                                resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                                   resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
+			"aws_appautoscaling_scheduled_action":                     resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
+			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
+			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
+			"aws_appsync_graphql_api":                                 resourceAwsAppsyncGraphqlApi(),
+			"aws_appsync_resolver":                                    resourceAwsAppsyncResolver(),
+			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
+			"aws_athena_prepared_statement":                           resourceAwsAthenaPreparedStatement(),
+			"aws_athena_workgroup":                                    resourceAwsAthenaWorkgroup(),
+			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_lifecycle_hook":                          resourceAwsAutoscalingLifecycleHook(),
+			"aws_autoscaling_notification":                            resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                                  resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                                resourceAwsAutoscalingSchedule(),
+			"aws_backup_plan":                                         resourceAwsBackupPlan(),
+			"aws_backup_selection":                                    resourceAwsBackupSelection(),
+			"aws_backup_vault":                                        resourceAwsBackupVault(),
+			"aws_backup_vault_lock_configuration":                     resourceAwsBackupVaultLockConfiguration(),
+			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloud9_environment_membership":                       resourceAwsCloud9EnvironmentMembership(),
+			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
+			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
+			"aws_cloudformation_stack_set_instance":                   resourceAwsCloudFormationStackSetInstance(),
+			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_api_destination":                    resourceAwsCloudWatchEventApiDestination(),
+			"aws_cloudwatch_event_archive":                            resourceAwsCloudWatchEventArchive(),
+			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
+			"aws_cloudwatch_event_connection":                         resourceAwsCloudWatchEventConnection(),
+			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
+			"aws_cloudwatch_event_replay":                             resourceAwsCloudWatchEventReplay(),
+			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                             resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                        resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":                      resourceAwsCloudWatchLogResourcePolicy(),
+			"aws_cloudwatch_log_stream":                               resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":                  resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_config_rule":                                  resourceAwsConfigConfigRule(),
+			"aws_config_conformance_pack":                             resourceAwsConfigConformancePack(),
+			"aws_config_configuration_recorder":                       resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":                resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                             resourceAwsConfigDeliveryChannel(),
+			"aws_config_organization_custom_rule":                     resourceAwsConfigOrganizationCustomRule(),
+			"aws_config_organization_managed_rule":                    resourceAwsConfigOrganizationManagedRule(),
+			"aws_config_remediation_configuration":                    resourceAwsConfigRemediationConfiguration(),
+			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
+			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
+			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_composite_alarm":                          resourceAwsCloudWatchCompositeAlarm(),
+			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                        resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                         resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                               resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                                  resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                                   resourceAwsCodeBuildProject(),
+			"aws_codebuild_webhook":                                   resourceAwsCodeBuildWebhook(),
+			"aws_codepipeline":                                        resourceAwsCodePipeline(),
+			"aws_customer_gateway":                                    resourceAwsCustomerGateway(),
+			"aws_datasync_location_efs":                               resourceAwsDataSyncLocationEfs(),
+			"aws_datasync_location_fsx_windows_file_system":           resourceAwsDataSyncLocationFsxWindowsFileSystem(),
+			"aws_datasync_location_nfs":                               resourceAwsDataSyncLocationNfs(),
+			"aws_datasync_location_s3":                                resourceAwsDataSyncLocationS3(),
+			"aws_datasync_location_smb":                               resourceAwsDataSyncLocationSmb(),
+			"aws_datasync_task":                                       resourceAwsDataSyncTask(),
+			"aws_datasync_task_execution":                             resourceAwsDataSyncTaskExecution(),
+			"aws_dax_cluster":                                         resourceAwsDaxCluster(),
+			"aws_db_event_subscription":                               resourceAwsDbEventSubscription(),
+			"aws_db_instance":                                         resourceAwsDbInstance(),
+			"aws_db_option_group":                                     resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                                  resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                                   resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
+			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
+			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
+			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
+			"aws_directory_service_log_subscription":                  resourceAwsDirectoryServiceLogSubscription(),
+			"aws_directory_service_shared_directory":                  resourceAwsDirectoryServiceSharedDirectory(),
+			"aws_directory_service_shared_directory_accepter":         resourceAwsDirectoryServiceSharedDirectoryAccepter(),
+			"aws_directory_service_trust":                             resourceAwsDirectoryServiceTrust(),
+			"aws_dlm_lifecycle_policy":                                resourceAwsDlmLifecyclePolicy(),
+			"aws_docdb_cluster":                                       resourceAwsDocDBCluster(),
+			"aws_docdb_cluster_instance":                              resourceAwsDocDBClusterInstance(),
+			"aws_docdb_global_cluster":                                resourceAwsDocDBGlobalCluster(),
+			"aws_dms_certificate":                                     resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                        resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                            resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                        resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                                resourceAwsDmsReplicationTask(),
+			"aws_dx_gateway":                                          resourceAwsDxGateway(),
+			"aws_dx_gateway_association":                              resourceAwsDxGatewayAssociation(),
+			"aws_dx_gateway_association_proposal":                     resourceAwsDxGatewayAssociationProposal(),
+			"aws_dx_lag":                                              resourceAwsDxLag(),
+			"aws_dx_connection":                                       resourceAwsDxConnection(),
+			"aws_dx_connection_association":                           resourceAwsDxConnectionAssociation(),
+			"aws_dx_private_virtual_interface":                        resourceAwsDxPrivateVirtualInterface(),
+			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
+			"aws_dx_transit_virtual_interface":                        resourceAwsDxTransitVirtualInterface(),
+			"aws_dynamodb_table":                                      resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_item":                                 resourceAwsDynamoDbTableItem(),
+			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
+			"aws_ebs_default_kms_key":                                 resourceAwsEbsDefaultKmsKey(),
+			"aws_ebs_encryption_by_default":                           resourceAwsEbsEncryptionByDefault(),
+			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
+			"aws_ebs_snapshot_import":                                 resourceAwsEbsSnapshotImport(),
+			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
+			"aws_ecr_lifecycle_policy":                                resourceAwsEcrLifecyclePolicy(),
+			"aws_ec2_capacity_reservation":                            resourceAwsEc2CapacityReservation(),
+			"aws_ec2_capacity_reservation_group":                      resourceAwsEc2CapacityReservationGroup(),
+			"aws_ec2_host":                                            resourceAwsEc2Host(),
+			"aws_ec2_image_block_public_access":                       resourceAwsEc2ImageBlockPublicAccess(),
+			"aws_ec2_instance_metadata_defaults":                      resourceAwsEc2InstanceMetadataDefaults(),
+			"aws_ec2_managed_prefix_list":                             resourceAwsEc2ManagedPrefixList(),
+			"aws_ec2_serial_console_access":                           resourceAwsEc2SerialConsoleAccess(),
+			"aws_ec2_snapshot_block_public_access":                    resourceAwsEc2SnapshotBlockPublicAccess(),
+			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_peering_attachment":              resourceAwsEc2TransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":     resourceAwsEc2TransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                     resourceAwsEc2TransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":         resourceAwsEc2TransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":         resourceAwsEc2TransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":                  resourceAwsEc2TransitGatewayVpcAttachment(),
+			"aws_ecr_registry_scanning_configuration":                 resourceAwsEcrRegistryScanningConfiguration(),
+			"aws_ecr_replication_configuration":                       resourceAwsEcrReplicationConfiguration(),
+			"aws_ecr_repository":                                      resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                               resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
+			"aws_ecs_service":                                         resourceAwsEcsService(),
+			"aws_ecs_task_definition":                                 resourceAwsEcsTaskDefinition(),
+			"aws_efs_access_point":                                    resourceAwsEfsAccessPoint(),
+			"aws_efs_file_system":                                     resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                                    resourceAwsEfsMountTarget(),
+			"aws_efs_replication_configuration":                       resourceAwsEfsReplicationConfiguration(),
+			"aws_egress_only_internet_gateway":                        resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                                 resourceAwsEip(),
+			"aws_eip_association":                                     resourceAwsEipAssociation(),
+			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                       resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                                resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                         resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                          resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                            resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                                 resourceAwsElb(),
+			"aws_elb_attachment":                                      resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                         resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
+			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
+			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
+			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
+			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
+			"aws_glacier_vault":                                       resourceAwsGlacierVault(),
+			"aws_globalaccelerator_accelerator":                       resourceAwsGlobalAcceleratorAccelerator(),
+			"aws_globalaccelerator_endpoint_group":                    resourceAwsGlobalAcceleratorEndpointGroup(),
+			"aws_globalaccelerator_listener":                          resourceAwsGlobalAcceleratorListener(),
+			"aws_glue_catalog_database":                               resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                                  resourceAwsGlueCatalogTable(),
+			"aws_glue_crawler":                                        resourceAwsGlueCrawler(),
+			"aws_glue_job":                                            resourceAwsGlueJob(),
+			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
+			"aws_glue_workflow":                                       resourceAwsGlueWorkflow(),
+			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
+			"aws_guardduty_ipset":                                     resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                                    resourceAwsGuardDutyMember(),
+			"aws_guardduty_organization_admin_account":                resourceAwsGuardDutyOrganizationAdminAccount(),
+			"aws_guardduty_organization_configuration":                resourceAwsGuardDutyOrganizationConfiguration(),
+			"aws_guardduty_publishing_destination":                    resourceAwsGuardDutyPublishingDestination(),
+			"aws_guardduty_threatintelset":                            resourceAwsGuardDutyThreatintelset(),
+			"aws_iam_access_key":                                      resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                                   resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                         resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                                    resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                           resourceAwsIamGroup(),
+			"aws_iam_group_membership":                                resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                         resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                                resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                         resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                          resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                               resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                          resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                     resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                            resourceAwsIamRole(),
+			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
+			"aws_iam_user_policy_attachment":                          resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                     resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                                    resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                            resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                              resourceAwsIamUserLoginProfile(),
+			"aws_imagebuilder_component":                              resourceAwsImageBuilderComponent(),
+			"aws_imagebuilder_container_recipe":                       resourceAwsImageBuilderContainerRecipe(),
+			"aws_imagebuilder_distribution_configuration":             resourceAwsImageBuilderDistributionConfiguration(),
+			"aws_imagebuilder_image_pipeline":                         resourceAwsImageBuilderImagePipeline(),
+			"aws_imagebuilder_image_recipe":                           resourceAwsImageBuilderImageRecipe(),
+			"aws_imagebuilder_infrastructure_configuration":           resourceAwsImageBuilderInfrastructureConfiguration(),
+			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
+			"aws_inspector2_delegated_admin_account":                  resourceAwsInspector2DelegatedAdminAccount(),
+			"aws_inspector2_enabler":                                  resourceAwsInspector2Enabler(),
+			"aws_inspector2_filter":                                   resourceAwsInspector2Filter(),
+			"aws_inspector2_organization_configuration":               resourceAwsInspector2OrganizationConfiguration(),
+			"aws_instance":                                            resourceAwsInstance(),
+			"aws_internet_gateway":                                    resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                     resourceAwsIotCertificate(),
+			"aws_iot_policy":                                          resourceAwsIotPolicy(),
+			"aws_iot_thing":                                           resourceAwsIotThing(),
+			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
+			"aws_key_pair":                                            resourceAwsKeyPair(),
+			"aws_keyspaces_keyspace":                                  resourceAwsKeyspacesKeyspace(),
+			"aws_keyspaces_table":                                     resourceAwsKeyspacesTable(),
+			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kms_alias":                                           resourceAwsKmsAlias(),
+			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
+			"aws_kms_grant":                                           resourceAwsKmsGrant(),
+			"aws_kms_key":                                             resourceAwsKmsKey(),
+			"aws_kms_replica_key":                                     resourceAwsKmsReplicaKey(),
+			"aws_lambda_function":                                     resourceAwsLambdaFunction(),
+			"aws_lambda_event_source_mapping":                         resourceAwsLambdaEventSourceMapping(),
+			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
+			"aws_lakeformation_lf_tag":                                resourceAwsLakeFormationLFTag(),
+			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
+			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
+			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
+			"aws_lambda_permission":                                   resourceAwsLambdaPermission(),
+			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
+			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                                resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":                 resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                       resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
+			"aws_macie2_account":                                      resourceAwsMacie2Account(),
+			"aws_macie2_classification_job":                           resourceAwsMacie2ClassificationJob(),
+			"aws_macie2_custom_data_identifier":                       resourceAwsMacie2CustomDataIdentifier(),
+			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
+			"aws_mq_broker":                                           resourceAwsMqBroker(),
+			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
+			"aws_memorydb_acl":                                        resourceAwsMemoryDBACL(),
+			"aws_memorydb_cluster":                                    resourceAwsMemoryDBCluster(),
+			"aws_memorydb_parameter_group":                            resourceAwsMemoryDBParameterGroup(),
+			"aws_memorydb_snapshot":                                   resourceAwsMemoryDBSnapshot(),
+			"aws_memorydb_subnet_group":                               resourceAwsMemoryDBSubnetGroup(),
+			"aws_memorydb_user":                                       resourceAwsMemoryDBUser(),
+			"aws_nat_gateway":                                         resourceAwsNatGateway(),
+			"aws_neptune_cluster":                                     resourceAwsNeptuneCluster(),
+			"aws_neptune_global_cluster":                              resourceAwsNeptuneGlobalCluster(),
+			"aws_network_acl":                                         resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                                 resourceAwsDefaultNetworkAcl(),
+			"aws_network_acl_rule":                                    resourceAwsNetworkAclRule(),
+			"aws_network_interface":                                   resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                        resourceAwsNetworkInterfaceAttachment(),
+			"aws_networkfirewall_firewall":                            resourceAwsNetworkFirewallFirewall(),
+			"aws_networkfirewall_firewall_policy":                     resourceAwsNetworkFirewallFirewallPolicy(),
+			"aws_networkfirewall_logging_configuration":               resourceAwsNetworkFirewallLoggingConfiguration(),
+			"aws_networkfirewall_rule_group":                          resourceAwsNetworkFirewallRuleGroup(),
+			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                              resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                           resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                              resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                            resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                           resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                            resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                                resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                              resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                               resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                                   resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                               resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                                 resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
+			"aws_organizations_account":                               resourceAwsOrganizationsAccount(),
+			"aws_organizations_delegated_administrator":               resourceAwsOrganizationsDelegatedAdministrator(),
+			"aws_organizations_organization":                          resourceAwsOrganizationsOrganization(),
+			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
+			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
+			"aws_pinpoint_apns_channel":                               resourceAwsPinpointAPNSChannel(),
+			"aws_pinpoint_app":                                        resourceAwsPinpointApp(),
+			"aws_pinpoint_campaign":                                   resourceAwsPinpointCampaign(),
+			"aws_pinpoint_email_channel":                              resourceAwsPinpointEmailChannel(),
+			"aws_pinpoint_gcm_channel":                                resourceAwsPinpointGCMChannel(),
+			"aws_pinpoint_segment":                                    resourceAwsPinpointSegment(),
+			"aws_pinpoint_sms_channel":                                resourceAwsPinpointSMSChannel(),
+			"aws_placement_group":                                     resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_qldb_ledger":                                         resourceAwsQLDBLedger(),
+			"aws_qldb_stream":                                         resourceAwsQLDBStream(),
+			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
+			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
+			"aws_ram_resource_share":                                  resourceAwsRamResourceShare(),
+			"aws_ram_resource_share_accepter":                         resourceAwsRamResourceShareAccepter(),
+			"aws_rds_cluster":                                         resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                                resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                         resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                                    resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                             resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                            resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                               resourceAwsRedshiftSubnetGroup(),
+			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
+			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
+			"aws_route53_record":                                      resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                            resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                        resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                                resourceAwsRoute53HealthCheck(),
+			"aws_route":                                               resourceAwsRoute(),
+			"aws_route_table":                                         resourceAwsRouteTable(),
+			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                             resourceAwsRouteTableAssociation(),
+			"aws_ses_active_receipt_rule_set":                         resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                                 resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_dkim":                                     resourceAwsSesDomainDkim(),
+			"aws_ses_domain_mail_from":                                resourceAwsSesDomainMailFrom(),
+			"aws_ses_receipt_filter":                                  resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                                    resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                                resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                               resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                               resourceAwsSesEventDestination(),
+			"aws_ses_template":                                        resourceAwsSesTemplate(),
+			"aws_sesv2_account_vdm_attributes":                        resourceAwsSesV2AccountVdmAttributes(),
+			"aws_sesv2_configuration_set":                             resourceAwsSesV2ConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination":           resourceAwsSesV2ConfigurationSetEventDestination(),
+			"aws_sesv2_contact_list":                                  resourceAwsSesV2ContactList(),
+			"aws_sesv2_dedicated_ip_pool":                             resourceAwsSesV2DedicatedIpPool(),
+			"aws_sesv2_email_identity":                                resourceAwsSesV2EmailIdentity(),
+			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
+			"aws_sagemaker_endpoint":                                  resourceAwsSagemakerEndpoint(),
+			"aws_sagemaker_endpoint_configuration":                    resourceAwsSagemakerEndpointConfiguration(),
+			"aws_sagemaker_model":                                     resourceAwsSagemakerModel(),
+			"aws_sagemaker_notebook_instance_lifecycle_configuration": resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration(),
+			"aws_secretsmanager_secret":                               resourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_rotation":                      resourceAwsSecretsManagerSecretRotation(),
+			"aws_securityhub_account":                                 resourceAwsSecurityHubAccount(),
+			"aws_securityhub_action_target":                           resourceAwsSecurityHubActionTarget(),
+			"aws_securityhub_finding_aggregator":                      resourceAwsSecurityHubFindingAggregator(),
+			"aws_securityhub_insight":                                 resourceAwsSecurityHubInsight(),
+			"aws_securityhub_organization_admin_account":              resourceAwsSecurityHubOrganizationAdminAccount(),
+			"aws_securityhub_organization_configuration":              resourceAwsSecurityHubOrganizationConfiguration(),
+			"aws_securityhub_standards_control":                       resourceAwsSecurityHubStandardsControl(),
+			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
+			"aws_security_group":                                      resourceAwsSecurityGroup(),
+			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_constraint":                           resourceAwsServiceCatalogConstraint(),
+			"aws_servicecatalog_principal_portfolio_association":      resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                              resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":        resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioned_product":                  resourceAwsServiceCatalogProvisionedProduct(),
+			"aws_servicecatalog_provisioning_artifact":                resourceAwsServiceCatalogProvisioningArtifact(),
+			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
+			"aws_shield_proactive_engagement":                         resourceAwsShieldProactiveEngagement(),
+			"aws_shield_protection":                                   resourceAwsShieldProtection(),
+			"aws_shield_protection_group":                             resourceAwsShieldProtectionGroup(),
+			"aws_shield_protection_health_check_association":          resourceAwsShieldProtectionHealthCheckAssociation(),
+			"aws_simpledb_domain":                                     resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                      resourceAwsSsmActivation(),
+			"aws_ssm_association":                                     resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                        resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                              resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                       resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                         resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
+			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
+			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                             resourceAwsSsoAdminPermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":               resourceAwsSsoAdminPermissionSetInlinePolicy(),
+			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
+			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
+			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
+			"aws_storagegateway_nfs_file_share":                       resourceAwsStorageGatewayNfsFileShare(),
+			"aws_storagegateway_smb_file_share":                       resourceAwsStorageGatewaySmbFileShare(),
+			"aws_storagegateway_stored_iscsi_volume":                  resourceAwsStorageGatewayStoredIscsiVolume(),
+			"aws_storagegateway_upload_buffer":                        resourceAwsStorageGatewayUploadBuffer(),
+			"aws_spot_datafeed_subscription":                          resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                               resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                                  resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                           resourceAwsSqsQueue(),
+			"aws_sqs_queue_policy":                                    resourceAwsSqsQueuePolicy(),
+			"aws_snapshot_create_volume_permission":                   resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                            resourceAwsSnsPlatformApplication(),
+			"aws_sns_topic":                                           resourceAwsSnsTopic(),
+			"aws_sns_topic_policy":                                    resourceAwsSnsTopicPolicy(),
+			"aws_sns_topic_subscription":                              resourceAwsSnsTopicSubscription(),
+			"aws_sfn_activity":                                        resourceAwsSfnActivity(),
+			"aws_sfn_alias":                                           resourceAwsSfnAlias(),
+			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
+			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
+			"aws_subnet":                                              resourceAwsSubnet(),
+			"aws_timestreamquery_scheduled_query":                     resourceAwsTimestreamQueryScheduledQuery(),
+			"aws_timestreamwrite_database":                            resourceAwsTimestreamWriteDatabase(),
+			"aws_timestreamwrite_table":                               resourceAwsTimestreamWriteTable(),
+			"aws_transfer_server":                                     resourceAwsTransferServer(),
+			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
+			"aws_transfer_user":                                       resourceAwsTransferUser(),
+			"aws_volume_attachment":                                   resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                        resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                            resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                                    resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                              resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                     resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_default_vpc":                                         resourceAwsDefaultVpc(),
+			"aws_vpc":                                                 resourceAwsVpc(),
+			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":                resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":              resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_service_private_dns_verification":       resourceAwsVpcEndpointServicePrivateDnsVerification(),
+			"aws_vpc_ipam":                                            resourceAwsVpcIpam(),
+			"aws_vpc_ipam_pool":                                       resourceAwsVpcIpamPool(),
+			"aws_vpc_ipam_pool_cidr":                                  resourceAwsVpcIpamPoolCidr(),
+			"aws_vpc_ipam_scope":                                      resourceAwsVpcIpamScope(),
+			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                              resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                       resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                                  resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                           resourceAwsWafIPSet(),
+			"aws_waf_rate_based_rule":                                 resourceAwsWafRateBasedRule(),
+			"aws_waf_regex_match_set":                                 resourceAwsWafRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                               resourceAwsWafRegexPatternSet(),
+			"aws_waf_rule":                                            resourceAwsWafRule(),
+			"aws_waf_rule_group":                                      resourceAwsWafRuleGroup(),
+			"aws_waf_size_constraint_set":                             resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                         resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                                   resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                         resourceAwsWafSqlInjectionMatchSet(),
+			"aws_waf_geo_match_set":                                   resourceAwsWafGeoMatchSet(),
+			"aws_wafregional_byte_match_set":                          resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_geo_match_set":                           resourceAwsWafRegionalGeoMatchSet(),
+			"aws_wafregional_ipset":                                   resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rate_based_rule":                         resourceAwsWafRegionalRateBasedRule(),
+			"aws_wafregional_regex_match_set":                         resourceAwsWafRegionalRegexMatchSet(),
+			"aws_wafregional_regex_pattern_set":                       resourceAwsWafRegionalRegexPatternSet(),
+			"aws_wafregional_rule":                                    resourceAwsWafRegionalRule(),
+			"aws_wafregional_rule_group":                              resourceAwsWafRegionalRuleGroup(),
+			"aws_wafregional_size_constraint_set":                     resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":                 resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_xss_match_set":                           resourceAwsWafRegionalXssMatchSet(),
+			"aws_wafregional_web_acl":                                 resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
+			"aws_wafv2_ip_set":                                        resourceAwsWafv2IPSet(),
+			"aws_wafv2_regex_pattern_set":                             resourceAwsWafv2RegexPatternSet(),
+			"aws_wafv2_rule_group":                                    resourceAwsWafv2RuleGroup(),
+			"aws_wafv2_web_acl":                                       resourceAwsWafv2WebACL(),
+			"aws_wafv2_web_acl_association":                           resourceAwsWafv2WebACLAssociation(),
+			"aws_wafv2_web_acl_logging_configuration":                 resourceAwsWafv2WebACLLoggingConfiguration(),
+			"aws_workspaces_directory":                                resourceAwsWorkspacesDirectory(),
+			"aws_workspaces_ip_group":                                 resourceAwsWorkspacesIpGroup(),
+			"aws_workspaces_workspace":                                resourceAwsWorkspacesWorkspace(),
+			"aws_xray_group":                                          resourceAwsXrayGroup(),
+			"aws_xray_sampling_rule":                                  resourceAwsXraySamplingRule(),
+			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ram_* family of resources.
+
+// ramNotFoundState is reported by the RAM refresh functions once the requested
+// object can no longer be found.
+const ramNotFoundState = "not-found"
+
+func decodeRamAssociationID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ",", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <resource-share-arn>,<principal-or-resource-arn>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func ramDescribeResourceShare(conn *ram.RAM, resourceShareArn, resourceOwner string) (*ram.ResourceShare, error) {
+	input := &ram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(resourceOwner),
+		ResourceShareArns: []*string{aws.String(resourceShareArn)},
+	}
+
+	var result *ram.ResourceShare
+
+	log.Printf("[DEBUG] Reading RAM Resource Share (%s): %s", resourceShareArn, input)
+	err := conn.GetResourceSharesPages(input, func(page *ram.GetResourceSharesOutput, lastPage bool) bool {
+		for _, resourceShare := range page.ResourceShares {
+			if resourceShare != nil && aws.StringValue(resourceShare.ResourceShareArn) == resourceShareArn {
+				result = resourceShare
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ramDescribeResourceShareAssociation(conn *ram.RAM, resourceShareArn, associationType, associatedEntity string) (*ram.ResourceShareAssociation, error) {
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: []*string{aws.String(resourceShareArn)},
+	}
+
+	switch associationType {
+	case ram.ResourceShareAssociationTypePrincipal:
+		input.Principal = aws.String(associatedEntity)
+	case ram.ResourceShareAssociationTypeResource:
+		input.ResourceArn = aws.String(associatedEntity)
+	}
+
+	var result *ram.ResourceShareAssociation
+
+	log.Printf("[DEBUG] Reading RAM Resource Share (%s) association: %s", resourceShareArn, input)
+	err := conn.GetResourceShareAssociationsPages(input, func(page *ram.GetResourceShareAssociationsOutput, lastPage bool) bool {
+		for _, association := range page.ResourceShareAssociations {
+			if association != nil && aws.StringValue(association.AssociatedEntity) == associatedEntity {
+				result = association
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ramDescribeResourceShareInvitation(conn *ram.RAM, resourceShareArn string) (*ram.ResourceShareInvitation, error) {
+	input := &ram.GetResourceShareInvitationsInput{
+		ResourceShareArns: []*string{aws.String(resourceShareArn)},
+	}
+
+	var result *ram.ResourceShareInvitation
+
+	log.Printf("[DEBUG] Reading RAM Resource Share (%s) invitations: %s", resourceShareArn, input)
+	err := conn.GetResourceShareInvitationsPages(input, func(page *ram.GetResourceShareInvitationsOutput, lastPage bool) bool {
+		for _, invitation := range page.ResourceShareInvitations {
+			if invitation == nil || aws.StringValue(invitation.ResourceShareArn) != resourceShareArn {
+				continue
+			}
+
+			// Prefer a pending invitation over any earlier ones that have
+			// since expired or been rejected.
+			if result == nil || aws.StringValue(invitation.Status) == ram.ResourceShareInvitationStatusPending {
+				result = invitation
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ramResourceShareRefreshFunc(conn *ram.RAM, resourceShareArn, resourceOwner string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resourceShare, err := ramDescribeResourceShare(conn, resourceShareArn, resourceOwner)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			return false, ramNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading RAM Resource Share (%s): %s", resourceShareArn, err)
+		}
+
+		if resourceShare == nil {
+			return false, ramNotFoundState, nil
+		}
+
+		if state := aws.StringValue(resourceShare.Status); state == ram.ResourceShareStatusFailed {
+			return resourceShare, state, fmt.Errorf("RAM Resource Share (%s) in %s state: %s", resourceShareArn, state, aws.StringValue(resourceShare.StatusMessage))
+		}
+
+		return resourceShare, aws.StringValue(resourceShare.Status), nil
+	}
+}
+
+func ramResourceShareAssociationRefreshFunc(conn *ram.RAM, resourceShareArn, associationType, associatedEntity string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, associationType, associatedEntity)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			return false, ramNotFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading RAM Resource Share (%s) association (%s): %s", resourceShareArn, associatedEntity, err)
+		}
+
+		if association == nil {
+			return false, ramNotFoundState, nil
+		}
+
+		if state := aws.StringValue(association.Status); state == ram.ResourceShareAssociationStatusFailed {
+			return association, state, fmt.Errorf("RAM Resource Share (%s) association (%s) in %s state: %s", resourceShareArn, associatedEntity, state, aws.StringValue(association.StatusMessage))
+		}
+
+		return association, aws.StringValue(association.Status), nil
+	}
+}
+
+func ramResourceShareInvitationRefreshFunc(conn *ram.RAM, resourceShareArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		invitation, err := ramDescribeResourceShareInvitation(conn, resourceShareArn)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading RAM Resource Share (%s) invitation: %s", resourceShareArn, err)
+		}
+
+		if invitation == nil {
+			return false, ramNotFoundState, nil
+		}
+
+		return invitation, aws.StringValue(invitation.Status), nil
+	}
+}
+
+func waitForRamResourceShareCreation(conn *ram.RAM, resourceShareArn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusPending},
+		Target:  []string{ram.ResourceShareStatusActive},
+		Refresh: ramResourceShareRefreshFunc(conn, resourceShareArn, ram.ResourceOwnerSelf),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for RAM Resource Share (%s) availability", resourceShareArn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForRamResourceShareDeletion(conn *ram.RAM, resourceShareArn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusActive, ram.ResourceShareStatusDeleting},
+		Target:  []string{ram.ResourceShareStatusDeleted, ramNotFoundState},
+		Refresh: ramResourceShareRefreshFunc(conn, resourceShareArn, ram.ResourceOwnerSelf),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for RAM Resource Share (%s) deletion", resourceShareArn)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForRamResourceShareAssociation(conn *ram.RAM, resourceShareArn, associationType, associatedEntity string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociating, ramNotFoundState},
+		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
+		Refresh: ramResourceShareAssociationRefreshFunc(conn, resourceShareArn, associationType, associatedEntity),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for RAM Resource Share (%s) association (%s)", resourceShareArn, associatedEntity)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForRamResourceShareDisassociation(conn *ram.RAM, resourceShareArn, associationType, associatedEntity string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ram.ResourceShareAssociationStatusAssociating,
+			ram.ResourceShareAssociationStatusAssociated,
+			ram.ResourceShareAssociationStatusDisassociating,
+		},
+		Target:  []string{ram.ResourceShareAssociationStatusDisassociated, ramNotFoundState},
+		Refresh: ramResourceShareAssociationRefreshFunc(conn, resourceShareArn, associationType, associatedEntity),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for RAM Resource Share (%s) disassociation (%s)", resourceShareArn, associatedEntity)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForRamResourceShareInvitationAcceptance(conn *ram.RAM, resourceShareArn string) (*ram.ResourceShareInvitation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareInvitationStatusPending},
+		Target:  []string{ram.ResourceShareInvitationStatusAccepted},
+		Refresh: ramResourceShareInvitationRefreshFunc(conn, resourceShareArn),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for RAM Resource Share (%s) invitation acceptance", resourceShareArn)
+	outputRaw, err := stateConf.WaitForState()
+
+	if invitation, ok := outputRaw.(*ram.ResourceShareInvitation); ok {
+		return invitation, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAccessAnalyzerAnalyzer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAccessAnalyzerAnalyzerCreate,
+		Read:   resourceAwsAccessAnalyzerAnalyzerRead,
+		Update: resourceAwsAccessAnalyzerAnalyzerUpdate,
+		Delete: resourceAwsAccessAnalyzerAnalyzerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAccessAnalyzerName,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  accessanalyzer.TypeAccount,
+				ValidateFunc: validation.StringInSlice([]string{
+					accessanalyzer.TypeAccount,
+					accessanalyzer.TypeOrganization,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+	analyzerName := d.Get("analyzer_name").(string)
+
+	input := &accessanalyzer.CreateAnalyzerInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Type:         aws.String(d.Get("type").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Access Analyzer Analyzer: %s", input)
+	if _, err := conn.CreateAnalyzer(input); err != nil {
+		return fmt.Errorf("error creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
+	}
+
+	d.SetId(analyzerName)
+
+	return resourceAwsAccessAnalyzerAnalyzerRead(d, meta)
+}
+
+func resourceAwsAccessAnalyzerAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	input := &accessanalyzer.GetAnalyzerInput{
+		AnalyzerName: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetAnalyzer(input)
+
+	if isAWSErr(err, accessanalyzer.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Access Analyzer Analyzer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Analyzer (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Analyzer == nil {
+		return fmt.Errorf("error reading Access Analyzer Analyzer (%s): empty response", d.Id())
+	}
+
+	d.Set("analyzer_name", output.Analyzer.Name)
+	d.Set("arn", output.Analyzer.Arn)
+	d.Set("type", output.Analyzer.Type)
+
+	if err := d.Set("tags", tagsToMapGeneric(output.Analyzer.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAccessAnalyzerAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		create, remove := diffTagsGeneric(o.(map[string]interface{}), n.(map[string]interface{}))
+
+		if len(remove) > 0 {
+			keys := make([]*string, 0, len(remove))
+			for k := range remove {
+				keys = append(keys, aws.String(k))
+			}
+
+			log.Printf("[DEBUG] Removing Access Analyzer Analyzer (%s) tags: %v", d.Id(), keys)
+			_, err := conn.UntagResource(&accessanalyzer.UntagResourceInput{
+				ResourceArn: aws.String(d.Get("arn").(string)),
+				TagKeys:     keys,
+			})
+			if err != nil {
+				return fmt.Errorf("error removing Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
+			}
+		}
+
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Adding Access Analyzer Analyzer (%s) tags: %v", d.Id(), create)
+			_, err := conn.TagResource(&accessanalyzer.TagResourceInput{
+				ResourceArn: aws.String(d.Get("arn").(string)),
+				Tags:        create,
+			})
+			if err != nil {
+				return fmt.Errorf("error adding Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsAccessAnalyzerAnalyzerRead(d, meta)
+}
+
+func resourceAwsAccessAnalyzerAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	input := &accessanalyzer.DeleteAnalyzerInput{
+		AnalyzerName: aws.String(d.Id()),
+		ClientToken:  aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Analyzer (%s)", d.Id())
+	_, err := conn.DeleteAnalyzer(input)
+
+	if isAWSErr(err, accessanalyzer.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAccessAnalyzerArchiveRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAccessAnalyzerArchiveRuleCreate,
+		Read:   resourceAwsAccessAnalyzerArchiveRuleRead,
+		Update: resourceAwsAccessAnalyzerArchiveRuleUpdate,
+		Delete: resourceAwsAccessAnalyzerArchiveRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAccessAnalyzerName,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						// A string rather than a bool so that an unset value
+						// can be told apart from false.
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAccessAnalyzerName,
+			},
+		},
+	}
+}
+
+func resourceAwsAccessAnalyzerArchiveRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+	analyzerName := d.Get("analyzer_name").(string)
+	ruleName := d.Get("rule_name").(string)
+
+	filter, err := expandAccessAnalyzerArchiveRuleFilter(d.Get("filter").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	input := &accessanalyzer.CreateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       filter,
+		RuleName:     aws.String(ruleName),
+	}
+
+	log.Printf("[DEBUG] Creating Access Analyzer Archive Rule: %s", input)
+	if _, err := conn.CreateArchiveRule(input); err != nil {
+		return fmt.Errorf("error creating Access Analyzer Archive Rule (%s/%s): %s", analyzerName, ruleName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", analyzerName, ruleName))
+
+	return resourceAwsAccessAnalyzerArchiveRuleRead(d, meta)
+}
+
+func resourceAwsAccessAnalyzerArchiveRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	analyzerName, ruleName, err := decodeAccessAnalyzerArchiveRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &accessanalyzer.GetArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		RuleName:     aws.String(ruleName),
+	}
+
+	output, err := conn.GetArchiveRule(input)
+
+	if isAWSErr(err, accessanalyzer.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Access Analyzer Archive Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Archive Rule (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ArchiveRule == nil {
+		return fmt.Errorf("error reading Access Analyzer Archive Rule (%s): empty response", d.Id())
+	}
+
+	d.Set("analyzer_name", analyzerName)
+	d.Set("rule_name", output.ArchiveRule.RuleName)
+
+	if err := d.Set("filter", flattenAccessAnalyzerArchiveRuleFilter(output.ArchiveRule.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAccessAnalyzerArchiveRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	if d.HasChange("filter") {
+		filter, err := expandAccessAnalyzerArchiveRuleFilter(d.Get("filter").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		input := &accessanalyzer.UpdateArchiveRuleInput{
+			AnalyzerName: aws.String(d.Get("analyzer_name").(string)),
+			ClientToken:  aws.String(resource.UniqueId()),
+			Filter:       filter,
+			RuleName:     aws.String(d.Get("rule_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Access Analyzer Archive Rule (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateArchiveRule(input); err != nil {
+			return fmt.Errorf("error updating Access Analyzer Archive Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAccessAnalyzerArchiveRuleRead(d, meta)
+}
+
+func resourceAwsAccessAnalyzerArchiveRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accessanalyzerconn
+
+	input := &accessanalyzer.DeleteArchiveRuleInput{
+		AnalyzerName: aws.String(d.Get("analyzer_name").(string)),
+		ClientToken:  aws.String(resource.UniqueId()),
+		RuleName:     aws.String(d.Get("rule_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Archive Rule (%s)", d.Id())
+	_, err := conn.DeleteArchiveRule(input)
+
+	if isAWSErr(err, accessanalyzer.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Access Analyzer Archive Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAccessAnalyzerArchiveRuleID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected ANALYZER-NAME/RULE-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandAccessAnalyzerArchiveRuleFilter(l []interface{}) (map[string]*accessanalyzer.Criterion, error) {
+	filter := make(map[string]*accessanalyzer.Criterion, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		criterion := &accessanalyzer.Criterion{}
+
+		if v, ok := m["contains"].([]interface{}); ok && len(v) > 0 {
+			criterion.Contains = expandStringList(v)
+		}
+
+		if v, ok := m["eq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Eq = expandStringList(v)
+		}
+
+		if v, ok := m["exists"].(string); ok && v != "" {
+			exists, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing filter exists (%s): %s", v, err)
+			}
+			criterion.Exists = aws.Bool(exists)
+		}
+
+		if v, ok := m["neq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Neq = expandStringList(v)
+		}
+
+		filter[m["criteria"].(string)] = criterion
+	}
+
+	return filter, nil
+}
+
+func flattenAccessAnalyzerArchiveRuleFilter(filter map[string]*accessanalyzer.Criterion) []interface{} {
+	l := make([]interface{}, 0, len(filter))
+
+	for criteria, criterion := range filter {
+		if criterion == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"contains": flattenStringList(criterion.Contains),
+			"criteria": criteria,
+			"eq":       flattenStringList(criterion.Eq),
+			"neq":      flattenStringList(criterion.Neq),
+		}
+
+		if criterion.Exists != nil {
+			m["exists"] = strconv.FormatBool(aws.BoolValue(criterion.Exists))
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsAccessAnalyzerArchiveRule_basic(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAccessAnalyzerArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAccessAnalyzerArchiveRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttrPair(resourceName, "analyzer_name", "aws_accessanalyzer_analyzer.test", "analyzer_name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsAccessAnalyzerArchiveRule_update(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAccessAnalyzerArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAccessAnalyzerArchiveRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsAccessAnalyzerArchiveRuleConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAccessAnalyzerArchiveRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_accessanalyzer_archive_rule" {
+			continue
+		}
+
+		analyzerName, ruleName, err := decodeAccessAnalyzerArchiveRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetArchiveRule(&accessanalyzer.GetArchiveRuleInput{
+			AnalyzerName: aws.String(analyzerName),
+			RuleName:     aws.String(ruleName),
+		})
+
+		if isAWSErr(err, accessanalyzer.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Access Analyzer Archive Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName string, archiveRule *accessanalyzer.ArchiveRuleSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		analyzerName, ruleName, err := decodeAccessAnalyzerArchiveRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+
+		output, err := conn.GetArchiveRule(&accessanalyzer.GetArchiveRuleInput{
+			AnalyzerName: aws.String(analyzerName),
+			RuleName:     aws.String(ruleName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.ArchiveRule == nil {
+			return fmt.Errorf("Access Analyzer Archive Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*archiveRule = *output.ArchiveRule
+
+		return nil
+	}
+}
+
+func testAccAwsAccessAnalyzerArchiveRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsAccessAnalyzerArchiveRuleConfigBasic(rName string) string {
+	return testAccAwsAccessAnalyzerArchiveRuleConfigBase(rName) + fmt.Sprintf(`
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = "${aws_accessanalyzer_analyzer.test.analyzer_name}"
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+}
+`, rName)
+}
+
+func testAccAwsAccessAnalyzerArchiveRuleConfigUpdated(rName string) string {
+	return testAccAwsAccessAnalyzerArchiveRuleConfigBase(rName) + fmt.Sprintf(`
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = "${aws_accessanalyzer_analyzer.test.analyzer_name}"
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+
+  filter {
+    criteria = "resourceType"
+    neq      = ["AWS::IAM::Role"]
+  }
+
+  filter {
+    criteria = "error"
+    exists   = "false"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Access Analyzer allows only one account analyzer per region, so these
+// tests must run serially.
+func TestAccAWSAccessAnalyzer(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Analyzer": {
+			"basic":            testAccAwsAccessAnalyzerAnalyzer_basic,
+			"tags":             testAccAwsAccessAnalyzerAnalyzer_tags,
+			"typeOrganization": testAccAwsAccessAnalyzerAnalyzer_typeOrganization,
+		},
+		"ArchiveRule": {
+			"basic":  testAccAwsAccessAnalyzerArchiveRule_basic,
+			"update": testAccAwsAccessAnalyzerArchiveRule_update,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 const (
@@ -67,6 +68,15 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 	if ramdiskId := d.Get("ramdisk_id").(string); ramdiskId != "" {
 		req.RamdiskId = aws.String(ramdiskId)
 	}
+	if bootMode := d.Get("boot_mode").(string); bootMode != "" {
+		req.BootMode = aws.String(bootMode)
+	}
+	if imdsSupport := d.Get("imds_support").(string); imdsSupport != "" {
+		req.ImdsSupport = aws.String(imdsSupport)
+	}
+	if tpmSupport := d.Get("tpm_support").(string); tpmSupport != "" {
+		req.TpmSupport = aws.String(tpmSupport)
+	}
 
 	ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
 	ephemeralBlockDevsSet := d.Get("ephemeral_block_device").(*schema.Set)
@@ -187,7 +197,10 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("name", image.Name)
+	d.Set("boot_mode", image.BootMode)
+	d.Set("deprecation_time", image.DeprecationTime)
 	d.Set("description", image.Description)
+	d.Set("imds_support", image.ImdsSupport)
 	d.Set("image_location", image.ImageLocation)
 	d.Set("architecture", image.Architecture)
 	d.Set("kernel_id", image.KernelId)
@@ -195,6 +208,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("root_device_name", image.RootDeviceName)
 	d.Set("root_snapshot_id", amiRootSnapshotId(image))
 	d.Set("sriov_net_support", image.SriovNetSupport)
+	d.Set("tpm_support", image.TpmSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 
 	var ebsBlockDevs []map[string]interface{}
@@ -258,11 +272,50 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("description")
 	}
 
+	if d.HasChange("deprecation_time") {
+		if err := resourceAwsAmiUpdateDeprecationTime(client, d.Id(), d.Get("deprecation_time").(string)); err != nil {
+			return err
+		}
+		d.SetPartial("deprecation_time")
+	}
+
 	d.Partial(false)
 
 	return resourceAwsAmiRead(d, meta)
 }
 
+// resourceAwsAmiUpdateDeprecationTime enables deprecation of the AMI at the
+// given RFC3339 timestamp, or disables it when the timestamp is empty.
+func resourceAwsAmiUpdateDeprecationTime(client *ec2.EC2, id, deprecationTime string) error {
+	if deprecationTime == "" {
+		log.Printf("[DEBUG] Disabling deprecation of AMI (%s)", id)
+		_, err := client.DisableImageDeprecation(&ec2.DisableImageDeprecationInput{
+			ImageId: aws.String(id),
+		})
+		if err != nil {
+			return fmt.Errorf("error disabling deprecation of AMI (%s): %s", id, err)
+		}
+
+		return nil
+	}
+
+	deprecateAt, err := time.Parse(time.RFC3339, deprecationTime)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Enabling deprecation of AMI (%s) at %s", id, deprecationTime)
+	_, err = client.EnableImageDeprecation(&ec2.EnableImageDeprecationInput{
+		DeprecateAt: aws.Time(deprecateAt),
+		ImageId:     aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling deprecation of AMI (%s): %s", id, err)
+	}
+
+	return nil
+}
+
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
@@ -397,7 +450,19 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 		volumeTypeDefault = "standard"
 	}
 
-	return map[string]*schema.Schema{
+	resourceSchema := map[string]*schema.Schema{
+		"boot_mode": {
+			Type:     schema.TypeString,
+			Optional: !computed,
+			Computed: true,
+			ForceNew: !computed,
+		},
+		"deprecation_time": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validateRFC3339TimeString,
+			DiffSuppressFunc: suppressEquivalentRFC3339Timestamps,
+		},
 		"image_location": {
 			Type:     schema.TypeString,
 			Optional: !computed,
@@ -415,6 +480,12 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"imds_support": {
+			Type:     schema.TypeString,
+			Optional: !computed,
+			Computed: true,
+			ForceNew: !computed,
+		},
 		"kernel_id": {
 			Type:     schema.TypeString,
 			Optional: !computed,
@@ -449,6 +520,12 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 			ForceNew: !computed,
 			Default:  sriovNetSupportDefault,
 		},
+		"tpm_support": {
+			Type:     schema.TypeString,
+			Optional: !computed,
+			Computed: true,
+			ForceNew: !computed,
+		},
 		"virtualization_type": {
 			Type:     schema.TypeString,
 			Optional: !computed,
@@ -572,4 +649,12 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 			ForceNew: true,
 		},
 	}
+
+	if !computed {
+		resourceSchema["boot_mode"].ValidateFunc = validation.StringInSlice(ec2.BootModeValues_Values(), false)
+		resourceSchema["imds_support"].ValidateFunc = validation.StringInSlice(ec2.ImdsSupportValues_Values(), false)
+		resourceSchema["tpm_support"].ValidateFunc = validation.StringInSlice(ec2.TpmSupportValues_Values(), false)
+	}
+
+	return resourceSchema
 }
@@ -72,6 +72,66 @@ func TestAccAWSAMI_snapshotSize(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMI_deprecationTime(t *testing.T) {
+	var ami ec2.Image
+	rInt := acctest.RandInt()
+	resourceName := "aws_ami.foo"
+	deprecationTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+	deprecationTimeUpdated := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfig_deprecationTime(rInt, deprecationTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecationTime),
+				),
+			},
+			{
+				Config: testAccAmiConfig_deprecationTime(rInt, deprecationTimeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecationTimeUpdated),
+				),
+			},
+			{
+				Config: testAccAmiConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_bootModeTpmSupport(t *testing.T) {
+	var ami ec2.Image
+	rInt := acctest.RandInt()
+	resourceName := "aws_ami.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfig_bootModeTpmSupport(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "boot_mode", "uefi"),
+					resource.TestCheckResourceAttr(resourceName, "imds_support", "v2.0"),
+					resource.TestCheckResourceAttr(resourceName, "tpm_support", "v2.0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAmiDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -266,3 +326,76 @@ resource "aws_ami" "foo" {
 }
 	`, rInt)
 }
+
+func testAccAmiConfig_deprecationTime(rInt int, deprecationTime string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ebs_volume" "foo" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size              = 8
+
+  tags {
+    Name = "testAccAmiConfig_deprecationTime"
+  }
+}
+
+resource "aws_ebs_snapshot" "foo" {
+  volume_id = "${aws_ebs_volume.foo.id}"
+
+  tags {
+    Name = "testAccAmiConfig_deprecationTime"
+  }
+}
+
+resource "aws_ami" "foo" {
+  name                = "tf-testing-%[1]d"
+  deprecation_time    = %[2]q
+  virtualization_type = "hvm"
+  root_device_name    = "/dev/sda1"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = "${aws_ebs_snapshot.foo.id}"
+  }
+}
+`, rInt, deprecationTime)
+}
+
+func testAccAmiConfig_bootModeTpmSupport(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ebs_volume" "foo" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size              = 8
+
+  tags {
+    Name = "testAccAmiConfig_bootModeTpmSupport"
+  }
+}
+
+resource "aws_ebs_snapshot" "foo" {
+  volume_id = "${aws_ebs_volume.foo.id}"
+
+  tags {
+    Name = "testAccAmiConfig_bootModeTpmSupport"
+  }
+}
+
+resource "aws_ami" "foo" {
+  name                = "tf-testing-%[1]d"
+  architecture        = "x86_64"
+  boot_mode           = "uefi"
+  imds_support        = "v2.0"
+  tpm_support         = "v2.0"
+  virtualization_type = "hvm"
+  root_device_name    = "/dev/xvda"
+
+  ebs_block_device {
+    device_name = "/dev/xvda"
+    snapshot_id = "${aws_ebs_snapshot.foo.id}"
+  }
+}
+`, rInt)
+}
@@ -0,0 +1,591 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAmplifyApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyAppCreate,
+		Read:   resourceAwsAmplifyAppRead,
+		Update: resourceAwsAmplifyAppUpdate,
+		Delete: resourceAwsAmplifyAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_branch_creation_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"basic_auth_credentials": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"build_spec": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enable_auto_build": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_basic_auth": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_performance_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"enable_pull_request_preview": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"environment_variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"framework": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"pull_request_environment_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"stage": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(amplify.Stage_Values(), false),
+						},
+					},
+				},
+			},
+			"auto_branch_creation_patterns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"basic_auth_credentials": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"build_spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"custom_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"200",
+								"301",
+								"302",
+								"404",
+								"404-200",
+							}, false),
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"default_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enable_auto_branch_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_basic_auth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_branch_auto_build": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_branch_auto_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"iam_service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"oauth_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      amplify.PlatformWeb,
+				ValidateFunc: validation.StringInSlice(amplify.Platform_Values(), false),
+			},
+			"production_branch": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_deploy_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbnail_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAmplifyAppCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	name := d.Get("name").(string)
+	input := &amplify.CreateAppInput{
+		EnableAutoBranchCreation: aws.Bool(d.Get("enable_auto_branch_creation").(bool)),
+		EnableBasicAuth:          aws.Bool(d.Get("enable_basic_auth").(bool)),
+		EnableBranchAutoBuild:    aws.Bool(d.Get("enable_branch_auto_build").(bool)),
+		EnableBranchAutoDeletion: aws.Bool(d.Get("enable_branch_auto_deletion").(bool)),
+		Name:                     aws.String(name),
+		Platform:                 aws.String(d.Get("platform").(string)),
+	}
+
+	if v, ok := d.GetOk("access_token"); ok {
+		input.AccessToken = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_branch_creation_config"); ok {
+		input.AutoBranchCreationConfig = expandAmplifyAutoBranchCreationConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("auto_branch_creation_patterns"); ok && v.(*schema.Set).Len() > 0 {
+		input.AutoBranchCreationPatterns = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("basic_auth_credentials"); ok {
+		input.BasicAuthCredentials = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("build_spec"); ok {
+		input.BuildSpec = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_rule"); ok {
+		input.CustomRules = expandAmplifyCustomRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_variables"); ok {
+		input.EnvironmentVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("iam_service_role_arn"); ok {
+		input.IamServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("oauth_token"); ok {
+		input.OauthToken = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository"); ok {
+		input.Repository = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Amplify App: %s", name)
+	output, err := conn.CreateApp(input)
+	if err != nil {
+		return fmt.Errorf("error creating Amplify App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppId))
+
+	return resourceAwsAmplifyAppRead(d, meta)
+}
+
+func resourceAwsAmplifyAppRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	output, err := conn.GetApp(&amplify.GetAppInput{
+		AppId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Amplify App (%s): %s", d.Id(), err)
+	}
+
+	app := output.App
+
+	d.Set("arn", app.AppArn)
+	d.Set("basic_auth_credentials", app.BasicAuthCredentials)
+	d.Set("build_spec", app.BuildSpec)
+	d.Set("default_domain", app.DefaultDomain)
+	d.Set("description", app.Description)
+	d.Set("enable_auto_branch_creation", app.EnableAutoBranchCreation)
+	d.Set("enable_basic_auth", app.EnableBasicAuth)
+	d.Set("enable_branch_auto_build", app.EnableBranchAutoBuild)
+	d.Set("enable_branch_auto_deletion", app.EnableBranchAutoDeletion)
+	d.Set("iam_service_role_arn", app.IamServiceRoleArn)
+	d.Set("name", app.Name)
+	d.Set("platform", app.Platform)
+	d.Set("repository", app.Repository)
+
+	if err := d.Set("auto_branch_creation_config", flattenAmplifyAutoBranchCreationConfig(app.AutoBranchCreationConfig)); err != nil {
+		return fmt.Errorf("error setting auto_branch_creation_config: %s", err)
+	}
+
+	if err := d.Set("auto_branch_creation_patterns", flattenStringList(app.AutoBranchCreationPatterns)); err != nil {
+		return fmt.Errorf("error setting auto_branch_creation_patterns: %s", err)
+	}
+
+	if err := d.Set("custom_rule", flattenAmplifyCustomRules(app.CustomRules)); err != nil {
+		return fmt.Errorf("error setting custom_rule: %s", err)
+	}
+
+	if err := d.Set("environment_variables", aws.StringValueMap(app.EnvironmentVariables)); err != nil {
+		return fmt.Errorf("error setting environment_variables: %s", err)
+	}
+
+	if err := d.Set("production_branch", flattenAmplifyProductionBranch(app.ProductionBranch)); err != nil {
+		return fmt.Errorf("error setting production_branch: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(app.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmplifyAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	if d.HasChange("access_token") || d.HasChange("auto_branch_creation_config") || d.HasChange("auto_branch_creation_patterns") ||
+		d.HasChange("basic_auth_credentials") || d.HasChange("build_spec") || d.HasChange("custom_rule") ||
+		d.HasChange("description") || d.HasChange("enable_auto_branch_creation") || d.HasChange("enable_basic_auth") ||
+		d.HasChange("enable_branch_auto_build") || d.HasChange("enable_branch_auto_deletion") || d.HasChange("environment_variables") ||
+		d.HasChange("iam_service_role_arn") || d.HasChange("name") || d.HasChange("oauth_token") ||
+		d.HasChange("platform") || d.HasChange("repository") {
+		input := &amplify.UpdateAppInput{
+			AppId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_token") {
+			input.AccessToken = aws.String(d.Get("access_token").(string))
+		}
+
+		if d.HasChange("auto_branch_creation_config") {
+			input.AutoBranchCreationConfig = expandAmplifyAutoBranchCreationConfig(d.Get("auto_branch_creation_config").([]interface{}))
+		}
+
+		if d.HasChange("auto_branch_creation_patterns") {
+			input.AutoBranchCreationPatterns = expandStringList(d.Get("auto_branch_creation_patterns").(*schema.Set).List())
+		}
+
+		if d.HasChange("basic_auth_credentials") {
+			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
+		}
+
+		if d.HasChange("build_spec") {
+			input.BuildSpec = aws.String(d.Get("build_spec").(string))
+		}
+
+		if d.HasChange("custom_rule") {
+			// An empty list is required to remove all rules.
+			input.CustomRules = expandAmplifyCustomRules(d.Get("custom_rule").([]interface{}))
+			if input.CustomRules == nil {
+				input.CustomRules = []*amplify.CustomRule{}
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("enable_auto_branch_creation") {
+			input.EnableAutoBranchCreation = aws.Bool(d.Get("enable_auto_branch_creation").(bool))
+		}
+
+		if d.HasChange("enable_basic_auth") {
+			input.EnableBasicAuth = aws.Bool(d.Get("enable_basic_auth").(bool))
+		}
+
+		if d.HasChange("enable_branch_auto_build") {
+			input.EnableBranchAutoBuild = aws.Bool(d.Get("enable_branch_auto_build").(bool))
+		}
+
+		if d.HasChange("enable_branch_auto_deletion") {
+			input.EnableBranchAutoDeletion = aws.Bool(d.Get("enable_branch_auto_deletion").(bool))
+		}
+
+		if d.HasChange("environment_variables") {
+			// An empty map is required to remove all variables.
+			input.EnvironmentVariables = stringMapToPointers(d.Get("environment_variables").(map[string]interface{}))
+		}
+
+		if d.HasChange("iam_service_role_arn") {
+			input.IamServiceRoleArn = aws.String(d.Get("iam_service_role_arn").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("oauth_token") {
+			input.OauthToken = aws.String(d.Get("oauth_token").(string))
+		}
+
+		if d.HasChange("platform") {
+			input.Platform = aws.String(d.Get("platform").(string))
+		}
+
+		if d.HasChange("repository") {
+			input.Repository = aws.String(d.Get("repository").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Amplify App: %s", input)
+		if _, err := conn.UpdateApp(input); err != nil {
+			return fmt.Errorf("error updating Amplify App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsAmplify(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Amplify App (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsAmplifyAppRead(d, meta)
+}
+
+func resourceAwsAmplifyAppDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	log.Printf("[DEBUG] Deleting Amplify App: %s", d.Id())
+	_, err := conn.DeleteApp(&amplify.DeleteAppInput{
+		AppId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify App (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAmplifyAutoBranchCreationConfig(l []interface{}) *amplify.AutoBranchCreationConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &amplify.AutoBranchCreationConfig{
+		EnableAutoBuild:          aws.Bool(m["enable_auto_build"].(bool)),
+		EnableBasicAuth:          aws.Bool(m["enable_basic_auth"].(bool)),
+		EnablePerformanceMode:    aws.Bool(m["enable_performance_mode"].(bool)),
+		EnablePullRequestPreview: aws.Bool(m["enable_pull_request_preview"].(bool)),
+	}
+
+	if v, ok := m["basic_auth_credentials"].(string); ok && v != "" {
+		config.BasicAuthCredentials = aws.String(v)
+	}
+
+	if v, ok := m["build_spec"].(string); ok && v != "" {
+		config.BuildSpec = aws.String(v)
+	}
+
+	if v, ok := m["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		config.EnvironmentVariables = stringMapToPointers(v)
+	}
+
+	if v, ok := m["framework"].(string); ok && v != "" {
+		config.Framework = aws.String(v)
+	}
+
+	if v, ok := m["pull_request_environment_name"].(string); ok && v != "" {
+		config.PullRequestEnvironmentName = aws.String(v)
+	}
+
+	if v, ok := m["stage"].(string); ok && v != "" {
+		config.Stage = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenAmplifyAutoBranchCreationConfig(config *amplify.AutoBranchCreationConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"basic_auth_credentials":        aws.StringValue(config.BasicAuthCredentials),
+		"build_spec":                    aws.StringValue(config.BuildSpec),
+		"enable_auto_build":             aws.BoolValue(config.EnableAutoBuild),
+		"enable_basic_auth":             aws.BoolValue(config.EnableBasicAuth),
+		"enable_performance_mode":       aws.BoolValue(config.EnablePerformanceMode),
+		"enable_pull_request_preview":   aws.BoolValue(config.EnablePullRequestPreview),
+		"environment_variables":         aws.StringValueMap(config.EnvironmentVariables),
+		"framework":                     aws.StringValue(config.Framework),
+		"pull_request_environment_name": aws.StringValue(config.PullRequestEnvironmentName),
+		"stage":                         aws.StringValue(config.Stage),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAmplifyCustomRules(l []interface{}) []*amplify.CustomRule {
+	if len(l) == 0 {
+		return nil
+	}
+
+	rules := make([]*amplify.CustomRule, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+
+		rule := &amplify.CustomRule{
+			Source: aws.String(m["source"].(string)),
+			Target: aws.String(m["target"].(string)),
+		}
+
+		if v, ok := m["condition"].(string); ok && v != "" {
+			rule.Condition = aws.String(v)
+		}
+
+		if v, ok := m["status"].(string); ok && v != "" {
+			rule.Status = aws.String(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenAmplifyCustomRules(rules []*amplify.CustomRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		l = append(l, map[string]interface{}{
+			"condition": aws.StringValue(rule.Condition),
+			"source":    aws.StringValue(rule.Source),
+			"status":    aws.StringValue(rule.Status),
+			"target":    aws.StringValue(rule.Target),
+		})
+	}
+
+	return l
+}
+
+func flattenAmplifyProductionBranch(branch *amplify.ProductionBranch) []interface{} {
+	if branch == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"branch_name":   aws.StringValue(branch.BranchName),
+		"status":        aws.StringValue(branch.Status),
+		"thumbnail_url": aws.StringValue(branch.ThumbnailUrl),
+	}
+
+	if branch.LastDeployTime != nil {
+		m["last_deploy_time"] = aws.TimeValue(branch.LastDeployTime).Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
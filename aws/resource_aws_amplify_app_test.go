@@ -0,0 +1,365 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyApp_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:amplify:[^:]+:[^:]+:apps/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "default_domain"),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_branch_creation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", "WEB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_buildSpec(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_buildSpec(rName, "make"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "build_spec", regexp.MustCompile(`make`)),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyAppConfig_buildSpec(rName, "npm run build"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "build_spec", regexp.MustCompile(`npm run build`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_environmentVariables(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_environmentVariables(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyAppConfig_environmentVariables(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_autoBranchCreation(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_autoBranchCreation(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_branch_creation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_branch_creation_patterns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_branch_creation_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_branch_creation_config.0.enable_auto_build", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_branch_creation_config.0.stage", "DEVELOPMENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_customRules(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_customRules(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.source", "/<*>"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.status", "404-200"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.target", "/index.html"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_repository(t *testing.T) {
+	repository := os.Getenv("AMPLIFY_GITHUB_REPOSITORY")
+	accessToken := os.Getenv("AMPLIFY_GITHUB_ACCESS_TOKEN")
+	if repository == "" || accessToken == "" {
+		t.Skip("Environment variables AMPLIFY_GITHUB_REPOSITORY and AMPLIFY_GITHUB_ACCESS_TOKEN are not set")
+	}
+
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_repository(rName, repository, accessToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "repository", repository),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_token"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyApp_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyAppConfig_tags(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyAppConfig_tags(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyAppExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify App ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		_, err := conn.GetApp(&amplify.GetAppInput{
+			AppId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAmplifyAppDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_app" {
+			continue
+		}
+
+		_, err := conn.GetApp(&amplify.GetAppInput{
+			AppId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify App %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAmplifyAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAmplifyAppConfig_buildSpec(rName, command string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  build_spec = <<EOF
+version: 0.1
+frontend:
+  phases:
+    build:
+      commands:
+        - %[2]s
+  artifacts:
+    baseDirectory: build
+    files:
+      - '**/*'
+EOF
+}
+`, rName, command)
+}
+
+func testAccAWSAmplifyAppConfig_environmentVariables(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  environment_variables {
+    ENVVAR1 = %[2]q
+  }
+}
+`, rName, value)
+}
+
+func testAccAWSAmplifyAppConfig_autoBranchCreation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  enable_auto_branch_creation = true
+
+  auto_branch_creation_patterns = [
+    "*",
+    "*/**",
+  ]
+
+  auto_branch_creation_config {
+    enable_auto_build = true
+    stage = "DEVELOPMENT"
+  }
+}
+`, rName)
+}
+
+func testAccAWSAmplifyAppConfig_customRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  custom_rule {
+    source = "/<*>"
+    status = "404-200"
+    target = "/index.html"
+  }
+}
+`, rName)
+}
+
+func testAccAWSAmplifyAppConfig_repository(rName, repository, accessToken string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+  repository = %[2]q
+  access_token = %[3]q
+}
+`, rName, repository, accessToken)
+}
+
+func testAccAWSAmplifyAppConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
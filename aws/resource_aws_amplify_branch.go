@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAmplifyBranch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyBranchCreate,
+		Read:   resourceAwsAmplifyBranchRead,
+		Update: resourceAwsAmplifyBranchUpdate,
+		Delete: resourceAwsAmplifyBranchDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"basic_auth_credentials": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"custom_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_branch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enable_auto_build": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"enable_basic_auth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_performance_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"enable_pull_request_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"framework": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pull_request_environment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_branch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(amplify.Stage_Values(), false),
+			},
+			"tags": tagsSchema(),
+			"ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAmplifyBranchCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID := d.Get("app_id").(string)
+	branchName := d.Get("branch_name").(string)
+
+	input := &amplify.CreateBranchInput{
+		AppId:                    aws.String(appID),
+		BranchName:               aws.String(branchName),
+		EnableAutoBuild:          aws.Bool(d.Get("enable_auto_build").(bool)),
+		EnableBasicAuth:          aws.Bool(d.Get("enable_basic_auth").(bool)),
+		EnableNotification:       aws.Bool(d.Get("enable_notification").(bool)),
+		EnablePerformanceMode:    aws.Bool(d.Get("enable_performance_mode").(bool)),
+		EnablePullRequestPreview: aws.Bool(d.Get("enable_pull_request_preview").(bool)),
+	}
+
+	if v, ok := d.GetOk("basic_auth_credentials"); ok {
+		input.BasicAuthCredentials = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_variables"); ok {
+		input.EnvironmentVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("framework"); ok {
+		input.Framework = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pull_request_environment_name"); ok {
+		input.PullRequestEnvironmentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stage"); ok {
+		input.Stage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("ttl"); ok {
+		input.Ttl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Amplify Branch: %s", input)
+	_, err := conn.CreateBranch(input)
+	if err != nil {
+		return fmt.Errorf("error creating Amplify Branch (%s/%s): %s", appID, branchName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", appID, branchName))
+
+	return resourceAwsAmplifyBranchRead(d, meta)
+}
+
+func resourceAwsAmplifyBranchRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, branchName, err := decodeAmplifyBranchID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetBranch(&amplify.GetBranchInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify Branch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Branch (%s): %s", d.Id(), err)
+	}
+
+	branch := output.Branch
+
+	d.Set("app_id", appID)
+	d.Set("arn", branch.BranchArn)
+	d.Set("basic_auth_credentials", branch.BasicAuthCredentials)
+	d.Set("branch_name", branch.BranchName)
+	d.Set("description", branch.Description)
+	d.Set("destination_branch", branch.DestinationBranch)
+	d.Set("display_name", branch.DisplayName)
+	d.Set("enable_auto_build", branch.EnableAutoBuild)
+	d.Set("enable_basic_auth", branch.EnableBasicAuth)
+	d.Set("enable_notification", branch.EnableNotification)
+	d.Set("enable_performance_mode", branch.EnablePerformanceMode)
+	d.Set("enable_pull_request_preview", branch.EnablePullRequestPreview)
+	d.Set("framework", branch.Framework)
+	d.Set("pull_request_environment_name", branch.PullRequestEnvironmentName)
+	d.Set("source_branch", branch.SourceBranch)
+	d.Set("stage", branch.Stage)
+	d.Set("ttl", branch.Ttl)
+
+	if err := d.Set("associated_resources", flattenStringList(branch.AssociatedResources)); err != nil {
+		return fmt.Errorf("error setting associated_resources: %s", err)
+	}
+
+	if err := d.Set("custom_domains", flattenStringList(branch.CustomDomains)); err != nil {
+		return fmt.Errorf("error setting custom_domains: %s", err)
+	}
+
+	if err := d.Set("environment_variables", aws.StringValueMap(branch.EnvironmentVariables)); err != nil {
+		return fmt.Errorf("error setting environment_variables: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(branch.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmplifyBranchUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, branchName, err := decodeAmplifyBranchID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("basic_auth_credentials") || d.HasChange("description") || d.HasChange("display_name") ||
+		d.HasChange("enable_auto_build") || d.HasChange("enable_basic_auth") || d.HasChange("enable_notification") ||
+		d.HasChange("enable_pull_request_preview") || d.HasChange("environment_variables") || d.HasChange("framework") ||
+		d.HasChange("pull_request_environment_name") || d.HasChange("stage") || d.HasChange("ttl") {
+		input := &amplify.UpdateBranchInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		}
+
+		if d.HasChange("basic_auth_credentials") {
+			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("enable_auto_build") {
+			input.EnableAutoBuild = aws.Bool(d.Get("enable_auto_build").(bool))
+		}
+
+		if d.HasChange("enable_basic_auth") {
+			input.EnableBasicAuth = aws.Bool(d.Get("enable_basic_auth").(bool))
+		}
+
+		if d.HasChange("enable_notification") {
+			input.EnableNotification = aws.Bool(d.Get("enable_notification").(bool))
+		}
+
+		if d.HasChange("enable_pull_request_preview") {
+			input.EnablePullRequestPreview = aws.Bool(d.Get("enable_pull_request_preview").(bool))
+		}
+
+		if d.HasChange("environment_variables") {
+			// An empty map is required to remove all variables.
+			input.EnvironmentVariables = stringMapToPointers(d.Get("environment_variables").(map[string]interface{}))
+		}
+
+		if d.HasChange("framework") {
+			input.Framework = aws.String(d.Get("framework").(string))
+		}
+
+		if d.HasChange("pull_request_environment_name") {
+			input.PullRequestEnvironmentName = aws.String(d.Get("pull_request_environment_name").(string))
+		}
+
+		if d.HasChange("stage") {
+			input.Stage = aws.String(d.Get("stage").(string))
+		}
+
+		if d.HasChange("ttl") {
+			input.Ttl = aws.String(d.Get("ttl").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Amplify Branch: %s", input)
+		if _, err := conn.UpdateBranch(input); err != nil {
+			return fmt.Errorf("error updating Amplify Branch (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsAmplify(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Amplify Branch (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsAmplifyBranchRead(d, meta)
+}
+
+func resourceAwsAmplifyBranchDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, branchName, err := decodeAmplifyBranchID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Amplify Branch: %s", d.Id())
+	_, err = conn.DeleteBranch(&amplify.DeleteBranchInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify Branch (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// Branch names may contain slashes (e.g. "feature/x"), so only the first
+// separator is significant.
+func decodeAmplifyBranchID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected APP-ID/BRANCH-NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyBranch_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", "aws_amplify_app.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:amplify:[^:]+:[^:]+:apps/.+/branches/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_build", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyBranch_options(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_options(rName, "first", "PRODUCTION", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "framework", "React"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "PRODUCTION"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyBranchConfig_options(rName, "second", "BETA", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "second"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "BETA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyBranch_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_tags(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSAmplifyBranchConfig_tags(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeAmplifyBranchID(t *testing.T) {
+	testCases := []struct {
+		Input      string
+		AppID      string
+		BranchName string
+		ErrCount   int
+	}{
+		{
+			Input:    "d1a2b3c4",
+			ErrCount: 1,
+		},
+		{
+			Input:    "/main",
+			ErrCount: 1,
+		},
+		{
+			Input:      "d1a2b3c4/main",
+			AppID:      "d1a2b3c4",
+			BranchName: "main",
+		},
+		{
+			Input:      "d1a2b3c4/feature/login",
+			AppID:      "d1a2b3c4",
+			BranchName: "feature/login",
+		},
+	}
+
+	for _, tc := range testCases {
+		appID, branchName, err := decodeAmplifyBranchID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if appID != tc.AppID || branchName != tc.BranchName {
+			t.Fatalf("expected %q to decode to %q, %q, received: %q, %q", tc.Input, tc.AppID, tc.BranchName, appID, branchName)
+		}
+	}
+}
+
+func testAccCheckAWSAmplifyBranchExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		appID, branchName, err := decodeAmplifyBranchID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		_, err = conn.GetBranch(&amplify.GetBranchInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAmplifyBranchDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_branch" {
+			continue
+		}
+
+		appID, branchName, err := decodeAmplifyBranchID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBranch(&amplify.GetBranchInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		})
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Branch %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAmplifyBranchConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAmplifyBranchConfig_options(rName, description, stage, envValue string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+
+  description = %[2]q
+  display_name = %[2]q
+  enable_notification = true
+  framework = "React"
+  stage = %[3]q
+
+  environment_variables {
+    ENVVAR1 = %[4]q
+  }
+}
+`, rName, description, stage, envValue)
+}
+
+func testAccAWSAmplifyBranchConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAmplifyDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyDomainAssociationCreate,
+		Read:   resourceAwsAmplifyDomainAssociationRead,
+		Update: resourceAwsAmplifyDomainAssociationUpdate,
+		Delete: resourceAwsAmplifyDomainAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_verification_dns_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enable_auto_sub_domain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sub_domain": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 255,
+				Set:      resourceAwsAmplifySubDomainHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"dns_record": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsAmplifyDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID := d.Get("app_id").(string)
+	domainName := d.Get("domain_name").(string)
+
+	input := &amplify.CreateDomainAssociationInput{
+		AppId:               aws.String(appID),
+		DomainName:          aws.String(domainName),
+		EnableAutoSubDomain: aws.Bool(d.Get("enable_auto_sub_domain").(bool)),
+		SubDomainSettings:   expandAmplifySubDomainSettings(d.Get("sub_domain").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating Amplify Domain Association: %s", input)
+	_, err := conn.CreateDomainAssociation(input)
+	if err != nil {
+		return fmt.Errorf("error creating Amplify Domain Association (%s/%s): %s", appID, domainName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", appID, domainName))
+
+	if err := waitForAmplifyDomainAssociationCreation(conn, appID, domainName); err != nil {
+		return fmt.Errorf("error waiting for Amplify Domain Association (%s) to create: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_verification").(bool) {
+		if err := waitForAmplifyDomainAssociationVerification(conn, appID, domainName); err != nil {
+			return fmt.Errorf("error waiting for Amplify Domain Association (%s) to verify: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAmplifyDomainAssociationRead(d, meta)
+}
+
+func resourceAwsAmplifyDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	domainAssociation, err := getAmplifyDomainAssociation(conn, appID, domainName)
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Domain Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_id", appID)
+	d.Set("arn", domainAssociation.DomainAssociationArn)
+	d.Set("certificate_verification_dns_record", domainAssociation.CertificateVerificationDNSRecord)
+	d.Set("domain_name", domainAssociation.DomainName)
+	d.Set("enable_auto_sub_domain", domainAssociation.EnableAutoSubDomain)
+
+	if err := d.Set("sub_domain", flattenAmplifySubDomains(domainAssociation.SubDomains)); err != nil {
+		return fmt.Errorf("error setting sub_domain: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmplifyDomainAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("enable_auto_sub_domain") || d.HasChange("sub_domain") {
+		input := &amplify.UpdateDomainAssociationInput{
+			AppId:      aws.String(appID),
+			DomainName: aws.String(domainName),
+		}
+
+		if d.HasChange("enable_auto_sub_domain") {
+			input.EnableAutoSubDomain = aws.Bool(d.Get("enable_auto_sub_domain").(bool))
+		}
+
+		if d.HasChange("sub_domain") {
+			input.SubDomainSettings = expandAmplifySubDomainSettings(d.Get("sub_domain").(*schema.Set).List())
+		}
+
+		log.Printf("[DEBUG] Updating Amplify Domain Association: %s", input)
+		if _, err := conn.UpdateDomainAssociation(input); err != nil {
+			return fmt.Errorf("error updating Amplify Domain Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("wait_for_verification").(bool) {
+		if err := waitForAmplifyDomainAssociationVerification(conn, appID, domainName); err != nil {
+			return fmt.Errorf("error waiting for Amplify Domain Association (%s) to verify: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAmplifyDomainAssociationRead(d, meta)
+}
+
+func resourceAwsAmplifyDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Amplify Domain Association: %s", d.Id())
+	_, err = conn.DeleteDomainAssociation(&amplify.DeleteDomainAssociationInput{
+		AppId:      aws.String(appID),
+		DomainName: aws.String(domainName),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify Domain Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getAmplifyDomainAssociation(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	output, err := conn.GetDomainAssociation(&amplify.GetDomainAssociationInput{
+		AppId:      aws.String(appID),
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.DomainAssociation, nil
+}
+
+func amplifyDomainAssociationStatusRefreshFunc(conn *amplify.Amplify, appID, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domainAssociation, err := getAmplifyDomainAssociation(conn, appID, domainName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(domainAssociation.DomainStatus)
+		if status == amplify.DomainStatusFailed {
+			return domainAssociation, status, fmt.Errorf("%s", aws.StringValue(domainAssociation.StatusReason))
+		}
+
+		return domainAssociation, status, nil
+	}
+}
+
+// Creation is complete once Amplify has issued the certificate and
+// published the DNS records that need to be added for verification.
+func waitForAmplifyDomainAssociationCreation(conn *amplify.Amplify, appID, domainName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusCreating,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusRequestingCertificate,
+		},
+		Target: []string{
+			amplify.DomainStatusAvailable,
+			amplify.DomainStatusAwaitingAppCname,
+			amplify.DomainStatusPendingDeployment,
+			amplify.DomainStatusPendingVerification,
+		},
+		Refresh: amplifyDomainAssociationStatusRefreshFunc(conn, appID, domainName),
+		Timeout: 5 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForAmplifyDomainAssociationVerification(conn *amplify.Amplify, appID, domainName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusAwaitingAppCname,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusPendingDeployment,
+			amplify.DomainStatusPendingVerification,
+			amplify.DomainStatusUpdating,
+		},
+		Target:  []string{amplify.DomainStatusAvailable},
+		Refresh: amplifyDomainAssociationStatusRefreshFunc(conn, appID, domainName),
+		Timeout: 15 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func decodeAmplifyDomainAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected APP-ID/DOMAIN-NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// Only the configured fields identify a sub-domain; dns_record and
+// verified are reported by the service.
+func resourceAwsAmplifySubDomainHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["branch_name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["prefix"].(string)))
+	return hashcode.String(buf.String())
+}
+
+func expandAmplifySubDomainSettings(l []interface{}) []*amplify.SubDomainSetting {
+	settings := make([]*amplify.SubDomainSetting, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+
+		settings = append(settings, &amplify.SubDomainSetting{
+			BranchName: aws.String(m["branch_name"].(string)),
+			Prefix:     aws.String(m["prefix"].(string)),
+		})
+	}
+
+	return settings
+}
+
+func flattenAmplifySubDomains(subDomains []*amplify.SubDomain) []interface{} {
+	l := make([]interface{}, 0, len(subDomains))
+	for _, subDomain := range subDomains {
+		m := map[string]interface{}{
+			"dns_record": aws.StringValue(subDomain.DnsRecord),
+			"verified":   aws.BoolValue(subDomain.Verified),
+		}
+
+		if v := subDomain.SubDomainSetting; v != nil {
+			m["branch_name"] = aws.StringValue(v.BranchName)
+			m["prefix"] = aws.StringValue(v.Prefix)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyDomainAssociation_basic(t *testing.T) {
+	domainName := os.Getenv("AMPLIFY_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip("Environment variable AMPLIFY_DOMAIN_NAME is not set")
+	}
+
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_domain_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyDomainAssociationConfig_basic(rName, domainName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyDomainAssociationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:amplify:[^:]+:[^:]+:apps/.+/domains/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_sub_domain", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_verification"},
+			},
+			{
+				Config: testAccAWSAmplifyDomainAssociationConfig_update(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyDomainAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_sub_domain", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyDomainAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		appID, domainName, err := decodeAmplifyDomainAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		_, err = getAmplifyDomainAssociation(conn, appID, domainName)
+
+		return err
+	}
+}
+
+func testAccCheckAWSAmplifyDomainAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_domain_association" {
+			continue
+		}
+
+		appID, domainName, err := decodeAmplifyDomainAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getAmplifyDomainAssociation(conn, appID, domainName)
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Domain Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAmplifyDomainAssociationConfig_basic(rName, domainName string, waitForVerification bool) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+}
+
+resource "aws_amplify_domain_association" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  domain_name = %[2]q
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix = ""
+  }
+
+  wait_for_verification = %[3]t
+}
+`, rName, domainName, waitForVerification)
+}
+
+func testAccAWSAmplifyDomainAssociationConfig_update(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+}
+
+resource "aws_amplify_domain_association" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  domain_name = %[2]q
+  enable_auto_sub_domain = true
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix = ""
+  }
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix = "www"
+  }
+
+  wait_for_verification = false
+}
+`, rName, domainName)
+}
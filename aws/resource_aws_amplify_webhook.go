@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAmplifyWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyWebhookCreate,
+		Read:   resourceAwsAmplifyWebhookRead,
+		Update: resourceAwsAmplifyWebhookUpdate,
+		Delete: resourceAwsAmplifyWebhookDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAmplifyWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	input := &amplify.CreateWebhookInput{
+		AppId:      aws.String(d.Get("app_id").(string)),
+		BranchName: aws.String(d.Get("branch_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Amplify Webhook: %s", input)
+	output, err := conn.CreateWebhook(input)
+	if err != nil {
+		return fmt.Errorf("error creating Amplify Webhook: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Webhook.WebhookId))
+
+	return resourceAwsAmplifyWebhookRead(d, meta)
+}
+
+func resourceAwsAmplifyWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	output, err := conn.GetWebhook(&amplify.GetWebhookInput{
+		WebhookId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify Webhook (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Webhook (%s): %s", d.Id(), err)
+	}
+
+	webhook := output.Webhook
+	webhookArn := aws.StringValue(webhook.WebhookArn)
+
+	// The webhook does not report its app, but the ARN is of the form
+	// arn:aws:amplify:REGION:ACCOUNT:apps/APP-ID/webhooks/WEBHOOK-ID.
+	parsedArn, err := arn.Parse(webhookArn)
+	if err != nil {
+		return fmt.Errorf("error parsing Amplify Webhook ARN (%s): %s", webhookArn, err)
+	}
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) != 4 {
+		return fmt.Errorf("unexpected format for Amplify Webhook ARN resource (%s)", parsedArn.Resource)
+	}
+
+	d.Set("app_id", parts[1])
+	d.Set("arn", webhookArn)
+	d.Set("branch_name", webhook.BranchName)
+	d.Set("description", webhook.Description)
+	d.Set("url", webhook.WebhookUrl)
+
+	return nil
+}
+
+func resourceAwsAmplifyWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	input := &amplify.UpdateWebhookInput{
+		BranchName:  aws.String(d.Get("branch_name").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		WebhookId:   aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating Amplify Webhook: %s", input)
+	if _, err := conn.UpdateWebhook(input); err != nil {
+		return fmt.Errorf("error updating Amplify Webhook (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAmplifyWebhookRead(d, meta)
+}
+
+func resourceAwsAmplifyWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	log.Printf("[DEBUG] Deleting Amplify Webhook: %s", d.Id())
+	_, err := conn.DeleteWebhook(&amplify.DeleteWebhookInput{
+		WebhookId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify Webhook (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyWebhook_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_amplify_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyWebhookConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", "aws_amplify_app.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:amplify:[^:]+:[^:]+:apps/.+/webhooks/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", rName+"-first"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://webhooks.amplify.`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAmplifyWebhookConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", rName+"-second"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify Webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		_, err := conn.GetWebhook(&amplify.GetWebhookInput{
+			WebhookId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAmplifyWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_webhook" {
+			continue
+		}
+
+		_, err := conn.GetWebhook(&amplify.GetWebhookInput{
+			WebhookId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Webhook %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAmplifyWebhookConfig_basic(rName, branch string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "first" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = "%[1]s-first"
+}
+
+resource "aws_amplify_branch" "second" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = "%[1]s-second"
+}
+
+resource "aws_amplify_webhook" "test" {
+  app_id = "${aws_amplify_app.test.id}"
+  branch_name = "${aws_amplify_branch.%[2]s.branch_name}"
+  description = %[2]q
+}
+`, rName, branch)
+}
@@ -178,16 +178,93 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 										},
 									},
 									"metric_name": &schema.Schema{
-										Type:     schema.TypeString,
-										Required: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
+									},
+									"metrics": &schema.Schema{
+										Type:          schema.TypeList,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metric_name"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_stat": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimensions": {
+																			Type:     schema.TypeSet,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"value": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																		"metric_name": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"namespace": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"stat": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"unit": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"return_data": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+											},
+										},
 									},
 									"namespace": &schema.Schema{
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 									"statistic": &schema.Schema{
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 										ValidateFunc: validation.StringInSlice([]string{
 											applicationautoscaling.MetricStatisticAverage,
 											applicationautoscaling.MetricStatisticMinimum,
@@ -418,20 +495,24 @@ func expandAppautoscalingCustomizedMetricSpecification(configured []interface{})
 
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
-		if v, ok := data["metric_name"]; ok {
-			spec.MetricName = aws.String(v.(string))
+		if v, ok := data["metric_name"].(string); ok && v != "" {
+			spec.MetricName = aws.String(v)
+		}
+
+		if v, ok := data["metrics"].([]interface{}); ok && len(v) > 0 {
+			spec.Metrics = expandAppautoscalingTargetTrackingMetricDataQueries(v)
 		}
 
-		if v, ok := data["namespace"]; ok {
-			spec.Namespace = aws.String(v.(string))
+		if v, ok := data["namespace"].(string); ok && v != "" {
+			spec.Namespace = aws.String(v)
 		}
 
 		if v, ok := data["unit"].(string); ok && v != "" {
 			spec.Unit = aws.String(v)
 		}
 
-		if v, ok := data["statistic"]; ok {
-			spec.Statistic = aws.String(v.(string))
+		if v, ok := data["statistic"].(string); ok && v != "" {
+			spec.Statistic = aws.String(v)
 		}
 
 		if s, ok := data["dimensions"].(*schema.Set); ok && s.Len() > 0 {
@@ -449,6 +530,63 @@ func expandAppautoscalingCustomizedMetricSpecification(configured []interface{})
 	return spec
 }
 
+func expandAppautoscalingTargetTrackingMetricDataQueries(configured []interface{}) []*applicationautoscaling.TargetTrackingMetricDataQuery {
+	var queries []*applicationautoscaling.TargetTrackingMetricDataQuery
+
+	for _, raw := range configured {
+		data, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		query := &applicationautoscaling.TargetTrackingMetricDataQuery{
+			Id:         aws.String(data["id"].(string)),
+			ReturnData: aws.Bool(data["return_data"].(bool)),
+		}
+
+		if v, ok := data["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if v, ok := data["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := data["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			stat := v[0].(map[string]interface{})
+			query.MetricStat = &applicationautoscaling.TargetTrackingMetricStat{
+				Stat: aws.String(stat["stat"].(string)),
+			}
+
+			if v, ok := stat["unit"].(string); ok && v != "" {
+				query.MetricStat.Unit = aws.String(v)
+			}
+
+			if v, ok := stat["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				metric := v[0].(map[string]interface{})
+				query.MetricStat.Metric = &applicationautoscaling.TargetTrackingMetric{
+					MetricName: aws.String(metric["metric_name"].(string)),
+					Namespace:  aws.String(metric["namespace"].(string)),
+				}
+
+				if s, ok := metric["dimensions"].(*schema.Set); ok {
+					for _, d := range s.List() {
+						dimension := d.(map[string]interface{})
+						query.MetricStat.Metric.Dimensions = append(query.MetricStat.Metric.Dimensions, &applicationautoscaling.TargetTrackingMetricDimension{
+							Name:  aws.String(dimension["name"].(string)),
+							Value: aws.String(dimension["value"].(string)),
+						})
+					}
+				}
+			}
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
 func expandAppautoscalingPredefinedMetricSpecification(configured []interface{}) *applicationautoscaling.PredefinedMetricSpecification {
 	spec := &applicationautoscaling.PredefinedMetricSpecification{}
 
@@ -690,21 +828,71 @@ func flattenCustomizedMetricSpecification(cfg *applicationautoscaling.Customized
 	}
 
 	m := map[string]interface{}{
-		"metric_name": *cfg.MetricName,
-		"namespace":   *cfg.Namespace,
-		"statistic":   *cfg.Statistic,
+		"metric_name": aws.StringValue(cfg.MetricName),
+		"namespace":   aws.StringValue(cfg.Namespace),
+		"statistic":   aws.StringValue(cfg.Statistic),
 	}
 
 	if len(cfg.Dimensions) > 0 {
 		m["dimensions"] = flattenMetricDimensions(cfg.Dimensions)
 	}
 
+	if len(cfg.Metrics) > 0 {
+		m["metrics"] = flattenAppautoscalingTargetTrackingMetricDataQueries(cfg.Metrics)
+	}
+
 	if cfg.Unit != nil {
 		m["unit"] = *cfg.Unit
 	}
 	return []interface{}{m}
 }
 
+func flattenAppautoscalingTargetTrackingMetricDataQueries(queries []*applicationautoscaling.TargetTrackingMetricDataQuery) []interface{} {
+	var l []interface{}
+
+	for _, query := range queries {
+		if query == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"expression":  aws.StringValue(query.Expression),
+			"id":          aws.StringValue(query.Id),
+			"label":       aws.StringValue(query.Label),
+			"return_data": query.ReturnData == nil || aws.BoolValue(query.ReturnData),
+		}
+
+		if stat := query.MetricStat; stat != nil {
+			tfStat := map[string]interface{}{
+				"stat": aws.StringValue(stat.Stat),
+				"unit": aws.StringValue(stat.Unit),
+			}
+
+			if metric := stat.Metric; metric != nil {
+				var dimensions []interface{}
+				for _, d := range metric.Dimensions {
+					dimensions = append(dimensions, map[string]interface{}{
+						"name":  aws.StringValue(d.Name),
+						"value": aws.StringValue(d.Value),
+					})
+				}
+
+				tfStat["metric"] = []interface{}{map[string]interface{}{
+					"dimensions":  dimensions,
+					"metric_name": aws.StringValue(metric.MetricName),
+					"namespace":   aws.StringValue(metric.Namespace),
+				}}
+			}
+
+			m["metric_stat"] = []interface{}{tfStat}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
 func flattenMetricDimensions(ds []*applicationautoscaling.MetricDimension) []interface{} {
 	l := make([]interface{}, len(ds), len(ds))
 	for i, d := range ds {
@@ -122,6 +122,32 @@ func TestAccAWSAppautoScalingPolicy_dynamoDb(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoScalingPolicy_metricMath(t *testing.T) {
+	var policy applicationautoscaling.ScalingPolicy
+
+	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", acctest.RandString(5))
+	resourceName := "aws_appautoscaling_policy.dynamo_test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppautoscalingPolicyMetricMath(randPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.0.id", "consumed"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.0.return_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.2.expression", "100 * consumed / (provisioned * 60)"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.2.return_data", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAppautoScalingPolicy_multiplePoliciesSameName(t *testing.T) {
 	var readPolicy1 applicationautoscaling.ScalingPolicy
 	var readPolicy2 applicationautoscaling.ScalingPolicy
@@ -462,6 +488,88 @@ resource "aws_appautoscaling_policy" "dynamo_test" {
 `, randPolicyName, randPolicyName)
 }
 
+func testAccAWSAppautoscalingPolicyMetricMath(randPolicyName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "dynamodb_table_test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "dynamo_test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.dynamodb_table_test.name}"
+  scalable_dimension = "dynamodb:table:WriteCapacityUnits"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+
+resource "aws_appautoscaling_policy" "dynamo_test" {
+  name               = %[1]q
+  policy_type        = "TargetTrackingScaling"
+  service_namespace  = "${aws_appautoscaling_target.dynamo_test.service_namespace}"
+  resource_id        = "${aws_appautoscaling_target.dynamo_test.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.dynamo_test.scalable_dimension}"
+
+  target_tracking_scaling_policy_configuration {
+    customized_metric_specification {
+      metrics {
+        id          = "consumed"
+        return_data = false
+
+        metric_stat {
+          metric {
+            dimensions {
+              name  = "TableName"
+              value = "${aws_dynamodb_table.dynamodb_table_test.name}"
+            }
+
+            metric_name = "ConsumedWriteCapacityUnits"
+            namespace   = "AWS/DynamoDB"
+          }
+
+          stat = "Sum"
+        }
+      }
+
+      metrics {
+        id          = "provisioned"
+        return_data = false
+
+        metric_stat {
+          metric {
+            dimensions {
+              name  = "TableName"
+              value = "${aws_dynamodb_table.dynamodb_table_test.name}"
+            }
+
+            metric_name = "ProvisionedWriteCapacityUnits"
+            namespace   = "AWS/DynamoDB"
+          }
+
+          stat = "Average"
+        }
+      }
+
+      metrics {
+        id         = "utilization"
+        expression = "100 * consumed / (provisioned * 60)"
+        label      = "Write capacity utilization"
+      }
+    }
+
+    target_value = 70
+  }
+}
+`, randPolicyName)
+}
+
 func testAccAWSAppautoscalingPolicy_multiplePoliciesSameName(tableName1, tableName2, namePrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "dynamodb_table_test1" {
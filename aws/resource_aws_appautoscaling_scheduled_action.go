@@ -75,6 +75,12 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"timezone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -108,6 +114,9 @@ func resourceAwsAppautoscalingScheduledActionPut(d *schema.ResourceData, meta in
 		}
 		input.ScalableTargetAction = sta
 	}
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("start_time"); ok {
 		t, err := time.Parse(awsAppautoscalingScheduleTimeLayout, v.(string))
 		if err != nil {
@@ -166,6 +175,7 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 		return fmt.Errorf("Scheduled Action (%s) not found", saName)
 	}
 	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+	d.Set("timezone", resp.ScheduledActions[0].Timezone)
 	return nil
 }
 
@@ -29,6 +29,26 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoscalingScheduledAction_Timezone(t *testing.T) {
+	resourceName := "aws_appautoscaling_scheduled_action.hoge"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppautoscalingScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppautoscalingScheduledActionConfig_Timezone(acctest.RandString(5), "Pacific/Tahiti"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppautoscalingScheduledActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 8 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Pacific/Tahiti"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAppautoscalingScheduledAction_ECS(t *testing.T) {
 	ts := time.Now().AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
 	resource.Test(t, resource.TestCase{
@@ -150,6 +170,44 @@ resource "aws_appautoscaling_scheduled_action" "hoge" {
 `, rName, rName, ts)
 }
 
+func testAccAppautoscalingScheduledActionConfig_Timezone(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "hoge" {
+  name           = "tf-ddb-%[1]s"
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "UserID"
+
+  attribute {
+    name = "UserID"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "read" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.hoge.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+
+resource "aws_appautoscaling_scheduled_action" "hoge" {
+  name               = "tf-appauto-%[1]s"
+  service_namespace  = "${aws_appautoscaling_target.read.service_namespace}"
+  resource_id        = "${aws_appautoscaling_target.read.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.read.scalable_dimension}"
+  schedule           = "cron(0 8 * * ? *)"
+  timezone           = %[2]q
+
+  scalable_target_action {
+    min_capacity = 1
+    max_capacity = 10
+  }
+}
+`, rName, timezone)
+}
+
 func testAccAppautoscalingScheduledActionConfig_ECS(rName, ts string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "hoge" {
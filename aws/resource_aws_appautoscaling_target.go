@@ -49,6 +49,31 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"suspended_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_scaling_in_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dynamic_scaling_out_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scheduled_scaling_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -68,6 +93,10 @@ func resourceAwsAppautoscalingTargetPut(d *schema.ResourceData, meta interface{}
 		targetOpts.RoleARN = aws.String(roleArn.(string))
 	}
 
+	if v, ok := d.GetOk("suspended_state"); ok {
+		targetOpts.SuspendedState = expandAppautoscalingTargetSuspendedState(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Application autoscaling target create configuration %s", targetOpts)
 	var err error
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -115,6 +144,10 @@ func resourceAwsAppautoscalingTargetRead(d *schema.ResourceData, meta interface{
 	d.Set("scalable_dimension", t.ScalableDimension)
 	d.Set("service_namespace", t.ServiceNamespace)
 
+	if err := d.Set("suspended_state", flattenAppautoscalingTargetSuspendedState(t.SuspendedState)); err != nil {
+		return fmt.Errorf("error setting suspended_state: %s", err)
+	}
+
 	return nil
 }
 
@@ -191,3 +224,31 @@ func getAwsAppautoscalingTarget(resourceId, namespace, dimension string,
 
 	return nil, nil
 }
+
+func expandAppautoscalingTargetSuspendedState(configured []interface{}) *applicationautoscaling.SuspendedState {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	return &applicationautoscaling.SuspendedState{
+		DynamicScalingInSuspended:  aws.Bool(data["dynamic_scaling_in_suspended"].(bool)),
+		DynamicScalingOutSuspended: aws.Bool(data["dynamic_scaling_out_suspended"].(bool)),
+		ScheduledScalingSuspended:  aws.Bool(data["scheduled_scaling_suspended"].(bool)),
+	}
+}
+
+func flattenAppautoscalingTargetSuspendedState(state *applicationautoscaling.SuspendedState) []interface{} {
+	if state == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"dynamic_scaling_in_suspended":  aws.BoolValue(state.DynamicScalingInSuspended),
+		"dynamic_scaling_out_suspended": aws.BoolValue(state.DynamicScalingOutSuspended),
+		"scheduled_scaling_suspended":   aws.BoolValue(state.ScheduledScalingSuspended),
+	}
+
+	return []interface{}{m}
+}
@@ -147,6 +147,39 @@ func TestAccAWSAppautoScalingTarget_optionalRoleArn(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoScalingTarget_suspendedState(t *testing.T) {
+	var readTarget applicationautoscaling.ScalableTarget
+
+	rInt := acctest.RandInt()
+	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppautoscalingTarget_suspendedState(tableName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingTargetExists("aws_appautoscaling_target.read", &readTarget),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.#", "1"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.0.dynamic_scaling_in_suspended", "true"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.0.dynamic_scaling_out_suspended", "false"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.0.scheduled_scaling_suspended", "false"),
+				),
+			},
+			{
+				Config: testAccAWSAppautoscalingTarget_suspendedState(tableName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingTargetExists("aws_appautoscaling_target.read", &readTarget),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.0.dynamic_scaling_in_suspended", "false"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.read", "suspended_state.0.scheduled_scaling_suspended", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
 
@@ -710,3 +743,32 @@ resource "aws_appautoscaling_target" "read" {
 }
 `, tableName)
 }
+
+func testAccAWSAppautoscalingTarget_suspendedState(tableName string, dynamicScalingInSuspended, scheduledScalingSuspended bool) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "dynamodb_table_test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "read" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.dynamodb_table_test.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 2
+  max_capacity       = 15
+
+  suspended_state {
+    dynamic_scaling_in_suspended = %[2]t
+    scheduled_scaling_suspended  = %[3]t
+  }
+}
+`, tableName, dynamicScalingInSuspended, scheduledScalingSuspended)
+}
@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncApiKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncApiKeyCreate,
+		Read:   resourceAwsAppsyncApiKeyRead,
+		Update: resourceAwsAppsyncApiKeyUpdate,
+		Delete: resourceAwsAppsyncApiKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"expires": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				// AppSync rounds expiry down to the hour, so only a change
+				// of the effective hour is a real difference.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					o, err := time.Parse(time.RFC3339, old)
+					if err != nil {
+						return false
+					}
+					n, err := time.Parse(time.RFC3339, new)
+					if err != nil {
+						return false
+					}
+					return o.Equal(n.Truncate(time.Hour))
+				},
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncApiKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID := d.Get("api_id").(string)
+	input := &appsync.CreateApiKeyInput{
+		ApiId:       aws.String(apiID),
+		Description: aws.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.Expires = aws.Int64(t.Unix())
+	}
+
+	log.Printf("[DEBUG] Creating Appsync API Key: %s", input)
+	resp, err := conn.CreateApiKey(input)
+	if err != nil {
+		return fmt.Errorf("error creating Appsync API Key: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", apiID, aws.StringValue(resp.ApiKey.Id)))
+
+	return resourceAwsAppsyncApiKeyRead(d, meta)
+}
+
+func resourceAwsAppsyncApiKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, keyID, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// There is no API to describe a single key.
+	var key *appsync.ApiKey
+	err = conn.ListApiKeysPages(&appsync.ListApiKeysInput{
+		ApiId: aws.String(apiID),
+	}, func(page *appsync.ListApiKeysOutput, lastPage bool) bool {
+		for _, k := range page.ApiKeys {
+			if aws.StringValue(k.Id) == keyID {
+				key = k
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Appsync API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Appsync API Key (%s): %s", d.Id(), err)
+	}
+
+	// Expired keys are purged by AppSync; the next apply issues a new one.
+	if key == nil {
+		log.Printf("[WARN] Appsync API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("api_id", apiID)
+	d.Set("description", key.Description)
+	d.Set("expires", time.Unix(aws.Int64Value(key.Expires), 0).UTC().Format(time.RFC3339))
+	d.Set("key", key.Id)
+
+	return nil
+}
+
+func resourceAwsAppsyncApiKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, keyID, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Extending the expiry keeps the key value, so clients do not need
+	// to be reconfigured.
+	input := &appsync.UpdateApiKeyInput{
+		ApiId:       aws.String(apiID),
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(keyID),
+	}
+
+	if d.HasChange("expires") {
+		t, _ := time.Parse(time.RFC3339, d.Get("expires").(string))
+		input.Expires = aws.Int64(t.Unix())
+	}
+
+	log.Printf("[DEBUG] Updating Appsync API Key: %s", input)
+	if _, err := conn.UpdateApiKey(input); err != nil {
+		return fmt.Errorf("error updating Appsync API Key (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncApiKeyRead(d, meta)
+}
+
+func resourceAwsAppsyncApiKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, keyID, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync API Key: %s", d.Id())
+	_, err = conn.DeleteApiKey(&appsync.DeleteApiKeyInput{
+		ApiId: aws.String(apiID),
+		Id:    aws.String(keyID),
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Appsync API Key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// Key IDs contain a hyphen, so a colon separates them from the API ID.
+func decodeAppsyncApiKeyID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID:API-KEY-ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncApiKey_basic(t *testing.T) {
+	resourceName := "aws_appsync_api_key.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncApiKey_expires(t *testing.T) {
+	resourceName := "aws_appsync_api_key.test"
+	rName := acctest.RandString(5)
+
+	// Expiry is rounded down to the hour, so the configured values are
+	// deliberately not hour-aligned.
+	expires1 := time.Now().UTC().Add(48*time.Hour + 30*time.Minute)
+	expires2 := time.Now().UTC().Add(96*time.Hour + 30*time.Minute)
+	var key1, key2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_expires(rName, expires1.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName),
+					testAccCheckAwsAppsyncApiKeyValue(resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "expires", expires1.Truncate(time.Hour).Format(time.RFC3339)),
+				),
+			},
+			{
+				Config: testAccAppsyncApiKeyConfig_expires(rName, expires2.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName),
+					testAccCheckAwsAppsyncApiKeyValue(resourceName, &key2),
+					resource.TestCheckResourceAttr(resourceName, "expires", expires2.Truncate(time.Hour).Format(time.RFC3339)),
+					func(s *terraform.State) error {
+						if key1 != key2 {
+							return fmt.Errorf("API key was replaced when extending its expiry")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncApiKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_api_key" {
+			continue
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListApiKeys(&appsync.ListApiKeysInput{
+			ApiId: aws.String(apiID),
+		})
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, key := range resp.ApiKeys {
+			if aws.StringValue(key.Id) == keyID {
+				return fmt.Errorf("Appsync API Key %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncApiKeyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		resp, err := conn.ListApiKeys(&appsync.ListApiKeysInput{
+			ApiId: aws.String(apiID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range resp.ApiKeys {
+			if aws.StringValue(key.Id) == keyID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Appsync API Key %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAwsAppsyncApiKeyValue(name string, key *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*key = rs.Primary.Attributes["key"]
+
+		return nil
+	}
+}
+
+func testAccAppsyncApiKeyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+}
+`, rName)
+}
+
+func testAccAppsyncApiKeyConfig_expires(rName, expires string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  expires = %q
+}
+`, rName, expires)
+}
@@ -43,6 +43,8 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 					appsync.DataSourceTypeAmazonDynamodb,
 					appsync.DataSourceTypeAmazonElasticsearch,
 					appsync.DataSourceTypeNone,
+					appsync.DataSourceTypeHttp,
+					appsync.DataSourceTypeRelationalDatabase,
 				}, true),
 				StateFunc: func(v interface{}) string {
 					return strings.ToUpper(v.(string))
@@ -72,7 +74,7 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"elasticsearch_config", "lambda_config"},
+				ConflictsWith: []string{"elasticsearch_config", "http_config", "lambda_config", "relational_database_config"},
 			},
 			"elasticsearch_config": {
 				Type:     schema.TypeList,
@@ -90,7 +92,53 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "lambda_config"},
+				ConflictsWith: []string{"dynamodb_config", "http_config", "lambda_config", "relational_database_config"},
+			},
+			"http_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      appsync.AuthorizationTypeAwsIam,
+										ValidateFunc: validation.StringInSlice(appsync.AuthorizationType_Values(), false),
+									},
+									"aws_iam_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"signing_region": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"signing_service_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "lambda_config", "relational_database_config"},
 			},
 			"lambda_config": {
 				Type:     schema.TypeList,
@@ -104,7 +152,53 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "relational_database_config"},
+			},
+			"relational_database_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_secret_store_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"db_cluster_identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      appsync.RelationalDatabaseSourceTypeRdsHttpEndpoint,
+							ValidateFunc: validation.StringInSlice(appsync.RelationalDatabaseSourceType_Values(), false),
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "lambda_config"},
 			},
 			"service_role_arn": {
 				Type:     schema.TypeString,
@@ -147,6 +241,14 @@ func resourceAwsAppsyncDatasourceCreate(d *schema.ResourceData, meta interface{}
 	if len(lambdaconfig) > 0 {
 		input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(lambdaconfig[0].(map[string]interface{}))
 	}
+	httpconfig := d.Get("http_config").([]interface{})
+	if len(httpconfig) > 0 {
+		input.HttpConfig = expandAppsyncHttpDataSourceConfig(httpconfig[0].(map[string]interface{}))
+	}
+	rdsconfig := d.Get("relational_database_config").([]interface{})
+	if len(rdsconfig) > 0 {
+		input.RelationalDatabaseConfig = expandAppsyncRelationalDatabaseDataSourceConfig(rdsconfig[0].(map[string]interface{}))
+	}
 
 	resp, err := conn.CreateDataSource(input)
 	if err != nil {
@@ -180,7 +282,9 @@ func resourceAwsAppsyncDatasourceRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", resp.DataSource.Description)
 	d.Set("dynamodb_config", flattenAppsyncDynamodbDataSourceConfig(resp.DataSource.DynamodbConfig))
 	d.Set("elasticsearch_config", flattenAppsyncElasticsearchDataSourceConfig(resp.DataSource.ElasticsearchConfig))
+	d.Set("http_config", flattenAppsyncHttpDataSourceConfig(resp.DataSource.HttpConfig))
 	d.Set("lambda_config", flattenAppsyncLambdaDataSourceConfig(resp.DataSource.LambdaConfig))
+	d.Set("relational_database_config", flattenAppsyncRelationalDatabaseDataSourceConfig(resp.DataSource.RelationalDatabaseConfig))
 	d.Set("name", resp.DataSource.Name)
 	d.Set("service_role_arn", resp.DataSource.ServiceRoleArn)
 	d.Set("type", resp.DataSource.Type)
@@ -194,6 +298,7 @@ func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}
 	input := &appsync.UpdateDataSourceInput{
 		ApiId: aws.String(d.Get("api_id").(string)),
 		Name:  aws.String(d.Get("name").(string)),
+		Type:  aws.String(d.Get("type").(string)),
 	}
 
 	if d.HasChange("description") {
@@ -202,9 +307,6 @@ func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("service_role_arn") {
 		input.ServiceRoleArn = aws.String(d.Get("service_role_arn").(string))
 	}
-	if d.HasChange("type") {
-		input.Type = aws.String(d.Get("type").(string))
-	}
 
 	if d.HasChange("dynamodb_config") {
 		ddbconfig := d.Get("dynamodb_config").([]interface{})
@@ -224,6 +326,18 @@ func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}
 			input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(lambdaconfig[0].(map[string]interface{}))
 		}
 	}
+	if d.HasChange("http_config") {
+		httpconfig := d.Get("http_config").([]interface{})
+		if len(httpconfig) > 0 {
+			input.HttpConfig = expandAppsyncHttpDataSourceConfig(httpconfig[0].(map[string]interface{}))
+		}
+	}
+	if d.HasChange("relational_database_config") {
+		rdsconfig := d.Get("relational_database_config").([]interface{})
+		if len(rdsconfig) > 0 {
+			input.RelationalDatabaseConfig = expandAppsyncRelationalDatabaseDataSourceConfig(rdsconfig[0].(map[string]interface{}))
+		}
+	}
 
 	_, err := conn.UpdateDataSource(input)
 	if err != nil {
@@ -321,3 +435,99 @@ func flattenAppsyncLambdaDataSourceConfig(config *appsync.LambdaDataSourceConfig
 
 	return []map[string]interface{}{result}
 }
+
+func expandAppsyncHttpDataSourceConfig(configured map[string]interface{}) *appsync.HttpDataSourceConfig {
+	result := &appsync.HttpDataSourceConfig{
+		Endpoint: aws.String(configured["endpoint"].(string)),
+	}
+
+	if v, ok := configured["authorization_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ac := v[0].(map[string]interface{})
+		result.AuthorizationConfig = &appsync.AuthorizationConfig{
+			AuthorizationType: aws.String(ac["authorization_type"].(string)),
+		}
+
+		if v, ok := ac["aws_iam_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			iam := v[0].(map[string]interface{})
+			result.AuthorizationConfig.AwsIamConfig = &appsync.AwsIamConfig{}
+
+			if v, ok := iam["signing_region"].(string); ok && v != "" {
+				result.AuthorizationConfig.AwsIamConfig.SigningRegion = aws.String(v)
+			}
+			if v, ok := iam["signing_service_name"].(string); ok && v != "" {
+				result.AuthorizationConfig.AwsIamConfig.SigningServiceName = aws.String(v)
+			}
+		}
+	}
+
+	return result
+}
+
+func flattenAppsyncHttpDataSourceConfig(config *appsync.HttpDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+
+	result["endpoint"] = aws.StringValue(config.Endpoint)
+	if ac := config.AuthorizationConfig; ac != nil {
+		authorizationConfig := map[string]interface{}{
+			"authorization_type": aws.StringValue(ac.AuthorizationType),
+		}
+		if iam := ac.AwsIamConfig; iam != nil {
+			authorizationConfig["aws_iam_config"] = []interface{}{map[string]interface{}{
+				"signing_region":       aws.StringValue(iam.SigningRegion),
+				"signing_service_name": aws.StringValue(iam.SigningServiceName),
+			}}
+		}
+		result["authorization_config"] = []interface{}{authorizationConfig}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandAppsyncRelationalDatabaseDataSourceConfig(configured map[string]interface{}) *appsync.RelationalDatabaseDataSourceConfig {
+	result := &appsync.RelationalDatabaseDataSourceConfig{
+		RelationalDatabaseSourceType: aws.String(configured["source_type"].(string)),
+	}
+
+	if v, ok := configured["http_endpoint_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		hec := v[0].(map[string]interface{})
+		result.RdsHttpEndpointConfig = &appsync.RdsHttpEndpointConfig{
+			AwsRegion:           aws.String(hec["region"].(string)),
+			AwsSecretStoreArn:   aws.String(hec["aws_secret_store_arn"].(string)),
+			DbClusterIdentifier: aws.String(hec["db_cluster_identifier"].(string)),
+		}
+
+		if v, ok := hec["database_name"].(string); ok && v != "" {
+			result.RdsHttpEndpointConfig.DatabaseName = aws.String(v)
+		}
+		if v, ok := hec["schema"].(string); ok && v != "" {
+			result.RdsHttpEndpointConfig.Schema = aws.String(v)
+		}
+	}
+
+	return result
+}
+
+func flattenAppsyncRelationalDatabaseDataSourceConfig(config *appsync.RelationalDatabaseDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+
+	result["source_type"] = aws.StringValue(config.RelationalDatabaseSourceType)
+	if hec := config.RdsHttpEndpointConfig; hec != nil {
+		result["http_endpoint_config"] = []interface{}{map[string]interface{}{
+			"aws_secret_store_arn":  aws.StringValue(hec.AwsSecretStoreArn),
+			"database_name":         aws.StringValue(hec.DatabaseName),
+			"db_cluster_identifier": aws.StringValue(hec.DbClusterIdentifier),
+			"region":                aws.StringValue(hec.AwsRegion),
+			"schema":                aws.StringValue(hec.Schema),
+		}}
+	}
+
+	return []map[string]interface{}{result}
+}
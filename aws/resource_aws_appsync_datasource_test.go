@@ -62,6 +62,26 @@ func TestAccAwsAppsyncDatasource_lambda(t *testing.T) {
 	})
 }
 
+func TestAccAwsAppsyncDatasource_http(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_http(acctest.RandString(5)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists("aws_appsync_datasource.test"),
+					resource.TestCheckResourceAttrSet("aws_appsync_datasource.test", "arn"),
+					resource.TestCheckResourceAttr("aws_appsync_datasource.test", "type", "HTTP"),
+					resource.TestCheckResourceAttr("aws_appsync_datasource.test", "http_config.#", "1"),
+					resource.TestCheckResourceAttr("aws_appsync_datasource.test", "http_config.0.endpoint", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsAppsyncDatasource_update(t *testing.T) {
 	rName := acctest.RandString(5)
 	resource.Test(t, resource.TestCase{
@@ -441,3 +461,21 @@ resource "aws_appsync_datasource" "test" {
 }
 `, rName, rName, rName, rName, rName, rName)
 }
+
+func testAccAppsyncDatasourceConfig_http(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  name = "tf_appsync_%s"
+  type = "HTTP"
+  http_config {
+    endpoint = "https://example.com"
+  }
+}
+`, rName, rName)
+}
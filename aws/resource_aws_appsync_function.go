@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncFunction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncFunctionCreate,
+		Read:   resourceAwsAppsyncFunctionRead,
+		Update: resourceAwsAppsyncFunctionUpdate,
+		Delete: resourceAwsAppsyncFunctionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"function_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"2018-05-29"}, false),
+			},
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"request_mapping_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"response_mapping_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime": appsyncRuntimeSchema(),
+		},
+	}
+}
+
+func resourceAwsAppsyncFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID := d.Get("api_id").(string)
+	input := &appsync.CreateFunctionInput{
+		ApiId:          aws.String(apiID),
+		DataSourceName: aws.String(d.Get("data_source").(string)),
+		Name:           aws.String(d.Get("name").(string)),
+		Runtime:        expandAppsyncRuntime(d.Get("runtime").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("function_version"); ok {
+		input.FunctionVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_batch_size"); ok {
+		input.MaxBatchSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("response_mapping_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Appsync Function: %s", input)
+	resp, err := conn.CreateFunction(input)
+	if err != nil {
+		return fmt.Errorf("error creating Appsync Function: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", apiID, aws.StringValue(resp.FunctionConfiguration.FunctionId)))
+
+	return resourceAwsAppsyncFunctionRead(d, meta)
+}
+
+func resourceAwsAppsyncFunctionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetFunction(&appsync.GetFunctionInput{
+		ApiId:      aws.String(apiID),
+		FunctionId: aws.String(functionID),
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Appsync Function (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Appsync Function (%s): %s", d.Id(), err)
+	}
+
+	function := resp.FunctionConfiguration
+
+	d.Set("api_id", apiID)
+	d.Set("arn", function.FunctionArn)
+	d.Set("code", function.Code)
+	d.Set("data_source", function.DataSourceName)
+	d.Set("description", function.Description)
+	d.Set("function_id", function.FunctionId)
+	d.Set("function_version", function.FunctionVersion)
+	d.Set("max_batch_size", function.MaxBatchSize)
+	d.Set("name", function.Name)
+	d.Set("request_mapping_template", function.RequestMappingTemplate)
+	d.Set("response_mapping_template", function.ResponseMappingTemplate)
+
+	if err := d.Set("runtime", flattenAppsyncRuntime(function.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateFunctionInput{
+		ApiId:          aws.String(apiID),
+		DataSourceName: aws.String(d.Get("data_source").(string)),
+		FunctionId:     aws.String(functionID),
+		Name:           aws.String(d.Get("name").(string)),
+		Runtime:        expandAppsyncRuntime(d.Get("runtime").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("function_version"); ok {
+		input.FunctionVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_batch_size"); ok {
+		input.MaxBatchSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("response_mapping_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Appsync Function: %s", input)
+	if _, err := conn.UpdateFunction(input); err != nil {
+		return fmt.Errorf("error updating Appsync Function (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncFunctionRead(d, meta)
+}
+
+func resourceAwsAppsyncFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync Function: %s", d.Id())
+	_, err = conn.DeleteFunction(&appsync.DeleteFunctionInput{
+		ApiId:      aws.String(apiID),
+		FunctionId: aws.String(functionID),
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Appsync Function (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppsyncFunctionID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID-FUNCTION-ID", id)
+	}
+	return parts[0], parts[1], nil
+}
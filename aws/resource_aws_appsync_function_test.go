@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncFunction_basic(t *testing.T) {
+	resourceName := "aws_appsync_function.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncFunctionConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncFunctionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2018-05-29"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf_appsync_"+rName),
+				),
+			},
+			{
+				Config: testAccAppsyncFunctionConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncFunctionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncFunction_jsRuntime(t *testing.T) {
+	resourceName := "aws_appsync_function.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncFunctionConfig_jsRuntime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncFunctionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "code"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncFunctionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_function" {
+			continue
+		}
+
+		apiID, functionID, err := decodeAppsyncFunctionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetFunction(&appsync.GetFunctionInput{
+			ApiId:      aws.String(apiID),
+			FunctionId: aws.String(functionID),
+		})
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Appsync Function %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncFunctionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, functionID, err := decodeAppsyncFunctionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		_, err = conn.GetFunction(&appsync.GetFunctionInput{
+			ApiId:      aws.String(apiID),
+			FunctionId: aws.String(functionID),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncFunctionConfig_basic(rName, description string) string {
+	return testAccAppsyncResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_function" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  data_source = "${aws_appsync_datasource.test.name}"
+  description = %[2]q
+  name = "tf_appsync_%[1]s"
+  function_version = "2018-05-29"
+
+  request_mapping_template = <<EOF
+{
+  "version": "2018-05-29",
+  "method": "GET",
+  "resourcePath": "/"
+}
+EOF
+
+  response_mapping_template = "$util.toJson($ctx.result)"
+}
+`, rName, description)
+}
+
+func testAccAppsyncFunctionConfig_jsRuntime(rName string) string {
+	return testAccAppsyncResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_function" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  data_source = "${aws_appsync_datasource.test.name}"
+  name = "tf_appsync_%[1]s"
+
+  code = <<EOF
+export function request(ctx) {
+  return { method: "GET", resourcePath: "/" };
+}
+
+export function response(ctx) {
+  return ctx.result;
+}
+EOF
+
+  runtime {
+    name = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`, rName)
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -23,14 +25,72 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"additional_authentication_provider": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
+						},
+						"lambda_authorizer_config": appsyncLambdaAuthorizerConfigSchema(),
+						"openid_connect_config":    appsyncOpenIDConnectConfigSchema(),
+						"user_pool_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_id_client_regex": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"aws_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"user_pool_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"authentication_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					appsync.AuthenticationTypeApiKey,
-					appsync.AuthenticationTypeAwsIam,
-					appsync.AuthenticationTypeAmazonCognitoUserPools,
-				}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
+			},
+			"lambda_authorizer_config": appsyncLambdaAuthorizerConfigSchema(),
+			"log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"exclude_verbose_content": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"field_log_level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.FieldLogLevel_Values(), false),
+						},
+					},
+				},
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -43,6 +103,11 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 					return
 				},
 			},
+			"openid_connect_config": appsyncOpenIDConnectConfigSchema(),
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"user_pool_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -76,6 +141,70 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags": tagsSchema(),
+			"uris": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"xray_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func appsyncLambdaAuthorizerConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"authorizer_result_ttl_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      300,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+				"authorizer_uri": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"identity_validation_expression": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func appsyncOpenIDConnectConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_ttl": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"client_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"iat_ttl": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"issuer": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
 		},
 	}
 }
@@ -84,22 +213,37 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).appsyncconn
 
 	input := &appsync.CreateGraphqlApiInput{
-		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
-		Name:               aws.String(d.Get("name").(string)),
+		AdditionalAuthenticationProviders: expandAppsyncGraphqlApiAdditionalAuthProviders(d.Get("additional_authentication_provider").([]interface{})),
+		AuthenticationType:                aws.String(d.Get("authentication_type").(string)),
+		LambdaAuthorizerConfig:            expandAppsyncGraphqlApiLambdaAuthorizerConfig(d.Get("lambda_authorizer_config").([]interface{})),
+		LogConfig:                         expandAppsyncGraphqlApiLogConfig(d.Get("log_config").([]interface{})),
+		Name:                              aws.String(d.Get("name").(string)),
+		OpenIDConnectConfig:               expandAppsyncGraphqlApiOpenIDConnectConfig(d.Get("openid_connect_config").([]interface{})),
+		XrayEnabled:                       aws.Bool(d.Get("xray_enabled").(bool)),
 	}
 
 	if v, ok := d.GetOk("user_pool_config"); ok {
 		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
 	resp, err := conn.CreateGraphqlApi(input)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(*resp.GraphqlApi.ApiId)
-	d.Set("arn", resp.GraphqlApi.Arn)
-	return nil
+
+	if v, ok := d.GetOk("schema"); ok {
+		if err := resourceAwsAppsyncSchemaPut(conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppsyncGraphqlApiRead(d, meta)
 }
 
 func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{}) error {
@@ -119,26 +263,59 @@ func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	d.Set("authentication_type", resp.GraphqlApi.AuthenticationType)
-	d.Set("name", resp.GraphqlApi.Name)
-	d.Set("user_pool_config", flattenAppsyncGraphqlApiUserPoolConfig(resp.GraphqlApi.UserPoolConfig))
-	d.Set("arn", resp.GraphqlApi.Arn)
+	api := resp.GraphqlApi
+
+	d.Set("arn", api.Arn)
+	d.Set("authentication_type", api.AuthenticationType)
+	d.Set("name", api.Name)
+	d.Set("xray_enabled", api.XrayEnabled)
+
+	if err := d.Set("additional_authentication_provider", flattenAppsyncGraphqlApiAdditionalAuthProviders(api.AdditionalAuthenticationProviders)); err != nil {
+		return fmt.Errorf("error setting additional_authentication_provider: %s", err)
+	}
+
+	if err := d.Set("lambda_authorizer_config", flattenAppsyncGraphqlApiLambdaAuthorizerConfig(api.LambdaAuthorizerConfig)); err != nil {
+		return fmt.Errorf("error setting lambda_authorizer_config: %s", err)
+	}
+
+	if err := d.Set("log_config", flattenAppsyncGraphqlApiLogConfig(api.LogConfig)); err != nil {
+		return fmt.Errorf("error setting log_config: %s", err)
+	}
+
+	if err := d.Set("openid_connect_config", flattenAppsyncGraphqlApiOpenIDConnectConfig(api.OpenIDConnectConfig)); err != nil {
+		return fmt.Errorf("error setting openid_connect_config: %s", err)
+	}
+
+	if err := d.Set("user_pool_config", flattenAppsyncGraphqlApiUserPoolConfig(api.UserPoolConfig)); err != nil {
+		return fmt.Errorf("error setting user_pool_config: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(api.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("uris", aws.StringValueMap(api.Uris)); err != nil {
+		return fmt.Errorf("error setting uris: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appsyncconn
 
+	// UpdateGraphqlApi replaces the whole configuration, so every
+	// setting is sent regardless of what changed.
 	input := &appsync.UpdateGraphqlApiInput{
-		ApiId: aws.String(d.Id()),
-		Name:  aws.String(d.Get("name").(string)),
-	}
-
-	if d.HasChange("authentication_type") {
-		input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
-	}
-	if d.HasChange("user_pool_config") {
-		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(d.Get("user_pool_config").([]interface{}))
+		AdditionalAuthenticationProviders: expandAppsyncGraphqlApiAdditionalAuthProviders(d.Get("additional_authentication_provider").([]interface{})),
+		ApiId:                             aws.String(d.Id()),
+		AuthenticationType:                aws.String(d.Get("authentication_type").(string)),
+		LambdaAuthorizerConfig:            expandAppsyncGraphqlApiLambdaAuthorizerConfig(d.Get("lambda_authorizer_config").([]interface{})),
+		LogConfig:                         expandAppsyncGraphqlApiLogConfig(d.Get("log_config").([]interface{})),
+		Name:                              aws.String(d.Get("name").(string)),
+		OpenIDConnectConfig:               expandAppsyncGraphqlApiOpenIDConnectConfig(d.Get("openid_connect_config").([]interface{})),
+		UserPoolConfig:                    expandAppsyncGraphqlApiUserPoolConfig(d.Get("user_pool_config").([]interface{})),
+		XrayEnabled:                       aws.Bool(d.Get("xray_enabled").(bool)),
 	}
 
 	_, err := conn.UpdateGraphqlApi(input)
@@ -146,6 +323,18 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	if d.HasChange("schema") {
+		if v, ok := d.GetOk("schema"); ok {
+			if err := resourceAwsAppsyncSchemaPut(conn, d.Id(), v.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := setTagsAppsync(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Appsync Graphql API (%s) tags: %s", d.Id(), err)
+	}
+
 	return resourceAwsAppsyncGraphqlApiRead(d, meta)
 }
 
@@ -166,8 +355,49 @@ func resourceAwsAppsyncGraphqlApiDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// Schema creation is asynchronous; wait for it to settle so that
+// resolvers created afterwards can reference the new types and fields.
+func resourceAwsAppsyncSchemaPut(conn *appsync.AppSync, apiID, definition string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting schema creation for Appsync Graphql API (%s)", apiID)
+	_, err := conn.StartSchemaCreation(&appsync.StartSchemaCreationInput{
+		ApiId:      aws.String(apiID),
+		Definition: []byte(definition),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Appsync Graphql API (%s) schema: %s", apiID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SchemaStatusProcessing},
+		Target:  []string{appsync.SchemaStatusActive, appsync.SchemaStatusSuccess},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetSchemaCreationStatus(&appsync.GetSchemaCreationStatusInput{
+				ApiId: aws.String(apiID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := aws.StringValue(resp.Status)
+			if status == appsync.SchemaStatusFailed {
+				return resp, status, fmt.Errorf("%s", aws.StringValue(resp.Details))
+			}
+
+			return resp, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Appsync Graphql API (%s) schema creation: %s", apiID, err)
+	}
+
+	return nil
+}
+
 func expandAppsyncGraphqlApiUserPoolConfig(config []interface{}) *appsync.UserPoolConfig {
-	if len(config) < 1 {
+	if len(config) < 1 || config[0] == nil {
 		return nil
 	}
 	cg := config[0].(map[string]interface{})
@@ -197,3 +427,157 @@ func flattenAppsyncGraphqlApiUserPoolConfig(upc *appsync.UserPoolConfig) []inter
 
 	return []interface{}{m}
 }
+
+func expandAppsyncGraphqlApiCognitoUserPoolConfig(config []interface{}) *appsync.CognitoUserPoolConfig {
+	if len(config) < 1 || config[0] == nil {
+		return nil
+	}
+	cg := config[0].(map[string]interface{})
+	upc := &appsync.CognitoUserPoolConfig{
+		UserPoolId: aws.String(cg["user_pool_id"].(string)),
+	}
+	if v, ok := cg["app_id_client_regex"].(string); ok && v != "" {
+		upc.AppIdClientRegex = aws.String(v)
+	}
+	if v, ok := cg["aws_region"].(string); ok && v != "" {
+		upc.AwsRegion = aws.String(v)
+	}
+	return upc
+}
+
+func flattenAppsyncGraphqlApiCognitoUserPoolConfig(upc *appsync.CognitoUserPoolConfig) []interface{} {
+	if upc == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"app_id_client_regex": aws.StringValue(upc.AppIdClientRegex),
+		"aws_region":          aws.StringValue(upc.AwsRegion),
+		"user_pool_id":        aws.StringValue(upc.UserPoolId),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncGraphqlApiOpenIDConnectConfig(config []interface{}) *appsync.OpenIDConnectConfig {
+	if len(config) < 1 || config[0] == nil {
+		return nil
+	}
+	cg := config[0].(map[string]interface{})
+	oidc := &appsync.OpenIDConnectConfig{
+		Issuer: aws.String(cg["issuer"].(string)),
+	}
+	if v, ok := cg["auth_ttl"].(int); ok && v != 0 {
+		oidc.AuthTTL = aws.Int64(int64(v))
+	}
+	if v, ok := cg["client_id"].(string); ok && v != "" {
+		oidc.ClientId = aws.String(v)
+	}
+	if v, ok := cg["iat_ttl"].(int); ok && v != 0 {
+		oidc.IatTTL = aws.Int64(int64(v))
+	}
+	return oidc
+}
+
+func flattenAppsyncGraphqlApiOpenIDConnectConfig(oidc *appsync.OpenIDConnectConfig) []interface{} {
+	if oidc == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"auth_ttl":  int(aws.Int64Value(oidc.AuthTTL)),
+		"client_id": aws.StringValue(oidc.ClientId),
+		"iat_ttl":   int(aws.Int64Value(oidc.IatTTL)),
+		"issuer":    aws.StringValue(oidc.Issuer),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncGraphqlApiLambdaAuthorizerConfig(config []interface{}) *appsync.LambdaAuthorizerConfig {
+	if len(config) < 1 || config[0] == nil {
+		return nil
+	}
+	cg := config[0].(map[string]interface{})
+	lac := &appsync.LambdaAuthorizerConfig{
+		AuthorizerResultTtlInSeconds: aws.Int64(int64(cg["authorizer_result_ttl_in_seconds"].(int))),
+		AuthorizerUri:                aws.String(cg["authorizer_uri"].(string)),
+	}
+	if v, ok := cg["identity_validation_expression"].(string); ok && v != "" {
+		lac.IdentityValidationExpression = aws.String(v)
+	}
+	return lac
+}
+
+func flattenAppsyncGraphqlApiLambdaAuthorizerConfig(lac *appsync.LambdaAuthorizerConfig) []interface{} {
+	if lac == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"authorizer_result_ttl_in_seconds": int(aws.Int64Value(lac.AuthorizerResultTtlInSeconds)),
+		"authorizer_uri":                   aws.StringValue(lac.AuthorizerUri),
+		"identity_validation_expression":   aws.StringValue(lac.IdentityValidationExpression),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncGraphqlApiLogConfig(config []interface{}) *appsync.LogConfig {
+	if len(config) < 1 || config[0] == nil {
+		return nil
+	}
+	cg := config[0].(map[string]interface{})
+	return &appsync.LogConfig{
+		CloudWatchLogsRoleArn: aws.String(cg["cloudwatch_logs_role_arn"].(string)),
+		ExcludeVerboseContent: aws.Bool(cg["exclude_verbose_content"].(bool)),
+		FieldLogLevel:         aws.String(cg["field_log_level"].(string)),
+	}
+}
+
+func flattenAppsyncGraphqlApiLogConfig(lc *appsync.LogConfig) []interface{} {
+	if lc == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"cloudwatch_logs_role_arn": aws.StringValue(lc.CloudWatchLogsRoleArn),
+		"exclude_verbose_content":  aws.BoolValue(lc.ExcludeVerboseContent),
+		"field_log_level":          aws.StringValue(lc.FieldLogLevel),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncGraphqlApiAdditionalAuthProviders(config []interface{}) []*appsync.AdditionalAuthenticationProvider {
+	if len(config) < 1 {
+		return nil
+	}
+
+	providers := make([]*appsync.AdditionalAuthenticationProvider, 0, len(config))
+	for _, raw := range config {
+		if raw == nil {
+			continue
+		}
+		cg := raw.(map[string]interface{})
+
+		providers = append(providers, &appsync.AdditionalAuthenticationProvider{
+			AuthenticationType:     aws.String(cg["authentication_type"].(string)),
+			LambdaAuthorizerConfig: expandAppsyncGraphqlApiLambdaAuthorizerConfig(cg["lambda_authorizer_config"].([]interface{})),
+			OpenIDConnectConfig:    expandAppsyncGraphqlApiOpenIDConnectConfig(cg["openid_connect_config"].([]interface{})),
+			UserPoolConfig:         expandAppsyncGraphqlApiCognitoUserPoolConfig(cg["user_pool_config"].([]interface{})),
+		})
+	}
+
+	return providers
+}
+
+func flattenAppsyncGraphqlApiAdditionalAuthProviders(providers []*appsync.AdditionalAuthenticationProvider) []interface{} {
+	result := make([]interface{}, 0, len(providers))
+	for _, p := range providers {
+		result = append(result, map[string]interface{}{
+			"authentication_type":      aws.StringValue(p.AuthenticationType),
+			"lambda_authorizer_config": flattenAppsyncGraphqlApiLambdaAuthorizerConfig(p.LambdaAuthorizerConfig),
+			"openid_connect_config":    flattenAppsyncGraphqlApiOpenIDConnectConfig(p.OpenIDConnectConfig),
+			"user_pool_config":         flattenAppsyncGraphqlApiCognitoUserPoolConfig(p.UserPoolConfig),
+		})
+	}
+
+	return result
+}
@@ -62,6 +62,199 @@ func TestAccAWSAppsyncGraphqlApi_cognito(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppsyncGraphqlApi_openIDConnect(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_openIDConnect(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "OPENID_CONNECT"),
+					resource.TestCheckResourceAttr(resourceName, "openid_connect_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "openid_connect_config.0.issuer", "https://example.com"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_openIDConnect(rName, "https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "openid_connect_config.0.issuer", "https://example.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_lambdaAuthorizer(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_lambdaAuthorizer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AWS_LAMBDA"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.0.authorizer_result_ttl_in_seconds", "60"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_authorizer_config.0.authorizer_uri", "aws_lambda_function.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_additionalAuthenticationProvider(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_additionalAuthenticationProvider(acctest.RandString(5)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.0.authentication_type", "AWS_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.1.authentication_type", "OPENID_CONNECT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.1.openid_connect_config.0.issuer", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_logConfig(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_logConfig(rName, "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_config.0.field_log_level", "ALL"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_config.0.cloudwatch_logs_role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_logConfig(rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_config.0.field_log_level", "ERROR"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_xrayEnabled(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_xrayEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "xray_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_xrayEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "xray_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_schema(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "Post"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttrSet(resourceName, "uris.GRAPHQL"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "PostV2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"schema"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_tags(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_tags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_tags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAppsyncGraphqlApi_import(t *testing.T) {
 	resourceName := "aws_appsync_graphql_api.test_apikey"
 
@@ -165,3 +358,174 @@ resource "aws_appsync_graphql_api" "test_cognito" {
 }
 `, rName, rName)
 }
+
+func testAccAppsyncGraphqlApiConfig_openIDConnect(rName, issuer string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "OPENID_CONNECT"
+  name = "tf_appsync_%s"
+
+  openid_connect_config {
+    issuer = %q
+  }
+}
+`, rName, issuer)
+}
+
+func testAccAppsyncGraphqlApiConfig_lambdaAuthorizer(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-lambdarole-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  function_name = "tf-lambda-%[1]s"
+  filename = "test-fixtures/lambdatest.zip"
+  role = "${aws_iam_role.test.arn}"
+  handler = "exports.test"
+  runtime = "nodejs6.10"
+}
+
+resource "aws_lambda_permission" "test" {
+  action = "lambda:InvokeFunction"
+  function_name = "${aws_lambda_function.test.function_name}"
+  principal = "appsync.amazonaws.com"
+  statement_id = "appsync_lambda_authorizer"
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "AWS_LAMBDA"
+  name = "tf_appsync_%[1]s"
+
+  lambda_authorizer_config {
+    authorizer_result_ttl_in_seconds = 60
+    authorizer_uri = "${aws_lambda_function.test.arn}"
+  }
+
+  depends_on = ["aws_lambda_permission.test"]
+}
+`, rName)
+}
+
+func testAccAppsyncGraphqlApiConfig_additionalAuthenticationProvider(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+
+  additional_authentication_provider {
+    authentication_type = "AWS_IAM"
+  }
+
+  additional_authentication_provider {
+    authentication_type = "OPENID_CONNECT"
+
+    openid_connect_config {
+      issuer = "https://example.com"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAppsyncGraphqlApiConfig_logConfig(rName, fieldLogLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSAppSyncPushToCloudWatchLogs"
+  role = "${aws_iam_role.test.name}"
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%[1]s"
+
+  log_config {
+    cloudwatch_logs_role_arn = "${aws_iam_role.test.arn}"
+    field_log_level = %[2]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, fieldLogLevel)
+}
+
+func testAccAppsyncGraphqlApiConfig_xrayEnabled(rName string, xrayEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+  xray_enabled = %t
+}
+`, rName, xrayEnabled)
+}
+
+func testAccAppsyncGraphqlApiConfig_schema(rName, typeName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%[1]s"
+
+  schema = <<EOF
+schema {
+  query: Query
+}
+
+type Query {
+  test: %[2]s
+}
+
+type %[2]s {
+  id: ID!
+  title: String
+}
+EOF
+}
+`, rName, typeName)
+}
+
+func testAccAppsyncGraphqlApiConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%s"
+
+  tags {
+    Key1 = %q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,392 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncResolver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncResolverCreate,
+		Read:   resourceAwsAppsyncResolverRead,
+		Update: resourceAwsAppsyncResolverUpdate,
+		Delete: resourceAwsAppsyncResolverDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"caching_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"caching_keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+					},
+				},
+			},
+			"code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"pipeline_config"},
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appsync.ResolverKindUnit,
+				ValidateFunc: validation.StringInSlice(appsync.ResolverKind_Values(), false),
+			},
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+			"pipeline_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"data_source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"functions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"request_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"response_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime": appsyncRuntimeSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// APPSYNC_JS resolvers and functions are written in JavaScript (code)
+// instead of VTL mapping templates.
+func appsyncRuntimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(appsync.RuntimeName_Values(), false),
+				},
+				"runtime_version": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID := d.Get("api_id").(string)
+	typeName := d.Get("type").(string)
+	fieldName := d.Get("field").(string)
+
+	input := &appsync.CreateResolverInput{
+		ApiId:          aws.String(apiID),
+		CachingConfig:  expandAppsyncResolverCachingConfig(d.Get("caching_config").([]interface{})),
+		FieldName:      aws.String(fieldName),
+		Kind:           aws.String(d.Get("kind").(string)),
+		PipelineConfig: expandAppsyncResolverPipelineConfig(d.Get("pipeline_config").([]interface{})),
+		Runtime:        expandAppsyncRuntime(d.Get("runtime").([]interface{})),
+		TypeName:       aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_source"); ok {
+		input.DataSourceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_batch_size"); ok {
+		input.MaxBatchSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("request_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Appsync Resolver: %s", input)
+	_, err := conn.CreateResolver(input)
+	if err != nil {
+		return fmt.Errorf("error creating Appsync Resolver (%s.%s): %s", typeName, fieldName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s", apiID, typeName, fieldName))
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetResolver(&appsync.GetResolverInput{
+		ApiId:     aws.String(apiID),
+		FieldName: aws.String(fieldName),
+		TypeName:  aws.String(typeName),
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Appsync Resolver (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	resolver := resp.Resolver
+
+	d.Set("api_id", apiID)
+	d.Set("arn", resolver.ResolverArn)
+	d.Set("code", resolver.Code)
+	d.Set("data_source", resolver.DataSourceName)
+	d.Set("field", resolver.FieldName)
+	d.Set("kind", resolver.Kind)
+	d.Set("max_batch_size", resolver.MaxBatchSize)
+	d.Set("request_template", resolver.RequestMappingTemplate)
+	d.Set("response_template", resolver.ResponseMappingTemplate)
+	d.Set("type", resolver.TypeName)
+
+	if err := d.Set("caching_config", flattenAppsyncResolverCachingConfig(resolver.CachingConfig)); err != nil {
+		return fmt.Errorf("error setting caching_config: %s", err)
+	}
+
+	if err := d.Set("pipeline_config", flattenAppsyncResolverPipelineConfig(resolver.PipelineConfig)); err != nil {
+		return fmt.Errorf("error setting pipeline_config: %s", err)
+	}
+
+	if err := d.Set("runtime", flattenAppsyncRuntime(resolver.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	input := &appsync.UpdateResolverInput{
+		ApiId:          aws.String(d.Get("api_id").(string)),
+		CachingConfig:  expandAppsyncResolverCachingConfig(d.Get("caching_config").([]interface{})),
+		FieldName:      aws.String(d.Get("field").(string)),
+		Kind:           aws.String(d.Get("kind").(string)),
+		PipelineConfig: expandAppsyncResolverPipelineConfig(d.Get("pipeline_config").([]interface{})),
+		Runtime:        expandAppsyncRuntime(d.Get("runtime").([]interface{})),
+		TypeName:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_source"); ok {
+		input.DataSourceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_batch_size"); ok {
+		input.MaxBatchSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("request_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Appsync Resolver: %s", input)
+	if _, err := conn.UpdateResolver(input); err != nil {
+		return fmt.Errorf("error updating Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync Resolver: %s", d.Id())
+	_, err = conn.DeleteResolver(&appsync.DeleteResolverInput{
+		ApiId:     aws.String(apiID),
+		FieldName: aws.String(fieldName),
+		TypeName:  aws.String(typeName),
+	})
+	if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// GraphQL type and field names cannot contain a hyphen, which makes it a
+// safe separator.
+func decodeAppsyncResolverID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "-", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID-TYPE-FIELD", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func expandAppsyncResolverCachingConfig(l []interface{}) *appsync.CachingConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.CachingConfig{
+		Ttl: aws.Int64(int64(m["ttl"].(int))),
+	}
+
+	if v, ok := m["caching_keys"].(*schema.Set); ok && v.Len() > 0 {
+		config.CachingKeys = expandStringList(v.List())
+	}
+
+	return config
+}
+
+func flattenAppsyncResolverCachingConfig(config *appsync.CachingConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"caching_keys": schema.NewSet(schema.HashString, flattenStringList(config.CachingKeys)),
+		"ttl":          int(aws.Int64Value(config.Ttl)),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncResolverPipelineConfig(l []interface{}) *appsync.PipelineConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.PipelineConfig{}
+
+	if v, ok := m["functions"].([]interface{}); ok && len(v) > 0 {
+		config.Functions = expandStringList(v)
+	}
+
+	return config
+}
+
+func flattenAppsyncResolverPipelineConfig(config *appsync.PipelineConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"functions": flattenStringList(config.Functions),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncRuntime(l []interface{}) *appsync.AppSyncRuntime {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &appsync.AppSyncRuntime{
+		Name:           aws.String(m["name"].(string)),
+		RuntimeVersion: aws.String(m["runtime_version"].(string)),
+	}
+}
+
+func flattenAppsyncRuntime(runtime *appsync.AppSyncRuntime) []interface{} {
+	if runtime == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"name":            aws.StringValue(runtime.Name),
+		"runtime_version": aws.StringValue(runtime.RuntimeVersion),
+	}
+
+	return []interface{}{m}
+}
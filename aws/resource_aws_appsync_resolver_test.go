@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncResolver_basic(t *testing.T) {
+	resourceName := "aws_appsync_resolver.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig_basic(rName, "$util.toJson($context.result)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "UNIT"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Query"),
+					resource.TestCheckResourceAttr(resourceName, "field", "singlePost"),
+					resource.TestCheckResourceAttr(resourceName, "data_source", "tf_appsync_"+rName),
+				),
+			},
+			{
+				Config: testAccAppsyncResolverConfig_basic(rName, "$util.toJson($ctx.result)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "response_template", "$util.toJson($ctx.result)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncResolver_pipeline(t *testing.T) {
+	resourceName := "aws_appsync_resolver.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig_pipeline(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "PIPELINE"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_config.0.functions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "pipeline_config.0.functions.0", "aws_appsync_function.test", "function_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncResolver_jsRuntime(t *testing.T) {
+	resourceName := "aws_appsync_resolver.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig_jsRuntime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.runtime_version", "1.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "code"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeAppsyncResolverID(t *testing.T) {
+	testCases := []struct {
+		Input     string
+		ApiID     string
+		TypeName  string
+		FieldName string
+		ErrCount  int
+	}{
+		{
+			Input:    "abc123-Query",
+			ErrCount: 1,
+		},
+		{
+			Input:    "-Query-singlePost",
+			ErrCount: 1,
+		},
+		{
+			Input:     "abc123-Query-singlePost",
+			ApiID:     "abc123",
+			TypeName:  "Query",
+			FieldName: "singlePost",
+		},
+	}
+
+	for _, tc := range testCases {
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if apiID != tc.ApiID || typeName != tc.TypeName || fieldName != tc.FieldName {
+			t.Fatalf("expected %q to decode to %q, %q, %q, received: %q, %q, %q", tc.Input, tc.ApiID, tc.TypeName, tc.FieldName, apiID, typeName, fieldName)
+		}
+	}
+}
+
+func testAccCheckAwsAppsyncResolverDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_resolver" {
+			continue
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Appsync Resolver %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncResolverExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncResolverConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name = "tf_appsync_%[1]s"
+
+  schema = <<EOF
+type Mutation {
+  putPost(id: ID!, title: String!): Post
+}
+
+type Post {
+  id: ID!
+  title: String!
+}
+
+type Query {
+  singlePost(id: ID!): Post
+}
+
+schema {
+  query: Query
+  mutation: Mutation
+}
+EOF
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  name = "tf_appsync_%[1]s"
+  type = "HTTP"
+
+  http_config {
+    endpoint = "http://example.com"
+  }
+}
+`, rName)
+}
+
+func testAccAppsyncResolverConfig_basic(rName, responseTemplate string) string {
+	return testAccAppsyncResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_resolver" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  field = "singlePost"
+  type = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  request_template = <<EOF
+{
+  "version": "2018-05-29",
+  "method": "GET",
+  "resourcePath": "/",
+  "params": {
+    "headers": $utils.http.copyheaders($ctx.request.headers)
+  }
+}
+EOF
+
+  response_template = %q
+}
+`, responseTemplate)
+}
+
+func testAccAppsyncResolverConfig_pipeline(rName string) string {
+	return testAccAppsyncResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_function" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  data_source = "${aws_appsync_datasource.test.name}"
+  name = "tf_appsync_%[1]s"
+  request_mapping_template = <<EOF
+{
+  "version": "2018-05-29",
+  "method": "GET",
+  "resourcePath": "/"
+}
+EOF
+  response_mapping_template = "$util.toJson($ctx.result)"
+}
+
+resource "aws_appsync_resolver" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  field = "singlePost"
+  type = "Query"
+  kind = "PIPELINE"
+  request_template = "{}"
+  response_template = "$util.toJson($ctx.result)"
+
+  pipeline_config {
+    functions = ["${aws_appsync_function.test.function_id}"]
+  }
+}
+`, rName)
+}
+
+func testAccAppsyncResolverConfig_jsRuntime(rName string) string {
+	return testAccAppsyncResolverConfig_base(rName) + `
+resource "aws_appsync_resolver" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  field = "singlePost"
+  type = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  code = <<EOF
+export function request(ctx) {
+  return { method: "GET", resourcePath: "/" };
+}
+
+export function response(ctx) {
+  return ctx.result;
+}
+EOF
+
+  runtime {
+    name = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`
+}
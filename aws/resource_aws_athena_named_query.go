@@ -39,6 +39,12 @@ func resourceAwsAthenaNamedQuery() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "primary",
+			},
 		},
 	}
 }
@@ -54,6 +60,9 @@ func resourceAwsAthenaNamedQueryCreate(d *schema.ResourceData, meta interface{})
 	if raw, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(raw.(string))
 	}
+	if raw, ok := d.GetOk("workgroup"); ok {
+		input.WorkGroup = aws.String(raw.(string))
+	}
 
 	resp, err := conn.CreateNamedQuery(input)
 	if err != nil {
@@ -84,6 +93,7 @@ func resourceAwsAthenaNamedQueryRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("query", resp.NamedQuery.QueryString)
 	d.Set("database", resp.NamedQuery.Database)
 	d.Set("description", resp.NamedQuery.Description)
+	d.Set("workgroup", resp.NamedQuery.WorkGroup)
 	return nil
 }
 
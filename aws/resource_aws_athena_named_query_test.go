@@ -27,6 +27,32 @@ func TestAccAWSAthenaNamedQuery_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAthenaNamedQuery_withWorkGroup(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_named_query.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaNamedQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaNamedQueryConfigWithWorkGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaNamedQueryExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+					resource.TestCheckResourceAttr("aws_athena_workgroup.test", "configuration.0.result_configuration.0.output_location", fmt.Sprintf("s3://%s/results/", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSAthenaNamedQuery_import(t *testing.T) {
 	resourceName := "aws_athena_named_query.foo"
 
@@ -115,3 +141,36 @@ resource "aws_athena_named_query" "foo" {
 }
 		`, rName, rInt, rName, rName)
 }
+
+func testAccAthenaNamedQueryConfigWithWorkGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/results/"
+    }
+  }
+}
+
+resource "aws_athena_database" "test" {
+  name          = "${replace(%[1]q, "-", "_")}"
+  bucket        = "${aws_s3_bucket.test.bucket}"
+  force_destroy = true
+}
+
+resource "aws_athena_named_query" "test" {
+  name      = %[1]q
+  workgroup = "${aws_athena_workgroup.test.name}"
+  database  = "${aws_athena_database.test.name}"
+  query     = "SELECT * FROM ${aws_athena_database.test.name} limit 10;"
+}
+`, rName)
+}
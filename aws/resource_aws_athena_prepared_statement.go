@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAthenaPreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaPreparedStatementCreate,
+		Read:   resourceAwsAthenaPreparedStatementRead,
+		Update: resourceAwsAthenaPreparedStatementUpdate,
+		Delete: resourceAwsAthenaPreparedStatementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"query_statement": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaPreparedStatementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	name := d.Get("name").(string)
+	workGroup := d.Get("workgroup").(string)
+
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(name),
+		WorkGroup:      aws.String(workGroup),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Prepared Statement: %s", input)
+	_, err := conn.CreatePreparedStatement(input)
+	if err != nil {
+		return fmt.Errorf("error creating Athena Prepared Statement (%s) in workgroup (%s): %s", name, workGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workGroup, name))
+
+	return resourceAwsAthenaPreparedStatementRead(d, meta)
+}
+
+func resourceAwsAthenaPreparedStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroup, name, err := readAwsAthenaPreparedStatementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetPreparedStatement(&athena.GetPreparedStatementInput{
+		StatementName: aws.String(name),
+		WorkGroup:     aws.String(workGroup),
+	})
+	if isAWSErr(err, athena.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	statement := output.PreparedStatement
+	if statement == nil {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", statement.Description)
+	d.Set("name", statement.StatementName)
+	d.Set("query_statement", statement.QueryStatement)
+	d.Set("workgroup", workGroup)
+
+	return nil
+}
+
+func resourceAwsAthenaPreparedStatementUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroup, name, err := readAwsAthenaPreparedStatementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(name),
+		WorkGroup:      aws.String(workGroup),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Athena Prepared Statement: %s", input)
+	_, err = conn.UpdatePreparedStatement(input)
+	if err != nil {
+		return fmt.Errorf("error updating Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAthenaPreparedStatementRead(d, meta)
+}
+
+func resourceAwsAthenaPreparedStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroup, name, err := readAwsAthenaPreparedStatementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Athena Prepared Statement: %s", d.Id())
+	_, err = conn.DeletePreparedStatement(&athena.DeletePreparedStatementInput{
+		StatementName: aws.String(name),
+		WorkGroup:     aws.String(workGroup),
+	})
+	if isAWSErr(err, athena.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func readAwsAthenaPreparedStatementID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected WORKGROUP/NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaPreparedStatement_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, "SELECT * FROM test WHERE x = ?", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf_acc_test_statement"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE x = ?"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, "SELECT * FROM test WHERE y = ?", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE y = ?"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaPreparedStatementDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_prepared_statement" {
+			continue
+		}
+
+		workGroup, name, err := readAwsAthenaPreparedStatementID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetPreparedStatement(&athena.GetPreparedStatementInput{
+			StatementName: aws.String(name),
+			WorkGroup:     aws.String(workGroup),
+		})
+		if isAWSErr(err, athena.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		// Deleting the workgroup also removes its prepared statements.
+		if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Prepared Statement (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAthenaPreparedStatementExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		workGroup, name, err := readAwsAthenaPreparedStatementID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+		_, err = conn.GetPreparedStatement(&athena.GetPreparedStatementInput{
+			StatementName: aws.String(name),
+			WorkGroup:     aws.String(workGroup),
+		})
+
+		return err
+	}
+}
+
+func testAccAthenaPreparedStatementConfig(rName, queryStatement, description string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_prepared_statement" "test" {
+  name            = "tf_acc_test_statement"
+  workgroup       = "${aws_athena_workgroup.test.name}"
+  query_statement = %[2]q
+  description     = %[3]q
+}
+`, rName, queryStatement, description)
+}
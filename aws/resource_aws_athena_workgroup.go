@@ -0,0 +1,525 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAthenaWorkgroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaWorkgroupCreate,
+		Read:   resourceAwsAthenaWorkgroupRead,
+		Update: resourceAwsAthenaWorkgroupUpdate,
+		Delete: resourceAwsAthenaWorkgroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned_cutoff_per_query": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(10485760),
+						},
+						"enforce_workgroup_configuration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"engine_version": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effective_engine_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"selected_engine_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "AUTO",
+									},
+								},
+							},
+						},
+						"publish_cloudwatch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"requester_pays_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"result_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"acl_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_acl_option": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(athena.S3AclOption_Values(), false),
+												},
+											},
+										},
+									},
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_option": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(athena.EncryptionOption_Values(), false),
+												},
+												"kms_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+									"expected_bucket_owner": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"output_location": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(1024),
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  athena.WorkGroupStateEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					athena.WorkGroupStateDisabled,
+					athena.WorkGroupStateEnabled,
+				}, false),
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaWorkgroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	name := d.Get("name").(string)
+	input := &athena.CreateWorkGroupInput{
+		Configuration: expandAthenaWorkGroupConfiguration(d.Get("configuration").([]interface{})),
+		Name:          aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapAthena(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Workgroup: %s", input)
+	_, err := conn.CreateWorkGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating Athena Workgroup (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Workgroups are always created enabled.
+	if v := d.Get("state").(string); v == athena.WorkGroupStateDisabled {
+		_, err := conn.UpdateWorkGroup(&athena.UpdateWorkGroupInput{
+			State:     aws.String(v),
+			WorkGroup: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error disabling Athena Workgroup (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAthenaWorkgroupRead(d, meta)
+}
+
+func resourceAwsAthenaWorkgroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	output, err := conn.GetWorkGroup(&athena.GetWorkGroupInput{
+		WorkGroup: aws.String(d.Id()),
+	})
+	if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
+		log.Printf("[WARN] Athena Workgroup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Athena Workgroup (%s): %s", d.Id(), err)
+	}
+
+	workGroup := output.WorkGroup
+	if workGroup == nil {
+		log.Printf("[WARN] Athena Workgroup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	workGroupARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "athena", meta.(*AWSClient).accountid, fmt.Sprintf("workgroup/%s", d.Id()))
+	d.Set("arn", workGroupARN)
+
+	if err := d.Set("configuration", flattenAthenaWorkGroupConfiguration(workGroup.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %s", err)
+	}
+
+	d.Set("description", workGroup.Description)
+	d.Set("name", workGroup.Name)
+	d.Set("state", workGroup.State)
+
+	tagsOutput, err := conn.ListTagsForResource(&athena.ListTagsForResourceInput{
+		ResourceARN: aws.String(workGroupARN),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Athena Workgroup (%s): %s", workGroupARN, err)
+	}
+
+	if err := d.Set("tags", tagsToMapAthena(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAthenaWorkgroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	if d.HasChange("configuration") || d.HasChange("description") || d.HasChange("state") {
+		input := &athena.UpdateWorkGroupInput{
+			WorkGroup: aws.String(d.Id()),
+		}
+
+		if d.HasChange("configuration") {
+			input.ConfigurationUpdates = expandAthenaWorkGroupConfigurationUpdates(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("state") {
+			input.State = aws.String(d.Get("state").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Athena Workgroup: %s", input)
+		_, err := conn.UpdateWorkGroup(input)
+		if err != nil {
+			return fmt.Errorf("error updating Athena Workgroup (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsAthena(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Athena Workgroup (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAthenaWorkgroupRead(d, meta)
+}
+
+func resourceAwsAthenaWorkgroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	input := &athena.DeleteWorkGroupInput{
+		RecursiveDeleteOption: aws.Bool(d.Get("force_destroy").(bool)),
+		WorkGroup:             aws.String(d.Id()),
+	}
+
+	log.Printf("[INFO] Deleting Athena Workgroup: %s", d.Id())
+	_, err := conn.DeleteWorkGroup(input)
+	if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Athena Workgroup (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAthenaWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	configuration := &athena.WorkGroupConfiguration{
+		EnforceWorkGroupConfiguration:   aws.Bool(m["enforce_workgroup_configuration"].(bool)),
+		PublishCloudWatchMetricsEnabled: aws.Bool(m["publish_cloudwatch_metrics_enabled"].(bool)),
+		RequesterPaysEnabled:            aws.Bool(m["requester_pays_enabled"].(bool)),
+	}
+
+	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
+		configuration.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["engine_version"].([]interface{}); ok && len(v) > 0 {
+		configuration.EngineVersion = expandAthenaEngineVersion(v)
+	}
+
+	if v, ok := m["result_configuration"].([]interface{}); ok && len(v) > 0 {
+		configuration.ResultConfiguration = expandAthenaResultConfiguration(v)
+	}
+
+	return configuration
+}
+
+func expandAthenaWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfigurationUpdates {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	configurationUpdates := &athena.WorkGroupConfigurationUpdates{
+		EnforceWorkGroupConfiguration:   aws.Bool(m["enforce_workgroup_configuration"].(bool)),
+		PublishCloudWatchMetricsEnabled: aws.Bool(m["publish_cloudwatch_metrics_enabled"].(bool)),
+		RequesterPaysEnabled:            aws.Bool(m["requester_pays_enabled"].(bool)),
+		ResultConfigurationUpdates:      expandAthenaResultConfigurationUpdates(m["result_configuration"].([]interface{})),
+	}
+
+	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
+		configurationUpdates.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
+	} else {
+		configurationUpdates.RemoveBytesScannedCutoffPerQuery = aws.Bool(true)
+	}
+
+	if v, ok := m["engine_version"].([]interface{}); ok && len(v) > 0 {
+		configurationUpdates.EngineVersion = expandAthenaEngineVersion(v)
+	}
+
+	return configurationUpdates
+}
+
+func expandAthenaEngineVersion(l []interface{}) *athena.EngineVersion {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	engineVersion := &athena.EngineVersion{}
+
+	if v, ok := m["selected_engine_version"].(string); ok && v != "" {
+		engineVersion.SelectedEngineVersion = aws.String(v)
+	}
+
+	return engineVersion
+}
+
+func expandAthenaResultConfiguration(l []interface{}) *athena.ResultConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	resultConfiguration := &athena.ResultConfiguration{}
+
+	if v, ok := m["acl_configuration"].([]interface{}); ok && len(v) > 0 {
+		resultConfiguration.AclConfiguration = expandAthenaAclConfiguration(v)
+	}
+
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 {
+		resultConfiguration.EncryptionConfiguration = expandAthenaEncryptionConfiguration(v)
+	}
+
+	if v, ok := m["expected_bucket_owner"].(string); ok && v != "" {
+		resultConfiguration.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := m["output_location"].(string); ok && v != "" {
+		resultConfiguration.OutputLocation = aws.String(v)
+	}
+
+	return resultConfiguration
+}
+
+// expandAthenaResultConfigurationUpdates explicitly removes any result
+// settings that are no longer configured, since omitting them from the
+// update would leave the previous values in place.
+func expandAthenaResultConfigurationUpdates(l []interface{}) *athena.ResultConfigurationUpdates {
+	resultConfigurationUpdates := &athena.ResultConfigurationUpdates{
+		RemoveAclConfiguration:        aws.Bool(true),
+		RemoveEncryptionConfiguration: aws.Bool(true),
+		RemoveExpectedBucketOwner:     aws.Bool(true),
+		RemoveOutputLocation:          aws.Bool(true),
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return resultConfigurationUpdates
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["acl_configuration"].([]interface{}); ok && len(v) > 0 {
+		resultConfigurationUpdates.AclConfiguration = expandAthenaAclConfiguration(v)
+		resultConfigurationUpdates.RemoveAclConfiguration = nil
+	}
+
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 {
+		resultConfigurationUpdates.EncryptionConfiguration = expandAthenaEncryptionConfiguration(v)
+		resultConfigurationUpdates.RemoveEncryptionConfiguration = nil
+	}
+
+	if v, ok := m["expected_bucket_owner"].(string); ok && v != "" {
+		resultConfigurationUpdates.ExpectedBucketOwner = aws.String(v)
+		resultConfigurationUpdates.RemoveExpectedBucketOwner = nil
+	}
+
+	if v, ok := m["output_location"].(string); ok && v != "" {
+		resultConfigurationUpdates.OutputLocation = aws.String(v)
+		resultConfigurationUpdates.RemoveOutputLocation = nil
+	}
+
+	return resultConfigurationUpdates
+}
+
+func expandAthenaAclConfiguration(l []interface{}) *athena.AclConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &athena.AclConfiguration{
+		S3AclOption: aws.String(m["s3_acl_option"].(string)),
+	}
+}
+
+func expandAthenaEncryptionConfiguration(l []interface{}) *athena.EncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryptionConfiguration := &athena.EncryptionConfiguration{
+		EncryptionOption: aws.String(m["encryption_option"].(string)),
+	}
+
+	if v, ok := m["kms_key_arn"].(string); ok && v != "" {
+		encryptionConfiguration.KmsKey = aws.String(v)
+	}
+
+	return encryptionConfiguration
+}
+
+func flattenAthenaWorkGroupConfiguration(configuration *athena.WorkGroupConfiguration) []interface{} {
+	if configuration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bytes_scanned_cutoff_per_query":     int(aws.Int64Value(configuration.BytesScannedCutoffPerQuery)),
+		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                     flattenAthenaEngineVersion(configuration.EngineVersion),
+		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
+		"requester_pays_enabled":             aws.BoolValue(configuration.RequesterPaysEnabled),
+		"result_configuration":               flattenAthenaResultConfiguration(configuration.ResultConfiguration),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAthenaEngineVersion(engineVersion *athena.EngineVersion) []interface{} {
+	if engineVersion == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"effective_engine_version": aws.StringValue(engineVersion.EffectiveEngineVersion),
+		"selected_engine_version":  aws.StringValue(engineVersion.SelectedEngineVersion),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAthenaResultConfiguration(resultConfiguration *athena.ResultConfiguration) []interface{} {
+	if resultConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"acl_configuration":        []interface{}{},
+		"encryption_configuration": []interface{}{},
+		"expected_bucket_owner":    aws.StringValue(resultConfiguration.ExpectedBucketOwner),
+		"output_location":          aws.StringValue(resultConfiguration.OutputLocation),
+	}
+
+	if v := resultConfiguration.AclConfiguration; v != nil {
+		m["acl_configuration"] = []interface{}{map[string]interface{}{
+			"s3_acl_option": aws.StringValue(v.S3AclOption),
+		}}
+	}
+
+	if v := resultConfiguration.EncryptionConfiguration; v != nil {
+		m["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"encryption_option": aws.StringValue(v.EncryptionOption),
+			"kms_key_arn":       aws.StringValue(v.KmsKey),
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,442 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaWorkgroup_basic(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:athena:[^:]+:[^:]+:workgroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.enforce_workgroup_configuration", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.publish_cloudwatch_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", athena.WorkGroupStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_bytesScannedCutoffPerQuery(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigBytesScannedCutoffPerQuery(rName, 10485760),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bytes_scanned_cutoff_per_query", "10485760"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigBytesScannedCutoffPerQuery(rName, 12582912),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bytes_scanned_cutoff_per_query", "12582912"),
+				),
+			},
+			{
+				Config: testAccAthenaWorkgroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bytes_scanned_cutoff_per_query", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_engineVersion(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigEngineVersion(rName, "AUTO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.engine_version.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.engine_version.0.selected_engine_version", "AUTO"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.engine_version.0.effective_engine_version"),
+				),
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigEngineVersion(rName, "Athena engine version 3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.engine_version.0.selected_engine_version", "Athena engine version 3"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.engine_version.0.effective_engine_version", "Athena engine version 3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_resultConfigurationOutputLocation(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigResultConfigurationOutputLocation(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.output_location", fmt.Sprintf("s3://%s/first/", rName)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigResultConfigurationOutputLocation(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.output_location", fmt.Sprintf("s3://%s/second/", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_resultConfigurationEncryption(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigResultConfigurationEncryptionSseS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.encryption_configuration.0.encryption_option", athena.EncryptionOptionSseS3),
+				),
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigResultConfigurationEncryptionKms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.encryption_configuration.0.encryption_option", athena.EncryptionOptionSseKms),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.result_configuration.0.encryption_configuration.0.kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_state(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigState(rName, athena.WorkGroupStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "state", athena.WorkGroupStateDisabled),
+				),
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigState(rName, athena.WorkGroupStateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "state", athena.WorkGroupStateEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaWorkgroup_tags(t *testing.T) {
+	var workGroup athena.WorkGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaWorkgroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAthenaWorkgroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaWorkgroupExists(resourceName, &workGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaWorkgroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_workgroup" {
+			continue
+		}
+
+		_, err := conn.GetWorkGroup(&athena.GetWorkGroupInput{
+			WorkGroup: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Workgroup (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAthenaWorkgroupExists(resourceName string, workGroup *athena.WorkGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Workgroup ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+		output, err := conn.GetWorkGroup(&athena.GetWorkGroupInput{
+			WorkGroup: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.WorkGroup == nil {
+			return fmt.Errorf("Athena Workgroup (%s) not found", rs.Primary.ID)
+		}
+
+		*workGroup = *output.WorkGroup
+
+		return nil
+	}
+}
+
+func testAccAthenaWorkgroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAthenaWorkgroupConfigBytesScannedCutoffPerQuery(rName string, bytesScannedCutoffPerQuery int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    bytes_scanned_cutoff_per_query = %[2]d
+  }
+}
+`, rName, bytesScannedCutoffPerQuery)
+}
+
+func testAccAthenaWorkgroupConfigEngineVersion(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    engine_version {
+      selected_engine_version = %[2]q
+    }
+  }
+}
+`, rName, engineVersion)
+}
+
+func testAccAthenaWorkgroupConfigResultConfigurationOutputLocation(rName, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/%[2]s/"
+    }
+  }
+}
+`, rName, prefix)
+}
+
+func testAccAthenaWorkgroupConfigResultConfigurationEncryptionSseS3(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/"
+
+      encryption_configuration {
+        encryption_option = "SSE_S3"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAthenaWorkgroupConfigResultConfigurationEncryptionKms(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/"
+
+      encryption_configuration {
+        encryption_option = "SSE_KMS"
+        kms_key_arn       = "${aws_kms_key.test.arn}"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAthenaWorkgroupConfigState(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name  = %[1]q
+  state = %[2]q
+}
+`, rName, state)
+}
+
+func testAccAthenaWorkgroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAthenaWorkgroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -44,6 +44,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				Optional: true,
 				Default:  "SimpleScaling", // preserve AWS's default to make validation easier.
 				ValidateFunc: validation.StringInSlice([]string{
+					"PredictiveScaling",
 					"SimpleScaling",
 					"StepScaling",
 					"TargetTrackingScaling",
@@ -62,6 +63,56 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"predictive_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity_breach_behavior": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      autoscaling.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+							ValidateFunc: validation.StringInSlice(autoscaling.PredictiveScalingMaxCapacityBreachBehavior_Values(), false),
+						},
+						"max_capacity_buffer": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"metric_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"customized_capacity_metric_specification": resourceAwsAutoscalingPolicyPredictiveCustomizedMetricSchema(),
+									"customized_load_metric_specification":     resourceAwsAutoscalingPolicyPredictiveCustomizedMetricSchema(),
+									"customized_scaling_metric_specification":  resourceAwsAutoscalingPolicyPredictiveCustomizedMetricSchema(),
+									"predefined_load_metric_specification":     resourceAwsAutoscalingPolicyPredictivePredefinedMetricSchema(autoscaling.PredefinedLoadMetricType_Values()),
+									"predefined_metric_pair_specification":     resourceAwsAutoscalingPolicyPredictivePredefinedMetricSchema(autoscaling.PredefinedMetricPairType_Values()),
+									"predefined_scaling_metric_specification":  resourceAwsAutoscalingPolicyPredictivePredefinedMetricSchema(autoscaling.PredefinedScalingMetricType_Values()),
+									"target_value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      autoscaling.PredictiveScalingModeForecastOnly,
+							ValidateFunc: validation.StringInSlice(autoscaling.PredictiveScalingMode_Values(), false),
+						},
+						"scheduling_buffer_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"min_adjustment_magnitude": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -148,16 +199,25 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 										},
 									},
 									"metric_name": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_configuration.0.customized_metric_specification.0.metrics"},
+									},
+									"metrics": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_configuration.0.customized_metric_specification.0.metric_name"},
+										Elem: &schema.Resource{
+											Schema: resourceAwsAutoscalingPolicyMetricDataQuerySchema(),
+										},
 									},
 									"namespace": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 									"statistic": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 									"unit": {
 										Type:     schema.TypeString,
@@ -182,6 +242,118 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingPolicyMetricDataQuerySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"expression": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"label": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"metric_stat": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"dimensions": {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"name": {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+											"value": {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+								"metric_name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"namespace": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"stat": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"unit": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"return_data": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func resourceAwsAutoscalingPolicyPredictiveCustomizedMetricSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric_data_queries": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: resourceAwsAutoscalingPolicyMetricDataQuerySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAutoscalingPolicyPredictivePredefinedMetricSchema(metricTypes []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"predefined_metric_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(metricTypes, false),
+				},
+				"resource_label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceAwsAutoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 
@@ -230,6 +402,9 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("arn", p.PolicyARN)
 	d.Set("name", p.PolicyName)
+	if err := d.Set("predictive_scaling_configuration", flattenAutoscalingPredictiveScalingConfiguration(p.PredictiveScalingConfiguration)); err != nil {
+		return fmt.Errorf("error setting predictive_scaling_configuration: %s", err)
+	}
 	d.Set("scaling_adjustment", p.ScalingAdjustment)
 	d.Set("step_adjustment", flattenStepAdjustments(p.StepAdjustments))
 	d.Set("target_tracking_configuration", flattenTargetTrackingConfiguration(p.TargetTrackingConfiguration))
@@ -366,6 +541,16 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		return params, fmt.Errorf("target_tracking_configuration is required for policy type TargetTrackingScaling")
 	}
 
+	// This parameter is required if the policy type is PredictiveScaling and not supported otherwise.
+	if v, ok := d.GetOk("predictive_scaling_configuration"); ok {
+		params.PredictiveScalingConfiguration = expandAutoscalingPredictiveScalingConfiguration(v.([]interface{}))
+		if policyType != "PredictiveScaling" {
+			return params, fmt.Errorf("predictive_scaling_configuration is only supported for policy type PredictiveScaling")
+		}
+	} else if !ok && policyType == "PredictiveScaling" {
+		return params, fmt.Errorf("predictive_scaling_configuration is required for policy type PredictiveScaling")
+	}
+
 	return params, nil
 }
 
@@ -441,14 +626,22 @@ func expandTargetTrackingConfiguration(configs []interface{}) *autoscaling.Targe
 	}
 	if v, ok := config["customized_metric_specification"]; ok && len(v.([]interface{})) > 0 {
 		spec := v.([]interface{})[0].(map[string]interface{})
-		customSpec := &autoscaling.CustomizedMetricSpecification{
-			Namespace:  aws.String(spec["namespace"].(string)),
-			MetricName: aws.String(spec["metric_name"].(string)),
-			Statistic:  aws.String(spec["statistic"].(string)),
+		customSpec := &autoscaling.CustomizedMetricSpecification{}
+		if val, ok := spec["namespace"]; ok && val.(string) != "" {
+			customSpec.Namespace = aws.String(val.(string))
+		}
+		if val, ok := spec["metric_name"]; ok && val.(string) != "" {
+			customSpec.MetricName = aws.String(val.(string))
 		}
-		if val, ok := spec["unit"]; ok {
+		if val, ok := spec["statistic"]; ok && val.(string) != "" {
+			customSpec.Statistic = aws.String(val.(string))
+		}
+		if val, ok := spec["unit"]; ok && val.(string) != "" {
 			customSpec.Unit = aws.String(val.(string))
 		}
+		if val, ok := spec["metrics"]; ok && len(val.([]interface{})) > 0 {
+			customSpec.Metrics = expandAutoscalingTargetTrackingMetricDataQueries(val.([]interface{}))
+		}
 		if val, ok := spec["metric_dimension"]; ok {
 			dims := val.([]interface{})
 			metDimList := make([]*autoscaling.MetricDimension, len(dims))
@@ -485,12 +678,15 @@ func flattenTargetTrackingConfiguration(config *autoscaling.TargetTrackingConfig
 	}
 	if config.CustomizedMetricSpecification != nil {
 		spec := map[string]interface{}{}
-		spec["metric_name"] = *config.CustomizedMetricSpecification.MetricName
-		spec["namespace"] = *config.CustomizedMetricSpecification.Namespace
-		spec["statistic"] = *config.CustomizedMetricSpecification.Statistic
+		spec["metric_name"] = aws.StringValue(config.CustomizedMetricSpecification.MetricName)
+		spec["namespace"] = aws.StringValue(config.CustomizedMetricSpecification.Namespace)
+		spec["statistic"] = aws.StringValue(config.CustomizedMetricSpecification.Statistic)
 		if config.CustomizedMetricSpecification.Unit != nil {
 			spec["unit"] = *config.CustomizedMetricSpecification.Unit
 		}
+		if config.CustomizedMetricSpecification.Metrics != nil {
+			spec["metrics"] = flattenAutoscalingTargetTrackingMetricDataQueries(config.CustomizedMetricSpecification.Metrics)
+		}
 		if config.CustomizedMetricSpecification.Dimensions != nil {
 			dimSpec := make([]interface{}, len(config.CustomizedMetricSpecification.Dimensions))
 			for i := range dimSpec {
@@ -506,3 +702,352 @@ func flattenTargetTrackingConfiguration(config *autoscaling.TargetTrackingConfig
 	}
 	return []interface{}{result}
 }
+
+func expandAutoscalingPredictiveScalingConfiguration(l []interface{}) *autoscaling.PredictiveScalingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &autoscaling.PredictiveScalingConfiguration{
+		MaxCapacityBreachBehavior: aws.String(m["max_capacity_breach_behavior"].(string)),
+		MetricSpecifications:      expandAutoscalingPredictiveScalingMetricSpecifications(m["metric_specification"].([]interface{})),
+		Mode:                      aws.String(m["mode"].(string)),
+	}
+
+	if v, ok := m["max_capacity_buffer"].(int); ok && v != 0 {
+		config.MaxCapacityBuffer = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["scheduling_buffer_time"].(int); ok && v != 0 {
+		config.SchedulingBufferTime = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func expandAutoscalingPredictiveScalingMetricSpecifications(l []interface{}) []*autoscaling.PredictiveScalingMetricSpecification {
+	var specs []*autoscaling.PredictiveScalingMetricSpecification
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		spec := &autoscaling.PredictiveScalingMetricSpecification{
+			TargetValue: aws.Float64(m["target_value"].(float64)),
+		}
+
+		if v := expandAutoscalingPredictiveScalingMetricDataQueries(m["customized_capacity_metric_specification"].([]interface{})); v != nil {
+			spec.CustomizedCapacityMetricSpecification = &autoscaling.PredictiveScalingCustomizedCapacityMetric{MetricDataQueries: v}
+		}
+
+		if v := expandAutoscalingPredictiveScalingMetricDataQueries(m["customized_load_metric_specification"].([]interface{})); v != nil {
+			spec.CustomizedLoadMetricSpecification = &autoscaling.PredictiveScalingCustomizedLoadMetric{MetricDataQueries: v}
+		}
+
+		if v := expandAutoscalingPredictiveScalingMetricDataQueries(m["customized_scaling_metric_specification"].([]interface{})); v != nil {
+			spec.CustomizedScalingMetricSpecification = &autoscaling.PredictiveScalingCustomizedScalingMetric{MetricDataQueries: v}
+		}
+
+		if v, ok := m["predefined_load_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			spec.PredefinedLoadMetricSpecification = &autoscaling.PredictiveScalingPredefinedLoadMetric{
+				PredefinedMetricType: aws.String(tfMap["predefined_metric_type"].(string)),
+			}
+			if v, ok := tfMap["resource_label"].(string); ok && v != "" {
+				spec.PredefinedLoadMetricSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		if v, ok := m["predefined_metric_pair_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			spec.PredefinedMetricPairSpecification = &autoscaling.PredictiveScalingPredefinedMetricPair{
+				PredefinedMetricType: aws.String(tfMap["predefined_metric_type"].(string)),
+			}
+			if v, ok := tfMap["resource_label"].(string); ok && v != "" {
+				spec.PredefinedMetricPairSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		if v, ok := m["predefined_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			spec.PredefinedScalingMetricSpecification = &autoscaling.PredictiveScalingPredefinedScalingMetric{
+				PredefinedMetricType: aws.String(tfMap["predefined_metric_type"].(string)),
+			}
+			if v, ok := tfMap["resource_label"].(string); ok && v != "" {
+				spec.PredefinedScalingMetricSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// expandAutoscalingPredictiveScalingMetricDataQueries expands the
+// metric_data_queries of a customized predictive scaling metric block.
+func expandAutoscalingPredictiveScalingMetricDataQueries(l []interface{}) []*autoscaling.MetricDataQuery {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	var queries []*autoscaling.MetricDataQuery
+
+	for _, raw := range m["metric_data_queries"].([]interface{}) {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		query := &autoscaling.MetricDataQuery{
+			Id:         aws.String(tfMap["id"].(string)),
+			ReturnData: aws.Bool(tfMap["return_data"].(bool)),
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			stat := v[0].(map[string]interface{})
+			query.MetricStat = &autoscaling.MetricStat{
+				Metric: expandAutoscalingMetric(stat["metric"].([]interface{})),
+				Stat:   aws.String(stat["stat"].(string)),
+			}
+			if v, ok := stat["unit"].(string); ok && v != "" {
+				query.MetricStat.Unit = aws.String(v)
+			}
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+func expandAutoscalingTargetTrackingMetricDataQueries(l []interface{}) []*autoscaling.TargetTrackingMetricDataQuery {
+	var queries []*autoscaling.TargetTrackingMetricDataQuery
+
+	for _, raw := range l {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		query := &autoscaling.TargetTrackingMetricDataQuery{
+			Id:         aws.String(tfMap["id"].(string)),
+			ReturnData: aws.Bool(tfMap["return_data"].(bool)),
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			stat := v[0].(map[string]interface{})
+			query.MetricStat = &autoscaling.TargetTrackingMetricStat{
+				Metric: expandAutoscalingMetric(stat["metric"].([]interface{})),
+				Stat:   aws.String(stat["stat"].(string)),
+			}
+			if v, ok := stat["unit"].(string); ok && v != "" {
+				query.MetricStat.Unit = aws.String(v)
+			}
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+func expandAutoscalingMetric(l []interface{}) *autoscaling.Metric {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	metric := &autoscaling.Metric{
+		MetricName: aws.String(m["metric_name"].(string)),
+		Namespace:  aws.String(m["namespace"].(string)),
+	}
+
+	for _, raw := range m["dimensions"].([]interface{}) {
+		dim, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric.Dimensions = append(metric.Dimensions, &autoscaling.MetricDimension{
+			Name:  aws.String(dim["name"].(string)),
+			Value: aws.String(dim["value"].(string)),
+		})
+	}
+
+	return metric
+}
+
+func flattenAutoscalingPredictiveScalingConfiguration(config *autoscaling.PredictiveScalingConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_capacity_breach_behavior": aws.StringValue(config.MaxCapacityBreachBehavior),
+		"max_capacity_buffer":          int(aws.Int64Value(config.MaxCapacityBuffer)),
+		"metric_specification":         flattenAutoscalingPredictiveScalingMetricSpecifications(config.MetricSpecifications),
+		"mode":                         aws.StringValue(config.Mode),
+		"scheduling_buffer_time":       int(aws.Int64Value(config.SchedulingBufferTime)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAutoscalingPredictiveScalingMetricSpecifications(specs []*autoscaling.PredictiveScalingMetricSpecification) []interface{} {
+	var l []interface{}
+
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"target_value": aws.Float64Value(spec.TargetValue),
+		}
+
+		if v := spec.CustomizedCapacityMetricSpecification; v != nil {
+			m["customized_capacity_metric_specification"] = flattenAutoscalingPredictiveScalingMetricDataQueries(v.MetricDataQueries)
+		}
+
+		if v := spec.CustomizedLoadMetricSpecification; v != nil {
+			m["customized_load_metric_specification"] = flattenAutoscalingPredictiveScalingMetricDataQueries(v.MetricDataQueries)
+		}
+
+		if v := spec.CustomizedScalingMetricSpecification; v != nil {
+			m["customized_scaling_metric_specification"] = flattenAutoscalingPredictiveScalingMetricDataQueries(v.MetricDataQueries)
+		}
+
+		if v := spec.PredefinedLoadMetricSpecification; v != nil {
+			m["predefined_load_metric_specification"] = []interface{}{map[string]interface{}{
+				"predefined_metric_type": aws.StringValue(v.PredefinedMetricType),
+				"resource_label":         aws.StringValue(v.ResourceLabel),
+			}}
+		}
+
+		if v := spec.PredefinedMetricPairSpecification; v != nil {
+			m["predefined_metric_pair_specification"] = []interface{}{map[string]interface{}{
+				"predefined_metric_type": aws.StringValue(v.PredefinedMetricType),
+				"resource_label":         aws.StringValue(v.ResourceLabel),
+			}}
+		}
+
+		if v := spec.PredefinedScalingMetricSpecification; v != nil {
+			m["predefined_scaling_metric_specification"] = []interface{}{map[string]interface{}{
+				"predefined_metric_type": aws.StringValue(v.PredefinedMetricType),
+				"resource_label":         aws.StringValue(v.ResourceLabel),
+			}}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenAutoscalingPredictiveScalingMetricDataQueries(queries []*autoscaling.MetricDataQuery) []interface{} {
+	var l []interface{}
+
+	for _, query := range queries {
+		if query == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"expression":  aws.StringValue(query.Expression),
+			"id":          aws.StringValue(query.Id),
+			"label":       aws.StringValue(query.Label),
+			"return_data": query.ReturnData == nil || aws.BoolValue(query.ReturnData),
+		}
+
+		if v := query.MetricStat; v != nil {
+			m["metric_stat"] = []interface{}{map[string]interface{}{
+				"metric": flattenAutoscalingMetric(v.Metric),
+				"stat":   aws.StringValue(v.Stat),
+				"unit":   aws.StringValue(v.Unit),
+			}}
+		}
+
+		l = append(l, m)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"metric_data_queries": l,
+	}}
+}
+
+func flattenAutoscalingTargetTrackingMetricDataQueries(queries []*autoscaling.TargetTrackingMetricDataQuery) []interface{} {
+	var l []interface{}
+
+	for _, query := range queries {
+		if query == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"expression":  aws.StringValue(query.Expression),
+			"id":          aws.StringValue(query.Id),
+			"label":       aws.StringValue(query.Label),
+			"return_data": query.ReturnData == nil || aws.BoolValue(query.ReturnData),
+		}
+
+		if v := query.MetricStat; v != nil {
+			m["metric_stat"] = []interface{}{map[string]interface{}{
+				"metric": flattenAutoscalingMetric(v.Metric),
+				"stat":   aws.StringValue(v.Stat),
+				"unit":   aws.StringValue(v.Unit),
+			}}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenAutoscalingMetric(metric *autoscaling.Metric) []interface{} {
+	if metric == nil {
+		return []interface{}{}
+	}
+
+	var dimensions []interface{}
+	for _, dim := range metric.Dimensions {
+		if dim == nil {
+			continue
+		}
+
+		dimensions = append(dimensions, map[string]interface{}{
+			"name":  aws.StringValue(dim.Name),
+			"value": aws.StringValue(dim.Value),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dimensions":  dimensions,
+		"metric_name": aws.StringValue(metric.MetricName),
+		"namespace":   aws.StringValue(metric.Namespace),
+	}}
+}
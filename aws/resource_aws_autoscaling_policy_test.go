@@ -230,6 +230,73 @@ func TestAccAWSAutoscalingPolicy_TargetTrack_Custom(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoscalingPolicy_TargetTrack_MetricMath(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	resourceName := "aws_autoscaling_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_TargetTracking_MetricMath(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.0.id", "m1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.0.return_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.0.metric_stat.0.metric.0.metric_name", "ApproximateNumberOfMessagesVisible"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.1.id", "e1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_configuration.0.customized_metric_specification.0.metrics.1.expression", "m1 / 10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoscalingPolicy_PredictiveScaling(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	resourceName := "aws_autoscaling_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Predefined(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.mode", "ForecastAndScale"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.scheduling_buffer_time", "10"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.max_capacity_breach_behavior", "IncreaseMaxCapacity"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.max_capacity_buffer", "10"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.target_value", "32"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification.0.predefined_metric_type", "ASGCPUUtilization"),
+				),
+			},
+			{
+				Config: testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Customized(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.mode", "ForecastOnly"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.0.metric_stat.0.stat", "Sum"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoscalingPolicy_zerovalue(t *testing.T) {
 	var simplepolicy autoscaling.ScalingPolicy
 	var steppolicy autoscaling.ScalingPolicy
@@ -512,6 +579,129 @@ resource "aws_autoscaling_policy" "test" {
 `, name)
 }
 
+func testAccAwsAutoscalingPolicyConfig_TargetTracking_MetricMath(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%s-test"
+  policy_type            = "TargetTrackingScaling"
+  autoscaling_group_name = "${aws_autoscaling_group.test.name}"
+
+  target_tracking_configuration {
+    customized_metric_specification {
+      metrics {
+        id          = "m1"
+        label       = "Get the queue size (the number of messages waiting to be processed)"
+        return_data = false
+
+        metric_stat {
+          metric {
+            dimensions {
+              name  = "QueueName"
+              value = "my-queue"
+            }
+
+            metric_name = "ApproximateNumberOfMessagesVisible"
+            namespace   = "AWS/SQS"
+          }
+
+          stat = "Sum"
+        }
+      }
+
+      metrics {
+        id         = "e1"
+        expression = "m1 / 10"
+        label      = "Calculate the backlog per instance"
+      }
+    }
+
+    target_value = 100
+  }
+}
+`, name)
+}
+
+func testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Predefined(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%s-test"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = "${aws_autoscaling_group.test.name}"
+
+  predictive_scaling_configuration {
+    max_capacity_breach_behavior = "IncreaseMaxCapacity"
+    max_capacity_buffer          = 10
+    mode                         = "ForecastAndScale"
+    scheduling_buffer_time       = 10
+
+    metric_specification {
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ASGCPUUtilization"
+      }
+
+      target_value = 32
+    }
+  }
+}
+`, name)
+}
+
+func testAccAwsAutoscalingPolicyConfig_PredictiveScaling_Customized(name string) string {
+	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-test"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = "${aws_autoscaling_group.test.name}"
+
+  predictive_scaling_configuration {
+    metric_specification {
+      customized_scaling_metric_specification {
+        metric_data_queries {
+          id = "scaling"
+
+          metric_stat {
+            metric {
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = "${aws_autoscaling_group.test.name}"
+              }
+
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/EC2"
+            }
+
+            stat = "Average"
+          }
+        }
+      }
+
+      customized_load_metric_specification {
+        metric_data_queries {
+          id = "load"
+
+          metric_stat {
+            metric {
+              dimensions {
+                name  = "AutoScalingGroupName"
+                value = "${aws_autoscaling_group.test.name}"
+              }
+
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/EC2"
+            }
+
+            stat = "Sum"
+          }
+        }
+      }
+
+      target_value = 50
+    }
+  }
+}
+`, name)
+}
+
 func testAccAWSAutoscalingPolicyConfig_zerovalue(name string) string {
 	return testAccAWSAutoscalingPolicyConfig_base(name) + fmt.Sprintf(`
 resource "aws_autoscaling_policy" "foobar_simple" {
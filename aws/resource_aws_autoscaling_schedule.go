@@ -9,10 +9,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 const awsAutoscalingScheduleTimeLayout = "2006-01-02T15:04:05Z"
 
+// awsAutoscalingScheduleSizeUnchanged is the size value that leaves the group's
+// current size unchanged when the scheduled action runs.
+const awsAutoscalingScheduleSizeUnchanged = -1
+
 func resourceAwsAutoscalingSchedule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsAutoscalingScheduleCreate,
@@ -48,24 +53,33 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 				ValidateFunc: validateASGScheduleTimestamp,
 			},
 			"recurrence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateASGScheduleRecurrence,
+			},
+			"time_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
 			"min_size": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(awsAutoscalingScheduleSizeUnchanged),
 			},
 			"max_size": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(awsAutoscalingScheduleSizeUnchanged),
 			},
 			"desired_capacity": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(awsAutoscalingScheduleSizeUnchanged),
 			},
 		},
 	}
@@ -98,6 +112,10 @@ func resourceAwsAutoscalingScheduleCreate(d *schema.ResourceData, meta interface
 		params.Recurrence = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("time_zone"); ok {
+		params.TimeZone = aws.String(attr.(string))
+	}
+
 	// Scheduled actions don't need to set all three size parameters. For example,
 	// you may want to change the min or max without also forcing an immediate
 	// resize by changing a desired_capacity that may have changed due to other
@@ -107,13 +125,13 @@ func resourceAwsAutoscalingScheduleCreate(d *schema.ResourceData, meta interface
 	minSize := int64(d.Get("min_size").(int))
 	maxSize := int64(d.Get("max_size").(int))
 	desiredCapacity := int64(d.Get("desired_capacity").(int))
-	if minSize != -1 {
+	if minSize != awsAutoscalingScheduleSizeUnchanged {
 		params.MinSize = aws.Int64(minSize)
 	}
-	if maxSize != -1 {
+	if maxSize != awsAutoscalingScheduleSizeUnchanged {
 		params.MaxSize = aws.Int64(maxSize)
 	}
-	if desiredCapacity != -1 {
+	if desiredCapacity != awsAutoscalingScheduleSizeUnchanged {
 		params.DesiredCapacity = aws.Int64(desiredCapacity)
 	}
 
@@ -144,22 +162,23 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 	d.Set("arn", sa.ScheduledActionARN)
 
 	if sa.MinSize == nil {
-		d.Set("min_size", -1)
+		d.Set("min_size", awsAutoscalingScheduleSizeUnchanged)
 	} else {
 		d.Set("min_size", sa.MinSize)
 	}
 	if sa.MaxSize == nil {
-		d.Set("max_size", -1)
+		d.Set("max_size", awsAutoscalingScheduleSizeUnchanged)
 	} else {
 		d.Set("max_size", sa.MaxSize)
 	}
 	if sa.DesiredCapacity == nil {
-		d.Set("desired_capacity", -1)
+		d.Set("desired_capacity", awsAutoscalingScheduleSizeUnchanged)
 	} else {
 		d.Set("desired_capacity", sa.DesiredCapacity)
 	}
 
 	d.Set("recurrence", sa.Recurrence)
+	d.Set("time_zone", sa.TimeZone)
 
 	if sa.StartTime != nil {
 		d.Set("start_time", sa.StartTime.Format(awsAutoscalingScheduleTimeLayout))
@@ -90,6 +90,34 @@ func TestAccAWSAutoscalingSchedule_recurrence(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoscalingSchedule_timeZone(t *testing.T) {
+	var schedule autoscaling.ScheduledUpdateGroupAction
+
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoscalingScheduleConfig_timeZone(rName, "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
+					resource.TestCheckResourceAttr("aws_autoscaling_schedule.foobar", "recurrence", "0 8 * * MON-FRI"),
+					resource.TestCheckResourceAttr("aws_autoscaling_schedule.foobar", "time_zone", "America/New_York"),
+				),
+			},
+			{
+				Config: testAccAWSAutoscalingScheduleConfig_timeZone(rName, "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
+					resource.TestCheckResourceAttr("aws_autoscaling_schedule.foobar", "time_zone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoscalingSchedule_zeroValues(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
@@ -290,6 +318,38 @@ resource "aws_autoscaling_schedule" "foobar" {
 }`, r, r)
 }
 
+func testAccAWSAutoscalingScheduleConfig_timeZone(r, timeZone string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_configuration" "foobar" {
+  name          = %[1]q
+  image_id      = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "foobar" {
+  availability_zones        = ["us-west-2a"]
+  name                      = %[1]q
+  max_size                  = 1
+  min_size                  = 1
+  health_check_grace_period = 300
+  health_check_type         = "ELB"
+  force_delete              = true
+  termination_policies      = ["OldestInstance"]
+  launch_configuration      = "${aws_launch_configuration.foobar.name}"
+}
+
+resource "aws_autoscaling_schedule" "foobar" {
+  scheduled_action_name  = "foobar"
+  min_size               = 0
+  max_size               = 1
+  desired_capacity       = -1
+  recurrence             = "0 8 * * MON-FRI"
+  time_zone              = %[2]q
+  autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
+}
+`, r, timeZone)
+}
+
 func testAccAWSAutoscalingScheduleConfig_zeroValues(r, start, end string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_configuration" "foobar" {
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupPlanCreate,
+		Read:   resourceAwsBackupPlanRead,
+		Update: resourceAwsBackupPlanUpdate,
+		Delete: resourceAwsBackupPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"advanced_backup_setting": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_options": {
+							Type:     schema.TypeMap,
+							Required: true,
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"EC2"}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"completion_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"copy_action": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_vault_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"lifecycle": backupPlanLifecycleSchema(),
+								},
+							},
+						},
+						"enable_continuous_backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"lifecycle":           backupPlanLifecycleSchema(),
+						"recovery_point_tags": tagsSchema(),
+						"rule_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{1,50}$`), "must consist of 1 to 50 alphanumeric, hyphen, underscore or period characters"),
+						},
+						"schedule": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"target_vault_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func backupPlanLifecycleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cold_storage_after": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"delete_after": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.CreateBackupPlanInput{
+		BackupPlan: expandBackupPlanInput(d),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.BackupPlanTags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Backup Plan: %s", input)
+	output, err := conn.CreateBackupPlan(input)
+	if err != nil {
+		return fmt.Errorf("error creating Backup Plan: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupPlanId))
+
+	return resourceAwsBackupPlanRead(d, meta)
+}
+
+func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	output, err := conn.GetBackupPlan(&backup.GetBackupPlanInput{
+		BackupPlanId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Backup Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Backup Plan (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.BackupPlanArn)
+	d.Set("arn", arn)
+	d.Set("name", output.BackupPlan.BackupPlanName)
+	d.Set("version", output.VersionId)
+
+	if err := d.Set("rule", flattenBackupPlanRules(output.BackupPlan.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	if err := d.Set("advanced_backup_setting", flattenBackupPlanAdvancedBackupSettings(output.BackupPlan.AdvancedBackupSettings)); err != nil {
+		return fmt.Errorf("error setting advanced_backup_setting: %s", err)
+	}
+
+	tags, err := getBackupResourceTags(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Plan (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	if d.HasChange("rule") || d.HasChange("advanced_backup_setting") {
+		input := &backup.UpdateBackupPlanInput{
+			BackupPlan:   expandBackupPlanInput(d),
+			BackupPlanId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Backup Plan: %s", input)
+		_, err := conn.UpdateBackupPlan(input)
+		if err != nil {
+			return fmt.Errorf("error updating Backup Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsBackup(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Backup Plan (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsBackupPlanRead(d, meta)
+}
+
+func resourceAwsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.DeleteBackupPlanInput{
+		BackupPlanId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Plan: %s", input)
+	_, err := conn.DeleteBackupPlan(input)
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBackupPlanInput(d *schema.ResourceData) *backup.PlanInput {
+	plan := &backup.PlanInput{
+		BackupPlanName: aws.String(d.Get("name").(string)),
+		Rules:          expandBackupPlanRules(d.Get("rule").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("advanced_backup_setting"); ok {
+		plan.AdvancedBackupSettings = expandBackupPlanAdvancedBackupSettings(v.(*schema.Set))
+	}
+
+	return plan
+}
+
+func expandBackupPlanRules(s *schema.Set) []*backup.RuleInput {
+	rules := []*backup.RuleInput{}
+
+	for _, r := range s.List() {
+		m := r.(map[string]interface{})
+
+		rule := &backup.RuleInput{
+			EnableContinuousBackup: aws.Bool(m["enable_continuous_backup"].(bool)),
+			RuleName:               aws.String(m["rule_name"].(string)),
+			TargetBackupVaultName:  aws.String(m["target_vault_name"].(string)),
+		}
+
+		if v, ok := m["schedule"].(string); ok && v != "" {
+			rule.ScheduleExpression = aws.String(v)
+		}
+
+		if v, ok := m["start_window"].(int); ok && v > 0 {
+			rule.StartWindowMinutes = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["completion_window"].(int); ok && v > 0 {
+			rule.CompletionWindowMinutes = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["lifecycle"].([]interface{}); ok {
+			rule.Lifecycle = expandBackupPlanLifecycle(v)
+		}
+
+		if v, ok := m["copy_action"].(*schema.Set); ok && v.Len() > 0 {
+			rule.CopyActions = expandBackupPlanCopyActions(v)
+		}
+
+		if v, ok := m["recovery_point_tags"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.RecoveryPointTags = tagsFromMapGeneric(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandBackupPlanLifecycle(l []interface{}) *backup.Lifecycle {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	lifecycle := &backup.Lifecycle{}
+
+	if v, ok := m["cold_storage_after"].(int); ok && v > 0 {
+		lifecycle.MoveToColdStorageAfterDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["delete_after"].(int); ok && v > 0 {
+		lifecycle.DeleteAfterDays = aws.Int64(int64(v))
+	}
+
+	return lifecycle
+}
+
+func expandBackupPlanCopyActions(s *schema.Set) []*backup.CopyAction {
+	actions := []*backup.CopyAction{}
+
+	for _, a := range s.List() {
+		m := a.(map[string]interface{})
+
+		action := &backup.CopyAction{
+			DestinationBackupVaultArn: aws.String(m["destination_vault_arn"].(string)),
+		}
+
+		if v, ok := m["lifecycle"].([]interface{}); ok {
+			action.Lifecycle = expandBackupPlanLifecycle(v)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandBackupPlanAdvancedBackupSettings(s *schema.Set) []*backup.AdvancedBackupSetting {
+	settings := []*backup.AdvancedBackupSetting{}
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		settings = append(settings, &backup.AdvancedBackupSetting{
+			BackupOptions: stringMapToPointers(m["backup_options"].(map[string]interface{})),
+			ResourceType:  aws.String(m["resource_type"].(string)),
+		})
+	}
+
+	return settings
+}
+
+func flattenBackupPlanRules(rules []*backup.Rule) []interface{} {
+	l := []interface{}{}
+
+	for _, rule := range rules {
+		m := map[string]interface{}{
+			"completion_window":        int(aws.Int64Value(rule.CompletionWindowMinutes)),
+			"copy_action":              flattenBackupPlanCopyActions(rule.CopyActions),
+			"enable_continuous_backup": aws.BoolValue(rule.EnableContinuousBackup),
+			"lifecycle":                flattenBackupPlanLifecycle(rule.Lifecycle),
+			"recovery_point_tags":      tagsToMapGeneric(rule.RecoveryPointTags),
+			"rule_name":                aws.StringValue(rule.RuleName),
+			"schedule":                 aws.StringValue(rule.ScheduleExpression),
+			"start_window":             int(aws.Int64Value(rule.StartWindowMinutes)),
+			"target_vault_name":        aws.StringValue(rule.TargetBackupVaultName),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenBackupPlanLifecycle(lifecycle *backup.Lifecycle) []interface{} {
+	if lifecycle == nil || (lifecycle.MoveToColdStorageAfterDays == nil && lifecycle.DeleteAfterDays == nil) {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cold_storage_after": int(aws.Int64Value(lifecycle.MoveToColdStorageAfterDays)),
+		"delete_after":       int(aws.Int64Value(lifecycle.DeleteAfterDays)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenBackupPlanCopyActions(actions []*backup.CopyAction) []interface{} {
+	l := []interface{}{}
+
+	for _, action := range actions {
+		m := map[string]interface{}{
+			"destination_vault_arn": aws.StringValue(action.DestinationBackupVaultArn),
+			"lifecycle":             flattenBackupPlanLifecycle(action.Lifecycle),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenBackupPlanAdvancedBackupSettings(settings []*backup.AdvancedBackupSetting) []interface{} {
+	l := []interface{}{}
+
+	for _, setting := range settings {
+		m := map[string]interface{}{
+			"backup_options": aws.StringValueMap(setting.BackupOptions),
+			"resource_type":  aws.StringValue(setting.ResourceType),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,235 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBackupSelection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupSelectionCreate,
+		Read:   resourceAwsBackupSelectionRead,
+		Delete: resourceAwsBackupSelectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBackupSelectionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{1,50}$`), "must consist of 1 to 50 alphanumeric, hyphen, underscore or period characters"),
+			},
+			"not_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selection_tag": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{backup.ConditionTypeStringequals}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	selection := &backup.Selection{
+		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		SelectionName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("resources"); ok {
+		selection.Resources = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_resources"); ok {
+		selection.NotResources = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("selection_tag"); ok {
+		selection.ListOfTags = expandBackupSelectionTags(v.(*schema.Set))
+	}
+
+	input := &backup.CreateBackupSelectionInput{
+		BackupPlanId:    aws.String(d.Get("plan_id").(string)),
+		BackupSelection: selection,
+	}
+
+	log.Printf("[DEBUG] Creating Backup Selection: %s", input)
+	var output *backup.CreateBackupSelectionOutput
+	// A newly created IAM role can take a few moments to become assumable
+	// by the Backup service.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackupSelection(input)
+		if isAWSErr(err, backup.ErrCodeInvalidParameterValueException, "Invalid ARN") ||
+			isAWSErr(err, backup.ErrCodeInvalidParameterValueException, "is not authorized") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Backup Selection: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SelectionId))
+
+	return resourceAwsBackupSelectionRead(d, meta)
+}
+
+func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	output, err := conn.GetBackupSelection(&backup.GetBackupSelectionInput{
+		BackupPlanId: aws.String(d.Get("plan_id").(string)),
+		SelectionId:  aws.String(d.Id()),
+	})
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Backup Selection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Backup Selection (%s): %s", d.Id(), err)
+	}
+
+	selection := output.BackupSelection
+
+	d.Set("iam_role_arn", selection.IamRoleArn)
+	d.Set("name", selection.SelectionName)
+	d.Set("plan_id", output.BackupPlanId)
+
+	if err := d.Set("resources", flattenStringList(selection.Resources)); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	if err := d.Set("not_resources", flattenStringList(selection.NotResources)); err != nil {
+		return fmt.Errorf("error setting not_resources: %s", err)
+	}
+
+	if err := d.Set("selection_tag", flattenBackupSelectionTags(selection.ListOfTags)); err != nil {
+		return fmt.Errorf("error setting selection_tag: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupSelectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.DeleteBackupSelectionInput{
+		BackupPlanId: aws.String(d.Get("plan_id").(string)),
+		SelectionId:  aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Selection: %s", input)
+	_, err := conn.DeleteBackupSelection(input)
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Selection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupSelectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected PLAN_ID|SELECTION_ID", d.Id())
+	}
+
+	d.Set("plan_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandBackupSelectionTags(s *schema.Set) []*backup.Condition {
+	conditions := []*backup.Condition{}
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		conditions = append(conditions, &backup.Condition{
+			ConditionKey:   aws.String(m["key"].(string)),
+			ConditionType:  aws.String(m["type"].(string)),
+			ConditionValue: aws.String(m["value"].(string)),
+		})
+	}
+
+	return conditions
+}
+
+func flattenBackupSelectionTags(conditions []*backup.Condition) []interface{} {
+	l := []interface{}{}
+
+	for _, condition := range conditions {
+		m := map[string]interface{}{
+			"key":   aws.StringValue(condition.ConditionKey),
+			"type":  aws.StringValue(condition.ConditionType),
+			"value": aws.StringValue(condition.ConditionValue),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBackupVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupVaultCreate,
+		Read:   resourceAwsBackupVaultRead,
+		Update: resourceAwsBackupVaultUpdate,
+		Delete: resourceAwsBackupVaultDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{2,50}$`), "must consist of 2 to 50 alphanumeric, hyphen, underscore or period characters"),
+			},
+			"recovery_points": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsBackupVaultCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+	name := d.Get("name").(string)
+
+	input := &backup.CreateBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.BackupVaultTags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Backup Vault: %s", input)
+	_, err := conn.CreateBackupVault(input)
+	if err != nil {
+		return fmt.Errorf("error creating Backup Vault (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsBackupVaultRead(d, meta)
+}
+
+func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	output, err := conn.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, "AccessDeniedException", "") {
+		log.Printf("[WARN] Backup Vault (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Backup Vault (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.BackupVaultArn)
+	d.Set("arn", arn)
+	d.Set("kms_key_arn", output.EncryptionKeyArn)
+	d.Set("name", output.BackupVaultName)
+	d.Set("recovery_points", output.NumberOfRecoveryPoints)
+
+	tags, err := getBackupResourceTags(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Vault (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	if err := setTagsBackup(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Backup Vault (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsBackupVaultRead(d, meta)
+}
+
+func resourceAwsBackupVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.DeleteBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Vault: %s", input)
+	_, err := conn.DeleteBackupVault(input)
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, "AccessDeniedException", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Vault (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getBackupResourceTags(conn *backup.Backup, arn string) (map[string]string, error) {
+	tags := make(map[string]*string)
+	input := &backup.ListTagsInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	for {
+		output, err := conn.ListTags(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range output.Tags {
+			tags[k] = v
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return tagsToMapGeneric(tags), nil
+}
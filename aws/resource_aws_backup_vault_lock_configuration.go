@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBackupVaultLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupVaultLockConfigurationCreate,
+		Read:   resourceAwsBackupVaultLockConfigurationRead,
+		Delete: resourceAwsBackupVaultLockConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_vault_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Setting changeable_for_days puts the vault into compliance mode.
+			// Once the grace period has passed the lock cannot be removed.
+			"changeable_for_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(3, 36500),
+			},
+			"max_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceAwsBackupVaultLockConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+	name := d.Get("backup_vault_name").(string)
+
+	input := &backup.PutBackupVaultLockConfigurationInput{
+		BackupVaultName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("changeable_for_days"); ok {
+		input.ChangeableForDays = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_retention_days"); ok {
+		input.MaxRetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("min_retention_days"); ok {
+		input.MinRetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating Backup Vault Lock Configuration: %s", input)
+	_, err := conn.PutBackupVaultLockConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error creating Backup Vault Lock Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsBackupVaultLockConfigurationRead(d, meta)
+}
+
+func resourceAwsBackupVaultLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	output, err := conn.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, "AccessDeniedException", "") {
+		log.Printf("[WARN] Backup Vault Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Backup Vault Lock Configuration (%s): %s", d.Id(), err)
+	}
+
+	if !aws.BoolValue(output.Locked) {
+		log.Printf("[WARN] Backup Vault Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backup_vault_arn", output.BackupVaultArn)
+	d.Set("backup_vault_name", output.BackupVaultName)
+	d.Set("max_retention_days", output.MaxRetentionDays)
+	d.Set("min_retention_days", output.MinRetentionDays)
+
+	return nil
+}
+
+func resourceAwsBackupVaultLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.DeleteBackupVaultLockConfigurationInput{
+		BackupVaultName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Backup Vault Lock Configuration: %s", input)
+	_, err := conn.DeleteBackupVaultLockConfiguration(input)
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Vault Lock Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsBackupVaultLockConfiguration_basic(t *testing.T) {
+	resourceName := "aws_backup_vault_lock_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBackupVaultLockConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupVaultLockConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBackupVaultLockConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_vault_arn", "aws_backup_vault.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "backup_vault_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsBackupVaultLockConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).backupconn
+
+		output, err := conn.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+			BackupVaultName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(output.Locked) {
+			return fmt.Errorf("Backup Vault (%s) is not locked", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsBackupVaultLockConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).backupconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_backup_vault_lock_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+			BackupVaultName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, "AccessDeniedException", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.Locked) {
+			return fmt.Errorf("Backup Vault Lock Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// The lock is created in governance mode so that it can be removed again.
+func testAccBackupVaultLockConfigurationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_vault_lock_configuration" "test" {
+  backup_vault_name  = "${aws_backup_vault.test.name}"
+  max_retention_days = 1200
+  min_retention_days = 7
+}
+`, rName)
+}
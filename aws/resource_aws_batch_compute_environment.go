@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,8 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 		Update: resourceAwsBatchComputeEnvironmentUpdate,
 		Delete: resourceAwsBatchComputeEnvironmentDelete,
 
+		CustomizeDiff: resourceAwsBatchComputeEnvironmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"compute_environment_name": {
 				Type:         schema.TypeString,
@@ -33,6 +36,18 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								batch.CRAllocationStrategyBestFit,
+								batch.CRAllocationStrategyBestFitProgressive,
+								batch.CRAllocationStrategySpotCapacityOptimized,
+								batch.CRAllocationStrategySpotPriceCapacityOptimized,
+							}, false),
+						},
 						"bid_percentage": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -54,23 +69,47 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 						},
 						"instance_role": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: validateArn,
 						},
 						"instance_type": {
 							Type:     schema.TypeSet,
-							Required: true,
-							ForceNew: true,
+							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"launch_template": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"launch_template_id": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_name"},
+									},
+									"launch_template_name": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_id"},
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
 						"max_vcpus": {
 							Type:     schema.TypeInt,
 							Required: true,
 						},
 						"min_vcpus": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
 						},
 						"security_group_ids": {
 							Type:     schema.TypeSet,
@@ -95,7 +134,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice([]string{batch.CRTypeEc2, batch.CRTypeSpot}, true),
+							ValidateFunc: validation.StringInSlice([]string{batch.CRTypeEc2, batch.CRTypeSpot, batch.CRTypeFargate, batch.CRTypeFargateSpot}, true),
 						},
 					},
 				},
@@ -165,56 +204,11 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 		if len(computeResources) == 0 {
 			return fmt.Errorf("One compute environment is expected, but no compute environments are set")
 		}
-		computeResource := computeResources[0].(map[string]interface{})
-
-		instanceRole := computeResource["instance_role"].(string)
-		maxvCpus := int64(computeResource["max_vcpus"].(int))
-		minvCpus := int64(computeResource["min_vcpus"].(int))
-		computeResourceType := computeResource["type"].(string)
-
-		var instanceTypes []*string
-		for _, v := range computeResource["instance_type"].(*schema.Set).List() {
-			instanceTypes = append(instanceTypes, aws.String(v.(string)))
-		}
-
-		var securityGroupIds []*string
-		for _, v := range computeResource["security_group_ids"].(*schema.Set).List() {
-			securityGroupIds = append(securityGroupIds, aws.String(v.(string)))
-		}
-
-		var subnets []*string
-		for _, v := range computeResource["subnets"].(*schema.Set).List() {
-			subnets = append(subnets, aws.String(v.(string)))
-		}
-
-		input.ComputeResources = &batch.ComputeResource{
-			InstanceRole:     aws.String(instanceRole),
-			InstanceTypes:    instanceTypes,
-			MaxvCpus:         aws.Int64(maxvCpus),
-			MinvCpus:         aws.Int64(minvCpus),
-			SecurityGroupIds: securityGroupIds,
-			Subnets:          subnets,
-			Type:             aws.String(computeResourceType),
-		}
-
-		if v, ok := computeResource["bid_percentage"]; ok {
-			input.ComputeResources.BidPercentage = aws.Int64(int64(v.(int)))
-		}
-		if v, ok := computeResource["desired_vcpus"]; ok {
-			input.ComputeResources.DesiredvCpus = aws.Int64(int64(v.(int)))
-		}
-		if v, ok := computeResource["ec2_key_pair"]; ok {
-			input.ComputeResources.Ec2KeyPair = aws.String(v.(string))
-		}
-		if v, ok := computeResource["image_id"]; ok {
-			input.ComputeResources.ImageId = aws.String(v.(string))
-		}
-		if v, ok := computeResource["spot_iam_fleet_role"]; ok {
-			input.ComputeResources.SpotIamFleetRole = aws.String(v.(string))
-		}
-		if v, ok := computeResource["tags"]; ok {
-			input.ComputeResources.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+		computeResource, err := expandBatchComputeResource(computeResources[0].(map[string]interface{}))
+		if err != nil {
+			return err
 		}
+		input.ComputeResources = computeResource
 	}
 
 	log.Printf("[DEBUG] Create compute environment %s.\n", input)
@@ -285,12 +279,14 @@ func flattenBatchComputeResources(computeResource *batch.ComputeResource) []map[
 	result := make([]map[string]interface{}, 0)
 	m := make(map[string]interface{})
 
+	m["allocation_strategy"] = aws.StringValue(computeResource.AllocationStrategy)
 	m["bid_percentage"] = int(aws.Int64Value(computeResource.BidPercentage))
 	m["desired_vcpus"] = int(aws.Int64Value(computeResource.DesiredvCpus))
 	m["ec2_key_pair"] = aws.StringValue(computeResource.Ec2KeyPair)
 	m["image_id"] = aws.StringValue(computeResource.ImageId)
 	m["instance_role"] = aws.StringValue(computeResource.InstanceRole)
 	m["instance_type"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.InstanceTypes))
+	m["launch_template"] = flattenBatchLaunchTemplateSpecification(computeResource.LaunchTemplate)
 	m["max_vcpus"] = int(aws.Int64Value(computeResource.MaxvCpus))
 	m["min_vcpus"] = int(aws.Int64Value(computeResource.MinvCpus))
 	m["security_group_ids"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.SecurityGroupIds))
@@ -303,6 +299,159 @@ func flattenBatchComputeResources(computeResource *batch.ComputeResource) []map[
 	return result
 }
 
+func isBatchFargateComputeResourceType(computeResourceType string) bool {
+	switch strings.ToUpper(computeResourceType) {
+	case batch.CRTypeFargate, batch.CRTypeFargateSpot:
+		return true
+	}
+	return false
+}
+
+func resourceAwsBatchComputeEnvironmentCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if len(diff.Get("compute_resources").([]interface{})) == 0 {
+		return nil
+	}
+
+	computeResourceType := diff.Get("compute_resources.0.type").(string)
+
+	if isBatchFargateComputeResourceType(computeResourceType) {
+		if diff.Id() == "" || diff.HasChange("compute_resources.0.type") {
+			return validateBatchFargateComputeResource(diff, computeResourceType)
+		}
+
+		return nil
+	}
+
+	// Instance types and launch templates can only be updated in place for the
+	// allocation strategies other than BEST_FIT.
+	if diff.Id() != "" {
+		switch diff.Get("compute_resources.0.allocation_strategy").(string) {
+		case "", batch.CRAllocationStrategyBestFit:
+			for _, k := range []string{"compute_resources.0.instance_type", "compute_resources.0.launch_template"} {
+				if diff.HasChange(k) {
+					if err := diff.ForceNew(k); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBatchFargateComputeResource rejects the EC2-specific settings, which
+// Fargate compute resources do not accept as they are provisioned by AWS.
+func validateBatchFargateComputeResource(diff *schema.ResourceDiff, computeResourceType string) error {
+	for _, k := range []string{"allocation_strategy", "ec2_key_pair", "image_id", "instance_role", "spot_iam_fleet_role"} {
+		if diff.Get("compute_resources.0."+k).(string) != "" {
+			return fmt.Errorf("%q cannot be specified for %s compute resources", k, computeResourceType)
+		}
+	}
+	for _, k := range []string{"bid_percentage", "desired_vcpus", "min_vcpus"} {
+		if diff.Get("compute_resources.0."+k).(int) != 0 {
+			return fmt.Errorf("%q cannot be specified for %s compute resources", k, computeResourceType)
+		}
+	}
+	if diff.Get("compute_resources.0.instance_type").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("\"instance_type\" cannot be specified for %s compute resources", computeResourceType)
+	}
+	if len(diff.Get("compute_resources.0.launch_template").([]interface{})) > 0 {
+		return fmt.Errorf("\"launch_template\" cannot be specified for %s compute resources", computeResourceType)
+	}
+	if len(diff.Get("compute_resources.0.tags").(map[string]interface{})) > 0 {
+		return fmt.Errorf("\"tags\" cannot be specified for %s compute resources", computeResourceType)
+	}
+
+	return nil
+}
+
+func expandBatchComputeResource(computeResource map[string]interface{}) (*batch.ComputeResource, error) {
+	computeResourceType := computeResource["type"].(string)
+
+	result := &batch.ComputeResource{
+		MaxvCpus:         aws.Int64(int64(computeResource["max_vcpus"].(int))),
+		SecurityGroupIds: expandStringSet(computeResource["security_group_ids"].(*schema.Set)),
+		Subnets:          expandStringSet(computeResource["subnets"].(*schema.Set)),
+		Type:             aws.String(computeResourceType),
+	}
+
+	// The EC2-specific settings are rejected for Fargate at plan time.
+	if isBatchFargateComputeResourceType(computeResourceType) {
+		return result, nil
+	}
+
+	instanceRole := computeResource["instance_role"].(string)
+	if instanceRole == "" {
+		return nil, fmt.Errorf("\"instance_role\" is required for %s compute resources", computeResourceType)
+	}
+	instanceTypes := computeResource["instance_type"].(*schema.Set)
+	if instanceTypes.Len() == 0 {
+		return nil, fmt.Errorf("\"instance_type\" is required for %s compute resources", computeResourceType)
+	}
+
+	result.InstanceRole = aws.String(instanceRole)
+	result.InstanceTypes = expandStringSet(instanceTypes)
+	result.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+
+	if v, ok := computeResource["allocation_strategy"].(string); ok && v != "" {
+		result.AllocationStrategy = aws.String(v)
+	}
+	if v, ok := computeResource["bid_percentage"].(int); ok && v != 0 {
+		result.BidPercentage = aws.Int64(int64(v))
+	}
+	if v, ok := computeResource["desired_vcpus"].(int); ok && v != 0 {
+		result.DesiredvCpus = aws.Int64(int64(v))
+	}
+	if v, ok := computeResource["ec2_key_pair"].(string); ok && v != "" {
+		result.Ec2KeyPair = aws.String(v)
+	}
+	if v, ok := computeResource["image_id"].(string); ok && v != "" {
+		result.ImageId = aws.String(v)
+	}
+	if v, ok := computeResource["launch_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		result.LaunchTemplate = expandBatchLaunchTemplateSpecification(v[0].(map[string]interface{}))
+	}
+	if v, ok := computeResource["spot_iam_fleet_role"].(string); ok && v != "" {
+		result.SpotIamFleetRole = aws.String(v)
+	}
+	if v, ok := computeResource["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		result.Tags = tagsFromMapGeneric(v)
+	}
+
+	return result, nil
+}
+
+func expandBatchLaunchTemplateSpecification(launchTemplate map[string]interface{}) *batch.LaunchTemplateSpecification {
+	result := &batch.LaunchTemplateSpecification{}
+
+	if v, ok := launchTemplate["launch_template_id"].(string); ok && v != "" {
+		result.LaunchTemplateId = aws.String(v)
+	}
+	if v, ok := launchTemplate["launch_template_name"].(string); ok && v != "" {
+		result.LaunchTemplateName = aws.String(v)
+	}
+	if v, ok := launchTemplate["version"].(string); ok && v != "" {
+		result.Version = aws.String(v)
+	}
+
+	return result
+}
+
+func flattenBatchLaunchTemplateSpecification(launchTemplate *batch.LaunchTemplateSpecification) []interface{} {
+	if launchTemplate == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"launch_template_id":   aws.StringValue(launchTemplate.LaunchTemplateId),
+		"launch_template_name": aws.StringValue(launchTemplate.LaunchTemplateName),
+		"version":              aws.StringValue(launchTemplate.Version),
+	}
+
+	return []interface{}{m}
+}
+
 func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
@@ -329,7 +478,6 @@ func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta inter
 
 	input := &batch.UpdateComputeEnvironmentInput{
 		ComputeEnvironment: aws.String(computeEnvironmentName),
-		ComputeResources:   &batch.ComputeResourceUpdate{},
 	}
 
 	if d.HasChange("service_role") {
@@ -346,15 +494,43 @@ func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta inter
 		}
 		computeResource := computeResources[0].(map[string]interface{})
 
-		input.ComputeResources.DesiredvCpus = aws.Int64(int64(computeResource["desired_vcpus"].(int)))
-		input.ComputeResources.MaxvCpus = aws.Int64(int64(computeResource["max_vcpus"].(int)))
-		input.ComputeResources.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+		computeResourceUpdate := &batch.ComputeResourceUpdate{
+			MaxvCpus: aws.Int64(int64(computeResource["max_vcpus"].(int))),
+		}
+
+		if !isBatchFargateComputeResourceType(computeResource["type"].(string)) {
+			computeResourceUpdate.DesiredvCpus = aws.Int64(int64(computeResource["desired_vcpus"].(int)))
+			computeResourceUpdate.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+
+			if d.HasChange("compute_resources.0.instance_type") {
+				computeResourceUpdate.InstanceTypes = expandStringSet(computeResource["instance_type"].(*schema.Set))
+			}
+
+			if d.HasChange("compute_resources.0.launch_template.0.version") {
+				if v, ok := computeResource["launch_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					computeResourceUpdate.LaunchTemplate = expandBatchLaunchTemplateSpecification(v[0].(map[string]interface{}))
+				}
+			}
+		}
+
+		input.ComputeResources = computeResourceUpdate
 	}
 
 	log.Printf("[DEBUG] Update compute environment %s.\n", input)
 
 	if _, err := conn.UpdateComputeEnvironment(input); err != nil {
-		return err
+		return fmt.Errorf("error updating Batch Compute Environment (%s): %s", computeEnvironmentName, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.CEStatusUpdating},
+		Target:     []string{batch.CEStatusValid},
+		Refresh:    resourceAwsBatchComputeEnvironmentStatusRefreshFunc(computeEnvironmentName, conn),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Batch Compute Environment (%s) update: %s", computeEnvironmentName, err)
 	}
 
 	return resourceAwsBatchComputeEnvironmentRead(d, meta)
@@ -172,6 +172,7 @@ func TestAccAWSBatchComputeEnvironment_updateMaxvCpus(t *testing.T) {
 }
 
 func TestAccAWSBatchComputeEnvironment_updateInstanceType(t *testing.T) {
+	var ecsClusterArn string
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
@@ -183,13 +184,45 @@ func TestAccAWSBatchComputeEnvironment_updateInstanceType(t *testing.T) {
 				Config: testAccAWSBatchComputeEnvironmentConfigEC2(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsBatchComputeEnvironmentExists(),
+					testAccCheckAwsBatchComputeEnvironmentEcsClusterArn("aws_batch_compute_environment.ec2", &ecsClusterArn, true),
 					resource.TestCheckResourceAttr("aws_batch_compute_environment.ec2", "compute_resources.0.instance_type.#", "1"),
 				),
 			},
 			{
+				// BEST_FIT compute environments are replaced.
 				Config: testAccAWSBatchComputeEnvironmentConfigEC2UpdateInstanceType(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsBatchComputeEnvironmentExists(),
+					testAccCheckAwsBatchComputeEnvironmentEcsClusterArn("aws_batch_compute_environment.ec2", &ecsClusterArn, false),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.ec2", "compute_resources.0.instance_type.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBatchComputeEnvironment_updateInstanceTypeBestFitProgressive(t *testing.T) {
+	var ecsClusterArn string
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigEC2AllocationStrategy(rInt, batch.CRAllocationStrategyBestFitProgressive, `"c4.large"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					testAccCheckAwsBatchComputeEnvironmentEcsClusterArn("aws_batch_compute_environment.ec2", &ecsClusterArn, true),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.ec2", "compute_resources.0.instance_type.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigEC2AllocationStrategy(rInt, batch.CRAllocationStrategyBestFitProgressive, `"c4.large", "c4.xlarge"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					testAccCheckAwsBatchComputeEnvironmentEcsClusterArn("aws_batch_compute_environment.ec2", &ecsClusterArn, true),
 					resource.TestCheckResourceAttr("aws_batch_compute_environment.ec2", "compute_resources.0.instance_type.#", "2"),
 				),
 			},
@@ -302,6 +335,68 @@ func TestAccAWSBatchComputeEnvironment_updateState(t *testing.T) {
 	})
 }
 
+func TestAccAWSBatchComputeEnvironment_createFargate(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigFargate(rInt, "FARGATE", 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.fargate", "compute_resources.0.type", "FARGATE"),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.fargate", "compute_resources.0.instance_type.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigFargate(rInt, "FARGATE", 32),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.fargate", "compute_resources.0.max_vcpus", "32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBatchComputeEnvironment_createFargateSpot(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigFargate(rInt, "FARGATE_SPOT", 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.fargate", "compute_resources.0.type", "FARGATE_SPOT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBatchComputeEnvironment_createFargateWithInstanceRole(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSBatchComputeEnvironmentConfigFargateWithInstanceRole(rInt),
+				ExpectError: regexp.MustCompile(`"instance_role" cannot be specified for FARGATE compute resources`),
+			},
+		},
+	})
+}
+
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).batchconn
 
@@ -328,6 +423,29 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckAwsBatchComputeEnvironmentEcsClusterArn records the ECS cluster
+// of the compute environment and checks whether it is the one recorded by a
+// previous step, i.e. whether the compute environment was updated in place.
+func testAccCheckAwsBatchComputeEnvironmentEcsClusterArn(n string, ecsClusterArn *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		arn := rs.Primary.Attributes["ecs_cluster_arn"]
+		if *ecsClusterArn != "" && (arn == *ecsClusterArn) != same {
+			if same {
+				return fmt.Errorf("Batch Compute Environment %s was replaced", rs.Primary.ID)
+			}
+			return fmt.Errorf("Batch Compute Environment %s was not replaced", rs.Primary.ID)
+		}
+		*ecsClusterArn = arn
+
+		return nil
+	}
+}
+
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).batchconn
@@ -612,6 +730,31 @@ resource "aws_batch_compute_environment" "ec2" {
 `, rInt)
 }
 
+func testAccAWSBatchComputeEnvironmentConfigEC2AllocationStrategy(rInt int, allocationStrategy, instanceTypes string) string {
+	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "ec2" {
+  compute_environment_name = "tf_acc_test_%[1]d"
+  compute_resources {
+    allocation_strategy = %[2]q
+    instance_role = "${aws_iam_instance_profile.ecs_instance_role.arn}"
+    instance_type = [%[3]s]
+    max_vcpus = 16
+    min_vcpus = 0
+    security_group_ids = [
+      "${aws_security_group.test_acc.id}"
+    ]
+    subnets = [
+      "${aws_subnet.test_acc.id}"
+    ]
+    type = "EC2"
+  }
+  service_role = "${aws_iam_role.aws_batch_service_role.arn}"
+  type = "MANAGED"
+  depends_on = ["aws_iam_role_policy_attachment.aws_batch_service_role"]
+}
+`, rInt, allocationStrategy, instanceTypes)
+}
+
 func testAccAWSBatchComputeEnvironmentConfigEC2UpdateState(rInt int, state string) string {
 	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
 resource "aws_batch_compute_environment" "ec2" {
@@ -727,3 +870,46 @@ resource "aws_batch_compute_environment" "ec2" {
 }
 `, rInt)
 }
+
+func testAccAWSBatchComputeEnvironmentConfigFargate(rInt int, computeResourceType string, maxvCpus int) string {
+	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "fargate" {
+  compute_environment_name = "tf_acc_test_%[1]d"
+  compute_resources {
+    max_vcpus = %[3]d
+    security_group_ids = [
+      "${aws_security_group.test_acc.id}"
+    ]
+    subnets = [
+      "${aws_subnet.test_acc.id}"
+    ]
+    type = %[2]q
+  }
+  service_role = "${aws_iam_role.aws_batch_service_role.arn}"
+  type = "MANAGED"
+  depends_on = ["aws_iam_role_policy_attachment.aws_batch_service_role"]
+}
+`, rInt, computeResourceType, maxvCpus)
+}
+
+func testAccAWSBatchComputeEnvironmentConfigFargateWithInstanceRole(rInt int) string {
+	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "fargate" {
+  compute_environment_name = "tf_acc_test_%d"
+  compute_resources {
+    instance_role = "${aws_iam_instance_profile.ecs_instance_role.arn}"
+    max_vcpus = 16
+    security_group_ids = [
+      "${aws_security_group.test_acc.id}"
+    ]
+    subnets = [
+      "${aws_subnet.test_acc.id}"
+    ]
+    type = "FARGATE"
+  }
+  service_role = "${aws_iam_role.aws_batch_service_role.arn}"
+  type = "MANAGED"
+  depends_on = ["aws_iam_role_policy_attachment.aws_batch_service_role"]
+}
+`, rInt)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
@@ -32,11 +33,23 @@ func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
 			"automatic_stop_time_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
 				ForceNew: true,
 			},
+			"connection_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloud9.ConnectionTypeConnectSsh,
+				ValidateFunc: validation.StringInSlice(cloud9.ConnectionType_Values(), false),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -61,6 +74,7 @@ func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -69,9 +83,12 @@ func resourceAwsCloud9EnvironmentEc2Create(d *schema.ResourceData, meta interfac
 	conn := meta.(*AWSClient).cloud9conn
 
 	params := &cloud9.CreateEnvironmentEC2Input{
+		ConnectionType:     aws.String(d.Get("connection_type").(string)),
+		ImageId:            aws.String(d.Get("image_id").(string)),
 		InstanceType:       aws.String(d.Get("instance_type").(string)),
 		Name:               aws.String(d.Get("name").(string)),
 		ClientRequestToken: aws.String(resource.UniqueId()),
+		Tags:               tagsFromMapCloud9(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("automatic_stop_time_minutes"); ok {
@@ -164,6 +181,7 @@ func resourceAwsCloud9EnvironmentEc2Read(d *schema.ResourceData, meta interface{
 	env := out.Environments[0]
 
 	d.Set("arn", env.Arn)
+	d.Set("connection_type", env.ConnectionType)
 	d.Set("description", env.Description)
 	d.Set("name", env.Name)
 	d.Set("owner_arn", env.OwnerArn)
@@ -171,12 +189,32 @@ func resourceAwsCloud9EnvironmentEc2Read(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Received Cloud9 Environment EC2: %s", env)
 
+	tagsOut, err := conn.ListTagsForResource(&cloud9.ListTagsForResourceInput{
+		ResourceARN: env.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Cloud9 Environment EC2 (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapCloud9(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsCloud9EnvironmentEc2Update(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloud9conn
 
+	if d.HasChange("tags") {
+		if err := setTagsCloud9(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Cloud9 Environment EC2 (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if !d.HasChange("description") && !d.HasChange("name") {
+		return resourceAwsCloud9EnvironmentEc2Read(d, meta)
+	}
+
 	input := cloud9.UpdateEnvironmentInput{
 		Description:   aws.String(d.Get("description").(string)),
 		EnvironmentId: aws.String(d.Id()),
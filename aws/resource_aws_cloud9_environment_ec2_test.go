@@ -32,6 +32,8 @@ func TestAccAWSCloud9EnvironmentEc2_basic(t *testing.T) {
 					testAccCheckAWSCloud9EnvironmentEc2Exists(resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
 					resource.TestCheckResourceAttr(resourceName, "name", envName),
+					resource.TestCheckResourceAttr(resourceName, "image_id", "amazonlinux-2-x86_64"),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "CONNECT_SSH"),
 					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cloud9:[^:]+:[^:]+:environment:.+$`)),
 					resource.TestMatchResourceAttr(resourceName, "owner_arn", regexp.MustCompile(`^arn:`)),
 				),
@@ -93,6 +95,72 @@ func TestAccAWSCloud9EnvironmentEc2_allFields(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloud9EnvironmentEc2_connectionTypeSsm(t *testing.T) {
+	var conf cloud9.Environment
+
+	rString := acctest.RandString(8)
+	envName := fmt.Sprintf("tf_acc_env_ssm_%s", rString)
+
+	resourceName := "aws_cloud9_environment_ec2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloud9EnvironmentEc2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloud9EnvironmentEc2ConnectionTypeConfig(envName, "CONNECT_SSM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentEc2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "CONNECT_SSM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloud9EnvironmentEc2_tags(t *testing.T) {
+	var conf cloud9.Environment
+
+	rString := acctest.RandString(8)
+	envName := fmt.Sprintf("tf_acc_env_tags_%s", rString)
+
+	resourceName := "aws_cloud9_environment_ec2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloud9EnvironmentEc2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloud9EnvironmentEc2ConfigTags1(envName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentEc2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCloud9EnvironmentEc2ConfigTags2(envName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentEc2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCloud9EnvironmentEc2ConfigTags1(envName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentEc2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCloud9EnvironmentEc2_importBasic(t *testing.T) {
 	rString := acctest.RandString(8)
 	name := fmt.Sprintf("tf_acc_api_doc_part_import_%s", rString)
@@ -111,7 +179,7 @@ func TestAccAWSCloud9EnvironmentEc2_importBasic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"instance_type"},
+				ImportStateVerifyIgnore: []string{"image_id", "instance_type"},
 			},
 		},
 	})
@@ -184,6 +252,7 @@ func testAccAWSCloud9EnvironmentEc2Config(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cloud9_environment_ec2" "test" {
   instance_type = "t2.micro"
+  image_id = "amazonlinux-2-x86_64"
   name = "%s"
 }
 `, name)
@@ -193,6 +262,7 @@ func testAccAWSCloud9EnvironmentEc2AllFieldsConfig(name, description, userName s
 	return fmt.Sprintf(`
 resource "aws_cloud9_environment_ec2" "test" {
   instance_type = "t2.micro"
+  image_id = "amazonlinux-2-x86_64"
   name = "%s"
   description = "%s"
   automatic_stop_time_minutes = 60
@@ -238,3 +308,43 @@ resource "aws_iam_user" "test" {
 }
 `, name, description, userName)
 }
+
+func testAccAWSCloud9EnvironmentEc2ConnectionTypeConfig(name, connectionType string) string {
+	return fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type   = "t2.micro"
+  image_id        = "amazonlinux-2-x86_64"
+  name            = %[1]q
+  connection_type = %[2]q
+}
+`, name, connectionType)
+}
+
+func testAccAWSCloud9EnvironmentEc2ConfigTags1(name, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type = "t2.micro"
+  image_id      = "amazonlinux-2-x86_64"
+  name          = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, name, tagKey1, tagValue1)
+}
+
+func testAccAWSCloud9EnvironmentEc2ConfigTags2(name, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type = "t2.micro"
+  image_id      = "amazonlinux-2-x86_64"
+  name          = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, name, tagKey1, tagValue1, tagKey2, tagValue2)
+}
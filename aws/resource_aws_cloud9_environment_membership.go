@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloud9EnvironmentMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloud9EnvironmentMembershipCreate,
+		Read:   resourceAwsCloud9EnvironmentMembershipRead,
+		Update: resourceAwsCloud9EnvironmentMembershipUpdate,
+		Delete: resourceAwsCloud9EnvironmentMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permissions": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloud9.MemberPermissions_Values(), false),
+			},
+			"user_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloud9EnvironmentMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	envId := d.Get("environment_id").(string)
+	userArn := d.Get("user_arn").(string)
+
+	input := &cloud9.CreateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(envId),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(userArn),
+	}
+
+	log.Printf("[DEBUG] Creating Cloud9 Environment Membership: %s", input)
+	_, err := conn.CreateEnvironmentMembership(input)
+	if err != nil {
+		return fmt.Errorf("error creating Cloud9 Environment Membership: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", envId, userArn))
+
+	return resourceAwsCloud9EnvironmentMembershipRead(d, meta)
+}
+
+func resourceAwsCloud9EnvironmentMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	envId, userArn, err := decodeCloud9EnvironmentMembershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := getCloud9EnvironmentMembership(conn, envId, userArn)
+	if err != nil {
+		if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Cloud9 Environment Membership (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+	if member == nil {
+		log.Printf("[WARN] Cloud9 Environment Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("environment_id", member.EnvironmentId)
+	d.Set("permissions", member.Permissions)
+	d.Set("user_arn", member.UserArn)
+	d.Set("user_id", member.UserId)
+
+	return nil
+}
+
+func resourceAwsCloud9EnvironmentMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	input := &cloud9.UpdateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(d.Get("user_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Cloud9 Environment Membership: %s", input)
+	_, err := conn.UpdateEnvironmentMembership(input)
+	if err != nil {
+		return fmt.Errorf("error updating Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloud9EnvironmentMembershipRead(d, meta)
+}
+
+func resourceAwsCloud9EnvironmentMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	log.Printf("[DEBUG] Deleting Cloud9 Environment Membership: %s", d.Id())
+	_, err := conn.DeleteEnvironmentMembership(&cloud9.DeleteEnvironmentMembershipInput{
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+		UserArn:       aws.String(d.Get("user_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getCloud9EnvironmentMembership(conn *cloud9.Cloud9, envId, userArn string) (*cloud9.EnvironmentMember, error) {
+	out, err := conn.DescribeEnvironmentMemberships(&cloud9.DescribeEnvironmentMembershipsInput{
+		EnvironmentId: aws.String(envId),
+		UserArn:       aws.String(userArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range out.Memberships {
+		if aws.StringValue(member.UserArn) == userArn {
+			return member, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func decodeCloud9EnvironmentMembershipID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ENVIRONMENT_ID/USER_ARN", id)
+	}
+	return parts[0], parts[1], nil
+}
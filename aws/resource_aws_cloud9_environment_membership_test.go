@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloud9EnvironmentMembership_basic(t *testing.T) {
+	var conf cloud9.EnvironmentMember
+
+	rString := acctest.RandString(8)
+	envName := fmt.Sprintf("tf_acc_env_member_%s", rString)
+	userName := fmt.Sprintf("tf_acc_cloud9_member_%s", rString)
+
+	resourceName := "aws_cloud9_environment_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloud9EnvironmentMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, "read-only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "read-only"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_cloud9_environment_ec2.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_arn", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "aws_iam_user.test", "unique_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, "read-write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "read-write"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeCloud9EnvironmentMembershipID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		EnvId       string
+		UserArn     string
+		ErrorExpect bool
+	}{
+		{
+			ID:      "0123456789abcdef/arn:aws:iam::123456789012:user/example",
+			EnvId:   "0123456789abcdef",
+			UserArn: "arn:aws:iam::123456789012:user/example",
+		},
+		{
+			ID:          "0123456789abcdef",
+			ErrorExpect: true,
+		},
+		{
+			ID:          "/arn:aws:iam::123456789012:user/example",
+			ErrorExpect: true,
+		},
+	}
+
+	for _, tc := range cases {
+		envId, userArn, err := decodeCloud9EnvironmentMembershipID(tc.ID)
+		if tc.ErrorExpect {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+		if envId != tc.EnvId || userArn != tc.UserArn {
+			t.Errorf("ID %q: expected (%q, %q), got (%q, %q)", tc.ID, tc.EnvId, tc.UserArn, envId, userArn)
+		}
+	}
+}
+
+func testAccCheckAWSCloud9EnvironmentMembershipExists(n string, res *cloud9.EnvironmentMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud9 Environment Membership ID is set")
+		}
+
+		envId, userArn, err := decodeCloud9EnvironmentMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+
+		member, err := getCloud9EnvironmentMembership(conn, envId, userArn)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return fmt.Errorf("Cloud9 Environment Membership (%q) not found", rs.Primary.ID)
+		}
+
+		*res = *member
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloud9EnvironmentMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloud9_environment_membership" {
+			continue
+		}
+
+		envId, userArn, err := decodeCloud9EnvironmentMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := getCloud9EnvironmentMembership(conn, envId, userArn)
+		if err != nil {
+			if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+				continue
+			}
+			// The environment itself may already be gone.
+			if isAWSErr(err, "AccessDeniedException", "is not authorized to access this resource") {
+				continue
+			}
+			return err
+		}
+		if member != nil {
+			return fmt.Errorf("Cloud9 Environment Membership %q still exists.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, permissions string) string {
+	return fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type = "t2.micro"
+  image_id      = "amazonlinux-2-x86_64"
+  name          = %[1]q
+}
+
+resource "aws_iam_user" "test" {
+  name = %[2]q
+}
+
+resource "aws_cloud9_environment_membership" "test" {
+  environment_id = "${aws_cloud9_environment_ec2.test.id}"
+  permissions    = %[3]q
+  user_arn       = "${aws_iam_user.test.arn}"
+}
+`, envName, userName, permissions)
+}
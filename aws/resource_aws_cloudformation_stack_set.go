@@ -0,0 +1,379 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudFormationStackSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetCreate,
+		Read:   resourceAwsCloudFormationStackSetRead,
+		Update: resourceAwsCloudFormationStackSetUpdate,
+		Delete: resourceAwsCloudFormationStackSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"administration_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"retain_stacks_on_account_removal": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"call_as": cloudFormationStackSetCallAsSchema(),
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.CapabilityCapabilityAutoExpand,
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,127}$`), "must begin with an alphabetic character, contain only alphanumeric and hyphen characters and be at most 128 characters"),
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permission_model": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  cloudformation.PermissionModelsSelfManaged,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudformation.PermissionModelsSelfManaged,
+					cloudformation.PermissionModelsServiceManaged,
+				}, false),
+			},
+			"stack_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"template_url"},
+				ValidateFunc:  validateCloudFormationTemplate,
+				StateFunc: func(v interface{}) string {
+					template, _ := normalizeCloudFormationTemplate(v)
+					return template
+				},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+		},
+
+		CustomizeDiff: resourceAwsCloudFormationStackSetCustomizeDiff,
+	}
+}
+
+func resourceAwsCloudFormationStackSetCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	permissionModel := diff.Get("permission_model").(string)
+
+	if _, ok := diff.GetOk("auto_deployment"); ok && permissionModel != cloudformation.PermissionModelsServiceManaged {
+		return fmt.Errorf("auto_deployment can only be configured with the %s permission model", cloudformation.PermissionModelsServiceManaged)
+	}
+
+	if permissionModel == cloudformation.PermissionModelsServiceManaged {
+		if _, ok := diff.GetOk("administration_role_arn"); ok {
+			return fmt.Errorf("administration_role_arn cannot be configured with the %s permission model", cloudformation.PermissionModelsServiceManaged)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	name := d.Get("name").(string)
+	input := &cloudformation.CreateStackSetInput{
+		CallAs:          aws.String(d.Get("call_as").(string)),
+		PermissionModel: aws.String(d.Get("permission_model").(string)),
+		StackSetName:    aws.String(name),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok {
+		input.AutoDeployment = expandCloudFormationStackSetAutoDeployment(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet: %s", input)
+	_, err := conn.CreateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudFormation StackSet (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	output, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+		CallAs:       aws.String(d.Get("call_as").(string)),
+		StackSetName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	stackSet := output.StackSet
+	if stackSet == nil || aws.StringValue(stackSet.Status) == cloudformation.StackSetStatusDeleted {
+		log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("administration_role_arn", stackSet.AdministrationRoleARN)
+	d.Set("arn", stackSet.StackSetARN)
+
+	// Service-managed stack sets always report an auto deployment setting, so
+	// it is only tracked when configured to avoid a perpetual diff.
+	if len(d.Get("auto_deployment").([]interface{})) > 0 {
+		if err := d.Set("auto_deployment", flattenCloudFormationStackSetAutoDeployment(stackSet.AutoDeployment)); err != nil {
+			return fmt.Errorf("error setting auto_deployment: %s", err)
+		}
+	}
+
+	if err := d.Set("capabilities", schema.NewSet(schema.HashString, flattenStringList(stackSet.Capabilities))); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	d.Set("description", stackSet.Description)
+	d.Set("execution_role_name", stackSet.ExecutionRoleName)
+	d.Set("name", stackSet.StackSetName)
+
+	if err := d.Set("parameters", flattenAllCloudFormationParameters(stackSet.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	d.Set("permission_model", stackSet.PermissionModel)
+	d.Set("stack_set_id", stackSet.StackSetId)
+
+	if err := d.Set("tags", flattenCloudFormationTags(stackSet.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	template, err := normalizeCloudFormationTemplate(aws.StringValue(stackSet.TemplateBody))
+	if err != nil {
+		return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+	}
+	d.Set("template_body", template)
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	callAs := d.Get("call_as").(string)
+
+	// UpdateStackSet replaces the stack set definition and rolls it out to
+	// every existing stack instance, so all arguments are sent each time.
+	input := &cloudformation.UpdateStackSetInput{
+		CallAs:          aws.String(callAs),
+		PermissionModel: aws.String(d.Get("permission_model").(string)),
+		StackSetName:    aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok {
+		input.AutoDeployment = expandCloudFormationStackSetAutoDeployment(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	} else {
+		template, err := normalizeCloudFormationTemplate(d.Get("template_body"))
+		if err != nil {
+			return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation StackSet: %s", input)
+	output, err := conn.UpdateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, d.Id(), aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	log.Printf("[INFO] Deleting CloudFormation StackSet: %s", d.Id())
+	_, err := conn.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+		CallAs:       aws.String(d.Get("call_as").(string)),
+		StackSetName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFormationStackSetAutoDeployment(l []interface{}) *cloudformation.AutoDeployment {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cloudformation.AutoDeployment{
+		Enabled:                      aws.Bool(m["enabled"].(bool)),
+		RetainStacksOnAccountRemoval: aws.Bool(m["retain_stacks_on_account_removal"].(bool)),
+	}
+}
+
+func flattenCloudFormationStackSetAutoDeployment(autoDeployment *cloudformation.AutoDeployment) []interface{} {
+	if autoDeployment == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":                          aws.BoolValue(autoDeployment.Enabled),
+		"retain_stacks_on_account_removal": aws.BoolValue(autoDeployment.RetainStacksOnAccountRemoval),
+	}
+
+	return []interface{}{m}
+}
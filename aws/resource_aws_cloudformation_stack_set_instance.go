@@ -0,0 +1,443 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetInstanceCreate,
+		Read:   resourceAwsCloudFormationStackSetInstanceRead,
+		Update: resourceAwsCloudFormationStackSetInstanceUpdate,
+		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"deployment_targets"},
+				ValidateFunc:  validateAwsAccountId,
+			},
+			"call_as": cloudFormationStackSetCallAsSchema(),
+			"deployment_targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), "must be an organizational unit or root ID"),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"organizational_unit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"retain_stack": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_instance_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organizational_unit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName := d.Get("stack_set_name").(string)
+	callAs := d.Get("call_as").(string)
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	input := &cloudformation.CreateStackInstancesInput{
+		CallAs:       aws.String(callAs),
+		Regions:      aws.StringSlice([]string{region}),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	// Self-managed stack sets deploy to accounts, service-managed stack sets
+	// deploy to every account in the targeted organizational units.
+	target := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("deployment_targets"); ok {
+		input.DeploymentTargets = expandCloudFormationStackSetDeploymentTargets(v.([]interface{}))
+		target = cloudFormationStackSetInstanceTargetID(input.DeploymentTargets.OrganizationalUnitIds)
+	} else {
+		if v, ok := d.GetOk("account_id"); ok {
+			target = v.(string)
+		}
+		input.Accounts = aws.StringSlice([]string{target})
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.CreateStackInstancesOutput
+	// Only one operation can run against a stack set at a time.
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateStackInstances(input)
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating CloudFormation StackSet (%s) Instance: %s", stackSetName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, target, region))
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, target, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	callAs := d.Get("call_as").(string)
+
+	d.Set("region", region)
+	d.Set("stack_set_name", stackSetName)
+
+	if !cloudFormationStackSetInstanceTargetIsAccount(target) {
+		organizationalUnitIDs := strings.Split(target, "/")
+
+		summaries, err := cloudFormationStackSetInstanceSummaries(conn, stackSetName, region, callAs, organizationalUnitIDs)
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation StackSet Instance (%s) stacks: %s", d.Id(), err)
+		}
+
+		if err := d.Set("deployment_targets", flattenCloudFormationStackSetDeploymentTargets(organizationalUnitIDs)); err != nil {
+			return fmt.Errorf("error setting deployment_targets: %s", err)
+		}
+
+		if err := d.Set("stack_instance_summaries", summaries); err != nil {
+			return fmt.Errorf("error setting stack_instance_summaries: %s", err)
+		}
+
+		return nil
+	}
+
+	output, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+		CallAs:               aws.String(callAs),
+		StackInstanceAccount: aws.String(target),
+		StackInstanceRegion:  aws.String(region),
+		StackSetName:         aws.String(stackSetName),
+	})
+	if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	stackInstance := output.StackInstance
+	if stackInstance == nil {
+		log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", stackInstance.Account)
+	d.Set("organizational_unit_id", stackInstance.OrganizationalUnitId)
+
+	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(stackInstance.ParameterOverrides)); err != nil {
+		return fmt.Errorf("error setting parameter_overrides: %s", err)
+	}
+
+	d.Set("stack_id", stackInstance.StackId)
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	if d.HasChange("parameter_overrides") {
+		stackSetName, target, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		callAs := d.Get("call_as").(string)
+
+		// An empty list removes every override, whereas omitting it keeps them.
+		parameterOverrides := expandCloudFormationParameters(d.Get("parameter_overrides").(map[string]interface{}))
+		if parameterOverrides == nil {
+			parameterOverrides = []*cloudformation.Parameter{}
+		}
+
+		input := &cloudformation.UpdateStackInstancesInput{
+			CallAs:             aws.String(callAs),
+			ParameterOverrides: parameterOverrides,
+			Regions:            aws.StringSlice([]string{region}),
+			StackSetName:       aws.String(stackSetName),
+		}
+
+		if cloudFormationStackSetInstanceTargetIsAccount(target) {
+			input.Accounts = aws.StringSlice([]string{target})
+		} else {
+			input.DeploymentTargets = &cloudformation.DeploymentTargets{
+				OrganizationalUnitIds: aws.StringSlice(strings.Split(target, "/")),
+			}
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok {
+			input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating CloudFormation StackSet Instance: %s", input)
+		var output *cloudformation.UpdateStackInstancesOutput
+		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			var err error
+			output, err = conn.UpdateStackInstances(input)
+			if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, target, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	callAs := d.Get("call_as").(string)
+
+	input := &cloudformation.DeleteStackInstancesInput{
+		CallAs:       aws.String(callAs),
+		Regions:      aws.StringSlice([]string{region}),
+		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if cloudFormationStackSetInstanceTargetIsAccount(target) {
+		input.Accounts = aws.StringSlice([]string{target})
+	} else {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{
+			OrganizationalUnitIds: aws.StringSlice(strings.Split(target, "/")),
+		}
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{}))
+	}
+
+	log.Printf("[INFO] Deleting CloudFormation StackSet Instance: %s", d.Id())
+	var output *cloudformation.DeleteStackInstancesOutput
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		var err error
+		output, err = conn.DeleteStackInstances(input)
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cloudFormationStackSetInstanceTargetID joins organizational unit IDs into the
+// stable middle component of a stack set instance ID.
+func cloudFormationStackSetInstanceTargetID(organizationalUnitIDs []*string) string {
+	ids := aws.StringValueSlice(organizationalUnitIDs)
+	sort.Strings(ids)
+
+	return strings.Join(ids, "/")
+}
+
+func cloudFormationStackSetInstanceTargetIsAccount(target string) bool {
+	return regexp.MustCompile(`^\d{12}$`).MatchString(target)
+}
+
+func cloudFormationStackSetInstanceSummaries(conn *cloudformation.CloudFormation, stackSetName, region, callAs string, organizationalUnitIDs []string) ([]map[string]interface{}, error) {
+	input := &cloudformation.ListStackInstancesInput{
+		CallAs:              aws.String(callAs),
+		StackInstanceRegion: aws.String(region),
+		StackSetName:        aws.String(stackSetName),
+	}
+
+	targeted := make(map[string]bool, len(organizationalUnitIDs))
+	for _, id := range organizationalUnitIDs {
+		targeted[id] = true
+	}
+
+	summaries := make([]map[string]interface{}, 0)
+
+	err := conn.ListStackInstancesPages(input, func(page *cloudformation.ListStackInstancesOutput, lastPage bool) bool {
+		for _, summary := range page.Summaries {
+			if summary == nil || !targeted[aws.StringValue(summary.OrganizationalUnitId)] {
+				continue
+			}
+
+			summaries = append(summaries, map[string]interface{}{
+				"account_id":             aws.StringValue(summary.Account),
+				"organizational_unit_id": aws.StringValue(summary.OrganizationalUnitId),
+				"stack_id":               aws.StringValue(summary.StackId),
+			})
+		}
+
+		return !lastPage
+	})
+
+	return summaries, err
+}
+
+func expandCloudFormationStackSetDeploymentTargets(l []interface{}) *cloudformation.DeploymentTargets {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cloudformation.DeploymentTargets{
+		OrganizationalUnitIds: expandStringSet(m["organizational_unit_ids"].(*schema.Set)),
+	}
+}
+
+func flattenCloudFormationStackSetDeploymentTargets(organizationalUnitIDs []string) []interface{} {
+	if len(organizationalUnitIDs) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"organizational_unit_ids": schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(organizationalUnitIDs))),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "retain_stack", "false"),
+					resource.TestMatchResourceAttr(resourceName, "stack_id", regexp.MustCompile(`^arn:[^:]+:cloudformation:[^:]+:[^:]+:stack/StackSet-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", "aws_cloudformation_stack_set.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"call_as", "retain_stack"},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides(rName, "overridden"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.Parameter1", "overridden"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_deploymentTargets(t *testing.T) {
+	organizationalUnitID := os.Getenv("CLOUDFORMATION_STACK_SET_ORGANIZATIONAL_UNIT_ID")
+	if organizationalUnitID == "" {
+		t.Skip("CLOUDFORMATION_STACK_SET_ORGANIZATIONAL_UNIT_ID must be set to an organizational unit in an organization with trusted access enabled for CloudFormation StackSets")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName, organizationalUnitID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.organizational_unit_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudformation_stack_set.test", "auto_deployment.0.enabled", "true"),
+					resource.TestCheckResourceAttr("aws_cloudformation_stack_set.test", "permission_model", "SERVICE_MANAGED"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudFormationStackSetInstanceTargetID(t *testing.T) {
+	target := cloudFormationStackSetInstanceTargetID(aws.StringSlice([]string{"ou-zzzz-bbbbbbbb", "ou-aaaa-cccccccc"}))
+	if expected := "ou-aaaa-cccccccc/ou-zzzz-bbbbbbbb"; target != expected {
+		t.Fatalf("expected target %q, got %q", expected, target)
+	}
+
+	if cloudFormationStackSetInstanceTargetIsAccount(target) {
+		t.Fatalf("expected %q not to be an account target", target)
+	}
+
+	if !cloudFormationStackSetInstanceTargetIsAccount("123456789012") {
+		t.Fatal("expected 123456789012 to be an account target")
+	}
+
+	name, account, region, err := decodeCloudFormationStackSetInstanceID("example,123456789012,us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "example" || account != "123456789012" || region != "us-east-1" {
+		t.Fatalf("unexpected decoded ID parts: %s, %s, %s", name, account, region)
+	}
+
+	if _, _, _, err := decodeCloudFormationStackSetInstanceID("example,us-east-1"); err == nil {
+		t.Fatal("expected error decoding ID with two parts")
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName string, stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.StackInstance == nil {
+			return fmt.Errorf("CloudFormation StackSet Instance (%s) not found", rs.Primary.ID)
+		}
+
+		*stackInstance = *output.StackInstance
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set_instance" {
+			continue
+		}
+
+		stackSetName, target, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !cloudFormationStackSetInstanceTargetIsAccount(target) {
+			continue
+		}
+
+		_, err = conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(target),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation StackSet Instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides(rName, value string) string {
+	overrides := ""
+	if value != "" {
+		overrides = fmt.Sprintf(`
+  parameter_overrides {
+    Parameter1 = %q
+  }
+`, value)
+	}
+
+	return testAccAWSCloudFormationStackSetConfigParameters(rName, "value1") + fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+%s}
+`, overrides)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName, organizationalUnitID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  auto_deployment {
+    enabled                          = true
+    retain_stacks_on_account_removal = false
+  }
+
+  template_body = <<TEMPLATE
+Resources:
+  TestTopic:
+    Type: AWS::SNS::Topic
+TEMPLATE
+}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+
+  deployment_targets {
+    organizational_unit_ids = [%[2]q]
+  }
+
+  operation_preferences {
+    failure_tolerance_percentage = 10
+    max_concurrent_percentage    = 25
+    region_concurrency_type      = "PARALLEL"
+  }
+}
+`, rName, organizationalUnitID)
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigParameters(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:stackset/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "execution_role_name", rName+"-execution"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.Parameter1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "permission_model", "SELF_MANAGED"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_set_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"call_as"},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigParameters(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "parameters.Parameter1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_autoDeploymentSelfManaged(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFormationStackSetConfigAutoDeploymentSelfManaged(rName),
+				ExpectError: regexp.MustCompile(`auto_deployment can only be configured with the SERVICE_MANAGED permission model`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFormationStackSetExists(resourceName string, stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		output, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.StackSet == nil {
+			return fmt.Errorf("CloudFormation StackSet (%s) not found", rs.Primary.ID)
+		}
+
+		*stackSet = *output.StackSet
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set" {
+			continue
+		}
+
+		output, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.StackSet != nil && aws.StringValue(output.StackSet.Status) != cloudformation.StackSetStatusDeleted {
+			return fmt.Errorf("CloudFormation StackSet (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFormationStackSetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "administration" {
+  name = "%[1]s-administration"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "cloudformation.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "administration" {
+  name = "%[1]s-administration"
+  role = "${aws_iam_role.administration.name}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Resource": "arn:aws:iam::*:role/%[1]s-execution"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "execution" {
+  name = "%[1]s-execution"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "${aws_iam_role.administration.arn}"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "execution" {
+  name = "%[1]s-execution"
+  role = "${aws_iam_role.execution.name}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetConfigParameters(rName, value string) string {
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.administration.arn}"
+  execution_role_name     = "${aws_iam_role.execution.name}"
+  name                    = %[1]q
+
+  parameters {
+    Parameter1 = %[2]q
+  }
+
+  template_body = <<TEMPLATE
+Parameters:
+  Parameter1:
+    Type: String
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+      Tags:
+        - Key: Name
+          Value: !Ref Parameter1
+TEMPLATE
+
+  depends_on = ["aws_iam_role_policy.administration", "aws_iam_role_policy.execution"]
+}
+`, rName, value)
+}
+
+func testAccAWSCloudFormationStackSetConfigAutoDeploymentSelfManaged(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name = %[1]q
+
+  auto_deployment {
+    enabled = true
+  }
+
+  template_body = <<TEMPLATE
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+TEMPLATE
+}
+`, rName)
+}
@@ -59,6 +59,11 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"is_organization_trail": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"sns_topic_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,9 +79,10 @@ func resourceAwsCloudTrail() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 			"event_selector": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 5,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"advanced_event_selector"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"read_write_type": {
@@ -104,7 +110,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 									"type": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"AWS::S3::Object", "AWS::Lambda::Function"}, false),
+										ValidateFunc: validation.StringInSlice([]string{"AWS::S3::Object", "AWS::Lambda::Function", "AWS::DynamoDB::Table"}, false),
 									},
 									"values": {
 										Type:     schema.TypeList,
@@ -118,6 +124,68 @@ func resourceAwsCloudTrail() *schema.Resource {
 					},
 				},
 			},
+			"advanced_event_selector": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"event_selector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"field_selector": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"eventCategory",
+											"eventName",
+											"eventSource",
+											"eventType",
+											"readOnly",
+											"resources.ARN",
+											"resources.type",
+											"sessionCredentialFromConsole",
+											"userIdentity.arn",
+											"errorCode",
+										}, false),
+									},
+									"ends_with":       cloudTrailAdvancedFieldSelectorValuesSchema(),
+									"equals":          cloudTrailAdvancedFieldSelectorValuesSchema(),
+									"not_ends_with":   cloudTrailAdvancedFieldSelectorValuesSchema(),
+									"not_equals":      cloudTrailAdvancedFieldSelectorValuesSchema(),
+									"not_starts_with": cloudTrailAdvancedFieldSelectorValuesSchema(),
+									"starts_with":     cloudTrailAdvancedFieldSelectorValuesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"insight_selector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"insight_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudtrail.InsightTypeApiCallRateInsight,
+								cloudtrail.InsightTypeApiErrorRateInsight,
+							}, false),
+						},
+					},
+				},
+			},
 			"home_region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -131,6 +199,18 @@ func resourceAwsCloudTrail() *schema.Resource {
 	}
 }
 
+func cloudTrailAdvancedFieldSelectorValuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringLenBetween(1, 2048),
+		},
+	}
+}
+
 func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudtrailconn
 
@@ -151,6 +231,9 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 	if v, ok := d.GetOk("is_multi_region_trail"); ok {
 		input.IsMultiRegionTrail = aws.Bool(v.(bool))
 	}
+	if v, ok := d.GetOk("is_organization_trail"); ok {
+		input.IsOrganizationTrail = aws.Bool(v.(bool))
+	}
 	if v, ok := d.GetOk("enable_log_file_validation"); ok {
 		input.EnableLogFileValidation = aws.Bool(v.(bool))
 	}
@@ -197,12 +280,21 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Event Selectors
-	if _, ok := d.GetOk("event_selector"); ok {
+	_, hasEventSelector := d.GetOk("event_selector")
+	_, hasAdvancedEventSelector := d.GetOk("advanced_event_selector")
+	if hasEventSelector || hasAdvancedEventSelector {
 		if err := cloudTrailSetEventSelectors(conn, d); err != nil {
 			return err
 		}
 	}
 
+	// Insight Selectors
+	if _, ok := d.GetOk("insight_selector"); ok {
+		if err := cloudTrailSetInsightSelectors(conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsCloudTrailUpdate(d, meta)
 }
 
@@ -243,6 +335,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cloud_watch_logs_group_arn", trail.CloudWatchLogsLogGroupArn)
 	d.Set("include_global_service_events", trail.IncludeGlobalServiceEvents)
 	d.Set("is_multi_region_trail", trail.IsMultiRegionTrail)
+	d.Set("is_organization_trail", trail.IsOrganizationTrail)
 	d.Set("sns_topic_name", trail.SnsTopicName)
 	d.Set("enable_log_file_validation", trail.LogFileValidationEnabled)
 
@@ -292,6 +385,27 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("advanced_event_selector", flattenAwsCloudTrailAdvancedEventSelector(eventSelectorsOut.AdvancedEventSelectors)); err != nil {
+		return err
+	}
+
+	// Get InsightSelectors
+	insightSelectorsOut, err := conn.GetInsightSelectors(&cloudtrail.GetInsightSelectorsInput{
+		TrailName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, cloudtrail.ErrCodeInsightNotEnabledException, "") {
+		return fmt.Errorf("error getting CloudTrail (%s) insight selectors: %s", d.Id(), err)
+	}
+
+	var insightSelectors []*cloudtrail.InsightSelector
+	if insightSelectorsOut != nil {
+		insightSelectors = insightSelectorsOut.InsightSelectors
+	}
+
+	if err := d.Set("insight_selector", flattenAwsCloudTrailInsightSelector(insightSelectors)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -320,6 +434,9 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("is_multi_region_trail") {
 		input.IsMultiRegionTrail = aws.Bool(d.Get("is_multi_region_trail").(bool))
 	}
+	if d.HasChange("is_organization_trail") {
+		input.IsOrganizationTrail = aws.Bool(d.Get("is_organization_trail").(bool))
+	}
 	if d.HasChange("enable_log_file_validation") {
 		input.EnableLogFileValidation = aws.Bool(d.Get("enable_log_file_validation").(bool))
 	}
@@ -365,13 +482,20 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("event_selector") {
+	if !d.IsNewResource() && (d.HasChange("event_selector") || d.HasChange("advanced_event_selector")) {
 		log.Printf("[DEBUG] Updating event selector on CloudTrail: %s", input)
 		if err := cloudTrailSetEventSelectors(conn, d); err != nil {
 			return err
 		}
 	}
 
+	if !d.IsNewResource() && d.HasChange("insight_selector") {
+		log.Printf("[DEBUG] Updating insight selector on CloudTrail: %s", input)
+		if err := cloudTrailSetInsightSelectors(conn, d); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] CloudTrail updated: %s", t)
 
 	return resourceAwsCloudTrailRead(d, meta)
@@ -435,8 +559,14 @@ func cloudTrailSetEventSelectors(conn *cloudtrail.CloudTrail, d *schema.Resource
 		TrailName: aws.String(d.Id()),
 	}
 
-	eventSelectors := expandAwsCloudTrailEventSelector(d.Get("event_selector").([]interface{}))
-	input.EventSelectors = eventSelectors
+	// Basic and advanced event selectors are mutually exclusive, and putting
+	// either one replaces whatever was configured before.
+	if v, ok := d.GetOk("advanced_event_selector"); ok {
+		input.AdvancedEventSelectors = expandAwsCloudTrailAdvancedEventSelector(v.([]interface{}))
+	} else {
+		eventSelectors := expandAwsCloudTrailEventSelector(d.Get("event_selector").([]interface{}))
+		input.EventSelectors = eventSelectors
+	}
 
 	if err := input.Validate(); err != nil {
 		return fmt.Errorf("Error validate CloudTrail (%s): %s", d.Id(), err)
@@ -524,3 +654,132 @@ func flattenAwsCloudTrailEventSelectorDataResource(configured []*cloudtrail.Data
 
 	return dataResources
 }
+
+func expandAwsCloudTrailAdvancedEventSelector(configured []interface{}) []*cloudtrail.AdvancedEventSelector {
+	advancedEventSelectors := make([]*cloudtrail.AdvancedEventSelector, 0, len(configured))
+
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		aes := &cloudtrail.AdvancedEventSelector{
+			FieldSelectors: expandAwsCloudTrailAdvancedFieldSelector(data["field_selector"].(*schema.Set).List()),
+		}
+
+		if v, ok := data["name"].(string); ok && v != "" {
+			aes.Name = aws.String(v)
+		}
+
+		advancedEventSelectors = append(advancedEventSelectors, aes)
+	}
+
+	return advancedEventSelectors
+}
+
+func expandAwsCloudTrailAdvancedFieldSelector(configured []interface{}) []*cloudtrail.AdvancedFieldSelector {
+	fieldSelectors := make([]*cloudtrail.AdvancedFieldSelector, 0, len(configured))
+
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		fs := &cloudtrail.AdvancedFieldSelector{
+			Field: aws.String(data["field"].(string)),
+		}
+
+		if v, ok := data["ends_with"].([]interface{}); ok && len(v) > 0 {
+			fs.EndsWith = expandStringList(v)
+		}
+		if v, ok := data["equals"].([]interface{}); ok && len(v) > 0 {
+			fs.Equals = expandStringList(v)
+		}
+		if v, ok := data["not_ends_with"].([]interface{}); ok && len(v) > 0 {
+			fs.NotEndsWith = expandStringList(v)
+		}
+		if v, ok := data["not_equals"].([]interface{}); ok && len(v) > 0 {
+			fs.NotEquals = expandStringList(v)
+		}
+		if v, ok := data["not_starts_with"].([]interface{}); ok && len(v) > 0 {
+			fs.NotStartsWith = expandStringList(v)
+		}
+		if v, ok := data["starts_with"].([]interface{}); ok && len(v) > 0 {
+			fs.StartsWith = expandStringList(v)
+		}
+
+		fieldSelectors = append(fieldSelectors, fs)
+	}
+
+	return fieldSelectors
+}
+
+func flattenAwsCloudTrailAdvancedEventSelector(configured []*cloudtrail.AdvancedEventSelector) []map[string]interface{} {
+	advancedEventSelectors := make([]map[string]interface{}, 0, len(configured))
+
+	for _, raw := range configured {
+		item := make(map[string]interface{})
+		item["name"] = aws.StringValue(raw.Name)
+		item["field_selector"] = flattenAwsCloudTrailAdvancedFieldSelector(raw.FieldSelectors)
+
+		advancedEventSelectors = append(advancedEventSelectors, item)
+	}
+
+	return advancedEventSelectors
+}
+
+func flattenAwsCloudTrailAdvancedFieldSelector(configured []*cloudtrail.AdvancedFieldSelector) []map[string]interface{} {
+	fieldSelectors := make([]map[string]interface{}, 0, len(configured))
+
+	for _, raw := range configured {
+		item := make(map[string]interface{})
+		item["field"] = aws.StringValue(raw.Field)
+		item["ends_with"] = flattenStringList(raw.EndsWith)
+		item["equals"] = flattenStringList(raw.Equals)
+		item["not_ends_with"] = flattenStringList(raw.NotEndsWith)
+		item["not_equals"] = flattenStringList(raw.NotEquals)
+		item["not_starts_with"] = flattenStringList(raw.NotStartsWith)
+		item["starts_with"] = flattenStringList(raw.StartsWith)
+
+		fieldSelectors = append(fieldSelectors, item)
+	}
+
+	return fieldSelectors
+}
+
+func cloudTrailSetInsightSelectors(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
+	input := &cloudtrail.PutInsightSelectorsInput{
+		InsightSelectors: expandAwsCloudTrailInsightSelector(d.Get("insight_selector").([]interface{})),
+		TrailName:        aws.String(d.Id()),
+	}
+
+	_, err := conn.PutInsightSelectors(input)
+	if err != nil {
+		return fmt.Errorf("Error set insight selector on CloudTrail (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAwsCloudTrailInsightSelector(configured []interface{}) []*cloudtrail.InsightSelector {
+	insightSelectors := make([]*cloudtrail.InsightSelector, 0, len(configured))
+
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		insightSelectors = append(insightSelectors, &cloudtrail.InsightSelector{
+			InsightType: aws.String(data["insight_type"].(string)),
+		})
+	}
+
+	return insightSelectors
+}
+
+func flattenAwsCloudTrailInsightSelector(configured []*cloudtrail.InsightSelector) []map[string]interface{} {
+	insightSelectors := make([]map[string]interface{}, 0, len(configured))
+
+	for _, raw := range configured {
+		item := make(map[string]interface{})
+		item["insight_type"] = aws.StringValue(raw.InsightType)
+
+		insightSelectors = append(insightSelectors, item)
+	}
+
+	return insightSelectors
+}
@@ -17,14 +17,17 @@ import (
 func TestAccAWSCloudTrail(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			"basic":         testAccAWSCloudTrail_basic,
-			"cloudwatch":    testAccAWSCloudTrail_cloudwatch,
-			"enableLogging": testAccAWSCloudTrail_enable_logging,
-			"isMultiRegion": testAccAWSCloudTrail_is_multi_region,
-			"logValidation": testAccAWSCloudTrail_logValidation,
-			"kmsKey":        testAccAWSCloudTrail_kmsKey,
-			"tags":          testAccAWSCloudTrail_tags,
-			"eventSelector": testAccAWSCloudTrail_event_selector,
+			"basic":                 testAccAWSCloudTrail_basic,
+			"cloudwatch":            testAccAWSCloudTrail_cloudwatch,
+			"enableLogging":         testAccAWSCloudTrail_enable_logging,
+			"isMultiRegion":         testAccAWSCloudTrail_is_multi_region,
+			"logValidation":         testAccAWSCloudTrail_logValidation,
+			"kmsKey":                testAccAWSCloudTrail_kmsKey,
+			"tags":                  testAccAWSCloudTrail_tags,
+			"eventSelector":         testAccAWSCloudTrail_event_selector,
+			"advancedEventSelector": testAccAWSCloudTrail_advanced_event_selector,
+			"insightSelector":       testAccAWSCloudTrail_insight_selector,
+			"isOrganization":        testAccAWSCloudTrail_is_organization,
 		},
 	}
 
@@ -357,6 +360,86 @@ func testAccAWSCloudTrail_event_selector(t *testing.T) {
 	})
 }
 
+func testAccAWSCloudTrail_advanced_event_selector(t *testing.T) {
+	cloudTrailRandInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudTrailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudTrailConfig_advancedEventSelector(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.#", "0"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.#", "2"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.0.name", "s3Custom"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.0.field_selector.#", "3"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.1.name", "dynamoDbAll"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.1.field_selector.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSCloudTrailConfig_eventSelector(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "advanced_event_selector.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudTrail_insight_selector(t *testing.T) {
+	cloudTrailRandInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudTrailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudTrailConfig_insightSelector(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "insight_selector.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "insight_selector.0.insight_type", "ApiCallRateInsight"),
+				),
+			},
+			{
+				Config: testAccAWSCloudTrailConfig(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "insight_selector.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudTrail_is_organization(t *testing.T) {
+	var trail cloudtrail.Trail
+	cloudTrailRandInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudTrailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudTrailConfigOrganization(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudTrailExists("aws_cloudtrail.foobar", &trail),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "is_organization_trail", "true"),
+				),
+			},
+			{
+				ResourceName:      "aws_cloudtrail.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCloudTrailExists(n string, trail *cloudtrail.Trail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1241,3 +1324,111 @@ resource "aws_lambda_function" "lambda_function_test" {
 }
 `, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt)
 }
+
+func testAccAWSCloudTrailConfigBucket(cloudTrailRandInt int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "foo" {
+	bucket = "tf-test-trail-%[1]d"
+	force_destroy = true
+	policy = <<POLICY
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Sid": "AWSCloudTrailAclCheck",
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "s3:GetBucketAcl",
+			"Resource": "arn:aws:s3:::tf-test-trail-%[1]d"
+		},
+		{
+			"Sid": "AWSCloudTrailWrite",
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "s3:PutObject",
+			"Resource": "arn:aws:s3:::tf-test-trail-%[1]d/*",
+			"Condition": {
+				"StringEquals": {
+					"s3:x-amz-acl": "bucket-owner-full-control"
+				}
+			}
+		}
+	]
+}
+POLICY
+}
+`, cloudTrailRandInt)
+}
+
+func testAccAWSCloudTrailConfig_advancedEventSelector(cloudTrailRandInt int) string {
+	return testAccAWSCloudTrailConfigBucket(cloudTrailRandInt) + fmt.Sprintf(`
+resource "aws_cloudtrail" "foobar" {
+	name = "tf-trail-foobar-%[1]d"
+	s3_bucket_name = "${aws_s3_bucket.foo.id}"
+
+	advanced_event_selector {
+		name = "s3Custom"
+
+		field_selector {
+			field = "eventCategory"
+			equals = ["Data"]
+		}
+
+		field_selector {
+			field = "resources.type"
+			equals = ["AWS::S3::Object"]
+		}
+
+		field_selector {
+			field = "resources.ARN"
+			starts_with = ["${aws_s3_bucket.bar.arn}/foobar"]
+		}
+	}
+
+	advanced_event_selector {
+		name = "dynamoDbAll"
+
+		field_selector {
+			field = "eventCategory"
+			equals = ["Data"]
+		}
+
+		field_selector {
+			field = "resources.type"
+			equals = ["AWS::DynamoDB::Table"]
+		}
+	}
+}
+
+resource "aws_s3_bucket" "bar" {
+	bucket = "tf-test-trail-event-select-%[1]d"
+	force_destroy = true
+}
+`, cloudTrailRandInt)
+}
+
+func testAccAWSCloudTrailConfig_insightSelector(cloudTrailRandInt int) string {
+	return testAccAWSCloudTrailConfigBucket(cloudTrailRandInt) + fmt.Sprintf(`
+resource "aws_cloudtrail" "foobar" {
+	name = "tf-trail-foobar-%[1]d"
+	s3_bucket_name = "${aws_s3_bucket.foo.id}"
+
+	insight_selector {
+		insight_type = "ApiCallRateInsight"
+	}
+}
+`, cloudTrailRandInt)
+}
+
+func testAccAWSCloudTrailConfigOrganization(cloudTrailRandInt int) string {
+	return testAccAWSCloudTrailConfigBucket(cloudTrailRandInt) + fmt.Sprintf(`
+resource "aws_cloudtrail" "foobar" {
+	name = "tf-trail-foobar-%[1]d"
+	s3_bucket_name = "${aws_s3_bucket.foo.id}"
+	is_organization_trail = true
+	is_multi_region_trail = true
+}
+`, cloudTrailRandInt)
+}
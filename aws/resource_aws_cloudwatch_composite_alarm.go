@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchCompositeAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchCompositeAlarmCreate,
+		Read:   resourceAwsCloudWatchCompositeAlarmRead,
+		Update: resourceAwsCloudWatchCompositeAlarmUpdate,
+		Delete: resourceAwsCloudWatchCompositeAlarmDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"actions_suppressor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1600),
+						},
+						"extension_period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"wait_period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"alarm_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"alarm_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"alarm_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"alarm_rule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10240),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"insufficient_data_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"ok_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsCloudWatchCompositeAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+	name := d.Get("alarm_name").(string)
+
+	input := expandAwsCloudWatchPutCompositeAlarmInput(d)
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapCloudWatch(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Composite Alarm: %s", input)
+	_, err := conn.PutCompositeAlarm(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Composite Alarm (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchCompositeAlarmRead(d, meta)
+}
+
+func resourceAwsCloudWatchCompositeAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	alarm, err := getAwsCloudWatchCompositeAlarm(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Composite Alarm (%s): %s", d.Id(), err)
+	}
+
+	if alarm == nil {
+		log.Printf("[WARN] CloudWatch Composite Alarm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("actions_enabled", alarm.ActionsEnabled)
+	d.Set("alarm_description", alarm.AlarmDescription)
+	d.Set("alarm_name", alarm.AlarmName)
+	d.Set("alarm_rule", alarm.AlarmRule)
+	d.Set("arn", alarm.AlarmArn)
+
+	if err := d.Set("actions_suppressor", flattenAwsCloudWatchCompositeAlarmActionsSuppressor(alarm)); err != nil {
+		return fmt.Errorf("error setting actions_suppressor: %s", err)
+	}
+
+	if err := d.Set("alarm_actions", flattenStringList(alarm.AlarmActions)); err != nil {
+		return fmt.Errorf("error setting alarm_actions: %s", err)
+	}
+
+	if err := d.Set("insufficient_data_actions", flattenStringList(alarm.InsufficientDataActions)); err != nil {
+		return fmt.Errorf("error setting insufficient_data_actions: %s", err)
+	}
+
+	if err := d.Set("ok_actions", flattenStringList(alarm.OKActions)); err != nil {
+		return fmt.Errorf("error setting ok_actions: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&cloudwatch.ListTagsForResourceInput{
+		ResourceARN: alarm.AlarmArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudWatch Composite Alarm (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapCloudWatch(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchCompositeAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	if d.HasChange("actions_enabled") || d.HasChange("actions_suppressor") || d.HasChange("alarm_actions") ||
+		d.HasChange("alarm_description") || d.HasChange("alarm_rule") || d.HasChange("insufficient_data_actions") ||
+		d.HasChange("ok_actions") {
+		input := expandAwsCloudWatchPutCompositeAlarmInput(d)
+
+		log.Printf("[DEBUG] Updating CloudWatch Composite Alarm: %s", input)
+		_, err := conn.PutCompositeAlarm(input)
+		if err != nil {
+			return fmt.Errorf("error updating CloudWatch Composite Alarm (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsCloudWatch(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating CloudWatch Composite Alarm (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudWatchCompositeAlarmRead(d, meta)
+}
+
+func resourceAwsCloudWatchCompositeAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	log.Printf("[INFO] Deleting CloudWatch Composite Alarm: %s", d.Id())
+	_, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, cloudwatch.ErrCodeResourceNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Composite Alarm (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getAwsCloudWatchCompositeAlarm(conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
+	output, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{name}),
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alarm := range output.CompositeAlarms {
+		if aws.StringValue(alarm.AlarmName) == name {
+			return alarm, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandAwsCloudWatchPutCompositeAlarmInput(d *schema.ResourceData) *cloudwatch.PutCompositeAlarmInput {
+	input := &cloudwatch.PutCompositeAlarmInput{
+		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
+		AlarmName:      aws.String(d.Get("alarm_name").(string)),
+		AlarmRule:      aws.String(d.Get("alarm_rule").(string)),
+	}
+
+	if v, ok := d.GetOk("actions_suppressor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.ActionsSuppressor = aws.String(m["alarm"].(string))
+		input.ActionsSuppressorExtensionPeriod = aws.Int64(int64(m["extension_period"].(int)))
+		input.ActionsSuppressorWaitPeriod = aws.Int64(int64(m["wait_period"].(int)))
+	}
+
+	if v, ok := d.GetOk("alarm_actions"); ok {
+		input.AlarmActions = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("alarm_description"); ok {
+		input.AlarmDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("insufficient_data_actions"); ok {
+		input.InsufficientDataActions = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("ok_actions"); ok {
+		input.OKActions = expandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
+
+func flattenAwsCloudWatchCompositeAlarmActionsSuppressor(alarm *cloudwatch.CompositeAlarm) []interface{} {
+	if alarm == nil || alarm.ActionsSuppressor == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"alarm":            aws.StringValue(alarm.ActionsSuppressor),
+			"extension_period": int(aws.Int64Value(alarm.ActionsSuppressorExtensionPeriod)),
+			"wait_period":      int(aws.Int64Value(alarm.ActionsSuppressorWaitPeriod)),
+		},
+	}
+}
@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchCompositeAlarm_basic(t *testing.T) {
+	var alarm cloudwatch.CompositeAlarm
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigBasic(rName, "OR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule", fmt.Sprintf("ALARM(%[1]s-0) OR ALARM(%[1]s-1)", rName)),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:cloudwatch:[^:]+:[^:]+:alarm:%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigBasic(rName, "AND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule", fmt.Sprintf("ALARM(%[1]s-0) AND ALARM(%[1]s-1)", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_actions(t *testing.T) {
+	var alarm cloudwatch.CompositeAlarm
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigActions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ok_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_description", "Test composite alarm"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigActions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
+	var alarm cloudwatch.CompositeAlarm
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigActionsSuppressor(rName, 20, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.alarm", rName+"-suppressor"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "20"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigActionsSuppressor(rName, 60, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_tags(t *testing.T) {
+	var alarm cloudwatch.CompositeAlarm
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsCloudWatchCompositeAlarmConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsCloudWatchCompositeAlarmExists(n string, alarm *cloudwatch.CompositeAlarm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Composite Alarm ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		output, err := getAwsCloudWatchCompositeAlarm(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudWatch Composite Alarm (%s) not found", rs.Primary.ID)
+		}
+
+		*alarm = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsCloudWatchCompositeAlarmDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_composite_alarm" {
+			continue
+		}
+
+		output, err := getAwsCloudWatchCompositeAlarm(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("CloudWatch Composite Alarm (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsCloudWatchCompositeAlarmConfigBase(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  count = %[2]d
+
+  alarm_name                = "%[1]s-${count.index}"
+  comparison_operator       = "GreaterThanOrEqualToThreshold"
+  evaluation_periods        = 2
+  metric_name               = "CPUUtilization"
+  namespace                 = "AWS/EC2"
+  period                    = 120
+  statistic                 = "Average"
+  threshold                 = 80
+  insufficient_data_actions = []
+
+  dimensions {
+    InstanceId = "i-abc123"
+  }
+}
+`, rName, count)
+}
+
+func testAccAwsCloudWatchCompositeAlarmConfigBasic(rName, operator string) string {
+	return testAccAwsCloudWatchCompositeAlarmConfigBase(rName, 2) + fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test.0.alarm_name}) %[2]s ALARM(${aws_cloudwatch_metric_alarm.test.1.alarm_name})"
+}
+`, rName, operator)
+}
+
+func testAccAwsCloudWatchCompositeAlarmConfigActions(rName string, enabled bool) string {
+	return testAccAwsCloudWatchCompositeAlarmConfigBase(rName, 2) + fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  actions_enabled           = %[2]t
+  alarm_actions             = ["${aws_sns_topic.test.arn}"]
+  alarm_description         = "Test composite alarm"
+  alarm_name                = %[1]q
+  alarm_rule                = "ALARM(${aws_cloudwatch_metric_alarm.test.0.alarm_name}) OR ALARM(${aws_cloudwatch_metric_alarm.test.1.alarm_name})"
+  insufficient_data_actions = ["${aws_sns_topic.test.arn}"]
+  ok_actions                = ["${aws_sns_topic.test.arn}"]
+}
+`, rName, enabled)
+}
+
+func testAccAwsCloudWatchCompositeAlarmConfigActionsSuppressor(rName string, extensionPeriod, waitPeriod int) string {
+	return testAccAwsCloudWatchCompositeAlarmConfigBase(rName, 2) + fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "suppressor" {
+  alarm_name = "%[1]s-suppressor"
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test.0.alarm_name})"
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test.0.alarm_name}) OR ALARM(${aws_cloudwatch_metric_alarm.test.1.alarm_name})"
+
+  actions_suppressor {
+    alarm            = "${aws_cloudwatch_composite_alarm.suppressor.alarm_name}"
+    extension_period = %[2]d
+    wait_period      = %[3]d
+  }
+}
+`, rName, extensionPeriod, waitPeriod)
+}
+
+func testAccAwsCloudWatchCompositeAlarmConfigTags(rName, tagValue string) string {
+	return testAccAwsCloudWatchCompositeAlarmConfigBase(rName, 2) + fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test.0.alarm_name}) OR ALARM(${aws_cloudwatch_metric_alarm.test.1.alarm_name})"
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
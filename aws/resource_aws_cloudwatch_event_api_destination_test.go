@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchEventApiDestination_basic(t *testing.T) {
+	var apiDestination events.DescribeApiDestinationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_api_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventApiDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventApiDestinationConfig(rName, "https://example.com/", "POST", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &apiDestination),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "invocation_endpoint", "https://example.com/"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "300"),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_arn", "aws_cloudwatch_event_connection.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:events:[^:]+:[^:]+:api-destination/%s/.+$", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchEventApiDestinationConfig(rName, "https://example.com/webhook", "PUT", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &apiDestination),
+					resource.TestCheckResourceAttr(resourceName, "invocation_endpoint", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "PUT"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventApiDestinationExists(n string, apiDestination *events.DescribeApiDestinationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		output, err := conn.DescribeApiDestination(&events.DescribeApiDestinationInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*apiDestination = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchEventApiDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_api_destination" {
+			continue
+		}
+
+		_, err := conn.DescribeApiDestination(&events.DescribeApiDestinationInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Event API Destination %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchEventApiDestinationConfig(rName, endpoint, httpMethod string, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "x-api-key"
+      value = "secret"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                             = %[1]q
+  invocation_endpoint              = %[2]q
+  http_method                      = %[3]q
+  invocation_rate_limit_per_second = %[4]d
+  connection_arn                   = "${aws_cloudwatch_event_connection.test.arn}"
+}
+`, rName, endpoint, httpMethod, rateLimit)
+}
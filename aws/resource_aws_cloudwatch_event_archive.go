@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchEventArchive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventArchiveCreate,
+		Read:   resourceAwsCloudWatchEventArchiveRead,
+		Update: resourceAwsCloudWatchEventArchiveUpdate,
+		Delete: resourceAwsCloudWatchEventArchiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]{1,48}$`), "must be 1-48 characters matching [.-_A-Za-z0-9]"),
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(512),
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventPatternValue(2048),
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v.(string))
+					return json
+				},
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateArchiveInput{
+		ArchiveName:    aws.String(name),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return errwrap.Wrapf("event pattern contains an invalid JSON: {{err}}", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		input.RetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Event Archive: %s", input)
+	_, err := conn.CreateArchive(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Event Archive (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeArchive(&events.DescribeArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] CloudWatch Event Archive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Event Archive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ArchiveArn)
+	d.Set("description", output.Description)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("name", output.ArchiveName)
+	d.Set("retention_days", output.RetentionDays)
+
+	if output.EventPattern != nil {
+		pattern, err := structure.NormalizeJsonString(aws.StringValue(output.EventPattern))
+		if err != nil {
+			return errwrap.Wrapf("event pattern contains an invalid JSON: {{err}}", err)
+		}
+		d.Set("event_pattern", pattern)
+	} else {
+		d.Set("event_pattern", "")
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateArchiveInput{
+		ArchiveName:   aws.String(d.Id()),
+		Description:   aws.String(d.Get("description").(string)),
+		RetentionDays: aws.Int64(int64(d.Get("retention_days").(int))),
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return errwrap.Wrapf("event pattern contains an invalid JSON: {{err}}", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Event Archive: %s", input)
+	_, err := conn.UpdateArchive(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Event Archive (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Event Archive: %s", d.Id())
+	_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Event Archive (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchEventArchive_basic(t *testing.T) {
+	var archive events.DescribeArchiveOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &archive),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:events:[^:]+:[^:]+:archive/%s$", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventArchive_update(t *testing.T) {
+	var archive events.DescribeArchiveOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &archive),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfigUpdated(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &archive),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", "{\"source\":[\"company.team.service\"]}"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfigUpdated(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &archive),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventArchiveExists(n string, archive *events.DescribeArchiveOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		output, err := conn.DescribeArchive(&events.DescribeArchiveInput{
+			ArchiveName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*archive = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchEventArchiveDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_archive" {
+			continue
+		}
+
+		_, err := conn.DescribeArchive(&events.DescribeArchiveInput{
+			ArchiveName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Event Archive %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchEventArchiveConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = "${aws_cloudwatch_event_bus.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchEventArchiveConfigUpdated(rName string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = "${aws_cloudwatch_event_bus.test.arn}"
+  description      = "test"
+  retention_days   = %[2]d
+
+  event_pattern = <<PATTERN
+{ "source": ["company.team.service"] }
+PATTERN
+}
+`, rName, retentionDays)
+}
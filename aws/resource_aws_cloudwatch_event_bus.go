@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudWatchEventBus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventBusCreate,
+		Read:   resourceAwsCloudWatchEventBusRead,
+		Update: resourceAwsCloudWatchEventBusUpdate,
+		Delete: resourceAwsCloudWatchEventBusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
+			"event_source_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateEventBusInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("event_source_name"); ok {
+		input.EventSourceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapCloudWatchEvents(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Event Bus: %s", input)
+	_, err := conn.CreateEventBus(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Event Bus (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchEventBusRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventBusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] CloudWatch Event Bus (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Event Bus (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("name", output.Name)
+
+	tagsOutput, err := conn.ListTagsForResource(&events.ListTagsForResourceInput{
+		ResourceARN: output.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudWatch Event Bus (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapCloudWatchEvents(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventBusUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	if d.HasChange("tags") {
+		if err := setTagsCloudWatchEvents(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating CloudWatch Event Bus (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudWatchEventBusRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventBusDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Event Bus: %s", d.Id())
+	_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Event Bus (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,572 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchEventConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventConnectionCreate,
+		Read:   resourceAwsCloudWatchEventConnectionRead,
+		Update: resourceAwsCloudWatchEventConnectionUpdate,
+		Delete: resourceAwsCloudWatchEventConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]{1,64}$`), "must be 1-64 characters matching [.-_A-Za-z0-9]"),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(512),
+			},
+			"authorization_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					events.ConnectionAuthorizationTypeApiKey,
+					events.ConnectionAuthorizationTypeBasic,
+					events.ConnectionAuthorizationTypeOauthClientCredentials,
+				}, false),
+			},
+			"auth_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"auth_parameters.0.basic", "auth_parameters.0.oauth"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"basic": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"auth_parameters.0.api_key", "auth_parameters.0.oauth"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"oauth": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"auth_parameters.0.api_key", "auth_parameters.0.basic"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_endpoint": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"http_method": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											events.ConnectionOAuthHttpMethodGet,
+											events.ConnectionOAuthHttpMethodPost,
+											events.ConnectionOAuthHttpMethodPut,
+										}, false),
+									},
+									"client_parameters": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"oauth_http_parameters": cloudWatchEventConnectionHttpParametersSchema(true),
+								},
+							},
+						},
+						"invocation_http_parameters": cloudWatchEventConnectionHttpParametersSchema(false),
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func cloudWatchEventConnectionHttpParametersSchema(required bool) *schema.Schema {
+	parameterSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+				"is_value_secret": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"body":         parameterSchema,
+				"header":       parameterSchema,
+				"query_string": parameterSchema,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateConnectionInput{
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		AuthParameters:    expandAwsCloudWatchEventCreateConnectionAuthRequestParameters(d.Get("auth_parameters").([]interface{})),
+		Name:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Event Connection: %s", name)
+	_, err := conn.CreateConnection(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Event Connection (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForCloudWatchEventConnectionAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Event Connection (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventConnectionRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] CloudWatch Event Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Event Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ConnectionArn)
+	d.Set("authorization_type", output.AuthorizationType)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("secret_arn", output.SecretArn)
+
+	// Secret values are never returned by the API, so they are carried over
+	// from the configuration.
+	if err := d.Set("auth_parameters", flattenAwsCloudWatchEventConnectionAuthParameters(output.AuthParameters, d)); err != nil {
+		return fmt.Errorf("error setting auth_parameters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateConnectionInput{
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		AuthParameters:    expandAwsCloudWatchEventUpdateConnectionAuthRequestParameters(d.Get("auth_parameters").([]interface{})),
+		Description:       aws.String(d.Get("description").(string)),
+		Name:              aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Event Connection: %s", d.Id())
+	_, err := conn.UpdateConnection(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Event Connection (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudWatchEventConnectionAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Event Connection (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventConnectionRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Event Connection: %s", d.Id())
+	_, err := conn.DeleteConnection(&events.DeleteConnectionInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Event Connection (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ConnectionStateDeleting},
+		Target:  []string{},
+		Refresh: cloudWatchEventConnectionStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Event Connection (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForCloudWatchEventConnectionAvailable(conn *events.CloudWatchEvents, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			events.ConnectionStateAuthorizing,
+			events.ConnectionStateCreating,
+			events.ConnectionStateDeauthorizing,
+			events.ConnectionStateUpdating,
+		},
+		Target: []string{
+			events.ConnectionStateAuthorized,
+			events.ConnectionStateDeauthorized,
+		},
+		Refresh: cloudWatchEventConnectionStateRefreshFunc(conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func cloudWatchEventConnectionStateRefreshFunc(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+			Name: aws.String(name),
+		})
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
+
+func expandAwsCloudWatchEventCreateConnectionAuthRequestParameters(l []interface{}) *events.CreateConnectionAuthRequestParameters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	params := &events.CreateConnectionAuthRequestParameters{
+		InvocationHttpParameters: expandAwsCloudWatchEventConnectionHttpParameters(m["invocation_http_parameters"].([]interface{})),
+	}
+
+	if v, ok := m["api_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiKey := v[0].(map[string]interface{})
+		params.ApiKeyAuthParameters = &events.CreateConnectionApiKeyAuthRequestParameters{
+			ApiKeyName:  aws.String(apiKey["key"].(string)),
+			ApiKeyValue: aws.String(apiKey["value"].(string)),
+		}
+	}
+
+	if v, ok := m["basic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		basic := v[0].(map[string]interface{})
+		params.BasicAuthParameters = &events.CreateConnectionBasicAuthRequestParameters{
+			Password: aws.String(basic["password"].(string)),
+			Username: aws.String(basic["username"].(string)),
+		}
+	}
+
+	if v, ok := m["oauth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		oauth := v[0].(map[string]interface{})
+		params.OAuthParameters = &events.CreateConnectionOAuthRequestParameters{
+			AuthorizationEndpoint: aws.String(oauth["authorization_endpoint"].(string)),
+			HttpMethod:            aws.String(oauth["http_method"].(string)),
+			OAuthHttpParameters:   expandAwsCloudWatchEventConnectionHttpParameters(oauth["oauth_http_parameters"].([]interface{})),
+		}
+
+		if v, ok := oauth["client_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			client := v[0].(map[string]interface{})
+			params.OAuthParameters.ClientParameters = &events.CreateConnectionOAuthClientRequestParameters{
+				ClientID:     aws.String(client["client_id"].(string)),
+				ClientSecret: aws.String(client["client_secret"].(string)),
+			}
+		}
+	}
+
+	return params
+}
+
+func expandAwsCloudWatchEventUpdateConnectionAuthRequestParameters(l []interface{}) *events.UpdateConnectionAuthRequestParameters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	params := &events.UpdateConnectionAuthRequestParameters{
+		InvocationHttpParameters: expandAwsCloudWatchEventConnectionHttpParameters(m["invocation_http_parameters"].([]interface{})),
+	}
+
+	if v, ok := m["api_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiKey := v[0].(map[string]interface{})
+		params.ApiKeyAuthParameters = &events.UpdateConnectionApiKeyAuthRequestParameters{
+			ApiKeyName:  aws.String(apiKey["key"].(string)),
+			ApiKeyValue: aws.String(apiKey["value"].(string)),
+		}
+	}
+
+	if v, ok := m["basic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		basic := v[0].(map[string]interface{})
+		params.BasicAuthParameters = &events.UpdateConnectionBasicAuthRequestParameters{
+			Password: aws.String(basic["password"].(string)),
+			Username: aws.String(basic["username"].(string)),
+		}
+	}
+
+	if v, ok := m["oauth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		oauth := v[0].(map[string]interface{})
+		params.OAuthParameters = &events.UpdateConnectionOAuthRequestParameters{
+			AuthorizationEndpoint: aws.String(oauth["authorization_endpoint"].(string)),
+			HttpMethod:            aws.String(oauth["http_method"].(string)),
+			OAuthHttpParameters:   expandAwsCloudWatchEventConnectionHttpParameters(oauth["oauth_http_parameters"].([]interface{})),
+		}
+
+		if v, ok := oauth["client_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			client := v[0].(map[string]interface{})
+			params.OAuthParameters.ClientParameters = &events.UpdateConnectionOAuthClientRequestParameters{
+				ClientID:     aws.String(client["client_id"].(string)),
+				ClientSecret: aws.String(client["client_secret"].(string)),
+			}
+		}
+	}
+
+	return params
+}
+
+func expandAwsCloudWatchEventConnectionHttpParameters(l []interface{}) *events.ConnectionHttpParameters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	params := &events.ConnectionHttpParameters{}
+
+	for _, raw := range m["body"].([]interface{}) {
+		p := raw.(map[string]interface{})
+		params.BodyParameters = append(params.BodyParameters, &events.ConnectionBodyParameter{
+			IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+			Key:           aws.String(p["key"].(string)),
+			Value:         aws.String(p["value"].(string)),
+		})
+	}
+
+	for _, raw := range m["header"].([]interface{}) {
+		p := raw.(map[string]interface{})
+		params.HeaderParameters = append(params.HeaderParameters, &events.ConnectionHeaderParameter{
+			IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+			Key:           aws.String(p["key"].(string)),
+			Value:         aws.String(p["value"].(string)),
+		})
+	}
+
+	for _, raw := range m["query_string"].([]interface{}) {
+		p := raw.(map[string]interface{})
+		params.QueryStringParameters = append(params.QueryStringParameters, &events.ConnectionQueryStringParameter{
+			IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+			Key:           aws.String(p["key"].(string)),
+			Value:         aws.String(p["value"].(string)),
+		})
+	}
+
+	return params
+}
+
+func flattenAwsCloudWatchEventConnectionAuthParameters(params *events.ConnectionAuthResponseParameters, d *schema.ResourceData) []interface{} {
+	if params == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"invocation_http_parameters": flattenAwsCloudWatchEventConnectionHttpParameters(params.InvocationHttpParameters, d, "auth_parameters.0.invocation_http_parameters.0"),
+	}
+
+	if params.ApiKeyAuthParameters != nil {
+		m["api_key"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(params.ApiKeyAuthParameters.ApiKeyName),
+				"value": d.Get("auth_parameters.0.api_key.0.value").(string),
+			},
+		}
+	}
+
+	if params.BasicAuthParameters != nil {
+		m["basic"] = []interface{}{
+			map[string]interface{}{
+				"password": d.Get("auth_parameters.0.basic.0.password").(string),
+				"username": aws.StringValue(params.BasicAuthParameters.Username),
+			},
+		}
+	}
+
+	if params.OAuthParameters != nil {
+		oauth := map[string]interface{}{
+			"authorization_endpoint": aws.StringValue(params.OAuthParameters.AuthorizationEndpoint),
+			"http_method":            aws.StringValue(params.OAuthParameters.HttpMethod),
+			"oauth_http_parameters":  flattenAwsCloudWatchEventConnectionHttpParameters(params.OAuthParameters.OAuthHttpParameters, d, "auth_parameters.0.oauth.0.oauth_http_parameters.0"),
+		}
+
+		if params.OAuthParameters.ClientParameters != nil {
+			oauth["client_parameters"] = []interface{}{
+				map[string]interface{}{
+					"client_id":     aws.StringValue(params.OAuthParameters.ClientParameters.ClientID),
+					"client_secret": d.Get("auth_parameters.0.oauth.0.client_parameters.0.client_secret").(string),
+				},
+			}
+		}
+
+		m["oauth"] = []interface{}{oauth}
+	}
+
+	return []interface{}{m}
+}
+
+// flattenAwsCloudWatchEventConnectionHttpParameters flattens HTTP parameters,
+// taking the values of secret parameters from the configuration at prefix.
+func flattenAwsCloudWatchEventConnectionHttpParameters(params *events.ConnectionHttpParameters, d *schema.ResourceData, prefix string) []interface{} {
+	if params == nil {
+		return nil
+	}
+
+	flattenParameter := func(attr string, i int, key, value *string, isValueSecret *bool) map[string]interface{} {
+		v := aws.StringValue(value)
+		if aws.BoolValue(isValueSecret) {
+			v = d.Get(fmt.Sprintf("%s.%s.%d.value", prefix, attr, i)).(string)
+		}
+
+		return map[string]interface{}{
+			"is_value_secret": aws.BoolValue(isValueSecret),
+			"key":             aws.StringValue(key),
+			"value":           v,
+		}
+	}
+
+	var body, header, queryString []interface{}
+
+	for i, p := range params.BodyParameters {
+		body = append(body, flattenParameter("body", i, p.Key, p.Value, p.IsValueSecret))
+	}
+
+	for i, p := range params.HeaderParameters {
+		header = append(header, flattenParameter("header", i, p.Key, p.Value, p.IsValueSecret))
+	}
+
+	for i, p := range params.QueryStringParameters {
+		queryString = append(queryString, flattenParameter("query_string", i, p.Key, p.Value, p.IsValueSecret))
+	}
+
+	if len(body) == 0 && len(header) == 0 && len(queryString) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"body":         body,
+			"header":       header,
+			"query_string": queryString,
+		},
+	}
+}
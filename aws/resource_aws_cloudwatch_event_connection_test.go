@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchEventConnection_apiKey(t *testing.T) {
+	var connection events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigApiKey(rName, "description1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", "API_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.key", "x-api-key"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.value", "value1"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:events:[^:]+:[^:]+:connection/%s/.+$", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_parameters.0.api_key.0.value"},
+			},
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigApiKey(rName, "description2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.value", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventConnection_basicWithInvocationParameters(t *testing.T) {
+	var connection events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.0.key", "x-public"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.0.value", "public"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.1.key", "x-secret"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.1.value", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.header.1.is_value_secret", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.query_string.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventConnection_oauth(t *testing.T) {
+	var connection events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigOAuth(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", "OAUTH_CLIENT_CREDENTIALS"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.authorization_endpoint", "https://example.com/auth"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.http_method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_id", "client"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventConnectionExists(n string, connection *events.DescribeConnectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		output, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*connection = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchEventConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_connection" {
+			continue
+		}
+
+		_, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Event Connection %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchEventConnectionConfigApiKey(rName, description, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  description        = %[2]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "x-api-key"
+      value = %[3]q
+    }
+  }
+}
+`, rName, description, value)
+}
+
+func testAccAWSCloudWatchEventConnectionConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "BASIC"
+
+  auth_parameters {
+    basic {
+      username = "user"
+      password = "Pass1234!"
+    }
+
+    invocation_http_parameters {
+      header {
+        key   = "x-public"
+        value = "public"
+      }
+
+      header {
+        key             = "x-secret"
+        value           = "secret"
+        is_value_secret = true
+      }
+
+      query_string {
+        key   = "query"
+        value = "value"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchEventConnectionConfigOAuth(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "OAUTH_CLIENT_CREDENTIALS"
+
+  auth_parameters {
+    oauth {
+      authorization_endpoint = "https://example.com/auth"
+      http_method            = "POST"
+
+      client_parameters {
+        client_id     = "client"
+        client_secret = "secret"
+      }
+
+      oauth_http_parameters {
+        body {
+          key             = "grant_type"
+          value           = "client_credentials"
+          is_value_secret = false
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchEventReplay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventReplayCreate,
+		Read:   resourceAwsCloudWatchEventReplayRead,
+		Delete: resourceAwsCloudWatchEventReplayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// A replay is a one-off operation, so every argument forces a new one.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]{1,64}$`), "must be 1-64 characters matching [.-_A-Za-z0-9]"),
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"event_start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Timestamps,
+			},
+			"event_end_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Timestamps,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"filter_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(512),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replay_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replay_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventReplayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+
+	// The times have been validated by validateRFC3339TimeString.
+	startTime, _ := time.Parse(time.RFC3339, d.Get("event_start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("event_end_time").(string))
+
+	input := &events.StartReplayInput{
+		Destination:    expandCloudWatchEventReplayDestination(d.Get("destination").([]interface{})),
+		EventEndTime:   aws.Time(endTime),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+		EventStartTime: aws.Time(startTime),
+		ReplayName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting CloudWatch Event Replay: %s", input)
+	_, err := conn.StartReplay(input)
+	if err != nil {
+		return fmt.Errorf("error starting CloudWatch Event Replay (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{events.ReplayStateStarting, events.ReplayStateRunning},
+		Target:     []string{events.ReplayStateCompleted},
+		Refresh:    cloudWatchEventReplayStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Event Replay (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventReplayRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventReplayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] CloudWatch Event Replay (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Event Replay (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ReplayArn)
+	d.Set("description", output.Description)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("name", output.ReplayName)
+	d.Set("state", output.State)
+	d.Set("state_reason", output.StateReason)
+
+	if output.EventStartTime != nil {
+		d.Set("event_start_time", aws.TimeValue(output.EventStartTime).Format(time.RFC3339))
+	}
+	if output.EventEndTime != nil {
+		d.Set("event_end_time", aws.TimeValue(output.EventEndTime).Format(time.RFC3339))
+	}
+	d.Set("replay_start_time", "")
+	if output.ReplayStartTime != nil {
+		d.Set("replay_start_time", aws.TimeValue(output.ReplayStartTime).Format(time.RFC3339))
+	}
+	d.Set("replay_end_time", "")
+	if output.ReplayEndTime != nil {
+		d.Set("replay_end_time", aws.TimeValue(output.ReplayEndTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("destination", flattenCloudWatchEventReplayDestination(output.Destination)); err != nil {
+		return fmt.Errorf("error setting destination: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsCloudWatchEventReplayDelete cancels a replay that is still in
+// progress. Replays themselves cannot be deleted; EventBridge expires them.
+func resourceAwsCloudWatchEventReplayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	switch d.Get("state").(string) {
+	case events.ReplayStateStarting, events.ReplayStateRunning:
+	default:
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling CloudWatch Event Replay: %s", d.Id())
+	_, err := conn.CancelReplay(&events.CancelReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	// The replay finished in the meantime.
+	if isAWSErr(err, events.ErrCodeIllegalStatusException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error cancelling CloudWatch Event Replay (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ReplayStateStarting, events.ReplayStateRunning, events.ReplayStateCancelling},
+		Target: []string{
+			events.ReplayStateCancelled,
+			events.ReplayStateCompleted,
+			events.ReplayStateFailed,
+		},
+		Refresh:    cloudWatchEventReplayStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Event Replay (%s) to be cancelled: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cloudWatchEventReplayStateRefreshFunc reports the state of a replay. A
+// replay that failed or was cancelled is reported as an error, with the reason
+// given by EventBridge.
+func cloudWatchEventReplayStateRefreshFunc(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+			ReplayName: aws.String(name),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(output.State)
+		switch state {
+		case events.ReplayStateFailed, events.ReplayStateCancelled:
+			return output, state, fmt.Errorf("replay %s: %s", state, aws.StringValue(output.StateReason))
+		}
+
+		return output, state, nil
+	}
+}
+
+func expandCloudWatchEventReplayDestination(l []interface{}) *events.ReplayDestination {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	destination := &events.ReplayDestination{
+		Arn: aws.String(m["arn"].(string)),
+	}
+
+	if v, ok := m["filter_arns"].(*schema.Set); ok && v.Len() > 0 {
+		destination.FilterArns = expandStringSet(v)
+	}
+
+	return destination
+}
+
+func flattenCloudWatchEventReplayDestination(destination *events.ReplayDestination) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"arn":         aws.StringValue(destination.Arn),
+		"filter_arns": schema.NewSet(schema.HashString, flattenStringList(destination.FilterArns)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchEventReplay_basic(t *testing.T) {
+	var replay events.DescribeReplayOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_replay.test"
+	endTime := time.Now().UTC().Truncate(time.Second)
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventReplayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventReplayConfig(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventReplayExists(resourceName, &replay),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", events.ReplayStateCompleted),
+					resource.TestCheckResourceAttr(resourceName, "event_start_time", startTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "event_end_time", endTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.arn", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_archive.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:events:[^:]+:[^:]+:replay/%s$", rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventReplayExists(n string, replay *events.DescribeReplayOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+			ReplayName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*replay = *output
+
+		return nil
+	}
+}
+
+// Replays cannot be deleted, so destroying one only has to stop it.
+func testAccCheckAWSCloudWatchEventReplayDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_replay" {
+			continue
+		}
+
+		output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+			ReplayName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		switch state := aws.StringValue(output.State); state {
+		case events.ReplayStateStarting, events.ReplayStateRunning, events.ReplayStateCancelling:
+			return fmt.Errorf("CloudWatch Event Replay %q still in progress (%s)", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchEventReplayConfig(rName string, startTime, endTime time.Time) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = "${aws_cloudwatch_event_bus.test.arn}"
+}
+
+resource "aws_cloudwatch_event_replay" "test" {
+  name             = %[1]q
+  event_source_arn = "${aws_cloudwatch_event_archive.test.arn}"
+  event_start_time = %[2]q
+  event_end_time   = %[3]q
+
+  destination {
+    arn = "${aws_cloudwatch_event_bus.test.arn}"
+  }
+}
+`, rName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
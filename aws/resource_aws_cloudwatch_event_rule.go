@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 )
 
+const cloudWatchEventDefaultEventBusName = "default"
+
 func resourceAwsCloudWatchEventRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudWatchEventRuleCreate,
@@ -32,6 +35,13 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateCloudWatchEventRuleName,
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudWatchEventDefaultEventBusName,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
 			"schedule_expression": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -100,7 +110,7 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("arn", out.RuleArn)
-	d.SetId(d.Get("name").(string))
+	d.SetId(cloudWatchEventRuleCreateId(d.Get("event_bus_name").(string), d.Get("name").(string)))
 
 	log.Printf("[INFO] CloudWatch Event Rule %q created", *out.RuleArn)
 
@@ -110,8 +120,14 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	busName, ruleName, err := cloudWatchEventRuleParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := events.DescribeRuleInput{
-		Name: aws.String(d.Id()),
+		EventBusName: aws.String(busName),
+		Name:         aws.String(ruleName),
 	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Rule: %s", input)
 	out, err := conn.DescribeRule(&input)
@@ -129,6 +145,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("arn", out.Arn)
 	d.Set("description", out.Description)
+	d.Set("event_bus_name", busName)
 	if out.EventPattern != nil {
 		pattern, err := structure.NormalizeJsonString(*out.EventPattern)
 		if err != nil {
@@ -153,10 +170,16 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	busName, ruleName, err := cloudWatchEventRuleParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Enabling CloudWatch Event Rule %q", d.Id())
 		_, err := conn.EnableRule(&events.EnableRuleInput{
-			Name: aws.String(d.Id()),
+			EventBusName: aws.String(busName),
+			Name:         aws.String(ruleName),
 		})
 		if err != nil {
 			return err
@@ -192,7 +215,8 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("is_enabled") && !d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Disabling CloudWatch Event Rule %q", d.Id())
 		_, err := conn.DisableRule(&events.DisableRuleInput{
-			Name: aws.String(d.Id()),
+			EventBusName: aws.String(busName),
+			Name:         aws.String(ruleName),
 		})
 		if err != nil {
 			return err
@@ -206,9 +230,15 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	busName, ruleName, err := cloudWatchEventRuleParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting CloudWatch Event Rule: %s", d.Id())
-	_, err := conn.DeleteRule(&events.DeleteRuleInput{
-		Name: aws.String(d.Id()),
+	_, err = conn.DeleteRule(&events.DeleteRuleInput{
+		EventBusName: aws.String(busName),
+		Name:         aws.String(ruleName),
 	})
 	if err != nil {
 		return fmt.Errorf("Error deleting CloudWatch Event Rule: %s", err)
@@ -222,7 +252,8 @@ func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface
 
 func buildPutRuleInputStruct(d *schema.ResourceData) (*events.PutRuleInput, error) {
 	input := events.PutRuleInput{
-		Name: aws.String(d.Get("name").(string)),
+		EventBusName: aws.String(d.Get("event_bus_name").(string)),
+		Name:         aws.String(d.Get("name").(string)),
 	}
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
@@ -246,6 +277,33 @@ func buildPutRuleInputStruct(d *schema.ResourceData) (*events.PutRuleInput, erro
 	return &input, nil
 }
 
+// cloudWatchEventRuleCreateId returns the resource ID of a rule. Rules on the
+// default event bus keep their plain name as ID for backwards compatibility.
+func cloudWatchEventRuleCreateId(busName, ruleName string) string {
+	if busName == "" || busName == cloudWatchEventDefaultEventBusName {
+		return ruleName
+	}
+
+	return busName + "/" + ruleName
+}
+
+// cloudWatchEventRuleParseId splits a rule ID into event bus and rule name.
+// Rule names cannot contain "/" but partner event bus names can, so the rule
+// name is everything after the last "/".
+func cloudWatchEventRuleParseId(id string) (string, string, error) {
+	i := strings.LastIndex(id, "/")
+	if i == -1 {
+		return cloudWatchEventDefaultEventBusName, id, nil
+	}
+
+	busName, ruleName := id[:i], id[i+1:]
+	if busName == "" || ruleName == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected RULE_NAME or EVENT_BUS_NAME/RULE_NAME", id)
+	}
+
+	return busName, ruleName, nil
+}
+
 // State is represented as (ENABLED|DISABLED) in the API
 func getBooleanStateFromString(state string) (bool, error) {
 	if state == "ENABLED" {
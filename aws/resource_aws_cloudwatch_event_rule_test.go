@@ -94,6 +94,85 @@ func TestAccAWSCloudWatchEventRule_enable(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventRule_eventBusName(t *testing.T) {
+	var rule events.DescribeRuleOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventRuleConfigEventBusName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%[1]s/%[1]s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCloudWatchEventRuleParseId(t *testing.T) {
+	testCases := []struct {
+		Id           string
+		ExpectedBus  string
+		ExpectedRule string
+		ExpectError  bool
+	}{
+		{
+			Id:           "my-rule",
+			ExpectedBus:  "default",
+			ExpectedRule: "my-rule",
+		},
+		{
+			Id:           "my-bus/my-rule",
+			ExpectedBus:  "my-bus",
+			ExpectedRule: "my-rule",
+		},
+		{
+			Id:           "aws.partner/example.com/123/my-rule",
+			ExpectedBus:  "aws.partner/example.com/123",
+			ExpectedRule: "my-rule",
+		},
+		{
+			Id:          "my-bus/",
+			ExpectError: true,
+		},
+		{
+			Id:          "/my-rule",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		busName, ruleName, err := cloudWatchEventRuleParseId(tc.Id)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error parsing %q", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", tc.Id, err)
+		}
+		if busName != tc.ExpectedBus || ruleName != tc.ExpectedRule {
+			t.Fatalf("parsing %q: expected (%q, %q), got (%q, %q)", tc.Id, tc.ExpectedBus, tc.ExpectedRule, busName, ruleName)
+		}
+		if id := cloudWatchEventRuleCreateId(busName, ruleName); id != tc.Id {
+			t.Fatalf("expected round trip ID %q, got %q", tc.Id, id)
+		}
+	}
+}
+
 func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -103,7 +182,8 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(rs.Primary.Attributes["event_bus_name"]),
+			Name:         aws.String(rs.Primary.Attributes["name"]),
 		}
 		resp, err := conn.DescribeRule(&params)
 		if err != nil {
@@ -128,7 +208,8 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(rs.Primary.Attributes["event_bus_name"]),
+			Name:         aws.String(rs.Primary.Attributes["name"]),
 		}
 		resp, err := conn.DescribeRule(&params)
 
@@ -152,7 +233,8 @@ func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
 		}
 
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(rs.Primary.Attributes["event_bus_name"]),
+			Name:         aws.String(rs.Primary.Attributes["name"]),
 		}
 
 		resp, err := conn.DescribeRule(&params)
@@ -264,4 +346,21 @@ PATTERN
 }
 `
 
+func testAccAWSCloudWatchEventRuleConfigEventBusName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = "${aws_cloudwatch_event_bus.test.name}"
+
+  event_pattern = <<PATTERN
+{ "source": ["my.application"] }
+PATTERN
+}
+`, rName)
+}
+
 // TODO: Figure out example with IAM Role
@@ -30,6 +30,13 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 				ValidateFunc: validateCloudWatchEventRuleName,
 			},
 
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
+
 			"target_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -46,7 +53,7 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 			"input": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"input_path"},
+				ConflictsWith: []string{"input_path", "input_transformer"},
 				// We could be normalizing the JSON here,
 				// but for built-in targets input may not be JSON
 			},
@@ -54,7 +61,7 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 			"input_path": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"input"},
+				ConflictsWith: []string{"input", "input_transformer"},
 			},
 
 			"role_arn": {
@@ -88,9 +95,95 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"capacity_provider_strategy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 6,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100000),
+									},
+									"capacity_provider": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+								},
+							},
+						},
+						"enable_ecs_managed_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_execute_command": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"launch_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								events.LaunchTypeEc2,
+								events.LaunchTypeExternal,
+								events.LaunchTypeFargate,
+							}, false),
+						},
+						"network_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assign_public_ip": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"security_groups": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+									"subnets": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+								},
+							},
+						},
+						"platform_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1600),
+						},
+						"propagate_tags": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								events.PropagateTagsTaskDefinition,
+							}, false),
+						},
+						"tags": tagsSchema(),
 						"task_count": {
 							Type:         schema.TypeInt,
 							Optional:     true,
+							Default:      1,
 							ValidateFunc: validation.IntBetween(1, math.MaxInt32),
 						},
 						"task_definition_arn": {
@@ -102,15 +195,94 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 				},
 			},
 
-			"input_transformer": {
+			"batch_target": {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"array_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 10000),
+						},
+						"job_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"job_definition": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"job_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"sqs_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"retry_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_event_age_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(60, 86400),
+						},
+						"maximum_retry_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 185),
+						},
+					},
+				},
+			},
+
+			"input_transformer": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"input", "input_path"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"input_paths": {
 							Type:     schema.TypeMap,
 							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"input_template": {
 							Type:         schema.TypeString,
@@ -164,6 +336,7 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
 		d.Get("rule").(string),
+		d.Get("event_bus_name").(string),
 		nil, conn)
 	if err != nil {
 		if regexp.MustCompile(" not found$").MatchString(err.Error()) {
@@ -203,27 +376,42 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if t.EcsParameters != nil {
-		if err := d.Set("ecs_target", flattenAwsCloudWatchEventTargetEcsParameters(t.EcsParameters)); err != nil {
-			return fmt.Errorf("[DEBUG] Error setting ecs_target error: %#v", err)
-		}
+	if err := d.Set("ecs_target", flattenAwsCloudWatchEventTargetEcsParameters(t.EcsParameters)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting ecs_target error: %#v", err)
 	}
 
-	if t.InputTransformer != nil {
-		if err := d.Set("input_transformer", flattenAwsCloudWatchInputTransformer(t.InputTransformer)); err != nil {
-			return fmt.Errorf("[DEBUG] Error setting input_transformer error: %#v", err)
-		}
+	if err := d.Set("batch_target", flattenAwsCloudWatchEventTargetBatchParameters(t.BatchParameters)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting batch_target error: %#v", err)
+	}
+
+	if err := d.Set("sqs_target", flattenAwsCloudWatchEventTargetSqsParameters(t.SqsParameters)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting sqs_target error: %#v", err)
+	}
+
+	if err := d.Set("dead_letter_config", flattenAwsCloudWatchEventTargetDeadLetterConfig(t.DeadLetterConfig)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting dead_letter_config error: %#v", err)
+	}
+
+	if err := d.Set("retry_policy", flattenAwsCloudWatchEventTargetRetryPolicy(t.RetryPolicy)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting retry_policy error: %#v", err)
+	}
+
+	if err := d.Set("input_transformer", flattenAwsCloudWatchInputTransformer(t.InputTransformer)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting input_transformer error: %#v", err)
 	}
 
 	return nil
 }
 
-func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
+func findEventTargetById(id, rule, busName string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
 	input := events.ListTargetsByRuleInput{
 		Rule:      aws.String(rule),
 		NextToken: nextToken,
 		Limit:     aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 	}
+	if busName != "" {
+		input.EventBusName = aws.String(busName)
+	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Target: %s", input)
 	out, err := conn.ListTargetsByRule(&input)
 	if err != nil {
@@ -237,7 +425,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 	}
 
 	if out.NextToken != nil {
-		return findEventTargetById(id, rule, nextToken, conn)
+		return findEventTargetById(id, rule, busName, out.NextToken, conn)
 	}
 
 	return nil, fmt.Errorf("CloudWatch Event Target %q (%q) not found", id, rule)
@@ -264,6 +452,9 @@ func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interfa
 		Ids:  []*string{aws.String(d.Get("target_id").(string))},
 		Rule: aws.String(d.Get("rule").(string)),
 	}
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
 	log.Printf("[INFO] Deleting CloudWatch Event Target: %s", input)
 	_, err := conn.RemoveTargets(&input)
 	if err != nil {
@@ -300,6 +491,22 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		e.EcsParameters = expandAwsCloudWatchEventTargetEcsParameters(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("batch_target"); ok {
+		e.BatchParameters = expandAwsCloudWatchEventTargetBatchParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sqs_target"); ok {
+		e.SqsParameters = expandAwsCloudWatchEventTargetSqsParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("dead_letter_config"); ok {
+		e.DeadLetterConfig = expandAwsCloudWatchEventTargetDeadLetterConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retry_policy"); ok {
+		e.RetryPolicy = expandAwsCloudWatchEventTargetRetryPolicy(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("input_transformer"); ok {
 		e.InputTransformer = expandAwsCloudWatchEventTransformerParameters(v.([]interface{}))
 	}
@@ -308,6 +515,9 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		Rule:    aws.String(d.Get("rule").(string)),
 		Targets: []*events.Target{e},
 	}
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
 
 	return &input
 }
@@ -339,11 +549,136 @@ func expandAwsCloudWatchEventTargetEcsParameters(config []interface{}) *events.E
 		param := c.(map[string]interface{})
 		ecsParameters.TaskCount = aws.Int64(int64(param["task_count"].(int)))
 		ecsParameters.TaskDefinitionArn = aws.String(param["task_definition_arn"].(string))
+
+		for _, raw := range param["capacity_provider_strategy"].([]interface{}) {
+			cp := raw.(map[string]interface{})
+			ecsParameters.CapacityProviderStrategy = append(ecsParameters.CapacityProviderStrategy, &events.CapacityProviderStrategyItem{
+				Base:             aws.Int64(int64(cp["base"].(int))),
+				CapacityProvider: aws.String(cp["capacity_provider"].(string)),
+				Weight:           aws.Int64(int64(cp["weight"].(int))),
+			})
+		}
+
+		if v, ok := param["enable_ecs_managed_tags"].(bool); ok && v {
+			ecsParameters.EnableECSManagedTags = aws.Bool(v)
+		}
+
+		if v, ok := param["enable_execute_command"].(bool); ok && v {
+			ecsParameters.EnableExecuteCommand = aws.Bool(v)
+		}
+
+		if v, ok := param["group"].(string); ok && v != "" {
+			ecsParameters.Group = aws.String(v)
+		}
+
+		if v, ok := param["launch_type"].(string); ok && v != "" {
+			ecsParameters.LaunchType = aws.String(v)
+		}
+
+		if v, ok := param["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			nc := v[0].(map[string]interface{})
+			awsVpcConfig := &events.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(events.AssignPublicIpDisabled),
+				Subnets:        expandStringSet(nc["subnets"].(*schema.Set)),
+			}
+			if nc["assign_public_ip"].(bool) {
+				awsVpcConfig.AssignPublicIp = aws.String(events.AssignPublicIpEnabled)
+			}
+			if v := nc["security_groups"].(*schema.Set); v.Len() > 0 {
+				awsVpcConfig.SecurityGroups = expandStringSet(v)
+			}
+			ecsParameters.NetworkConfiguration = &events.NetworkConfiguration{
+				AwsvpcConfiguration: awsVpcConfig,
+			}
+		}
+
+		if v, ok := param["platform_version"].(string); ok && v != "" {
+			ecsParameters.PlatformVersion = aws.String(v)
+		}
+
+		if v, ok := param["propagate_tags"].(string); ok && v != "" {
+			ecsParameters.PropagateTags = aws.String(v)
+		}
+
+		if v, ok := param["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			ecsParameters.Tags = tagsFromMapCloudWatchEvents(v)
+		}
 	}
 
 	return ecsParameters
 }
 
+func expandAwsCloudWatchEventTargetBatchParameters(config []interface{}) *events.BatchParameters {
+	batchParameters := &events.BatchParameters{}
+	for _, c := range config {
+		param := c.(map[string]interface{})
+		batchParameters.JobDefinition = aws.String(param["job_definition"].(string))
+		batchParameters.JobName = aws.String(param["job_name"].(string))
+
+		if v, ok := param["array_size"].(int); ok && v > 1 {
+			batchParameters.ArrayProperties = &events.BatchArrayProperties{
+				Size: aws.Int64(int64(v)),
+			}
+		}
+
+		if v, ok := param["job_attempts"].(int); ok && v > 0 {
+			batchParameters.RetryStrategy = &events.BatchRetryStrategy{
+				Attempts: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	return batchParameters
+}
+
+func expandAwsCloudWatchEventTargetSqsParameters(config []interface{}) *events.SqsParameters {
+	sqsParameters := &events.SqsParameters{}
+	for _, c := range config {
+		if c == nil {
+			continue
+		}
+		param := c.(map[string]interface{})
+		if v, ok := param["message_group_id"].(string); ok && v != "" {
+			sqsParameters.MessageGroupId = aws.String(v)
+		}
+	}
+
+	return sqsParameters
+}
+
+func expandAwsCloudWatchEventTargetDeadLetterConfig(config []interface{}) *events.DeadLetterConfig {
+	deadLetterConfig := &events.DeadLetterConfig{}
+	for _, c := range config {
+		if c == nil {
+			continue
+		}
+		param := c.(map[string]interface{})
+		if v, ok := param["arn"].(string); ok && v != "" {
+			deadLetterConfig.Arn = aws.String(v)
+		}
+	}
+
+	return deadLetterConfig
+}
+
+func expandAwsCloudWatchEventTargetRetryPolicy(config []interface{}) *events.RetryPolicy {
+	retryPolicy := &events.RetryPolicy{}
+	for _, c := range config {
+		if c == nil {
+			continue
+		}
+		param := c.(map[string]interface{})
+		if v, ok := param["maximum_event_age_in_seconds"].(int); ok && v != 0 {
+			retryPolicy.MaximumEventAgeInSeconds = aws.Int64(int64(v))
+		}
+		if v, ok := param["maximum_retry_attempts"].(int); ok {
+			retryPolicy.MaximumRetryAttempts = aws.Int64(int64(v))
+		}
+	}
+
+	return retryPolicy
+}
+
 func expandAwsCloudWatchEventTransformerParameters(config []interface{}) *events.InputTransformer {
 	transformerParameters := &events.InputTransformer{}
 
@@ -378,14 +713,102 @@ func flattenAwsCloudWatchEventTargetRunParameters(runCommand *events.RunCommandP
 	return result
 }
 func flattenAwsCloudWatchEventTargetEcsParameters(ecsParameters *events.EcsParameters) []map[string]interface{} {
+	if ecsParameters == nil {
+		return nil
+	}
+
+	config := make(map[string]interface{})
+	config["task_count"] = aws.Int64Value(ecsParameters.TaskCount)
+	config["task_definition_arn"] = aws.StringValue(ecsParameters.TaskDefinitionArn)
+	config["enable_ecs_managed_tags"] = aws.BoolValue(ecsParameters.EnableECSManagedTags)
+	config["enable_execute_command"] = aws.BoolValue(ecsParameters.EnableExecuteCommand)
+	config["group"] = aws.StringValue(ecsParameters.Group)
+	config["launch_type"] = aws.StringValue(ecsParameters.LaunchType)
+	config["platform_version"] = aws.StringValue(ecsParameters.PlatformVersion)
+	config["propagate_tags"] = aws.StringValue(ecsParameters.PropagateTags)
+	config["tags"] = tagsToMapCloudWatchEvents(ecsParameters.Tags)
+
+	capacityProviderStrategy := make([]interface{}, 0, len(ecsParameters.CapacityProviderStrategy))
+	for _, cp := range ecsParameters.CapacityProviderStrategy {
+		capacityProviderStrategy = append(capacityProviderStrategy, map[string]interface{}{
+			"base":              int(aws.Int64Value(cp.Base)),
+			"capacity_provider": aws.StringValue(cp.CapacityProvider),
+			"weight":            int(aws.Int64Value(cp.Weight)),
+		})
+	}
+	config["capacity_provider_strategy"] = capacityProviderStrategy
+
+	if nc := ecsParameters.NetworkConfiguration; nc != nil && nc.AwsvpcConfiguration != nil {
+		config["network_configuration"] = []interface{}{
+			map[string]interface{}{
+				"assign_public_ip": aws.StringValue(nc.AwsvpcConfiguration.AssignPublicIp) == events.AssignPublicIpEnabled,
+				"security_groups":  schema.NewSet(schema.HashString, flattenStringList(nc.AwsvpcConfiguration.SecurityGroups)),
+				"subnets":          schema.NewSet(schema.HashString, flattenStringList(nc.AwsvpcConfiguration.Subnets)),
+			},
+		}
+	}
+
+	result := []map[string]interface{}{config}
+	return result
+}
+
+func flattenAwsCloudWatchEventTargetBatchParameters(batchParameters *events.BatchParameters) []map[string]interface{} {
+	if batchParameters == nil {
+		return nil
+	}
+
 	config := make(map[string]interface{})
-	config["task_count"] = *ecsParameters.TaskCount
-	config["task_definition_arn"] = *ecsParameters.TaskDefinitionArn
+	config["job_definition"] = aws.StringValue(batchParameters.JobDefinition)
+	config["job_name"] = aws.StringValue(batchParameters.JobName)
+	if batchParameters.ArrayProperties != nil {
+		config["array_size"] = int(aws.Int64Value(batchParameters.ArrayProperties.Size))
+	}
+	if batchParameters.RetryStrategy != nil {
+		config["job_attempts"] = int(aws.Int64Value(batchParameters.RetryStrategy.Attempts))
+	}
+	result := []map[string]interface{}{config}
+	return result
+}
+
+func flattenAwsCloudWatchEventTargetSqsParameters(sqsParameters *events.SqsParameters) []map[string]interface{} {
+	if sqsParameters == nil {
+		return nil
+	}
+
+	config := make(map[string]interface{})
+	config["message_group_id"] = aws.StringValue(sqsParameters.MessageGroupId)
+	result := []map[string]interface{}{config}
+	return result
+}
+
+func flattenAwsCloudWatchEventTargetDeadLetterConfig(deadLetterConfig *events.DeadLetterConfig) []map[string]interface{} {
+	if deadLetterConfig == nil {
+		return nil
+	}
+
+	config := make(map[string]interface{})
+	config["arn"] = aws.StringValue(deadLetterConfig.Arn)
+	result := []map[string]interface{}{config}
+	return result
+}
+
+func flattenAwsCloudWatchEventTargetRetryPolicy(retryPolicy *events.RetryPolicy) []map[string]interface{} {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	config := make(map[string]interface{})
+	config["maximum_event_age_in_seconds"] = int(aws.Int64Value(retryPolicy.MaximumEventAgeInSeconds))
+	config["maximum_retry_attempts"] = int(aws.Int64Value(retryPolicy.MaximumRetryAttempts))
 	result := []map[string]interface{}{config}
 	return result
 }
 
 func flattenAwsCloudWatchInputTransformer(inputTransformer *events.InputTransformer) []map[string]interface{} {
+	if inputTransformer == nil {
+		return nil
+	}
+
 	config := make(map[string]interface{})
 	inputPathsMap := make(map[string]string)
 	for k, v := range inputTransformer.InputPathsMap {
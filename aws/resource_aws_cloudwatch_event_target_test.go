@@ -158,6 +158,99 @@ func TestAccAWSCloudWatchEventTarget_input_transformer(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventTarget_ecsFull(t *testing.T) {
+	var target events.Target
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigEcsFull(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.launch_type", "FARGATE"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.platform_version", "1.4.0"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.group", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.enable_ecs_managed_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.propagate_tags", "TASK_DEFINITION"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.network_configuration.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_target.0.network_configuration.0.assign_public_ip", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventTarget_sqsFifo(t *testing.T) {
+	var target events.Target
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigSqsFifo(rName, "group1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "sqs_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sqs_target.0.message_group_id", "group1"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigSqsFifo(rName, "group2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "sqs_target.0.message_group_id", "group2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventTarget_deadLetterAndRetryPolicy(t *testing.T) {
+	var target events.Target
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigDeadLetterAndRetryPolicy(rName, 60, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_event_age_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_retry_attempts", "5"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigDeadLetterAndRetryPolicy(rName, 3600, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_event_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_retry_attempts", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -167,7 +260,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err != nil {
 			return fmt.Errorf("Event Target not found: %s", err)
 		}
@@ -187,7 +280,7 @@ func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
 		}
 
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err == nil {
 			return fmt.Errorf("CloudWatch Event Target %q still exists: %s",
 				rs.Primary.ID, t)
@@ -532,3 +625,178 @@ EOF
   }
 }`, rName)
 }
+
+func testAccAWSCloudWatchEventTargetConfigEcsFull(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(5 minutes)"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "events.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecs:RunTask",
+        "ecs:TagResource",
+        "iam:PassRole"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  cpu                      = 256
+  memory                   = 512
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+
+  container_definitions = <<EOF
+[
+  {
+    "name": "first",
+    "image": "service-first",
+    "cpu": 10,
+    "memory": 512,
+    "essential": true
+  }
+]
+EOF
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn      = "${aws_ecs_cluster.test.arn}"
+  rule     = "${aws_cloudwatch_event_rule.test.id}"
+  role_arn = "${aws_iam_role.test.arn}"
+
+  ecs_target {
+    task_count              = 1
+    task_definition_arn     = "${aws_ecs_task_definition.test.arn}"
+    launch_type             = "FARGATE"
+    platform_version        = "1.4.0"
+    group                   = "foo"
+    enable_ecs_managed_tags = true
+    propagate_tags          = "TASK_DEFINITION"
+
+    network_configuration {
+      subnets = ["${aws_subnet.test.id}"]
+    }
+
+    tags {
+      Name = %[1]q
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchEventTargetConfigSqsFifo(rName, messageGroupId string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sqs_queue" "test" {
+  name                        = "%[1]s.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = true
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn  = "${aws_sqs_queue.test.arn}"
+  rule = "${aws_cloudwatch_event_rule.test.id}"
+
+  sqs_target {
+    message_group_id = %[2]q
+  }
+}
+`, rName, messageGroupId)
+}
+
+func testAccAWSCloudWatchEventTargetConfigDeadLetterAndRetryPolicy(rName string, maxEventAge, maxRetryAttempts int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn  = "${aws_sns_topic.test.arn}"
+  rule = "${aws_cloudwatch_event_rule.test.id}"
+
+  dead_letter_config {
+    arn = "${aws_sqs_queue.dlq.arn}"
+  }
+
+  retry_policy {
+    maximum_event_age_in_seconds = %[2]d
+    maximum_retry_attempts       = %[3]d
+  }
+}
+`, rName, maxEventAge, maxRetryAttempts)
+}
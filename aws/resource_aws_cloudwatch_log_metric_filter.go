@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -73,6 +74,17 @@ func resourceAwsCloudWatchLogMetricFilter() *schema.Resource {
 							Type:     schema.TypeFloat,
 							Optional: true,
 						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatchlogs.StandardUnitNone,
+							ValidateFunc: validation.StringInSlice(cloudwatchlogs.StandardUnit_Values(), false),
+						},
 					},
 				},
 			},
@@ -63,6 +63,46 @@ func TestAccAWSCloudWatchLogMetricFilter_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchLogMetricFilter_dimensionsAndUnit(t *testing.T) {
+	var mf cloudwatchlogs.MetricFilter
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_log_metric_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogMetricFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogMetricFilterConfigDimensions(rName, "Seconds"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogMetricFilterExists(resourceName, &mf),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.dimensions.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.dimensions.ErrorCode", "$.errorCode"),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.dimensions.EventSource", "$.eventSource"),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.unit", "Seconds"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchLogMetricFilterConfigDimensions(rName, "Milliseconds"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogMetricFilterExists(resourceName, &mf),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.dimensions.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.unit", "Milliseconds"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchLogMetricFilterConfigNoDimensions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogMetricFilterExists(resourceName, &mf),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.dimensions.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_transformation.0.unit", "None"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudWatchLogMetricFilterName(mf *cloudwatchlogs.MetricFilter, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if name != *mf.FilterName {
@@ -191,3 +231,49 @@ resource "aws_cloudwatch_log_group" "dada" {
 }
 `, rInt, rInt)
 }
+
+func testAccAWSCloudWatchLogMetricFilterConfigDimensions(rName, unit string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_metric_filter" "test" {
+  name           = %[1]q
+  pattern        = "{ $.errorCode = \"*\" }"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+
+  metric_transformation {
+    name      = "ErrorCount"
+    namespace = "MyNamespace"
+    value     = "$.duration"
+    unit      = %[2]q
+
+    dimensions {
+      ErrorCode   = "$.errorCode"
+      EventSource = "$.eventSource"
+    }
+  }
+}
+`, rName, unit)
+}
+
+func testAccAWSCloudWatchLogMetricFilterConfigNoDimensions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_metric_filter" "test" {
+  name           = %[1]q
+  pattern        = "{ $.errorCode = \"*\" }"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+
+  metric_transformation {
+    name      = "ErrorCount"
+    namespace = "MyNamespace"
+    value     = "1"
+  }
+}
+`, rName)
+}
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
@@ -29,9 +31,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"destination_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
 			},
 			"filter_pattern": {
 				Type:     schema.TypeString,
@@ -44,13 +47,19 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
 			},
 			"distribution": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Default:  cloudwatchlogs.DistributionByLogStream,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudwatchlogs.DistributionByLogStream,
+					cloudwatchlogs.DistributionRandom,
+				}, false),
 			},
 		},
 	}
@@ -58,6 +67,11 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 
 func resourceAwsCloudwatchLogSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	if err := validateAwsCloudWatchLogSubscriptionFilterDestination(d); err != nil {
+		return err
+	}
+
 	params := getAwsCloudWatchLogsSubscriptionFilterInput(d)
 	log.Printf("[DEBUG] Creating SubscriptionFilter %#v", params)
 
@@ -92,6 +106,10 @@ func resourceAwsCloudwatchLogSubscriptionFilterCreate(d *schema.ResourceData, me
 func resourceAwsCloudwatchLogSubscriptionFilterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 
+	if err := validateAwsCloudWatchLogSubscriptionFilterDestination(d); err != nil {
+		return err
+	}
+
 	params := getAwsCloudWatchLogsSubscriptionFilterInput(d)
 
 	log.Printf("[DEBUG] Update SubscriptionFilter %#v", params)
@@ -154,8 +172,12 @@ func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta
 	}
 
 	for _, subscriptionFilter := range resp.SubscriptionFilters {
-		if *subscriptionFilter.LogGroupName == log_group_name {
+		if aws.StringValue(subscriptionFilter.LogGroupName) == log_group_name && aws.StringValue(subscriptionFilter.FilterName) == name {
 			d.SetId(cloudwatchLogsSubscriptionFilterId(log_group_name))
+			d.Set("destination_arn", subscriptionFilter.DestinationArn)
+			d.Set("distribution", subscriptionFilter.Distribution)
+			d.Set("filter_pattern", subscriptionFilter.FilterPattern)
+			d.Set("role_arn", subscriptionFilter.RoleArn)
 			return nil // OK, matching subscription filter found
 		}
 	}
@@ -184,6 +206,26 @@ func resourceAwsCloudwatchLogSubscriptionFilterDelete(d *schema.ResourceData, me
 	return nil
 }
 
+// validateAwsCloudWatchLogSubscriptionFilterDestination ensures a role is
+// supplied for destinations that CloudWatch Logs must assume a role to write
+// to. Lambda functions and cross-account log destinations are authorized by
+// resource policies instead.
+func validateAwsCloudWatchLogSubscriptionFilterDestination(d *schema.ResourceData) error {
+	destinationArn, err := arn.Parse(d.Get("destination_arn").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing destination_arn (%s): %s", d.Get("destination_arn").(string), err)
+	}
+
+	switch destinationArn.Service {
+	case "kinesis", "firehose":
+		if _, ok := d.GetOk("role_arn"); !ok {
+			return fmt.Errorf("`role_arn` must be set for a subscription filter with a %s destination (%s)", destinationArn.Service, destinationArn)
+		}
+	}
+
+	return nil
+}
+
 func cloudwatchLogsSubscriptionFilterId(log_group_name string) string {
 	var buf bytes.Buffer
 
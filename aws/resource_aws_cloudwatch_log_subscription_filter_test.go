@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,7 +25,7 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_basic(t *testing.T) {
 			{
 				Config: testAccAWSCloudwatchLogSubscriptionFilterConfig(rstring),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAwsCloudwatchLogSubscriptionFilterExists("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", &filter, rstring),
+					testAccCheckAwsCloudwatchLogSubscriptionFilterExists("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", &filter),
 					resource.TestCheckResourceAttr(
 						"aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "filter_pattern", "logtype test"),
 					resource.TestCheckResourceAttr(
@@ -51,7 +52,7 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_subscriptionFilterDisappears(t *t
 			{
 				Config: testAccAWSCloudwatchLogSubscriptionFilterConfig(rstring),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAwsCloudwatchLogSubscriptionFilterExists("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", &filter, rstring),
+					testAccCheckAwsCloudwatchLogSubscriptionFilterExists("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", &filter),
 					testAccCheckCloudwatchLogSubscriptionFilterDisappears(rstring),
 				),
 				ExpectNonEmptyPlan: true,
@@ -60,6 +61,52 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_subscriptionFilterDisappears(t *t
 	})
 }
 
+func TestAccAWSCloudwatchLogSubscriptionFilter_kinesisDistribution(t *testing.T) {
+	var filter cloudwatchlogs.SubscriptionFilter
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_log_subscription_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudwatchLogSubscriptionFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudwatchLogSubscriptionFilterConfigKinesis(rName, "ByLogStream"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudwatchLogSubscriptionFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "ByLogStream"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSCloudwatchLogSubscriptionFilterConfigKinesis(rName, "Random"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudwatchLogSubscriptionFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "Random"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudwatchLogSubscriptionFilter_kinesisMissingRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudwatchLogSubscriptionFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudwatchLogSubscriptionFilterConfigKinesisMissingRole(rName),
+				ExpectError: regexp.MustCompile("`role_arn` must be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudwatchLogSubscriptionFilterDisappears(rName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
@@ -100,7 +147,7 @@ func testAccCheckCloudwatchLogSubscriptionFilterDestroy(s *terraform.State) erro
 
 }
 
-func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, filter *cloudwatchlogs.SubscriptionFilter, rName string) resource.TestCheckFunc {
+func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, filter *cloudwatchlogs.SubscriptionFilter) resource.TestCheckFunc {
 	// Wait for IAM role
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -123,7 +170,7 @@ func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, filter *clou
 		}
 
 		resp, err := conn.DescribeSubscriptionFilters(&input)
-		if err == nil {
+		if err != nil {
 			return err
 		}
 
@@ -133,7 +180,7 @@ func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, filter *clou
 
 		var found bool
 		for _, i := range resp.SubscriptionFilters {
-			if *i.FilterName == fmt.Sprintf("test_lambdafunction_logfilter_%s", rName) {
+			if aws.StringValue(i.FilterName) == filterNamePrefix {
 				*filter = *i
 				found = true
 			}
@@ -221,3 +268,82 @@ EOF
 }
 `, rstring, rstring, rstring, rstring, rstring)
 }
+
+func testAccAWSCloudwatchLogSubscriptionFilterConfigKinesisBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name              = %[1]q
+  retention_in_days = 1
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "logs.${data.aws_region.current.name}.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "kinesis:PutRecord",
+      "Resource": "${aws_kinesis_stream.test.arn}"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSCloudwatchLogSubscriptionFilterConfigKinesis(rName, distribution string) string {
+	return testAccAWSCloudwatchLogSubscriptionFilterConfigKinesisBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_subscription_filter" "test" {
+  name            = %[1]q
+  log_group_name  = "${aws_cloudwatch_log_group.test.name}"
+  filter_pattern  = "logtype test"
+  destination_arn = "${aws_kinesis_stream.test.arn}"
+  role_arn        = "${aws_iam_role.test.arn}"
+  distribution    = %[2]q
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, distribution)
+}
+
+func testAccAWSCloudwatchLogSubscriptionFilterConfigKinesisMissingRole(rName string) string {
+	return testAccAWSCloudwatchLogSubscriptionFilterConfigKinesisBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_subscription_filter" "test" {
+  name            = %[1]q
+  log_group_name  = "${aws_cloudwatch_log_group.test.name}"
+  filter_pattern  = "logtype test"
+  destination_arn = "${aws_kinesis_stream.test.arn}"
+}
+`, rName)
+}
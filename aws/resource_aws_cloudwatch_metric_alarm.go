@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -39,25 +40,113 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				Required: true,
 			},
 			"metric_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"metric_query"},
+			},
+			"metric_query": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      20,
+				ConflictsWith: []string{"extended_statistic", "metric_name", "namespace", "period", "statistic"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-z][a-zA-Z0-9_]{0,254}$`),
+								"must start with a lowercase letter and contain only letters, numbers and underscores",
+							),
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"namespace": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"unit": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
 			},
 			"namespace": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"metric_query"},
 			},
 			"period": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"metric_query"},
 			},
 			"statistic": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"extended_statistic"},
+				ConflictsWith: []string{"extended_statistic", "metric_query"},
 			},
 			"threshold": {
-				Type:     schema.TypeFloat,
-				Required: true,
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"threshold_metric_id"},
+			},
+			"threshold_metric_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"threshold"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
 			},
 			"actions_enabled": {
 				Type:     schema.TypeBool,
@@ -101,7 +190,7 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 			"extended_statistic": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"statistic"},
+				ConflictsWith: []string{"statistic", "metric_query"},
 			},
 			"treat_missing_data": {
 				Type:         schema.TypeString,
@@ -122,11 +211,8 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 func resourceAwsCloudWatchMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchconn
 
-	_, statisticOk := d.GetOk("statistic")
-	_, extendedStatisticOk := d.GetOk("extended_statistic")
-
-	if !statisticOk && !extendedStatisticOk {
-		return fmt.Errorf("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
+	if err := validateAwsCloudWatchMetricAlarm(d); err != nil {
+		return err
 	}
 
 	params := getAwsCloudWatchPutMetricAlarmInput(d)
@@ -174,12 +260,17 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 	d.Set("metric_name", a.MetricName)
 	d.Set("namespace", a.Namespace)
 
+	if err := d.Set("metric_query", flattenAwsCloudWatchMetricAlarmMetrics(a.Metrics)); err != nil {
+		return fmt.Errorf("error setting metric_query: %s", err)
+	}
+
 	if err := d.Set("ok_actions", _strArrPtrToList(a.OKActions)); err != nil {
 		log.Printf("[WARN] Error setting OK Actions: %s", err)
 	}
 	d.Set("period", a.Period)
 	d.Set("statistic", a.Statistic)
 	d.Set("threshold", a.Threshold)
+	d.Set("threshold_metric_id", a.ThresholdMetricId)
 	d.Set("unit", a.Unit)
 	d.Set("extended_statistic", a.ExtendedStatistic)
 	d.Set("treat_missing_data", a.TreatMissingData)
@@ -190,6 +281,11 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 
 func resourceAwsCloudWatchMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchconn
+
+	if err := validateAwsCloudWatchMetricAlarm(d); err != nil {
+		return err
+	}
+
 	params := getAwsCloudWatchPutMetricAlarmInput(d)
 
 	log.Printf("[DEBUG] Updating CloudWatch Metric Alarm: %#v", params)
@@ -233,13 +329,33 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		AlarmName:          aws.String(d.Get("alarm_name").(string)),
 		ComparisonOperator: aws.String(d.Get("comparison_operator").(string)),
 		EvaluationPeriods:  aws.Int64(int64(d.Get("evaluation_periods").(int))),
-		MetricName:         aws.String(d.Get("metric_name").(string)),
-		Namespace:          aws.String(d.Get("namespace").(string)),
-		Period:             aws.Int64(int64(d.Get("period").(int))),
-		Threshold:          aws.Float64(d.Get("threshold").(float64)),
 		TreatMissingData:   aws.String(d.Get("treat_missing_data").(string)),
 	}
 
+	if v, ok := d.GetOk("metric_name"); ok {
+		params.MetricName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("namespace"); ok {
+		params.Namespace = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("period"); ok {
+		params.Period = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("metric_query"); ok {
+		params.Metrics = expandAwsCloudWatchMetricAlarmMetrics(v.(*schema.Set).List())
+	}
+
+	// Anomaly detection alarms compare against a band returned by a metric
+	// query rather than a static threshold.
+	if v, ok := d.GetOk("threshold_metric_id"); ok {
+		params.ThresholdMetricId = aws.String(v.(string))
+	} else {
+		params.Threshold = aws.Float64(d.Get("threshold").(float64))
+	}
+
 	if v := d.Get("actions_enabled"); v != nil {
 		params.ActionsEnabled = aws.Bool(v.(bool))
 	}
@@ -295,17 +411,151 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		params.OKActions = okActions
 	}
 
-	a := d.Get("dimensions").(map[string]interface{})
-	dimensions := make([]*cloudwatch.Dimension, 0, len(a))
-	for k, v := range a {
+	if _, ok := d.GetOk("metric_query"); !ok {
+		params.Dimensions = expandAwsCloudWatchMetricAlarmDimensions(d.Get("dimensions").(map[string]interface{}))
+	}
+
+	return params
+}
+
+func validateAwsCloudWatchMetricAlarm(d *schema.ResourceData) error {
+	if _, ok := d.GetOk("metric_query"); ok {
+		return nil
+	}
+
+	if _, ok := d.GetOk("metric_name"); !ok {
+		return fmt.Errorf("One of `metric_name` or `metric_query` must be set for a cloudwatch metric alarm")
+	}
+
+	if _, ok := d.GetOk("namespace"); !ok {
+		return fmt.Errorf("`namespace` must be set for a cloudwatch metric alarm using `metric_name`")
+	}
+
+	if _, ok := d.GetOk("period"); !ok {
+		return fmt.Errorf("`period` must be set for a cloudwatch metric alarm using `metric_name`")
+	}
+
+	_, statisticOk := d.GetOk("statistic")
+	_, extendedStatisticOk := d.GetOk("extended_statistic")
+
+	if !statisticOk && !extendedStatisticOk {
+		return fmt.Errorf("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
+	}
+
+	return nil
+}
+
+func expandAwsCloudWatchMetricAlarmDimensions(m map[string]interface{}) []*cloudwatch.Dimension {
+	dimensions := make([]*cloudwatch.Dimension, 0, len(m))
+	for k, v := range m {
 		dimensions = append(dimensions, &cloudwatch.Dimension{
 			Name:  aws.String(k),
 			Value: aws.String(v.(string)),
 		})
 	}
-	params.Dimensions = dimensions
+	return dimensions
+}
 
-	return params
+func expandAwsCloudWatchMetricAlarmMetrics(l []interface{}) []*cloudwatch.MetricDataQuery {
+	queries := make([]*cloudwatch.MetricDataQuery, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		query := &cloudwatch.MetricDataQuery{
+			Id:         aws.String(m["id"].(string)),
+			ReturnData: aws.Bool(m["return_data"].(bool)),
+		}
+
+		if v, ok := m["account_id"].(string); ok && v != "" {
+			query.AccountId = aws.String(v)
+		}
+
+		if v, ok := m["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if v, ok := m["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := m["period"].(int); ok && v != 0 {
+			query.Period = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			query.MetricStat = expandAwsCloudWatchMetricAlarmMetricStat(v[0].(map[string]interface{}))
+		}
+
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+func expandAwsCloudWatchMetricAlarmMetricStat(m map[string]interface{}) *cloudwatch.MetricStat {
+	metric := &cloudwatch.Metric{
+		MetricName: aws.String(m["metric_name"].(string)),
+	}
+
+	if v, ok := m["namespace"].(string); ok && v != "" {
+		metric.Namespace = aws.String(v)
+	}
+
+	if v, ok := m["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		metric.Dimensions = expandAwsCloudWatchMetricAlarmDimensions(v)
+	}
+
+	stat := &cloudwatch.MetricStat{
+		Metric: metric,
+		Period: aws.Int64(int64(m["period"].(int))),
+		Stat:   aws.String(m["stat"].(string)),
+	}
+
+	if v, ok := m["unit"].(string); ok && v != "" {
+		stat.Unit = aws.String(v)
+	}
+
+	return stat
+}
+
+func flattenAwsCloudWatchMetricAlarmMetrics(queries []*cloudwatch.MetricDataQuery) []interface{} {
+	l := make([]interface{}, 0, len(queries))
+
+	for _, query := range queries {
+		if query == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"account_id":  aws.StringValue(query.AccountId),
+			"expression":  aws.StringValue(query.Expression),
+			"id":          aws.StringValue(query.Id),
+			"label":       aws.StringValue(query.Label),
+			"period":      int(aws.Int64Value(query.Period)),
+			"return_data": aws.BoolValue(query.ReturnData),
+		}
+
+		if stat := query.MetricStat; stat != nil && stat.Metric != nil {
+			m["metric"] = []interface{}{
+				map[string]interface{}{
+					"dimensions":  flattenDimensions(stat.Metric.Dimensions),
+					"metric_name": aws.StringValue(stat.Metric.MetricName),
+					"namespace":   aws.StringValue(stat.Metric.Namespace),
+					"period":      int(aws.Int64Value(stat.Period)),
+					"stat":        aws.StringValue(stat.Stat),
+					"unit":        aws.StringValue(stat.Unit),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
 }
 
 func getAwsCloudWatchMetricAlarm(d *schema.ResourceData, meta interface{}) (*cloudwatch.MetricAlarm, error) {
@@ -66,6 +66,87 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 				},
 				Set: resourceAwsCodeBuildProjectArtifactsHash,
 			},
+			"build_batch_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"combine_artifacts": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"restrictions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compute_types_allowed": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(codebuild.ComputeType_Values(), false),
+										},
+									},
+									"maximum_builds_allowed": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+								},
+							},
+						},
+						"service_role": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timeout_in_mins": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(5, 2160),
+						},
+					},
+				},
+			},
+			"cache": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"modes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codebuild.CacheMode_Values(), false),
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  codebuild.CacheTypeNoCache,
+							ValidateFunc: validation.StringInSlice([]string{
+								codebuild.CacheTypeNoCache,
+								codebuild.CacheTypeS3,
+								codebuild.CacheTypeLocal,
+							}, false),
+						},
+					},
+				},
+			},
+			"concurrent_build_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -129,6 +210,39 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 				},
 				Set: resourceAwsCodeBuildProjectEnvironmentHash,
 			},
+			"file_system_locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"mount_options": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"mount_point": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  codebuild.FileSystemTypeEfs,
+							ValidateFunc: validation.StringInSlice([]string{
+								codebuild.FileSystemTypeEfs,
+							}, false),
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -268,6 +382,22 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.VpcConfig = expandCodeBuildVpcConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("build_batch_config"); ok {
+		params.BuildBatchConfig = expandCodeBuildBuildBatchConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("cache"); ok {
+		params.Cache = expandCodeBuildProjectCache(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("concurrent_build_limit"); ok {
+		params.ConcurrentBuildLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("file_system_locations"); ok {
+		params.FileSystemLocations = expandCodeBuildProjectFileSystemLocations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("tags"); ok {
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
@@ -468,6 +598,26 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := d.Set("build_batch_config", flattenCodeBuildBuildBatchConfig(project.BuildBatchConfig)); err != nil {
+		return fmt.Errorf("error setting build_batch_config: %s", err)
+	}
+
+	// CodeBuild reports NO_CACHE for projects without a cache, so only keep
+	// that value when the block is actually configured.
+	cache := flattenCodeBuildProjectCache(project.Cache)
+	if len(d.Get("cache").([]interface{})) == 0 && project.Cache != nil && aws.StringValue(project.Cache.Type) == codebuild.CacheTypeNoCache {
+		cache = []interface{}{}
+	}
+	if err := d.Set("cache", cache); err != nil {
+		return fmt.Errorf("error setting cache: %s", err)
+	}
+
+	d.Set("concurrent_build_limit", project.ConcurrentBuildLimit)
+
+	if err := d.Set("file_system_locations", flattenCodeBuildProjectFileSystemLocations(project.FileSystemLocations)); err != nil {
+		return fmt.Errorf("error setting file_system_locations: %s", err)
+	}
+
 	d.Set("description", project.Description)
 	d.Set("encryption_key", project.EncryptionKey)
 	d.Set("name", project.Name)
@@ -507,6 +657,26 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 		params.VpcConfig = expandCodeBuildVpcConfig(d.Get("vpc_config").([]interface{}))
 	}
 
+	if d.HasChange("build_batch_config") {
+		params.BuildBatchConfig = expandCodeBuildBuildBatchConfig(d.Get("build_batch_config").([]interface{}))
+	}
+
+	if d.HasChange("cache") {
+		params.Cache = expandCodeBuildProjectCache(d.Get("cache").([]interface{}))
+	}
+
+	if d.HasChange("concurrent_build_limit") {
+		// A limit of -1 removes any previously configured limit.
+		params.ConcurrentBuildLimit = aws.Int64(-1)
+		if v, ok := d.GetOk("concurrent_build_limit"); ok {
+			params.ConcurrentBuildLimit = aws.Int64(int64(v.(int)))
+		}
+	}
+
+	if d.HasChange("file_system_locations") {
+		params.FileSystemLocations = expandCodeBuildProjectFileSystemLocations(d.Get("file_system_locations").(*schema.Set).List())
+	}
+
 	if d.HasChange("description") {
 		params.Description = aws.String(d.Get("description").(string))
 	}
@@ -554,6 +724,138 @@ func resourceAwsCodeBuildProjectDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func expandCodeBuildBuildBatchConfig(l []interface{}) *codebuild.ProjectBuildBatchConfig {
+	// An empty configuration disables batch builds.
+	if len(l) == 0 || l[0] == nil {
+		return &codebuild.ProjectBuildBatchConfig{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &codebuild.ProjectBuildBatchConfig{
+		CombineArtifacts: aws.Bool(m["combine_artifacts"].(bool)),
+		ServiceRole:      aws.String(m["service_role"].(string)),
+	}
+
+	if v, ok := m["restrictions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rm := v[0].(map[string]interface{})
+		config.Restrictions = &codebuild.BatchRestrictions{}
+
+		if v, ok := rm["compute_types_allowed"].([]interface{}); ok && len(v) > 0 {
+			config.Restrictions.ComputeTypesAllowed = expandStringList(v)
+		}
+		if v, ok := rm["maximum_builds_allowed"].(int); ok && v != 0 {
+			config.Restrictions.MaximumBuildsAllowed = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := m["timeout_in_mins"].(int); ok && v != 0 {
+		config.TimeoutInMins = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func flattenCodeBuildBuildBatchConfig(config *codebuild.ProjectBuildBatchConfig) []interface{} {
+	if config == nil || config.ServiceRole == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"combine_artifacts": aws.BoolValue(config.CombineArtifacts),
+		"restrictions":      []interface{}{},
+		"service_role":      aws.StringValue(config.ServiceRole),
+		"timeout_in_mins":   int(aws.Int64Value(config.TimeoutInMins)),
+	}
+
+	if r := config.Restrictions; r != nil {
+		m["restrictions"] = []interface{}{
+			map[string]interface{}{
+				"compute_types_allowed":  flattenStringList(r.ComputeTypesAllowed),
+				"maximum_builds_allowed": int(aws.Int64Value(r.MaximumBuildsAllowed)),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandCodeBuildProjectCache(l []interface{}) *codebuild.ProjectCache {
+	if len(l) == 0 || l[0] == nil {
+		return &codebuild.ProjectCache{
+			Type: aws.String(codebuild.CacheTypeNoCache),
+		}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	cache := &codebuild.ProjectCache{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["location"].(string); ok && v != "" {
+		cache.Location = aws.String(v)
+	}
+
+	if v, ok := m["modes"].([]interface{}); ok && len(v) > 0 {
+		cache.Modes = expandStringList(v)
+	}
+
+	return cache
+}
+
+func flattenCodeBuildProjectCache(cache *codebuild.ProjectCache) []interface{} {
+	if cache == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"location": aws.StringValue(cache.Location),
+		"modes":    flattenStringList(cache.Modes),
+		"type":     aws.StringValue(cache.Type),
+	}
+
+	return []interface{}{m}
+}
+
+func expandCodeBuildProjectFileSystemLocations(l []interface{}) []*codebuild.ProjectFileSystemLocation {
+	locations := make([]*codebuild.ProjectFileSystemLocation, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		location := &codebuild.ProjectFileSystemLocation{
+			Identifier: aws.String(m["identifier"].(string)),
+			Location:   aws.String(m["location"].(string)),
+			MountPoint: aws.String(m["mount_point"].(string)),
+			Type:       aws.String(m["type"].(string)),
+		}
+
+		if v, ok := m["mount_options"].(string); ok && v != "" {
+			location.MountOptions = aws.String(v)
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations
+}
+
+func flattenCodeBuildProjectFileSystemLocations(locations []*codebuild.ProjectFileSystemLocation) []interface{} {
+	result := make([]interface{}, 0, len(locations))
+
+	for _, location := range locations {
+		result = append(result, map[string]interface{}{
+			"identifier":    aws.StringValue(location.Identifier),
+			"location":      aws.StringValue(location.Location),
+			"mount_options": aws.StringValue(location.MountOptions),
+			"mount_point":   aws.StringValue(location.MountPoint),
+			"type":          aws.StringValue(location.Type),
+		})
+	}
+
+	return result
+}
+
 func flattenAwsCodeBuildProjectArtifacts(artifacts *codebuild.ProjectArtifacts) *schema.Set {
 
 	artifactSet := schema.Set{
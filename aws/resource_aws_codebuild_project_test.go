@@ -133,6 +133,141 @@ func TestAccAWSCodeBuildProject_default_build_timeout(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeBuildProject_buildBatchConfig(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_buildBatchConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.combine_artifacts", "true"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.restrictions.0.compute_types_allowed.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.restrictions.0.compute_types_allowed.0", "BUILD_GENERAL1_SMALL"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.restrictions.0.maximum_builds_allowed", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "build_batch_config.0.service_role", "aws_iam_role.codebuild_role", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.timeout_in_mins", "60"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_buildBatchConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "build_batch_config.0.restrictions.0.maximum_builds_allowed", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_cache(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_cacheS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.type", "S3"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache.0.location", "aws_s3_bucket.cache", "bucket"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_cacheLocal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.type", "LOCAL"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.modes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.modes.0", "LOCAL_SOURCE_CACHE"),
+					resource.TestCheckResourceAttr(resourceName, "cache.0.modes.1", "LOCAL_DOCKER_LAYER_CACHE"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_concurrentBuildLimit(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_concurrentBuildLimit(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_concurrentBuildLimit(rName, "concurrent_build_limit = 4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "concurrent_build_limit", "4"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_concurrentBuildLimit(rName, "concurrent_build_limit = 12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "concurrent_build_limit", "12"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_concurrentBuildLimit(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "concurrent_build_limit", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_fileSystemLocations(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_fileSystemLocations(rName, "/mount1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "file_system_locations.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_fileSystemLocations(rName, "/mount2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "file_system_locations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func longTestData() string {
 	data := `
 	test-test-test-test-test-test-test-test-test-test-
@@ -625,3 +760,145 @@ func testAccAWSCodeBuildProjectConfig_vpcConfig(subnets string) string {
   }
 `, subnets)
 }
+
+func testAccAWSCodeBuildProjectConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "codebuild_role" {
+  name = "codebuild-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codebuild.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "codebuild_policy" {
+  name = "codebuild-policy-%[1]s"
+  role = "${aws_iam_role.codebuild_role.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Resource": [
+        "*"
+      ],
+      "Action": [
+        "codebuild:*",
+        "ec2:*",
+        "elasticfilesystem:*",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "s3:*"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAWSCodeBuildProjectConfig_project(rName, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_codebuild_project" "foo" {
+  name          = "test-project-%[1]s"
+  build_timeout = "5"
+  service_role  = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type    = "BUILD_GENERAL1_SMALL"
+    image           = "2"
+    type            = "LINUX_CONTAINER"
+    privileged_mode = true
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+
+%[2]s
+
+  depends_on = ["aws_iam_role_policy.codebuild_policy"]
+}
+`, rName, extra)
+}
+
+func testAccAWSCodeBuildProjectConfig_buildBatchConfig(rName string, maximumBuildsAllowed int) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + testAccAWSCodeBuildProjectConfig_project(rName, fmt.Sprintf(`
+  build_batch_config {
+    combine_artifacts = true
+    service_role      = "${aws_iam_role.codebuild_role.arn}"
+    timeout_in_mins   = 60
+
+    restrictions {
+      compute_types_allowed  = ["BUILD_GENERAL1_SMALL"]
+      maximum_builds_allowed = %d
+    }
+  }
+`, maximumBuildsAllowed))
+}
+
+func testAccAWSCodeBuildProjectConfig_cacheS3(rName string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "cache" {
+  bucket        = "tf-acc-test-codebuild-cache-%s"
+  force_destroy = true
+}
+`, strings.ToLower(rName)) + testAccAWSCodeBuildProjectConfig_project(rName, `
+  cache {
+    type     = "S3"
+    location = "${aws_s3_bucket.cache.bucket}"
+  }
+`)
+}
+
+func testAccAWSCodeBuildProjectConfig_cacheLocal(rName string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + testAccAWSCodeBuildProjectConfig_project(rName, `
+  cache {
+    type  = "LOCAL"
+    modes = ["LOCAL_SOURCE_CACHE", "LOCAL_DOCKER_LAYER_CACHE"]
+  }
+`)
+}
+
+func testAccAWSCodeBuildProjectConfig_concurrentBuildLimit(rName, concurrentBuildLimit string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + testAccAWSCodeBuildProjectConfig_project(rName, concurrentBuildLimit)
+}
+
+func testAccAWSCodeBuildProjectConfig_fileSystemLocations(rName, mountPoint string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + testAccAWSCodeBuildProjectConfig_vpcResources() + `
+resource "aws_efs_file_system" "test" {}
+
+resource "aws_efs_mount_target" "test" {
+  file_system_id  = "${aws_efs_file_system.test.id}"
+  subnet_id       = "${aws_subnet.codebuild_subnet.id}"
+  security_groups = ["${aws_security_group.codebuild_security_group.id}"]
+}
+` + testAccAWSCodeBuildProjectConfig_project(rName, testAccAWSCodeBuildProjectConfig_vpcConfig("\"${aws_subnet.codebuild_subnet.id}\"")+fmt.Sprintf(`
+  file_system_locations {
+    identifier  = "test"
+    location    = "${aws_efs_file_system.test.dns_name}:/directory-path"
+    mount_point = %q
+    type        = "EFS"
+  }
+`, mountPoint))
+}
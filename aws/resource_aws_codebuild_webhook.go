@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCodeBuildWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeBuildWebhookCreate,
+		Read:   resourceAwsCodeBuildWebhookRead,
+		Update: resourceAwsCodeBuildWebhookUpdate,
+		Delete: resourceAwsCodeBuildWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch_filter": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filter_group"},
+			},
+			"build_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					codebuild.WebhookBuildTypeBuild,
+					codebuild.WebhookBuildTypeBuildBatch,
+				}, false),
+			},
+			"filter_group": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"branch_filter"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_matched_pattern": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"pattern": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(codebuild.WebhookFilterType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"payload_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCodeBuildWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	projectName := d.Get("project_name").(string)
+	input := &codebuild.CreateWebhookInput{
+		ProjectName: aws.String(projectName),
+	}
+
+	if v, ok := d.GetOk("branch_filter"); ok {
+		input.BranchFilter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("build_type"); ok {
+		input.BuildType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_group"); ok {
+		input.FilterGroups = expandCodeBuildWebhookFilterGroups(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating CodeBuild Webhook: %s", input)
+	output, err := conn.CreateWebhook(input)
+	if err != nil {
+		return fmt.Errorf("error creating CodeBuild Webhook (%s): %s", projectName, err)
+	}
+
+	d.SetId(projectName)
+
+	// The secret is only returned when the webhook is created.
+	d.Set("secret", output.Webhook.Secret)
+
+	return resourceAwsCodeBuildWebhookRead(d, meta)
+}
+
+func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	output, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+		Names: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading CodeBuild Webhook (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Projects) == 0 || output.Projects[0].Webhook == nil {
+		log.Printf("[WARN] CodeBuild Webhook (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	webhook := output.Projects[0].Webhook
+
+	d.Set("branch_filter", webhook.BranchFilter)
+	d.Set("build_type", webhook.BuildType)
+
+	if err := d.Set("filter_group", flattenCodeBuildWebhookFilterGroups(webhook.FilterGroups)); err != nil {
+		return fmt.Errorf("error setting filter_group: %s", err)
+	}
+
+	d.Set("payload_url", webhook.PayloadUrl)
+	d.Set("project_name", output.Projects[0].Name)
+	d.Set("url", webhook.Url)
+
+	return nil
+}
+
+func resourceAwsCodeBuildWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	input := &codebuild.UpdateWebhookInput{
+		ProjectName:  aws.String(d.Id()),
+		BranchFilter: aws.String(d.Get("branch_filter").(string)),
+		FilterGroups: expandCodeBuildWebhookFilterGroups(d.Get("filter_group").(*schema.Set).List()),
+		RotateSecret: aws.Bool(false),
+	}
+
+	if v, ok := d.GetOk("build_type"); ok {
+		input.BuildType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating CodeBuild Webhook: %s", input)
+	_, err := conn.UpdateWebhook(input)
+	if err != nil {
+		return fmt.Errorf("error updating CodeBuild Webhook (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCodeBuildWebhookRead(d, meta)
+}
+
+func resourceAwsCodeBuildWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	log.Printf("[INFO] Deleting CodeBuild Webhook: %s", d.Id())
+	_, err := conn.DeleteWebhook(&codebuild.DeleteWebhookInput{
+		ProjectName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, codebuild.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CodeBuild Webhook (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCodeBuildWebhookFilterGroups(l []interface{}) [][]*codebuild.WebhookFilter {
+	filterGroups := make([][]*codebuild.WebhookFilter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var filters []*codebuild.WebhookFilter
+		for _, rawFilter := range m["filter"].([]interface{}) {
+			fm := rawFilter.(map[string]interface{})
+			filters = append(filters, &codebuild.WebhookFilter{
+				ExcludeMatchedPattern: aws.Bool(fm["exclude_matched_pattern"].(bool)),
+				Pattern:               aws.String(fm["pattern"].(string)),
+				Type:                  aws.String(fm["type"].(string)),
+			})
+		}
+
+		filterGroups = append(filterGroups, filters)
+	}
+
+	return filterGroups
+}
+
+func flattenCodeBuildWebhookFilterGroups(filterGroups [][]*codebuild.WebhookFilter) []interface{} {
+	result := make([]interface{}, 0, len(filterGroups))
+
+	for _, filterGroup := range filterGroups {
+		filters := make([]interface{}, 0, len(filterGroup))
+		for _, filter := range filterGroup {
+			filters = append(filters, map[string]interface{}{
+				"exclude_matched_pattern": aws.BoolValue(filter.ExcludeMatchedPattern),
+				"pattern":                 aws.StringValue(filter.Pattern),
+				"type":                    aws.StringValue(filter.Type),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"filter": filters,
+		})
+	}
+
+	return result
+}
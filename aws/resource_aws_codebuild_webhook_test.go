@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// CodeBuild can only manage webhooks in repositories its stored source
+// credentials have admin access to, so the tests need a repository to be
+// provided.
+func testAccAWSCodeBuildWebhookSourceLocation(t *testing.T) string {
+	location := os.Getenv("CODEBUILD_WEBHOOK_SOURCE_LOCATION")
+	if location == "" {
+		t.Skip("Environment variable CODEBUILD_WEBHOOK_SOURCE_LOCATION is not set. " +
+			"It must be set to the URL of a GitHub repository CodeBuild is authorized to manage webhooks in.")
+	}
+	return location
+}
+
+func TestAccAWSCodeBuildWebhook_branchFilter(t *testing.T) {
+	sourceLocation := testAccAWSCodeBuildWebhookSourceLocation(t)
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_branchFilter(rName, sourceLocation, "master"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch_filter", "master"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "payload_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_branchFilter(rName, sourceLocation, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch_filter", "dev"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildWebhook_filterGroup(t *testing.T) {
+	sourceLocation := testAccAWSCodeBuildWebhookSourceLocation(t)
+	rName := acctest.RandString(10)
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_filterGroup(rName, sourceLocation, "PULL_REQUEST_CREATED, PULL_REQUEST_UPDATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch_filter", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_filterGroup(rName, sourceLocation, "PUSH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestCodeBuildWebhookFilterGroups(t *testing.T) {
+	filterGroups := [][]*codebuild.WebhookFilter{
+		{
+			{
+				ExcludeMatchedPattern: aws.Bool(false),
+				Pattern:               aws.String("PUSH"),
+				Type:                  aws.String(codebuild.WebhookFilterTypeEvent),
+			},
+			{
+				ExcludeMatchedPattern: aws.Bool(true),
+				Pattern:               aws.String("^refs/heads/wip/"),
+				Type:                  aws.String(codebuild.WebhookFilterTypeHeadRef),
+			},
+		},
+	}
+
+	flattened := flattenCodeBuildWebhookFilterGroups(filterGroups)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 filter group, got %d", len(flattened))
+	}
+
+	expanded := expandCodeBuildWebhookFilterGroups(flattened)
+	if !reflect.DeepEqual(expanded, filterGroups) {
+		t.Fatalf("expected filter groups:\n%s\ngot:\n%s", filterGroups, expanded)
+	}
+}
+
+func testAccCheckAWSCodeBuildWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeBuild Webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+		output, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Projects) == 0 || output.Projects[0].Webhook == nil {
+			return fmt.Errorf("CodeBuild Webhook (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCodeBuildWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codebuild_webhook" {
+			continue
+		}
+
+		output, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Projects) > 0 && output.Projects[0].Webhook != nil && output.Projects[0].Webhook.Url != nil {
+			return fmt.Errorf("CodeBuild Webhook (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCodeBuildWebhookConfig_project(rName, sourceLocation string) string {
+	return testAccAWSCodeBuildProjectConfig_base(rName) + fmt.Sprintf(`
+resource "aws_codebuild_project" "foo" {
+  name         = "test-project-%[1]s"
+  service_role = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = %[2]q
+  }
+
+  depends_on = ["aws_iam_role_policy.codebuild_policy"]
+}
+`, rName, sourceLocation)
+}
+
+func testAccAWSCodeBuildWebhookConfig_branchFilter(rName, sourceLocation, branchFilter string) string {
+	return testAccAWSCodeBuildWebhookConfig_project(rName, sourceLocation) + fmt.Sprintf(`
+resource "aws_codebuild_webhook" "test" {
+  project_name  = "${aws_codebuild_project.foo.name}"
+  branch_filter = %q
+}
+`, branchFilter)
+}
+
+func testAccAWSCodeBuildWebhookConfig_filterGroup(rName, sourceLocation, events string) string {
+	return testAccAWSCodeBuildWebhookConfig_project(rName, sourceLocation) + fmt.Sprintf(`
+resource "aws_codebuild_webhook" "test" {
+  project_name = "${aws_codebuild_project.foo.name}"
+
+  filter_group {
+    filter {
+      type    = "EVENT"
+      pattern = %q
+    }
+
+    filter {
+      type                    = "HEAD_REF"
+      pattern                 = "^refs/heads/wip/"
+      exclude_matched_pattern = true
+    }
+  }
+}
+`, events)
+}
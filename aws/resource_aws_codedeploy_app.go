@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -26,6 +27,18 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"compute_platform": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  codedeploy.ComputePlatformServer,
+				ValidateFunc: validation.StringInSlice([]string{
+					codedeploy.ComputePlatformEcs,
+					codedeploy.ComputePlatformLambda,
+					codedeploy.ComputePlatformServer,
+				}, false),
+			},
+
 			// The unique ID is set by AWS on create.
 			"unique_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -44,6 +57,7 @@ func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) er
 
 	resp, err := conn.CreateApplication(&codedeploy.CreateApplicationInput{
 		ApplicationName: aws.String(application),
+		ComputePlatform: aws.String(d.Get("compute_platform").(string)),
 	})
 	if err != nil {
 		return err
@@ -78,6 +92,7 @@ func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	d.Set("compute_platform", resp.Application.ComputePlatform)
 	d.Set("name", resp.Application.ApplicationName)
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -33,6 +34,32 @@ func TestAccAWSCodeDeployApp_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeDeployApp_computePlatform(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeDeployAppConfigComputePlatform(rName, "Server"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployAppExists("aws_codedeploy_app.foo"),
+					resource.TestCheckResourceAttr("aws_codedeploy_app.foo", "compute_platform", "Server"),
+				),
+			},
+			{
+				Config: testAccAWSCodeDeployAppConfigComputePlatform(rName, "ECS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployAppExists("aws_codedeploy_app.foo"),
+					resource.TestCheckResourceAttr("aws_codedeploy_app.foo", "compute_platform", "ECS"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSCodeDeployAppDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
 
@@ -79,3 +106,11 @@ var testAccAWSCodeDeployAppModified = `
 resource "aws_codedeploy_app" "foo" {
 	name = "bar"
 }`
+
+func testAccAWSCodeDeployAppConfigComputePlatform(rName, computePlatform string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_app" "foo" {
+  compute_platform = %q
+  name             = %q
+}`, computePlatform, rName)
+}
@@ -24,9 +24,21 @@ func resourceAwsCodeDeployDeploymentConfig() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"compute_platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  codedeploy.ComputePlatformServer,
+				ValidateFunc: validation.StringInSlice([]string{
+					codedeploy.ComputePlatformEcs,
+					codedeploy.ComputePlatformLambda,
+					codedeploy.ComputePlatformServer,
+				}, false),
+			},
+
 			"minimum_healthy_hosts": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -47,6 +59,74 @@ func resourceAwsCodeDeployDeploymentConfig() *schema.Resource {
 				},
 			},
 
+			"traffic_routing_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  codedeploy.TrafficRoutingTypeAllAtOnce,
+							ValidateFunc: validation.StringInSlice([]string{
+								codedeploy.TrafficRoutingTypeAllAtOnce,
+								codedeploy.TrafficRoutingTypeTimeBasedCanary,
+								codedeploy.TrafficRoutingTypeTimeBasedLinear,
+							}, false),
+						},
+
+						"time_based_canary": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"traffic_routing_config.0.time_based_linear"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"interval": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 99),
+									},
+								},
+							},
+						},
+
+						"time_based_linear": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"traffic_routing_config.0.time_based_canary"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"interval": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 99),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"deployment_config_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,8 +139,10 @@ func resourceAwsCodeDeployDeploymentConfigCreate(d *schema.ResourceData, meta in
 	conn := meta.(*AWSClient).codedeployconn
 
 	input := &codedeploy.CreateDeploymentConfigInput{
+		ComputePlatform:      aws.String(d.Get("compute_platform").(string)),
 		DeploymentConfigName: aws.String(d.Get("deployment_config_name").(string)),
 		MinimumHealthyHosts:  expandAwsCodeDeployConfigMinimumHealthHosts(d),
+		TrafficRoutingConfig: expandAwsCodeDeployTrafficRoutingConfig(d.Get("traffic_routing_config").([]interface{})),
 	}
 
 	_, err := conn.CreateDeploymentConfig(input)
@@ -99,6 +181,10 @@ func resourceAwsCodeDeployDeploymentConfigRead(d *schema.ResourceData, meta inte
 	if err := d.Set("minimum_healthy_hosts", flattenAwsCodeDeployConfigMinimumHealthHosts(resp.DeploymentConfigInfo.MinimumHealthyHosts)); err != nil {
 		return err
 	}
+	if err := d.Set("traffic_routing_config", flattenAwsCodeDeployTrafficRoutingConfig(resp.DeploymentConfigInfo.TrafficRoutingConfig)); err != nil {
+		return err
+	}
+	d.Set("compute_platform", resp.DeploymentConfigInfo.ComputePlatform)
 	d.Set("deployment_config_id", resp.DeploymentConfigInfo.DeploymentConfigId)
 	d.Set("deployment_config_name", resp.DeploymentConfigInfo.DeploymentConfigName)
 
@@ -122,6 +208,9 @@ func resourceAwsCodeDeployDeploymentConfigDelete(d *schema.ResourceData, meta in
 
 func expandAwsCodeDeployConfigMinimumHealthHosts(d *schema.ResourceData) *codedeploy.MinimumHealthyHosts {
 	hosts := d.Get("minimum_healthy_hosts").([]interface{})
+	if len(hosts) == 0 || hosts[0] == nil {
+		return nil
+	}
 	host := hosts[0].(map[string]interface{})
 
 	minimumHealthyHost := codedeploy.MinimumHealthyHosts{
@@ -135,6 +224,11 @@ func expandAwsCodeDeployConfigMinimumHealthHosts(d *schema.ResourceData) *codede
 func flattenAwsCodeDeployConfigMinimumHealthHosts(hosts *codedeploy.MinimumHealthyHosts) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 
+	// Lambda and ECS deployment configs shift traffic rather than hosts.
+	if hosts == nil || hosts.Type == nil {
+		return result
+	}
+
 	item := make(map[string]interface{})
 
 	item["type"] = *hosts.Type
@@ -144,3 +238,66 @@ func flattenAwsCodeDeployConfigMinimumHealthHosts(hosts *codedeploy.MinimumHealt
 
 	return result
 }
+
+func expandAwsCodeDeployTrafficRoutingConfig(l []interface{}) *codedeploy.TrafficRoutingConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &codedeploy.TrafficRoutingConfig{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v := m["time_based_canary"].([]interface{}); len(v) > 0 && v[0] != nil {
+		canary := v[0].(map[string]interface{})
+		config.TimeBasedCanary = &codedeploy.TimeBasedCanary{
+			CanaryInterval:   aws.Int64(int64(canary["interval"].(int))),
+			CanaryPercentage: aws.Int64(int64(canary["percentage"].(int))),
+		}
+	}
+
+	if v := m["time_based_linear"].([]interface{}); len(v) > 0 && v[0] != nil {
+		linear := v[0].(map[string]interface{})
+		config.TimeBasedLinear = &codedeploy.TimeBasedLinear{
+			LinearInterval:   aws.Int64(int64(linear["interval"].(int))),
+			LinearPercentage: aws.Int64(int64(linear["percentage"].(int))),
+		}
+	}
+
+	return config
+}
+
+func flattenAwsCodeDeployTrafficRoutingConfig(config *codedeploy.TrafficRoutingConfig) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	if config == nil || config.Type == nil {
+		return result
+	}
+
+	item := map[string]interface{}{
+		"type": aws.StringValue(config.Type),
+	}
+
+	if config.TimeBasedCanary != nil {
+		item["time_based_canary"] = []map[string]interface{}{
+			{
+				"interval":   aws.Int64Value(config.TimeBasedCanary.CanaryInterval),
+				"percentage": aws.Int64Value(config.TimeBasedCanary.CanaryPercentage),
+			},
+		}
+	}
+
+	if config.TimeBasedLinear != nil {
+		item["time_based_linear"] = []map[string]interface{}{
+			{
+				"interval":   aws.Int64Value(config.TimeBasedLinear.LinearInterval),
+				"percentage": aws.Int64Value(config.TimeBasedLinear.LinearPercentage),
+			},
+		}
+	}
+
+	result = append(result, item)
+
+	return result
+}
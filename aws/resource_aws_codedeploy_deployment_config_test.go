@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -60,6 +61,116 @@ func TestAccAWSCodeDeployDeploymentConfig_hostCount(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeDeployDeploymentConfig_trafficCanary(t *testing.T) {
+	var config codedeploy.DeploymentConfigInfo
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployDeploymentConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeDeployDeploymentConfigTrafficCanary(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentConfigExists("aws_codedeploy_deployment_config.foo", &config),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.type", "TimeBasedCanary"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_canary.0.interval", "10"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_canary.0.percentage", "10"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_linear.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeDeployDeploymentConfig_trafficLinear(t *testing.T) {
+	var config codedeploy.DeploymentConfigInfo
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployDeploymentConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeDeployDeploymentConfigTrafficLinear(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentConfigExists("aws_codedeploy_deployment_config.foo", &config),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "compute_platform", "ECS"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_linear.0.interval", "5"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_linear.0.percentage", "20"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_config.foo", "traffic_routing_config.0.time_based_canary.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAWSCodeDeployDeploymentConfig_trafficRoutingConfig(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"type":              "TimeBasedLinear",
+			"time_based_canary": []interface{}{},
+			"time_based_linear": []interface{}{
+				map[string]interface{}{
+					"interval":   5,
+					"percentage": 20,
+				},
+			},
+		},
+	}
+
+	expected := &codedeploy.TrafficRoutingConfig{
+		Type: aws.String("TimeBasedLinear"),
+		TimeBasedLinear: &codedeploy.TimeBasedLinear{
+			LinearInterval:   aws.Int64(5),
+			LinearPercentage: aws.Int64(20),
+		},
+	}
+
+	actual := expandAwsCodeDeployTrafficRoutingConfig(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expandAwsCodeDeployTrafficRoutingConfig output is not correct.\nGot:\n%#v\nExpected:\n%#v\n",
+			actual, expected)
+	}
+
+	flattened := flattenAwsCodeDeployTrafficRoutingConfig(actual)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 traffic routing config, got %d", len(flattened))
+	}
+	if v := flattened[0]["type"]; v != "TimeBasedLinear" {
+		t.Fatalf("expected type TimeBasedLinear, got %v", v)
+	}
+	if _, ok := flattened[0]["time_based_canary"]; ok {
+		t.Fatalf("expected no time_based_canary, got %v", flattened[0]["time_based_canary"])
+	}
+	linear := flattened[0]["time_based_linear"].([]map[string]interface{})
+	if linear[0]["interval"] != int64(5) || linear[0]["percentage"] != int64(20) {
+		t.Fatalf("time_based_linear output is not correct: %#v", linear)
+	}
+
+	if actual := expandAwsCodeDeployTrafficRoutingConfig([]interface{}{}); actual != nil {
+		t.Fatalf("expected nil for empty traffic_routing_config, got %#v", actual)
+	}
+}
+
 func testAccCheckAWSCodeDeployDeploymentConfigDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
 
@@ -132,3 +243,37 @@ resource "aws_codedeploy_deployment_config" "foo" {
 	}
 }`, rName)
 }
+
+func testAccAWSCodeDeployDeploymentConfigTrafficCanary(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "foo" {
+	deployment_config_name = "test-deployment-config-%s"
+	compute_platform = "Lambda"
+
+	traffic_routing_config {
+		type = "TimeBasedCanary"
+
+		time_based_canary {
+			interval = 10
+			percentage = 10
+		}
+	}
+}`, rName)
+}
+
+func testAccAWSCodeDeployDeploymentConfigTrafficLinear(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "foo" {
+	deployment_config_name = "test-deployment-config-%s"
+	compute_platform = "ECS"
+
+	traffic_routing_config {
+		type = "TimeBasedLinear"
+
+		time_based_linear {
+			interval = 5
+			percentage = 20
+		}
+	}
+}`, rName)
+}
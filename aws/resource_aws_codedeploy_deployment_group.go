@@ -144,6 +144,27 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 				Required: true,
 			},
 
+			"ecs_service": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"service_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
 			"alarm_configuration": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -194,9 +215,10 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 						},
 
 						"target_group_info": &schema.Schema{
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      loadBalancerInfoHash,
+							Type:          schema.TypeSet,
+							Optional:      true,
+							Set:           loadBalancerInfoHash,
+							ConflictsWith: []string{"load_balancer_info.0.target_group_pair_info"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": &schema.Schema{
@@ -206,6 +228,64 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 								},
 							},
 						},
+
+						"target_group_pair_info": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"load_balancer_info.0.elb_info", "load_balancer_info.0.target_group_info"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prod_traffic_route": &schema.Schema{
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"listener_arns": &schema.Schema{
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+													Set:      schema.HashString,
+												},
+											},
+										},
+									},
+
+									"target_group": &schema.Schema{
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 2,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": &schema.Schema{
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+											},
+										},
+									},
+
+									"test_traffic_route": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"listener_arns": &schema.Schema{
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+													Set:      schema.HashString,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -393,6 +473,10 @@ func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta int
 		input.BlueGreenDeploymentConfiguration = expandBlueGreenDeploymentConfig(attr.([]interface{}))
 	}
 
+	if attr, ok := d.GetOk("ecs_service"); ok {
+		input.EcsServices = expandCodeDeployEcsServices(attr.([]interface{}))
+	}
+
 	// Retry to handle IAM role eventual consistency.
 	var resp *codedeploy.CreateDeploymentGroupOutput
 	var err error
@@ -488,6 +572,10 @@ func resourceAwsCodeDeployDeploymentGroupRead(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if err := d.Set("ecs_service", flattenCodeDeployEcsServices(resp.DeploymentGroupInfo.EcsServices)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -559,6 +647,11 @@ func resourceAwsCodeDeployDeploymentGroupUpdate(d *schema.ResourceData, meta int
 		input.BlueGreenDeploymentConfiguration = expandBlueGreenDeploymentConfig(n.([]interface{}))
 	}
 
+	if d.HasChange("ecs_service") {
+		_, n := d.GetChange("ecs_service")
+		input.EcsServices = expandCodeDeployEcsServices(n.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Updating CodeDeploy DeploymentGroup %s", d.Id())
 	// Retry to handle IAM role eventual consistency.
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -782,9 +875,70 @@ func expandLoadBalancerInfo(list []interface{}) *codedeploy.LoadBalancerInfo {
 		}
 	}
 
+	if attr, ok := lbInfo["target_group_pair_info"]; ok {
+		loadBalancerInfo.TargetGroupPairInfoList = expandTargetGroupPairInfo(attr.([]interface{}))
+	}
+
 	return loadBalancerInfo
 }
 
+// expandTargetGroupPairInfo converts a raw schema list containing a map[string]interface{}
+// into the single-item []*codedeploy.TargetGroupPairInfo list used by ECS blue/green deployments
+func expandTargetGroupPairInfo(list []interface{}) []*codedeploy.TargetGroupPairInfo {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	pairInfo := &codedeploy.TargetGroupPairInfo{
+		ProdTrafficRoute: expandTrafficRoute(m["prod_traffic_route"].([]interface{})),
+		TestTrafficRoute: expandTrafficRoute(m["test_traffic_route"].([]interface{})),
+	}
+
+	for _, raw := range m["target_group"].([]interface{}) {
+		targetGroup, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		pairInfo.TargetGroups = append(pairInfo.TargetGroups, &codedeploy.TargetGroupInfo{
+			Name: aws.String(targetGroup["name"].(string)),
+		})
+	}
+
+	return []*codedeploy.TargetGroupPairInfo{pairInfo}
+}
+
+func expandTrafficRoute(list []interface{}) *codedeploy.TrafficRoute {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+
+	return &codedeploy.TrafficRoute{
+		ListenerArns: expandStringSet(m["listener_arns"].(*schema.Set)),
+	}
+}
+
+// expandCodeDeployEcsServices converts a raw schema list containing a map[string]interface{}
+// into a []*codedeploy.ECSService list
+func expandCodeDeployEcsServices(list []interface{}) []*codedeploy.ECSService {
+	services := make([]*codedeploy.ECSService, 0, len(list))
+
+	for _, raw := range list {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		services = append(services, &codedeploy.ECSService{
+			ClusterName: aws.String(m["cluster_name"].(string)),
+			ServiceName: aws.String(m["service_name"].(string)),
+		})
+	}
+
+	return services
+}
+
 // expandBlueGreenDeploymentConfig converts a raw schema list containing a map[string]interface{}
 // into a single codedeploy.BlueGreenDeploymentConfiguration object
 func expandBlueGreenDeploymentConfig(list []interface{}) *codedeploy.BlueGreenDeploymentConfiguration {
@@ -988,12 +1142,69 @@ func flattenLoadBalancerInfo(loadBalancerInfo *codedeploy.LoadBalancerInfo) []ma
 	lbInfo := make(map[string]interface{})
 	lbInfo["elb_info"] = schema.NewSet(loadBalancerInfoHash, elbs)
 	lbInfo["target_group_info"] = schema.NewSet(loadBalancerInfoHash, targetGroups)
+	lbInfo["target_group_pair_info"] = flattenTargetGroupPairInfo(loadBalancerInfo.TargetGroupPairInfoList)
 
 	result := make([]map[string]interface{}, 0, 1)
 	result = append(result, lbInfo)
 	return result
 }
 
+// flattenTargetGroupPairInfo converts a []*codedeploy.TargetGroupPairInfo list
+// into a []map[string]interface{} list containing at most a single item
+func flattenTargetGroupPairInfo(list []*codedeploy.TargetGroupPairInfo) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, 1)
+
+	if len(list) == 0 || list[0] == nil {
+		return result
+	}
+
+	pairInfo := list[0]
+
+	targetGroups := make([]map[string]interface{}, 0, len(pairInfo.TargetGroups))
+	for _, targetGroup := range pairInfo.TargetGroups {
+		targetGroups = append(targetGroups, map[string]interface{}{
+			"name": aws.StringValue(targetGroup.Name),
+		})
+	}
+
+	item := map[string]interface{}{
+		"prod_traffic_route": flattenTrafficRoute(pairInfo.ProdTrafficRoute),
+		"target_group":       targetGroups,
+		"test_traffic_route": flattenTrafficRoute(pairInfo.TestTrafficRoute),
+	}
+
+	result = append(result, item)
+	return result
+}
+
+func flattenTrafficRoute(route *codedeploy.TrafficRoute) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, 1)
+
+	if route == nil || len(route.ListenerArns) == 0 {
+		return result
+	}
+
+	result = append(result, map[string]interface{}{
+		"listener_arns": schema.NewSet(schema.HashString, flattenStringList(route.ListenerArns)),
+	})
+	return result
+}
+
+// flattenCodeDeployEcsServices converts a []*codedeploy.ECSService list
+// into a []map[string]interface{} list
+func flattenCodeDeployEcsServices(list []*codedeploy.ECSService) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+
+	for _, service := range list {
+		result = append(result, map[string]interface{}{
+			"cluster_name": aws.StringValue(service.ClusterName),
+			"service_name": aws.StringValue(service.ServiceName),
+		})
+	}
+
+	return result
+}
+
 // flattenBlueGreenDeploymentConfig converts a codedeploy.BlueGreenDeploymentConfiguration object
 // into a []map[string]interface{} list containing a single item
 func flattenBlueGreenDeploymentConfig(config *codedeploy.BlueGreenDeploymentConfiguration) []map[string]interface{} {
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"os"
 	"reflect"
 	"regexp"
 	"sort"
@@ -1204,6 +1205,66 @@ func TestAccAWSCodeDeployDeploymentGroup_blueGreenDeployment_complete(t *testing
 	})
 }
 
+func TestAccAWSCodeDeployDeploymentGroup_ecsBlueGreen(t *testing.T) {
+	var group codedeploy.DeploymentGroupInfo
+
+	// The ECS service must use the CODE_DEPLOY deployment controller and be
+	// attached to the listener and first target group supplied here.
+	clusterName := os.Getenv("CODEDEPLOY_ECS_CLUSTER_NAME")
+	serviceName := os.Getenv("CODEDEPLOY_ECS_SERVICE_NAME")
+	listenerArn := os.Getenv("CODEDEPLOY_ECS_LISTENER_ARN")
+	blueTargetGroup := os.Getenv("CODEDEPLOY_ECS_BLUE_TARGET_GROUP")
+	greenTargetGroup := os.Getenv("CODEDEPLOY_ECS_GREEN_TARGET_GROUP")
+	if clusterName == "" || serviceName == "" || listenerArn == "" || blueTargetGroup == "" || greenTargetGroup == "" {
+		t.Skip("CODEDEPLOY_ECS_CLUSTER_NAME, CODEDEPLOY_ECS_SERVICE_NAME, CODEDEPLOY_ECS_LISTENER_ARN, " +
+			"CODEDEPLOY_ECS_BLUE_TARGET_GROUP and CODEDEPLOY_ECS_GREEN_TARGET_GROUP must be set")
+	}
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployDeploymentGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: test_config_ecs_blue_green(rName, clusterName, serviceName, listenerArn, blueTargetGroup, greenTargetGroup, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentGroupExists("aws_codedeploy_deployment_group.foo_group", &group),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "deployment_config_name", "CodeDeployDefault.ECSAllAtOnce"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "ecs_service.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "ecs_service.0.cluster_name", clusterName),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "ecs_service.0.service_name", serviceName),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "load_balancer_info.0.target_group_pair_info.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "load_balancer_info.0.target_group_pair_info.0.prod_traffic_route.0.listener_arns.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "load_balancer_info.0.target_group_pair_info.0.target_group.#", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "load_balancer_info.0.target_group_pair_info.0.target_group.0.name", blueTargetGroup),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "load_balancer_info.0.target_group_pair_info.0.target_group.1.name", greenTargetGroup),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "5"),
+				),
+			},
+			resource.TestStep{
+				Config: test_config_ecs_blue_green(rName, clusterName, serviceName, listenerArn, blueTargetGroup, greenTargetGroup, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentGroupExists("aws_codedeploy_deployment_group.foo_group", &group),
+					resource.TestCheckResourceAttr(
+						"aws_codedeploy_deployment_group.foo_group", "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAWSCodeDeployDeploymentGroup_buildTriggerConfigs(t *testing.T) {
 	input := []interface{}{
 		map[string]interface{}{
@@ -1462,6 +1523,99 @@ func TestAWSCodeDeployDeploymentGroup_flattenLoadBalancerInfo(t *testing.T) {
 	}
 }
 
+func TestAWSCodeDeployDeploymentGroup_expandLoadBalancerInfo_targetGroupPairInfo(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"target_group_pair_info": []interface{}{
+				map[string]interface{}{
+					"prod_traffic_route": []interface{}{
+						map[string]interface{}{
+							"listener_arns": schema.NewSet(schema.HashString, []interface{}{"prod-listener"}),
+						},
+					},
+					"target_group": []interface{}{
+						map[string]interface{}{
+							"name": "blue-tg",
+						},
+						map[string]interface{}{
+							"name": "green-tg",
+						},
+					},
+					"test_traffic_route": []interface{}{},
+				},
+			},
+		},
+	}
+
+	expected := &codedeploy.LoadBalancerInfo{
+		TargetGroupPairInfoList: []*codedeploy.TargetGroupPairInfo{
+			{
+				ProdTrafficRoute: &codedeploy.TrafficRoute{
+					ListenerArns: []*string{aws.String("prod-listener")},
+				},
+				TargetGroups: []*codedeploy.TargetGroupInfo{
+					{
+						Name: aws.String("blue-tg"),
+					},
+					{
+						Name: aws.String("green-tg"),
+					},
+				},
+			},
+		},
+	}
+
+	actual := expandLoadBalancerInfo(input)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expandLoadBalancerInfo output is not correct.\nGot:\n%#v\nExpected:\n%#v\n",
+			actual, expected)
+	}
+}
+
+func TestAWSCodeDeployDeploymentGroup_flattenLoadBalancerInfo_targetGroupPairInfo(t *testing.T) {
+	input := &codedeploy.LoadBalancerInfo{
+		TargetGroupPairInfoList: []*codedeploy.TargetGroupPairInfo{
+			{
+				ProdTrafficRoute: &codedeploy.TrafficRoute{
+					ListenerArns: []*string{aws.String("prod-listener")},
+				},
+				TargetGroups: []*codedeploy.TargetGroupInfo{
+					{
+						Name: aws.String("blue-tg"),
+					},
+					{
+						Name: aws.String("green-tg"),
+					},
+				},
+				TestTrafficRoute: &codedeploy.TrafficRoute{
+					ListenerArns: []*string{aws.String("test-listener")},
+				},
+			},
+		},
+	}
+
+	actual := flattenLoadBalancerInfo(input)[0]["target_group_pair_info"].([]map[string]interface{})
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 target_group_pair_info, got %d", len(actual))
+	}
+
+	targetGroups := actual[0]["target_group"].([]map[string]interface{})
+	if len(targetGroups) != 2 || targetGroups[0]["name"] != "blue-tg" || targetGroups[1]["name"] != "green-tg" {
+		t.Fatalf("target_group output is not correct: %#v", targetGroups)
+	}
+
+	prod := actual[0]["prod_traffic_route"].([]map[string]interface{})
+	if !prod[0]["listener_arns"].(*schema.Set).Equal(schema.NewSet(schema.HashString, []interface{}{"prod-listener"})) {
+		t.Fatalf("prod_traffic_route output is not correct: %#v", prod)
+	}
+
+	test := actual[0]["test_traffic_route"].([]map[string]interface{})
+	if !test[0]["listener_arns"].(*schema.Set).Equal(schema.NewSet(schema.HashString, []interface{}{"test-listener"})) {
+		t.Fatalf("test_traffic_route output is not correct: %#v", test)
+	}
+}
+
 func TestAWSCodeDeployDeploymentGroup_expandBlueGreenDeploymentConfig(t *testing.T) {
 	input := []interface{}{
 		map[string]interface{}{
@@ -2611,3 +2765,85 @@ resource "aws_codedeploy_deployment_group" "foo_group" {
   }
 }`, baseCodeDeployConfig(rName), rName)
 }
+
+func test_config_ecs_blue_green(rName, clusterName, serviceName, listenerArn, blueTargetGroup, greenTargetGroup string, terminationWait int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_app" "foo_app" {
+  compute_platform = "ECS"
+  name = "foo-app-%s"
+}
+
+resource "aws_iam_role" "foo_role" {
+  name = "foo-role-%s"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codedeploy.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "foo_policy" {
+  role = "${aws_iam_role.foo_role.name}"
+  policy_arn = "arn:aws:iam::aws:policy/AWSCodeDeployRoleForECS"
+}
+
+resource "aws_codedeploy_deployment_group" "foo_group" {
+  app_name = "${aws_codedeploy_app.foo_app.name}"
+  deployment_config_name = "CodeDeployDefault.ECSAllAtOnce"
+  deployment_group_name = "foo-group-%s"
+  service_role_arn = "${aws_iam_role.foo_role.arn}"
+
+  auto_rollback_configuration {
+    enabled = true
+    events = ["DEPLOYMENT_FAILURE"]
+  }
+
+  blue_green_deployment_config {
+    deployment_ready_option {
+      action_on_timeout = "CONTINUE_DEPLOYMENT"
+    }
+
+    terminate_blue_instances_on_deployment_success {
+      action = "TERMINATE"
+      termination_wait_time_in_minutes = %d
+    }
+  }
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type = "BLUE_GREEN"
+  }
+
+  ecs_service {
+    cluster_name = %q
+    service_name = %q
+  }
+
+  load_balancer_info {
+    target_group_pair_info {
+      prod_traffic_route {
+        listener_arns = [%q]
+      }
+
+      target_group {
+        name = %q
+      }
+
+      target_group {
+        name = %q
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.foo_policy"]
+}`, rName, rName, rName, terminationWait, clusterName, serviceName, listenerArn, blueTargetGroup, greenTargetGroup)
+}
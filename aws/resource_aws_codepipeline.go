@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +25,8 @@ func resourceAwsCodePipeline() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsCodePipelineCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -41,6 +44,109 @@ func resourceAwsCodePipeline() *schema.Resource {
 				Required: true,
 			},
 
+			"pipeline_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  codepipeline.PipelineTypeV1,
+				ValidateFunc: validation.StringInSlice([]string{
+					codepipeline.PipelineTypeV1,
+					codepipeline.PipelineTypeV2,
+				}, false),
+			},
+
+			// CodePipeline adds a default trigger to V2 pipelines with a
+			// CodeStarSourceConnection source when none is configured.
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codepipeline.PipelineTriggerProviderTypeCodeStarSourceConnection,
+							}, false),
+						},
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches":   resourceAwsCodePipelineGitFilterCriteriaSchema(),
+												"file_paths": resourceAwsCodePipelineGitFilterCriteriaSchema(),
+												"tags":       resourceAwsCodePipelineGitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"pull_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches": resourceAwsCodePipelineGitFilterCriteriaSchema(),
+												"events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 3,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+														ValidateFunc: validation.StringInSlice([]string{
+															codepipeline.GitPullRequestEventTypeOpen,
+															codepipeline.GitPullRequestEventTypeUpdated,
+															codepipeline.GitPullRequestEventTypeClosed,
+														}, false),
+													},
+												},
+												"file_paths": resourceAwsCodePipelineGitFilterCriteriaSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9@\-_]{1,128}$`), "must be 1 to 128 alphanumeric characters, at signs, hyphens or underscores"),
+						},
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+
 			"artifact_store": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -94,6 +200,44 @@ func resourceAwsCodePipeline() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"before_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": resourceAwsCodePipelineConditionSchema(),
+								},
+							},
+						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": resourceAwsCodePipelineConditionSchema(),
+									"result": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											codepipeline.ResultRollback,
+											codepipeline.ResultFail,
+										}, false),
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": resourceAwsCodePipelineConditionSchema(),
+								},
+							},
+						},
 						"action": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -165,6 +309,189 @@ func resourceAwsCodePipeline() *schema.Resource {
 	}
 }
 
+func resourceAwsCodePipelineGitFilterCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"includes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCodePipelineConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"result": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						codepipeline.ResultRollback,
+						codepipeline.ResultFail,
+					}, false),
+				},
+				"rule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"configuration": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"input_artifacts": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"role_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validateArn,
+							},
+							"rule_type_id": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"category": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(codepipeline.RuleCategory_Values(), false),
+										},
+										"owner": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Default:      codepipeline.RuleOwnerAws,
+											ValidateFunc: validation.StringInSlice(codepipeline.RuleOwner_Values(), false),
+										},
+										"provider": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"version": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(5, 86400),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsCodePipelineCustomizeDiff rejects V2-only settings (triggers,
+// variables and stage conditions) on V1 pipelines and triggers that refer to unknown source actions, which
+// CodePipeline would otherwise only report when the pipeline is saved.
+func resourceAwsCodePipelineCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	pipelineType := diff.Get("pipeline_type").(string)
+	triggers := diff.Get("trigger").([]interface{})
+	variables := diff.Get("variable").([]interface{})
+
+	// Triggers that are not in the diff were kept from state, either
+	// unchanged or added by CodePipeline, and are not checked.
+	triggersChanged := len(diff.GetChangedKeysPrefix("trigger")) > 0
+
+	if pipelineType != codepipeline.PipelineTypeV2 {
+		if triggersChanged && len(triggers) > 0 {
+			return fmt.Errorf("trigger can only be set when pipeline_type is %q", codepipeline.PipelineTypeV2)
+		}
+		if len(variables) > 0 {
+			return fmt.Errorf("variable can only be set when pipeline_type is %q", codepipeline.PipelineTypeV2)
+		}
+	}
+
+	sourceActions := map[string]bool{}
+	for _, rawStage := range diff.Get("stage").([]interface{}) {
+		stage, ok := rawStage.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if pipelineType != codepipeline.PipelineTypeV2 {
+			for _, k := range []string{"before_entry", "on_failure", "on_success"} {
+				if v, ok := stage[k].([]interface{}); ok && len(v) > 0 {
+					return fmt.Errorf("stage %q: %s can only be set when pipeline_type is %q", stage["name"], k, codepipeline.PipelineTypeV2)
+				}
+			}
+		}
+		for _, rawAction := range stage["action"].([]interface{}) {
+			action, ok := rawAction.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if action["category"].(string) == codepipeline.ActionCategorySource {
+				sourceActions[action["name"].(string)] = true
+			}
+		}
+	}
+
+	for _, rawTrigger := range triggers {
+		trigger, ok := rawTrigger.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, rawConfig := range trigger["git_configuration"].([]interface{}) {
+			config, ok := rawConfig.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := config["source_action_name"].(string)
+			if name == "" || sourceActions[name] {
+				continue
+			}
+			if !triggersChanged {
+				// Let CodePipeline replace a default trigger for a source
+				// action that has been renamed or removed.
+				return diff.SetNewComputed("trigger")
+			}
+			return fmt.Errorf("trigger source_action_name %q does not match any Source action in the pipeline", name)
+		}
+	}
+
+	return nil
+}
+
 func validateAwsCodePipelineStageActionConfiguration(v interface{}, k string) (ws []string, errors []error) {
 	for k := range v.(map[string]interface{}) {
 		if k == "OAuthToken" {
@@ -212,9 +539,295 @@ func expandAwsCodePipeline(d *schema.ResourceData) *codepipeline.PipelineDeclara
 		RoleArn:       aws.String(d.Get("role_arn").(string)),
 		ArtifactStore: pipelineArtifactStore,
 		Stages:        pipelineStages,
+		PipelineType:  aws.String(d.Get("pipeline_type").(string)),
+		Variables:     expandAwsCodePipelineVariables(d.Get("variable").([]interface{})),
+	}
+
+	// A V2 pipeline's default trigger is kept in state after changing
+	// pipeline_type to V1, which does not support triggers.
+	if d.Get("pipeline_type").(string) == codepipeline.PipelineTypeV2 {
+		pipeline.Triggers = expandAwsCodePipelineTriggers(d.Get("trigger").([]interface{}))
 	}
+
 	return &pipeline
 }
+
+func expandAwsCodePipelineTriggers(l []interface{}) []*codepipeline.PipelineTriggerDeclaration {
+	if len(l) == 0 {
+		return nil
+	}
+
+	triggers := make([]*codepipeline.PipelineTriggerDeclaration, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		trigger := &codepipeline.PipelineTriggerDeclaration{
+			ProviderType: aws.String(m["provider_type"].(string)),
+		}
+
+		if v := m["git_configuration"].([]interface{}); len(v) > 0 && v[0] != nil {
+			gm := v[0].(map[string]interface{})
+			config := &codepipeline.GitConfiguration{
+				SourceActionName: aws.String(gm["source_action_name"].(string)),
+			}
+
+			for _, rawPush := range gm["push"].([]interface{}) {
+				push := &codepipeline.GitPushFilter{}
+				if pm, ok := rawPush.(map[string]interface{}); ok {
+					if v := expandAwsCodePipelineGitFilterCriteria(pm["branches"].([]interface{})); v != nil {
+						push.Branches = &codepipeline.GitBranchFilterCriteria{Includes: v.Includes, Excludes: v.Excludes}
+					}
+					push.FilePaths = expandAwsCodePipelineGitFilterCriteria(pm["file_paths"].([]interface{}))
+					if v := expandAwsCodePipelineGitFilterCriteria(pm["tags"].([]interface{})); v != nil {
+						push.Tags = &codepipeline.GitTagFilterCriteria{Includes: v.Includes, Excludes: v.Excludes}
+					}
+				}
+				config.Push = append(config.Push, push)
+			}
+
+			for _, rawPullRequest := range gm["pull_request"].([]interface{}) {
+				pullRequest := &codepipeline.GitPullRequestFilter{}
+				if pm, ok := rawPullRequest.(map[string]interface{}); ok {
+					if v := expandAwsCodePipelineGitFilterCriteria(pm["branches"].([]interface{})); v != nil {
+						pullRequest.Branches = &codepipeline.GitBranchFilterCriteria{Includes: v.Includes, Excludes: v.Excludes}
+					}
+					if v := pm["events"].([]interface{}); len(v) > 0 {
+						pullRequest.Events = expandStringList(v)
+					}
+					pullRequest.FilePaths = expandAwsCodePipelineGitFilterCriteria(pm["file_paths"].([]interface{}))
+				}
+				config.PullRequest = append(config.PullRequest, pullRequest)
+			}
+
+			trigger.GitConfiguration = config
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers
+}
+
+// expandAwsCodePipelineGitFilterCriteria expands the includes/excludes block
+// shared by the branch, file path and tag filters. The SDK models each of them
+// as a distinct type with identical fields.
+func expandAwsCodePipelineGitFilterCriteria(l []interface{}) *codepipeline.GitFilePathFilterCriteria {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	criteria := &codepipeline.GitFilePathFilterCriteria{}
+
+	if v := m["excludes"].([]interface{}); len(v) > 0 {
+		criteria.Excludes = expandStringList(v)
+	}
+	if v := m["includes"].([]interface{}); len(v) > 0 {
+		criteria.Includes = expandStringList(v)
+	}
+
+	return criteria
+}
+
+func flattenAwsCodePipelineGitFilterCriteria(includes, excludes []*string) []interface{} {
+	if len(includes) == 0 && len(excludes) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"excludes": flattenStringList(excludes),
+			"includes": flattenStringList(includes),
+		},
+	}
+}
+
+func flattenAwsCodePipelineTriggers(triggers []*codepipeline.PipelineTriggerDeclaration) []interface{} {
+	l := make([]interface{}, 0, len(triggers))
+
+	for _, trigger := range triggers {
+		m := map[string]interface{}{
+			"provider_type":     aws.StringValue(trigger.ProviderType),
+			"git_configuration": []interface{}{},
+		}
+
+		if config := trigger.GitConfiguration; config != nil {
+			pushes := make([]interface{}, 0, len(config.Push))
+			for _, push := range config.Push {
+				pm := map[string]interface{}{
+					"branches":   []interface{}{},
+					"file_paths": []interface{}{},
+					"tags":       []interface{}{},
+				}
+				if push.Branches != nil {
+					pm["branches"] = flattenAwsCodePipelineGitFilterCriteria(push.Branches.Includes, push.Branches.Excludes)
+				}
+				if push.FilePaths != nil {
+					pm["file_paths"] = flattenAwsCodePipelineGitFilterCriteria(push.FilePaths.Includes, push.FilePaths.Excludes)
+				}
+				if push.Tags != nil {
+					pm["tags"] = flattenAwsCodePipelineGitFilterCriteria(push.Tags.Includes, push.Tags.Excludes)
+				}
+				pushes = append(pushes, pm)
+			}
+
+			pullRequests := make([]interface{}, 0, len(config.PullRequest))
+			for _, pullRequest := range config.PullRequest {
+				pm := map[string]interface{}{
+					"branches":   []interface{}{},
+					"events":     flattenStringList(pullRequest.Events),
+					"file_paths": []interface{}{},
+				}
+				if pullRequest.Branches != nil {
+					pm["branches"] = flattenAwsCodePipelineGitFilterCriteria(pullRequest.Branches.Includes, pullRequest.Branches.Excludes)
+				}
+				if pullRequest.FilePaths != nil {
+					pm["file_paths"] = flattenAwsCodePipelineGitFilterCriteria(pullRequest.FilePaths.Includes, pullRequest.FilePaths.Excludes)
+				}
+				pullRequests = append(pullRequests, pm)
+			}
+
+			m["git_configuration"] = []interface{}{
+				map[string]interface{}{
+					"pull_request":       pullRequests,
+					"push":               pushes,
+					"source_action_name": aws.StringValue(config.SourceActionName),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func expandAwsCodePipelineVariables(l []interface{}) []*codepipeline.PipelineVariableDeclaration {
+	if len(l) == 0 {
+		return nil
+	}
+
+	variables := make([]*codepipeline.PipelineVariableDeclaration, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		variable := &codepipeline.PipelineVariableDeclaration{
+			Name: aws.String(m["name"].(string)),
+		}
+
+		if v := m["default_value"].(string); v != "" {
+			variable.DefaultValue = aws.String(v)
+		}
+		if v := m["description"].(string); v != "" {
+			variable.Description = aws.String(v)
+		}
+
+		variables = append(variables, variable)
+	}
+
+	return variables
+}
+
+func flattenAwsCodePipelineVariables(variables []*codepipeline.PipelineVariableDeclaration) []interface{} {
+	l := make([]interface{}, 0, len(variables))
+
+	for _, variable := range variables {
+		l = append(l, map[string]interface{}{
+			"default_value": aws.StringValue(variable.DefaultValue),
+			"description":   aws.StringValue(variable.Description),
+			"name":          aws.StringValue(variable.Name),
+		})
+	}
+
+	return l
+}
+
+func expandAwsCodePipelineCondition(l []interface{}) []*codepipeline.Condition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	condition := &codepipeline.Condition{}
+
+	if v := m["result"].(string); v != "" {
+		condition.Result = aws.String(v)
+	}
+
+	for _, raw := range m["rule"].([]interface{}) {
+		rm := raw.(map[string]interface{})
+		rule := &codepipeline.RuleDeclaration{
+			Name: aws.String(rm["name"].(string)),
+		}
+
+		if v := rm["configuration"].(map[string]interface{}); len(v) > 0 {
+			rule.Configuration = stringMapToPointers(v)
+		}
+		if v := rm["input_artifacts"].([]interface{}); len(v) > 0 {
+			rule.InputArtifacts = expandAwsCodePipelineActionsInputArtifacts(v)
+		}
+		if v := rm["region"].(string); v != "" {
+			rule.Region = aws.String(v)
+		}
+		if v := rm["role_arn"].(string); v != "" {
+			rule.RoleArn = aws.String(v)
+		}
+		if v := rm["rule_type_id"].([]interface{}); len(v) > 0 && v[0] != nil {
+			tm := v[0].(map[string]interface{})
+			rule.RuleTypeId = &codepipeline.RuleTypeId{
+				Category: aws.String(tm["category"].(string)),
+				Owner:    aws.String(tm["owner"].(string)),
+				Provider: aws.String(tm["provider"].(string)),
+			}
+			if v := tm["version"].(string); v != "" {
+				rule.RuleTypeId.Version = aws.String(v)
+			}
+		}
+		if v := rm["timeout_in_minutes"].(int); v != 0 {
+			rule.TimeoutInMinutes = aws.Int64(int64(v))
+		}
+
+		condition.Rules = append(condition.Rules, rule)
+	}
+
+	return []*codepipeline.Condition{condition}
+}
+
+func flattenAwsCodePipelineCondition(conditions []*codepipeline.Condition) []interface{} {
+	if len(conditions) == 0 || conditions[0] == nil {
+		return []interface{}{}
+	}
+
+	condition := conditions[0]
+	rules := make([]interface{}, 0, len(condition.Rules))
+	for _, rule := range condition.Rules {
+		rm := map[string]interface{}{
+			"configuration":      aws.StringValueMap(rule.Configuration),
+			"input_artifacts":    flattenAwsCodePipelineActionsInputArtifacts(rule.InputArtifacts),
+			"name":               aws.StringValue(rule.Name),
+			"region":             aws.StringValue(rule.Region),
+			"role_arn":           aws.StringValue(rule.RoleArn),
+			"rule_type_id":       []interface{}{},
+			"timeout_in_minutes": int(aws.Int64Value(rule.TimeoutInMinutes)),
+		}
+		if rule.RuleTypeId != nil {
+			rm["rule_type_id"] = []interface{}{
+				map[string]interface{}{
+					"category": aws.StringValue(rule.RuleTypeId.Category),
+					"owner":    aws.StringValue(rule.RuleTypeId.Owner),
+					"provider": aws.StringValue(rule.RuleTypeId.Provider),
+					"version":  aws.StringValue(rule.RuleTypeId.Version),
+				},
+			}
+		}
+		rules = append(rules, rm)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"result": aws.StringValue(condition.Result),
+			"rule":   rules,
+		},
+	}
+}
 func expandAwsCodePipelineArtifactStore(d *schema.ResourceData) *codepipeline.ArtifactStore {
 	configs := d.Get("artifact_store").([]interface{})
 	data := configs[0].(map[string]interface{})
@@ -256,10 +869,36 @@ func expandAwsCodePipelineStages(d *schema.ResourceData) []*codepipeline.StageDe
 		data := stage.(map[string]interface{})
 		a := data["action"].([]interface{})
 		actions := expandAwsCodePipelineActions(a)
-		pipelineStages = append(pipelineStages, &codepipeline.StageDeclaration{
+		stage := &codepipeline.StageDeclaration{
 			Name:    aws.String(data["name"].(string)),
 			Actions: actions,
-		})
+		}
+
+		if v := data["before_entry"].([]interface{}); len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			stage.BeforeEntry = &codepipeline.BeforeEntryConditions{
+				Conditions: expandAwsCodePipelineCondition(m["condition"].([]interface{})),
+			}
+		}
+
+		if v := data["on_failure"].([]interface{}); len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			stage.OnFailure = &codepipeline.FailureConditions{
+				Conditions: expandAwsCodePipelineCondition(m["condition"].([]interface{})),
+			}
+			if v := m["result"].(string); v != "" {
+				stage.OnFailure.Result = aws.String(v)
+			}
+		}
+
+		if v := data["on_success"].([]interface{}); len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			stage.OnSuccess = &codepipeline.SuccessConditions{
+				Conditions: expandAwsCodePipelineCondition(m["condition"].([]interface{})),
+			}
+		}
+
+		pipelineStages = append(pipelineStages, stage)
 	}
 	return pipelineStages
 }
@@ -270,6 +909,32 @@ func flattenAwsCodePipelineStages(stages []*codepipeline.StageDeclaration) []int
 		values := map[string]interface{}{}
 		values["name"] = *stage.Name
 		values["action"] = flattenAwsCodePipelineStageActions(stage.Actions)
+
+		if stage.BeforeEntry != nil {
+			values["before_entry"] = []interface{}{
+				map[string]interface{}{
+					"condition": flattenAwsCodePipelineCondition(stage.BeforeEntry.Conditions),
+				},
+			}
+		}
+
+		if stage.OnFailure != nil {
+			values["on_failure"] = []interface{}{
+				map[string]interface{}{
+					"condition": flattenAwsCodePipelineCondition(stage.OnFailure.Conditions),
+					"result":    aws.StringValue(stage.OnFailure.Result),
+				},
+			}
+		}
+
+		if stage.OnSuccess != nil {
+			values["on_success"] = []interface{}{
+				map[string]interface{}{
+					"condition": flattenAwsCodePipelineCondition(stage.OnSuccess.Conditions),
+				},
+			}
+		}
+
 		stagesList = append(stagesList, values)
 	}
 	return stagesList
@@ -453,8 +1118,23 @@ func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err := d.Set("trigger", flattenAwsCodePipelineTriggers(pipeline.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %s", err)
+	}
+
+	if err := d.Set("variable", flattenAwsCodePipelineVariables(pipeline.Variables)); err != nil {
+		return fmt.Errorf("error setting variable: %s", err)
+	}
+
 	d.Set("arn", metadata.PipelineArn)
 	d.Set("name", pipeline.Name)
+
+	// Pipelines created before pipeline types were introduced report none.
+	pipelineType := codepipeline.PipelineTypeV1
+	if pipeline.PipelineType != nil {
+		pipelineType = aws.StringValue(pipeline.PipelineType)
+	}
+	d.Set("pipeline_type", pipelineType)
 	d.Set("role_arn", pipeline.RoleArn)
 	return nil
 }
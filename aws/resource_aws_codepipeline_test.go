@@ -110,6 +110,106 @@ func TestAccAWSCodePipeline_deployWithServiceRole(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodePipeline_pipelineTypeV2(t *testing.T) {
+	connectionArn, repositoryId := testAccAWSCodePipelineCodeStarConnection(t)
+	name := acctest.RandString(10)
+	resourceName := "aws_codepipeline.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodePipelineConfig_pipelineTypeV2(name, connectionArn, repositoryId, "main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "Environment"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "main"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.excludes.0", "docs/**"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", "ROLLBACK"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "VariableCheck"),
+				),
+			},
+			{
+				Config: testAccAWSCodePipelineConfig_pipelineTypeV2(name, connectionArn, repositoryId, "release/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "release/*"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// CodePipeline adds a default trigger to V2 pipelines with a
+// CodeStarSourceConnection source, which must not show up as a diff.
+func TestAccAWSCodePipeline_pipelineTypeV2DefaultTrigger(t *testing.T) {
+	connectionArn, repositoryId := testAccAWSCodePipelineCodeStarConnection(t)
+	name := acctest.RandString(10)
+	resourceName := "aws_codepipeline.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodePipelineConfig_pipelineTypeV2NoTrigger(name, connectionArn, repositoryId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+				),
+			},
+			{
+				Config:   testAccAWSCodePipelineConfig_pipelineTypeV2NoTrigger(name, connectionArn, repositoryId),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodePipeline_pipelineTypeV1Validation(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCodePipelineConfig_pipelineTypeV1WithVariable(name),
+				ExpectError: regexp.MustCompile(`variable can only be set when pipeline_type is "V2"`),
+			},
+		},
+	})
+}
+
+func testAccAWSCodePipelineCodeStarConnection(t *testing.T) (string, string) {
+	connectionArn := os.Getenv("CODEPIPELINE_CODESTAR_CONNECTION_ARN")
+	repositoryId := os.Getenv("CODEPIPELINE_FULL_REPOSITORY_ID")
+	if connectionArn == "" || repositoryId == "" {
+		t.Skip("Environment variables CODEPIPELINE_CODESTAR_CONNECTION_ARN and CODEPIPELINE_FULL_REPOSITORY_ID must be set " +
+			"to an available CodeStar connection and a repository (owner/name) it can access")
+	}
+	return connectionArn, repositoryId
+}
+
 func testAccCheckAWSCodePipelineExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -672,3 +772,352 @@ resource "aws_codepipeline" "bar" {
   }}
 `, rName, rName, rName, rName)
 }
+
+func testAccAWSCodePipelineConfig_pipelineTypeV2(rName, connectionArn, repositoryId, branch string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "foo" {
+  bucket        = "tf-test-pipeline-%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "codepipeline_role" {
+  name = "codepipeline-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codepipeline.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "codepipeline_policy" {
+  name = "codepipeline_policy"
+  role = "${aws_iam_role.codepipeline_role.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect":"Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetBucketVersioning",
+        "s3:PutObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.foo.arn}",
+        "${aws_s3_bucket.foo.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "codebuild:BatchGetBuilds",
+        "codebuild:StartBuild",
+        "codestar-connections:UseConnection"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_codepipeline" "bar" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = "${aws_iam_role.codepipeline_role.arn}"
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = "${aws_s3_bucket.foo.bucket}"
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Environment"
+    default_value = "staging"
+    description   = "Target environment"
+  }
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      push {
+        branches {
+          includes = [%[4]q]
+        }
+
+        file_paths {
+          excludes = ["docs/**"]
+        }
+      }
+    }
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration {
+        ConnectionArn    = %[2]q
+        FullRepositoryId = %[3]q
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "CheckEnvironment"
+
+          rule_type_id {
+            category = "Rule"
+            provider = "VariableCheck"
+            version  = "1"
+          }
+
+          configuration {
+            Variable = "#{variables.Environment}"
+            Value    = "production"
+            Operator = "NE"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = "ROLLBACK"
+    }
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName, connectionArn, repositoryId, branch)
+}
+
+func testAccAWSCodePipelineConfig_pipelineTypeV2NoTrigger(rName, connectionArn, repositoryId string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "foo" {
+  bucket        = "tf-test-pipeline-%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "codepipeline_role" {
+  name = "codepipeline-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codepipeline.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "codepipeline_policy" {
+  name = "codepipeline_policy"
+  role = "${aws_iam_role.codepipeline_role.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect":"Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetBucketVersioning",
+        "s3:PutObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.foo.arn}",
+        "${aws_s3_bucket.foo.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "codebuild:BatchGetBuilds",
+        "codebuild:StartBuild",
+        "codestar-connections:UseConnection"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_codepipeline" "bar" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = "${aws_iam_role.codepipeline_role.arn}"
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = "${aws_s3_bucket.foo.bucket}"
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration {
+        ConnectionArn    = %[2]q
+        FullRepositoryId = %[3]q
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName, connectionArn, repositoryId)
+}
+
+func testAccAWSCodePipelineConfig_pipelineTypeV1WithVariable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "foo" {
+  bucket = "tf-test-pipeline-%[1]s"
+  acl    = "private"
+}
+
+resource "aws_iam_role" "codepipeline_role" {
+  name = "codepipeline-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codepipeline.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_codepipeline" "bar" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = "${aws_iam_role.codepipeline_role.arn}"
+
+  artifact_store {
+    location = "${aws_s3_bucket.foo.bucket}"
+    type     = "S3"
+  }
+
+  variable {
+    name = "Environment"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "S3"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration {
+        S3Bucket    = "${aws_s3_bucket.foo.bucket}"
+        S3ObjectKey = "source.zip"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Provider details that the service fills in on its own. They are only
+// tracked when present in the configuration, otherwise every plan would
+// propose to remove them.
+var cognitoIdentityProviderComputedDetails = []string{
+	"ActiveEncryptionCertificate",
+	"attributes_url",
+	"attributes_url_add_attributes",
+	"authorize_url",
+	"oidc_issuer",
+	"token_request_method",
+	"token_url",
+}
+
+func resourceAwsCognitoIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityProviderCreate,
+		Read:   resourceAwsCognitoIdentityProviderRead,
+		Update: resourceAwsCognitoIdentityProviderUpdate,
+		Delete: resourceAwsCognitoIdentityProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoIdentityProviderImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateIdentityProvider.html
+		Schema: map[string]*schema.Schema{
+			"attribute_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"idp_identifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w\s+=.@-]{1,40}$`), "must be 1-40 alphanumeric characters, whitespace or the characters +=.@-"),
+				},
+			},
+			"provider_details": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cognitoidentityprovider.IdentityProviderTypeType_Values(), false),
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	providerName := d.Get("provider_name").(string)
+
+	params := &cognitoidentityprovider.CreateIdentityProviderInput{
+		ProviderDetails: stringMapToPointers(d.Get("provider_details").(map[string]interface{})),
+		ProviderName:    aws.String(providerName),
+		ProviderType:    aws.String(d.Get("provider_type").(string)),
+		UserPoolId:      aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("attribute_mapping"); ok {
+		params.AttributeMapping = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("idp_identifiers"); ok {
+		params.IdpIdentifiers = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Identity Provider: %s", params)
+
+	_, err := conn.CreateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Identity Provider: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, providerName))
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DescribeIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito Identity Provider: %s", params)
+
+	resp, err := conn.DescribeIdentityProvider(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Identity Provider %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Identity Provider (%s): %s", d.Id(), err)
+	}
+
+	ip := resp.IdentityProvider
+
+	if err := d.Set("attribute_mapping", aws.StringValueMap(ip.AttributeMapping)); err != nil {
+		return fmt.Errorf("Error setting attribute_mapping: %s", err)
+	}
+
+	if err := d.Set("idp_identifiers", flattenStringList(ip.IdpIdentifiers)); err != nil {
+		return fmt.Errorf("Error setting idp_identifiers: %s", err)
+	}
+
+	providerDetails := aws.StringValueMap(ip.ProviderDetails)
+	configuredDetails := d.Get("provider_details").(map[string]interface{})
+	for _, k := range cognitoIdentityProviderComputedDetails {
+		if _, ok := configuredDetails[k]; !ok {
+			delete(providerDetails, k)
+		}
+	}
+
+	if err := d.Set("provider_details", providerDetails); err != nil {
+		return fmt.Errorf("Error setting provider_details: %s", err)
+	}
+
+	d.Set("provider_name", ip.ProviderName)
+	d.Set("provider_type", ip.ProviderType)
+	d.Set("user_pool_id", ip.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.UpdateIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if d.HasChange("attribute_mapping") {
+		params.AttributeMapping = stringMapToPointers(d.Get("attribute_mapping").(map[string]interface{}))
+	}
+
+	if d.HasChange("idp_identifiers") {
+		params.IdpIdentifiers = expandStringList(d.Get("idp_identifiers").([]interface{}))
+	}
+
+	if d.HasChange("provider_details") {
+		params.ProviderDetails = stringMapToPointers(d.Get("provider_details").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Identity Provider: %s", params)
+
+	_, err := conn.UpdateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Identity Provider (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DeleteIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Identity Provider: %s", params)
+
+	_, err := conn.DeleteIdentityProvider(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Identity Provider (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.SplitN(d.Id(), "/", 2)
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return nil, errors.New("Error importing Cognito Identity Provider. Must specify user_pool_id/provider_name")
+	}
+	d.Set("user_pool_id", idSplit[0])
+	d.Set("provider_name", idSplit[1])
+	return []*schema.ResourceData{d}, nil
+}
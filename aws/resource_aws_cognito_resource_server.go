@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoResourceServerCreate,
+		Read:   resourceAwsCognitoResourceServerRead,
+		Update: resourceAwsCognitoResourceServerUpdate,
+		Delete: resourceAwsCognitoResourceServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoResourceServerImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateResourceServer.html
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"scope_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\x21\x23-\x2E\x30-\x5B\x5D-\x7E]{1,256}$`), "must be 1-256 printable characters, excluding whitespace, quotes, slashes and backslashes"),
+						},
+					},
+				},
+			},
+			"scope_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	identifier := d.Get("identifier").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("scope"); ok {
+		params.Scopes = expandCognitoResourceServerScopes(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Resource Server: %s", params)
+
+	_, err := conn.CreateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Resource Server: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, identifier))
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DescribeResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito Resource Server: %s", params)
+
+	resp, err := conn.DescribeResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Resource Server %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Resource Server (%s): %s", d.Id(), err)
+	}
+
+	rs := resp.ResourceServer
+
+	d.Set("identifier", rs.Identifier)
+	d.Set("name", rs.Name)
+	d.Set("user_pool_id", rs.UserPoolId)
+
+	if err := d.Set("scope", flattenCognitoResourceServerScopes(rs.Scopes)); err != nil {
+		return fmt.Errorf("Error setting scope: %s", err)
+	}
+
+	scopeIdentifiers := make([]string, 0, len(rs.Scopes))
+	for _, scope := range rs.Scopes {
+		scopeIdentifiers = append(scopeIdentifiers, fmt.Sprintf("%s/%s", aws.StringValue(rs.Identifier), aws.StringValue(scope.ScopeName)))
+	}
+
+	if err := d.Set("scope_identifiers", scopeIdentifiers); err != nil {
+		return fmt.Errorf("Error setting scope_identifiers: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// The update replaces the whole resource server, so every argument
+	// has to be sent along, not only the changed ones.
+	params := &cognitoidentityprovider.UpdateResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScopes(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Resource Server: %s", params)
+
+	_, err := conn.UpdateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Resource Server (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DeleteResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Resource Server: %s", params)
+
+	_, err := conn.DeleteResourceServer(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Resource Server (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Identifiers are commonly URLs, so only the first separator counts.
+	idSplit := strings.SplitN(d.Id(), "/", 2)
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return nil, errors.New("Error importing Cognito Resource Server. Must specify user_pool_id/identifier")
+	}
+	d.Set("user_pool_id", idSplit[0])
+	d.Set("identifier", idSplit[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCognitoResourceServerScopes(inputs []interface{}) []*cognitoidentityprovider.ResourceServerScopeType {
+	configs := make([]*cognitoidentityprovider.ResourceServerScopeType, 0, len(inputs))
+
+	for _, input := range inputs {
+		data := input.(map[string]interface{})
+
+		configs = append(configs, &cognitoidentityprovider.ResourceServerScopeType{
+			ScopeDescription: aws.String(data["scope_description"].(string)),
+			ScopeName:        aws.String(data["scope_name"].(string)),
+		})
+	}
+
+	return configs
+}
+
+func flattenCognitoResourceServerScopes(inputs []*cognitoidentityprovider.ResourceServerScopeType) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(inputs))
+
+	for _, input := range inputs {
+		values = append(values, map[string]interface{}{
+			"scope_description": aws.StringValue(input.ScopeDescription),
+			"scope_name":        aws.StringValue(input.ScopeName),
+		})
+	}
+
+	return values
+}
@@ -29,6 +29,37 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 
 		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserPool.html
 		Schema: map[string]*schema.Schema{
+			"account_recovery_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recovery_mechanism": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.RecoveryOptionNameType_Values(), false),
+									},
+									"priority": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 2),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"admin_create_user_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -175,16 +206,59 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validateArn,
 						},
+						"custom_email_sender": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"lambda_version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CustomEmailSenderLambdaVersionType_Values(), false),
+									},
+								},
+							},
+						},
 						"custom_message": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validateArn,
 						},
+						"custom_sms_sender": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"lambda_version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CustomSMSSenderLambdaVersionType_Values(), false),
+									},
+								},
+							},
+						},
 						"define_auth_challenge": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validateArn,
 						},
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"post_authentication": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -388,6 +462,20 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ValidateFunc: validateCognitoUserPoolSmsVerificationMessage,
 			},
 
+			"software_token_mfa_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 
 			"username_attributes": {
@@ -404,6 +492,21 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ConflictsWith: []string{"alias_attributes"},
 			},
 
+			"user_pool_add_ons": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_security_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cognitoidentityprovider.AdvancedSecurityModeType_Values(), false),
+						},
+					},
+				},
+			},
+
 			"verification_message_template": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -464,6 +567,15 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		PoolName: aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("account_recovery_setting"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.AccountRecoverySetting = expandCognitoUserPoolAccountRecoverySetting(config)
+		}
+	}
+
 	if v, ok := d.GetOk("admin_create_user_config"); ok {
 		configs := v.([]interface{})
 		config, ok := configs[0].(map[string]interface{})
@@ -535,7 +647,11 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if v, ok := d.GetOk("mfa_configuration"); ok {
+	// Software token MFA can only be configured via SetUserPoolMfaConfig,
+	// which then also takes care of the MFA configuration itself.
+	_, softwareTokenMfaConfigured := d.GetOk("software_token_mfa_configuration")
+
+	if v, ok := d.GetOk("mfa_configuration"); ok && !softwareTokenMfaConfigured {
 		params.MfaConfiguration = aws.String(v.(string))
 	}
 
@@ -568,6 +684,15 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("user_pool_add_ons"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.UserPoolAddOns = expandCognitoUserPoolUserPoolAddOns(config)
+		}
+	}
+
 	if v, ok := d.GetOk("username_attributes"); ok {
 		params.UsernameAttributes = expandStringList(v.([]interface{}))
 	}
@@ -613,6 +738,12 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.UserPool.Id)
 
+	if softwareTokenMfaConfigured {
+		if err := resourceAwsCognitoUserPoolSetMfaConfig(conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsCognitoUserPoolRead(d, meta)
 }
 
@@ -636,6 +767,10 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := d.Set("account_recovery_setting", flattenCognitoUserPoolAccountRecoverySetting(resp.UserPool.AccountRecoverySetting)); err != nil {
+		return fmt.Errorf("Failed setting account_recovery_setting: %s", err)
+	}
+
 	if err := d.Set("admin_create_user_config", flattenCognitoUserPoolAdminCreateUserConfig(resp.UserPool.AdminCreateUserConfig)); err != nil {
 		return fmt.Errorf("Failed setting admin_create_user_config: %s", err)
 	}
@@ -697,6 +832,10 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Failed setting sms_configuration: %s", err)
 	}
 
+	if err := d.Set("user_pool_add_ons", flattenCognitoUserPoolUserPoolAddOns(resp.UserPool.UserPoolAddOns)); err != nil {
+		return fmt.Errorf("Failed setting user_pool_add_ons: %s", err)
+	}
+
 	if resp.UserPool.UsernameAttributes != nil {
 		d.Set("username_attributes", flattenStringList(resp.UserPool.UsernameAttributes))
 	}
@@ -710,16 +849,42 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", resp.UserPool.Name)
 	d.Set("tags", tagsToMapGeneric(resp.UserPool.UserPoolTags))
 
+	mfaResp, err := conn.GetUserPoolMfaConfig(&cognitoidentityprovider.GetUserPoolMfaConfigInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+	}
+
+	if err := d.Set("software_token_mfa_configuration", flattenCognitoUserPoolSoftwareTokenMfaConfiguration(mfaResp.SoftwareTokenMfaConfiguration)); err != nil {
+		return fmt.Errorf("Failed setting software_token_mfa_configuration: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoidpconn
 
+	if d.HasChange("mfa_configuration") || d.HasChange("software_token_mfa_configuration") {
+		if err := resourceAwsCognitoUserPoolSetMfaConfig(conn, d); err != nil {
+			return err
+		}
+	}
+
 	params := &cognitoidentityprovider.UpdateUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
 	}
 
+	if v, ok := d.GetOk("account_recovery_setting"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.AccountRecoverySetting = expandCognitoUserPoolAccountRecoverySetting(config)
+		}
+	}
+
 	if d.HasChange("admin_create_user_config") {
 		configs := d.Get("admin_create_user_config").([]interface{})
 		config, ok := configs[0].(map[string]interface{})
@@ -790,7 +955,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("mfa_configuration") {
+	if _, ok := d.GetOk("software_token_mfa_configuration"); !ok && d.HasChange("mfa_configuration") {
 		params.MfaConfiguration = aws.String(d.Get("mfa_configuration").(string))
 	}
 
@@ -818,6 +983,15 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("user_pool_add_ons"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.UserPoolAddOns = expandCognitoUserPoolUserPoolAddOns(config)
+		}
+	}
+
 	if d.HasChange("verification_message_template") {
 		configs := d.Get("verification_message_template").([]interface{})
 		config, ok := configs[0].(map[string]interface{})
@@ -883,3 +1057,58 @@ func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func resourceAwsCognitoUserPoolSetMfaConfig(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData) error {
+	params := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
+		MfaConfiguration: aws.String(d.Get("mfa_configuration").(string)),
+		UserPoolId:       aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("software_token_mfa_configuration"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.SoftwareTokenMfaConfiguration = expandCognitoUserPoolSoftwareTokenMfaConfiguration(config)
+		}
+	}
+
+	// Omitting the SMS MFA configuration would disable SMS as a second factor.
+	if v, ok := d.GetOk("sms_configuration"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.SmsMfaConfiguration = &cognitoidentityprovider.SmsMfaConfigType{
+				SmsConfiguration: expandCognitoUserPoolSmsConfiguration(config),
+			}
+
+			if v, ok := d.GetOk("sms_authentication_message"); ok {
+				params.SmsMfaConfiguration.SmsAuthenticationMessage = aws.String(v.(string))
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool MFA configuration: %s", params)
+
+	// IAM roles & policies can take some time to propagate and be attached
+	// to the User Pool.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.SetUserPoolMfaConfig(params)
+		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
+			log.Printf("[DEBUG] Received %s, retrying SetUserPoolMfaConfig", err)
+			return resource.RetryableError(err)
+		}
+		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
+			log.Printf("[DEBUG] Received %s, retrying SetUserPoolMfaConfig", err)
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
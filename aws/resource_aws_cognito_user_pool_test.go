@@ -467,6 +467,115 @@ func TestAccAWSCognitoUserPool_withVerificationMessageTemplate(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_withUserPoolAddOns(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, "AUDIT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.0.advanced_security_mode", "AUDIT"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, "ENFORCED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.0.advanced_security_mode", "ENFORCED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withAccountRecoverySetting(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withAccountRecoverySetting(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "account_recovery_setting.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "account_recovery_setting.0.recovery_mechanism.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withAccountRecoverySettingUpdated(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "account_recovery_setting.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "account_recovery_setting.0.recovery_mechanism.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withSoftwareTokenMfaConfiguration(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "ON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "ON"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "OPTIONAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OPTIONAL"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withCustomSenders(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withCustomSenders(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.main"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.main", "lambda_config.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.main", "lambda_config.0.kms_key_id", "aws_kms_key.main", "arn"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.main", "lambda_config.0.custom_email_sender.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.main", "lambda_config.0.custom_email_sender.0.lambda_arn", "aws_lambda_function.main", "arn"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.main", "lambda_config.0.custom_email_sender.0.lambda_version", "V1_0"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.main", "lambda_config.0.custom_sms_sender.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.main", "lambda_config.0.custom_sms_sender.0.lambda_arn", "aws_lambda_function.main", "arn"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.main", "lambda_config.0.custom_sms_sender.0.lambda_version", "V1_0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
 
@@ -956,3 +1065,112 @@ resource "aws_cognito_user_pool" "pool" {
   }
 }`, name)
 }
+
+func testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  user_pool_add_ons {
+    advanced_security_mode = "%s"
+  }
+}`, name, mode)
+}
+
+func testAccAWSCognitoUserPoolConfig_withAccountRecoverySetting(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  account_recovery_setting {
+    recovery_mechanism {
+      name     = "admin_only"
+      priority = 1
+    }
+  }
+}`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withAccountRecoverySettingUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  account_recovery_setting {
+    recovery_mechanism {
+      name     = "verified_email"
+      priority = 1
+    }
+
+    recovery_mechanism {
+      name     = "verified_phone_number"
+      priority = 2
+    }
+  }
+}`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, mfaConfiguration string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name              = "terraform-test-pool-%s"
+  mfa_configuration = "%s"
+
+  software_token_mfa_configuration {
+    enabled = true
+  }
+}`, name, mfaConfiguration)
+}
+
+func testAccAWSCognitoUserPoolConfig_withCustomSenders(name string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "main" {
+  name = "%s"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "main" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s"
+  role          = "${aws_iam_role.main.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_kms_key" "main" {
+  description             = "%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+
+  lambda_config {
+    kms_key_id = "${aws_kms_key.main.arn}"
+
+    custom_email_sender {
+      lambda_arn     = "${aws_lambda_function.main.arn}"
+      lambda_version = "V1_0"
+    }
+
+    custom_sms_sender {
+      lambda_arn     = "${aws_lambda_function.main.arn}"
+      lambda_version = "V1_0"
+    }
+  }
+}`, name)
+}
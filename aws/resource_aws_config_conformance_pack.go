@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigConformancePack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigConformancePackPut,
+		Read:   resourceAwsConfigConformancePackRead,
+		Update: resourceAwsConfigConformancePackPut,
+		Delete: resourceAwsConfigConformancePackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(63),
+			},
+			"delivery_s3_key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(1024),
+			},
+			"input_parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 60,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMaxLength(255),
+						},
+						"parameter_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMaxLength(4096),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{0,255}$`),
+					"must begin with a letter and contain up to 256 alphanumeric characters and hyphens",
+				),
+			},
+			"template_body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringLenBetween(1, 51200),
+				DiffSuppressFunc: suppressEquivalentYamlDiffs,
+				ConflictsWith:    []string{"template_s3_uri"},
+			},
+			"template_s3_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^s3://`),
+					"must begin with s3://",
+				),
+				ConflictsWith: []string{"template_body"},
+			},
+		},
+	}
+}
+
+func resourceAwsConfigConformancePackPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	input := &configservice.PutConformancePackInput{
+		ConformancePackName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_s3_bucket"); ok {
+		input.DeliveryS3Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("delivery_s3_key_prefix"); ok {
+		input.DeliveryS3KeyPrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_parameter"); ok {
+		input.ConformancePackInputParameters = expandConfigConformancePackInputParameters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		input.TemplateBody = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_s3_uri"); ok {
+		input.TemplateS3Uri = aws.String(v.(string))
+	}
+
+	if input.TemplateBody == nil && input.TemplateS3Uri == nil {
+		return fmt.Errorf("error creating Config Conformance Pack (%s): one of template_body or template_s3_uri must be set", name)
+	}
+
+	log.Printf("[DEBUG] Putting Config Conformance Pack: %s", input)
+	_, err := conn.PutConformancePack(input)
+	if err != nil {
+		return fmt.Errorf("error putting Config Conformance Pack (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForConfigConformancePackStateCreateComplete(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsConfigConformancePackRead(d, meta)
+}
+
+func resourceAwsConfigConformancePackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	pack, err := configDescribeConformancePack(conn, d.Id())
+	if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Config Conformance Pack (%s): %s", d.Id(), err)
+	}
+
+	if pack == nil {
+		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", pack.ConformancePackArn)
+	d.Set("delivery_s3_bucket", pack.DeliveryS3Bucket)
+	d.Set("delivery_s3_key_prefix", pack.DeliveryS3KeyPrefix)
+	d.Set("name", pack.ConformancePackName)
+
+	if err := d.Set("input_parameter", flattenConfigConformancePackInputParameters(pack.ConformancePackInputParameters)); err != nil {
+		return fmt.Errorf("error setting input_parameter: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigConformancePackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	log.Printf("[INFO] Deleting Config Conformance Pack: %s", d.Id())
+	_, err := conn.DeleteConformancePack(&configservice.DeleteConformancePackInput{
+		ConformancePackName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Config Conformance Pack (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForConfigConformancePackStateDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigConformancePackInputParameters(l []interface{}) []*configservice.ConformancePackInputParameter {
+	parameters := make([]*configservice.ConformancePackInputParameter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, &configservice.ConformancePackInputParameter{
+			ParameterName:  aws.String(m["parameter_name"].(string)),
+			ParameterValue: aws.String(m["parameter_value"].(string)),
+		})
+	}
+
+	return parameters
+}
+
+func flattenConfigConformancePackInputParameters(parameters []*configservice.ConformancePackInputParameter) []interface{} {
+	l := make([]interface{}, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		if parameter == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"parameter_name":  aws.StringValue(parameter.ParameterName),
+			"parameter_value": aws.StringValue(parameter.ParameterValue),
+		})
+	}
+
+	return l
+}
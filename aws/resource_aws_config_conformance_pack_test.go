@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccConfigConformancePack_basic(t *testing.T) {
+	resourceName := "aws_config_conformance_pack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigTemplateBody(rName, "S3_BUCKET_VERSIONING_ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`conformance-pack/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", ""),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+			{
+				Config: testAccConfigConformancePackConfigTemplateBody(rName, "S3_BUCKET_PUBLIC_READ_PROHIBITED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_inputParameters(t *testing.T) {
+	resourceName := "aws_config_conformance_pack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigInputParameter(rName, "TestKey", "TestValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_s3Template(t *testing.T) {
+	resourceName := "aws_config_conformance_pack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigTemplateS3Uri(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_s3_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_s3_uri"},
+			},
+		},
+	})
+}
+
+func TestAWSConfigConformancePack_flattenInputParameters(t *testing.T) {
+	parameters := []*configservice.ConformancePackInputParameter{
+		{
+			ParameterName:  aws.String("TestKey"),
+			ParameterValue: aws.String("TestValue"),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"parameter_name":  "TestKey",
+			"parameter_value": "TestValue",
+		},
+	}
+
+	output := flattenConfigConformancePackInputParameters(parameters)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+
+	if expanded := expandConfigConformancePackInputParameters(output); !reflect.DeepEqual(expanded, parameters) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", expanded, parameters)
+	}
+}
+
+func testAccCheckConfigConformancePackExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		pack, err := configDescribeConformancePack(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if pack == nil {
+			return fmt.Errorf("Config Conformance Pack (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigConformancePackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_conformance_pack" {
+			continue
+		}
+
+		pack, err := configDescribeConformancePack(conn, rs.Primary.ID)
+		if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if pack != nil {
+			return fmt.Errorf("Config Conformance Pack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccConfigConformancePackConfigBase configures the configuration recorder
+// that conformance packs and organization rules require.
+func testAccConfigConformancePackConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_config_configuration_recorder" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWS_ConfigRole"
+}
+`, rName)
+}
+
+func testAccConfigConformancePackConfigTemplateBody(rName, identifier string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  name = %[1]q
+
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: %[2]s
+    Type: AWS::Config::ConfigRule
+EOT
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+`, rName, identifier)
+}
+
+func testAccConfigConformancePackConfigInputParameter(rName, key, value string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  name = %[1]q
+
+  input_parameter {
+    parameter_name  = %[2]q
+    parameter_value = %[3]q
+  }
+
+  template_body = <<EOT
+Parameters:
+  %[2]s:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+`, rName, key, value)
+}
+
+func testAccConfigConformancePackConfigTemplateS3Uri(rName string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = %[1]q
+  content = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+
+resource "aws_config_conformance_pack" "test" {
+  name                   = %[1]q
+  delivery_s3_bucket     = "${aws_s3_bucket.test.id}"
+  delivery_s3_key_prefix = %[1]q
+  template_s3_uri        = "s3://${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.id}"
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+`, rName)
+}
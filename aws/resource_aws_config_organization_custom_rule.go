@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigOrganizationCustomRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigOrganizationCustomRuleCreate,
+		Read:   resourceAwsConfigOrganizationCustomRuleRead,
+		Update: resourceAwsConfigOrganizationCustomRuleUpdate,
+		Delete: resourceAwsConfigOrganizationCustomRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"excluded_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+				Set: schema.HashString,
+			},
+			"input_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateJsonString,
+			},
+			"lambda_function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"maximum_execution_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateConfigExecutionFrequency(),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"resource_id_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 768),
+			},
+			"resource_types_scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				Set: schema.HashString,
+			},
+			"tag_key_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tag_value_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"trigger_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						configservice.OrganizationConfigRuleTriggerTypeConfigurationItemChangeNotification,
+						configservice.OrganizationConfigRuleTriggerTypeOversizedConfigurationItemChangeNotification,
+						configservice.OrganizationConfigRuleTriggerTypeScheduledNotification,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsConfigOrganizationCustomRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	input := &configservice.PutOrganizationConfigRuleInput{
+		OrganizationConfigRuleName:     aws.String(name),
+		OrganizationCustomRuleMetadata: expandConfigOrganizationCustomRuleMetadata(d),
+	}
+
+	if v, ok := d.GetOk("excluded_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExcludedAccounts = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Config Organization Custom Rule: %s", input)
+	_, err := conn.PutOrganizationConfigRule(input)
+	if err != nil {
+		return fmt.Errorf("error creating Config Organization Custom Rule (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForConfigOrganizationConfigRuleStatusCreateSuccessful(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Custom Rule (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsConfigOrganizationCustomRuleRead(d, meta)
+}
+
+func resourceAwsConfigOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	rule, err := configDescribeOrganizationConfigRule(conn, d.Id())
+	if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
+		log.Printf("[WARN] Config Organization Custom Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Config Organization Custom Rule (%s): %s", d.Id(), err)
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] Config Organization Custom Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if rule.OrganizationManagedRuleMetadata != nil {
+		return fmt.Errorf("expected Config Organization Custom Rule (%s), found Config Organization Managed Rule", d.Id())
+	}
+
+	metadata := rule.OrganizationCustomRuleMetadata
+	if metadata == nil {
+		return fmt.Errorf("error reading Config Organization Custom Rule (%s): empty metadata", d.Id())
+	}
+
+	d.Set("arn", rule.OrganizationConfigRuleArn)
+	d.Set("description", metadata.Description)
+
+	if err := d.Set("excluded_accounts", schema.NewSet(schema.HashString, flattenStringList(rule.ExcludedAccounts))); err != nil {
+		return fmt.Errorf("error setting excluded_accounts: %s", err)
+	}
+
+	d.Set("input_parameters", metadata.InputParameters)
+	d.Set("lambda_function_arn", metadata.LambdaFunctionArn)
+	d.Set("maximum_execution_frequency", metadata.MaximumExecutionFrequency)
+	d.Set("name", rule.OrganizationConfigRuleName)
+	d.Set("resource_id_scope", metadata.ResourceIdScope)
+
+	if err := d.Set("resource_types_scope", schema.NewSet(schema.HashString, flattenStringList(metadata.ResourceTypesScope))); err != nil {
+		return fmt.Errorf("error setting resource_types_scope: %s", err)
+	}
+
+	d.Set("tag_key_scope", metadata.TagKeyScope)
+	d.Set("tag_value_scope", metadata.TagValueScope)
+
+	if err := d.Set("trigger_types", schema.NewSet(schema.HashString, flattenStringList(metadata.OrganizationConfigRuleTriggerTypes))); err != nil {
+		return fmt.Errorf("error setting trigger_types: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigOrganizationCustomRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.PutOrganizationConfigRuleInput{
+		OrganizationConfigRuleName:     aws.String(d.Id()),
+		OrganizationCustomRuleMetadata: expandConfigOrganizationCustomRuleMetadata(d),
+	}
+
+	if v, ok := d.GetOk("excluded_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExcludedAccounts = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Updating Config Organization Custom Rule: %s", input)
+	_, err := conn.PutOrganizationConfigRule(input)
+	if err != nil {
+		return fmt.Errorf("error updating Config Organization Custom Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForConfigOrganizationConfigRuleStatusUpdateSuccessful(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Custom Rule (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsConfigOrganizationCustomRuleRead(d, meta)
+}
+
+func resourceAwsConfigOrganizationCustomRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	log.Printf("[INFO] Deleting Config Organization Custom Rule: %s", d.Id())
+	return configDeleteOrganizationConfigRule(conn, d.Id())
+}
+
+func expandConfigOrganizationCustomRuleMetadata(d *schema.ResourceData) *configservice.OrganizationCustomRuleMetadata {
+	metadata := &configservice.OrganizationCustomRuleMetadata{
+		LambdaFunctionArn:                  aws.String(d.Get("lambda_function_arn").(string)),
+		OrganizationConfigRuleTriggerTypes: expandStringSet(d.Get("trigger_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		metadata.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_parameters"); ok {
+		metadata.InputParameters = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maximum_execution_frequency"); ok {
+		metadata.MaximumExecutionFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_id_scope"); ok {
+		metadata.ResourceIdScope = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_types_scope"); ok && v.(*schema.Set).Len() > 0 {
+		metadata.ResourceTypesScope = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tag_key_scope"); ok {
+		metadata.TagKeyScope = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag_value_scope"); ok {
+		metadata.TagValueScope = aws.String(v.(string))
+	}
+
+	return metadata
+}
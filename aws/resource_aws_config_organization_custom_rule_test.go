@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccConfigOrganizationCustomRule_basic(t *testing.T) {
+	var rule configservice.OrganizationConfigRule
+	resourceName := "aws_config_organization_custom_rule.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConfigRuleDestroy("aws_config_organization_custom_rule"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationCustomRuleConfigTriggerTypes(rName, `"ConfigurationItemChangeNotification"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConfigRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_function_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "trigger_types.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigOrganizationCustomRuleConfigTriggerTypes(rName, `"ConfigurationItemChangeNotification", "OversizedConfigurationItemChangeNotification"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConfigRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "trigger_types.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigOrganizationCustomRuleConfigTriggerTypes(rName, triggerTypes string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = "${aws_iam_role.lambda.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs8.10"
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = "${aws_lambda_function.test.arn}"
+  principal     = "config.amazonaws.com"
+  statement_id  = "AllowExecutionFromConfig"
+}
+
+resource "aws_config_organization_custom_rule" "test" {
+  lambda_function_arn = "${aws_lambda_function.test.arn}"
+  name                = %[1]q
+  trigger_types       = [%[2]s]
+
+  depends_on = ["aws_config_configuration_recorder.test", "aws_lambda_permission.test"]
+}
+`, rName, triggerTypes)
+}
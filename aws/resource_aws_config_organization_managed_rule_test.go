@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccConfigOrganizationManagedRule_basic(t *testing.T) {
+	var rule configservice.OrganizationConfigRule
+	resourceName := "aws_config_organization_managed_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigOrganizationConfigRuleDestroy("aws_config_organization_managed_rule"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigOrganizationManagedRuleConfigRuleIdentifier(rName, "IAM_PASSWORD_POLICY", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConfigRuleExists(resourceName, &rule),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`organization-config-rule/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types_scope.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule_identifier", "IAM_PASSWORD_POLICY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigOrganizationManagedRuleConfigRuleIdentifier(rName, "S3_BUCKET_VERSIONING_ENABLED", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigOrganizationConfigRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_identifier", "S3_BUCKET_VERSIONING_ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigOrganizationConfigRuleExists(n string, rule *configservice.OrganizationConfigRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		output, err := configDescribeOrganizationConfigRule(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Config Organization Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*rule = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigOrganizationConfigRuleDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			rule, err := configDescribeOrganizationConfigRule(conn, rs.Primary.ID)
+			if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if rule != nil {
+				return fmt.Errorf("Config Organization Rule (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigOrganizationManagedRuleConfigRuleIdentifier(rName, ruleIdentifier, description string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_config_organization_managed_rule" "test" {
+  description     = %[3]q
+  name            = %[1]q
+  rule_identifier = %[2]q
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+`, rName, ruleIdentifier, description)
+}
@@ -0,0 +1,461 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigRemediationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigRemediationConfigurationPut,
+		Read:   resourceAwsConfigRemediationConfigurationRead,
+		Update: resourceAwsConfigRemediationConfigurationPut,
+		Delete: resourceAwsConfigRemediationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"config_rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"execution_controls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_controls": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"concurrent_execution_rate_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+									"error_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"maximum_automatic_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Set:      configRemediationConfigurationParameterHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"resource_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								configservice.ResourceValueTypeResourceId,
+							}, false),
+						},
+						"static_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"static_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"retry_attempt_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 2678000),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					configservice.RemediationTargetTypeSsmDocument,
+				}, false),
+			},
+			"target_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConfigRemediationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("config_rule_name").(string)
+	remediationConfiguration := &configservice.RemediationConfiguration{
+		Automatic:      aws.Bool(d.Get("automatic").(bool)),
+		ConfigRuleName: aws.String(name),
+		TargetId:       aws.String(d.Get("target_id").(string)),
+		TargetType:     aws.String(d.Get("target_type").(string)),
+	}
+
+	if v, ok := d.GetOk("execution_controls"); ok {
+		remediationConfiguration.ExecutionControls = expandConfigRemediationConfigurationExecutionControls(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("maximum_automatic_attempts"); ok {
+		remediationConfiguration.MaximumAutomaticAttempts = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandConfigRemediationConfigurationParameters(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		remediationConfiguration.Parameters = parameters
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		remediationConfiguration.ResourceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retry_attempt_seconds"); ok {
+		remediationConfiguration.RetryAttemptSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("target_version"); ok {
+		remediationConfiguration.TargetVersion = aws.String(v.(string))
+	}
+
+	input := &configservice.PutRemediationConfigurationsInput{
+		RemediationConfigurations: []*configservice.RemediationConfiguration{remediationConfiguration},
+	}
+
+	log.Printf("[DEBUG] Putting Config Remediation Configuration: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		output, err := conn.PutRemediationConfigurations(input)
+		if isAWSErr(err, configservice.ErrCodeInsufficientPermissionsException, "") {
+			// IAM is eventually consistent
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		// The API reports per-item failures in the response rather than as an error.
+		if len(output.FailedBatches) > 0 {
+			messages := make([]string, 0, len(output.FailedBatches))
+			for _, batch := range output.FailedBatches {
+				messages = append(messages, aws.StringValue(batch.FailureMessage))
+			}
+			return resource.NonRetryableError(fmt.Errorf("%s", strings.Join(messages, ", ")))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Config Remediation Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigRemediationConfigurationRead(d, meta)
+}
+
+func resourceAwsConfigRemediationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+		ConfigRuleNames: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") || isAWSErr(err, configservice.ErrCodeNoSuchRemediationConfigurationException, "") {
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Config Remediation Configuration (%s): %s", d.Id(), err)
+	}
+
+	var remediationConfiguration *configservice.RemediationConfiguration
+	for _, rc := range output.RemediationConfigurations {
+		if aws.StringValue(rc.ConfigRuleName) == d.Id() {
+			remediationConfiguration = rc
+			break
+		}
+	}
+
+	if remediationConfiguration == nil {
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", remediationConfiguration.Arn)
+	d.Set("automatic", remediationConfiguration.Automatic)
+	d.Set("config_rule_name", remediationConfiguration.ConfigRuleName)
+
+	if err := d.Set("execution_controls", flattenConfigRemediationConfigurationExecutionControls(remediationConfiguration.ExecutionControls)); err != nil {
+		return fmt.Errorf("error setting execution_controls: %s", err)
+	}
+
+	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
+
+	// Keep a single configured static_values element in static_values rather than static_value.
+	staticValuesNames := make(map[string]bool)
+	for _, raw := range d.Get("parameter").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		if len(m["static_values"].([]interface{})) > 0 {
+			staticValuesNames[m["name"].(string)] = true
+		}
+	}
+
+	if err := d.Set("parameter", flattenConfigRemediationConfigurationParameters(remediationConfiguration.Parameters, staticValuesNames)); err != nil {
+		return fmt.Errorf("error setting parameter: %s", err)
+	}
+
+	d.Set("resource_type", remediationConfiguration.ResourceType)
+	d.Set("retry_attempt_seconds", remediationConfiguration.RetryAttemptSeconds)
+	d.Set("target_id", remediationConfiguration.TargetId)
+	d.Set("target_type", remediationConfiguration.TargetType)
+	d.Set("target_version", remediationConfiguration.TargetVersion)
+
+	return nil
+}
+
+func resourceAwsConfigRemediationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.DeleteRemediationConfigurationInput{
+		ConfigRuleName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Deleting Config Remediation Configuration: %s", d.Id())
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteRemediationConfiguration(input)
+		// Remediation executions that are still in flight block deletion.
+		if isAWSErr(err, configservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, configservice.ErrCodeNoSuchRemediationConfigurationException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Config Remediation Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigRemediationConfigurationExecutionControls(l []interface{}) *configservice.ExecutionControls {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	executionControls := &configservice.ExecutionControls{}
+
+	if v, ok := m["ssm_controls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sm := v[0].(map[string]interface{})
+		ssmControls := &configservice.SsmControls{}
+
+		if v, ok := sm["concurrent_execution_rate_percentage"].(int); ok && v > 0 {
+			ssmControls.ConcurrentExecutionRatePercentage = aws.Int64(int64(v))
+		}
+
+		if v, ok := sm["error_percentage"].(int); ok && v > 0 {
+			ssmControls.ErrorPercentage = aws.Int64(int64(v))
+		}
+
+		executionControls.SsmControls = ssmControls
+	}
+
+	return executionControls
+}
+
+func flattenConfigRemediationConfigurationExecutionControls(executionControls *configservice.ExecutionControls) []interface{} {
+	if executionControls == nil || executionControls.SsmControls == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ssm_controls": []interface{}{
+			map[string]interface{}{
+				"concurrent_execution_rate_percentage": int(aws.Int64Value(executionControls.SsmControls.ConcurrentExecutionRatePercentage)),
+				"error_percentage":                     int(aws.Int64Value(executionControls.SsmControls.ErrorPercentage)),
+			},
+		},
+	}
+
+	return []interface{}{m}
+}
+
+func expandConfigRemediationConfigurationParameters(l []interface{}) (map[string]*configservice.RemediationParameterValue, error) {
+	parameters := make(map[string]*configservice.RemediationParameterValue, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := m["name"].(string)
+		resourceValue := m["resource_value"].(string)
+		staticValue := m["static_value"].(string)
+		staticValues := m["static_values"].([]interface{})
+
+		set := 0
+		for _, isSet := range []bool{resourceValue != "", staticValue != "", len(staticValues) > 0} {
+			if isSet {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("parameter %q: exactly one of resource_value, static_value or static_values must be set", name)
+		}
+
+		value := &configservice.RemediationParameterValue{}
+
+		switch {
+		case resourceValue != "":
+			value.ResourceValue = &configservice.ResourceValue{
+				Value: aws.String(resourceValue),
+			}
+		case staticValue != "":
+			value.StaticValue = &configservice.StaticValue{
+				Values: []*string{aws.String(staticValue)},
+			}
+		default:
+			value.StaticValue = &configservice.StaticValue{
+				Values: expandStringList(staticValues),
+			}
+		}
+
+		parameters[name] = value
+	}
+
+	return parameters, nil
+}
+
+// flattenConfigRemediationConfigurationParameters returns a single static value
+// as static_value unless the parameter name is in staticValuesNames; the API
+// does not distinguish a single value from a one-element list.
+func flattenConfigRemediationConfigurationParameters(parameters map[string]*configservice.RemediationParameterValue, staticValuesNames map[string]bool) []interface{} {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	l := make([]interface{}, 0, len(parameters))
+
+	for _, name := range names {
+		value := parameters[name]
+		if value == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"name":           name,
+			"resource_value": "",
+			"static_value":   "",
+			"static_values":  []interface{}{},
+		}
+
+		if value.ResourceValue != nil {
+			m["resource_value"] = aws.StringValue(value.ResourceValue.Value)
+		}
+
+		if value.StaticValue != nil {
+			if len(value.StaticValue.Values) == 1 && !staticValuesNames[name] {
+				m["static_value"] = aws.StringValue(value.StaticValue.Values[0])
+			} else {
+				m["static_values"] = flattenStringList(value.StaticValue.Values)
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func configRemediationConfigurationParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+
+	if v, ok := m["resource_value"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["static_value"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["static_values"].([]interface{}); ok {
+		for _, s := range v {
+			buf.WriteString(fmt.Sprintf("%s-", s.(string)))
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
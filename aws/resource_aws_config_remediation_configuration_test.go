@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccConfigRemediationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_config_remediation_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig(rName, "Remediated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "config_rule_name", "aws_config_config_rule.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_id", "AWS-PublishSNSNotification"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "SSM_DOCUMENT"),
+					resource.TestCheckResourceAttr(resourceName, "target_version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigRemediationConfigurationConfig(rName, "Remediated again"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRemediationConfiguration_automatic(t *testing.T) {
+	resourceName := "aws_config_remediation_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfigAutomatic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.error_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_automatic_attempts", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retry_attempt_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAWSConfigRemediationConfiguration_expandParameters(t *testing.T) {
+	cases := []struct {
+		Input       []interface{}
+		Expected    map[string]*configservice.RemediationParameterValue
+		ErrExpected bool
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":           "BucketName",
+					"resource_value": "RESOURCE_ID",
+					"static_value":   "",
+					"static_values":  []interface{}{},
+				},
+				map[string]interface{}{
+					"name":           "SSEAlgorithm",
+					"resource_value": "",
+					"static_value":   "AES256",
+					"static_values":  []interface{}{},
+				},
+				map[string]interface{}{
+					"name":           "Regions",
+					"resource_value": "",
+					"static_value":   "",
+					"static_values":  []interface{}{"us-east-1", "us-west-2"},
+				},
+			},
+			Expected: map[string]*configservice.RemediationParameterValue{
+				"BucketName": {
+					ResourceValue: &configservice.ResourceValue{Value: aws.String("RESOURCE_ID")},
+				},
+				"SSEAlgorithm": {
+					StaticValue: &configservice.StaticValue{Values: []*string{aws.String("AES256")}},
+				},
+				"Regions": {
+					StaticValue: &configservice.StaticValue{Values: []*string{aws.String("us-east-1"), aws.String("us-west-2")}},
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":           "Both",
+					"resource_value": "RESOURCE_ID",
+					"static_value":   "value",
+					"static_values":  []interface{}{},
+				},
+			},
+			ErrExpected: true,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":           "Neither",
+					"resource_value": "",
+					"static_value":   "",
+					"static_values":  []interface{}{},
+				},
+			},
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandConfigRemediationConfigurationParameters(tc.Input)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error for input %#v", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Expected)
+		}
+	}
+}
+
+func TestAWSConfigRemediationConfiguration_flattenParameters(t *testing.T) {
+	parameters := map[string]*configservice.RemediationParameterValue{
+		"Message": {
+			StaticValue: &configservice.StaticValue{Values: []*string{aws.String("hello")}},
+		},
+		"Regions": {
+			StaticValue: &configservice.StaticValue{Values: []*string{aws.String("us-east-1")}},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":           "Message",
+			"resource_value": "",
+			"static_value":   "hello",
+			"static_values":  []interface{}{},
+		},
+		map[string]interface{}{
+			"name":           "Regions",
+			"resource_value": "",
+			"static_value":   "",
+			"static_values":  []interface{}{"us-east-1"},
+		},
+	}
+
+	output := flattenConfigRemediationConfigurationParameters(parameters, map[string]bool{"Regions": true})
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+}
+
+func testAccCheckConfigRemediationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.RemediationConfigurations) == 0 {
+			return fmt.Errorf("Config Remediation Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigRemediationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_remediation_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.RemediationConfigurations) > 0 {
+			return fmt.Errorf("Config Remediation Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigRemediationConfigurationConfigBase(rName string) string {
+	return testAccConfigConformancePackConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+`, rName)
+}
+
+func testAccConfigRemediationConfigurationConfig(rName, message string) string {
+	return testAccConfigRemediationConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "${aws_config_config_rule.test.name}"
+  resource_type    = "AWS::S3::Bucket"
+  target_id        = "AWS-PublishSNSNotification"
+  target_type      = "SSM_DOCUMENT"
+  target_version   = "1"
+
+  parameter {
+    name         = "AutomationAssumeRole"
+    static_value = "${aws_iam_role.test.arn}"
+  }
+
+  parameter {
+    name         = "Message"
+    static_value = %[1]q
+  }
+
+  parameter {
+    name         = "TopicArn"
+    static_value = "${aws_sns_topic.test.arn}"
+  }
+}
+`, message)
+}
+
+func testAccConfigRemediationConfigurationConfigAutomatic(rName string) string {
+	return testAccConfigRemediationConfigurationConfigBase(rName) + `
+resource "aws_config_remediation_configuration" "test" {
+  automatic                  = true
+  config_rule_name           = "${aws_config_config_rule.test.name}"
+  maximum_automatic_attempts = 5
+  resource_type              = "AWS::S3::Bucket"
+  retry_attempt_seconds      = 600
+  target_id                  = "AWS-EnableS3BucketEncryption"
+  target_type                = "SSM_DOCUMENT"
+
+  parameter {
+    name         = "AutomationAssumeRole"
+    static_value = "${aws_iam_role.test.arn}"
+  }
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+
+  parameter {
+    name         = "SSEAlgorithm"
+    static_value = "AES256"
+  }
+
+  execution_controls {
+    ssm_controls {
+      concurrent_execution_rate_percentage = 25
+      error_percentage                     = 20
+    }
+  }
+}
+`
+}
@@ -13,6 +13,11 @@ func TestAccAWSConfig(t *testing.T) {
 			"importAws":    testAccConfigConfigRule_importAws,
 			"importLambda": testAccConfigConfigRule_importLambda,
 		},
+		"ConformancePack": {
+			"basic":           testAccConfigConformancePack_basic,
+			"inputParameters": testAccConfigConformancePack_inputParameters,
+			"s3Template":      testAccConfigConformancePack_s3Template,
+		},
 		"ConfigurationRecorderStatus": {
 			"basic":        testAccConfigConfigurationRecorderStatus_basic,
 			"startEnabled": testAccConfigConfigurationRecorderStatus_startEnabled,
@@ -28,6 +33,16 @@ func TestAccAWSConfig(t *testing.T) {
 			"allParams":   testAccConfigDeliveryChannel_allParams,
 			"importBasic": testAccConfigDeliveryChannel_importBasic,
 		},
+		"OrganizationCustomRule": {
+			"basic": testAccConfigOrganizationCustomRule_basic,
+		},
+		"OrganizationManagedRule": {
+			"basic": testAccConfigOrganizationManagedRule_basic,
+		},
+		"RemediationConfiguration": {
+			"basic":     testAccConfigRemediationConfiguration_basic,
+			"automatic": testAccConfigRemediationConfiguration_automatic,
+		},
 	}
 
 	for group, m := range testCases {
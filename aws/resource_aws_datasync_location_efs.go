@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDataSyncLocationEfs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationEfsCreate,
+		Read:   resourceAwsDataSyncLocationEfsRead,
+		Update: resourceAwsDataSyncLocationEfsUpdate,
+		Delete: resourceAwsDataSyncLocationEfsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ec2_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_arns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+						"subnet_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"efs_file_system_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"subdirectory": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "/",
+				DiffSuppressFunc: dataSyncSubdirectoryDiffSuppress,
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationEfsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateLocationEfsInput{
+		Ec2Config:        expandDataSyncEc2Config(d.Get("ec2_config").([]interface{})),
+		EfsFilesystemArn: aws.String(d.Get("efs_file_system_arn").(string)),
+		Subdirectory:     aws.String(d.Get("subdirectory").(string)),
+		Tags:             tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating DataSync EFS location: %s", input)
+	output, err := conn.CreateLocationEfs(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync EFS location: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationEfsRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeLocationEfs(&datasync.DescribeLocationEfsInput{
+		LocationArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync EFS location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync EFS location (%s): %s", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncLocationSubdirectoryFromURI(aws.StringValue(output.LocationUri))
+	if err != nil {
+		return err
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync EFS location (%s): %s", d.Id(), err)
+	}
+
+	// The file system ARN is not returned. The URI host has the form
+	// REGION.FILE_SYSTEM_ID and the account matches the location's.
+	if u, err := url.Parse(aws.StringValue(output.LocationUri)); err == nil {
+		if parts := strings.SplitN(u.Host, ".", 2); len(parts) == 2 {
+			if locationARN, err := arn.Parse(d.Id()); err == nil {
+				fsARN := arn.ARN{
+					Partition: locationARN.Partition,
+					Region:    parts[0],
+					Service:   "elasticfilesystem",
+					AccountID: locationARN.AccountID,
+					Resource:  fmt.Sprintf("file-system/%s", parts[1]),
+				}
+				d.Set("efs_file_system_arn", fsARN.String())
+			}
+		}
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+
+	if err := d.Set("ec2_config", flattenDataSyncEc2Config(output.Ec2Config)); err != nil {
+		return fmt.Errorf("error setting ec2_config: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync EFS location (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncLocationEfsRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationEfsDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteDataSyncLocation(meta.(*AWSClient).datasyncconn, d.Id(), "EFS")
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSyncLocationEfs_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_location_efs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationEfsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:datasync:[^:]+:\d{12}:location/loc-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "ec2_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_config.0.security_group_arns.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "ec2_config.0.subnet_arn", regexp.MustCompile(`:subnet/subnet-.+$`)),
+					resource.TestMatchResourceAttr(resourceName, "efs_file_system_arn", regexp.MustCompile(`:file-system/fs-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^efs://.+/`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationEfs_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_location_efs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationEfsConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncLocationEfsConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSyncLocationEfsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf-acc-datasync-location-efs"
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-datasync-location-efs"
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-datasync-location-efs"
+  }
+}
+
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_mount_target" "test" {
+  file_system_id  = "${aws_efs_file_system.test.id}"
+  security_groups = ["${aws_security_group.test.id}"]
+  subnet_id       = "${aws_subnet.test.id}"
+}
+
+locals {
+  efs_file_system_arn = "arn:${data.aws_partition.current.partition}:elasticfilesystem:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:file-system/${aws_efs_mount_target.test.file_system_id}"
+  subnet_arn          = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:subnet/${aws_subnet.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSDataSyncLocationEfsConfig(rName string) string {
+	return testAccAWSDataSyncLocationEfsConfigBase(rName) + `
+resource "aws_datasync_location_efs" "test" {
+  efs_file_system_arn = "${local.efs_file_system_arn}"
+
+  ec2_config {
+    security_group_arns = ["${aws_security_group.test.arn}"]
+    subnet_arn          = "${local.subnet_arn}"
+  }
+}
+`
+}
+
+func testAccAWSDataSyncLocationEfsConfigTags(rName, tagValue string) string {
+	return testAccAWSDataSyncLocationEfsConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_efs" "test" {
+  efs_file_system_arn = "${local.efs_file_system_arn}"
+
+  ec2_config {
+    security_group_arns = ["${aws_security_group.test.arn}"]
+    subnet_arn          = "${local.subnet_arn}"
+  }
+
+  tags {
+    Key1 = %[1]q
+  }
+}
+`, tagValue)
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDataSyncLocationFsxWindowsFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationFsxWindowsFileSystemCreate,
+		Read:   resourceAwsDataSyncLocationFsxWindowsFileSystemRead,
+		Update: resourceAwsDataSyncLocationFsxWindowsFileSystemUpdate,
+		Delete: resourceAwsDataSyncLocationFsxWindowsFileSystemDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"fsx_filesystem_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"security_group_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"subdirectory": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: dataSyncSubdirectoryDiffSuppress,
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationFsxWindowsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateLocationFsxWindowsInput{
+		FsxFilesystemArn:  aws.String(d.Get("fsx_filesystem_arn").(string)),
+		Password:          aws.String(d.Get("password").(string)),
+		SecurityGroupArns: expandStringSet(d.Get("security_group_arns").(*schema.Set)),
+		Tags:              tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+		User:              aws.String(d.Get("user").(string)),
+	}
+
+	if v, ok := d.GetOk("domain"); ok {
+		input.Domain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subdirectory"); ok {
+		input.Subdirectory = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync FSx Windows File System location")
+	output, err := conn.CreateLocationFsxWindows(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync FSx Windows File System location: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationFsxWindowsFileSystemRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationFsxWindowsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeLocationFsxWindows(&datasync.DescribeLocationFsxWindowsInput{
+		LocationArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync FSx Windows File System location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync FSx Windows File System location (%s): %s", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncLocationSubdirectoryFromURI(aws.StringValue(output.LocationUri))
+	if err != nil {
+		return err
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync FSx Windows File System location (%s): %s", d.Id(), err)
+	}
+
+	// As with EFS locations, the URI host has the form REGION.FILE_SYSTEM_ID.
+	if u, err := url.Parse(aws.StringValue(output.LocationUri)); err == nil {
+		if parts := strings.SplitN(u.Host, ".", 2); len(parts) == 2 {
+			if locationARN, err := arn.Parse(d.Id()); err == nil {
+				fsARN := arn.ARN{
+					Partition: locationARN.Partition,
+					Region:    parts[0],
+					Service:   "fsx",
+					AccountID: locationARN.AccountID,
+					Resource:  fmt.Sprintf("file-system/%s", parts[1]),
+				}
+				d.Set("fsx_filesystem_arn", fsARN.String())
+			}
+		}
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("creation_time", aws.TimeValue(output.CreationTime).Format(time.RFC3339))
+	d.Set("domain", output.Domain)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+	d.Set("user", output.User)
+
+	if err := d.Set("security_group_arns", flattenStringList(output.SecurityGroupArns)); err != nil {
+		return fmt.Errorf("error setting security_group_arns: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationFsxWindowsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync FSx Windows File System location (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncLocationFsxWindowsFileSystemRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationFsxWindowsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteDataSyncLocation(meta.(*AWSClient).datasyncconn, d.Id(), "FSx Windows File System")
+}
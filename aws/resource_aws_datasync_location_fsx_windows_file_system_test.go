@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSyncLocationFsxWindowsFileSystem_basic(t *testing.T) {
+	resourceName := "aws_datasync_location_fsx_windows_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationFsxWindowsFileSystemConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:datasync:[^:]+:\d{12}:location/loc-.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "fsx_filesystem_arn", "aws_fsx_windows_file_system.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^fsxw://.+/`)),
+					resource.TestCheckResourceAttr(resourceName, "user", "Admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSyncLocationFsxWindowsFileSystemConfig() string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + `
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-datasync-location-fsx-windows"
+  }
+}
+
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  security_group_ids  = ["${aws_security_group.test.id}"]
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+}
+
+resource "aws_datasync_location_fsx_windows_file_system" "test" {
+  fsx_filesystem_arn  = "${aws_fsx_windows_file_system.test.arn}"
+  password            = "${aws_directory_service_directory.test.password}"
+  security_group_arns = ["${aws_security_group.test.arn}"]
+  user                = "Admin"
+}
+`
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDataSyncLocationNfs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationNfsCreate,
+		Read:   resourceAwsDataSyncLocationNfsRead,
+		Update: resourceAwsDataSyncLocationNfsUpdate,
+		Delete: resourceAwsDataSyncLocationNfsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mount_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      datasync.NfsVersionAutomatic,
+							ValidateFunc: validation.StringInSlice(datasync.NfsVersion_Values(), false),
+						},
+					},
+				},
+			},
+			"on_prem_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_arns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+			},
+			"server_hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subdirectory": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: dataSyncSubdirectoryDiffSuppress,
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationNfsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateLocationNfsInput{
+		OnPremConfig:   expandDataSyncOnPremConfig(d.Get("on_prem_config").([]interface{})),
+		ServerHostname: aws.String(d.Get("server_hostname").(string)),
+		Subdirectory:   aws.String(d.Get("subdirectory").(string)),
+		Tags:           tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("mount_options"); ok {
+		input.MountOptions = expandDataSyncNfsMountOptions(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync NFS location: %s", input)
+	output, err := conn.CreateLocationNfs(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync NFS location: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationNfsRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationNfsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeLocationNfs(&datasync.DescribeLocationNfsInput{
+		LocationArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync NFS location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync NFS location (%s): %s", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncLocationSubdirectoryFromURI(aws.StringValue(output.LocationUri))
+	if err != nil {
+		return err
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync NFS location (%s): %s", d.Id(), err)
+	}
+
+	if u, err := url.Parse(aws.StringValue(output.LocationUri)); err == nil {
+		d.Set("server_hostname", u.Host)
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+
+	if err := d.Set("mount_options", flattenDataSyncNfsMountOptions(output.MountOptions)); err != nil {
+		return fmt.Errorf("error setting mount_options: %s", err)
+	}
+
+	if err := d.Set("on_prem_config", flattenDataSyncOnPremConfig(output.OnPremConfig)); err != nil {
+		return fmt.Errorf("error setting on_prem_config: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationNfsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if d.HasChange("mount_options") || d.HasChange("on_prem_config") || d.HasChange("subdirectory") {
+		input := &datasync.UpdateLocationNfsInput{
+			LocationArn:  aws.String(d.Id()),
+			MountOptions: expandDataSyncNfsMountOptions(d.Get("mount_options").([]interface{})),
+			OnPremConfig: expandDataSyncOnPremConfig(d.Get("on_prem_config").([]interface{})),
+			Subdirectory: aws.String(d.Get("subdirectory").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating DataSync NFS location: %s", input)
+		if _, err := conn.UpdateLocationNfs(input); err != nil {
+			return fmt.Errorf("error updating DataSync NFS location (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync NFS location (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncLocationNfsRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationNfsDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteDataSyncLocation(meta.(*AWSClient).datasyncconn, d.Id(), "NFS")
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// testAccAWSDataSyncAgentArnFromEnv returns the ARN of an activated DataSync
+// agent. NFS and SMB locations cannot be created without one.
+func testAccAWSDataSyncAgentArnFromEnv(t *testing.T) string {
+	if os.Getenv("DATASYNC_AGENT_ARN") == "" {
+		t.Skip(
+			"Environment variable DATASYNC_AGENT_ARN is not set. " +
+				"NFS and SMB location testing requires an activated " +
+				"DataSync agent in the testing region.")
+	}
+	return os.Getenv("DATASYNC_AGENT_ARN")
+}
+
+func TestAccAWSDataSyncLocationNfs_basic(t *testing.T) {
+	agentARN := testAccAWSDataSyncAgentArnFromEnv(t)
+	resourceName := "aws_datasync_location_nfs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationNfsConfig(agentARN, "/export1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.0.version", "AUTOMATIC"),
+					resource.TestCheckResourceAttr(resourceName, "on_prem_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_prem_config.0.agent_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_hostname", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/export1/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^nfs://example.com/export1/$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataSyncLocationNfsConfig(agentARN, "/export2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/export2/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationNfs_mountOptions(t *testing.T) {
+	agentARN := testAccAWSDataSyncAgentArnFromEnv(t)
+	resourceName := "aws_datasync_location_nfs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationNfsConfigMountOptions(agentARN, "NFS4_0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.0.version", "NFS4_0"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncLocationNfsConfigMountOptions(agentARN, "NFS4_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.0.version", "NFS4_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSyncLocationNfsConfig(agentARN, subdirectory string) string {
+	return fmt.Sprintf(`
+resource "aws_datasync_location_nfs" "test" {
+  server_hostname = "example.com"
+  subdirectory    = %[2]q
+
+  on_prem_config {
+    agent_arns = [%[1]q]
+  }
+}
+`, agentARN, subdirectory)
+}
+
+func testAccAWSDataSyncLocationNfsConfigMountOptions(agentARN, version string) string {
+	return fmt.Sprintf(`
+resource "aws_datasync_location_nfs" "test" {
+  server_hostname = "example.com"
+  subdirectory    = "/export"
+
+  mount_options {
+    version = %[2]q
+  }
+
+  on_prem_config {
+    agent_arns = [%[1]q]
+  }
+}
+`, agentARN, version)
+}
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDataSyncLocationS3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationS3Create,
+		Read:   resourceAwsDataSyncLocationS3Read,
+		Update: resourceAwsDataSyncLocationS3Update,
+		Delete: resourceAwsDataSyncLocationS3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"s3_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"s3_storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(datasync.S3StorageClass_Values(), false),
+			},
+			"subdirectory": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: dataSyncSubdirectoryDiffSuppress,
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationS3Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateLocationS3Input{
+		S3BucketArn:  aws.String(d.Get("s3_bucket_arn").(string)),
+		S3Config:     expandDataSyncS3Config(d.Get("s3_config").([]interface{})),
+		Subdirectory: aws.String(d.Get("subdirectory").(string)),
+		Tags:         tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("agent_arns"); ok {
+		input.AgentArns = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("s3_storage_class"); ok {
+		input.S3StorageClass = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync S3 location: %s", input)
+
+	// The bucket access role may not have propagated yet.
+	var output *datasync.CreateLocationS3Output
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateLocationS3(input)
+
+		if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "Unable to assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating DataSync S3 location: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationS3Read(d, meta)
+}
+
+func resourceAwsDataSyncLocationS3Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeLocationS3(&datasync.DescribeLocationS3Input{
+		LocationArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync S3 location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync S3 location (%s): %s", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncLocationSubdirectoryFromURI(aws.StringValue(output.LocationUri))
+	if err != nil {
+		return err
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync S3 location (%s): %s", d.Id(), err)
+	}
+
+	// The bucket ARN is not returned, so it is rebuilt from the URI for
+	// imported locations.
+	if u, err := url.Parse(aws.StringValue(output.LocationUri)); err == nil && u.Host != "" {
+		bucketARN := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   "s3",
+			Resource:  u.Host,
+		}
+		d.Set("s3_bucket_arn", bucketARN.String())
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("s3_storage_class", output.S3StorageClass)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+
+	if err := d.Set("agent_arns", flattenStringList(output.AgentArns)); err != nil {
+		return fmt.Errorf("error setting agent_arns: %s", err)
+	}
+
+	if err := d.Set("s3_config", flattenDataSyncS3Config(output.S3Config)); err != nil {
+		return fmt.Errorf("error setting s3_config: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationS3Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync S3 location (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncLocationS3Read(d, meta)
+}
+
+func resourceAwsDataSyncLocationS3Delete(d *schema.ResourceData, meta interface{}) error {
+	return deleteDataSyncLocation(meta.(*AWSClient).datasyncconn, d.Id(), "S3")
+}
@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDataSyncLocationS3_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_location_s3.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationS3Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:datasync:[^:]+:\d{12}:location/loc-.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "s3_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_config.0.bucket_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/test/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^s3://.+/`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationS3_storageClass(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_location_s3.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationS3ConfigStorageClass(rName, "STANDARD_IA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_storage_class", "STANDARD_IA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationS3_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_location_s3.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationS3ConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncLocationS3ConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAWSDataSyncLocationDestroy covers every aws_datasync_location_*
+// resource type, as all locations share the ListLocations API.
+func testAccCheckAWSDataSyncLocationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "aws_datasync_location_") {
+			continue
+		}
+
+		found, err := testAccAWSDataSyncLocationFound(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("DataSync location (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDataSyncLocationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataSync location ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+		found, err := testAccAWSDataSyncLocationFound(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("DataSync location (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDataSyncLocationFound(conn *datasync.DataSync, arn string) (bool, error) {
+	found := false
+	input := &datasync.ListLocationsInput{}
+	err := conn.ListLocationsPages(input, func(page *datasync.ListLocationsOutput, lastPage bool) bool {
+		for _, location := range page.Locations {
+			if aws.StringValue(location.LocationArn) == arn {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSDataSyncLocationS3ConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "datasync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:*"],
+      "Resource": ["*"]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAWSDataSyncLocationS3Config(rName string) string {
+	return testAccAWSDataSyncLocationS3ConfigBase(rName) + `
+resource "aws_datasync_location_s3" "test" {
+  s3_bucket_arn = "${aws_s3_bucket.test.arn}"
+  subdirectory  = "/test"
+
+  s3_config {
+    bucket_access_role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`
+}
+
+func testAccAWSDataSyncLocationS3ConfigStorageClass(rName, storageClass string) string {
+	return testAccAWSDataSyncLocationS3ConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_s3" "test" {
+  s3_bucket_arn    = "${aws_s3_bucket.test.arn}"
+  s3_storage_class = %[1]q
+  subdirectory     = "/test"
+
+  s3_config {
+    bucket_access_role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, storageClass)
+}
+
+func testAccAWSDataSyncLocationS3ConfigTags(rName, tagValue string) string {
+	return testAccAWSDataSyncLocationS3ConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_location_s3" "test" {
+  s3_bucket_arn = "${aws_s3_bucket.test.arn}"
+  subdirectory  = "/test"
+
+  s3_config {
+    bucket_access_role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  tags {
+    Key1 = %[1]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, tagValue)
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDataSyncLocationSmb() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationSmbCreate,
+		Read:   resourceAwsDataSyncLocationSmbRead,
+		Update: resourceAwsDataSyncLocationSmbUpdate,
+		Delete: resourceAwsDataSyncLocationSmbDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mount_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      datasync.SmbVersionAutomatic,
+							ValidateFunc: validation.StringInSlice(datasync.SmbVersion_Values(), false),
+						},
+					},
+				},
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"server_hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subdirectory": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: dataSyncSubdirectoryDiffSuppress,
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationSmbCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateLocationSmbInput{
+		AgentArns:      expandStringSet(d.Get("agent_arns").(*schema.Set)),
+		Password:       aws.String(d.Get("password").(string)),
+		ServerHostname: aws.String(d.Get("server_hostname").(string)),
+		Subdirectory:   aws.String(d.Get("subdirectory").(string)),
+		Tags:           tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+		User:           aws.String(d.Get("user").(string)),
+	}
+
+	if v, ok := d.GetOk("domain"); ok {
+		input.Domain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mount_options"); ok {
+		input.MountOptions = expandDataSyncSmbMountOptions(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync SMB location")
+	output, err := conn.CreateLocationSmb(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync SMB location: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationSmbRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationSmbRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeLocationSmb(&datasync.DescribeLocationSmbInput{
+		LocationArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync SMB location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync SMB location (%s): %s", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncLocationSubdirectoryFromURI(aws.StringValue(output.LocationUri))
+	if err != nil {
+		return err
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync SMB location (%s): %s", d.Id(), err)
+	}
+
+	if u, err := url.Parse(aws.StringValue(output.LocationUri)); err == nil {
+		d.Set("server_hostname", u.Host)
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("domain", output.Domain)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+	d.Set("user", output.User)
+
+	if err := d.Set("agent_arns", flattenStringList(output.AgentArns)); err != nil {
+		return fmt.Errorf("error setting agent_arns: %s", err)
+	}
+
+	if err := d.Set("mount_options", flattenDataSyncSmbMountOptions(output.MountOptions)); err != nil {
+		return fmt.Errorf("error setting mount_options: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationSmbUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if d.HasChange("agent_arns") || d.HasChange("domain") || d.HasChange("mount_options") ||
+		d.HasChange("password") || d.HasChange("subdirectory") || d.HasChange("user") {
+		input := &datasync.UpdateLocationSmbInput{
+			AgentArns:    expandStringSet(d.Get("agent_arns").(*schema.Set)),
+			LocationArn:  aws.String(d.Id()),
+			MountOptions: expandDataSyncSmbMountOptions(d.Get("mount_options").([]interface{})),
+			Password:     aws.String(d.Get("password").(string)),
+			Subdirectory: aws.String(d.Get("subdirectory").(string)),
+			User:         aws.String(d.Get("user").(string)),
+		}
+
+		if v, ok := d.GetOk("domain"); ok {
+			input.Domain = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating DataSync SMB location: %s", d.Id())
+		if _, err := conn.UpdateLocationSmb(input); err != nil {
+			return fmt.Errorf("error updating DataSync SMB location (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync SMB location (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncLocationSmbRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationSmbDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteDataSyncLocation(meta.(*AWSClient).datasyncconn, d.Id(), "SMB")
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSyncLocationSmb_basic(t *testing.T) {
+	agentARN := testAccAWSDataSyncAgentArnFromEnv(t)
+	resourceName := "aws_datasync_location_smb.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationSmbConfig(agentARN, "/share1", "user1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "agent_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mount_options.0.version", "AUTOMATIC"),
+					resource.TestCheckResourceAttr(resourceName, "server_hostname", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/share1/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^smb://example.com/share1/$`)),
+					resource.TestCheckResourceAttr(resourceName, "user", "user1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccAWSDataSyncLocationSmbConfig(agentARN, "/share2", "user2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/share2/"),
+					resource.TestCheckResourceAttr(resourceName, "user", "user2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSyncLocationSmbConfig(agentARN, subdirectory, user string) string {
+	return fmt.Sprintf(`
+resource "aws_datasync_location_smb" "test" {
+  agent_arns      = [%[1]q]
+  password        = "ZaphodBeeblebroxPW"
+  server_hostname = "example.com"
+  subdirectory    = %[2]q
+  user            = %[3]q
+}
+`, agentARN, subdirectory, user)
+}
@@ -0,0 +1,364 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDataSyncTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncTaskCreate,
+		Read:   resourceAwsDataSyncTaskRead,
+		Update: resourceAwsDataSyncTaskUpdate,
+		Delete: resourceAwsDataSyncTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_log_group_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"destination_location_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"excludes": dataSyncFilterRuleSchema(),
+			"includes": dataSyncFilterRuleSchema(),
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"atime": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.Atime_Values(), false),
+						},
+						"bytes_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(-1),
+						},
+						"gid": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.Gid_Values(), false),
+						},
+						"log_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.LogLevel_Values(), false),
+						},
+						"mtime": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.Mtime_Values(), false),
+						},
+						"overwrite_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.OverwriteMode_Values(), false),
+						},
+						"posix_permissions": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.PosixPermissions_Values(), false),
+						},
+						"preserve_deleted_files": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.PreserveDeletedFiles_Values(), false),
+						},
+						"preserve_devices": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.PreserveDevices_Values(), false),
+						},
+						"task_queueing": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.TaskQueueing_Values(), false),
+						},
+						"transfer_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.TransferMode_Values(), false),
+						},
+						"uid": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.Uid_Values(), false),
+						},
+						"verify_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.VerifyMode_Values(), false),
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_expression": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^(cron|rate)\(.+\)$`),
+								"must be a cron or rate expression",
+							),
+						},
+					},
+				},
+			},
+			"source_location_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSyncFilterRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"filter_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      datasync.FilterTypeSimplePattern,
+					ValidateFunc: validation.StringInSlice(datasync.FilterType_Values(), false),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.CreateTaskInput{
+		DestinationLocationArn: aws.String(d.Get("destination_location_arn").(string)),
+		Options:                expandDataSyncOptions(d.Get("options").([]interface{})),
+		Schedule:               expandDataSyncTaskSchedule(d.Get("schedule").([]interface{})),
+		SourceLocationArn:      aws.String(d.Get("source_location_arn").(string)),
+		Tags:                   tagsFromMapDataSync(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("cloudwatch_log_group_arn"); ok {
+		input.CloudWatchLogGroupArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("excludes"); ok {
+		input.Excludes = expandDataSyncFilterRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("includes"); ok {
+		input.Includes = expandDataSyncFilterRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync task: %s", input)
+	output, err := conn.CreateTask(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync task: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TaskArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datasync.TaskStatusCreating},
+		Target:  []string{datasync.TaskStatusAvailable, datasync.TaskStatusRunning},
+		Refresh: resourceAwsDataSyncTaskStatusRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for DataSync task (%s) to become available", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DataSync task (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncTaskRead(d, meta)
+}
+
+func resourceAwsDataSyncTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeTask(&datasync.DescribeTaskInput{
+		TaskArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync task (%s): %s", d.Id(), err)
+	}
+
+	tags, err := listDataSyncTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TaskArn)
+	d.Set("cloudwatch_log_group_arn", output.CloudWatchLogGroupArn)
+	d.Set("destination_location_arn", output.DestinationLocationArn)
+	d.Set("name", output.Name)
+	d.Set("source_location_arn", output.SourceLocationArn)
+	d.Set("status", output.Status)
+
+	if err := d.Set("excludes", flattenDataSyncFilterRules(output.Excludes)); err != nil {
+		return fmt.Errorf("error setting excludes: %s", err)
+	}
+
+	if err := d.Set("includes", flattenDataSyncFilterRules(output.Includes)); err != nil {
+		return fmt.Errorf("error setting includes: %s", err)
+	}
+
+	if err := d.Set("options", flattenDataSyncOptions(output.Options)); err != nil {
+		return fmt.Errorf("error setting options: %s", err)
+	}
+
+	if err := d.Set("schedule", flattenDataSyncTaskSchedule(output.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapDataSync(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if d.HasChange("cloudwatch_log_group_arn") || d.HasChange("excludes") || d.HasChange("includes") ||
+		d.HasChange("name") || d.HasChange("options") || d.HasChange("schedule") {
+		input := &datasync.UpdateTaskInput{
+			CloudWatchLogGroupArn: aws.String(d.Get("cloudwatch_log_group_arn").(string)),
+			Excludes:              expandDataSyncFilterRules(d.Get("excludes").([]interface{})),
+			Includes:              expandDataSyncFilterRules(d.Get("includes").([]interface{})),
+			Name:                  aws.String(d.Get("name").(string)),
+			Options:               expandDataSyncOptions(d.Get("options").([]interface{})),
+			TaskArn:               aws.String(d.Id()),
+		}
+
+		// An empty schedule expression removes the schedule.
+		input.Schedule = expandDataSyncTaskSchedule(d.Get("schedule").([]interface{}))
+		if input.Schedule == nil {
+			input.Schedule = &datasync.TaskSchedule{
+				ScheduleExpression: aws.String(""),
+			}
+		}
+
+		log.Printf("[DEBUG] Updating DataSync task: %s", input)
+		if _, err := conn.UpdateTask(input); err != nil {
+			return fmt.Errorf("error updating DataSync task (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDataSync(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating DataSync task (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDataSyncTaskRead(d, meta)
+}
+
+func resourceAwsDataSyncTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	log.Printf("[DEBUG] Deleting DataSync task: %s", d.Id())
+	_, err := conn.DeleteTask(&datasync.DeleteTaskInput{
+		TaskArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting DataSync task (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncTaskStatusRefreshFunc(conn *datasync.DataSync, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeTask(&datasync.DescribeTaskInput{
+			TaskArn: aws.String(arn),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.Status)
+		if status == datasync.TaskStatusUnavailable {
+			return output, status, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorCode), aws.StringValue(output.ErrorDetail))
+		}
+
+		return output, status, nil
+	}
+}
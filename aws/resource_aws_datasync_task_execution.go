@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsDataSyncTaskExecution starts a run of a DataSync task. A new
+// execution is started whenever a value in triggers changes.
+func resourceAwsDataSyncTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncTaskExecutionCreate,
+		Read:   resourceAwsDataSyncTaskExecutionRead,
+		Delete: resourceAwsDataSyncTaskExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"files_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  datasync.FilterTypeSimplePattern,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncTaskExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(d.Get("task_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("includes"); ok {
+		input.Includes = expandDataSyncFilterRules(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting DataSync task execution: %s", input)
+	output, err := conn.StartTaskExecution(input)
+	if err != nil {
+		return fmt.Errorf("error starting DataSync task execution: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TaskExecutionArn))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				datasync.TaskExecutionStatusQueued,
+				datasync.TaskExecutionStatusLaunching,
+				datasync.TaskExecutionStatusPreparing,
+				datasync.TaskExecutionStatusTransferring,
+				datasync.TaskExecutionStatusVerifying,
+			},
+			Target:  []string{datasync.TaskExecutionStatusSuccess},
+			Refresh: resourceAwsDataSyncTaskExecutionStatusRefreshFunc(conn, d.Id()),
+			Timeout: d.Timeout(schema.TimeoutCreate),
+			Delay:   10 * time.Second,
+		}
+
+		log.Printf("[DEBUG] Waiting for DataSync task execution (%s) to complete", d.Id())
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for DataSync task execution (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDataSyncTaskExecutionRead(d, meta)
+}
+
+func resourceAwsDataSyncTaskExecutionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	output, err := conn.DescribeTaskExecution(&datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		log.Printf("[WARN] DataSync task execution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DataSync task execution (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TaskExecutionArn)
+	d.Set("bytes_transferred", int(aws.Int64Value(output.BytesTransferred)))
+	d.Set("files_transferred", int(aws.Int64Value(output.FilesTransferred)))
+	d.Set("status", output.Status)
+
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncTaskExecutionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	// Finished executions cannot be deleted; only a running one is cancelled.
+	switch d.Get("status").(string) {
+	case datasync.TaskExecutionStatusSuccess, datasync.TaskExecutionStatusError:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling DataSync task execution: %s", d.Id())
+	_, err := conn.CancelTaskExecution(&datasync.CancelTaskExecutionInput{
+		TaskExecutionArn: aws.String(d.Id()),
+	})
+	if isDataSyncNotFound(err) {
+		return nil
+	}
+	// The execution may have finished since it was last read.
+	if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "") {
+		log.Printf("[WARN] Unable to cancel DataSync task execution (%s): %s", d.Id(), err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error cancelling DataSync task execution (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncTaskExecutionStatusRefreshFunc(conn *datasync.DataSync, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeTaskExecution(&datasync.DescribeTaskExecutionInput{
+			TaskExecutionArn: aws.String(arn),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.Status)
+		if status == datasync.TaskExecutionStatusError && output.Result != nil {
+			return output, status, fmt.Errorf("%s: %s", aws.StringValue(output.Result.ErrorCode), aws.StringValue(output.Result.ErrorDetail))
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDataSyncTaskExecution_basic(t *testing.T) {
+	var execution1, execution2 datasync.DescribeTaskExecutionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task_execution.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskExecutionConfig(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExecutionExists(resourceName, &execution1),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:task/task-.+/execution/exec-.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCESS"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_arn", "aws_datasync_task.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncTaskExecutionConfig(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExecutionExists(resourceName, &execution2),
+					testAccCheckAWSDataSyncTaskExecutionRecreated(&execution1, &execution2),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDataSyncTaskExecutionExists(n string, execution *datasync.DescribeTaskExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataSync task execution ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+		output, err := conn.DescribeTaskExecution(&datasync.DescribeTaskExecutionInput{
+			TaskExecutionArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*execution = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDataSyncTaskExecutionRecreated(i, j *datasync.DescribeTaskExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TaskExecutionArn) == aws.StringValue(j.TaskExecutionArn) {
+			return fmt.Errorf("DataSync task execution was not started again")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDataSyncTaskExecutionConfig(rName, trigger string) string {
+	return testAccAWSDataSyncTaskConfig(rName) + fmt.Sprintf(`
+resource "aws_datasync_task_execution" "test" {
+  task_arn = "${aws_datasync_task.test.arn}"
+
+  triggers {
+    run = %[1]q
+  }
+}
+`, trigger)
+}
@@ -0,0 +1,372 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDataSyncTask_basic(t *testing.T) {
+	var task1 datasync.DescribeTaskOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task1),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:datasync:[^:]+:\d{12}:task/task-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_log_group_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_location_arn", "aws_datasync_location_s3.destination", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.bytes_per_second", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.verify_mode", "POINT_IN_TIME_CONSISTENT"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_location_arn", "aws_datasync_location_s3.source", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncTask_options(t *testing.T) {
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskConfigOptions(rName, 1048576, "ONLY_FILES_TRANSFERRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.bytes_per_second", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.verify_mode", "ONLY_FILES_TRANSFERRED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfigOptions(rName, -1, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					testAccCheckAWSDataSyncTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "options.0.bytes_per_second", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.verify_mode", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncTask_filters(t *testing.T) {
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskConfigFilters(rName, "/folder1|/folder2", "*.tmp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.filter_type", "SIMPLE_PATTERN"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.value", "*.tmp"),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.filter_type", "SIMPLE_PATTERN"),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.value", "/folder1|/folder2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfigFilters(rName, "/folder3", "*.bak|*.tmp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					testAccCheckAWSDataSyncTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.value", "*.bak|*.tmp"),
+					resource.TestCheckResourceAttr(resourceName, "includes.0.value", "/folder3"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "includes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncTask_schedule(t *testing.T) {
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskConfigSchedule(rName, "cron(0 12 ? * SUN,WED *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.schedule_expression", "cron(0 12 ? * SUN,WED *)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfigSchedule(rName, "cron(0 12 ? * SUN,MON *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					testAccCheckAWSDataSyncTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.schedule_expression", "cron(0 12 ? * SUN,MON *)"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncTask_tags(t *testing.T) {
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datasync_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncTaskConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncTaskConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncTaskExists(resourceName, &task2),
+					testAccCheckAWSDataSyncTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDataSyncTaskDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datasync_task" {
+			continue
+		}
+
+		_, err := conn.DescribeTask(&datasync.DescribeTaskInput{
+			TaskArn: aws.String(rs.Primary.ID),
+		})
+		if isDataSyncNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataSync task (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDataSyncTaskExists(n string, task *datasync.DescribeTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataSync task ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+		output, err := conn.DescribeTask(&datasync.DescribeTaskInput{
+			TaskArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*task = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDataSyncTaskNotRecreated(i, j *datasync.DescribeTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return fmt.Errorf("DataSync task was recreated")
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSDataSyncTaskConfigBase copies between two S3 locations, which
+// needs neither an agent nor a VPC.
+func testAccAWSDataSyncTaskConfigBase(rName string) string {
+	return testAccAWSDataSyncLocationS3ConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "destination" {
+  bucket        = "%[1]s-destination"
+  force_destroy = true
+}
+
+resource "aws_datasync_location_s3" "source" {
+  s3_bucket_arn = "${aws_s3_bucket.test.arn}"
+  subdirectory  = "/"
+
+  s3_config {
+    bucket_access_role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+
+resource "aws_datasync_location_s3" "destination" {
+  s3_bucket_arn = "${aws_s3_bucket.destination.arn}"
+  subdirectory  = "/"
+
+  s3_config {
+    bucket_access_role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAWSDataSyncTaskConfig(rName string) string {
+	return testAccAWSDataSyncTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = "${aws_datasync_location_s3.destination.arn}"
+  name                     = %[1]q
+  source_location_arn      = "${aws_datasync_location_s3.source.arn}"
+}
+`, rName)
+}
+
+func testAccAWSDataSyncTaskConfigOptions(rName string, bytesPerSecond int, verifyMode string) string {
+	return testAccAWSDataSyncTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = "${aws_datasync_location_s3.destination.arn}"
+  name                     = %[1]q
+  source_location_arn      = "${aws_datasync_location_s3.source.arn}"
+
+  options {
+    bytes_per_second = %[2]d
+    verify_mode      = %[3]q
+  }
+}
+`, rName, bytesPerSecond, verifyMode)
+}
+
+func testAccAWSDataSyncTaskConfigFilters(rName, includes, excludes string) string {
+	return testAccAWSDataSyncTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = "${aws_datasync_location_s3.destination.arn}"
+  name                     = %[1]q
+  source_location_arn      = "${aws_datasync_location_s3.source.arn}"
+
+  excludes {
+    value = %[3]q
+  }
+
+  includes {
+    value = %[2]q
+  }
+}
+`, rName, includes, excludes)
+}
+
+func testAccAWSDataSyncTaskConfigSchedule(rName, scheduleExpression string) string {
+	return testAccAWSDataSyncTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = "${aws_datasync_location_s3.destination.arn}"
+  name                     = %[1]q
+  source_location_arn      = "${aws_datasync_location_s3.source.arn}"
+
+  schedule {
+    schedule_expression = %[2]q
+  }
+}
+`, rName, scheduleExpression)
+}
+
+func testAccAWSDataSyncTaskConfigTags(rName, tagValue string) string {
+	return testAccAWSDataSyncTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = "${aws_datasync_location_s3.destination.arn}"
+  name                     = %[1]q
+  source_location_arn      = "${aws_datasync_location_s3.source.arn}"
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -46,6 +46,11 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Computed: true,
 	}
 
+	// IPAM pool allocation is not available for Default Subnets
+	delete(dsubnet.Schema, "ipv4_ipam_pool_id")
+	delete(dsubnet.Schema, "ipv4_netmask_length")
+	dsubnet.CustomizeDiff = nil
+
 	return dsubnet
 }
 
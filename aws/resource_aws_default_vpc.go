@@ -31,6 +31,11 @@ func resourceAwsDefaultVpc() *schema.Resource {
 		Computed: true,
 	}
 
+	// IPAM pool allocation is not available for Default VPCs
+	delete(dvpc.Schema, "ipv4_ipam_pool_id")
+	delete(dvpc.Schema, "ipv4_netmask_length")
+	dvpc.CustomizeDiff = nil
+
 	return dvpc
 }
 
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceConditionalForwarder_basic(t *testing.T) {
+	resourceName := "aws_directory_service_conditional_forwarder.test"
+	domainName := fmt.Sprintf("tf-acc-%s.com", acctest.RandString(8))
+
+	ip1, ip2, ip3 := "8.8.8.8", "1.1.1.1", "8.8.4.4"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceConditionalForwarderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceConditionalForwarderConfig(domainName, ip1, ip2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName, []string{ip1, ip2}),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_name", "test.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryServiceConditionalForwarderConfig(domainName, ip1, ip3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName, []string{ip1, ip3}),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeDirectoryServiceConditionalForwarderID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		DirectoryId string
+		DomainName  string
+		ErrorExpect bool
+	}{
+		{
+			ID:          "d-1234567890/test.example.com",
+			DirectoryId: "d-1234567890",
+			DomainName:  "test.example.com",
+		},
+		{
+			ID:          "d-1234567890",
+			ErrorExpect: true,
+		},
+		{
+			ID:          "/test.example.com",
+			ErrorExpect: true,
+		},
+	}
+
+	for _, tc := range cases {
+		directoryId, domainName, err := decodeDirectoryServiceConditionalForwarderID(tc.ID)
+		if tc.ErrorExpect {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+		if directoryId != tc.DirectoryId || domainName != tc.DomainName {
+			t.Errorf("ID %q: expected (%q, %q), got (%q, %q)", tc.ID, tc.DirectoryId, tc.DomainName, directoryId, domainName)
+		}
+	}
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_conditional_forwarder" {
+			continue
+		}
+
+		directoryId, domainName, err := decodeDirectoryServiceConditionalForwarderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(res.ConditionalForwarders) > 0 {
+			return fmt.Errorf("Expected AWS Directory Service Conditional Forwarder to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderExists(name string, dnsIps []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		directoryId, domainName, err := decodeDirectoryServiceConditionalForwarderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		res, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.ConditionalForwarders) == 0 {
+			return fmt.Errorf("No Conditional Fowrwarder found")
+		}
+
+		cfd := res.ConditionalForwarders[0]
+
+		if dnsIps != nil {
+			if !reflect.DeepEqual(dnsIps, aws.StringValueSlice(cfd.DnsIpAddrs)) {
+				return fmt.Errorf("DnsIpAddrs not equal in state and AWS: %v vs %v", dnsIps, aws.StringValueSlice(cfd.DnsIpAddrs))
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccDirectoryServiceDirectoryConfigMicrosoftADBase returns a Microsoft
+// AD directory, and its networking, for the dependent resource tests.
+func testAccDirectoryServiceDirectoryConfigMicrosoftADBase(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = "${aws_vpc.test.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-directory-service-microsoft-ad"
+  }
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2a"
+  cidr_block        = "10.0.1.0/24"
+
+  tags {
+    Name = "tf-acc-directory-service-microsoft-ad-foo"
+  }
+}
+
+resource "aws_subnet" "bar" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2b"
+  cidr_block        = "10.0.2.0/24"
+
+  tags {
+    Name = "tf-acc-directory-service-microsoft-ad-bar"
+  }
+}
+`, domainName)
+}
+
+func testAccDirectoryServiceConditionalForwarderConfig(domainName, ip1, ip2 string) string {
+	return testAccDirectoryServiceDirectoryConfigMicrosoftADBase(domainName) + fmt.Sprintf(`
+resource "aws_directory_service_conditional_forwarder" "test" {
+  directory_id       = "${aws_directory_service_directory.test.id}"
+  remote_domain_name = "test.example.com"
+
+  dns_ips = [
+    %[1]q,
+    %[2]q,
+  ]
+}
+`, ip1, ip2)
+}
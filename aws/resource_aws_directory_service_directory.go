@@ -82,6 +82,12 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"availability_zones": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
 					},
 				},
 			},
@@ -116,9 +122,27 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"connect_ips": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"availability_zones": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
 					},
 				},
 			},
+			"desired_number_of_domain_controllers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
 			"enable_sso": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -407,6 +431,16 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("desired_number_of_domain_controllers") {
+		if v, ok := d.GetOk("desired_number_of_domain_controllers"); ok {
+			d.SetPartial("desired_number_of_domain_controllers")
+
+			if err := updateDirectoryServiceDomainControllers(dsconn, d.Id(), v.(int)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := setTagsDS(dsconn, d, d.Id()); err != nil {
 		return err
 	}
@@ -452,6 +486,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	d.Set("vpc_settings", flattenDSVpcSettings(dir.VpcSettings))
 	d.Set("connect_settings", flattenDSConnectSettings(dir.DnsIpAddrs, dir.ConnectSettings))
 	d.Set("enable_sso", dir.SsoEnabled)
+	d.Set("desired_number_of_domain_controllers", dir.DesiredNumberOfDomainControllers)
 
 	if dir.VpcSettings != nil {
 		d.Set("security_group_id", *dir.VpcSettings.SecurityGroupId)
@@ -468,6 +503,69 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func updateDirectoryServiceDomainControllers(dsconn *directoryservice.DirectoryService, directoryId string, desiredNumber int) error {
+	log.Printf("[DEBUG] Updating number of domain controllers for DS directory %q to %d", directoryId, desiredNumber)
+	_, err := dsconn.UpdateNumberOfDomainControllers(&directoryservice.UpdateNumberOfDomainControllersInput{
+		DirectoryId:   aws.String(directoryId),
+		DesiredNumber: aws.Int64(int64(desiredNumber)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating number of domain controllers for Directory Service (%s): %s", directoryId, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for DS (%q) domain controllers to become active", directoryId)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			var controllers []*directoryservice.DomainController
+			input := &directoryservice.DescribeDomainControllersInput{
+				DirectoryId: aws.String(directoryId),
+			}
+			for {
+				resp, err := dsconn.DescribeDomainControllers(input)
+				if err != nil {
+					return nil, "", err
+				}
+				controllers = append(controllers, resp.DomainControllers...)
+				if resp.NextToken == nil {
+					break
+				}
+				input.NextToken = resp.NextToken
+			}
+
+			active := 0
+			for _, dc := range controllers {
+				switch aws.StringValue(dc.Status) {
+				case directoryservice.DomainControllerStatusActive:
+					active++
+				case directoryservice.DomainControllerStatusFailed:
+					return controllers, "", fmt.Errorf("domain controller %s failed: %s",
+						aws.StringValue(dc.DomainControllerId), aws.StringValue(dc.StatusReason))
+				case directoryservice.DomainControllerStatusDeleted:
+					continue
+				default:
+					return controllers, "pending", nil
+				}
+			}
+
+			if active != desiredNumber {
+				return controllers, "pending", nil
+			}
+			return controllers, "ready", nil
+		},
+		Timeout:    60 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for Directory Service (%s) domain controllers to become active: %s",
+			directoryId, err)
+	}
+
+	return nil
+}
+
 func resourceAwsDirectoryServiceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 	dsconn := meta.(*AWSClient).dsconn
 
@@ -156,6 +156,31 @@ func TestAccAWSDirectoryServiceDirectory_microsoftStandard(t *testing.T) {
 	})
 }
 
+func TestAccAWSDirectoryServiceDirectory_desiredNumberOfDomainControllers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDirectoryServiceDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceDirectoryConfig_microsoftStandard,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDirectoryExists("aws_directory_service_directory.bar"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "desired_number_of_domain_controllers", "2"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "vpc_settings.0.availability_zones.#", "2"),
+				),
+			},
+			{
+				Config: testAccDirectoryServiceDirectoryConfig_desiredNumberOfDomainControllers,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDirectoryExists("aws_directory_service_directory.bar"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.bar", "desired_number_of_domain_controllers", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDirectoryServiceDirectory_connector(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -166,6 +191,8 @@ func TestAccAWSDirectoryServiceDirectory_connector(t *testing.T) {
 				Config: testAccDirectoryServiceDirectoryConfig_connector,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceDirectoryExists("aws_directory_service_directory.connector"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.connector", "connect_settings.0.connect_ips.#", "2"),
+					resource.TestCheckResourceAttr("aws_directory_service_directory.connector", "connect_settings.0.availability_zones.#", "2"),
 				),
 			},
 		},
@@ -535,6 +562,45 @@ resource "aws_subnet" "bar" {
 }
 `
 
+const testAccDirectoryServiceDirectoryConfig_desiredNumberOfDomainControllers = `
+resource "aws_directory_service_directory" "bar" {
+  name = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type = "MicrosoftAD"
+  edition = "Standard"
+  desired_number_of_domain_controllers = 3
+
+  vpc_settings {
+    vpc_id = "${aws_vpc.main.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-directory-service-directory-microsoft"
+  }
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2a"
+  cidr_block = "10.0.1.0/24"
+  tags {
+    Name = "tf-acc-directory-service-directory-microsoft-foo"
+  }
+}
+resource "aws_subnet" "bar" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2b"
+  cidr_block = "10.0.2.0/24"
+  tags {
+    Name = "tf-acc-directory-service-directory-microsoft-bar"
+  }
+}
+`
+
 var randomInteger = acctest.RandInt()
 var testAccDirectoryServiceDirectoryConfig_withAlias = fmt.Sprintf(`
 resource "aws_directory_service_directory" "bar_a" {
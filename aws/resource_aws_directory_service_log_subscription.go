@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceLogSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceLogSubscriptionCreate,
+		Read:   resourceAwsDirectoryServiceLogSubscriptionRead,
+		Delete: resourceAwsDirectoryServiceLogSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceLogSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+
+	input := &directoryservice.CreateLogSubscriptionInput{
+		DirectoryId:  aws.String(directoryId),
+		LogGroupName: aws.String(d.Get("log_group_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service Log Subscription: %s", input)
+	_, err := dsconn.CreateLogSubscription(input)
+	if err != nil {
+		return fmt.Errorf("error creating Directory Service Log Subscription: %s", err)
+	}
+
+	d.SetId(directoryId)
+
+	return resourceAwsDirectoryServiceLogSubscriptionRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceLogSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	resp, err := dsconn.ListLogSubscriptions(&directoryservice.ListLogSubscriptionsInput{
+		DirectoryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] Directory Service Log Subscription (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Directory Service Log Subscription (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.LogSubscriptions) == 0 {
+		log.Printf("[WARN] Directory Service Log Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	logSubscription := resp.LogSubscriptions[0]
+
+	d.Set("directory_id", logSubscription.DirectoryId)
+	d.Set("log_group_name", logSubscription.LogGroupName)
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceLogSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	log.Printf("[DEBUG] Deleting Directory Service Log Subscription: %s", d.Id())
+	_, err := dsconn.DeleteLogSubscription(&directoryservice.DeleteLogSubscriptionInput{
+		DirectoryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Directory Service Log Subscription (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceLogSubscription_basic(t *testing.T) {
+	resourceName := "aws_directory_service_log_subscription.test"
+	rString := acctest.RandString(8)
+	domainName := fmt.Sprintf("tf-acc-%s.com", rString)
+	logGroupName := fmt.Sprintf("/aws/directoryservice/tf-acc-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceLogSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceLogSubscriptionConfig(domainName, logGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceLogSubscriptionExists(resourceName, logGroupName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "log_group_name", logGroupName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceLogSubscriptionDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_log_subscription" {
+			continue
+		}
+
+		res, err := dsconn.ListLogSubscriptions(&directoryservice.ListLogSubscriptionsInput{
+			DirectoryId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(res.LogSubscriptions) > 0 {
+			return fmt.Errorf("Expected AWS Directory Service Log Subscription to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceLogSubscriptionExists(name string, logGroupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		res, err := dsconn.ListLogSubscriptions(&directoryservice.ListLogSubscriptionsInput{
+			DirectoryId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.LogSubscriptions) == 0 {
+			return fmt.Errorf("No Log Subscription found")
+		}
+
+		if aws.StringValue(res.LogSubscriptions[0].LogGroupName) != logGroupName {
+			return fmt.Errorf("Expected Log Subscription with log group %q, got %q", logGroupName, aws.StringValue(res.LogSubscriptions[0].LogGroupName))
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceLogSubscriptionConfig(domainName, logGroupName string) string {
+	return testAccDirectoryServiceDirectoryConfigMicrosoftADBase(domainName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name              = %[1]q
+  retention_in_days = 1
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+    ]
+
+    principals {
+      identifiers = ["ds.amazonaws.com"]
+      type        = "Service"
+    }
+
+    resources = ["${aws_cloudwatch_log_group.test.arn}"]
+
+    effect = "Allow"
+  }
+}
+
+resource "aws_cloudwatch_log_resource_policy" "test" {
+  policy_document = "${data.aws_iam_policy_document.test.json}"
+  policy_name     = %[1]q
+}
+
+resource "aws_directory_service_log_subscription" "test" {
+  directory_id   = "${aws_directory_service_directory.test.id}"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+
+  depends_on = ["aws_cloudwatch_log_resource_policy.test"]
+}
+`, logGroupName)
+}
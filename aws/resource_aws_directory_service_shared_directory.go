@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDirectoryServiceSharedDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceSharedDirectoryCreate,
+		Read:   resourceAwsDirectoryServiceSharedDirectoryRead,
+		Delete: resourceAwsDirectoryServiceSharedDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      directoryservice.ShareMethodHandshake,
+				ValidateFunc: validation.StringInSlice(directoryservice.ShareMethod_Values(), false),
+			},
+			"notes": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      directoryservice.TargetTypeAccount,
+							ValidateFunc: validation.StringInSlice(directoryservice.TargetType_Values(), false),
+						},
+					},
+				},
+			},
+			"shared_directory_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+
+	input := &directoryservice.ShareDirectoryInput{
+		DirectoryId: aws.String(directoryId),
+		ShareMethod: aws.String(d.Get("method").(string)),
+		ShareTarget: expandDirectoryServiceShareTarget(d.Get("target").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		input.ShareNotes = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Sharing Directory Service Directory: %s", directoryId)
+	resp, err := dsconn.ShareDirectory(input)
+	if err != nil {
+		return fmt.Errorf("error sharing Directory Service Directory (%s): %s", directoryId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", directoryId, aws.StringValue(resp.SharedDirectoryId)))
+
+	return resourceAwsDirectoryServiceSharedDirectoryRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	ownerDirectoryId, sharedDirectoryId, err := decodeDirectoryServiceSharedDirectoryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sharedDirectory, err := getDirectoryServiceSharedDirectory(dsconn, ownerDirectoryId, sharedDirectoryId)
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	if sharedDirectory == nil || aws.StringValue(sharedDirectory.ShareStatus) == directoryservice.ShareStatusDeleted {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", sharedDirectory.OwnerDirectoryId)
+	d.Set("method", sharedDirectory.ShareMethod)
+	d.Set("notes", sharedDirectory.ShareNotes)
+	d.Set("shared_directory_id", sharedDirectory.SharedDirectoryId)
+
+	target := map[string]interface{}{
+		"id":   aws.StringValue(sharedDirectory.SharedAccountId),
+		"type": directoryservice.TargetTypeAccount,
+	}
+	if v, ok := d.GetOk("target.0.type"); ok {
+		target["type"] = v.(string)
+	}
+	if err := d.Set("target", []interface{}{target}); err != nil {
+		return fmt.Errorf("error setting target: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	ownerDirectoryId, sharedDirectoryId, err := decodeDirectoryServiceSharedDirectoryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	target := expandDirectoryServiceShareTarget(d.Get("target").([]interface{}))
+
+	log.Printf("[DEBUG] Unsharing Directory Service Directory: %s", d.Id())
+	_, err = dsconn.UnshareDirectory(&directoryservice.UnshareDirectoryInput{
+		DirectoryId: aws.String(ownerDirectoryId),
+		UnshareTarget: &directoryservice.UnshareTarget{
+			Id:   target.Id,
+			Type: target.Type,
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") ||
+			isAWSErr(err, directoryservice.ErrCodeDirectoryNotSharedException, "") {
+			return nil
+		}
+		return fmt.Errorf("error unsharing Directory Service Directory (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Directory Service Shared Directory (%s) to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.ShareStatusDeleting,
+			directoryservice.ShareStatusPendingAcceptance,
+			directoryservice.ShareStatusRejectFailed,
+			directoryservice.ShareStatusRejected,
+			directoryservice.ShareStatusRejecting,
+			directoryservice.ShareStatusShareFailed,
+			directoryservice.ShareStatusShared,
+			directoryservice.ShareStatusSharing,
+		},
+		Target: []string{directoryservice.ShareStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			sharedDirectory, err := getDirectoryServiceSharedDirectory(dsconn, ownerDirectoryId, sharedDirectoryId)
+			if err != nil {
+				if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+					return 42, directoryservice.ShareStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			if sharedDirectory == nil {
+				return 42, directoryservice.ShareStatusDeleted, nil
+			}
+			return sharedDirectory, aws.StringValue(sharedDirectory.ShareStatus), nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getDirectoryServiceSharedDirectory(dsconn *directoryservice.DirectoryService, ownerDirectoryId, sharedDirectoryId string) (*directoryservice.SharedDirectory, error) {
+	resp, err := dsconn.DescribeSharedDirectories(&directoryservice.DescribeSharedDirectoriesInput{
+		OwnerDirectoryId:   aws.String(ownerDirectoryId),
+		SharedDirectoryIds: []*string{aws.String(sharedDirectoryId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.SharedDirectories) == 0 {
+		return nil, nil
+	}
+
+	return resp.SharedDirectories[0], nil
+}
+
+func expandDirectoryServiceShareTarget(l []interface{}) *directoryservice.ShareTarget {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &directoryservice.ShareTarget{
+		Id:   aws.String(m["id"].(string)),
+		Type: aws.String(m["type"].(string)),
+	}
+}
+
+func decodeDirectoryServiceSharedDirectoryID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected OWNER_DIRECTORY_ID/SHARED_DIRECTORY_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceSharedDirectoryAccepterCreate,
+		Read:   resourceAwsDirectoryServiceSharedDirectoryAccepterRead,
+		Delete: resourceAwsDirectoryServiceSharedDirectoryAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"shared_directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notes": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_directory_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	sharedDirectoryId := d.Get("shared_directory_id").(string)
+
+	log.Printf("[DEBUG] Accepting Directory Service Shared Directory: %s", sharedDirectoryId)
+	_, err := dsconn.AcceptSharedDirectory(&directoryservice.AcceptSharedDirectoryInput{
+		SharedDirectoryId: aws.String(sharedDirectoryId),
+	})
+	if err != nil {
+		return fmt.Errorf("error accepting Directory Service Shared Directory (%s): %s", sharedDirectoryId, err)
+	}
+
+	d.SetId(sharedDirectoryId)
+
+	log.Printf("[DEBUG] Waiting for Directory Service Shared Directory (%s) to become available", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.DirectoryStageRequested,
+			directoryservice.DirectoryStageCreating,
+			directoryservice.DirectoryStageCreated,
+		},
+		Target: []string{directoryservice.DirectoryStageActive},
+		Refresh: func() (interface{}, string, error) {
+			dir, err := getDirectoryServiceDirectory(dsconn, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if dir == nil {
+				return nil, "", nil
+			}
+			return dir, aws.StringValue(dir.Stage), nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceSharedDirectoryAccepterRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	dir, err := getDirectoryServiceDirectory(dsconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	if dir == nil {
+		log.Printf("[WARN] Directory Service Shared Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("shared_directory_id", dir.DirectoryId)
+	d.Set("method", dir.ShareMethod)
+	d.Set("notes", dir.ShareNotes)
+
+	if dir.OwnerDirectoryDescription != nil {
+		d.Set("owner_account_id", dir.OwnerDirectoryDescription.AccountId)
+		d.Set("owner_directory_id", dir.OwnerDirectoryDescription.DirectoryId)
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceSharedDirectoryAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	log.Printf("[DEBUG] Deleting Directory Service Shared Directory: %s", d.Id())
+	_, err := dsconn.DeleteDirectory(&directoryservice.DeleteDirectoryInput{
+		DirectoryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Directory Service Shared Directory (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Directory Service Shared Directory (%s) to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.DirectoryStageActive,
+			directoryservice.DirectoryStageDeleting,
+		},
+		Target: []string{directoryservice.DirectoryStageDeleted},
+		Refresh: func() (interface{}, string, error) {
+			dir, err := getDirectoryServiceDirectory(dsconn, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if dir == nil {
+				return 42, directoryservice.DirectoryStageDeleted, nil
+			}
+			return dir, aws.StringValue(dir.Stage), nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Shared Directory (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getDirectoryServiceDirectory(dsconn *directoryservice.DirectoryService, directoryId string) (*directoryservice.DirectoryDescription, error) {
+	resp, err := dsconn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
+		DirectoryIds: []*string{aws.String(directoryId)},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(resp.DirectoryDescriptions) == 0 {
+		return nil, nil
+	}
+
+	return resp.DirectoryDescriptions[0], nil
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Shared directories are offered from a second account, so these tests need
+// credentials for the account that owns the directory.
+func testAccPreCheckAwsDirectoryServiceSharedDirectoryAccepter(t *testing.T) {
+	if os.Getenv("DS_SHARE_OWNER_PROFILE") == "" {
+		t.Skip("Environment variable DS_SHARE_OWNER_PROFILE is not set")
+	}
+}
+
+func TestAccAWSDirectoryServiceSharedDirectoryAccepter_basic(t *testing.T) {
+	resourceName := "aws_directory_service_shared_directory_accepter.test"
+	domainName := fmt.Sprintf("tf-acc-%s.com", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDirectoryServiceSharedDirectoryAccepter(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceSharedDirectoryAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceSharedDirectoryAccepterConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceSharedDirectoryAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_directory_id", "aws_directory_service_shared_directory.test", "shared_directory_id"),
+					resource.TestCheckResourceAttr(resourceName, "method", "HANDSHAKE"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Terraform testing"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryAccepterDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_shared_directory_accepter" {
+			continue
+		}
+
+		dir, err := getDirectoryServiceDirectory(dsconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if dir != nil {
+			return fmt.Errorf("Expected AWS Directory Service Shared Directory %q to be gone, but was still found", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryAccepterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		dir, err := getDirectoryServiceDirectory(dsconn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if dir == nil {
+			return fmt.Errorf("Directory Service Shared Directory %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceSharedDirectoryAccepterConfig(domainName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "owner"
+  profile = %[2]q
+}
+
+data "aws_caller_identity" "receiver" {}
+
+resource "aws_vpc" "test" {
+  provider = "aws.owner"
+
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-directory-service-shared-directory-accepter"
+  }
+}
+
+resource "aws_subnet" "foo" {
+  provider = "aws.owner"
+
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2a"
+  cidr_block        = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "bar" {
+  provider = "aws.owner"
+
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2b"
+  cidr_block        = "10.0.2.0/24"
+}
+
+resource "aws_directory_service_directory" "test" {
+  provider = "aws.owner"
+
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = "${aws_vpc.test.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+}
+
+resource "aws_directory_service_shared_directory" "test" {
+  provider = "aws.owner"
+
+  directory_id = "${aws_directory_service_directory.test.id}"
+  notes        = "Terraform testing"
+
+  target {
+    id = "${data.aws_caller_identity.receiver.account_id}"
+  }
+}
+
+resource "aws_directory_service_shared_directory_accepter" "test" {
+  shared_directory_id = "${aws_directory_service_shared_directory.test.shared_directory_id}"
+}
+`, domainName, os.Getenv("DS_SHARE_OWNER_PROFILE"))
+}
@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Directories are shared with a second account, so these tests need the
+// identifier of that account.
+func testAccPreCheckAwsDirectoryServiceSharedDirectory(t *testing.T) {
+	if os.Getenv("DS_SHARE_TARGET_ACCOUNT_ID") == "" {
+		t.Skip("Environment variable DS_SHARE_TARGET_ACCOUNT_ID is not set")
+	}
+}
+
+func TestAccAWSDirectoryServiceSharedDirectory_basic(t *testing.T) {
+	resourceName := "aws_directory_service_shared_directory.test"
+	domainName := fmt.Sprintf("tf-acc-%s.com", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDirectoryServiceSharedDirectory(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceSharedDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceSharedDirectoryConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceSharedDirectoryExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "method", "HANDSHAKE"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Terraform testing"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.id", os.Getenv("DS_SHARE_TARGET_ACCOUNT_ID")),
+					resource.TestCheckResourceAttr(resourceName, "target.0.type", "ACCOUNT"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_directory_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDecodeDirectoryServiceSharedDirectoryID(t *testing.T) {
+	cases := []struct {
+		ID                string
+		OwnerDirectoryId  string
+		SharedDirectoryId string
+		ErrorExpect       bool
+	}{
+		{
+			ID:                "d-1234567890/d-0987654321",
+			OwnerDirectoryId:  "d-1234567890",
+			SharedDirectoryId: "d-0987654321",
+		},
+		{
+			ID:          "d-1234567890",
+			ErrorExpect: true,
+		},
+		{
+			ID:          "d-1234567890/",
+			ErrorExpect: true,
+		},
+	}
+
+	for _, tc := range cases {
+		ownerDirectoryId, sharedDirectoryId, err := decodeDirectoryServiceSharedDirectoryID(tc.ID)
+		if tc.ErrorExpect {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+		if ownerDirectoryId != tc.OwnerDirectoryId || sharedDirectoryId != tc.SharedDirectoryId {
+			t.Errorf("ID %q: expected (%q, %q), got (%q, %q)", tc.ID, tc.OwnerDirectoryId, tc.SharedDirectoryId, ownerDirectoryId, sharedDirectoryId)
+		}
+	}
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_shared_directory" {
+			continue
+		}
+
+		ownerDirectoryId, sharedDirectoryId, err := decodeDirectoryServiceSharedDirectoryID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		sharedDirectory, err := getDirectoryServiceSharedDirectory(dsconn, ownerDirectoryId, sharedDirectoryId)
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if sharedDirectory != nil && aws.StringValue(sharedDirectory.ShareStatus) != directoryservice.ShareStatusDeleted {
+			return fmt.Errorf("Expected AWS Directory Service Shared Directory to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceSharedDirectoryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		ownerDirectoryId, sharedDirectoryId, err := decodeDirectoryServiceSharedDirectoryID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		sharedDirectory, err := getDirectoryServiceSharedDirectory(dsconn, ownerDirectoryId, sharedDirectoryId)
+		if err != nil {
+			return err
+		}
+
+		if sharedDirectory == nil {
+			return fmt.Errorf("Directory Service Shared Directory %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceSharedDirectoryConfig(domainName string) string {
+	return testAccDirectoryServiceDirectoryConfigMicrosoftADBase(domainName) + fmt.Sprintf(`
+resource "aws_directory_service_shared_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+  notes        = "Terraform testing"
+
+  target {
+    id = %[1]q
+  }
+}
+`, os.Getenv("DS_SHARE_TARGET_ACCOUNT_ID"))
+}
@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDirectoryServiceTrust() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceTrustCreate,
+		Read:   resourceAwsDirectoryServiceTrustRead,
+		Update: resourceAwsDirectoryServiceTrustUpdate,
+		Delete: resourceAwsDirectoryServiceTrustDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDirectoryServiceTrustImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"trust_direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(directoryservice.TrustDirection_Values(), false),
+			},
+			"trust_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"trust_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      directoryservice.TrustTypeForest,
+				ValidateFunc: validation.StringInSlice(directoryservice.TrustType_Values(), false),
+			},
+			"conditional_forwarder_ip_addrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"delete_associated_conditional_forwarder": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"selective_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(directoryservice.SelectiveAuth_Values(), false),
+			},
+			"created_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceTrustCreate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+
+	input := &directoryservice.CreateTrustInput{
+		DirectoryId:      aws.String(directoryId),
+		RemoteDomainName: aws.String(d.Get("remote_domain_name").(string)),
+		TrustDirection:   aws.String(d.Get("trust_direction").(string)),
+		TrustPassword:    aws.String(d.Get("trust_password").(string)),
+		TrustType:        aws.String(d.Get("trust_type").(string)),
+	}
+
+	if v, ok := d.GetOk("conditional_forwarder_ip_addrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConditionalForwarderIpAddrs = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("selective_auth"); ok {
+		input.SelectiveAuth = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service Trust: %s", d.Get("remote_domain_name").(string))
+	resp, err := dsconn.CreateTrust(input)
+	if err != nil {
+		return fmt.Errorf("error creating Directory Service Trust: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.TrustId))
+
+	if err := waitForDirectoryServiceTrustState(dsconn, directoryId, d.Id(),
+		[]string{
+			directoryservice.TrustStateCreating,
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateVerifying,
+		},
+		[]string{
+			directoryservice.TrustStateVerified,
+			directoryservice.TrustStateVerifyFailed,
+		}); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Trust (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustRead(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	trust, err := getDirectoryServiceTrust(dsconn, d.Get("directory_id").(string), d.Id())
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] Directory Service Trust (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	if trust == nil || aws.StringValue(trust.TrustState) == directoryservice.TrustStateDeleted {
+		log.Printf("[WARN] Directory Service Trust (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", trust.DirectoryId)
+	d.Set("remote_domain_name", trust.RemoteDomainName)
+	d.Set("selective_auth", trust.SelectiveAuth)
+	d.Set("trust_direction", trust.TrustDirection)
+	d.Set("trust_state", trust.TrustState)
+	d.Set("trust_state_reason", trust.TrustStateReason)
+	d.Set("trust_type", trust.TrustType)
+
+	if trust.CreatedDateTime != nil {
+		d.Set("created_date_time", aws.TimeValue(trust.CreatedDateTime).Format(time.RFC3339))
+	}
+	if trust.LastUpdatedDateTime != nil {
+		d.Set("last_updated_date_time", aws.TimeValue(trust.LastUpdatedDateTime).Format(time.RFC3339))
+	}
+	if trust.StateLastUpdatedDateTime != nil {
+		d.Set("state_last_updated_date_time", aws.TimeValue(trust.StateLastUpdatedDateTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceTrustUpdate(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	if d.HasChange("selective_auth") {
+		log.Printf("[DEBUG] Updating Directory Service Trust (%s) selective authentication", d.Id())
+		_, err := dsconn.UpdateTrust(&directoryservice.UpdateTrustInput{
+			TrustId:       aws.String(d.Id()),
+			SelectiveAuth: aws.String(d.Get("selective_auth").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Directory Service Trust (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDirectoryServiceTrustState(dsconn, d.Get("directory_id").(string), d.Id(),
+			[]string{directoryservice.TrustStateUpdating},
+			[]string{
+				directoryservice.TrustStateUpdated,
+				directoryservice.TrustStateVerified,
+				directoryservice.TrustStateVerifyFailed,
+			}); err != nil {
+			return fmt.Errorf("error waiting for Directory Service Trust (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustDelete(d *schema.ResourceData, meta interface{}) error {
+	dsconn := meta.(*AWSClient).dsconn
+
+	log.Printf("[DEBUG] Deleting Directory Service Trust: %s", d.Id())
+	_, err := dsconn.DeleteTrust(&directoryservice.DeleteTrustInput{
+		TrustId:                              aws.String(d.Id()),
+		DeleteAssociatedConditionalForwarder: aws.Bool(d.Get("delete_associated_conditional_forwarder").(bool)),
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDirectoryServiceTrustState(dsconn, d.Get("directory_id").(string), d.Id(),
+		[]string{
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateDeleting,
+			directoryservice.TrustStateUpdated,
+			directoryservice.TrustStateUpdateFailed,
+			directoryservice.TrustStateVerified,
+			directoryservice.TrustStateVerifyFailed,
+		},
+		[]string{directoryservice.TrustStateDeleted}); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Trust (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceTrustImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DIRECTORY_ID/TRUST_ID", d.Id())
+	}
+
+	d.Set("directory_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getDirectoryServiceTrust(dsconn *directoryservice.DirectoryService, directoryId, trustId string) (*directoryservice.Trust, error) {
+	resp, err := dsconn.DescribeTrusts(&directoryservice.DescribeTrustsInput{
+		DirectoryId: aws.String(directoryId),
+		TrustIds:    []*string{aws.String(trustId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Trusts) == 0 {
+		return nil, nil
+	}
+
+	return resp.Trusts[0], nil
+}
+
+func waitForDirectoryServiceTrustState(dsconn *directoryservice.DirectoryService, directoryId, trustId string, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			trust, err := getDirectoryServiceTrust(dsconn, directoryId, trustId)
+			if err != nil {
+				if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+					return 42, directoryservice.TrustStateDeleted, nil
+				}
+				return nil, "", err
+			}
+			if trust == nil {
+				return 42, directoryservice.TrustStateDeleted, nil
+			}
+
+			state := aws.StringValue(trust.TrustState)
+			if state == directoryservice.TrustStateFailed {
+				return trust, state, fmt.Errorf("%s", aws.StringValue(trust.TrustStateReason))
+			}
+
+			return trust, state, nil
+		},
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
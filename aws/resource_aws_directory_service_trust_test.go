@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceTrust_basic(t *testing.T) {
+	resourceName := "aws_directory_service_trust.test"
+	rString := acctest.RandString(8)
+	domainName := fmt.Sprintf("tf-acc-%s.com", rString)
+	remoteDomainName := fmt.Sprintf("tf-acc-remote-%s.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceTrustDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryServiceTrustConfig(domainName, remoteDomainName, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_name", remoteDomainName),
+					resource.TestCheckResourceAttr(resourceName, "trust_direction", "One-Way: Outgoing"),
+					resource.TestCheckResourceAttr(resourceName, "trust_type", "Forest"),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Disabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "trust_state"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDirectoryServiceTrustImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"conditional_forwarder_ip_addrs",
+					"delete_associated_conditional_forwarder",
+					"trust_password",
+				},
+			},
+			{
+				Config: testAccDirectoryServiceTrustConfig(domainName, remoteDomainName, "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectoryServiceTrustImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["directory_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckAwsDirectoryServiceTrustDestroy(s *terraform.State) error {
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_trust" {
+			continue
+		}
+
+		trust, err := getDirectoryServiceTrust(dsconn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if trust != nil && aws.StringValue(trust.TrustState) != directoryservice.TrustStateDeleted {
+			return fmt.Errorf("Expected AWS Directory Service Trust to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceTrustExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+
+		trust, err := getDirectoryServiceTrust(dsconn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if trust == nil {
+			return fmt.Errorf("Directory Service Trust %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceTrustConfig(domainName, remoteDomainName, selectiveAuth string) string {
+	return testAccDirectoryServiceDirectoryConfigMicrosoftADBase(domainName) + fmt.Sprintf(`
+resource "aws_subnet" "remote_foo" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2a"
+  cidr_block        = "10.0.3.0/24"
+
+  tags {
+    Name = "tf-acc-directory-service-trust-remote-foo"
+  }
+}
+
+resource "aws_subnet" "remote_bar" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2b"
+  cidr_block        = "10.0.4.0/24"
+
+  tags {
+    Name = "tf-acc-directory-service-trust-remote-bar"
+  }
+}
+
+resource "aws_directory_service_directory" "remote" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = "${aws_vpc.test.id}"
+    subnet_ids = ["${aws_subnet.remote_foo.id}", "${aws_subnet.remote_bar.id}"]
+  }
+}
+
+resource "aws_directory_service_trust" "test" {
+  directory_id       = "${aws_directory_service_directory.test.id}"
+  remote_domain_name = "${aws_directory_service_directory.remote.name}"
+  trust_direction    = "One-Way: Outgoing"
+  trust_password     = "Some0therPassword"
+  selective_auth     = %[2]q
+
+  conditional_forwarder_ip_addrs          = ["${aws_directory_service_directory.remote.dns_ip_addresses}"]
+  delete_associated_conditional_forwarder = true
+}
+`, remoteDomainName, selectiveAuth)
+}
@@ -0,0 +1,920 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDlmLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDlmLifecyclePolicyCreate,
+		Read:   resourceAwsDlmLifecyclePolicyRead,
+		Update: resourceAwsDlmLifecyclePolicyUpdate,
+		Delete: resourceAwsDlmLifecyclePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"policy_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cross_region_copy": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"cmk_arn": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validateArn,
+															},
+															"encrypted": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+														},
+													},
+												},
+												"retain_rule": dlmCrossRegionCopyRetainRuleSchema(),
+												"target": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 120),
+									},
+								},
+							},
+						},
+						"event_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"parameters": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"description_regex": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1000),
+												},
+												"event_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(dlm.EventTypeValues_Values(), false),
+												},
+												"snapshot_owner": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MaxItems: 50,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateAwsAccountId,
+													},
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(dlm.EventSourceValues_Values(), false),
+									},
+								},
+							},
+						},
+						"parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volume": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"no_reboot": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"policy_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      dlm.PolicyTypeValuesEbsSnapshotManagement,
+							ValidateFunc: validation.StringInSlice(dlm.PolicyTypeValues_Values(), false),
+						},
+						"resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(dlm.ResourceTypeValues_Values(), false),
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 4,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"copy_tags": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"create_rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cron_expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"interval": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"interval_unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(dlm.IntervalUnitValues_Values(), false),
+												},
+												"times": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`), "must be in the 24-hour format hh:mm"),
+													},
+												},
+											},
+										},
+									},
+									"cross_region_copy_rule": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cmk_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateArn,
+												},
+												"copy_tags": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"encrypted": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+												"retain_rule": dlmCrossRegionCopyRetainRuleSchema(),
+												"target": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"fast_restore_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"availability_zones": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 10,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"count": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+												"interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"interval_unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dlm.RetentionIntervalUnitValues_Values(), false),
+												},
+											},
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 120),
+									},
+									"retain_rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"count": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+												"interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"interval_unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dlm.RetentionIntervalUnitValues_Values(), false),
+												},
+											},
+										},
+									},
+									"tags_to_add": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"variable_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"target_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      dlm.SettablePolicyStateValuesEnabled,
+				ValidateFunc: validation.StringInSlice(dlm.SettablePolicyStateValues_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dlmCrossRegionCopyRetainRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"interval": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"interval_unit": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(dlm.RetentionIntervalUnitValues_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDlmLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	input := &dlm.CreateLifecyclePolicyInput{
+		Description:      aws.String(d.Get("description").(string)),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		PolicyDetails:    expandDlmPolicyDetails(d.Get("policy_details").([]interface{})),
+		State:            aws.String(d.Get("state").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating DLM Lifecycle Policy: %s", input)
+	output, err := conn.CreateLifecyclePolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating DLM Lifecycle Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PolicyId))
+
+	return resourceAwsDlmLifecyclePolicyRead(d, meta)
+}
+
+func resourceAwsDlmLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	output, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] DLM Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DLM Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", policy.PolicyArn)
+	d.Set("description", policy.Description)
+	d.Set("execution_role_arn", policy.ExecutionRoleArn)
+	d.Set("state", policy.State)
+
+	if err := d.Set("policy_details", flattenDlmPolicyDetails(policy.PolicyDetails)); err != nil {
+		return fmt.Errorf("error setting policy_details: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(policy.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDlmLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	if d.HasChange("description") || d.HasChange("execution_role_arn") || d.HasChange("policy_details") || d.HasChange("state") {
+		input := &dlm.UpdateLifecyclePolicyInput{
+			Description:      aws.String(d.Get("description").(string)),
+			ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+			PolicyDetails:    expandDlmPolicyDetails(d.Get("policy_details").([]interface{})),
+			PolicyId:         aws.String(d.Id()),
+			State:            aws.String(d.Get("state").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating DLM Lifecycle Policy: %s", input)
+		_, err := conn.UpdateLifecyclePolicy(input)
+		if err != nil {
+			return fmt.Errorf("error updating DLM Lifecycle Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDlm(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating DLM Lifecycle Policy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDlmLifecyclePolicyRead(d, meta)
+}
+
+func resourceAwsDlmLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	input := &dlm.DeleteLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DLM Lifecycle Policy: %s", input)
+	_, err := conn.DeleteLifecyclePolicy(input)
+	if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting DLM Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDlmPolicyDetails(l []interface{}) *dlm.PolicyDetails {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	policyType := m["policy_type"].(string)
+	details := &dlm.PolicyDetails{
+		PolicyType: aws.String(policyType),
+	}
+
+	if v, ok := m["resource_types"].([]interface{}); ok && len(v) > 0 {
+		details.ResourceTypes = expandStringList(v)
+	}
+
+	if v, ok := m["target_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		details.TargetTags = expandDlmTags(v)
+	}
+
+	if v, ok := m["schedule"].([]interface{}); ok && len(v) > 0 {
+		details.Schedules = expandDlmSchedules(v)
+	}
+
+	if v, ok := m["parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		parameters := &dlm.Parameters{
+			NoReboot: aws.Bool(p["no_reboot"].(bool)),
+		}
+
+		// Excluding the boot volume only applies to policies that snapshot
+		// instances rather than volumes or create AMIs.
+		if policyType == dlm.PolicyTypeValuesEbsSnapshotManagement {
+			parameters.ExcludeBootVolume = aws.Bool(p["exclude_boot_volume"].(bool))
+		}
+
+		details.Parameters = parameters
+	}
+
+	if v, ok := m["event_source"].([]interface{}); ok && len(v) > 0 {
+		details.EventSource = expandDlmEventSource(v)
+	}
+
+	if v, ok := m["action"].([]interface{}); ok && len(v) > 0 {
+		details.Actions = expandDlmActions(v)
+	}
+
+	return details
+}
+
+func expandDlmSchedules(l []interface{}) []*dlm.Schedule {
+	schedules := make([]*dlm.Schedule, 0, len(l))
+
+	for _, s := range l {
+		m := s.(map[string]interface{})
+
+		schedule := &dlm.Schedule{
+			CopyTags:   aws.Bool(m["copy_tags"].(bool)),
+			CreateRule: expandDlmCreateRule(m["create_rule"].([]interface{})),
+			Name:       aws.String(m["name"].(string)),
+			RetainRule: expandDlmRetainRule(m["retain_rule"].([]interface{})),
+		}
+
+		if v, ok := m["cross_region_copy_rule"].(*schema.Set); ok && v.Len() > 0 {
+			schedule.CrossRegionCopyRules = expandDlmCrossRegionCopyRules(v.List())
+		}
+
+		if v, ok := m["fast_restore_rule"].([]interface{}); ok && len(v) > 0 {
+			schedule.FastRestoreRule = expandDlmFastRestoreRule(v)
+		}
+
+		if v, ok := m["tags_to_add"].(map[string]interface{}); ok && len(v) > 0 {
+			schedule.TagsToAdd = expandDlmTags(v)
+		}
+
+		if v, ok := m["variable_tags"].(map[string]interface{}); ok && len(v) > 0 {
+			schedule.VariableTags = expandDlmTags(v)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+func expandDlmCreateRule(l []interface{}) *dlm.CreateRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["cron_expression"].(string); ok && v != "" {
+		return &dlm.CreateRule{
+			CronExpression: aws.String(v),
+		}
+	}
+
+	rule := &dlm.CreateRule{}
+
+	if v, ok := m["interval"].(int); ok && v > 0 {
+		rule.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		rule.IntervalUnit = aws.String(v)
+	} else if rule.Interval != nil {
+		rule.IntervalUnit = aws.String(dlm.IntervalUnitValuesHours)
+	}
+
+	if v, ok := m["times"].([]interface{}); ok && len(v) > 0 {
+		rule.Times = expandStringList(v)
+	}
+
+	return rule
+}
+
+func expandDlmRetainRule(l []interface{}) *dlm.RetainRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rule := &dlm.RetainRule{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		rule.Count = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval"].(int); ok && v > 0 {
+		rule.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		rule.IntervalUnit = aws.String(v)
+	}
+
+	return rule
+}
+
+func expandDlmCrossRegionCopyRules(l []interface{}) []*dlm.CrossRegionCopyRule {
+	rules := make([]*dlm.CrossRegionCopyRule, 0, len(l))
+
+	for _, r := range l {
+		m := r.(map[string]interface{})
+
+		rule := &dlm.CrossRegionCopyRule{
+			CopyTags:   aws.Bool(m["copy_tags"].(bool)),
+			Encrypted:  aws.Bool(m["encrypted"].(bool)),
+			RetainRule: expandDlmCrossRegionCopyRetainRule(m["retain_rule"].([]interface{})),
+			Target:     aws.String(m["target"].(string)),
+		}
+
+		if v, ok := m["cmk_arn"].(string); ok && v != "" {
+			rule.CmkArn = aws.String(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandDlmCrossRegionCopyRetainRule(l []interface{}) *dlm.CrossRegionCopyRetainRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &dlm.CrossRegionCopyRetainRule{
+		Interval:     aws.Int64(int64(m["interval"].(int))),
+		IntervalUnit: aws.String(m["interval_unit"].(string)),
+	}
+}
+
+func expandDlmFastRestoreRule(l []interface{}) *dlm.FastRestoreRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rule := &dlm.FastRestoreRule{
+		AvailabilityZones: expandStringSet(m["availability_zones"].(*schema.Set)),
+	}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		rule.Count = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval"].(int); ok && v > 0 {
+		rule.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		rule.IntervalUnit = aws.String(v)
+	}
+
+	return rule
+}
+
+func expandDlmEventSource(l []interface{}) *dlm.EventSource {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	source := &dlm.EventSource{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+
+		source.Parameters = &dlm.EventParameters{
+			DescriptionRegex: aws.String(p["description_regex"].(string)),
+			EventType:        aws.String(p["event_type"].(string)),
+			SnapshotOwner:    expandStringSet(p["snapshot_owner"].(*schema.Set)),
+		}
+	}
+
+	return source
+}
+
+func expandDlmActions(l []interface{}) []*dlm.Action {
+	actions := make([]*dlm.Action, 0, len(l))
+
+	for _, a := range l {
+		m := a.(map[string]interface{})
+
+		action := &dlm.Action{
+			Name: aws.String(m["name"].(string)),
+		}
+
+		for _, c := range m["cross_region_copy"].(*schema.Set).List() {
+			cm := c.(map[string]interface{})
+
+			action.CrossRegionCopy = append(action.CrossRegionCopy, &dlm.CrossRegionCopyAction{
+				EncryptionConfiguration: expandDlmEncryptionConfiguration(cm["encryption_configuration"].([]interface{})),
+				RetainRule:              expandDlmCrossRegionCopyRetainRule(cm["retain_rule"].([]interface{})),
+				Target:                  aws.String(cm["target"].(string)),
+			})
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandDlmEncryptionConfiguration(l []interface{}) *dlm.EncryptionConfiguration {
+	config := &dlm.EncryptionConfiguration{
+		Encrypted: aws.Bool(false),
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m := l[0].(map[string]interface{})
+	config.Encrypted = aws.Bool(m["encrypted"].(bool))
+
+	if v, ok := m["cmk_arn"].(string); ok && v != "" {
+		config.CmkArn = aws.String(v)
+	}
+
+	return config
+}
+
+func expandDlmTags(m map[string]interface{}) []*dlm.Tag {
+	tags := make([]*dlm.Tag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &dlm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenDlmPolicyDetails(details *dlm.PolicyDetails) []interface{} {
+	if details == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"action":         flattenDlmActions(details.Actions),
+		"event_source":   flattenDlmEventSource(details.EventSource),
+		"policy_type":    aws.StringValue(details.PolicyType),
+		"resource_types": flattenStringList(details.ResourceTypes),
+		"schedule":       flattenDlmSchedules(details.Schedules),
+		"target_tags":    flattenDlmTags(details.TargetTags),
+	}
+
+	if details.Parameters != nil {
+		m["parameters"] = []interface{}{
+			map[string]interface{}{
+				"exclude_boot_volume": aws.BoolValue(details.Parameters.ExcludeBootVolume),
+				"no_reboot":           aws.BoolValue(details.Parameters.NoReboot),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmSchedules(schedules []*dlm.Schedule) []interface{} {
+	l := make([]interface{}, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		m := map[string]interface{}{
+			"copy_tags":              aws.BoolValue(schedule.CopyTags),
+			"create_rule":            flattenDlmCreateRule(schedule.CreateRule),
+			"cross_region_copy_rule": flattenDlmCrossRegionCopyRules(schedule.CrossRegionCopyRules),
+			"fast_restore_rule":      flattenDlmFastRestoreRule(schedule.FastRestoreRule),
+			"name":                   aws.StringValue(schedule.Name),
+			"retain_rule":            flattenDlmRetainRule(schedule.RetainRule),
+			"tags_to_add":            flattenDlmTags(schedule.TagsToAdd),
+			"variable_tags":          flattenDlmTags(schedule.VariableTags),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenDlmCreateRule(rule *dlm.CreateRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cron_expression": aws.StringValue(rule.CronExpression),
+		"interval":        int(aws.Int64Value(rule.Interval)),
+		"interval_unit":   aws.StringValue(rule.IntervalUnit),
+		"times":           flattenStringList(rule.Times),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmRetainRule(rule *dlm.RetainRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"count":         int(aws.Int64Value(rule.Count)),
+		"interval":      int(aws.Int64Value(rule.Interval)),
+		"interval_unit": aws.StringValue(rule.IntervalUnit),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmCrossRegionCopyRules(rules []*dlm.CrossRegionCopyRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		m := map[string]interface{}{
+			"cmk_arn":     aws.StringValue(rule.CmkArn),
+			"copy_tags":   aws.BoolValue(rule.CopyTags),
+			"encrypted":   aws.BoolValue(rule.Encrypted),
+			"retain_rule": flattenDlmCrossRegionCopyRetainRule(rule.RetainRule),
+			"target":      aws.StringValue(rule.Target),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenDlmCrossRegionCopyRetainRule(rule *dlm.CrossRegionCopyRetainRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"interval":      int(aws.Int64Value(rule.Interval)),
+		"interval_unit": aws.StringValue(rule.IntervalUnit),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmFastRestoreRule(rule *dlm.FastRestoreRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"availability_zones": flattenStringList(rule.AvailabilityZones),
+		"count":              int(aws.Int64Value(rule.Count)),
+		"interval":           int(aws.Int64Value(rule.Interval)),
+		"interval_unit":      aws.StringValue(rule.IntervalUnit),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmEventSource(source *dlm.EventSource) []interface{} {
+	if source == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"type": aws.StringValue(source.Type),
+	}
+
+	if source.Parameters != nil {
+		m["parameters"] = []interface{}{
+			map[string]interface{}{
+				"description_regex": aws.StringValue(source.Parameters.DescriptionRegex),
+				"event_type":        aws.StringValue(source.Parameters.EventType),
+				"snapshot_owner":    flattenStringList(source.Parameters.SnapshotOwner),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenDlmActions(actions []*dlm.Action) []interface{} {
+	l := make([]interface{}, 0, len(actions))
+
+	for _, action := range actions {
+		copies := make([]interface{}, 0, len(action.CrossRegionCopy))
+		for _, c := range action.CrossRegionCopy {
+			cm := map[string]interface{}{
+				"retain_rule": flattenDlmCrossRegionCopyRetainRule(c.RetainRule),
+				"target":      aws.StringValue(c.Target),
+			}
+
+			if c.EncryptionConfiguration != nil {
+				cm["encryption_configuration"] = []interface{}{
+					map[string]interface{}{
+						"cmk_arn":   aws.StringValue(c.EncryptionConfiguration.CmkArn),
+						"encrypted": aws.BoolValue(c.EncryptionConfiguration.Encrypted),
+					},
+				}
+			}
+
+			copies = append(copies, cm)
+		}
+
+		m := map[string]interface{}{
+			"cross_region_copy": copies,
+			"name":              aws.StringValue(action.Name),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenDlmTags(tags []*dlm.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
@@ -0,0 +1,429 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDlmLifecyclePolicy_basic(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:policy/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-acc-basic"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.policy_type", "EBS_SNAPSHOT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.resource_types.0", "VOLUME"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.name", "tf-acc-basic"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.create_rule.0.interval", "12"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.retain_rule.0.count", "10"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.target_tags.tf-acc-test", "basic"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDlmLifecyclePolicy_crossRegionCopyRule(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigCrossRegionCopyRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.cross_region_copy_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.cross_region_copy_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDlmLifecyclePolicy_fastRestoreRule(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigFastRestoreRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.fast_restore_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.fast_restore_rule.0.availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.fast_restore_rule.0.count", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDlmLifecyclePolicy_eventBasedPolicy(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigEventBasedPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.policy_type", "EVENT_BASED_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.type", "MANAGED_CWE"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.parameters.0.event_type", "shareSnapshot"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.action.0.name", "tf-acc-event"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.action.0.cross_region_copy.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDlmLifecyclePolicy_tags(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDlmLifecyclePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dlmconn
+
+		_, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsDlmLifecyclePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dlmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dlm_lifecycle_policy" {
+			continue
+		}
+
+		_, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DLM Lifecycle Policy (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAwsDlmLifecyclePolicyCopyTargetRegion returns a region other than
+// the one under test to copy snapshots into.
+func testAccAwsDlmLifecyclePolicyCopyTargetRegion() string {
+	if testAccGetRegion() == "us-east-1" {
+		return "us-west-2"
+	}
+	return "us-east-1"
+}
+
+func testAccAwsDlmLifecyclePolicyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "dlm.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSDataLifecycleManagerServiceRole"
+  role       = "${aws_iam_role.test.name}"
+}
+`, rName)
+}
+
+func testAccAwsDlmLifecyclePolicyConfig(rName string) string {
+	return testAccAwsDlmLifecyclePolicyConfigBase(rName) + `
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-basic"
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  policy_details {
+    resource_types = ["VOLUME"]
+
+    schedule {
+      name = "tf-acc-basic"
+
+      create_rule {
+        interval = 12
+      }
+
+      retain_rule {
+        count = 10
+      }
+    }
+
+    target_tags {
+      tf-acc-test = "basic"
+    }
+  }
+}
+`
+}
+
+func testAccAwsDlmLifecyclePolicyConfigCrossRegionCopyRule(rName string) string {
+	return testAccAwsDlmLifecyclePolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-basic"
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  policy_details {
+    resource_types = ["VOLUME"]
+
+    schedule {
+      name = "tf-acc-basic"
+
+      create_rule {
+        interval = 12
+      }
+
+      retain_rule {
+        count = 10
+      }
+
+      cross_region_copy_rule {
+        target    = %[1]q
+        encrypted = false
+        copy_tags = true
+
+        retain_rule {
+          interval      = 15
+          interval_unit = "DAYS"
+        }
+      }
+    }
+
+    target_tags {
+      tf-acc-test = "basic"
+    }
+  }
+}
+`, testAccAwsDlmLifecyclePolicyCopyTargetRegion())
+}
+
+func testAccAwsDlmLifecyclePolicyConfigFastRestoreRule(rName string) string {
+	return testAccAwsDlmLifecyclePolicyConfigBase(rName) + `
+data "aws_availability_zones" "available" {}
+
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-basic"
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  policy_details {
+    resource_types = ["VOLUME"]
+
+    schedule {
+      name = "tf-acc-basic"
+
+      create_rule {
+        interval      = 24
+        interval_unit = "HOURS"
+        times         = ["23:45"]
+      }
+
+      retain_rule {
+        count = 10
+      }
+
+      fast_restore_rule {
+        availability_zones = ["${slice(data.aws_availability_zones.available.names, 0, 2)}"]
+        count              = 5
+      }
+    }
+
+    target_tags {
+      tf-acc-test = "basic"
+    }
+  }
+}
+`
+}
+
+func testAccAwsDlmLifecyclePolicyConfigEventBasedPolicy(rName string) string {
+	return testAccAwsDlmLifecyclePolicyConfigBase(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-event"
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  policy_details {
+    policy_type = "EVENT_BASED_POLICY"
+
+    action {
+      name = "tf-acc-event"
+
+      cross_region_copy {
+        target = %[1]q
+
+        encryption_configuration {}
+
+        retain_rule {
+          interval      = 15
+          interval_unit = "MONTHS"
+        }
+      }
+    }
+
+    event_source {
+      type = "MANAGED_CWE"
+
+      parameters {
+        description_regex = "^.*Created for policy: policy-1234567890abcdef0.*$"
+        event_type        = "shareSnapshot"
+        snapshot_owner    = ["${data.aws_caller_identity.current.account_id}"]
+      }
+    }
+  }
+}
+`, testAccAwsDlmLifecyclePolicyCopyTargetRegion())
+}
+
+func testAccAwsDlmLifecyclePolicyConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsDlmLifecyclePolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-basic"
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  policy_details {
+    resource_types = ["VOLUME"]
+
+    schedule {
+      name = "tf-acc-basic"
+
+      create_rule {
+        interval = 12
+      }
+
+      retain_rule {
+        count = 10
+      }
+    }
+
+    target_tags {
+      tf-acc-test = "basic"
+    }
+  }
+
+  tags {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
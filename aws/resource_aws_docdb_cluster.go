@@ -0,0 +1,683 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDocDBCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDocDBClusterCreate,
+		Read:   resourceAwsDocDBClusterRead,
+		Update: resourceAwsDocDBClusterUpdate,
+		Delete: resourceAwsDocDBClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDocDBClusterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// apply_immediately is used to determine when the update modifications
+			// take place.
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Set:      schema.HashString,
+			},
+
+			"backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtMost(35),
+			},
+
+			"cluster_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_identifier_prefix"},
+				ValidateFunc:  validateRdsIdentifier,
+			},
+
+			"cluster_identifier_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifierPrefix,
+			},
+
+			"cluster_members": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+				Set:      schema.HashString,
+			},
+
+			"cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"db_subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"enabled_cloudwatch_logs_exports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"audit",
+						"profiler",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "docdb",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"docdb"}, false),
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"final_snapshot_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"master_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"master_username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  27017,
+				ForceNew: true,
+			},
+
+			"preferred_backup_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					if val == nil {
+						return ""
+					}
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+
+			"reader_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "iopt1"}, false),
+			},
+
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDocDBClusterImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
+	// from any API call, so we need to default skip_final_snapshot to true so
+	// that final_snapshot_identifier is not required
+	d.Set("skip_final_snapshot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	var identifier string
+	if v, ok := d.GetOk("cluster_identifier"); ok {
+		identifier = v.(string)
+	} else {
+		if v, ok := d.GetOk("cluster_identifier_prefix"); ok {
+			identifier = resource.PrefixedUniqueId(v.(string))
+		} else {
+			identifier = resource.PrefixedUniqueId("tf-")
+		}
+	}
+
+	input := &docdb.CreateDBClusterInput{
+		BackupRetentionPeriod: aws.Int64(int64(d.Get("backup_retention_period").(int))),
+		DBClusterIdentifier:   aws.String(identifier),
+		Engine:                aws.String(d.Get("engine").(string)),
+		Port:                  aws.Int64(int64(d.Get("port").(int))),
+		StorageEncrypted:      aws.Bool(d.Get("storage_encrypted").(bool)),
+		Tags:                  tagsFromMapDocDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	if attr := d.Get("availability_zones").(*schema.Set); attr.Len() > 0 {
+		input.AvailabilityZones = expandStringSet(attr)
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("db_cluster_parameter_group_name"); ok {
+		input.DBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_subnet_group_name"); ok {
+		input.DBSubnetGroupName = aws.String(v.(string))
+	}
+
+	if attr := d.Get("enabled_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+		input.EnableCloudwatchLogsExports = expandStringSet(attr)
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("global_cluster_identifier"); ok {
+		input.GlobalClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("master_password"); ok {
+		input.MasterUserPassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("master_username"); ok {
+		input.MasterUsername = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_backup_window"); ok {
+		input.PreferredBackupWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_type"); ok {
+		input.StorageType = aws.String(v.(string))
+	}
+
+	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+		input.VpcSecurityGroupIds = expandStringSet(attr)
+	}
+
+	log.Printf("[DEBUG] Creating DocDB Cluster: %s", input)
+	if _, err := conn.CreateDBCluster(input); err != nil {
+		return fmt.Errorf("error creating DocDB Cluster: %s", err)
+	}
+
+	d.SetId(identifier)
+
+	log.Printf("[INFO] Waiting for DocDB Cluster (%s) to be available", d.Id())
+	if err := waitForDocDBClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DocDB Cluster (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDocDBClusterRead(d, meta)
+}
+
+func resourceAwsDocDBClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	dbc, err := getDocDBCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error describing DocDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	if dbc == nil {
+		log.Printf("[WARN] DocDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones)); err != nil {
+		return fmt.Errorf("error setting availability_zones: %s", err)
+	}
+
+	d.Set("arn", dbc.DBClusterArn)
+	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
+	d.Set("cluster_identifier", dbc.DBClusterIdentifier)
+	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("deletion_protection", dbc.DeletionProtection)
+	d.Set("endpoint", dbc.Endpoint)
+	d.Set("engine", dbc.Engine)
+	d.Set("engine_version", dbc.EngineVersion)
+	d.Set("db_cluster_parameter_group_name", dbc.DBClusterParameterGroup)
+	d.Set("db_subnet_group_name", dbc.DBSubnetGroup)
+	d.Set("hosted_zone_id", dbc.HostedZoneId)
+	d.Set("kms_key_id", dbc.KmsKeyId)
+	d.Set("master_username", dbc.MasterUsername)
+	d.Set("port", dbc.Port)
+	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
+	d.Set("reader_endpoint", dbc.ReaderEndpoint)
+	d.Set("storage_encrypted", dbc.StorageEncrypted)
+	d.Set("storage_type", dbc.StorageType)
+
+	if err := d.Set("enabled_cloudwatch_logs_exports", aws.StringValueSlice(dbc.EnabledCloudwatchLogsExports)); err != nil {
+		return fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err)
+	}
+
+	// The cluster description does not include its global cluster membership
+	globalClusterId, err := getDocDBGlobalClusterIdentifierByClusterArn(conn, aws.StringValue(dbc.DBClusterArn))
+	if err != nil {
+		return fmt.Errorf("error reading DocDB Global Cluster membership for DocDB Cluster (%s): %s", d.Id(), err)
+	}
+	d.Set("global_cluster_identifier", globalClusterId)
+
+	var cm []string
+	for _, m := range dbc.DBClusterMembers {
+		cm = append(cm, aws.StringValue(m.DBInstanceIdentifier))
+	}
+	if err := d.Set("cluster_members", cm); err != nil {
+		return fmt.Errorf("error setting cluster_members: %s", err)
+	}
+
+	var vpcg []string
+	for _, g := range dbc.VpcSecurityGroups {
+		vpcg = append(vpcg, aws.StringValue(g.VpcSecurityGroupId))
+	}
+	if err := d.Set("vpc_security_group_ids", vpcg); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+	}
+
+	if err := saveTagsDocDB(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+		return fmt.Errorf("error setting tags for DocDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+	requestUpdate := false
+
+	req := &docdb.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
+		DBClusterIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("backup_retention_period") {
+		req.BackupRetentionPeriod = aws.Int64(int64(d.Get("backup_retention_period").(int)))
+		requestUpdate = true
+	}
+
+	if d.HasChange("deletion_protection") {
+		req.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("db_cluster_parameter_group_name") {
+		req.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("enabled_cloudwatch_logs_exports") {
+		o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		req.CloudwatchLogsExportConfiguration = &docdb.CloudwatchLogsExportConfiguration{
+			DisableLogTypes: expandStringSet(os.Difference(ns)),
+			EnableLogTypes:  expandStringSet(ns.Difference(os)),
+		}
+		requestUpdate = true
+	}
+
+	if d.HasChange("master_password") {
+		req.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		req.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("storage_type") {
+		req.StorageType = aws.String(d.Get("storage_type").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("vpc_security_group_ids") {
+		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+			req.VpcSecurityGroupIds = expandStringSet(attr)
+		} else {
+			req.VpcSecurityGroupIds = []*string{}
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying DocDB Cluster: %s", req)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.ModifyDBCluster(req)
+			if err != nil {
+				if isAWSErr(err, docdb.ErrCodeInvalidDBClusterStateFault, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying DocDB Cluster (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Waiting for DocDB Cluster (%s) to be available", d.Id())
+		if err := waitForDocDBClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for DocDB Cluster (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("global_cluster_identifier") {
+		o, n := d.GetChange("global_cluster_identifier")
+		if o.(string) == "" {
+			return fmt.Errorf("existing DocDB Clusters cannot be added to a DocDB Global Cluster")
+		}
+		if n.(string) != "" {
+			return fmt.Errorf("existing DocDB Clusters cannot be migrated between DocDB Global Clusters")
+		}
+
+		if err := removeDocDBClusterFromGlobalCluster(conn, o.(string), d.Get("arn").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsDocDB(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for DocDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsDocDBClusterRead(d, meta)
+}
+
+func resourceAwsDocDBClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	if v, ok := d.GetOk("global_cluster_identifier"); ok {
+		if err := removeDocDBClusterFromGlobalCluster(conn, v.(string), d.Get("arn").(string)); err != nil {
+			return err
+		}
+	}
+
+	input := &docdb.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(d.Id()),
+		SkipFinalSnapshot:   aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) {
+		v, ok := d.GetOk("final_snapshot_identifier")
+		if !ok {
+			return fmt.Errorf("DocDB Cluster final_snapshot_identifier is required when skip_final_snapshot is false")
+		}
+		input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting DocDB Cluster: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteDBCluster(input)
+		if err != nil {
+			if isAWSErr(err, docdb.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, docdb.ErrCodeDBClusterNotFoundFault, "") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting DocDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsDocDBClusterDeletePendingStates,
+		Target:     []string{"destroyed"},
+		Refresh:    resourceAwsDocDBClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DocDB Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getDocDBCluster(conn *docdb.DocDB, id string) (*docdb.DBCluster, error) {
+	resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeDBClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, c := range resp.DBClusters {
+		if aws.StringValue(c.DBClusterIdentifier) == id {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsDocDBClusterStateRefreshFunc(conn *docdb.DocDB, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dbc, err := getDocDBCluster(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if dbc == nil {
+			return 42, "destroyed", nil
+		}
+
+		log.Printf("[DEBUG] DocDB Cluster status (%s): %s", id, aws.StringValue(dbc.Status))
+		return dbc, aws.StringValue(dbc.Status), nil
+	}
+}
+
+func waitForDocDBClusterAvailable(conn *docdb.DocDB, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsDocDBClusterPendingStates,
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDocDBClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func removeDocDBClusterFromGlobalCluster(conn *docdb.DocDB, globalClusterId, clusterArn string) error {
+	input := &docdb.RemoveFromGlobalClusterInput{
+		DbClusterIdentifier:     aws.String(clusterArn),
+		GlobalClusterIdentifier: aws.String(globalClusterId),
+	}
+
+	log.Printf("[DEBUG] Removing DocDB Cluster (%s) from DocDB Global Cluster (%s)", clusterArn, globalClusterId)
+	_, err := conn.RemoveFromGlobalCluster(input)
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeDBClusterNotFoundFault, "") || isAWSErr(err, docdb.ErrCodeGlobalClusterNotFoundFault, "") || isAWSErr(err, "InvalidParameterValue", "is not found in global cluster") {
+			return nil
+		}
+		return fmt.Errorf("error removing DocDB Cluster (%s) from DocDB Global Cluster (%s): %s", clusterArn, globalClusterId, err)
+	}
+
+	return nil
+}
+
+func getDocDBGlobalClusterIdentifierByClusterArn(conn *docdb.DocDB, clusterArn string) (string, error) {
+	var globalClusterId string
+
+	err := conn.DescribeGlobalClustersPages(&docdb.DescribeGlobalClustersInput{}, func(page *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
+		for _, globalCluster := range page.GlobalClusters {
+			for _, member := range globalCluster.GlobalClusterMembers {
+				if aws.StringValue(member.DBClusterArn) == clusterArn {
+					globalClusterId = aws.StringValue(globalCluster.GlobalClusterIdentifier)
+					return false
+				}
+			}
+		}
+		return !lastPage
+	})
+
+	return globalClusterId, err
+}
+
+var resourceAwsDocDBClusterPendingStates = []string{
+	"backing-up",
+	"creating",
+	"modifying",
+	"preparing-data-migration",
+	"migrating",
+	"resetting-master-credentials",
+	"upgrading",
+}
+
+var resourceAwsDocDBClusterDeletePendingStates = []string{
+	"available",
+	"backing-up",
+	"deleting",
+	"modifying",
+}
@@ -0,0 +1,464 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDocDBClusterInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDocDBClusterInstanceCreate,
+		Read:   resourceAwsDocDBClusterInstanceRead,
+		Update: resourceAwsDocDBClusterInstanceUpdate,
+		Delete: resourceAwsDocDBClusterInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// apply_immediately is used to determine when the update modifications
+			// take place.
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"ca_cert_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"db_subnet_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dbi_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enable_performance_insights": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "docdb",
+				ValidateFunc: validation.StringInSlice([]string{"docdb"}, false),
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"identifier_prefix"},
+				ValidateFunc:  validateRdsIdentifier,
+			},
+
+			"identifier_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifierPrefix,
+			},
+
+			"instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"performance_insights_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"preferred_backup_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(v interface{}) string {
+					if v != nil {
+						value := v.(string)
+						return strings.ToLower(value)
+					}
+					return ""
+				},
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+
+			"promotion_tier": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 15),
+			},
+
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"writer": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDocDBClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	createOpts := &docdb.CreateDBInstanceInput{
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		DBClusterIdentifier:     aws.String(d.Get("cluster_identifier").(string)),
+		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
+		Engine:                  aws.String(d.Get("engine").(string)),
+		PromotionTier:           aws.Int64(int64(d.Get("promotion_tier").(int))),
+		Tags:                    tagsFromMapDocDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("identifier"); ok {
+		createOpts.DBInstanceIdentifier = aws.String(v.(string))
+	} else {
+		if v, ok := d.GetOk("identifier_prefix"); ok {
+			createOpts.DBInstanceIdentifier = aws.String(resource.PrefixedUniqueId(v.(string)))
+		} else {
+			createOpts.DBInstanceIdentifier = aws.String(resource.PrefixedUniqueId("tf-"))
+		}
+	}
+
+	if attr, ok := d.GetOk("availability_zone"); ok {
+		createOpts.AvailabilityZone = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("ca_cert_identifier"); ok {
+		createOpts.CACertificateIdentifier = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("enable_performance_insights"); ok {
+		createOpts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+	}
+
+	if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+		createOpts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("preferred_maintenance_window"); ok {
+		createOpts.PreferredMaintenanceWindow = aws.String(attr.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DocDB Cluster Instance: %s", createOpts)
+	resp, err := conn.CreateDBInstance(createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating DocDB Cluster Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DBInstance.DBInstanceIdentifier))
+
+	if err := waitForDocDBClusterInstanceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DocDB Cluster Instance (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDocDBClusterInstanceRead(d, meta)
+}
+
+func resourceAwsDocDBClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	db, err := getDocDBClusterInstance(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error describing DocDB Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	if db == nil {
+		log.Printf("[WARN] DocDB Cluster Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Retrieve DB Cluster information, to determine if this Instance is a writer
+	dbc, err := getDocDBCluster(conn, aws.StringValue(db.DBClusterIdentifier))
+	if err != nil {
+		return fmt.Errorf("error describing DocDB Cluster (%s): %s", aws.StringValue(db.DBClusterIdentifier), err)
+	}
+
+	if dbc == nil {
+		return fmt.Errorf("DocDB Cluster (%s) for DocDB Cluster Instance (%s) not found", aws.StringValue(db.DBClusterIdentifier), d.Id())
+	}
+
+	for _, m := range dbc.DBClusterMembers {
+		if aws.StringValue(m.DBInstanceIdentifier) == d.Id() {
+			d.Set("writer", aws.BoolValue(m.IsClusterWriter))
+		}
+	}
+
+	if db.Endpoint != nil {
+		d.Set("endpoint", db.Endpoint.Address)
+		d.Set("port", db.Endpoint.Port)
+	}
+
+	if db.DBSubnetGroup != nil {
+		d.Set("db_subnet_group_name", db.DBSubnetGroup.DBSubnetGroupName)
+	}
+
+	d.Set("arn", db.DBInstanceArn)
+	d.Set("auto_minor_version_upgrade", db.AutoMinorVersionUpgrade)
+	d.Set("availability_zone", db.AvailabilityZone)
+	d.Set("ca_cert_identifier", db.CACertificateIdentifier)
+	d.Set("cluster_identifier", db.DBClusterIdentifier)
+	d.Set("dbi_resource_id", db.DbiResourceId)
+	d.Set("enable_performance_insights", db.PerformanceInsightsEnabled)
+	d.Set("engine", db.Engine)
+	d.Set("engine_version", db.EngineVersion)
+	d.Set("identifier", db.DBInstanceIdentifier)
+	d.Set("instance_class", db.DBInstanceClass)
+	d.Set("kms_key_id", db.KmsKeyId)
+	d.Set("performance_insights_kms_key_id", db.PerformanceInsightsKMSKeyId)
+	d.Set("preferred_backup_window", db.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", db.PreferredMaintenanceWindow)
+	d.Set("promotion_tier", db.PromotionTier)
+	d.Set("publicly_accessible", db.PubliclyAccessible)
+	d.Set("storage_encrypted", db.StorageEncrypted)
+
+	if err := saveTagsDocDB(conn, d, aws.StringValue(db.DBInstanceArn)); err != nil {
+		return fmt.Errorf("error setting tags for DocDB Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDocDBClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+	requestUpdate := false
+
+	req := &docdb.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
+		DBInstanceIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("auto_minor_version_upgrade") {
+		req.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("ca_cert_identifier") {
+		req.CACertificateIdentifier = aws.String(d.Get("ca_cert_identifier").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("enable_performance_insights") {
+		req.EnablePerformanceInsights = aws.Bool(d.Get("enable_performance_insights").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("instance_class") {
+		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("performance_insights_kms_key_id") {
+		req.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("promotion_tier") {
+		req.PromotionTier = aws.Int64(int64(d.Get("promotion_tier").(int)))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying DocDB Cluster Instance: %s", req)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.ModifyDBInstance(req)
+			if err != nil {
+				if isAWSErr(err, docdb.ErrCodeInvalidDBInstanceStateFault, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying DocDB Cluster Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDocDBClusterInstanceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for DocDB Cluster Instance (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDocDB(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for DocDB Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsDocDBClusterInstanceRead(d, meta)
+}
+
+func resourceAwsDocDBClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	log.Printf("[DEBUG] Deleting DocDB Cluster Instance (%s)", d.Id())
+	_, err := conn.DeleteDBInstance(&docdb.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeDBInstanceNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting DocDB Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"configuring-log-exports", "modifying", "deleting"},
+		Target:     []string{"destroyed"},
+		Refresh:    resourceAwsDocDBClusterInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DocDB Cluster Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getDocDBClusterInstance(conn *docdb.DocDB, id string) (*docdb.DBInstance, error) {
+	resp, err := conn.DescribeDBInstances(&docdb.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeDBInstanceNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, db := range resp.DBInstances {
+		if aws.StringValue(db.DBInstanceIdentifier) == id {
+			return db, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsDocDBClusterInstanceStateRefreshFunc(conn *docdb.DocDB, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		db, err := getDocDBClusterInstance(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if db == nil {
+			return 42, "destroyed", nil
+		}
+
+		log.Printf("[DEBUG] DocDB Cluster Instance status (%s): %s", id, aws.StringValue(db.DBInstanceStatus))
+		return db, aws.StringValue(db.DBInstanceStatus), nil
+	}
+}
+
+func waitForDocDBClusterInstanceAvailable(conn *docdb.DocDB, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"backing-up", "configuring-log-exports", "creating", "maintenance",
+			"modifying", "rebooting", "renaming", "resetting-master-credentials",
+			"starting", "storage-optimization", "upgrading"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDocDBClusterInstanceStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDocDBClusterInstance_basic(t *testing.T) {
+	var v docdb.DBInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig(rName, "db.r5.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds:[^:]+:\d{12}:db:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.large"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttr(resourceName, "writer", "true"),
+					resource.TestCheckResourceAttr(resourceName, "promotion_tier", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "dbi_resource_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBClusterInstance_instanceClass(t *testing.T) {
+	var v docdb.DBInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig(rName, "db.r5.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.large"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig(rName, "db.r5.xlarge"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					testAccCheckAWSDocDBClusterInstanceNotRecreated(rName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBClusterInstance_performanceInsights(t *testing.T) {
+	var v docdb.DBInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig_performanceInsights(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "performance_insights_kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBClusterInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_docdb_cluster_instance" {
+			continue
+		}
+
+		db, err := getDocDBClusterInstance(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if db != nil {
+			return fmt.Errorf("DocDB Cluster Instance (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDocDBClusterInstanceExists(n string, v *docdb.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Cluster Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+		db, err := getDocDBClusterInstance(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if db == nil {
+			return fmt.Errorf("DocDB Cluster Instance (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *db
+
+		return nil
+	}
+}
+
+// testAccCheckAWSDocDBClusterInstanceNotRecreated ensures an instance class
+// change was applied in place rather than by replacing the instance.
+func testAccCheckAWSDocDBClusterInstanceNotRecreated(identifier string, v *docdb.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+		db, err := getDocDBClusterInstance(conn, identifier)
+		if err != nil {
+			return err
+		}
+
+		if db == nil || db.InstanceCreateTime == nil || v.InstanceCreateTime == nil {
+			return fmt.Errorf("DocDB Cluster Instance (%s) not found", identifier)
+		}
+
+		if !db.InstanceCreateTime.Equal(*v.InstanceCreateTime) {
+			return fmt.Errorf("DocDB Cluster Instance (%s) was recreated", identifier)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDocDBClusterInstanceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+`, rName)
+}
+
+func testAccAWSDocDBClusterInstanceConfig(rName, instanceClass string) string {
+	return testAccAWSDocDBClusterInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = "${aws_docdb_cluster.test.id}"
+  instance_class     = %[2]q
+  apply_immediately  = true
+}
+`, rName, instanceClass)
+}
+
+func testAccAWSDocDBClusterInstanceConfig_performanceInsights(rName string) string {
+	return testAccAWSDocDBClusterInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier                      = %[1]q
+  cluster_identifier              = "${aws_docdb_cluster.test.id}"
+  instance_class                  = "db.r5.large"
+  enable_performance_insights     = true
+  performance_insights_kms_key_id = "${aws_kms_key.test.arn}"
+}
+`, rName)
+}
@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDocDBCluster_basic(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds:[^:]+:\d{12}:cluster:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "master_username", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "port", "27017"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "reader_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "hosted_zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_namePrefix(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_namePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestMatchResourceAttr(resourceName, "cluster_identifier", regexp.MustCompile("^tf-test-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_enabledCloudwatchLogsExports(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_enabledCloudwatchLogsExports(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_storageType(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_storageType(rName, "iopt1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "iopt1"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfig_storageType(rName, "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "standard"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_globalClusterIdentifier(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_globalClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "global_cluster_identifier", "aws_docdb_global_cluster.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_updateTags(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfig_updatedTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_docdb_cluster" {
+			continue
+		}
+
+		dbc, err := getDocDBCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if dbc != nil {
+			return fmt.Errorf("DocDB Cluster (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDocDBClusterExists(n string, v *docdb.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+		dbc, err := getDocDBCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if dbc == nil {
+			return fmt.Errorf("DocDB Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *dbc
+
+		return nil
+	}
+}
+
+func testAccAWSDocDBClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+  apply_immediately   = true
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSDocDBClusterConfig_updatedTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+
+  tags {
+    Name        = %[1]q
+    Environment = "test"
+  }
+}
+`, rName)
+}
+
+const testAccAWSDocDBClusterConfig_namePrefix = `
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier_prefix = "tf-test-"
+  master_username           = "foo"
+  master_password           = "mustbeeightcharaters"
+  skip_final_snapshot       = true
+}
+`
+
+func testAccAWSDocDBClusterConfig_enabledCloudwatchLogsExports(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+  enabled_cloudwatch_logs_exports = ["audit", "profiler"]
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSDocDBClusterConfig_storageType(rName, storageType string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine_version      = "5.0.0"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+  apply_immediately   = true
+  storage_type        = %[2]q
+}
+`, rName, storageType)
+}
+
+func testAccAWSDocDBClusterConfig_globalClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "docdb"
+  engine_version            = "4.0.0"
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier        = %[1]q
+  engine_version            = "${aws_docdb_global_cluster.test.engine_version}"
+  global_cluster_identifier = "${aws_docdb_global_cluster.test.id}"
+  master_username           = "foo"
+  master_password           = "mustbeeightcharaters"
+  skip_final_snapshot       = true
+}
+`, rName)
+}
@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDocDBGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDocDBGlobalClusterCreate,
+		Read:   resourceAwsDocDBGlobalClusterRead,
+		Update: resourceAwsDocDBGlobalClusterUpdate,
+		Delete: resourceAwsDocDBGlobalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_db_cluster_identifier"},
+				ValidateFunc:  validation.StringInSlice([]string{"docdb"}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDocDBGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	input := &docdb.CreateGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Get("global_cluster_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("database_name"); ok {
+		input.DatabaseName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_db_cluster_identifier"); ok {
+		input.SourceDBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_encrypted"); ok {
+		input.StorageEncrypted = aws.Bool(v.(bool))
+	}
+
+	// DocDB requires an engine unless a source cluster is given
+	if input.Engine == nil && input.SourceDBClusterIdentifier == nil {
+		input.Engine = aws.String("docdb")
+	}
+
+	log.Printf("[DEBUG] Creating DocDB Global Cluster: %s", input)
+	output, err := conn.CreateGlobalCluster(input)
+	if err != nil {
+		return fmt.Errorf("error creating DocDB Global Cluster: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
+
+	if err := waitForDocDBGlobalClusterAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for DocDB Global Cluster (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDocDBGlobalClusterRead(d, meta)
+}
+
+func resourceAwsDocDBGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	globalCluster, err := getDocDBGlobalCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading DocDB Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	if globalCluster == nil || aws.StringValue(globalCluster.Status) == "deleting" {
+		log.Printf("[WARN] DocDB Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalCluster.GlobalClusterArn)
+	d.Set("database_name", globalCluster.DatabaseName)
+	d.Set("deletion_protection", globalCluster.DeletionProtection)
+	d.Set("engine", globalCluster.Engine)
+	d.Set("engine_version", globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("status", globalCluster.Status)
+	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+
+	if err := d.Set("global_cluster_members", flattenDocDBGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return fmt.Errorf("error setting global_cluster_members: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDocDBGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	if d.HasChange("deletion_protection") {
+		input := &docdb.ModifyGlobalClusterInput{
+			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
+			GlobalClusterIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating DocDB Global Cluster: %s", input)
+		if _, err := conn.ModifyGlobalCluster(input); err != nil {
+			if isAWSErr(err, docdb.ErrCodeGlobalClusterNotFoundFault, "") {
+				return nil
+			}
+			return fmt.Errorf("error updating DocDB Global Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDocDBGlobalClusterAvailable(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for DocDB Global Cluster (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDocDBGlobalClusterRead(d, meta)
+}
+
+func resourceAwsDocDBGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	input := &docdb.DeleteGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DocDB Global Cluster (%s)", d.Id())
+	// Members are removed by their own resources, which may still be in progress
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalCluster(input)
+		if err != nil {
+			if isAWSErr(err, docdb.ErrCodeInvalidGlobalClusterStateFault, "is not empty") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeGlobalClusterNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting DocDB Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceAwsDocDBGlobalClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DocDB Global Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getDocDBGlobalCluster(conn *docdb.DocDB, id string) (*docdb.GlobalCluster, error) {
+	output, err := conn.DescribeGlobalClusters(&docdb.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, docdb.ErrCodeGlobalClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, globalCluster := range output.GlobalClusters {
+		if aws.StringValue(globalCluster.GlobalClusterIdentifier) == id {
+			return globalCluster, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsDocDBGlobalClusterStateRefreshFunc(conn *docdb.DocDB, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalCluster, err := getDocDBGlobalCluster(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if globalCluster == nil {
+			return 42, "deleted", nil
+		}
+
+		return globalCluster, aws.StringValue(globalCluster.Status), nil
+	}
+}
+
+func waitForDocDBGlobalClusterAvailable(conn *docdb.DocDB, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying", "upgrading"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDocDBGlobalClusterStateRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func flattenDocDBGlobalClusterMembers(members []*docdb.GlobalClusterMember) []interface{} {
+	l := make([]interface{}, 0, len(members))
+
+	for _, member := range members {
+		l = append(l, map[string]interface{}{
+			"db_cluster_arn": aws.StringValue(member.DBClusterArn),
+			"is_writer":      aws.BoolValue(member.IsWriter),
+		})
+	}
+
+	return l
+}
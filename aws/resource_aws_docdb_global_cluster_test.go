@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDocDBGlobalCluster_basic(t *testing.T) {
+	var globalCluster docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds::\d{12}:global-cluster:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "global_cluster_resource_id", regexp.MustCompile(`cluster-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_deletionProtection(t *testing.T) {
+	var globalCluster docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_sourceDbClusterIdentifier(t *testing.T) {
+	var globalCluster docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig_sourceDbClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_identifier", "aws_docdb_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBGlobalClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_docdb_global_cluster" {
+			continue
+		}
+
+		globalCluster, err := getDocDBGlobalCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if globalCluster != nil {
+			return fmt.Errorf("DocDB Global Cluster (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDocDBGlobalClusterExists(n string, v *docdb.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Global Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+		globalCluster, err := getDocDBGlobalCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if globalCluster == nil {
+			return fmt.Errorf("DocDB Global Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *globalCluster
+
+		return nil
+	}
+}
+
+func testAccAWSDocDBGlobalClusterConfig(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "docdb"
+  engine_version            = "4.0.0"
+  deletion_protection       = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccAWSDocDBGlobalClusterConfig_sourceDbClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine_version      = "4.0.0"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+
+  # global_cluster_identifier cannot be Computed
+  lifecycle {
+    ignore_changes = ["global_cluster_identifier"]
+  }
+}
+
+resource "aws_docdb_global_cluster" "test" {
+  global_cluster_identifier    = %[1]q
+  source_db_cluster_identifier = "${aws_docdb_cluster.test.arn}"
+}
+`, rName)
+}
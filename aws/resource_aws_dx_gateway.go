@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxGatewayCreate,
+		Read:   resourceAwsDxGatewayRead,
+		Update: resourceAwsDxGatewayUpdate,
+		Delete: resourceAwsDxGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"amazon_side_asn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAmazonSideAsn,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	asn, err := strconv.ParseInt(d.Get("amazon_side_asn").(string), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	input := &directconnect.CreateDirectConnectGatewayInput{
+		AmazonSideAsn:            aws.Int64(asn),
+		DirectConnectGatewayName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Gateway: %s", input)
+	output, err := conn.CreateDirectConnectGateway(input)
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Gateway: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DirectConnectGateway.DirectConnectGatewayId))
+
+	if err := waitForDxGatewayCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Gateway (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxGatewayRead(d, meta)
+}
+
+func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	gateway, err := dxDescribeGateway(conn, d.Id())
+
+	if isNoSuchDxGatewayErr(err) {
+		log.Printf("[WARN] Direct Connect Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect Gateway (%s): %s", d.Id(), err)
+	}
+
+	if gateway == nil {
+		log.Printf("[WARN] Direct Connect Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(gateway.DirectConnectGatewayState) {
+	case directconnect.GatewayStateDeleting, directconnect.GatewayStateDeleted:
+		log.Printf("[WARN] Direct Connect Gateway (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(gateway.DirectConnectGatewayState))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(gateway.AmazonSideAsn), 10))
+	d.Set("name", gateway.DirectConnectGatewayName)
+	d.Set("owner_account_id", gateway.OwnerAccount)
+
+	return nil
+}
+
+func resourceAwsDxGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	if d.HasChange("name") {
+		input := &directconnect.UpdateDirectConnectGatewayInput{
+			DirectConnectGatewayId:      aws.String(d.Id()),
+			NewDirectConnectGatewayName: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect Gateway (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateDirectConnectGateway(input); err != nil {
+			return fmt.Errorf("error updating Direct Connect Gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDxGatewayRead(d, meta)
+}
+
+func resourceAwsDxGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.DeleteDirectConnectGatewayInput{
+		DirectConnectGatewayId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect Gateway (%s): %s", d.Id(), input)
+	_, err := conn.DeleteDirectConnectGateway(input)
+
+	if isNoSuchDxGatewayErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect Gateway (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDxGatewayDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Gateway (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxGatewayAssociationCreate,
+		Read:   resourceAwsDxGatewayAssociationRead,
+		Update: resourceAwsDxGatewayAssociationUpdate,
+		Delete: resourceAwsDxGatewayAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"associated_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"associated_gateway_owner_account_id", "proposal_id"},
+			},
+			"associated_gateway_owner_account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateAwsAccountId,
+				ConflictsWith: []string{"associated_gateway_id"},
+			},
+			"associated_gateway_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dx_gateway_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dx_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dx_gateway_owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proposal_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"associated_gateway_id"},
+			},
+		},
+	}
+}
+
+func resourceAwsDxGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	dxGatewayId := d.Get("dx_gateway_id").(string)
+	var association *directconnect.GatewayAssociation
+
+	if v, ok := d.GetOk("associated_gateway_id"); ok {
+		input := &directconnect.CreateDirectConnectGatewayAssociationInput{
+			DirectConnectGatewayId: aws.String(dxGatewayId),
+			GatewayId:              aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandDxRouteFilterPrefixes(v.(*schema.Set).List())
+		}
+
+		log.Printf("[DEBUG] Creating Direct Connect Gateway Association: %s", input)
+		output, err := conn.CreateDirectConnectGatewayAssociation(input)
+		if err != nil {
+			return fmt.Errorf("error creating Direct Connect Gateway Association (%s): %s", dxGatewayId, err)
+		}
+
+		association = output.DirectConnectGatewayAssociation
+	} else if v, ok := d.GetOk("proposal_id"); ok {
+		// Accept an association proposal made from the account that owns the
+		// virtual private gateway or transit gateway.
+		ownerAccountId := d.Get("associated_gateway_owner_account_id").(string)
+		if ownerAccountId == "" {
+			return fmt.Errorf("error accepting Direct Connect Gateway Association Proposal (%s): associated_gateway_owner_account_id must be set", v.(string))
+		}
+
+		input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
+			AssociatedGatewayOwnerAccount: aws.String(ownerAccountId),
+			DirectConnectGatewayId:        aws.String(dxGatewayId),
+			ProposalId:                    aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+			input.OverrideAllowedPrefixesToDirectConnectGateway = expandDxRouteFilterPrefixes(v.(*schema.Set).List())
+		}
+
+		log.Printf("[DEBUG] Accepting Direct Connect Gateway Association Proposal: %s", input)
+		output, err := conn.AcceptDirectConnectGatewayAssociationProposal(input)
+		if err != nil {
+			return fmt.Errorf("error accepting Direct Connect Gateway Association Proposal (%s): %s", v.(string), err)
+		}
+
+		association = output.DirectConnectGatewayAssociation
+	} else {
+		return fmt.Errorf("error creating Direct Connect Gateway Association (%s): one of associated_gateway_id or proposal_id must be set", dxGatewayId)
+	}
+
+	d.SetId(aws.StringValue(association.AssociationId))
+
+	if err := waitForDxGatewayAssociationAssociated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Gateway Association (%s) to be associated: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxGatewayAssociationRead(d, meta)
+}
+
+func resourceAwsDxGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	association, err := dxDescribeGatewayAssociation(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if association == nil || aws.StringValue(association.AssociationState) == directconnect.GatewayAssociationStateDisassociated {
+		log.Printf("[WARN] Direct Connect Gateway Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if association.AssociatedGateway != nil {
+		d.Set("associated_gateway_id", association.AssociatedGateway.Id)
+		d.Set("associated_gateway_owner_account_id", association.AssociatedGateway.OwnerAccount)
+		d.Set("associated_gateway_type", association.AssociatedGateway.Type)
+	}
+
+	d.Set("dx_gateway_association_id", association.AssociationId)
+	d.Set("dx_gateway_id", association.DirectConnectGatewayId)
+	d.Set("dx_gateway_owner_account_id", association.DirectConnectGatewayOwnerAccount)
+
+	if err := d.Set("allowed_prefixes", flattenDxRouteFilterPrefixes(association.AllowedPrefixesToDirectConnectGateway)); err != nil {
+		return fmt.Errorf("error setting allowed_prefixes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDxGatewayAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	if d.HasChange("allowed_prefixes") {
+		o, n := d.GetChange("allowed_prefixes")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandDxRouteFilterPrefixes(add)
+		}
+
+		if remove := os.Difference(ns).List(); len(remove) > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandDxRouteFilterPrefixes(remove)
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect Gateway Association (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateDirectConnectGatewayAssociation(input); err != nil {
+			return fmt.Errorf("error updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDxGatewayAssociationAssociated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Direct Connect Gateway Association (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDxGatewayAssociationRead(d, meta)
+}
+
+func resourceAwsDxGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
+		AssociationId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect Gateway Association (%s): %s", d.Id(), input)
+	_, err := conn.DeleteDirectConnectGatewayAssociation(input)
+
+	if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDxGatewayAssociationDisassociated(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Gateway Association (%s) to be disassociated: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxGatewayAssociationProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxGatewayAssociationProposalCreate,
+		Read:   resourceAwsDxGatewayAssociationProposalRead,
+		Delete: resourceAwsDxGatewayAssociationProposalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"associated_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"associated_gateway_owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_gateway_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dx_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dx_gateway_owner_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsDxGatewayAssociationProposalCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.CreateDirectConnectGatewayAssociationProposalInput{
+		DirectConnectGatewayId:           aws.String(d.Get("dx_gateway_id").(string)),
+		DirectConnectGatewayOwnerAccount: aws.String(d.Get("dx_gateway_owner_account_id").(string)),
+		GatewayId:                        aws.String(d.Get("associated_gateway_id").(string)),
+	}
+
+	if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+		input.AddAllowedPrefixesToDirectConnectGateway = expandDxRouteFilterPrefixes(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Gateway Association Proposal: %s", input)
+	output, err := conn.CreateDirectConnectGatewayAssociationProposal(input)
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Gateway Association Proposal: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DirectConnectGatewayAssociationProposal.ProposalId))
+
+	return resourceAwsDxGatewayAssociationProposalRead(d, meta)
+}
+
+func resourceAwsDxGatewayAssociationProposalRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	proposal, err := dxDescribeGatewayAssociationProposal(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect Gateway Association Proposal (%s): %s", d.Id(), err)
+	}
+
+	if proposal == nil || aws.StringValue(proposal.ProposalState) == directconnect.GatewayAssociationProposalStateDeleted {
+		// Proposals are removed once accepted. Keep the resource in state as
+		// long as the association it proposed exists, so that accepting the
+		// proposal does not cause it to be recreated.
+		dxGatewayId := d.Get("dx_gateway_id").(string)
+		associatedGatewayId := d.Get("associated_gateway_id").(string)
+
+		if dxGatewayId != "" && associatedGatewayId != "" {
+			association, err := dxDescribeGatewayAssociationByGateways(conn, dxGatewayId, associatedGatewayId)
+			if err != nil {
+				return fmt.Errorf("error reading Direct Connect Gateway Association (%s/%s): %s", dxGatewayId, associatedGatewayId, err)
+			}
+
+			if association != nil && aws.StringValue(association.AssociationState) != directconnect.GatewayAssociationStateDisassociated {
+				log.Printf("[DEBUG] Direct Connect Gateway Association Proposal (%s) has been accepted", d.Id())
+				return nil
+			}
+		}
+
+		log.Printf("[WARN] Direct Connect Gateway Association Proposal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if proposal.AssociatedGateway != nil {
+		d.Set("associated_gateway_id", proposal.AssociatedGateway.Id)
+		d.Set("associated_gateway_owner_account_id", proposal.AssociatedGateway.OwnerAccount)
+		d.Set("associated_gateway_type", proposal.AssociatedGateway.Type)
+	}
+
+	d.Set("dx_gateway_id", proposal.DirectConnectGatewayId)
+	d.Set("dx_gateway_owner_account_id", proposal.DirectConnectGatewayOwnerAccount)
+
+	if err := d.Set("allowed_prefixes", flattenDxRouteFilterPrefixes(proposal.RequestedAllowedPrefixesToDirectConnectGateway)); err != nil {
+		return fmt.Errorf("error setting allowed_prefixes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDxGatewayAssociationProposalDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.DeleteDirectConnectGatewayAssociationProposalInput{
+		ProposalId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect Gateway Association Proposal (%s): %s", d.Id(), input)
+	_, err := conn.DeleteDirectConnectGatewayAssociationProposal(input)
+
+	if isAWSErr(err, directconnect.ErrCodeClientException, "is not found") || isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect Gateway Association Proposal (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Association proposals are made from the account that owns the virtual
+// private gateway or transit gateway to the account that owns the Direct
+// Connect gateway, so these tests need credentials for a second account.
+func testAccPreCheckAwsDxGatewayAssociationProposal(t *testing.T) {
+	if os.Getenv("DX_GATEWAY_OWNER_PROFILE") == "" {
+		t.Skip("Environment variable DX_GATEWAY_OWNER_PROFILE is not set")
+	}
+}
+
+func TestAccAWSDxGatewayAssociationProposal_basic(t *testing.T) {
+	var proposal directconnect.GatewayAssociationProposal
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	resourceName := "aws_dx_gateway_association_proposal.test"
+	dxGatewayResourceName := "aws_dx_gateway.test"
+	vpnGatewayResourceName := "aws_vpn_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxGatewayAssociationProposal(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationProposalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationProposalConfig(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationProposalExists(resourceName, &proposal),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "associated_gateway_id", vpnGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", directconnect.GatewayTypeVirtualPrivateGateway),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", dxGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_owner_account_id", dxGatewayResourceName, "owner_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDxGatewayAssociationProposal_accepted(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	resourceName := "aws_dx_gateway_association_proposal.test"
+	associationResourceName := "aws_dx_gateway_association.test"
+	vpnGatewayResourceName := "aws_vpn_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxGatewayAssociationProposal(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationProposalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationProposalConfigAccepted(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(associationResourceName, "associated_gateway_id", vpnGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(associationResourceName, "associated_gateway_owner_account_id", resourceName, "associated_gateway_owner_account_id"),
+					resource.TestCheckResourceAttrPair(associationResourceName, "proposal_id", resourceName, "id"),
+				),
+			},
+			{
+				// Accepting the proposal removes it; the plan must stay empty.
+				Config:   testAccDxGatewayAssociationProposalConfigAccepted(rName, rBgpAsn),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxGatewayAssociationProposalExists(resourceName string, proposal *directconnect.GatewayAssociationProposal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Direct Connect Gateway Association Proposal ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		output, err := dxDescribeGatewayAssociationProposal(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Direct Connect Gateway Association Proposal (%s) not found", rs.Primary.ID)
+		}
+
+		*proposal = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDxGatewayAssociationProposalDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_gateway_association_proposal" {
+			continue
+		}
+
+		output, err := dxDescribeGatewayAssociationProposal(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && *output.ProposalState != directconnect.GatewayAssociationProposalStateDeleted {
+			return fmt.Errorf("Direct Connect Gateway Association Proposal (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDxGatewayAssociationProposalConfigBase(rName string, rBgpAsn int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "owner"
+  profile = %[3]q
+}
+
+resource "aws_dx_gateway" "test" {
+  provider = "aws.owner"
+
+  name            = %[1]q
+  amazon_side_asn = "%[2]d"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.255.255.0/28"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_dx_gateway_association_proposal" "test" {
+  dx_gateway_id               = "${aws_dx_gateway.test.id}"
+  dx_gateway_owner_account_id = "${aws_dx_gateway.test.owner_account_id}"
+  associated_gateway_id       = "${aws_vpn_gateway.test.id}"
+}
+`, rName, rBgpAsn, os.Getenv("DX_GATEWAY_OWNER_PROFILE"))
+}
+
+func testAccDxGatewayAssociationProposalConfig(rName string, rBgpAsn int) string {
+	return testAccDxGatewayAssociationProposalConfigBase(rName, rBgpAsn)
+}
+
+func testAccDxGatewayAssociationProposalConfigAccepted(rName string, rBgpAsn int) string {
+	return testAccDxGatewayAssociationProposalConfigBase(rName, rBgpAsn) + `
+data "aws_caller_identity" "current" {}
+
+resource "aws_dx_gateway_association" "test" {
+  provider = "aws.owner"
+
+  dx_gateway_id                       = "${aws_dx_gateway.test.id}"
+  proposal_id                         = "${aws_dx_gateway_association_proposal.test.id}"
+  associated_gateway_owner_account_id = "${data.aws_caller_identity.current.account_id}"
+}
+`
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDxGatewayAssociation_vpnGateway(t *testing.T) {
+	var association directconnect.GatewayAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	resourceName := "aws_dx_gateway_association.test"
+	vpnGatewayResourceName := "aws_vpn_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationConfigVpnGateway(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "associated_gateway_id", vpnGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", directconnect.GatewayTypeVirtualPrivateGateway),
+					resource.TestCheckResourceAttrSet(resourceName, "dx_gateway_association_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDxGatewayAssociation_transitGatewayAllowedPrefixes(t *testing.T) {
+	var association directconnect.GatewayAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	resourceName := "aws_dx_gateway_association.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationConfigTransitGatewayAllowedPrefixes(rName, rBgpAsn, `"10.255.255.0/30", "10.255.255.8/30"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "associated_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", directconnect.GatewayTypeTransitGateway),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDxGatewayAssociationConfigTransitGatewayAllowedPrefixes(rName, rBgpAsn, `"10.255.255.8/29"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxGatewayAssociationExists(resourceName string, association *directconnect.GatewayAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Direct Connect Gateway Association ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		output, err := dxDescribeGatewayAssociation(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Direct Connect Gateway Association (%s) not found", rs.Primary.ID)
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDxGatewayAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_gateway_association" {
+			continue
+		}
+
+		output, err := dxDescribeGatewayAssociation(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && *output.AssociationState != directconnect.GatewayAssociationStateDisassociated {
+			return fmt.Errorf("Direct Connect Gateway Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDxGatewayAssociationConfigVpnGateway(rName string, rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = %[1]q
+  amazon_side_asn = "%[2]d"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.255.255.0/28"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_dx_gateway_association" "test" {
+  dx_gateway_id         = "${aws_dx_gateway.test.id}"
+  associated_gateway_id = "${aws_vpn_gateway.test.id}"
+}
+`, rName, rBgpAsn)
+}
+
+func testAccDxGatewayAssociationConfigTransitGatewayAllowedPrefixes(rName string, rBgpAsn int, allowedPrefixes string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = %[1]q
+  amazon_side_asn = "%[2]d"
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_dx_gateway_association" "test" {
+  dx_gateway_id         = "${aws_dx_gateway.test.id}"
+  associated_gateway_id = "${aws_ec2_transit_gateway.test.id}"
+  allowed_prefixes      = [%[3]s]
+}
+`, rName, rBgpAsn, allowedPrefixes)
+}
@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDxPrivateVirtualInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxPrivateVirtualInterfaceCreate,
+		Read:   resourceAwsDxPrivateVirtualInterfaceRead,
+		Update: resourceAwsDxPrivateVirtualInterfaceUpdate,
+		Delete: resourceAwsDxPrivateVirtualInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directconnect.AddressFamilyIpv4,
+					directconnect.AddressFamilyIpv6,
+				}, false),
+			},
+			"amazon_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"amazon_side_asn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_device": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bgp_auth_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"customer_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"dx_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpn_gateway_id"},
+			},
+			"jumbo_frame_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1500,
+				ValidateFunc: validateIntegerInSlice([]int{1500, 9001}),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sitelink_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"vlan": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"vpn_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dx_gateway_id"},
+			},
+		},
+	}
+}
+
+func resourceAwsDxPrivateVirtualInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	dxGatewayId := d.Get("dx_gateway_id").(string)
+	vpnGatewayId := d.Get("vpn_gateway_id").(string)
+	if dxGatewayId == "" && vpnGatewayId == "" {
+		return fmt.Errorf("error creating Direct Connect Private Virtual Interface: one of dx_gateway_id or vpn_gateway_id must be set")
+	}
+
+	vif := &directconnect.NewPrivateVirtualInterface{
+		AddressFamily:        aws.String(d.Get("address_family").(string)),
+		Asn:                  aws.Int64(int64(d.Get("bgp_asn").(int))),
+		EnableSiteLink:       aws.Bool(d.Get("sitelink_enabled").(bool)),
+		Mtu:                  aws.Int64(int64(d.Get("mtu").(int))),
+		VirtualInterfaceName: aws.String(d.Get("name").(string)),
+		Vlan:                 aws.Int64(int64(d.Get("vlan").(int))),
+	}
+
+	if v, ok := d.GetOk("amazon_address"); ok {
+		vif.AmazonAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("bgp_auth_key"); ok {
+		vif.AuthKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_address"); ok {
+		vif.CustomerAddress = aws.String(v.(string))
+	}
+
+	if dxGatewayId != "" {
+		vif.DirectConnectGatewayId = aws.String(dxGatewayId)
+	}
+
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		vif.Tags = tagsFromMapDX(v.(map[string]interface{}))
+	}
+
+	if vpnGatewayId != "" {
+		vif.VirtualGatewayId = aws.String(vpnGatewayId)
+	}
+
+	input := &directconnect.CreatePrivateVirtualInterfaceInput{
+		ConnectionId:               aws.String(d.Get("connection_id").(string)),
+		NewPrivateVirtualInterface: vif,
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Private Virtual Interface: %s", input)
+	output, err := conn.CreatePrivateVirtualInterface(input)
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Private Virtual Interface: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VirtualInterfaceId))
+
+	if err := waitForDxVirtualInterfaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Private Virtual Interface (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxPrivateVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxPrivateVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	vif, err := dxVirtualInterfaceRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if vif == nil {
+		log.Printf("[WARN] Direct Connect Private Virtual Interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(vif.AmazonSideAsn), 10))
+	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
+	d.Set("jumbo_frame_capable", vif.JumboFrameCapable)
+	d.Set("mtu", vif.Mtu)
+	d.Set("sitelink_enabled", vif.SiteLinkEnabled)
+	d.Set("vpn_gateway_id", vif.VirtualGatewayId)
+
+	return nil
+}
+
+func resourceAwsDxPrivateVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := dxVirtualInterfaceUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsDxPrivateVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxPrivateVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	return dxVirtualInterfaceDelete(d, meta)
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Virtual interfaces can only be created on a provisioned Direct Connect
+// connection, which cannot be set up as part of an acceptance test.
+func testAccPreCheckAwsDxVirtualInterface(t *testing.T) {
+	if os.Getenv("DX_CONNECTION_ID") == "" {
+		t.Skip("Environment variable DX_CONNECTION_ID is not set")
+	}
+}
+
+func TestAccAWSDxPrivateVirtualInterface_basic(t *testing.T) {
+	var vif directconnect.VirtualInterface
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64513, 65534)
+	rVlan := acctest.RandIntRange(2049, 4094)
+	resourceName := "aws_dx_private_virtual_interface.test"
+	vpnGatewayResourceName := "aws_vpn_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxVirtualInterface(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxVirtualInterfaceDestroy("aws_dx_private_virtual_interface"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxPrivateVirtualInterfaceConfigVpnGateway(connectionId, rName, rBgpAsn, rVlan, 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "address_family", directconnect.AddressFamilyIpv4),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`dxvif/dxvif-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "aws_device"),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionId),
+					resource.TestCheckResourceAttr(resourceName, "dx_gateway_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "1500"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpn_gateway_id", vpnGatewayResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDxPrivateVirtualInterfaceConfigVpnGateway(connectionId, rName, rBgpAsn, rVlan, 9001),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "jumbo_frame_capable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "9001"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDxPrivateVirtualInterface_dxGateway(t *testing.T) {
+	var vif directconnect.VirtualInterface
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64513, 65534)
+	rVlan := acctest.RandIntRange(2049, 4094)
+	resourceName := "aws_dx_private_virtual_interface.test"
+	dxGatewayResourceName := "aws_dx_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxVirtualInterface(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxVirtualInterfaceDestroy("aws_dx_private_virtual_interface"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxPrivateVirtualInterfaceConfigDxGateway(connectionId, rName, rBgpAsn, rVlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", dxGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_gateway_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxVirtualInterfaceExists(resourceName string, vif *directconnect.VirtualInterface) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Direct Connect Virtual Interface ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		output, err := dxDescribeVirtualInterface(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Direct Connect Virtual Interface (%s) not found", rs.Primary.ID)
+		}
+
+		*vif = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsDxVirtualInterfaceDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			output, err := dxDescribeVirtualInterface(conn, rs.Primary.ID)
+
+			if isNoSuchDxVirtualInterfaceErr(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output != nil && *output.VirtualInterfaceState != directconnect.VirtualInterfaceStateDeleted {
+				return fmt.Errorf("Direct Connect Virtual Interface (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDxPrivateVirtualInterfaceConfigVpnGateway(connectionId, rName string, rBgpAsn, rVlan, mtu int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.255.255.0/28"
+
+  tags {
+    Name = %[2]q
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[2]q
+  }
+}
+
+resource "aws_dx_private_virtual_interface" "test" {
+  address_family = "ipv4"
+  bgp_asn        = %[3]d
+  connection_id  = %[1]q
+  mtu            = %[5]d
+  name           = %[2]q
+  vlan           = %[4]d
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+
+  tags {
+    Name = %[2]q
+  }
+}
+`, connectionId, rName, rBgpAsn, rVlan, mtu)
+}
+
+func testAccDxPrivateVirtualInterfaceConfigDxGateway(connectionId, rName string, rBgpAsn, rVlan int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = %[2]q
+  amazon_side_asn = "64512"
+}
+
+resource "aws_dx_private_virtual_interface" "test" {
+  address_family = "ipv4"
+  bgp_asn        = %[3]d
+  connection_id  = %[1]q
+  dx_gateway_id  = "${aws_dx_gateway.test.id}"
+  name           = %[2]q
+  vlan           = %[4]d
+}
+`, connectionId, rName, rBgpAsn, rVlan)
+}
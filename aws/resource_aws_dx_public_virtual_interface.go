@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDxPublicVirtualInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxPublicVirtualInterfaceCreate,
+		Read:   resourceAwsDxPublicVirtualInterfaceRead,
+		Update: resourceAwsDxPublicVirtualInterfaceUpdate,
+		Delete: resourceAwsDxPublicVirtualInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directconnect.AddressFamilyIpv4,
+					directconnect.AddressFamilyIpv6,
+				}, false),
+			},
+			"amazon_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_device": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bgp_auth_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"customer_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_filter_prefixes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"vlan": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+		},
+	}
+}
+
+func resourceAwsDxPublicVirtualInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	addressFamily := d.Get("address_family").(string)
+	amazonAddress := d.Get("amazon_address").(string)
+	customerAddress := d.Get("customer_address").(string)
+
+	// Public virtual interfaces need public IPv4 peering addresses; for IPv6
+	// Amazon allocates them.
+	if addressFamily == directconnect.AddressFamilyIpv4 && (amazonAddress == "" || customerAddress == "") {
+		return fmt.Errorf("error creating Direct Connect Public Virtual Interface: amazon_address and customer_address must be set when address_family is %q", directconnect.AddressFamilyIpv4)
+	}
+
+	vif := &directconnect.NewPublicVirtualInterface{
+		AddressFamily:        aws.String(addressFamily),
+		Asn:                  aws.Int64(int64(d.Get("bgp_asn").(int))),
+		RouteFilterPrefixes:  expandDxRouteFilterPrefixes(d.Get("route_filter_prefixes").(*schema.Set).List()),
+		VirtualInterfaceName: aws.String(d.Get("name").(string)),
+		Vlan:                 aws.Int64(int64(d.Get("vlan").(int))),
+	}
+
+	if amazonAddress != "" {
+		vif.AmazonAddress = aws.String(amazonAddress)
+	}
+
+	if v, ok := d.GetOk("bgp_auth_key"); ok {
+		vif.AuthKey = aws.String(v.(string))
+	}
+
+	if customerAddress != "" {
+		vif.CustomerAddress = aws.String(customerAddress)
+	}
+
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		vif.Tags = tagsFromMapDX(v.(map[string]interface{}))
+	}
+
+	input := &directconnect.CreatePublicVirtualInterfaceInput{
+		ConnectionId:              aws.String(d.Get("connection_id").(string)),
+		NewPublicVirtualInterface: vif,
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Public Virtual Interface: %s", input)
+	output, err := conn.CreatePublicVirtualInterface(input)
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Public Virtual Interface: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VirtualInterfaceId))
+
+	if err := waitForDxVirtualInterfaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Public Virtual Interface (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxPublicVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxPublicVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	vif, err := dxVirtualInterfaceRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if vif == nil {
+		log.Printf("[WARN] Direct Connect Public Virtual Interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("route_filter_prefixes", flattenDxRouteFilterPrefixes(vif.RouteFilterPrefixes)); err != nil {
+		return fmt.Errorf("error setting route_filter_prefixes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDxPublicVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	if err := setTagsDX(conn, d, dxVirtualInterfaceArn(meta, d.Id())); err != nil {
+		return fmt.Errorf("error updating Direct Connect Public Virtual Interface (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxPublicVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxPublicVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	return dxVirtualInterfaceDelete(d, meta)
+}
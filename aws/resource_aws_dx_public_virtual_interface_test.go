@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDxPublicVirtualInterface_basic(t *testing.T) {
+	var vif directconnect.VirtualInterface
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	rVlan := acctest.RandIntRange(2049, 4094)
+	resourceName := "aws_dx_public_virtual_interface.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxVirtualInterface(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxVirtualInterfaceDestroy("aws_dx_public_virtual_interface"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxPublicVirtualInterfaceConfigTags(connectionId, rName, rBgpAsn, rVlan, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "address_family", directconnect.AddressFamilyIpv4),
+					resource.TestCheckResourceAttr(resourceName, "amazon_address", "175.45.176.1/30"),
+					resource.TestCheckResourceAttr(resourceName, "customer_address", "175.45.176.2/30"),
+					resource.TestCheckResourceAttr(resourceName, "route_filter_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDxPublicVirtualInterfaceConfigTags(connectionId, rName, rBgpAsn, rVlan, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDxPublicVirtualInterfaceConfigTags(connectionId, rName string, rBgpAsn, rVlan int, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_public_virtual_interface" "test" {
+  address_family   = "ipv4"
+  amazon_address   = "175.45.176.1/30"
+  bgp_asn          = %[3]d
+  connection_id    = %[1]q
+  customer_address = "175.45.176.2/30"
+  name             = %[2]q
+  vlan             = %[4]d
+
+  route_filter_prefixes = [
+    "210.52.109.0/24",
+    "175.45.176.0/22",
+  ]
+
+  tags {
+    %[5]q = %[6]q
+  }
+}
+`, connectionId, rName, rBgpAsn, rVlan, tagKey, tagValue)
+}
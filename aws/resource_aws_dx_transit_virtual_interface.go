@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDxTransitVirtualInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxTransitVirtualInterfaceCreate,
+		Read:   resourceAwsDxTransitVirtualInterfaceRead,
+		Update: resourceAwsDxTransitVirtualInterfaceUpdate,
+		Delete: resourceAwsDxTransitVirtualInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directconnect.AddressFamilyIpv4,
+					directconnect.AddressFamilyIpv6,
+				}, false),
+			},
+			"amazon_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"amazon_side_asn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_device": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bgp_auth_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"customer_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"dx_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"jumbo_frame_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1500,
+				ValidateFunc: validateIntegerInSlice([]int{1500, 8500}),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sitelink_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"vlan": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+		},
+	}
+}
+
+func resourceAwsDxTransitVirtualInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vif := &directconnect.NewTransitVirtualInterface{
+		AddressFamily:          aws.String(d.Get("address_family").(string)),
+		Asn:                    aws.Int64(int64(d.Get("bgp_asn").(int))),
+		DirectConnectGatewayId: aws.String(d.Get("dx_gateway_id").(string)),
+		EnableSiteLink:         aws.Bool(d.Get("sitelink_enabled").(bool)),
+		Mtu:                    aws.Int64(int64(d.Get("mtu").(int))),
+		VirtualInterfaceName:   aws.String(d.Get("name").(string)),
+		Vlan:                   aws.Int64(int64(d.Get("vlan").(int))),
+	}
+
+	if v, ok := d.GetOk("amazon_address"); ok {
+		vif.AmazonAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("bgp_auth_key"); ok {
+		vif.AuthKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_address"); ok {
+		vif.CustomerAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		vif.Tags = tagsFromMapDX(v.(map[string]interface{}))
+	}
+
+	input := &directconnect.CreateTransitVirtualInterfaceInput{
+		ConnectionId:               aws.String(d.Get("connection_id").(string)),
+		NewTransitVirtualInterface: vif,
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Transit Virtual Interface: %s", input)
+	output, err := conn.CreateTransitVirtualInterface(input)
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Transit Virtual Interface: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VirtualInterface.VirtualInterfaceId))
+
+	if err := waitForDxVirtualInterfaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect Transit Virtual Interface (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxTransitVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxTransitVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	vif, err := dxVirtualInterfaceRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if vif == nil {
+		log.Printf("[WARN] Direct Connect Transit Virtual Interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(vif.AmazonSideAsn), 10))
+	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
+	d.Set("jumbo_frame_capable", vif.JumboFrameCapable)
+	d.Set("mtu", vif.Mtu)
+	d.Set("sitelink_enabled", vif.SiteLinkEnabled)
+
+	return nil
+}
+
+func resourceAwsDxTransitVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := dxVirtualInterfaceUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsDxTransitVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxTransitVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	return dxVirtualInterfaceDelete(d, meta)
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDxTransitVirtualInterface_basic(t *testing.T) {
+	var vif directconnect.VirtualInterface
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rBgpAsn := acctest.RandIntRange(64513, 65534)
+	rVlan := acctest.RandIntRange(2049, 4094)
+	resourceName := "aws_dx_transit_virtual_interface.test"
+	dxGatewayResourceName := "aws_dx_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsDxVirtualInterface(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxVirtualInterfaceDestroy("aws_dx_transit_virtual_interface"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxTransitVirtualInterfaceConfig(connectionId, rName, rBgpAsn, rVlan, 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "address_family", directconnect.AddressFamilyIpv4),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", dxGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "1500"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDxTransitVirtualInterfaceConfig(connectionId, rName, rBgpAsn, rVlan, 8500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxVirtualInterfaceExists(resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "8500"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDxTransitVirtualInterfaceConfig(connectionId, rName string, rBgpAsn, rVlan, mtu int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = %[2]q
+  amazon_side_asn = "64512"
+}
+
+resource "aws_dx_transit_virtual_interface" "test" {
+  address_family = "ipv4"
+  bgp_asn        = %[3]d
+  connection_id  = %[1]q
+  dx_gateway_id  = "${aws_dx_gateway.test.id}"
+  mtu            = %[5]d
+  name           = %[2]q
+  vlan           = %[4]d
+}
+`, connectionId, rName, rBgpAsn, rVlan, mtu)
+}
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEBSDefaultKmsKey_basic(t *testing.T) {
+	resourceName := "aws_ebs_default_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEbsDefaultKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsDefaultKmsKeyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEbsDefaultKmsKey(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEbsDefaultKmsKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetEbsDefaultKmsKeyId(&ec2.GetEbsDefaultKmsKeyIdInput{})
+	if err != nil {
+		return err
+	}
+
+	// Verify that the default key is now the AWS managed key.
+	if !regexp.MustCompile(`:alias/aws/ebs$`).MatchString(aws.StringValue(output.KmsKeyId)) {
+		return fmt.Errorf("EBS default KMS key is not the AWS managed key: %s", aws.StringValue(output.KmsKeyId))
+	}
+
+	return nil
+}
+
+func testAccCheckEbsDefaultKmsKey(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.GetEbsDefaultKmsKeyId(&ec2.GetEbsDefaultKmsKeyIdInput{})
+		if err != nil {
+			return err
+		}
+
+		if actual := aws.StringValue(output.KmsKeyId); actual != rs.Primary.Attributes["key_arn"] {
+			return fmt.Errorf("EBS default KMS key is %s, expected %s", actual, rs.Primary.Attributes["key_arn"])
+		}
+
+		return nil
+	}
+}
+
+const testAccAwsEbsDefaultKmsKeyConfig = `
+resource "aws_kms_key" "test" {
+  description             = "tf-acc-test-ebs-default-kms-key"
+  deletion_window_in_days = 7
+}
+
+resource "aws_ebs_default_kms_key" "test" {
+  key_arn = "${aws_kms_key.test.arn}"
+}
+`
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsEncryptionByDefault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsEncryptionByDefaultCreate,
+		Read:   resourceAwsEbsEncryptionByDefaultRead,
+		Update: resourceAwsEbsEncryptionByDefaultUpdate,
+		Delete: resourceAwsEbsEncryptionByDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsEbsEncryptionByDefaultCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	if err := setEbsEncryptionByDefault(conn, d.Get("enabled").(bool)); err != nil {
+		return fmt.Errorf("error creating EBS encryption by default (%t): %s", d.Get("enabled").(bool), err)
+	}
+
+	// Encryption by default is a per-region account setting.
+	d.SetId(client.region)
+
+	return resourceAwsEbsEncryptionByDefaultRead(d, meta)
+}
+
+func resourceAwsEbsEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return fmt.Errorf("error reading EBS encryption by default: %s", err)
+	}
+
+	d.Set("enabled", output.EbsEncryptionByDefault)
+
+	return nil
+}
+
+func resourceAwsEbsEncryptionByDefaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setEbsEncryptionByDefault(conn, d.Get("enabled").(bool)); err != nil {
+		return fmt.Errorf("error updating EBS encryption by default (%t): %s", d.Get("enabled").(bool), err)
+	}
+
+	return resourceAwsEbsEncryptionByDefaultRead(d, meta)
+}
+
+func resourceAwsEbsEncryptionByDefaultDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource disables EBS encryption by default.
+	if err := setEbsEncryptionByDefault(conn, false); err != nil {
+		return fmt.Errorf("error disabling EBS encryption by default: %s", err)
+	}
+
+	return nil
+}
+
+func setEbsEncryptionByDefault(conn *ec2.EC2, enabled bool) error {
+	var err error
+
+	log.Printf("[DEBUG] Setting EBS encryption by default to %t", enabled)
+	if enabled {
+		_, err = conn.EnableEbsEncryptionByDefault(&ec2.EnableEbsEncryptionByDefaultInput{})
+	} else {
+		_, err = conn.DisableEbsEncryptionByDefault(&ec2.DisableEbsEncryptionByDefaultInput{})
+	}
+
+	return err
+}
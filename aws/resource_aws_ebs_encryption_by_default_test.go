@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEBSEncryptionByDefault_basic(t *testing.T) {
+	resourceName := "aws_ebs_encryption_by_default.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEbsEncryptionByDefaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsEncryptionByDefaultConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEbsEncryptionByDefault(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAwsEbsEncryptionByDefaultConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEbsEncryptionByDefault(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEbsEncryptionByDefaultDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return err
+	}
+
+	// Verify that EBS encryption by default is disabled.
+	if aws.BoolValue(output.EbsEncryptionByDefault) {
+		return fmt.Errorf("EBS encryption by default is not disabled")
+	}
+
+	return nil
+}
+
+func testAccCheckEbsEncryptionByDefault(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+		if err != nil {
+			return err
+		}
+
+		if actual := aws.BoolValue(output.EbsEncryptionByDefault); actual != enabled {
+			return fmt.Errorf("EBS encryption by default is %t, expected %t", actual, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsEbsEncryptionByDefaultConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_encryption_by_default" "test" {
+  enabled = %[1]s
+}
+`, strconv.FormatBool(enabled))
+}
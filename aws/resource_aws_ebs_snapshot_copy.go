@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotCopyCreate,
+		Read:   resourceAwsEbsSnapshotCopyRead,
+		Update: resourceAwsEbsSnapshotCopyUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_encryption_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CopySnapshotInput{
+		SourceRegion:      aws.String(d.Get("source_region").(string)),
+		SourceSnapshotId:  aws.String(d.Get("source_snapshot_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeSnapshot),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encrypted"); ok {
+		input.Encrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying EBS Snapshot: %s", input)
+	output, err := conn.CopySnapshot(input)
+	if err != nil {
+		return fmt.Errorf("error copying EBS Snapshot (%s): %s", d.Get("source_snapshot_id").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.SnapshotId))
+
+	if err := waitForEc2SnapshotCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EBS Snapshot copy (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	snapshot, err := ec2DescribeSnapshot(conn, d.Id())
+
+	if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+		log.Printf("[WARN] EBS Snapshot copy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS Snapshot copy (%s): %s", d.Id(), err)
+	}
+
+	if snapshot == nil {
+		log.Printf("[WARN] EBS Snapshot copy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", snapshot.Description)
+
+	return resourceAwsEbsSnapshotCopyOrImportSetAttributes(d, meta.(*AWSClient), snapshot)
+}
+
+func resourceAwsEbsSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EBS Snapshot copy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotCopyRead(d, meta)
+}
+
+// resourceAwsEbsSnapshotCopyOrImportSetAttributes sets the attributes shared by
+// the aws_ebs_snapshot_copy and aws_ebs_snapshot_import resources.
+func resourceAwsEbsSnapshotCopyOrImportSetAttributes(d *schema.ResourceData, client *AWSClient, snapshot *ec2.Snapshot) error {
+	arn := arn.ARN{
+		Partition: client.partition,
+		Region:    client.region,
+		Service:   "ec2",
+		Resource:  fmt.Sprintf("snapshot/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("owner_alias", snapshot.OwnerAlias)
+	d.Set("owner_id", snapshot.OwnerId)
+	d.Set("volume_id", snapshot.VolumeId)
+	d.Set("volume_size", snapshot.VolumeSize)
+
+	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
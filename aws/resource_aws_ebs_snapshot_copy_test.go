@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEBSSnapshotCopy_basic(t *testing.T) {
+	var v ec2.Snapshot
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ebs_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`snapshot/snap-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "volume_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEBSSnapshotCopy_withRegionAndKms(t *testing.T) {
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ebs_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAwsEbsSnapshotCopyDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithRegionAndKms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source_region", "us-east-1"),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEbsSnapshotCopyDestroy(s *terraform.State) error {
+	return testAccCheckAwsEbsSnapshotCopyDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckAwsEbsSnapshotCopyDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot_copy" {
+			continue
+		}
+
+		snapshot, err := ec2DescribeSnapshot(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if snapshot != nil {
+			return fmt.Errorf("EBS Snapshot copy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsEbsSnapshotCopyConfigTags(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+data "aws_region" "current" {}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  description        = %[1]q
+  source_region      = "${data.aws_region.current.name}"
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsEbsSnapshotCopyConfigWithRegionAndKms(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "source"
+  region = "us-east-1"
+}
+
+data "aws_availability_zones" "source" {
+  provider = "aws.source"
+  state    = "available"
+}
+
+resource "aws_ebs_volume" "test" {
+  provider = "aws.source"
+
+  availability_zone = "${data.aws_availability_zones.source.names[0]}"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  provider = "aws.source"
+
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  encrypted          = true
+  kms_key_id         = "${aws_kms_key.test.arn}"
+  source_region      = "us-east-1"
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+}
+`, rName)
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEbsSnapshotImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotImportCreate,
+		Read:   resourceAwsEbsSnapshotImportRead,
+		Update: resourceAwsEbsSnapshotImportUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_encryption_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disk_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"RAW",
+								"VHD",
+								"VMDK",
+							}, false),
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_bucket": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"s3_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "vmimport",
+			},
+			"tags": tagsSchema(),
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ImportSnapshotInput{
+		DiskContainer: expandEc2SnapshotDiskContainer(d.Get("disk_container").([]interface{})),
+		RoleName:      aws.String(d.Get("role_name").(string)),
+	}
+
+	if input.DiskContainer.Url == nil && input.DiskContainer.UserBucket == nil {
+		return fmt.Errorf("one of disk_container.0.url or disk_container.0.user_bucket must be configured")
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encrypted"); ok {
+		input.Encrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing EBS Snapshot: %s", input)
+	output, err := conn.ImportSnapshot(input)
+	if err != nil {
+		return fmt.Errorf("error importing EBS Snapshot: %s", err)
+	}
+
+	importTaskID := aws.StringValue(output.ImportTaskId)
+
+	task, err := waitForEc2ImportSnapshotTaskCompleted(conn, importTaskID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Import Snapshot Task (%s) to complete: %s", importTaskID, err)
+	}
+
+	d.SetId(aws.StringValue(task.SnapshotTaskDetail.SnapshotId))
+
+	// Tags specified when importing apply to the import task only.
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error setting EBS Snapshot import (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotImportRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	snapshot, err := ec2DescribeSnapshot(conn, d.Id())
+
+	if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+		log.Printf("[WARN] EBS Snapshot import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS Snapshot import (%s): %s", d.Id(), err)
+	}
+
+	if snapshot == nil {
+		log.Printf("[WARN] EBS Snapshot import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return resourceAwsEbsSnapshotCopyOrImportSetAttributes(d, meta.(*AWSClient), snapshot)
+}
+
+func resourceAwsEbsSnapshotImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EBS Snapshot import (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotImportRead(d, meta)
+}
+
+func expandEc2SnapshotDiskContainer(l []interface{}) *ec2.SnapshotDiskContainer {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	diskContainer := &ec2.SnapshotDiskContainer{
+		Format: aws.String(m["format"].(string)),
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		diskContainer.Description = aws.String(v)
+	}
+
+	if v, ok := m["url"].(string); ok && v != "" {
+		diskContainer.Url = aws.String(v)
+	}
+
+	if v, ok := m["user_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		bucket := v[0].(map[string]interface{})
+		diskContainer.UserBucket = &ec2.UserBucket{
+			S3Bucket: aws.String(bucket["s3_bucket"].(string)),
+			S3Key:    aws.String(bucket["s3_key"].(string)),
+		}
+	}
+
+	return diskContainer
+}
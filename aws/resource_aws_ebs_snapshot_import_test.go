@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Importing a snapshot requires a disk image in S3 and the "vmimport" service
+// role described in https://docs.aws.amazon.com/vm-import/latest/userguide/vmie_prereqs.html.
+func testAccPreCheckAwsEbsSnapshotImport(t *testing.T) {
+	if os.Getenv("EBS_SNAPSHOT_IMPORT_S3_BUCKET") == "" || os.Getenv("EBS_SNAPSHOT_IMPORT_S3_KEY") == "" {
+		t.Skip("Environment variables EBS_SNAPSHOT_IMPORT_S3_BUCKET and EBS_SNAPSHOT_IMPORT_S3_KEY are not set")
+	}
+}
+
+func TestAccAWSEBSSnapshotImport_basic(t *testing.T) {
+	var v ec2.Snapshot
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ebs_snapshot_import.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsEbsSnapshotImport(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEbsSnapshotImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotImportConfigBasic(rName, os.Getenv("EBS_SNAPSHOT_IMPORT_S3_BUCKET"), os.Getenv("EBS_SNAPSHOT_IMPORT_S3_KEY")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_size"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEbsSnapshotImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot_import" {
+			continue
+		}
+
+		snapshot, err := ec2DescribeSnapshot(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if snapshot != nil {
+			return fmt.Errorf("EBS Snapshot import (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsEbsSnapshotImportConfigBasic(rName, bucket, key string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot_import" "test" {
+  description = %[1]q
+
+  disk_container {
+    format = "VHD"
+
+    user_bucket {
+      s3_bucket = %[2]q
+      s3_key    = %[3]q
+    }
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, bucket, key)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEbsVolume() *schema.Resource {
@@ -25,6 +26,10 @@ func resourceAwsEbsVolume() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -64,6 +69,12 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(125, 1000),
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -93,10 +104,10 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		request.SnapshotId = aws.String(value.(string))
 	}
 
-	// IOPs are only valid, and required for, storage type io1. The current minimu
-	// is 100. Instead of a hard validation we we only apply the IOPs to the
-	// request if the type is io1, and log a warning otherwise. This allows users
-	// to "disable" iops. See https://github.com/hashicorp/terraform/pull/4146
+	// IOPs are only valid, and required for, storage types io1 and io2, and
+	// optional for gp3. Instead of a hard validation we we only apply the IOPs
+	// to the request for those types, and log a warning otherwise. This allows
+	// users to "disable" iops. See https://github.com/hashicorp/terraform/pull/4146
 	var t string
 	if value, ok := d.GetOk("type"); ok {
 		t = value.(string)
@@ -104,14 +115,21 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	iops := d.Get("iops").(int)
-	if t != "io1" && iops > 0 {
-		log.Printf("[WARN] IOPs is only valid for storate type io1 for EBS Volumes")
-	} else if t == "io1" {
+	if !ebsVolumeTypeSupportsIops(t) && iops > 0 {
+		log.Printf("[WARN] IOPs is only valid for storage types io1, io2 and gp3 for EBS Volumes")
+	} else if t == ec2.VolumeTypeIo1 || t == ec2.VolumeTypeIo2 || (t == ec2.VolumeTypeGp3 && iops > 0) {
 		// We add the iops value without validating it's size, to allow AWS to
 		// enforce a size requirement (currently 100)
 		request.Iops = aws.Int64(int64(iops))
 	}
 
+	throughput := d.Get("throughput").(int)
+	if t != ec2.VolumeTypeGp3 && throughput > 0 {
+		log.Printf("[WARN] Throughput is only valid for storage type gp3 for EBS Volumes")
+	} else if t == ec2.VolumeTypeGp3 && throughput > 0 {
+		request.Throughput = aws.Int64(int64(throughput))
+	}
+
 	log.Printf(
 		"[DEBUG] EBS Volume create opts: %s", request)
 	result, err := conn.CreateVolume(request)
@@ -166,36 +184,32 @@ func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 		params.Size = aws.Int64(int64(d.Get("size").(int)))
 	}
 
+	t := d.Get("type").(string)
 	if d.HasChange("type") {
 		requestUpdate = true
-		params.VolumeType = aws.String(d.Get("type").(string))
+		params.VolumeType = aws.String(t)
 	}
 
-	if d.HasChange("iops") {
+	// When the volume type changes, the IOPs and throughput of the new type
+	// are sent along with it so that AWS does not apply the old values.
+	if iops := d.Get("iops").(int); ebsVolumeTypeSupportsIops(t) && iops > 0 && (d.HasChange("iops") || d.HasChange("type")) {
 		requestUpdate = true
-		params.Iops = aws.Int64(int64(d.Get("iops").(int)))
+		params.Iops = aws.Int64(int64(iops))
 	}
 
-	if requestUpdate {
-		result, err := conn.ModifyVolume(params)
-		if err != nil {
-			return err
-		}
+	if throughput := d.Get("throughput").(int); t == ec2.VolumeTypeGp3 && throughput > 0 && (d.HasChange("throughput") || d.HasChange("type")) {
+		requestUpdate = true
+		params.Throughput = aws.Int64(int64(throughput))
+	}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"creating", "modifying"},
-			Target:     []string{"available", "in-use"},
-			Refresh:    volumeStateRefreshFunc(conn, *result.VolumeModification.VolumeId),
-			Timeout:    5 * time.Minute,
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying EBS Volume: %s", params)
+		if _, err := conn.ModifyVolume(params); err != nil {
+			return fmt.Errorf("error modifying EBS Volume (%s): %s", d.Id(), err)
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for Volume (%s) to become available: %s",
-				*result.VolumeModification.VolumeId, err)
+		if err := waitForEc2VolumeModificationComplete(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EBS Volume (%s) modification: %s", d.Id(), err)
 		}
 	}
 
@@ -301,17 +315,35 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		d.Set("type", *volume.VolumeType)
 	}
 
-	if volume.VolumeType != nil && *volume.VolumeType == "io1" {
-		// Only set the iops attribute if the volume type is io1. Setting otherwise
-		// can trigger a refresh/plan loop based on the computed value that is given
-		// from AWS, and prevent us from specifying 0 as a valid iops.
+	if volume.VolumeType != nil && ebsVolumeTypeSupportsIops(*volume.VolumeType) {
+		// Only set the iops attribute if the volume type supports provisioned
+		// IOPs. Setting otherwise can trigger a refresh/plan loop based on the
+		// computed value that is given from AWS, and prevent us from specifying
+		// 0 as a valid iops.
 		//   See https://github.com/hashicorp/terraform/pull/4146
 		if volume.Iops != nil {
 			d.Set("iops", *volume.Iops)
 		}
 	}
 
+	if volume.VolumeType != nil && *volume.VolumeType == ec2.VolumeTypeGp3 {
+		if volume.Throughput != nil {
+			d.Set("throughput", *volume.Throughput)
+		}
+	}
+
 	d.Set("tags", tagsToMap(volume.Tags))
 
 	return nil
 }
+
+// ebsVolumeTypeSupportsIops returns whether IOPs can be provisioned for the
+// EBS volume type.
+func ebsVolumeTypeSupportsIops(volumeType string) bool {
+	switch volumeType {
+	case ec2.VolumeTypeIo1, ec2.VolumeTypeIo2, ec2.VolumeTypeGp3:
+		return true
+	}
+
+	return false
+}
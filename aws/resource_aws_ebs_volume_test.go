@@ -131,6 +131,45 @@ func TestAccAWSEBSVolume_updateIops(t *testing.T) {
 	})
 }
 
+func TestAccAWSEBSVolume_updateGp3(t *testing.T) {
+	var before, after ec2.Volume
+	resourceName := "aws_ebs_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: resourceName,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsVolumeConfigUpdateType,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "type", "sc1"),
+				),
+			},
+			{
+				Config: testAccAwsEbsVolumeConfigGp3(3000, 125),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(resourceName, &after),
+					testAccCheckVolumeNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "125"),
+				),
+			},
+			{
+				Config: testAccAwsEbsVolumeConfigGp3(4000, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(resourceName, &after),
+					testAccCheckVolumeNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "iops", "4000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "250"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEBSVolume_kmsKey(t *testing.T) {
 	var v ec2.Volume
 	ri := acctest.RandInt()
@@ -215,6 +254,15 @@ func testAccCheckVolumeExists(n string, v *ec2.Volume) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckVolumeNotRecreated(before, after *ec2.Volume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.VolumeId) != aws.StringValue(after.VolumeId) {
+			return fmt.Errorf("EBS Volume recreated: %s -> %s", aws.StringValue(before.VolumeId), aws.StringValue(after.VolumeId))
+		}
+		return nil
+	}
+}
+
 const testAccAwsEbsVolumeConfig = `
 resource "aws_ebs_volume" "test" {
   availability_zone = "us-west-2a"
@@ -390,6 +438,22 @@ resource "aws_ebs_volume" "test" {
 }
 `
 
+func testAccAwsEbsVolumeConfigGp3(iops, throughput int) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  type              = "gp3"
+  size              = 500
+  iops              = %[1]d
+  throughput        = %[2]d
+
+  tags {
+    Name = "tf-acc-test-ebs-volume-test"
+  }
+}
+`, iops, throughput)
+}
+
 const testAccAwsEbsVolumeConfigWithKmsKey = `
 resource "aws_kms_key" "foo" {
   description = "Terraform acc test %d"
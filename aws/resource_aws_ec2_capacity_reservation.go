@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2CapacityReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2CapacityReservationCreate,
+		Read:   resourceAwsEc2CapacityReservationRead,
+		Update: resourceAwsEc2CapacityReservationUpdate,
+		Delete: resourceAwsEc2CapacityReservationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339TimeString,
+			},
+			"end_date_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.EndDateTypeUnlimited,
+				ValidateFunc: validation.StringInSlice(ec2.EndDateType_Values(), false),
+			},
+			"ephemeral_storage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"instance_match_criteria": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.InstanceMatchCriteriaOpen,
+				ValidateFunc: validation.StringInSlice(ec2.InstanceMatchCriteria_Values(), false),
+			},
+			"instance_platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_group_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"tags": tagsSchema(),
+			"tenancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.CapacityReservationTenancyDefault,
+				ValidateFunc: validation.StringInSlice(ec2.CapacityReservationTenancy_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2CapacityReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateCapacityReservationInput{
+		AvailabilityZone:      aws.String(d.Get("availability_zone").(string)),
+		EbsOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
+		EndDateType:           aws.String(d.Get("end_date_type").(string)),
+		EphemeralStorage:      aws.Bool(d.Get("ephemeral_storage").(bool)),
+		InstanceCount:         aws.Int64(int64(d.Get("instance_count").(int))),
+		InstanceMatchCriteria: aws.String(d.Get("instance_match_criteria").(string)),
+		InstancePlatform:      aws.String(d.Get("instance_platform").(string)),
+		InstanceType:          aws.String(d.Get("instance_type").(string)),
+		TagSpecifications:     ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeCapacityReservation),
+		Tenancy:               aws.String(d.Get("tenancy").(string)),
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndDate = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("outpost_arn"); ok {
+		input.OutpostArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Capacity Reservation: %s", input)
+	output, err := conn.CreateCapacityReservation(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Capacity Reservation: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservation.CapacityReservationId))
+
+	if err := waitForEc2CapacityReservationActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Capacity Reservation (%s) to become active: %s", d.Id(), err)
+	}
+
+	if v := d.Get("resource_group_arns").(*schema.Set); v.Len() > 0 {
+		resourceArn := aws.StringValue(output.CapacityReservation.CapacityReservationArn)
+
+		for _, groupArn := range expandStringSet(v) {
+			if err := resourceGroupsGroupResource(meta.(*AWSClient).resourcegroupsconn, aws.StringValue(groupArn), resourceArn); err != nil {
+				return fmt.Errorf("error adding EC2 Capacity Reservation (%s) to Resource Group (%s): %s", d.Id(), aws.StringValue(groupArn), err)
+			}
+		}
+	}
+
+	return resourceAwsEc2CapacityReservationRead(d, meta)
+}
+
+func resourceAwsEc2CapacityReservationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	reservation, err := ec2DescribeCapacityReservation(conn, d.Id())
+
+	if isAWSErr(err, "InvalidCapacityReservationId.NotFound", "") {
+		log.Printf("[WARN] EC2 Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	if reservation == nil {
+		log.Printf("[WARN] EC2 Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(reservation.State) {
+	case ec2.CapacityReservationStateCancelled, ec2.CapacityReservationStateExpired:
+		log.Printf("[WARN] EC2 Capacity Reservation (%s) in %s state, removing from state", d.Id(), aws.StringValue(reservation.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", reservation.CapacityReservationArn)
+	d.Set("availability_zone", reservation.AvailabilityZone)
+	d.Set("ebs_optimized", reservation.EbsOptimized)
+	d.Set("end_date", "")
+	if reservation.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(reservation.EndDate).Format(time.RFC3339))
+	}
+	d.Set("end_date_type", reservation.EndDateType)
+	d.Set("ephemeral_storage", reservation.EphemeralStorage)
+	d.Set("instance_count", reservation.TotalInstanceCount)
+	d.Set("instance_match_criteria", reservation.InstanceMatchCriteria)
+	d.Set("instance_platform", reservation.InstancePlatform)
+	d.Set("instance_type", reservation.InstanceType)
+	d.Set("outpost_arn", reservation.OutpostArn)
+	d.Set("owner_id", reservation.OwnerId)
+	d.Set("tenancy", reservation.Tenancy)
+
+	groupArns, err := ec2GetCapacityReservationGroupArns(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Reservation (%s) groups: %s", d.Id(), err)
+	}
+
+	if err := d.Set("resource_group_arns", groupArns); err != nil {
+		return fmt.Errorf("error setting resource_group_arns: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(reservation.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2CapacityReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("end_date") || d.HasChange("end_date_type") || d.HasChange("instance_count") {
+		input := &ec2.ModifyCapacityReservationInput{
+			CapacityReservationId: aws.String(d.Id()),
+			EndDateType:           aws.String(d.Get("end_date_type").(string)),
+			InstanceCount:         aws.Int64(int64(d.Get("instance_count").(int))),
+		}
+
+		// An end date may only be sent for reservations with a limited end date type.
+		if v, ok := d.GetOk("end_date"); ok && d.Get("end_date_type").(string) == ec2.EndDateTypeLimited {
+			t, _ := time.Parse(time.RFC3339, v.(string))
+			input.EndDate = aws.Time(t)
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Capacity Reservation (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyCapacityReservation(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2CapacityReservationActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Capacity Reservation (%s) to become active: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_group_arns") {
+		rgconn := meta.(*AWSClient).resourcegroupsconn
+		resourceArn := d.Get("arn").(string)
+
+		o, n := d.GetChange("resource_group_arns")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, groupArn := range expandStringSet(os.Difference(ns)) {
+			if err := resourceGroupsUngroupResource(rgconn, aws.StringValue(groupArn), resourceArn); err != nil {
+				return fmt.Errorf("error removing EC2 Capacity Reservation (%s) from Resource Group (%s): %s", d.Id(), aws.StringValue(groupArn), err)
+			}
+		}
+
+		for _, groupArn := range expandStringSet(ns.Difference(os)) {
+			if err := resourceGroupsGroupResource(rgconn, aws.StringValue(groupArn), resourceArn); err != nil {
+				return fmt.Errorf("error adding EC2 Capacity Reservation (%s) to Resource Group (%s): %s", d.Id(), aws.StringValue(groupArn), err)
+			}
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Capacity Reservation (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2CapacityReservationRead(d, meta)
+}
+
+func resourceAwsEc2CapacityReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CancelCapacityReservationInput{
+		CapacityReservationId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Cancelling EC2 Capacity Reservation (%s): %s", d.Id(), input)
+	_, err := conn.CancelCapacityReservation(input)
+
+	if isAWSErr(err, "InvalidCapacityReservationId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling EC2 Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2CapacityReservationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2CapacityReservationGroupCreate,
+		Read:   resourceAwsEc2CapacityReservationGroupRead,
+		Update: resourceAwsEc2CapacityReservationGroupUpdate,
+		Delete: resourceAwsEc2CapacityReservationGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2CapacityReservationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	// A Capacity Reservation group is a service-linked resource group that
+	// may only contain Capacity Reservations.
+	input := &resourcegroups.CreateGroupInput{
+		Configuration: []*resourcegroups.GroupConfigurationItem{
+			{
+				Type: aws.String(resourceGroupConfigurationTypeCapacityReservationPool),
+			},
+			{
+				Type: aws.String(resourceGroupConfigurationTypeGeneric),
+				Parameters: []*resourcegroups.GroupConfigurationParameter{
+					{
+						Name:   aws.String(resourceGroupConfigurationParameterAllowedResourceTypes),
+						Values: aws.StringSlice([]string{resourceGroupResourceTypeCapacityReservation}),
+					},
+				},
+			},
+		},
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Capacity Reservation Group: %s", input)
+	output, err := conn.CreateGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Capacity Reservation Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Group.Name))
+
+	return resourceAwsEc2CapacityReservationGroupRead(d, meta)
+}
+
+func resourceAwsEc2CapacityReservationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	output, err := conn.GetGroup(&resourcegroups.GetGroupInput{
+		Group: aws.String(d.Id()),
+	})
+	if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] EC2 Capacity Reservation Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Reservation Group (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Group == nil {
+		log.Printf("[WARN] EC2 Capacity Reservation Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(output.Group.GroupArn)
+
+	d.Set("arn", arn)
+	d.Set("description", output.Group.Description)
+	d.Set("name", output.Group.Name)
+
+	tagsOutput, err := conn.GetTags(&resourcegroups.GetTagsInput{
+		Arn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for EC2 Capacity Reservation Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2CapacityReservationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	if d.HasChange("description") {
+		input := &resourcegroups.UpdateGroupInput{
+			Description: aws.String(d.Get("description").(string)),
+			Group:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EC2 Capacity Reservation Group (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateGroup(input); err != nil {
+			return fmt.Errorf("error updating EC2 Capacity Reservation Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsResourceGroups(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating EC2 Capacity Reservation Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2CapacityReservationGroupRead(d, meta)
+}
+
+func resourceAwsEc2CapacityReservationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	log.Printf("[DEBUG] Deleting EC2 Capacity Reservation Group: %s", d.Id())
+	_, err := conn.DeleteGroup(&resourcegroups.DeleteGroupInput{
+		Group: aws.String(d.Id()),
+	})
+	if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Capacity Reservation Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2CapacityReservationGroup_basic(t *testing.T) {
+	resourceName := "aws_ec2_capacity_reservation_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationGroupConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationGroupExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`group/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationGroupConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2CapacityReservationGroup_Tags(t *testing.T) {
+	resourceName := "aws_ec2_capacity_reservation_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationGroupConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationGroupConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2CapacityReservationGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Reservation Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+		_, err := conn.GetGroup(&resourcegroups.GetGroupInput{
+			Group: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSEc2CapacityReservationGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_capacity_reservation_group" {
+			continue
+		}
+
+		_, err := conn.GetGroup(&resourcegroups.GetGroupInput{
+			Group: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Capacity Reservation Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEc2CapacityReservationGroupConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_group" "test" {
+  description = %[2]q
+  name        = %[1]q
+}
+`, rName, description)
+}
+
+func testAccAWSEc2CapacityReservationGroupConfigTags(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_group" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
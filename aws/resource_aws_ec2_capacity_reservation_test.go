@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2CapacityReservation_basic(t *testing.T) {
+	var reservation ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationConfigInstanceCount(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "end_date_type", "unlimited"),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_storage", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationConfigInstanceCount(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2CapacityReservation_EndDate(t *testing.T) {
+	var reservation ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationConfigEndDate("2099-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "end_date_type", "limited"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationConfigEndDate("2099-02-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2099-02-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2CapacityReservation_ResourceGroupArns(t *testing.T) {
+	var reservation ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation.test"
+	groupResourceName := "aws_ec2_capacity_reservation_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationConfigResourceGroupArns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_group_arns.0", groupResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationConfigResourceGroupArnsEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2CapacityReservation_Tags(t *testing.T) {
+	var reservation ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2CapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2CapacityReservationConfigTags("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2CapacityReservationConfigTags("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2CapacityReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2CapacityReservationExists(resourceName string, reservation *ec2.CapacityReservation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Reservation ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeCapacityReservation(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Capacity Reservation not found")
+		}
+
+		*reservation = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2CapacityReservationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_capacity_reservation" {
+			continue
+		}
+
+		reservation, err := ec2DescribeCapacityReservation(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidCapacityReservationId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if reservation == nil {
+			continue
+		}
+
+		if aws.StringValue(reservation.State) != ec2.CapacityReservationStateCancelled {
+			return fmt.Errorf("EC2 Capacity Reservation (%s) still exists in non-cancelled (%s) state", rs.Primary.ID, aws.StringValue(reservation.State))
+		}
+	}
+
+	return nil
+}
+
+const testAccAWSEc2CapacityReservationConfigBase = `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+`
+
+func testAccAWSEc2CapacityReservationConfigInstanceCount(instanceCount int) string {
+	return testAccAWSEc2CapacityReservationConfigBase + fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_count    = %[1]d
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t2.micro"
+}
+`, instanceCount)
+}
+
+func testAccAWSEc2CapacityReservationConfigEndDate(endDate string) string {
+	return testAccAWSEc2CapacityReservationConfigBase + fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  end_date          = %[1]q
+  end_date_type     = "limited"
+  instance_count    = 1
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t2.micro"
+}
+`, endDate)
+}
+
+func testAccAWSEc2CapacityReservationConfigResourceGroupArns(rName string) string {
+	return testAccAWSEc2CapacityReservationConfigBase + fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone       = "${data.aws_availability_zones.available.names[0]}"
+  instance_count          = 1
+  instance_match_criteria = "targeted"
+  instance_platform       = "Linux/UNIX"
+  instance_type           = "t2.micro"
+  resource_group_arns     = ["${aws_ec2_capacity_reservation_group.test.arn}"]
+}
+`, rName)
+}
+
+func testAccAWSEc2CapacityReservationConfigResourceGroupArnsEmpty(rName string) string {
+	return testAccAWSEc2CapacityReservationConfigBase + fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone       = "${data.aws_availability_zones.available.names[0]}"
+  instance_count          = 1
+  instance_match_criteria = "targeted"
+  instance_platform       = "Linux/UNIX"
+  instance_type           = "t2.micro"
+}
+`, rName)
+}
+
+func testAccAWSEc2CapacityReservationConfigTags(tagKey1, tagValue1 string) string {
+	return testAccAWSEc2CapacityReservationConfigBase + fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_count    = 1
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t2.micro"
+
+  tags {
+    %[1]s = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
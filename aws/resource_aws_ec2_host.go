@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice(ec2.AutoPlacement_Values(), false),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_recovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.HostRecoveryOff,
+				ValidateFunc: validation.StringInSlice(ec2.HostRecovery_Values(), false),
+			},
+			"instance_family": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"instance_type"},
+			},
+			"instance_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"instance_family"},
+			},
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:     aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone:  aws.String(d.Get("availability_zone").(string)),
+		HostRecovery:      aws.String(d.Get("host_recovery").(string)),
+		Quantity:          aws.Int64(1),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeDedicatedHost),
+	}
+
+	if v, ok := d.GetOk("instance_family"); ok {
+		input.InstanceFamily = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		input.InstanceType = aws.String(v.(string))
+	}
+
+	if input.InstanceFamily == nil && input.InstanceType == nil {
+		return fmt.Errorf("one of instance_family or instance_type must be configured")
+	}
+
+	if v, ok := d.GetOk("outpost_arn"); ok {
+		input.OutpostArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Allocating EC2 Host: %s", input)
+	output, err := conn.AllocateHosts(input)
+	if err != nil {
+		return fmt.Errorf("error allocating EC2 Host: %s", err)
+	}
+
+	if output == nil || len(output.HostIds) == 0 {
+		return fmt.Errorf("error allocating EC2 Host: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.HostIds[0]))
+
+	if err := waitForEc2HostAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	host, err := ec2DescribeHost(conn, d.Id())
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if host == nil {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(host.State) {
+	case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+		log.Printf("[WARN] EC2 Host (%s) in %s state, removing from state", d.Id(), aws.StringValue(host.State))
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: client.partition,
+		Region:    client.region,
+		Service:   "ec2",
+		AccountID: aws.StringValue(host.OwnerId),
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_recovery", host.HostRecovery)
+	d.Set("outpost_arn", host.OutpostArn)
+	d.Set("owner_id", host.OwnerId)
+
+	if host.HostProperties != nil {
+		d.Set("instance_family", host.HostProperties.InstanceFamily)
+		d.Set("instance_type", host.HostProperties.InstanceType)
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("auto_placement") || d.HasChange("host_recovery") || d.HasChange("instance_family") || d.HasChange("instance_type") {
+		input := &ec2.ModifyHostsInput{
+			HostIds: aws.StringSlice([]string{d.Id()}),
+		}
+
+		if d.HasChange("auto_placement") {
+			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
+		}
+
+		if d.HasChange("host_recovery") {
+			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
+		}
+
+		// Exactly one of instance family or instance type may be sent.
+		if d.HasChange("instance_family") && d.Get("instance_family").(string) != "" {
+			input.InstanceFamily = aws.String(d.Get("instance_family").(string))
+		} else if d.HasChange("instance_type") && d.Get("instance_type").(string) != "" {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Host (%s): %s", d.Id(), input)
+		output, err := conn.ModifyHosts(input)
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+
+		if err := ec2UnsuccessfulItemsError(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Host (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ReleaseHostsInput{
+		HostIds: aws.StringSlice([]string{d.Id()}),
+	}
+
+	log.Printf("[DEBUG] Releasing EC2 Host (%s): %s", d.Id(), input)
+	output, err := conn.ReleaseHosts(input)
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := ec2UnsuccessfulItemsError(output.Unsuccessful); err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2HostReleased(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to be released: %s", d.Id(), err)
+	}
+
+	return nil
+}
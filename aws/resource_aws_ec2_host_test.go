@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigInstanceType("on", "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`dedicated-host/h-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", "c5"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, "outpost_arn", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2HostConfigInstanceType("off", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "on"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_InstanceFamily(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigInstanceFamily("c5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "instance_family", "c5"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_Tags(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigTags("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2HostConfigTags("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2HostExists(resourceName string, host *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeHost(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Host not found")
+		}
+
+		*host = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		host, err := ec2DescribeHost(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			continue
+		}
+
+		switch state := aws.StringValue(host.State); state {
+		case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+			continue
+		default:
+			return fmt.Errorf("EC2 Host (%s) still exists in non-released (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+const testAccAWSEc2HostConfigBase = `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+`
+
+func testAccAWSEc2HostConfigInstanceType(autoPlacement, hostRecovery string) string {
+	return testAccAWSEc2HostConfigBase + fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  auto_placement    = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  host_recovery     = %[2]q
+  instance_type     = "c5.large"
+}
+`, autoPlacement, hostRecovery)
+}
+
+func testAccAWSEc2HostConfigInstanceFamily(instanceFamily string) string {
+	return testAccAWSEc2HostConfigBase + fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_family   = %[1]q
+}
+`, instanceFamily)
+}
+
+func testAccAWSEc2HostConfigTags(tagKey1, tagValue1 string) string {
+	return testAccAWSEc2HostConfigBase + fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "c5.large"
+
+  tags {
+    %[1]s = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
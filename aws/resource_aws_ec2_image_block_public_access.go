@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ImageBlockPublicAccessCreate,
+		Read:   resourceAwsEc2ImageBlockPublicAccessRead,
+		Update: resourceAwsEc2ImageBlockPublicAccessUpdate,
+		Delete: resourceAwsEc2ImageBlockPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing,
+					ec2.ImageBlockPublicAccessDisabledStateUnblocked,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ImageBlockPublicAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	state := d.Get("state").(string)
+	if err := setEc2ImageBlockPublicAccessState(conn, state, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating EC2 image block public access (%s): %s", state, err)
+	}
+
+	// Image block public access is a per-region account setting.
+	d.SetId(client.region)
+
+	return resourceAwsEc2ImageBlockPublicAccessRead(d, meta)
+}
+
+func resourceAwsEc2ImageBlockPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetImageBlockPublicAccessState(&ec2.GetImageBlockPublicAccessStateInput{})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 image block public access: %s", err)
+	}
+
+	d.Set("state", output.ImageBlockPublicAccessState)
+
+	return nil
+}
+
+func resourceAwsEc2ImageBlockPublicAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	state := d.Get("state").(string)
+	if err := setEc2ImageBlockPublicAccessState(conn, state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating EC2 image block public access (%s): %s", state, err)
+	}
+
+	return resourceAwsEc2ImageBlockPublicAccessRead(d, meta)
+}
+
+func resourceAwsEc2ImageBlockPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource unblocks public sharing of AMIs.
+	if err := setEc2ImageBlockPublicAccessState(conn, ec2.ImageBlockPublicAccessDisabledStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error deleting EC2 image block public access: %s", err)
+	}
+
+	return nil
+}
+
+// setEc2ImageBlockPublicAccessState changes the image block public access state
+// and waits for the change, which can take several minutes, to take effect.
+func setEc2ImageBlockPublicAccessState(conn *ec2.EC2, state string, timeout time.Duration) error {
+	var err error
+	var pending string
+
+	log.Printf("[DEBUG] Setting EC2 image block public access state to %s", state)
+	if state == ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing {
+		pending = ec2.ImageBlockPublicAccessDisabledStateUnblocked
+		_, err = conn.EnableImageBlockPublicAccess(&ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	} else {
+		pending = ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing
+		_, err = conn.DisableImageBlockPublicAccess(&ec2.DisableImageBlockPublicAccessInput{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pending},
+		Target:  []string{state},
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.GetImageBlockPublicAccessState(&ec2.GetImageBlockPublicAccessStateInput{})
+			if err != nil {
+				return nil, "", err
+			}
+
+			return output, aws.StringValue(output.ImageBlockPublicAccessState), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ImageBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2ImageBlockPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2ImageBlockPublicAccessConfig("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsEc2ImageBlockPublicAccessConfig("unblocked"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "unblocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ImageBlockPublicAccessDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetImageBlockPublicAccessState(&ec2.GetImageBlockPublicAccessStateInput{})
+	if err != nil {
+		return err
+	}
+
+	if state := aws.StringValue(output.ImageBlockPublicAccessState); state != ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		return fmt.Errorf("EC2 image block public access state is %s, expected %s", state, ec2.ImageBlockPublicAccessDisabledStateUnblocked)
+	}
+
+	return nil
+}
+
+func testAccAwsEc2ImageBlockPublicAccessConfig(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// ec2InstanceMetadataDefaultsHopLimitNoPreference is the hop limit value that
+// clears the account-level default.
+const ec2InstanceMetadataDefaultsHopLimitNoPreference = -1
+
+func resourceAwsEc2InstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2InstanceMetadataDefaultsPut,
+		Read:   resourceAwsEc2InstanceMetadataDefaultsRead,
+		Update: resourceAwsEc2InstanceMetadataDefaultsPut,
+		Delete: resourceAwsEc2InstanceMetadataDefaultsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataEndpointStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataEndpointState_Values(), false),
+			},
+			"http_put_response_hop_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      ec2InstanceMetadataDefaultsHopLimitNoPreference,
+				ValidateFunc: validation.IntBetween(ec2InstanceMetadataDefaultsHopLimitNoPreference, 64),
+			},
+			"http_tokens": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.MetadataDefaultHttpTokensStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.MetadataDefaultHttpTokensState_Values(), false),
+			},
+			"instance_metadata_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataTagsStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataTagsState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2InstanceMetadataDefaultsPut(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(d.Get("http_endpoint").(string)),
+		HttpPutResponseHopLimit: aws.Int64(int64(d.Get("http_put_response_hop_limit").(int))),
+		HttpTokens:              aws.String(d.Get("http_tokens").(string)),
+		InstanceMetadataTags:    aws.String(d.Get("instance_metadata_tags").(string)),
+	}
+
+	log.Printf("[DEBUG] Setting EC2 instance metadata defaults: %s", input)
+	if _, err := conn.ModifyInstanceMetadataDefaults(input); err != nil {
+		return fmt.Errorf("error setting EC2 instance metadata defaults: %s", err)
+	}
+
+	// Instance metadata defaults are a per-region account setting.
+	if d.IsNewResource() {
+		d.SetId(client.region)
+	}
+
+	return resourceAwsEc2InstanceMetadataDefaultsRead(d, meta)
+}
+
+func resourceAwsEc2InstanceMetadataDefaultsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetInstanceMetadataDefaults(&ec2.GetInstanceMetadataDefaultsInput{})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 instance metadata defaults: %s", err)
+	}
+
+	// Settings without an account-level default are omitted from the response.
+	httpEndpoint := ec2.DefaultInstanceMetadataEndpointStateNoPreference
+	httpPutResponseHopLimit := int64(ec2InstanceMetadataDefaultsHopLimitNoPreference)
+	httpTokens := ec2.MetadataDefaultHttpTokensStateNoPreference
+	instanceMetadataTags := ec2.DefaultInstanceMetadataTagsStateNoPreference
+
+	if v := output.AccountLevel; v != nil {
+		if v.HttpEndpoint != nil {
+			httpEndpoint = aws.StringValue(v.HttpEndpoint)
+		}
+		if v.HttpPutResponseHopLimit != nil {
+			httpPutResponseHopLimit = aws.Int64Value(v.HttpPutResponseHopLimit)
+		}
+		if v.HttpTokens != nil {
+			httpTokens = aws.StringValue(v.HttpTokens)
+		}
+		if v.InstanceMetadataTags != nil {
+			instanceMetadataTags = aws.StringValue(v.InstanceMetadataTags)
+		}
+	}
+
+	d.Set("http_endpoint", httpEndpoint)
+	d.Set("http_put_response_hop_limit", httpPutResponseHopLimit)
+	d.Set("http_tokens", httpTokens)
+	d.Set("instance_metadata_tags", instanceMetadataTags)
+
+	return nil
+}
+
+func resourceAwsEc2InstanceMetadataDefaultsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource clears all account-level defaults.
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(ec2.DefaultInstanceMetadataEndpointStateNoPreference),
+		HttpPutResponseHopLimit: aws.Int64(ec2InstanceMetadataDefaultsHopLimitNoPreference),
+		HttpTokens:              aws.String(ec2.MetadataDefaultHttpTokensStateNoPreference),
+		InstanceMetadataTags:    aws.String(ec2.DefaultInstanceMetadataTagsStateNoPreference),
+	}
+
+	if _, err := conn.ModifyInstanceMetadataDefaults(input); err != nil {
+		return fmt.Errorf("error deleting EC2 instance metadata defaults: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2InstanceMetadataDefaults_basic(t *testing.T) {
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2InstanceMetadataDefaultsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2InstanceMetadataDefaultsConfigEmpty,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "no-preference"),
+				),
+			},
+			{
+				Config: testAccAwsEc2InstanceMetadataDefaultsConfigFull,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2InstanceMetadataDefaultsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetInstanceMetadataDefaults(&ec2.GetInstanceMetadataDefaultsInput{})
+	if err != nil {
+		return err
+	}
+
+	// Cleared settings are omitted from the response.
+	if v := output.AccountLevel; v != nil && (v.HttpEndpoint != nil || v.HttpPutResponseHopLimit != nil || v.HttpTokens != nil || v.InstanceMetadataTags != nil) {
+		return fmt.Errorf("EC2 instance metadata defaults still set: %s", v)
+	}
+
+	return nil
+}
+
+const testAccAwsEc2InstanceMetadataDefaultsConfigEmpty = `
+resource "aws_ec2_instance_metadata_defaults" "test" {}
+`
+
+const testAccAwsEc2InstanceMetadataDefaultsConfigFull = `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_endpoint               = "enabled"
+  http_put_response_hop_limit = 2
+  http_tokens                 = "required"
+  instance_metadata_tags      = "disabled"
+}
+`
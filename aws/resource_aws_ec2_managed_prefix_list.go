@@ -0,0 +1,338 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ManagedPrefixList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ManagedPrefixListCreate,
+		Read:   resourceAwsEc2ManagedPrefixListRead,
+		Update: resourceAwsEc2ManagedPrefixListUpdate,
+		Delete: resourceAwsEc2ManagedPrefixListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4",
+					"IPv6",
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"max_entries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateManagedPrefixListInput{
+		AddressFamily:     aws.String(d.Get("address_family").(string)),
+		MaxEntries:        aws.Int64(int64(d.Get("max_entries").(int))),
+		PrefixListName:    aws.String(d.Get("name").(string)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypePrefixList),
+	}
+
+	if v, ok := d.GetOk("entry"); ok && v.(*schema.Set).Len() > 0 {
+		input.Entries = expandEc2AddPrefixListEntries(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Managed Prefix List: %s", input)
+	output, err := conn.CreateManagedPrefixList(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Managed Prefix List: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PrefixList.PrefixListId))
+
+	if err := waitForEc2ManagedPrefixListCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ManagedPrefixListRead(d, meta)
+}
+
+func resourceAwsEc2ManagedPrefixListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	prefixList, err := ec2DescribeManagedPrefixList(conn, d.Id())
+
+	if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+		log.Printf("[WARN] EC2 Managed Prefix List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	if prefixList == nil {
+		log.Printf("[WARN] EC2 Managed Prefix List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(prefixList.State) {
+	case ec2.PrefixListStateDeleteInProgress, ec2.PrefixListStateDeleteComplete:
+		log.Printf("[WARN] EC2 Managed Prefix List (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(prefixList.State))
+		d.SetId("")
+		return nil
+	}
+
+	entries, err := ec2GetManagedPrefixListEntries(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s) entries: %s", d.Id(), err)
+	}
+
+	d.Set("address_family", prefixList.AddressFamily)
+	d.Set("arn", prefixList.PrefixListArn)
+	d.Set("max_entries", prefixList.MaxEntries)
+	d.Set("name", prefixList.PrefixListName)
+	d.Set("owner_id", prefixList.OwnerId)
+	d.Set("version", prefixList.Version)
+
+	if err := d.Set("entry", flattenEc2PrefixListEntries(entries)); err != nil {
+		return fmt.Errorf("error setting entry: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(prefixList.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// The maximum number of entries cannot be changed in the same request as
+	// the entries themselves. Grow the list before adding entries and shrink
+	// it only once entries have been removed.
+	oldMaxEntriesRaw, newMaxEntriesRaw := d.GetChange("max_entries")
+	oldMaxEntries, newMaxEntries := oldMaxEntriesRaw.(int), newMaxEntriesRaw.(int)
+
+	currentVersion := int64(d.Get("version").(int))
+
+	if newMaxEntries > oldMaxEntries {
+		if err := resourceAwsEc2ManagedPrefixListModifyMaxEntries(conn, d.Id(), newMaxEntries); err != nil {
+			return err
+		}
+
+		prefixList, err := ec2DescribeManagedPrefixList(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+
+		if prefixList != nil {
+			currentVersion = aws.Int64Value(prefixList.Version)
+		}
+	}
+
+	if d.HasChange("entry") || d.HasChange("name") {
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: aws.Int64(currentVersion),
+			PrefixListId:   aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			input.PrefixListName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("entry") {
+			o, n := d.GetChange("entry")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+
+			if add := ns.Difference(os).List(); len(add) > 0 {
+				input.AddEntries = expandEc2AddPrefixListEntries(add)
+			}
+
+			// An entry whose description changed is re-added with the new
+			// description, so it must not also be removed.
+			addedCidrs := make(map[string]bool)
+			for _, entry := range input.AddEntries {
+				addedCidrs[aws.StringValue(entry.Cidr)] = true
+			}
+
+			for _, entry := range expandEc2RemovePrefixListEntries(os.Difference(ns).List()) {
+				if !addedCidrs[aws.StringValue(entry.Cidr)] {
+					input.RemoveEntries = append(input.RemoveEntries, entry)
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Managed Prefix List (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyManagedPrefixList(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2ManagedPrefixListModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) modification: %s", d.Id(), err)
+		}
+	}
+
+	if newMaxEntries < oldMaxEntries {
+		if err := resourceAwsEc2ManagedPrefixListModifyMaxEntries(conn, d.Id(), newMaxEntries); err != nil {
+			return err
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Managed Prefix List (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ManagedPrefixListRead(d, meta)
+}
+
+func resourceAwsEc2ManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Managed Prefix List (%s): %s", d.Id(), input)
+	_, err := conn.DeleteManagedPrefixList(input)
+
+	if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2ManagedPrefixListDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ManagedPrefixListModifyMaxEntries(conn *ec2.EC2, prefixListID string, maxEntries int) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		MaxEntries:   aws.Int64(int64(maxEntries)),
+		PrefixListId: aws.String(prefixListID),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Managed Prefix List (%s) max entries: %s", prefixListID, input)
+	if _, err := conn.ModifyManagedPrefixList(input); err != nil {
+		return fmt.Errorf("error modifying EC2 Managed Prefix List (%s) max entries: %s", prefixListID, err)
+	}
+
+	if err := waitForEc2ManagedPrefixListModification(conn, prefixListID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) modification: %s", prefixListID, err)
+	}
+
+	return nil
+}
+
+func expandEc2AddPrefixListEntries(l []interface{}) []*ec2.AddPrefixListEntry {
+	entries := make([]*ec2.AddPrefixListEntry, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entry := &ec2.AddPrefixListEntry{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			entry.Description = aws.String(v)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func expandEc2RemovePrefixListEntries(l []interface{}) []*ec2.RemovePrefixListEntry {
+	entries := make([]*ec2.RemovePrefixListEntry, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, &ec2.RemovePrefixListEntry{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		})
+	}
+
+	return entries
+}
+
+func flattenEc2PrefixListEntries(entries []*ec2.PrefixListEntry) []interface{} {
+	l := make([]interface{}, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"cidr":        aws.StringValue(entry.Cidr),
+			"description": aws.StringValue(entry.Description),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ManagedPrefixList_basic(t *testing.T) {
+	var prefixList ec2.ManagedPrefixList
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ManagedPrefixListConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ManagedPrefixListExists(resourceName, &prefixList),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "IPv4"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`prefix-list/pl-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ManagedPrefixList_Entry(t *testing.T) {
+	var prefixList1, prefixList2 ec2.ManagedPrefixList
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ManagedPrefixListConfigEntry1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ManagedPrefixListExists(resourceName, &prefixList1),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2ManagedPrefixListConfigEntry2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ManagedPrefixListExists(resourceName, &prefixList2),
+					testAccCheckAWSEc2ManagedPrefixListNotRecreated(&prefixList1, &prefixList2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "5"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ManagedPrefixList_Tags(t *testing.T) {
+	var prefixList1, prefixList2 ec2.ManagedPrefixList
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ManagedPrefixListConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ManagedPrefixListExists(resourceName, &prefixList1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2ManagedPrefixListConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ManagedPrefixListExists(resourceName, &prefixList2),
+					testAccCheckAWSEc2ManagedPrefixListNotRecreated(&prefixList1, &prefixList2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ManagedPrefixListExists(resourceName string, prefixList *ec2.ManagedPrefixList) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Managed Prefix List ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeManagedPrefixList(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Managed Prefix List not found")
+		}
+
+		*prefixList = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ManagedPrefixListDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_managed_prefix_list" {
+			continue
+		}
+
+		prefixList, err := ec2DescribeManagedPrefixList(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if prefixList == nil {
+			continue
+		}
+
+		if aws.StringValue(prefixList.State) != ec2.PrefixListStateDeleteComplete {
+			return fmt.Errorf("EC2 Managed Prefix List (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(prefixList.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2ManagedPrefixListNotRecreated(i, j *ec2.ManagedPrefixList) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.PrefixListId) != aws.StringValue(j.PrefixListId) {
+			return fmt.Errorf("EC2 Managed Prefix List was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2ManagedPrefixListConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSEc2ManagedPrefixListConfigEntry1(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 2
+  name           = %[1]q
+
+  entry {
+    cidr        = "1.0.0.0/8"
+    description = "Test1"
+  }
+
+  entry {
+    cidr        = "2.0.0.0/8"
+    description = "Test2"
+  }
+}
+`, rName)
+}
+
+func testAccAWSEc2ManagedPrefixListConfigEntry2(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 5
+  name           = %[1]q
+
+  entry {
+    cidr        = "1.0.0.0/8"
+    description = "Test1 updated"
+  }
+
+  entry {
+    cidr        = "3.0.0.0/8"
+    description = "Test3"
+  }
+
+  entry {
+    cidr = "4.0.0.0/8"
+  }
+}
+`, rName)
+}
+
+func testAccAWSEc2ManagedPrefixListConfigTags(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
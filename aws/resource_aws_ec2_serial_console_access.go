@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2SerialConsoleAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2SerialConsoleAccessCreate,
+		Read:   resourceAwsEc2SerialConsoleAccessRead,
+		Update: resourceAwsEc2SerialConsoleAccessUpdate,
+		Delete: resourceAwsEc2SerialConsoleAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2SerialConsoleAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	if err := setEc2SerialConsoleAccess(conn, d.Get("enabled").(bool)); err != nil {
+		return fmt.Errorf("error creating EC2 serial console access (%t): %s", d.Get("enabled").(bool), err)
+	}
+
+	// Serial console access is a per-region account setting.
+	d.SetId(client.region)
+
+	return resourceAwsEc2SerialConsoleAccessRead(d, meta)
+}
+
+func resourceAwsEc2SerialConsoleAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 serial console access: %s", err)
+	}
+
+	d.Set("enabled", output.SerialConsoleAccessEnabled)
+
+	return nil
+}
+
+func resourceAwsEc2SerialConsoleAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setEc2SerialConsoleAccess(conn, d.Get("enabled").(bool)); err != nil {
+		return fmt.Errorf("error updating EC2 serial console access (%t): %s", d.Get("enabled").(bool), err)
+	}
+
+	return resourceAwsEc2SerialConsoleAccessRead(d, meta)
+}
+
+func resourceAwsEc2SerialConsoleAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource disables serial console access.
+	if err := setEc2SerialConsoleAccess(conn, false); err != nil {
+		return fmt.Errorf("error disabling EC2 serial console access: %s", err)
+	}
+
+	return nil
+}
+
+func setEc2SerialConsoleAccess(conn *ec2.EC2, enabled bool) error {
+	var err error
+
+	log.Printf("[DEBUG] Setting EC2 serial console access to %t", enabled)
+	if enabled {
+		_, err = conn.EnableSerialConsoleAccess(&ec2.EnableSerialConsoleAccessInput{})
+	} else {
+		_, err = conn.DisableSerialConsoleAccess(&ec2.DisableSerialConsoleAccessInput{})
+	}
+
+	return err
+}
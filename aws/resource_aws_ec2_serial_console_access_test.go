@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2SerialConsoleAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_serial_console_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2SerialConsoleAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2SerialConsoleAccessConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2SerialConsoleAccess(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAwsEc2SerialConsoleAccessConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2SerialConsoleAccess(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2SerialConsoleAccessDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(output.SerialConsoleAccessEnabled) {
+		return fmt.Errorf("EC2 serial console access is not disabled")
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2SerialConsoleAccess(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+		if err != nil {
+			return err
+		}
+
+		if actual := aws.BoolValue(output.SerialConsoleAccessEnabled); actual != enabled {
+			return fmt.Errorf("EC2 serial console access is %t, expected %t", actual, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsEc2SerialConsoleAccessConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_serial_console_access" "test" {
+  enabled = %[1]t
+}
+`, enabled)
+}
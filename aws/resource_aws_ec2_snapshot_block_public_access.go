@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2SnapshotBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2SnapshotBlockPublicAccessCreate,
+		Read:   resourceAwsEc2SnapshotBlockPublicAccessRead,
+		Update: resourceAwsEc2SnapshotBlockPublicAccessUpdate,
+		Delete: resourceAwsEc2SnapshotBlockPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SnapshotBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2SnapshotBlockPublicAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	state := d.Get("state").(string)
+	if err := setEc2SnapshotBlockPublicAccessState(conn, state); err != nil {
+		return fmt.Errorf("error creating EBS snapshot block public access (%s): %s", state, err)
+	}
+
+	// Snapshot block public access is a per-region account setting.
+	d.SetId(client.region)
+
+	return resourceAwsEc2SnapshotBlockPublicAccessRead(d, meta)
+}
+
+func resourceAwsEc2SnapshotBlockPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetSnapshotBlockPublicAccessState(&ec2.GetSnapshotBlockPublicAccessStateInput{})
+	if err != nil {
+		return fmt.Errorf("error reading EBS snapshot block public access: %s", err)
+	}
+
+	d.Set("state", output.State)
+
+	return nil
+}
+
+func resourceAwsEc2SnapshotBlockPublicAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	state := d.Get("state").(string)
+	if err := setEc2SnapshotBlockPublicAccessState(conn, state); err != nil {
+		return fmt.Errorf("error updating EBS snapshot block public access (%s): %s", state, err)
+	}
+
+	return resourceAwsEc2SnapshotBlockPublicAccessRead(d, meta)
+}
+
+func resourceAwsEc2SnapshotBlockPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource unblocks public sharing of snapshots.
+	if err := setEc2SnapshotBlockPublicAccessState(conn, ec2.SnapshotBlockPublicAccessStateUnblocked); err != nil {
+		return fmt.Errorf("error deleting EBS snapshot block public access: %s", err)
+	}
+
+	return nil
+}
+
+func setEc2SnapshotBlockPublicAccessState(conn *ec2.EC2, state string) error {
+	var err error
+
+	log.Printf("[DEBUG] Setting EBS snapshot block public access state to %s", state)
+	if state == ec2.SnapshotBlockPublicAccessStateUnblocked {
+		_, err = conn.DisableSnapshotBlockPublicAccess(&ec2.DisableSnapshotBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableSnapshotBlockPublicAccess(&ec2.EnableSnapshotBlockPublicAccessInput{
+			State: aws.String(state),
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2SnapshotBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_snapshot_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2SnapshotBlockPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2SnapshotBlockPublicAccessConfig("block-all-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "block-all-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsEc2SnapshotBlockPublicAccessConfig("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2SnapshotBlockPublicAccessDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetSnapshotBlockPublicAccessState(&ec2.GetSnapshotBlockPublicAccessStateInput{})
+	if err != nil {
+		return err
+	}
+
+	if state := aws.StringValue(output.State); state != ec2.SnapshotBlockPublicAccessStateUnblocked {
+		return fmt.Errorf("EBS snapshot block public access state is %s, expected %s", state, ec2.SnapshotBlockPublicAccessStateUnblocked)
+	}
+
+	return nil
+}
+
+func testAccAwsEc2SnapshotBlockPublicAccessConfig(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_snapshot_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
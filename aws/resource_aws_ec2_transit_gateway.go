@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TransitGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayCreate,
+		Read:   resourceAwsEc2TransitGatewayRead,
+		Update: resourceAwsEc2TransitGatewayUpdate,
+		Delete: resourceAwsEc2TransitGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"amazon_side_asn": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  64512,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_accept_shared_attachments": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.AutoAcceptSharedAttachmentsValueDisable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AutoAcceptSharedAttachmentsValueDisable,
+					ec2.AutoAcceptSharedAttachmentsValueEnable,
+				}, false),
+			},
+			"default_route_table_association": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.DefaultRouteTableAssociationValueEnable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.DefaultRouteTableAssociationValueDisable,
+					ec2.DefaultRouteTableAssociationValueEnable,
+				}, false),
+			},
+			"default_route_table_propagation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.DefaultRouteTablePropagationValueEnable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.DefaultRouteTablePropagationValueDisable,
+					ec2.DefaultRouteTablePropagationValueEnable,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.DnsSupportValueEnable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.DnsSupportValueDisable,
+					ec2.DnsSupportValueEnable,
+				}, false),
+			},
+			"multicast_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.MulticastSupportValueDisable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.MulticastSupportValueDisable,
+					ec2.MulticastSupportValueEnable,
+				}, false),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"propagation_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"vpn_ecmp_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.VpnEcmpSupportValueEnable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpnEcmpSupportValueDisable,
+					ec2.VpnEcmpSupportValueEnable,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTransitGatewayInput{
+		Options: &ec2.TransitGatewayRequestOptions{
+			AmazonSideAsn:                aws.Int64(int64(d.Get("amazon_side_asn").(int))),
+			AutoAcceptSharedAttachments:  aws.String(d.Get("auto_accept_shared_attachments").(string)),
+			DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
+			DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
+			DnsSupport:                   aws.String(d.Get("dns_support").(string)),
+			MulticastSupport:             aws.String(d.Get("multicast_support").(string)),
+			VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
+		},
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGateway),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway: %s", input)
+	output, err := conn.CreateTransitGateway(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGateway.TransitGatewayId))
+
+	if err := waitForEc2TransitGatewayCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGateway, err := ec2DescribeTransitGateway(conn, d.Id())
+
+	if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
+		log.Printf("[WARN] EC2 Transit Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway (%s): %s", d.Id(), err)
+	}
+
+	if transitGateway == nil || aws.StringValue(transitGateway.State) == ec2.TransitGatewayStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if transitGateway.Options == nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway (%s): missing options", d.Id())
+	}
+
+	d.Set("amazon_side_asn", aws.Int64Value(transitGateway.Options.AmazonSideAsn))
+	d.Set("arn", transitGateway.TransitGatewayArn)
+	d.Set("association_default_route_table_id", transitGateway.Options.AssociationDefaultRouteTableId)
+	d.Set("auto_accept_shared_attachments", transitGateway.Options.AutoAcceptSharedAttachments)
+	d.Set("default_route_table_association", transitGateway.Options.DefaultRouteTableAssociation)
+	d.Set("default_route_table_propagation", transitGateway.Options.DefaultRouteTablePropagation)
+	d.Set("description", transitGateway.Description)
+	d.Set("dns_support", transitGateway.Options.DnsSupport)
+	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
+	d.Set("owner_id", transitGateway.OwnerId)
+	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
+	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
+
+	if err := d.Set("tags", tagsToMap(transitGateway.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("auto_accept_shared_attachments") || d.HasChange("default_route_table_association") ||
+		d.HasChange("default_route_table_propagation") || d.HasChange("description") ||
+		d.HasChange("dns_support") || d.HasChange("vpn_ecmp_support") {
+		input := &ec2.ModifyTransitGatewayInput{
+			Description: aws.String(d.Get("description").(string)),
+			Options: &ec2.ModifyTransitGatewayOptions{
+				AutoAcceptSharedAttachments:  aws.String(d.Get("auto_accept_shared_attachments").(string)),
+				DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
+				DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
+				DnsSupport:                   aws.String(d.Get("dns_support").(string)),
+				VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
+			},
+			TransitGatewayId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Transit Gateway: %s", input)
+		if _, err := conn.ModifyTransitGateway(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Transit Gateway (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2TransitGatewayModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) modification: %s", d.Id(), err)
+		}
+
+		d.SetPartial("auto_accept_shared_attachments")
+		d.SetPartial("default_route_table_association")
+		d.SetPartial("default_route_table_propagation")
+		d.SetPartial("description")
+		d.SetPartial("dns_support")
+		d.SetPartial("vpn_ecmp_support")
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsEc2TransitGatewayRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteTransitGatewayInput{
+		TransitGatewayId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway (%s): %s", d.Id(), input)
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteTransitGateway(input)
+
+		// Attachments are removed asynchronously; retry until they are gone.
+		if isAWSErr(err, "IncorrectState", "has non-deleted Transit Gateway Attachments") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, "IncorrectState", "has non-deleted DirectConnect Gateway Attachments") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, "IncorrectState", "has non-deleted VPN Attachments") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2TransitGatewayDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
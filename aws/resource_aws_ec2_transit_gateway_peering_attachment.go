@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2TransitGatewayPeeringAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayPeeringAttachmentCreate,
+		Read:   resourceAwsEc2TransitGatewayPeeringAttachmentRead,
+		Update: resourceAwsEc2TransitGatewayPeeringAttachmentUpdate,
+		Delete: resourceAwsEc2TransitGatewayPeeringAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"peer_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_transit_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayPeeringAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	peerAccountId := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("peer_account_id"); ok {
+		peerAccountId = v.(string)
+	}
+
+	input := &ec2.CreateTransitGatewayPeeringAttachmentInput{
+		PeerAccountId:        aws.String(peerAccountId),
+		PeerRegion:           aws.String(d.Get("peer_region").(string)),
+		PeerTransitGatewayId: aws.String(d.Get("peer_transit_gateway_id").(string)),
+		TagSpecifications:    ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGatewayAttachment),
+		TransitGatewayId:     aws.String(d.Get("transit_gateway_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Peering Attachment: %s", input)
+	output, err := conn.CreateTransitGatewayPeeringAttachment(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Peering Attachment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId))
+
+	if err := waitForEc2TransitGatewayPeeringAttachmentCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Peering Attachment (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayPeeringAttachmentRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayPeeringAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayPeeringAttachment, err := ec2DescribeTransitGatewayPeeringAttachment(conn, d.Id())
+
+	if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+		log.Printf("[WARN] EC2 Transit Gateway Peering Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
+	}
+
+	if transitGatewayPeeringAttachment == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Peering Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(transitGatewayPeeringAttachment.State) {
+	case ec2.TransitGatewayAttachmentStateDeleting, ec2.TransitGatewayAttachmentStateDeleted,
+		ec2.TransitGatewayAttachmentStateFailed, ec2.TransitGatewayAttachmentStateRejected:
+		log.Printf("[WARN] EC2 Transit Gateway Peering Attachment (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGatewayPeeringAttachment.State))
+		d.SetId("")
+		return nil
+	}
+
+	if transitGatewayPeeringAttachment.AccepterTgwInfo == nil || transitGatewayPeeringAttachment.RequesterTgwInfo == nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Peering Attachment (%s): missing Transit Gateway information", d.Id())
+	}
+
+	d.Set("peer_account_id", transitGatewayPeeringAttachment.AccepterTgwInfo.OwnerId)
+	d.Set("peer_region", transitGatewayPeeringAttachment.AccepterTgwInfo.Region)
+	d.Set("peer_transit_gateway_id", transitGatewayPeeringAttachment.AccepterTgwInfo.TransitGatewayId)
+	d.Set("state", transitGatewayPeeringAttachment.State)
+	d.Set("transit_gateway_id", transitGatewayPeeringAttachment.RequesterTgwInfo.TransitGatewayId)
+
+	if err := d.Set("tags", tagsToMap(transitGatewayPeeringAttachment.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayPeeringAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Peering Attachment (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayPeeringAttachmentRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayPeeringAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTransitGatewayPeeringAttachment(input)
+
+	if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2TransitGatewayPeeringAttachmentDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Peering Attachment (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TransitGatewayPeeringAttachment_basic(t *testing.T) {
+	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
+	var providers []*schema.Provider
+	resourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayResourceNamePeer := "aws_ec2_transit_gateway.peer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSEc2TransitGatewayPeeringAttachmentDestroy(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayPeeringAttachmentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists(resourceName, &transitGatewayPeeringAttachment, &providers),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", "us-east-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", transitGatewayResourceNamePeer, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.TransitGatewayAttachmentStatePendingAcceptance),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-acc-test-ec2-transit-gateway-peering-attachment"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayPeeringAttachmentAccepter_basic(t *testing.T) {
+	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
+	var providers []*schema.Provider
+	resourceName := "aws_ec2_transit_gateway_peering_attachment_accepter.peer"
+	peeringAttachmentResourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayResourceNamePeer := "aws_ec2_transit_gateway.peer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSEc2TransitGatewayPeeringAttachmentDestroy(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayPeeringAttachmentAccepterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists(resourceName, &transitGatewayPeeringAttachment, &providers),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_account_id", peeringAttachmentResourceName, "peer_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", "us-west-2"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Side", "Accepter"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", peeringAttachmentResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceNamePeer, "id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists looks up the
+// attachment through each configured provider as either side of the peering
+// may own the resource under test.
+func testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists(resourceName string, transitGatewayPeeringAttachment *ec2.TransitGatewayPeeringAttachment, providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Peering Attachment ID is set")
+		}
+
+		for _, provider := range *providers {
+			if provider.Meta() == nil {
+				continue
+			}
+
+			conn := provider.Meta().(*AWSClient).ec2conn
+
+			attachment, err := ec2DescribeTransitGatewayPeeringAttachment(conn, rs.Primary.ID)
+
+			if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if attachment == nil {
+				continue
+			}
+
+			*transitGatewayPeeringAttachment = *attachment
+
+			return nil
+		}
+
+		return fmt.Errorf("EC2 Transit Gateway Peering Attachment (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayPeeringAttachmentDestroy(providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, provider := range *providers {
+			if provider.Meta() == nil {
+				continue
+			}
+
+			conn := provider.Meta().(*AWSClient).ec2conn
+
+			for _, rs := range s.RootModule().Resources {
+				if rs.Type != "aws_ec2_transit_gateway_peering_attachment" && rs.Type != "aws_ec2_transit_gateway_peering_attachment_accepter" {
+					continue
+				}
+
+				attachment, err := ec2DescribeTransitGatewayPeeringAttachment(conn, rs.Primary.ID)
+
+				if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				if attachment == nil {
+					continue
+				}
+
+				switch state := aws.StringValue(attachment.State); state {
+				case ec2.TransitGatewayAttachmentStateDeleted, ec2.TransitGatewayAttachmentStateRejected:
+					continue
+				default:
+					return fmt.Errorf("EC2 Transit Gateway Peering Attachment (%s) still exists in non-deleted (%s) state", rs.Primary.ID, state)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TransitGatewayPeeringAttachmentConfigBase() string {
+	return `
+provider "aws" {
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "peer"
+  region = "us-east-1"
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags {
+    Name = "tf-acc-test-ec2-transit-gateway-peering-attachment"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "peer" {
+  provider = "aws.peer"
+
+  tags {
+    Name = "tf-acc-test-ec2-transit-gateway-peering-attachment"
+  }
+}
+`
+}
+
+func testAccAWSEc2TransitGatewayPeeringAttachmentConfig() string {
+	return testAccAWSEc2TransitGatewayPeeringAttachmentConfigBase() + `
+resource "aws_ec2_transit_gateway_peering_attachment" "test" {
+  peer_region             = "us-east-1"
+  peer_transit_gateway_id = "${aws_ec2_transit_gateway.peer.id}"
+  transit_gateway_id      = "${aws_ec2_transit_gateway.test.id}"
+
+  tags {
+    Name = "tf-acc-test-ec2-transit-gateway-peering-attachment"
+  }
+}
+`
+}
+
+func testAccAWSEc2TransitGatewayPeeringAttachmentAccepterConfig() string {
+	return testAccAWSEc2TransitGatewayPeeringAttachmentConfig() + `
+resource "aws_ec2_transit_gateway_peering_attachment_accepter" "peer" {
+  provider = "aws.peer"
+
+  transit_gateway_attachment_id = "${aws_ec2_transit_gateway_peering_attachment.test.id}"
+
+  tags {
+    Side = "Accepter"
+  }
+}
+`
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2TransitGatewayRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayRouteCreate,
+		Read:   resourceAwsEc2TransitGatewayRouteRead,
+		Delete: resourceAwsEc2TransitGatewayRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blackhole": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	destination := d.Get("destination_cidr_block").(string)
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	input := &ec2.CreateTransitGatewayRouteInput{
+		Blackhole:                  aws.Bool(d.Get("blackhole").(bool)),
+		DestinationCidrBlock:       aws.String(destination),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	if v, ok := d.GetOk("transit_gateway_attachment_id"); ok {
+		input.TransitGatewayAttachmentId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Route: %s", input)
+	if _, err := conn.CreateTransitGatewayRoute(input); err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Route: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", transitGatewayRouteTableID, destination))
+
+	return resourceAwsEc2TransitGatewayRouteRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	transitGatewayRoute, err := ec2DescribeTransitGatewayRoute(conn, transitGatewayRouteTableID, destination)
+
+	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+		log.Printf("[WARN] EC2 Transit Gateway Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route (%s): %s", d.Id(), err)
+	}
+
+	if transitGatewayRoute == nil || aws.StringValue(transitGatewayRoute.State) == ec2.TransitGatewayRouteStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	state := aws.StringValue(transitGatewayRoute.State)
+
+	d.Set("blackhole", state == ec2.TransitGatewayRouteStateBlackhole)
+	d.Set("destination_cidr_block", transitGatewayRoute.DestinationCidrBlock)
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableID)
+
+	// Blackhole routes have no attachment.
+	d.Set("transit_gateway_attachment_id", "")
+	for _, attachment := range transitGatewayRoute.TransitGatewayAttachments {
+		if attachment != nil {
+			d.Set("transit_gateway_attachment_id", attachment.TransitGatewayAttachmentId)
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DeleteTransitGatewayRouteInput{
+		DestinationCidrBlock:       aws.String(destination),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route (%s): %s", d.Id(), input)
+	_, err = conn.DeleteTransitGatewayRoute(input)
+
+	if isAWSErr(err, "InvalidRoute.NotFound", "") || isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Route (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2TransitGatewayRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayRouteTableAssociationCreate,
+		Read:   resourceAwsEc2TransitGatewayRouteTableAssociationRead,
+		Delete: resourceAwsEc2TransitGatewayRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayAttachmentID := d.Get("transit_gateway_attachment_id").(string)
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	if err := ec2TransitGatewayRouteTableAssociate(conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", transitGatewayRouteTableID, transitGatewayAttachmentID))
+
+	return resourceAwsEc2TransitGatewayRouteTableAssociationRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayRouteTableID, transitGatewayAttachmentID, err := decodeEc2TransitGatewayRouteTableAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := ec2DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
+
+	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing from state", transitGatewayRouteTableID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	if association == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_id", association.ResourceId)
+	d.Set("resource_type", association.ResourceType)
+	d.Set("transit_gateway_attachment_id", association.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableID)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayRouteTableID, transitGatewayAttachmentID, err := decodeEc2TransitGatewayRouteTableAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Disassociating EC2 Transit Gateway Route Table (%s): %s", d.Id(), input)
+	_, err = conn.DisassociateTransitGatewayRouteTable(input)
+
+	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating EC2 Transit Gateway Route Table (%s) from Attachment (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	if err := waitForEc2TransitGatewayRouteTableAssociationDeletion(conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) disassociation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+	}
+
+	return nil
+}
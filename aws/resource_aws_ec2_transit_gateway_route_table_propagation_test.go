@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TransitGatewayRouteTablePropagation_basic(t *testing.T) {
+	var transitGatewayRouteTablePropagation1 ec2.TransitGatewayRouteTablePropagation
+	resourceName := "aws_ec2_transit_gateway_route_table_propagation.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTablePropagationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTablePropagationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTablePropagationExists(resourceName, &transitGatewayRouteTablePropagation1),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ec2.TransitGatewayAttachmentResourceTypeVpc),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", transitGatewayVpcAttachmentResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteTablePropagationExists(resourceName string, transitGatewayRouteTablePropagation *ec2.TransitGatewayRouteTablePropagation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route Table Propagation ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		transitGatewayRouteTableID, transitGatewayAttachmentID, err := decodeEc2TransitGatewayRouteTablePropagationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		propagation, err := ec2DescribeTransitGatewayRouteTablePropagation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
+
+		if err != nil {
+			return err
+		}
+
+		if propagation == nil {
+			return fmt.Errorf("EC2 Transit Gateway Route Table Propagation not found")
+		}
+
+		*transitGatewayRouteTablePropagation = *propagation
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteTablePropagationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_route_table_propagation" {
+			continue
+		}
+
+		transitGatewayRouteTableID, transitGatewayAttachmentID, err := decodeEc2TransitGatewayRouteTablePropagationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		propagation, err := ec2DescribeTransitGatewayRouteTablePropagation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if propagation == nil || aws.StringValue(propagation.State) == ec2.TransitGatewayPropagationStateDisabled {
+			continue
+		}
+
+		return fmt.Errorf("EC2 Transit Gateway Route Table (%s) propagation (%s) still exists", transitGatewayRouteTableID, transitGatewayAttachmentID)
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayRouteTablePropagationConfig() string {
+	return testAccAWSEc2TransitGatewayVpcAttachmentConfigBase() + `
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids                                      = ["${aws_subnet.test.0.id}"]
+  transit_gateway_default_route_table_association = false
+  transit_gateway_default_route_table_propagation = false
+  transit_gateway_id                              = "${aws_ec2_transit_gateway.test.id}"
+  vpc_id                                          = "${aws_vpc.test.id}"
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = "${aws_ec2_transit_gateway.test.id}"
+}
+
+resource "aws_ec2_transit_gateway_route_table_propagation" "test" {
+  transit_gateway_attachment_id  = "${aws_ec2_transit_gateway_vpc_attachment.test.id}"
+  transit_gateway_route_table_id = "${aws_ec2_transit_gateway_route_table.test.id}"
+}
+`
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TransitGatewayRoute_basic(t *testing.T) {
+	var transitGatewayRoute1 ec2.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteConfigDestinationCidrBlock(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteExists(resourceName, &transitGatewayRoute1),
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "false"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", transitGatewayVpcAttachmentResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayResourceName, "association_default_route_table_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayRoute_Blackhole(t *testing.T) {
+	var transitGatewayRoute1 ec2.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route.test_blackhole"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteConfigDestinationCidrBlock(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteExists(resourceName, &transitGatewayRoute1),
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteExists(resourceName string, transitGatewayRoute *ec2.TransitGatewayRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		route, err := ec2DescribeTransitGatewayRoute(conn, transitGatewayRouteTableID, destination)
+
+		if err != nil {
+			return err
+		}
+
+		if route == nil {
+			return fmt.Errorf("EC2 Transit Gateway Route not found")
+		}
+
+		*transitGatewayRoute = *route
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_route" {
+			continue
+		}
+
+		transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		route, err := ec2DescribeTransitGatewayRoute(conn, transitGatewayRouteTableID, destination)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if route == nil {
+			continue
+		}
+
+		if aws.StringValue(route.State) != ec2.TransitGatewayRouteStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Route (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(route.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayRouteConfigDestinationCidrBlock() string {
+	return testAccAWSEc2TransitGatewayVpcAttachmentConfig() + `
+resource "aws_ec2_transit_gateway_route" "test" {
+  destination_cidr_block         = "0.0.0.0/0"
+  transit_gateway_attachment_id  = "${aws_ec2_transit_gateway_vpc_attachment.test.id}"
+  transit_gateway_route_table_id = "${aws_ec2_transit_gateway.test.association_default_route_table_id}"
+}
+
+resource "aws_ec2_transit_gateway_route" "test_blackhole" {
+  blackhole                      = true
+  destination_cidr_block         = "10.1.0.0/16"
+  transit_gateway_route_table_id = "${aws_ec2_transit_gateway.test.association_default_route_table_id}"
+}
+`
+}
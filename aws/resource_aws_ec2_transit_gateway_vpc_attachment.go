@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TransitGatewayVpcAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayVpcAttachmentCreate,
+		Read:   resourceAwsEc2TransitGatewayVpcAttachmentRead,
+		Update: resourceAwsEc2TransitGatewayVpcAttachmentUpdate,
+		Delete: resourceAwsEc2TransitGatewayVpcAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"appliance_mode_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.ApplianceModeSupportValueDisable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ApplianceModeSupportValueDisable,
+					ec2.ApplianceModeSupportValueEnable,
+				}, false),
+			},
+			"dns_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.DnsSupportValueEnable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.DnsSupportValueDisable,
+					ec2.DnsSupportValueEnable,
+				}, false),
+			},
+			"ipv6_support": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.Ipv6SupportValueDisable,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.Ipv6SupportValueDisable,
+					ec2.Ipv6SupportValueEnable,
+				}, false),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_default_route_table_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_default_route_table_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayVpcAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+
+	input := &ec2.CreateTransitGatewayVpcAttachmentInput{
+		Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+			ApplianceModeSupport: aws.String(d.Get("appliance_mode_support").(string)),
+			DnsSupport:           aws.String(d.Get("dns_support").(string)),
+			Ipv6Support:          aws.String(d.Get("ipv6_support").(string)),
+		},
+		SubnetIds:         expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		TransitGatewayId:  aws.String(transitGatewayID),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGatewayAttachment),
+		VpcId:             aws.String(d.Get("vpc_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway VPC Attachment: %s", input)
+	output, err := conn.CreateTransitGatewayVpcAttachment(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway VPC Attachment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayVpcAttachment.TransitGatewayAttachmentId))
+
+	if err := waitForEc2TransitGatewayVpcAttachmentCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway VPC Attachment (%s) availability: %s", d.Id(), err)
+	}
+
+	transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	if transitGateway == nil || transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	// The Transit Gateway associates and propagates new attachments with its
+	// default route table automatically, so undo that if it is not wanted.
+	if !d.Get("transit_gateway_default_route_table_association").(bool) && aws.StringValue(transitGateway.Options.DefaultRouteTableAssociation) == ec2.DefaultRouteTableAssociationValueEnable {
+		if err := ec2TransitGatewayRouteTableDisassociate(conn, aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if !d.Get("transit_gateway_default_route_table_propagation").(bool) && aws.StringValue(transitGateway.Options.DefaultRouteTablePropagation) == ec2.DefaultRouteTablePropagationValueEnable {
+		if err := ec2TransitGatewayRouteTablePropagationDisable(conn, aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayVpcAttachmentRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayVpcAttachment, err := ec2DescribeTransitGatewayVpcAttachment(conn, d.Id())
+
+	if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+		log.Printf("[WARN] EC2 Transit Gateway VPC Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+	}
+
+	if transitGatewayVpcAttachment == nil {
+		log.Printf("[WARN] EC2 Transit Gateway VPC Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(transitGatewayVpcAttachment.State) {
+	case ec2.TransitGatewayAttachmentStateDeleting, ec2.TransitGatewayAttachmentStateDeleted:
+		log.Printf("[WARN] EC2 Transit Gateway VPC Attachment (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGatewayVpcAttachment.State))
+		d.SetId("")
+		return nil
+	}
+
+	transitGatewayID := aws.StringValue(transitGatewayVpcAttachment.TransitGatewayId)
+	transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	if transitGateway == nil || transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	// The default route table may not exist, or the attachment may be in a
+	// different account than the Transit Gateway, so only look it up when
+	// it can be known.
+	transitGatewayDefaultRouteTableAssociation := true
+	if transitGatewayRouteTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId); transitGatewayRouteTableID != "" {
+		association, err := ec2DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayRouteTableID, d.Id())
+		if err != nil {
+			return fmt.Errorf("error determining EC2 Transit Gateway VPC Attachment (%s) association to Route Table (%s): %s", d.Id(), transitGatewayRouteTableID, err)
+		}
+		transitGatewayDefaultRouteTableAssociation = (association != nil)
+	}
+
+	transitGatewayDefaultRouteTablePropagation := true
+	if transitGatewayRouteTableID := aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId); transitGatewayRouteTableID != "" {
+		propagation, err := ec2DescribeTransitGatewayRouteTablePropagation(conn, transitGatewayRouteTableID, d.Id())
+		if err != nil {
+			return fmt.Errorf("error determining EC2 Transit Gateway VPC Attachment (%s) propagation to Route Table (%s): %s", d.Id(), transitGatewayRouteTableID, err)
+		}
+		transitGatewayDefaultRouteTablePropagation = (propagation != nil)
+	}
+
+	if transitGatewayVpcAttachment.Options != nil {
+		d.Set("appliance_mode_support", transitGatewayVpcAttachment.Options.ApplianceModeSupport)
+		d.Set("dns_support", transitGatewayVpcAttachment.Options.DnsSupport)
+		d.Set("ipv6_support", transitGatewayVpcAttachment.Options.Ipv6Support)
+	}
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(transitGatewayVpcAttachment.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(transitGatewayVpcAttachment.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	d.Set("transit_gateway_default_route_table_association", transitGatewayDefaultRouteTableAssociation)
+	d.Set("transit_gateway_default_route_table_propagation", transitGatewayDefaultRouteTablePropagation)
+	d.Set("transit_gateway_id", transitGatewayVpcAttachment.TransitGatewayId)
+	d.Set("vpc_id", transitGatewayVpcAttachment.VpcId)
+	d.Set("vpc_owner_id", transitGatewayVpcAttachment.VpcOwnerId)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayVpcAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("appliance_mode_support") || d.HasChange("dns_support") || d.HasChange("ipv6_support") || d.HasChange("subnet_ids") {
+		input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+			Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
+				ApplianceModeSupport: aws.String(d.Get("appliance_mode_support").(string)),
+				DnsSupport:           aws.String(d.Get("dns_support").(string)),
+				Ipv6Support:          aws.String(d.Get("ipv6_support").(string)),
+			},
+			TransitGatewayAttachmentId: aws.String(d.Id()),
+		}
+
+		oldRaw, newRaw := d.GetChange("subnet_ids")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		if added := newSet.Difference(oldSet); added.Len() > 0 {
+			input.AddSubnetIds = expandStringSet(added)
+		}
+
+		if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+			input.RemoveSubnetIds = expandStringSet(removed)
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Transit Gateway VPC Attachment: %s", input)
+		if _, err := conn.ModifyTransitGatewayVpcAttachment(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2TransitGatewayVpcAttachmentModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway VPC Attachment (%s) modification: %s", d.Id(), err)
+		}
+
+		d.SetPartial("appliance_mode_support")
+		d.SetPartial("dns_support")
+		d.SetPartial("ipv6_support")
+		d.SetPartial("subnet_ids")
+	}
+
+	if d.HasChange("transit_gateway_default_route_table_association") || d.HasChange("transit_gateway_default_route_table_propagation") {
+		transitGatewayID := d.Get("transit_gateway_id").(string)
+
+		transitGateway, err := ec2DescribeTransitGateway(conn, transitGatewayID)
+		if err != nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+		}
+
+		if transitGateway == nil || transitGateway.Options == nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_association") {
+			transitGatewayRouteTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
+
+			if d.Get("transit_gateway_default_route_table_association").(bool) {
+				if err := ec2TransitGatewayRouteTableAssociate(conn, transitGatewayRouteTableID, d.Id()); err != nil {
+					return err
+				}
+			} else {
+				if err := ec2TransitGatewayRouteTableDisassociate(conn, transitGatewayRouteTableID, d.Id()); err != nil {
+					return err
+				}
+			}
+
+			d.SetPartial("transit_gateway_default_route_table_association")
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_propagation") {
+			transitGatewayRouteTableID := aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId)
+
+			if d.Get("transit_gateway_default_route_table_propagation").(bool) {
+				if err := ec2TransitGatewayRouteTablePropagationEnable(conn, transitGatewayRouteTableID, d.Id()); err != nil {
+					return err
+				}
+			} else {
+				if err := ec2TransitGatewayRouteTablePropagationDisable(conn, transitGatewayRouteTableID, d.Id()); err != nil {
+					return err
+				}
+			}
+
+			d.SetPartial("transit_gateway_default_route_table_propagation")
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway VPC Attachment (%s) tags: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsEc2TransitGatewayVpcAttachmentRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayVpcAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTransitGatewayVpcAttachment(input)
+
+	if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2TransitGatewayVpcAttachmentDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway VPC Attachment (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -1,11 +1,53 @@
 package aws
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Lifecycle policy documents use their own lower-case vocabulary rather than
+// the upper-case enums the ECR API uses elsewhere.
+const (
+	ecrLifecyclePolicyTagStatusTagged   = "tagged"
+	ecrLifecyclePolicyTagStatusUntagged = "untagged"
+	ecrLifecyclePolicyTagStatusAny      = "any"
+
+	ecrLifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	ecrLifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+
+	ecrLifecyclePolicyCountUnitDays = "days"
+
+	ecrLifecyclePolicyActionTypeExpire = "expire"
 )
 
+type ecrLifecyclePolicy struct {
+	Rules []*ecrLifecyclePolicyRule `json:"rules"`
+}
+
+type ecrLifecyclePolicyRule struct {
+	RulePriority int                              `json:"rulePriority"`
+	Description  string                           `json:"description,omitempty"`
+	Selection    *ecrLifecyclePolicyRuleSelection `json:"selection"`
+	Action       *ecrLifecyclePolicyRuleAction    `json:"action"`
+}
+
+type ecrLifecyclePolicyRuleSelection struct {
+	TagStatus     string   `json:"tagStatus"`
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+	CountType     string   `json:"countType"`
+	CountUnit     string   `json:"countUnit,omitempty"`
+	CountNumber   int      `json:"countNumber"`
+}
+
+type ecrLifecyclePolicyRuleAction struct {
+	Type string `json:"type"`
+}
+
 func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcrLifecyclePolicyCreate,
@@ -16,6 +58,8 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsEcrLifecyclePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"repository": &schema.Schema{
 				Type:     schema.TypeString,
@@ -24,11 +68,83 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 			},
 			"policy": &schema.Schema{
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
+				ConflictsWith:    []string{"rule"},
 				ValidateFunc:     validateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"rule": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecrLifecyclePolicyTagStatusAny,
+											ecrLifecyclePolicyTagStatusTagged,
+											ecrLifecyclePolicyTagStatusUntagged,
+										}, false),
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"count_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecrLifecyclePolicyCountTypeImageCountMoreThan,
+											ecrLifecyclePolicyCountTypeSinceImagePushed,
+										}, false),
+									},
+									"count_unit": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecrLifecyclePolicyCountUnitDays,
+										}, false),
+									},
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"registry_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,9 +156,21 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 func resourceAwsEcrLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrconn
 
+	policy := d.Get("policy").(string)
+	if v, ok := d.GetOk("rule"); ok {
+		var err error
+		policy, err = expandEcrLifecyclePolicyRules(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+	if policy == "" {
+		return fmt.Errorf("one of policy or rule must be configured")
+	}
+
 	input := &ecr.PutLifecyclePolicyInput{
 		RepositoryName:      aws.String(d.Get("repository").(string)),
-		LifecyclePolicyText: aws.String(d.Get("policy").(string)),
+		LifecyclePolicyText: aws.String(policy),
 	}
 
 	resp, err := conn.PutLifecyclePolicy(input)
@@ -78,6 +206,18 @@ func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 	d.Set("registry_id", resp.RegistryId)
 	d.Set("policy", resp.LifecyclePolicyText)
 
+	// Only manage the typed rules when they were used to configure the
+	// policy; documents written as raw JSON are left to the policy attribute.
+	if len(d.Get("rule").([]interface{})) > 0 {
+		rules, err := flattenEcrLifecyclePolicyRules(aws.StringValue(resp.LifecyclePolicyText))
+		if err != nil {
+			return fmt.Errorf("error reading ECR Lifecycle Policy (%s) rules: %s", d.Id(), err)
+		}
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("error setting rule: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -103,3 +243,160 @@ func resourceAwsEcrLifecyclePolicyDelete(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+func resourceAwsEcrLifecyclePolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	rules := diff.Get("rule").([]interface{})
+	if len(rules) == 0 {
+		if diff.Get("policy").(string) == "" {
+			return fmt.Errorf("one of policy or rule must be configured")
+		}
+		return nil
+	}
+
+	if err := validateEcrLifecyclePolicyRules(rules); err != nil {
+		return err
+	}
+
+	// The rendered document is only known once ECR has stored it.
+	if diff.HasChange("rule") {
+		return diff.SetNewComputed("policy")
+	}
+
+	return nil
+}
+
+// validateEcrLifecyclePolicyRules enforces the constraints ECR applies to
+// lifecycle policy rules so that they are reported at plan time rather than
+// when the policy is put.
+func validateEcrLifecyclePolicyRules(rules []interface{}) error {
+	priorities := make(map[int]bool, len(rules))
+	maxPriority := 0
+	anyPriority := 0
+
+	for _, raw := range rules {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// A priority of zero means the value is not yet known.
+		priority := m["priority"].(int)
+		if priority != 0 {
+			if priorities[priority] {
+				return fmt.Errorf("rule priority %d is used more than once, priorities must be unique", priority)
+			}
+			priorities[priority] = true
+			if priority > maxPriority {
+				maxPriority = priority
+			}
+		}
+
+		selections := m["selection"].([]interface{})
+		if len(selections) == 0 || selections[0] == nil {
+			continue
+		}
+		selection := selections[0].(map[string]interface{})
+
+		tagStatus := selection["tag_status"].(string)
+		tagPrefixes := selection["tag_prefix_list"].([]interface{})
+		switch tagStatus {
+		case ecrLifecyclePolicyTagStatusTagged:
+			if len(tagPrefixes) == 0 {
+				return fmt.Errorf("rule %d: tag_prefix_list must be set when tag_status is %q", priority, tagStatus)
+			}
+		case ecrLifecyclePolicyTagStatusAny:
+			anyPriority = priority
+			fallthrough
+		default:
+			if len(tagPrefixes) > 0 {
+				return fmt.Errorf("rule %d: tag_prefix_list can only be set when tag_status is %q", priority, ecrLifecyclePolicyTagStatusTagged)
+			}
+		}
+
+		countType := selection["count_type"].(string)
+		countUnit := selection["count_unit"].(string)
+		switch countType {
+		case ecrLifecyclePolicyCountTypeSinceImagePushed:
+			if countUnit == "" {
+				return fmt.Errorf("rule %d: count_unit must be set when count_type is %q", priority, countType)
+			}
+		case ecrLifecyclePolicyCountTypeImageCountMoreThan:
+			if countUnit != "" {
+				return fmt.Errorf("rule %d: count_unit cannot be set when count_type is %q", priority, countType)
+			}
+		}
+	}
+
+	if anyPriority != 0 && anyPriority != maxPriority {
+		return fmt.Errorf("rule %d: a rule with tag_status %q must have the highest priority", anyPriority, ecrLifecyclePolicyTagStatusAny)
+	}
+
+	return nil
+}
+
+func expandEcrLifecyclePolicyRules(l []interface{}) (string, error) {
+	policy := &ecrLifecyclePolicy{
+		Rules: make([]*ecrLifecyclePolicyRule, 0, len(l)),
+	}
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		rule := &ecrLifecyclePolicyRule{
+			RulePriority: m["priority"].(int),
+			Description:  m["description"].(string),
+			Selection:    &ecrLifecyclePolicyRuleSelection{},
+			Action: &ecrLifecyclePolicyRuleAction{
+				Type: ecrLifecyclePolicyActionTypeExpire,
+			},
+		}
+
+		if v := m["selection"].([]interface{}); len(v) > 0 && v[0] != nil {
+			sm := v[0].(map[string]interface{})
+			rule.Selection.TagStatus = sm["tag_status"].(string)
+			rule.Selection.TagPrefixList = aws.StringValueSlice(expandStringList(sm["tag_prefix_list"].([]interface{})))
+			rule.Selection.CountType = sm["count_type"].(string)
+			rule.Selection.CountUnit = sm["count_unit"].(string)
+			rule.Selection.CountNumber = sm["count_number"].(int)
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", fmt.Errorf("error building ECR Lifecycle Policy document: %s", err)
+	}
+
+	return string(b), nil
+}
+
+func flattenEcrLifecyclePolicyRules(document string) ([]interface{}, error) {
+	policy := &ecrLifecyclePolicy{}
+	if err := json.Unmarshal([]byte(document), policy); err != nil {
+		return nil, err
+	}
+
+	rules := make([]interface{}, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		m := map[string]interface{}{
+			"priority":    rule.RulePriority,
+			"description": rule.Description,
+		}
+
+		if rule.Selection != nil {
+			m["selection"] = []interface{}{
+				map[string]interface{}{
+					"tag_status":      rule.Selection.TagStatus,
+					"tag_prefix_list": rule.Selection.TagPrefixList,
+					"count_type":      rule.Selection.CountType,
+					"count_unit":      rule.Selection.CountUnit,
+					"count_number":    rule.Selection.CountNumber,
+				},
+			}
+		}
+
+		rules = append(rules, m)
+	}
+
+	return rules, nil
+}
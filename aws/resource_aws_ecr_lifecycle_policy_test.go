@@ -2,6 +2,8 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -53,6 +55,183 @@ func TestAccAWSEcrLifecyclePolicy_import(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcrLifecyclePolicy_rule(t *testing.T) {
+	resourceName := "aws_ecr_lifecycle_policy.foo"
+	rName := acctest.RandomWithPrefix("tf-acc-test-lifecycle")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcrLifecyclePolicyConfig_rule(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.tag_status", "tagged"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.tag_prefix_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.tag_prefix_list.0", "v"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.count_number", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.selection.0.count_unit", "days"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				Config: testAccEcrLifecyclePolicyConfig_rule(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.count_number", "10"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrLifecyclePolicy_ruleDuplicatePriority(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test-lifecycle")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEcrLifecyclePolicyConfig_ruleDuplicatePriority(rName),
+				ExpectError: regexp.MustCompile(`rule priority 1 is used more than once`),
+			},
+		},
+	})
+}
+
+func TestValidateEcrLifecyclePolicyRules(t *testing.T) {
+	rule := func(priority int, tagStatus string, tagPrefixes []interface{}, countType, countUnit string) interface{} {
+		return map[string]interface{}{
+			"priority":    priority,
+			"description": "",
+			"selection": []interface{}{
+				map[string]interface{}{
+					"tag_status":      tagStatus,
+					"tag_prefix_list": tagPrefixes,
+					"count_type":      countType,
+					"count_unit":      countUnit,
+					"count_number":    1,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Rules       []interface{}
+		ErrorPrefix string
+	}{
+		{
+			Rules: []interface{}{
+				rule(1, "tagged", []interface{}{"v"}, "imageCountMoreThan", ""),
+				rule(2, "untagged", []interface{}{}, "sinceImagePushed", "days"),
+				rule(3, "any", []interface{}{}, "imageCountMoreThan", ""),
+			},
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "untagged", []interface{}{}, "imageCountMoreThan", ""),
+				rule(1, "tagged", []interface{}{"v"}, "imageCountMoreThan", ""),
+			},
+			ErrorPrefix: "rule priority 1 is used more than once",
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "tagged", []interface{}{}, "imageCountMoreThan", ""),
+			},
+			ErrorPrefix: "rule 1: tag_prefix_list must be set",
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "untagged", []interface{}{"v"}, "imageCountMoreThan", ""),
+			},
+			ErrorPrefix: "rule 1: tag_prefix_list can only be set",
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "untagged", []interface{}{}, "sinceImagePushed", ""),
+			},
+			ErrorPrefix: "rule 1: count_unit must be set",
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "untagged", []interface{}{}, "imageCountMoreThan", "days"),
+			},
+			ErrorPrefix: "rule 1: count_unit cannot be set",
+		},
+		{
+			Rules: []interface{}{
+				rule(1, "any", []interface{}{}, "imageCountMoreThan", ""),
+				rule(2, "untagged", []interface{}{}, "imageCountMoreThan", ""),
+			},
+			ErrorPrefix: "rule 1: a rule with tag_status \"any\" must have the highest priority",
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateEcrLifecyclePolicyRules(tc.Rules)
+		if tc.ErrorPrefix == "" {
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), tc.ErrorPrefix) {
+			t.Errorf("case %d: expected error prefix %q, got: %v", i, tc.ErrorPrefix, err)
+		}
+	}
+}
+
+func TestEcrLifecyclePolicyRulesRoundTrip(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"priority":    1,
+			"description": "Keep last 30 release images",
+			"selection": []interface{}{
+				map[string]interface{}{
+					"tag_status":      "tagged",
+					"tag_prefix_list": []interface{}{"v"},
+					"count_type":      "imageCountMoreThan",
+					"count_unit":      "",
+					"count_number":    30,
+				},
+			},
+		},
+	}
+
+	document, err := expandEcrLifecyclePolicyRules(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"rules":[{"rulePriority":1,"description":"Keep last 30 release images","selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}]}`
+	if document != expected {
+		t.Fatalf("expected document:\n%s\ngot:\n%s", expected, document)
+	}
+
+	flattened, err := flattenEcrLifecyclePolicyRules(document)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	selection := flattened[0].(map[string]interface{})["selection"].([]interface{})[0].(map[string]interface{})
+	if selection["tag_status"] != "tagged" || selection["count_number"] != 30 || len(selection["tag_prefix_list"].([]string)) != 1 {
+		t.Fatalf("unexpected flattened rules: %#v", flattened)
+	}
+}
+
 func testAccCheckAWSEcrLifecyclePolicyDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ecrconn
 
@@ -131,3 +310,71 @@ EOF
 }
 `, rName)
 }
+
+func testAccEcrLifecyclePolicyConfig_rule(rName string, countNumber int) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "foo" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "foo" {
+  repository = "${aws_ecr_repository.foo.name}"
+
+  rule {
+    priority    = 1
+    description = "Keep recent release images"
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = %[2]d
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+  }
+}
+`, rName, countNumber)
+}
+
+func testAccEcrLifecyclePolicyConfig_ruleDuplicatePriority(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "foo" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "foo" {
+  repository = "${aws_ecr_repository.foo.name}"
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 10
+    }
+  }
+}
+`, rName)
+}
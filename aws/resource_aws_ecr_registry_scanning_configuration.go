@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEcrRegistryScanningConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrRegistryScanningConfigurationPut,
+		Read:   resourceAwsEcrRegistryScanningConfigurationRead,
+		Update: resourceAwsEcrRegistryScanningConfigurationPut,
+		Delete: resourceAwsEcrRegistryScanningConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_filter": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecr.ScanningRepositoryFilterType_Values(), false),
+									},
+								},
+							},
+						},
+						"scan_frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecr.ScanFrequency_Values(), false),
+						},
+					},
+				},
+			},
+			"scan_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsEcrRegistryScanningConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	input := &ecr.PutRegistryScanningConfigurationInput{
+		Rules:    expandEcrRegistryScanningRules(d.Get("rule").(*schema.Set).List()),
+		ScanType: aws.String(d.Get("scan_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECR registry scanning configuration: %s", input)
+	_, err := conn.PutRegistryScanningConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error putting ECR registry scanning configuration: %s", err)
+	}
+
+	// The scanning configuration is a singleton per registry, so the
+	// registry ID doubles as the resource ID.
+	if d.Id() == "" {
+		output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+		if err != nil {
+			return fmt.Errorf("error reading ECR registry scanning configuration: %s", err)
+		}
+		d.SetId(aws.StringValue(output.RegistryId))
+	}
+
+	return resourceAwsEcrRegistryScanningConfigurationRead(d, meta)
+}
+
+func resourceAwsEcrRegistryScanningConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+	if err != nil {
+		return fmt.Errorf("error reading ECR registry scanning configuration: %s", err)
+	}
+
+	d.Set("registry_id", output.RegistryId)
+
+	if config := output.ScanningConfiguration; config != nil {
+		d.Set("scan_type", config.ScanType)
+
+		if err := d.Set("rule", flattenEcrRegistryScanningRules(config.Rules)); err != nil {
+			return fmt.Errorf("error setting rule: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsEcrRegistryScanningConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	// There is no way to remove the configuration, so reset the registry to
+	// basic scanning without any rules.
+	log.Printf("[INFO] Resetting ECR registry scanning configuration: %s", d.Id())
+	_, err := conn.PutRegistryScanningConfiguration(&ecr.PutRegistryScanningConfigurationInput{
+		Rules:    []*ecr.RegistryScanningRule{},
+		ScanType: aws.String(ecr.ScanTypeBasic),
+	})
+	if err != nil {
+		return fmt.Errorf("error resetting ECR registry scanning configuration: %s", err)
+	}
+
+	return nil
+}
+
+func expandEcrRegistryScanningRules(l []interface{}) []*ecr.RegistryScanningRule {
+	rules := make([]*ecr.RegistryScanningRule, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		rule := &ecr.RegistryScanningRule{
+			ScanFrequency: aws.String(m["scan_frequency"].(string)),
+		}
+
+		for _, raw := range m["repository_filter"].(*schema.Set).List() {
+			fm := raw.(map[string]interface{})
+			rule.RepositoryFilters = append(rule.RepositoryFilters, &ecr.ScanningRepositoryFilter{
+				Filter:     aws.String(fm["filter"].(string)),
+				FilterType: aws.String(fm["filter_type"].(string)),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenEcrRegistryScanningRules(rules []*ecr.RegistryScanningRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		filters := make([]interface{}, 0, len(rule.RepositoryFilters))
+		for _, filter := range rule.RepositoryFilters {
+			filters = append(filters, map[string]interface{}{
+				"filter":      aws.StringValue(filter.Filter),
+				"filter_type": aws.StringValue(filter.FilterType),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"repository_filter": filters,
+			"scan_frequency":    aws.StringValue(rule.ScanFrequency),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEcrRegistryScanningConfiguration_basic(t *testing.T) {
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrRegistryScanningConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRegistryScanningConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "registry_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrRegistryScanningConfigurationConfig_rules(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRegistryScanningConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrRegistryScanningConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Registry Scanning Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+		_, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+		return err
+	}
+}
+
+func testAccCheckAWSEcrRegistryScanningConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_registry_scanning_configuration" {
+			continue
+		}
+
+		output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+		if err != nil {
+			return err
+		}
+
+		config := output.ScanningConfiguration
+		if config != nil && (aws.StringValue(config.ScanType) != ecr.ScanTypeBasic || len(config.Rules) > 0) {
+			return fmt.Errorf("ECR Registry Scanning Configuration (%s) was not reset", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcrRegistryScanningConfigurationConfig_basic() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+}
+`
+}
+
+func testAccAWSEcrRegistryScanningConfigurationConfig_rules() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+
+    repository_filter {
+      filter      = "tf-acc-test-*"
+      filter_type = "WILDCARD"
+    }
+  }
+
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEcrReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrReplicationConfigurationPut,
+		Read:   resourceAwsEcrReplicationConfigurationRead,
+		Update: resourceAwsEcrReplicationConfigurationPut,
+		Delete: resourceAwsEcrReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"region": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"registry_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateAwsAccountId,
+												},
+											},
+										},
+									},
+									"repository_filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"filter": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(2, 256),
+												},
+												"filter_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(ecr.RepositoryFilterType_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsEcrReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	input := &ecr.PutReplicationConfigurationInput{
+		ReplicationConfiguration: expandEcrReplicationConfiguration(d.Get("replication_configuration").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting ECR replication configuration: %s", input)
+	_, err := conn.PutReplicationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error putting ECR replication configuration: %s", err)
+	}
+
+	// The replication configuration is a singleton per registry, so the
+	// registry ID doubles as the resource ID.
+	if d.Id() == "" {
+		registry, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+		if err != nil {
+			return fmt.Errorf("error reading ECR registry: %s", err)
+		}
+		d.SetId(aws.StringValue(registry.RegistryId))
+	}
+
+	return resourceAwsEcrReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsEcrReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+	if err != nil {
+		return fmt.Errorf("error reading ECR replication configuration: %s", err)
+	}
+
+	if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+		log.Printf("[WARN] ECR replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("registry_id", output.RegistryId)
+
+	if err := d.Set("replication_configuration", flattenEcrReplicationConfiguration(output.ReplicationConfiguration)); err != nil {
+		return fmt.Errorf("error setting replication_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEcrReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	log.Printf("[INFO] Removing ECR replication configuration: %s", d.Id())
+	_, err := conn.PutReplicationConfiguration(&ecr.PutReplicationConfigurationInput{
+		ReplicationConfiguration: &ecr.ReplicationConfiguration{
+			Rules: []*ecr.ReplicationRule{},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error removing ECR replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+func expandEcrReplicationConfiguration(l []interface{}) *ecr.ReplicationConfiguration {
+	config := &ecr.ReplicationConfiguration{
+		Rules: []*ecr.ReplicationRule{},
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m := l[0].(map[string]interface{})
+
+	for _, raw := range m["rule"].([]interface{}) {
+		rm := raw.(map[string]interface{})
+		rule := &ecr.ReplicationRule{}
+
+		for _, raw := range rm["destination"].([]interface{}) {
+			dm := raw.(map[string]interface{})
+			rule.Destinations = append(rule.Destinations, &ecr.ReplicationDestination{
+				Region:     aws.String(dm["region"].(string)),
+				RegistryId: aws.String(dm["registry_id"].(string)),
+			})
+		}
+
+		for _, raw := range rm["repository_filter"].([]interface{}) {
+			fm := raw.(map[string]interface{})
+			rule.RepositoryFilters = append(rule.RepositoryFilters, &ecr.RepositoryFilter{
+				Filter:     aws.String(fm["filter"].(string)),
+				FilterType: aws.String(fm["filter_type"].(string)),
+			})
+		}
+
+		config.Rules = append(config.Rules, rule)
+	}
+
+	return config
+}
+
+func flattenEcrReplicationConfiguration(config *ecr.ReplicationConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	rules := make([]interface{}, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		destinations := make([]interface{}, 0, len(rule.Destinations))
+		for _, destination := range rule.Destinations {
+			destinations = append(destinations, map[string]interface{}{
+				"region":      aws.StringValue(destination.Region),
+				"registry_id": aws.StringValue(destination.RegistryId),
+			})
+		}
+
+		filters := make([]interface{}, 0, len(rule.RepositoryFilters))
+		for _, filter := range rule.RepositoryFilters {
+			filters = append(filters, map[string]interface{}{
+				"filter":      aws.StringValue(filter.Filter),
+				"filter_type": aws.StringValue(filter.FilterType),
+			})
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"destination":       destinations,
+			"repository_filter": filters,
+		})
+	}
+
+	m := map[string]interface{}{
+		"rule": rules,
+	}
+
+	return []interface{}{m}
+}
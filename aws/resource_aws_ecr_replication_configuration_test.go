@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEcrReplicationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_ecr_replication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrReplicationConfigurationConfig_basic("us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "registry_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.0.region", "us-east-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "replication_configuration.0.rule.0.destination.0.registry_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrReplicationConfigurationConfig_repositoryFilter("us-east-2", "tf-acc-test-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.destination.0.region", "us-east-2"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter", "tf-acc-test-"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter_type", "PREFIX_MATCH"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrReplicationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Replication Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+		output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+			return fmt.Errorf("ECR Replication Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcrReplicationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_replication_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.ReplicationConfiguration != nil && len(output.ReplicationConfiguration.Rules) > 0 {
+			return fmt.Errorf("ECR Replication Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcrReplicationConfigurationConfig_basic(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = "${data.aws_caller_identity.current.account_id}"
+      }
+    }
+  }
+}
+`, region)
+}
+
+func testAccAWSEcrReplicationConfigurationConfig_repositoryFilter(region, filter string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = "${data.aws_caller_identity.current.account_id}"
+      }
+
+      repository_filter {
+        filter      = %[2]q
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+  }
+}
+`, region, filter)
+}
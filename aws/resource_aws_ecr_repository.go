@@ -11,12 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEcrRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcrRepositoryCreate,
 		Read:   resourceAwsEcrRepositoryRead,
+		Update: resourceAwsEcrRepositoryUpdate,
 		Delete: resourceAwsEcrRepositoryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -28,6 +30,45 @@ func resourceAwsEcrRepository() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      ecr.EncryptionTypeAes256,
+							ValidateFunc: validation.StringInSlice(ecr.EncryptionType_Values(), false),
+						},
+						"kms_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"image_scanning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scan_on_push": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,6 +92,14 @@ func resourceAwsEcrRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 		RepositoryName: aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("encryption_configuration"); ok {
+		input.EncryptionConfiguration = expandEcrEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("image_scanning_configuration"); ok {
+		input.ImageScanningConfiguration = expandEcrImageScanningConfiguration(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating ECR resository: %s", input)
 	out, err := conn.CreateRepository(&input)
 	if err != nil {
@@ -92,6 +141,14 @@ func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("registry_id", repository.RegistryId)
 	d.Set("name", repository.RepositoryName)
 
+	if err := d.Set("encryption_configuration", flattenEcrEncryptionConfiguration(repository.EncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting encryption_configuration: %s", err)
+	}
+
+	if err := d.Set("image_scanning_configuration", flattenEcrImageScanningConfiguration(repository.ImageScanningConfiguration)); err != nil {
+		return fmt.Errorf("error setting image_scanning_configuration: %s", err)
+	}
+
 	repositoryUrl := buildRepositoryUrl(repository, meta.(*AWSClient).region)
 	log.Printf("[INFO] Setting the repository url to be %s", repositoryUrl)
 	d.Set("repository_url", repositoryUrl)
@@ -99,6 +156,25 @@ func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceAwsEcrRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	if d.HasChange("image_scanning_configuration") {
+		input := &ecr.PutImageScanningConfigurationInput{
+			ImageScanningConfiguration: expandEcrImageScanningConfiguration(d.Get("image_scanning_configuration").([]interface{})),
+			RegistryId:                 aws.String(d.Get("registry_id").(string)),
+			RepositoryName:             aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating ECR repository image scanning configuration: %s", input)
+		if _, err := conn.PutImageScanningConfiguration(input); err != nil {
+			return fmt.Errorf("error updating ECR repository (%s) image scanning configuration: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEcrRepositoryRead(d, meta)
+}
+
 func buildRepositoryUrl(repo *ecr.Repository, region string) string {
 	return fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s", *repo.RegistryId, region, *repo.RepositoryName)
 }
@@ -150,3 +226,61 @@ func resourceAwsEcrRepositoryDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func expandEcrEncryptionConfiguration(l []interface{}) *ecr.EncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &ecr.EncryptionConfiguration{
+		EncryptionType: aws.String(m["encryption_type"].(string)),
+	}
+
+	if v, ok := m["kms_key"].(string); ok && v != "" {
+		config.KmsKey = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenEcrEncryptionConfiguration(config *ecr.EncryptionConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"encryption_type": aws.StringValue(config.EncryptionType),
+		"kms_key":         aws.StringValue(config.KmsKey),
+	}
+
+	return []interface{}{m}
+}
+
+func expandEcrImageScanningConfiguration(l []interface{}) *ecr.ImageScanningConfiguration {
+	config := &ecr.ImageScanningConfiguration{
+		ScanOnPush: aws.Bool(false),
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m := l[0].(map[string]interface{})
+	config.ScanOnPush = aws.Bool(m["scan_on_push"].(bool))
+
+	return config
+}
+
+func flattenEcrImageScanningConfiguration(config *ecr.ImageScanningConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"scan_on_push": aws.BoolValue(config.ScanOnPush),
+	}
+
+	return []interface{}{m}
+}
@@ -30,6 +30,89 @@ func TestAccAWSEcrRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcrRepository_imageScanningConfiguration(t *testing.T) {
+	randString := acctest.RandString(10)
+	resourceName := "aws_ecr_repository.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrRepositoryConfig_imageScanningConfiguration(randString, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.scan_on_push", "true"),
+				),
+			},
+			{
+				Config: testAccAWSEcrRepositoryConfig_imageScanningConfiguration(randString, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.scan_on_push", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrRepository_encryptionKms(t *testing.T) {
+	randString := acctest.RandString(10)
+	resourceName := "aws_ecr_repository.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrRepositoryConfig_encryptionKms(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.encryption_type", "KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.kms_key", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrRepository_encryptionAes256(t *testing.T) {
+	randString := acctest.RandString(10)
+	resourceName := "aws_ecr_repository.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrRepository(randString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.encryption_type", "AES256"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.kms_key", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSEcrRepositoryDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ecrconn
 
@@ -79,3 +162,33 @@ resource "aws_ecr_repository" "default" {
 }
 `, randString)
 }
+
+func testAccAWSEcrRepositoryConfig_imageScanningConfiguration(randString string, scanOnPush bool) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "default" {
+  name = "tf-acc-test-ecr-%s"
+
+  image_scanning_configuration {
+    scan_on_push = %t
+  }
+}
+`, randString, scanOnPush)
+}
+
+func testAccAWSEcrRepositoryConfig_encryptionKms(randString string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "tf-acc-test-ecr-%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_ecr_repository" "default" {
+  name = "tf-acc-test-ecr-%[1]s"
+
+  encryption_configuration {
+    encryption_type = "KMS"
+    kms_key         = "${aws_kms_key.test.arn}"
+  }
+}
+`, randString)
+}
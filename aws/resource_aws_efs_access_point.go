@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEfsAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEfsAccessPointCreate,
+		Read:   resourceAwsEfsAccessPointRead,
+		Update: resourceAwsEfsAccessPointUpdate,
+		Delete: resourceAwsEfsAccessPointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_system_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"posix_user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gid": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"secondary_gids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"uid": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"root_directory": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"owner_gid": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+									"owner_uid": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+									"permissions": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-7]{3,4}$`), "must be an octal mode such as 755"),
+									},
+								},
+							},
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEfsAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+	fsID := d.Get("file_system_id").(string)
+
+	input := &efs.CreateAccessPointInput{
+		FileSystemId: aws.String(fsID),
+		Tags:         tagsFromMapEFS(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("posix_user"); ok {
+		input.PosixUser = expandEfsAccessPointPosixUser(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("root_directory"); ok {
+		input.RootDirectory = expandEfsAccessPointRootDirectory(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EFS access point: %s", input)
+	ap, err := conn.CreateAccessPoint(input)
+	if err != nil {
+		return fmt.Errorf("error creating EFS access point for file system (%s): %s", fsID, err)
+	}
+
+	d.SetId(aws.StringValue(ap.AccessPointId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{efs.LifeCycleStateCreating},
+		Target:     []string{efs.LifeCycleStateAvailable},
+		Refresh:    resourceEfsAccessPointLifeCycleStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EFS access point (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsEfsAccessPointRead(d, meta)
+}
+
+func resourceAwsEfsAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+
+	if err := setTagsEFSResource(conn, d); err != nil {
+		return fmt.Errorf("error updating EFS access point (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEfsAccessPointRead(d, meta)
+}
+
+func resourceAwsEfsAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+
+	resp, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+		AccessPointId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, efs.ErrCodeAccessPointNotFound, "") {
+		log.Printf("[WARN] EFS access point (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EFS access point (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || len(resp.AccessPoints) == 0 {
+		log.Printf("[WARN] EFS access point (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ap := resp.AccessPoints[0]
+	fsID := aws.StringValue(ap.FileSystemId)
+
+	fsARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Service:   "elasticfilesystem",
+		AccountID: aws.StringValue(ap.OwnerId),
+		Resource:  fmt.Sprintf("file-system/%s", fsID),
+	}
+
+	d.Set("arn", ap.AccessPointArn)
+	d.Set("file_system_arn", fsARN.String())
+	d.Set("file_system_id", fsID)
+	d.Set("owner_id", ap.OwnerId)
+
+	if err := d.Set("posix_user", flattenEfsAccessPointPosixUser(ap.PosixUser)); err != nil {
+		return fmt.Errorf("error setting posix_user: %s", err)
+	}
+
+	if err := d.Set("root_directory", flattenEfsAccessPointRootDirectory(ap.RootDirectory)); err != nil {
+		return fmt.Errorf("error setting root_directory: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapEFS(ap.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEfsAccessPointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+
+	log.Printf("[DEBUG] Deleting EFS access point: %s", d.Id())
+	_, err := conn.DeleteAccessPoint(&efs.DeleteAccessPointInput{
+		AccessPointId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, efs.ErrCodeAccessPointNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting EFS access point (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{efs.LifeCycleStateAvailable, efs.LifeCycleStateDeleting},
+		Target:     []string{efs.LifeCycleStateDeleted},
+		Refresh:    resourceEfsAccessPointLifeCycleStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EFS access point (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEfsAccessPointLifeCycleStateRefreshFunc(conn *efs.EFS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+			AccessPointId: aws.String(id),
+		})
+		if isAWSErr(err, efs.ErrCodeAccessPointNotFound, "") {
+			return &efs.AccessPointDescription{}, efs.LifeCycleStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.AccessPoints) == 0 {
+			return &efs.AccessPointDescription{}, efs.LifeCycleStateDeleted, nil
+		}
+
+		ap := resp.AccessPoints[0]
+		return ap, aws.StringValue(ap.LifeCycleState), nil
+	}
+}
+
+func expandEfsAccessPointPosixUser(l []interface{}) *efs.PosixUser {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	posixUser := &efs.PosixUser{
+		Gid: aws.Int64(int64(m["gid"].(int))),
+		Uid: aws.Int64(int64(m["uid"].(int))),
+	}
+
+	if v, ok := m["secondary_gids"].(*schema.Set); ok && v.Len() > 0 {
+		for _, gid := range v.List() {
+			posixUser.SecondaryGids = append(posixUser.SecondaryGids, aws.Int64(int64(gid.(int))))
+		}
+	}
+
+	return posixUser
+}
+
+func expandEfsAccessPointRootDirectory(l []interface{}) *efs.RootDirectory {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rootDir := &efs.RootDirectory{}
+
+	if v, ok := m["path"].(string); ok && v != "" {
+		rootDir.Path = aws.String(v)
+	}
+
+	if v, ok := m["creation_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ci := v[0].(map[string]interface{})
+		rootDir.CreationInfo = &efs.CreationInfo{
+			OwnerGid:    aws.Int64(int64(ci["owner_gid"].(int))),
+			OwnerUid:    aws.Int64(int64(ci["owner_uid"].(int))),
+			Permissions: aws.String(ci["permissions"].(string)),
+		}
+	}
+
+	return rootDir
+}
+
+func flattenEfsAccessPointPosixUser(posixUser *efs.PosixUser) []interface{} {
+	if posixUser == nil {
+		return []interface{}{}
+	}
+
+	secondaryGids := make([]interface{}, 0, len(posixUser.SecondaryGids))
+	for _, gid := range posixUser.SecondaryGids {
+		secondaryGids = append(secondaryGids, int(aws.Int64Value(gid)))
+	}
+
+	m := map[string]interface{}{
+		"gid":            aws.Int64Value(posixUser.Gid),
+		"secondary_gids": secondaryGids,
+		"uid":            aws.Int64Value(posixUser.Uid),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEfsAccessPointRootDirectory(rootDir *efs.RootDirectory) []interface{} {
+	if rootDir == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"path": aws.StringValue(rootDir.Path),
+	}
+
+	if ci := rootDir.CreationInfo; ci != nil {
+		m["creation_info"] = []interface{}{
+			map[string]interface{}{
+				"owner_gid":   aws.Int64Value(ci.OwnerGid),
+				"owner_uid":   aws.Int64Value(ci.OwnerUid),
+				"permissions": aws.StringValue(ci.Permissions),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,265 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEFSAccessPoint_basic(t *testing.T) {
+	var ap efs.AccessPointDescription
+	resourceName := "aws_efs_access_point.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSAccessPointConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsAccessPointExists(resourceName, &ap),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "file_system_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "file_system_id", "aws_efs_file_system.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "posix_user.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEFSAccessPoint_posixUser(t *testing.T) {
+	var ap efs.AccessPointDescription
+	resourceName := "aws_efs_access_point.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSAccessPointConfigPosixUser(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsAccessPointExists(resourceName, &ap),
+					resource.TestCheckResourceAttr(resourceName, "posix_user.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "posix_user.0.gid", "1001"),
+					resource.TestCheckResourceAttr(resourceName, "posix_user.0.uid", "1001"),
+					resource.TestCheckResourceAttr(resourceName, "posix_user.0.secondary_gids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEFSAccessPoint_rootDirectoryCreationInfo(t *testing.T) {
+	var ap efs.AccessPointDescription
+	resourceName := "aws_efs_access_point.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSAccessPointConfigRootDirectoryCreationInfo(rInt, "/home/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsAccessPointExists(resourceName, &ap),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.path", "/home/test"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.creation_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.creation_info.0.owner_gid", "1001"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.creation_info.0.owner_uid", "1001"),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.creation_info.0.permissions", "755"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEFSAccessPoint_tags(t *testing.T) {
+	var ap efs.AccessPointDescription
+	resourceName := "aws_efs_access_point.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSAccessPointConfigTags(rInt, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsAccessPointExists(resourceName, &ap),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEFSAccessPointConfigTags(rInt, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsAccessPointExists(resourceName, &ap),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEfsAccessPointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).efsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_efs_access_point" {
+			continue
+		}
+
+		resp, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+			AccessPointId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, efs.ErrCodeAccessPointNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error describing EFS access point in tests: %s", err)
+		}
+
+		if len(resp.AccessPoints) > 0 {
+			return fmt.Errorf("EFS access point %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEfsAccessPointExists(n string, v *efs.AccessPointDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).efsconn
+
+		resp, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+			AccessPointId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.AccessPoints) == 0 {
+			return fmt.Errorf("EFS access point (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.AccessPoints[0]
+
+		return nil
+	}
+}
+
+func testAccAWSEFSAccessPointConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = "tf-acc-access-point-%d"
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = "${aws_efs_file_system.test.id}"
+}
+`, rInt)
+}
+
+func testAccAWSEFSAccessPointConfigPosixUser(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = "tf-acc-access-point-%d"
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = "${aws_efs_file_system.test.id}"
+
+  posix_user {
+    gid            = 1001
+    uid            = 1001
+    secondary_gids = [1002]
+  }
+}
+`, rInt)
+}
+
+func testAccAWSEFSAccessPointConfigRootDirectoryCreationInfo(rInt int, path string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = "tf-acc-access-point-%d"
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = "${aws_efs_file_system.test.id}"
+
+  root_directory {
+    path = %q
+
+    creation_info {
+      owner_gid   = 1001
+      owner_uid   = 1001
+      permissions = "755"
+    }
+  }
+}
+`, rInt, path)
+}
+
+func testAccAWSEFSAccessPointConfigTags(rInt int, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = "tf-acc-access-point-%d"
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = "${aws_efs_file_system.test.id}"
+
+  tags {
+    Name = "tf-acc-access-point-%d"
+    Key  = %q
+  }
+}
+`, rInt, rInt, tagValue)
+}
@@ -73,6 +73,50 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Computed: true,
 			},
 
+			"availability_zone_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"availability_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"throughput_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      efs.ThroughputModeBursting,
+				ValidateFunc: validation.StringInSlice(efs.ThroughputMode_Values(), false),
+			},
+
+			"provisioned_throughput_in_mibps": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"lifecycle_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transition_to_ia": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(efs.TransitionToIARules_Values(), false),
+						},
+						"transition_to_primary_storage_class": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(efs.TransitionToPrimaryStorageClassRules_Values(), false),
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -101,6 +145,21 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 		createOpts.PerformanceMode = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("availability_zone_name"); ok {
+		createOpts.AvailabilityZoneName = aws.String(v.(string))
+	}
+
+	throughputMode := d.Get("throughput_mode").(string)
+	createOpts.ThroughputMode = aws.String(throughputMode)
+
+	if v, ok := d.GetOk("provisioned_throughput_in_mibps"); ok {
+		createOpts.ProvisionedThroughputInMibps = aws.Float64(v.(float64))
+	}
+
+	if throughputMode == efs.ThroughputModeProvisioned && createOpts.ProvisionedThroughputInMibps == nil {
+		return errors.New("provisioned_throughput_in_mibps must be set when throughput_mode is provisioned")
+	}
+
 	encrypted, hasEncrypted := d.GetOk("encrypted")
 	kmsKeyId, hasKmsKeyId := d.GetOk("kms_key_id")
 
@@ -161,6 +220,51 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).efsconn
+
+	if !d.IsNewResource() && (d.HasChange("throughput_mode") || d.HasChange("provisioned_throughput_in_mibps")) {
+		input := &efs.UpdateFileSystemInput{
+			FileSystemId:   aws.String(d.Id()),
+			ThroughputMode: aws.String(d.Get("throughput_mode").(string)),
+		}
+
+		if d.Get("throughput_mode").(string) == efs.ThroughputModeProvisioned {
+			input.ProvisionedThroughputInMibps = aws.Float64(d.Get("provisioned_throughput_in_mibps").(float64))
+		}
+
+		log.Printf("[DEBUG] Updating EFS file system: %s", input)
+		_, err := conn.UpdateFileSystem(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EFS file system (%q): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{efs.LifeCycleStateUpdating},
+			Target:     []string{efs.LifeCycleStateAvailable},
+			Refresh:    resourceEfsFileSystemLifeCycleStateRefreshFunc(conn, d.Id()),
+			Timeout:    10 * time.Minute,
+			Delay:      2 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for EFS file system (%q) to update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("lifecycle_policy") {
+		input := &efs.PutLifecycleConfigurationInput{
+			FileSystemId:      aws.String(d.Id()),
+			LifecyclePolicies: expandEfsLifecyclePolicies(d.Get("lifecycle_policy").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Putting EFS file system lifecycle configuration: %s", input)
+		_, err := conn.PutLifecycleConfiguration(input)
+		if err != nil {
+			return fmt.Errorf("Error putting EFS file system (%q) lifecycle configuration: %s", d.Id(), err)
+		}
+	}
+
 	err := setTagsEFS(conn, d)
 	if err != nil {
 		return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
@@ -238,6 +342,21 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("performance_mode", fs.PerformanceMode)
 	d.Set("encrypted", fs.Encrypted)
 	d.Set("kms_key_id", fs.KmsKeyId)
+	d.Set("availability_zone_id", fs.AvailabilityZoneId)
+	d.Set("availability_zone_name", fs.AvailabilityZoneName)
+	d.Set("throughput_mode", fs.ThroughputMode)
+	d.Set("provisioned_throughput_in_mibps", fs.ProvisionedThroughputInMibps)
+
+	lcResp, err := conn.DescribeLifecycleConfiguration(&efs.DescribeLifecycleConfigurationInput{
+		FileSystemId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing lifecycle configuration for EFS file system (%q): %s", d.Id(), err)
+	}
+
+	if err := d.Set("lifecycle_policy", flattenEfsLifecyclePolicies(lcResp.LifecyclePolicies)); err != nil {
+		return fmt.Errorf("Error setting lifecycle_policy: %s", err)
+	}
 
 	region := meta.(*AWSClient).region
 	err = d.Set("dns_name", resourceAwsEfsDnsName(*fs.FileSystemId, region))
@@ -314,3 +433,69 @@ func hasEmptyFileSystems(fs *efs.DescribeFileSystemsOutput) bool {
 func resourceAwsEfsDnsName(fileSystemId, region string) string {
 	return fmt.Sprintf("%s.efs.%s.amazonaws.com", fileSystemId, region)
 }
+
+func resourceEfsFileSystemLifeCycleStateRefreshFunc(conn *efs.EFS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
+			FileSystemId: aws.String(id),
+		})
+		if err != nil {
+			return nil, "error", err
+		}
+
+		if hasEmptyFileSystems(resp) {
+			return nil, "not-found", fmt.Errorf("EFS file system %q could not be found.", id)
+		}
+
+		fs := resp.FileSystems[0]
+		log.Printf("[DEBUG] current status of %q: %q", *fs.FileSystemId, *fs.LifeCycleState)
+		return fs, *fs.LifeCycleState, nil
+	}
+}
+
+func expandEfsLifecyclePolicies(l []interface{}) []*efs.LifecyclePolicy {
+	policies := make([]*efs.LifecyclePolicy, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		policy := &efs.LifecyclePolicy{}
+
+		if v, ok := m["transition_to_ia"].(string); ok && v != "" {
+			policy.TransitionToIA = aws.String(v)
+		}
+
+		if v, ok := m["transition_to_primary_storage_class"].(string); ok && v != "" {
+			policy.TransitionToPrimaryStorageClass = aws.String(v)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+func flattenEfsLifecyclePolicies(policies []*efs.LifecyclePolicy) []interface{} {
+	l := make([]interface{}, 0, len(policies))
+
+	for _, policy := range policies {
+		m := make(map[string]interface{})
+
+		if policy.TransitionToIA != nil {
+			m["transition_to_ia"] = aws.StringValue(policy.TransitionToIA)
+		}
+
+		if policy.TransitionToPrimaryStorageClass != nil {
+			m["transition_to_primary_storage_class"] = aws.StringValue(policy.TransitionToPrimaryStorageClass)
+		}
+
+		// Archive transitions are not managed by this resource.
+		if len(m) > 0 {
+			l = append(l, m)
+		}
+	}
+
+	return l
+}
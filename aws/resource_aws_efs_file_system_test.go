@@ -183,6 +183,90 @@ func TestAccAWSEFSFileSystem_kmsConfigurationWithoutEncryption(t *testing.T) {
 	})
 }
 
+func TestAccAWSEFSFileSystem_lifecyclePolicy(t *testing.T) {
+	resourceName := "aws_efs_file_system.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSFileSystemConfigWithLifecyclePolicy(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsFileSystem(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_policy.0.transition_to_ia", "AFTER_30_DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_policy.1.transition_to_primary_storage_class", "AFTER_1_ACCESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEFSFileSystemConfigWithoutLifecyclePolicy(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsFileSystem(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEFSFileSystem_provisionedThroughput(t *testing.T) {
+	resourceName := "aws_efs_file_system.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSFileSystemConfigWithProvisionedThroughput(rInt, 1.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsFileSystem(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput_mode", efs.ThroughputModeProvisioned),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_throughput_in_mibps", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEFSFileSystemConfigWithProvisionedThroughput(rInt, 2.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsFileSystem(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput_mode", efs.ThroughputModeProvisioned),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_throughput_in_mibps", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEFSFileSystem_oneZone(t *testing.T) {
+	resourceName := "aws_efs_file_system.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSFileSystemConfigWithAvailabilityZone(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsFileSystem(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone_name", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEfsFileSystemDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).efsconn
 	for _, rs := range s.RootModule().Resources {
@@ -390,3 +474,50 @@ resource "aws_efs_file_system" "foo-with-kms" {
 }
 `, rInt)
 }
+
+func testAccAWSEFSFileSystemConfigWithLifecyclePolicy(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "foo" {
+  creation_token = "tf-acc-lifecycle-%d"
+
+  lifecycle_policy {
+    transition_to_ia = "AFTER_30_DAYS"
+  }
+
+  lifecycle_policy {
+    transition_to_primary_storage_class = "AFTER_1_ACCESS"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSEFSFileSystemConfigWithoutLifecyclePolicy(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "foo" {
+  creation_token = "tf-acc-lifecycle-%d"
+}
+`, rInt)
+}
+
+func testAccAWSEFSFileSystemConfigWithProvisionedThroughput(rInt int, throughput float64) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "foo" {
+  creation_token                  = "tf-acc-throughput-%d"
+  throughput_mode                 = "provisioned"
+  provisioned_throughput_in_mibps = %f
+}
+`, rInt, throughput)
+}
+
+func testAccAWSEFSFileSystemConfigWithAvailabilityZone(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_efs_file_system" "foo" {
+  creation_token         = "tf-acc-one-zone-%d"
+  availability_zone_name = "${data.aws_availability_zones.available.names[0]}"
+}
+`, rInt)
+}
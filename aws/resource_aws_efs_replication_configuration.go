@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEfsReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEfsReplicationConfigurationCreate,
+		Read:   resourceAwsEfsReplicationConfigurationRead,
+		Delete: resourceAwsEfsReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"file_system_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"original_source_file_system_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_file_system_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_file_system_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEfsReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+	fsID := d.Get("source_file_system_id").(string)
+
+	input := &efs.CreateReplicationConfigurationInput{
+		Destinations:       expandEfsReplicationDestinations(d.Get("destination").([]interface{})),
+		SourceFileSystemId: aws.String(fsID),
+	}
+
+	log.Printf("[DEBUG] Creating EFS replication configuration: %s", input)
+	_, err := conn.CreateReplicationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error creating EFS replication configuration for file system (%s): %s", fsID, err)
+	}
+
+	d.SetId(fsID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{efs.ReplicationStatusEnabling},
+		Target:  []string{efs.ReplicationStatusEnabled},
+		Refresh: efsReplicationConfigurationRefreshStatusFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EFS replication configuration (%s) to enable: %s", d.Id(), err)
+	}
+
+	return resourceAwsEfsReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsEfsReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+
+	replication, err := getEfsReplicationConfiguration(conn, d.Id())
+	if isAWSErr(err, efs.ErrCodeFileSystemNotFound, "") || isAWSErr(err, efs.ErrCodeReplicationNotFound, "") {
+		log.Printf("[WARN] EFS replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EFS replication configuration (%s): %s", d.Id(), err)
+	}
+
+	if replication == nil {
+		log.Printf("[WARN] EFS replication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("creation_time", aws.TimeValue(replication.CreationTime).Format(time.RFC3339))
+	d.Set("original_source_file_system_arn", replication.OriginalSourceFileSystemArn)
+	d.Set("source_file_system_arn", replication.SourceFileSystemArn)
+	d.Set("source_file_system_id", replication.SourceFileSystemId)
+	d.Set("source_file_system_region", replication.SourceFileSystemRegion)
+
+	// The availability zone and KMS key of the destination are not returned,
+	// so they are carried over from the configuration.
+	destinations := flattenEfsReplicationDestinations(replication.Destinations)
+	if v, ok := d.GetOk("destination"); ok && len(destinations) > 0 {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			destinations[0].(map[string]interface{})["availability_zone_name"] = m["availability_zone_name"]
+			destinations[0].(map[string]interface{})["kms_key_id"] = m["kms_key_id"]
+		}
+	}
+
+	if err := d.Set("destination", destinations); err != nil {
+		return fmt.Errorf("error setting destination: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEfsReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).efsconn
+
+	input := &efs.DeleteReplicationConfigurationInput{
+		SourceFileSystemId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EFS replication configuration: %s", input)
+	_, err := conn.DeleteReplicationConfiguration(input)
+	if isAWSErr(err, efs.ErrCodeFileSystemNotFound, "") || isAWSErr(err, efs.ErrCodeReplicationNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting EFS replication configuration (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{efs.ReplicationStatusDeleting, efs.ReplicationStatusEnabled},
+		Target:  []string{"NotFound"},
+		Refresh: efsReplicationConfigurationRefreshStatusFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EFS replication configuration (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getEfsReplicationConfiguration(conn *efs.EFS, fsID string) (*efs.ReplicationConfigurationDescription, error) {
+	output, err := conn.DescribeReplicationConfigurations(&efs.DescribeReplicationConfigurationsInput{
+		FileSystemId: aws.String(fsID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replication := range output.Replications {
+		if aws.StringValue(replication.SourceFileSystemId) == fsID {
+			return replication, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func efsReplicationConfigurationRefreshStatusFunc(conn *efs.EFS, fsID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replication, err := getEfsReplicationConfiguration(conn, fsID)
+		if isAWSErr(err, efs.ErrCodeFileSystemNotFound, "") || isAWSErr(err, efs.ErrCodeReplicationNotFound, "") {
+			return &efs.ReplicationConfigurationDescription{}, "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if replication == nil || len(replication.Destinations) == 0 {
+			return &efs.ReplicationConfigurationDescription{}, "NotFound", nil
+		}
+
+		return replication, aws.StringValue(replication.Destinations[0].Status), nil
+	}
+}
+
+func expandEfsReplicationDestinations(l []interface{}) []*efs.DestinationToCreate {
+	destinations := make([]*efs.DestinationToCreate, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		destination := &efs.DestinationToCreate{}
+
+		if v, ok := m["availability_zone_name"].(string); ok && v != "" {
+			destination.AvailabilityZoneName = aws.String(v)
+		}
+
+		if v, ok := m["kms_key_id"].(string); ok && v != "" {
+			destination.KmsKeyId = aws.String(v)
+		}
+
+		if v, ok := m["region"].(string); ok && v != "" {
+			destination.Region = aws.String(v)
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations
+}
+
+func flattenEfsReplicationDestinations(destinations []*efs.Destination) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		m := map[string]interface{}{
+			"file_system_id": aws.StringValue(destination.FileSystemId),
+			"region":         aws.StringValue(destination.Region),
+			"status":         aws.StringValue(destination.Status),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
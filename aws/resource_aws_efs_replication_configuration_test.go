@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEFSReplicationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_efs_replication_configuration.test"
+	rInt := acctest.RandInt()
+	destinationRegion := testAccAwsEfsReplicationConfigurationDestinationRegion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEfsReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEFSReplicationConfigurationConfig(rInt, destinationRegion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEfsReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination.0.file_system_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.region", destinationRegion),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+					resource.TestCheckResourceAttrSet(resourceName, "original_source_file_system_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_file_system_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_file_system_id", "aws_efs_file_system.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_file_system_region", testAccGetRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEfsReplicationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).efsconn
+
+		replication, err := getEfsReplicationConfiguration(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if replication == nil {
+			return fmt.Errorf("EFS replication configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEfsReplicationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).efsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_efs_replication_configuration" {
+			continue
+		}
+
+		replication, err := getEfsReplicationConfiguration(conn, rs.Primary.ID)
+		if isAWSErr(err, efs.ErrCodeFileSystemNotFound, "") || isAWSErr(err, efs.ErrCodeReplicationNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if replication != nil {
+			return fmt.Errorf("EFS replication configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccAwsEfsReplicationConfigurationDestinationRegion returns a region
+// other than the one under test to replicate into.
+func testAccAwsEfsReplicationConfigurationDestinationRegion() string {
+	if testAccGetRegion() == "us-east-1" {
+		return "us-west-2"
+	}
+	return "us-east-1"
+}
+
+// The destination file system is created by EFS and is left behind once the
+// replication configuration is deleted.
+func testAccAWSEFSReplicationConfigurationConfig(rInt int, region string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = "tf-acc-replication-%d"
+}
+
+resource "aws_efs_replication_configuration" "test" {
+  source_file_system_id = "${aws_efs_file_system.test.id}"
+
+  destination {
+    region = %q
+  }
+}
+`, rInt, region)
+}
@@ -17,7 +17,11 @@ func resourceAwsEgressOnlyInternetGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEgressOnlyInternetGatewayCreate,
 		Read:   resourceAwsEgressOnlyInternetGatewayRead,
+		Update: resourceAwsEgressOnlyInternetGatewayUpdate,
 		Delete: resourceAwsEgressOnlyInternetGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -25,6 +29,7 @@ func resourceAwsEgressOnlyInternetGateway() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -33,7 +38,8 @@ func resourceAwsEgressOnlyInternetGatewayCreate(d *schema.ResourceData, meta int
 	conn := meta.(*AWSClient).ec2conn
 
 	resp, err := conn.CreateEgressOnlyInternetGateway(&ec2.CreateEgressOnlyInternetGatewayInput{
-		VpcId: aws.String(d.Get("vpc_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeEgressOnlyInternetGateway),
+		VpcId:             aws.String(d.Get("vpc_id").(string)),
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating egress internet gateway: %s", err)
@@ -99,28 +105,49 @@ func resourceAwsEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error describing egress internet gateway: %s", err)
 	}
 
-	found := false
+	var found *ec2.EgressOnlyInternetGateway
 	for _, igw := range resp.EgressOnlyInternetGateways {
-		if *igw.EgressOnlyInternetGatewayId == d.Id() {
-			found = true
+		if aws.StringValue(igw.EgressOnlyInternetGatewayId) == d.Id() {
+			found = igw
 		}
 	}
 
-	if !found {
+	if found == nil {
 		log.Printf("[Error] Cannot find Egress Only Internet Gateway: %q", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if len(found.Attachments) == 1 && aws.StringValue(found.Attachments[0].State) == ec2.AttachmentStatusAttached {
+		d.Set("vpc_id", found.Attachments[0].VpcId)
+	}
+
+	if err := d.Set("tags", tagsToMap(found.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsEgressOnlyInternetGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating Egress Only Internet Gateway (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEgressOnlyInternetGatewayRead(d, meta)
+}
+
 func resourceAwsEgressOnlyInternetGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	_, err := conn.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{
 		EgressOnlyInternetGatewayId: aws.String(d.Id()),
 	})
+	if isAWSErr(err, "InvalidEgressInternetGatewayID.NotFound", "") {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error deleting egress internet gateway: %s", err)
 	}
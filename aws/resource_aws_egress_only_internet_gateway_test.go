@@ -21,6 +21,68 @@ func TestAccAWSEgressOnlyInternetGateway_basic(t *testing.T) {
 				Config: testAccAWSEgressOnlyInternetGatewayConfig_basic,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAWSEgressOnlyInternetGatewayExists("aws_egress_only_internet_gateway.foo", &igw),
+					resource.TestCheckResourceAttrPair("aws_egress_only_internet_gateway.foo", "vpc_id", "aws_vpc.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "aws_egress_only_internet_gateway.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEgressOnlyInternetGateway_tags(t *testing.T) {
+	var igw ec2.EgressOnlyInternetGateway
+	resourceName := "aws_egress_only_internet_gateway.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEgressOnlyInternetGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEgressOnlyInternetGatewayConfigTags("foo", "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEgressOnlyInternetGatewayExists(resourceName, &igw),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEgressOnlyInternetGatewayConfigTags("foo", "baz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEgressOnlyInternetGatewayExists(resourceName, &igw),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEgressOnlyInternetGateway_dualStack(t *testing.T) {
+	var igw ec2.EgressOnlyInternetGateway
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEgressOnlyInternetGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEgressOnlyInternetGatewayConfigDualStack,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEgressOnlyInternetGatewayExists("aws_egress_only_internet_gateway.foo", &igw),
+					resource.TestCheckResourceAttrSet("aws_vpc.foo", "ipv6_cidr_block"),
+					resource.TestCheckResourceAttrSet("aws_subnet.foo", "ipv6_cidr_block"),
+					resource.TestCheckResourceAttr("aws_subnet.foo", "assign_ipv6_address_on_creation", "true"),
+					resource.TestCheckResourceAttr("aws_route_table.foo", "route.#", "2"),
 				),
 			},
 		},
@@ -93,3 +155,73 @@ resource "aws_egress_only_internet_gateway" "foo" {
   	vpc_id = "${aws_vpc.foo.id}"
 }
 `
+
+func testAccAWSEgressOnlyInternetGatewayConfigTags(tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags {
+    Name = "terraform-testacc-egress-only-igw-tags"
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+
+  tags {
+    %[1]s = %[2]q
+  }
+}
+`, tagKey, tagValue)
+}
+
+const testAccAWSEgressOnlyInternetGatewayConfigDualStack = `
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags {
+    Name = "terraform-testacc-egress-only-igw-dual-stack"
+  }
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id                          = "${aws_vpc.foo.id}"
+  cidr_block                      = "${cidrsubnet(aws_vpc.foo.cidr_block, 8, 1)}"
+  ipv6_cidr_block                 = "${cidrsubnet(aws_vpc.foo.ipv6_cidr_block, 8, 1)}"
+  assign_ipv6_address_on_creation = true
+
+  tags {
+    Name = "tf-acc-egress-only-igw-dual-stack"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_egress_only_internet_gateway" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "${aws_internet_gateway.foo.id}"
+  }
+
+  route {
+    ipv6_cidr_block        = "::/0"
+    egress_only_gateway_id = "${aws_egress_only_internet_gateway.foo.id}"
+  }
+}
+
+resource "aws_route_table_association" "foo" {
+  subnet_id      = "${aws_subnet.foo.id}"
+  route_table_id = "${aws_route_table.foo.id}"
+}
+`
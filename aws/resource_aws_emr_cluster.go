@@ -37,21 +37,26 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Required: true,
 			},
 			"master_instance_type": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Required:      false,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"master_instance_fleet"},
 			},
 			"core_instance_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"core_instance_fleet"},
 			},
 			"core_instance_count": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"core_instance_fleet"},
 			},
+			"master_instance_fleet": resourceAwsEMRClusterInstanceFleetSchema(true),
+			"core_instance_fleet":   resourceAwsEMRClusterInstanceFleetSchema(false),
 			"cluster_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -171,9 +176,10 @@ func resourceAwsEMRCluster() *schema.Resource {
 				},
 			},
 			"instance_group": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"core_instance_fleet", "master_instance_fleet"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bid_price": {
@@ -372,6 +378,238 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateAwsEmrCustomAmiId,
 			},
+			"step_concurrency_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 256),
+			},
+			"managed_scaling_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_limits": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_capacity_units": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"maximum_core_capacity_units": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"maximum_ondemand_capacity_units": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"minimum_capacity_units": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"unit_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(emr.ComputeLimitsUnitType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"auto_termination_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idle_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(60, 604800),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsEMRClusterInstanceFleetSchema returns the schema of the master
+// or core instance fleet. The master fleet always runs a single instance, so
+// only the core fleet's target capacities can be changed in place.
+func resourceAwsEMRClusterInstanceFleetSchema(master bool) *schema.Schema {
+	conflictsWith := []string{"core_instance_count", "core_instance_type", "instance_group"}
+	if master {
+		conflictsWith = []string{"instance_group", "master_instance_type"}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      master,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_type_configs": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bid_price": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"bid_price_as_percentage_of_on_demand_price": {
+								Type:     schema.TypeFloat,
+								Optional: true,
+								ForceNew: true,
+								Default:  100,
+							},
+							"ebs_config": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"iops": {
+											Type:     schema.TypeInt,
+											Optional: true,
+											ForceNew: true,
+										},
+										"size": {
+											Type:     schema.TypeInt,
+											Required: true,
+											ForceNew: true,
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validateAwsEmrEbsVolumeType(),
+										},
+										"volumes_per_instance": {
+											Type:     schema.TypeInt,
+											Optional: true,
+											ForceNew: true,
+											Default:  1,
+										},
+									},
+								},
+							},
+							"instance_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"weighted_capacity": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								ForceNew: true,
+								Default:  1,
+							},
+						},
+					},
+				},
+				"launch_specifications": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"on_demand_specification": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"allocation_strategy": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(emr.OnDemandProvisioningAllocationStrategy_Values(), false),
+										},
+									},
+								},
+							},
+							"spot_specification": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"allocation_strategy": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(emr.SpotProvisioningAllocationStrategy_Values(), false),
+										},
+										"block_duration_minutes": {
+											Type:     schema.TypeInt,
+											Optional: true,
+											ForceNew: true,
+										},
+										"timeout_action": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(emr.SpotProvisioningTimeoutAction_Values(), false),
+										},
+										"timeout_duration_minutes": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.IntBetween(5, 1440),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+				},
+				"provisioned_on_demand_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"provisioned_spot_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"target_on_demand_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: master,
+					Default:  0,
+				},
+				"target_spot_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: master,
+					Default:  0,
+				},
+			},
 		},
 	}
 }
@@ -457,6 +695,12 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		instanceGroupConfigs := v.(*schema.Set).List()
 		instanceConfig.InstanceGroups = expandInstanceGroupConfigs(instanceGroupConfigs)
 	}
+	if v, ok := d.GetOk("master_instance_fleet"); ok {
+		instanceConfig.InstanceFleets = append(instanceConfig.InstanceFleets, expandEmrInstanceFleetConfig(v.([]interface{}), emr.InstanceFleetTypeMaster))
+	}
+	if v, ok := d.GetOk("core_instance_fleet"); ok {
+		instanceConfig.InstanceFleets = append(instanceConfig.InstanceFleets, expandEmrInstanceFleetConfig(v.([]interface{}), emr.InstanceFleetTypeCore))
+	}
 
 	emrApps := expandApplications(applications)
 
@@ -494,6 +738,18 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		params.CustomAmiId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("step_concurrency_level"); ok {
+		params.StepConcurrencyLevel = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("managed_scaling_policy"); ok {
+		params.ManagedScalingPolicy = expandEmrManagedScalingPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("auto_termination_policy"); ok {
+		params.AutoTerminationPolicy = expandEmrAutoTerminationPolicy(v.([]interface{}))
+	}
+
 	if instanceProfile != "" {
 		params.JobFlowRole = aws.String(instanceProfile)
 	}
@@ -629,6 +885,49 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("custom_ami_id", cluster.CustomAmiId)
 	}
 
+	d.Set("step_concurrency_level", cluster.StepConcurrencyLevel)
+
+	if aws.StringValue(cluster.InstanceCollectionType) == emr.InstanceCollectionTypeInstanceFleet {
+		instanceFleets, err := fetchAllEMRInstanceFleets(emrconn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error listing EMR Cluster (%s) instance fleets: %s", d.Id(), err)
+		}
+
+		if err := d.Set("master_instance_fleet", flattenEmrInstanceFleet(findEmrInstanceFleet(instanceFleets, emr.InstanceFleetTypeMaster))); err != nil {
+			return fmt.Errorf("error setting master_instance_fleet: %s", err)
+		}
+
+		if err := d.Set("core_instance_fleet", flattenEmrInstanceFleet(findEmrInstanceFleet(instanceFleets, emr.InstanceFleetTypeCore))); err != nil {
+			return fmt.Errorf("error setting core_instance_fleet: %s", err)
+		}
+	}
+
+	managedScalingPolicy, err := emrconn.GetManagedScalingPolicy(&emr.GetManagedScalingPolicyInput{
+		ClusterId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EMR Cluster (%s) managed scaling policy: %s", d.Id(), err)
+	}
+
+	if err := d.Set("managed_scaling_policy", flattenEmrManagedScalingPolicy(managedScalingPolicy.ManagedScalingPolicy)); err != nil {
+		return fmt.Errorf("error setting managed_scaling_policy: %s", err)
+	}
+
+	autoTerminationPolicy, err := emrconn.GetAutoTerminationPolicy(&emr.GetAutoTerminationPolicyInput{
+		ClusterId: aws.String(d.Id()),
+	})
+	// Release labels without auto-termination support reject the call.
+	if isAWSErr(err, emr.ErrCodeInvalidRequestException, "") {
+		log.Printf("[WARN] EMR Cluster (%s) does not support auto-termination: %s", d.Id(), err)
+		autoTerminationPolicy = &emr.GetAutoTerminationPolicyOutput{}
+	} else if err != nil {
+		return fmt.Errorf("error reading EMR Cluster (%s) auto-termination policy: %s", d.Id(), err)
+	}
+
+	if err := d.Set("auto_termination_policy", flattenEmrAutoTerminationPolicy(autoTerminationPolicy.AutoTerminationPolicy)); err != nil {
+		return fmt.Errorf("error setting auto_termination_policy: %s", err)
+	}
+
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
 		log.Printf("[ERR] Error setting EMR Applications for cluster (%s): %s", d.Id(), err)
 	}
@@ -738,6 +1037,85 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("core_instance_fleet.0.target_on_demand_capacity") || d.HasChange("core_instance_fleet.0.target_spot_capacity") {
+		d.SetPartial("core_instance_fleet")
+		fleetID := d.Get("core_instance_fleet.0.id").(string)
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &emr.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(fleetID),
+				TargetOnDemandCapacity: aws.Int64(int64(d.Get("core_instance_fleet.0.target_on_demand_capacity").(int))),
+				TargetSpotCapacity:     aws.Int64(int64(d.Get("core_instance_fleet.0.target_spot_capacity").(int))),
+			},
+		}
+
+		log.Printf("[DEBUG] Modifying EMR Cluster (%s) core instance fleet: %s", d.Id(), input)
+		if _, err := conn.ModifyInstanceFleet(input); err != nil {
+			return fmt.Errorf("error modifying EMR Cluster (%s) core instance fleet: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{emr.InstanceFleetStateResizing},
+			Target:     []string{emr.InstanceFleetStateRunning},
+			Refresh:    resourceAwsEMRInstanceFleetStateRefreshFunc(conn, d.Id(), fleetID),
+			Timeout:    75 * time.Minute,
+			MinTimeout: 10 * time.Second,
+			Delay:      5 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for EMR Cluster (%s) core instance fleet to resize: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("step_concurrency_level") {
+		d.SetPartial("step_concurrency_level")
+		_, err := conn.ModifyCluster(&emr.ModifyClusterInput{
+			ClusterId:            aws.String(d.Id()),
+			StepConcurrencyLevel: aws.Int64(int64(d.Get("step_concurrency_level").(int))),
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying EMR Cluster (%s) step concurrency level: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("managed_scaling_policy") {
+		d.SetPartial("managed_scaling_policy")
+		var err error
+		if v, ok := d.GetOk("managed_scaling_policy"); ok {
+			_, err = conn.PutManagedScalingPolicy(&emr.PutManagedScalingPolicyInput{
+				ClusterId:            aws.String(d.Id()),
+				ManagedScalingPolicy: expandEmrManagedScalingPolicy(v.([]interface{})),
+			})
+		} else {
+			_, err = conn.RemoveManagedScalingPolicy(&emr.RemoveManagedScalingPolicyInput{
+				ClusterId: aws.String(d.Id()),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("error updating EMR Cluster (%s) managed scaling policy: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("auto_termination_policy") {
+		d.SetPartial("auto_termination_policy")
+		var err error
+		if v, ok := d.GetOk("auto_termination_policy"); ok {
+			_, err = conn.PutAutoTerminationPolicy(&emr.PutAutoTerminationPolicyInput{
+				AutoTerminationPolicy: expandEmrAutoTerminationPolicy(v.([]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.RemoveAutoTerminationPolicy(&emr.RemoveAutoTerminationPolicyInput{
+				ClusterId: aws.String(d.Id()),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("error updating EMR Cluster (%s) auto-termination policy: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("visible_to_all_users") {
 		d.SetPartial("visible_to_all_users")
 		_, errModify := conn.SetVisibleToAllUsers(&emr.SetVisibleToAllUsersInput{
@@ -1353,6 +1731,335 @@ func readBodyJson(body string, target interface{}) error {
 	return nil
 }
 
+func expandEmrInstanceFleetConfig(l []interface{}, fleetType string) *emr.InstanceFleetConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &emr.InstanceFleetConfig{
+		InstanceFleetType:      aws.String(fleetType),
+		TargetOnDemandCapacity: aws.Int64(int64(m["target_on_demand_capacity"].(int))),
+		TargetSpotCapacity:     aws.Int64(int64(m["target_spot_capacity"].(int))),
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		config.Name = aws.String(v)
+	}
+
+	if v, ok := m["instance_type_configs"].(*schema.Set); ok && v.Len() > 0 {
+		config.InstanceTypeConfigs = expandEmrInstanceTypeConfigs(v.List())
+	}
+
+	if v, ok := m["launch_specifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.LaunchSpecifications = expandEmrLaunchSpecifications(v[0].(map[string]interface{}))
+	}
+
+	return config
+}
+
+func expandEmrInstanceTypeConfigs(l []interface{}) []*emr.InstanceTypeConfig {
+	configs := make([]*emr.InstanceTypeConfig, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		config := &emr.InstanceTypeConfig{
+			InstanceType:     aws.String(m["instance_type"].(string)),
+			WeightedCapacity: aws.Int64(int64(m["weighted_capacity"].(int))),
+		}
+
+		// The API accepts either an absolute bid price or a percentage of
+		// the On-Demand price, but not both.
+		if v, ok := m["bid_price"].(string); ok && v != "" {
+			config.BidPrice = aws.String(v)
+		} else if v, ok := m["bid_price_as_percentage_of_on_demand_price"].(float64); ok && v != 0 {
+			config.BidPriceAsPercentageOfOnDemandPrice = aws.Float64(v)
+		}
+
+		if v, ok := m["ebs_config"].(*schema.Set); ok && v.Len() > 0 {
+			config.EbsConfiguration = expandEmrEbsConfiguration(v.List())
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func expandEmrEbsConfiguration(l []interface{}) *emr.EbsConfiguration {
+	ebsBlockDeviceConfigs := make([]*emr.EbsBlockDeviceConfig, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		ebsBlockDeviceConfig := &emr.EbsBlockDeviceConfig{
+			VolumesPerInstance: aws.Int64(int64(m["volumes_per_instance"].(int))),
+			VolumeSpecification: &emr.VolumeSpecification{
+				SizeInGB:   aws.Int64(int64(m["size"].(int))),
+				VolumeType: aws.String(m["type"].(string)),
+			},
+		}
+
+		if v, ok := m["iops"].(int); ok && v != 0 {
+			ebsBlockDeviceConfig.VolumeSpecification.Iops = aws.Int64(int64(v))
+		}
+
+		ebsBlockDeviceConfigs = append(ebsBlockDeviceConfigs, ebsBlockDeviceConfig)
+	}
+
+	return &emr.EbsConfiguration{
+		EbsBlockDeviceConfigs: ebsBlockDeviceConfigs,
+	}
+}
+
+func expandEmrLaunchSpecifications(m map[string]interface{}) *emr.InstanceFleetProvisioningSpecifications {
+	specifications := &emr.InstanceFleetProvisioningSpecifications{}
+
+	if v, ok := m["on_demand_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		om := v[0].(map[string]interface{})
+		specifications.OnDemandSpecification = &emr.OnDemandProvisioningSpecification{
+			AllocationStrategy: aws.String(om["allocation_strategy"].(string)),
+		}
+	}
+
+	if v, ok := m["spot_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sm := v[0].(map[string]interface{})
+		spotSpecification := &emr.SpotProvisioningSpecification{
+			TimeoutAction:          aws.String(sm["timeout_action"].(string)),
+			TimeoutDurationMinutes: aws.Int64(int64(sm["timeout_duration_minutes"].(int))),
+		}
+		if v, ok := sm["allocation_strategy"].(string); ok && v != "" {
+			spotSpecification.AllocationStrategy = aws.String(v)
+		}
+		if v, ok := sm["block_duration_minutes"].(int); ok && v != 0 {
+			spotSpecification.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		specifications.SpotSpecification = spotSpecification
+	}
+
+	return specifications
+}
+
+func flattenEmrInstanceFleet(fleet *emr.InstanceFleet) []interface{} {
+	if fleet == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"id":                             aws.StringValue(fleet.Id),
+		"instance_type_configs":          flattenEmrInstanceTypeSpecifications(fleet.InstanceTypeSpecifications),
+		"launch_specifications":          flattenEmrLaunchSpecifications(fleet.LaunchSpecifications),
+		"name":                           aws.StringValue(fleet.Name),
+		"provisioned_on_demand_capacity": int(aws.Int64Value(fleet.ProvisionedOnDemandCapacity)),
+		"provisioned_spot_capacity":      int(aws.Int64Value(fleet.ProvisionedSpotCapacity)),
+		"target_on_demand_capacity":      int(aws.Int64Value(fleet.TargetOnDemandCapacity)),
+		"target_spot_capacity":           int(aws.Int64Value(fleet.TargetSpotCapacity)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEmrInstanceTypeSpecifications(specifications []*emr.InstanceTypeSpecification) []interface{} {
+	result := make([]interface{}, 0, len(specifications))
+
+	for _, specification := range specifications {
+		result = append(result, map[string]interface{}{
+			"bid_price": aws.StringValue(specification.BidPrice),
+			"bid_price_as_percentage_of_on_demand_price": aws.Float64Value(specification.BidPriceAsPercentageOfOnDemandPrice),
+			"ebs_config":        flattenEmrEbsBlockDevices(specification.EbsBlockDevices),
+			"instance_type":     aws.StringValue(specification.InstanceType),
+			"weighted_capacity": int(aws.Int64Value(specification.WeightedCapacity)),
+		})
+	}
+
+	return result
+}
+
+// flattenEmrEbsBlockDevices folds the per-volume devices that EMR returns
+// back into volume specifications with a volumes_per_instance count.
+func flattenEmrEbsBlockDevices(devices []*emr.EbsBlockDevice) []interface{} {
+	result := make([]interface{}, 0, len(devices))
+	counts := make(map[string]int)
+	var order []string
+	specs := make(map[string]*emr.VolumeSpecification)
+
+	for _, device := range devices {
+		spec := device.VolumeSpecification
+		if spec == nil {
+			continue
+		}
+		key := spec.String()
+		if _, ok := counts[key]; !ok {
+			order = append(order, key)
+			specs[key] = spec
+		}
+		counts[key]++
+	}
+
+	for _, key := range order {
+		spec := specs[key]
+		result = append(result, map[string]interface{}{
+			"iops":                 int(aws.Int64Value(spec.Iops)),
+			"size":                 int(aws.Int64Value(spec.SizeInGB)),
+			"type":                 aws.StringValue(spec.VolumeType),
+			"volumes_per_instance": counts[key],
+		})
+	}
+
+	return result
+}
+
+func flattenEmrLaunchSpecifications(specifications *emr.InstanceFleetProvisioningSpecifications) []interface{} {
+	if specifications == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"on_demand_specification": []interface{}{},
+		"spot_specification":      []interface{}{},
+	}
+
+	if v := specifications.OnDemandSpecification; v != nil {
+		m["on_demand_specification"] = []interface{}{map[string]interface{}{
+			"allocation_strategy": aws.StringValue(v.AllocationStrategy),
+		}}
+	}
+
+	if v := specifications.SpotSpecification; v != nil {
+		m["spot_specification"] = []interface{}{map[string]interface{}{
+			"allocation_strategy":      aws.StringValue(v.AllocationStrategy),
+			"block_duration_minutes":   int(aws.Int64Value(v.BlockDurationMinutes)),
+			"timeout_action":           aws.StringValue(v.TimeoutAction),
+			"timeout_duration_minutes": int(aws.Int64Value(v.TimeoutDurationMinutes)),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func fetchAllEMRInstanceFleets(conn *emr.EMR, clusterID string) ([]*emr.InstanceFleet, error) {
+	var fleets []*emr.InstanceFleet
+
+	err := conn.ListInstanceFleetsPages(&emr.ListInstanceFleetsInput{
+		ClusterId: aws.String(clusterID),
+	}, func(page *emr.ListInstanceFleetsOutput, lastPage bool) bool {
+		fleets = append(fleets, page.InstanceFleets...)
+		return !lastPage
+	})
+
+	return fleets, err
+}
+
+func findEmrInstanceFleet(fleets []*emr.InstanceFleet, fleetType string) *emr.InstanceFleet {
+	for _, fleet := range fleets {
+		if aws.StringValue(fleet.InstanceFleetType) == fleetType {
+			return fleet
+		}
+	}
+	return nil
+}
+
+func resourceAwsEMRInstanceFleetStateRefreshFunc(conn *emr.EMR, clusterID, fleetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleets, err := fetchAllEMRInstanceFleets(conn, clusterID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, fleet := range fleets {
+			if aws.StringValue(fleet.Id) != fleetID {
+				continue
+			}
+			if fleet.Status == nil {
+				return fleet, "", nil
+			}
+			return fleet, aws.StringValue(fleet.Status.State), nil
+		}
+
+		return nil, "", fmt.Errorf("instance fleet (%s) not found", fleetID)
+	}
+}
+
+func expandEmrManagedScalingPolicy(l []interface{}) *emr.ManagedScalingPolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	computeLimits := m["compute_limits"].([]interface{})
+	if len(computeLimits) == 0 || computeLimits[0] == nil {
+		return &emr.ManagedScalingPolicy{}
+	}
+
+	cm := computeLimits[0].(map[string]interface{})
+	limits := &emr.ComputeLimits{
+		MaximumCapacityUnits: aws.Int64(int64(cm["maximum_capacity_units"].(int))),
+		MinimumCapacityUnits: aws.Int64(int64(cm["minimum_capacity_units"].(int))),
+		UnitType:             aws.String(cm["unit_type"].(string)),
+	}
+
+	if v, ok := cm["maximum_core_capacity_units"].(int); ok && v > 0 {
+		limits.MaximumCoreCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := cm["maximum_ondemand_capacity_units"].(int); ok && v > 0 {
+		limits.MaximumOnDemandCapacityUnits = aws.Int64(int64(v))
+	}
+
+	return &emr.ManagedScalingPolicy{
+		ComputeLimits: limits,
+	}
+}
+
+func flattenEmrManagedScalingPolicy(policy *emr.ManagedScalingPolicy) []interface{} {
+	if policy == nil || policy.ComputeLimits == nil {
+		return []interface{}{}
+	}
+
+	limits := policy.ComputeLimits
+	m := map[string]interface{}{
+		"compute_limits": []interface{}{map[string]interface{}{
+			"maximum_capacity_units":          int(aws.Int64Value(limits.MaximumCapacityUnits)),
+			"maximum_core_capacity_units":     int(aws.Int64Value(limits.MaximumCoreCapacityUnits)),
+			"maximum_ondemand_capacity_units": int(aws.Int64Value(limits.MaximumOnDemandCapacityUnits)),
+			"minimum_capacity_units":          int(aws.Int64Value(limits.MinimumCapacityUnits)),
+			"unit_type":                       aws.StringValue(limits.UnitType),
+		}},
+	}
+
+	return []interface{}{m}
+}
+
+func expandEmrAutoTerminationPolicy(l []interface{}) *emr.AutoTerminationPolicy {
+	policy := &emr.AutoTerminationPolicy{}
+
+	if len(l) == 0 || l[0] == nil {
+		return policy
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["idle_timeout"].(int); ok && v > 0 {
+		policy.IdleTimeout = aws.Int64(int64(v))
+	}
+
+	return policy
+}
+
+func flattenEmrAutoTerminationPolicy(policy *emr.AutoTerminationPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"idle_timeout": int(aws.Int64Value(policy.IdleTimeout)),
+	}
+
+	return []interface{}{m}
+}
+
 func resourceAwsEMRClusterStateRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		conn := meta.(*AWSClient).emrconn
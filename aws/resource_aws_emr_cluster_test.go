@@ -358,6 +358,147 @@ func TestAccAWSEMRCluster_custom_ami_id(t *testing.T) {
 	})
 }
 
+func TestAccAWSEMRCluster_stepConcurrencyLevel(t *testing.T) {
+	var cluster emr.Cluster
+	resourceName := "aws_emr_cluster.tf-test-cluster"
+	r := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrClusterConfigStepConcurrencyLevel(r, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "step_concurrency_level", "2"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigStepConcurrencyLevel(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "step_concurrency_level", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRCluster_managedScalingPolicy(t *testing.T) {
+	var cluster emr.Cluster
+	resourceName := "aws_emr_cluster.tf-test-cluster"
+	r := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrClusterConfigManagedScalingPolicy(r, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.0.compute_limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.0.compute_limits.0.unit_type", "Instances"),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.0.compute_limits.0.minimum_capacity_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.0.compute_limits.0.maximum_capacity_units", "2"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigManagedScalingPolicy(r, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.0.compute_limits.0.maximum_capacity_units", "3"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigStepConcurrencyLevel(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "managed_scaling_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRCluster_autoTerminationPolicy(t *testing.T) {
+	var cluster emr.Cluster
+	resourceName := "aws_emr_cluster.tf-test-cluster"
+	r := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrClusterConfigAutoTerminationPolicy(r, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "auto_termination_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_termination_policy.0.idle_timeout", "3600"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigAutoTerminationPolicy(r, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "auto_termination_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_termination_policy.0.idle_timeout", "7200"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigStepConcurrencyLevel(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "auto_termination_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRCluster_instanceFleets(t *testing.T) {
+	var cluster emr.Cluster
+	resourceName := "aws_emr_cluster.tf-test-cluster"
+	r := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrClusterConfigInstanceFleets(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_instance_fleet.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.instance_type_configs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "core_instance_fleet.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.name", "core fleet"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.instance_type_configs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.spot_specification.0.timeout_action", "SWITCH_TO_ON_DEMAND"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.spot_specification.0.timeout_duration_minutes", "10"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfigInstanceFleets(r, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -3678,3 +3819,199 @@ data "aws_ami" "emr-custom-ami" {
 }
 `, r, r, r, r, r, r, r, r)
 }
+
+func testAccAWSEmrClusterConfigBaseVpc(rInt int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_security_group" "allow_all" {
+  name        = "allow_all_%[1]d"
+  description = "Allow all inbound traffic"
+  vpc_id      = "${aws_vpc.main.id}"
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  depends_on = ["aws_subnet.main"]
+
+  lifecycle {
+    ignore_changes = ["ingress", "egress"]
+  }
+
+  tags {
+    Name = "emr_test"
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-emr-cluster"
+  }
+}
+
+resource "aws_subnet" "main" {
+  availability_zone = "${element(data.aws_availability_zones.available.names, count.index)}"
+  count             = 2
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.main.id}"
+
+  tags {
+    Name = "terraform-testacc-emr-cluster"
+  }
+}
+
+resource "aws_internet_gateway" "gw" {
+  vpc_id = "${aws_vpc.main.id}"
+
+  tags {
+    Name = "terraform-testacc-emr-cluster"
+  }
+}
+
+resource "aws_route_table" "r" {
+  vpc_id = "${aws_vpc.main.id}"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "${aws_internet_gateway.gw.id}"
+  }
+}
+
+resource "aws_main_route_table_association" "a" {
+  route_table_id = "${aws_route_table.r.id}"
+  vpc_id         = "${aws_vpc.main.id}"
+}
+`, rInt)
+}
+
+func testAccAWSEmrClusterConfigWithInstanceTypes(rInt int, extraConfig string) string {
+	return testAccAWSEmrClusterConfigBaseVpc(rInt) + fmt.Sprintf(`
+resource "aws_emr_cluster" "tf-test-cluster" {
+  applications                      = ["Spark"]
+  core_instance_count               = 1
+  core_instance_type                = "m5.xlarge"
+  keep_job_flow_alive_when_no_steps = true
+  master_instance_type              = "m5.xlarge"
+  name                              = "emr-test-%[1]d"
+  release_label                     = "emr-5.34.0"
+  service_role                      = "EMR_DefaultRole"
+  termination_protection            = false
+
+  ec2_attributes {
+    emr_managed_master_security_group = "${aws_security_group.allow_all.id}"
+    emr_managed_slave_security_group  = "${aws_security_group.allow_all.id}"
+    instance_profile                  = "EMR_EC2_DefaultRole"
+    subnet_id                         = "${aws_subnet.main.0.id}"
+  }
+
+%[2]s
+
+  depends_on = ["aws_main_route_table_association.a"]
+}
+`, rInt, extraConfig)
+}
+
+func testAccAWSEmrClusterConfigStepConcurrencyLevel(rInt, stepConcurrencyLevel int) string {
+	return testAccAWSEmrClusterConfigWithInstanceTypes(rInt, fmt.Sprintf(`
+  step_concurrency_level = %[1]d
+`, stepConcurrencyLevel))
+}
+
+func testAccAWSEmrClusterConfigManagedScalingPolicy(rInt, minimumCapacityUnits, maximumCapacityUnits int) string {
+	return testAccAWSEmrClusterConfigWithInstanceTypes(rInt, fmt.Sprintf(`
+  managed_scaling_policy {
+    compute_limits {
+      unit_type              = "Instances"
+      minimum_capacity_units = %[1]d
+      maximum_capacity_units = %[2]d
+    }
+  }
+`, minimumCapacityUnits, maximumCapacityUnits))
+}
+
+func testAccAWSEmrClusterConfigAutoTerminationPolicy(rInt, idleTimeout int) string {
+	return testAccAWSEmrClusterConfigWithInstanceTypes(rInt, fmt.Sprintf(`
+  auto_termination_policy {
+    idle_timeout = %[1]d
+  }
+`, idleTimeout))
+}
+
+func testAccAWSEmrClusterConfigInstanceFleets(rInt, coreTargetSpotCapacity int) string {
+	return testAccAWSEmrClusterConfigBaseVpc(rInt) + fmt.Sprintf(`
+resource "aws_emr_cluster" "tf-test-cluster" {
+  applications                      = ["Spark"]
+  keep_job_flow_alive_when_no_steps = true
+  name                              = "emr-test-%[1]d"
+  release_label                     = "emr-5.34.0"
+  service_role                      = "EMR_DefaultRole"
+  termination_protection            = false
+
+  ec2_attributes {
+    emr_managed_master_security_group = "${aws_security_group.allow_all.id}"
+    emr_managed_slave_security_group  = "${aws_security_group.allow_all.id}"
+    instance_profile                  = "EMR_EC2_DefaultRole"
+    subnet_id                         = "${aws_subnet.main.0.id}"
+  }
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m5.xlarge"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    name                 = "core fleet"
+    target_spot_capacity = %[2]d
+
+    instance_type_configs {
+      bid_price_as_percentage_of_on_demand_price = 80
+      instance_type                              = "m5.xlarge"
+      weighted_capacity                          = 1
+
+      ebs_config {
+        size                 = 100
+        type                 = "gp2"
+        volumes_per_instance = 1
+      }
+    }
+
+    instance_type_configs {
+      bid_price_as_percentage_of_on_demand_price = 100
+      instance_type                              = "m4.xlarge"
+      weighted_capacity                          = 1
+    }
+
+    launch_specifications {
+      spot_specification {
+        allocation_strategy      = "capacity-optimized"
+        timeout_action           = "SWITCH_TO_ON_DEMAND"
+        timeout_duration_minutes = 10
+      }
+    }
+  }
+
+  depends_on = ["aws_main_route_table_association.a"]
+}
+`, rInt, coreTargetSpotCapacity)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsFlowLog() *schema.Resource {
@@ -20,43 +21,125 @@ func resourceAwsFlowLog() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"destination_options": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_format": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.DestinationFileFormatPlainText,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.DestinationFileFormatPlainText,
+								ec2.DestinationFileFormatParquet,
+							}, false),
+						},
+						"hive_compatible_partitions": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"per_hour_partition": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"iam_role_arn": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
-			"log_group_name": &schema.Schema{
+			"log_destination": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"log_group_name"},
+			},
+
+			"log_destination_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
+				Default:  ec2.LogDestinationTypeCloudWatchLogs,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.LogDestinationTypeCloudWatchLogs,
+					ec2.LogDestinationTypeS3,
+				}, false),
+			},
+
+			"log_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"log_group_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"log_destination"},
 			},
 
 			"vpc_id": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"subnet_id", "eni_id"},
+				ConflictsWith: []string{"subnet_id", "eni_id", "transit_gateway_id", "transit_gateway_attachment_id"},
 			},
 
 			"subnet_id": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"eni_id", "vpc_id"},
+				ConflictsWith: []string{"eni_id", "vpc_id", "transit_gateway_id", "transit_gateway_attachment_id"},
 			},
 
 			"eni_id": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"subnet_id", "vpc_id"},
+				ConflictsWith: []string{"subnet_id", "vpc_id", "transit_gateway_id", "transit_gateway_attachment_id"},
+			},
+
+			"transit_gateway_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"eni_id", "subnet_id", "vpc_id", "transit_gateway_attachment_id"},
+			},
+
+			"transit_gateway_attachment_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"eni_id", "subnet_id", "vpc_id", "transit_gateway_id"},
 			},
 
 			"traffic_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TrafficTypeAccept,
+					ec2.TrafficTypeReject,
+					ec2.TrafficTypeAll,
+				}, false),
 			},
 		},
 	}
@@ -69,9 +152,11 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		ID   string
 		Type string
 	}{
-		{ID: d.Get("vpc_id").(string), Type: "VPC"},
-		{ID: d.Get("subnet_id").(string), Type: "Subnet"},
-		{ID: d.Get("eni_id").(string), Type: "NetworkInterface"},
+		{ID: d.Get("vpc_id").(string), Type: ec2.FlowLogsResourceTypeVpc},
+		{ID: d.Get("subnet_id").(string), Type: ec2.FlowLogsResourceTypeSubnet},
+		{ID: d.Get("eni_id").(string), Type: ec2.FlowLogsResourceTypeNetworkInterface},
+		{ID: d.Get("transit_gateway_id").(string), Type: ec2.FlowLogsResourceTypeTransitGateway},
+		{ID: d.Get("transit_gateway_attachment_id").(string), Type: ec2.FlowLogsResourceTypeTransitGatewayAttachment},
 	}
 
 	var resourceId string
@@ -85,15 +170,48 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if resourceId == "" || resourceType == "" {
-		return fmt.Errorf("Error: Flow Logs require either a VPC, Subnet, or ENI ID")
+		return fmt.Errorf("Error: Flow Logs require either a VPC, Subnet, ENI, Transit Gateway or Transit Gateway Attachment ID")
 	}
 
 	opts := &ec2.CreateFlowLogsInput{
-		DeliverLogsPermissionArn: aws.String(d.Get("iam_role_arn").(string)),
-		LogGroupName:             aws.String(d.Get("log_group_name").(string)),
-		ResourceIds:              []*string{aws.String(resourceId)},
-		ResourceType:             aws.String(resourceType),
-		TrafficType:              aws.String(d.Get("traffic_type").(string)),
+		LogDestinationType: aws.String(d.Get("log_destination_type").(string)),
+		ResourceIds:        []*string{aws.String(resourceId)},
+		ResourceType:       aws.String(resourceType),
+	}
+
+	// Transit gateway flow logs always capture all traffic and reject an
+	// explicit traffic type.
+	switch resourceType {
+	case ec2.FlowLogsResourceTypeTransitGateway, ec2.FlowLogsResourceTypeTransitGatewayAttachment:
+		if _, ok := d.GetOk("traffic_type"); ok {
+			return fmt.Errorf("Error: traffic_type cannot be set for Transit Gateway Flow Logs")
+		}
+	default:
+		v, ok := d.GetOk("traffic_type")
+		if !ok {
+			return fmt.Errorf("Error: traffic_type is required for VPC, Subnet and ENI Flow Logs")
+		}
+		opts.TrafficType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_options"); ok {
+		opts.DestinationOptions = expandEc2FlowLogDestinationOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		opts.DeliverLogsPermissionArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_destination"); ok {
+		opts.LogDestination = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_format"); ok {
+		opts.LogFormat = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_group_name"); ok {
+		opts.LogGroupName = aws.String(v.(string))
 	}
 
 	log.Printf(
@@ -103,6 +221,10 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating Flow Log for (%s), error: %s", resourceId, err)
 	}
 
+	if len(resp.Unsuccessful) > 0 && resp.Unsuccessful[0].Error != nil {
+		return fmt.Errorf("Error creating Flow Log for (%s), error: %s", resourceId, aws.StringValue(resp.Unsuccessful[0].Error.Message))
+	}
+
 	if len(resp.FlowLogIds) > 1 {
 		return fmt.Errorf("Error: multiple Flow Logs created for (%s)", resourceId)
 	}
@@ -134,9 +256,16 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 
 	fl := resp.FlowLogs[0]
 	d.Set("traffic_type", fl.TrafficType)
+	d.Set("log_destination", fl.LogDestination)
+	d.Set("log_destination_type", fl.LogDestinationType)
+	d.Set("log_format", fl.LogFormat)
 	d.Set("log_group_name", fl.LogGroupName)
 	d.Set("iam_role_arn", fl.DeliverLogsPermissionArn)
 
+	if err := d.Set("destination_options", flattenEc2FlowLogDestinationOptions(fl.DestinationOptions)); err != nil {
+		return fmt.Errorf("error setting destination_options: %s", err)
+	}
+
 	var resourceKey string
 	if strings.HasPrefix(*fl.ResourceId, "vpc-") {
 		resourceKey = "vpc_id"
@@ -144,6 +273,10 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 		resourceKey = "subnet_id"
 	} else if strings.HasPrefix(*fl.ResourceId, "eni-") {
 		resourceKey = "eni_id"
+	} else if strings.HasPrefix(*fl.ResourceId, "tgw-attach-") {
+		resourceKey = "transit_gateway_attachment_id"
+	} else if strings.HasPrefix(*fl.ResourceId, "tgw-") {
+		resourceKey = "transit_gateway_id"
 	}
 	if resourceKey != "" {
 		d.Set(resourceKey, fl.ResourceId)
@@ -167,3 +300,31 @@ func resourceAwsLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandEc2FlowLogDestinationOptions(l []interface{}) *ec2.DestinationOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.DestinationOptionsRequest{
+		FileFormat:               aws.String(m["file_format"].(string)),
+		HiveCompatiblePartitions: aws.Bool(m["hive_compatible_partitions"].(bool)),
+		PerHourPartition:         aws.Bool(m["per_hour_partition"].(bool)),
+	}
+}
+
+func flattenEc2FlowLogDestinationOptions(options *ec2.DestinationOptionsResponse) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"file_format":                aws.StringValue(options.FileFormat),
+			"hive_compatible_partitions": aws.BoolValue(options.HiveCompatiblePartitions),
+			"per_hour_partition":         aws.BoolValue(options.PerHourPartition),
+		},
+	}
+}
@@ -0,0 +1,441 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// fmsResourceTypeList is the resource type Firewall Manager expects when a
+// policy applies to the resource types given in ResourceTypeList.
+const fmsResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"delete_unused_fm_managed_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"exclude_map": fmsPolicyScopeSchema(),
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": fmsPolicyScopeSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"resource_type_list"},
+			},
+			"resource_type_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"resource_type"},
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"policy_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_firewall_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"firewall_deployment_model": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(fms.FirewallDeploymentModel_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// fmsPolicyScopeSchema returns the schema of the include_map and
+// exclude_map blocks, which list AWS accounts and Organizations OUs.
+func fmsPolicyScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateAwsAccountId,
+					},
+				},
+				"orgunit": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.TagList = tagsFromMapFms(v)
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	policy := output.Policy
+	arn := aws.StringValue(output.PolicyArn)
+
+	d.Set("arn", arn)
+	d.Set("delete_unused_fm_managed_resources", policy.DeleteUnusedFMManagedResources)
+	d.Set("description", policy.PolicyDescription)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_type", policy.ResourceType)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %s", err)
+	}
+
+	if err := d.Set("include_map", flattenFmsPolicyMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %s", err)
+	}
+
+	if err := d.Set("resource_tags", flattenFmsResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	if err := d.Set("resource_type_list", schema.NewSet(schema.HashString, flattenStringList(policy.ResourceTypeList))); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %s", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	tags, err := getTagsFms(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for FMS Policy (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	if d.HasChange("delete_unused_fm_managed_resources") || d.HasChange("description") ||
+		d.HasChange("exclude_map") || d.HasChange("exclude_resource_tags") ||
+		d.HasChange("include_map") || d.HasChange("name") ||
+		d.HasChange("remediation_enabled") || d.HasChange("resource_tags") ||
+		d.HasChange("resource_type") || d.HasChange("resource_type_list") ||
+		d.HasChange("security_service_policy_data") {
+		policy := expandFmsPolicy(d)
+		policy.PolicyId = aws.String(d.Id())
+		policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+		input := &fms.PutPolicyInput{
+			Policy: policy,
+		}
+
+		log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+		_, err := conn.PutPolicy(input)
+		if err != nil {
+			return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsFms(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.DeletePolicyInput{
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+		PolicyId:                 aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", input)
+	_, err := conn.DeletePolicy(input)
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		DeleteUnusedFMManagedResources: aws.Bool(d.Get("delete_unused_fm_managed_resources").(bool)),
+		ExcludeMap:                     expandFmsPolicyMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags:            aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:                     expandFmsPolicyMap(d.Get("include_map").([]interface{})),
+		PolicyName:                     aws.String(d.Get("name").(string)),
+		RemediationEnabled:             aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:                   expandFmsResourceTags(d.Get("resource_tags").(map[string]interface{})),
+		SecurityServicePolicyData:      expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		policy.PolicyDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceType = aws.String(fmsResourceTypeList)
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	} else {
+		policy.ResourceType = aws.String(d.Get("resource_type").(string))
+	}
+
+	return policy
+}
+
+func expandFmsPolicyMap(l []interface{}) map[string][]*string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	result := make(map[string][]*string)
+
+	if v, ok := m["account"].(*schema.Set); ok && v.Len() > 0 {
+		result[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	if v, ok := m["orgunit"].(*schema.Set); ok && v.Len() > 0 {
+		result[fms.CustomerPolicyScopeIdTypeOrgUnit] = expandStringSet(v)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func flattenFmsPolicyMap(policyMap map[string][]*string) []interface{} {
+	if len(policyMap) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v, ok := policyMap[fms.CustomerPolicyScopeIdTypeAccount]; ok {
+		m["account"] = schema.NewSet(schema.HashString, flattenStringList(v))
+	}
+
+	if v, ok := policyMap[fms.CustomerPolicyScopeIdTypeOrgUnit]; ok {
+		m["orgunit"] = schema.NewSet(schema.HashString, flattenStringList(v))
+	}
+
+	return []interface{}{m}
+}
+
+func expandFmsResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	tags := make([]*fms.ResourceTag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenFmsResourceTags(tags []*fms.ResourceTag) map[string]string {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func expandFmsSecurityServicePolicyData(l []interface{}) *fms.SecurityServicePolicyData {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	data := &fms.SecurityServicePolicyData{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["managed_service_data"].(string); ok && v != "" {
+		data.ManagedServiceData = aws.String(v)
+	}
+
+	if v, ok := m["policy_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		option := &fms.PolicyOption{}
+		om := v[0].(map[string]interface{})
+
+		if v, ok := om["network_firewall_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			nfm := v[0].(map[string]interface{})
+			option.NetworkFirewallPolicy = &fms.NetworkFirewallPolicy{}
+
+			if v, ok := nfm["firewall_deployment_model"].(string); ok && v != "" {
+				option.NetworkFirewallPolicy.FirewallDeploymentModel = aws.String(v)
+			}
+		}
+
+		data.PolicyOption = option
+	}
+
+	return data
+}
+
+func flattenFmsSecurityServicePolicyData(data *fms.SecurityServicePolicyData) []interface{} {
+	if data == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": aws.StringValue(data.ManagedServiceData),
+		"type":                 aws.StringValue(data.Type),
+	}
+
+	if data.PolicyOption != nil && data.PolicyOption.NetworkFirewallPolicy != nil {
+		m["policy_option"] = []interface{}{
+			map[string]interface{}{
+				"network_firewall_policy": []interface{}{
+					map[string]interface{}{
+						"firewall_deployment_model": aws.StringValue(data.PolicyOption.NetworkFirewallPolicy.FirewallDeploymentModel),
+					},
+				},
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,396 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsFsxLustreFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxLustreFileSystemCreate,
+		Read:   resourceAwsFsxLustreFileSystemRead,
+		Update: resourceAwsFsxLustreFileSystemUpdate,
+		Delete: resourceAwsFsxLustreFileSystemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_import_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(fsx.AutoImportPolicyType_Values(), false),
+			},
+			"automatic_backup_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 90),
+			},
+			"copy_tags_to_backups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"daily_automatic_backup_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in the format HH:MM"),
+			},
+			"data_compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      fsx.DataCompressionTypeNone,
+				ValidateFunc: validation.StringInSlice(fsx.DataCompressionType_Values(), false),
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      fsx.LustreDeploymentTypeScratch1,
+				ValidateFunc: validation.StringInSlice(fsx.LustreDeploymentType_Values(), false),
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drive_cache_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(fsx.DriveCacheType_Values(), false),
+			},
+			"export_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+			},
+			"import_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+			},
+			"imported_file_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 512000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"mount_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"per_unit_storage_throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(12, 1000),
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1200),
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      fsx.StorageTypeSsd,
+				ValidateFunc: validation.StringInSlice(fsx.StorageType_Values(), false),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-7]:([01]\d|2[0-3]):[0-5]\d$`), "must be in the format d:HH:MM"),
+			},
+		},
+	}
+}
+
+func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.CreateFileSystemInput{
+		FileSystemType:  aws.String(fsx.FileSystemTypeLustre),
+		StorageCapacity: aws.Int64(int64(d.Get("storage_capacity").(int))),
+		StorageType:     aws.String(d.Get("storage_type").(string)),
+		SubnetIds:       expandStringList(d.Get("subnet_ids").([]interface{})),
+		LustreConfiguration: &fsx.CreateFileSystemLustreConfiguration{
+			DataCompressionType: aws.String(d.Get("data_compression_type").(string)),
+			DeploymentType:      aws.String(d.Get("deployment_type").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("auto_import_policy"); ok {
+		input.LustreConfiguration.AutoImportPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("automatic_backup_retention_days"); ok {
+		input.LustreConfiguration.AutomaticBackupRetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("copy_tags_to_backups"); ok {
+		input.LustreConfiguration.CopyTagsToBackups = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("daily_automatic_backup_start_time"); ok {
+		input.LustreConfiguration.DailyAutomaticBackupStartTime = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("drive_cache_type"); ok {
+		input.LustreConfiguration.DriveCacheType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("export_path"); ok {
+		input.LustreConfiguration.ExportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("import_path"); ok {
+		input.LustreConfiguration.ImportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("imported_file_chunk_size"); ok {
+		input.LustreConfiguration.ImportedFileChunkSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("per_unit_storage_throughput"); ok {
+		input.LustreConfiguration.PerUnitStorageThroughput = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapFSX(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
+		input.LustreConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating FSx Lustre file system: %s", input)
+	output, err := conn.CreateFileSystem(input)
+	if err != nil {
+		return fmt.Errorf("error creating FSx Lustre file system: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FileSystem.FileSystemId))
+
+	if err := waitForFsxFileSystemCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FSx Lustre file system (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	if d.HasChange("tags") {
+		if err := setTagsFSX(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating FSx Lustre file system (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("auto_import_policy") ||
+		d.HasChange("automatic_backup_retention_days") ||
+		d.HasChange("daily_automatic_backup_start_time") ||
+		d.HasChange("data_compression_type") ||
+		d.HasChange("per_unit_storage_throughput") ||
+		d.HasChange("storage_capacity") ||
+		d.HasChange("weekly_maintenance_start_time") {
+		input := &fsx.UpdateFileSystemInput{
+			FileSystemId:        aws.String(d.Id()),
+			LustreConfiguration: &fsx.UpdateFileSystemLustreConfiguration{},
+		}
+
+		if d.HasChange("auto_import_policy") {
+			input.LustreConfiguration.AutoImportPolicy = aws.String(d.Get("auto_import_policy").(string))
+		}
+
+		if d.HasChange("automatic_backup_retention_days") {
+			input.LustreConfiguration.AutomaticBackupRetentionDays = aws.Int64(int64(d.Get("automatic_backup_retention_days").(int)))
+		}
+
+		if d.HasChange("daily_automatic_backup_start_time") {
+			input.LustreConfiguration.DailyAutomaticBackupStartTime = aws.String(d.Get("daily_automatic_backup_start_time").(string))
+		}
+
+		if d.HasChange("data_compression_type") {
+			input.LustreConfiguration.DataCompressionType = aws.String(d.Get("data_compression_type").(string))
+		}
+
+		if d.HasChange("per_unit_storage_throughput") {
+			input.LustreConfiguration.PerUnitStorageThroughput = aws.Int64(int64(d.Get("per_unit_storage_throughput").(int)))
+		}
+
+		if d.HasChange("storage_capacity") {
+			input.StorageCapacity = aws.Int64(int64(d.Get("storage_capacity").(int)))
+		}
+
+		if d.HasChange("weekly_maintenance_start_time") {
+			input.LustreConfiguration.WeeklyMaintenanceStartTime = aws.String(d.Get("weekly_maintenance_start_time").(string))
+		}
+
+		log.Printf("[DEBUG] Updating FSx Lustre file system: %s", input)
+		if _, err := conn.UpdateFileSystem(input); err != nil {
+			return fmt.Errorf("error updating FSx Lustre file system (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForFsxFileSystemUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for FSx Lustre file system (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	fs, err := describeFsxFileSystem(conn, d.Id())
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		log.Printf("[WARN] FSx Lustre file system (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading FSx Lustre file system (%s): %s", d.Id(), err)
+	}
+
+	if fs == nil {
+		log.Printf("[WARN] FSx Lustre file system (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if fs.LustreConfiguration == nil {
+		return fmt.Errorf("error describing FSx Lustre file system (%s): empty Lustre configuration", d.Id())
+	}
+
+	lustre := fs.LustreConfiguration
+
+	if repo := lustre.DataRepositoryConfiguration; repo != nil {
+		d.Set("auto_import_policy", repo.AutoImportPolicy)
+		d.Set("export_path", repo.ExportPath)
+		d.Set("import_path", repo.ImportPath)
+		d.Set("imported_file_chunk_size", repo.ImportedFileChunkSize)
+	}
+
+	d.Set("arn", fs.ResourceARN)
+	d.Set("automatic_backup_retention_days", lustre.AutomaticBackupRetentionDays)
+	d.Set("copy_tags_to_backups", lustre.CopyTagsToBackups)
+	d.Set("daily_automatic_backup_start_time", lustre.DailyAutomaticBackupStartTime)
+	d.Set("data_compression_type", lustre.DataCompressionType)
+	d.Set("deployment_type", lustre.DeploymentType)
+	d.Set("dns_name", fs.DNSName)
+	d.Set("drive_cache_type", lustre.DriveCacheType)
+	d.Set("kms_key_id", fs.KmsKeyId)
+	d.Set("mount_name", lustre.MountName)
+	d.Set("owner_id", fs.OwnerId)
+	d.Set("per_unit_storage_throughput", lustre.PerUnitStorageThroughput)
+	d.Set("storage_capacity", fs.StorageCapacity)
+	d.Set("storage_type", fs.StorageType)
+	d.Set("vpc_id", fs.VpcId)
+	d.Set("weekly_maintenance_start_time", lustre.WeeklyMaintenanceStartTime)
+
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(fs.NetworkInterfaceIds)); err != nil {
+		return fmt.Errorf("error setting network_interface_ids: %s", err)
+	}
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(fs.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapFSX(fs.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFsxLustreFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	log.Printf("[DEBUG] Deleting FSx Lustre file system: %s", d.Id())
+	_, err := conn.DeleteFileSystem(&fsx.DeleteFileSystemInput{
+		FileSystemId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting FSx Lustre file system (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForFsxFileSystemDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FSx Lustre file system (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFsxLustreFileSystem_basic(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`file-system/fs-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_compression_type", fsx.DataCompressionTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "deployment_type", fsx.LustreDeploymentTypeScratch1),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`fs-.+\.fsx\.`)),
+					resource.TestCheckResourceAttr(resourceName, "import_path", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "mount_name"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", fsx.StorageTypeSsd),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "weekly_maintenance_start_time", regexp.MustCompile(`^\d:\d\d:\d\d$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_dataCompression(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigDataCompression(fsx.DataCompressionTypeLz4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "data_compression_type", fsx.DataCompressionTypeLz4),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigDataCompression(fsx.DataCompressionTypeNone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "data_compression_type", fsx.DataCompressionTypeNone),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_dataRepository(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigDataRepository(rName, fsx.AutoImportPolicyTypeNew),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "auto_import_policy", fsx.AutoImportPolicyTypeNew),
+					resource.TestCheckResourceAttr(resourceName, "export_path", fmt.Sprintf("s3://%s/export", rName)),
+					resource.TestCheckResourceAttr(resourceName, "import_path", fmt.Sprintf("s3://%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "imported_file_chunk_size", "2048"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigDataRepository(rName, fsx.AutoImportPolicyTypeNewChangedDeleted),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "auto_import_policy", fsx.AutoImportPolicyTypeNewChangedDeleted),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_persistentStorageCapacity(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigPersistent(1200, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_type", fsx.LustreDeploymentTypePersistent1),
+					resource.TestCheckResourceAttr(resourceName, "per_unit_storage_throughput", "50"),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "1200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigPersistent(2400, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "2400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_weeklyMaintenanceStartTime(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime("1:01:01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "1:01:01"),
+				),
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime("2:02:02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "2:02:02"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_tags(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigTags("value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigTags("value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxLustreFileSystemDestroy(s *terraform.State) error {
+	return testAccCheckFsxFileSystemDestroy(s, "aws_fsx_lustre_file_system")
+}
+
+func testAccCheckFsxFileSystemExists(n string, v *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+		fs, err := describeFsxFileSystem(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if fs == nil {
+			return fmt.Errorf("FSx file system (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *fs
+
+		return nil
+	}
+}
+
+func testAccCheckFsxFileSystemDestroy(s *terraform.State, resourceType string) error {
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		fs, err := describeFsxFileSystem(conn, rs.Primary.ID)
+		if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if fs != nil {
+			return fmt.Errorf("FSx file system (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFsxFileSystemNotRecreated(i, j *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *i.FileSystemId != *j.FileSystemId {
+			return fmt.Errorf("FSx file system (%s) recreated", *i.FileSystemId)
+		}
+
+		return nil
+	}
+}
+
+const testAccAwsFsxLustreFileSystemConfigBase = `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf-acc-fsx-lustre-file-system"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags {
+    Name = "tf-acc-fsx-lustre-file-system"
+  }
+}
+`
+
+func testAccAwsFsxLustreFileSystemConfigSubnetIds1() string {
+	return testAccAwsFsxLustreFileSystemConfigBase + `
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 1200
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+}
+`
+}
+
+func testAccAwsFsxLustreFileSystemConfigDataCompression(compression string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  data_compression_type = %q
+  storage_capacity      = 1200
+  subnet_ids            = ["${aws_subnet.test1.id}"]
+}
+`, compression)
+}
+
+func testAccAwsFsxLustreFileSystemConfigDataRepository(rName, autoImportPolicy string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_fsx_lustre_file_system" "test" {
+  auto_import_policy       = %[2]q
+  export_path              = "s3://${aws_s3_bucket.test.bucket}/export"
+  import_path              = "s3://${aws_s3_bucket.test.bucket}"
+  imported_file_chunk_size = 2048
+  storage_capacity         = 1200
+  subnet_ids               = ["${aws_subnet.test1.id}"]
+}
+`, rName, autoImportPolicy)
+}
+
+func testAccAwsFsxLustreFileSystemConfigPersistent(storageCapacity, perUnitStorageThroughput int) string {
+	return testAccAwsFsxLustreFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  deployment_type             = "PERSISTENT_1"
+  per_unit_storage_throughput = %[2]d
+  storage_capacity            = %[1]d
+  subnet_ids                  = ["${aws_subnet.test1.id}"]
+}
+`, storageCapacity, perUnitStorageThroughput)
+}
+
+func testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime(weeklyMaintenanceStartTime string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity              = 1200
+  subnet_ids                    = ["${aws_subnet.test1.id}"]
+  weekly_maintenance_start_time = %q
+}
+`, weeklyMaintenanceStartTime)
+}
+
+func testAccAwsFsxLustreFileSystemConfigTags(tagValue1 string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 1200
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+
+  tags {
+    Key1 = %q
+  }
+}
+`, tagValue1)
+}
@@ -0,0 +1,570 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsFsxWindowsFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxWindowsFileSystemCreate,
+		Read:   resourceAwsFsxWindowsFileSystemRead,
+		Update: resourceAwsFsxWindowsFileSystemUpdate,
+		Delete: resourceAwsFsxWindowsFileSystemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_final_backup", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_directory_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"self_managed_active_directory"},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"audit_log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit_log_destination": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateArn,
+						},
+						"file_access_audit_log_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      fsx.WindowsAccessAuditLogLevelDisabled,
+							ValidateFunc: validation.StringInSlice(fsx.WindowsAccessAuditLogLevel_Values(), false),
+						},
+						"file_share_access_audit_log_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      fsx.WindowsAccessAuditLogLevelDisabled,
+							ValidateFunc: validation.StringInSlice(fsx.WindowsAccessAuditLogLevel_Values(), false),
+						},
+					},
+				},
+			},
+			"automatic_backup_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(0, 90),
+			},
+			"copy_tags_to_backups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"daily_automatic_backup_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in the format HH:MM"),
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      fsx.WindowsDeploymentTypeSingleAz1,
+				ValidateFunc: validation.StringInSlice(fsx.WindowsDeploymentType_Values(), false),
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preferred_file_server_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preferred_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"remote_administration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"self_managed_active_directory": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"active_directory_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_ips": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"file_system_administrators_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "Domain Admins",
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"organizational_unit_distinguished_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+						"password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"skip_final_backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"storage_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(32, 65536),
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      fsx.StorageTypeSsd,
+				ValidateFunc: validation.StringInSlice(fsx.StorageType_Values(), false),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"throughput_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(8, 2048),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-7]:([01]\d|2[0-3]):[0-5]\d$`), "must be in the format d:HH:MM"),
+			},
+		},
+	}
+}
+
+func resourceAwsFsxWindowsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.CreateFileSystemInput{
+		FileSystemType:  aws.String(fsx.FileSystemTypeWindows),
+		StorageCapacity: aws.Int64(int64(d.Get("storage_capacity").(int))),
+		StorageType:     aws.String(d.Get("storage_type").(string)),
+		SubnetIds:       expandStringList(d.Get("subnet_ids").([]interface{})),
+		WindowsConfiguration: &fsx.CreateFileSystemWindowsConfiguration{
+			AutomaticBackupRetentionDays: aws.Int64(int64(d.Get("automatic_backup_retention_days").(int))),
+			CopyTagsToBackups:            aws.Bool(d.Get("copy_tags_to_backups").(bool)),
+			DeploymentType:               aws.String(d.Get("deployment_type").(string)),
+			ThroughputCapacity:           aws.Int64(int64(d.Get("throughput_capacity").(int))),
+		},
+	}
+
+	if v, ok := d.GetOk("active_directory_id"); ok {
+		input.WindowsConfiguration.ActiveDirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("audit_log_configuration"); ok {
+		input.WindowsConfiguration.AuditLogConfiguration = expandFsxWindowsAuditLogConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("daily_automatic_backup_start_time"); ok {
+		input.WindowsConfiguration.DailyAutomaticBackupStartTime = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_subnet_id"); ok {
+		input.WindowsConfiguration.PreferredSubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("self_managed_active_directory"); ok {
+		input.WindowsConfiguration.SelfManagedActiveDirectoryConfiguration = expandFsxSelfManagedActiveDirectoryConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapFSX(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
+		input.WindowsConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating FSx Windows file system: %s", input)
+	output, err := conn.CreateFileSystem(input)
+	if err != nil {
+		return fmt.Errorf("error creating FSx Windows file system: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FileSystem.FileSystemId))
+
+	if err := waitForFsxFileSystemCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FSx Windows file system (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsFsxWindowsFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxWindowsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	if d.HasChange("tags") {
+		if err := setTagsFSX(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating FSx Windows file system (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	// Storage capacity and throughput capacity are scaled through separate
+	// administrative actions, so each is submitted on its own and allowed to
+	// finish before the next one is requested.
+	if d.HasChange("storage_capacity") {
+		input := &fsx.UpdateFileSystemInput{
+			FileSystemId:    aws.String(d.Id()),
+			StorageCapacity: aws.Int64(int64(d.Get("storage_capacity").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating FSx Windows file system storage capacity: %s", input)
+		if _, err := conn.UpdateFileSystem(input); err != nil {
+			return fmt.Errorf("error updating FSx Windows file system (%s) storage capacity: %s", d.Id(), err)
+		}
+
+		if err := waitForFsxFileSystemUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for FSx Windows file system (%s) storage capacity update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("throughput_capacity") {
+		input := &fsx.UpdateFileSystemInput{
+			FileSystemId: aws.String(d.Id()),
+			WindowsConfiguration: &fsx.UpdateFileSystemWindowsConfiguration{
+				ThroughputCapacity: aws.Int64(int64(d.Get("throughput_capacity").(int))),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating FSx Windows file system throughput capacity: %s", input)
+		if _, err := conn.UpdateFileSystem(input); err != nil {
+			return fmt.Errorf("error updating FSx Windows file system (%s) throughput capacity: %s", d.Id(), err)
+		}
+
+		if err := waitForFsxFileSystemUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for FSx Windows file system (%s) throughput capacity update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("audit_log_configuration") ||
+		d.HasChange("automatic_backup_retention_days") ||
+		d.HasChange("daily_automatic_backup_start_time") ||
+		d.HasChange("self_managed_active_directory") ||
+		d.HasChange("weekly_maintenance_start_time") {
+		input := &fsx.UpdateFileSystemInput{
+			FileSystemId: aws.String(d.Id()),
+			WindowsConfiguration: &fsx.UpdateFileSystemWindowsConfiguration{
+				AutomaticBackupRetentionDays: aws.Int64(int64(d.Get("automatic_backup_retention_days").(int))),
+			},
+		}
+
+		if d.HasChange("audit_log_configuration") {
+			input.WindowsConfiguration.AuditLogConfiguration = expandFsxWindowsAuditLogConfiguration(d.Get("audit_log_configuration").([]interface{}))
+		}
+
+		if v, ok := d.GetOk("daily_automatic_backup_start_time"); ok {
+			input.WindowsConfiguration.DailyAutomaticBackupStartTime = aws.String(v.(string))
+		}
+
+		if d.HasChange("self_managed_active_directory") {
+			input.WindowsConfiguration.SelfManagedActiveDirectoryConfiguration = expandFsxSelfManagedActiveDirectoryConfigurationUpdates(d.Get("self_managed_active_directory").([]interface{}))
+		}
+
+		if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
+			input.WindowsConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating FSx Windows file system: %s", input)
+		if _, err := conn.UpdateFileSystem(input); err != nil {
+			return fmt.Errorf("error updating FSx Windows file system (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForFsxFileSystemUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for FSx Windows file system (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFsxWindowsFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxWindowsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	fs, err := describeFsxFileSystem(conn, d.Id())
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		log.Printf("[WARN] FSx Windows file system (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading FSx Windows file system (%s): %s", d.Id(), err)
+	}
+
+	if fs == nil {
+		log.Printf("[WARN] FSx Windows file system (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if fs.WindowsConfiguration == nil {
+		return fmt.Errorf("error describing FSx Windows file system (%s): empty Windows configuration", d.Id())
+	}
+
+	windows := fs.WindowsConfiguration
+
+	d.Set("active_directory_id", windows.ActiveDirectoryId)
+	d.Set("arn", fs.ResourceARN)
+	d.Set("automatic_backup_retention_days", windows.AutomaticBackupRetentionDays)
+	d.Set("copy_tags_to_backups", windows.CopyTagsToBackups)
+	d.Set("daily_automatic_backup_start_time", windows.DailyAutomaticBackupStartTime)
+	d.Set("deployment_type", windows.DeploymentType)
+	d.Set("dns_name", fs.DNSName)
+	d.Set("kms_key_id", fs.KmsKeyId)
+	d.Set("owner_id", fs.OwnerId)
+	d.Set("preferred_file_server_ip", windows.PreferredFileServerIp)
+	d.Set("preferred_subnet_id", windows.PreferredSubnetId)
+	d.Set("remote_administration_endpoint", windows.RemoteAdministrationEndpoint)
+	d.Set("storage_capacity", fs.StorageCapacity)
+	d.Set("storage_type", fs.StorageType)
+	d.Set("throughput_capacity", windows.ThroughputCapacity)
+	d.Set("vpc_id", fs.VpcId)
+	d.Set("weekly_maintenance_start_time", windows.WeeklyMaintenanceStartTime)
+
+	if err := d.Set("audit_log_configuration", flattenFsxWindowsAuditLogConfiguration(windows.AuditLogConfiguration)); err != nil {
+		return fmt.Errorf("error setting audit_log_configuration: %s", err)
+	}
+
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(fs.NetworkInterfaceIds)); err != nil {
+		return fmt.Errorf("error setting network_interface_ids: %s", err)
+	}
+
+	if err := d.Set("self_managed_active_directory", flattenFsxSelfManagedActiveDirectoryConfiguration(d, windows.SelfManagedActiveDirectoryConfiguration)); err != nil {
+		return fmt.Errorf("error setting self_managed_active_directory: %s", err)
+	}
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(fs.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapFSX(fs.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFsxWindowsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn
+
+	input := &fsx.DeleteFileSystemInput{
+		FileSystemId: aws.String(d.Id()),
+		WindowsConfiguration: &fsx.DeleteFileSystemWindowsConfiguration{
+			SkipFinalBackup: aws.Bool(d.Get("skip_final_backup").(bool)),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting FSx Windows file system: %s", d.Id())
+	_, err := conn.DeleteFileSystem(input)
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting FSx Windows file system (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForFsxFileSystemDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FSx Windows file system (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFsxWindowsAuditLogConfiguration(l []interface{}) *fsx.WindowsAuditLogCreateConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &fsx.WindowsAuditLogCreateConfiguration{
+		FileAccessAuditLogLevel:      aws.String(m["file_access_audit_log_level"].(string)),
+		FileShareAccessAuditLogLevel: aws.String(m["file_share_access_audit_log_level"].(string)),
+	}
+
+	// The destination may only be set when at least one kind of auditing is
+	// turned on.
+	if v, ok := m["audit_log_destination"].(string); ok && v != "" {
+		if aws.StringValue(config.FileAccessAuditLogLevel) != fsx.WindowsAccessAuditLogLevelDisabled ||
+			aws.StringValue(config.FileShareAccessAuditLogLevel) != fsx.WindowsAccessAuditLogLevelDisabled {
+			config.AuditLogDestination = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+func expandFsxSelfManagedActiveDirectoryConfiguration(l []interface{}) *fsx.SelfManagedActiveDirectoryConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &fsx.SelfManagedActiveDirectoryConfiguration{
+		DomainName: aws.String(m["domain_name"].(string)),
+		DnsIps:     expandStringSet(m["dns_ips"].(*schema.Set)),
+		Password:   aws.String(m["password"].(string)),
+		UserName:   aws.String(m["username"].(string)),
+	}
+
+	if v, ok := m["file_system_administrators_group"].(string); ok && v != "" {
+		config.FileSystemAdministratorsGroup = aws.String(v)
+	}
+
+	if v, ok := m["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		config.OrganizationalUnitDistinguishedName = aws.String(v)
+	}
+
+	return config
+}
+
+func expandFsxSelfManagedActiveDirectoryConfigurationUpdates(l []interface{}) *fsx.SelfManagedActiveDirectoryConfigurationUpdates {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &fsx.SelfManagedActiveDirectoryConfigurationUpdates{
+		DnsIps:   expandStringSet(m["dns_ips"].(*schema.Set)),
+		Password: aws.String(m["password"].(string)),
+		UserName: aws.String(m["username"].(string)),
+	}
+}
+
+func flattenFsxWindowsAuditLogConfiguration(config *fsx.WindowsAuditLogConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"audit_log_destination":             aws.StringValue(config.AuditLogDestination),
+		"file_access_audit_log_level":       aws.StringValue(config.FileAccessAuditLogLevel),
+		"file_share_access_audit_log_level": aws.StringValue(config.FileShareAccessAuditLogLevel),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenFsxSelfManagedActiveDirectoryConfiguration(d *schema.ResourceData, config *fsx.SelfManagedActiveDirectoryAttributes) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	// The password is never returned by the API.
+	m := map[string]interface{}{
+		"dns_ips":                                aws.StringValueSlice(config.DnsIps),
+		"domain_name":                            aws.StringValue(config.DomainName),
+		"file_system_administrators_group":       aws.StringValue(config.FileSystemAdministratorsGroup),
+		"organizational_unit_distinguished_name": aws.StringValue(config.OrganizationalUnitDistinguishedName),
+		"password":                               d.Get("self_managed_active_directory.0.password").(string),
+		"username":                               aws.StringValue(config.UserName),
+	}
+
+	return []interface{}{m}
+}
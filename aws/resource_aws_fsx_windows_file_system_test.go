@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFsxWindowsFileSystem_basic(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					resource.TestCheckResourceAttrPair(resourceName, "active_directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`file-system/fs-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "automatic_backup_retention_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags_to_backups", "false"),
+					resource.TestMatchResourceAttr(resourceName, "daily_automatic_backup_start_time", regexp.MustCompile(`^\d\d:\d\d$`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_type", fsx.WindowsDeploymentTypeSingleAz1),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`fs-.+\..+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "skip_final_backup", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "32"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", fsx.StorageTypeSsd),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "8"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "weekly_maintenance_start_time", regexp.MustCompile(`^\d:\d\d:\d\d$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_final_backup"},
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_auditLogConfiguration(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigAuditLogConfiguration(rName, fsx.WindowsAccessAuditLogLevelSuccessOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "audit_log_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "audit_log_configuration.0.audit_log_destination", "aws_cloudwatch_log_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "audit_log_configuration.0.file_access_audit_log_level", fsx.WindowsAccessAuditLogLevelSuccessOnly),
+					resource.TestCheckResourceAttr(resourceName, "audit_log_configuration.0.file_share_access_audit_log_level", fsx.WindowsAccessAuditLogLevelSuccessOnly),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_final_backup"},
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigAuditLogConfiguration(rName, fsx.WindowsAccessAuditLogLevelSuccessAndFailure),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "audit_log_configuration.0.file_access_audit_log_level", fsx.WindowsAccessAuditLogLevelSuccessAndFailure),
+					resource.TestCheckResourceAttr(resourceName, "audit_log_configuration.0.file_share_access_audit_log_level", fsx.WindowsAccessAuditLogLevelSuccessAndFailure),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_throughputCapacity(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "16"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_final_backup"},
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(32),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_weeklyMaintenanceStartTime(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigWeeklyMaintenanceStartTime("1:01:01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "1:01:01"),
+				),
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigWeeklyMaintenanceStartTime("2:02:02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "2:02:02"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_tags(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigTags("value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigTags("value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxWindowsFileSystemDestroy(s *terraform.State) error {
+	return testAccCheckFsxFileSystemDestroy(s, "aws_fsx_windows_file_system")
+}
+
+const testAccAwsFsxWindowsFileSystemConfigBase = `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf-acc-fsx-windows-file-system"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags {
+    Name = "tf-acc-fsx-windows-file-system"
+  }
+}
+
+resource "aws_subnet" "test2" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+
+  tags {
+    Name = "tf-acc-fsx-windows-file-system"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+`
+
+func testAccAwsFsxWindowsFileSystemConfigSubnetIds1() string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + `
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+}
+`
+}
+
+func testAccAwsFsxWindowsFileSystemConfigAuditLogConfiguration(rName, auditLogLevel string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + fmt.Sprintf(`
+# The log group name must begin with /aws/fsx.
+resource "aws_cloudwatch_log_group" "test" {
+  name = "/aws/fsx/%[1]s"
+}
+
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+
+  audit_log_configuration {
+    audit_log_destination             = "${aws_cloudwatch_log_group.test.arn}"
+    file_access_audit_log_level       = %[2]q
+    file_share_access_audit_log_level = %[2]q
+  }
+}
+`, rName, auditLogLevel)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(throughputCapacity int) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = %d
+}
+`, throughputCapacity)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigWeeklyMaintenanceStartTime(weeklyMaintenanceStartTime string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id           = "${aws_directory_service_directory.test.id}"
+  skip_final_backup             = true
+  storage_capacity              = 32
+  subnet_ids                    = ["${aws_subnet.test1.id}"]
+  throughput_capacity           = 8
+  weekly_maintenance_start_time = %q
+}
+`, weeklyMaintenanceStartTime)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigTags(tagValue1 string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+
+  tags {
+    Key1 = %q
+  }
+}
+`, tagValue1)
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorAcceleratorCreate,
+		Read:   resourceAwsGlobalAcceleratorAcceleratorRead,
+		Update: resourceAwsGlobalAcceleratorAcceleratorUpdate,
+		Delete: resourceAwsGlobalAcceleratorAcceleratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_logs_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"flow_logs_s3_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"flow_logs_s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.IpAddressTypeIpv4,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.IpAddressTypeIpv4,
+					globalaccelerator.IpAddressTypeDualStack,
+				}, false),
+			},
+			"ip_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ip_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	name := d.Get("name").(string)
+
+	input := &globalaccelerator.CreateAcceleratorInput{
+		Enabled:          aws.Bool(d.Get("enabled").(bool)),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		IpAddressType:    aws.String(d.Get("ip_address_type").(string)),
+		Name:             aws.String(name),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGlobalAccelerator(v)
+	}
+
+	log.Printf("[DEBUG] Creating Global Accelerator Accelerator: %s", input)
+	output, err := conn.CreateAccelerator(input)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator Accelerator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Accelerator.AcceleratorArn))
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		if err := globalAcceleratorUpdateAcceleratorAttributes(conn, d.Id(), v.([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	accelerator, err := globalAcceleratorDescribeAccelerator(conn, d.Id())
+
+	if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator Accelerator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Accelerator (%s): %s", d.Id(), err)
+	}
+
+	if accelerator == nil {
+		log.Printf("[WARN] Global Accelerator Accelerator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("dns_name", accelerator.DnsName)
+	d.Set("enabled", accelerator.Enabled)
+	d.Set("hosted_zone_id", globalAcceleratorRoute53ZoneID)
+	d.Set("ip_address_type", accelerator.IpAddressType)
+	d.Set("name", accelerator.Name)
+
+	if err := d.Set("ip_sets", flattenGlobalAcceleratorIpSets(accelerator.IpSets)); err != nil {
+		return fmt.Errorf("error setting ip_sets: %s", err)
+	}
+
+	attributesOutput, err := conn.DescribeAcceleratorAttributes(&globalaccelerator.DescribeAcceleratorAttributesInput{
+		AcceleratorArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("attributes", flattenGlobalAcceleratorAcceleratorAttributes(attributesOutput.AcceleratorAttributes)); err != nil {
+		return fmt.Errorf("error setting attributes: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Global Accelerator Accelerator (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapGlobalAccelerator(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	if d.HasChange("enabled") || d.HasChange("ip_address_type") || d.HasChange("name") {
+		input := &globalaccelerator.UpdateAcceleratorInput{
+			AcceleratorArn: aws.String(d.Id()),
+			Enabled:        aws.Bool(d.Get("enabled").(bool)),
+			IpAddressType:  aws.String(d.Get("ip_address_type").(string)),
+			Name:           aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Global Accelerator Accelerator (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateAccelerator(input); err != nil {
+			return fmt.Errorf("error updating Global Accelerator Accelerator (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("attributes") {
+		if err := globalAcceleratorUpdateAcceleratorAttributes(conn, d.Id(), d.Get("attributes").([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsGlobalAccelerator(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Global Accelerator Accelerator (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	// An accelerator must be disabled before it can be deleted.
+	input := &globalaccelerator.UpdateAcceleratorInput{
+		AcceleratorArn: aws.String(d.Id()),
+		Enabled:        aws.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Disabling Global Accelerator Accelerator (%s): %s", d.Id(), input)
+	_, err := conn.UpdateAccelerator(input)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Global Accelerator Accelerator (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Accelerator (%s)", d.Id())
+	_, err = conn.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{
+		AcceleratorArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator Accelerator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func globalAcceleratorUpdateAcceleratorAttributes(conn *globalaccelerator.GlobalAccelerator, arn string, l []interface{}, timeout time.Duration) error {
+	input := &globalaccelerator.UpdateAcceleratorAttributesInput{
+		AcceleratorArn:  aws.String(arn),
+		FlowLogsEnabled: aws.Bool(false),
+	}
+
+	if len(l) > 0 && l[0] != nil {
+		tfMap := l[0].(map[string]interface{})
+
+		input.FlowLogsEnabled = aws.Bool(tfMap["flow_logs_enabled"].(bool))
+
+		// The S3 location may only be supplied when flow logs are enabled.
+		if tfMap["flow_logs_enabled"].(bool) {
+			input.FlowLogsS3Bucket = aws.String(tfMap["flow_logs_s3_bucket"].(string))
+			input.FlowLogsS3Prefix = aws.String(tfMap["flow_logs_s3_prefix"].(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Global Accelerator Accelerator (%s) attributes: %s", arn, input)
+	if _, err := conn.UpdateAcceleratorAttributes(input); err != nil {
+		return fmt.Errorf("error updating Global Accelerator Accelerator (%s) attributes: %s", arn, err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, arn, timeout); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", arn, err)
+	}
+
+	return nil
+}
+
+func flattenGlobalAcceleratorAcceleratorAttributes(attributes *globalaccelerator.AcceleratorAttributes) []interface{} {
+	if attributes == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"flow_logs_enabled":   aws.BoolValue(attributes.FlowLogsEnabled),
+			"flow_logs_s3_bucket": aws.StringValue(attributes.FlowLogsS3Bucket),
+			"flow_logs_s3_prefix": aws.StringValue(attributes.FlowLogsS3Prefix),
+		},
+	}
+}
+
+func flattenGlobalAcceleratorIpSets(ipSets []*globalaccelerator.IpSet) []interface{} {
+	l := make([]interface{}, 0, len(ipSets))
+
+	for _, ipSet := range ipSets {
+		if ipSet == nil {
+			continue
+		}
+
+		ipFamily := aws.StringValue(ipSet.IpAddressFamily)
+		if ipFamily == "" {
+			ipFamily = aws.StringValue(ipSet.IpFamily)
+		}
+
+		l = append(l, map[string]interface{}{
+			"ip_addresses": flattenStringList(ipSet.IpAddresses),
+			"ip_family":    ipFamily,
+		})
+	}
+
+	return l
+}
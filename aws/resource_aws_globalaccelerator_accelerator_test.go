@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorAccelerator_basic(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_accelerator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "false"),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`\.awsglobalaccelerator\.com$`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "hosted_zone_id", globalAcceleratorRoute53ZoneID),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", globalaccelerator.IpAddressTypeIpv4),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.0.ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.0.ip_family", "IPv4"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_enabled(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_accelerator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_attributes(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_accelerator.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigAttributes(rName, true, "flow-logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "attributes.0.flow_logs_s3_bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_prefix", "flow-logs/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigAttributes(rName, true, "flow-logs-updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_prefix", "flow-logs-updated/"),
+				),
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigAttributes(rName, false, "flow-logs-updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorAccelerator_tags(t *testing.T) {
+	var accelerator globalaccelerator.Accelerator
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_accelerator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorAcceleratorConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlobalAcceleratorAcceleratorExists(resourceName string, accelerator *globalaccelerator.Accelerator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator Accelerator ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		output, err := globalAcceleratorDescribeAccelerator(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Global Accelerator Accelerator (%s) not found", rs.Primary.ID)
+		}
+
+		*accelerator = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_accelerator" {
+			continue
+		}
+
+		output, err := globalAcceleratorDescribeAccelerator(conn, rs.Primary.ID)
+
+		if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Global Accelerator Accelerator (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlobalAcceleratorAcceleratorConfigEnabled(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name    = %[1]q
+  enabled = %[2]t
+}
+`, rName, enabled)
+}
+
+func testAccAWSGlobalAcceleratorAcceleratorConfigAttributes(rName string, flowLogsEnabled bool, flowLogsPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+
+  attributes {
+    flow_logs_enabled   = %[2]t
+    flow_logs_s3_bucket = "${aws_s3_bucket.test.bucket}"
+    flow_logs_s3_prefix = %[3]q
+  }
+}
+`, rName, flowLogsEnabled, flowLogsPrefix)
+}
+
+func testAccAWSGlobalAcceleratorAcceleratorConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSGlobalAcceleratorAcceleratorConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorEndpointGroupCreate,
+		Read:   resourceAwsGlobalAcceleratorEndpointGroupRead,
+		Update: resourceAwsGlobalAcceleratorEndpointGroupUpdate,
+		Delete: resourceAwsGlobalAcceleratorEndpointGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_ip_preservation_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      128,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+					},
+				},
+			},
+			"endpoint_group_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"health_check_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(10, 30),
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"health_check_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"health_check_protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.HealthCheckProtocolTcp,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.HealthCheckProtocolTcp,
+					globalaccelerator.HealthCheckProtocolHttp,
+					globalaccelerator.HealthCheckProtocolHttps,
+				}, false),
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"port_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"listener_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+			},
+			"threshold_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"traffic_dial_percentage": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  100.0,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(float64)
+					if value < 0 || value > 100 {
+						errors = append(errors, fmt.Errorf("%q must be between 0 and 100, got: %f", k, value))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	listenerArn := d.Get("listener_arn").(string)
+
+	acceleratorArn, err := globalAcceleratorAcceleratorArnFromChildArn(listenerArn)
+	if err != nil {
+		return err
+	}
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		region = v.(string)
+	}
+
+	input := &globalaccelerator.CreateEndpointGroupInput{
+		EndpointGroupRegion:        aws.String(region),
+		HealthCheckIntervalSeconds: aws.Int64(int64(d.Get("health_check_interval_seconds").(int))),
+		HealthCheckProtocol:        aws.String(d.Get("health_check_protocol").(string)),
+		IdempotencyToken:           aws.String(resource.UniqueId()),
+		ListenerArn:                aws.String(listenerArn),
+		ThresholdCount:             aws.Int64(int64(d.Get("threshold_count").(int))),
+		TrafficDialPercentage:      aws.Float64(d.Get("traffic_dial_percentage").(float64)),
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok {
+		input.EndpointConfigurations = expandGlobalAcceleratorEndpointConfigurations(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("health_check_path"); ok {
+		input.HealthCheckPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_port"); ok {
+		input.HealthCheckPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("port_override"); ok {
+		input.PortOverrides = expandGlobalAcceleratorPortOverrides(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Global Accelerator Endpoint Group: %s", input)
+	output, err := conn.CreateEndpointGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator Endpoint Group (%s): %s", listenerArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointGroup.EndpointGroupArn))
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return resourceAwsGlobalAcceleratorEndpointGroupRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	endpointGroup, err := globalAcceleratorDescribeEndpointGroup(conn, d.Id())
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	if endpointGroup == nil {
+		log.Printf("[WARN] Global Accelerator Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	listenerArn, err := globalAcceleratorListenerArnFromEndpointGroupArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", endpointGroup.EndpointGroupArn)
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("health_check_interval_seconds", endpointGroup.HealthCheckIntervalSeconds)
+	d.Set("health_check_path", endpointGroup.HealthCheckPath)
+	d.Set("health_check_port", endpointGroup.HealthCheckPort)
+	d.Set("health_check_protocol", endpointGroup.HealthCheckProtocol)
+	d.Set("listener_arn", listenerArn)
+	d.Set("threshold_count", endpointGroup.ThresholdCount)
+	d.Set("traffic_dial_percentage", endpointGroup.TrafficDialPercentage)
+
+	if err := d.Set("endpoint_configuration", flattenGlobalAcceleratorEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+		return fmt.Errorf("error setting endpoint_configuration: %s", err)
+	}
+
+	if err := d.Set("port_override", flattenGlobalAcceleratorPortOverrides(endpointGroup.PortOverrides)); err != nil {
+		return fmt.Errorf("error setting port_override: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	acceleratorArn, err := globalAcceleratorAcceleratorArnFromChildArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Omitted lists are left untouched by the API, so empty lists are sent
+	// explicitly to remove all endpoints or port overrides.
+	input := &globalaccelerator.UpdateEndpointGroupInput{
+		EndpointConfigurations:     expandGlobalAcceleratorEndpointConfigurations(d.Get("endpoint_configuration").(*schema.Set).List()),
+		EndpointGroupArn:           aws.String(d.Id()),
+		HealthCheckIntervalSeconds: aws.Int64(int64(d.Get("health_check_interval_seconds").(int))),
+		HealthCheckProtocol:        aws.String(d.Get("health_check_protocol").(string)),
+		PortOverrides:              expandGlobalAcceleratorPortOverrides(d.Get("port_override").(*schema.Set).List()),
+		ThresholdCount:             aws.Int64(int64(d.Get("threshold_count").(int))),
+		TrafficDialPercentage:      aws.Float64(d.Get("traffic_dial_percentage").(float64)),
+	}
+
+	if v, ok := d.GetOk("health_check_path"); ok {
+		input.HealthCheckPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_port"); ok {
+		input.HealthCheckPort = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Updating Global Accelerator Endpoint Group (%s): %s", d.Id(), input)
+	if _, err := conn.UpdateEndpointGroup(input); err != nil {
+		return fmt.Errorf("error updating Global Accelerator Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return resourceAwsGlobalAcceleratorEndpointGroupRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	acceleratorArn, err := globalAcceleratorAcceleratorArnFromChildArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &globalaccelerator.DeleteEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Endpoint Group (%s): %s", d.Id(), input)
+	_, err = conn.DeleteEndpointGroup(input)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return nil
+}
+
+func expandGlobalAcceleratorEndpointConfigurations(l []interface{}) []*globalaccelerator.EndpointConfiguration {
+	configurations := make([]*globalaccelerator.EndpointConfiguration, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configuration := &globalaccelerator.EndpointConfiguration{
+			EndpointId: aws.String(tfMap["endpoint_id"].(string)),
+			Weight:     aws.Int64(int64(tfMap["weight"].(int))),
+		}
+
+		if v, ok := tfMap["client_ip_preservation_enabled"].(bool); ok && v {
+			configuration.ClientIPPreservationEnabled = aws.Bool(v)
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+func flattenGlobalAcceleratorEndpointDescriptions(descriptions []*globalaccelerator.EndpointDescription) []interface{} {
+	l := make([]interface{}, 0, len(descriptions))
+
+	for _, description := range descriptions {
+		if description == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"client_ip_preservation_enabled": aws.BoolValue(description.ClientIPPreservationEnabled),
+			"endpoint_id":                    aws.StringValue(description.EndpointId),
+			"weight":                         int(aws.Int64Value(description.Weight)),
+		})
+	}
+
+	return l
+}
+
+func expandGlobalAcceleratorPortOverrides(l []interface{}) []*globalaccelerator.PortOverride {
+	portOverrides := make([]*globalaccelerator.PortOverride, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		portOverrides = append(portOverrides, &globalaccelerator.PortOverride{
+			EndpointPort: aws.Int64(int64(tfMap["endpoint_port"].(int))),
+			ListenerPort: aws.Int64(int64(tfMap["listener_port"].(int))),
+		})
+	}
+
+	return portOverrides
+}
+
+func flattenGlobalAcceleratorPortOverrides(portOverrides []*globalaccelerator.PortOverride) []interface{} {
+	l := make([]interface{}, 0, len(portOverrides))
+
+	for _, portOverride := range portOverrides {
+		if portOverride == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"endpoint_port": int(aws.Int64Value(portOverride.EndpointPort)),
+			"listener_port": int(aws.Int64Value(portOverride.ListenerPort)),
+		})
+	}
+
+	return l
+}
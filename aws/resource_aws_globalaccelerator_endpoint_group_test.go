@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorEndpointGroup_basic(t *testing.T) {
+	var endpointGroup globalaccelerator.EndpointGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_endpoint_group.test"
+	listenerResourceName := "aws_globalaccelerator_listener.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorEndpointGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorEndpointGroupExists(resourceName, &endpointGroup),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_group_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_interval_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_protocol", globalaccelerator.HealthCheckProtocolTcp),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", listenerResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "port_override.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorEndpointGroup_endpointConfiguration(t *testing.T) {
+	var endpointGroup globalaccelerator.EndpointGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_endpoint_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorEndpointGroupConfigEndpointConfiguration(rName, 50, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorEndpointGroupExists(resourceName, &endpointGroup),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_interval_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/healthz"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_protocol", globalaccelerator.HealthCheckProtocolHttp),
+					resource.TestCheckResourceAttr(resourceName, "port_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorEndpointGroupConfigEndpointConfiguration(rName, 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorEndpointGroupExists(resourceName, &endpointGroup),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "0"),
+				),
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorEndpointGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorEndpointGroupExists(resourceName, &endpointGroup),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "port_override.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlobalAcceleratorEndpointGroupExists(resourceName string, endpointGroup *globalaccelerator.EndpointGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator Endpoint Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		output, err := globalAcceleratorDescribeEndpointGroup(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Global Accelerator Endpoint Group (%s) not found", rs.Primary.ID)
+		}
+
+		*endpointGroup = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlobalAcceleratorEndpointGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_endpoint_group" {
+			continue
+		}
+
+		output, err := globalAcceleratorDescribeEndpointGroup(conn, rs.Primary.ID)
+
+		if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") ||
+			isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") ||
+			isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Global Accelerator Endpoint Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlobalAcceleratorEndpointGroupConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_listener" "test" {
+  accelerator_arn = "${aws_globalaccelerator_accelerator.test.id}"
+  protocol        = "TCP"
+
+  port_range {
+    from_port = 80
+    to_port   = 80
+  }
+}
+`, rName)
+}
+
+func testAccAWSGlobalAcceleratorEndpointGroupConfigBasic(rName string) string {
+	return testAccAWSGlobalAcceleratorEndpointGroupConfigBase(rName) + `
+resource "aws_globalaccelerator_endpoint_group" "test" {
+  listener_arn = "${aws_globalaccelerator_listener.test.id}"
+}
+`
+}
+
+func testAccAWSGlobalAcceleratorEndpointGroupConfigEndpointConfiguration(rName string, trafficDialPercentage float64, weight int) string {
+	return testAccAWSGlobalAcceleratorEndpointGroupConfigBase(rName) + fmt.Sprintf(`
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_globalaccelerator_endpoint_group" "test" {
+  listener_arn                  = "${aws_globalaccelerator_listener.test.id}"
+  health_check_interval_seconds = 10
+  health_check_path             = "/healthz"
+  health_check_port             = 80
+  health_check_protocol         = "HTTP"
+  threshold_count               = 5
+  traffic_dial_percentage       = %[2]g
+
+  endpoint_configuration {
+    endpoint_id = "${aws_eip.test.id}"
+    weight      = %[3]d
+  }
+
+  port_override {
+    endpoint_port = 8080
+    listener_port = 80
+  }
+}
+`, rName, trafficDialPercentage, weight)
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorListenerCreate,
+		Read:   resourceAwsGlobalAcceleratorListenerRead,
+		Update: resourceAwsGlobalAcceleratorListenerUpdate,
+		Delete: resourceAwsGlobalAcceleratorListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"client_affinity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.ClientAffinityNone,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.ClientAffinityNone,
+					globalaccelerator.ClientAffinitySourceIp,
+				}, false),
+			},
+			"port_range": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.ProtocolTcp,
+					globalaccelerator.ProtocolUdp,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	acceleratorArn := d.Get("accelerator_arn").(string)
+
+	input := &globalaccelerator.CreateListenerInput{
+		AcceleratorArn:   aws.String(acceleratorArn),
+		ClientAffinity:   aws.String(d.Get("client_affinity").(string)),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		PortRanges:       expandGlobalAcceleratorPortRanges(d.Get("port_range").(*schema.Set).List()),
+		Protocol:         aws.String(d.Get("protocol").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Global Accelerator Listener: %s", input)
+	output, err := conn.CreateListener(input)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator Listener (%s): %s", acceleratorArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.Listener.ListenerArn))
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return resourceAwsGlobalAcceleratorListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorListenerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	listener, err := globalAcceleratorDescribeListener(conn, d.Id())
+
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Listener (%s): %s", d.Id(), err)
+	}
+
+	if listener == nil {
+		log.Printf("[WARN] Global Accelerator Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	acceleratorArn, err := globalAcceleratorAcceleratorArnFromChildArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("accelerator_arn", acceleratorArn)
+	d.Set("client_affinity", listener.ClientAffinity)
+	d.Set("protocol", listener.Protocol)
+
+	if err := d.Set("port_range", flattenGlobalAcceleratorPortRanges(listener.PortRanges)); err != nil {
+		return fmt.Errorf("error setting port_range: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	acceleratorArn := d.Get("accelerator_arn").(string)
+
+	input := &globalaccelerator.UpdateListenerInput{
+		ClientAffinity: aws.String(d.Get("client_affinity").(string)),
+		ListenerArn:    aws.String(d.Id()),
+		PortRanges:     expandGlobalAcceleratorPortRanges(d.Get("port_range").(*schema.Set).List()),
+		Protocol:       aws.String(d.Get("protocol").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Global Accelerator Listener (%s): %s", d.Id(), input)
+	if _, err := conn.UpdateListener(input); err != nil {
+		return fmt.Errorf("error updating Global Accelerator Listener (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return resourceAwsGlobalAcceleratorListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	acceleratorArn := d.Get("accelerator_arn").(string)
+
+	input := &globalaccelerator.DeleteListenerInput{
+		ListenerArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Listener (%s): %s", d.Id(), input)
+	_, err := conn.DeleteListener(input)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator Listener (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Accelerator (%s) deployment: %s", acceleratorArn, err)
+	}
+
+	return nil
+}
+
+func expandGlobalAcceleratorPortRanges(l []interface{}) []*globalaccelerator.PortRange {
+	portRanges := make([]*globalaccelerator.PortRange, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		portRanges = append(portRanges, &globalaccelerator.PortRange{
+			FromPort: aws.Int64(int64(tfMap["from_port"].(int))),
+			ToPort:   aws.Int64(int64(tfMap["to_port"].(int))),
+		})
+	}
+
+	return portRanges
+}
+
+func flattenGlobalAcceleratorPortRanges(portRanges []*globalaccelerator.PortRange) []interface{} {
+	l := make([]interface{}, 0, len(portRanges))
+
+	for _, portRange := range portRanges {
+		if portRange == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"from_port": int(aws.Int64Value(portRange.FromPort)),
+			"to_port":   int(aws.Int64Value(portRange.ToPort)),
+		})
+	}
+
+	return l
+}
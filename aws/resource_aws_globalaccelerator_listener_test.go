@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlobalAcceleratorListener_basic(t *testing.T) {
+	var listener globalaccelerator.Listener
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_listener.test"
+	acceleratorResourceName := "aws_globalaccelerator_accelerator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorListenerConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorListenerExists(resourceName, &listener),
+					resource.TestCheckResourceAttrPair(resourceName, "accelerator_arn", acceleratorResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity", globalaccelerator.ClientAffinityNone),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", globalaccelerator.ProtocolTcp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlobalAcceleratorListener_update(t *testing.T) {
+	var listener globalaccelerator.Listener
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_globalaccelerator_listener.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlobalAcceleratorListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlobalAcceleratorListenerConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorListenerExists(resourceName, &listener),
+				),
+			},
+			{
+				Config: testAccAWSGlobalAcceleratorListenerConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlobalAcceleratorListenerExists(resourceName, &listener),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity", globalaccelerator.ClientAffinitySourceIp),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", globalaccelerator.ProtocolUdp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlobalAcceleratorListenerExists(resourceName string, listener *globalaccelerator.Listener) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator Listener ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		output, err := globalAcceleratorDescribeListener(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Global Accelerator Listener (%s) not found", rs.Primary.ID)
+		}
+
+		*listener = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlobalAcceleratorListenerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_listener" {
+			continue
+		}
+
+		output, err := globalAcceleratorDescribeListener(conn, rs.Primary.ID)
+
+		if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") ||
+			isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Global Accelerator Listener (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlobalAcceleratorListenerConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_listener" "test" {
+  accelerator_arn = "${aws_globalaccelerator_accelerator.test.id}"
+  protocol        = "TCP"
+
+  port_range {
+    from_port = 80
+    to_port   = 80
+  }
+}
+`, rName)
+}
+
+func testAccAWSGlobalAcceleratorListenerConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "test" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_listener" "test" {
+  accelerator_arn = "${aws_globalaccelerator_accelerator.test.id}"
+  client_affinity = "SOURCE_IP"
+  protocol        = "UDP"
+
+  port_range {
+    from_port = 53
+    to_port   = 53
+  }
+
+  port_range {
+    from_port = 8000
+    to_port   = 8080
+  }
+}
+`, rName)
+}
@@ -0,0 +1,860 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCatalogTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCatalogTableCreate,
+		Read:   resourceAwsGlueCatalogTableRead,
+		Update: resourceAwsGlueCatalogTableUpdate,
+		Delete: resourceAwsGlueCatalogTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsGlueCatalogTableCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(2048),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateMaxLength(255),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"partition_index": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"index_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keys": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"retention": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"storage_descriptor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateMaxLength(255),
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"compressed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"number_of_buckets": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"output_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"schema_reference": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_id": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"registry_name": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"storage_descriptor.0.schema_reference.0.schema_id.0.schema_arn"},
+												},
+												"schema_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateArn,
+													ConflictsWith: []string{
+														"storage_descriptor.0.schema_reference.0.schema_id.0.registry_name",
+														"storage_descriptor.0.schema_reference.0.schema_id.0.schema_name",
+													},
+												},
+												"schema_name": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"storage_descriptor.0.schema_reference.0.schema_id.0.schema_arn"},
+												},
+											},
+										},
+									},
+									"schema_version_id": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"storage_descriptor.0.schema_reference.0.schema_id"},
+									},
+									"schema_version_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 100000),
+									},
+								},
+							},
+						},
+						"ser_de_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"serialization_library": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"skewed_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"skewed_column_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"skewed_column_value_location_maps": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"skewed_column_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"sort_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"sort_order": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1),
+									},
+								},
+							},
+						},
+						"stored_as_sub_directories": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"table_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view_expanded_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view_original_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCatalogTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	name := d.Get("name").(string)
+
+	input := &glue.CreateTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableInput:   expandGlueTableInput(d),
+	}
+
+	if v, ok := d.GetOk("partition_index"); ok {
+		input.PartitionIndexes = expandGluePartitionIndexes(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Catalog Table: %s", input)
+	_, err := conn.CreateTable(input)
+	if err != nil {
+		return fmt.Errorf("error creating Glue Catalog Table (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", catalogID, dbName, name))
+
+	return resourceAwsGlueCatalogTableRead(d, meta)
+}
+
+func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetTable(&glue.GetTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		Name:         aws.String(name),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Catalog Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	table := output.Table
+	if table == nil {
+		log.Printf("[WARN] Glue Catalog Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tableARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "glue", catalogID, fmt.Sprintf("table/%s/%s", dbName, name))
+	d.Set("arn", tableARN)
+	d.Set("catalog_id", catalogID)
+	d.Set("database_name", dbName)
+	d.Set("description", table.Description)
+	d.Set("name", table.Name)
+	d.Set("owner", table.Owner)
+	d.Set("retention", table.Retention)
+	d.Set("table_type", table.TableType)
+	d.Set("view_expanded_text", table.ViewExpandedText)
+	d.Set("view_original_text", table.ViewOriginalText)
+
+	if err := d.Set("parameters", aws.StringValueMap(table.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("partition_keys", flattenGlueColumns(table.PartitionKeys)); err != nil {
+		return fmt.Errorf("error setting partition_keys: %s", err)
+	}
+
+	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(table.StorageDescriptor)); err != nil {
+		return fmt.Errorf("error setting storage_descriptor: %s", err)
+	}
+
+	var partitionIndexes []*glue.PartitionIndexDescriptor
+	err = conn.GetPartitionIndexesPages(&glue.GetPartitionIndexesInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(name),
+	}, func(page *glue.GetPartitionIndexesOutput, lastPage bool) bool {
+		partitionIndexes = append(partitionIndexes, page.PartitionIndexDescriptorList...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing partition indexes for Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("partition_index", flattenGluePartitionIndexDescriptors(partitionIndexes)); err != nil {
+		return fmt.Errorf("error setting partition_index: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, _, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableInput:   expandGlueTableInput(d),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Catalog Table: %s", input)
+	if _, err := conn.UpdateTable(input); err != nil {
+		return fmt.Errorf("error updating Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCatalogTableRead(d, meta)
+}
+
+func resourceAwsGlueCatalogTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Glue Catalog Table: %s", d.Id())
+	_, err = conn.DeleteTable(&glue.DeleteTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		Name:         aws.String(name),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCatalogTableCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	var partitionKeys []string
+	for _, raw := range diff.Get("partition_keys").([]interface{}) {
+		if m, ok := raw.(map[string]interface{}); ok {
+			partitionKeys = append(partitionKeys, m["name"].(string))
+		}
+	}
+
+	return validateGlueCatalogTablePartitionProjection(diff.Get("parameters").(map[string]interface{}), partitionKeys)
+}
+
+// validateGlueCatalogTablePartitionProjection checks the Athena partition projection
+// table properties, which Glue itself stores without validation, so that mistakes
+// surface at plan time instead of as failing queries.
+func validateGlueCatalogTablePartitionProjection(parameters map[string]interface{}, partitionKeys []string) error {
+	enabled, ok := parameters["projection.enabled"]
+	if !ok {
+		return nil
+	}
+	switch strings.ToLower(enabled.(string)) {
+	case "true":
+	case "false":
+		return nil
+	default:
+		return fmt.Errorf("parameter \"projection.enabled\" must be \"true\" or \"false\", got %q", enabled)
+	}
+
+	isPartitionKey := make(map[string]bool, len(partitionKeys))
+	for _, key := range partitionKeys {
+		isPartitionKey[key] = true
+	}
+
+	var errors []string
+	for k := range parameters {
+		if !strings.HasPrefix(k, "projection.") || k == "projection.enabled" {
+			continue
+		}
+		column := strings.TrimPrefix(k, "projection.")
+		if i := strings.LastIndex(column, "."); i > 0 {
+			column = column[:i]
+		}
+		if !isPartitionKey[column] {
+			errors = append(errors, fmt.Sprintf("parameter %q configures projection for %q, which is not a partition key", k, column))
+		}
+	}
+
+	for _, key := range partitionKeys {
+		prefix := fmt.Sprintf("projection.%s.", key)
+		get := func(suffix string) string {
+			if v, ok := parameters[prefix+suffix]; ok {
+				return v.(string)
+			}
+			return ""
+		}
+
+		projectionType := get("type")
+		switch projectionType {
+		case "":
+			errors = append(errors, fmt.Sprintf("parameter %q is required when partition projection is enabled", prefix+"type"))
+		case "enum":
+			if get("values") == "" {
+				errors = append(errors, fmt.Sprintf("parameter %q is required for enum projection", prefix+"values"))
+			}
+		case "integer":
+			r := strings.Split(get("range"), ",")
+			if len(r) != 2 {
+				errors = append(errors, fmt.Sprintf("parameter %q must be two comma separated integers for integer projection", prefix+"range"))
+				break
+			}
+			for _, bound := range r {
+				if _, err := strconv.ParseInt(strings.TrimSpace(bound), 10, 64); err != nil {
+					errors = append(errors, fmt.Sprintf("parameter %q must be two comma separated integers for integer projection", prefix+"range"))
+					break
+				}
+			}
+		case "date":
+			if len(strings.Split(get("range"), ",")) != 2 {
+				errors = append(errors, fmt.Sprintf("parameter %q must be two comma separated dates for date projection", prefix+"range"))
+			}
+			if get("format") == "" {
+				errors = append(errors, fmt.Sprintf("parameter %q is required for date projection", prefix+"format"))
+			}
+		case "injected":
+		default:
+			errors = append(errors, fmt.Sprintf("parameter %q must be one of enum, integer, date or injected, got %q", prefix+"type", projectionType))
+		}
+	}
+
+	if v, ok := parameters["storage.location.template"]; ok {
+		for _, key := range partitionKeys {
+			if !strings.Contains(v.(string), fmt.Sprintf("${%s}", key)) {
+				errors = append(errors, fmt.Sprintf("parameter \"storage.location.template\" must contain a ${%s} placeholder", key))
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		sort.Strings(errors)
+		return fmt.Errorf("invalid partition projection configuration:\n%s", strings.Join(errors, "\n"))
+	}
+
+	return nil
+}
+
+func readAwsGlueCatalogTableID(id string) (catalogID string, dbName string, name string, err error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("expected ID in format catalog-id:database-name:table-name, received: %s", id)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+func expandGlueTableInput(d *schema.ResourceData) *glue.TableInput {
+	tableInput := &glue.TableInput{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		tableInput.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("owner"); ok {
+		tableInput.Owner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retention"); ok {
+		tableInput.Retention = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("storage_descriptor"); ok {
+		tableInput.StorageDescriptor = expandGlueStorageDescriptor(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("partition_keys"); ok {
+		tableInput.PartitionKeys = expandGlueColumns(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("view_original_text"); ok {
+		tableInput.ViewOriginalText = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("view_expanded_text"); ok {
+		tableInput.ViewExpandedText = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_type"); ok {
+		tableInput.TableType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		tableInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	return tableInput
+}
+
+func expandGlueStorageDescriptor(l []interface{}) *glue.StorageDescriptor {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	storageDescriptor := &glue.StorageDescriptor{
+		Compressed:             aws.Bool(m["compressed"].(bool)),
+		StoredAsSubDirectories: aws.Bool(m["stored_as_sub_directories"].(bool)),
+	}
+
+	if v, ok := m["columns"].([]interface{}); ok && len(v) > 0 {
+		storageDescriptor.Columns = expandGlueColumns(v)
+	}
+
+	if v, ok := m["location"].(string); ok && v != "" {
+		storageDescriptor.Location = aws.String(v)
+	}
+
+	if v, ok := m["input_format"].(string); ok && v != "" {
+		storageDescriptor.InputFormat = aws.String(v)
+	}
+
+	if v, ok := m["output_format"].(string); ok && v != "" {
+		storageDescriptor.OutputFormat = aws.String(v)
+	}
+
+	if v, ok := m["number_of_buckets"].(int); ok && v != 0 {
+		storageDescriptor.NumberOfBuckets = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["ser_de_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sm := v[0].(map[string]interface{})
+		serDeInfo := &glue.SerDeInfo{}
+		if v, ok := sm["name"].(string); ok && v != "" {
+			serDeInfo.Name = aws.String(v)
+		}
+		if v, ok := sm["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			serDeInfo.Parameters = stringMapToPointers(v)
+		}
+		if v, ok := sm["serialization_library"].(string); ok && v != "" {
+			serDeInfo.SerializationLibrary = aws.String(v)
+		}
+		storageDescriptor.SerdeInfo = serDeInfo
+	}
+
+	if v, ok := m["bucket_columns"].([]interface{}); ok && len(v) > 0 {
+		storageDescriptor.BucketColumns = expandStringList(v)
+	}
+
+	if v, ok := m["sort_columns"].([]interface{}); ok && len(v) > 0 {
+		for _, raw := range v {
+			om := raw.(map[string]interface{})
+			storageDescriptor.SortColumns = append(storageDescriptor.SortColumns, &glue.Order{
+				Column:    aws.String(om["column"].(string)),
+				SortOrder: aws.Int64(int64(om["sort_order"].(int))),
+			})
+		}
+	}
+
+	if v, ok := m["skewed_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sm := v[0].(map[string]interface{})
+		storageDescriptor.SkewedInfo = &glue.SkewedInfo{
+			SkewedColumnNames:             expandStringList(sm["skewed_column_names"].([]interface{})),
+			SkewedColumnValueLocationMaps: stringMapToPointers(sm["skewed_column_value_location_maps"].(map[string]interface{})),
+			SkewedColumnValues:            expandStringList(sm["skewed_column_values"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		storageDescriptor.Parameters = stringMapToPointers(v)
+	}
+
+	if v, ok := m["schema_reference"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		storageDescriptor.SchemaReference = expandGlueSchemaReference(v[0].(map[string]interface{}))
+	}
+
+	return storageDescriptor
+}
+
+func expandGlueSchemaReference(m map[string]interface{}) *glue.SchemaReference {
+	schemaReference := &glue.SchemaReference{
+		SchemaVersionNumber: aws.Int64(int64(m["schema_version_number"].(int))),
+	}
+
+	if v, ok := m["schema_version_id"].(string); ok && v != "" {
+		schemaReference.SchemaVersionId = aws.String(v)
+	}
+
+	if v, ok := m["schema_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		im := v[0].(map[string]interface{})
+		schemaID := &glue.SchemaId{}
+		if v, ok := im["registry_name"].(string); ok && v != "" {
+			schemaID.RegistryName = aws.String(v)
+		}
+		if v, ok := im["schema_arn"].(string); ok && v != "" {
+			schemaID.SchemaArn = aws.String(v)
+		}
+		if v, ok := im["schema_name"].(string); ok && v != "" {
+			schemaID.SchemaName = aws.String(v)
+		}
+		schemaReference.SchemaId = schemaID
+	}
+
+	return schemaReference
+}
+
+func expandGlueColumns(l []interface{}) []*glue.Column {
+	columns := make([]*glue.Column, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		column := &glue.Column{
+			Name: aws.String(m["name"].(string)),
+		}
+
+		if v, ok := m["comment"].(string); ok && v != "" {
+			column.Comment = aws.String(v)
+		}
+		if v, ok := m["type"].(string); ok && v != "" {
+			column.Type = aws.String(v)
+		}
+		if v, ok := m["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			column.Parameters = stringMapToPointers(v)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+func expandGluePartitionIndexes(l []interface{}) []*glue.PartitionIndex {
+	partitionIndexes := make([]*glue.PartitionIndex, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		partitionIndexes = append(partitionIndexes, &glue.PartitionIndex{
+			IndexName: aws.String(m["index_name"].(string)),
+			Keys:      expandStringList(m["keys"].([]interface{})),
+		})
+	}
+
+	return partitionIndexes
+}
+
+func flattenGlueStorageDescriptor(storageDescriptor *glue.StorageDescriptor) []interface{} {
+	if storageDescriptor == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket_columns":            flattenStringList(storageDescriptor.BucketColumns),
+		"columns":                   flattenGlueColumns(storageDescriptor.Columns),
+		"compressed":                aws.BoolValue(storageDescriptor.Compressed),
+		"input_format":              aws.StringValue(storageDescriptor.InputFormat),
+		"location":                  aws.StringValue(storageDescriptor.Location),
+		"number_of_buckets":         int(aws.Int64Value(storageDescriptor.NumberOfBuckets)),
+		"output_format":             aws.StringValue(storageDescriptor.OutputFormat),
+		"parameters":                aws.StringValueMap(storageDescriptor.Parameters),
+		"schema_reference":          []interface{}{},
+		"ser_de_info":               []interface{}{},
+		"skewed_info":               []interface{}{},
+		"sort_columns":              []interface{}{},
+		"stored_as_sub_directories": aws.BoolValue(storageDescriptor.StoredAsSubDirectories),
+	}
+
+	if v := storageDescriptor.SchemaReference; v != nil {
+		sm := map[string]interface{}{
+			"schema_id":             []interface{}{},
+			"schema_version_id":     aws.StringValue(v.SchemaVersionId),
+			"schema_version_number": int(aws.Int64Value(v.SchemaVersionNumber)),
+		}
+		if id := v.SchemaId; id != nil {
+			sm["schema_id"] = []interface{}{map[string]interface{}{
+				"registry_name": aws.StringValue(id.RegistryName),
+				"schema_arn":    aws.StringValue(id.SchemaArn),
+				"schema_name":   aws.StringValue(id.SchemaName),
+			}}
+		}
+		m["schema_reference"] = []interface{}{sm}
+	}
+
+	if v := storageDescriptor.SerdeInfo; v != nil {
+		m["ser_de_info"] = []interface{}{map[string]interface{}{
+			"name":                  aws.StringValue(v.Name),
+			"parameters":            aws.StringValueMap(v.Parameters),
+			"serialization_library": aws.StringValue(v.SerializationLibrary),
+		}}
+	}
+
+	if v := storageDescriptor.SkewedInfo; v != nil {
+		m["skewed_info"] = []interface{}{map[string]interface{}{
+			"skewed_column_names":               flattenStringList(v.SkewedColumnNames),
+			"skewed_column_value_location_maps": aws.StringValueMap(v.SkewedColumnValueLocationMaps),
+			"skewed_column_values":              flattenStringList(v.SkewedColumnValues),
+		}}
+	}
+
+	sortColumns := make([]interface{}, 0, len(storageDescriptor.SortColumns))
+	for _, order := range storageDescriptor.SortColumns {
+		sortColumns = append(sortColumns, map[string]interface{}{
+			"column":     aws.StringValue(order.Column),
+			"sort_order": int(aws.Int64Value(order.SortOrder)),
+		})
+	}
+	m["sort_columns"] = sortColumns
+
+	return []interface{}{m}
+}
+
+func flattenGlueColumns(columns []*glue.Column) []interface{} {
+	result := make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		result = append(result, map[string]interface{}{
+			"comment":    aws.StringValue(column.Comment),
+			"name":       aws.StringValue(column.Name),
+			"parameters": aws.StringValueMap(column.Parameters),
+			"type":       aws.StringValue(column.Type),
+		})
+	}
+
+	return result
+}
+
+func flattenGluePartitionIndexDescriptors(partitionIndexes []*glue.PartitionIndexDescriptor) []interface{} {
+	result := make([]interface{}, 0, len(partitionIndexes))
+
+	for _, partitionIndex := range partitionIndexes {
+		keys := make([]interface{}, 0, len(partitionIndex.Keys))
+		for _, key := range partitionIndex.Keys {
+			keys = append(keys, aws.StringValue(key.Name))
+		}
+
+		result = append(result, map[string]interface{}{
+			"index_name":   aws.StringValue(partitionIndex.IndexName),
+			"index_status": aws.StringValue(partitionIndex.IndexStatus),
+			"keys":         keys,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateGlueCatalogTablePartitionProjection(t *testing.T) {
+	cases := []struct {
+		Parameters    map[string]interface{}
+		PartitionKeys []string
+		ErrContains   string
+	}{
+		{
+			Parameters:    map[string]interface{}{"classification": "parquet"},
+			PartitionKeys: []string{"dt"},
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled": "false",
+			},
+			PartitionKeys: []string{"dt"},
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":        "true",
+				"projection.dt.type":        "date",
+				"projection.dt.range":       "2020-01-01,NOW",
+				"projection.dt.format":      "yyyy-MM-dd",
+				"projection.region.type":    "enum",
+				"projection.region.values":  "us-east-1,us-west-2",
+				"projection.shard.type":     "integer",
+				"projection.shard.range":    "0,15",
+				"projection.account.type":   "injected",
+				"storage.location.template": "s3://bucket/${account}/${region}/${shard}/${dt}/",
+			},
+			PartitionKeys: []string{"dt", "region", "shard", "account"},
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled": "yes",
+			},
+			PartitionKeys: []string{"dt"},
+			ErrContains:   `must be "true" or "false"`,
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled": "true",
+			},
+			PartitionKeys: []string{"dt"},
+			ErrContains:   `"projection.dt.type" is required`,
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled": "true",
+				"projection.dt.type": "timestamp",
+			},
+			PartitionKeys: []string{"dt"},
+			ErrContains:   "must be one of enum, integer, date or injected",
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":   "true",
+				"projection.dt.type":   "date",
+				"projection.dt.range":  "2020-01-01",
+				"projection.dt.format": "",
+			},
+			PartitionKeys: []string{"dt"},
+			ErrContains:   `"projection.dt.format" is required`,
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":     "true",
+				"projection.shard.type":  "integer",
+				"projection.shard.range": "0,ten",
+			},
+			PartitionKeys: []string{"shard"},
+			ErrContains:   "two comma separated integers",
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":     "true",
+				"projection.region.type": "enum",
+			},
+			PartitionKeys: []string{"region"},
+			ErrContains:   `"projection.region.values" is required`,
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":      "true",
+				"projection.region.type":  "injected",
+				"projection.missing.type": "injected",
+			},
+			PartitionKeys: []string{"region"},
+			ErrContains:   "which is not a partition key",
+		},
+		{
+			Parameters: map[string]interface{}{
+				"projection.enabled":        "true",
+				"projection.region.type":    "injected",
+				"storage.location.template": "s3://bucket/data/",
+			},
+			PartitionKeys: []string{"region"},
+			ErrContains:   "must contain a ${region} placeholder",
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateGlueCatalogTablePartitionProjection(tc.Parameters, tc.PartitionKeys)
+		if tc.ErrContains == "" {
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("case %d: expected error containing %q, got none", i, tc.ErrContains)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.ErrContains) {
+			t.Errorf("case %d: expected error containing %q, got: %s", i, tc.ErrContains, err)
+		}
+	}
+}
+
+func TestAccAWSGlueCatalogTable_basic(t *testing.T) {
+	resourceName := "aws_glue_catalog_table.test"
+	rName := strings.Replace(acctest.RandomWithPrefix("tf_acc_test"), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfig_basic(rName, "First table"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:glue:[^:]+:\d{12}:table/.+/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "description", "First table"),
+					resource.TestCheckResourceAttr(resourceName, "table_type", "EXTERNAL_TABLE"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.serialization_library", "org.apache.hadoop.hive.ql.io.parquet.serde.ParquetHiveSerDe"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.name", "dt"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlueCatalogTableConfig_basic(rName, "Second table"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second table"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCatalogTable_partitionProjection(t *testing.T) {
+	resourceName := "aws_glue_catalog_table.test"
+	rName := strings.Replace(acctest.RandomWithPrefix("tf_acc_test"), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGlueCatalogTableConfig_partitionProjection(rName, ""),
+				ExpectError: regexp.MustCompile(`"projection.dt.format" is required for date projection`),
+			},
+			{
+				Config: testAccGlueCatalogTableConfig_partitionProjection(rName, "yyyy-MM-dd"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.projection.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.projection.dt.format", "yyyy-MM-dd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCatalogTable_partitionIndex(t *testing.T) {
+	resourceName := "aws_glue_catalog_table.test"
+	rName := strings.Replace(acctest.RandomWithPrefix("tf_acc_test"), "-", "_", -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfig_partitionIndex(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_index.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_index.0.index_name", "by_dt"),
+					resource.TestCheckResourceAttr(resourceName, "partition_index.0.keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_index.0.keys.0", "dt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCatalogTableExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Catalog Table ID is set")
+		}
+
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Table == nil {
+			return fmt.Errorf("Glue Catalog Table (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlueCatalogTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_table" {
+			continue
+		}
+
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Table (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGlueCatalogTableConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  description   = %[2]q
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  parameters {
+    classification = "parquet"
+  }
+
+  partition_keys {
+    name = "dt"
+    type = "string"
+  }
+
+  storage_descriptor {
+    input_format  = "org.apache.hadoop.hive.ql.io.parquet.MapredParquetInputFormat"
+    location      = "s3://example-bucket/%[1]s/"
+    output_format = "org.apache.hadoop.hive.ql.io.parquet.MapredParquetOutputFormat"
+
+    columns {
+      name = "id"
+      type = "bigint"
+    }
+
+    columns {
+      comment = "Event payload"
+      name    = "payload"
+      type    = "string"
+    }
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.ql.io.parquet.serde.ParquetHiveSerDe"
+
+      parameters {
+        "serialization.format" = 1
+      }
+    }
+  }
+}
+`, rName, description)
+}
+
+func testAccGlueCatalogTableConfig_partitionProjection(rName, dateFormat string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  parameters {
+    "projection.enabled"        = "true"
+    "projection.dt.type"        = "date"
+    "projection.dt.range"       = "2020-01-01,NOW"
+    "projection.dt.format"      = %[2]q
+    "storage.location.template" = "s3://example-bucket/%[1]s/$${dt}/"
+  }
+
+  partition_keys {
+    name = "dt"
+    type = "string"
+  }
+
+  storage_descriptor {
+    location = "s3://example-bucket/%[1]s/"
+
+    columns {
+      name = "id"
+      type = "bigint"
+    }
+  }
+}
+`, rName, dateFormat)
+}
+
+func testAccGlueCatalogTableConfig_partitionIndex(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  partition_keys {
+    name = "dt"
+    type = "string"
+  }
+
+  partition_index {
+    index_name = "by_dt"
+    keys       = ["dt"]
+  }
+
+  storage_descriptor {
+    location = "s3://example-bucket/%[1]s/"
+
+    columns {
+      name = "id"
+      type = "bigint"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,602 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCrawler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCrawlerCreate,
+		Read:   resourceAwsGlueCrawlerRead,
+		Update: resourceAwsGlueCrawlerUpdate,
+		Delete: resourceAwsGlueCrawlerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(2048),
+			},
+			"classifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"security_configuration": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"table_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"s3_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"connection_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sample_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 249),
+						},
+					},
+				},
+			},
+			"jdbc_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"dynamodb_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"scan_all": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"scan_rate": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"catalog_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"tables": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"schema_change_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "1" && new == "0"
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_behavior": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      glue.DeleteBehaviorDeprecateInDatabase,
+							ValidateFunc: validation.StringInSlice(glue.DeleteBehavior_Values(), false),
+						},
+						"update_behavior": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      glue.UpdateBehaviorUpdateInDatabase,
+							ValidateFunc: validation.StringInSlice(glue.UpdateBehavior_Values(), false),
+						},
+					},
+				},
+			},
+			"recrawl_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "1" && new == "0"
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recrawl_behavior": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      glue.RecrawlBehaviorCrawlEverything,
+							ValidateFunc: validation.StringInSlice(glue.RecrawlBehavior_Values(), false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	name := d.Get("name").(string)
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return err
+	}
+
+	input := &glue.CreateCrawlerInput{
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		Name:         aws.String(name),
+		Role:         aws.String(d.Get("role").(string)),
+		Targets:      targets,
+	}
+
+	if v, ok := d.GetOk("classifiers"); ok {
+		input.Classifiers = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recrawl_policy"); ok {
+		input.RecrawlPolicy = expandGlueCrawlerRecrawlPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schema_change_policy"); ok {
+		input.SchemaChangePolicy = expandGlueCrawlerSchemaChangePolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("security_configuration"); ok {
+		input.CrawlerSecurityConfiguration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_prefix"); ok {
+		input.TablePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Crawler: %s", input)
+	// IAM roles can take some time to propagate
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateCrawler(input)
+		if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Glue Crawler (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	crawler := output.Crawler
+	if crawler == nil {
+		log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	crawlerARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "glue", meta.(*AWSClient).accountid, fmt.Sprintf("crawler/%s", d.Id()))
+	d.Set("arn", crawlerARN)
+	d.Set("name", crawler.Name)
+	d.Set("database_name", crawler.DatabaseName)
+	d.Set("role", crawler.Role)
+	d.Set("description", crawler.Description)
+	d.Set("security_configuration", crawler.CrawlerSecurityConfiguration)
+	d.Set("table_prefix", crawler.TablePrefix)
+
+	if err := d.Set("classifiers", flattenStringList(crawler.Classifiers)); err != nil {
+		return fmt.Errorf("error setting classifiers: %s", err)
+	}
+
+	if crawler.Configuration != nil {
+		configuration, err := structure.NormalizeJsonString(aws.StringValue(crawler.Configuration))
+		if err != nil {
+			return fmt.Errorf("configuration contains an invalid JSON: %s", err)
+		}
+		d.Set("configuration", configuration)
+	} else {
+		d.Set("configuration", "")
+	}
+
+	if crawler.Schedule != nil {
+		d.Set("schedule", crawler.Schedule.ScheduleExpression)
+	} else {
+		d.Set("schedule", "")
+	}
+
+	if err := d.Set("recrawl_policy", flattenGlueCrawlerRecrawlPolicy(crawler.RecrawlPolicy)); err != nil {
+		return fmt.Errorf("error setting recrawl_policy: %s", err)
+	}
+
+	if err := d.Set("schema_change_policy", flattenGlueCrawlerSchemaChangePolicy(crawler.SchemaChangePolicy)); err != nil {
+		return fmt.Errorf("error setting schema_change_policy: %s", err)
+	}
+
+	targets := crawler.Targets
+	if targets == nil {
+		targets = &glue.CrawlerTargets{}
+	}
+
+	if err := d.Set("s3_target", flattenGlueS3Targets(targets.S3Targets)); err != nil {
+		return fmt.Errorf("error setting s3_target: %s", err)
+	}
+
+	if err := d.Set("jdbc_target", flattenGlueJdbcTargets(targets.JdbcTargets)); err != nil {
+		return fmt.Errorf("error setting jdbc_target: %s", err)
+	}
+
+	if err := d.Set("dynamodb_target", flattenGlueDynamoDBTargets(targets.DynamoDBTargets)); err != nil {
+		return fmt.Errorf("error setting dynamodb_target: %s", err)
+	}
+
+	if err := d.Set("catalog_target", flattenGlueCatalogTargets(targets.CatalogTargets)); err != nil {
+		return fmt.Errorf("error setting catalog_target: %s", err)
+	}
+
+	tags, err := getTagsGlue(conn, crawlerARN)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Crawler (%s): %s", crawlerARN, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateCrawlerInput{
+		Classifiers:                  expandStringList(d.Get("classifiers").([]interface{})),
+		CrawlerSecurityConfiguration: aws.String(d.Get("security_configuration").(string)),
+		DatabaseName:                 aws.String(d.Get("database_name").(string)),
+		Description:                  aws.String(d.Get("description").(string)),
+		Name:                         aws.String(d.Id()),
+		Role:                         aws.String(d.Get("role").(string)),
+		Schedule:                     aws.String(d.Get("schedule").(string)),
+		TablePrefix:                  aws.String(d.Get("table_prefix").(string)),
+		Targets:                      targets,
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recrawl_policy"); ok {
+		input.RecrawlPolicy = expandGlueCrawlerRecrawlPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("schema_change_policy"); ok {
+		input.SchemaChangePolicy = expandGlueCrawlerSchemaChangePolicy(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Glue Crawler: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.UpdateCrawler(input)
+		if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsGlue(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Glue Crawler (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[INFO] Deleting Glue Crawler: %s", d.Id())
+	_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueCrawlerTargets(d *schema.ResourceData) (*glue.CrawlerTargets, error) {
+	targets := &glue.CrawlerTargets{
+		CatalogTargets:  []*glue.CatalogTarget{},
+		DynamoDBTargets: []*glue.DynamoDBTarget{},
+		JdbcTargets:     []*glue.JdbcTarget{},
+		S3Targets:       []*glue.S3Target{},
+	}
+
+	for _, raw := range d.Get("s3_target").([]interface{}) {
+		m := raw.(map[string]interface{})
+		target := &glue.S3Target{
+			Exclusions: expandStringList(m["exclusions"].([]interface{})),
+			Path:       aws.String(m["path"].(string)),
+		}
+		if v, ok := m["connection_name"].(string); ok && v != "" {
+			target.ConnectionName = aws.String(v)
+		}
+		if v, ok := m["sample_size"].(int); ok && v != 0 {
+			target.SampleSize = aws.Int64(int64(v))
+		}
+		targets.S3Targets = append(targets.S3Targets, target)
+	}
+
+	for _, raw := range d.Get("jdbc_target").([]interface{}) {
+		m := raw.(map[string]interface{})
+		targets.JdbcTargets = append(targets.JdbcTargets, &glue.JdbcTarget{
+			ConnectionName: aws.String(m["connection_name"].(string)),
+			Exclusions:     expandStringList(m["exclusions"].([]interface{})),
+			Path:           aws.String(m["path"].(string)),
+		})
+	}
+
+	for _, raw := range d.Get("dynamodb_target").([]interface{}) {
+		m := raw.(map[string]interface{})
+		target := &glue.DynamoDBTarget{
+			Path:    aws.String(m["path"].(string)),
+			ScanAll: aws.Bool(m["scan_all"].(bool)),
+		}
+		if v, ok := m["scan_rate"].(float64); ok && v != 0 {
+			target.ScanRate = aws.Float64(v)
+		}
+		targets.DynamoDBTargets = append(targets.DynamoDBTargets, target)
+	}
+
+	for _, raw := range d.Get("catalog_target").([]interface{}) {
+		m := raw.(map[string]interface{})
+		targets.CatalogTargets = append(targets.CatalogTargets, &glue.CatalogTarget{
+			DatabaseName: aws.String(m["database_name"].(string)),
+			Tables:       expandStringList(m["tables"].([]interface{})),
+		})
+	}
+
+	if len(targets.S3Targets) == 0 && len(targets.JdbcTargets) == 0 && len(targets.DynamoDBTargets) == 0 && len(targets.CatalogTargets) == 0 {
+		return nil, fmt.Errorf("At least one of s3_target, jdbc_target, dynamodb_target or catalog_target must be set")
+	}
+
+	return targets, nil
+}
+
+func flattenGlueS3Targets(targets []*glue.S3Target) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, map[string]interface{}{
+			"connection_name": aws.StringValue(target.ConnectionName),
+			"exclusions":      flattenStringList(target.Exclusions),
+			"path":            aws.StringValue(target.Path),
+			"sample_size":     int(aws.Int64Value(target.SampleSize)),
+		})
+	}
+	return result
+}
+
+func flattenGlueJdbcTargets(targets []*glue.JdbcTarget) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, map[string]interface{}{
+			"connection_name": aws.StringValue(target.ConnectionName),
+			"exclusions":      flattenStringList(target.Exclusions),
+			"path":            aws.StringValue(target.Path),
+		})
+	}
+	return result
+}
+
+func flattenGlueDynamoDBTargets(targets []*glue.DynamoDBTarget) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, map[string]interface{}{
+			"path":      aws.StringValue(target.Path),
+			"scan_all":  aws.BoolValue(target.ScanAll),
+			"scan_rate": aws.Float64Value(target.ScanRate),
+		})
+	}
+	return result
+}
+
+func flattenGlueCatalogTargets(targets []*glue.CatalogTarget) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, map[string]interface{}{
+			"database_name": aws.StringValue(target.DatabaseName),
+			"tables":        flattenStringList(target.Tables),
+		})
+	}
+	return result
+}
+
+func expandGlueCrawlerSchemaChangePolicy(l []interface{}) *glue.SchemaChangePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.SchemaChangePolicy{
+		DeleteBehavior: aws.String(m["delete_behavior"].(string)),
+		UpdateBehavior: aws.String(m["update_behavior"].(string)),
+	}
+}
+
+func flattenGlueCrawlerSchemaChangePolicy(policy *glue.SchemaChangePolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"delete_behavior": aws.StringValue(policy.DeleteBehavior),
+		"update_behavior": aws.StringValue(policy.UpdateBehavior),
+	}
+
+	return []interface{}{m}
+}
+
+func expandGlueCrawlerRecrawlPolicy(l []interface{}) *glue.RecrawlPolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.RecrawlPolicy{
+		RecrawlBehavior: aws.String(m["recrawl_behavior"].(string)),
+	}
+}
+
+func flattenGlueCrawlerRecrawlPolicy(policy *glue.RecrawlPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"recrawl_behavior": aws.StringValue(policy.RecrawlBehavior),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCrawler_s3Target(t *testing.T) {
+	var crawler glue.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_s3Target(rName, "data/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:glue:[^:]+:\d{12}:crawler/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.exclusions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DEPRECATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "UPDATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_s3Target(rName, "other/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_scheduleAndPolicies(t *testing.T) {
+	var crawler glue.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_scheduleAndPolicies(rName, "cron(0 1 * * ? *)", "LOG"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 1 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "LOG"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "LOG"),
+					resource.TestCheckResourceAttr(resourceName, "table_prefix", "prefix_"),
+				),
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_scheduleAndPolicies(rName, "cron(0 2 * * ? *)", "DELETE_FROM_DATABASE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(0 2 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DELETE_FROM_DATABASE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_tags(t *testing.T) {
+	var crawler glue.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueCrawlerExists(n string, crawler *glue.Crawler) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Crawler ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Crawler == nil {
+			return fmt.Errorf("Glue Crawler (%s) not found", rs.Primary.ID)
+		}
+
+		*crawler = *output.Crawler
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueCrawlerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_crawler" {
+			continue
+		}
+
+		_, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Crawler (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSGlueCrawlerConfig_base(rName string) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "${replace(%[1]q, "-", "_")}"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAWSGlueCrawlerConfig_s3Target(rName, prefix string) string {
+	return testAccAWSGlueCrawlerConfig_base(rName) + fmt.Sprintf(`
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  role          = "${aws_iam_role.test.name}"
+
+  s3_target {
+    path = "s3://${aws_s3_bucket.test.bucket}/%[2]s"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, prefix)
+}
+
+func testAccAWSGlueCrawlerConfig_scheduleAndPolicies(rName, schedule, deleteBehavior string) string {
+	return testAccAWSGlueCrawlerConfig_base(rName) + fmt.Sprintf(`
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  role          = "${aws_iam_role.test.name}"
+  schedule      = %[2]q
+  table_prefix  = "prefix_"
+
+  s3_target {
+    path       = "s3://${aws_s3_bucket.test.bucket}"
+    exclusions = ["*.tmp"]
+  }
+
+  schema_change_policy {
+    delete_behavior = %[3]q
+    update_behavior = "LOG"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, schedule, deleteBehavior)
+}
+
+func testAccAWSGlueCrawlerConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSGlueCrawlerConfig_base(rName) + fmt.Sprintf(`
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  role          = "${aws_iam_role.test.name}"
+
+  s3_target {
+    path = "s3://${aws_s3_bucket.test.bucket}"
+  }
+
+  tags {
+    %[2]s = %[3]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,501 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueJobCreate,
+		Read:   resourceAwsGlueJobRead,
+		Update: resourceAwsGlueJobUpdate,
+		Delete: resourceAwsGlueJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"command": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "glueetl",
+							ValidateFunc: validation.StringInSlice([]string{"glueetl", "gluestreaming", "pythonshell"}, false),
+						},
+						"python_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"2", "3", "3.9"}, false),
+						},
+						"script_location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"non_overridable_arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(2048),
+			},
+			"execution_property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_runs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"glue_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"max_capacity": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"number_of_workers", "worker_type"},
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"notification_property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notify_delay_after": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"number_of_workers": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"max_capacity"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+			"worker_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"max_capacity"},
+				ValidateFunc:  validation.StringInSlice(glue.WorkerType_Values(), false),
+			},
+			"security_configuration": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	name := d.Get("name").(string)
+	input := &glue.CreateJobInput{
+		Command: expandGlueJobCommand(d.Get("command").([]interface{})),
+		Name:    aws.String(name),
+		Role:    aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("connections"); ok {
+		input.Connections = &glue.ConnectionsList{
+			Connections: expandStringList(v.([]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("default_arguments"); ok {
+		input.DefaultArguments = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("non_overridable_arguments"); ok {
+		input.NonOverridableArguments = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_property"); ok {
+		input.ExecutionProperty = expandGlueExecutionProperty(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("glue_version"); ok {
+		input.GlueVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_capacity"); ok {
+		input.MaxCapacity = aws.Float64(v.(float64))
+	}
+
+	if v, ok := d.GetOk("max_retries"); ok {
+		input.MaxRetries = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("notification_property"); ok {
+		input.NotificationProperty = expandGlueNotificationProperty(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_configuration"); ok {
+		input.SecurityConfiguration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		input.Timeout = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Job: %s", input)
+	_, err := conn.CreateJob(input)
+	if err != nil {
+		return fmt.Errorf("error creating Glue Job (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueJobRead(d, meta)
+}
+
+func resourceAwsGlueJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetJob(&glue.GetJobInput{
+		JobName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glue Job (%s): %s", d.Id(), err)
+	}
+
+	job := output.Job
+	if job == nil {
+		log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	jobARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "glue", meta.(*AWSClient).accountid, fmt.Sprintf("job/%s", d.Id()))
+	d.Set("arn", jobARN)
+
+	if err := d.Set("command", flattenGlueJobCommand(job.Command)); err != nil {
+		return fmt.Errorf("error setting command: %s", err)
+	}
+
+	var connections []*string
+	if job.Connections != nil {
+		connections = job.Connections.Connections
+	}
+	if err := d.Set("connections", flattenStringList(connections)); err != nil {
+		return fmt.Errorf("error setting connections: %s", err)
+	}
+
+	if err := d.Set("default_arguments", aws.StringValueMap(job.DefaultArguments)); err != nil {
+		return fmt.Errorf("error setting default_arguments: %s", err)
+	}
+
+	if err := d.Set("non_overridable_arguments", aws.StringValueMap(job.NonOverridableArguments)); err != nil {
+		return fmt.Errorf("error setting non_overridable_arguments: %s", err)
+	}
+
+	d.Set("description", job.Description)
+
+	if err := d.Set("execution_property", flattenGlueExecutionProperty(job.ExecutionProperty)); err != nil {
+		return fmt.Errorf("error setting execution_property: %s", err)
+	}
+
+	d.Set("glue_version", job.GlueVersion)
+	d.Set("max_capacity", aws.Float64Value(job.MaxCapacity))
+	d.Set("max_retries", job.MaxRetries)
+	d.Set("name", job.Name)
+
+	if err := d.Set("notification_property", flattenGlueNotificationProperty(job.NotificationProperty)); err != nil {
+		return fmt.Errorf("error setting notification_property: %s", err)
+	}
+
+	d.Set("number_of_workers", job.NumberOfWorkers)
+	d.Set("role_arn", job.Role)
+	d.Set("security_configuration", job.SecurityConfiguration)
+	d.Set("timeout", job.Timeout)
+	d.Set("worker_type", job.WorkerType)
+
+	tags, err := getTagsGlue(conn, jobARN)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Job (%s): %s", jobARN, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChange("command") || d.HasChange("connections") || d.HasChange("default_arguments") ||
+		d.HasChange("non_overridable_arguments") || d.HasChange("description") || d.HasChange("execution_property") ||
+		d.HasChange("glue_version") || d.HasChange("max_capacity") || d.HasChange("max_retries") ||
+		d.HasChange("notification_property") || d.HasChange("number_of_workers") || d.HasChange("role_arn") ||
+		d.HasChange("security_configuration") || d.HasChange("timeout") || d.HasChange("worker_type") {
+		// UpdateJob replaces the whole job definition, so every argument is sent.
+		jobUpdate := &glue.JobUpdate{
+			Command: expandGlueJobCommand(d.Get("command").([]interface{})),
+			Role:    aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("connections"); ok {
+			jobUpdate.Connections = &glue.ConnectionsList{
+				Connections: expandStringList(v.([]interface{})),
+			}
+		}
+
+		if v, ok := d.GetOk("default_arguments"); ok {
+			jobUpdate.DefaultArguments = stringMapToPointers(v.(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("non_overridable_arguments"); ok {
+			jobUpdate.NonOverridableArguments = stringMapToPointers(v.(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			jobUpdate.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("execution_property"); ok {
+			jobUpdate.ExecutionProperty = expandGlueExecutionProperty(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("glue_version"); ok {
+			jobUpdate.GlueVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("number_of_workers"); ok {
+			jobUpdate.NumberOfWorkers = aws.Int64(int64(v.(int)))
+		} else if v, ok := d.GetOk("max_capacity"); ok {
+			jobUpdate.MaxCapacity = aws.Float64(v.(float64))
+		}
+
+		if v, ok := d.GetOk("max_retries"); ok {
+			jobUpdate.MaxRetries = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("notification_property"); ok {
+			jobUpdate.NotificationProperty = expandGlueNotificationProperty(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("worker_type"); ok {
+			jobUpdate.WorkerType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("security_configuration"); ok {
+			jobUpdate.SecurityConfiguration = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("timeout"); ok {
+			jobUpdate.Timeout = aws.Int64(int64(v.(int)))
+		}
+
+		input := &glue.UpdateJobInput{
+			JobName:   aws.String(d.Id()),
+			JobUpdate: jobUpdate,
+		}
+
+		log.Printf("[DEBUG] Updating Glue Job: %s", input)
+		_, err := conn.UpdateJob(input)
+		if err != nil {
+			return fmt.Errorf("error updating Glue Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsGlue(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Glue Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueJobRead(d, meta)
+}
+
+func resourceAwsGlueJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[INFO] Deleting Glue Job: %s", d.Id())
+	_, err := conn.DeleteJob(&glue.DeleteJobInput{
+		JobName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Job (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueJobCommand(l []interface{}) *glue.JobCommand {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	command := &glue.JobCommand{
+		Name:           aws.String(m["name"].(string)),
+		ScriptLocation: aws.String(m["script_location"].(string)),
+	}
+
+	if v, ok := m["python_version"].(string); ok && v != "" {
+		command.PythonVersion = aws.String(v)
+	}
+
+	return command
+}
+
+func flattenGlueJobCommand(command *glue.JobCommand) []interface{} {
+	if command == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"name":            aws.StringValue(command.Name),
+		"python_version":  aws.StringValue(command.PythonVersion),
+		"script_location": aws.StringValue(command.ScriptLocation),
+	}
+
+	return []interface{}{m}
+}
+
+func expandGlueExecutionProperty(l []interface{}) *glue.ExecutionProperty {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.ExecutionProperty{
+		MaxConcurrentRuns: aws.Int64(int64(m["max_concurrent_runs"].(int))),
+	}
+}
+
+func flattenGlueExecutionProperty(executionProperty *glue.ExecutionProperty) []interface{} {
+	if executionProperty == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_concurrent_runs": int(aws.Int64Value(executionProperty.MaxConcurrentRuns)),
+	}
+
+	return []interface{}{m}
+}
+
+func expandGlueNotificationProperty(l []interface{}) *glue.NotificationProperty {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	notificationProperty := &glue.NotificationProperty{}
+
+	if v, ok := m["notify_delay_after"].(int); ok && v != 0 {
+		notificationProperty.NotifyDelayAfter = aws.Int64(int64(v))
+	}
+
+	return notificationProperty
+}
+
+func flattenGlueNotificationProperty(notificationProperty *glue.NotificationProperty) []interface{} {
+	if notificationProperty == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"notify_delay_after": int(aws.Int64Value(notificationProperty.NotifyDelayAfter)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueJob_basic(t *testing.T) {
+	var job glue.Job
+	resourceName := "aws_glue_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_basic(rName, "Test job"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:glue:[^:]+:\d{12}:job/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test job"),
+					resource.TestCheckResourceAttr(resourceName, "command.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "glueetl"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.script_location", "testscriptlocation"),
+					resource.TestCheckResourceAttr(resourceName, "default_arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_arguments.--job-language", "python"),
+					resource.TestCheckResourceAttr(resourceName, "execution_property.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_property.0.max_concurrent_runs", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueJobConfig_basic(rName, "Updated test job"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated test job"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueJob_pythonShell(t *testing.T) {
+	var job glue.Job
+	resourceName := "aws_glue_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_pythonShell(rName, "0.0625"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "pythonshell"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.python_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "0.0625"),
+				),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_pythonShell(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueJob_workerType(t *testing.T) {
+	var job glue.Job
+	resourceName := "aws_glue_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_workerType(rName, "G.1X", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.1X"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "glue_version", "4.0"),
+				),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_workerType(rName, "G.2X", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.2X"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueJob_tags(t *testing.T) {
+	var job glue.Job
+	resourceName := "aws_glue_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueJobConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSGlueJobConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueJobExists(n string, job *glue.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetJob(&glue.GetJobInput{
+			JobName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Job == nil {
+			return fmt.Errorf("Glue Job (%s) not found", rs.Primary.ID)
+		}
+
+		*job = *output.Job
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_job" {
+			continue
+		}
+
+		_, err := conn.GetJob(&glue.GetJobInput{
+			JobName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Job (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSGlueConfig_role(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "glue.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = "${aws_iam_role.test.name}"
+}
+`, rName)
+}
+
+func testAccAWSGlueJobConfig_basic(rName, description string) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  default_arguments {
+    "--job-language" = "python"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, description)
+}
+
+func testAccAWSGlueJobConfig_pythonShell(rName, maxCapacity string) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name         = %[1]q
+  max_capacity = %[2]s
+  role_arn     = "${aws_iam_role.test.arn}"
+
+  command {
+    name            = "pythonshell"
+    python_version  = "3"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, maxCapacity)
+}
+
+func testAccAWSGlueJobConfig_workerType(rName, workerType string, numberOfWorkers int) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name              = %[1]q
+  glue_version      = "4.0"
+  role_arn          = "${aws_iam_role.test.arn}"
+  worker_type       = %[2]q
+  number_of_workers = %[3]d
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, workerType, numberOfWorkers)
+}
+
+func testAccAWSGlueJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  tags {
+    %[2]s = %[3]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, tagKey1, tagValue1)
+}
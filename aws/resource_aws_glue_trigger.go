@@ -0,0 +1,521 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueTriggerCreate,
+		Read:   resourceAwsGlueTriggerRead,
+		Update: resourceAwsGlueTriggerUpdate,
+		Delete: resourceAwsGlueTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.TriggerTypeConditional,
+					glue.TriggerTypeOnDemand,
+					glue.TriggerTypeScheduled,
+				}, false),
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"crawler_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"job_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"notification_property": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"notify_delay_after": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"security_configuration": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(2048),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"predicate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"crawl_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(glue.CrawlState_Values(), false),
+									},
+									"crawler_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"job_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"logical_operator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      glue.LogicalOperatorEquals,
+										ValidateFunc: validation.StringInSlice(glue.LogicalOperator_Values(), false),
+									},
+									"state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(glue.JobRunState_Values(), false),
+									},
+								},
+							},
+						},
+						"logical": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      glue.LogicalAnd,
+							ValidateFunc: validation.StringInSlice(glue.Logical_Values(), false),
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"workflow_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	name := d.Get("name").(string)
+	triggerType := d.Get("type").(string)
+
+	input := &glue.CreateTriggerInput{
+		Actions: expandGlueActions(d.Get("actions").([]interface{})),
+		Name:    aws.String(name),
+		Type:    aws.String(triggerType),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("predicate"); ok {
+		input.Predicate = expandGluePredicate(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workflow_name"); ok {
+		input.WorkflowName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	// On-demand triggers have no activation state and reject StartOnCreation.
+	if d.Get("enabled").(bool) && triggerType != glue.TriggerTypeOnDemand {
+		input.StartOnCreation = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Creating Glue Trigger: %s", input)
+	_, err := conn.CreateTrigger(input)
+	if err != nil {
+		return fmt.Errorf("error creating Glue Trigger (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForGlueTriggerStable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Glue Trigger (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetTrigger(&glue.GetTriggerInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glue Trigger (%s): %s", d.Id(), err)
+	}
+
+	trigger := output.Trigger
+	if trigger == nil {
+		log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	triggerARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "glue", meta.(*AWSClient).accountid, fmt.Sprintf("trigger/%s", d.Id()))
+	d.Set("arn", triggerARN)
+
+	if err := d.Set("actions", flattenGlueActions(trigger.Actions)); err != nil {
+		return fmt.Errorf("error setting actions: %s", err)
+	}
+
+	d.Set("description", trigger.Description)
+
+	if aws.StringValue(trigger.Type) != glue.TriggerTypeOnDemand {
+		state := aws.StringValue(trigger.State)
+		d.Set("enabled", state == glue.TriggerStateActivated || state == glue.TriggerStateActivating)
+	}
+
+	d.Set("name", trigger.Name)
+
+	if err := d.Set("predicate", flattenGluePredicate(trigger.Predicate)); err != nil {
+		return fmt.Errorf("error setting predicate: %s", err)
+	}
+
+	d.Set("schedule", trigger.Schedule)
+	d.Set("type", trigger.Type)
+	d.Set("workflow_name", trigger.WorkflowName)
+
+	tags, err := getTagsGlue(conn, triggerARN)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Trigger (%s): %s", triggerARN, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChange("actions") || d.HasChange("description") || d.HasChange("predicate") || d.HasChange("schedule") {
+		triggerUpdate := &glue.TriggerUpdate{
+			Actions: expandGlueActions(d.Get("actions").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			triggerUpdate.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("predicate"); ok {
+			triggerUpdate.Predicate = expandGluePredicate(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("schedule"); ok {
+			triggerUpdate.Schedule = aws.String(v.(string))
+		}
+
+		input := &glue.UpdateTriggerInput{
+			Name:          aws.String(d.Id()),
+			TriggerUpdate: triggerUpdate,
+		}
+
+		log.Printf("[DEBUG] Updating Glue Trigger: %s", input)
+		_, err := conn.UpdateTrigger(input)
+		if err != nil {
+			return fmt.Errorf("error updating Glue Trigger (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForGlueTriggerStable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Glue Trigger (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") && d.Get("type").(string) != glue.TriggerTypeOnDemand {
+		var err error
+		if d.Get("enabled").(bool) {
+			log.Printf("[DEBUG] Starting Glue Trigger: %s", d.Id())
+			_, err = conn.StartTrigger(&glue.StartTriggerInput{
+				Name: aws.String(d.Id()),
+			})
+		} else {
+			log.Printf("[DEBUG] Stopping Glue Trigger: %s", d.Id())
+			_, err = conn.StopTrigger(&glue.StopTriggerInput{
+				Name: aws.String(d.Id()),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("error updating Glue Trigger (%s) state: %s", d.Id(), err)
+		}
+
+		if err := waitForGlueTriggerStable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Glue Trigger (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsGlue(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Glue Trigger (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[INFO] Deleting Glue Trigger: %s", d.Id())
+	_, err := conn.DeleteTrigger(&glue.DeleteTriggerInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Trigger (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{glue.TriggerStateDeleting},
+		Target:     []string{""},
+		Refresh:    resourceAwsGlueTriggerStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Glue Trigger (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForGlueTriggerStable(conn *glue.Glue, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			glue.TriggerStateActivating,
+			glue.TriggerStateCreating,
+			glue.TriggerStateDeactivating,
+			glue.TriggerStateUpdating,
+		},
+		Target: []string{
+			glue.TriggerStateActivated,
+			glue.TriggerStateCreated,
+			glue.TriggerStateDeactivated,
+		},
+		Refresh:    resourceAwsGlueTriggerStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsGlueTriggerStateRefreshFunc(conn *glue.Glue, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetTrigger(&glue.GetTriggerInput{
+			Name: aws.String(name),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return 42, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Trigger == nil {
+			return 42, "", nil
+		}
+
+		return output, aws.StringValue(output.Trigger.State), nil
+	}
+}
+
+func expandGlueActions(l []interface{}) []*glue.Action {
+	actions := make([]*glue.Action, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		action := &glue.Action{}
+
+		if v, ok := m["arguments"].(map[string]interface{}); ok && len(v) > 0 {
+			action.Arguments = stringMapToPointers(v)
+		}
+		if v, ok := m["crawler_name"].(string); ok && v != "" {
+			action.CrawlerName = aws.String(v)
+		}
+		if v, ok := m["job_name"].(string); ok && v != "" {
+			action.JobName = aws.String(v)
+		}
+		if v, ok := m["notification_property"].([]interface{}); ok && len(v) > 0 {
+			action.NotificationProperty = expandGlueNotificationProperty(v)
+		}
+		if v, ok := m["security_configuration"].(string); ok && v != "" {
+			action.SecurityConfiguration = aws.String(v)
+		}
+		if v, ok := m["timeout"].(int); ok && v != 0 {
+			action.Timeout = aws.Int64(int64(v))
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func flattenGlueActions(actions []*glue.Action) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+
+	for _, action := range actions {
+		result = append(result, map[string]interface{}{
+			"arguments":              aws.StringValueMap(action.Arguments),
+			"crawler_name":           aws.StringValue(action.CrawlerName),
+			"job_name":               aws.StringValue(action.JobName),
+			"notification_property":  flattenGlueNotificationProperty(action.NotificationProperty),
+			"security_configuration": aws.StringValue(action.SecurityConfiguration),
+			"timeout":                int(aws.Int64Value(action.Timeout)),
+		})
+	}
+
+	return result
+}
+
+func expandGluePredicate(l []interface{}) *glue.Predicate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	predicate := &glue.Predicate{
+		Logical: aws.String(m["logical"].(string)),
+	}
+
+	for _, raw := range m["conditions"].([]interface{}) {
+		cm := raw.(map[string]interface{})
+		condition := &glue.Condition{
+			LogicalOperator: aws.String(cm["logical_operator"].(string)),
+		}
+
+		if v, ok := cm["crawl_state"].(string); ok && v != "" {
+			condition.CrawlState = aws.String(v)
+		}
+		if v, ok := cm["crawler_name"].(string); ok && v != "" {
+			condition.CrawlerName = aws.String(v)
+		}
+		if v, ok := cm["job_name"].(string); ok && v != "" {
+			condition.JobName = aws.String(v)
+		}
+		if v, ok := cm["state"].(string); ok && v != "" {
+			condition.State = aws.String(v)
+		}
+
+		predicate.Conditions = append(predicate.Conditions, condition)
+	}
+
+	return predicate
+}
+
+func flattenGluePredicate(predicate *glue.Predicate) []interface{} {
+	if predicate == nil || len(predicate.Conditions) == 0 {
+		return []interface{}{}
+	}
+
+	conditions := make([]interface{}, 0, len(predicate.Conditions))
+	for _, condition := range predicate.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"crawl_state":      aws.StringValue(condition.CrawlState),
+			"crawler_name":     aws.StringValue(condition.CrawlerName),
+			"job_name":         aws.StringValue(condition.JobName),
+			"logical_operator": aws.StringValue(condition.LogicalOperator),
+			"state":            aws.StringValue(condition.State),
+		})
+	}
+
+	m := map[string]interface{}{
+		"conditions": conditions,
+		"logical":    aws.StringValue(predicate.Logical),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueTrigger_onDemand(t *testing.T) {
+	var trigger glue.Trigger
+	resourceName := "aws_glue_trigger.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_onDemand(rName, "First trigger"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:glue:[^:]+:\d{12}:trigger/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "ON_DEMAND"),
+					resource.TestCheckResourceAttr(resourceName, "description", "First trigger"),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions.0.job_name", "aws_glue_job.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueTriggerConfig_onDemand(rName, "Second trigger"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second trigger"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueTrigger_scheduled(t *testing.T) {
+	var trigger glue.Trigger
+	resourceName := "aws_glue_trigger.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_scheduled(rName, "cron(15 12 * * ? *)", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "type", "SCHEDULED"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(15 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSGlueTriggerConfig_scheduled(rName, "cron(20 12 * * ? *)", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(20 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueTrigger_conditional(t *testing.T) {
+	var trigger glue.Trigger
+	resourceName := "aws_glue_trigger.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_conditional(rName, "SUCCEEDED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "type", "CONDITIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.0.logical", "AND"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.0.conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.0.conditions.0.state", "SUCCEEDED"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.0.conditions.0.logical_operator", "EQUALS"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_name", "aws_glue_workflow.test", "name"),
+				),
+			},
+			{
+				Config: testAccAWSGlueTriggerConfig_conditional(rName, "FAILED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "predicate.0.conditions.0.state", "FAILED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueTrigger_tags(t *testing.T) {
+	var trigger glue.Trigger
+	resourceName := "aws_glue_trigger.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSGlueTriggerConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueTriggerExists(n string, trigger *glue.Trigger) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Trigger ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetTrigger(&glue.GetTriggerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Trigger == nil {
+			return fmt.Errorf("Glue Trigger (%s) not found", rs.Primary.ID)
+		}
+
+		*trigger = *output.Trigger
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueTriggerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_trigger" {
+			continue
+		}
+
+		_, err := conn.GetTrigger(&glue.GetTriggerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Trigger (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSGlueTriggerConfig_job(rName string) string {
+	return testAccAWSGlueConfig_role(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName)
+}
+
+func testAccAWSGlueTriggerConfig_onDemand(rName, description string) string {
+	return testAccAWSGlueTriggerConfig_job(rName) + fmt.Sprintf(`
+resource "aws_glue_trigger" "test" {
+  description = %[2]q
+  name        = %[1]q
+  type        = "ON_DEMAND"
+
+  actions {
+    job_name = "${aws_glue_job.test.name}"
+  }
+}
+`, rName, description)
+}
+
+func testAccAWSGlueTriggerConfig_scheduled(rName, schedule string, enabled bool) string {
+	return testAccAWSGlueTriggerConfig_job(rName) + fmt.Sprintf(`
+resource "aws_glue_trigger" "test" {
+  enabled  = %[3]t
+  name     = %[1]q
+  schedule = %[2]q
+  type     = "SCHEDULED"
+
+  actions {
+    job_name = "${aws_glue_job.test.name}"
+  }
+}
+`, rName, schedule, enabled)
+}
+
+func testAccAWSGlueTriggerConfig_conditional(rName, state string) string {
+	return testAccAWSGlueTriggerConfig_job(rName) + fmt.Sprintf(`
+resource "aws_glue_job" "test2" {
+  name     = "%[1]s-2"
+  role_arn = "${aws_iam_role.test.arn}"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_trigger" "start" {
+  name          = "%[1]s-start"
+  type          = "ON_DEMAND"
+  workflow_name = "${aws_glue_workflow.test.name}"
+
+  actions {
+    job_name = "${aws_glue_job.test.name}"
+  }
+}
+
+resource "aws_glue_trigger" "test" {
+  name          = %[1]q
+  type          = "CONDITIONAL"
+  workflow_name = "${aws_glue_workflow.test.name}"
+
+  actions {
+    job_name = "${aws_glue_job.test2.name}"
+  }
+
+  predicate {
+    conditions {
+      job_name = "${aws_glue_job.test.name}"
+      state    = %[2]q
+    }
+  }
+}
+`, rName, state)
+}
+
+func testAccAWSGlueTriggerConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSGlueTriggerConfig_job(rName) + fmt.Sprintf(`
+resource "aws_glue_trigger" "test" {
+  name = %[1]q
+  type = "ON_DEMAND"
+
+  actions {
+    job_name = "${aws_glue_job.test.name}"
+  }
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueWorkflowCreate,
+		Read:   resourceAwsGlueWorkflowRead,
+		Update: resourceAwsGlueWorkflowUpdate,
+		Delete: resourceAwsGlueWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"default_run_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_concurrent_runs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	name := d.Get("name").(string)
+	input := &glue.CreateWorkflowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("default_run_properties"); ok {
+		input.DefaultRunProperties = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_concurrent_runs"); ok {
+		input.MaxConcurrentRuns = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Workflow: %s", input)
+	_, err := conn.CreateWorkflow(input)
+	if err != nil {
+		return fmt.Errorf("error creating Glue Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueWorkflowRead(d, meta)
+}
+
+func resourceAwsGlueWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Glue Workflow (%s): %s", d.Id(), err)
+	}
+
+	workflow := output.Workflow
+	if workflow == nil {
+		log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	workflowARN := arnString(meta.(*AWSClient).partition, meta.(*AWSClient).region, "glue", meta.(*AWSClient).accountid, fmt.Sprintf("workflow/%s", d.Id()))
+	d.Set("arn", workflowARN)
+
+	if err := d.Set("default_run_properties", aws.StringValueMap(workflow.DefaultRunProperties)); err != nil {
+		return fmt.Errorf("error setting default_run_properties: %s", err)
+	}
+
+	d.Set("description", workflow.Description)
+	d.Set("max_concurrent_runs", workflow.MaxConcurrentRuns)
+	d.Set("name", workflow.Name)
+
+	tags, err := getTagsGlue(conn, workflowARN)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Workflow (%s): %s", workflowARN, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChange("default_run_properties") || d.HasChange("description") || d.HasChange("max_concurrent_runs") {
+		input := &glue.UpdateWorkflowInput{
+			DefaultRunProperties: stringMapToPointers(d.Get("default_run_properties").(map[string]interface{})),
+			Description:          aws.String(d.Get("description").(string)),
+			Name:                 aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("max_concurrent_runs"); ok {
+			input.MaxConcurrentRuns = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Glue Workflow: %s", input)
+		_, err := conn.UpdateWorkflow(input)
+		if err != nil {
+			return fmt.Errorf("error updating Glue Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsGlue(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Glue Workflow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueWorkflowRead(d, meta)
+}
+
+func resourceAwsGlueWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[INFO] Deleting Glue Workflow: %s", d.Id())
+	_, err := conn.DeleteWorkflow(&glue.DeleteWorkflowInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Workflow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
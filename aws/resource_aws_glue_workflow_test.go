@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueWorkflow_basic(t *testing.T) {
+	var workflow glue.Workflow
+	resourceName := "aws_glue_workflow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfig_basic(rName, "First workflow", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:glue:[^:]+:\d{12}:workflow/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "First workflow"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_runs", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.stage", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueWorkflowConfig_basic(rName, "Second workflow", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second workflow"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_runs", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueWorkflow_tags(t *testing.T) {
+	var workflow glue.Workflow
+	resourceName := "aws_glue_workflow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSGlueWorkflowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueWorkflowExists(n string, workflow *glue.Workflow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Workflow ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Workflow == nil {
+			return fmt.Errorf("Glue Workflow (%s) not found", rs.Primary.ID)
+		}
+
+		*workflow = *output.Workflow
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueWorkflowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_workflow" {
+			continue
+		}
+
+		_, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Workflow (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSGlueWorkflowConfig_basic(rName, description string, maxConcurrentRuns int) string {
+	return fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  name                = %[1]q
+  description         = %[2]q
+  max_concurrent_runs = %[3]d
+
+  default_run_properties {
+    stage = "test"
+  }
+}
+`, rName, description, maxConcurrentRuns)
+}
+
+func testAccAWSGlueWorkflowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
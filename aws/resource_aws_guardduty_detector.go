@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsGuardDutyDetector() *schema.Resource {
@@ -30,6 +31,95 @@ func resourceAwsGuardDutyDetector() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubernetes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audit_logs": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"malware_protection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scan_ec2_instance_with_findings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volumes": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enable": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"finding_publishing_frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.FindingPublishingFrequencyFifteenMinutes,
+					guardduty.FindingPublishingFrequencyOneHour,
+					guardduty.FindingPublishingFrequencySixHours,
+				}, false),
+			},
 		},
 	}
 }
@@ -41,6 +131,14 @@ func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}
 		Enable: aws.Bool(d.Get("enable").(bool)),
 	}
 
+	if v, ok := d.GetOk("datasources"); ok {
+		input.DataSources = expandGuardDutyDataSourceConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("finding_publishing_frequency"); ok {
+		input.FindingPublishingFrequency = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Creating GuardDuty Detector: %s", input)
 	output, err := conn.CreateDetector(&input)
 	if err != nil {
@@ -70,6 +168,11 @@ func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("account_id", meta.(*AWSClient).accountid)
 	d.Set("enable", *gdo.Status == guardduty.DetectorStatusEnabled)
+	d.Set("finding_publishing_frequency", gdo.FindingPublishingFrequency)
+
+	if err := d.Set("datasources", flattenGuardDutyDataSourceConfigurationsResult(gdo.DataSources)); err != nil {
+		return fmt.Errorf("error setting datasources: %s", err)
+	}
 
 	return nil
 }
@@ -82,6 +185,14 @@ func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}
 		Enable:     aws.Bool(d.Get("enable").(bool)),
 	}
 
+	if d.HasChange("datasources") {
+		input.DataSources = expandGuardDutyDataSourceConfigurations(d.Get("datasources").([]interface{}))
+	}
+
+	if d.HasChange("finding_publishing_frequency") {
+		input.FindingPublishingFrequency = aws.String(d.Get("finding_publishing_frequency").(string))
+	}
+
 	log.Printf("[DEBUG] Update GuardDuty Detector: %s", input)
 	_, err := conn.UpdateDetector(&input)
 	if err != nil {
@@ -104,3 +215,91 @@ func resourceAwsGuardDutyDetectorDelete(d *schema.ResourceData, meta interface{}
 	}
 	return nil
 }
+
+func expandGuardDutyDataSourceConfigurations(l []interface{}) *guardduty.DataSourceConfigurations {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &guardduty.DataSourceConfigurations{}
+
+	if v, ok := m["kubernetes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		auditLogs := v[0].(map[string]interface{})["audit_logs"].([]interface{})
+		if len(auditLogs) > 0 && auditLogs[0] != nil {
+			config.Kubernetes = &guardduty.KubernetesConfiguration{
+				AuditLogs: &guardduty.KubernetesAuditLogsConfiguration{
+					Enable: aws.Bool(auditLogs[0].(map[string]interface{})["enable"].(bool)),
+				},
+			}
+		}
+	}
+
+	if v, ok := m["malware_protection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		scan := v[0].(map[string]interface{})["scan_ec2_instance_with_findings"].([]interface{})
+		if len(scan) > 0 && scan[0] != nil {
+			ebsVolumes := scan[0].(map[string]interface{})["ebs_volumes"].([]interface{})
+			if len(ebsVolumes) > 0 && ebsVolumes[0] != nil {
+				config.MalwareProtection = &guardduty.MalwareProtectionConfiguration{
+					ScanEc2InstanceWithFindings: &guardduty.ScanEc2InstanceWithFindings{
+						EbsVolumes: aws.Bool(ebsVolumes[0].(map[string]interface{})["enable"].(bool)),
+					},
+				}
+			}
+		}
+	}
+
+	if v, ok := m["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.S3Logs = &guardduty.S3LogsConfiguration{
+			Enable: aws.Bool(v[0].(map[string]interface{})["enable"].(bool)),
+		}
+	}
+
+	return config
+}
+
+func flattenGuardDutyDataSourceConfigurationsResult(config *guardduty.DataSourceConfigurationsResult) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.Kubernetes != nil && config.Kubernetes.AuditLogs != nil {
+		m["kubernetes"] = []interface{}{
+			map[string]interface{}{
+				"audit_logs": []interface{}{
+					map[string]interface{}{
+						"enable": aws.StringValue(config.Kubernetes.AuditLogs.Status) == guardduty.DataSourceStatusEnabled,
+					},
+				},
+			},
+		}
+	}
+
+	if config.MalwareProtection != nil && config.MalwareProtection.ScanEc2InstanceWithFindings != nil && config.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes != nil {
+		m["malware_protection"] = []interface{}{
+			map[string]interface{}{
+				"scan_ec2_instance_with_findings": []interface{}{
+					map[string]interface{}{
+						"ebs_volumes": []interface{}{
+							map[string]interface{}{
+								"enable": aws.StringValue(config.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes.Status) == guardduty.DataSourceStatusEnabled,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if config.S3Logs != nil {
+		m["s3_logs"] = []interface{}{
+			map[string]interface{}{
+				"enable": aws.StringValue(config.S3Logs.Status) == guardduty.DataSourceStatusEnabled,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyOrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyOrganizationAdminAccountCreate,
+		Read:   resourceAwsGuardDutyOrganizationAdminAccountRead,
+		Delete: resourceAwsGuardDutyOrganizationAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+	adminAccountID := d.Get("admin_account_id").(string)
+
+	input := &guardduty.EnableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(adminAccountID),
+	}
+
+	log.Printf("[DEBUG] Enabling GuardDuty Organization Admin Account: %s", input)
+	_, err := conn.EnableOrganizationAdminAccount(input)
+	if err != nil {
+		return fmt.Errorf("error enabling GuardDuty Organization Admin Account (%s): %s", adminAccountID, err)
+	}
+
+	d.SetId(adminAccountID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"NotFound"},
+		Target:  []string{guardduty.AdminStatusEnabled},
+		Refresh: guardDutyOrganizationAdminAccountRefreshStatusFunc(conn, d.Id()),
+		Timeout: 5 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for GuardDuty Organization Admin Account (%s) to enable: %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyOrganizationAdminAccountRead(d, meta)
+}
+
+func resourceAwsGuardDutyOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	adminAccount, err := getGuardDutyOrganizationAdminAccount(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if adminAccount == nil {
+		log.Printf("[WARN] GuardDuty Organization Admin Account %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("admin_account_id", adminAccount.AdminAccountId)
+
+	return nil
+}
+
+func resourceAwsGuardDutyOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	input := &guardduty.DisableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Disabling GuardDuty Organization Admin Account: %s", input)
+	_, err := conn.DisableOrganizationAdminAccount(input)
+	if err != nil {
+		return fmt.Errorf("error disabling GuardDuty Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{guardduty.AdminStatusEnabled, guardduty.AdminStatusDisableInProgress},
+		Target:  []string{"NotFound"},
+		Refresh: guardDutyOrganizationAdminAccountRefreshStatusFunc(conn, d.Id()),
+		Timeout: 5 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for GuardDuty Organization Admin Account (%s) to disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getGuardDutyOrganizationAdminAccount(conn *guardduty.GuardDuty, adminAccountID string) (*guardduty.AdminAccount, error) {
+	var result *guardduty.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(&guardduty.ListOrganizationAdminAccountsInput{}, func(page *guardduty.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		for _, adminAccount := range page.AdminAccounts {
+			if aws.StringValue(adminAccount.AdminAccountId) == adminAccountID {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func guardDutyOrganizationAdminAccountRefreshStatusFunc(conn *guardduty.GuardDuty, adminAccountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		adminAccount, err := getGuardDutyOrganizationAdminAccount(conn, adminAccountID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if adminAccount == nil {
+			return &guardduty.AdminAccount{}, "NotFound", nil
+		}
+
+		return adminAccount, aws.StringValue(adminAccount.AdminStatus), nil
+	}
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyOrganizationConfigurationUpdate,
+		Read:   resourceAwsGuardDutyOrganizationConfigurationRead,
+		Update: resourceAwsGuardDutyOrganizationConfigurationUpdate,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable_organization_members": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.AutoEnableMembersAll,
+					guardduty.AutoEnableMembersNew,
+					guardduty.AutoEnableMembersNone,
+				}, false),
+			},
+			"datasources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubernetes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audit_logs": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_enable": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"malware_protection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scan_ec2_instance_with_findings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volumes": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"auto_enable": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_enable": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyOrganizationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+	detectorID := d.Get("detector_id").(string)
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		AutoEnableOrganizationMembers: aws.String(d.Get("auto_enable_organization_members").(string)),
+		DetectorId:                    aws.String(detectorID),
+	}
+
+	if v, ok := d.GetOk("datasources"); ok {
+		input.DataSources = expandGuardDutyOrganizationDataSourceConfigurations(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty Organization Configuration: %s", input)
+	_, err := conn.UpdateOrganizationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Organization Configuration (%s): %s", detectorID, err)
+	}
+
+	d.SetId(detectorID)
+
+	return resourceAwsGuardDutyOrganizationConfigurationRead(d, meta)
+}
+
+func resourceAwsGuardDutyOrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	input := &guardduty.DescribeOrganizationConfigurationInput{
+		DetectorId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Organization Configuration: %s", input)
+	output, err := conn.DescribeOrganizationConfiguration(input)
+	if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		log.Printf("[WARN] GuardDuty Organization Configuration %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading GuardDuty Organization Configuration (%s): empty response", d.Id())
+	}
+
+	d.Set("auto_enable_organization_members", output.AutoEnableOrganizationMembers)
+	d.Set("detector_id", d.Id())
+
+	if err := d.Set("datasources", flattenGuardDutyOrganizationDataSourceConfigurationsResult(output.DataSources)); err != nil {
+		return fmt.Errorf("error setting datasources: %s", err)
+	}
+
+	return nil
+}
+
+func expandGuardDutyOrganizationDataSourceConfigurations(l []interface{}) *guardduty.OrganizationDataSourceConfigurations {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &guardduty.OrganizationDataSourceConfigurations{}
+
+	if v, ok := m["kubernetes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		auditLogs := v[0].(map[string]interface{})["audit_logs"].([]interface{})
+		if len(auditLogs) > 0 && auditLogs[0] != nil {
+			config.Kubernetes = &guardduty.OrganizationKubernetesConfiguration{
+				AuditLogs: &guardduty.OrganizationKubernetesAuditLogsConfiguration{
+					AutoEnable: aws.Bool(auditLogs[0].(map[string]interface{})["auto_enable"].(bool)),
+				},
+			}
+		}
+	}
+
+	if v, ok := m["malware_protection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		scan := v[0].(map[string]interface{})["scan_ec2_instance_with_findings"].([]interface{})
+		if len(scan) > 0 && scan[0] != nil {
+			ebsVolumes := scan[0].(map[string]interface{})["ebs_volumes"].([]interface{})
+			if len(ebsVolumes) > 0 && ebsVolumes[0] != nil {
+				config.MalwareProtection = &guardduty.OrganizationMalwareProtectionConfiguration{
+					ScanEc2InstanceWithFindings: &guardduty.OrganizationScanEc2InstanceWithFindings{
+						EbsVolumes: &guardduty.OrganizationEbsVolumes{
+							AutoEnable: aws.Bool(ebsVolumes[0].(map[string]interface{})["auto_enable"].(bool)),
+						},
+					},
+				}
+			}
+		}
+	}
+
+	if v, ok := m["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.S3Logs = &guardduty.OrganizationS3LogsConfiguration{
+			AutoEnable: aws.Bool(v[0].(map[string]interface{})["auto_enable"].(bool)),
+		}
+	}
+
+	return config
+}
+
+func flattenGuardDutyOrganizationDataSourceConfigurationsResult(config *guardduty.OrganizationDataSourceConfigurationsResult) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.Kubernetes != nil && config.Kubernetes.AuditLogs != nil {
+		m["kubernetes"] = []interface{}{
+			map[string]interface{}{
+				"audit_logs": []interface{}{
+					map[string]interface{}{
+						"auto_enable": aws.BoolValue(config.Kubernetes.AuditLogs.AutoEnable),
+					},
+				},
+			},
+		}
+	}
+
+	if config.MalwareProtection != nil && config.MalwareProtection.ScanEc2InstanceWithFindings != nil && config.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes != nil {
+		m["malware_protection"] = []interface{}{
+			map[string]interface{}{
+				"scan_ec2_instance_with_findings": []interface{}{
+					map[string]interface{}{
+						"ebs_volumes": []interface{}{
+							map[string]interface{}{
+								"auto_enable": aws.BoolValue(config.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes.AutoEnable),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if config.S3Logs != nil {
+		m["s3_logs"] = []interface{}{
+			map[string]interface{}{
+				"auto_enable": aws.BoolValue(config.S3Logs.AutoEnable),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
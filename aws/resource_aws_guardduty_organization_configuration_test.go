@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccAwsGuardDutyOrganizationConfiguration_basic(t *testing.T) {
+	detectorResourceName := "aws_guardduty_detector.test"
+	resourceName := "aws_guardduty_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigAutoEnable("ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_organization_members", "ALL"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", detectorResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigAutoEnable("NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_organization_members", "NEW"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyOrganizationConfiguration_datasources(t *testing.T) {
+	resourceName := "aws_guardduty_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigDatasources(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "datasources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.kubernetes.0.audit_logs.0.auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.malware_protection.0.scan_ec2_instance_with_findings.0.ebs_volumes.0.auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.s3_logs.0.auto_enable", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigDatasources(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.kubernetes.0.audit_logs.0.auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.malware_protection.0.scan_ec2_instance_with_findings.0.ebs_volumes.0.auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "datasources.0.s3_logs.0.auto_enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGuardDutyOrganizationConfigurationConfigBase = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_organization_admin_account" "test" {
+  depends_on = ["aws_guardduty_detector.test"]
+
+  admin_account_id = "${data.aws_caller_identity.current.account_id}"
+}
+`
+
+func testAccGuardDutyOrganizationConfigurationConfigAutoEnable(autoEnable string) string {
+	return testAccGuardDutyOrganizationConfigurationConfigBase + fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = ["aws_guardduty_organization_admin_account.test"]
+
+  auto_enable_organization_members = %[1]q
+  detector_id                      = "${aws_guardduty_detector.test.id}"
+}
+`, autoEnable)
+}
+
+func testAccGuardDutyOrganizationConfigurationConfigDatasources(s3Logs, others bool) string {
+	return testAccGuardDutyOrganizationConfigurationConfigBase + fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = ["aws_guardduty_organization_admin_account.test"]
+
+  auto_enable_organization_members = "ALL"
+  detector_id                      = "${aws_guardduty_detector.test.id}"
+
+  datasources {
+    kubernetes {
+      audit_logs {
+        auto_enable = %[2]t
+      }
+    }
+
+    malware_protection {
+      scan_ec2_instance_with_findings {
+        ebs_volumes {
+          auto_enable = %[2]t
+        }
+      }
+    }
+
+    s3_logs {
+      auto_enable = %[1]t
+    }
+  }
+}
+`, s3Logs, others)
+}
@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyPublishingDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyPublishingDestinationCreate,
+		Read:   resourceAwsGuardDutyPublishingDestinationRead,
+		Update: resourceAwsGuardDutyPublishingDestinationUpdate,
+		Delete: resourceAwsGuardDutyPublishingDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  guardduty.DestinationTypeS3,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.DestinationTypeS3,
+				}, false),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyPublishingDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+	detectorID := d.Get("detector_id").(string)
+
+	input := &guardduty.CreatePublishingDestinationInput{
+		DestinationProperties: &guardduty.DestinationProperties{
+			DestinationArn: aws.String(d.Get("destination_arn").(string)),
+			KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
+		},
+		DestinationType: aws.String(d.Get("destination_type").(string)),
+		DetectorId:      aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Publishing Destination: %s", input)
+	var output *guardduty.CreatePublishingDestinationOutput
+	// The destination bucket and key policies granting GuardDuty access can
+	// take a few moments to propagate.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreatePublishingDestination(input)
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request failed because the GuardDuty service principal does not have permission") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating GuardDuty Publishing Destination: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.StringValue(output.DestinationId)))
+
+	return resourceAwsGuardDutyPublishingDestinationRead(d, meta)
+}
+
+func resourceAwsGuardDutyPublishingDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	destinationID, detectorID, err := decodeGuardDutyPublishingDestinationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DescribePublishingDestinationInput{
+		DestinationId: aws.String(destinationID),
+		DetectorId:    aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Publishing Destination: %s", input)
+	output, err := conn.DescribePublishingDestination(input)
+	if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the one or more input parameters have invalid values.") ||
+		isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		log.Printf("[WARN] GuardDuty Publishing Destination %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("destination_type", output.DestinationType)
+	d.Set("detector_id", detectorID)
+
+	if output.DestinationProperties != nil {
+		d.Set("destination_arn", output.DestinationProperties.DestinationArn)
+		d.Set("kms_key_arn", output.DestinationProperties.KmsKeyArn)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyPublishingDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	destinationID, detectorID, err := decodeGuardDutyPublishingDestinationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdatePublishingDestinationInput{
+		DestinationId: aws.String(destinationID),
+		DestinationProperties: &guardduty.DestinationProperties{
+			DestinationArn: aws.String(d.Get("destination_arn").(string)),
+			KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
+		},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty Publishing Destination: %s", input)
+	_, err = conn.UpdatePublishingDestination(input)
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyPublishingDestinationRead(d, meta)
+}
+
+func resourceAwsGuardDutyPublishingDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	destinationID, detectorID, err := decodeGuardDutyPublishingDestinationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeletePublishingDestinationInput{
+		DestinationId: aws.String(destinationID),
+		DetectorId:    aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Publishing Destination: %s", input)
+	_, err = conn.DeletePublishingDestination(input)
+	if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeGuardDutyPublishingDestinationID(id string) (destinationID, detectorID string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("GuardDuty Publishing Destination ID must be of the form <Detector ID>:<Publishing Destination ID>, was provided: %s", id)
+		return
+	}
+	destinationID = parts[1]
+	detectorID = parts[0]
+	return
+}
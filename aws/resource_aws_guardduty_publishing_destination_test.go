@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyPublishingDestination_basic(t *testing.T) {
+	resourceName := "aws_guardduty_publishing_destination.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyPublishingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyPublishingDestinationConfig(rName, "aws_s3_bucket.test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyPublishingDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "S3"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyPublishingDestinationConfig(rName, "aws_s3_bucket.test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyPublishingDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_s3_bucket.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyPublishingDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_publishing_destination" {
+			continue
+		}
+
+		destinationID, detectorID, err := decodeGuardDutyPublishingDestinationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribePublishingDestination(&guardduty.DescribePublishingDestinationInput{
+			DestinationId: aws.String(destinationID),
+			DetectorId:    aws.String(detectorID),
+		})
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GuardDuty Publishing Destination (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyPublishingDestinationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		destinationID, detectorID, err := decodeGuardDutyPublishingDestinationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+		_, err = conn.DescribePublishingDestination(&guardduty.DescribePublishingDestinationInput{
+			DestinationId: aws.String(destinationID),
+			DetectorId:    aws.String(detectorID),
+		})
+
+		return err
+	}
+}
+
+func testAccGuardDutyPublishingDestinationConfig(rName, bucketResourceName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = "%[1]s-1"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "test2" {
+  bucket        = "%[1]s-2"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "guardduty.amazonaws.com"},
+      "Action": ["s3:GetBucketLocation", "s3:PutObject"],
+      "Resource": ["${aws_s3_bucket.test.arn}", "${aws_s3_bucket.test.arn}/*"]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket_policy" "test2" {
+  bucket = "${aws_s3_bucket.test2.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "guardduty.amazonaws.com"},
+      "Action": ["s3:GetBucketLocation", "s3:PutObject"],
+      "Resource": ["${aws_s3_bucket.test2.arn}", "${aws_s3_bucket.test2.arn}/*"]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Allow GuardDuty to encrypt findings",
+      "Effect": "Allow",
+      "Principal": {"Service": "guardduty.amazonaws.com"},
+      "Action": "kms:GenerateDataKey",
+      "Resource": "arn:${data.aws_partition.current.partition}:kms:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:key/*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_guardduty_publishing_destination" "test" {
+  depends_on = ["aws_s3_bucket_policy.test", "aws_s3_bucket_policy.test2"]
+
+  destination_arn = "${%[2]s.arn}"
+  detector_id     = "${aws_guardduty_detector.test.id}"
+  kms_key_arn     = "${aws_kms_key.test.arn}"
+}
+`, rName, bucketResourceName)
+}
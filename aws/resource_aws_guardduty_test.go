@@ -7,8 +7,10 @@ import (
 func TestAccAWSGuardDuty(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Detector": {
-			"basic":  testAccAwsGuardDutyDetector_basic,
-			"import": testAccAwsGuardDutyDetector_import,
+			"basic":                      testAccAwsGuardDutyDetector_basic,
+			"datasources":                testAccAwsGuardDutyDetector_datasources,
+			"findingPublishingFrequency": testAccAwsGuardDutyDetector_findingPublishingFrequency,
+			"import":                     testAccAwsGuardDutyDetector_import,
 		},
 		"IPSet": {
 			"basic":  testAccAwsGuardDutyIpset_basic,
@@ -22,6 +24,16 @@ func TestAccAWSGuardDuty(t *testing.T) {
 			"basic":  testAccAwsGuardDutyMember_basic,
 			"import": testAccAwsGuardDutyMember_import,
 		},
+		"OrganizationAdminAccount": {
+			"basic": testAccAwsGuardDutyOrganizationAdminAccount_basic,
+		},
+		"OrganizationConfiguration": {
+			"basic":       testAccAwsGuardDutyOrganizationConfiguration_basic,
+			"datasources": testAccAwsGuardDutyOrganizationConfiguration_datasources,
+		},
+		"PublishingDestination": {
+			"basic": testAccAwsGuardDutyPublishingDestination_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/awspolicyequivalence"
 )
 
 func resourceAwsIamRole() *schema.Resource {
@@ -95,12 +96,48 @@ func resourceAwsIamRole() *schema.Resource {
 				ValidateFunc:     validateJsonString,
 			},
 
+			"permissions_boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
 			"force_detach_policies": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
+			"inline_policy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIamRolePolicyName,
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIAMPolicyJson,
+						},
+					},
+				},
+			},
+
+			"managed_policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+
 			"create_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -137,6 +174,10 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("permissions_boundary"); ok {
+		request.PermissionsBoundary = aws.String(v.(string))
+	}
+
 	var createResp *iam.CreateRoleOutput
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
 		var err error
@@ -152,6 +193,26 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
 	d.SetId(*createResp.Role.RoleName)
+
+	if v, ok := d.GetOk("inline_policy"); ok {
+		policies, err := expandIamRoleInlinePolicies(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		if err := putIamRoleInlinePolicies(iamconn, d.Id(), policies); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("managed_policy_arns"); ok {
+		for _, arn := range v.(*schema.Set).List() {
+			if err := attachPolicyToRole(iamconn, d.Id(), arn.(string)); err != nil {
+				return fmt.Errorf("Error attaching policy %s to IAM Role %s: %s", arn.(string), d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsIamRoleRead(d, meta)
 }
 
@@ -196,6 +257,12 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Clear the boundary when it has been removed out-of-band.
+	d.Set("permissions_boundary", "")
+	if role.PermissionsBoundary != nil {
+		d.Set("permissions_boundary", role.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
 	assumRolePolicy, err := url.QueryUnescape(*role.AssumeRolePolicyDocument)
 	if err != nil {
 		return err
@@ -203,6 +270,23 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("assume_role_policy", assumRolePolicy); err != nil {
 		return err
 	}
+
+	inlinePolicies, err := readIamRoleInlinePolicies(iamconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading inline policies for IAM Role %s: %s", d.Id(), err)
+	}
+	if err := d.Set("inline_policy", flattenIamRoleInlinePolicies(inlinePolicies, d.Get("inline_policy").(*schema.Set).List())); err != nil {
+		return fmt.Errorf("error setting inline_policy: %s", err)
+	}
+
+	managedPolicyArns, err := readIamRoleManagedPolicyArns(iamconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading managed policies for IAM Role %s: %s", d.Id(), err)
+	}
+	if err := d.Set("managed_policy_arns", managedPolicyArns); err != nil {
+		return fmt.Errorf("error setting managed_policy_arns: %s", err)
+	}
+
 	return nil
 }
 
@@ -239,7 +323,66 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	if d.HasChange("permissions_boundary") {
+		if err := updateIamRolePermissionsBoundary(iamconn, d); err != nil {
+			return err
+		}
+	}
+
+	// When configured, the role is authoritative over its inline policies:
+	// any policy not in the configuration is deleted.
+	if d.HasChange("inline_policy") {
+		o, n := d.GetChange("inline_policy")
+
+		newPolicies, err := expandIamRoleInlinePolicies(n.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		oldPolicies, err := expandIamRoleInlinePolicies(o.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		for name := range oldPolicies {
+			if _, ok := newPolicies[name]; ok {
+				continue
+			}
+
+			_, err := iamconn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+				PolicyName: aws.String(name),
+				RoleName:   aws.String(d.Id()),
+			})
+			if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error deleting inline policy %s of IAM Role %s: %s", name, d.Id(), err)
+			}
+		}
+
+		if err := putIamRoleInlinePolicies(iamconn, d.Id(), newPolicies); err != nil {
+			return err
+		}
+	}
+
+	// Likewise for managed policies; out-of-band attachments are detached.
+	if d.HasChange("managed_policy_arns") {
+		o, n := d.GetChange("managed_policy_arns")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, arn := range os.Difference(ns).List() {
+			if err := detachPolicyFromRole(iamconn, d.Id(), arn.(string)); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error detaching policy %s from IAM Role %s: %s", arn.(string), d.Id(), err)
+			}
+		}
+
+		for _, arn := range ns.Difference(os).List() {
+			if err := attachPolicyToRole(iamconn, d.Id(), arn.(string)); err != nil {
+				return fmt.Errorf("Error attaching policy %s to IAM Role %s: %s", arn.(string), d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsIamRoleRead(d, meta)
 }
 
 func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
@@ -266,6 +409,33 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Policies managed through inline_policy and managed_policy_arns are
+	// always removed; force_detach_policies covers any others.
+	if v, ok := d.GetOk("inline_policy"); ok {
+		policies, err := expandIamRoleInlinePolicies(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		for name := range policies {
+			_, err := iamconn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+				PolicyName: aws.String(name),
+				RoleName:   aws.String(d.Id()),
+			})
+			if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error deleting inline policy %s of IAM Role %s: %s", name, d.Id(), err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("managed_policy_arns"); ok {
+		for _, arn := range v.(*schema.Set).List() {
+			if err := detachPolicyFromRole(iamconn, d.Id(), arn.(string)); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error detaching policy %s from IAM Role %s: %s", arn.(string), d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("force_detach_policies").(bool) {
 		// For managed policies
 		managedPolicies := make([]*string, 0)
@@ -336,3 +506,154 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 }
+
+// expandIamRoleInlinePolicies returns the configured inline policies keyed by
+// name. An empty inline_policy block is allowed and means "no policies".
+func expandIamRoleInlinePolicies(l []interface{}) (map[string]string, error) {
+	policies := make(map[string]string)
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := m["name"].(string)
+		policy := m["policy"].(string)
+
+		if name == "" && policy == "" {
+			continue
+		}
+
+		if name == "" || policy == "" {
+			return nil, fmt.Errorf("inline_policy: both name and policy must be specified")
+		}
+
+		policies[name] = policy
+	}
+
+	return policies, nil
+}
+
+func putIamRoleInlinePolicies(conn *iam.IAM, roleName string, policies map[string]string) error {
+	for name, policy := range policies {
+		_, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyName:     aws.String(name),
+			RoleName:       aws.String(roleName),
+		})
+		if err != nil {
+			return fmt.Errorf("Error putting inline policy %s on IAM Role %s: %s", name, roleName, err)
+		}
+	}
+
+	return nil
+}
+
+func readIamRoleInlinePolicies(conn *iam.IAM, roleName string) (map[string]string, error) {
+	var names []*string
+	err := conn.ListRolePoliciesPages(&iam.ListRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		names = append(names, page.PolicyNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[string]string, len(names))
+	for _, name := range names {
+		resp, err := conn.GetRolePolicy(&iam.GetRolePolicyInput{
+			PolicyName: name,
+			RoleName:   aws.String(roleName),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := url.QueryUnescape(aws.StringValue(resp.PolicyDocument))
+		if err != nil {
+			return nil, err
+		}
+
+		policies[aws.StringValue(name)] = policy
+	}
+
+	return policies, nil
+}
+
+// flattenIamRoleInlinePolicies keeps the configured policy document when it
+// is equivalent to the one returned by IAM, as sets cannot suppress
+// formatting-only differences.
+func flattenIamRoleInlinePolicies(policies map[string]string, configured []interface{}) []interface{} {
+	existing := make(map[string]string)
+	for _, raw := range configured {
+		if m, ok := raw.(map[string]interface{}); ok {
+			existing[m["name"].(string)] = m["policy"].(string)
+		}
+	}
+
+	// Preserve an empty configuration block so that it does not show a diff.
+	if _, ok := existing[""]; ok && len(policies) == 0 {
+		return []interface{}{
+			map[string]interface{}{
+				"name":   "",
+				"policy": "",
+			},
+		}
+	}
+
+	l := make([]interface{}, 0, len(policies))
+	for name, policy := range policies {
+		if v, ok := existing[name]; ok {
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(v, policy); err == nil && equivalent {
+				policy = v
+			}
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":   name,
+			"policy": policy,
+		})
+	}
+
+	return l
+}
+
+func readIamRoleManagedPolicyArns(conn *iam.IAM, roleName string) ([]string, error) {
+	var arns []string
+	err := conn.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		for _, v := range page.AttachedPolicies {
+			arns = append(arns, aws.StringValue(v.PolicyArn))
+		}
+		return !lastPage
+	})
+
+	return arns, err
+}
+
+func updateIamRolePermissionsBoundary(conn *iam.IAM, d *schema.ResourceData) error {
+	if v, ok := d.GetOk("permissions_boundary"); ok {
+		_, err := conn.PutRolePermissionsBoundary(&iam.PutRolePermissionsBoundaryInput{
+			PermissionsBoundary: aws.String(v.(string)),
+			RoleName:            aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating IAM Role (%s) permissions boundary: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	_, err := conn.DeleteRolePermissionsBoundary(&iam.DeleteRolePermissionsBoundaryInput{
+		RoleName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return fmt.Errorf("Error deleting IAM Role (%s) permissions boundary: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -158,6 +158,193 @@ func TestAccAWSIAMRole_force_detach_policies(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMRole_inlinePolicy(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+	resourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfigInlinePolicy(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfigInlinePolicyMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "2"),
+				),
+			},
+			{
+				// Out-of-band inline policies are removed on the next apply.
+				Config: testAccAWSIAMRoleConfigInlinePolicy(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccAddAwsIAMRolePolicy(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMRoleConfigInlinePolicy(rName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfigInlinePolicyEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccCheckAWSRoleInlinePolicyCount(resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMRole_managedPolicyArns(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+	resourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfigManagedPolicyArns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfigManagedPolicyArnsMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "2"),
+				),
+			},
+			{
+				// Out-of-band attachments are detached on the next apply.
+				Config: testAccAWSIAMRoleConfigManagedPolicyArns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccAttachAwsIAMRoleManagedPolicy(resourceName, "aws_iam_policy.test2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMRoleConfigManagedPolicyArns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMRole_inlinePolicyAndManagedPolicyArnsDestroy(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+	resourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfigInlinePolicyAndManagedPolicyArns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "force_detach_policies", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMRole_permissionsBoundary(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+	resourceName := "aws_iam_role.role"
+	permissionsBoundary1 := "arn:aws:iam::aws:policy/AdministratorAccess"
+	permissionsBoundary2 := "arn:aws:iam::aws:policy/ReadOnlyAccess"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfigPermissionsBoundary(rName, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", permissionsBoundary1),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfigPermissionsBoundary(rName, permissionsBoundary2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", permissionsBoundary2),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", ""),
+				),
+			},
+			{
+				// Drift: a boundary removed out-of-band is restored.
+				Config: testAccAWSIAMRoleConfigPermissionsBoundary(rName, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccCheckAWSRoleDeletePermissionsBoundary(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMRoleConfigPermissionsBoundary(rName, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", permissionsBoundary1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoleDeletePermissionsBoundary(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found")
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := iamconn.DeleteRolePermissionsBoundary(&iam.DeleteRolePermissionsBoundaryInput{
+			RoleName: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -261,6 +448,47 @@ func testAccAddAwsIAMRolePolicy(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAWSRoleInlinePolicyCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found")
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		resp, err := iamconn.ListRolePolicies(&iam.ListRolePoliciesInput{
+			RoleName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.PolicyNames) != expected {
+			return fmt.Errorf("Expected %d inline policies, found %d", expected, len(resp.PolicyNames))
+		}
+
+		return nil
+	}
+}
+
+func testAccAttachAwsIAMRoleManagedPolicy(n, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found")
+		}
+		prs, ok := s.RootModule().Resources[policy]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", policy)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		return attachPolicyToRole(iamconn, rs.Primary.ID, prs.Primary.Attributes["arn"])
+	}
+}
+
 func testAccAWSIAMRoleConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "role" {
@@ -494,3 +722,113 @@ EOF
 }
 `, rName, rName, rName)
 }
+
+func testAccAWSIAMRoleConfigInlinePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  inline_policy {
+    name   = "test-policy-%[1]s"
+    policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"%[2]s\"],\"Resource\":\"*\"}]}"
+  }
+}
+`, rName, action)
+}
+
+func testAccAWSIAMRoleConfigInlinePolicyMultiple(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  inline_policy {
+    name   = "test-policy-%[1]s"
+    policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"ec2:Describe*\"],\"Resource\":\"*\"}]}"
+  }
+
+  inline_policy {
+    name   = "test-policy-2-%[1]s"
+    policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"s3:ListAllMyBuckets\"],\"Resource\":\"*\"}]}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigInlinePolicyEmpty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  inline_policy {}
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigManagedPolicyArnsBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test1" {
+  name   = "test-policy-1-%[1]s"
+  policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"ec2:Describe*\"],\"Resource\":\"*\"}]}"
+}
+
+resource "aws_iam_policy" "test2" {
+  name   = "test-policy-2-%[1]s"
+  policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"s3:ListAllMyBuckets\"],\"Resource\":\"*\"}]}"
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigManagedPolicyArns(rName string) string {
+	return testAccAWSIAMRoleConfigManagedPolicyArnsBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  managed_policy_arns = ["${aws_iam_policy.test1.arn}"]
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigManagedPolicyArnsMultiple(rName string) string {
+	return testAccAWSIAMRoleConfigManagedPolicyArnsBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  managed_policy_arns = [
+    "${aws_iam_policy.test1.arn}",
+    "${aws_iam_policy.test2.arn}",
+  ]
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigInlinePolicyAndManagedPolicyArns(rName string) string {
+	return testAccAWSIAMRoleConfigManagedPolicyArnsBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%[1]s"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+
+  inline_policy {
+    name   = "test-policy-%[1]s"
+    policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"ec2:Describe*\"],\"Resource\":\"*\"}]}"
+  }
+
+  managed_policy_arns = ["${aws_iam_policy.test1.arn}"]
+}
+`, rName)
+}
+
+func testAccAWSIAMRoleConfigPermissionsBoundary(rName, permissionsBoundary string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name   = "test-role-%s"
+  path = "/"
+  assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+  permissions_boundary = "%s"
+}
+`, rName, permissionsBoundary)
+}
@@ -49,6 +49,11 @@ func resourceAwsIamUser() *schema.Resource {
 				Optional: true,
 				Default:  "/",
 			},
+			"permissions_boundary": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
 			"force_destroy": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -69,6 +74,10 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 		UserName: aws.String(name),
 	}
 
+	if v, ok := d.GetOk("permissions_boundary"); ok {
+		request.PermissionsBoundary = aws.String(v.(string))
+	}
+
 	log.Println("[DEBUG] Create IAM User request:", request)
 	createResp, err := iamconn.CreateUser(request)
 	if err != nil {
@@ -110,12 +119,18 @@ func resourceAwsIamUserReadResult(d *schema.ResourceData, user *iam.User) error
 	if err := d.Set("unique_id", user.UserId); err != nil {
 		return err
 	}
+	// Clear the boundary when it has been removed out-of-band.
+	d.Set("permissions_boundary", "")
+	if user.PermissionsBoundary != nil {
+		d.Set("permissions_boundary", user.PermissionsBoundary.PermissionsBoundaryArn)
+	}
 	return nil
 }
 
 func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
 	if d.HasChange("name") || d.HasChange("path") {
-		iamconn := meta.(*AWSClient).iamconn
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -137,9 +152,15 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		d.SetId(nn.(string))
-		return resourceAwsIamUserRead(d, meta)
 	}
-	return nil
+
+	if d.HasChange("permissions_boundary") {
+		if err := updateIamUserPermissionsBoundary(iamconn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamUserRead(d, meta)
 }
 
 func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
@@ -248,3 +269,26 @@ func validateAwsIamUserName(v interface{}, k string) (ws []string, errors []erro
 	}
 	return
 }
+
+func updateIamUserPermissionsBoundary(conn *iam.IAM, d *schema.ResourceData) error {
+	if v, ok := d.GetOk("permissions_boundary"); ok {
+		_, err := conn.PutUserPermissionsBoundary(&iam.PutUserPermissionsBoundaryInput{
+			PermissionsBoundary: aws.String(v.(string)),
+			UserName:            aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating IAM User (%s) permissions boundary: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	_, err := conn.DeleteUserPermissionsBoundary(&iam.DeleteUserPermissionsBoundaryInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return fmt.Errorf("Error deleting IAM User (%s) permissions boundary: %s", d.Id(), err)
+	}
+
+	return nil
+}
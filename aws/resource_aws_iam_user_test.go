@@ -136,6 +136,75 @@ func TestAccAWSUser_pathChange(t *testing.T) {
 	})
 }
 
+func TestAccAWSUser_permissionsBoundary(t *testing.T) {
+	var conf iam.GetUserOutput
+
+	name := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	permissionsBoundary1 := "arn:aws:iam::aws:policy/AdministratorAccess"
+	permissionsBoundary2 := "arn:aws:iam::aws:policy/ReadOnlyAccess"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSUserConfigPermissionsBoundary(name, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.user", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.user", "permissions_boundary", permissionsBoundary1),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSUserConfigPermissionsBoundary(name, permissionsBoundary2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.user", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.user", "permissions_boundary", permissionsBoundary2),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSUserConfig(name, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.user", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.user", "permissions_boundary", ""),
+				),
+			},
+			resource.TestStep{
+				// Drift: a boundary removed out-of-band is restored.
+				Config: testAccAWSUserConfigPermissionsBoundary(name, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.user", &conf),
+					testAccCheckAWSUserDeletePermissionsBoundary("aws_iam_user.user"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccAWSUserConfigPermissionsBoundary(name, permissionsBoundary1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserExists("aws_iam_user.user", &conf),
+					resource.TestCheckResourceAttr("aws_iam_user.user", "permissions_boundary", permissionsBoundary1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSUserDeletePermissionsBoundary(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := iamconn.DeleteUserPermissionsBoundary(&iam.DeleteUserPermissionsBoundaryInput{
+			UserName: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
 func testAccCheckAWSUserDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -212,3 +281,11 @@ resource "aws_iam_user" "user" {
 	path = "%s"
 }`, r, p)
 }
+
+func testAccAWSUserConfigPermissionsBoundary(r, permissionsBoundary string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "user" {
+	name                 = "%s"
+	permissions_boundary = "%s"
+}`, r, permissionsBoundary)
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderComponent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderComponentCreate,
+		Read:   resourceAwsImageBuilderComponentRead,
+		Update: resourceAwsImageBuilderComponentUpdate,
+		Delete: resourceAwsImageBuilderComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"uri"},
+				ValidateFunc:  validation.StringLenBetween(1, 16000),
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.Platform_Values(), false),
+			},
+			"supported_os_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"data"},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`),
+					"must be a semantic version of the form major.minor.patch",
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsImageBuilderComponentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateComponentInput{
+		Name:            aws.String(d.Get("name").(string)),
+		Platform:        aws.String(d.Get("platform").(string)),
+		SemanticVersion: aws.String(d.Get("version").(string)),
+	}
+
+	if v, ok := d.GetOk("change_description"); ok {
+		input.ChangeDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data"); ok {
+		input.Data = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("supported_os_versions"); ok && v.(*schema.Set).Len() > 0 {
+		input.SupportedOsVersions = expandStringSet(v.(*schema.Set))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	if v, ok := d.GetOk("uri"); ok {
+		input.Uri = aws.String(v.(string))
+	}
+
+	if input.Data == nil && input.Uri == nil {
+		return fmt.Errorf("one of data or uri must be configured")
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Component: %s", input)
+	output, err := conn.CreateComponent(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Component: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ComponentBuildVersionArn))
+
+	return resourceAwsImageBuilderComponentRead(d, meta)
+}
+
+func resourceAwsImageBuilderComponentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetComponent(&imagebuilder.GetComponentInput{
+		ComponentBuildVersionArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Component (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Component == nil {
+		log.Printf("[WARN] Image Builder Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	component := output.Component
+
+	d.Set("arn", component.Arn)
+	d.Set("change_description", component.ChangeDescription)
+	d.Set("date_created", component.DateCreated)
+	d.Set("description", component.Description)
+	d.Set("encrypted", component.Encrypted)
+	d.Set("kms_key_id", component.KmsKeyId)
+	d.Set("name", component.Name)
+	d.Set("owner", component.Owner)
+	d.Set("platform", component.Platform)
+	d.Set("type", component.Type)
+	d.Set("version", component.Version)
+
+	// The API only returns the document when it was supplied inline.
+	if _, ok := d.GetOk("uri"); !ok {
+		d.Set("data", component.Data)
+	}
+
+	if err := d.Set("supported_os_versions", flattenStringList(component.SupportedOsVersions)); err != nil {
+		return fmt.Errorf("error setting supported_os_versions: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(component.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderComponentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Component (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderComponentRead(d, meta)
+}
+
+func resourceAwsImageBuilderComponentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Component: %s", d.Id())
+	_, err := conn.DeleteComponent(&imagebuilder.DeleteComponentInput{
+		ComponentBuildVersionArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Component (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderContainerRecipe() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderContainerRecipeCreate,
+		Read:   resourceAwsImageBuilderContainerRecipeRead,
+		Update: resourceAwsImageBuilderContainerRecipeUpdate,
+		Delete: resourceAwsImageBuilderContainerRecipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": imageBuilderComponentConfigurationSchema(),
+			"container_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.ContainerType_Values(), false),
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"dockerfile_template_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dockerfile_template_uri"},
+				ValidateFunc:  validation.StringLenBetween(1, 16000),
+			},
+			"dockerfile_template_uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dockerfile_template_data"},
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^s3://`),
+					"must begin with s3://",
+				),
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"instance_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_device_mapping": imageBuilderBlockDeviceMappingSchema(),
+						"image": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":              tagsSchema(),
+			"target_repository": imageBuilderTargetContainerRepositorySchema(true),
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`),
+					"must be a semantic version of the form major.minor.patch",
+				),
+			},
+			"working_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsImageBuilderContainerRecipeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateContainerRecipeInput{
+		Components:       expandImageBuilderComponentConfigurations(d.Get("component").([]interface{})),
+		ContainerType:    aws.String(d.Get("container_type").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		ParentImage:      aws.String(d.Get("parent_image").(string)),
+		SemanticVersion:  aws.String(d.Get("version").(string)),
+		TargetRepository: expandImageBuilderTargetContainerRepository(d.Get("target_repository").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dockerfile_template_data"); ok {
+		input.DockerfileTemplateData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dockerfile_template_uri"); ok {
+		input.DockerfileTemplateUri = aws.String(v.(string))
+	}
+
+	if input.DockerfileTemplateData == nil && input.DockerfileTemplateUri == nil {
+		return fmt.Errorf("one of dockerfile_template_data or dockerfile_template_uri must be configured")
+	}
+
+	if v, ok := d.GetOk("instance_configuration"); ok {
+		input.InstanceConfiguration = expandImageBuilderInstanceConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	if v, ok := d.GetOk("working_directory"); ok {
+		input.WorkingDirectory = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Container Recipe: %s", input)
+	output, err := conn.CreateContainerRecipe(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Container Recipe: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ContainerRecipeArn))
+
+	return resourceAwsImageBuilderContainerRecipeRead(d, meta)
+}
+
+func resourceAwsImageBuilderContainerRecipeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetContainerRecipe(&imagebuilder.GetContainerRecipeInput{
+		ContainerRecipeArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Container Recipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Container Recipe (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ContainerRecipe == nil {
+		log.Printf("[WARN] Image Builder Container Recipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	recipe := output.ContainerRecipe
+
+	d.Set("arn", recipe.Arn)
+	d.Set("container_type", recipe.ContainerType)
+	d.Set("date_created", recipe.DateCreated)
+	d.Set("description", recipe.Description)
+	d.Set("encrypted", recipe.Encrypted)
+	d.Set("kms_key_id", recipe.KmsKeyId)
+	d.Set("name", recipe.Name)
+	d.Set("owner", recipe.Owner)
+	d.Set("parent_image", recipe.ParentImage)
+	d.Set("platform", recipe.Platform)
+	d.Set("version", recipe.Version)
+	d.Set("working_directory", recipe.WorkingDirectory)
+
+	// The API returns the rendered Dockerfile even when it was supplied by S3 URI.
+	if _, ok := d.GetOk("dockerfile_template_uri"); !ok {
+		d.Set("dockerfile_template_data", recipe.DockerfileTemplateData)
+	}
+
+	if err := d.Set("component", flattenImageBuilderComponentConfigurations(recipe.Components)); err != nil {
+		return fmt.Errorf("error setting component: %s", err)
+	}
+
+	if err := d.Set("instance_configuration", flattenImageBuilderInstanceConfiguration(recipe.InstanceConfiguration)); err != nil {
+		return fmt.Errorf("error setting instance_configuration: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(recipe.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("target_repository", flattenImageBuilderTargetContainerRepository(recipe.TargetRepository)); err != nil {
+		return fmt.Errorf("error setting target_repository: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderContainerRecipeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Container Recipe (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderContainerRecipeRead(d, meta)
+}
+
+func resourceAwsImageBuilderContainerRecipeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Container Recipe: %s", d.Id())
+	_, err := conn.DeleteContainerRecipe(&imagebuilder.DeleteContainerRecipeInput{
+		ContainerRecipeArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Container Recipe (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderInstanceConfiguration(l []interface{}) *imagebuilder.InstanceConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &imagebuilder.InstanceConfiguration{}
+
+	if v, ok := m["block_device_mapping"].(*schema.Set); ok && v.Len() > 0 {
+		configuration.BlockDeviceMappings = expandImageBuilderBlockDeviceMappings(v.List())
+	}
+
+	if v, ok := m["image"].(string); ok && v != "" {
+		configuration.Image = aws.String(v)
+	}
+
+	return configuration
+}
+
+func flattenImageBuilderInstanceConfiguration(configuration *imagebuilder.InstanceConfiguration) []interface{} {
+	if configuration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"block_device_mapping": flattenImageBuilderBlockDeviceMappings(configuration.BlockDeviceMappings),
+		"image":                aws.StringValue(configuration.Image),
+	}
+
+	return []interface{}{m}
+}
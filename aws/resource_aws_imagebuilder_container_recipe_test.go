@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSImageBuilderContainerRecipe_basic(t *testing.T) {
+	resourceName := "aws_imagebuilder_container_recipe.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderContainerRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderContainerRecipeConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderContainerRecipeExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`:container-recipe/%s/1.0.0$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "component.0.component_arn", "aws_imagebuilder_component.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", imagebuilder.ContainerTypeDocker),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttrSet(resourceName, "dockerfile_template_data"),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "parent_image", "amazonlinux:latest"),
+					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformLinux),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_repository.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_repository.0.repository_name", "aws_ecr_repository.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_repository.0.service", imagebuilder.ContainerRepositoryServiceEcr),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderContainerRecipe_tags(t *testing.T) {
+	resourceName := "aws_imagebuilder_container_recipe.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderContainerRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderContainerRecipeConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderContainerRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsImageBuilderContainerRecipeConfigTags1(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderContainerRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsImageBuilderContainerRecipeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_container_recipe" {
+			continue
+		}
+
+		_, err := conn.GetContainerRecipe(&imagebuilder.GetContainerRecipeInput{
+			ContainerRecipeArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Container Recipe (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderContainerRecipeExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		_, err := conn.GetContainerRecipe(&imagebuilder.GetContainerRecipeInput{
+			ContainerRecipeArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAwsImageBuilderContainerRecipeConfigBase(rName string) string {
+	return testAccAwsImageBuilderComponentConfigName(rName) + fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderContainerRecipeConfigName(rName string) string {
+	return testAccAwsImageBuilderContainerRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_container_recipe" "test" {
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  container_type = "DOCKER"
+
+  dockerfile_template_data = <<EOF
+FROM {{{ imagebuilder:parentImage }}}
+{{{ imagebuilder:environments }}}
+{{{ imagebuilder:components }}}
+EOF
+
+  name         = %[1]q
+  parent_image = "amazonlinux:latest"
+
+  target_repository {
+    repository_name = "${aws_ecr_repository.test.name}"
+    service         = "ECR"
+  }
+
+  version = "1.0.0"
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderContainerRecipeConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsImageBuilderContainerRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_container_recipe" "test" {
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  container_type = "DOCKER"
+
+  dockerfile_template_data = <<EOF
+FROM {{{ imagebuilder:parentImage }}}
+{{{ imagebuilder:environments }}}
+{{{ imagebuilder:components }}}
+EOF
+
+  name         = %[1]q
+  parent_image = "amazonlinux:latest"
+
+  target_repository {
+    repository_name = "${aws_ecr_repository.test.name}"
+    service         = "ECR"
+  }
+
+  version = "1.0.0"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,397 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderDistributionConfigurationCreate,
+		Read:   resourceAwsImageBuilderDistributionConfigurationRead,
+		Update: resourceAwsImageBuilderDistributionConfigurationUpdate,
+		Delete: resourceAwsImageBuilderDistributionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"distribution": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami_distribution_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"launch_permission": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_groups": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateAwsAccountId,
+													},
+												},
+											},
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 127),
+									},
+									"target_account_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateAwsAccountId,
+										},
+									},
+								},
+							},
+						},
+						"container_distribution_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_tags": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"target_repository": imageBuilderTargetContainerRepositorySchema(false),
+								},
+							},
+						},
+						"license_configuration_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsImageBuilderDistributionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateDistributionConfigurationInput{
+		Distributions: expandImageBuilderDistributions(d.Get("distribution").(*schema.Set).List()),
+		Name:          aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Distribution Configuration: %s", input)
+	output, err := conn.CreateDistributionConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Distribution Configuration: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DistributionConfigurationArn))
+
+	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetDistributionConfiguration(&imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Distribution Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Distribution Configuration (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.DistributionConfiguration == nil {
+		log.Printf("[WARN] Image Builder Distribution Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	configuration := output.DistributionConfiguration
+
+	d.Set("arn", configuration.Arn)
+	d.Set("date_created", configuration.DateCreated)
+	d.Set("date_updated", configuration.DateUpdated)
+	d.Set("description", configuration.Description)
+	d.Set("name", configuration.Name)
+
+	if err := d.Set("distribution", flattenImageBuilderDistributions(configuration.Distributions)); err != nil {
+		return fmt.Errorf("error setting distribution: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(configuration.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderDistributionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChange("description") || d.HasChange("distribution") {
+		input := &imagebuilder.UpdateDistributionConfigurationInput{
+			DistributionConfigurationArn: aws.String(d.Id()),
+			Distributions:                expandImageBuilderDistributions(d.Get("distribution").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Distribution Configuration: %s", input)
+		_, err := conn.UpdateDistributionConfiguration(input)
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Distribution Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Distribution Configuration (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderDistributionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Distribution Configuration: %s", d.Id())
+	_, err := conn.DeleteDistributionConfiguration(&imagebuilder.DeleteDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Distribution Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderDistributions(l []interface{}) []*imagebuilder.Distribution {
+	distributions := make([]*imagebuilder.Distribution, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		distribution := &imagebuilder.Distribution{
+			Region: aws.String(m["region"].(string)),
+		}
+
+		if v, ok := m["ami_distribution_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			distribution.AmiDistributionConfiguration = expandImageBuilderAmiDistributionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := m["container_distribution_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			distribution.ContainerDistributionConfiguration = expandImageBuilderContainerDistributionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := m["license_configuration_arns"].(*schema.Set); ok && v.Len() > 0 {
+			distribution.LicenseConfigurationArns = expandStringSet(v)
+		}
+
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions
+}
+
+func expandImageBuilderAmiDistributionConfiguration(m map[string]interface{}) *imagebuilder.AmiDistributionConfiguration {
+	configuration := &imagebuilder.AmiDistributionConfiguration{}
+
+	if v, ok := m["ami_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		configuration.AmiTags = tagsFromMapGeneric(v)
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		configuration.Description = aws.String(v)
+	}
+
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		configuration.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := m["launch_permission"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		launchPermission := v[0].(map[string]interface{})
+		configuration.LaunchPermission = &imagebuilder.LaunchPermissionConfiguration{}
+
+		if v, ok := launchPermission["user_groups"].(*schema.Set); ok && v.Len() > 0 {
+			configuration.LaunchPermission.UserGroups = expandStringSet(v)
+		}
+
+		if v, ok := launchPermission["user_ids"].(*schema.Set); ok && v.Len() > 0 {
+			configuration.LaunchPermission.UserIds = expandStringSet(v)
+		}
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		configuration.Name = aws.String(v)
+	}
+
+	if v, ok := m["target_account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		configuration.TargetAccountIds = expandStringSet(v)
+	}
+
+	return configuration
+}
+
+func expandImageBuilderContainerDistributionConfiguration(m map[string]interface{}) *imagebuilder.ContainerDistributionConfiguration {
+	configuration := &imagebuilder.ContainerDistributionConfiguration{
+		TargetRepository: expandImageBuilderTargetContainerRepository(m["target_repository"].([]interface{})),
+	}
+
+	if v, ok := m["container_tags"].(*schema.Set); ok && v.Len() > 0 {
+		configuration.ContainerTags = expandStringSet(v)
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		configuration.Description = aws.String(v)
+	}
+
+	return configuration
+}
+
+func flattenImageBuilderDistributions(distributions []*imagebuilder.Distribution) []interface{} {
+	l := make([]interface{}, 0, len(distributions))
+
+	for _, distribution := range distributions {
+		if distribution == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"license_configuration_arns": flattenStringList(distribution.LicenseConfigurationArns),
+			"region":                     aws.StringValue(distribution.Region),
+		}
+
+		if configuration := distribution.AmiDistributionConfiguration; configuration != nil {
+			ami := map[string]interface{}{
+				"ami_tags":           tagsToMapGeneric(configuration.AmiTags),
+				"description":        aws.StringValue(configuration.Description),
+				"kms_key_id":         aws.StringValue(configuration.KmsKeyId),
+				"name":               aws.StringValue(configuration.Name),
+				"target_account_ids": flattenStringList(configuration.TargetAccountIds),
+			}
+
+			if launchPermission := configuration.LaunchPermission; launchPermission != nil {
+				ami["launch_permission"] = []interface{}{
+					map[string]interface{}{
+						"user_groups": flattenStringList(launchPermission.UserGroups),
+						"user_ids":    flattenStringList(launchPermission.UserIds),
+					},
+				}
+			}
+
+			m["ami_distribution_configuration"] = []interface{}{ami}
+		}
+
+		if configuration := distribution.ContainerDistributionConfiguration; configuration != nil {
+			m["container_distribution_configuration"] = []interface{}{
+				map[string]interface{}{
+					"container_tags":    flattenStringList(configuration.ContainerTags),
+					"description":       aws.StringValue(configuration.Description),
+					"target_repository": flattenImageBuilderTargetContainerRepository(configuration.TargetRepository),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
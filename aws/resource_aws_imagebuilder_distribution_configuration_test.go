@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSImageBuilderDistributionConfiguration_basic(t *testing.T) {
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigAmiName(rName, "{{ imagebuilder:buildDate }}-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`:distribution-configuration/%s$`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigAmiName(rName, "{{ imagebuilder:buildDate }}-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderDistributionConfiguration_ContainerDistributionConfiguration(t *testing.T) {
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigContainerDistributionConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderDistributionConfiguration_tags(t *testing.T) {
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsImageBuilderDistributionConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_distribution_configuration" {
+			continue
+		}
+
+		_, err := conn.GetDistributionConfiguration(&imagebuilder.GetDistributionConfigurationInput{
+			DistributionConfigurationArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Distribution Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderDistributionConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		_, err := conn.GetDistributionConfiguration(&imagebuilder.GetDistributionConfigurationInput{
+			DistributionConfigurationArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAwsImageBuilderDistributionConfigurationConfigAmiName(rName, amiName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      ami_tags {
+        Name = %[1]q
+      }
+
+      name = %[2]q
+    }
+
+    region = "${data.aws_region.current.name}"
+  }
+}
+`, rName, amiName)
+}
+
+func testAccAwsImageBuilderDistributionConfigurationConfigContainerDistributionConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    container_distribution_configuration {
+      container_tags = ["latest"]
+
+      target_repository {
+        repository_name = "${aws_ecr_repository.test.name}"
+        service         = "ECR"
+      }
+    }
+
+    region = "${data.aws_region.current.name}"
+  }
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderDistributionConfigurationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      name = "{{ imagebuilder:buildDate }}"
+    }
+
+    region = "${data.aws_region.current.name}"
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
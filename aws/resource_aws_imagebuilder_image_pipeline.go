@@ -0,0 +1,375 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderImagePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderImagePipelineCreate,
+		Read:   resourceAwsImageBuilderImagePipelineRead,
+		Update: resourceAwsImageBuilderImagePipelineUpdate,
+		Delete: resourceAwsImageBuilderImagePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_recipe_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"image_recipe_arn"},
+				ValidateFunc:  validateArn,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_last_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_next_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"distribution_configuration_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"enhanced_image_metadata_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"image_recipe_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"container_recipe_arn"},
+				ValidateFunc:  validateArn,
+			},
+			"image_tests_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_tests_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      720,
+							ValidateFunc: validation.IntBetween(60, 1440),
+						},
+					},
+				},
+			},
+			"infrastructure_configuration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipeline_execution_start_condition": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      imagebuilder.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable,
+							ValidateFunc: validation.StringInSlice(imagebuilder.PipelineExecutionStartCondition_Values(), false),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(3, 100),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      imagebuilder.PipelineStatusEnabled,
+				ValidateFunc: validation.StringInSlice(imagebuilder.PipelineStatus_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsImageBuilderImagePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateImagePipelineInput{
+		EnhancedImageMetadataEnabled:   aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
+		InfrastructureConfigurationArn: aws.String(d.Get("infrastructure_configuration_arn").(string)),
+		Name:                           aws.String(d.Get("name").(string)),
+		Status:                         aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("container_recipe_arn"); ok {
+		input.ContainerRecipeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distribution_configuration_arn"); ok {
+		input.DistributionConfigurationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_recipe_arn"); ok {
+		input.ImageRecipeArn = aws.String(v.(string))
+	}
+
+	if input.ContainerRecipeArn == nil && input.ImageRecipeArn == nil {
+		return fmt.Errorf("one of container_recipe_arn or image_recipe_arn must be configured")
+	}
+
+	if v, ok := d.GetOk("image_tests_configuration"); ok {
+		input.ImageTestsConfiguration = expandImageBuilderImageTestsConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = expandImageBuilderSchedule(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Image Pipeline: %s", input)
+	output, err := conn.CreateImagePipeline(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Image Pipeline: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ImagePipelineArn))
+
+	return resourceAwsImageBuilderImagePipelineRead(d, meta)
+}
+
+func resourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetImagePipeline(&imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Image Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Image Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ImagePipeline == nil {
+		log.Printf("[WARN] Image Builder Image Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pipeline := output.ImagePipeline
+
+	d.Set("arn", pipeline.Arn)
+	d.Set("container_recipe_arn", pipeline.ContainerRecipeArn)
+	d.Set("date_created", pipeline.DateCreated)
+	d.Set("date_last_run", pipeline.DateLastRun)
+	d.Set("date_next_run", pipeline.DateNextRun)
+	d.Set("date_updated", pipeline.DateUpdated)
+	d.Set("description", pipeline.Description)
+	d.Set("distribution_configuration_arn", pipeline.DistributionConfigurationArn)
+	d.Set("enhanced_image_metadata_enabled", pipeline.EnhancedImageMetadataEnabled)
+	d.Set("image_recipe_arn", pipeline.ImageRecipeArn)
+	d.Set("infrastructure_configuration_arn", pipeline.InfrastructureConfigurationArn)
+	d.Set("name", pipeline.Name)
+	d.Set("platform", pipeline.Platform)
+	d.Set("status", pipeline.Status)
+
+	if err := d.Set("image_tests_configuration", flattenImageBuilderImageTestsConfiguration(pipeline.ImageTestsConfiguration)); err != nil {
+		return fmt.Errorf("error setting image_tests_configuration: %s", err)
+	}
+
+	if err := d.Set("schedule", flattenImageBuilderSchedule(pipeline.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(pipeline.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderImagePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChange("container_recipe_arn") || d.HasChange("description") || d.HasChange("distribution_configuration_arn") || d.HasChange("enhanced_image_metadata_enabled") || d.HasChange("image_recipe_arn") || d.HasChange("image_tests_configuration") || d.HasChange("infrastructure_configuration_arn") || d.HasChange("schedule") || d.HasChange("status") {
+		// The update replaces the whole pipeline, so every argument is sent.
+		input := &imagebuilder.UpdateImagePipelineInput{
+			EnhancedImageMetadataEnabled:   aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
+			ImagePipelineArn:               aws.String(d.Id()),
+			InfrastructureConfigurationArn: aws.String(d.Get("infrastructure_configuration_arn").(string)),
+			Status:                         aws.String(d.Get("status").(string)),
+		}
+
+		if v, ok := d.GetOk("container_recipe_arn"); ok {
+			input.ContainerRecipeArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("distribution_configuration_arn"); ok {
+			input.DistributionConfigurationArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("image_recipe_arn"); ok {
+			input.ImageRecipeArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("image_tests_configuration"); ok {
+			input.ImageTestsConfiguration = expandImageBuilderImageTestsConfiguration(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("schedule"); ok {
+			input.Schedule = expandImageBuilderSchedule(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Image Pipeline: %s", input)
+		_, err := conn.UpdateImagePipeline(input)
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Image Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Image Pipeline (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderImagePipelineRead(d, meta)
+}
+
+func resourceAwsImageBuilderImagePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Image Pipeline: %s", d.Id())
+	_, err := conn.DeleteImagePipeline(&imagebuilder.DeleteImagePipelineInput{
+		ImagePipelineArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Image Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderImageTestsConfiguration(l []interface{}) *imagebuilder.ImageTestsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &imagebuilder.ImageTestsConfiguration{
+		ImageTestsEnabled: aws.Bool(m["image_tests_enabled"].(bool)),
+		TimeoutMinutes:    aws.Int64(int64(m["timeout_minutes"].(int))),
+	}
+}
+
+func flattenImageBuilderImageTestsConfiguration(configuration *imagebuilder.ImageTestsConfiguration) []interface{} {
+	if configuration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"image_tests_enabled": aws.BoolValue(configuration.ImageTestsEnabled),
+		"timeout_minutes":     int(aws.Int64Value(configuration.TimeoutMinutes)),
+	}
+
+	return []interface{}{m}
+}
+
+func expandImageBuilderSchedule(l []interface{}) *imagebuilder.Schedule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	schedule := &imagebuilder.Schedule{
+		PipelineExecutionStartCondition: aws.String(m["pipeline_execution_start_condition"].(string)),
+		ScheduleExpression:              aws.String(m["schedule_expression"].(string)),
+	}
+
+	if v, ok := m["timezone"].(string); ok && v != "" {
+		schedule.Timezone = aws.String(v)
+	}
+
+	return schedule
+}
+
+func flattenImageBuilderSchedule(schedule *imagebuilder.Schedule) []interface{} {
+	if schedule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"pipeline_execution_start_condition": aws.StringValue(schedule.PipelineExecutionStartCondition),
+		"schedule_expression":                aws.StringValue(schedule.ScheduleExpression),
+		"timezone":                           aws.StringValue(schedule.Timezone),
+	}
+
+	return []interface{}{m}
+}
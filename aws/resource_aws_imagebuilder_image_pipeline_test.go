@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSImageBuilderImagePipeline_basic(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+	imageRecipeResourceName := "aws_imagebuilder_image_recipe.test"
+	infrastructureConfigurationResourceName := "aws_imagebuilder_infrastructure_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`:image-pipeline/%s$`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "distribution_configuration_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_recipe_arn", imageRecipeResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.image_tests_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.timeout_minutes", "720"),
+					resource.TestCheckResourceAttrPair(resourceName, "infrastructure_configuration_arn", infrastructureConfigurationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformLinux),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.PipelineStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderImagePipeline_Schedule(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigSchedule(rName, "cron(1 0 * * ? *)", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.pipeline_execution_start_condition", imagebuilder.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.schedule_expression", "cron(1 0 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.PipelineStatusDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigSchedule(rName, "cron(2 0 * * ? *)", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.schedule_expression", "cron(2 0 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.PipelineStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderImagePipeline_tags(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsImageBuilderImagePipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_image_pipeline" {
+			continue
+		}
+
+		_, err := conn.GetImagePipeline(&imagebuilder.GetImagePipelineInput{
+			ImagePipelineArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Image Pipeline (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderImagePipelineExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		_, err := conn.GetImagePipeline(&imagebuilder.GetImagePipelineInput{
+			ImagePipelineArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAwsImageBuilderImagePipelineConfigBase(rName string) string {
+	return testAccAwsImageBuilderImageRecipeConfigName(rName) +
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = "${aws_iam_instance_profile.test.name}"
+  name                  = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderImagePipelineConfigDescription(rName, description string) string {
+	return testAccAwsImageBuilderImagePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  description                      = %[2]q
+  image_recipe_arn                 = "${aws_imagebuilder_image_recipe.test.arn}"
+  infrastructure_configuration_arn = "${aws_imagebuilder_infrastructure_configuration.test.arn}"
+  name                             = %[1]q
+}
+`, rName, description)
+}
+
+func testAccAwsImageBuilderImagePipelineConfigSchedule(rName, scheduleExpression, status string) string {
+	return testAccAwsImageBuilderImagePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = "${aws_imagebuilder_image_recipe.test.arn}"
+  infrastructure_configuration_arn = "${aws_imagebuilder_infrastructure_configuration.test.arn}"
+  name                             = %[1]q
+  status                           = %[3]q
+
+  schedule {
+    schedule_expression = %[2]q
+  }
+}
+`, rName, scheduleExpression, status)
+}
+
+func testAccAwsImageBuilderImagePipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsImageBuilderImagePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = "${aws_imagebuilder_image_recipe.test.arn}"
+  infrastructure_configuration_arn = "${aws_imagebuilder_infrastructure_configuration.test.arn}"
+  name                             = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
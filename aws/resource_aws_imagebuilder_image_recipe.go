@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderImageRecipe() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderImageRecipeCreate,
+		Read:   resourceAwsImageBuilderImageRecipeRead,
+		Update: resourceAwsImageBuilderImageRecipeUpdate,
+		Delete: resourceAwsImageBuilderImageRecipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"block_device_mapping": imageBuilderBlockDeviceMappingSchema(),
+			"component":            imageBuilderComponentConfigurationSchema(),
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`),
+					"must be a semantic version of the form major.minor.patch",
+				),
+			},
+			"working_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsImageBuilderImageRecipeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateImageRecipeInput{
+		Components:      expandImageBuilderComponentConfigurations(d.Get("component").([]interface{})),
+		Name:            aws.String(d.Get("name").(string)),
+		ParentImage:     aws.String(d.Get("parent_image").(string)),
+		SemanticVersion: aws.String(d.Get("version").(string)),
+	}
+
+	if v, ok := d.GetOk("block_device_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		input.BlockDeviceMappings = expandImageBuilderBlockDeviceMappings(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	if v, ok := d.GetOk("working_directory"); ok {
+		input.WorkingDirectory = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Image Recipe: %s", input)
+	output, err := conn.CreateImageRecipe(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Image Recipe: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ImageRecipeArn))
+
+	return resourceAwsImageBuilderImageRecipeRead(d, meta)
+}
+
+func resourceAwsImageBuilderImageRecipeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetImageRecipe(&imagebuilder.GetImageRecipeInput{
+		ImageRecipeArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Image Recipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Image Recipe (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ImageRecipe == nil {
+		log.Printf("[WARN] Image Builder Image Recipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	recipe := output.ImageRecipe
+
+	d.Set("arn", recipe.Arn)
+	d.Set("date_created", recipe.DateCreated)
+	d.Set("description", recipe.Description)
+	d.Set("name", recipe.Name)
+	d.Set("owner", recipe.Owner)
+	d.Set("parent_image", recipe.ParentImage)
+	d.Set("platform", recipe.Platform)
+	d.Set("version", recipe.Version)
+	d.Set("working_directory", recipe.WorkingDirectory)
+
+	if err := d.Set("block_device_mapping", flattenImageBuilderBlockDeviceMappings(recipe.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("error setting block_device_mapping: %s", err)
+	}
+
+	if err := d.Set("component", flattenImageBuilderComponentConfigurations(recipe.Components)); err != nil {
+		return fmt.Errorf("error setting component: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(recipe.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderImageRecipeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Image Recipe (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderImageRecipeRead(d, meta)
+}
+
+func resourceAwsImageBuilderImageRecipeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Image Recipe: %s", d.Id())
+	_, err := conn.DeleteImageRecipe(&imagebuilder.DeleteImageRecipeInput{
+		ImageRecipeArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Image Recipe (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSImageBuilderImageRecipe_basic(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_recipe.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`:image-recipe/%s/1.0.0$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mapping.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "component.0.component_arn", "aws_imagebuilder_component.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformLinux),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderImageRecipe_BlockDeviceMapping(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_recipe.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigBlockDeviceMapping(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mapping.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderImageRecipe_tags(t *testing.T) {
+	resourceName := "aws_imagebuilder_image_recipe.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsImageBuilderImageRecipeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_image_recipe" {
+			continue
+		}
+
+		_, err := conn.GetImageRecipe(&imagebuilder.GetImageRecipeInput{
+			ImageRecipeArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Image Recipe (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderImageRecipeExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		_, err := conn.GetImageRecipe(&imagebuilder.GetImageRecipeInput{
+			ImageRecipeArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccAwsImageBuilderImageRecipeConfigBase provides a build component and
+// the Amazon managed Amazon Linux 2 parent image used by the recipe tests.
+func testAccAwsImageBuilderImageRecipeConfigBase(rName string) string {
+	return testAccAwsImageBuilderComponentConfigName(rName) + `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+locals {
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+}
+`
+}
+
+func testAccAwsImageBuilderImageRecipeConfigName(rName string) string {
+	return testAccAwsImageBuilderImageRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  name         = %[1]q
+  parent_image = "${local.parent_image}"
+  version      = "1.0.0"
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderImageRecipeConfigBlockDeviceMapping(rName string) string {
+	return testAccAwsImageBuilderImageRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  block_device_mapping {
+    device_name = "/dev/xvdb"
+
+    ebs {
+      delete_on_termination = true
+      volume_size           = 20
+      volume_type           = "gp3"
+    }
+  }
+
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  name         = %[1]q
+  parent_image = "${local.parent_image}"
+  version      = "1.0.0"
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderImageRecipeConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsImageBuilderImageRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  name         = %[1]q
+  parent_image = "${local.parent_image}"
+  version      = "1.0.0"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsImageBuilderImageRecipeConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsImageBuilderImageRecipeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  component {
+    component_arn = "${aws_imagebuilder_component.test.arn}"
+  }
+
+  name         = %[1]q
+  parent_image = "${local.parent_image}"
+  version      = "1.0.0"
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
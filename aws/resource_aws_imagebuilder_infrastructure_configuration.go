@@ -0,0 +1,343 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderInfrastructureConfigurationCreate,
+		Read:   resourceAwsImageBuilderInfrastructureConfigurationRead,
+		Update: resourceAwsImageBuilderInfrastructureConfigurationUpdate,
+		Delete: resourceAwsImageBuilderInfrastructureConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"instance_profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"instance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_pair": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"s3_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"terminate_instance_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.CreateInfrastructureConfigurationInput{
+		InstanceProfileName:        aws.String(d.Get("instance_profile_name").(string)),
+		Name:                       aws.String(d.Get("name").(string)),
+		TerminateInstanceOnFailure: aws.Bool(d.Get("terminate_instance_on_failure").(bool)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceTypes = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("key_pair"); ok {
+		input.KeyPair = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging"); ok {
+		input.Logging = expandImageBuilderLogging(v.([]interface{}))
+	}
+
+	if v := d.Get("resource_tags").(map[string]interface{}); len(v) > 0 {
+		input.ResourceTags = tagsFromMapGeneric(v)
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Infrastructure Configuration: %s", input)
+	output, err := conn.CreateInfrastructureConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Infrastructure Configuration: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.InfrastructureConfigurationArn))
+
+	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	output, err := conn.GetInfrastructureConfiguration(&imagebuilder.GetInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Image Builder Infrastructure Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Infrastructure Configuration (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.InfrastructureConfiguration == nil {
+		log.Printf("[WARN] Image Builder Infrastructure Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	configuration := output.InfrastructureConfiguration
+
+	d.Set("arn", configuration.Arn)
+	d.Set("date_created", configuration.DateCreated)
+	d.Set("date_updated", configuration.DateUpdated)
+	d.Set("description", configuration.Description)
+	d.Set("instance_profile_name", configuration.InstanceProfileName)
+	d.Set("key_pair", configuration.KeyPair)
+	d.Set("name", configuration.Name)
+	d.Set("sns_topic_arn", configuration.SnsTopicArn)
+	d.Set("subnet_id", configuration.SubnetId)
+	d.Set("terminate_instance_on_failure", configuration.TerminateInstanceOnFailure)
+
+	if err := d.Set("instance_types", flattenStringList(configuration.InstanceTypes)); err != nil {
+		return fmt.Errorf("error setting instance_types: %s", err)
+	}
+
+	if err := d.Set("logging", flattenImageBuilderLogging(configuration.Logging)); err != nil {
+		return fmt.Errorf("error setting logging: %s", err)
+	}
+
+	if err := d.Set("resource_tags", tagsToMapGeneric(configuration.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	if err := d.Set("security_group_ids", flattenStringList(configuration.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(configuration.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChange("description") || d.HasChange("instance_profile_name") || d.HasChange("instance_types") || d.HasChange("key_pair") || d.HasChange("logging") || d.HasChange("resource_tags") || d.HasChange("security_group_ids") || d.HasChange("sns_topic_arn") || d.HasChange("subnet_id") || d.HasChange("terminate_instance_on_failure") {
+		// The update replaces the whole configuration, so every argument is sent.
+		input := &imagebuilder.UpdateInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: aws.String(d.Id()),
+			InstanceProfileName:            aws.String(d.Get("instance_profile_name").(string)),
+			TerminateInstanceOnFailure:     aws.Bool(d.Get("terminate_instance_on_failure").(bool)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+			input.InstanceTypes = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("key_pair"); ok {
+			input.KeyPair = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("logging"); ok {
+			input.Logging = expandImageBuilderLogging(v.([]interface{}))
+		}
+
+		if v := d.Get("resource_tags").(map[string]interface{}); len(v) > 0 {
+			input.ResourceTags = tagsFromMapGeneric(v)
+		}
+
+		if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+			input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("sns_topic_arn"); ok {
+			input.SnsTopicArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("subnet_id"); ok {
+			input.SubnetId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Infrastructure Configuration: %s", input)
+		_, err := conn.UpdateInfrastructureConfiguration(input)
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsImageBuilder(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Infrastructure Configuration: %s", d.Id())
+	_, err := conn.DeleteInfrastructureConfiguration(&imagebuilder.DeleteInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderLogging(l []interface{}) *imagebuilder.Logging {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	logging := &imagebuilder.Logging{}
+
+	if v, ok := m["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3Logs := v[0].(map[string]interface{})
+		logging.S3Logs = &imagebuilder.S3Logs{
+			S3BucketName: aws.String(s3Logs["s3_bucket_name"].(string)),
+		}
+
+		if v, ok := s3Logs["s3_key_prefix"].(string); ok && v != "" {
+			logging.S3Logs.S3KeyPrefix = aws.String(v)
+		}
+	}
+
+	return logging
+}
+
+func flattenImageBuilderLogging(logging *imagebuilder.Logging) []interface{} {
+	if logging == nil || logging.S3Logs == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"s3_logs": []interface{}{
+			map[string]interface{}{
+				"s3_bucket_name": aws.StringValue(logging.S3Logs.S3BucketName),
+				"s3_key_prefix":  aws.StringValue(logging.S3Logs.S3KeyPrefix),
+			},
+		},
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSImageBuilderInfrastructureConfiguration_basic(t *testing.T) {
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+	iamInstanceProfileResourceName := "aws_iam_instance_profile.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigDescription(rName, "description1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`:infrastructure-configuration/%s$`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_profile_name", iamInstanceProfileResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instance_on_failure", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigDescription(rName, "description2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instance_on_failure", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderInfrastructureConfiguration_Logging(t *testing.T) {
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigLogging(rName, "/prefix1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.s3_logs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging.0.s3_logs.0.s3_bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.s3_logs.0.s3_key_prefix", "/prefix1/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigLogging(rName, "/prefix2/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.s3_logs.0.s3_key_prefix", "/prefix2/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSImageBuilderInfrastructureConfiguration_tags(t *testing.T) {
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_infrastructure_configuration" {
+			continue
+		}
+
+		_, err := conn.GetInfrastructureConfiguration(&imagebuilder.GetInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, imagebuilder.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Infrastructure Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderInfrastructureConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		_, err := conn.GetInfrastructureConfiguration(&imagebuilder.GetInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccAwsImageBuilderInfrastructureConfigurationConfigBase provides the
+// instance profile assumed by the build and test instances.
+func testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.name}"
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigDescription(rName, description string, terminateInstanceOnFailure bool) string {
+	return testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  description                   = %[2]q
+  instance_profile_name         = "${aws_iam_instance_profile.test.name}"
+  name                          = %[1]q
+  terminate_instance_on_failure = %[3]t
+}
+`, rName, description, terminateInstanceOnFailure)
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigLogging(rName, s3KeyPrefix string) string {
+	return testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = "${aws_iam_instance_profile.test.name}"
+  name                  = %[1]q
+
+  logging {
+    s3_logs {
+      s3_bucket_name = "${aws_s3_bucket.test.bucket}"
+      s3_key_prefix  = %[2]q
+    }
+  }
+}
+`, rName, s3KeyPrefix)
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = "${aws_iam_instance_profile.test.name}"
+  name                  = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
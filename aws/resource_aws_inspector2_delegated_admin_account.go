@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsInspector2DelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2DelegatedAdminAccountCreate,
+		Read:   resourceAwsInspector2DelegatedAdminAccountRead,
+		Delete: resourceAwsInspector2DelegatedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2DelegatedAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+	accountID := d.Get("account_id").(string)
+
+	input := &inspector2.EnableDelegatedAdminAccountInput{
+		ClientToken:             aws.String(resource.UniqueId()),
+		DelegatedAdminAccountId: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector2 Delegated Admin Account: %s", input)
+	_, err := conn.EnableDelegatedAdminAccount(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Inspector2 Delegated Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"NotFound"},
+		Target:  []string{inspector2.DelegatedAdminStatusEnabled},
+		Refresh: inspector2DelegatedAdminAccountRefreshStatusFunc(conn, d.Id()),
+		Timeout: 5 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) to enable: %s", d.Id(), err)
+	}
+
+	return resourceAwsInspector2DelegatedAdminAccountRead(d, meta)
+}
+
+func resourceAwsInspector2DelegatedAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	adminAccount, err := getInspector2DelegatedAdminAccount(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if adminAccount == nil {
+		log.Printf("[WARN] Inspector2 Delegated Admin Account %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", adminAccount.AccountId)
+	d.Set("relationship_status", adminAccount.Status)
+
+	return nil
+}
+
+func resourceAwsInspector2DelegatedAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	input := &inspector2.DisableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Disabling Inspector2 Delegated Admin Account: %s", input)
+	_, err := conn.DisableDelegatedAdminAccount(input)
+	if isAWSErr(err, inspector2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disabling Inspector2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.DelegatedAdminStatusEnabled, inspector2.DelegatedAdminStatusDisableInProgress},
+		Target:  []string{"NotFound"},
+		Refresh: inspector2DelegatedAdminAccountRefreshStatusFunc(conn, d.Id()),
+		Timeout: 5 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) to disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getInspector2DelegatedAdminAccount(conn *inspector2.Inspector2, accountID string) (*inspector2.DelegatedAdminAccount, error) {
+	var result *inspector2.DelegatedAdminAccount
+
+	err := conn.ListDelegatedAdminAccountsPages(&inspector2.ListDelegatedAdminAccountsInput{}, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		for _, adminAccount := range page.DelegatedAdminAccounts {
+			if aws.StringValue(adminAccount.AccountId) == accountID {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func inspector2DelegatedAdminAccountRefreshStatusFunc(conn *inspector2.Inspector2, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		adminAccount, err := getInspector2DelegatedAdminAccount(conn, accountID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if adminAccount == nil {
+			return &inspector2.DelegatedAdminAccount{}, "NotFound", nil
+		}
+
+		return adminAccount, aws.StringValue(adminAccount.Status), nil
+	}
+}
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSInspector2DelegatedAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2DelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2DelegatedAdminAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2DelegatedAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", inspector2.DelegatedAdminStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2DelegatedAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_delegated_admin_account" {
+			continue
+		}
+
+		adminAccount, err := getInspector2DelegatedAdminAccount(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if adminAccount != nil {
+			return fmt.Errorf("Inspector2 Delegated Admin Account (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2DelegatedAdminAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		adminAccount, err := getInspector2DelegatedAdminAccount(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if adminAccount == nil {
+			return fmt.Errorf("Inspector2 Delegated Admin Account (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsInspector2DelegatedAdminAccountConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = "${data.aws_caller_identity.current.account_id}"
+}
+`
+}
@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsInspector2Enabler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2EnablerCreate,
+		Read:   resourceAwsInspector2EnablerRead,
+		Update: resourceAwsInspector2EnablerUpdate,
+		Delete: resourceAwsInspector2EnablerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsInspector2EnablerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inspector2.ResourceScanType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2EnablerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+	resourceTypes := expandStringSet(d.Get("resource_types").(*schema.Set))
+
+	if err := inspector2EnableResourceTypes(conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(inspector2EnablerID(accountIDs))
+
+	return resourceAwsInspector2EnablerRead(d, meta)
+}
+
+func resourceAwsInspector2EnablerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+
+	statuses, err := getInspector2AccountResourceStatuses(conn, accountIDs)
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Enabler (%s): %s", d.Id(), err)
+	}
+
+	// Only report the resource types that are enabled in every account, so
+	// that a partially enabled account shows up as a difference.
+	var resourceTypes []string
+	for _, resourceType := range inspector2.ResourceScanType_Values() {
+		enabled := true
+		for _, accountID := range accountIDs {
+			if statuses[aws.StringValue(accountID)][resourceType] != inspector2.StatusEnabled {
+				enabled = false
+				break
+			}
+		}
+
+		if enabled {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	if len(resourceTypes) == 0 {
+		log.Printf("[WARN] Inspector2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("resource_types", resourceTypes); err != nil {
+		return fmt.Errorf("error setting resource_types: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsInspector2EnablerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	if d.HasChange("resource_types") {
+		accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+
+		o, n := d.GetChange("resource_types")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			if err := inspector2EnableResourceTypes(conn, accountIDs, expandStringSet(add), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			if err := inspector2DisableResourceTypes(conn, accountIDs, expandStringSet(remove), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsInspector2EnablerRead(d, meta)
+}
+
+func resourceAwsInspector2EnablerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+	resourceTypes := expandStringSet(d.Get("resource_types").(*schema.Set))
+
+	return inspector2DisableResourceTypes(conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutDelete))
+}
+
+func resourceAwsInspector2EnablerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountIDs := strings.Split(d.Id(), ",")
+	for _, accountID := range accountIDs {
+		if accountID == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected ACCOUNT_ID[,ACCOUNT_ID...]", d.Id())
+		}
+	}
+
+	d.Set("account_ids", accountIDs)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// inspector2EnablerID builds an ID from the sorted, comma separated list of
+// account IDs.
+func inspector2EnablerID(accountIDs []*string) string {
+	ids := aws.StringValueSlice(accountIDs)
+	sort.Strings(ids)
+
+	return strings.Join(ids, ",")
+}
+
+func inspector2EnableResourceTypes(conn *inspector2.Inspector2, accountIDs, resourceTypes []*string, timeout time.Duration) error {
+	input := &inspector2.EnableInput{
+		AccountIds:    accountIDs,
+		ClientToken:   aws.String(resource.UniqueId()),
+		ResourceTypes: resourceTypes,
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector2: %s", input)
+	output, err := conn.Enable(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Inspector2: %s", err)
+	}
+
+	if len(output.FailedAccounts) > 0 {
+		failed := output.FailedAccounts[0]
+		return fmt.Errorf("error enabling Inspector2 for account %s: %s: %s", aws.StringValue(failed.AccountId), aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		// Accounts can briefly report as disabled before enabling starts.
+		Pending: []string{inspector2.StatusDisabled, inspector2.StatusEnabling},
+		Target:  []string{inspector2.StatusEnabled},
+		Refresh: inspector2EnablerRefreshStatusFunc(conn, accountIDs, resourceTypes, inspector2.StatusEnabled),
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 to enable: %s", err)
+	}
+
+	return nil
+}
+
+func inspector2DisableResourceTypes(conn *inspector2.Inspector2, accountIDs, resourceTypes []*string, timeout time.Duration) error {
+	input := &inspector2.DisableInput{
+		AccountIds:    accountIDs,
+		ResourceTypes: resourceTypes,
+	}
+
+	log.Printf("[DEBUG] Disabling Inspector2: %s", input)
+	output, err := conn.Disable(input)
+	if err != nil {
+		return fmt.Errorf("error disabling Inspector2: %s", err)
+	}
+
+	if len(output.FailedAccounts) > 0 {
+		failed := output.FailedAccounts[0]
+		return fmt.Errorf("error disabling Inspector2 for account %s: %s: %s", aws.StringValue(failed.AccountId), aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.StatusEnabled, inspector2.StatusDisabling},
+		Target:  []string{inspector2.StatusDisabled},
+		Refresh: inspector2EnablerRefreshStatusFunc(conn, accountIDs, resourceTypes, inspector2.StatusDisabled),
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Inspector2 to disable: %s", err)
+	}
+
+	return nil
+}
+
+// getInspector2AccountResourceStatuses returns the scan status of each
+// resource type, keyed by account ID and then resource type.
+func getInspector2AccountResourceStatuses(conn *inspector2.Inspector2, accountIDs []*string) (map[string]map[string]string, error) {
+	output, err := conn.BatchGetAccountStatus(&inspector2.BatchGetAccountStatusInput{
+		AccountIds: accountIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]string)
+	for _, account := range output.Accounts {
+		if account.ResourceState == nil {
+			continue
+		}
+
+		statuses := make(map[string]string)
+		for resourceType, state := range map[string]*inspector2.State{
+			inspector2.ResourceScanTypeEc2:        account.ResourceState.Ec2,
+			inspector2.ResourceScanTypeEcr:        account.ResourceState.Ecr,
+			inspector2.ResourceScanTypeLambda:     account.ResourceState.Lambda,
+			inspector2.ResourceScanTypeLambdaCode: account.ResourceState.LambdaCode,
+		} {
+			if state != nil {
+				statuses[resourceType] = aws.StringValue(state.Status)
+			}
+		}
+
+		result[aws.StringValue(account.AccountId)] = statuses
+	}
+
+	return result, nil
+}
+
+// inspector2EnablerRefreshStatusFunc reports the target status once every
+// given resource type has reached it in every account, and otherwise the
+// first status that differs.
+func inspector2EnablerRefreshStatusFunc(conn *inspector2.Inspector2, accountIDs, resourceTypes []*string, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		statuses, err := getInspector2AccountResourceStatuses(conn, accountIDs)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, accountID := range accountIDs {
+			for _, resourceType := range resourceTypes {
+				status := statuses[aws.StringValue(accountID)][aws.StringValue(resourceType)]
+				if status != target {
+					return statuses, status, nil
+				}
+			}
+		}
+
+		return statuses, target, nil
+	}
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSInspector2Enabler_basic(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2EnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2EnablerConfig(inspector2.ResourceScanTypeEcr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2Enabler_resourceTypes(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2EnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2EnablerConfig(inspector2.ResourceScanTypeEcr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsInspector2EnablerConfig(inspector2.ResourceScanTypeEcr, inspector2.ResourceScanTypeEc2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+				),
+			},
+			{
+				Config: testAccAwsInspector2EnablerConfig(inspector2.ResourceScanTypeEc2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2EnablerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_enabler" {
+			continue
+		}
+
+		accountID := testAccProvider.Meta().(*AWSClient).accountid
+		statuses, err := getInspector2AccountResourceStatuses(conn, aws.StringSlice([]string{accountID}))
+		if err != nil {
+			return err
+		}
+
+		for resourceType, status := range statuses[accountID] {
+			if status == inspector2.StatusEnabled {
+				return fmt.Errorf("Inspector2 Enabler (%s) still has %s enabled", rs.Primary.ID, resourceType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2EnablerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		accountID := testAccProvider.Meta().(*AWSClient).accountid
+		statuses, err := getInspector2AccountResourceStatuses(conn, aws.StringSlice([]string{accountID}))
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses[accountID] {
+			if status == inspector2.StatusEnabled {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Inspector2 Enabler (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccAwsInspector2EnablerConfig(resourceTypes ...string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = ["${data.aws_caller_identity.current.account_id}"]
+  resource_types = ["%s"]
+}
+`, strings.Join(resourceTypes, `", "`))
+}
@@ -0,0 +1,690 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// inspector2StringFilterFields maps the string filter arguments of
+// filter_criteria to the matching fields of c.
+func inspector2StringFilterFields(c *inspector2.FilterCriteria) map[string]*[]*inspector2.StringFilter {
+	return map[string]*[]*inspector2.StringFilter{
+		"aws_account_id":                     &c.AwsAccountId,
+		"code_vulnerability_detector_name":   &c.CodeVulnerabilityDetectorName,
+		"code_vulnerability_detector_tags":   &c.CodeVulnerabilityDetectorTags,
+		"code_vulnerability_file_path":       &c.CodeVulnerabilityFilePath,
+		"component_id":                       &c.ComponentId,
+		"component_type":                     &c.ComponentType,
+		"ec2_instance_image_id":              &c.Ec2InstanceImageId,
+		"ec2_instance_subnet_id":             &c.Ec2InstanceSubnetId,
+		"ec2_instance_vpc_id":                &c.Ec2InstanceVpcId,
+		"ecr_image_architecture":             &c.EcrImageArchitecture,
+		"ecr_image_hash":                     &c.EcrImageHash,
+		"ecr_image_registry":                 &c.EcrImageRegistry,
+		"ecr_image_repository_name":          &c.EcrImageRepositoryName,
+		"ecr_image_tags":                     &c.EcrImageTags,
+		"exploit_available":                  &c.ExploitAvailable,
+		"finding_arn":                        &c.FindingArn,
+		"finding_status":                     &c.FindingStatus,
+		"finding_type":                       &c.FindingType,
+		"fix_available":                      &c.FixAvailable,
+		"lambda_function_execution_role_arn": &c.LambdaFunctionExecutionRoleArn,
+		"lambda_function_layers":             &c.LambdaFunctionLayers,
+		"lambda_function_name":               &c.LambdaFunctionName,
+		"lambda_function_runtime":            &c.LambdaFunctionRuntime,
+		"network_protocol":                   &c.NetworkProtocol,
+		"related_vulnerabilities":            &c.RelatedVulnerabilities,
+		"resource_id":                        &c.ResourceId,
+		"resource_type":                      &c.ResourceType,
+		"severity":                           &c.Severity,
+		"title":                              &c.Title,
+		"vendor_severity":                    &c.VendorSeverity,
+		"vulnerability_id":                   &c.VulnerabilityId,
+		"vulnerability_source":               &c.VulnerabilitySource,
+	}
+}
+
+// inspector2DateFilterFields maps the date filter arguments of
+// filter_criteria to the matching fields of c.
+func inspector2DateFilterFields(c *inspector2.FilterCriteria) map[string]*[]*inspector2.DateFilter {
+	return map[string]*[]*inspector2.DateFilter{
+		"ecr_image_pushed_at":              &c.EcrImagePushedAt,
+		"first_observed_at":                &c.FirstObservedAt,
+		"lambda_function_last_modified_at": &c.LambdaFunctionLastModifiedAt,
+		"last_observed_at":                 &c.LastObservedAt,
+		"updated_at":                       &c.UpdatedAt,
+	}
+}
+
+// inspector2NumberFilterFields maps the number filter arguments of
+// filter_criteria to the matching fields of c.
+func inspector2NumberFilterFields(c *inspector2.FilterCriteria) map[string]*[]*inspector2.NumberFilter {
+	return map[string]*[]*inspector2.NumberFilter{
+		"epss_score":      &c.EpssScore,
+		"inspector_score": &c.InspectorScore,
+	}
+}
+
+func resourceAwsInspector2Filter() *schema.Resource {
+	criteria := map[string]*schema.Schema{
+		"port_range": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"begin_inclusive": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+					"end_inclusive": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+				},
+			},
+		},
+		"resource_tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(inspector2.MapComparison_Values(), false),
+					},
+					"key": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"value": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(0, 256),
+					},
+				},
+			},
+		},
+		"vulnerable_packages": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"architecture":            inspector2PackageStringFilterSchema(),
+					"epoch":                   inspector2NumberFilterSchema(1),
+					"name":                    inspector2PackageStringFilterSchema(),
+					"release":                 inspector2PackageStringFilterSchema(),
+					"source_lambda_layer_arn": inspector2PackageStringFilterSchema(),
+					"source_layer_hash":       inspector2PackageStringFilterSchema(),
+					"version":                 inspector2PackageStringFilterSchema(),
+				},
+			},
+		},
+	}
+
+	for k := range inspector2StringFilterFields(&inspector2.FilterCriteria{}) {
+		criteria[k] = inspector2StringFilterSchema()
+	}
+
+	for k := range inspector2DateFilterFields(&inspector2.FilterCriteria{}) {
+		criteria[k] = inspector2DateFilterSchema()
+	}
+
+	for k := range inspector2NumberFilterFields(&inspector2.FilterCriteria{}) {
+		criteria[k] = inspector2NumberFilterSchema(10)
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsInspector2FilterCreate,
+		Read:   resourceAwsInspector2FilterRead,
+		Update: resourceAwsInspector2FilterUpdate,
+		Delete: resourceAwsInspector2FilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(inspector2.FilterAction_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: criteria,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func inspector2StringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(inspector2.StringComparison_Values(), false),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func inspector2PackageStringFilterSchema() *schema.Schema {
+	s := inspector2StringFilterSchema()
+	s.Type = schema.TypeList
+	s.MaxItems = 1
+
+	return s
+}
+
+func inspector2DateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateRFC3339TimeString,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateRFC3339TimeString,
+				},
+			},
+		},
+	}
+}
+
+func inspector2NumberFilterSchema(maxItems int) *schema.Schema {
+	s := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: maxItems,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				"upper_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	if maxItems == 1 {
+		s.Type = schema.TypeList
+	}
+
+	return s
+}
+
+func resourceAwsInspector2FilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	input := &inspector2.CreateFilterInput{
+		Action:         aws.String(d.Get("action").(string)),
+		FilterCriteria: expandInspector2FilterCriteria(d.Get("filter_criteria").([]interface{})),
+		Name:           aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Inspector2 Filter: %s", input)
+	output, err := conn.CreateFilter(input)
+	if err != nil {
+		return fmt.Errorf("error creating Inspector2 Filter: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceAwsInspector2FilterRead(d, meta)
+}
+
+func resourceAwsInspector2FilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	filter, err := getInspector2Filter(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	if filter == nil {
+		log.Printf("[WARN] Inspector2 Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	d.Set("name", filter.Name)
+	d.Set("owner_id", filter.OwnerId)
+	d.Set("reason", filter.Reason)
+
+	if err := d.Set("filter_criteria", flattenInspector2FilterCriteria(filter.Criteria)); err != nil {
+		return fmt.Errorf("error setting filter_criteria: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(filter.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsInspector2FilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	if d.HasChange("action") || d.HasChange("description") || d.HasChange("filter_criteria") || d.HasChange("name") || d.HasChange("reason") {
+		input := &inspector2.UpdateFilterInput{
+			Action:         aws.String(d.Get("action").(string)),
+			FilterArn:      aws.String(d.Id()),
+			FilterCriteria: expandInspector2FilterCriteria(d.Get("filter_criteria").([]interface{})),
+			Name:           aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("reason"); ok {
+			input.Reason = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Inspector2 Filter: %s", input)
+		_, err := conn.UpdateFilter(input)
+		if err != nil {
+			return fmt.Errorf("error updating Inspector2 Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsInspector2(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Inspector2 Filter (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsInspector2FilterRead(d, meta)
+}
+
+func resourceAwsInspector2FilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	log.Printf("[DEBUG] Deleting Inspector2 Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(&inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, inspector2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getInspector2Filter(conn *inspector2.Inspector2, arn string) (*inspector2.Filter, error) {
+	var result *inspector2.Filter
+
+	input := &inspector2.ListFiltersInput{
+		Arns: aws.StringSlice([]string{arn}),
+	}
+
+	err := conn.ListFiltersPages(input, func(page *inspector2.ListFiltersOutput, lastPage bool) bool {
+		for _, filter := range page.Filters {
+			if aws.StringValue(filter.Arn) == arn {
+				result = filter
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func expandInspector2FilterCriteria(l []interface{}) *inspector2.FilterCriteria {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	criteria := &inspector2.FilterCriteria{}
+
+	for k, field := range inspector2StringFilterFields(criteria) {
+		if v, ok := m[k].(*schema.Set); ok && v.Len() > 0 {
+			*field = expandInspector2StringFilters(v.List())
+		}
+	}
+
+	for k, field := range inspector2DateFilterFields(criteria) {
+		if v, ok := m[k].(*schema.Set); ok && v.Len() > 0 {
+			*field = expandInspector2DateFilters(v.List())
+		}
+	}
+
+	for k, field := range inspector2NumberFilterFields(criteria) {
+		if v, ok := m[k].(*schema.Set); ok && v.Len() > 0 {
+			*field = expandInspector2NumberFilters(v.List())
+		}
+	}
+
+	if v, ok := m["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		for _, raw := range v.List() {
+			pm := raw.(map[string]interface{})
+			criteria.PortRange = append(criteria.PortRange, &inspector2.PortRangeFilter{
+				BeginInclusive: aws.Int64(int64(pm["begin_inclusive"].(int))),
+				EndInclusive:   aws.Int64(int64(pm["end_inclusive"].(int))),
+			})
+		}
+	}
+
+	if v, ok := m["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		for _, raw := range v.List() {
+			tm := raw.(map[string]interface{})
+			filter := &inspector2.MapFilter{
+				Comparison: aws.String(tm["comparison"].(string)),
+				Key:        aws.String(tm["key"].(string)),
+			}
+
+			if v, ok := tm["value"].(string); ok && v != "" {
+				filter.Value = aws.String(v)
+			}
+
+			criteria.ResourceTags = append(criteria.ResourceTags, filter)
+		}
+	}
+
+	if v, ok := m["vulnerable_packages"].(*schema.Set); ok && v.Len() > 0 {
+		for _, raw := range v.List() {
+			pm := raw.(map[string]interface{})
+			criteria.VulnerablePackages = append(criteria.VulnerablePackages, &inspector2.PackageFilter{
+				Architecture:         expandInspector2PackageStringFilter(pm["architecture"].([]interface{})),
+				Epoch:                expandInspector2PackageNumberFilter(pm["epoch"].([]interface{})),
+				Name:                 expandInspector2PackageStringFilter(pm["name"].([]interface{})),
+				Release:              expandInspector2PackageStringFilter(pm["release"].([]interface{})),
+				SourceLambdaLayerArn: expandInspector2PackageStringFilter(pm["source_lambda_layer_arn"].([]interface{})),
+				SourceLayerHash:      expandInspector2PackageStringFilter(pm["source_layer_hash"].([]interface{})),
+				Version:              expandInspector2PackageStringFilter(pm["version"].([]interface{})),
+			})
+		}
+	}
+
+	return criteria
+}
+
+func expandInspector2StringFilters(l []interface{}) []*inspector2.StringFilter {
+	filters := make([]*inspector2.StringFilter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		filters = append(filters, &inspector2.StringFilter{
+			Comparison: aws.String(m["comparison"].(string)),
+			Value:      aws.String(m["value"].(string)),
+		})
+	}
+
+	return filters
+}
+
+func expandInspector2PackageStringFilter(l []interface{}) *inspector2.StringFilter {
+	if filters := expandInspector2StringFilters(l); len(filters) > 0 {
+		return filters[0]
+	}
+
+	return nil
+}
+
+func expandInspector2DateFilters(l []interface{}) []*inspector2.DateFilter {
+	filters := make([]*inspector2.DateFilter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		filter := &inspector2.DateFilter{}
+
+		if v, ok := m["end_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			filter.EndInclusive = aws.Time(t)
+		}
+
+		if v, ok := m["start_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			filter.StartInclusive = aws.Time(t)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+func expandInspector2NumberFilters(l []interface{}) []*inspector2.NumberFilter {
+	filters := make([]*inspector2.NumberFilter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		filters = append(filters, &inspector2.NumberFilter{
+			LowerInclusive: aws.Float64(m["lower_inclusive"].(float64)),
+			UpperInclusive: aws.Float64(m["upper_inclusive"].(float64)),
+		})
+	}
+
+	return filters
+}
+
+func expandInspector2PackageNumberFilter(l []interface{}) *inspector2.NumberFilter {
+	if filters := expandInspector2NumberFilters(l); len(filters) > 0 {
+		return filters[0]
+	}
+
+	return nil
+}
+
+func flattenInspector2FilterCriteria(criteria *inspector2.FilterCriteria) []interface{} {
+	if criteria == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	for k, field := range inspector2StringFilterFields(criteria) {
+		if v := *field; len(v) > 0 {
+			m[k] = flattenInspector2StringFilters(v)
+		}
+	}
+
+	for k, field := range inspector2DateFilterFields(criteria) {
+		if v := *field; len(v) > 0 {
+			m[k] = flattenInspector2DateFilters(v)
+		}
+	}
+
+	for k, field := range inspector2NumberFilterFields(criteria) {
+		if v := *field; len(v) > 0 {
+			m[k] = flattenInspector2NumberFilters(v)
+		}
+	}
+
+	if len(criteria.PortRange) > 0 {
+		l := make([]interface{}, 0, len(criteria.PortRange))
+		for _, filter := range criteria.PortRange {
+			l = append(l, map[string]interface{}{
+				"begin_inclusive": int(aws.Int64Value(filter.BeginInclusive)),
+				"end_inclusive":   int(aws.Int64Value(filter.EndInclusive)),
+			})
+		}
+		m["port_range"] = l
+	}
+
+	if len(criteria.ResourceTags) > 0 {
+		l := make([]interface{}, 0, len(criteria.ResourceTags))
+		for _, filter := range criteria.ResourceTags {
+			l = append(l, map[string]interface{}{
+				"comparison": aws.StringValue(filter.Comparison),
+				"key":        aws.StringValue(filter.Key),
+				"value":      aws.StringValue(filter.Value),
+			})
+		}
+		m["resource_tags"] = l
+	}
+
+	if len(criteria.VulnerablePackages) > 0 {
+		l := make([]interface{}, 0, len(criteria.VulnerablePackages))
+		for _, filter := range criteria.VulnerablePackages {
+			l = append(l, map[string]interface{}{
+				"architecture":            flattenInspector2PackageStringFilter(filter.Architecture),
+				"epoch":                   flattenInspector2PackageNumberFilter(filter.Epoch),
+				"name":                    flattenInspector2PackageStringFilter(filter.Name),
+				"release":                 flattenInspector2PackageStringFilter(filter.Release),
+				"source_lambda_layer_arn": flattenInspector2PackageStringFilter(filter.SourceLambdaLayerArn),
+				"source_layer_hash":       flattenInspector2PackageStringFilter(filter.SourceLayerHash),
+				"version":                 flattenInspector2PackageStringFilter(filter.Version),
+			})
+		}
+		m["vulnerable_packages"] = l
+	}
+
+	return []interface{}{m}
+}
+
+func flattenInspector2StringFilters(filters []*inspector2.StringFilter) []interface{} {
+	l := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		l = append(l, map[string]interface{}{
+			"comparison": aws.StringValue(filter.Comparison),
+			"value":      aws.StringValue(filter.Value),
+		})
+	}
+
+	return l
+}
+
+func flattenInspector2PackageStringFilter(filter *inspector2.StringFilter) []interface{} {
+	if filter == nil {
+		return []interface{}{}
+	}
+
+	return flattenInspector2StringFilters([]*inspector2.StringFilter{filter})
+}
+
+func flattenInspector2DateFilters(filters []*inspector2.DateFilter) []interface{} {
+	l := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		m := map[string]interface{}{}
+
+		if filter.EndInclusive != nil {
+			m["end_inclusive"] = aws.TimeValue(filter.EndInclusive).Format(time.RFC3339)
+		}
+
+		if filter.StartInclusive != nil {
+			m["start_inclusive"] = aws.TimeValue(filter.StartInclusive).Format(time.RFC3339)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenInspector2NumberFilters(filters []*inspector2.NumberFilter) []interface{} {
+	l := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		l = append(l, map[string]interface{}{
+			"lower_inclusive": aws.Float64Value(filter.LowerInclusive),
+			"upper_inclusive": aws.Float64Value(filter.UpperInclusive),
+		})
+	}
+
+	return l
+}
+
+func flattenInspector2PackageNumberFilter(filter *inspector2.NumberFilter) []interface{} {
+	if filter == nil {
+		return []interface{}{}
+	}
+
+	return flattenInspector2NumberFilters([]*inspector2.NumberFilter{filter})
+}
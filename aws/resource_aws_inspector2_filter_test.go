@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSInspector2Filter_basic(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2FilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2FilterConfig(rName, inspector2.FilterActionNone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", inspector2.FilterActionNone),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:owner/\d{12}/filter/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsInspector2FilterConfig(rName, inspector2.FilterActionSuppress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", inspector2.FilterActionSuppress),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2Filter_filterCriteria(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2FilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2FilterConfigFilterCriteria(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.ecr_image_repository_name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.first_observed_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2Filter_tags(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2FilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2FilterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsInspector2FilterConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsInspector2FilterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2FilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2FilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_filter" {
+			continue
+		}
+
+		filter, err := getInspector2Filter(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			return fmt.Errorf("Inspector2 Filter (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2FilterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		filter, err := getInspector2Filter(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if filter == nil {
+			return fmt.Errorf("Inspector2 Filter (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsInspector2FilterConfig(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = %[2]q
+  reason = "testing"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+}
+`, rName, action)
+}
+
+func testAccAwsInspector2FilterConfigFilterCriteria(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    ecr_image_repository_name {
+      comparison = "PREFIX"
+      value      = "test-"
+    }
+
+    first_observed_at {
+      start_inclusive = "2023-01-01T00:00:00Z"
+      end_inclusive   = "2023-12-31T23:59:59Z"
+    }
+
+    inspector_score {
+      lower_inclusive = 0
+      upper_inclusive = 3.9
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "test"
+    }
+
+    vulnerable_packages {
+      name {
+        comparison = "EQUALS"
+        value      = "openssl"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAwsInspector2FilterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsInspector2FilterConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
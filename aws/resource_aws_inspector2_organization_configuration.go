@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsInspector2OrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2OrganizationConfigurationPut,
+		Read:   resourceAwsInspector2OrganizationConfigurationRead,
+		Update: resourceAwsInspector2OrganizationConfigurationPut,
+		Delete: resourceAwsInspector2OrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ecr": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"lambda": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"lambda_code": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2OrganizationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: expandInspector2AutoEnable(d.Get("auto_enable").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration: %s", input)
+	_, err := conn.UpdateOrganizationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error updating Inspector2 Organization Configuration: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsInspector2OrganizationConfigurationRead(d, meta)
+}
+
+func resourceAwsInspector2OrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	output, err := conn.DescribeOrganizationConfiguration(&inspector2.DescribeOrganizationConfigurationInput{})
+	if isAWSErr(err, inspector2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Inspector2 Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Inspector2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("auto_enable", flattenInspector2AutoEnable(output.AutoEnable)); err != nil {
+		return fmt.Errorf("error setting auto_enable: %s", err)
+	}
+
+	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceAwsInspector2OrganizationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	// The organization configuration cannot be removed, only reset so that
+	// no resource types are enabled for new member accounts.
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: &inspector2.AutoEnable{
+			Ec2:        aws.Bool(false),
+			Ecr:        aws.Bool(false),
+			Lambda:     aws.Bool(false),
+			LambdaCode: aws.Bool(false),
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Inspector2 Organization Configuration: %s", input)
+	_, err := conn.UpdateOrganizationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error resetting Inspector2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInspector2AutoEnable(l []interface{}) *inspector2.AutoEnable {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &inspector2.AutoEnable{
+		Ec2:        aws.Bool(m["ec2"].(bool)),
+		Ecr:        aws.Bool(m["ecr"].(bool)),
+		Lambda:     aws.Bool(m["lambda"].(bool)),
+		LambdaCode: aws.Bool(m["lambda_code"].(bool)),
+	}
+}
+
+func flattenInspector2AutoEnable(autoEnable *inspector2.AutoEnable) []interface{} {
+	if autoEnable == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ec2":         aws.BoolValue(autoEnable.Ec2),
+		"ecr":         aws.BoolValue(autoEnable.Ecr),
+		"lambda":      aws.BoolValue(autoEnable.Lambda),
+		"lambda_code": aws.BoolValue(autoEnable.LambdaCode),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSInspector2OrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2OrganizationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsInspector2OrganizationConfigurationConfig(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2OrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsInspector2OrganizationConfigurationConfig(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2OrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2OrganizationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_organization_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeOrganizationConfiguration(&inspector2.DescribeOrganizationConfigurationInput{})
+		if isAWSErr(err, inspector2.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if autoEnable := output.AutoEnable; autoEnable != nil && (aws.BoolValue(autoEnable.Ec2) || aws.BoolValue(autoEnable.Ecr)) {
+			return fmt.Errorf("Inspector2 Organization Configuration (%s) still auto-enables resource types", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2OrganizationConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		_, err := conn.DescribeOrganizationConfiguration(&inspector2.DescribeOrganizationConfigurationInput{})
+
+		return err
+	}
+}
+
+func testAccAwsInspector2OrganizationConfigurationConfig(ec2, ecr bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = "${data.aws_caller_identity.current.account_id}"
+}
+
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2 = %[1]t
+    ecr = %[2]t
+  }
+
+  depends_on = ["aws_inspector2_delegated_admin_account.test"]
+}
+`, ec2, ecr)
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Inspector2 enablement is account-wide, so the tests must run serially.
+func TestAccAWSInspector2(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"DelegatedAdminAccount": {
+			"basic": testAccAWSInspector2DelegatedAdminAccount_basic,
+		},
+		"Enabler": {
+			"basic":         testAccAWSInspector2Enabler_basic,
+			"resourceTypes": testAccAWSInspector2Enabler_resourceTypes,
+		},
+		"Filter": {
+			"basic":          testAccAWSInspector2Filter_basic,
+			"filterCriteria": testAccAWSInspector2Filter_filterCriteria,
+			"tags":           testAccAWSInspector2Filter_tags,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccAWSInspector2OrganizationConfiguration_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
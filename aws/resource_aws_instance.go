@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -29,6 +31,8 @@ func resourceAwsInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsInstanceMigrateState,
 
@@ -66,6 +70,30 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"placement_partition_number": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"host_resource_group_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"host_id"},
+				ValidateFunc:  validateArn,
+			},
+
 			"instance_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -225,9 +253,59 @@ func resourceAwsInstance() *schema.Resource {
 			"ebs_optimized": {
 				Type:     schema.TypeBool,
 				Optional: true,
+			},
+
+			"allow_stop_for_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"hibernation": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				ForceNew: true,
 			},
 
+			"cpu_core_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"cpu_threads_per_core": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The credit specification of burstable performance
+					// instances is always read, so suppress the removal of
+					// an unconfigured block from existing instances.
+					return d.Id() != "" && old != "0" && new == "0"
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"disable_api_termination": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -426,6 +504,13 @@ func resourceAwsInstance() *schema.Resource {
 							ForceNew: true,
 						},
 
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
 						"volume_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -437,6 +522,88 @@ func resourceAwsInstance() *schema.Resource {
 	}
 }
 
+// resourceAwsInstanceCustomizeDiff validates arguments that depend on each
+// other before any instance is launched, and forces replacement of existing
+// instances for changes that would otherwise require them to be stopped.
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Hibernation stores the instance memory (RAM) on the root EBS volume,
+	// which must be encrypted. Hibernation forces a new instance, so this is
+	// also checked when an existing instance is replaced.
+	if diff.Get("hibernation").(bool) {
+		if diff.Id() == "" || diff.HasChange("hibernation") || diff.HasChange("ami") || diff.HasChange("root_block_device.0.encrypted") {
+			encrypted, err := awsInstanceRootBlockDeviceEncrypted(diff, meta.(*AWSClient).ec2conn)
+			if err != nil {
+				return err
+			}
+			if !encrypted {
+				return errors.New("hibernation requires root_block_device to be encrypted")
+			}
+		}
+	}
+
+	if diff.Id() != "" {
+		// Changing EBS optimization requires the instance to be stopped,
+		// which is only done when explicitly allowed.
+		if diff.HasChange("ebs_optimized") && !diff.Get("allow_stop_for_update").(bool) {
+			return diff.ForceNew("ebs_optimized")
+		}
+
+		return nil
+	}
+
+	if _, ok := diff.GetOk("placement_partition_number"); ok {
+		if _, ok := diff.GetOk("placement_group"); !ok {
+			return fmt.Errorf("placement_partition_number requires placement_group to be set to a placement group with the %q strategy", ec2.PlacementStrategyPartition)
+		}
+	}
+
+	return nil
+}
+
+// awsInstanceRootBlockDeviceEncrypted returns whether the root EBS volume of a
+// planned instance will be encrypted. A root volume is encrypted when requested
+// explicitly, when the AMI root snapshot is encrypted, or when the account
+// enables EBS encryption by default.
+func awsInstanceRootBlockDeviceEncrypted(diff *schema.ResourceDiff, conn *ec2.EC2) (bool, error) {
+	if diff.Get("root_block_device.0.encrypted").(bool) {
+		return true, nil
+	}
+
+	ami := diff.Get("ami").(string)
+	if ami == "" {
+		// The AMI is not known until apply, leave the check to EC2.
+		return true, nil
+	}
+
+	res, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: []*string{aws.String(ami)},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error describing AMI (%s): %s", ami, err)
+	}
+	if len(res.Images) == 0 {
+		// EC2 reports the missing AMI when the instance is launched.
+		return true, nil
+	}
+
+	image := res.Images[0]
+	for _, bdm := range image.BlockDeviceMappings {
+		if aws.StringValue(bdm.DeviceName) != aws.StringValue(image.RootDeviceName) {
+			continue
+		}
+		if bdm.Ebs != nil && aws.BoolValue(bdm.Ebs.Encrypted) {
+			return true, nil
+		}
+	}
+
+	output, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return false, fmt.Errorf("error reading EBS encryption by default: %s", err)
+	}
+
+	return aws.BoolValue(output.EbsEncryptionByDefault), nil
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1
 	i := strings.LastIndexByte(k, '.')
@@ -477,6 +644,35 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		UserData:                          instanceOpts.UserData64,
 	}
 
+	if d.Get("hibernation").(bool) {
+		runOpts.HibernationOptions = &ec2.HibernationOptionsRequest{
+			Configured: aws.Bool(true),
+		}
+	}
+
+	if v, ok := d.GetOk("cpu_core_count"); ok {
+		runOpts.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount: aws.Int64(int64(v.(int))),
+		}
+
+		if v, ok := d.GetOk("cpu_threads_per_core"); ok {
+			runOpts.CpuOptions.ThreadsPerCore = aws.Int64(int64(v.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if isAwsInstanceTypeBurstable(d.Get("instance_type").(string)) {
+			cs := v.([]interface{})[0].(map[string]interface{})
+			if v, ok := cs["cpu_credits"].(string); ok && v != "" {
+				runOpts.CreditSpecification = &ec2.CreditSpecificationRequest{
+					CpuCredits: aws.String(v),
+				}
+			}
+		} else {
+			log.Print("[WARN] credit_specification is only valid for burstable performance (T family) instance types")
+		}
+	}
+
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
@@ -640,6 +836,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if instance.Placement.Tenancy != nil {
 		d.Set("tenancy", instance.Placement.Tenancy)
 	}
+	d.Set("placement_partition_number", instance.Placement.PartitionNumber)
+	d.Set("host_id", instance.Placement.HostId)
+	d.Set("host_resource_group_arn", instance.Placement.HostResourceGroupArn)
 
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
@@ -725,6 +924,31 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+
+	if instance.HibernationOptions != nil {
+		d.Set("hibernation", instance.HibernationOptions.Configured)
+	}
+
+	if instance.CpuOptions != nil {
+		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
+		d.Set("cpu_threads_per_core", instance.CpuOptions.ThreadsPerCore)
+	}
+
+	creditSpecifications := make([]map[string]interface{}, 0)
+	if isAwsInstanceTypeBurstable(aws.StringValue(instance.InstanceType)) {
+		cs, err := ec2DescribeInstanceCreditSpecification(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error describing EC2 Instance (%s) credit specification: %s", d.Id(), err)
+		}
+		if cs != nil {
+			creditSpecifications = append(creditSpecifications, map[string]interface{}{
+				"cpu_credits": aws.StringValue(cs.CpuCredits),
+			})
+		}
+	}
+	if err := d.Set("credit_specification", creditSpecifications); err != nil {
+		return fmt.Errorf("error setting credit_specification: %s", err)
+	}
 	if instance.SubnetId != nil && *instance.SubnetId != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -982,8 +1206,8 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
+	if (d.HasChange("instance_type") || d.HasChange("ebs_optimized")) && !d.IsNewResource() {
+		log.Printf("[INFO] Stopping Instance %q for instance attribute change", d.Id())
 		_, err := conn.StopInstances(&ec2.StopInstancesInput{
 			InstanceIds: []*string{aws.String(d.Id())},
 		})
@@ -1003,18 +1227,33 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				"Error waiting for instance (%s) to stop: %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
+		if d.HasChange("instance_type") {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if d.HasChange("ebs_optimized") {
+			log.Printf("[INFO] Modifying EBS optimization of instance %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				EbsOptimized: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+				},
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
+		log.Printf("[INFO] Starting Instance %q after instance attribute change", d.Id())
 		_, err = conn.StartInstances(&ec2.StartInstancesInput{
 			InstanceIds: []*string{aws.String(d.Id())},
 		})
@@ -1079,6 +1318,18 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("credit_specification") && !d.IsNewResource() && isAwsInstanceTypeBurstable(d.Get("instance_type").(string)) {
+		if v, ok := d.GetOk("credit_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			cs := v.([]interface{})[0].(map[string]interface{})
+			if cpuCredits, ok := cs["cpu_credits"].(string); ok && cpuCredits != "" {
+				log.Printf("[DEBUG] Modifying Instance (%s) credit specification: %s", d.Id(), cpuCredits)
+				if err := ec2ModifyInstanceCreditSpecification(conn, d.Id(), cpuCredits); err != nil {
+					return fmt.Errorf("error updating EC2 Instance (%s) credit specification: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -1221,6 +1472,9 @@ func readBlockDevicesFromInstance(instance *ec2.Instance, conn *ec2.EC2) (map[st
 		if vol.Iops != nil {
 			bd["iops"] = *vol.Iops
 		}
+		if vol.Encrypted != nil {
+			bd["encrypted"] = *vol.Encrypted
+		}
 
 		if blockDeviceIsRoot(instanceBd, instance) {
 			blockDevices["root"] = bd
@@ -1228,9 +1482,6 @@ func readBlockDevicesFromInstance(instance *ec2.Instance, conn *ec2.EC2) (map[st
 			if instanceBd.DeviceName != nil {
 				bd["device_name"] = *instanceBd.DeviceName
 			}
-			if vol.Encrypted != nil {
-				bd["encrypted"] = *vol.Encrypted
-			}
 			if vol.SnapshotId != nil {
 				bd["snapshot_id"] = *vol.SnapshotId
 			}
@@ -1456,6 +1707,10 @@ func readBlockDeviceMappingsFromConfig(
 				ebs.VolumeType = aws.String(v)
 			}
 
+			if v, ok := bd["encrypted"].(bool); ok && v {
+				ebs.Encrypted = aws.Bool(v)
+			}
+
 			if v, ok := bd["iops"].(int); ok && v > 0 && *ebs.VolumeType == "io1" {
 				// Only set the iops attribute if the volume type is io1. Setting otherwise
 				// can trigger a refresh/plan loop based on the computed value that is given
@@ -1685,6 +1940,20 @@ func buildAwsInstanceOpts(
 		opts.Placement.Tenancy = aws.String(v)
 	}
 
+	// The following arguments are not part of the aws_spot_instance_request
+	// schema, so their values may be absent.
+	if v, ok := d.Get("placement_partition_number").(int); ok && v != 0 {
+		opts.Placement.PartitionNumber = aws.Int64(int64(v))
+	}
+
+	if v, ok := d.Get("host_id").(string); ok && v != "" {
+		opts.Placement.HostId = aws.String(v)
+	}
+
+	if v, ok := d.Get("host_resource_group_arn").(string); ok && v != "" {
+		opts.Placement.HostResourceGroupArn = aws.String(v)
+	}
+
 	var groups []*string
 	if v := d.Get("security_groups"); v != nil {
 		// Security group names.
@@ -1833,3 +2102,52 @@ func getAwsInstanceVolumeIds(conn *ec2.EC2, d *schema.ResourceData) ([]*string,
 
 	return volumeIds, nil
 }
+
+// burstableInstanceTypeRegexp matches the burstable performance (T family)
+// instance types, e.g. t2.micro or t3a.large, but not trn1.2xlarge.
+var burstableInstanceTypeRegexp = regexp.MustCompile(`^t\d[a-z]?\.`)
+
+// isAwsInstanceTypeBurstable returns whether the instance type is a burstable
+// performance (T family) instance type that supports credit specifications.
+func isAwsInstanceTypeBurstable(instanceType string) bool {
+	return burstableInstanceTypeRegexp.MatchString(instanceType)
+}
+
+func ec2DescribeInstanceCreditSpecification(conn *ec2.EC2, id string) (*ec2.InstanceCreditSpecification, error) {
+	output, err := conn.DescribeInstanceCreditSpecifications(&ec2.DescribeInstanceCreditSpecificationsInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cs := range output.InstanceCreditSpecifications {
+		if aws.StringValue(cs.InstanceId) == id {
+			return cs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2ModifyInstanceCreditSpecification(conn *ec2.EC2, id, cpuCredits string) error {
+	output, err := conn.ModifyInstanceCreditSpecification(&ec2.ModifyInstanceCreditSpecificationInput{
+		InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+			{
+				CpuCredits: aws.String(cpuCredits),
+				InstanceId: aws.String(id),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range output.UnsuccessfulInstanceCreditSpecifications {
+		if item.Error != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+
+	return nil
+}
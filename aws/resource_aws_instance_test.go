@@ -575,6 +575,146 @@ func TestAccAWSInstance_placementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_placementPartitionNumber(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigPlacementPartitionNumber(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group", "aws_placement_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "placement_partition_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_hostId(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigHostId,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "host_id", "aws_ec2_host.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "host"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_cpuOptions(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigCpuOptions(2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cpu_core_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_threads_per_core", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_creditSpecification(t *testing.T) {
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigCreditSpecification("standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credit_specification.0.cpu_credits", "standard"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceConfigCreditSpecification("unlimited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credit_specification.0.cpu_credits", "unlimited"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_hibernation(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfigHibernation(true, false),
+				ExpectError: regexp.MustCompile(`hibernation requires root_block_device to be encrypted`),
+			},
+			{
+				Config: testAccInstanceConfigHibernation(false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hibernation", "false"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfigHibernation(true, false),
+				ExpectError: regexp.MustCompile(`hibernation requires root_block_device to be encrypted`),
+			},
+			{
+				Config: testAccInstanceConfigHibernation(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hibernation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.encrypted", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_ipv6_supportAddressCount(t *testing.T) {
 	var v ec2.Instance
 
@@ -1096,6 +1236,44 @@ func TestAccAWSInstance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_allowStopForUpdate(t *testing.T) {
+	var before, after, replaced ec2.Instance
+	resourceName := "aws_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigAllowStopForUpdate("r3.large", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop_for_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigAllowStopForUpdate("r3.xlarge", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "r3.xlarge"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigAllowStopForUpdate("r3.xlarge", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &replaced),
+					testAccCheckInstanceRecreated(t, &after, &replaced),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_primaryNetworkInterface(t *testing.T) {
 	var instance ec2.Instance
 	var ini ec2.NetworkInterface
@@ -1407,6 +1585,16 @@ func testAccCheckInstanceNotRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckInstanceRecreated(t *testing.T,
+	before, after *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.InstanceId == *after.InstanceId {
+			t.Fatalf("AWS Instance (%s) not recreated", *before.InstanceId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceDestroy(s *terraform.State) error {
 	return testAccCheckInstanceDestroyWithProvider(s, testAccProvider)
 }
@@ -1494,6 +1682,25 @@ func TestInstanceTenancySchema(t *testing.T) {
 	}
 }
 
+func TestIsAwsInstanceTypeBurstable(t *testing.T) {
+	cases := map[string]bool{
+		"t1.micro":     true,
+		"t2.nano":      true,
+		"t3.large":     true,
+		"t3a.xlarge":   true,
+		"t4g.medium":   true,
+		"trn1.2xlarge": false,
+		"m5.large":     false,
+		"x1e.xlarge":   false,
+	}
+
+	for instanceType, expected := range cases {
+		if actual := isAwsInstanceTypeBurstable(instanceType); actual != expected {
+			t.Errorf("isAwsInstanceTypeBurstable(%q) = %t, expected %t", instanceType, actual, expected)
+		}
+	}
+}
+
 func driftTags(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
@@ -1821,6 +2028,150 @@ resource "aws_instance" "foo" {
 `, rStr)
 }
 
+func testAccInstanceConfigPlacementPartitionNumber(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_placement_group" "test" {
+  name            = %[1]q
+  partition_count = 2
+  strategy        = "partition"
+}
+
+resource "aws_instance" "test" {
+  ami                        = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type              = "m5.large"
+  placement_group            = "${aws_placement_group.test.name}"
+  placement_partition_number = 2
+}
+`, rName)
+}
+
+const testAccInstanceConfigHostId = `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_ec2_host" "test" {
+  auto_placement    = "off"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "c5.large"
+}
+
+resource "aws_instance" "test" {
+  ami               = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  availability_zone = "${aws_ec2_host.test.availability_zone}"
+  host_id           = "${aws_ec2_host.test.id}"
+  instance_type     = "c5.large"
+  tenancy           = "host"
+}
+`
+
+const testAccInstanceConfigAmznAmiMinimalHvmEbs = `
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+`
+
+func testAccInstanceConfigAllowStopForUpdate(instanceType string, ebsOptimized, allowStopForUpdate bool) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                   = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type         = %[1]q
+  ebs_optimized         = %[2]t
+  allow_stop_for_update = %[3]t
+}
+`, instanceType, ebsOptimized, allowStopForUpdate)
+}
+
+func testAccInstanceConfigCpuOptions(coreCount, threadsPerCore int) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                  = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type        = "c5.xlarge"
+  cpu_core_count       = %[1]d
+  cpu_threads_per_core = %[2]d
+}
+`, coreCount, threadsPerCore)
+}
+
+func testAccInstanceConfigCreditSpecification(cpuCredits string) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "t3.micro"
+
+  credit_specification {
+    cpu_credits = %[1]q
+  }
+}
+`, cpuCredits)
+}
+
+func testAccInstanceConfigHibernation(hibernation, encrypted bool) string {
+	return fmt.Sprintf(`
+data "aws_ami" "amzn-ami-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = "${data.aws_ami.amzn-ami-hvm-ebs.id}"
+  instance_type = "m5.large"
+  hibernation   = %[1]t
+
+  root_block_device {
+    encrypted   = %[2]t
+    volume_size = 20
+  }
+}
+`, hibernation, encrypted)
+}
+
 const testAccInstanceConfigIpv6ErrorConfig = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
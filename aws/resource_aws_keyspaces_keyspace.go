@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsKeyspacesKeyspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKeyspacesKeyspaceCreate,
+		Read:   resourceAwsKeyspacesKeyspaceRead,
+		Update: resourceAwsKeyspacesKeyspaceUpdate,
+		Delete: resourceAwsKeyspacesKeyspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyspacesName,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsKeyspacesKeyspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	name := d.Get("name").(string)
+	input := &keyspaces.CreateKeyspaceInput{
+		KeyspaceName: aws.String(name),
+	}
+
+	if tags := tagsFromMapKeyspaces(d.Get("tags").(map[string]interface{})); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating Keyspaces Keyspace: %s", input)
+	if _, err := conn.CreateKeyspace(input); err != nil {
+		return fmt.Errorf("error creating Keyspaces Keyspace (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Keyspaces are created asynchronously
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.GetKeyspace(&keyspaces.GetKeyspaceInput{
+			KeyspaceName: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for Keyspaces Keyspace (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsKeyspacesKeyspaceRead(d, meta)
+}
+
+func resourceAwsKeyspacesKeyspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	keyspace, err := conn.GetKeyspace(&keyspaces.GetKeyspaceInput{
+		KeyspaceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Keyspaces Keyspace (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Keyspaces Keyspace (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", keyspace.ResourceArn)
+	d.Set("name", keyspace.KeyspaceName)
+
+	tags, err := getTagsKeyspaces(conn, aws.StringValue(keyspace.ResourceArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for Keyspaces Keyspace (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKeyspacesKeyspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	if d.HasChange("tags") {
+		if err := setTagsKeyspaces(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Keyspaces Keyspace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKeyspacesKeyspaceRead(d, meta)
+}
+
+func resourceAwsKeyspacesKeyspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	log.Printf("[DEBUG] Deleting Keyspaces Keyspace (%s)", d.Id())
+	_, err := conn.DeleteKeyspace(&keyspaces.DeleteKeyspaceInput{
+		KeyspaceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Keyspaces Keyspace (%s): %s", d.Id(), err)
+	}
+
+	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		_, err := conn.GetKeyspace(&keyspaces.GetKeyspaceInput{
+			KeyspaceName: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Keyspaces Keyspace (%s) still exists", d.Id()))
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for Keyspaces Keyspace (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
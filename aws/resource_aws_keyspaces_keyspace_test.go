@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKeyspacesKeyspace_basic(t *testing.T) {
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesKeyspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesKeyspaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesKeyspaceExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cassandra:[^:]+:\d{12}:/keyspace/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesKeyspace_tags(t *testing.T) {
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesKeyspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesKeyspaceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSKeyspacesKeyspaceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSKeyspacesKeyspaceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKeyspacesKeyspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).keyspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_keyspaces_keyspace" {
+			continue
+		}
+
+		_, err := conn.GetKeyspace(&keyspaces.GetKeyspaceInput{
+			KeyspaceName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Keyspaces Keyspace (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKeyspacesKeyspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Keyspaces Keyspace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).keyspacesconn
+
+		_, err := conn.GetKeyspace(&keyspaces.GetKeyspaceInput{
+			KeyspaceName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSKeyspacesKeyspaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesKeyspaceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSKeyspacesKeyspaceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,704 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKeyspacesTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKeyspacesTableCreate,
+		Read:   resourceAwsKeyspacesTableRead,
+		Update: resourceAwsKeyspacesTableUpdate,
+		Delete: resourceAwsKeyspacesTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Columns can be added in place, but removing one requires a new table
+			customdiff.ForceNewIfChange("schema_definition.0.column", func(old, new, meta interface{}) bool {
+				return old.(*schema.Set).Difference(new.(*schema.Set)).Len() > 0
+			}),
+			// TTL cannot be disabled once it has been enabled
+			customdiff.ForceNewIfChange("ttl.0.status", func(old, new, meta interface{}) bool {
+				return old.(string) == keyspaces.TimeToLiveStatusEnabled && new.(string) != keyspaces.TimeToLiveStatusEnabled
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"throughput_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyspaces.ThroughputModePayPerRequest,
+							ValidateFunc: validation.StringInSlice(keyspaces.ThroughputMode_Values(), false),
+						},
+						"write_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"default_time_to_live": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 630720000),
+			},
+			"encryption_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_identifier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyspaces.EncryptionTypeAwsOwnedKmsKey,
+							ValidateFunc: validation.StringInSlice(keyspaces.EncryptionType_Values(), false),
+						},
+					},
+				},
+			},
+			"keyspace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyspacesName,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyspaces.PointInTimeRecoveryStatusDisabled,
+							ValidateFunc: validation.StringInSlice(keyspaces.PointInTimeRecoveryStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"schema_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"clustering_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"order_by": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(keyspaces.SortOrder_Values(), false),
+									},
+								},
+							},
+						},
+						"column": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"partition_key": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"static_column": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyspacesName,
+			},
+			"tags": tagsSchema(),
+			"ttl": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(keyspaces.TimeToLiveStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsKeyspacesTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	keyspaceName := d.Get("keyspace_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	input := &keyspaces.CreateTableInput{
+		KeyspaceName:     aws.String(keyspaceName),
+		SchemaDefinition: expandKeyspacesSchemaDefinition(d.Get("schema_definition").([]interface{})),
+		TableName:        aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("capacity_specification"); ok {
+		input.CapacitySpecification = expandKeyspacesCapacitySpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("default_time_to_live"); ok {
+		input.DefaultTimeToLive = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("encryption_specification"); ok {
+		input.EncryptionSpecification = expandKeyspacesEncryptionSpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("point_in_time_recovery"); ok {
+		input.PointInTimeRecovery = expandKeyspacesPointInTimeRecovery(v.([]interface{}))
+	}
+
+	if tags := tagsFromMapKeyspaces(d.Get("tags").(map[string]interface{})); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	if v, ok := d.GetOk("ttl"); ok {
+		input.Ttl = expandKeyspacesTimeToLive(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Keyspaces Table: %s", input)
+	if _, err := conn.CreateTable(input); err != nil {
+		return fmt.Errorf("error creating Keyspaces Table (%s): %s", tableName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", keyspaceName, tableName))
+
+	if err := waitForKeyspacesTableActive(conn, keyspaceName, tableName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Keyspaces Table (%s) to be active: %s", d.Id(), err)
+	}
+
+	return resourceAwsKeyspacesTableRead(d, meta)
+}
+
+func resourceAwsKeyspacesTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	keyspaceName, tableName, err := decodeKeyspacesTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	table, err := getKeyspacesTable(conn, keyspaceName, tableName)
+	if err != nil {
+		return fmt.Errorf("error reading Keyspaces Table (%s): %s", d.Id(), err)
+	}
+
+	if table == nil || aws.StringValue(table.Status) == keyspaces.TableStatusDeleting {
+		log.Printf("[WARN] Keyspaces Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", table.ResourceArn)
+	d.Set("default_time_to_live", table.DefaultTimeToLive)
+	d.Set("keyspace_name", table.KeyspaceName)
+	d.Set("table_name", table.TableName)
+
+	if err := d.Set("capacity_specification", flattenKeyspacesCapacitySpecificationSummary(table.CapacitySpecification)); err != nil {
+		return fmt.Errorf("error setting capacity_specification: %s", err)
+	}
+
+	if err := d.Set("encryption_specification", flattenKeyspacesEncryptionSpecification(table.EncryptionSpecification)); err != nil {
+		return fmt.Errorf("error setting encryption_specification: %s", err)
+	}
+
+	if err := d.Set("point_in_time_recovery", flattenKeyspacesPointInTimeRecoverySummary(table.PointInTimeRecovery)); err != nil {
+		return fmt.Errorf("error setting point_in_time_recovery: %s", err)
+	}
+
+	if err := d.Set("schema_definition", flattenKeyspacesSchemaDefinition(table.SchemaDefinition)); err != nil {
+		return fmt.Errorf("error setting schema_definition: %s", err)
+	}
+
+	if err := d.Set("ttl", flattenKeyspacesTimeToLive(table.Ttl)); err != nil {
+		return fmt.Errorf("error setting ttl: %s", err)
+	}
+
+	tags, err := getTagsKeyspaces(conn, aws.StringValue(table.ResourceArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for Keyspaces Table (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKeyspacesTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	keyspaceName, tableName, err := decodeKeyspacesTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Keyspaces only accepts a single property change per UpdateTable call
+	var inputs []*keyspaces.UpdateTableInput
+
+	if d.HasChange("capacity_specification") {
+		inputs = append(inputs, &keyspaces.UpdateTableInput{
+			CapacitySpecification: expandKeyspacesCapacitySpecification(d.Get("capacity_specification").([]interface{})),
+		})
+	}
+
+	if d.HasChange("default_time_to_live") {
+		inputs = append(inputs, &keyspaces.UpdateTableInput{
+			DefaultTimeToLive: aws.Int64(int64(d.Get("default_time_to_live").(int))),
+		})
+	}
+
+	if d.HasChange("encryption_specification") {
+		inputs = append(inputs, &keyspaces.UpdateTableInput{
+			EncryptionSpecification: expandKeyspacesEncryptionSpecification(d.Get("encryption_specification").([]interface{})),
+		})
+	}
+
+	if d.HasChange("point_in_time_recovery") {
+		inputs = append(inputs, &keyspaces.UpdateTableInput{
+			PointInTimeRecovery: expandKeyspacesPointInTimeRecovery(d.Get("point_in_time_recovery").([]interface{})),
+		})
+	}
+
+	if d.HasChange("schema_definition.0.column") {
+		o, n := d.GetChange("schema_definition.0.column")
+		added := n.(*schema.Set).Difference(o.(*schema.Set))
+
+		if added.Len() > 0 {
+			inputs = append(inputs, &keyspaces.UpdateTableInput{
+				AddColumns: expandKeyspacesColumnDefinitions(added.List()),
+			})
+		}
+	}
+
+	if d.HasChange("ttl") {
+		if ttl := expandKeyspacesTimeToLive(d.Get("ttl").([]interface{})); ttl != nil {
+			inputs = append(inputs, &keyspaces.UpdateTableInput{
+				Ttl: ttl,
+			})
+		}
+	}
+
+	for _, input := range inputs {
+		input.KeyspaceName = aws.String(keyspaceName)
+		input.TableName = aws.String(tableName)
+
+		log.Printf("[DEBUG] Updating Keyspaces Table: %s", input)
+		if _, err := conn.UpdateTable(input); err != nil {
+			return fmt.Errorf("error updating Keyspaces Table (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForKeyspacesTableActive(conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Keyspaces Table (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsKeyspaces(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Keyspaces Table (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKeyspacesTableRead(d, meta)
+}
+
+func resourceAwsKeyspacesTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).keyspacesconn
+
+	keyspaceName, tableName, err := decodeKeyspacesTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Keyspaces Table (%s)", d.Id())
+	_, err = conn.DeleteTable(&keyspaces.DeleteTableInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TableName:    aws.String(tableName),
+	})
+	if err != nil {
+		if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Keyspaces Table (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{keyspaces.TableStatusActive, keyspaces.TableStatusDeleting},
+		Target:     []string{keyspaces.TableStatusDeleted},
+		Refresh:    resourceAwsKeyspacesTableStateRefreshFunc(conn, keyspaceName, tableName),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Keyspaces Table (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeKeyspacesTableID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected KEYSPACE_NAME/TABLE_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func getKeyspacesTable(conn *keyspaces.Keyspaces, keyspaceName, tableName string) (*keyspaces.GetTableOutput, error) {
+	output, err := conn.GetTable(&keyspaces.GetTableInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TableName:    aws.String(tableName),
+	})
+	if err != nil {
+		if isAWSErr(err, keyspaces.ErrCodeResourceNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func resourceAwsKeyspacesTableStateRefreshFunc(conn *keyspaces.Keyspaces, keyspaceName, tableName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		table, err := getKeyspacesTable(conn, keyspaceName, tableName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if table == nil {
+			return 42, keyspaces.TableStatusDeleted, nil
+		}
+
+		return table, aws.StringValue(table.Status), nil
+	}
+}
+
+func waitForKeyspacesTableActive(conn *keyspaces.Keyspaces, keyspaceName, tableName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		// Newly created tables may not be visible immediately
+		Pending:    []string{keyspaces.TableStatusCreating, keyspaces.TableStatusDeleted, keyspaces.TableStatusUpdating},
+		Target:     []string{keyspaces.TableStatusActive},
+		Refresh:    resourceAwsKeyspacesTableStateRefreshFunc(conn, keyspaceName, tableName),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandKeyspacesCapacitySpecification(l []interface{}) *keyspaces.CapacitySpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &keyspaces.CapacitySpecification{
+		ThroughputMode: aws.String(m["throughput_mode"].(string)),
+	}
+
+	if v, ok := m["read_capacity_units"].(int); ok && v > 0 {
+		spec.ReadCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["write_capacity_units"].(int); ok && v > 0 {
+		spec.WriteCapacityUnits = aws.Int64(int64(v))
+	}
+
+	return spec
+}
+
+func flattenKeyspacesCapacitySpecificationSummary(spec *keyspaces.CapacitySpecificationSummary) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"read_capacity_units":  aws.Int64Value(spec.ReadCapacityUnits),
+		"throughput_mode":      aws.StringValue(spec.ThroughputMode),
+		"write_capacity_units": aws.Int64Value(spec.WriteCapacityUnits),
+	}
+
+	return []interface{}{m}
+}
+
+func expandKeyspacesEncryptionSpecification(l []interface{}) *keyspaces.EncryptionSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &keyspaces.EncryptionSpecification{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["kms_key_identifier"].(string); ok && v != "" {
+		spec.KmsKeyIdentifier = aws.String(v)
+	}
+
+	return spec
+}
+
+func flattenKeyspacesEncryptionSpecification(spec *keyspaces.EncryptionSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"kms_key_identifier": aws.StringValue(spec.KmsKeyIdentifier),
+		"type":               aws.StringValue(spec.Type),
+	}
+
+	return []interface{}{m}
+}
+
+func expandKeyspacesPointInTimeRecovery(l []interface{}) *keyspaces.PointInTimeRecovery {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &keyspaces.PointInTimeRecovery{
+		Status: aws.String(m["status"].(string)),
+	}
+}
+
+func flattenKeyspacesPointInTimeRecoverySummary(pitr *keyspaces.PointInTimeRecoverySummary) []interface{} {
+	if pitr == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"status": aws.StringValue(pitr.Status),
+	}
+
+	return []interface{}{m}
+}
+
+func expandKeyspacesTimeToLive(l []interface{}) *keyspaces.TimeToLive {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &keyspaces.TimeToLive{
+		Status: aws.String(m["status"].(string)),
+	}
+}
+
+func flattenKeyspacesTimeToLive(ttl *keyspaces.TimeToLive) []interface{} {
+	if ttl == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"status": aws.StringValue(ttl.Status),
+	}
+
+	return []interface{}{m}
+}
+
+func expandKeyspacesSchemaDefinition(l []interface{}) *keyspaces.SchemaDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	def := &keyspaces.SchemaDefinition{}
+
+	if v, ok := m["column"].(*schema.Set); ok && v.Len() > 0 {
+		def.AllColumns = expandKeyspacesColumnDefinitions(v.List())
+	}
+
+	if v, ok := m["clustering_key"].([]interface{}); ok && len(v) > 0 {
+		for _, raw := range v {
+			key := raw.(map[string]interface{})
+			def.ClusteringKeys = append(def.ClusteringKeys, &keyspaces.ClusteringKey{
+				Name:    aws.String(key["name"].(string)),
+				OrderBy: aws.String(key["order_by"].(string)),
+			})
+		}
+	}
+
+	if v, ok := m["partition_key"].([]interface{}); ok && len(v) > 0 {
+		for _, raw := range v {
+			key := raw.(map[string]interface{})
+			def.PartitionKeys = append(def.PartitionKeys, &keyspaces.PartitionKey{
+				Name: aws.String(key["name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := m["static_column"].(*schema.Set); ok && v.Len() > 0 {
+		for _, raw := range v.List() {
+			column := raw.(map[string]interface{})
+			def.StaticColumns = append(def.StaticColumns, &keyspaces.StaticColumn{
+				Name: aws.String(column["name"].(string)),
+			})
+		}
+	}
+
+	return def
+}
+
+func expandKeyspacesColumnDefinitions(l []interface{}) []*keyspaces.ColumnDefinition {
+	columns := make([]*keyspaces.ColumnDefinition, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		columns = append(columns, &keyspaces.ColumnDefinition{
+			Name: aws.String(m["name"].(string)),
+			Type: aws.String(m["type"].(string)),
+		})
+	}
+
+	return columns
+}
+
+func flattenKeyspacesSchemaDefinition(def *keyspaces.SchemaDefinition) []interface{} {
+	if def == nil {
+		return []interface{}{}
+	}
+
+	columns := make([]interface{}, 0, len(def.AllColumns))
+	for _, column := range def.AllColumns {
+		columns = append(columns, map[string]interface{}{
+			"name": aws.StringValue(column.Name),
+			"type": aws.StringValue(column.Type),
+		})
+	}
+
+	clusteringKeys := make([]interface{}, 0, len(def.ClusteringKeys))
+	for _, key := range def.ClusteringKeys {
+		clusteringKeys = append(clusteringKeys, map[string]interface{}{
+			"name":     aws.StringValue(key.Name),
+			"order_by": aws.StringValue(key.OrderBy),
+		})
+	}
+
+	partitionKeys := make([]interface{}, 0, len(def.PartitionKeys))
+	for _, key := range def.PartitionKeys {
+		partitionKeys = append(partitionKeys, map[string]interface{}{
+			"name": aws.StringValue(key.Name),
+		})
+	}
+
+	staticColumns := make([]interface{}, 0, len(def.StaticColumns))
+	for _, column := range def.StaticColumns {
+		staticColumns = append(staticColumns, map[string]interface{}{
+			"name": aws.StringValue(column.Name),
+		})
+	}
+
+	m := map[string]interface{}{
+		"clustering_key": clusteringKeys,
+		"column":         columns,
+		"partition_key":  partitionKeys,
+		"static_column":  staticColumns,
+	}
+
+	return []interface{}{m}
+}
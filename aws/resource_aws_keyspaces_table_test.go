@@ -0,0 +1,548 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKeyspacesTable_basic(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cassandra:[^:]+:\d{12}:/keyspace/.+/table/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(resourceName, "default_time_to_live", "0"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_specification.0.type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(resourceName, "keyspace_name", "aws_keyspaces_keyspace.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.partition_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.partition_key.0.name", "message"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.static_column.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_multipleColumns(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfigMultipleColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.0.name", "division"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.0.order_by", "ASC"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.1.name", "project"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.clustering_key.1.order_by", "DESC"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.column.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.partition_key.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.static_column.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_addColumns(t *testing.T) {
+	var table1, table2 keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table1),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.column.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSKeyspacesTableConfigAddColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table2),
+					testAccCheckAWSKeyspacesTableNotRecreated(&table1, &table2),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.column.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_capacitySpecification(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfigCapacitySpecificationProvisioned(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.read_capacity_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.write_capacity_units", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKeyspacesTableConfigCapacitySpecificationProvisioned(rName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.read_capacity_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.write_capacity_units", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_encryptionSpecification(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfigEncryptionSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_specification.0.kms_key_identifier", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_specification.0.type", "CUSTOMER_MANAGED_KMS_KEY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_pointInTimeRecoveryAndTTL(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSKeyspacesTableConfigPointInTimeRecoveryAndTTL(rName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "default_time_to_live", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKeyspacesTable_tags(t *testing.T) {
+	var table keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + acctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKeyspacesTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKeyspacesTableConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSKeyspacesTableConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKeyspacesTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKeyspacesTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).keyspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_keyspaces_table" {
+			continue
+		}
+
+		keyspaceName, tableName, err := decodeKeyspacesTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		table, err := getKeyspacesTable(conn, keyspaceName, tableName)
+		if err != nil {
+			return err
+		}
+
+		if table != nil {
+			return fmt.Errorf("Keyspaces Table (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKeyspacesTableExists(n string, v *keyspaces.GetTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Keyspaces Table ID is set")
+		}
+
+		keyspaceName, tableName, err := decodeKeyspacesTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).keyspacesconn
+
+		table, err := getKeyspacesTable(conn, keyspaceName, tableName)
+		if err != nil {
+			return err
+		}
+
+		if table == nil {
+			return fmt.Errorf("Keyspaces Table (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *table
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKeyspacesTableNotRecreated(i, j *keyspaces.GetTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTimestamp).Equal(aws.TimeValue(j.CreationTimestamp)) {
+			return fmt.Errorf("Keyspaces Table was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSKeyspacesTableConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesTableConfig(rName string) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesTableConfigAddColumns(rName string) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    column {
+      name = "ts"
+      type = "timestamp"
+    }
+
+    column {
+      name = "amount"
+      type = "decimal"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesTableConfigMultipleColumns(rName string) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "id"
+      type = "text"
+    }
+
+    column {
+      name = "name"
+      type = "text"
+    }
+
+    column {
+      name = "region"
+      type = "text"
+    }
+
+    column {
+      name = "division"
+      type = "text"
+    }
+
+    column {
+      name = "project"
+      type = "text"
+    }
+
+    column {
+      name = "role"
+      type = "text"
+    }
+
+    partition_key {
+      name = "id"
+    }
+
+    partition_key {
+      name = "name"
+    }
+
+    clustering_key {
+      name     = "division"
+      order_by = "ASC"
+    }
+
+    clustering_key {
+      name     = "project"
+      order_by = "DESC"
+    }
+
+    static_column {
+      name = "role"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesTableConfigCapacitySpecificationProvisioned(rName string, readCapacityUnits, writeCapacityUnits int) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    read_capacity_units  = %[2]d
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = %[3]d
+  }
+}
+`, rName, readCapacityUnits, writeCapacityUnits)
+}
+
+func testAccAWSKeyspacesTableConfigEncryptionSpecification(rName string) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  encryption_specification {
+    kms_key_identifier = "${aws_kms_key.test.arn}"
+    type               = "CUSTOMER_MANAGED_KMS_KEY"
+  }
+}
+`, rName)
+}
+
+func testAccAWSKeyspacesTableConfigPointInTimeRecoveryAndTTL(rName string, defaultTimeToLive int) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name        = "${aws_keyspaces_keyspace.test.name}"
+  table_name           = %[1]q
+  default_time_to_live = %[2]d
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  point_in_time_recovery {
+    status = "ENABLED"
+  }
+
+  ttl {
+    status = "ENABLED"
+  }
+}
+`, rName, defaultTimeToLive)
+}
+
+func testAccAWSKeyspacesTableConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSKeyspacesTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = "${aws_keyspaces_keyspace.test.name}"
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,379 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsExternalKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsExternalKeyCreate,
+		Read:   resourceAwsKmsExternalKeyRead,
+		Update: resourceAwsKmsExternalKeyUpdate,
+		Delete: resourceAwsKmsExternalKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8192),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_base64": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"tags": tagsSchema(),
+			"valid_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.CreateKeyInput{
+		BypassPolicyLockoutSafetyCheck: aws.Bool(d.Get("bypass_policy_lockout_safety_check").(bool)),
+		KeyUsage:                       aws.String(kms.KeyUsageTypeEncryptDecrypt),
+		Origin:                         aws.String(kms.OriginTypeExternal),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_region"); ok {
+		input.MultiRegion = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		input.Policy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	var output *kms.CreateKeyOutput
+
+	// AWS requires any principal in the policy to exist before the key is created.
+	err := resource.Retry(30*time.Second, func() *resource.RetryError {
+		var err error
+
+		log.Printf("[DEBUG] Creating KMS external key: %s", input)
+		output, err = conn.CreateKey(input)
+
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS external key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.KeyMetadata.KeyId))
+
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+			return fmt.Errorf("error importing KMS external key (%s) material: %s", d.Id(), err)
+		}
+
+		if err := waitForKmsKeyState(conn, d.Id(), []string{kms.KeyStatePendingImport}, []string{kms.KeyStateEnabled}); err != nil {
+			return fmt.Errorf("error waiting for KMS external key (%s) to be enabled: %s", d.Id(), err)
+		}
+
+		// Newly imported key material leaves the key enabled.
+		if v, ok := d.GetOkExists("enabled"); ok && !v.(bool) {
+			if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	var output *kms.DescribeKeyOutput
+	var err error
+
+	if d.IsNewResource() {
+		var out interface{}
+		out, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(&kms.DescribeKeyInput{
+				KeyId: aws.String(d.Id()),
+			})
+		})
+		output, _ = out.(*kms.DescribeKeyOutput)
+	} else {
+		output, err = conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(d.Id()),
+		})
+	}
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] KMS external key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS external key (%s): %s", d.Id(), err)
+	}
+
+	metadata := output.KeyMetadata
+
+	if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] KMS external key (%s) is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if origin := aws.StringValue(metadata.Origin); origin != kms.OriginTypeExternal {
+		return fmt.Errorf("KMS key (%s) has origin %s, expected %s", d.Id(), origin, kms.OriginTypeExternal)
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("expiration_model", metadata.ExpirationModel)
+	d.Set("key_state", metadata.KeyState)
+	d.Set("key_usage", metadata.KeyUsage)
+	d.Set("multi_region", metadata.MultiRegion)
+
+	if metadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(metadata.ValidTo).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("valid_to", nil)
+	}
+
+	policyOutput, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+		KeyId:      metadata.KeyId,
+		PolicyName: aws.String("default"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS external key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(policyOutput.Policy))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	tagsOutput, err := conn.ListResourceTags(&kms.ListResourceTagsInput{
+		KeyId: metadata.KeyId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS external key (%s) tags: %s", d.Id(), err)
+	}
+
+	d.Set("tags", tagsToMapKMS(tagsOutput.Tags))
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Enable before any attributes will be modified
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d); err != nil {
+			return fmt.Errorf("error updating KMS external key (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+			return fmt.Errorf("error updating KMS external key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	// Only disable when all attributes are modified
+	// because we cannot modify disabled keys
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating KMS external key (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(d.Id()),
+		PendingWindowInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+	}
+
+	log.Printf("[DEBUG] Deleting KMS external key (%s)", d.Id())
+	_, err := conn.ScheduleKeyDeletion(input)
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if isAWSErr(err, kms.ErrCodeInvalidStateException, "is pending deletion") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS external key (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKmsKeyDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for KMS external key (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// importKmsExternalKeyMaterial wraps the plaintext key material with the
+// public key returned by GetParametersForImport and imports it into the key.
+func importKmsExternalKeyMaterial(conn *kms.KMS, keyID, keyMaterialBase64, validTo string) error {
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return fmt.Errorf("error decoding key material: %s", err)
+	}
+
+	parameters, err := conn.GetParametersForImport(&kms.GetParametersForImportInput{
+		KeyId:             aws.String(keyID),
+		WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+		WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting parameters for import: %s", err)
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(parameters.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing public key: %s", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unexpected public key type %T", publicKey)
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPublicKey, keyMaterial, []byte{})
+	if err != nil {
+		return fmt.Errorf("error encrypting key material: %s", err)
+	}
+
+	input := &kms.ImportKeyMaterialInput{
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+		ImportToken:          parameters.ImportToken,
+		KeyId:                aws.String(keyID),
+	}
+
+	if validTo != "" {
+		t, err := time.Parse(time.RFC3339, validTo)
+		if err != nil {
+			return fmt.Errorf("error parsing valid_to: %s", err)
+		}
+
+		input.ExpirationModel = aws.String(kms.ExpirationModelTypeKeyMaterialExpires)
+		input.ValidTo = aws.Time(t)
+	}
+
+	// Key policy changes can take a moment to allow kms:ImportKeyMaterial.
+	_, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.ImportKeyMaterial(input)
+	})
+
+	return err
+}
@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// 32 random bytes, base64 encoded; only ever used for acceptance testing.
+const testAccAWSKmsExternalKeyMaterialBase64 = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+
+func TestAccAWSKmsExternalKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:kms:[^:]+:[^:]+:key/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "key_material_base64"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStatePendingImport),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check", "deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_keyMaterialBase64(t *testing.T) {
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterialBase64(testAccAWSKmsExternalKeyMaterialBase64),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+					resource.TestCheckResourceAttr(resourceName, "key_material_base64", testAccAWSKmsExternalKeyMaterialBase64),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateEnabled),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check", "deletion_window_in_days", "key_material_base64"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_validTo(t *testing.T) {
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_external_key.test"
+	validTo := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigValidTo(testAccAWSKmsExternalKeyMaterialBase64, validTo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialExpires),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_update(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigUpdate(testAccAWSKmsExternalKeyMaterialBase64, rName, true, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigUpdate(testAccAWSKmsExternalKeyMaterialBase64, "updated", false, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateDisabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsExternalKeyExists(resourceName string, key *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS external key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+		output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*key = *output.KeyMetadata
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsExternalKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_external_key" {
+			continue
+		}
+
+		output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+			continue
+		}
+
+		return fmt.Errorf("KMS external key (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsExternalKeyConfig() string {
+	return `
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+}
+`
+}
+
+func testAccAWSKmsExternalKeyConfigKeyMaterialBase64(keyMaterialBase64 string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  key_material_base64     = %[1]q
+}
+`, keyMaterialBase64)
+}
+
+func testAccAWSKmsExternalKeyConfigValidTo(keyMaterialBase64, validTo string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  key_material_base64     = %[1]q
+  valid_to                = %[2]q
+}
+`, keyMaterialBase64, validTo)
+}
+
+func testAccAWSKmsExternalKeyConfigUpdate(keyMaterialBase64, description string, enabled bool, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  deletion_window_in_days = 7
+  description             = %[2]q
+  enabled                 = %[3]t
+  key_material_base64     = %[1]q
+
+  tags {
+    Key1 = %[4]q
+  }
+}
+`, keyMaterialBase64, description, enabled, tagValue)
+}
@@ -73,6 +73,12 @@ func resourceAwsKmsKey() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -92,6 +98,9 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
+	if v, exists := d.GetOk("multi_region"); exists {
+		req.MultiRegion = aws.Bool(v.(bool))
+	}
 	if v, exists := d.GetOk("tags"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
@@ -155,6 +164,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", metadata.Description)
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
+	d.Set("multi_region", metadata.MultiRegion)
 
 	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
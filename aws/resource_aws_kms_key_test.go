@@ -215,6 +215,48 @@ func TestAccAWSKmsKey_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSKmsKey_multiRegion(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "aws_kms_key.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsKey_multiRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "multi_region", "true"),
+					testAccCheckAWSKmsKeyMultiRegionKeyType(&key, kms.MultiRegionKeyTypePrimary),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsKeyMultiRegionKeyType(key *kms.KeyMetadata, keyType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if key.MultiRegionConfiguration == nil {
+			return fmt.Errorf("KMS key %s has no multi-Region configuration", aws.StringValue(key.KeyId))
+		}
+
+		if actual := aws.StringValue(key.MultiRegionConfiguration.MultiRegionKeyType); actual != keyType {
+			return fmt.Errorf("expected KMS key %s multi-Region key type to be %s, got %s", aws.StringValue(key.KeyId), keyType, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -436,3 +478,13 @@ resource "aws_kms_key" "foo" {
 	}
 }`, rName, rName)
 }
+
+func testAccAWSKmsKey_multiRegion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "foo" {
+  description             = "Terraform acc test %s"
+  deletion_window_in_days = 7
+  multi_region            = true
+}
+`, rName)
+}
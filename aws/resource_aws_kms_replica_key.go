@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsReplicaKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsReplicaKeyCreate,
+		Read:   resourceAwsKmsReplicaKeyRead,
+		Update: resourceAwsKmsReplicaKeyUpdate,
+		Delete: resourceAwsKmsReplicaKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8192),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"key_spec": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"primary_key_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsKmsReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+	primaryKeyArn := d.Get("primary_key_arn").(string)
+
+	// ReplicateKey has to be called in the Region of the primary key, while
+	// the replica itself lives in the provider's Region.
+	parsedArn, err := arn.Parse(primaryKeyArn)
+	if err != nil {
+		return fmt.Errorf("error parsing primary KMS key ARN (%s): %s", primaryKeyArn, err)
+	}
+
+	sess, err := session.NewSession(conn.Config.Copy(&aws.Config{Region: aws.String(parsedArn.Region)}))
+	if err != nil {
+		return fmt.Errorf("error creating AWS session for Region %s: %s", parsedArn.Region, err)
+	}
+
+	primaryConn := kms.New(sess)
+
+	input := &kms.ReplicateKeyInput{
+		BypassPolicyLockoutSafetyCheck: aws.Bool(d.Get("bypass_policy_lockout_safety_check").(bool)),
+		KeyId:                          aws.String(primaryKeyArn),
+		ReplicaRegion:                  aws.String(meta.(*AWSClient).region),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		input.Policy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	var output *kms.ReplicateKeyOutput
+
+	// As with CreateKey, principals in the key policy may not be visible to
+	// KMS yet.
+	err = resource.Retry(30*time.Second, func() *resource.RetryError {
+		var err error
+
+		log.Printf("[DEBUG] Creating KMS replica key: %s", input)
+		output, err = primaryConn.ReplicateKey(input)
+
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS replica key of %s: %s", primaryKeyArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicaKeyMetadata.KeyId))
+
+	if err := waitForKmsKeyState(conn, d.Id(), []string{kms.KeyStateCreating}, []string{kms.KeyStateEnabled}); err != nil {
+		return fmt.Errorf("error waiting for KMS replica key (%s) creation: %s", d.Id(), err)
+	}
+
+	if !d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKmsReplicaKeyRead(d, meta)
+}
+
+func resourceAwsKmsReplicaKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] KMS replica key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS replica key (%s): %s", d.Id(), err)
+	}
+
+	metadata := output.KeyMetadata
+
+	switch aws.StringValue(metadata.KeyState) {
+	case kms.KeyStatePendingDeletion, kms.KeyStatePendingReplicaDeletion:
+		log.Printf("[WARN] KMS replica key (%s) is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if metadata.MultiRegionConfiguration == nil || aws.StringValue(metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypeReplica {
+		return fmt.Errorf("KMS key (%s) is not a multi-Region replica key", d.Id())
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("key_id", metadata.KeyId)
+	d.Set("key_spec", metadata.KeySpec)
+	d.Set("key_usage", metadata.KeyUsage)
+
+	if metadata.MultiRegionConfiguration.PrimaryKey != nil {
+		d.Set("primary_key_arn", metadata.MultiRegionConfiguration.PrimaryKey.Arn)
+	}
+
+	policyOutput, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+		KeyId:      metadata.KeyId,
+		PolicyName: aws.String("default"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS replica key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(policyOutput.Policy))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	rotationOutput, err := conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
+		KeyId: metadata.KeyId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS replica key (%s) rotation status: %s", d.Id(), err)
+	}
+
+	d.Set("key_rotation_enabled", rotationOutput.KeyRotationEnabled)
+
+	tagsOutput, err := conn.ListResourceTags(&kms.ListResourceTagsInput{
+		KeyId: metadata.KeyId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS replica key (%s) tags: %s", d.Id(), err)
+	}
+
+	d.Set("tags", tagsToMapKMS(tagsOutput.Tags))
+
+	return nil
+}
+
+func resourceAwsKmsReplicaKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Enable before any attributes will be modified
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d); err != nil {
+			return fmt.Errorf("error updating KMS replica key (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+			return fmt.Errorf("error updating KMS replica key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	// Only disable when all attributes are modified
+	// because we cannot modify disabled keys
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating KMS replica key (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsKmsReplicaKeyRead(d, meta)
+}
+
+func resourceAwsKmsReplicaKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(d.Id()),
+		PendingWindowInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+	}
+
+	log.Printf("[DEBUG] Deleting KMS replica key (%s)", d.Id())
+	_, err := conn.ScheduleKeyDeletion(input)
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if isAWSErr(err, kms.ErrCodeInvalidStateException, "is pending deletion") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS replica key (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKmsKeyDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for KMS replica key (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
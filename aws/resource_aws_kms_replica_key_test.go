@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKmsReplicaKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+	primaryKeyResourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSKmsReplicaKeyDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfig(rName, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsReplicaKeyExistsWithProvider(resourceName, &key, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_spec", kms.KeySpecSymmetricDefault),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfig(rName, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsReplicaKeyExistsWithProvider(resourceName, &key, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsReplicaKeyExistsWithProvider(resourceName string, key *kms.KeyMetadata, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS replica key ID is set")
+		}
+
+		provider := providerF()
+		if provider == nil {
+			return fmt.Errorf("No provider found for %s", resourceName)
+		}
+
+		conn := provider.Meta().(*AWSClient).kmsconn
+
+		output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*key = *output.KeyMetadata
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsReplicaKeyDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_replica_key" {
+			continue
+		}
+
+		output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch aws.StringValue(output.KeyMetadata.KeyState) {
+		case kms.KeyStatePendingDeletion, kms.KeyStatePendingReplicaDeletion:
+			continue
+		}
+
+		return fmt.Errorf("KMS replica key (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsReplicaKeyConfig(rName, description string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "primary"
+  region = "us-east-1"
+}
+
+resource "aws_kms_key" "test" {
+  provider = "aws.primary"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = true
+}
+
+resource "aws_kms_replica_key" "test" {
+  description             = %[2]q
+  deletion_window_in_days = 7
+  enabled                 = %[3]t
+  primary_key_arn         = "${aws_kms_key.test.arn}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, description, enabled)
+}
@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationDataLakeSettingsPut,
+		Read:   resourceAwsLakeFormationDataLakeSettingsRead,
+		Update: resourceAwsLakeFormationDataLakeSettingsPut,
+		Delete: resourceAwsLakeFormationDataLakeSettingsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"create_database_default_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     lakeFormationPrincipalPermissionsSchema(),
+			},
+			"create_table_default_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     lakeFormationPrincipalPermissionsSchema(),
+			},
+			"trusted_resource_owners": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func lakeFormationPrincipalPermissionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationDataLakeSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: expandLakeFormationPrincipalPermissions(d.Get("create_database_default_permissions").([]interface{})),
+			CreateTableDefaultPermissions:    expandLakeFormationPrincipalPermissions(d.Get("create_table_default_permissions").([]interface{})),
+			DataLakeAdmins:                   expandLakeFormationDataLakePrincipals(d.Get("admins").(*schema.Set).List()),
+			TrustedResourceOwners:            expandStringList(d.Get("trusted_resource_owners").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting Lake Formation Data Lake Settings: %s", input)
+	_, err := conn.PutDataLakeSettings(input)
+	if err != nil {
+		return fmt.Errorf("error putting Lake Formation Data Lake Settings: %s", err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+	}
+
+	return resourceAwsLakeFormationDataLakeSettingsRead(d, meta)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.GetDataLakeSettingsInput{}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.GetDataLakeSettings(input)
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	settings := output.DataLakeSettings
+	if settings == nil {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("admins", flattenLakeFormationDataLakePrincipals(settings.DataLakeAdmins)); err != nil {
+		return fmt.Errorf("error setting admins: %s", err)
+	}
+
+	if err := d.Set("create_database_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateDatabaseDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_database_default_permissions: %s", err)
+	}
+
+	if err := d.Set("create_table_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateTableDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_table_default_permissions: %s", err)
+	}
+
+	if err := d.Set("trusted_resource_owners", flattenStringList(settings.TrustedResourceOwners)); err != nil {
+		return fmt.Errorf("error setting trusted_resource_owners: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsLakeFormationDataLakeSettingsDelete clears the admins and
+// restores the default IAM_ALLOWED_PRINCIPALS permissions, which is the
+// state of an account that has never configured Lake Formation.
+func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	defaultPermissions := []*lakeformation.PrincipalPermissions{
+		{
+			Permissions: aws.StringSlice([]string{lakeformation.PermissionAll}),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String("IAM_ALLOWED_PRINCIPALS"),
+			},
+		},
+	}
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: defaultPermissions,
+			CreateTableDefaultPermissions:    defaultPermissions,
+			DataLakeAdmins:                   []*lakeformation.DataLakePrincipal{},
+			TrustedResourceOwners:            []*string{},
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Resetting Lake Formation Data Lake Settings: %s", d.Id())
+	_, err := conn.PutDataLakeSettings(input)
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error resetting Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationDataLakePrincipals(l []interface{}) []*lakeformation.DataLakePrincipal {
+	principals := make([]*lakeformation.DataLakePrincipal, 0, len(l))
+
+	for _, v := range l {
+		principals = append(principals, &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v.(string)),
+		})
+	}
+
+	return principals
+}
+
+func flattenLakeFormationDataLakePrincipals(principals []*lakeformation.DataLakePrincipal) []interface{} {
+	result := make([]interface{}, 0, len(principals))
+
+	for _, principal := range principals {
+		result = append(result, aws.StringValue(principal.DataLakePrincipalIdentifier))
+	}
+
+	return result
+}
+
+func expandLakeFormationPrincipalPermissions(l []interface{}) []*lakeformation.PrincipalPermissions {
+	permissions := make([]*lakeformation.PrincipalPermissions, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		permissions = append(permissions, &lakeformation.PrincipalPermissions{
+			Permissions: expandStringSet(m["permissions"].(*schema.Set)),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(m["principal"].(string)),
+			},
+		})
+	}
+
+	return permissions
+}
+
+func flattenLakeFormationPrincipalPermissions(permissions []*lakeformation.PrincipalPermissions) []interface{} {
+	result := make([]interface{}, 0, len(permissions))
+
+	for _, permission := range permissions {
+		m := map[string]interface{}{
+			"permissions": schema.NewSet(schema.HashString, flattenStringList(permission.Permissions)),
+		}
+
+		if permission.Principal != nil {
+			m["principal"] = aws.StringValue(permission.Principal.DataLakePrincipalIdentifier)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLakeFormationDataLakeSettings_basic(t *testing.T) {
+	resourceName := "aws_lakeformation_data_lake_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationDataLakeSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationDataLakeSettingsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_database_default_permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permissions.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSLakeFormationDataLakeSettingsConfig_defaultPermissions,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_database_default_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_database_default_permissions.0.principal", "IAM_ALLOWED_PRINCIPALS"),
+					resource.TestCheckResourceAttr(resourceName, "create_database_default_permissions.0.permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_table_default_permissions.0.principal", "IAM_ALLOWED_PRINCIPALS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationDataLakeSettingsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_data_lake_settings" {
+			continue
+		}
+
+		output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.DataLakeSettings != nil && len(output.DataLakeSettings.DataLakeAdmins) > 0 {
+			return fmt.Errorf("Lake Formation Data Lake Settings (%s) still have admins", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.DataLakeSettings == nil {
+			return fmt.Errorf("Lake Formation Data Lake Settings (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSLakeFormationDataLakeSettingsConfig_basic = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+
+  create_database_default_permissions = []
+  create_table_default_permissions    = []
+}
+`
+
+const testAccAWSLakeFormationDataLakeSettingsConfig_defaultPermissions = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+
+  create_database_default_permissions {
+    permissions = ["ALL"]
+    principal   = "IAM_ALLOWED_PRINCIPALS"
+  }
+
+  create_table_default_permissions {
+    permissions = ["ALL"]
+    principal   = "IAM_ALLOWED_PRINCIPALS"
+  }
+}
+`
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLakeFormationLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationLFTagCreate,
+		Read:   resourceAwsLakeFormationLFTagRead,
+		Update: resourceAwsLakeFormationLFTagUpdate,
+		Delete: resourceAwsLakeFormationLFTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	key := d.Get("key").(string)
+	input := &lakeformation.CreateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+		TagValues: expandStringSet(d.Get("values").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating Lake Formation LF-Tag: %s", input)
+	_, err := conn.CreateLFTag(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag (%s): %s", key, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, key))
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, key, err := readAwsLakeFormationLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetLFTag(&lakeformation.GetLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("key", output.TagKey)
+
+	if err := d.Set("values", flattenStringList(output.TagValues)); err != nil {
+		return fmt.Errorf("error setting values: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationLFTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, key, err := readAwsLakeFormationLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("values")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	input := &lakeformation.UpdateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+	}
+
+	if add := ns.Difference(os); add.Len() > 0 {
+		input.TagValuesToAdd = expandStringSet(add)
+	}
+
+	if remove := os.Difference(ns); remove.Len() > 0 {
+		input.TagValuesToDelete = expandStringSet(remove)
+	}
+
+	log.Printf("[DEBUG] Updating Lake Formation LF-Tag: %s", input)
+	_, err = conn.UpdateLFTag(input)
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation LF-Tag (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, key, err := readAwsLakeFormationLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation LF-Tag: %s", d.Id())
+	_, err = conn.DeleteLFTag(&lakeformation.DeleteLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func readAwsLakeFormationLFTagID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected CATALOG-ID:KEY", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLakeFormationLFTag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_basic(rName, `"dev"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "catalog_id", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_basic(rName, `"prod", "test"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationLFTagDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_lf_tag" {
+			continue
+		}
+
+		catalogID, key, err := readAwsLakeFormationLFTagID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetLFTag(&lakeformation.GetLFTagInput{
+			CatalogId: aws.String(catalogID),
+			TagKey:    aws.String(key),
+		})
+		if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lake Formation LF-Tag (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationLFTagExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		catalogID, key, err := readAwsLakeFormationLFTagID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		_, err = conn.GetLFTag(&lakeformation.GetLFTagInput{
+			CatalogId: aws.String(catalogID),
+			TagKey:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSLakeFormationLFTagConfig_basic(rName, values string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = [%[2]s]
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName, values)
+}
@@ -0,0 +1,575 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"catalog_resource": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"lf_tag_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"expression": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lakeformation.ResourceTypeDatabase,
+								lakeformation.ResourceTypeTable,
+							}, false),
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	lfResource, err := expandLakeFormationResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+		// Newly created IAM principals are not immediately visible to Lake Formation.
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation Permissions: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	lfResource, err := expandLakeFormationResource(d)
+	if err != nil {
+		return err
+	}
+
+	principal := d.Get("principal").(string)
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	// Column-level grants are listed through their table, and matched on
+	// the returned column selection below.
+	if v := lfResource.TableWithColumns; v != nil {
+		input.Resource = &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				CatalogId:    v.CatalogId,
+				DatabaseName: v.DatabaseName,
+				Name:         v.Name,
+			},
+		}
+	}
+
+	var permissions, permissionsWithGrantOption []*string
+	err = conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != principal {
+				continue
+			}
+
+			if lfResource.TableWithColumns != nil && !lakeFormationTableWithColumnsMatches(lfResource.TableWithColumns, permission.Resource) {
+				continue
+			}
+
+			permissions = append(permissions, permission.Permissions...)
+			permissionsWithGrantOption = append(permissionsWithGrantOption, permission.PermissionsWithGrantOption...)
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	if len(permissions) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("permissions", flattenStringList(permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %s", err)
+	}
+
+	if err := d.Set("permissions_with_grant_option", flattenStringList(permissionsWithGrantOption)); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	lfResource, err := expandLakeFormationResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[INFO] Revoking Lake Formation Permissions: %s", input)
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandLakeFormationResource builds the resource the permissions apply to.
+// Exactly one of the resource arguments must be configured.
+func expandLakeFormationResource(d *schema.ResourceData) (*lakeformation.Resource, error) {
+	lfResource := &lakeformation.Resource{}
+	configured := 0
+
+	if d.Get("catalog_resource").(bool) {
+		lfResource.Catalog = &lakeformation.CatalogResource{}
+		configured++
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		lfResource.DataLocation = &lakeformation.DataLocationResource{
+			CatalogId:   lakeFormationCatalogID(m),
+			ResourceArn: aws.String(m["arn"].(string)),
+		}
+		configured++
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		lfResource.Database = &lakeformation.DatabaseResource{
+			CatalogId: lakeFormationCatalogID(m),
+			Name:      aws.String(m["name"].(string)),
+		}
+		configured++
+	}
+
+	if v, ok := d.GetOk("lf_tag"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		lfResource.LFTag = &lakeformation.LFTagKeyResource{
+			CatalogId: lakeFormationCatalogID(m),
+			TagKey:    aws.String(m["key"].(string)),
+			TagValues: expandStringSet(m["values"].(*schema.Set)),
+		}
+		configured++
+	}
+
+	if v, ok := d.GetOk("lf_tag_policy"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		policy := &lakeformation.LFTagPolicyResource{
+			CatalogId:    lakeFormationCatalogID(m),
+			ResourceType: aws.String(m["resource_type"].(string)),
+		}
+		for _, raw := range m["expression"].([]interface{}) {
+			em := raw.(map[string]interface{})
+			policy.Expression = append(policy.Expression, &lakeformation.LFTag{
+				TagKey:    aws.String(em["key"].(string)),
+				TagValues: expandStringSet(em["values"].(*schema.Set)),
+			})
+		}
+		lfResource.LFTagPolicy = policy
+		configured++
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		table := &lakeformation.TableResource{
+			CatalogId:    lakeFormationCatalogID(m),
+			DatabaseName: aws.String(m["database_name"].(string)),
+		}
+		name := m["name"].(string)
+		wildcard := m["wildcard"].(bool)
+		if (name == "") == !wildcard {
+			return nil, fmt.Errorf("exactly one of table name or wildcard must be set")
+		}
+		if wildcard {
+			table.TableWildcard = &lakeformation.TableWildcard{}
+		} else {
+			table.Name = aws.String(name)
+		}
+		lfResource.Table = table
+		configured++
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		table := &lakeformation.TableWithColumnsResource{
+			CatalogId:    lakeFormationCatalogID(m),
+			DatabaseName: aws.String(m["database_name"].(string)),
+			Name:         aws.String(m["name"].(string)),
+		}
+		columnNames := m["column_names"].(*schema.Set)
+		excludedColumnNames := m["excluded_column_names"].(*schema.Set)
+		wildcard := m["wildcard"].(bool)
+		if (columnNames.Len() > 0) == wildcard {
+			return nil, fmt.Errorf("exactly one of table_with_columns column_names or wildcard must be set")
+		}
+		if !wildcard && excludedColumnNames.Len() > 0 {
+			return nil, fmt.Errorf("table_with_columns excluded_column_names requires wildcard")
+		}
+		if wildcard {
+			table.ColumnWildcard = &lakeformation.ColumnWildcard{
+				ExcludedColumnNames: expandStringSet(excludedColumnNames),
+			}
+		} else {
+			table.ColumnNames = expandStringSet(columnNames)
+		}
+		lfResource.TableWithColumns = table
+		configured++
+	}
+
+	if configured != 1 {
+		return nil, fmt.Errorf("exactly one of catalog_resource, data_location, database, lf_tag, lf_tag_policy, table or table_with_columns must be set")
+	}
+
+	return lfResource, nil
+}
+
+func lakeFormationCatalogID(m map[string]interface{}) *string {
+	if v, ok := m["catalog_id"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+	return nil
+}
+
+// lakeFormationTableWithColumnsMatches reports whether a listed permission
+// applies to the same column selection as the configured one.
+func lakeFormationTableWithColumnsMatches(expected *lakeformation.TableWithColumnsResource, actual *lakeformation.Resource) bool {
+	if actual == nil || actual.TableWithColumns == nil {
+		return false
+	}
+
+	columns := actual.TableWithColumns
+
+	if expected.ColumnWildcard != nil {
+		if columns.ColumnWildcard == nil {
+			return false
+		}
+		return stringSlicesEqualIgnoreOrder(expected.ColumnWildcard.ExcludedColumnNames, columns.ColumnWildcard.ExcludedColumnNames)
+	}
+
+	return columns.ColumnWildcard == nil && stringSlicesEqualIgnoreOrder(expected.ColumnNames, columns.ColumnNames)
+}
+
+func stringSlicesEqualIgnoreOrder(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[aws.StringValue(v)]++
+	}
+	for _, v := range b {
+		counts[aws.StringValue(v)]--
+		if counts[aws.StringValue(v)] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
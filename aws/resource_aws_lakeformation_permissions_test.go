@@ -0,0 +1,343 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLakeFormationTableWithColumnsMatches(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected *lakeformation.TableWithColumnsResource
+		Actual   *lakeformation.Resource
+		Matches  bool
+	}{
+		{
+			Name: "no resource",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnNames: aws.StringSlice([]string{"a"}),
+			},
+			Actual:  nil,
+			Matches: false,
+		},
+		{
+			Name: "table permission",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnNames: aws.StringSlice([]string{"a"}),
+			},
+			Actual: &lakeformation.Resource{
+				Table: &lakeformation.TableResource{},
+			},
+			Matches: false,
+		},
+		{
+			Name: "same columns in different order",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnNames: aws.StringSlice([]string{"a", "b"}),
+			},
+			Actual: &lakeformation.Resource{
+				TableWithColumns: &lakeformation.TableWithColumnsResource{
+					ColumnNames: aws.StringSlice([]string{"b", "a"}),
+				},
+			},
+			Matches: true,
+		},
+		{
+			Name: "different columns",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnNames: aws.StringSlice([]string{"a", "b"}),
+			},
+			Actual: &lakeformation.Resource{
+				TableWithColumns: &lakeformation.TableWithColumnsResource{
+					ColumnNames: aws.StringSlice([]string{"a", "c"}),
+				},
+			},
+			Matches: false,
+		},
+		{
+			Name: "wildcard against columns",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnWildcard: &lakeformation.ColumnWildcard{},
+			},
+			Actual: &lakeformation.Resource{
+				TableWithColumns: &lakeformation.TableWithColumnsResource{
+					ColumnNames: aws.StringSlice([]string{"a"}),
+				},
+			},
+			Matches: false,
+		},
+		{
+			Name: "wildcard with same exclusions",
+			Expected: &lakeformation.TableWithColumnsResource{
+				ColumnWildcard: &lakeformation.ColumnWildcard{
+					ExcludedColumnNames: aws.StringSlice([]string{"secret"}),
+				},
+			},
+			Actual: &lakeformation.Resource{
+				TableWithColumns: &lakeformation.TableWithColumnsResource{
+					ColumnWildcard: &lakeformation.ColumnWildcard{
+						ExcludedColumnNames: aws.StringSlice([]string{"secret"}),
+					},
+				},
+			},
+			Matches: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := lakeFormationTableWithColumnsMatches(tc.Expected, tc.Actual); got != tc.Matches {
+			t.Errorf("%s: expected %t, got %t", tc.Name, tc.Matches, got)
+		}
+	}
+}
+
+func TestAccAWSLakeFormationPermissions_database(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_tableWithColumns(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName, `column_names = ["id"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName, "wildcard = true\n    excluded_column_names = [\"payload\"]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.wildcard", "true"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.excluded_column_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_lfTagPolicy(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_lfTagPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.resource_type", lakeformation.ResourceTypeTable),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.expression.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag_policy.0.expression.0.key", "aws_lakeformation_lf_tag.test", "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions" {
+			continue
+		}
+
+		count, err := testAccAWSLakeFormationPermissionsCount(rs)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) still granted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationPermissionsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		count, err := testAccAWSLakeFormationPermissionsCount(rs)
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSLakeFormationPermissionsCount returns the number of permissions
+// the principal holds, across all resources.
+func testAccAWSLakeFormationPermissionsCount(rs *terraform.ResourceState) (int, error) {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal"]),
+		},
+	}
+
+	count := 0
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			count += len(permission.Permissions)
+		}
+		return !lastPage
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return 0, nil
+	}
+
+	return count, err
+}
+
+func testAccAWSLakeFormationPermissionsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "glue.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    columns {
+      name = "id"
+      type = "bigint"
+    }
+
+    columns {
+      name = "payload"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_database(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_base(rName) + `
+resource "aws_lakeformation_permissions" "test" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["ALTER", "CREATE_TABLE"]
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+}
+`
+}
+
+func testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName, columns string) string {
+	return testAccAWSLakeFormationPermissionsConfig_base(rName) + fmt.Sprintf(`
+resource "aws_lakeformation_permissions" "test" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["SELECT"]
+
+  table_with_columns {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    name          = "${aws_glue_catalog_table.test.name}"
+    %[1]s
+  }
+}
+`, columns)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_lfTagPolicy(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_base(rName) + fmt.Sprintf(`
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["public", "restricted"]
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["SELECT", "DESCRIBE"]
+
+  lf_tag_policy {
+    resource_type = "TABLE"
+
+    expression {
+      key    = "${aws_lakeformation_lf_tag.test.key}"
+      values = ["public"]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLakeFormationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationResourceCreate,
+		Read:   resourceAwsLakeFormationResourceRead,
+		Delete: resourceAwsLakeFormationResourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	resourceArn := d.Get("arn").(string)
+	input := &lakeformation.RegisterResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	// Without an explicit role Lake Formation accesses the location through
+	// its service-linked role.
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	} else {
+		input.UseServiceLinkedRole = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Registering Lake Formation Resource: %s", input)
+	_, err := conn.RegisterResource(input)
+	if err != nil {
+		return fmt.Errorf("error registering Lake Formation Resource (%s): %s", resourceArn, err)
+	}
+
+	d.SetId(resourceArn)
+
+	return resourceAwsLakeFormationResourceRead(d, meta)
+}
+
+func resourceAwsLakeFormationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	output, err := conn.DescribeResource(&lakeformation.DescribeResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource (%s): %s", d.Id(), err)
+	}
+
+	resourceInfo := output.ResourceInfo
+	if resourceInfo == nil {
+		log.Printf("[WARN] Lake Formation Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resourceInfo.ResourceArn)
+	d.Set("role_arn", resourceInfo.RoleArn)
+
+	if v := resourceInfo.LastModified; v != nil {
+		d.Set("last_modified", v.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	log.Printf("[INFO] Deregistering Lake Formation Resource: %s", d.Id())
+	_, err := conn.DeregisterResource(&lakeformation.DeregisterResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deregistering Lake Formation Resource (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
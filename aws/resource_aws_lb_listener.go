@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -39,14 +40,14 @@ func resourceAwsLbListener() *schema.Resource {
 
 			"port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.IntBetween(1, 65535),
 			},
 
 			"protocol": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "HTTP",
+				Computed: true,
 				StateFunc: func(v interface{}) string {
 					return strings.ToUpper(v.(string))
 				},
@@ -67,19 +68,7 @@ func resourceAwsLbListener() *schema.Resource {
 			"default_action": {
 				Type:     schema.TypeList,
 				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validateLbListenerActionType(),
-						},
-					},
-				},
+				Elem:     lbListenerActionResource(),
 			},
 		},
 	}
@@ -92,8 +81,22 @@ func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error
 
 	params := &elbv2.CreateListenerInput{
 		LoadBalancerArn: aws.String(lbArn),
-		Port:            aws.Int64(int64(d.Get("port").(int))),
-		Protocol:        aws.String(d.Get("protocol").(string)),
+	}
+
+	// Gateway Load Balancer listeners listen on all ports and protocols, and
+	// the API rejects any port or protocol being specified for them.
+	if !isLbGatewayArn(lbArn) {
+		port, ok := d.GetOk("port")
+		if !ok {
+			return fmt.Errorf("Error creating LB Listener: port is required for load balancers of type application or network")
+		}
+		params.Port = aws.Int64(int64(port.(int)))
+
+		protocol := "HTTP"
+		if v, ok := d.GetOk("protocol"); ok {
+			protocol = v.(string)
+		}
+		params.Protocol = aws.String(protocol)
 	}
 
 	if sslPolicy, ok := d.GetOk("ssl_policy"); ok {
@@ -107,22 +110,15 @@ func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if defaultActions := d.Get("default_action").([]interface{}); len(defaultActions) == 1 {
-		params.DefaultActions = make([]*elbv2.Action, len(defaultActions))
-
-		for i, defaultAction := range defaultActions {
-			defaultActionMap := defaultAction.(map[string]interface{})
-
-			params.DefaultActions[i] = &elbv2.Action{
-				TargetGroupArn: aws.String(defaultActionMap["target_group_arn"].(string)),
-				Type:           aws.String(defaultActionMap["type"].(string)),
-			}
-		}
+	defaultActions, err := expandLbListenerActions(d.Get("default_action").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error creating LB Listener: %s", err)
 	}
+	params.DefaultActions = defaultActions
 
 	var resp *elbv2.CreateListenerOutput
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
 		log.Printf("[DEBUG] Creating LB listener for ARN: %s", d.Get("load_balancer_arn").(string))
 		resp, err = elbconn.CreateListener(params)
@@ -179,17 +175,9 @@ func resourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("certificate_arn", listener.Certificates[0].CertificateArn)
 	}
 
-	defaultActions := make([]map[string]interface{}, 0)
-	if listener.DefaultActions != nil && len(listener.DefaultActions) > 0 {
-		for _, defaultAction := range listener.DefaultActions {
-			action := map[string]interface{}{
-				"target_group_arn": *defaultAction.TargetGroupArn,
-				"type":             *defaultAction.Type,
-			}
-			defaultActions = append(defaultActions, action)
-		}
+	if err := d.Set("default_action", flattenLbListenerActions(d, "default_action", listener.DefaultActions)); err != nil {
+		return fmt.Errorf("error setting default_action: %s", err)
 	}
-	d.Set("default_action", defaultActions)
 
 	return nil
 }
@@ -199,8 +187,11 @@ func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error
 
 	params := &elbv2.ModifyListenerInput{
 		ListenerArn: aws.String(d.Id()),
-		Port:        aws.Int64(int64(d.Get("port").(int))),
-		Protocol:    aws.String(d.Get("protocol").(string)),
+	}
+
+	if !isLbGatewayArn(d.Get("load_balancer_arn").(string)) {
+		params.Port = aws.Int64(int64(d.Get("port").(int)))
+		params.Protocol = aws.String(d.Get("protocol").(string))
 	}
 
 	if sslPolicy, ok := d.GetOk("ssl_policy"); ok {
@@ -214,20 +205,13 @@ func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if defaultActions := d.Get("default_action").([]interface{}); len(defaultActions) == 1 {
-		params.DefaultActions = make([]*elbv2.Action, len(defaultActions))
-
-		for i, defaultAction := range defaultActions {
-			defaultActionMap := defaultAction.(map[string]interface{})
-
-			params.DefaultActions[i] = &elbv2.Action{
-				TargetGroupArn: aws.String(defaultActionMap["target_group_arn"].(string)),
-				Type:           aws.String(defaultActionMap["type"].(string)),
-			}
-		}
+	defaultActions, err := expandLbListenerActions(d.Get("default_action").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error modifying LB Listener: %s", err)
 	}
+	params.DefaultActions = defaultActions
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		_, err := elbconn.ModifyListener(params)
 		if err != nil {
 			if isAWSErr(err, elbv2.ErrCodeCertificateNotFoundException, "") {
@@ -260,9 +244,18 @@ func resourceAwsLbListenerDelete(d *schema.ResourceData, meta interface{}) error
 func validateLbListenerActionType() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		elbv2.ActionTypeEnumForward,
+		elbv2.ActionTypeEnumAuthenticateOidc,
+		elbv2.ActionTypeEnumAuthenticateCognito,
 	}, true)
 }
 
+// isLbGatewayArn reports whether the given load balancer ARN belongs to a
+// Gateway Load Balancer, e.g.
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/gwy/my-lb/50dc6c495c0c9188
+func isLbGatewayArn(arn string) bool {
+	return strings.Contains(arn, ":loadbalancer/gwy/")
+}
+
 func validateLbListenerProtocol() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"http",
@@ -270,3 +263,305 @@ func validateLbListenerProtocol() schema.SchemaValidateFunc {
 		"tcp",
 	}, true)
 }
+
+// lbListenerActionResource returns the schema shared by listener default
+// actions and listener rule actions.
+func lbListenerActionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLbListenerActionType(),
+			},
+
+			"order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 50000),
+			},
+
+			"target_group_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"authenticate_cognito": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_request_extra_params": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"on_unauthenticated_request": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateLbListenerOnUnauthenticatedRequest(),
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"session_cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"session_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"user_pool_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_pool_client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_pool_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"authenticate_oidc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_request_extra_params": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"authorization_endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"on_unauthenticated_request": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateLbListenerOnUnauthenticatedRequest(),
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"session_cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"session_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"token_endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_info_endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateLbListenerOnUnauthenticatedRequest() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		elbv2.AuthenticateCognitoActionConditionalBehaviorEnumDeny,
+		elbv2.AuthenticateCognitoActionConditionalBehaviorEnumAllow,
+		elbv2.AuthenticateCognitoActionConditionalBehaviorEnumAuthenticate,
+	}, true)
+}
+
+func expandLbListenerActions(l []interface{}) ([]*elbv2.Action, error) {
+	actions := make([]*elbv2.Action, 0, len(l))
+
+	for i, raw := range l {
+		m := raw.(map[string]interface{})
+		actionType := strings.ToLower(m["type"].(string))
+
+		action := &elbv2.Action{
+			Type: aws.String(actionType),
+		}
+
+		if v, ok := m["order"].(int); ok && v != 0 {
+			action.Order = aws.Int64(int64(v))
+		} else if len(l) > 1 {
+			action.Order = aws.Int64(int64(i + 1))
+		}
+
+		switch actionType {
+		case elbv2.ActionTypeEnumForward:
+			v := m["target_group_arn"].(string)
+			if v == "" {
+				return nil, errors.New("target_group_arn must be specified for forward actions")
+			}
+			action.TargetGroupArn = aws.String(v)
+
+		case elbv2.ActionTypeEnumAuthenticateCognito:
+			config := m["authenticate_cognito"].([]interface{})
+			if len(config) == 0 || config[0] == nil {
+				return nil, errors.New("authenticate_cognito must be specified for authenticate-cognito actions")
+			}
+			action.AuthenticateCognitoConfig = expandLbAuthenticateCognitoConfig(config[0].(map[string]interface{}))
+
+		case elbv2.ActionTypeEnumAuthenticateOidc:
+			config := m["authenticate_oidc"].([]interface{})
+			if len(config) == 0 || config[0] == nil {
+				return nil, errors.New("authenticate_oidc must be specified for authenticate-oidc actions")
+			}
+			action.AuthenticateOidcConfig = expandLbAuthenticateOidcConfig(config[0].(map[string]interface{}))
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+func expandLbAuthenticateCognitoConfig(m map[string]interface{}) *elbv2.AuthenticateCognitoActionConfig {
+	config := &elbv2.AuthenticateCognitoActionConfig{
+		UserPoolArn:      aws.String(m["user_pool_arn"].(string)),
+		UserPoolClientId: aws.String(m["user_pool_client_id"].(string)),
+		UserPoolDomain:   aws.String(m["user_pool_domain"].(string)),
+	}
+
+	if v, ok := m["authentication_request_extra_params"].(map[string]interface{}); ok && len(v) > 0 {
+		config.AuthenticationRequestExtraParams = stringMapToPointers(v)
+	}
+	if v, ok := m["on_unauthenticated_request"].(string); ok && v != "" {
+		config.OnUnauthenticatedRequest = aws.String(strings.ToLower(v))
+	}
+	if v, ok := m["scope"].(string); ok && v != "" {
+		config.Scope = aws.String(v)
+	}
+	if v, ok := m["session_cookie_name"].(string); ok && v != "" {
+		config.SessionCookieName = aws.String(v)
+	}
+	if v, ok := m["session_timeout"].(int); ok && v != 0 {
+		config.SessionTimeout = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func expandLbAuthenticateOidcConfig(m map[string]interface{}) *elbv2.AuthenticateOidcActionConfig {
+	config := &elbv2.AuthenticateOidcActionConfig{
+		AuthorizationEndpoint: aws.String(m["authorization_endpoint"].(string)),
+		ClientId:              aws.String(m["client_id"].(string)),
+		ClientSecret:          aws.String(m["client_secret"].(string)),
+		Issuer:                aws.String(m["issuer"].(string)),
+		TokenEndpoint:         aws.String(m["token_endpoint"].(string)),
+		UserInfoEndpoint:      aws.String(m["user_info_endpoint"].(string)),
+	}
+
+	if v, ok := m["authentication_request_extra_params"].(map[string]interface{}); ok && len(v) > 0 {
+		config.AuthenticationRequestExtraParams = stringMapToPointers(v)
+	}
+	if v, ok := m["on_unauthenticated_request"].(string); ok && v != "" {
+		config.OnUnauthenticatedRequest = aws.String(strings.ToLower(v))
+	}
+	if v, ok := m["scope"].(string); ok && v != "" {
+		config.Scope = aws.String(v)
+	}
+	if v, ok := m["session_cookie_name"].(string); ok && v != "" {
+		config.SessionCookieName = aws.String(v)
+	}
+	if v, ok := m["session_timeout"].(int); ok && v != 0 {
+		config.SessionTimeout = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+// flattenLbListenerActions converts the actions returned by the ELBv2 API into
+// their schema representation. The API never returns OIDC client secrets, so
+// the secret currently held in configuration or state for the same position
+// under key is passed through.
+func flattenLbListenerActions(d *schema.ResourceData, key string, actions []*elbv2.Action) []interface{} {
+	sorted := make([]*elbv2.Action, len(actions))
+	copy(sorted, actions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].Order) < aws.Int64Value(sorted[j].Order)
+	})
+
+	l := make([]interface{}, 0, len(sorted))
+	for i, action := range sorted {
+		m := map[string]interface{}{
+			"type":             aws.StringValue(action.Type),
+			"order":            int(aws.Int64Value(action.Order)),
+			"target_group_arn": aws.StringValue(action.TargetGroupArn),
+		}
+
+		if config := action.AuthenticateCognitoConfig; config != nil {
+			m["authenticate_cognito"] = []interface{}{
+				map[string]interface{}{
+					"authentication_request_extra_params": pointersMapToStringList(config.AuthenticationRequestExtraParams),
+					"on_unauthenticated_request":          aws.StringValue(config.OnUnauthenticatedRequest),
+					"scope":                               aws.StringValue(config.Scope),
+					"session_cookie_name":                 aws.StringValue(config.SessionCookieName),
+					"session_timeout":                     int(aws.Int64Value(config.SessionTimeout)),
+					"user_pool_arn":                       aws.StringValue(config.UserPoolArn),
+					"user_pool_client_id":                 aws.StringValue(config.UserPoolClientId),
+					"user_pool_domain":                    aws.StringValue(config.UserPoolDomain),
+				},
+			}
+		}
+
+		if config := action.AuthenticateOidcConfig; config != nil {
+			clientSecret := d.Get(fmt.Sprintf("%s.%d.authenticate_oidc.0.client_secret", key, i)).(string)
+
+			m["authenticate_oidc"] = []interface{}{
+				map[string]interface{}{
+					"authentication_request_extra_params": pointersMapToStringList(config.AuthenticationRequestExtraParams),
+					"authorization_endpoint":              aws.StringValue(config.AuthorizationEndpoint),
+					"client_id":                           aws.StringValue(config.ClientId),
+					"client_secret":                       clientSecret,
+					"issuer":                              aws.StringValue(config.Issuer),
+					"on_unauthenticated_request":          aws.StringValue(config.OnUnauthenticatedRequest),
+					"scope":                               aws.StringValue(config.Scope),
+					"session_cookie_name":                 aws.StringValue(config.SessionCookieName),
+					"session_timeout":                     int(aws.Int64Value(config.SessionTimeout)),
+					"token_endpoint":                      aws.StringValue(config.TokenEndpoint),
+					"user_info_endpoint":                  aws.StringValue(config.UserInfoEndpoint),
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
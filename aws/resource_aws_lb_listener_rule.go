@@ -47,19 +47,7 @@ func resourceAwsLbbListenerRule() *schema.Resource {
 			"action": {
 				Type:     schema.TypeList,
 				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validateLbListenerActionType(),
-						},
-					},
-				},
+				Elem:     lbListenerActionResource(),
 			},
 			"condition": {
 				Type:     schema.TypeSet,
@@ -92,15 +80,11 @@ func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) e
 		ListenerArn: aws.String(listenerArn),
 	}
 
-	actions := d.Get("action").([]interface{})
-	params.Actions = make([]*elbv2.Action, len(actions))
-	for i, action := range actions {
-		actionMap := action.(map[string]interface{})
-		params.Actions[i] = &elbv2.Action{
-			TargetGroupArn: aws.String(actionMap["target_group_arn"].(string)),
-			Type:           aws.String(actionMap["type"].(string)),
-		}
+	actions, err := expandLbListenerActions(d.Get("action").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error creating LB Listener Rule: %s", err)
 	}
+	params.Actions = actions
 
 	conditions := d.Get("condition").(*schema.Set).List()
 	params.Conditions = make([]*elbv2.RuleCondition, len(conditions))
@@ -192,14 +176,9 @@ func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	actions := make([]interface{}, len(rule.Actions))
-	for i, action := range rule.Actions {
-		actionMap := make(map[string]interface{})
-		actionMap["target_group_arn"] = *action.TargetGroupArn
-		actionMap["type"] = *action.Type
-		actions[i] = actionMap
+	if err := d.Set("action", flattenLbListenerActions(d, "action", rule.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %s", err)
 	}
-	d.Set("action", actions)
 
 	conditions := make([]interface{}, len(rule.Conditions))
 	for i, condition := range rule.Conditions {
@@ -246,15 +225,11 @@ func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("action") {
-		actions := d.Get("action").([]interface{})
-		params.Actions = make([]*elbv2.Action, len(actions))
-		for i, action := range actions {
-			actionMap := action.(map[string]interface{})
-			params.Actions[i] = &elbv2.Action{
-				TargetGroupArn: aws.String(actionMap["target_group_arn"].(string)),
-				Type:           aws.String(actionMap["type"].(string)),
-			}
+		actions, err := expandLbListenerActions(d.Get("action").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error modifying LB Listener Rule: %s", err)
 		}
+		params.Actions = actions
 		requestUpdate = true
 		d.SetPartial("action")
 	}
@@ -265,6 +265,35 @@ func TestAccAWSLBListenerRule_priority(t *testing.T) {
 	})
 }
 
+func TestAccAWSLBListenerRule_oidc(t *testing.T) {
+	var conf elbv2.Rule
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_listener_rule.test",
+		Providers:     testAccProvidersWithTLS,
+		CheckDestroy:  testAccCheckAWSLBListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBListenerRuleConfig_oidc(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBListenerRuleExists("aws_lb_listener_rule.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.#", "2"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.type", "authenticate-oidc"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.order", "1"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.authenticate_oidc.0.client_id", "s6BhdRkqt3"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.authenticate_oidc.0.client_secret", "7Fjfp0ZBr1KtDRbnfVdmIw"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.authenticate_oidc.0.on_unauthenticated_request", "allow"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.0.authenticate_oidc.0.session_timeout", "3600"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.1.type", "forward"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "action.1.order", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSLbListenerRuleRecreated(t *testing.T,
 	before, after *elbv2.Rule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1149,3 +1178,50 @@ resource "aws_lb_listener_rule" "50000_in_use" {
 }
 `)
 }
+
+func testAccAWSLBListenerRuleConfig_oidc(rName string) string {
+	return testAccAWSLBListenerConfig_httpsBase(rName) + `
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.id}"
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2015-05"
+  certificate_arn   = "${aws_iam_server_certificate.test.arn}"
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.id}"
+    type             = "forward"
+  }
+}
+
+resource "aws_lb_listener_rule" "test" {
+  listener_arn = "${aws_lb_listener.test.arn}"
+  priority     = 100
+
+  action {
+    type = "authenticate-oidc"
+
+    authenticate_oidc {
+      authorization_endpoint     = "https://example.com/authorization_endpoint"
+      client_id                  = "s6BhdRkqt3"
+      client_secret              = "7Fjfp0ZBr1KtDRbnfVdmIw"
+      issuer                     = "https://example.com"
+      on_unauthenticated_request = "allow"
+      session_timeout            = 3600
+      token_endpoint             = "https://example.com/token_endpoint"
+      user_info_endpoint         = "https://example.com/user_info_endpoint"
+    }
+  }
+
+  action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.test.id}"
+  }
+
+  condition {
+    field  = "path-pattern"
+    values = ["/static/*"]
+  }
+}
+`
+}
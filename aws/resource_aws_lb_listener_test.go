@@ -101,6 +101,100 @@ func TestAccAWSLBListener_https(t *testing.T) {
 	})
 }
 
+func TestAccAWSLBListener_cognito(t *testing.T) {
+	var conf elbv2.Listener
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_listener.test",
+		Providers:     testAccProvidersWithTLS,
+		CheckDestroy:  testAccCheckAWSLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBListenerConfig_cognito(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBListenerExists("aws_lb_listener.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.#", "2"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.type", "authenticate-cognito"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.order", "1"),
+					resource.TestCheckResourceAttrSet("aws_lb_listener.test", "default_action.0.authenticate_cognito.0.user_pool_arn"),
+					resource.TestCheckResourceAttrSet("aws_lb_listener.test", "default_action.0.authenticate_cognito.0.user_pool_client_id"),
+					resource.TestCheckResourceAttrSet("aws_lb_listener.test", "default_action.0.authenticate_cognito.0.user_pool_domain"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_cognito.0.authentication_request_extra_params.%", "1"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_cognito.0.authentication_request_extra_params.param", "test"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.1.type", "forward"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.1.order", "2"),
+					resource.TestCheckResourceAttrSet("aws_lb_listener.test", "default_action.1.target_group_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLBListener_oidc(t *testing.T) {
+	var conf elbv2.Listener
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_listener.test",
+		Providers:     testAccProvidersWithTLS,
+		CheckDestroy:  testAccCheckAWSLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBListenerConfig_oidc(rName, "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBListenerExists("aws_lb_listener.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.#", "2"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.type", "authenticate-oidc"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.authorization_endpoint", "https://example.com/authorization_endpoint"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.client_id", "s6BhdRkqt3"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.client_secret", "7Fjfp0ZBr1KtDRbnfVdmIw"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.issuer", "https://example.com"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.on_unauthenticated_request", "deny"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.token_endpoint", "https://example.com/token_endpoint"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.user_info_endpoint", "https://example.com/user_info_endpoint"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.1.type", "forward"),
+				),
+			},
+			{
+				Config: testAccAWSLBListenerConfig_oidc(rName, "authenticate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBListenerExists("aws_lb_listener.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.authenticate_oidc.0.on_unauthenticated_request", "authenticate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLBListener_gatewayLoadBalancer(t *testing.T) {
+	var conf elbv2.Listener
+	rName := fmt.Sprintf("testaccawslb-gwlb-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_listener.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBListenerConfig_gatewayLoadBalancer(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBListenerExists("aws_lb_listener.test", &conf),
+					resource.TestCheckResourceAttrPair("aws_lb_listener.test", "load_balancer_arn", "aws_lb.test", "arn"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "port", "0"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "protocol", ""),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.#", "1"),
+					resource.TestCheckResourceAttr("aws_lb_listener.test", "default_action.0.type", "forward"),
+					resource.TestCheckResourceAttrPair("aws_lb_listener.test", "default_action.0.target_group_arn", "aws_lb_target_group.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSLBListenerExists(n string, res *elbv2.Listener) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -490,3 +584,230 @@ resource "tls_self_signed_cert" "example" {
 }
 `, lbName, targetGroupName, rand.New(rand.NewSource(time.Now().UnixNano())).Int())
 }
+
+func testAccAWSLBListenerConfig_httpsBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-listener-auth"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_subnet" "test" {
+  count                   = 2
+  vpc_id                  = "${aws_vpc.test.id}"
+  cidr_block              = "${cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)}"
+  map_public_ip_on_launch = true
+  availability_zone       = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "tf-acc-lb-listener-auth-${count.index}"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "%[1]s"
+  vpc_id = "${aws_vpc.test.id}"
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_lb" "test" {
+  name            = "%[1]s"
+  internal        = false
+  security_groups = ["${aws_security_group.test.id}"]
+  subnets         = ["${aws_subnet.test.*.id}"]
+
+  depends_on = ["aws_internet_gateway.test"]
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = "%[1]s"
+  port     = 8080
+  protocol = "HTTP"
+  vpc_id   = "${aws_vpc.test.id}"
+}
+
+resource "aws_iam_server_certificate" "test" {
+  name             = "%[1]s"
+  certificate_body = "${tls_self_signed_cert.test.cert_pem}"
+  private_key      = "${tls_private_key.test.private_key_pem}"
+}
+
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "test" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name  = "example.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+`, rName)
+}
+
+func testAccAWSLBListenerConfig_cognito(rName string) string {
+	return testAccAWSLBListenerConfig_httpsBase(rName) + fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name                                 = "%[1]s"
+  user_pool_id                         = "${aws_cognito_user_pool.test.id}"
+  generate_secret                      = true
+  allowed_oauth_flows_user_pool_client = true
+  allowed_oauth_flows                  = ["code", "implicit"]
+  allowed_oauth_scopes                 = ["phone", "email", "openid", "profile", "aws.cognito.signin.user.admin"]
+  callback_urls                        = ["https://www.example.com/callback"]
+  supported_identity_providers         = ["COGNITO"]
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.test.id}"
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.id}"
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2015-05"
+  certificate_arn   = "${aws_iam_server_certificate.test.arn}"
+
+  default_action {
+    type = "authenticate-cognito"
+
+    authenticate_cognito {
+      user_pool_arn       = "${aws_cognito_user_pool.test.arn}"
+      user_pool_client_id = "${aws_cognito_user_pool_client.test.id}"
+      user_pool_domain    = "${aws_cognito_user_pool_domain.test.domain}"
+
+      authentication_request_extra_params {
+        param = "test"
+      }
+    }
+  }
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.id}"
+    type             = "forward"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLBListenerConfig_oidc(rName, onUnauthenticatedRequest string) string {
+	return testAccAWSLBListenerConfig_httpsBase(rName) + fmt.Sprintf(`
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.id}"
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2015-05"
+  certificate_arn   = "${aws_iam_server_certificate.test.arn}"
+
+  default_action {
+    type = "authenticate-oidc"
+
+    authenticate_oidc {
+      authorization_endpoint     = "https://example.com/authorization_endpoint"
+      client_id                  = "s6BhdRkqt3"
+      client_secret              = "7Fjfp0ZBr1KtDRbnfVdmIw"
+      issuer                     = "https://example.com"
+      on_unauthenticated_request = "%[1]s"
+      token_endpoint             = "https://example.com/token_endpoint"
+      user_info_endpoint         = "https://example.com/user_info_endpoint"
+    }
+  }
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.id}"
+    type             = "forward"
+  }
+}
+`, onUnauthenticatedRequest)
+}
+
+func testAccAWSLBListenerConfig_gatewayLoadBalancer(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-listener-gateway-load-balancer"
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.10.0.0/24"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-lb-listener-gateway-load-balancer"
+  }
+}
+
+resource "aws_lb" "test" {
+  name               = "%[1]s"
+  load_balancer_type = "gateway"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.id}"
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = "%[1]s"
+  port     = 6081
+  protocol = "GENEVE"
+  vpc_id   = "${aws_vpc.test.id}"
+
+  health_check {
+    port     = 80
+    protocol = "HTTP"
+  }
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.id}"
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.id}"
+    type             = "forward"
+  }
+}
+`, rName)
+}
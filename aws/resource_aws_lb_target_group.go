@@ -58,21 +58,21 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 
 			"port": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(1, 65535),
 			},
 
 			"protocol": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateLbListenerProtocol(),
+				ValidateFunc: validateLbTargetGroupProtocol(),
 			},
 
 			"vpc_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
@@ -86,8 +86,13 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 			"target_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "instance",
+				Default:  elbv2.TargetTypeEnumInstance,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					elbv2.TargetTypeEnumInstance,
+					elbv2.TargetTypeEnumIp,
+					elbv2.TargetTypeEnumLambda,
+				}, false),
 			},
 
 			"stickiness": {
@@ -205,18 +210,26 @@ func resourceAwsLbTargetGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	params := &elbv2.CreateTargetGroupInput{
 		Name:       aws.String(groupName),
-		Port:       aws.Int64(int64(d.Get("port").(int))),
-		Protocol:   aws.String(d.Get("protocol").(string)),
-		VpcId:      aws.String(d.Get("vpc_id").(string)),
 		TargetType: aws.String(d.Get("target_type").(string)),
 	}
 
+	// Lambda target groups have no port, protocol or VPC
+	if d.Get("target_type").(string) != elbv2.TargetTypeEnumLambda {
+		for _, k := range []string{"port", "protocol", "vpc_id"} {
+			if _, ok := d.GetOk(k); !ok {
+				return fmt.Errorf("%s should be set when target type is %s", k, d.Get("target_type").(string))
+			}
+		}
+
+		params.Port = aws.Int64(int64(d.Get("port").(int)))
+		params.Protocol = aws.String(d.Get("protocol").(string))
+		params.VpcId = aws.String(d.Get("vpc_id").(string))
+	}
+
 	if healthChecks := d.Get("health_check").([]interface{}); len(healthChecks) == 1 {
 		healthCheck := healthChecks[0].(map[string]interface{})
 
 		params.HealthCheckIntervalSeconds = aws.Int64(int64(healthCheck["interval"].(int)))
-		params.HealthCheckPort = aws.String(healthCheck["port"].(string))
-		params.HealthCheckProtocol = aws.String(healthCheck["protocol"].(string))
 		params.HealthyThresholdCount = aws.Int64(int64(healthCheck["healthy_threshold"].(int)))
 		params.UnhealthyThresholdCount = aws.Int64(int64(healthCheck["unhealthy_threshold"].(int)))
 		t := healthCheck["timeout"].(int)
@@ -224,7 +237,12 @@ func resourceAwsLbTargetGroupCreate(d *schema.ResourceData, meta interface{}) er
 			params.HealthCheckTimeoutSeconds = aws.Int64(int64(t))
 		}
 
-		if *params.HealthCheckProtocol != "TCP" {
+		if d.Get("target_type").(string) != elbv2.TargetTypeEnumLambda {
+			params.HealthCheckPort = aws.String(healthCheck["port"].(string))
+			params.HealthCheckProtocol = aws.String(healthCheck["protocol"].(string))
+		}
+
+		if aws.StringValue(params.HealthCheckProtocol) != "TCP" {
 			p := healthCheck["path"].(string)
 			if p != "" {
 				params.HealthCheckPath = aws.String(p)
@@ -294,12 +312,15 @@ func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) er
 
 			params = &elbv2.ModifyTargetGroupInput{
 				TargetGroupArn:          aws.String(d.Id()),
-				HealthCheckPort:         aws.String(healthCheck["port"].(string)),
-				HealthCheckProtocol:     aws.String(healthCheck["protocol"].(string)),
 				HealthyThresholdCount:   aws.Int64(int64(healthCheck["healthy_threshold"].(int))),
 				UnhealthyThresholdCount: aws.Int64(int64(healthCheck["unhealthy_threshold"].(int))),
 			}
 
+			if d.Get("target_type").(string) != elbv2.TargetTypeEnumLambda {
+				params.HealthCheckPort = aws.String(healthCheck["port"].(string))
+				params.HealthCheckProtocol = aws.String(healthCheck["protocol"].(string))
+			}
+
 			t := healthCheck["timeout"].(int)
 			if t != 0 {
 				params.HealthCheckTimeoutSeconds = aws.Int64(int64(t))
@@ -326,18 +347,19 @@ func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) er
 
 	var attrs []*elbv2.TargetGroupAttribute
 
-	if d.HasChange("deregistration_delay") {
+	// Lambda target groups do not support connection draining
+	if d.HasChange("deregistration_delay") && d.Get("target_type").(string) != elbv2.TargetTypeEnumLambda {
 		attrs = append(attrs, &elbv2.TargetGroupAttribute{
 			Key:   aws.String("deregistration_delay.timeout_seconds"),
 			Value: aws.String(fmt.Sprintf("%d", d.Get("deregistration_delay").(int))),
 		})
 	}
 
-	// In CustomizeDiff we allow LB stickiness to be declared for TCP target
-	// groups, so long as it's not enabled. This allows for better support for
-	// modules, but also means we need to completely skip sending the data to the
-	// API if it's defined on a TCP target group.
-	if d.HasChange("stickiness") && d.Get("protocol") != "TCP" {
+	// In CustomizeDiff we allow LB stickiness to be declared for TCP and GENEVE
+	// target groups, so long as it's not enabled. This allows for better support
+	// for modules, but also means we need to completely skip sending the data to
+	// the API if it's defined on a TCP or GENEVE target group.
+	if d.HasChange("stickiness") && lbTargetGroupSupportsStickiness(d.Get("protocol").(string)) && d.Get("target_type").(string) != elbv2.TargetTypeEnumLambda {
 		stickinessBlocks := d.Get("stickiness").([]interface{})
 		if len(stickinessBlocks) == 1 {
 			stickiness := stickinessBlocks[0].(map[string]interface{})
@@ -396,6 +418,26 @@ func isTargetGroupNotFound(err error) bool {
 	return ok && elberr.Code() == "TargetGroupNotFound"
 }
 
+func validateLbTargetGroupProtocol() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"http",
+		"https",
+		"tcp",
+		"geneve",
+	}, true)
+}
+
+// lbTargetGroupSupportsStickiness reports whether target groups using the
+// given protocol accept the stickiness attributes. Network (TCP) and Gateway
+// (GENEVE) Load Balancer target groups do not.
+func lbTargetGroupSupportsStickiness(protocol string) bool {
+	switch strings.ToUpper(protocol) {
+	case elbv2.ProtocolEnumTcp, elbv2.ProtocolEnumGeneve:
+		return false
+	}
+	return true
+}
+
 func validateAwsLbTargetGroupHealthCheckPath(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 1024 {
@@ -455,12 +497,23 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	d.Set("target_type", targetGroup.TargetType)
 
 	healthCheck := make(map[string]interface{})
-	healthCheck["interval"] = *targetGroup.HealthCheckIntervalSeconds
-	healthCheck["port"] = *targetGroup.HealthCheckPort
-	healthCheck["protocol"] = *targetGroup.HealthCheckProtocol
-	healthCheck["timeout"] = *targetGroup.HealthCheckTimeoutSeconds
-	healthCheck["healthy_threshold"] = *targetGroup.HealthyThresholdCount
-	healthCheck["unhealthy_threshold"] = *targetGroup.UnhealthyThresholdCount
+	healthCheck["interval"] = aws.Int64Value(targetGroup.HealthCheckIntervalSeconds)
+	healthCheck["timeout"] = aws.Int64Value(targetGroup.HealthCheckTimeoutSeconds)
+	healthCheck["healthy_threshold"] = aws.Int64Value(targetGroup.HealthyThresholdCount)
+	healthCheck["unhealthy_threshold"] = aws.Int64Value(targetGroup.UnhealthyThresholdCount)
+
+	// Lambda target groups do not return a health check port or protocol, so
+	// keep the configured values to avoid a perpetual diff against the defaults
+	if targetGroup.HealthCheckPort != nil {
+		healthCheck["port"] = *targetGroup.HealthCheckPort
+	} else {
+		healthCheck["port"] = d.Get("health_check.0.port").(string)
+	}
+	if targetGroup.HealthCheckProtocol != nil {
+		healthCheck["protocol"] = *targetGroup.HealthCheckProtocol
+	} else {
+		healthCheck["protocol"] = d.Get("health_check.0.protocol").(string)
+	}
 
 	if targetGroup.HealthCheckPath != nil {
 		healthCheck["path"] = *targetGroup.HealthCheckPath
@@ -481,19 +534,23 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	}
 
 	// We only read in the stickiness attributes if the target group is not
-	// TCP-based. This ensures we don't end up causing a spurious diff if someone
-	// has defined the stickiness block on a TCP target group (albeit with
-	// false), for which this update would clobber the state coming from config
-	// for.
+	// TCP or GENEVE based. This ensures we don't end up causing a spurious diff
+	// if someone has defined the stickiness block on such a target group (albeit
+	// with false), for which this update would clobber the state coming from
+	// config for.
 	//
 	// This is a workaround to support module design where the module needs to
 	// support HTTP and TCP target groups.
 	switch {
-	case *targetGroup.Protocol != "TCP":
+	case aws.StringValue(targetGroup.TargetType) == elbv2.TargetTypeEnumLambda:
+		if err = d.Set("stickiness", []interface{}{}); err != nil {
+			return err
+		}
+	case lbTargetGroupSupportsStickiness(aws.StringValue(targetGroup.Protocol)):
 		if err = flattenAwsLbTargetGroupStickiness(d, attrResp.Attributes); err != nil {
 			return err
 		}
-	case *targetGroup.Protocol == "TCP" && len(d.Get("stickiness").([]interface{})) < 1:
+	case len(d.Get("stickiness").([]interface{})) < 1:
 		if err = d.Set("stickiness", []interface{}{}); err != nil {
 			return err
 		}
@@ -555,11 +612,23 @@ func flattenAwsLbTargetGroupStickiness(d *schema.ResourceData, attributes []*elb
 
 func resourceAwsLbTargetGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
 	protocol := diff.Get("protocol").(string)
-	if protocol == "TCP" {
-		// TCP load balancers do not support stickiness
+
+	if diff.Get("target_type").(string) == elbv2.TargetTypeEnumLambda {
+		for _, k := range []string{"port", "protocol", "vpc_id"} {
+			if _, ok := diff.GetOk(k); ok {
+				return fmt.Errorf("%s: %q is not supported for target_groups with the lambda target type", diff.Id(), k)
+			}
+		}
+		return nil
+	}
+	if !lbTargetGroupSupportsStickiness(protocol) {
+		// TCP and GENEVE load balancers do not support stickiness
 		if stickinessBlocks := diff.Get("stickiness").([]interface{}); len(stickinessBlocks) == 1 {
 			stickiness := stickinessBlocks[0].(map[string]interface{})
 			if val := stickiness["enabled"].(bool); val {
+				if strings.EqualFold(protocol, elbv2.ProtocolEnumGeneve) {
+					return fmt.Errorf("Gateway Load Balancers do not support Stickiness")
+				}
 				return fmt.Errorf("Network Load Balancers do not support Stickiness")
 			}
 		}
@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -54,6 +56,9 @@ func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if v, ok := d.GetOk("port"); ok {
+		if isLbTargetLambdaArn(d.Get("target_id").(string)) {
+			return fmt.Errorf("port cannot be specified when registering a Lambda function with a target group")
+		}
 		target.Port = aws.Int64(int64(v.(int)))
 	}
 
@@ -69,8 +74,25 @@ func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Registering Target %s with Target Group %s", d.Get("target_id").(string),
 		d.Get("target_group_arn").(string))
 
-	_, err := elbconn.RegisterTargets(params)
+	// Registering a Lambda function requires that Elastic Load Balancing has
+	// been granted permission to invoke it, which may still be propagating
+	// when the permission was created in the same apply
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := elbconn.RegisterTargets(params)
+		if err != nil {
+			if isAWSErr(err, "AccessDenied", "") && isLbTargetLambdaArn(d.Get("target_id").(string)) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
+		if isAWSErr(err, "AccessDenied", "") && isLbTargetLambdaArn(d.Get("target_id").(string)) {
+			return fmt.Errorf("Error registering Lambda function %s with target group: %s. "+
+				"Ensure an aws_lambda_permission grants elasticloadbalancing.amazonaws.com "+
+				"permission to invoke the function", d.Get("target_id").(string), err)
+		}
 		return errwrap.Wrapf("Error registering targets with target group: {{err}}", err)
 	}
 
@@ -157,3 +179,8 @@ func isInvalidTarget(err error) bool {
 	elberr, ok := err.(awserr.Error)
 	return ok && elberr.Code() == "InvalidTarget"
 }
+
+// isLbTargetLambdaArn returns true if the target ID refers to a Lambda function
+func isLbTargetLambdaArn(targetId string) bool {
+	return strings.HasPrefix(targetId, "arn:") && strings.Contains(targetId, ":lambda:")
+}
@@ -305,6 +305,40 @@ func TestAccAWSLB_networkLoadbalancer_updateCrossZone(t *testing.T) {
 	})
 }
 
+func TestAccAWSLB_gatewayLoadBalancer(t *testing.T) {
+	var pre, post elbv2.LoadBalancer
+	lbName := fmt.Sprintf("testaccawslb-gwlb-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb.lb_test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBConfig_gatewayLoadBalancer(lbName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBExists("aws_lb.lb_test", &pre),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "name", lbName),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "load_balancer_type", "gateway"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "enable_cross_zone_load_balancing", "false"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "subnet_mapping.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_lb.lb_test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSLBConfig_gatewayLoadBalancer(lbName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBExists("aws_lb.lb_test", &post),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "load_balancing.cross_zone.enabled", "true"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "enable_cross_zone_load_balancing", "true"),
+					testAccCheckAWSlbARNs(&pre, &post),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLB_applicationLoadBalancer_updateHttp2(t *testing.T) {
 	var pre, mid, post elbv2.LoadBalancer
 	lbName := fmt.Sprintf("testaccawsalb-http2-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -345,6 +379,45 @@ func TestAccAWSLB_applicationLoadBalancer_updateHttp2(t *testing.T) {
 	})
 }
 
+func TestAccAWSLB_applicationLoadBalancer_updateHttpAttributes(t *testing.T) {
+	var pre, post elbv2.LoadBalancer
+	lbName := fmt.Sprintf("testaccawsalb-attrs-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb.lb_test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBConfig_httpAttributes(lbName, false, false, "defensive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBExists("aws_lb.lb_test", &pre),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "routing.http.drop_invalid_header_fields.enabled", "false"),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "waf.fail_open.enabled", "false"),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "routing.http.desync_mitigation_mode", "defensive"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "drop_invalid_header_fields", "false"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "enable_waf_fail_open", "false"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "desync_mitigation_mode", "defensive"),
+				),
+			},
+			{
+				Config: testAccAWSLBConfig_httpAttributes(lbName, true, true, "strictest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBExists("aws_lb.lb_test", &post),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "routing.http.drop_invalid_header_fields.enabled", "true"),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "waf.fail_open.enabled", "true"),
+					testAccCheckAWSLBAttribute("aws_lb.lb_test", "routing.http.desync_mitigation_mode", "strictest"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "drop_invalid_header_fields", "true"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "enable_waf_fail_open", "true"),
+					resource.TestCheckResourceAttr("aws_lb.lb_test", "desync_mitigation_mode", "strictest"),
+					testAccCheckAWSlbARNs(&pre, &post),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLB_applicationLoadBalancer_updateDeletionProtection(t *testing.T) {
 	var pre, mid, post elbv2.LoadBalancer
 	lbName := fmt.Sprintf("testaccawsalb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -1075,6 +1148,77 @@ resource "aws_security_group" "alb_test" {
 }`, lbName, http2)
 }
 
+func testAccAWSLBConfig_httpAttributes(lbName string, dropInvalidHeaderFields, wafFailOpen bool, desyncMitigationMode string) string {
+	return fmt.Sprintf(`resource "aws_lb" "lb_test" {
+  name            = "%s"
+  internal        = true
+  security_groups = ["${aws_security_group.alb_test.id}"]
+  subnets         = ["${aws_subnet.alb_test.*.id}"]
+  
+  idle_timeout = 30
+  enable_deletion_protection = false
+
+  drop_invalid_header_fields = %t
+  enable_waf_fail_open       = %t
+  desync_mitigation_mode     = "%s"
+
+  tags {
+    Name = "TestAccAWSALB_basic"
+  }
+}
+
+variable "subnets" {
+  default = ["10.0.1.0/24", "10.0.2.0/24"]
+  type    = "list"
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "alb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-basic"
+  }
+}
+
+resource "aws_subnet" "alb_test" {
+  count                   = 2
+  vpc_id                  = "${aws_vpc.alb_test.id}"
+  cidr_block              = "${element(var.subnets, count.index)}"
+  map_public_ip_on_launch = true
+  availability_zone       = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "tf-acc-lb-basic-${count.index}"
+  }
+}
+
+resource "aws_security_group" "alb_test" {
+  name        = "allow_all_alb_test"
+  description = "Used for ALB Testing"
+  vpc_id      = "${aws_vpc.alb_test.id}"
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags {
+    Name = "TestAccAWSALB_basic"
+  }
+}`, lbName, dropInvalidHeaderFields, wafFailOpen, desyncMitigationMode)
+}
+
 func testAccAWSLBConfig_enableDeletionProtection(lbName string, deletion_protection bool) string {
 	return fmt.Sprintf(`resource "aws_lb" "lb_test" {
   name            = "%s"
@@ -1243,6 +1387,38 @@ resource "aws_subnet" "alb_test" {
 `, lbName, cz)
 }
 
+func testAccAWSLBConfig_gatewayLoadBalancer(lbName string, cz bool) string {
+	return fmt.Sprintf(`resource "aws_lb" "lb_test" {
+  name               = "%s"
+  load_balancer_type = "gateway"
+
+  enable_cross_zone_load_balancing = %t
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.alb_test.id}"
+  }
+}
+
+resource "aws_vpc" "alb_test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-gateway-load-balancer"
+  }
+}
+
+resource "aws_subnet" "alb_test" {
+  vpc_id            = "${aws_vpc.alb_test.id}"
+  cidr_block        = "10.10.0.0/21"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-gateway-load-balancer"
+  }
+}
+`, lbName, cz)
+}
+
 func testAccAWSLBConfig_networkLoadBalancerEIP(lbName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMacie2Account() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2AccountCreate,
+		Read:   resourceAwsMacie2AccountRead,
+		Update: resourceAwsMacie2AccountUpdate,
+		Delete: resourceAwsMacie2AccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finding_publishing_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.FindingPublishingFrequency_Values(), false),
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2AccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.EnableMacieInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("finding_publishing_frequency"); ok {
+		input.FindingPublishingFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Enabling Macie for account: %s", input)
+	err := resource.Retry(4*time.Minute, func() *resource.RetryError {
+		_, err := conn.EnableMacie(input)
+
+		// The Macie service-linked role can take a moment to become usable.
+		if isAWSErr(err, macie2.ErrCodeAccessDeniedException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling Macie for account: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsMacie2AccountRead(d, meta)
+}
+
+func resourceAwsMacie2AccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	output, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		log.Printf("[WARN] Macie not enabled, removing from state")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Macie Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("finding_publishing_frequency", output.FindingPublishingFrequency)
+	d.Set("service_role", output.ServiceRole)
+	d.Set("status", output.Status)
+
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	}
+
+	if output.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2AccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.UpdateMacieSessionInput{}
+
+	if d.HasChange("finding_publishing_frequency") {
+		input.FindingPublishingFrequency = aws.String(d.Get("finding_publishing_frequency").(string))
+	}
+
+	if d.HasChange("status") {
+		input.Status = aws.String(d.Get("status").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Macie Account: %s", input)
+	_, err := conn.UpdateMacieSession(input)
+	if err != nil {
+		return fmt.Errorf("error updating Macie Account (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsMacie2AccountRead(d, meta)
+}
+
+func resourceAwsMacie2AccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disabling Macie for account: %s", d.Id())
+	_, err := conn.DisableMacie(&macie2.DisableMacieInput{})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disabling Macie for account (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
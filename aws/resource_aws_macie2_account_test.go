@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSMacie2Account_basic(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigStatus(macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2Account_findingPublishingFrequency(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencyOneHour),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyOneHour),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencySixHours),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencySixHours),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2AccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+		return err
+	}
+}
+
+func testAccCheckAwsMacie2AccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_account" {
+			continue
+		}
+
+		_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+		if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Account (%s) still enabled", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2AccountConfig() string {
+	return `
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccAwsMacie2AccountConfigStatus(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccAwsMacie2AccountConfigFindingPublishingFrequency(frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+}
+`, frequency)
+}
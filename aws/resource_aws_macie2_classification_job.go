@@ -0,0 +1,602 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMacie2ClassificationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2ClassificationJobCreate,
+		Read:   resourceAwsMacie2ClassificationJobRead,
+		Update: resourceAwsMacie2ClassificationJobUpdate,
+		Delete: resourceAwsMacie2ClassificationJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_data_identifier_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"initial_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					macie2.JobStatusCancelled,
+					macie2.JobStatusRunning,
+					macie2.JobStatusUserPaused,
+				}, false),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Jobs report IDLE between scheduled runs and COMPLETE
+					// once a one-time run finishes; both follow RUNNING.
+					return new == macie2.JobStatusRunning &&
+						(old == macie2.JobStatusIdle || old == macie2.JobStatusComplete)
+				},
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.JobType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"s3_job_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excludes": macie2CriteriaBlockForJobSchema(),
+									"includes": macie2CriteriaBlockForJobSchema(),
+								},
+							},
+						},
+						"bucket_definitions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"buckets": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sampling_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"schedule_frequency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_schedule": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"monthly_schedule": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 31),
+						},
+						"weekly_schedule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(macie2.DayOfWeek_Values(), false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func macie2CriteriaBlockForJobSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"and": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"simple_criterion": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.SimpleCriterionKeyForJob_Values(), false),
+										},
+										"values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"tag_criterion": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"tag_values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"key": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2ClassificationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.CreateClassificationJobInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		JobType:         aws.String(d.Get("job_type").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+		S3JobDefinition: expandMacie2S3JobDefinition(d.Get("s3_job_definition").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
+		input.CustomDataIdentifierIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("initial_run"); ok {
+		input.InitialRun = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("sampling_percentage"); ok {
+		input.SamplingPercentage = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule_frequency"); ok {
+		input.ScheduleFrequency = expandMacie2JobScheduleFrequency(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Macie Classification Job: %s", input)
+	output, err := conn.CreateClassificationJob(input)
+	if err != nil {
+		return fmt.Errorf("error creating Macie Classification Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if v, ok := d.GetOk("job_status"); ok && v.(string) == macie2.JobStatusUserPaused {
+		if err := macie2UpdateClassificationJobStatus(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+		JobId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		log.Printf("[WARN] Macie Classification Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("initial_run", output.InitialRun)
+	d.Set("job_arn", output.JobArn)
+	d.Set("job_id", output.JobId)
+	d.Set("job_status", output.JobStatus)
+	d.Set("job_type", output.JobType)
+	d.Set("name", output.Name)
+	d.Set("sampling_percentage", output.SamplingPercentage)
+
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	}
+
+	if err := d.Set("custom_data_identifier_ids", schema.NewSet(schema.HashString, flattenStringList(output.CustomDataIdentifierIds))); err != nil {
+		return fmt.Errorf("error setting custom_data_identifier_ids: %s", err)
+	}
+
+	if err := d.Set("s3_job_definition", flattenMacie2S3JobDefinition(output.S3JobDefinition)); err != nil {
+		return fmt.Errorf("error setting s3_job_definition: %s", err)
+	}
+
+	if err := d.Set("schedule_frequency", flattenMacie2JobScheduleFrequency(output.ScheduleFrequency)); err != nil {
+		return fmt.Errorf("error setting schedule_frequency: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2ClassificationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("job_status") {
+		if err := macie2UpdateClassificationJobStatus(conn, d.Id(), d.Get("job_status").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsMacie2(conn, d, d.Get("job_arn").(string)); err != nil {
+		return fmt.Errorf("error updating Macie Classification Job (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	// Classification jobs cannot be deleted, only cancelled.
+	output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+		JobId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): %s", d.Id(), err)
+	}
+
+	switch aws.StringValue(output.JobStatus) {
+	case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+		return nil
+	}
+
+	return macie2UpdateClassificationJobStatus(conn, d.Id(), macie2.JobStatusCancelled)
+}
+
+func macie2UpdateClassificationJobStatus(conn *macie2.Macie2, jobID, status string) error {
+	input := &macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(jobID),
+		JobStatus: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Classification Job: %s", input)
+	_, err := conn.UpdateClassificationJob(input)
+	if err != nil {
+		return fmt.Errorf("error updating Macie Classification Job (%s) status to %s: %s", jobID, status, err)
+	}
+
+	return nil
+}
+
+func expandMacie2S3JobDefinition(l []interface{}) *macie2.S3JobDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	definition := &macie2.S3JobDefinition{}
+
+	if v, ok := m["bucket_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		criteria := v[0].(map[string]interface{})
+		definition.BucketCriteria = &macie2.S3BucketCriteriaForJob{
+			Excludes: expandMacie2CriteriaBlockForJob(criteria["excludes"].([]interface{})),
+			Includes: expandMacie2CriteriaBlockForJob(criteria["includes"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["bucket_definitions"].([]interface{}); ok && len(v) > 0 {
+		for _, raw := range v {
+			bucketDefinition := raw.(map[string]interface{})
+			definition.BucketDefinitions = append(definition.BucketDefinitions, &macie2.S3BucketDefinitionForJob{
+				AccountId: aws.String(bucketDefinition["account_id"].(string)),
+				Buckets:   expandStringList(bucketDefinition["buckets"].([]interface{})),
+			})
+		}
+	}
+
+	return definition
+}
+
+func expandMacie2CriteriaBlockForJob(l []interface{}) *macie2.CriteriaBlockForJob {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	block := &macie2.CriteriaBlockForJob{}
+
+	for _, raw := range m["and"].([]interface{}) {
+		criteria := &macie2.CriteriaForJob{}
+		c := raw.(map[string]interface{})
+
+		if v, ok := c["simple_criterion"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			simple := v[0].(map[string]interface{})
+			criteria.SimpleCriterion = &macie2.SimpleCriterionForJob{
+				Comparator: aws.String(simple["comparator"].(string)),
+				Key:        aws.String(simple["key"].(string)),
+				Values:     expandStringList(simple["values"].([]interface{})),
+			}
+		}
+
+		if v, ok := c["tag_criterion"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tag := v[0].(map[string]interface{})
+			criteria.TagCriterion = &macie2.TagCriterionForJob{
+				Comparator: aws.String(tag["comparator"].(string)),
+			}
+
+			for _, rawPair := range tag["tag_values"].([]interface{}) {
+				pair := rawPair.(map[string]interface{})
+				criteria.TagCriterion.TagValues = append(criteria.TagCriterion.TagValues, &macie2.TagCriterionPairForJob{
+					Key:   aws.String(pair["key"].(string)),
+					Value: aws.String(pair["value"].(string)),
+				})
+			}
+		}
+
+		block.And = append(block.And, criteria)
+	}
+
+	return block
+}
+
+func expandMacie2JobScheduleFrequency(l []interface{}) *macie2.JobScheduleFrequency {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	frequency := &macie2.JobScheduleFrequency{}
+
+	if v, ok := m["daily_schedule"].(bool); ok && v {
+		frequency.DailySchedule = &macie2.DailySchedule{}
+	}
+
+	if v, ok := m["monthly_schedule"].(int); ok && v > 0 {
+		frequency.MonthlySchedule = &macie2.MonthlySchedule{
+			DayOfMonth: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := m["weekly_schedule"].(string); ok && v != "" {
+		frequency.WeeklySchedule = &macie2.WeeklySchedule{
+			DayOfWeek: aws.String(v),
+		}
+	}
+
+	return frequency
+}
+
+func flattenMacie2S3JobDefinition(definition *macie2.S3JobDefinition) []interface{} {
+	if definition == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket_criteria":    []interface{}{},
+		"bucket_definitions": []interface{}{},
+	}
+
+	if criteria := definition.BucketCriteria; criteria != nil {
+		m["bucket_criteria"] = []interface{}{
+			map[string]interface{}{
+				"excludes": flattenMacie2CriteriaBlockForJob(criteria.Excludes),
+				"includes": flattenMacie2CriteriaBlockForJob(criteria.Includes),
+			},
+		}
+	}
+
+	bucketDefinitions := make([]interface{}, 0, len(definition.BucketDefinitions))
+	for _, bucketDefinition := range definition.BucketDefinitions {
+		bucketDefinitions = append(bucketDefinitions, map[string]interface{}{
+			"account_id": aws.StringValue(bucketDefinition.AccountId),
+			"buckets":    flattenStringList(bucketDefinition.Buckets),
+		})
+	}
+	m["bucket_definitions"] = bucketDefinitions
+
+	return []interface{}{m}
+}
+
+func flattenMacie2CriteriaBlockForJob(block *macie2.CriteriaBlockForJob) []interface{} {
+	if block == nil {
+		return []interface{}{}
+	}
+
+	and := make([]interface{}, 0, len(block.And))
+	for _, criteria := range block.And {
+		c := map[string]interface{}{
+			"simple_criterion": []interface{}{},
+			"tag_criterion":    []interface{}{},
+		}
+
+		if simple := criteria.SimpleCriterion; simple != nil {
+			c["simple_criterion"] = []interface{}{
+				map[string]interface{}{
+					"comparator": aws.StringValue(simple.Comparator),
+					"key":        aws.StringValue(simple.Key),
+					"values":     flattenStringList(simple.Values),
+				},
+			}
+		}
+
+		if tag := criteria.TagCriterion; tag != nil {
+			tagValues := make([]interface{}, 0, len(tag.TagValues))
+			for _, pair := range tag.TagValues {
+				tagValues = append(tagValues, map[string]interface{}{
+					"key":   aws.StringValue(pair.Key),
+					"value": aws.StringValue(pair.Value),
+				})
+			}
+
+			c["tag_criterion"] = []interface{}{
+				map[string]interface{}{
+					"comparator": aws.StringValue(tag.Comparator),
+					"tag_values": tagValues,
+				},
+			}
+		}
+
+		and = append(and, c)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"and": and,
+		},
+	}
+}
+
+func flattenMacie2JobScheduleFrequency(frequency *macie2.JobScheduleFrequency) []interface{} {
+	if frequency == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"daily_schedule":   frequency.DailySchedule != nil,
+		"monthly_schedule": 0,
+		"weekly_schedule":  "",
+	}
+
+	if frequency.MonthlySchedule != nil {
+		m["monthly_schedule"] = int(aws.Int64Value(frequency.MonthlySchedule.DayOfMonth))
+	}
+
+	if frequency.WeeklySchedule != nil {
+		m["weekly_schedule"] = aws.StringValue(frequency.WeeklySchedule.DayOfWeek)
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,452 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAWSMacie2ClassificationJob_expandS3JobDefinition(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"bucket_criteria": []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_criterion": []interface{}{},
+									"tag_criterion": []interface{}{
+										map[string]interface{}{
+											"comparator": "EQ",
+											"tag_values": []interface{}{
+												map[string]interface{}{
+													"key":   "Environment",
+													"value": "Test",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"includes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_criterion": []interface{}{
+										map[string]interface{}{
+											"comparator": "STARTS_WITH",
+											"key":        "S3_BUCKET_NAME",
+											"values":     []interface{}{"data-"},
+										},
+									},
+									"tag_criterion": []interface{}{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"bucket_definitions": []interface{}{
+				map[string]interface{}{
+					"account_id": "123456789012",
+					"buckets":    []interface{}{"bucket1", "bucket2"},
+				},
+			},
+		},
+	}
+
+	expected := &macie2.S3JobDefinition{
+		BucketCriteria: &macie2.S3BucketCriteriaForJob{
+			Excludes: &macie2.CriteriaBlockForJob{
+				And: []*macie2.CriteriaForJob{
+					{
+						TagCriterion: &macie2.TagCriterionForJob{
+							Comparator: aws.String("EQ"),
+							TagValues: []*macie2.TagCriterionPairForJob{
+								{
+									Key:   aws.String("Environment"),
+									Value: aws.String("Test"),
+								},
+							},
+						},
+					},
+				},
+			},
+			Includes: &macie2.CriteriaBlockForJob{
+				And: []*macie2.CriteriaForJob{
+					{
+						SimpleCriterion: &macie2.SimpleCriterionForJob{
+							Comparator: aws.String("STARTS_WITH"),
+							Key:        aws.String("S3_BUCKET_NAME"),
+							Values:     []*string{aws.String("data-")},
+						},
+					},
+				},
+			},
+		},
+		BucketDefinitions: []*macie2.S3BucketDefinitionForJob{
+			{
+				AccountId: aws.String("123456789012"),
+				Buckets:   []*string{aws.String("bucket1"), aws.String("bucket2")},
+			},
+		},
+	}
+
+	output := expandMacie2S3JobDefinition(input)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+
+	if flattened := flattenMacie2S3JobDefinition(output); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", flattened, input)
+	}
+}
+
+func TestAWSMacie2ClassificationJob_expandJobScheduleFrequency(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected *macie2.JobScheduleFrequency
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"daily_schedule":   true,
+					"monthly_schedule": 0,
+					"weekly_schedule":  "",
+				},
+			},
+			Expected: &macie2.JobScheduleFrequency{
+				DailySchedule: &macie2.DailySchedule{},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"daily_schedule":   false,
+					"monthly_schedule": 0,
+					"weekly_schedule":  "MONDAY",
+				},
+			},
+			Expected: &macie2.JobScheduleFrequency{
+				WeeklySchedule: &macie2.WeeklySchedule{
+					DayOfWeek: aws.String("MONDAY"),
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"daily_schedule":   false,
+					"monthly_schedule": 15,
+					"weekly_schedule":  "",
+				},
+			},
+			Expected: &macie2.JobScheduleFrequency{
+				MonthlySchedule: &macie2.MonthlySchedule{
+					DayOfMonth: aws.Int64(15),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandMacie2JobScheduleFrequency(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Expected)
+		}
+
+		if flattened := flattenMacie2JobScheduleFrequency(output); !reflect.DeepEqual(flattened, tc.Input) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", flattened, tc.Input)
+		}
+	}
+}
+
+func testAccAWSMacie2ClassificationJob_basic(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigOneTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestMatchResourceAttr(resourceName, "job_arn", regexp.MustCompile(`:classification-job/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeOneTime),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_percentage", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2ClassificationJob_scheduled(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "initial_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_criteria.0.includes.0.and.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_criteria.0.includes.0.and.0.simple_criterion.0.key", macie2.SimpleCriterionKeyForJobS3BucketName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_criteria.0.excludes.0.and.0.tag_criterion.0.tag_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", "MONDAY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, macie2.JobStatusUserPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusUserPaused),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2ClassificationJob_tags(t *testing.T) {
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2ClassificationJobExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		switch status := aws.StringValue(output.JobStatus); status {
+		case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+		default:
+			return fmt.Errorf("Macie Classification Job (%s) still active: %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2ClassificationJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsMacie2ClassificationJobConfigOneTime(rName string) string {
+	return testAccAwsMacie2ClassificationJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  job_type            = "ONE_TIME"
+  name                = %[1]q
+  sampling_percentage = 50
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = "${data.aws_caller_identity.current.account_id}"
+      buckets    = ["${aws_s3_bucket.test.bucket}"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAwsMacie2ClassificationJobConfigScheduled(rName, jobStatus string) string {
+	return testAccAwsMacie2ClassificationJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  initial_run = true
+  job_status  = %[2]q
+  job_type    = "SCHEDULED"
+  name        = %[1]q
+
+  schedule_frequency {
+    weekly_schedule = "MONDAY"
+  }
+
+  s3_job_definition {
+    bucket_criteria {
+      includes {
+        and {
+          simple_criterion {
+            comparator = "EQ"
+            key        = "S3_BUCKET_NAME"
+            values     = ["${aws_s3_bucket.test.bucket}"]
+          }
+        }
+      }
+
+      excludes {
+        and {
+          tag_criterion {
+            comparator = "EQ"
+
+            tag_values {
+              key   = "Environment"
+              value = "Test"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, jobStatus)
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsMacie2ClassificationJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  job_type = "SCHEDULED"
+  name     = %[1]q
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = "${data.aws_caller_identity.current.account_id}"
+      buckets    = ["${aws_s3_bucket.test.bucket}"]
+    }
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsMacie2ClassificationJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  job_type = "SCHEDULED"
+  name     = %[1]q
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = "${data.aws_caller_identity.current.account_id}"
+      buckets    = ["${aws_s3_bucket.test.bucket}"]
+    }
+  }
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
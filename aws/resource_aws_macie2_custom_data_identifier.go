@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMacie2CustomDataIdentifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2CustomDataIdentifierCreate,
+		Read:   resourceAwsMacie2CustomDataIdentifierRead,
+		Update: resourceAwsMacie2CustomDataIdentifierUpdate,
+		Delete: resourceAwsMacie2CustomDataIdentifierDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"ignore_words": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(4, 90),
+				},
+			},
+			"keywords": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 90),
+				},
+			},
+			"maximum_match_distance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"regex": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMacie2CustomDataIdentifierCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.CreateCustomDataIdentifierInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(d.Get("name").(string)),
+		Regex:       aws.String(d.Get("regex").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ignore_words"); ok {
+		input.IgnoreWords = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("keywords"); ok {
+		input.Keywords = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("maximum_match_distance"); ok {
+		input.MaximumMatchDistance = aws.Int64(int64(v.(int)))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Macie Custom Data Identifier: %s", input)
+	output, err := conn.CreateCustomDataIdentifier(input)
+	if err != nil {
+		return fmt.Errorf("error creating Macie Custom Data Identifier: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomDataIdentifierId))
+
+	return resourceAwsMacie2CustomDataIdentifierRead(d, meta)
+}
+
+func resourceAwsMacie2CustomDataIdentifierRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(d.Id()),
+	})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		log.Printf("[WARN] Macie Custom Data Identifier (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Macie Custom Data Identifier (%s): %s", d.Id(), err)
+	}
+
+	// Deleted identifiers remain readable for a while with this flag set.
+	if aws.BoolValue(output.Deleted) {
+		log.Printf("[WARN] Macie Custom Data Identifier (%s) is deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("maximum_match_distance", output.MaximumMatchDistance)
+	d.Set("name", output.Name)
+	d.Set("regex", output.Regex)
+
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	}
+
+	if err := d.Set("ignore_words", schema.NewSet(schema.HashString, flattenStringList(output.IgnoreWords))); err != nil {
+		return fmt.Errorf("error setting ignore_words: %s", err)
+	}
+
+	if err := d.Set("keywords", schema.NewSet(schema.HashString, flattenStringList(output.Keywords))); err != nil {
+		return fmt.Errorf("error setting keywords: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2CustomDataIdentifierUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if err := setTagsMacie2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Macie Custom Data Identifier (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsMacie2CustomDataIdentifierRead(d, meta)
+}
+
+func resourceAwsMacie2CustomDataIdentifierDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Custom Data Identifier: %s", d.Id())
+	_, err := conn.DeleteCustomDataIdentifier(&macie2.DeleteCustomDataIdentifierInput{
+		Id: aws.String(d.Id()),
+	})
+	if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Custom Data Identifier (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSMacie2CustomDataIdentifier_basic(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:custom-data-identifier/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Employee IDs"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_words.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keywords.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_match_distance", "10"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "regex", "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2CustomDataIdentifier_tags(t *testing.T) {
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.Deleted) {
+			return fmt.Errorf("Macie Custom Data Identifier (%s) is deleted", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_custom_data_identifier" {
+			continue
+		}
+
+		output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, macie2.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(output.Deleted) {
+			return fmt.Errorf("Macie Custom Data Identifier (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  name                   = %[1]q
+  description            = "Employee IDs"
+  regex                  = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+  keywords               = ["employee", "badge"]
+  ignore_words           = ["000-00-0000"]
+  maximum_match_distance = 10
+}
+`, rName)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  depends_on = ["aws_macie2_account.test"]
+
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
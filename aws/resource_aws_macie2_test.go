@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSMacie2(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Account": {
+			"basic":                      testAccAWSMacie2Account_basic,
+			"findingPublishingFrequency": testAccAWSMacie2Account_findingPublishingFrequency,
+		},
+		"ClassificationJob": {
+			"basic":     testAccAWSMacie2ClassificationJob_basic,
+			"scheduled": testAccAWSMacie2ClassificationJob_scheduled,
+			"tags":      testAccAWSMacie2ClassificationJob_tags,
+		},
+		"CustomDataIdentifier": {
+			"basic": testAccAWSMacie2CustomDataIdentifier_basic,
+			"tags":  testAccAWSMacie2CustomDataIdentifier_tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
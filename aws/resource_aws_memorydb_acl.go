@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMemoryDBACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBACLCreate,
+		Read:   resourceAwsMemoryDBACLRead,
+		Update: resourceAwsMemoryDBACLUpdate,
+		Delete: resourceAwsMemoryDBACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDBName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDBNamePrefix,
+			},
+			"tags": tagsSchema(),
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDBACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.PrefixedUniqueId("tf-")
+	}
+
+	input := &memorydb.CreateACLInput{
+		ACLName: aws.String(name),
+		Tags:    tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("user_names").(*schema.Set); v.Len() > 0 {
+		input.UserNames = expandStringSet(v)
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB ACL: %s", input)
+	if _, err := conn.CreateACL(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB ACL (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForMemoryDBACLActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDBACLRead(d, meta)
+}
+
+func resourceAwsMemoryDBACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	acl, err := getMemoryDBACL(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+
+	if acl == nil {
+		log.Printf("[WARN] MemoryDB ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", acl.ARN)
+	d.Set("minimum_engine_version", acl.MinimumEngineVersion)
+	d.Set("name", acl.Name)
+	if err := d.Set("user_names", flattenStringList(acl.UserNames)); err != nil {
+		return fmt.Errorf("error setting user_names: %s", err)
+	}
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(acl.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &memorydb.UpdateACLInput{
+			ACLName: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserNamesToAdd = expandStringSet(add)
+		}
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			input.UserNamesToRemove = expandStringSet(remove)
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB ACL: %s", input)
+		if _, err := conn.UpdateACL(input); err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMemoryDBACLActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB ACL (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBACLRead(d, meta)
+}
+
+func resourceAwsMemoryDBACLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB ACL (%s)", d.Id())
+	_, err := conn.DeleteACL(&memorydb.DeleteACLInput{
+		ACLName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, memorydb.ErrCodeACLNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{},
+		Refresh:    resourceAwsMemoryDBACLStateRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBACL(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	resp, err := conn.DescribeACLs(&memorydb.DescribeACLsInput{
+		ACLName: aws.String(name),
+	})
+	if isAWSErr(err, memorydb.ErrCodeACLNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, acl := range resp.ACLs {
+		if aws.StringValue(acl.Name) == name {
+			return acl, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsMemoryDBACLStateRefreshFunc(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		acl, err := getMemoryDBACL(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if acl == nil {
+			return nil, "", nil
+		}
+
+		return acl, aws.StringValue(acl.Status), nil
+	}
+}
+
+func waitForMemoryDBACLActive(conn *memorydb.MemoryDB, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying"},
+		Target:     []string{"active"},
+		Refresh:    resourceAwsMemoryDBACLStateRefreshFunc(conn, name),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
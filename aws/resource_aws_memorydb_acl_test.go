@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBACL_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBACLConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:acl/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBACL_userNames(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBACLConfigUserNames(rName, `"${aws_memorydb_user.test.0.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDBACLConfigUserNames(rName, `"${aws_memorydb_user.test.0.id}", "${aws_memorydb_user.test.1.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBACLConfigUserNames(rName, `"${aws_memorydb_user.test.1.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBACL_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBACLConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBACLConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBACLDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_acl" {
+			continue
+		}
+
+		acl, err := getMemoryDBACL(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if acl != nil {
+			return fmt.Errorf("MemoryDB ACL (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBACLExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB ACL ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		acl, err := getMemoryDBACL(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if acl == nil {
+			return fmt.Errorf("MemoryDB ACL (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBACLConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBACLConfigUserNames(rName, userNames string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  count = 2
+
+  user_name     = "%[1]s-${count.index}"
+  access_string = "on ~* &* +@all"
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+
+resource "aws_memorydb_acl" "test" {
+  name       = %[1]q
+  user_names = [%[2]s]
+}
+`, rName, userNames)
+}
+
+func testAccAWSMemoryDBACLConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,652 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMemoryDBCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBClusterCreate,
+		Read:   resourceAwsMemoryDBClusterRead,
+		Update: resourceAwsMemoryDBClusterUpdate,
+		Delete: resourceAwsMemoryDBClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"cluster_endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     memoryDBEndpointSchema(),
+			},
+			"data_tiering": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"engine_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMemoryDBName,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDBName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDBNamePrefix,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_replicas_per_shard": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"shards": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nodes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     memoryDBEndpointSchema(),
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_arns": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"snapshot_name"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_arns"},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"snapshot_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func memoryDBEndpointSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.PrefixedUniqueId("tf-")
+	}
+
+	input := &memorydb.CreateClusterInput{
+		ACLName:                 aws.String(d.Get("acl_name").(string)),
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		ClusterName:             aws.String(name),
+		Description:             aws.String(d.Get("description").(string)),
+		NodeType:                aws.String(d.Get("node_type").(string)),
+		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
+		Tags:                    tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("data_tiering"); ok {
+		input.DataTiering = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v := d.Get("security_group_ids").(*schema.Set); v.Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := d.GetOk("snapshot_arns"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		input.SnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_window"); ok {
+		input.SnapshotWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
+	if _, err := conn.CreateCluster(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForMemoryDBClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDBClusterRead(d, meta)
+}
+
+func resourceAwsMemoryDBClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	cluster, err := getMemoryDBCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	if cluster == nil {
+		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("acl_name", cluster.ACLName)
+	d.Set("arn", cluster.ARN)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
+
+	if err := d.Set("cluster_endpoint", flattenMemoryDBEndpoint(cluster.ClusterEndpoint)); err != nil {
+		return fmt.Errorf("error setting cluster_endpoint: %s", err)
+	}
+
+	d.Set("data_tiering", aws.StringValue(cluster.DataTiering) == memorydb.DataTieringStatusTrue)
+	d.Set("description", cluster.Description)
+	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("kms_key_arn", cluster.KmsKeyId)
+	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("name", cluster.Name)
+	d.Set("node_type", cluster.NodeType)
+
+	// All shards have the same number of nodes: one primary and its replicas
+	numReplicasPerShard := 0
+	if len(cluster.Shards) > 0 {
+		numReplicasPerShard = int(aws.Int64Value(cluster.Shards[0].NumberOfNodes)) - 1
+	}
+	d.Set("num_replicas_per_shard", numReplicasPerShard)
+	d.Set("num_shards", cluster.NumberOfShards)
+
+	d.Set("parameter_group_name", cluster.ParameterGroupName)
+
+	if cluster.ClusterEndpoint != nil {
+		d.Set("port", cluster.ClusterEndpoint.Port)
+	}
+
+	securityGroupIds := make([]string, 0, len(cluster.SecurityGroups))
+	for _, sg := range cluster.SecurityGroups {
+		securityGroupIds = append(securityGroupIds, aws.StringValue(sg.SecurityGroupId))
+	}
+	if err := d.Set("security_group_ids", securityGroupIds); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	if err := d.Set("shards", flattenMemoryDBShards(cluster.Shards)); err != nil {
+		return fmt.Errorf("error setting shards: %s", err)
+	}
+
+	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
+	d.Set("snapshot_window", cluster.SnapshotWindow)
+
+	if aws.StringValue(cluster.SnsTopicStatus) == "active" {
+		d.Set("sns_topic_arn", cluster.SnsTopicArn)
+	} else {
+		d.Set("sns_topic_arn", "")
+	}
+
+	d.Set("subnet_group_name", cluster.SubnetGroupName)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(cluster.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.UpdateClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if d.HasChange("acl_name") {
+		input.ACLName = aws.String(d.Get("acl_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("engine_version") {
+		input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("maintenance_window") {
+		input.MaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("node_type") {
+		input.NodeType = aws.String(d.Get("node_type").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("num_replicas_per_shard") {
+		input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
+			ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		}
+		requestUpdate = true
+	}
+
+	if d.HasChange("num_shards") {
+		input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+			ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+		}
+		requestUpdate = true
+	}
+
+	if d.HasChange("parameter_group_name") {
+		input.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("security_group_ids") {
+		input.SecurityGroupIds = expandStringSet(d.Get("security_group_ids").(*schema.Set))
+		requestUpdate = true
+	}
+
+	if d.HasChange("snapshot_retention_limit") {
+		input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		requestUpdate = true
+	}
+
+	if d.HasChange("snapshot_window") {
+		input.SnapshotWindow = aws.String(d.Get("snapshot_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("sns_topic_arn") {
+		if v := d.Get("sns_topic_arn").(string); v != "" {
+			input.SnsTopicArn = aws.String(v)
+			input.SnsTopicStatus = aws.String("active")
+		} else {
+			input.SnsTopicStatus = aws.String("inactive")
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Updating MemoryDB Cluster: %s", input)
+		if _, err := conn.UpdateCluster(input); err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMemoryDBClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBClusterRead(d, meta)
+}
+
+func resourceAwsMemoryDBClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("final_snapshot_name"); ok {
+		input.FinalSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Cluster: %s", input)
+	_, err := conn.DeleteCluster(input)
+	if isAWSErr(err, memorydb.ErrCodeClusterNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{},
+		Refresh:    resourceAwsMemoryDBClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBCluster(conn *memorydb.MemoryDB, name string) (*memorydb.Cluster, error) {
+	resp, err := conn.DescribeClusters(&memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	})
+	if isAWSErr(err, memorydb.ErrCodeClusterNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range resp.Clusters {
+		if aws.StringValue(cluster.Name) == name {
+			return cluster, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsMemoryDBClusterStateRefreshFunc(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := getMemoryDBCluster(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if cluster == nil {
+			return nil, "", nil
+		}
+
+		return cluster, aws.StringValue(cluster.Status), nil
+	}
+}
+
+func waitForMemoryDBClusterAvailable(conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "snapshotting", "updating"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsMemoryDBClusterStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func flattenMemoryDBEndpoint(endpoint *memorydb.Endpoint) []interface{} {
+	if endpoint == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"address": aws.StringValue(endpoint.Address),
+		"port":    int(aws.Int64Value(endpoint.Port)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenMemoryDBShards(shards []*memorydb.Shard) []interface{} {
+	l := make([]interface{}, 0, len(shards))
+
+	for _, shard := range shards {
+		if shard == nil {
+			continue
+		}
+
+		nodes := make([]interface{}, 0, len(shard.Nodes))
+		for _, node := range shard.Nodes {
+			if node == nil {
+				continue
+			}
+
+			var createTime string
+			if node.CreateTime != nil {
+				createTime = aws.TimeValue(node.CreateTime).Format(time.RFC3339)
+			}
+
+			nodes = append(nodes, map[string]interface{}{
+				"availability_zone": aws.StringValue(node.AvailabilityZone),
+				"create_time":       createTime,
+				"endpoint":          flattenMemoryDBEndpoint(node.Endpoint),
+				"name":              aws.StringValue(node.Name),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":      aws.StringValue(shard.Name),
+			"nodes":     nodes,
+			"num_nodes": int(aws.Int64Value(shard.NumberOfNodes)),
+			"slots":     aws.StringValue(shard.Slots),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBCluster_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl_name", "open-access"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:cluster/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_endpoint.0.address"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint.0.port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "data_tiering", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_patch_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "node_type", "db.t4g.small"),
+					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "1"),
+					resource.TestCheckResourceAttr(resourceName, "num_shards", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameter_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shards.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_window"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_group_name", "aws_memorydb_subnet_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tls_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBCluster_aclAndParameterGroup(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBClusterConfigACLAndParameterGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "acl_name", "aws_memorydb_acl.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameter_group_name", "aws_memorydb_parameter_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBCluster_update(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBClusterConfigUpdate(rName, "description1", 7, "05:00-09:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "7"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_window", "05:00-09:00"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBClusterConfigUpdate(rName, "description2", 3, "06:00-10:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "3"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_window", "06:00-10:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBCluster_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBClusterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBClusterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_cluster" {
+			continue
+		}
+
+		cluster, err := getMemoryDBCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if cluster != nil {
+			return fmt.Errorf("MemoryDB Cluster (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		cluster, err := getMemoryDBCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if cluster == nil {
+			return fmt.Errorf("MemoryDB Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBClusterConfigBase(rName string) string {
+	return testAccAWSMemoryDBNetworkConfigBase(rName) + fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_memorydb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = ["${aws_subnet.test.*.id}"]
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBClusterConfig(rName string) string {
+	return testAccAWSMemoryDBClusterConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  name                     = %[1]q
+  acl_name                 = "open-access"
+  node_type                = "db.t4g.small"
+  num_shards               = 2
+  security_group_ids       = ["${aws_security_group.test.id}"]
+  snapshot_retention_limit = 7
+  subnet_group_name        = "${aws_memorydb_subnet_group.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBClusterConfigACLAndParameterGroup(rName string) string {
+	return testAccAWSMemoryDBClusterConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  user_name     = %[1]q
+  access_string = "on ~* &* +@all"
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+
+resource "aws_memorydb_acl" "test" {
+  name       = %[1]q
+  user_names = ["${aws_memorydb_user.test.id}"]
+}
+
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = "70"
+  }
+}
+
+resource "aws_memorydb_cluster" "test" {
+  name                   = %[1]q
+  acl_name               = "${aws_memorydb_acl.test.id}"
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  parameter_group_name   = "${aws_memorydb_parameter_group.test.id}"
+  subnet_group_name      = "${aws_memorydb_subnet_group.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBClusterConfigUpdate(rName, description string, snapshotRetentionLimit int, snapshotWindow string) string {
+	return testAccAWSMemoryDBClusterConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  name                     = %[1]q
+  acl_name                 = "open-access"
+  description              = %[2]q
+  node_type                = "db.t4g.small"
+  num_replicas_per_shard   = 0
+  snapshot_retention_limit = %[3]d
+  snapshot_window          = %[4]q
+  subnet_group_name        = "${aws_memorydb_subnet_group.test.id}"
+}
+`, rName, description, snapshotRetentionLimit, snapshotWindow)
+}
+
+func testAccAWSMemoryDBClusterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMemoryDBClusterConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  name                   = %[1]q
+  acl_name               = "open-access"
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  subnet_group_name      = "${aws_memorydb_subnet_group.test.id}"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
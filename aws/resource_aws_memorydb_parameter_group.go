@@ -0,0 +1,367 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMemoryDBParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBParameterGroupCreate,
+		Read:   resourceAwsMemoryDBParameterGroupRead,
+		Update: resourceAwsMemoryDBParameterGroupUpdate,
+		Delete: resourceAwsMemoryDBParameterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDBName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDBNamePrefix,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMemoryDBParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.PrefixedUniqueId("tf-")
+	}
+
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	if _, err := conn.CreateParameterGroup(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Parameters cannot be set at creation time
+	if v := d.Get("parameter").(*schema.Set); v.Len() > 0 {
+		if err := modifyMemoryDBParameterGroupParameters(conn, d.Id(), expandMemoryDBParameters(v.List())); err != nil {
+			return fmt.Errorf("error setting MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBParameterGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDBParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	group, err := getMemoryDBParameterGroup(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	if group == nil {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+
+	parameters, err := getMemoryDBParameterGroupParameters(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+	}
+
+	// Only include parameters that are configured or that differ from the
+	// family's default parameter group, as there are dozens of system ones
+	configured := make(map[string]bool)
+	for _, raw := range d.Get("parameter").(*schema.Set).List() {
+		configured[raw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	defaultGroupName := "default." + strings.Replace(aws.StringValue(group.Family), "_", "-", -1)
+	defaults, err := getMemoryDBParameterGroupParameters(conn, defaultGroupName)
+	if err != nil && !isAWSErr(err, memorydb.ErrCodeParameterGroupNotFoundFault, "") {
+		return fmt.Errorf("error listing MemoryDB Parameter Group (%s) parameters: %s", defaultGroupName, err)
+	}
+
+	defaultValues := make(map[string]string)
+	for _, p := range defaults {
+		defaultValues[aws.StringValue(p.Name)] = aws.StringValue(p.Value)
+	}
+
+	var userParameters []*memorydb.Parameter
+	for _, p := range parameters {
+		name := aws.StringValue(p.Name)
+		if v, ok := defaultValues[name]; configured[name] || !ok || v != aws.StringValue(p.Value) {
+			userParameters = append(userParameters, p)
+		}
+	}
+
+	if err := d.Set("parameter", flattenMemoryDBParameters(userParameters)); err != nil {
+		return fmt.Errorf("error setting parameter: %s", err)
+	}
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(group.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// Parameters that are no longer configured, and whose name is not
+		// being given a new value, are reset to their default values
+		newNames := make(map[string]bool)
+		for _, raw := range ns.List() {
+			newNames[raw.(map[string]interface{})["name"].(string)] = true
+		}
+
+		var toReset []*string
+		for _, raw := range os.Difference(ns).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if !newNames[name] {
+				toReset = append(toReset, aws.String(name))
+			}
+		}
+
+		if err := resetMemoryDBParameterGroupParameters(conn, d.Id(), toReset); err != nil {
+			return fmt.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+		}
+
+		toModify := expandMemoryDBParameters(ns.Difference(os).List())
+		if err := modifyMemoryDBParameterGroupParameters(conn, d.Id(), toModify); err != nil {
+			return fmt.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB Parameter Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBParameterGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDBParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group (%s)", d.Id())
+	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteParameterGroup(input)
+		if isAWSErr(err, memorydb.ErrCodeInvalidParameterGroupStateFault, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, memorydb.ErrCodeParameterGroupNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBParameterGroup(conn *memorydb.MemoryDB, name string) (*memorydb.ParameterGroup, error) {
+	resp, err := conn.DescribeParameterGroups(&memorydb.DescribeParameterGroupsInput{
+		ParameterGroupName: aws.String(name),
+	})
+	if isAWSErr(err, memorydb.ErrCodeParameterGroupNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range resp.ParameterGroups {
+		if aws.StringValue(group.Name) == name {
+			return group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getMemoryDBParameterGroupParameters(conn *memorydb.MemoryDB, name string) ([]*memorydb.Parameter, error) {
+	var parameters []*memorydb.Parameter
+
+	err := conn.DescribeParametersPages(&memorydb.DescribeParametersInput{
+		ParameterGroupName: aws.String(name),
+	}, func(page *memorydb.DescribeParametersOutput, lastPage bool) bool {
+		parameters = append(parameters, page.Parameters...)
+		return !lastPage
+	})
+
+	return parameters, err
+}
+
+// The API accepts at most 20 parameters per request.
+const memoryDBParameterGroupMaxParamsBulkEdit = 20
+
+func modifyMemoryDBParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		var batch []*memorydb.ParameterNameValue
+		if len(parameters) <= memoryDBParameterGroupMaxParamsBulkEdit {
+			batch, parameters = parameters, nil
+		} else {
+			batch, parameters = parameters[:memoryDBParameterGroupMaxParamsBulkEdit], parameters[memoryDBParameterGroupMaxParamsBulkEdit:]
+		}
+
+		input := &memorydb.UpdateParameterGroupInput{
+			ParameterGroupName:  aws.String(name),
+			ParameterNameValues: batch,
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Parameter Group: %s", input)
+		if _, err := conn.UpdateParameterGroup(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resetMemoryDBParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameterNames []*string) error {
+	for len(parameterNames) > 0 {
+		var batch []*string
+		if len(parameterNames) <= memoryDBParameterGroupMaxParamsBulkEdit {
+			batch, parameterNames = parameterNames, nil
+		} else {
+			batch, parameterNames = parameterNames[:memoryDBParameterGroupMaxParamsBulkEdit], parameterNames[memoryDBParameterGroupMaxParamsBulkEdit:]
+		}
+
+		input := &memorydb.ResetParameterGroupInput{
+			ParameterGroupName: aws.String(name),
+			ParameterNames:     batch,
+		}
+
+		log.Printf("[DEBUG] Resetting MemoryDB Parameter Group: %s", input)
+		err := resource.Retry(30*time.Second, func() *resource.RetryError {
+			_, err := conn.ResetParameterGroup(input)
+			if isAWSErr(err, memorydb.ErrCodeInvalidParameterGroupStateFault, " has pending changes") {
+				return resource.RetryableError(err)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandMemoryDBParameters(l []interface{}) []*memorydb.ParameterNameValue {
+	parameters := make([]*memorydb.ParameterNameValue, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		parameters = append(parameters, &memorydb.ParameterNameValue{
+			ParameterName:  aws.String(m["name"].(string)),
+			ParameterValue: aws.String(m["value"].(string)),
+		})
+	}
+
+	return parameters
+}
+
+func flattenMemoryDBParameters(parameters []*memorydb.Parameter) []interface{} {
+	l := make([]interface{}, 0, len(parameters))
+
+	for _, p := range parameters {
+		l = append(l, map[string]interface{}{
+			"name":  aws.StringValue(p.Name),
+			"value": aws.StringValue(p.Value),
+		})
+	}
+
+	return l
+}
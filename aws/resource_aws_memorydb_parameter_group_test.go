@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBParameterGroup_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:parametergroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "family", "memorydb_redis6"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBParameterGroup_parameters(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfigParameters2(rName, "active-defrag-cycle-max", "70", "active-defrag-cycle-min", "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfigParameters1(rName, "active-defrag-cycle-max", "75"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBParameterGroup_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBParameterGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBParameterGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_parameter_group" {
+			continue
+		}
+
+		group, err := getMemoryDBParameterGroup(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if group != nil {
+			return fmt.Errorf("MemoryDB Parameter Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBParameterGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Parameter Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		group, err := getMemoryDBParameterGroup(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if group == nil {
+			return fmt.Errorf("MemoryDB Parameter Group (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBParameterGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBParameterGroupConfigParameters1(rName, paramName1, paramValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+}
+`, rName, paramName1, paramValue1)
+}
+
+func testAccAWSMemoryDBParameterGroupConfigParameters2(rName, paramName1, paramValue1, paramName2, paramValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+
+  parameter {
+    name  = %[4]q
+    value = %[5]q
+  }
+}
+`, rName, paramName1, paramValue1, paramName2, paramValue2)
+}
+
+func testAccAWSMemoryDBParameterGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
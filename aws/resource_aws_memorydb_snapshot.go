@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMemoryDBSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBSnapshotCreate,
+		Read:   resourceAwsMemoryDBSnapshotRead,
+		Update: resourceAwsMemoryDBSnapshotUpdate,
+		Delete: resourceAwsMemoryDBSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"num_shards": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"parameter_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"snapshot_retention_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"snapshot_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDBName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDBNamePrefix,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMemoryDBSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.PrefixedUniqueId("tf-")
+	}
+
+	input := &memorydb.CreateSnapshotInput{
+		ClusterName:  aws.String(d.Get("cluster_name").(string)),
+		SnapshotName: aws.String(name),
+		Tags:         tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Snapshot: %s", input)
+	if _, err := conn.CreateSnapshot(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB Snapshot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsMemoryDBSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Snapshot (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDBSnapshotRead(d, meta)
+}
+
+func resourceAwsMemoryDBSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	snapshot, err := getMemoryDBSnapshot(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if snapshot == nil {
+		log.Printf("[WARN] MemoryDB Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", snapshot.ARN)
+	if err := d.Set("cluster_configuration", flattenMemoryDBClusterConfiguration(snapshot.ClusterConfiguration)); err != nil {
+		return fmt.Errorf("error setting cluster_configuration: %s", err)
+	}
+	if snapshot.ClusterConfiguration != nil {
+		d.Set("cluster_name", snapshot.ClusterConfiguration.Name)
+	}
+	d.Set("kms_key_arn", snapshot.KmsKeyId)
+	d.Set("name", snapshot.Name)
+	d.Set("source", snapshot.Source)
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(snapshot.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Snapshot (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB Snapshot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBSnapshotRead(d, meta)
+}
+
+func resourceAwsMemoryDBSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Snapshot (%s)", d.Id())
+	_, err := conn.DeleteSnapshot(&memorydb.DeleteSnapshotInput{
+		SnapshotName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, memorydb.ErrCodeSnapshotNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Snapshot (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{},
+		Refresh:    resourceAwsMemoryDBSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Snapshot (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBSnapshot(conn *memorydb.MemoryDB, name string) (*memorydb.Snapshot, error) {
+	resp, err := conn.DescribeSnapshots(&memorydb.DescribeSnapshotsInput{
+		ShowDetail:   aws.Bool(true),
+		SnapshotName: aws.String(name),
+	})
+	if isAWSErr(err, memorydb.ErrCodeSnapshotNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range resp.Snapshots {
+		if aws.StringValue(snapshot.Name) == name {
+			return snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsMemoryDBSnapshotStateRefreshFunc(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := getMemoryDBSnapshot(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if snapshot == nil {
+			return nil, "", nil
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+func flattenMemoryDBClusterConfiguration(config *memorydb.ClusterConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"description":              aws.StringValue(config.Description),
+		"engine_version":           aws.StringValue(config.EngineVersion),
+		"maintenance_window":       aws.StringValue(config.MaintenanceWindow),
+		"name":                     aws.StringValue(config.Name),
+		"node_type":                aws.StringValue(config.NodeType),
+		"num_shards":               int(aws.Int64Value(config.NumShards)),
+		"parameter_group_name":     aws.StringValue(config.ParameterGroupName),
+		"port":                     int(aws.Int64Value(config.Port)),
+		"snapshot_retention_limit": int(aws.Int64Value(config.SnapshotRetentionLimit)),
+		"snapshot_window":          aws.StringValue(config.SnapshotWindow),
+		"subnet_group_name":        aws.StringValue(config.SubnetGroupName),
+		"topic_arn":                aws.StringValue(config.TopicArn),
+		"vpc_id":                   aws.StringValue(config.VpcId),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBSnapshot_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSnapshotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSnapshotExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:snapshot/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_configuration.0.name", "aws_memorydb_cluster.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_configuration.0.node_type", "aws_memorydb_cluster.test", "node_type"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_configuration.0.num_shards", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_configuration.0.subnet_group_name", "aws_memorydb_subnet_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_configuration.0.vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_memorydb_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBSnapshot_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSnapshotConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBSnapshotConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_snapshot" {
+			continue
+		}
+
+		snapshot, err := getMemoryDBSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if snapshot != nil {
+			return fmt.Errorf("MemoryDB Snapshot (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Snapshot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		snapshot, err := getMemoryDBSnapshot(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if snapshot == nil {
+			return fmt.Errorf("MemoryDB Snapshot (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBSnapshotConfigBase(rName string) string {
+	return testAccAWSMemoryDBClusterConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  name                   = %[1]q
+  acl_name               = "open-access"
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  subnet_group_name      = "${aws_memorydb_subnet_group.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBSnapshotConfig(rName string) string {
+	return testAccAWSMemoryDBSnapshotConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_snapshot" "test" {
+  cluster_name = "${aws_memorydb_cluster.test.id}"
+  name         = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBSnapshotConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMemoryDBSnapshotConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_snapshot" "test" {
+  cluster_name = "${aws_memorydb_cluster.test.id}"
+  name         = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
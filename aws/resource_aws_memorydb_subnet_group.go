@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMemoryDBSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBSubnetGroupCreate,
+		Read:   resourceAwsMemoryDBSubnetGroupRead,
+		Update: resourceAwsMemoryDBSubnetGroupUpdate,
+		Delete: resourceAwsMemoryDBSubnetGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDBName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDBNamePrefix,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDBSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.PrefixedUniqueId("tf-")
+	}
+
+	input := &memorydb.CreateSubnetGroupInput{
+		Description:     aws.String(d.Get("description").(string)),
+		SubnetGroupName: aws.String(name),
+		SubnetIds:       expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		Tags:            tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Subnet Group: %s", input)
+	if _, err := conn.CreateSubnetGroup(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB Subnet Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMemoryDBSubnetGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDBSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	group, err := getMemoryDBSubnetGroup(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Subnet Group (%s): %s", d.Id(), err)
+	}
+
+	if group == nil {
+		log.Printf("[WARN] MemoryDB Subnet Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	subnetIds := make([]string, 0, len(group.Subnets))
+	for _, subnet := range group.Subnets {
+		subnetIds = append(subnetIds, aws.StringValue(subnet.Identifier))
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("name", group.Name)
+	if err := d.Set("subnet_ids", subnetIds); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+	d.Set("vpc_id", group.VpcId)
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(group.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Subnet Group (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("description") || d.HasChange("subnet_ids") {
+		input := &memorydb.UpdateSubnetGroupInput{
+			Description:     aws.String(d.Get("description").(string)),
+			SubnetGroupName: aws.String(d.Id()),
+			SubnetIds:       expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Subnet Group: %s", input)
+		if _, err := conn.UpdateSubnetGroup(input); err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBSubnetGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDBSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.DeleteSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Subnet Group (%s)", d.Id())
+	// Clusters using the subnet group may still be tearing down
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteSubnetGroup(input)
+		if isAWSErr(err, memorydb.ErrCodeSubnetGroupInUseFault, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, memorydb.ErrCodeSubnetGroupNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Subnet Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBSubnetGroup(conn *memorydb.MemoryDB, name string) (*memorydb.SubnetGroup, error) {
+	resp, err := conn.DescribeSubnetGroups(&memorydb.DescribeSubnetGroupsInput{
+		SubnetGroupName: aws.String(name),
+	})
+	if isAWSErr(err, memorydb.ErrCodeSubnetGroupNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range resp.SubnetGroups {
+		if aws.StringValue(group.Name) == name {
+			return group, nil
+		}
+	}
+
+	return nil, nil
+}
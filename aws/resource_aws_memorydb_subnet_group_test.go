@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBSubnetGroup_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_subnet_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:subnetgroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBSubnetGroup_namePrefix(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_subnet_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfigNamePrefix(rName, "tf-acc-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(`^tf-acc-`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBSubnetGroup_update(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_subnet_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfigUpdate(rName, "description1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfigUpdate(rName, "description2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBSubnetGroup_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_subnet_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBSubnetGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBSubnetGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_subnet_group" {
+			continue
+		}
+
+		group, err := getMemoryDBSubnetGroup(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if group != nil {
+			return fmt.Errorf("MemoryDB Subnet Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBSubnetGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Subnet Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		group, err := getMemoryDBSubnetGroup(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if group == nil {
+			return fmt.Errorf("MemoryDB Subnet Group (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBNetworkConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBSubnetGroupConfig(rName string) string {
+	return testAccAWSMemoryDBNetworkConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = ["${aws_subnet.test.*.id}"]
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBSubnetGroupConfigNamePrefix(rName, namePrefix string) string {
+	return testAccAWSMemoryDBNetworkConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_subnet_group" "test" {
+  name_prefix = %[1]q
+  subnet_ids  = ["${aws_subnet.test.*.id}"]
+}
+`, namePrefix)
+}
+
+func testAccAWSMemoryDBSubnetGroupConfigUpdate(rName, description string, subnetCount int) string {
+	return testAccAWSMemoryDBNetworkConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_subnet_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+  subnet_ids  = ["${slice(aws_subnet.test.*.id, 0, %[3]d)}"]
+}
+`, rName, description, subnetCount)
+}
+
+func testAccAWSMemoryDBSubnetGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMemoryDBNetworkConfigBase(rName) + fmt.Sprintf(`
+resource "aws_memorydb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = ["${aws_subnet.test.*.id}"]
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMemoryDBUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDBUserCreate,
+		Read:   resourceAwsMemoryDBUserRead,
+		Update: resourceAwsMemoryDBUserUpdate,
+		Delete: resourceAwsMemoryDBUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_mode": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passwords": {
+							Type:      schema.TypeSet,
+							Optional:  true,
+							MinItems:  1,
+							MaxItems:  2,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+							Set: schema.HashString,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								memorydb.InputAuthenticationTypeIam,
+								memorydb.InputAuthenticationTypePassword,
+							}, false),
+						},
+					},
+				},
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]{0,39}$`),
+					"must be 1 to 40 alphanumeric characters or hyphens and start with a letter"),
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDBUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	userName := d.Get("user_name").(string)
+	input := &memorydb.CreateUserInput{
+		AccessString:       aws.String(d.Get("access_string").(string)),
+		AuthenticationMode: expandMemoryDBAuthenticationMode(d.Get("authentication_mode").([]interface{})),
+		Tags:               tagsFromMapMemoryDB(d.Get("tags").(map[string]interface{})),
+		UserName:           aws.String(userName),
+	}
+
+	// Prevent the passwords from being logged
+	log.Printf("[DEBUG] Creating MemoryDB User: %s", userName)
+	if _, err := conn.CreateUser(input); err != nil {
+		return fmt.Errorf("error creating MemoryDB User (%s): %s", userName, err)
+	}
+
+	d.SetId(userName)
+
+	if err := waitForMemoryDBUserActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDBUserRead(d, meta)
+}
+
+func resourceAwsMemoryDBUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	user, err := getMemoryDBUser(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB User (%s): %s", d.Id(), err)
+	}
+
+	if user == nil {
+		log.Printf("[WARN] MemoryDB User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_string", user.AccessString)
+	d.Set("arn", user.ARN)
+	d.Set("minimum_engine_version", user.MinimumEngineVersion)
+	d.Set("user_name", user.Name)
+
+	// The passwords are never returned by the API, so keep the configured ones
+	if user.Authentication != nil {
+		mode := map[string]interface{}{
+			"password_count": int(aws.Int64Value(user.Authentication.PasswordCount)),
+			"passwords":      schema.NewSet(schema.HashString, nil),
+			"type":           aws.StringValue(user.Authentication.Type),
+		}
+
+		if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			mode["passwords"] = v.([]interface{})[0].(map[string]interface{})["passwords"]
+		}
+
+		if err := d.Set("authentication_mode", []interface{}{mode}); err != nil {
+			return fmt.Errorf("error setting authentication_mode: %s", err)
+		}
+	}
+
+	tags, err := getTagsMemoryDB(conn, aws.StringValue(user.ARN))
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB User (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDBUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("access_string") || d.HasChange("authentication_mode") {
+		input := &memorydb.UpdateUserInput{
+			UserName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_string") {
+			input.AccessString = aws.String(d.Get("access_string").(string))
+		}
+
+		if d.HasChange("authentication_mode") {
+			input.AuthenticationMode = expandMemoryDBAuthenticationMode(d.Get("authentication_mode").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB User: %s", d.Id())
+		if _, err := conn.UpdateUser(input); err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMemoryDBUserActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB User (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsMemoryDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDBUserRead(d, meta)
+}
+
+func resourceAwsMemoryDBUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB User (%s)", d.Id())
+	_, err := conn.DeleteUser(&memorydb.DeleteUserInput{
+		UserName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, memorydb.ErrCodeUserNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB User (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{},
+		Refresh:    resourceAwsMemoryDBUserStateRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getMemoryDBUser(conn *memorydb.MemoryDB, userName string) (*memorydb.User, error) {
+	resp, err := conn.DescribeUsers(&memorydb.DescribeUsersInput{
+		UserName: aws.String(userName),
+	})
+	if isAWSErr(err, memorydb.ErrCodeUserNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range resp.Users {
+		if aws.StringValue(user.Name) == userName {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsMemoryDBUserStateRefreshFunc(conn *memorydb.MemoryDB, userName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := getMemoryDBUser(conn, userName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if user == nil {
+			return nil, "", nil
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
+
+func waitForMemoryDBUserActive(conn *memorydb.MemoryDB, userName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"modifying"},
+		Target:     []string{"active"},
+		Refresh:    resourceAwsMemoryDBUserStateRefreshFunc(conn, userName),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandMemoryDBAuthenticationMode(l []interface{}) *memorydb.AuthenticationMode {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	mode := &memorydb.AuthenticationMode{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["passwords"].(*schema.Set); ok && v.Len() > 0 {
+		mode.Passwords = expandStringSet(v)
+	}
+
+	return mode
+}
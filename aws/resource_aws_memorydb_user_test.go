@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMemoryDBUser_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBUserConfig(rName, "on ~* &* +@all", "aaaaaaaaaaaaaaaa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_string", "on ~* &* +@all"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:memorydb:[^:]+:\d{12}:user/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.passwords.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.type", "password"),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authentication_mode.0.passwords"},
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBUser_update(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBUserConfig(rName, "on ~* &* +@all", "aaaaaaaaaaaaaaaa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_string", "on ~* &* +@all"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBUserConfig(rName, "off ~* &* +@all", "bbbbbbbbbbbbbbbb"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_string", "off ~* &* +@all"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBUserConfigIAM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.type", "iam"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDBUser_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDBUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDBUserConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDBUserConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDBUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDBUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_user" {
+			continue
+		}
+
+		user, err := getMemoryDBUser(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if user != nil {
+			return fmt.Errorf("MemoryDB User (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDBUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB User ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		user, err := getMemoryDBUser(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("MemoryDB User (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMemoryDBUserConfig(rName, accessString, password string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  user_name     = %[1]q
+  access_string = %[2]q
+
+  authentication_mode {
+    type      = "password"
+    passwords = [%[3]q]
+  }
+}
+`, rName, accessString, password)
+}
+
+func testAccAWSMemoryDBUserConfigIAM(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  user_name     = %[1]q
+  access_string = "on ~* &* +@all"
+
+  authentication_mode {
+    type = "iam"
+  }
+}
+`, rName)
+}
+
+func testAccAWSMemoryDBUserConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  user_name     = %[1]q
+  access_string = "on ~* &* +@all"
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
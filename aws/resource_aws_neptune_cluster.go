@@ -0,0 +1,700 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNeptuneCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNeptuneClusterCreate,
+		Read:   resourceAwsNeptuneClusterRead,
+		Update: resourceAwsNeptuneClusterUpdate,
+		Delete: resourceAwsNeptuneClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNeptuneClusterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// apply_immediately is used to determine when the update modifications
+			// take place.
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Set:      schema.HashString,
+			},
+
+			"backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtMost(35),
+			},
+
+			"cluster_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cluster_identifier_prefix"},
+				ValidateFunc:  validateRdsIdentifier,
+			},
+
+			"cluster_identifier_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifierPrefix,
+			},
+
+			"cluster_members": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+				Set:      schema.HashString,
+			},
+
+			"cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"enable_cloudwatch_logs_exports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"audit",
+						"slowquery",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "neptune",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"neptune"}, false),
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"final_snapshot_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"iam_database_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"neptune_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"neptune_subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8182,
+				ForceNew: true,
+			},
+
+			"preferred_backup_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					if val == nil {
+						return ""
+					}
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+
+			"reader_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replication_source_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"serverless_v2_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  128.0,
+						},
+						"min_capacity": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  2.5,
+						},
+					},
+				},
+			},
+
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsNeptuneClusterImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
+	// from any API call, so we need to default skip_final_snapshot to true so
+	// that final_snapshot_identifier is not required
+	d.Set("skip_final_snapshot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	var identifier string
+	if v, ok := d.GetOk("cluster_identifier"); ok {
+		identifier = v.(string)
+	} else {
+		if v, ok := d.GetOk("cluster_identifier_prefix"); ok {
+			identifier = resource.PrefixedUniqueId(v.(string))
+		} else {
+			identifier = resource.PrefixedUniqueId("tf-")
+		}
+	}
+
+	input := &neptune.CreateDBClusterInput{
+		BackupRetentionPeriod: aws.Int64(int64(d.Get("backup_retention_period").(int))),
+		DBClusterIdentifier:   aws.String(identifier),
+		Engine:                aws.String(d.Get("engine").(string)),
+		Port:                  aws.Int64(int64(d.Get("port").(int))),
+		StorageEncrypted:      aws.Bool(d.Get("storage_encrypted").(bool)),
+		Tags:                  tagsFromMapNeptune(d.Get("tags").(map[string]interface{})),
+	}
+
+	if attr := d.Get("availability_zones").(*schema.Set); attr.Len() > 0 {
+		input.AvailabilityZones = expandStringSet(attr)
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if attr := d.Get("enable_cloudwatch_logs_exports").(*schema.Set); attr.Len() > 0 {
+		input.EnableCloudwatchLogsExports = expandStringSet(attr)
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("global_cluster_identifier"); ok {
+		input.GlobalClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+		input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("neptune_cluster_parameter_group_name"); ok {
+		input.DBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("neptune_subnet_group_name"); ok {
+		input.DBSubnetGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_backup_window"); ok {
+		input.PreferredBackupWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("replication_source_identifier"); ok {
+		input.ReplicationSourceIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("serverless_v2_scaling_configuration"); ok {
+		input.ServerlessV2ScalingConfiguration = expandNeptuneServerlessV2ScalingConfiguration(v.([]interface{}))
+	}
+
+	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+		input.VpcSecurityGroupIds = expandStringSet(attr)
+	}
+
+	log.Printf("[DEBUG] Creating Neptune Cluster: %s", input)
+	if _, err := conn.CreateDBCluster(input); err != nil {
+		return fmt.Errorf("error creating Neptune Cluster: %s", err)
+	}
+
+	d.SetId(identifier)
+
+	log.Printf("[INFO] Waiting for Neptune Cluster (%s) to be available", d.Id())
+	if err := waitForNeptuneClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Neptune Cluster (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsNeptuneClusterRead(d, meta)
+}
+
+func resourceAwsNeptuneClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	dbc, err := getNeptuneCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error describing Neptune Cluster (%s): %s", d.Id(), err)
+	}
+
+	if dbc == nil {
+		log.Printf("[WARN] Neptune Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones)); err != nil {
+		return fmt.Errorf("error setting availability_zones: %s", err)
+	}
+
+	d.Set("arn", dbc.DBClusterArn)
+	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
+	d.Set("cluster_identifier", dbc.DBClusterIdentifier)
+	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("deletion_protection", dbc.DeletionProtection)
+	d.Set("endpoint", dbc.Endpoint)
+	d.Set("engine", dbc.Engine)
+	d.Set("engine_version", dbc.EngineVersion)
+	d.Set("global_cluster_identifier", dbc.GlobalClusterIdentifier)
+	d.Set("hosted_zone_id", dbc.HostedZoneId)
+	d.Set("iam_database_authentication_enabled", dbc.IAMDatabaseAuthenticationEnabled)
+	d.Set("kms_key_arn", dbc.KmsKeyId)
+	d.Set("neptune_cluster_parameter_group_name", dbc.DBClusterParameterGroup)
+	d.Set("neptune_subnet_group_name", dbc.DBSubnetGroup)
+	d.Set("port", dbc.Port)
+	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
+	d.Set("reader_endpoint", dbc.ReaderEndpoint)
+	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
+	d.Set("storage_encrypted", dbc.StorageEncrypted)
+
+	if err := d.Set("enable_cloudwatch_logs_exports", aws.StringValueSlice(dbc.EnabledCloudwatchLogsExports)); err != nil {
+		return fmt.Errorf("error setting enable_cloudwatch_logs_exports: %s", err)
+	}
+
+	if err := d.Set("serverless_v2_scaling_configuration", flattenNeptuneServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)); err != nil {
+		return fmt.Errorf("error setting serverless_v2_scaling_configuration: %s", err)
+	}
+
+	var cm []string
+	for _, m := range dbc.DBClusterMembers {
+		cm = append(cm, aws.StringValue(m.DBInstanceIdentifier))
+	}
+	if err := d.Set("cluster_members", cm); err != nil {
+		return fmt.Errorf("error setting cluster_members: %s", err)
+	}
+
+	var vpcg []string
+	for _, g := range dbc.VpcSecurityGroups {
+		vpcg = append(vpcg, aws.StringValue(g.VpcSecurityGroupId))
+	}
+	if err := d.Set("vpc_security_group_ids", vpcg); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+	}
+
+	if err := saveTagsNeptune(conn, d, aws.StringValue(dbc.DBClusterArn)); err != nil {
+		return fmt.Errorf("error setting tags for Neptune Cluster (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsNeptuneClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+	requestUpdate := false
+
+	req := &neptune.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
+		DBClusterIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("backup_retention_period") {
+		req.BackupRetentionPeriod = aws.Int64(int64(d.Get("backup_retention_period").(int)))
+		requestUpdate = true
+	}
+
+	if d.HasChange("deletion_protection") {
+		req.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("enable_cloudwatch_logs_exports") {
+		o, n := d.GetChange("enable_cloudwatch_logs_exports")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		req.CloudwatchLogsExportConfiguration = &neptune.CloudwatchLogsExportConfiguration{
+			DisableLogTypes: expandStringSet(os.Difference(ns)),
+			EnableLogTypes:  expandStringSet(ns.Difference(os)),
+		}
+		requestUpdate = true
+	}
+
+	if d.HasChange("iam_database_authentication_enabled") {
+		req.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("neptune_cluster_parameter_group_name") {
+		req.DBClusterParameterGroupName = aws.String(d.Get("neptune_cluster_parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		req.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("serverless_v2_scaling_configuration") {
+		req.ServerlessV2ScalingConfiguration = expandNeptuneServerlessV2ScalingConfiguration(d.Get("serverless_v2_scaling_configuration").([]interface{}))
+		requestUpdate = true
+	}
+
+	if d.HasChange("vpc_security_group_ids") {
+		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+			req.VpcSecurityGroupIds = expandStringSet(attr)
+		} else {
+			req.VpcSecurityGroupIds = []*string{}
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying Neptune Cluster: %s", req)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.ModifyDBCluster(req)
+			if err != nil {
+				if isAWSErr(err, neptune.ErrCodeInvalidDBClusterStateFault, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying Neptune Cluster (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Waiting for Neptune Cluster (%s) to be available", d.Id())
+		if err := waitForNeptuneClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Neptune Cluster (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("global_cluster_identifier") {
+		o, n := d.GetChange("global_cluster_identifier")
+		if o.(string) == "" {
+			return fmt.Errorf("existing Neptune Clusters cannot be added to a Neptune Global Cluster")
+		}
+		if n.(string) != "" {
+			return fmt.Errorf("existing Neptune Clusters cannot be migrated between Neptune Global Clusters")
+		}
+
+		if err := removeNeptuneClusterFromGlobalCluster(conn, o.(string), d.Get("arn").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsNeptune(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for Neptune Cluster (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsNeptuneClusterRead(d, meta)
+}
+
+func resourceAwsNeptuneClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	if v, ok := d.GetOk("global_cluster_identifier"); ok {
+		if err := removeNeptuneClusterFromGlobalCluster(conn, v.(string), d.Get("arn").(string)); err != nil {
+			return err
+		}
+	}
+
+	input := &neptune.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(d.Id()),
+		SkipFinalSnapshot:   aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) {
+		v, ok := d.GetOk("final_snapshot_identifier")
+		if !ok {
+			return fmt.Errorf("Neptune Cluster final_snapshot_identifier is required when skip_final_snapshot is false")
+		}
+		input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Neptune Cluster: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteDBCluster(input)
+		if err != nil {
+			if isAWSErr(err, neptune.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, neptune.ErrCodeDBClusterNotFoundFault, "") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Neptune Cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsNeptuneClusterDeletePendingStates,
+		Target:     []string{"destroyed"},
+		Refresh:    resourceAwsNeptuneClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Neptune Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getNeptuneCluster(conn *neptune.Neptune, id string) (*neptune.DBCluster, error) {
+	resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeDBClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, c := range resp.DBClusters {
+		if aws.StringValue(c.DBClusterIdentifier) == id {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsNeptuneClusterStateRefreshFunc(conn *neptune.Neptune, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dbc, err := getNeptuneCluster(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if dbc == nil {
+			return 42, "destroyed", nil
+		}
+
+		log.Printf("[DEBUG] Neptune Cluster status (%s): %s", id, aws.StringValue(dbc.Status))
+		return dbc, aws.StringValue(dbc.Status), nil
+	}
+}
+
+func waitForNeptuneClusterAvailable(conn *neptune.Neptune, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsNeptuneClusterPendingStates,
+		Target:     []string{"available"},
+		Refresh:    resourceAwsNeptuneClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func removeNeptuneClusterFromGlobalCluster(conn *neptune.Neptune, globalClusterId, clusterArn string) error {
+	input := &neptune.RemoveFromGlobalClusterInput{
+		DbClusterIdentifier:     aws.String(clusterArn),
+		GlobalClusterIdentifier: aws.String(globalClusterId),
+	}
+
+	log.Printf("[DEBUG] Removing Neptune Cluster (%s) from Neptune Global Cluster (%s)", clusterArn, globalClusterId)
+	_, err := conn.RemoveFromGlobalCluster(input)
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeDBClusterNotFoundFault, "") || isAWSErr(err, neptune.ErrCodeGlobalClusterNotFoundFault, "") || isAWSErr(err, "InvalidParameterValue", "is not found in global cluster") {
+			return nil
+		}
+		return fmt.Errorf("error removing Neptune Cluster (%s) from Neptune Global Cluster (%s): %s", clusterArn, globalClusterId, err)
+	}
+
+	return nil
+}
+
+func expandNeptuneServerlessV2ScalingConfiguration(l []interface{}) *neptune.ServerlessV2ScalingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &neptune.ServerlessV2ScalingConfiguration{
+		MaxCapacity: aws.Float64(m["max_capacity"].(float64)),
+		MinCapacity: aws.Float64(m["min_capacity"].(float64)),
+	}
+}
+
+func flattenNeptuneServerlessV2ScalingConfigurationInfo(c *neptune.ServerlessV2ScalingConfigurationInfo) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_capacity": aws.Float64Value(c.MaxCapacity),
+		"min_capacity": aws.Float64Value(c.MinCapacity),
+	}
+
+	return []interface{}{m}
+}
+
+var resourceAwsNeptuneClusterPendingStates = []string{
+	"backing-up",
+	"creating",
+	"modifying",
+	"preparing-data-migration",
+	"migrating",
+	"resetting-master-credentials",
+	"upgrading",
+}
+
+var resourceAwsNeptuneClusterDeletePendingStates = []string{
+	"available",
+	"backing-up",
+	"deleting",
+	"modifying",
+}
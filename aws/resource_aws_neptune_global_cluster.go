@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNeptuneGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNeptuneGlobalClusterCreate,
+		Read:   resourceAwsNeptuneGlobalClusterRead,
+		Update: resourceAwsNeptuneGlobalClusterUpdate,
+		Delete: resourceAwsNeptuneGlobalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_db_cluster_identifier"},
+				ValidateFunc:  validation.StringInSlice([]string{"neptune"}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNeptuneGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	input := &neptune.CreateGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Get("global_cluster_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_db_cluster_identifier"); ok {
+		input.SourceDBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_encrypted"); ok {
+		input.StorageEncrypted = aws.Bool(v.(bool))
+	}
+
+	// Neptune requires an engine unless a source cluster is given
+	if input.Engine == nil && input.SourceDBClusterIdentifier == nil {
+		input.Engine = aws.String("neptune")
+	}
+
+	log.Printf("[DEBUG] Creating Neptune Global Cluster: %s", input)
+	output, err := conn.CreateGlobalCluster(input)
+	if err != nil {
+		return fmt.Errorf("error creating Neptune Global Cluster: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
+
+	if err := waitForNeptuneGlobalClusterAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Neptune Global Cluster (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsNeptuneGlobalClusterRead(d, meta)
+}
+
+func resourceAwsNeptuneGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	globalCluster, err := getNeptuneGlobalCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Neptune Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	if globalCluster == nil || aws.StringValue(globalCluster.Status) == "deleting" {
+		log.Printf("[WARN] Neptune Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalCluster.GlobalClusterArn)
+	d.Set("deletion_protection", globalCluster.DeletionProtection)
+	d.Set("engine", globalCluster.Engine)
+	d.Set("engine_version", globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("status", globalCluster.Status)
+	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+
+	if err := d.Set("global_cluster_members", flattenNeptuneGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return fmt.Errorf("error setting global_cluster_members: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNeptuneGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	if d.HasChange("deletion_protection") {
+		input := &neptune.ModifyGlobalClusterInput{
+			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
+			GlobalClusterIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Neptune Global Cluster: %s", input)
+		if _, err := conn.ModifyGlobalCluster(input); err != nil {
+			if isAWSErr(err, neptune.ErrCodeGlobalClusterNotFoundFault, "") {
+				return nil
+			}
+			return fmt.Errorf("error updating Neptune Global Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForNeptuneGlobalClusterAvailable(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Neptune Global Cluster (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNeptuneGlobalClusterRead(d, meta)
+}
+
+func resourceAwsNeptuneGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	input := &neptune.DeleteGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Neptune Global Cluster (%s)", d.Id())
+	// Members are removed by their own resources, which may still be in progress
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalCluster(input)
+		if err != nil {
+			if isAWSErr(err, neptune.ErrCodeInvalidGlobalClusterStateFault, "is not empty") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeGlobalClusterNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Neptune Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceAwsNeptuneGlobalClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Neptune Global Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getNeptuneGlobalCluster(conn *neptune.Neptune, id string) (*neptune.GlobalCluster, error) {
+	output, err := conn.DescribeGlobalClusters(&neptune.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeGlobalClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, globalCluster := range output.GlobalClusters {
+		if aws.StringValue(globalCluster.GlobalClusterIdentifier) == id {
+			return globalCluster, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsNeptuneGlobalClusterStateRefreshFunc(conn *neptune.Neptune, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalCluster, err := getNeptuneGlobalCluster(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if globalCluster == nil {
+			return 42, "deleted", nil
+		}
+
+		return globalCluster, aws.StringValue(globalCluster.Status), nil
+	}
+}
+
+func waitForNeptuneGlobalClusterAvailable(conn *neptune.Neptune, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying", "upgrading"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsNeptuneGlobalClusterStateRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func flattenNeptuneGlobalClusterMembers(members []*neptune.GlobalClusterMember) []interface{} {
+	l := make([]interface{}, 0, len(members))
+
+	for _, member := range members {
+		l = append(l, map[string]interface{}{
+			"db_cluster_arn": aws.StringValue(member.DBClusterArn),
+			"is_writer":      aws.BoolValue(member.IsWriter),
+		})
+	}
+
+	return l
+}
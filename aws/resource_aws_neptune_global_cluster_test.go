@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNeptuneGlobalCluster_basic(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneGlobalClusterConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds::\d{12}:global-cluster:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "global_cluster_resource_id", regexp.MustCompile(`cluster-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneGlobalCluster_deletionProtection(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneGlobalClusterConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config: testAccAWSNeptuneGlobalClusterConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneGlobalCluster_sourceDbClusterIdentifier(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneGlobalClusterConfig_sourceDbClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_identifier", "aws_neptune_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNeptuneGlobalClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_global_cluster" {
+			continue
+		}
+
+		globalCluster, err := getNeptuneGlobalCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if globalCluster != nil {
+			return fmt.Errorf("Neptune Global Cluster (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSNeptuneGlobalClusterExists(n string, v *neptune.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Global Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+		globalCluster, err := getNeptuneGlobalCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if globalCluster == nil {
+			return fmt.Errorf("Neptune Global Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *globalCluster
+
+		return nil
+	}
+}
+
+func testAccAWSNeptuneGlobalClusterConfig(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  deletion_protection       = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccAWSNeptuneGlobalClusterConfig_sourceDbClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine_version      = "1.2.0.0"
+  skip_final_snapshot = true
+
+  # global_cluster_identifier cannot be Computed
+  lifecycle {
+    ignore_changes = ["global_cluster_identifier"]
+  }
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier    = %[1]q
+  source_db_cluster_identifier = "${aws_neptune_cluster.test.arn}"
+}
+`, rName)
+}
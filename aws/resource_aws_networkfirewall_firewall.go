@@ -0,0 +1,483 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNetworkFirewallFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkFirewallFirewallCreate,
+		Read:   resourceAwsNetworkFirewallFirewallRead,
+		Update: resourceAwsNetworkFirewallFirewallUpdate,
+		Delete: resourceAwsNetworkFirewallFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"firewall_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"firewall_policy_change_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"firewall_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sync_states": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attachment": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"endpoint_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"subnet_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"subnet_change_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"subnet_mapping": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkFirewallFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+	name := d.Get("name").(string)
+
+	input := &networkfirewall.CreateFirewallInput{
+		DeleteProtection:               aws.Bool(d.Get("delete_protection").(bool)),
+		FirewallName:                   aws.String(name),
+		FirewallPolicyArn:              aws.String(d.Get("firewall_policy_arn").(string)),
+		FirewallPolicyChangeProtection: aws.Bool(d.Get("firewall_policy_change_protection").(bool)),
+		SubnetChangeProtection:         aws.Bool(d.Get("subnet_change_protection").(bool)),
+		SubnetMappings:                 expandNetworkFirewallSubnetMappings(d.Get("subnet_mapping").(*schema.Set).List()),
+		VpcId:                          aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapNetworkFirewall(v)
+	}
+
+	log.Printf("[DEBUG] Creating NetworkFirewall Firewall: %s", input)
+	output, err := conn.CreateFirewall(input)
+	if err != nil {
+		return fmt.Errorf("error creating NetworkFirewall Firewall (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Firewall.FirewallArn))
+
+	if err := waitForNetworkFirewallFirewallReady(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for NetworkFirewall Firewall (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsNetworkFirewallFirewallRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	output, err := networkFirewallDescribeFirewall(conn, d.Id())
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] NetworkFirewall Firewall (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading NetworkFirewall Firewall (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Firewall == nil {
+		log.Printf("[WARN] NetworkFirewall Firewall (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	firewall := output.Firewall
+
+	d.Set("arn", firewall.FirewallArn)
+	d.Set("delete_protection", firewall.DeleteProtection)
+	d.Set("description", firewall.Description)
+	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
+	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
+	d.Set("name", firewall.FirewallName)
+	d.Set("subnet_change_protection", firewall.SubnetChangeProtection)
+	d.Set("update_token", output.UpdateToken)
+	d.Set("vpc_id", firewall.VpcId)
+
+	if err := d.Set("firewall_status", flattenNetworkFirewallFirewallStatus(output.FirewallStatus)); err != nil {
+		return fmt.Errorf("error setting firewall_status: %s", err)
+	}
+
+	if err := d.Set("subnet_mapping", flattenNetworkFirewallSubnetMappings(firewall.SubnetMappings)); err != nil {
+		return fmt.Errorf("error setting subnet_mapping: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapNetworkFirewall(firewall.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkFirewallFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+	arn := d.Id()
+
+	// Every mutating call returns a fresh update token which must be passed
+	// to the next call.
+	updateToken := aws.String(d.Get("update_token").(string))
+
+	if d.HasChange("description") {
+		input := &networkfirewall.UpdateFirewallDescriptionInput{
+			Description: aws.String(d.Get("description").(string)),
+			FirewallArn: aws.String(arn),
+			UpdateToken: updateToken,
+		}
+
+		log.Printf("[DEBUG] Updating NetworkFirewall Firewall (%s) description: %s", arn, input)
+		output, err := conn.UpdateFirewallDescription(input)
+		if err != nil {
+			return fmt.Errorf("error updating NetworkFirewall Firewall (%s) description: %s", arn, err)
+		}
+
+		updateToken = output.UpdateToken
+	}
+
+	// Change protection must be lifted before the protected attribute can be
+	// modified, and is only re-enabled once the modification has been made.
+	if d.HasChange("firewall_policy_change_protection") && !d.Get("firewall_policy_change_protection").(bool) {
+		token, err := networkFirewallUpdateFirewallPolicyChangeProtection(conn, arn, updateToken, false)
+		if err != nil {
+			return err
+		}
+
+		updateToken = token
+	}
+
+	if d.HasChange("firewall_policy_arn") {
+		input := &networkfirewall.AssociateFirewallPolicyInput{
+			FirewallArn:       aws.String(arn),
+			FirewallPolicyArn: aws.String(d.Get("firewall_policy_arn").(string)),
+			UpdateToken:       updateToken,
+		}
+
+		log.Printf("[DEBUG] Associating NetworkFirewall Firewall (%s) policy: %s", arn, input)
+		output, err := conn.AssociateFirewallPolicy(input)
+		if err != nil {
+			return fmt.Errorf("error associating NetworkFirewall Firewall (%s) policy: %s", arn, err)
+		}
+
+		updateToken = output.UpdateToken
+	}
+
+	if d.HasChange("firewall_policy_change_protection") && d.Get("firewall_policy_change_protection").(bool) {
+		token, err := networkFirewallUpdateFirewallPolicyChangeProtection(conn, arn, updateToken, true)
+		if err != nil {
+			return err
+		}
+
+		updateToken = token
+	}
+
+	if d.HasChange("subnet_change_protection") && !d.Get("subnet_change_protection").(bool) {
+		token, err := networkFirewallUpdateSubnetChangeProtection(conn, arn, updateToken, false)
+		if err != nil {
+			return err
+		}
+
+		updateToken = token
+	}
+
+	if d.HasChange("subnet_mapping") {
+		o, n := d.GetChange("subnet_mapping")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// Associate new subnets before disassociating old ones so that the
+		// firewall always retains at least one subnet.
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input := &networkfirewall.AssociateSubnetsInput{
+				FirewallArn:    aws.String(arn),
+				SubnetMappings: expandNetworkFirewallSubnetMappings(add),
+				UpdateToken:    updateToken,
+			}
+
+			log.Printf("[DEBUG] Associating NetworkFirewall Firewall (%s) subnets: %s", arn, input)
+			output, err := conn.AssociateSubnets(input)
+			if err != nil {
+				return fmt.Errorf("error associating NetworkFirewall Firewall (%s) subnets: %s", arn, err)
+			}
+
+			updateToken = output.UpdateToken
+
+			if err := waitForNetworkFirewallFirewallReady(conn, arn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for NetworkFirewall Firewall (%s) to become ready: %s", arn, err)
+			}
+		}
+
+		if remove := os.Difference(ns).List(); len(remove) > 0 {
+			subnetIDs := make([]*string, 0, len(remove))
+			for _, mappingRaw := range remove {
+				mapping := mappingRaw.(map[string]interface{})
+				subnetIDs = append(subnetIDs, aws.String(mapping["subnet_id"].(string)))
+			}
+
+			input := &networkfirewall.DisassociateSubnetsInput{
+				FirewallArn: aws.String(arn),
+				SubnetIds:   subnetIDs,
+				UpdateToken: updateToken,
+			}
+
+			log.Printf("[DEBUG] Disassociating NetworkFirewall Firewall (%s) subnets: %s", arn, input)
+			output, err := conn.DisassociateSubnets(input)
+			if err != nil {
+				return fmt.Errorf("error disassociating NetworkFirewall Firewall (%s) subnets: %s", arn, err)
+			}
+
+			updateToken = output.UpdateToken
+
+			if err := waitForNetworkFirewallFirewallReady(conn, arn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for NetworkFirewall Firewall (%s) to become ready: %s", arn, err)
+			}
+		}
+	}
+
+	if d.HasChange("subnet_change_protection") && d.Get("subnet_change_protection").(bool) {
+		token, err := networkFirewallUpdateSubnetChangeProtection(conn, arn, updateToken, true)
+		if err != nil {
+			return err
+		}
+
+		updateToken = token
+	}
+
+	if d.HasChange("delete_protection") {
+		input := &networkfirewall.UpdateFirewallDeleteProtectionInput{
+			DeleteProtection: aws.Bool(d.Get("delete_protection").(bool)),
+			FirewallArn:      aws.String(arn),
+			UpdateToken:      updateToken,
+		}
+
+		log.Printf("[DEBUG] Updating NetworkFirewall Firewall (%s) delete protection: %s", arn, input)
+		if _, err := conn.UpdateFirewallDeleteProtection(input); err != nil {
+			return fmt.Errorf("error updating NetworkFirewall Firewall (%s) delete protection: %s", arn, err)
+		}
+	}
+
+	if err := setTagsNetworkFirewall(conn, d, arn); err != nil {
+		return fmt.Errorf("error updating NetworkFirewall Firewall (%s) tags: %s", arn, err)
+	}
+
+	return resourceAwsNetworkFirewallFirewallRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	input := &networkfirewall.DeleteFirewallInput{
+		FirewallArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall Firewall (%s): %s", d.Id(), input)
+	_, err := conn.DeleteFirewall(input)
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting NetworkFirewall Firewall (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForNetworkFirewallFirewallDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for NetworkFirewall Firewall (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func networkFirewallUpdateFirewallPolicyChangeProtection(conn *networkfirewall.NetworkFirewall, arn string, updateToken *string, enabled bool) (*string, error) {
+	input := &networkfirewall.UpdateFirewallPolicyChangeProtectionInput{
+		FirewallArn:                    aws.String(arn),
+		FirewallPolicyChangeProtection: aws.Bool(enabled),
+		UpdateToken:                    updateToken,
+	}
+
+	log.Printf("[DEBUG] Updating NetworkFirewall Firewall (%s) policy change protection: %s", arn, input)
+	output, err := conn.UpdateFirewallPolicyChangeProtection(input)
+	if err != nil {
+		return nil, fmt.Errorf("error updating NetworkFirewall Firewall (%s) policy change protection: %s", arn, err)
+	}
+
+	return output.UpdateToken, nil
+}
+
+func networkFirewallUpdateSubnetChangeProtection(conn *networkfirewall.NetworkFirewall, arn string, updateToken *string, enabled bool) (*string, error) {
+	input := &networkfirewall.UpdateSubnetChangeProtectionInput{
+		FirewallArn:            aws.String(arn),
+		SubnetChangeProtection: aws.Bool(enabled),
+		UpdateToken:            updateToken,
+	}
+
+	log.Printf("[DEBUG] Updating NetworkFirewall Firewall (%s) subnet change protection: %s", arn, input)
+	output, err := conn.UpdateSubnetChangeProtection(input)
+	if err != nil {
+		return nil, fmt.Errorf("error updating NetworkFirewall Firewall (%s) subnet change protection: %s", arn, err)
+	}
+
+	return output.UpdateToken, nil
+}
+
+func expandNetworkFirewallSubnetMappings(l []interface{}) []*networkfirewall.SubnetMapping {
+	mappings := make([]*networkfirewall.SubnetMapping, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		mappings = append(mappings, &networkfirewall.SubnetMapping{
+			SubnetId: aws.String(tfMap["subnet_id"].(string)),
+		})
+	}
+
+	return mappings
+}
+
+func flattenNetworkFirewallSubnetMappings(mappings []*networkfirewall.SubnetMapping) []interface{} {
+	l := make([]interface{}, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		if mapping == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"subnet_id": aws.StringValue(mapping.SubnetId),
+		})
+	}
+
+	return l
+}
+
+func flattenNetworkFirewallFirewallStatus(status *networkfirewall.FirewallStatus) []interface{} {
+	if status == nil {
+		return []interface{}{}
+	}
+
+	syncStates := make([]interface{}, 0, len(status.SyncStates))
+	for availabilityZone, syncState := range status.SyncStates {
+		if syncState == nil {
+			continue
+		}
+
+		var attachment []interface{}
+		if syncState.Attachment != nil {
+			attachment = []interface{}{
+				map[string]interface{}{
+					"endpoint_id": aws.StringValue(syncState.Attachment.EndpointId),
+					"subnet_id":   aws.StringValue(syncState.Attachment.SubnetId),
+				},
+			}
+		}
+
+		syncStates = append(syncStates, map[string]interface{}{
+			"attachment":        attachment,
+			"availability_zone": availabilityZone,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"sync_states": syncStates,
+		},
+	}
+}
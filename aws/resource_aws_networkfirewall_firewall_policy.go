@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNetworkFirewallFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkFirewallFirewallPolicyCreate,
+		Read:   resourceAwsNetworkFirewallFirewallPolicyRead,
+		Update: resourceAwsNetworkFirewallFirewallPolicyUpdate,
+		Delete: resourceAwsNetworkFirewallFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"firewall_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stateful_default_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"stateful_engine_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_order": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.RuleOrder_Values(), false),
+									},
+								},
+							},
+						},
+						"stateful_rule_group_reference": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"resource_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"stateless_custom_action": networkFirewallCustomActionSchema(),
+						"stateless_default_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"stateless_fragment_default_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"stateless_rule_group_reference": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"priority": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"resource_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags": tagsSchema(),
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkFirewallFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+	name := d.Get("name").(string)
+
+	input := &networkfirewall.CreateFirewallPolicyInput{
+		FirewallPolicy:     expandNetworkFirewallFirewallPolicy(d.Get("firewall_policy").([]interface{})),
+		FirewallPolicyName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapNetworkFirewall(v)
+	}
+
+	log.Printf("[DEBUG] Creating NetworkFirewall Firewall Policy: %s", input)
+	output, err := conn.CreateFirewallPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating NetworkFirewall Firewall Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.FirewallPolicyResponse.FirewallPolicyArn))
+
+	return resourceAwsNetworkFirewallFirewallPolicyRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	output, err := networkFirewallDescribeFirewallPolicy(conn, d.Id())
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] NetworkFirewall Firewall Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.FirewallPolicyResponse == nil {
+		log.Printf("[WARN] NetworkFirewall Firewall Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	response := output.FirewallPolicyResponse
+
+	d.Set("arn", response.FirewallPolicyArn)
+	d.Set("description", response.Description)
+	d.Set("name", response.FirewallPolicyName)
+	d.Set("update_token", output.UpdateToken)
+
+	if err := d.Set("firewall_policy", flattenNetworkFirewallFirewallPolicy(output.FirewallPolicy)); err != nil {
+		return fmt.Errorf("error setting firewall_policy: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapNetworkFirewall(response.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkFirewallFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	if d.HasChange("description") || d.HasChange("firewall_policy") {
+		input := &networkfirewall.UpdateFirewallPolicyInput{
+			Description:       aws.String(d.Get("description").(string)),
+			FirewallPolicy:    expandNetworkFirewallFirewallPolicy(d.Get("firewall_policy").([]interface{})),
+			FirewallPolicyArn: aws.String(d.Id()),
+			UpdateToken:       aws.String(d.Get("update_token").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateFirewallPolicy(input); err != nil {
+			return fmt.Errorf("error updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsNetworkFirewall(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating NetworkFirewall Firewall Policy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsNetworkFirewallFirewallPolicyRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	input := &networkfirewall.DeleteFirewallPolicyInput{
+		FirewallPolicyArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall Firewall Policy (%s): %s", d.Id(), input)
+	_, err := conn.DeleteFirewallPolicy(input)
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForNetworkFirewallFirewallPolicyDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for NetworkFirewall Firewall Policy (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNetworkFirewallFirewallPolicy(l []interface{}) *networkfirewall.FirewallPolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	policy := &networkfirewall.FirewallPolicy{
+		StatelessDefaultActions:         expandStringSet(tfMap["stateless_default_actions"].(*schema.Set)),
+		StatelessFragmentDefaultActions: expandStringSet(tfMap["stateless_fragment_default_actions"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["stateful_default_actions"].(*schema.Set); ok && v.Len() > 0 {
+		policy.StatefulDefaultActions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["stateful_engine_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options := v[0].(map[string]interface{})
+		policy.StatefulEngineOptions = &networkfirewall.StatefulEngineOptions{
+			RuleOrder: aws.String(options["rule_order"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["stateful_rule_group_reference"].(*schema.Set); ok && v.Len() > 0 {
+		for _, referenceRaw := range v.List() {
+			reference := referenceRaw.(map[string]interface{})
+			ref := &networkfirewall.StatefulRuleGroupReference{
+				ResourceArn: aws.String(reference["resource_arn"].(string)),
+			}
+
+			if v, ok := reference["priority"].(int); ok && v > 0 {
+				ref.Priority = aws.Int64(int64(v))
+			}
+
+			policy.StatefulRuleGroupReferences = append(policy.StatefulRuleGroupReferences, ref)
+		}
+	}
+
+	if v, ok := tfMap["stateless_custom_action"].(*schema.Set); ok && v.Len() > 0 {
+		policy.StatelessCustomActions = expandNetworkFirewallCustomActions(v.List())
+	}
+
+	if v, ok := tfMap["stateless_rule_group_reference"].(*schema.Set); ok && v.Len() > 0 {
+		for _, referenceRaw := range v.List() {
+			reference := referenceRaw.(map[string]interface{})
+			policy.StatelessRuleGroupReferences = append(policy.StatelessRuleGroupReferences, &networkfirewall.StatelessRuleGroupReference{
+				Priority:    aws.Int64(int64(reference["priority"].(int))),
+				ResourceArn: aws.String(reference["resource_arn"].(string)),
+			})
+		}
+	}
+
+	return policy
+}
+
+func flattenNetworkFirewallFirewallPolicy(policy *networkfirewall.FirewallPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"stateful_default_actions":           flattenStringList(policy.StatefulDefaultActions),
+		"stateless_custom_action":            flattenNetworkFirewallCustomActions(policy.StatelessCustomActions),
+		"stateless_default_actions":          flattenStringList(policy.StatelessDefaultActions),
+		"stateless_fragment_default_actions": flattenStringList(policy.StatelessFragmentDefaultActions),
+	}
+
+	if policy.StatefulEngineOptions != nil && policy.StatefulEngineOptions.RuleOrder != nil {
+		tfMap["stateful_engine_options"] = []interface{}{
+			map[string]interface{}{
+				"rule_order": aws.StringValue(policy.StatefulEngineOptions.RuleOrder),
+			},
+		}
+	}
+
+	statefulReferences := make([]interface{}, 0, len(policy.StatefulRuleGroupReferences))
+	for _, reference := range policy.StatefulRuleGroupReferences {
+		statefulReferences = append(statefulReferences, map[string]interface{}{
+			"priority":     int(aws.Int64Value(reference.Priority)),
+			"resource_arn": aws.StringValue(reference.ResourceArn),
+		})
+	}
+	tfMap["stateful_rule_group_reference"] = statefulReferences
+
+	statelessReferences := make([]interface{}, 0, len(policy.StatelessRuleGroupReferences))
+	for _, reference := range policy.StatelessRuleGroupReferences {
+		statelessReferences = append(statelessReferences, map[string]interface{}{
+			"priority":     int(aws.Int64Value(reference.Priority)),
+			"resource_arn": aws.StringValue(reference.ResourceArn),
+		})
+	}
+	tfMap["stateless_rule_group_reference"] = statelessReferences
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNetworkFirewallFirewallPolicy_basic(t *testing.T) {
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`firewall-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_default_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_fragment_default_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewallPolicy_statelessCustomAction(t *testing.T) {
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigStatelessCustomAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_custom_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_default_actions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_custom_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_default_actions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewallPolicy_ruleGroupReferences(t *testing.T) {
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	statefulRuleGroupResourceName := "aws_networkfirewall_rule_group.stateful"
+	statelessRuleGroupResourceName := "aws_networkfirewall_rule_group.stateless"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigRuleGroupReferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateful_rule_group_reference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_rule_group_reference.#", "1"),
+					testAccCheckAWSNetworkFirewallFirewallPolicyReferences(&firewallPolicy, statefulRuleGroupResourceName, statelessRuleGroupResourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewallPolicy_tags(t *testing.T) {
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallPolicyConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkFirewallFirewallPolicyExists(resourceName string, firewallPolicy *networkfirewall.DescribeFirewallPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall Firewall Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+		output, err := networkFirewallDescribeFirewallPolicy(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.FirewallPolicyResponse == nil {
+			return fmt.Errorf("NetworkFirewall Firewall Policy (%s) not found", rs.Primary.ID)
+		}
+
+		*firewallPolicy = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkFirewallFirewallPolicyReferences(firewallPolicy *networkfirewall.DescribeFirewallPolicyOutput, statefulResourceName, statelessResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		stateful, ok := s.RootModule().Resources[statefulResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", statefulResourceName)
+		}
+
+		stateless, ok := s.RootModule().Resources[statelessResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", statelessResourceName)
+		}
+
+		policy := firewallPolicy.FirewallPolicy
+
+		if len(policy.StatefulRuleGroupReferences) != 1 || *policy.StatefulRuleGroupReferences[0].ResourceArn != stateful.Primary.ID {
+			return fmt.Errorf("expected stateful rule group reference to %s, got %v", stateful.Primary.ID, policy.StatefulRuleGroupReferences)
+		}
+
+		if len(policy.StatelessRuleGroupReferences) != 1 || *policy.StatelessRuleGroupReferences[0].ResourceArn != stateless.Primary.ID {
+			return fmt.Errorf("expected stateless rule group reference to %s, got %v", stateless.Primary.ID, policy.StatelessRuleGroupReferences)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkFirewallFirewallPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkfirewall_firewall_policy" {
+			continue
+		}
+
+		output, err := networkFirewallDescribeFirewallPolicy(conn, rs.Primary.ID)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("NetworkFirewall Firewall Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkFirewallFirewallPolicyConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallPolicyConfigStatelessCustomAction(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass", "CustomAction"]
+
+    stateless_custom_action {
+      action_name = "CustomAction"
+
+      action_definition {
+        publish_metric_action {
+          dimension {
+            value = "1"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallPolicyConfigRuleGroupReferences(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "stateful" {
+  capacity = 100
+  name     = "%[1]s-stateful"
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+}
+
+resource "aws_networkfirewall_rule_group" "stateless" {
+  capacity = 100
+  name     = "%[1]s-stateless"
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              source {
+                address_definition = "1.2.3.4/32"
+              }
+
+              destination {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+
+    stateful_rule_group_reference {
+      resource_arn = "${aws_networkfirewall_rule_group.stateful.arn}"
+    }
+
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = "${aws_networkfirewall_rule_group.stateless.arn}"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallPolicyConfigTags(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+
+  tags {
+    %s = %q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,394 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNetworkFirewallFirewall_basic(t *testing.T) {
+	var firewall networkfirewall.DescribeFirewallOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall.test"
+	policyResourceName := "aws_networkfirewall_firewall_policy.test"
+	subnetResourceName := "aws_subnet.test.0"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`firewall/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "delete_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "firewall_policy_arn", policyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy_change_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subnet_change_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "1"),
+					testAccCheckAWSNetworkFirewallFirewallSubnetMapped(&firewall, subnetResourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewall_description(t *testing.T) {
+	var firewall networkfirewall.DescribeFirewallOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewall_protection(t *testing.T) {
+	var firewall networkfirewall.DescribeFirewallOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "delete_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy_change_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_change_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "delete_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy_change_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_change_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewall_subnetMappings(t *testing.T) {
+	var firewall networkfirewall.DescribeFirewallOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "1"),
+					testAccCheckAWSNetworkFirewallFirewallSubnetMapped(&firewall, "aws_subnet.test.0"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigSubnetMappingsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "2"),
+					testAccCheckAWSNetworkFirewallFirewallSubnetMapped(&firewall, "aws_subnet.test.0"),
+					testAccCheckAWSNetworkFirewallFirewallSubnetMapped(&firewall, "aws_subnet.test.1"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigSubnetMappingsReplaced(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "1"),
+					testAccCheckAWSNetworkFirewallFirewallSubnetMapped(&firewall, "aws_subnet.test.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallFirewall_tags(t *testing.T) {
+	var firewall networkfirewall.DescribeFirewallOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_firewall.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallFirewallConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallFirewallExists(resourceName, &firewall),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkFirewallFirewallExists(resourceName string, firewall *networkfirewall.DescribeFirewallOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall Firewall ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+		output, err := networkFirewallDescribeFirewall(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Firewall == nil {
+			return fmt.Errorf("NetworkFirewall Firewall (%s) not found", rs.Primary.ID)
+		}
+
+		*firewall = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkFirewallFirewallSubnetMapped(firewall *networkfirewall.DescribeFirewallOutput, subnetResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[subnetResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", subnetResourceName)
+		}
+
+		for _, mapping := range firewall.Firewall.SubnetMappings {
+			if mapping != nil && *mapping.SubnetId == rs.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("NetworkFirewall Firewall (%s) is not mapped to subnet %s", *firewall.Firewall.FirewallArn, rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSNetworkFirewallFirewallDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkfirewall_firewall" {
+			continue
+		}
+
+		output, err := networkFirewallDescribeFirewall(conn, rs.Primary.ID)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("NetworkFirewall Firewall (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkFirewallFirewallConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "${cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)}"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigBasic(rName string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %q
+  firewall_policy_arn = "${aws_networkfirewall_firewall_policy.test.arn}"
+  vpc_id              = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.0.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigDescription(rName, description string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %q
+  description         = %q
+  firewall_policy_arn = "${aws_networkfirewall_firewall_policy.test.arn}"
+  vpc_id              = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.0.id}"
+  }
+}
+`, rName, description)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigProtection(rName string, protection bool) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                              = %[1]q
+  delete_protection                 = %[2]t
+  firewall_policy_arn               = "${aws_networkfirewall_firewall_policy.test.arn}"
+  firewall_policy_change_protection = %[2]t
+  subnet_change_protection          = %[2]t
+  vpc_id                            = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.0.id}"
+  }
+}
+`, rName, protection)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigSubnetMappingsUpdated(rName string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %q
+  firewall_policy_arn = "${aws_networkfirewall_firewall_policy.test.arn}"
+  vpc_id              = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.0.id}"
+  }
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.1.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigSubnetMappingsReplaced(rName string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %q
+  firewall_policy_arn = "${aws_networkfirewall_firewall_policy.test.arn}"
+  vpc_id              = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.1.id}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallFirewallConfigTags(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %q
+  firewall_policy_arn = "${aws_networkfirewall_firewall_policy.test.arn}"
+  vpc_id              = "${aws_vpc.test.id}"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.test.0.id}"
+  }
+
+  tags {
+    %s = %q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNetworkFirewallLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkFirewallLoggingConfigurationCreate,
+		Read:   resourceAwsNetworkFirewallLoggingConfigurationRead,
+		Update: resourceAwsNetworkFirewallLoggingConfigurationUpdate,
+		Delete: resourceAwsNetworkFirewallLoggingConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"firewall_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_destination_config": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_destination": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"log_destination_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.LogDestinationType_Values(), false),
+									},
+									"log_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.LogType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkFirewallLoggingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+	firewallArn := d.Get("firewall_arn").(string)
+
+	configs := expandNetworkFirewallLogDestinationConfigs(d.Get("logging_configuration").([]interface{}))
+
+	if err := networkFirewallUpdateLogDestinationConfigs(conn, firewallArn, nil, configs); err != nil {
+		return err
+	}
+
+	d.SetId(firewallArn)
+
+	return resourceAwsNetworkFirewallLoggingConfigurationRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallLoggingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	output, err := networkFirewallDescribeLoggingConfiguration(conn, d.Id())
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] NetworkFirewall Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading NetworkFirewall Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.LoggingConfiguration == nil || len(output.LoggingConfiguration.LogDestinationConfigs) == 0 {
+		log.Printf("[WARN] NetworkFirewall Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("firewall_arn", output.FirewallArn)
+
+	if err := d.Set("logging_configuration", flattenNetworkFirewallLoggingConfiguration(output.LoggingConfiguration)); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkFirewallLoggingConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	if d.HasChange("logging_configuration") {
+		o, n := d.GetChange("logging_configuration")
+
+		if err := networkFirewallUpdateLogDestinationConfigs(conn, d.Id(), expandNetworkFirewallLogDestinationConfigs(o.([]interface{})), expandNetworkFirewallLogDestinationConfigs(n.([]interface{}))); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsNetworkFirewallLoggingConfigurationRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallLoggingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	output, err := networkFirewallDescribeLoggingConfiguration(conn, d.Id())
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading NetworkFirewall Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.LoggingConfiguration == nil {
+		return nil
+	}
+
+	err = networkFirewallUpdateLogDestinationConfigs(conn, d.Id(), output.LoggingConfiguration.LogDestinationConfigs, nil)
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	return err
+}
+
+// networkFirewallUpdateLogDestinationConfigs moves a firewall's logging
+// configuration from the old to the new set of destinations. The API only
+// accepts a single added, removed or modified destination per call, so the
+// transition is made one destination at a time.
+func networkFirewallUpdateLogDestinationConfigs(conn *networkfirewall.NetworkFirewall, firewallArn string, o, n []*networkfirewall.LogDestinationConfig) error {
+	current := make([]*networkfirewall.LogDestinationConfig, 0, len(o))
+	current = append(current, o...)
+
+	for _, config := range o {
+		if networkFirewallFindLogDestinationConfig(n, aws.StringValue(config.LogType)) != nil {
+			continue
+		}
+
+		current = networkFirewallRemoveLogDestinationConfig(current, aws.StringValue(config.LogType))
+
+		if err := networkFirewallPutLoggingConfiguration(conn, firewallArn, current); err != nil {
+			return err
+		}
+	}
+
+	for _, config := range n {
+		existing := networkFirewallFindLogDestinationConfig(current, aws.StringValue(config.LogType))
+
+		if existing != nil && reflect.DeepEqual(existing, config) {
+			continue
+		}
+
+		current = append(networkFirewallRemoveLogDestinationConfig(current, aws.StringValue(config.LogType)), config)
+
+		if err := networkFirewallPutLoggingConfiguration(conn, firewallArn, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func networkFirewallPutLoggingConfiguration(conn *networkfirewall.NetworkFirewall, firewallArn string, configs []*networkfirewall.LogDestinationConfig) error {
+	input := &networkfirewall.UpdateLoggingConfigurationInput{
+		FirewallArn: aws.String(firewallArn),
+		LoggingConfiguration: &networkfirewall.LoggingConfiguration{
+			LogDestinationConfigs: configs,
+		},
+	}
+
+	log.Printf("[DEBUG] Updating NetworkFirewall Logging Configuration (%s): %s", firewallArn, input)
+	if _, err := conn.UpdateLoggingConfiguration(input); err != nil {
+		return fmt.Errorf("error updating NetworkFirewall Logging Configuration (%s): %s", firewallArn, err)
+	}
+
+	return nil
+}
+
+func networkFirewallFindLogDestinationConfig(configs []*networkfirewall.LogDestinationConfig, logType string) *networkfirewall.LogDestinationConfig {
+	for _, config := range configs {
+		if aws.StringValue(config.LogType) == logType {
+			return config
+		}
+	}
+
+	return nil
+}
+
+func networkFirewallRemoveLogDestinationConfig(configs []*networkfirewall.LogDestinationConfig, logType string) []*networkfirewall.LogDestinationConfig {
+	result := make([]*networkfirewall.LogDestinationConfig, 0, len(configs))
+
+	for _, config := range configs {
+		if aws.StringValue(config.LogType) != logType {
+			result = append(result, config)
+		}
+	}
+
+	return result
+}
+
+func expandNetworkFirewallLogDestinationConfigs(l []interface{}) []*networkfirewall.LogDestinationConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	configsRaw := tfMap["log_destination_config"].(*schema.Set).List()
+	configs := make([]*networkfirewall.LogDestinationConfig, 0, len(configsRaw))
+
+	for _, configRaw := range configsRaw {
+		config, ok := configRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configs = append(configs, &networkfirewall.LogDestinationConfig{
+			LogDestination:     stringMapToPointers(config["log_destination"].(map[string]interface{})),
+			LogDestinationType: aws.String(config["log_destination_type"].(string)),
+			LogType:            aws.String(config["log_type"].(string)),
+		})
+	}
+
+	return configs
+}
+
+func flattenNetworkFirewallLoggingConfiguration(loggingConfiguration *networkfirewall.LoggingConfiguration) []interface{} {
+	configs := make([]interface{}, 0, len(loggingConfiguration.LogDestinationConfigs))
+
+	for _, config := range loggingConfiguration.LogDestinationConfigs {
+		if config == nil {
+			continue
+		}
+
+		configs = append(configs, map[string]interface{}{
+			"log_destination":      pointersMapToStringList(config.LogDestination),
+			"log_destination_type": aws.StringValue(config.LogDestinationType),
+			"log_type":             aws.StringValue(config.LogType),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_destination_config": configs,
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNetworkFirewallLoggingConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_logging_configuration.test"
+	firewallResourceName := "aws_networkfirewall_firewall.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallLoggingConfigurationConfigS3(rName, networkfirewall.LogTypeFlow),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "firewall_arn", firewallResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.log_destination_config.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkFirewallLoggingConfigurationConfigS3(rName, networkfirewall.LogTypeAlert),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.log_destination_config.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallLoggingConfiguration_multipleDestinations(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_logging_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallLoggingConfigurationConfigS3AndCloudWatch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.log_destination_config.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkFirewallLoggingConfigurationConfigS3(rName, networkfirewall.LogTypeFlow),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.log_destination_config.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkFirewallLoggingConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall Logging Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+		output, err := networkFirewallDescribeLoggingConfiguration(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.LoggingConfiguration == nil || len(output.LoggingConfiguration.LogDestinationConfigs) == 0 {
+			return fmt.Errorf("NetworkFirewall Logging Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkFirewallLoggingConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkfirewall_logging_configuration" {
+			continue
+		}
+
+		output, err := networkFirewallDescribeLoggingConfiguration(conn, rs.Primary.ID)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.LoggingConfiguration != nil && len(output.LoggingConfiguration.LogDestinationConfigs) > 0 {
+			return fmt.Errorf("NetworkFirewall Logging Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkFirewallLoggingConfigurationConfigBase(rName string) string {
+	return testAccAWSNetworkFirewallFirewallConfigBasic(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallLoggingConfigurationConfigS3(rName, logType string) string {
+	return testAccAWSNetworkFirewallLoggingConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_networkfirewall_logging_configuration" "test" {
+  firewall_arn = "${aws_networkfirewall_firewall.test.arn}"
+
+  logging_configuration {
+    log_destination_config {
+      log_destination {
+        bucketName = "${aws_s3_bucket.test.bucket}"
+        prefix     = "logs"
+      }
+
+      log_destination_type = "S3"
+      log_type             = %q
+    }
+  }
+}
+`, logType)
+}
+
+func testAccAWSNetworkFirewallLoggingConfigurationConfigS3AndCloudWatch(rName string) string {
+	return testAccAWSNetworkFirewallLoggingConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %q
+}
+
+resource "aws_networkfirewall_logging_configuration" "test" {
+  firewall_arn = "${aws_networkfirewall_firewall.test.arn}"
+
+  logging_configuration {
+    log_destination_config {
+      log_destination {
+        bucketName = "${aws_s3_bucket.test.bucket}"
+        prefix     = "logs"
+      }
+
+      log_destination_type = "S3"
+      log_type             = "FLOW"
+    }
+
+    log_destination_config {
+      log_destination {
+        logGroup = "${aws_cloudwatch_log_group.test.name}"
+      }
+
+      log_destination_type = "CloudWatchLogs"
+      log_type             = "ALERT"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,925 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNetworkFirewallRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkFirewallRuleGroupCreate,
+		Read:   resourceAwsNetworkFirewallRuleGroupRead,
+		Update: resourceAwsNetworkFirewallRuleGroupUpdate,
+		Delete: resourceAwsNetworkFirewallRuleGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"rule_group": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rules"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_variables": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_sets":   networkFirewallRuleVariableSchema("ip_set"),
+									"port_sets": networkFirewallRuleVariableSchema("port_set"),
+								},
+							},
+						},
+						"rules_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rules_source_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"generated_rules_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.GeneratedRulesType_Values(), false),
+												},
+												"target_types": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(networkfirewall.TargetType_Values(), false),
+													},
+												},
+												"targets": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"rules_string": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stateful_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.StatefulAction_Values(), false),
+												},
+												"header": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"destination": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"destination_port": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"direction": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(networkfirewall.StatefulRuleDirection_Values(), false),
+															},
+															"protocol": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(networkfirewall.StatefulRuleProtocol_Values(), false),
+															},
+															"source": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"source_port": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"rule_option": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"keyword": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"settings": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"stateless_rules_and_custom_actions": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"custom_action": networkFirewallCustomActionSchema(),
+												"stateless_rule": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"priority": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"rule_definition": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"actions": {
+																			Type:     schema.TypeSet,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																		"match_attributes": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"destination":      networkFirewallAddressSchema(),
+																					"destination_port": networkFirewallPortRangeSchema(),
+																					"protocols": {
+																						Type:     schema.TypeSet,
+																						Optional: true,
+																						Elem: &schema.Schema{
+																							Type:         schema.TypeInt,
+																							ValidateFunc: validation.IntBetween(0, 255),
+																						},
+																					},
+																					"source":      networkFirewallAddressSchema(),
+																					"source_port": networkFirewallPortRangeSchema(),
+																					"tcp_flag": {
+																						Type:     schema.TypeSet,
+																						Optional: true,
+																						Elem: &schema.Resource{
+																							Schema: map[string]*schema.Schema{
+																								"flags": {
+																									Type:     schema.TypeSet,
+																									Required: true,
+																									Elem: &schema.Schema{
+																										Type:         schema.TypeString,
+																										ValidateFunc: validation.StringInSlice(networkfirewall.TCPFlag_Values(), false),
+																									},
+																								},
+																								"masks": {
+																									Type:     schema.TypeSet,
+																									Optional: true,
+																									Elem: &schema.Schema{
+																										Type:         schema.TypeString,
+																										ValidateFunc: validation.StringInSlice(networkfirewall.TCPFlag_Values(), false),
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"stateful_rule_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_order": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.RuleOrder_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rule_group"},
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(networkfirewall.RuleGroupType_Values(), false),
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkFirewallRuleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+	name := d.Get("name").(string)
+
+	input := &networkfirewall.CreateRuleGroupInput{
+		Capacity:      aws.Int64(int64(d.Get("capacity").(int))),
+		RuleGroupName: aws.String(name),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("rules"); ok {
+		input.Rules = aws.String(v.(string))
+	} else if v, ok := d.GetOk("rule_group"); ok {
+		input.RuleGroup = expandNetworkFirewallRuleGroup(v.([]interface{}))
+	} else {
+		return fmt.Errorf("error creating NetworkFirewall Rule Group (%s): one of rule_group or rules must be configured", name)
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapNetworkFirewall(v)
+	}
+
+	log.Printf("[DEBUG] Creating NetworkFirewall Rule Group: %s", input)
+	output, err := conn.CreateRuleGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating NetworkFirewall Rule Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RuleGroupResponse.RuleGroupArn))
+
+	return resourceAwsNetworkFirewallRuleGroupRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallRuleGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	output, err := networkFirewallDescribeRuleGroup(conn, d.Id())
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] NetworkFirewall Rule Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.RuleGroupResponse == nil {
+		log.Printf("[WARN] NetworkFirewall Rule Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	response := output.RuleGroupResponse
+
+	d.Set("arn", response.RuleGroupArn)
+	d.Set("capacity", response.Capacity)
+	d.Set("description", response.Description)
+	d.Set("name", response.RuleGroupName)
+	d.Set("type", response.Type)
+	d.Set("update_token", output.UpdateToken)
+
+	if err := d.Set("rule_group", flattenNetworkFirewallRuleGroup(output.RuleGroup)); err != nil {
+		return fmt.Errorf("error setting rule_group: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapNetworkFirewall(response.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkFirewallRuleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	if d.HasChange("description") || d.HasChange("rule_group") || d.HasChange("rules") {
+		input := &networkfirewall.UpdateRuleGroupInput{
+			Description:  aws.String(d.Get("description").(string)),
+			RuleGroupArn: aws.String(d.Id()),
+			Type:         aws.String(d.Get("type").(string)),
+			UpdateToken:  aws.String(d.Get("update_token").(string)),
+		}
+
+		if v, ok := d.GetOk("rules"); ok {
+			input.Rules = aws.String(v.(string))
+		} else {
+			input.RuleGroup = expandNetworkFirewallRuleGroup(d.Get("rule_group").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating NetworkFirewall Rule Group (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateRuleGroup(input); err != nil {
+			return fmt.Errorf("error updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsNetworkFirewall(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating NetworkFirewall Rule Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsNetworkFirewallRuleGroupRead(d, meta)
+}
+
+func resourceAwsNetworkFirewallRuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkfirewallconn
+
+	input := &networkfirewall.DeleteRuleGroupInput{
+		RuleGroupArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall Rule Group (%s): %s", d.Id(), input)
+	_, err := conn.DeleteRuleGroup(input)
+
+	if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForNetworkFirewallRuleGroupDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for NetworkFirewall Rule Group (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func networkFirewallRuleVariableSchema(definitionKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				definitionKey: {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"definition": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func networkFirewallAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_definition": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+		},
+	}
+}
+
+func networkFirewallPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkFirewallRuleGroup(l []interface{}) *networkfirewall.RuleGroup {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	ruleGroup := &networkfirewall.RuleGroup{}
+
+	if v, ok := tfMap["rule_variables"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		variables := v[0].(map[string]interface{})
+		ruleGroup.RuleVariables = &networkfirewall.RuleVariables{}
+
+		if v, ok := variables["ip_sets"].(*schema.Set); ok && v.Len() > 0 {
+			ruleGroup.RuleVariables.IPSets = make(map[string]*networkfirewall.IPSet)
+			for key, definition := range expandNetworkFirewallRuleVariables(v.List(), "ip_set") {
+				ruleGroup.RuleVariables.IPSets[key] = &networkfirewall.IPSet{Definition: definition}
+			}
+		}
+
+		if v, ok := variables["port_sets"].(*schema.Set); ok && v.Len() > 0 {
+			ruleGroup.RuleVariables.PortSets = make(map[string]*networkfirewall.PortSet)
+			for key, definition := range expandNetworkFirewallRuleVariables(v.List(), "port_set") {
+				ruleGroup.RuleVariables.PortSets[key] = &networkfirewall.PortSet{Definition: definition}
+			}
+		}
+	}
+
+	if v, ok := tfMap["rules_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ruleGroup.RulesSource = expandNetworkFirewallRulesSource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["stateful_rule_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options := v[0].(map[string]interface{})
+		ruleGroup.StatefulRuleOptions = &networkfirewall.StatefulRuleOptions{
+			RuleOrder: aws.String(options["rule_order"].(string)),
+		}
+	}
+
+	return ruleGroup
+}
+
+func expandNetworkFirewallRuleVariables(l []interface{}, definitionKey string) map[string][]*string {
+	variables := make(map[string][]*string, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var definition []*string
+		if v, ok := tfMap[definitionKey].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			definition = expandStringSet(v[0].(map[string]interface{})["definition"].(*schema.Set))
+		}
+
+		variables[tfMap["key"].(string)] = definition
+	}
+
+	return variables
+}
+
+func expandNetworkFirewallRulesSource(tfMap map[string]interface{}) *networkfirewall.RulesSource {
+	rulesSource := &networkfirewall.RulesSource{}
+
+	if v, ok := tfMap["rules_source_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		list := v[0].(map[string]interface{})
+		rulesSource.RulesSourceList = &networkfirewall.RulesSourceList{
+			GeneratedRulesType: aws.String(list["generated_rules_type"].(string)),
+			TargetTypes:        expandStringSet(list["target_types"].(*schema.Set)),
+			Targets:            expandStringSet(list["targets"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := tfMap["rules_string"].(string); ok && v != "" {
+		rulesSource.RulesString = aws.String(v)
+	}
+
+	if v, ok := tfMap["stateful_rule"].([]interface{}); ok && len(v) > 0 {
+		for _, ruleRaw := range v {
+			rule, ok := ruleRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			statefulRule := &networkfirewall.StatefulRule{
+				Action: aws.String(rule["action"].(string)),
+			}
+
+			if v, ok := rule["header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				header := v[0].(map[string]interface{})
+				statefulRule.Header = &networkfirewall.Header{
+					Destination:     aws.String(header["destination"].(string)),
+					DestinationPort: aws.String(header["destination_port"].(string)),
+					Direction:       aws.String(header["direction"].(string)),
+					Protocol:        aws.String(header["protocol"].(string)),
+					Source:          aws.String(header["source"].(string)),
+					SourcePort:      aws.String(header["source_port"].(string)),
+				}
+			}
+
+			for _, optionRaw := range rule["rule_option"].(*schema.Set).List() {
+				option := optionRaw.(map[string]interface{})
+				ruleOption := &networkfirewall.RuleOption{
+					Keyword: aws.String(option["keyword"].(string)),
+				}
+
+				if v, ok := option["settings"].(*schema.Set); ok && v.Len() > 0 {
+					ruleOption.Settings = expandStringSet(v)
+				}
+
+				statefulRule.RuleOptions = append(statefulRule.RuleOptions, ruleOption)
+			}
+
+			rulesSource.StatefulRules = append(rulesSource.StatefulRules, statefulRule)
+		}
+	}
+
+	if v, ok := tfMap["stateless_rules_and_custom_actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rulesAndActions := v[0].(map[string]interface{})
+		rulesSource.StatelessRulesAndCustomActions = &networkfirewall.StatelessRulesAndCustomActions{}
+
+		if v, ok := rulesAndActions["custom_action"].(*schema.Set); ok && v.Len() > 0 {
+			rulesSource.StatelessRulesAndCustomActions.CustomActions = expandNetworkFirewallCustomActions(v.List())
+		}
+
+		for _, ruleRaw := range rulesAndActions["stateless_rule"].(*schema.Set).List() {
+			rule := ruleRaw.(map[string]interface{})
+			statelessRule := &networkfirewall.StatelessRule{
+				Priority: aws.Int64(int64(rule["priority"].(int))),
+			}
+
+			if v, ok := rule["rule_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				definition := v[0].(map[string]interface{})
+				statelessRule.RuleDefinition = &networkfirewall.RuleDefinition{
+					Actions: expandStringSet(definition["actions"].(*schema.Set)),
+				}
+
+				if v, ok := definition["match_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					statelessRule.RuleDefinition.MatchAttributes = expandNetworkFirewallMatchAttributes(v[0].(map[string]interface{}))
+				}
+			}
+
+			rulesSource.StatelessRulesAndCustomActions.StatelessRules = append(rulesSource.StatelessRulesAndCustomActions.StatelessRules, statelessRule)
+		}
+	}
+
+	return rulesSource
+}
+
+func expandNetworkFirewallMatchAttributes(tfMap map[string]interface{}) *networkfirewall.MatchAttributes {
+	matchAttributes := &networkfirewall.MatchAttributes{}
+
+	if v, ok := tfMap["destination"].(*schema.Set); ok && v.Len() > 0 {
+		matchAttributes.Destinations = expandNetworkFirewallAddresses(v.List())
+	}
+
+	if v, ok := tfMap["destination_port"].(*schema.Set); ok && v.Len() > 0 {
+		matchAttributes.DestinationPorts = expandNetworkFirewallPortRanges(v.List())
+	}
+
+	if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+		for _, protocol := range v.List() {
+			matchAttributes.Protocols = append(matchAttributes.Protocols, aws.Int64(int64(protocol.(int))))
+		}
+	}
+
+	if v, ok := tfMap["source"].(*schema.Set); ok && v.Len() > 0 {
+		matchAttributes.Sources = expandNetworkFirewallAddresses(v.List())
+	}
+
+	if v, ok := tfMap["source_port"].(*schema.Set); ok && v.Len() > 0 {
+		matchAttributes.SourcePorts = expandNetworkFirewallPortRanges(v.List())
+	}
+
+	if v, ok := tfMap["tcp_flag"].(*schema.Set); ok && v.Len() > 0 {
+		for _, flagRaw := range v.List() {
+			flag := flagRaw.(map[string]interface{})
+			tcpFlag := &networkfirewall.TCPFlagField{
+				Flags: expandStringSet(flag["flags"].(*schema.Set)),
+			}
+
+			if v, ok := flag["masks"].(*schema.Set); ok && v.Len() > 0 {
+				tcpFlag.Masks = expandStringSet(v)
+			}
+
+			matchAttributes.TCPFlags = append(matchAttributes.TCPFlags, tcpFlag)
+		}
+	}
+
+	return matchAttributes
+}
+
+func expandNetworkFirewallAddresses(l []interface{}) []*networkfirewall.Address {
+	addresses := make([]*networkfirewall.Address, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, &networkfirewall.Address{
+			AddressDefinition: aws.String(tfMap["address_definition"].(string)),
+		})
+	}
+
+	return addresses
+}
+
+func expandNetworkFirewallPortRanges(l []interface{}) []*networkfirewall.PortRange {
+	portRanges := make([]*networkfirewall.PortRange, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		portRanges = append(portRanges, &networkfirewall.PortRange{
+			FromPort: aws.Int64(int64(tfMap["from_port"].(int))),
+			ToPort:   aws.Int64(int64(tfMap["to_port"].(int))),
+		})
+	}
+
+	return portRanges
+}
+
+func flattenNetworkFirewallRuleGroup(ruleGroup *networkfirewall.RuleGroup) []interface{} {
+	if ruleGroup == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if ruleGroup.RuleVariables != nil {
+		ipSets := make([]interface{}, 0, len(ruleGroup.RuleVariables.IPSets))
+		for key, ipSet := range ruleGroup.RuleVariables.IPSets {
+			if ipSet == nil {
+				continue
+			}
+
+			ipSets = append(ipSets, map[string]interface{}{
+				"ip_set": []interface{}{
+					map[string]interface{}{
+						"definition": flattenStringList(ipSet.Definition),
+					},
+				},
+				"key": key,
+			})
+		}
+
+		portSets := make([]interface{}, 0, len(ruleGroup.RuleVariables.PortSets))
+		for key, portSet := range ruleGroup.RuleVariables.PortSets {
+			if portSet == nil {
+				continue
+			}
+
+			portSets = append(portSets, map[string]interface{}{
+				"key": key,
+				"port_set": []interface{}{
+					map[string]interface{}{
+						"definition": flattenStringList(portSet.Definition),
+					},
+				},
+			})
+		}
+
+		tfMap["rule_variables"] = []interface{}{
+			map[string]interface{}{
+				"ip_sets":   ipSets,
+				"port_sets": portSets,
+			},
+		}
+	}
+
+	if ruleGroup.RulesSource != nil {
+		tfMap["rules_source"] = []interface{}{flattenNetworkFirewallRulesSource(ruleGroup.RulesSource)}
+	}
+
+	if ruleGroup.StatefulRuleOptions != nil && ruleGroup.StatefulRuleOptions.RuleOrder != nil {
+		tfMap["stateful_rule_options"] = []interface{}{
+			map[string]interface{}{
+				"rule_order": aws.StringValue(ruleGroup.StatefulRuleOptions.RuleOrder),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNetworkFirewallRulesSource(rulesSource *networkfirewall.RulesSource) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"rules_string": aws.StringValue(rulesSource.RulesString),
+	}
+
+	if list := rulesSource.RulesSourceList; list != nil {
+		tfMap["rules_source_list"] = []interface{}{
+			map[string]interface{}{
+				"generated_rules_type": aws.StringValue(list.GeneratedRulesType),
+				"target_types":         flattenStringList(list.TargetTypes),
+				"targets":              flattenStringList(list.Targets),
+			},
+		}
+	}
+
+	statefulRules := make([]interface{}, 0, len(rulesSource.StatefulRules))
+	for _, rule := range rulesSource.StatefulRules {
+		if rule == nil {
+			continue
+		}
+
+		var header []interface{}
+		if rule.Header != nil {
+			header = []interface{}{
+				map[string]interface{}{
+					"destination":      aws.StringValue(rule.Header.Destination),
+					"destination_port": aws.StringValue(rule.Header.DestinationPort),
+					"direction":        aws.StringValue(rule.Header.Direction),
+					"protocol":         aws.StringValue(rule.Header.Protocol),
+					"source":           aws.StringValue(rule.Header.Source),
+					"source_port":      aws.StringValue(rule.Header.SourcePort),
+				},
+			}
+		}
+
+		ruleOptions := make([]interface{}, 0, len(rule.RuleOptions))
+		for _, option := range rule.RuleOptions {
+			ruleOptions = append(ruleOptions, map[string]interface{}{
+				"keyword":  aws.StringValue(option.Keyword),
+				"settings": flattenStringList(option.Settings),
+			})
+		}
+
+		statefulRules = append(statefulRules, map[string]interface{}{
+			"action":      aws.StringValue(rule.Action),
+			"header":      header,
+			"rule_option": ruleOptions,
+		})
+	}
+	tfMap["stateful_rule"] = statefulRules
+
+	if rulesAndActions := rulesSource.StatelessRulesAndCustomActions; rulesAndActions != nil {
+		statelessRules := make([]interface{}, 0, len(rulesAndActions.StatelessRules))
+		for _, rule := range rulesAndActions.StatelessRules {
+			if rule == nil {
+				continue
+			}
+
+			var ruleDefinition []interface{}
+			if rule.RuleDefinition != nil {
+				ruleDefinition = []interface{}{
+					map[string]interface{}{
+						"actions":          flattenStringList(rule.RuleDefinition.Actions),
+						"match_attributes": flattenNetworkFirewallMatchAttributes(rule.RuleDefinition.MatchAttributes),
+					},
+				}
+			}
+
+			statelessRules = append(statelessRules, map[string]interface{}{
+				"priority":        int(aws.Int64Value(rule.Priority)),
+				"rule_definition": ruleDefinition,
+			})
+		}
+
+		tfMap["stateless_rules_and_custom_actions"] = []interface{}{
+			map[string]interface{}{
+				"custom_action":  flattenNetworkFirewallCustomActions(rulesAndActions.CustomActions),
+				"stateless_rule": statelessRules,
+			},
+		}
+	}
+
+	return tfMap
+}
+
+func flattenNetworkFirewallMatchAttributes(matchAttributes *networkfirewall.MatchAttributes) []interface{} {
+	if matchAttributes == nil {
+		return []interface{}{}
+	}
+
+	protocols := make([]interface{}, 0, len(matchAttributes.Protocols))
+	for _, protocol := range matchAttributes.Protocols {
+		protocols = append(protocols, int(aws.Int64Value(protocol)))
+	}
+
+	tcpFlags := make([]interface{}, 0, len(matchAttributes.TCPFlags))
+	for _, tcpFlag := range matchAttributes.TCPFlags {
+		if tcpFlag == nil {
+			continue
+		}
+
+		tcpFlags = append(tcpFlags, map[string]interface{}{
+			"flags": flattenStringList(tcpFlag.Flags),
+			"masks": flattenStringList(tcpFlag.Masks),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"destination":      flattenNetworkFirewallAddresses(matchAttributes.Destinations),
+			"destination_port": flattenNetworkFirewallPortRanges(matchAttributes.DestinationPorts),
+			"protocols":        protocols,
+			"source":           flattenNetworkFirewallAddresses(matchAttributes.Sources),
+			"source_port":      flattenNetworkFirewallPortRanges(matchAttributes.SourcePorts),
+			"tcp_flag":         tcpFlags,
+		},
+	}
+}
+
+func flattenNetworkFirewallAddresses(addresses []*networkfirewall.Address) []interface{} {
+	l := make([]interface{}, 0, len(addresses))
+
+	for _, address := range addresses {
+		if address == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"address_definition": aws.StringValue(address.AddressDefinition),
+		})
+	}
+
+	return l
+}
+
+func flattenNetworkFirewallPortRanges(portRanges []*networkfirewall.PortRange) []interface{} {
+	l := make([]interface{}, 0, len(portRanges))
+
+	for _, portRange := range portRanges {
+		if portRange == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"from_port": int(aws.Int64Value(portRange.FromPort)),
+			"to_port":   int(aws.Int64Value(portRange.ToPort)),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,397 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNetworkFirewallRuleGroup_basic_rulesSourceList(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigRulesSourceList(rName, "test.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`stateful-rulegroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", networkfirewall.RuleGroupTypeStateful),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.generated_rules_type", networkfirewall.GeneratedRulesTypeAllowlist),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigRulesSourceList(rName, "updated.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallRuleGroup_rules(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	rules := `alert http any any -> any any (http_response_line; content:\"403 Forbidden\"; sid:1;)`
+	updatedRules := `drop http any any -> any any (http_response_line; content:\"403 Forbidden\"; sid:1;)`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigRules(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrSet(resourceName, "rules"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", "alert http any any -> any any (http_response_line; content:\"403 Forbidden\"; sid:1;)"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules"},
+			},
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigRules(rName, updatedRules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", "drop http any any -> any any (http_response_line; content:\"403 Forbidden\"; sid:1;)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallRuleGroup_statefulRule(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigStatefulRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.0.ip_sets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.action", networkfirewall.StatefulActionPass),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.header.0.protocol", networkfirewall.StatefulRuleProtocolIp),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.rule_option.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallRuleGroup_statelessRule(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigStatelessRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "type", networkfirewall.RuleGroupTypeStateless),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateless_rules_and_custom_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateless_rules_and_custom_actions.0.custom_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateless_rules_and_custom_actions.0.stateless_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkFirewallRuleGroup_tags(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkFirewallRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkFirewallRuleGroupConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkFirewallRuleGroupExists(resourceName string, ruleGroup *networkfirewall.DescribeRuleGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall Rule Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+		output, err := networkFirewallDescribeRuleGroup(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.RuleGroupResponse == nil {
+			return fmt.Errorf("NetworkFirewall Rule Group (%s) not found", rs.Primary.ID)
+		}
+
+		*ruleGroup = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkFirewallRuleGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkfirewall_rule_group" {
+			continue
+		}
+
+		output, err := networkFirewallDescribeRuleGroup(conn, rs.Primary.ID)
+
+		if isAWSErr(err, networkfirewall.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("NetworkFirewall Rule Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkFirewallRuleGroupConfigRulesSourceList(rName, target string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = [%q]
+      }
+    }
+  }
+}
+`, rName, target)
+}
+
+func testAccAWSNetworkFirewallRuleGroupConfigRules(rName, rules string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %q
+  type     = "STATEFUL"
+  rules    = "%s"
+}
+`, rName, rules)
+}
+
+func testAccAWSNetworkFirewallRuleGroupConfigStatefulRule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %q
+  type     = "STATEFUL"
+
+  rule_group {
+    rule_variables {
+      ip_sets {
+        key = "HOME_NET"
+
+        ip_set {
+          definition = ["10.0.0.0/16", "10.1.0.0/16"]
+        }
+      }
+    }
+
+    rules_source {
+      stateful_rule {
+        action = "PASS"
+
+        header {
+          destination      = "124.1.1.24/32"
+          destination_port = 53
+          direction        = "ANY"
+          protocol         = "IP"
+          source           = "1.2.3.4/32"
+          source_port      = 53
+        }
+
+        rule_option {
+          keyword = "sid:1"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallRuleGroupConfigStatelessRule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        custom_action {
+          action_name = "example"
+
+          action_definition {
+            publish_metric_action {
+              dimension {
+                value = "2"
+              }
+            }
+          }
+        }
+
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass", "example"]
+
+            match_attributes {
+              protocols = [6]
+
+              source {
+                address_definition = "1.2.3.4/32"
+              }
+
+              source_port {
+                from_port = 443
+                to_port   = 443
+              }
+
+              destination {
+                address_definition = "124.1.1.5/32"
+              }
+
+              destination_port {
+                from_port = 443
+                to_port   = 443
+              }
+
+              tcp_flag {
+                flags = ["SYN"]
+                masks = ["SYN", "ACK"]
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSNetworkFirewallRuleGroupConfigTags(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+
+  tags {
+    %s = %q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
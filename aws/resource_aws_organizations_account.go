@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsAccountCreate,
+		Read:   resourceAwsOrganizationsAccountRead,
+		Update: resourceAwsOrganizationsAccountUpdate,
+		Delete: resourceAwsOrganizationsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"close_on_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(6, 64),
+			},
+			"iam_user_access_to_billing": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					organizations.IAMUserAccessToBillingAllow,
+					organizations.IAMUserAccessToBillingDeny,
+				}, false),
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateAccountInput{
+		AccountName: aws.String(d.Get("name").(string)),
+		Email:       aws.String(d.Get("email").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_user_access_to_billing"); ok {
+		input.IamUserAccessToBilling = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapOrganizations(v)
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Account: %s", input)
+
+	var output *organizations.CreateAccountOutput
+	// Only one account creation request may be in progress at a time.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateAccount(input)
+
+		if isAWSErr(err, organizations.ErrCodeFinalizingOrganizationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, organizations.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Organizations Account: %s", err)
+	}
+
+	requestID := aws.StringValue(output.CreateAccountStatus.Id)
+
+	status, err := waitForOrganizationsAccountCreation(conn, requestID)
+	if err != nil {
+		return fmt.Errorf("error waiting for Organizations Account creation (%s): %s", requestID, err)
+	}
+
+	d.SetId(aws.StringValue(status.AccountId))
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		if err := moveOrganizationsAccount(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DescribeAccountInput{
+		AccountId: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeAccount(input)
+
+	if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+		log.Printf("[WARN] Organizations Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Account (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Account == nil {
+		return fmt.Errorf("error reading Organizations Account (%s): empty response", d.Id())
+	}
+
+	parentID, err := organizationsParentID(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Account (%s) parent: %s", d.Id(), err)
+	}
+
+	account := output.Account
+
+	d.Set("arn", account.Arn)
+	d.Set("email", account.Email)
+	d.Set("joined_method", account.JoinedMethod)
+	d.Set("name", account.Name)
+	d.Set("parent_id", parentID)
+	d.Set("status", account.Status)
+
+	if account.JoinedTimestamp != nil {
+		d.Set("joined_timestamp", aws.TimeValue(account.JoinedTimestamp).Format(time.RFC3339))
+	}
+
+	if err := getTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error reading Organizations Account (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("parent_id") {
+		if err := moveOrganizationsAccount(conn, d.Id(), d.Get("parent_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Organizations Account (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.Get("close_on_deletion").(bool) {
+		input := &organizations.CloseAccountInput{
+			AccountId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Closing Organizations Account (%s)", d.Id())
+		_, err := conn.CloseAccount(input)
+
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") ||
+			isAWSErr(err, organizations.ErrCodeAccountAlreadyClosedException, "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error closing Organizations Account (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForOrganizationsAccountClosure(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Organizations Account (%s) closure: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	input := &organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Removing Organizations Account (%s) from Organization", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(input)
+
+	if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Organizations Account (%s) from Organization: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func moveOrganizationsAccount(conn *organizations.Organizations, accountID, parentID string) error {
+	currentParentID, err := organizationsParentID(conn, accountID)
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Account (%s) parent: %s", accountID, err)
+	}
+
+	if currentParentID == parentID {
+		return nil
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountID),
+		DestinationParentId: aws.String(parentID),
+		SourceParentId:      aws.String(currentParentID),
+	}
+
+	log.Printf("[DEBUG] Moving Organizations Account (%s): %s", accountID, input)
+	if _, err := conn.MoveAccount(input); err != nil {
+		return fmt.Errorf("error moving Organizations Account (%s) to %s: %s", accountID, parentID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Account tests create real member accounts in an existing organization and
+// close them on destroy. Closed accounts remain in the organization in the
+// SUSPENDED state for 90 days and count towards the account closure quota.
+func testAccPreCheckAwsOrganizationsAccount(t *testing.T) {
+	if os.Getenv("TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN") == "" {
+		t.Skip("'TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN' not set, skipping test.")
+	}
+}
+
+func testAccAwsOrganizationsAccount_basic(t *testing.T) {
+	var account organizations.Account
+	rInt := acctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, os.Getenv("TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"))
+	resourceName := "aws_organizations_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsOrganizationsAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsAccountConfig(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_method"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"close_on_deletion"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_account" {
+			continue
+		}
+
+		input := &organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeAccount(input)
+
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Closed accounts are listed as SUSPENDED until they are removed
+		// from the organization.
+		if output != nil && output.Account != nil && aws.StringValue(output.Account.Status) != organizations.AccountStatusSuspended {
+			return fmt.Errorf("Organizations Account (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsAccountExists(n string, v *organizations.Account) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Organizations Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		input := &organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeAccount(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Account == nil {
+			return fmt.Errorf("Organizations Account (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output.Account
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsAccountConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  close_on_deletion = true
+}
+`, name, email)
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsDelegatedAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsDelegatedAdministratorCreate,
+		Read:   resourceAwsOrganizationsDelegatedAdministratorRead,
+		Delete: resourceAwsOrganizationsDelegatedAdministratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delegation_enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+	accountID := d.Get("account_id").(string)
+	servicePrincipal := d.Get("service_principal").(string)
+
+	input := &organizations.RegisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Registering Organizations Delegated Administrator: %s", input)
+	if _, err := conn.RegisterDelegatedAdministrator(input); err != nil {
+		return fmt.Errorf("error registering Organizations Delegated Administrator (%s/%s): %s", accountID, servicePrincipal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, servicePrincipal))
+
+	return resourceAwsOrganizationsDelegatedAdministratorRead(d, meta)
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeOrganizationsDelegatedAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	var delegatedAdministrator *organizations.DelegatedAdministrator
+
+	err = conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		for _, v := range page.DelegatedAdministrators {
+			if aws.StringValue(v.Id) == accountID {
+				delegatedAdministrator = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Delegated Administrator (%s): %s", d.Id(), err)
+	}
+
+	if delegatedAdministrator == nil {
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", delegatedAdministrator.Arn)
+	d.Set("email", delegatedAdministrator.Email)
+	d.Set("joined_method", delegatedAdministrator.JoinedMethod)
+	d.Set("name", delegatedAdministrator.Name)
+	d.Set("service_principal", servicePrincipal)
+	d.Set("status", delegatedAdministrator.Status)
+
+	if delegatedAdministrator.DelegationEnabledDate != nil {
+		d.Set("delegation_enabled_date", aws.TimeValue(delegatedAdministrator.DelegationEnabledDate).Format(time.RFC3339))
+	}
+
+	if delegatedAdministrator.JoinedTimestamp != nil {
+		d.Set("joined_timestamp", aws.TimeValue(delegatedAdministrator.JoinedTimestamp).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeOrganizationsDelegatedAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Deregistering Organizations Delegated Administrator (%s)", d.Id())
+	_, err = conn.DeregisterDelegatedAdministrator(input)
+
+	if isAWSErr(err, organizations.ErrCodeAccountNotRegisteredException, "") ||
+		isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Organizations Delegated Administrator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Delegated administrator tests require an existing organization with
+// trusted access enabled for the service principal and a member account to
+// register.
+func testAccPreCheckAwsOrganizationsDelegatedAdministrator(t *testing.T) {
+	if os.Getenv("TEST_AWS_ORGANIZATION_MEMBER_ACCOUNT_ID") == "" {
+		t.Skip("'TEST_AWS_ORGANIZATION_MEMBER_ACCOUNT_ID' not set, skipping test.")
+	}
+}
+
+func testAccAwsOrganizationsDelegatedAdministrator_basic(t *testing.T) {
+	accountID := os.Getenv("TEST_AWS_ORGANIZATION_MEMBER_ACCOUNT_ID")
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+	resourceName := "aws_organizations_delegated_administrator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsOrganizationsDelegatedAdministrator(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(accountID, servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "delegation_enabled_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_method"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", servicePrincipal),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_delegated_administrator" {
+			continue
+		}
+
+		found, err := testAccAwsOrganizationsDelegatedAdministratorFind(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Organizations Delegated Administrator ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		found, err := testAccAwsOrganizationsDelegatedAdministratorFind(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsDelegatedAdministratorFind(conn *organizations.Organizations, id string) (bool, error) {
+	accountID, servicePrincipal, err := decodeOrganizationsDelegatedAdministratorID(id)
+	if err != nil {
+		return false, err
+	}
+
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	var found bool
+
+	err = conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		for _, v := range page.DelegatedAdministrators {
+			if aws.StringValue(v.Id) == accountID {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAwsOrganizationsDelegatedAdministratorConfig(accountID, servicePrincipal string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = %[1]q
+  service_principal = %[2]q
+}
+`, accountID, servicePrincipal)
+}
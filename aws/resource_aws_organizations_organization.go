@@ -14,16 +14,63 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsOrganizationCreate,
 		Read:   resourceAwsOrganizationsOrganizationRead,
+		Update: resourceAwsOrganizationsOrganizationUpdate,
 		Delete: resourceAwsOrganizationsOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"aws_service_access_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled_policy_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						organizations.PolicyTypeAiservicesOptOutPolicy,
+						organizations.PolicyTypeBackupPolicy,
+						organizations.PolicyTypeServiceControlPolicy,
+						organizations.PolicyTypeTagPolicy,
+					}, false),
+				},
+			},
 			"master_account_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,6 +93,42 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 					organizations.OrganizationFeatureSetConsolidatedBilling,
 				}, true),
 			},
+			"roots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -66,6 +149,25 @@ func resourceAwsOrganizationsOrganizationCreate(d *schema.ResourceData, meta int
 	org := resp.Organization
 	d.SetId(*org.Id)
 
+	for _, principal := range d.Get("aws_service_access_principals").(*schema.Set).List() {
+		if err := enableOrganizationsServiceAccess(conn, principal.(string)); err != nil {
+			return err
+		}
+	}
+
+	if policyTypes := d.Get("enabled_policy_types").(*schema.Set).List(); len(policyTypes) > 0 {
+		rootID, err := organizationsRootID(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, policyType := range policyTypes {
+			if err := enableOrganizationsPolicyType(conn, rootID, policyType.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsOrganizationsOrganizationRead(d, meta)
 }
 
@@ -88,9 +190,122 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 	d.Set("master_account_arn", org.Organization.MasterAccountArn)
 	d.Set("master_account_email", org.Organization.MasterAccountEmail)
 	d.Set("master_account_id", org.Organization.MasterAccountId)
+
+	accounts, err := organizationsListAccounts(conn)
+	if err != nil {
+		return fmt.Errorf("error listing Organization accounts: %s", err)
+	}
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	roots, err := organizationsListRoots(conn)
+	if err != nil {
+		return fmt.Errorf("error listing Organization roots: %s", err)
+	}
+
+	if err := d.Set("roots", flattenOrganizationsRoots(roots)); err != nil {
+		return fmt.Errorf("error setting roots: %s", err)
+	}
+
+	var enabledPolicyTypes []string
+	for _, root := range roots {
+		for _, policyType := range root.PolicyTypes {
+			if aws.StringValue(policyType.Status) == organizations.PolicyTypeStatusEnabled {
+				enabledPolicyTypes = append(enabledPolicyTypes, aws.StringValue(policyType.Type))
+			}
+		}
+	}
+
+	if err := d.Set("enabled_policy_types", enabledPolicyTypes); err != nil {
+		return fmt.Errorf("error setting enabled_policy_types: %s", err)
+	}
+
+	// Service access is only available when all features are enabled.
+	var servicePrincipals []string
+	if aws.StringValue(org.Organization.FeatureSet) == organizations.OrganizationFeatureSetAll {
+		err := conn.ListAWSServiceAccessForOrganizationPages(&organizations.ListAWSServiceAccessForOrganizationInput{}, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+			for _, principal := range page.EnabledServicePrincipals {
+				servicePrincipals = append(servicePrincipals, aws.StringValue(principal.ServicePrincipal))
+			}
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Organization AWS service access: %s", err)
+		}
+	}
+
+	if err := d.Set("aws_service_access_principals", servicePrincipals); err != nil {
+		return fmt.Errorf("error setting aws_service_access_principals: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsOrganizationsOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("aws_service_access_principals") {
+		o, n := d.GetChange("aws_service_access_principals")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, principal := range os.Difference(ns).List() {
+			input := &organizations.DisableAWSServiceAccessInput{
+				ServicePrincipal: aws.String(principal.(string)),
+			}
+
+			log.Printf("[DEBUG] Disabling AWS service access for Organization: %s", input)
+			if _, err := conn.DisableAWSServiceAccess(input); err != nil {
+				return fmt.Errorf("error disabling AWS service access (%s) for Organization: %s", principal, err)
+			}
+		}
+
+		for _, principal := range ns.Difference(os).List() {
+			if err := enableOrganizationsServiceAccess(conn, principal.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("enabled_policy_types") {
+		rootID, err := organizationsRootID(conn)
+		if err != nil {
+			return err
+		}
+
+		o, n := d.GetChange("enabled_policy_types")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, policyType := range os.Difference(ns).List() {
+			input := &organizations.DisablePolicyTypeInput{
+				PolicyType: aws.String(policyType.(string)),
+				RootId:     aws.String(rootID),
+			}
+
+			log.Printf("[DEBUG] Disabling Organization policy type: %s", input)
+			if _, err := conn.DisablePolicyType(input); err != nil {
+				return fmt.Errorf("error disabling policy type (%s) in Organization Root (%s): %s", policyType, rootID, err)
+			}
+
+			if err := waitForOrganizationsPolicyTypeDisabled(conn, rootID, policyType.(string)); err != nil {
+				return fmt.Errorf("error waiting for policy type (%s) to be disabled in Organization Root (%s): %s", policyType, rootID, err)
+			}
+		}
+
+		for _, policyType := range ns.Difference(os).List() {
+			if err := enableOrganizationsPolicyType(conn, rootID, policyType.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationRead(d, meta)
+}
+
 func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
@@ -103,3 +318,79 @@ func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// organizationsRootID returns the identifier of the organization's root.
+func organizationsRootID(conn *organizations.Organizations) (string, error) {
+	roots, err := organizationsListRoots(conn)
+	if err != nil {
+		return "", fmt.Errorf("error listing Organization roots: %s", err)
+	}
+
+	if len(roots) == 0 || roots[0] == nil {
+		return "", fmt.Errorf("error listing Organization roots: no roots found")
+	}
+
+	return aws.StringValue(roots[0].Id), nil
+}
+
+func enableOrganizationsServiceAccess(conn *organizations.Organizations, principal string) error {
+	input := &organizations.EnableAWSServiceAccessInput{
+		ServicePrincipal: aws.String(principal),
+	}
+
+	log.Printf("[DEBUG] Enabling AWS service access for Organization: %s", input)
+	if _, err := conn.EnableAWSServiceAccess(input); err != nil {
+		return fmt.Errorf("error enabling AWS service access (%s) for Organization: %s", principal, err)
+	}
+
+	return nil
+}
+
+func enableOrganizationsPolicyType(conn *organizations.Organizations, rootID, policyType string) error {
+	input := &organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     aws.String(rootID),
+	}
+
+	log.Printf("[DEBUG] Enabling Organization policy type: %s", input)
+	if _, err := conn.EnablePolicyType(input); err != nil {
+		return fmt.Errorf("error enabling policy type (%s) in Organization Root (%s): %s", policyType, rootID, err)
+	}
+
+	if err := waitForOrganizationsPolicyTypeEnabled(conn, rootID, policyType); err != nil {
+		return fmt.Errorf("error waiting for policy type (%s) to be enabled in Organization Root (%s): %s", policyType, rootID, err)
+	}
+
+	return nil
+}
+
+func flattenOrganizationsRoots(roots []*organizations.Root) []interface{} {
+	l := make([]interface{}, 0, len(roots))
+
+	for _, root := range roots {
+		if root == nil {
+			continue
+		}
+
+		policyTypes := make([]interface{}, 0, len(root.PolicyTypes))
+		for _, policyType := range root.PolicyTypes {
+			if policyType == nil {
+				continue
+			}
+
+			policyTypes = append(policyTypes, map[string]interface{}{
+				"status": aws.StringValue(policyType.Status),
+				"type":   aws.StringValue(policyType.Type),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"arn":          aws.StringValue(root.Arn),
+			"id":           aws.StringValue(root.Id),
+			"name":         aws.StringValue(root.Name),
+			"policy_types": policyTypes,
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsOrganizationalUnitCreate,
+		Read:   resourceAwsOrganizationsOrganizationalUnitRead,
+		Update: resourceAwsOrganizationsOrganizationalUnitUpdate,
+		Delete: resourceAwsOrganizationsOrganizationalUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsOrganizationsOrganizationalUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(d.Get("name").(string)),
+		ParentId: aws.String(d.Get("parent_id").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapOrganizations(v)
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Organizational Unit: %s", input)
+	output, err := conn.CreateOrganizationalUnit(input)
+	if err != nil {
+		return fmt.Errorf("error creating Organizations Organizational Unit: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.OrganizationalUnit.Id))
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeOrganizationalUnit(input)
+
+	if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+		log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.OrganizationalUnit == nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s): empty response", d.Id())
+	}
+
+	parentID, err := organizationsParentID(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s) parent: %s", d.Id(), err)
+	}
+
+	accounts, err := organizationsListAccountsForParent(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Organizational Unit (%s) accounts: %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.OrganizationalUnit.Arn)
+	d.Set("name", output.OrganizationalUnit.Name)
+	d.Set("parent_id", parentID)
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	if err := getTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsOrganizationalUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("name") {
+		input := &organizations.UpdateOrganizationalUnitInput{
+			Name:                 aws.String(d.Get("name").(string)),
+			OrganizationalUnitId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Organizations Organizational Unit (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateOrganizationalUnit(input); err != nil {
+			return fmt.Errorf("error updating Organizations Organizational Unit (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Organizations Organizational Unit (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Organizations Organizational Unit (%s)", d.Id())
+	_, err := conn.DeleteOrganizationalUnit(input)
+
+	if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
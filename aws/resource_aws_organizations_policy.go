@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsPolicyCreate,
+		Read:   resourceAwsOrganizationsPolicyRead,
+		Update: resourceAwsOrganizationsPolicyUpdate,
+		Delete: resourceAwsOrganizationsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateJsonString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  organizations.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice([]string{
+					organizations.PolicyTypeAiservicesOptOutPolicy,
+					organizations.PolicyTypeBackupPolicy,
+					organizations.PolicyTypeServiceControlPolicy,
+					organizations.PolicyTypeTagPolicy,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+	name := d.Get("name").(string)
+
+	input := &organizations.CreatePolicyInput{
+		Content:     aws.String(d.Get("content").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(name),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapOrganizations(v)
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Policy: %s", input)
+	output, err := conn.CreatePolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating Organizations Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicySummary.Id))
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DescribePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribePolicy(input)
+
+	if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+		log.Printf("[WARN] Organizations Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil || output.Policy.PolicySummary == nil {
+		return fmt.Errorf("error reading Organizations Policy (%s): empty response", d.Id())
+	}
+
+	policySummary := output.Policy.PolicySummary
+
+	d.Set("arn", policySummary.Arn)
+	d.Set("content", output.Policy.Content)
+	d.Set("description", policySummary.Description)
+	d.Set("name", policySummary.Name)
+	d.Set("type", policySummary.Type)
+
+	if err := getTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error reading Organizations Policy (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("content") || d.HasChange("description") || d.HasChange("name") {
+		input := &organizations.UpdatePolicyInput{
+			Content:     aws.String(d.Get("content").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Get("name").(string)),
+			PolicyId:    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Organizations Policy (%s): %s", d.Id(), input)
+		if _, err := conn.UpdatePolicy(input); err != nil {
+			return fmt.Errorf("error updating Organizations Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsOrganizations(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Organizations Policy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DeletePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Organizations Policy (%s)", d.Id())
+	_, err := conn.DeletePolicy(input)
+
+	if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -7,9 +7,32 @@ import (
 func TestAccAWSOrganizations(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Organization": {
-			"basic":               testAccAwsOrganizationsOrganization_basic,
-			"importBasic":         testAccAwsOrganizationsOrganization_importBasic,
-			"consolidatedBilling": testAccAwsOrganizationsOrganization_consolidatedBilling,
+			"basic":                      testAccAwsOrganizationsOrganization_basic,
+			"importBasic":                testAccAwsOrganizationsOrganization_importBasic,
+			"consolidatedBilling":        testAccAwsOrganizationsOrganization_consolidatedBilling,
+			"AwsServiceAccessPrincipals": testAccAwsOrganizationsOrganization_AwsServiceAccessPrincipals,
+			"EnabledPolicyTypes":         testAccAwsOrganizationsOrganization_EnabledPolicyTypes,
+		},
+		"Account": {
+			"basic": testAccAwsOrganizationsAccount_basic,
+		},
+		"OrganizationalUnit": {
+			"basic": testAccAwsOrganizationsOrganizationalUnit_basic,
+			"Name":  testAccAwsOrganizationsOrganizationalUnit_Name,
+			"Tags":  testAccAwsOrganizationsOrganizationalUnit_Tags,
+		},
+		"Policy": {
+			"basic":       testAccAwsOrganizationsPolicy_basic,
+			"Description": testAccAwsOrganizationsPolicy_Description,
+			"Tags":        testAccAwsOrganizationsPolicy_Tags,
+			"Type_Tag":    testAccAwsOrganizationsPolicy_Type_Tag,
+		},
+		"PolicyAttachment": {
+			"OrganizationalUnit": testAccAwsOrganizationsPolicyAttachment_OrganizationalUnit,
+			"Root":               testAccAwsOrganizationsPolicyAttachment_Root,
+		},
+		"DelegatedAdministrator": {
+			"basic": testAccAwsOrganizationsDelegatedAdministrator_basic,
 		},
 	}
 
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointAPNSChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointAPNSChannelPut,
+		Read:   resourceAwsPinpointAPNSChannelRead,
+		Update: resourceAwsPinpointAPNSChannelPut,
+		Delete: resourceAwsPinpointAPNSChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bundle_id": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"certificate": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"default_authentication_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CERTIFICATE", "TOKEN"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"team_id": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_key_id": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointAPNSChannelPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	certificate, certificateOk := d.GetOk("certificate")
+	privateKey, privateKeyOk := d.GetOk("private_key")
+
+	bundleId, bundleIdOk := d.GetOk("bundle_id")
+	teamId, teamIdOk := d.GetOk("team_id")
+	tokenKey, tokenKeyOk := d.GetOk("token_key")
+	tokenKeyId, tokenKeyIdOk := d.GetOk("token_key_id")
+
+	// The channel authenticates either with a certificate and private key
+	// or with a token key; one full set of credentials is required.
+	if !(certificateOk && privateKeyOk) && !(bundleIdOk && teamIdOk && tokenKeyOk && tokenKeyIdOk) {
+		return fmt.Errorf("At least one set of credentials is required; either [certificate, private_key] or [bundle_id, team_id, token_key, token_key_id]")
+	}
+
+	applicationId := d.Get("application_id").(string)
+	request := &pinpoint.APNSChannelRequest{
+		Enabled: aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("default_authentication_method"); ok {
+		request.DefaultAuthenticationMethod = aws.String(v.(string))
+	}
+
+	if certificateOk && privateKeyOk {
+		request.Certificate = aws.String(certificate.(string))
+		request.PrivateKey = aws.String(privateKey.(string))
+	}
+
+	if bundleIdOk && teamIdOk && tokenKeyOk && tokenKeyIdOk {
+		request.BundleId = aws.String(bundleId.(string))
+		request.TeamId = aws.String(teamId.(string))
+		request.TokenKey = aws.String(tokenKey.(string))
+		request.TokenKeyId = aws.String(tokenKeyId.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint APNs Channel for application %s", applicationId)
+	_, err := conn.UpdateApnsChannel(&pinpoint.UpdateApnsChannelInput{
+		APNSChannelRequest: request,
+		ApplicationId:      aws.String(applicationId),
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Pinpoint APNs Channel for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(applicationId)
+
+	return resourceAwsPinpointAPNSChannelRead(d, meta)
+}
+
+func resourceAwsPinpointAPNSChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetApnsChannel(&pinpoint.GetApnsChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint APNs Channel for application %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint APNs Channel for application %s: %s", d.Id(), err)
+	}
+
+	// The credentials are write-only and are not returned by the API.
+	d.Set("application_id", output.APNSChannelResponse.ApplicationId)
+	d.Set("enabled", output.APNSChannelResponse.Enabled)
+
+	return nil
+}
+
+func resourceAwsPinpointAPNSChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint APNs Channel for application %s", d.Id())
+	_, err := conn.DeleteApnsChannel(&pinpoint.DeleteApnsChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint APNs Channel for application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccPreCheckAWSPinpointAPNSChannelCertificate(t *testing.T) {
+	if os.Getenv("APNS_CERTIFICATE") == "" || os.Getenv("APNS_PRIVATE_KEY") == "" {
+		t.Skip("Environment variables APNS_CERTIFICATE and APNS_PRIVATE_KEY are not set")
+	}
+}
+
+func TestAccAWSPinpointAPNSChannel_basicCertificate(t *testing.T) {
+	testAccPreCheckAWSPinpointAPNSChannelCertificate(t)
+
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_apns_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAPNSChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAPNSChannelConfig_certificate(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAPNSChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointAPNSChannelConfig_certificate(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAPNSChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointAPNSChannelExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint APNs Channel ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		_, err := conn.GetApnsChannel(&pinpoint.GetApnsChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointAPNSChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_apns_channel" {
+			continue
+		}
+
+		_, err := conn.GetApnsChannel(&pinpoint.GetApnsChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint APNs Channel %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointAPNSChannelConfig_certificate(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_apns_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  enabled        = %[2]t
+
+  certificate = %[3]q
+  private_key = %[4]q
+}
+`, rName, enabled, os.Getenv("APNS_CERTIFICATE"), os.Getenv("APNS_PRIVATE_KEY"))
+}
@@ -0,0 +1,369 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointAppCreate,
+		Read:   resourceAwsPinpointAppRead,
+		Update: resourceAwsPinpointAppUpdate,
+		Delete: resourceAwsPinpointAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Mode_Values(), false),
+						},
+						"web_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     pinpointCampaignLimitsResource(),
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"quiet_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     pinpointQuietTimeResource(),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// The campaign limits and quiet time blocks are shared by the application
+// settings and the campaigns themselves.
+func pinpointCampaignLimitsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"daily": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"maximum_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+			"messages_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(50, 20000),
+			},
+			"total": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+}
+
+func pinpointQuietTimeResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &pinpoint.CreateAppInput{
+		CreateApplicationRequest: &pinpoint.CreateApplicationRequest{
+			Name: aws.String(name),
+		},
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.CreateApplicationRequest.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint App: %s", input)
+	output, err := conn.CreateApp(input)
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationResponse.Id))
+
+	if err := resourceAwsPinpointAppUpdateSettings(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsPinpointAppRead(d, meta)
+}
+
+func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetApp(&pinpoint.GetAppInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint App (%s): %s", d.Id(), err)
+	}
+
+	app := output.ApplicationResponse
+	d.Set("application_id", app.Id)
+	d.Set("arn", app.Arn)
+	d.Set("name", app.Name)
+
+	if err := d.Set("tags", tagsToMapGeneric(app.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	settings, err := conn.GetApplicationSettings(&pinpoint.GetApplicationSettingsInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint App (%s) settings: %s", d.Id(), err)
+	}
+
+	if err := d.Set("campaign_hook", flattenPinpointCampaignHook(settings.ApplicationSettingsResource.CampaignHook)); err != nil {
+		return fmt.Errorf("error setting campaign_hook: %s", err)
+	}
+
+	if err := d.Set("limits", flattenPinpointCampaignLimits(settings.ApplicationSettingsResource.Limits)); err != nil {
+		return fmt.Errorf("error setting limits: %s", err)
+	}
+
+	if err := d.Set("quiet_time", flattenPinpointQuietTime(settings.ApplicationSettingsResource.QuietTime)); err != nil {
+		return fmt.Errorf("error setting quiet_time: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	if d.HasChange("campaign_hook") || d.HasChange("limits") || d.HasChange("quiet_time") {
+		if err := resourceAwsPinpointAppUpdateSettings(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsPinpoint(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Pinpoint App (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsPinpointAppRead(d, meta)
+}
+
+func resourceAwsPinpointAppDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint App: %s", d.Id())
+	_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint App (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointAppUpdateSettings(conn *pinpoint.Pinpoint, d *schema.ResourceData) error {
+	input := &pinpoint.UpdateApplicationSettingsInput{
+		ApplicationId: aws.String(d.Id()),
+		WriteApplicationSettingsRequest: &pinpoint.WriteApplicationSettingsRequest{
+			CampaignHook: expandPinpointCampaignHook(d.Get("campaign_hook").([]interface{})),
+			Limits:       expandPinpointCampaignLimits(d.Get("limits").([]interface{})),
+			QuietTime:    expandPinpointQuietTime(d.Get("quiet_time").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint App settings: %s", input)
+	if _, err := conn.UpdateApplicationSettings(input); err != nil {
+		return fmt.Errorf("error updating Pinpoint App (%s) settings: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPinpointCampaignHook(l []interface{}) *pinpoint.CampaignHook {
+	if len(l) == 0 || l[0] == nil {
+		return &pinpoint.CampaignHook{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	hook := &pinpoint.CampaignHook{}
+
+	if v, ok := m["lambda_function_name"].(string); ok && v != "" {
+		hook.LambdaFunctionName = aws.String(v)
+	}
+
+	if v, ok := m["mode"].(string); ok && v != "" {
+		hook.Mode = aws.String(v)
+	}
+
+	if v, ok := m["web_url"].(string); ok && v != "" {
+		hook.WebUrl = aws.String(v)
+	}
+
+	return hook
+}
+
+func flattenPinpointCampaignHook(hook *pinpoint.CampaignHook) []interface{} {
+	if hook == nil || (hook.LambdaFunctionName == nil && hook.Mode == nil && hook.WebUrl == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"lambda_function_name": aws.StringValue(hook.LambdaFunctionName),
+		"mode":                 aws.StringValue(hook.Mode),
+		"web_url":              aws.StringValue(hook.WebUrl),
+	}}
+}
+
+func expandPinpointCampaignLimits(l []interface{}) *pinpoint.CampaignLimits {
+	if len(l) == 0 || l[0] == nil {
+		return &pinpoint.CampaignLimits{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	limits := &pinpoint.CampaignLimits{}
+
+	if v, ok := m["daily"].(int); ok && v != 0 {
+		limits.Daily = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["maximum_duration"].(int); ok && v != 0 {
+		limits.MaximumDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["messages_per_second"].(int); ok && v != 0 {
+		limits.MessagesPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["total"].(int); ok && v != 0 {
+		limits.Total = aws.Int64(int64(v))
+	}
+
+	return limits
+}
+
+func flattenPinpointCampaignLimits(limits *pinpoint.CampaignLimits) []interface{} {
+	if limits == nil || (limits.Daily == nil && limits.MaximumDuration == nil && limits.MessagesPerSecond == nil && limits.Total == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"daily":               int(aws.Int64Value(limits.Daily)),
+		"maximum_duration":    int(aws.Int64Value(limits.MaximumDuration)),
+		"messages_per_second": int(aws.Int64Value(limits.MessagesPerSecond)),
+		"total":               int(aws.Int64Value(limits.Total)),
+	}}
+}
+
+func expandPinpointQuietTime(l []interface{}) *pinpoint.QuietTime {
+	if len(l) == 0 || l[0] == nil {
+		return &pinpoint.QuietTime{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	quietTime := &pinpoint.QuietTime{}
+
+	if v, ok := m["end"].(string); ok && v != "" {
+		quietTime.End = aws.String(v)
+	}
+
+	if v, ok := m["start"].(string); ok && v != "" {
+		quietTime.Start = aws.String(v)
+	}
+
+	return quietTime
+}
+
+func flattenPinpointQuietTime(quietTime *pinpoint.QuietTime) []interface{} {
+	if quietTime == nil || (quietTime.End == nil && quietTime.Start == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"end":   aws.StringValue(quietTime.End),
+		"start": aws.StringValue(quietTime.Start),
+	}}
+}
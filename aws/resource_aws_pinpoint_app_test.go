@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointApp_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointApp_settings(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAppConfig_settings(rName, 600, "00:00", "03:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.maximum_duration", "600"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.start", "00:00"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.end", "03:00"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointAppConfig_settings(rName, 900, "01:00", "05:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.maximum_duration", "900"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.start", "01:00"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.end", "05:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointApp_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAppConfig_tags(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointAppConfig_tags(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointAppExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint App ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		_, err := conn.GetApp(&pinpoint.GetAppInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointAppDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_app" {
+			continue
+		}
+
+		_, err := conn.GetApp(&pinpoint.GetAppInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint App %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSPinpointAppConfig_settings(rName string, maximumDuration int, quietTimeStart, quietTimeEnd string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+
+  limits {
+    maximum_duration = %[2]d
+  }
+
+  quiet_time {
+    start = %[3]q
+    end   = %[4]q
+  }
+}
+`, rName, maximumDuration, quietTimeStart, quietTimeEnd)
+}
+
+func testAccAWSPinpointAppConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+
+  tags {
+    key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,549 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointCampaign() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointCampaignCreate,
+		Read:   resourceAwsPinpointCampaignRead,
+		Update: resourceAwsPinpointCampaignUpdate,
+		Delete: resourceAwsPinpointCampaignDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"holdout_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"is_paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     pinpointCampaignLimitsResource(),
+			},
+			"message_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apns_message":    pinpointMessageSchema(),
+						"default_message": pinpointMessageSchema(),
+						"email_message": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"from_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"html_body": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"gcm_message": pinpointMessageSchema(),
+						"sms_message": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"message_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      pinpoint.MessageTypePromotional,
+										ValidateFunc: validation.StringInSlice(pinpoint.MessageType_Values(), false),
+									},
+									"sender_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateRFC3339TimeString,
+						},
+						"frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Frequency_Values(), false),
+						},
+						"is_local_time": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"quiet_time": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     pinpointQuietTimeResource(),
+						},
+						// Either an RFC 3339 timestamp or IMMEDIATE.
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func pinpointMessageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pinpoint.ActionOpenApp,
+					ValidateFunc: validation.StringInSlice(pinpoint.Action_Values(), false),
+				},
+				"body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointCampaignCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId := d.Get("application_id").(string)
+	request := expandPinpointWriteCampaignRequest(d)
+
+	if v, ok := d.GetOk("tags"); ok {
+		request.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Campaign: %s", request)
+	output, err := conn.CreateCampaign(&pinpoint.CreateCampaignInput{
+		ApplicationId:        aws.String(applicationId),
+		WriteCampaignRequest: request,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Campaign for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", applicationId, aws.StringValue(output.CampaignResponse.Id)))
+
+	return resourceAwsPinpointCampaignRead(d, meta)
+}
+
+func resourceAwsPinpointCampaignRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, campaignId, err := decodePinpointCampaignID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetCampaign(&pinpoint.GetCampaignInput{
+		ApplicationId: aws.String(applicationId),
+		CampaignId:    aws.String(campaignId),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Campaign (%s): %s", d.Id(), err)
+	}
+
+	campaign := output.CampaignResponse
+	d.Set("application_id", campaign.ApplicationId)
+	d.Set("arn", campaign.Arn)
+	d.Set("campaign_id", campaign.Id)
+	d.Set("description", campaign.Description)
+	d.Set("holdout_percent", campaign.HoldoutPercent)
+	d.Set("is_paused", campaign.IsPaused)
+	d.Set("name", campaign.Name)
+	d.Set("segment_id", campaign.SegmentId)
+	d.Set("segment_version", campaign.SegmentVersion)
+	d.Set("version", campaign.Version)
+
+	if campaign.State != nil {
+		d.Set("state", campaign.State.CampaignStatus)
+	}
+
+	if err := d.Set("limits", flattenPinpointCampaignLimits(campaign.Limits)); err != nil {
+		return fmt.Errorf("error setting limits: %s", err)
+	}
+
+	if err := d.Set("message_configuration", flattenPinpointMessageConfiguration(campaign.MessageConfiguration)); err != nil {
+		return fmt.Errorf("error setting message_configuration: %s", err)
+	}
+
+	if err := d.Set("schedule", flattenPinpointSchedule(campaign.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(campaign.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointCampaignUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, campaignId, err := decodePinpointCampaignID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("description") || d.HasChange("holdout_percent") || d.HasChange("is_paused") ||
+		d.HasChange("limits") || d.HasChange("message_configuration") || d.HasChange("name") ||
+		d.HasChange("schedule") || d.HasChange("segment_id") || d.HasChange("segment_version") {
+		request := expandPinpointWriteCampaignRequest(d)
+
+		log.Printf("[DEBUG] Updating Pinpoint Campaign (%s): %s", d.Id(), request)
+		_, err := conn.UpdateCampaign(&pinpoint.UpdateCampaignInput{
+			ApplicationId:        aws.String(applicationId),
+			CampaignId:           aws.String(campaignId),
+			WriteCampaignRequest: request,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Campaign (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsPinpoint(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Pinpoint Campaign (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsPinpointCampaignRead(d, meta)
+}
+
+func resourceAwsPinpointCampaignDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, campaignId, err := decodePinpointCampaignID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Campaign: %s", d.Id())
+	_, err = conn.DeleteCampaign(&pinpoint.DeleteCampaignInput{
+		ApplicationId: aws.String(applicationId),
+		CampaignId:    aws.String(campaignId),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Campaign (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodePinpointCampaignID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION_ID/CAMPAIGN_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandPinpointWriteCampaignRequest(d *schema.ResourceData) *pinpoint.WriteCampaignRequest {
+	request := &pinpoint.WriteCampaignRequest{
+		IsPaused:             aws.Bool(d.Get("is_paused").(bool)),
+		Limits:               expandPinpointCampaignLimits(d.Get("limits").([]interface{})),
+		MessageConfiguration: expandPinpointMessageConfiguration(d.Get("message_configuration").([]interface{})),
+		Name:                 aws.String(d.Get("name").(string)),
+		Schedule:             expandPinpointSchedule(d.Get("schedule").([]interface{})),
+		SegmentId:            aws.String(d.Get("segment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("holdout_percent"); ok {
+		request.HoldoutPercent = aws.Int64(int64(v.(int)))
+	}
+
+	// Changing the segment resets the version to the segment's latest.
+	if v, ok := d.GetOk("segment_version"); ok && !d.HasChange("segment_id") {
+		request.SegmentVersion = aws.Int64(int64(v.(int)))
+	}
+
+	return request
+}
+
+func expandPinpointSchedule(l []interface{}) *pinpoint.Schedule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	schedule := &pinpoint.Schedule{
+		Frequency:   aws.String(m["frequency"].(string)),
+		IsLocalTime: aws.Bool(m["is_local_time"].(bool)),
+		StartTime:   aws.String(m["start_time"].(string)),
+	}
+
+	if v, ok := m["end_time"].(string); ok && v != "" {
+		schedule.EndTime = aws.String(v)
+	}
+
+	if v, ok := m["quiet_time"].([]interface{}); ok && len(v) > 0 {
+		schedule.QuietTime = expandPinpointQuietTime(v)
+	}
+
+	if v, ok := m["timezone"].(string); ok && v != "" {
+		schedule.Timezone = aws.String(v)
+	}
+
+	return schedule
+}
+
+func flattenPinpointSchedule(schedule *pinpoint.Schedule) []interface{} {
+	if schedule == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"end_time":      aws.StringValue(schedule.EndTime),
+		"frequency":     aws.StringValue(schedule.Frequency),
+		"is_local_time": aws.BoolValue(schedule.IsLocalTime),
+		"quiet_time":    flattenPinpointQuietTime(schedule.QuietTime),
+		"start_time":    aws.StringValue(schedule.StartTime),
+		"timezone":      aws.StringValue(schedule.Timezone),
+	}}
+}
+
+func expandPinpointMessageConfiguration(l []interface{}) *pinpoint.MessageConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	configuration := &pinpoint.MessageConfiguration{
+		APNSMessage:    expandPinpointMessage(m["apns_message"].([]interface{})),
+		DefaultMessage: expandPinpointMessage(m["default_message"].([]interface{})),
+		GCMMessage:     expandPinpointMessage(m["gcm_message"].([]interface{})),
+	}
+
+	if v, ok := m["email_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		configuration.EmailMessage = &pinpoint.CampaignEmailMessage{
+			Title: aws.String(tfMap["title"].(string)),
+		}
+
+		if v, ok := tfMap["body"].(string); ok && v != "" {
+			configuration.EmailMessage.Body = aws.String(v)
+		}
+
+		if v, ok := tfMap["from_address"].(string); ok && v != "" {
+			configuration.EmailMessage.FromAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["html_body"].(string); ok && v != "" {
+			configuration.EmailMessage.HtmlBody = aws.String(v)
+		}
+	}
+
+	if v, ok := m["sms_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		configuration.SMSMessage = &pinpoint.CampaignSmsMessage{
+			Body:        aws.String(tfMap["body"].(string)),
+			MessageType: aws.String(tfMap["message_type"].(string)),
+		}
+
+		if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+			configuration.SMSMessage.SenderId = aws.String(v)
+		}
+	}
+
+	return configuration
+}
+
+func flattenPinpointMessageConfiguration(configuration *pinpoint.MessageConfiguration) []interface{} {
+	if configuration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"apns_message":    flattenPinpointMessage(configuration.APNSMessage),
+		"default_message": flattenPinpointMessage(configuration.DefaultMessage),
+		"gcm_message":     flattenPinpointMessage(configuration.GCMMessage),
+	}
+
+	if v := configuration.EmailMessage; v != nil {
+		m["email_message"] = []interface{}{map[string]interface{}{
+			"body":         aws.StringValue(v.Body),
+			"from_address": aws.StringValue(v.FromAddress),
+			"html_body":    aws.StringValue(v.HtmlBody),
+			"title":        aws.StringValue(v.Title),
+		}}
+	}
+
+	if v := configuration.SMSMessage; v != nil {
+		m["sms_message"] = []interface{}{map[string]interface{}{
+			"body":         aws.StringValue(v.Body),
+			"message_type": aws.StringValue(v.MessageType),
+			"sender_id":    aws.StringValue(v.SenderId),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func expandPinpointMessage(l []interface{}) *pinpoint.Message {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	message := &pinpoint.Message{
+		Action: aws.String(m["action"].(string)),
+	}
+
+	if v, ok := m["body"].(string); ok && v != "" {
+		message.Body = aws.String(v)
+	}
+
+	if v, ok := m["image_url"].(string); ok && v != "" {
+		message.ImageUrl = aws.String(v)
+	}
+
+	if v, ok := m["title"].(string); ok && v != "" {
+		message.Title = aws.String(v)
+	}
+
+	if v, ok := m["url"].(string); ok && v != "" {
+		message.Url = aws.String(v)
+	}
+
+	return message
+}
+
+func flattenPinpointMessage(message *pinpoint.Message) []interface{} {
+	if message == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"action":    aws.StringValue(message.Action),
+		"body":      aws.StringValue(message.Body),
+		"image_url": aws.StringValue(message.ImageUrl),
+		"title":     aws.StringValue(message.Title),
+		"url":       aws.StringValue(message.Url),
+	}}
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointCampaign_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	startTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_pinpoint_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointCampaignConfig_basic(rName, startTime, "title1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "campaign_id"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "false"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.email_message.0.title", "title1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "ONCE"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.start_time", startTime),
+					resource.TestCheckResourceAttrPair(resourceName, "segment_id", "aws_pinpoint_segment.test", "segment_id"),
+					resource.TestCheckResourceAttr(resourceName, "segment_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointCampaignConfig_basic(rName, startTime, "title2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.email_message.0.title", "title2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointCampaign_sms(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	startTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_pinpoint_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointCampaignConfig_sms(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "holdout_percent", "10"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily", "5"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.sms_message.0.message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.0.start", "22:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointCampaignExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Campaign ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		applicationId, campaignId, err := decodePinpointCampaignID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetCampaign(&pinpoint.GetCampaignInput{
+			ApplicationId: aws.String(applicationId),
+			CampaignId:    aws.String(campaignId),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointCampaignDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_campaign" {
+			continue
+		}
+
+		applicationId, campaignId, err := decodePinpointCampaignID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetCampaign(&pinpoint.GetCampaignInput{
+			ApplicationId: aws.String(applicationId),
+			CampaignId:    aws.String(campaignId),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Campaign %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointCampaignConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSPinpointCampaignConfig_basic(rName, startTime, title string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+  segment_id     = "${aws_pinpoint_segment.test.segment_id}"
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = %[2]q
+  }
+
+  message_configuration {
+    email_message {
+      title     = %[3]q
+      html_body = "<p>test</p>"
+    }
+  }
+}
+`, rName, startTime, title)
+}
+
+func testAccAWSPinpointCampaignConfig_sms(rName, startTime string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id  = "${aws_pinpoint_app.test.application_id}"
+  name            = %[1]q
+  segment_id      = "${aws_pinpoint_segment.test.segment_id}"
+  holdout_percent = 10
+  is_paused       = true
+
+  limits {
+    daily = 5
+  }
+
+  schedule {
+    frequency  = "DAILY"
+    start_time = %[2]q
+    timezone   = "UTC"
+
+    quiet_time {
+      start = "22:00"
+      end   = "06:00"
+    }
+  }
+
+  message_configuration {
+    sms_message {
+      body         = "test"
+      message_type = "TRANSACTIONAL"
+    }
+  }
+}
+`, rName, startTime)
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointEmailChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointEmailChannelPut,
+		Read:   resourceAwsPinpointEmailChannelRead,
+		Update: resourceAwsPinpointEmailChannelPut,
+		Delete: resourceAwsPinpointEmailChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"from_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"messages_per_second": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointEmailChannelPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId := d.Get("application_id").(string)
+	request := &pinpoint.EmailChannelRequest{
+		Enabled:     aws.Bool(d.Get("enabled").(bool)),
+		FromAddress: aws.String(d.Get("from_address").(string)),
+		Identity:    aws.String(d.Get("identity").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration_set"); ok {
+		request.ConfigurationSet = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		request.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint Email Channel for application %s: %s", applicationId, request)
+	_, err := conn.UpdateEmailChannel(&pinpoint.UpdateEmailChannelInput{
+		ApplicationId:       aws.String(applicationId),
+		EmailChannelRequest: request,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Pinpoint Email Channel for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(applicationId)
+
+	return resourceAwsPinpointEmailChannelRead(d, meta)
+}
+
+func resourceAwsPinpointEmailChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetEmailChannel(&pinpoint.GetEmailChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint Email Channel for application %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Email Channel for application %s: %s", d.Id(), err)
+	}
+
+	channel := output.EmailChannelResponse
+	d.Set("application_id", channel.ApplicationId)
+	d.Set("configuration_set", channel.ConfigurationSet)
+	d.Set("enabled", channel.Enabled)
+	d.Set("from_address", channel.FromAddress)
+	d.Set("identity", channel.Identity)
+	d.Set("messages_per_second", channel.MessagesPerSecond)
+	d.Set("role_arn", channel.RoleArn)
+
+	return nil
+}
+
+func resourceAwsPinpointEmailChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint Email Channel for application %s", d.Id())
+	_, err := conn.DeleteEmailChannel(&pinpoint.DeleteEmailChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Email Channel for application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointEmailChannel_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	domain := fmt.Sprintf("%s.example.com", rName)
+	resourceName := "aws_pinpoint_email_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointEmailChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointEmailChannelConfig_basic(rName, domain, "user1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointEmailChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "from_address", fmt.Sprintf("user1@%s", domain)),
+					resource.TestCheckResourceAttrPair(resourceName, "identity", "aws_ses_domain_identity.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "messages_per_second"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointEmailChannelConfig_basic(rName, domain, "user2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointEmailChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "from_address", fmt.Sprintf("user2@%s", domain)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointEmailChannelExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Email Channel ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		_, err := conn.GetEmailChannel(&pinpoint.GetEmailChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointEmailChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_email_channel" {
+			continue
+		}
+
+		_, err := conn.GetEmailChannel(&pinpoint.GetEmailChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Email Channel %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointEmailChannelConfig_basic(rName, domain, user string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = %[2]q
+}
+
+resource "aws_pinpoint_email_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  from_address   = "%[3]s@%[2]s"
+  identity       = "${aws_ses_domain_identity.test.arn}"
+}
+`, rName, domain, user)
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointGCMChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointGCMChannelPut,
+		Read:   resourceAwsPinpointGCMChannelRead,
+		Update: resourceAwsPinpointGCMChannelPut,
+		Delete: resourceAwsPinpointGCMChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"service_json"},
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_authentication_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"KEY", "TOKEN"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"service_json": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"api_key"},
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointGCMChannelPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId := d.Get("application_id").(string)
+	request := &pinpoint.GCMChannelRequest{
+		Enabled: aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("api_key"); ok {
+		request.ApiKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_authentication_method"); ok {
+		request.DefaultAuthenticationMethod = aws.String(v.(string))
+	}
+
+	// FCM HTTP v1 credentials are sent as a service account JSON document.
+	if v, ok := d.GetOk("service_json"); ok {
+		request.ServiceJson = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint GCM Channel for application %s", applicationId)
+	_, err := conn.UpdateGcmChannel(&pinpoint.UpdateGcmChannelInput{
+		ApplicationId:     aws.String(applicationId),
+		GCMChannelRequest: request,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Pinpoint GCM Channel for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(applicationId)
+
+	return resourceAwsPinpointGCMChannelRead(d, meta)
+}
+
+func resourceAwsPinpointGCMChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetGcmChannel(&pinpoint.GetGcmChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint GCM Channel for application %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint GCM Channel for application %s: %s", d.Id(), err)
+	}
+
+	// The credentials are write-only and are not returned by the API.
+	d.Set("application_id", output.GCMChannelResponse.ApplicationId)
+	d.Set("default_authentication_method", output.GCMChannelResponse.DefaultAuthenticationMethod)
+	d.Set("enabled", output.GCMChannelResponse.Enabled)
+
+	return nil
+}
+
+func resourceAwsPinpointGCMChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint GCM Channel for application %s", d.Id())
+	_, err := conn.DeleteGcmChannel(&pinpoint.DeleteGcmChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint GCM Channel for application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointGCMChannel_basic(t *testing.T) {
+	if os.Getenv("GCM_API_KEY") == "" {
+		t.Skip("Environment variable GCM_API_KEY is not set")
+	}
+
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_gcm_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointGCMChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointGCMChannelConfig_basic(rName, os.Getenv("GCM_API_KEY"), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointGCMChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointGCMChannelConfig_basic(rName, os.Getenv("GCM_API_KEY"), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointGCMChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointGCMChannelExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint GCM Channel ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		_, err := conn.GetGcmChannel(&pinpoint.GetGcmChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointGCMChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_gcm_channel" {
+			continue
+		}
+
+		_, err := conn.GetGcmChannel(&pinpoint.GetGcmChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint GCM Channel %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointGCMChannelConfig_basic(rName, apiKey string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_gcm_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  api_key        = %[2]q
+  enabled        = %[3]t
+}
+`, rName, apiKey, enabled)
+}
@@ -0,0 +1,500 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointSegment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointSegmentCreate,
+		Read:   resourceAwsPinpointSegmentRead,
+		Update: resourceAwsPinpointSegmentUpdate,
+		Delete: resourceAwsPinpointSegmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": pinpointAttributeDimensionSchema(),
+						"behavior": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"recency": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(pinpoint.Duration_Values(), false),
+												},
+												"recency_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(pinpoint.RecencyType_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"demographic": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_version": pinpointSetDimensionSchema(),
+									"channel":     pinpointSetDimensionSchema(),
+									"device_type": pinpointSetDimensionSchema(),
+									"make":        pinpointSetDimensionSchema(),
+									"model":       pinpointSetDimensionSchema(),
+									"platform":    pinpointSetDimensionSchema(),
+								},
+							},
+						},
+						"location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"country": pinpointSetDimensionSchema(),
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"GREATER_THAN",
+											"LESS_THAN",
+											"GREATER_THAN_OR_EQUAL",
+											"LESS_THAN_OR_EQUAL",
+											"EQUAL",
+										}, false),
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+						"user_attribute": pinpointAttributeDimensionSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func pinpointAttributeDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pinpoint.AttributeTypeInclusive,
+					ValidateFunc: validation.StringInSlice(pinpoint.AttributeType_Values(), false),
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func pinpointSetDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dimension_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pinpoint.DimensionTypeInclusive,
+					ValidateFunc: validation.StringInSlice(pinpoint.DimensionType_Values(), false),
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointSegmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId := d.Get("application_id").(string)
+	request := &pinpoint.WriteSegmentRequest{
+		Dimensions: expandPinpointSegmentDimensions(d.Get("dimensions").([]interface{})),
+		Name:       aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		request.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Segment: %s", request)
+	output, err := conn.CreateSegment(&pinpoint.CreateSegmentInput{
+		ApplicationId:       aws.String(applicationId),
+		WriteSegmentRequest: request,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Segment for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", applicationId, aws.StringValue(output.SegmentResponse.Id)))
+
+	return resourceAwsPinpointSegmentRead(d, meta)
+}
+
+func resourceAwsPinpointSegmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, segmentId, err := decodePinpointSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetSegment(&pinpoint.GetSegmentInput{
+		ApplicationId: aws.String(applicationId),
+		SegmentId:     aws.String(segmentId),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint Segment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	segment := output.SegmentResponse
+	d.Set("application_id", segment.ApplicationId)
+	d.Set("arn", segment.Arn)
+	d.Set("name", segment.Name)
+	d.Set("segment_id", segment.Id)
+	d.Set("segment_type", segment.SegmentType)
+	d.Set("version", segment.Version)
+
+	if err := d.Set("dimensions", flattenPinpointSegmentDimensions(segment.Dimensions)); err != nil {
+		return fmt.Errorf("error setting dimensions: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(segment.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointSegmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, segmentId, err := decodePinpointSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("dimensions") || d.HasChange("name") {
+		request := &pinpoint.WriteSegmentRequest{
+			Dimensions: expandPinpointSegmentDimensions(d.Get("dimensions").([]interface{})),
+			Name:       aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Segment (%s): %s", d.Id(), request)
+		_, err := conn.UpdateSegment(&pinpoint.UpdateSegmentInput{
+			ApplicationId:       aws.String(applicationId),
+			SegmentId:           aws.String(segmentId),
+			WriteSegmentRequest: request,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Segment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsPinpoint(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Pinpoint Segment (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsPinpointSegmentRead(d, meta)
+}
+
+func resourceAwsPinpointSegmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId, segmentId, err := decodePinpointSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Segment: %s", d.Id())
+	_, err = conn.DeleteSegment(&pinpoint.DeleteSegmentInput{
+		ApplicationId: aws.String(applicationId),
+		SegmentId:     aws.String(segmentId),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodePinpointSegmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION_ID/SEGMENT_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandPinpointSegmentDimensions(l []interface{}) *pinpoint.SegmentDimensions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	dimensions := &pinpoint.SegmentDimensions{
+		Attributes:     expandPinpointAttributeDimensions(m["attribute"].(*schema.Set).List()),
+		UserAttributes: expandPinpointAttributeDimensions(m["user_attribute"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["behavior"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		behavior := v[0].(map[string]interface{})
+		recency := behavior["recency"].([]interface{})[0].(map[string]interface{})
+
+		dimensions.Behavior = &pinpoint.SegmentBehaviors{
+			Recency: &pinpoint.RecencyDimension{
+				Duration:    aws.String(recency["duration"].(string)),
+				RecencyType: aws.String(recency["recency_type"].(string)),
+			},
+		}
+	}
+
+	if v, ok := m["demographic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		demographic := v[0].(map[string]interface{})
+
+		dimensions.Demographic = &pinpoint.SegmentDemographics{
+			AppVersion: expandPinpointSetDimension(demographic["app_version"].([]interface{})),
+			Channel:    expandPinpointSetDimension(demographic["channel"].([]interface{})),
+			DeviceType: expandPinpointSetDimension(demographic["device_type"].([]interface{})),
+			Make:       expandPinpointSetDimension(demographic["make"].([]interface{})),
+			Model:      expandPinpointSetDimension(demographic["model"].([]interface{})),
+			Platform:   expandPinpointSetDimension(demographic["platform"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+
+		dimensions.Location = &pinpoint.SegmentLocation{
+			Country: expandPinpointSetDimension(location["country"].([]interface{})),
+		}
+	}
+
+	if v := m["metric"].(*schema.Set).List(); len(v) > 0 {
+		dimensions.Metrics = make(map[string]*pinpoint.MetricDimension, len(v))
+
+		for _, metricRaw := range v {
+			metric := metricRaw.(map[string]interface{})
+			dimensions.Metrics[metric["name"].(string)] = &pinpoint.MetricDimension{
+				ComparisonOperator: aws.String(metric["comparison_operator"].(string)),
+				Value:              aws.Float64(metric["value"].(float64)),
+			}
+		}
+	}
+
+	return dimensions
+}
+
+func flattenPinpointSegmentDimensions(dimensions *pinpoint.SegmentDimensions) []interface{} {
+	if dimensions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"attribute":      flattenPinpointAttributeDimensions(dimensions.Attributes),
+		"user_attribute": flattenPinpointAttributeDimensions(dimensions.UserAttributes),
+	}
+
+	if v := dimensions.Behavior; v != nil && v.Recency != nil {
+		m["behavior"] = []interface{}{map[string]interface{}{
+			"recency": []interface{}{map[string]interface{}{
+				"duration":     aws.StringValue(v.Recency.Duration),
+				"recency_type": aws.StringValue(v.Recency.RecencyType),
+			}},
+		}}
+	}
+
+	if v := dimensions.Demographic; v != nil {
+		m["demographic"] = []interface{}{map[string]interface{}{
+			"app_version": flattenPinpointSetDimension(v.AppVersion),
+			"channel":     flattenPinpointSetDimension(v.Channel),
+			"device_type": flattenPinpointSetDimension(v.DeviceType),
+			"make":        flattenPinpointSetDimension(v.Make),
+			"model":       flattenPinpointSetDimension(v.Model),
+			"platform":    flattenPinpointSetDimension(v.Platform),
+		}}
+	}
+
+	if v := dimensions.Location; v != nil && v.Country != nil {
+		m["location"] = []interface{}{map[string]interface{}{
+			"country": flattenPinpointSetDimension(v.Country),
+		}}
+	}
+
+	metrics := make([]interface{}, 0, len(dimensions.Metrics))
+	for name, metric := range dimensions.Metrics {
+		if metric == nil {
+			continue
+		}
+
+		metrics = append(metrics, map[string]interface{}{
+			"comparison_operator": aws.StringValue(metric.ComparisonOperator),
+			"name":                name,
+			"value":               aws.Float64Value(metric.Value),
+		})
+	}
+	m["metric"] = metrics
+
+	return []interface{}{m}
+}
+
+func expandPinpointAttributeDimensions(l []interface{}) map[string]*pinpoint.AttributeDimension {
+	if len(l) == 0 {
+		return nil
+	}
+
+	attributes := make(map[string]*pinpoint.AttributeDimension, len(l))
+
+	for _, attributeRaw := range l {
+		attribute := attributeRaw.(map[string]interface{})
+		attributes[attribute["name"].(string)] = &pinpoint.AttributeDimension{
+			AttributeType: aws.String(attribute["attribute_type"].(string)),
+			Values:        expandStringList(attribute["values"].([]interface{})),
+		}
+	}
+
+	return attributes
+}
+
+func flattenPinpointAttributeDimensions(attributes map[string]*pinpoint.AttributeDimension) []interface{} {
+	l := make([]interface{}, 0, len(attributes))
+
+	for name, attribute := range attributes {
+		if attribute == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"attribute_type": aws.StringValue(attribute.AttributeType),
+			"name":           name,
+			"values":         flattenStringList(attribute.Values),
+		})
+	}
+
+	return l
+}
+
+func expandPinpointSetDimension(l []interface{}) *pinpoint.SetDimension {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &pinpoint.SetDimension{
+		DimensionType: aws.String(m["dimension_type"].(string)),
+		Values:        expandStringList(m["values"].([]interface{})),
+	}
+}
+
+func flattenPinpointSetDimension(dimension *pinpoint.SetDimension) []interface{} {
+	if dimension == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dimension_type": aws.StringValue(dimension.DimensionType),
+		"values":         flattenStringList(dimension.Values),
+	}}
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointSegment_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "segment_id"),
+					resource.TestCheckResourceAttr(resourceName, "segment_type", "DIMENSIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointSegment_dimensions(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfig_dimensions(rName, "DAY_7", "ios"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.duration", "DAY_7"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.recency_type", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.0.values.0", "ios"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.location.0.country.0.dimension_type", "EXCLUSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.user_attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointSegmentConfig_dimensions(rName, "DAY_30", "android"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.duration", "DAY_30"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.0.values.0", "android"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointSegmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Segment ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		applicationId, segmentId, err := decodePinpointSegmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetSegment(&pinpoint.GetSegmentInput{
+			ApplicationId: aws.String(applicationId),
+			SegmentId:     aws.String(segmentId),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSPinpointSegmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_segment" {
+			continue
+		}
+
+		applicationId, segmentId, err := decodePinpointSegmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetSegment(&pinpoint.GetSegmentInput{
+			ApplicationId: aws.String(applicationId),
+			SegmentId:     aws.String(segmentId),
+		})
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Segment %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointSegmentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSPinpointSegmentConfig_dimensions(rName, duration, platform string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+
+  dimensions {
+    behavior {
+      recency {
+        duration     = %[2]q
+        recency_type = "ACTIVE"
+      }
+    }
+
+    demographic {
+      platform {
+        values = [%[3]q]
+      }
+    }
+
+    location {
+      country {
+        dimension_type = "EXCLUSIVE"
+        values         = ["US"]
+      }
+    }
+
+    metric {
+      name                = "purchases"
+      comparison_operator = "GREATER_THAN"
+      value               = 3
+    }
+
+    user_attribute {
+      name   = "plan"
+      values = ["premium"]
+    }
+  }
+}
+`, rName, duration, platform)
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointSMSChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointSMSChannelPut,
+		Read:   resourceAwsPinpointSMSChannelRead,
+		Update: resourceAwsPinpointSMSChannelPut,
+		Delete: resourceAwsPinpointSMSChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"promotional_messages_per_second": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sender_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"short_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"transactional_messages_per_second": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointSMSChannelPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationId := d.Get("application_id").(string)
+	request := &pinpoint.SMSChannelRequest{
+		Enabled: aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("sender_id"); ok {
+		request.SenderId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("short_code"); ok {
+		request.ShortCode = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint SMS Channel for application %s: %s", applicationId, request)
+	_, err := conn.UpdateSmsChannel(&pinpoint.UpdateSmsChannelInput{
+		ApplicationId:     aws.String(applicationId),
+		SMSChannelRequest: request,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Pinpoint SMS Channel for application %s: %s", applicationId, err)
+	}
+
+	d.SetId(applicationId)
+
+	return resourceAwsPinpointSMSChannelRead(d, meta)
+}
+
+func resourceAwsPinpointSMSChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetSmsChannel(&pinpoint.GetSmsChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint SMS Channel for application %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint SMS Channel for application %s: %s", d.Id(), err)
+	}
+
+	channel := output.SMSChannelResponse
+	d.Set("application_id", channel.ApplicationId)
+	d.Set("enabled", channel.Enabled)
+	d.Set("promotional_messages_per_second", channel.PromotionalMessagesPerSecond)
+	d.Set("sender_id", channel.SenderId)
+	d.Set("short_code", channel.ShortCode)
+	d.Set("transactional_messages_per_second", channel.TransactionalMessagesPerSecond)
+
+	return nil
+}
+
+func resourceAwsPinpointSMSChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint SMS Channel for application %s", d.Id())
+	_, err := conn.DeleteSmsChannel(&pinpoint.DeleteSmsChannelInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint SMS Channel for application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
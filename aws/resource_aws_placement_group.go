@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsPlacementGroup() *schema.Resource {
@@ -21,16 +22,37 @@ func resourceAwsPlacementGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsPlacementGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
-			"strategy": &schema.Schema{
+			"partition_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+			"placement_group_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Computed: true,
+			},
+			"spread_level": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SpreadLevel_Values(), false),
+			},
+			"strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.PlacementStrategy_Values(), false),
 			},
 		},
 	}
@@ -44,6 +66,15 @@ func resourceAwsPlacementGroupCreate(d *schema.ResourceData, meta interface{}) e
 		GroupName: aws.String(name),
 		Strategy:  aws.String(d.Get("strategy").(string)),
 	}
+
+	if v, ok := d.GetOk("partition_count"); ok {
+		input.PartitionCount = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("spread_level"); ok {
+		input.SpreadLevel = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Placement group: %s", input)
 	_, err := conn.CreatePlacementGroup(&input)
 	if err != nil {
@@ -99,11 +130,39 @@ func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Received EC2 Placement Group: %s", pg)
 
 	d.Set("name", pg.GroupName)
+	d.Set("partition_count", pg.PartitionCount)
+	d.Set("placement_group_id", pg.GroupId)
+	d.Set("spread_level", pg.SpreadLevel)
 	d.Set("strategy", pg.Strategy)
 
 	return nil
 }
 
+// resourceAwsPlacementGroupCustomizeDiff rejects arguments that do not apply
+// to the configured placement strategy.
+func resourceAwsPlacementGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// Both arguments are computed, so for existing placement groups only
+	// values in the diff come from the configuration rather than from state.
+	isSet := func(k string) bool {
+		if _, ok := diff.GetOk(k); !ok {
+			return false
+		}
+		return diff.Id() == "" || len(diff.GetChangedKeysPrefix(k)) > 0
+	}
+
+	strategy := diff.Get("strategy").(string)
+
+	if isSet("partition_count") && strategy != ec2.PlacementStrategyPartition {
+		return fmt.Errorf("partition_count can only be set when strategy is %q", ec2.PlacementStrategyPartition)
+	}
+
+	if isSet("spread_level") && strategy != ec2.PlacementStrategySpread {
+		return fmt.Errorf("spread_level can only be set when strategy is %q", ec2.PlacementStrategySpread)
+	}
+
+	return nil
+}
+
 func resourceAwsPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
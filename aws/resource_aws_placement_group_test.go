@@ -2,8 +2,10 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -28,6 +30,76 @@ func TestAccAWSPlacementGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSPlacementGroup_PartitionCount(t *testing.T) {
+	resourceName := "aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPlacementGroupConfigPartitionCount(rName, "cluster", 2),
+				ExpectError: regexp.MustCompile(`partition_count can only be set when strategy is "partition"`),
+			},
+			{
+				Config: testAccAWSPlacementGroupConfigPartitionCount(rName, "partition", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPlacementGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "placement_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "partition"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAWSPlacementGroupConfigPartitionCount(rName, "cluster", 2),
+				ExpectError: regexp.MustCompile(`partition_count can only be set when strategy is "partition"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSPlacementGroup_SpreadLevel(t *testing.T) {
+	resourceName := "aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPlacementGroupConfigSpreadLevel(rName, "partition", "rack"),
+				ExpectError: regexp.MustCompile(`spread_level can only be set when strategy is "spread"`),
+			},
+			{
+				Config: testAccAWSPlacementGroupConfigSpreadLevel(rName, "spread", "rack"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPlacementGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spread_level", "rack"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "spread"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAWSPlacementGroupConfigSpreadLevel(rName, "partition", "rack"),
+				ExpectError: regexp.MustCompile(`spread_level can only be set when strategy is "spread"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSPlacementGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -104,3 +176,23 @@ resource "aws_placement_group" "pg" {
 	strategy = "cluster"
 }
 `
+
+func testAccAWSPlacementGroupConfigPartitionCount(rName, strategy string, partitionCount int) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name            = %[1]q
+  partition_count = %[3]d
+  strategy        = %[2]q
+}
+`, rName, strategy, partitionCount)
+}
+
+func testAccAWSPlacementGroupConfigSpreadLevel(rName, strategy, spreadLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name         = %[1]q
+  spread_level = %[3]q
+  strategy     = %[2]q
+}
+`, rName, strategy, spreadLevel)
+}
@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const qldbLedgerAwsOwnedKmsKey = "AWS_OWNED_KMS_KEY"
+
+func resourceAwsQLDBLedger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQLDBLedgerCreate,
+		Read:   resourceAwsQLDBLedgerRead,
+		Update: resourceAwsQLDBLedgerUpdate,
+		Delete: resourceAwsQLDBLedgerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"kms_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`), "must be 1-32 alphanumeric characters, underscores or hyphens"),
+			},
+			"permissions_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(qldb.PermissionsMode_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	input := &qldb.CreateLedgerInput{
+		DeletionProtection: aws.Bool(d.Get("deletion_protection").(bool)),
+		Name:               aws.String(d.Get("name").(string)),
+		PermissionsMode:    aws.String(d.Get("permissions_mode").(string)),
+		Tags:               tagsFromMapGeneric(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KmsKey = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating QLDB Ledger: %s", input)
+	output, err := conn.CreateLedger(input)
+	if err != nil {
+		return fmt.Errorf("error creating QLDB Ledger: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Name))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.LedgerStateCreating},
+		Target:     []string{qldb.LedgerStateActive},
+		Refresh:    resourceAwsQLDBLedgerStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for QLDB Ledger (%s) to be active: %s", d.Id(), err)
+	}
+
+	return resourceAwsQLDBLedgerRead(d, meta)
+}
+
+func resourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	ledger, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] QLDB Ledger (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading QLDB Ledger (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", ledger.Arn)
+	d.Set("deletion_protection", ledger.DeletionProtection)
+	d.Set("name", ledger.Name)
+	d.Set("permissions_mode", ledger.PermissionsMode)
+
+	if ledger.EncryptionDescription != nil {
+		d.Set("kms_key", ledger.EncryptionDescription.KmsKeyArn)
+	} else {
+		d.Set("kms_key", qldbLedgerAwsOwnedKmsKey)
+	}
+
+	tagsOut, err := conn.ListTagsForResource(&qldb.ListTagsForResourceInput{
+		ResourceArn: ledger.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for QLDB Ledger (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapGeneric(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	if d.HasChange("permissions_mode") {
+		input := &qldb.UpdateLedgerPermissionsModeInput{
+			Name:            aws.String(d.Id()),
+			PermissionsMode: aws.String(d.Get("permissions_mode").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating QLDB Ledger permissions mode: %s", input)
+		if _, err := conn.UpdateLedgerPermissionsMode(input); err != nil {
+			return fmt.Errorf("error updating QLDB Ledger (%s) permissions mode: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("deletion_protection") || d.HasChange("kms_key") {
+		input := &qldb.UpdateLedgerInput{
+			DeletionProtection: aws.Bool(d.Get("deletion_protection").(bool)),
+			Name:               aws.String(d.Id()),
+		}
+
+		if d.HasChange("kms_key") {
+			input.KmsKey = aws.String(d.Get("kms_key").(string))
+		}
+
+		log.Printf("[DEBUG] Updating QLDB Ledger: %s", input)
+		if _, err := conn.UpdateLedger(input); err != nil {
+			return fmt.Errorf("error updating QLDB Ledger (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsQLDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating QLDB Ledger (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQLDBLedgerRead(d, meta)
+}
+
+func resourceAwsQLDBLedgerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	input := &qldb.DeleteLedgerInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting QLDB Ledger (%s)", d.Id())
+	// Journal streams are cancelled by their own resources, which may still be in progress
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteLedger(input)
+		if err != nil {
+			if isAWSErr(err, qldb.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting QLDB Ledger (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.LedgerStateActive, qldb.LedgerStateDeleting},
+		Target:     []string{qldb.LedgerStateDeleted},
+		Refresh:    resourceAwsQLDBLedgerStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for QLDB Ledger (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsQLDBLedgerStateRefreshFunc(conn *qldb.QLDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ledger, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
+			Name: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+				return 42, qldb.LedgerStateDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		return ledger, aws.StringValue(ledger.State), nil
+	}
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSQLDBLedger_basic(t *testing.T) {
+	var ledger qldb.DescribeLedgerOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_ledger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBLedgerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBLedgerConfig(rName, "ALLOW_ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:qldb:[^:]+:\d{12}:ledger/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permissions_mode", "ALLOW_ALL"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBLedger_permissionsMode(t *testing.T) {
+	var ledger qldb.DescribeLedgerOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_ledger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBLedgerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBLedgerConfig(rName, "ALLOW_ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "permissions_mode", "ALLOW_ALL"),
+				),
+			},
+			{
+				Config: testAccAWSQLDBLedgerConfig(rName, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "permissions_mode", "STANDARD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBLedger_deletionProtection(t *testing.T) {
+	var ledger qldb.DescribeLedgerOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_ledger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBLedgerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBLedgerConfigDeletionProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config: testAccAWSQLDBLedgerConfigDeletionProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBLedger_kmsKey(t *testing.T) {
+	var ledger qldb.DescribeLedgerOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_ledger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBLedgerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBLedgerConfigKmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQLDBLedgerConfigKmsKeyAwsOwned(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "kms_key", "AWS_OWNED_KMS_KEY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBLedger_tags(t *testing.T) {
+	var ledger qldb.DescribeLedgerOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_ledger.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBLedgerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBLedgerConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSQLDBLedgerConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBLedgerExists(resourceName, &ledger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQLDBLedgerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).qldbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_qldb_ledger" {
+			continue
+		}
+
+		output, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.State) != qldb.LedgerStateDeleted {
+			return fmt.Errorf("QLDB Ledger (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQLDBLedgerExists(n string, v *qldb.DescribeLedgerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QLDB Ledger ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).qldbconn
+
+		output, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSQLDBLedgerConfig(rName, permissionsMode string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = %[2]q
+  deletion_protection = false
+}
+`, rName, permissionsMode)
+}
+
+func testAccAWSQLDBLedgerConfigDeletionProtection(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "STANDARD"
+  deletion_protection = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccAWSQLDBLedgerConfigKmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "STANDARD"
+  deletion_protection = false
+  kms_key             = "${aws_kms_key.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSQLDBLedgerConfigKmsKeyAwsOwned(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "STANDARD"
+  deletion_protection = false
+  kms_key             = "AWS_OWNED_KMS_KEY"
+}
+`, rName)
+}
+
+func testAccAWSQLDBLedgerConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "STANDARD"
+  deletion_protection = false
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
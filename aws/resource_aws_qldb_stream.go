@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsQLDBStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQLDBStreamCreate,
+		Read:   resourceAwsQLDBStreamRead,
+		Update: resourceAwsQLDBStreamUpdate,
+		Delete: resourceAwsQLDBStreamDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclusive_end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"inclusive_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"kinesis_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregation_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"ledger_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"stream_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`), "must be 1-32 alphanumeric characters, underscores or hyphens"),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsQLDBStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	ledgerName := d.Get("ledger_name").(string)
+
+	// Validated by the schema
+	inclusiveStartTime, _ := time.Parse(time.RFC3339, d.Get("inclusive_start_time").(string))
+
+	input := &qldb.StreamJournalToKinesisInput{
+		InclusiveStartTime:   aws.Time(inclusiveStartTime),
+		KinesisConfiguration: expandQLDBKinesisConfiguration(d.Get("kinesis_configuration").([]interface{})),
+		LedgerName:           aws.String(ledgerName),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		StreamName:           aws.String(d.Get("stream_name").(string)),
+		Tags:                 tagsFromMapGeneric(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("exclusive_end_time"); ok {
+		exclusiveEndTime, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExclusiveEndTime = aws.Time(exclusiveEndTime)
+	}
+
+	log.Printf("[DEBUG] Creating QLDB Stream: %s", input)
+	output, err := conn.StreamJournalToKinesis(input)
+	if err != nil {
+		return fmt.Errorf("error creating QLDB Stream: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.StreamId))
+
+	return resourceAwsQLDBStreamRead(d, meta)
+}
+
+func resourceAwsQLDBStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	stream, err := getQLDBStream(conn, d.Get("ledger_name").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading QLDB Stream (%s): %s", d.Id(), err)
+	}
+
+	if stream == nil || aws.StringValue(stream.Status) == qldb.StreamStatusCanceled {
+		log.Printf("[WARN] QLDB Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", stream.Arn)
+	d.Set("ledger_name", stream.LedgerName)
+	d.Set("role_arn", stream.RoleArn)
+	d.Set("stream_name", stream.StreamName)
+
+	if stream.ExclusiveEndTime != nil {
+		d.Set("exclusive_end_time", aws.TimeValue(stream.ExclusiveEndTime).Format(time.RFC3339))
+	}
+
+	if stream.InclusiveStartTime != nil {
+		d.Set("inclusive_start_time", aws.TimeValue(stream.InclusiveStartTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("kinesis_configuration", flattenQLDBKinesisConfiguration(stream.KinesisConfiguration)); err != nil {
+		return fmt.Errorf("error setting kinesis_configuration: %s", err)
+	}
+
+	tagsOut, err := conn.ListTagsForResource(&qldb.ListTagsForResourceInput{
+		ResourceArn: stream.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for QLDB Stream (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapGeneric(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQLDBStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	if d.HasChange("tags") {
+		if err := setTagsQLDB(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating QLDB Stream (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQLDBStreamRead(d, meta)
+}
+
+func resourceAwsQLDBStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).qldbconn
+
+	ledgerName := d.Get("ledger_name").(string)
+
+	log.Printf("[DEBUG] Cancelling QLDB Stream (%s)", d.Id())
+	_, err := conn.CancelJournalKinesisStream(&qldb.CancelJournalKinesisStreamInput{
+		LedgerName: aws.String(ledgerName),
+		StreamId:   aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		// Completed and failed streams can no longer be cancelled
+		if isAWSErr(err, qldb.ErrCodeResourcePreconditionNotMetException, "") {
+			return nil
+		}
+		return fmt.Errorf("error cancelling QLDB Stream (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.StreamStatusActive, qldb.StreamStatusImpaired},
+		Target:     []string{qldb.StreamStatusCanceled},
+		Refresh:    resourceAwsQLDBStreamStateRefreshFunc(conn, ledgerName, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for QLDB Stream (%s) cancellation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getQLDBStream(conn *qldb.QLDB, ledgerName, id string) (*qldb.JournalKinesisStreamDescription, error) {
+	output, err := conn.DescribeJournalKinesisStream(&qldb.DescribeJournalKinesisStreamInput{
+		LedgerName: aws.String(ledgerName),
+		StreamId:   aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return output.Stream, nil
+}
+
+func resourceAwsQLDBStreamStateRefreshFunc(conn *qldb.QLDB, ledgerName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stream, err := getQLDBStream(conn, ledgerName, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// A cancelled stream may disappear entirely
+		if stream == nil {
+			return 42, qldb.StreamStatusCanceled, nil
+		}
+
+		return stream, aws.StringValue(stream.Status), nil
+	}
+}
+
+func expandQLDBKinesisConfiguration(l []interface{}) *qldb.KinesisConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &qldb.KinesisConfiguration{
+		AggregationEnabled: aws.Bool(m["aggregation_enabled"].(bool)),
+		StreamArn:          aws.String(m["stream_arn"].(string)),
+	}
+}
+
+func flattenQLDBKinesisConfiguration(config *qldb.KinesisConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"aggregation_enabled": aws.BoolValue(config.AggregationEnabled),
+		"stream_arn":          aws.StringValue(config.StreamArn),
+	}
+
+	return []interface{}{m}
+}
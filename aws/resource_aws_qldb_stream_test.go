@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSQLDBStream_basic(t *testing.T) {
+	var stream qldb.JournalKinesisStreamDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_stream.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBStreamConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBStreamExists(resourceName, &stream),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:qldb:[^:]+:\d{12}:stream/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", ""),
+					resource.TestCheckResourceAttr(resourceName, "inclusive_start_time", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.0.aggregation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_configuration.0.stream_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "ledger_name", "aws_qldb_ledger.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stream_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBStream_aggregationDisabled(t *testing.T) {
+	var stream qldb.JournalKinesisStreamDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_stream.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBStreamConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.0.aggregation_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQLDBStream_tags(t *testing.T) {
+	var stream qldb.JournalKinesisStreamDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_qldb_stream.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQLDBStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQLDBStreamConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSQLDBStreamConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQLDBStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQLDBStreamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).qldbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_qldb_stream" {
+			continue
+		}
+
+		stream, err := getQLDBStream(conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if stream != nil && aws.StringValue(stream.Status) != qldb.StreamStatusCanceled {
+			return fmt.Errorf("QLDB Stream (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQLDBStreamExists(n string, v *qldb.JournalKinesisStreamDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QLDB Stream ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).qldbconn
+
+		stream, err := getQLDBStream(conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if stream == nil {
+			return fmt.Errorf("QLDB Stream (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *stream
+
+		return nil
+	}
+}
+
+func testAccAWSQLDBStreamConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "ALLOW_ALL"
+  deletion_protection = false
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "qldb.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "kinesis:PutRecord*",
+        "kinesis:DescribeStream",
+        "kinesis:ListShards"
+      ],
+      "Resource": "${aws_kinesis_stream.test.arn}"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSQLDBStreamConfig(rName string, aggregationEnabled bool) string {
+	return testAccAWSQLDBStreamConfigBase(rName) + fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  ledger_name          = "${aws_qldb_ledger.test.id}"
+  stream_name          = %[1]q
+  role_arn             = "${aws_iam_role.test.arn}"
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+
+  kinesis_configuration {
+    aggregation_enabled = %[2]t
+    stream_arn          = "${aws_kinesis_stream.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, aggregationEnabled)
+}
+
+func testAccAWSQLDBStreamConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSQLDBStreamConfigBase(rName) + fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  ledger_name          = "${aws_qldb_ledger.test.id}"
+  stream_name          = %[1]q
+  role_arn             = "${aws_iam_role.test.arn}"
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+
+  kinesis_configuration {
+    stream_arn = "${aws_kinesis_stream.test.arn}"
+  }
+
+  tags {
+    %[2]q = %[3]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamPrincipalAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamPrincipalAssociationCreate,
+		Read:   resourceAwsRamPrincipalAssociationRead,
+		Delete: resourceAwsRamPrincipalAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// An AWS account ID, or the ARN of an organization, organizational
+			// unit, IAM role or IAM user.
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	resourceShareArn := d.Get("resource_share_arn").(string)
+	principal := d.Get("principal").(string)
+
+	input := &ram.AssociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       []*string{aws.String(principal)},
+		ResourceShareArn: aws.String(resourceShareArn),
+	}
+
+	log.Printf("[DEBUG] Creating RAM Principal Association: %s", input)
+	if _, err := conn.AssociateResourceShare(input); err != nil {
+		return fmt.Errorf("error associating principal (%s) with RAM Resource Share (%s): %s", principal, resourceShareArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceShareArn, principal))
+
+	// Associations with accounts outside of the organization remain in the
+	// ASSOCIATING state until the invitation is accepted in that account.
+	if _, errs := validateAwsAccountId(principal, "principal"); len(errs) == 0 {
+		return resourceAwsRamPrincipalAssociationRead(d, meta)
+	}
+
+	if err := waitForRamResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypePrincipal, principal); err != nil {
+		return fmt.Errorf("error waiting for RAM Principal Association (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamPrincipalAssociationRead(d, meta)
+}
+
+func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareArn, principal, err := decodeRamAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypePrincipal, principal)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		log.Printf("[WARN] RAM Principal Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Principal Association (%s): %s", d.Id(), err)
+	}
+
+	if association == nil {
+		log.Printf("[WARN] RAM Principal Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(association.Status) {
+	case ram.ResourceShareAssociationStatusDisassociating, ram.ResourceShareAssociationStatusDisassociated, ram.ResourceShareAssociationStatusFailed:
+		log.Printf("[WARN] RAM Principal Association (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(association.Status))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("principal", association.AssociatedEntity)
+	d.Set("resource_share_arn", association.ResourceShareArn)
+
+	return nil
+}
+
+func resourceAwsRamPrincipalAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareArn, principal, err := decodeRamAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       []*string{aws.String(principal)},
+		ResourceShareArn: aws.String(resourceShareArn),
+	}
+
+	log.Printf("[DEBUG] Deleting RAM Principal Association (%s)", d.Id())
+	_, err = conn.DisassociateResourceShare(input)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RAM Principal Association (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRamResourceShareDisassociation(conn, resourceShareArn, ram.ResourceShareAssociationTypePrincipal, principal); err != nil {
+		return fmt.Errorf("error waiting for RAM Principal Association (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
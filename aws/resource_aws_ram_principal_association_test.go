@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRamPrincipalAssociation_basic(t *testing.T) {
+	var association ram.ResourceShareAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_principal_association.test"
+	resourceShareResourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamPrincipalAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamPrincipalAssociationConfig(rName, "111111111111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamPrincipalAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "principal", "111111111111"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", resourceShareResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamPrincipalAssociationExists(resourceName string, association *ram.ResourceShareAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM Principal Association ID is set")
+		}
+
+		resourceShareArn, principal, err := decodeRamAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		output, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypePrincipal, principal)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("RAM Principal Association (%s) not found", rs.Primary.ID)
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRamPrincipalAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_principal_association" {
+			continue
+		}
+
+		resourceShareArn, principal, err := decodeRamAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypePrincipal, principal)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.Status) != ram.ResourceShareAssociationStatusDisassociated {
+			return fmt.Errorf("RAM Principal Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsRamPrincipalAssociationConfig(rName, principal string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  allow_external_principals = true
+  name                      = %[1]q
+}
+
+resource "aws_ram_principal_association" "test" {
+  principal          = %[2]q
+  resource_share_arn = "${aws_ram_resource_share.test.id}"
+}
+`, rName, principal)
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceAssociationCreate,
+		Read:   resourceAwsRamResourceAssociationRead,
+		Delete: resourceAwsRamResourceAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsRamResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	resourceShareArn := d.Get("resource_share_arn").(string)
+	resourceArn := d.Get("resource_arn").(string)
+
+	input := &ram.AssociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceArns:     []*string{aws.String(resourceArn)},
+		ResourceShareArn: aws.String(resourceShareArn),
+	}
+
+	log.Printf("[DEBUG] Creating RAM Resource Association: %s", input)
+	if _, err := conn.AssociateResourceShare(input); err != nil {
+		return fmt.Errorf("error associating resource (%s) with RAM Resource Share (%s): %s", resourceArn, resourceShareArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceShareArn, resourceArn))
+
+	if err := waitForRamResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypeResource, resourceArn); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Association (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamResourceAssociationRead(d, meta)
+}
+
+func resourceAwsRamResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareArn, resourceArn, err := decodeRamAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypeResource, resourceArn)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		log.Printf("[WARN] RAM Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if association == nil {
+		log.Printf("[WARN] RAM Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(association.Status) {
+	case ram.ResourceShareAssociationStatusDisassociating, ram.ResourceShareAssociationStatusDisassociated, ram.ResourceShareAssociationStatusFailed:
+		log.Printf("[WARN] RAM Resource Association (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(association.Status))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", association.AssociatedEntity)
+	d.Set("resource_share_arn", association.ResourceShareArn)
+
+	return nil
+}
+
+func resourceAwsRamResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareArn, resourceArn, err := decodeRamAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceArns:     []*string{aws.String(resourceArn)},
+		ResourceShareArn: aws.String(resourceShareArn),
+	}
+
+	log.Printf("[DEBUG] Deleting RAM Resource Association (%s)", d.Id())
+	_, err = conn.DisassociateResourceShare(input)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RAM Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRamResourceShareDisassociation(conn, resourceShareArn, ram.ResourceShareAssociationTypeResource, resourceArn); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Association (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
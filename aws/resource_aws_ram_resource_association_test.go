@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRamResourceAssociation_basic(t *testing.T) {
+	var association ram.ResourceShareAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_association.test"
+	resourceShareResourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceAssociationExists(resourceName, &association),
+					resource.TestMatchResourceAttr(resourceName, "resource_arn", regexp.MustCompile(`^arn:[^:]+:ec2:[^:]+:\d{12}:subnet/subnet-.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", resourceShareResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceAssociationExists(resourceName string, association *ram.ResourceShareAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM Resource Association ID is set")
+		}
+
+		resourceShareArn, resourceArn, err := decodeRamAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		output, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypeResource, resourceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("RAM Resource Association (%s) not found", rs.Primary.ID)
+		}
+
+		if aws.StringValue(output.Status) != ram.ResourceShareAssociationStatusAssociated {
+			return fmt.Errorf("RAM Resource Association (%s) is not associated: %s", rs.Primary.ID, aws.StringValue(output.Status))
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRamResourceAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_association" {
+			continue
+		}
+
+		resourceShareArn, resourceArn, err := decodeRamAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := ramDescribeResourceShareAssociation(conn, resourceShareArn, ram.ResourceShareAssociationTypeResource, resourceArn)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.Status) != ram.ResourceShareAssociationStatusDisassociated {
+			return fmt.Errorf("RAM Resource Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsRamResourceAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:subnet/${aws_subnet.test.id}"
+  resource_share_arn = "${aws_ram_resource_share.test.id}"
+}
+`, rName)
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceShareCreate,
+		Read:   resourceAwsRamResourceShareRead,
+		Update: resourceAwsRamResourceShareUpdate,
+		Delete: resourceAwsRamResourceShareDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_external_principals": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	name := d.Get("name").(string)
+
+	input := &ram.CreateResourceShareInput{
+		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+		ClientToken:             aws.String(resource.UniqueId()),
+		Name:                    aws.String(name),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapRAM(v)
+	}
+
+	log.Printf("[DEBUG] Creating RAM Resource Share: %s", input)
+	output, err := conn.CreateResourceShare(input)
+	if err != nil {
+		return fmt.Errorf("error creating RAM Resource Share (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceShare.ResourceShareArn))
+
+	if err := waitForRamResourceShareCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamResourceShareRead(d, meta)
+}
+
+func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShare, err := ramDescribeResourceShare(conn, d.Id(), ram.ResourceOwnerSelf)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		log.Printf("[WARN] RAM Resource Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	if resourceShare == nil {
+		log.Printf("[WARN] RAM Resource Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(resourceShare.Status) {
+	case ram.ResourceShareStatusDeleting, ram.ResourceShareStatusDeleted:
+		log.Printf("[WARN] RAM Resource Share (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(resourceShare.Status))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
+	d.Set("arn", resourceShare.ResourceShareArn)
+	d.Set("name", resourceShare.Name)
+
+	if err := d.Set("tags", tagsToMapRAM(resourceShare.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	if d.HasChange("allow_external_principals") || d.HasChange("name") {
+		input := &ram.UpdateResourceShareInput{
+			AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+			ClientToken:             aws.String(resource.UniqueId()),
+			Name:                    aws.String(d.Get("name").(string)),
+			ResourceShareArn:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating RAM Resource Share (%s): %s", d.Id(), input)
+		if _, err := conn.UpdateResourceShare(input); err != nil {
+			return fmt.Errorf("error updating RAM Resource Share (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsRAM(conn, d); err != nil {
+		return fmt.Errorf("error updating RAM Resource Share (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamResourceShareRead(d, meta)
+}
+
+func resourceAwsRamResourceShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	input := &ram.DeleteResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceShareArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting RAM Resource Share (%s)", d.Id())
+	_, err := conn.DeleteResourceShare(input)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRamResourceShareDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
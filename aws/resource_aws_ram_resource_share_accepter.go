@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceShareAccepterCreate,
+		Read:   resourceAwsRamResourceShareAccepterRead,
+		Delete: resourceAwsRamResourceShareAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"invitation_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"receiver_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sender_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	shareArn := d.Get("share_arn").(string)
+
+	invitation, err := ramDescribeResourceShareInvitation(conn, shareArn)
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) invitation: %s", shareArn, err)
+	}
+
+	if invitation == nil || aws.StringValue(invitation.Status) != ram.ResourceShareInvitationStatusPending {
+		return fmt.Errorf("error accepting RAM Resource Share (%s): no pending invitation found", shareArn)
+	}
+
+	input := &ram.AcceptResourceShareInvitationInput{
+		ClientToken:                aws.String(resource.UniqueId()),
+		ResourceShareInvitationArn: invitation.ResourceShareInvitationArn,
+	}
+
+	log.Printf("[DEBUG] Accepting RAM Resource Share invitation: %s", input)
+	if _, err := conn.AcceptResourceShareInvitation(input); err != nil {
+		return fmt.Errorf("error accepting RAM Resource Share (%s) invitation: %s", shareArn, err)
+	}
+
+	d.SetId(shareArn)
+
+	if _, err := waitForRamResourceShareInvitationAcceptance(conn, shareArn); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) invitation acceptance: %s", shareArn, err)
+	}
+
+	return resourceAwsRamResourceShareAccepterRead(d, meta)
+}
+
+func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShare, err := ramDescribeResourceShare(conn, d.Id(), ram.ResourceOwnerOtherAccounts)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		log.Printf("[WARN] RAM Resource Share (%s) not found, removing accepter from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	if resourceShare == nil {
+		log.Printf("[WARN] RAM Resource Share (%s) not found, removing accepter from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(resourceShare.Status) {
+	case ram.ResourceShareStatusDeleting, ram.ResourceShareStatusDeleted:
+		log.Printf("[WARN] RAM Resource Share (%s) in deleted state (%s), removing accepter from state", d.Id(), aws.StringValue(resourceShare.Status))
+		d.SetId("")
+		return nil
+	}
+
+	// Shares received from within the organization are not sent as
+	// invitations, so there may be nothing to report here.
+	invitation, err := ramDescribeResourceShareInvitation(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) invitation: %s", d.Id(), err)
+	}
+
+	if invitation != nil {
+		d.Set("invitation_arn", invitation.ResourceShareInvitationArn)
+		d.Set("receiver_account_id", invitation.ReceiverAccountId)
+		d.Set("status", invitation.Status)
+	} else {
+		d.Set("receiver_account_id", meta.(*AWSClient).accountid)
+		d.Set("status", ram.ResourceShareInvitationStatusAccepted)
+	}
+
+	input := &ram.ListResourcesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: []*string{aws.String(d.Id())},
+	}
+
+	var resources []string
+
+	err = conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		for _, v := range page.Resources {
+			resources = append(resources, aws.StringValue(v.Arn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing RAM Resource Share (%s) resources: %s", d.Id(), err)
+	}
+
+	d.Set("sender_account_id", resourceShare.OwningAccountId)
+	d.Set("share_arn", resourceShare.ResourceShareArn)
+	d.Set("share_name", resourceShare.Name)
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	receiverAccountID := d.Get("receiver_account_id").(string)
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       []*string{aws.String(receiverAccountID)},
+		ResourceShareArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Leaving RAM Resource Share (%s): %s", d.Id(), input)
+	_, err := conn.DisassociateResourceShare(input)
+
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error leaving RAM Resource Share (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
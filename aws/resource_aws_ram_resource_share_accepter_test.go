@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Resource shares are offered from a second account, so these tests need
+// credentials for the account that owns the share.
+func testAccPreCheckAwsRamResourceShareAccepter(t *testing.T) {
+	if os.Getenv("RAM_SHARE_OWNER_PROFILE") == "" {
+		t.Skip("Environment variable RAM_SHARE_OWNER_PROFILE is not set")
+	}
+}
+
+func TestAccAWSRamResourceShareAccepter_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_share_accepter.test"
+	principalAssociationResourceName := "aws_ram_principal_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAwsRamResourceShareAccepter(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareAccepterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareAccepterExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "receiver_account_id", principalAssociationResourceName, "principal"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "sender_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "share_arn", principalAssociationResourceName, "resource_share_arn"),
+					resource.TestCheckResourceAttr(resourceName, "share_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", ram.ResourceShareInvitationStatusAccepted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceShareAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM Resource Share Accepter ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		output, err := ramDescribeResourceShare(conn, rs.Primary.ID, ram.ResourceOwnerOtherAccounts)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.Status) != ram.ResourceShareStatusActive {
+			return fmt.Errorf("RAM Resource Share (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRamResourceShareAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share_accepter" {
+			continue
+		}
+
+		output, err := ramDescribeResourceShare(conn, rs.Primary.ID, ram.ResourceOwnerOtherAccounts)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.Status) == ram.ResourceShareStatusActive {
+			return fmt.Errorf("RAM Resource Share (%s) is still shared with this account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsRamResourceShareAccepterConfig(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "owner"
+  profile = %[2]q
+}
+
+data "aws_caller_identity" "receiver" {}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "aws.owner"
+
+  allow_external_principals = true
+  name                      = %[1]q
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "aws.owner"
+
+  principal          = "${data.aws_caller_identity.receiver.account_id}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = "${aws_ram_principal_association.test.resource_share_arn}"
+}
+`, rName, os.Getenv("RAM_SHARE_OWNER_PROFILE"))
+}
@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRamResourceShare_basic(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "false"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:ram:[^:]+:\d{12}:resource-share/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRamResourceShare_AllowExternalPrincipals(t *testing.T) {
+	var resourceShare1, resourceShare2 ram.ResourceShare
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigAllowExternalPrincipals(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare1),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigAllowExternalPrincipals(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare2),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRamResourceShare_Name(t *testing.T) {
+	var resourceShare1, resourceShare2 ram.ResourceShare
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigName(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigName(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRamResourceShare_Tags(t *testing.T) {
+	var resourceShare1, resourceShare2, resourceShare3 ram.ResourceShare
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ram_resource_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceShareExists(resourceName string, resourceShare *ram.ResourceShare) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM Resource Share ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		output, err := ramDescribeResourceShare(conn, rs.Primary.ID, ram.ResourceOwnerSelf)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("RAM Resource Share (%s) not found", rs.Primary.ID)
+		}
+
+		if aws.StringValue(output.Status) != ram.ResourceShareStatusActive {
+			return fmt.Errorf("RAM Resource Share (%s) is not active: %s", rs.Primary.ID, aws.StringValue(output.Status))
+		}
+
+		*resourceShare = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRamResourceShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share" {
+			continue
+		}
+
+		output, err := ramDescribeResourceShare(conn, rs.Primary.ID, ram.ResourceOwnerSelf)
+
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.Status) != ram.ResourceShareStatusDeleted {
+			return fmt.Errorf("RAM Resource Share (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsRamResourceShareConfigAllowExternalPrincipals(rName string, allowExternalPrincipals bool) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  allow_external_principals = %[2]t
+  name                      = %[1]q
+}
+`, rName, allowExternalPrincipals)
+}
+
+func testAccAwsRamResourceShareConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsRamResourceShareConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsRamResourceShareConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
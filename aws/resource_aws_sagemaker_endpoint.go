@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateEndpointInput{
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		EndpointName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSagemaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Endpoint: %s", input)
+	_, err := conn.CreateEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for SageMaker Endpoint (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+		log.Printf("[WARN] SageMaker Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpoint.EndpointArn)
+	d.Set("endpoint_config_name", endpoint.EndpointConfigName)
+	d.Set("name", endpoint.EndpointName)
+
+	tags, err := getTagsSagemaker(conn, aws.StringValue(endpoint.EndpointArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("tags") {
+		if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating SageMaker Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	// Endpoint configurations are immutable, so model rollouts are done by
+	// pointing the endpoint at a new configuration. SageMaker keeps serving
+	// traffic from the old configuration until the new one is in service.
+	if d.HasChange("endpoint_config_name") {
+		endpointConfigName := d.Get("endpoint_config_name").(string)
+		input := &sagemaker.UpdateEndpointInput{
+			EndpointConfigName: aws.String(endpointConfigName),
+			EndpointName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Endpoint: %s", input)
+		_, err := conn.UpdateEndpoint(input)
+		if err != nil {
+			return fmt.Errorf("error updating SageMaker Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for SageMaker Endpoint (%s) update: %s", d.Id(), err)
+		}
+
+		// A failed update is rolled back and the endpoint returns to service
+		// with its previous configuration.
+		endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if aws.StringValue(endpoint.EndpointConfigName) != endpointConfigName {
+			return fmt.Errorf("error updating SageMaker Endpoint (%s) to configuration %s, rolled back: %s", d.Id(), endpointConfigName, aws.StringValue(endpoint.FailureReason))
+		}
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sagemaker.EndpointStatusDeleting},
+		Target:     []string{""},
+		Refresh:    resourceAwsSagemakerEndpointStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for SageMaker Endpoint (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForSagemakerEndpointInService(conn *sagemaker.SageMaker, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.EndpointStatusCreating,
+			sagemaker.EndpointStatusRollingBack,
+			sagemaker.EndpointStatusSystemUpdating,
+			sagemaker.EndpointStatusUpdating,
+		},
+		Target:     []string{sagemaker.EndpointStatusInService},
+		Refresh:    resourceAwsSagemakerEndpointStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsSagemakerEndpointStateRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(name),
+		})
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			return 42, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.EndpointStatus)
+		if status == sagemaker.EndpointStatusFailed || status == sagemaker.EndpointStatusUpdateRollbackFailed {
+			return output, status, fmt.Errorf("endpoint is %s: %s", status, aws.StringValue(output.FailureReason))
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,478 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointConfigurationCreate,
+		Read:   resourceAwsSagemakerEndpointConfigurationRead,
+		Update: resourceAwsSagemakerEndpointConfigurationUpdate,
+		Delete: resourceAwsSagemakerEndpointConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"production_variants": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.ProductionVariantAcceleratorType_Values(), false),
+						},
+						"container_startup_health_check_timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(60, 3600),
+						},
+						"initial_instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"initial_variant_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							ForceNew: true,
+							Default:  1,
+						},
+						"instance_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.ProductionVariantInstanceType_Values(), false),
+						},
+						"model_data_download_timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(60, 3600),
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"serverless_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_concurrency": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 200),
+									},
+									"memory_size_in_mb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateSagemakerServerlessMemorySize,
+									},
+									"provisioned_concurrency": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 200),
+									},
+								},
+							},
+						},
+						"variant_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"volume_size_in_gb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 512),
+						},
+					},
+				},
+			},
+			"data_capture_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capture_content_type_header": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv_content_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 10,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"json_content_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 10,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"capture_options": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capture_mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.CaptureMode_Values(), false),
+									},
+								},
+							},
+						},
+						"destination_s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^(https|s3)://([^/]+)/?(.*)$`),
+								"must be an S3 or HTTPS URI"),
+						},
+						"enable_capture": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"initial_sampling_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		ProductionVariants: expandSagemakerProductionVariants(d.Get("production_variants").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("data_capture_config"); ok {
+		input.DataCaptureConfig = expandSagemakerDataCaptureConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSagemaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Endpoint Configuration: %s", input)
+	_, err := conn.CreateEndpointConfig(input)
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Endpoint Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpointConfig, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+		log.Printf("[WARN] SageMaker Endpoint Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpointConfig.EndpointConfigArn)
+	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
+	d.Set("name", endpointConfig.EndpointConfigName)
+
+	if err := d.Set("production_variants", flattenSagemakerProductionVariants(endpointConfig.ProductionVariants)); err != nil {
+		return fmt.Errorf("error setting production_variants: %s", err)
+	}
+
+	if err := d.Set("data_capture_config", flattenSagemakerDataCaptureConfig(endpointConfig.DataCaptureConfig)); err != nil {
+		return fmt.Errorf("error setting data_capture_config: %s", err)
+	}
+
+	tags, err := getTagsSagemaker(conn, aws.StringValue(endpointConfig.EndpointConfigArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("tags") {
+		if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating SageMaker Endpoint Configuration (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker Endpoint Configuration: %s", d.Id())
+	_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerProductionVariants(l []interface{}) []*sagemaker.ProductionVariant {
+	variants := make([]*sagemaker.ProductionVariant, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		variant := &sagemaker.ProductionVariant{
+			InitialVariantWeight: aws.Float64(m["initial_variant_weight"].(float64)),
+			ModelName:            aws.String(m["model_name"].(string)),
+		}
+
+		if v, ok := m["variant_name"].(string); ok && v != "" {
+			variant.VariantName = aws.String(v)
+		} else {
+			variant.VariantName = aws.String(resource.UniqueId())
+		}
+
+		if v, ok := m["accelerator_type"].(string); ok && v != "" {
+			variant.AcceleratorType = aws.String(v)
+		}
+		if v, ok := m["container_startup_health_check_timeout_in_seconds"].(int); ok && v > 0 {
+			variant.ContainerStartupHealthCheckTimeoutInSeconds = aws.Int64(int64(v))
+		}
+		if v, ok := m["initial_instance_count"].(int); ok && v > 0 {
+			variant.InitialInstanceCount = aws.Int64(int64(v))
+		}
+		if v, ok := m["instance_type"].(string); ok && v != "" {
+			variant.InstanceType = aws.String(v)
+		}
+		if v, ok := m["model_data_download_timeout_in_seconds"].(int); ok && v > 0 {
+			variant.ModelDataDownloadTimeoutInSeconds = aws.Int64(int64(v))
+		}
+		if v, ok := m["serverless_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variant.ServerlessConfig = expandSagemakerServerlessConfig(v[0].(map[string]interface{}))
+		}
+		if v, ok := m["volume_size_in_gb"].(int); ok && v > 0 {
+			variant.VolumeSizeInGB = aws.Int64(int64(v))
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants
+}
+
+func expandSagemakerServerlessConfig(m map[string]interface{}) *sagemaker.ProductionVariantServerlessConfig {
+	config := &sagemaker.ProductionVariantServerlessConfig{
+		MaxConcurrency: aws.Int64(int64(m["max_concurrency"].(int))),
+		MemorySizeInMB: aws.Int64(int64(m["memory_size_in_mb"].(int))),
+	}
+
+	if v, ok := m["provisioned_concurrency"].(int); ok && v > 0 {
+		config.ProvisionedConcurrency = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func flattenSagemakerProductionVariants(variants []*sagemaker.ProductionVariant) []interface{} {
+	result := make([]interface{}, 0, len(variants))
+
+	for _, variant := range variants {
+		m := map[string]interface{}{
+			"accelerator_type": aws.StringValue(variant.AcceleratorType),
+			"container_startup_health_check_timeout_in_seconds": int(aws.Int64Value(variant.ContainerStartupHealthCheckTimeoutInSeconds)),
+			"initial_instance_count":                            int(aws.Int64Value(variant.InitialInstanceCount)),
+			"initial_variant_weight":                            aws.Float64Value(variant.InitialVariantWeight),
+			"instance_type":                                     aws.StringValue(variant.InstanceType),
+			"model_data_download_timeout_in_seconds":            int(aws.Int64Value(variant.ModelDataDownloadTimeoutInSeconds)),
+			"model_name":                                        aws.StringValue(variant.ModelName),
+			"serverless_config":                                 []interface{}{},
+			"variant_name":                                      aws.StringValue(variant.VariantName),
+			"volume_size_in_gb":                                 int(aws.Int64Value(variant.VolumeSizeInGB)),
+		}
+
+		if v := variant.ServerlessConfig; v != nil {
+			m["serverless_config"] = []interface{}{map[string]interface{}{
+				"max_concurrency":         int(aws.Int64Value(v.MaxConcurrency)),
+				"memory_size_in_mb":       int(aws.Int64Value(v.MemorySizeInMB)),
+				"provisioned_concurrency": int(aws.Int64Value(v.ProvisionedConcurrency)),
+			}}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandSagemakerDataCaptureConfig(l []interface{}) *sagemaker.DataCaptureConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.DataCaptureConfig{
+		DestinationS3Uri:          aws.String(m["destination_s3_uri"].(string)),
+		EnableCapture:             aws.Bool(m["enable_capture"].(bool)),
+		InitialSamplingPercentage: aws.Int64(int64(m["initial_sampling_percentage"].(int))),
+	}
+
+	for _, raw := range m["capture_options"].([]interface{}) {
+		config.CaptureOptions = append(config.CaptureOptions, &sagemaker.CaptureOption{
+			CaptureMode: aws.String(raw.(map[string]interface{})["capture_mode"].(string)),
+		})
+	}
+
+	if v, ok := m["capture_content_type_header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		hm := v[0].(map[string]interface{})
+		header := &sagemaker.CaptureContentTypeHeader{}
+		if v, ok := hm["csv_content_types"].(*schema.Set); ok && v.Len() > 0 {
+			header.CsvContentTypes = expandStringSet(v)
+		}
+		if v, ok := hm["json_content_types"].(*schema.Set); ok && v.Len() > 0 {
+			header.JsonContentTypes = expandStringSet(v)
+		}
+		config.CaptureContentTypeHeader = header
+	}
+
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		config.KmsKeyId = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenSagemakerDataCaptureConfig(config *sagemaker.DataCaptureConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	captureOptions := make([]interface{}, 0, len(config.CaptureOptions))
+	for _, option := range config.CaptureOptions {
+		captureOptions = append(captureOptions, map[string]interface{}{
+			"capture_mode": aws.StringValue(option.CaptureMode),
+		})
+	}
+
+	m := map[string]interface{}{
+		"capture_content_type_header": []interface{}{},
+		"capture_options":             captureOptions,
+		"destination_s3_uri":          aws.StringValue(config.DestinationS3Uri),
+		"enable_capture":              aws.BoolValue(config.EnableCapture),
+		"initial_sampling_percentage": int(aws.Int64Value(config.InitialSamplingPercentage)),
+		"kms_key_id":                  aws.StringValue(config.KmsKeyId),
+	}
+
+	if v := config.CaptureContentTypeHeader; v != nil {
+		m["capture_content_type_header"] = []interface{}{map[string]interface{}{
+			"csv_content_types":  schema.NewSet(schema.HashString, flattenStringList(v.CsvContentTypes)),
+			"json_content_types": schema.NewSet(schema.HashString, flattenStringList(v.JsonContentTypes)),
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The endpoint tests deploy a real model, which needs trained k-means model
+// artifacts in us-west-2.
+func testAccSagemakerEndpointPreCheck(t *testing.T) string {
+	modelDataURL := os.Getenv("SAGEMAKER_KMEANS_MODEL_DATA_URL")
+	if modelDataURL == "" {
+		t.Skip("Environment variable SAGEMAKER_KMEANS_MODEL_DATA_URL is not set")
+	}
+	return modelDataURL
+}
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	modelDataURL := testAccSagemakerEndpointPreCheck(t)
+	var endpoint sagemaker.DescribeEndpointOutput
+	resourceName := "aws_sagemaker_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig_basic(rName, modelDataURL, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:sagemaker:[^:]+:\d{12}:endpoint/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.first", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_endpointConfigName(t *testing.T) {
+	modelDataURL := testAccSagemakerEndpointPreCheck(t)
+	var before, after sagemaker.DescribeEndpointOutput
+	resourceName := "aws_sagemaker_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig_basic(rName, modelDataURL, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.first", "name"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfig_basic(rName, modelDataURL, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &after),
+					testAccCheckAWSSagemakerEndpointNotRecreated(&before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.second", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_tags(t *testing.T) {
+	modelDataURL := testAccSagemakerEndpointPreCheck(t)
+	var endpoint sagemaker.DescribeEndpointOutput
+	resourceName := "aws_sagemaker_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig_tags1(rName, modelDataURL, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfig_tags1(rName, modelDataURL, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerEndpointExists(n string, endpoint *sagemaker.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*endpoint = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointNotRecreated(before, after *sagemaker.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(before.CreationTime).Equal(aws.TimeValue(after.CreationTime)) {
+			return fmt.Errorf("SageMaker Endpoint (%s) was recreated", aws.StringValue(before.EndpointName))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SageMaker Endpoint (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerEndpointConfigBase(rName, modelDataURL string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:aws:iam::aws:policy/AmazonSageMakerFullAccess"
+}
+
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image          = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+    model_data_url = %[2]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+
+resource "aws_sagemaker_endpoint_configuration" "first" {
+  name = "%[1]s-first"
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+
+resource "aws_sagemaker_endpoint_configuration" "second" {
+  name = "%[1]s-second"
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName, modelDataURL)
+}
+
+func testAccAWSSagemakerEndpointConfig_basic(rName, modelDataURL, endpointConfig string) string {
+	return testAccAWSSagemakerEndpointConfigBase(rName, modelDataURL) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.%[2]s.name}"
+}
+`, rName, endpointConfig)
+}
+
+func testAccAWSSagemakerEndpointConfig_tags1(rName, modelDataURL, tagKey1, tagValue1 string) string {
+	return testAccAWSSagemakerEndpointConfigBase(rName, modelDataURL) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.first.name}"
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,366 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerModelCreate,
+		Read:   resourceAwsSagemakerModelRead,
+		Update: resourceAwsSagemakerModelUpdate,
+		Delete: resourceAwsSagemakerModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"primary_container": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container"},
+				Elem:          resourceAwsSagemakerModelContainerSchema(),
+			},
+			"container": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      15,
+				ConflictsWith: []string{"primary_container"},
+				Elem:          resourceAwsSagemakerModelContainerSchema(),
+			},
+			"enable_network_isolation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerModelContainerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"container_hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"environment": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"image_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_access_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.RepositoryAccessMode_Values(), false),
+						},
+					},
+				},
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      sagemaker.ContainerModeSingleModel,
+				ValidateFunc: validation.StringInSlice(sagemaker.ContainerMode_Values(), false),
+			},
+			"model_data_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateModelInput{
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		ModelName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("primary_container"); ok {
+		input.PrimaryContainer = expandSagemakerContainer(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("container"); ok {
+		input.Containers = expandSagemakerContainers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_network_isolation"); ok {
+		input.EnableNetworkIsolation = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandSagemakerVpcConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSagemaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Model: %s", input)
+	// IAM is eventually consistent, so the execution role may not be
+	// assumable by SageMaker straight after it has been created.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateModel(input)
+		if isAWSErr(err, "ValidationException", "Could not assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	model, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find model") {
+		log.Printf("[WARN] SageMaker Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", model.ModelArn)
+	d.Set("enable_network_isolation", model.EnableNetworkIsolation)
+	d.Set("execution_role_arn", model.ExecutionRoleArn)
+	d.Set("name", model.ModelName)
+
+	if err := d.Set("primary_container", flattenSagemakerContainer(model.PrimaryContainer)); err != nil {
+		return fmt.Errorf("error setting primary_container: %s", err)
+	}
+
+	if err := d.Set("container", flattenSagemakerContainers(model.Containers)); err != nil {
+		return fmt.Errorf("error setting container: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSagemakerVpcConfig(model.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := getTagsSagemaker(conn, aws.StringValue(model.ModelArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Model (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("tags") {
+		if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating SageMaker Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker Model: %s", d.Id())
+	_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find model") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerContainer(m map[string]interface{}) *sagemaker.ContainerDefinition {
+	container := &sagemaker.ContainerDefinition{
+		Image: aws.String(m["image"].(string)),
+	}
+
+	if v, ok := m["container_hostname"].(string); ok && v != "" {
+		container.ContainerHostname = aws.String(v)
+	}
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		container.Environment = stringMapToPointers(v)
+	}
+	if v, ok := m["image_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		container.ImageConfig = &sagemaker.ImageConfig{
+			RepositoryAccessMode: aws.String(v[0].(map[string]interface{})["repository_access_mode"].(string)),
+		}
+	}
+	if v, ok := m["mode"].(string); ok && v != "" {
+		container.Mode = aws.String(v)
+	}
+	if v, ok := m["model_data_url"].(string); ok && v != "" {
+		container.ModelDataUrl = aws.String(v)
+	}
+
+	return container
+}
+
+func expandSagemakerContainers(l []interface{}) []*sagemaker.ContainerDefinition {
+	containers := make([]*sagemaker.ContainerDefinition, 0, len(l))
+
+	for _, raw := range l {
+		containers = append(containers, expandSagemakerContainer(raw.(map[string]interface{})))
+	}
+
+	return containers
+}
+
+func flattenSagemakerContainer(container *sagemaker.ContainerDefinition) []interface{} {
+	if container == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_hostname": aws.StringValue(container.ContainerHostname),
+		"environment":        aws.StringValueMap(container.Environment),
+		"image":              aws.StringValue(container.Image),
+		"image_config":       []interface{}{},
+		"mode":               aws.StringValue(container.Mode),
+		"model_data_url":     aws.StringValue(container.ModelDataUrl),
+	}
+
+	if container.ImageConfig != nil {
+		m["image_config"] = []interface{}{map[string]interface{}{
+			"repository_access_mode": aws.StringValue(container.ImageConfig.RepositoryAccessMode),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSagemakerContainers(containers []*sagemaker.ContainerDefinition) []interface{} {
+	result := make([]interface{}, 0, len(containers))
+
+	for _, container := range containers {
+		result = append(result, flattenSagemakerContainer(container)...)
+	}
+
+	return result
+}
+
+func expandSagemakerVpcConfig(l []interface{}) *sagemaker.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sagemaker.VpcConfig{
+		SecurityGroupIds: expandStringSet(m["security_group_ids"].(*schema.Set)),
+		Subnets:          expandStringSet(m["subnets"].(*schema.Set)),
+	}
+}
+
+func flattenSagemakerVpcConfig(config *sagemaker.VpcConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_ids": schema.NewSet(schema.HashString, flattenStringList(config.SecurityGroupIds)),
+		"subnets":            schema.NewSet(schema.HashString, flattenStringList(config.Subnets)),
+	}
+
+	return []interface{}{m}
+}
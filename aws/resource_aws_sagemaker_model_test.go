@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerModel_basic(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:sagemaker:[^:]+:\d{12}:model/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.image", "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.mode", "SingleModel"),
+					resource.TestCheckResourceAttr(resourceName, "enable_network_isolation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_generatedName(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig_generatedName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(`^terraform-`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_primaryContainerEnvironment(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig_primaryContainerEnvironment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.container_hostname", "test-host"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_tags(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerModelExists(n string, model *sagemaker.DescribeModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		output, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*model = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_model" {
+			continue
+		}
+
+		_, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SageMaker Model (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSSagemakerModelConfigBase pins the region because the built-in
+// algorithm images are published per region.
+func testAccAWSSagemakerModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = "${data.aws_iam_policy_document.assume_role.json}"
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfig_basic(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfig_generatedName(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + `
+resource "aws_sagemaker_model" "test" {
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+  }
+}
+`
+}
+
+func testAccAWSSagemakerModelConfig_primaryContainerEnvironment(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    container_hostname = "test-host"
+    image              = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+
+    environment {
+      foo = "bar"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+  }
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_basic(t *testing.T) {
+	var lifecycleConfig sagemaker.DescribeNotebookInstanceLifecycleConfigOutput
+	resourceName := "aws_sagemaker_notebook_instance_lifecycle_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:sagemaker:[^:]+:\d{12}:notebook-instance-lifecycle-config/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "on_create", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_start", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_update(t *testing.T) {
+	var lifecycleConfig sagemaker.DescribeNotebookInstanceLifecycleConfigOutput
+	resourceName := "aws_sagemaker_notebook_instance_lifecycle_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_scripts(rName, "echo create", "echo start"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "on_create", "ZWNobyBjcmVhdGU="),
+					resource.TestCheckResourceAttr(resourceName, "on_start", "ZWNobyBzdGFydA=="),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_scripts(rName, "echo create again", "echo start again"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "on_create", "ZWNobyBjcmVhdGUgYWdhaW4="),
+					resource.TestCheckResourceAttr(resourceName, "on_start", "ZWNobyBzdGFydCBhZ2Fpbg=="),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "on_create", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_start", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(n string, lifecycleConfig *sagemaker.DescribeNotebookInstanceLifecycleConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Notebook Instance Lifecycle Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		output, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+			NotebookInstanceLifecycleConfigName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*lifecycleConfig = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_notebook_instance_lifecycle_configuration" {
+			continue
+		}
+
+		_, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+			NotebookInstanceLifecycleConfigName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SageMaker Notebook Instance Lifecycle Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig_scripts(rName, onCreate, onStart string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name      = %[1]q
+  on_create = "${base64encode(%[2]q)}"
+  on_start  = "${base64encode(%[3]q)}"
+}
+`, rName, onCreate, onStart)
+}
@@ -0,0 +1,417 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecretsManagerSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecretsManagerSecretCreate,
+		Read:   resourceAwsSecretsManagerSecretRead,
+		Update: resourceAwsSecretsManagerSecretUpdate,
+		Delete: resourceAwsSecretsManagerSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"force_overwrite_replica_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateSecretsManagerSecretName,
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSecretsManagerSecretNamePrefix,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"recovery_window_in_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value == 0 {
+						return
+					}
+					if value < 7 || value > 30 {
+						errors = append(errors, fmt.Errorf("%q must be 0 or between 7 and 30 inclusive, got %d", k, value))
+					}
+					return
+				},
+			},
+			"replica": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      secretsManagerReplicaHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"last_accessed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &secretsmanager.CreateSecretInput{
+		Description:                 aws.String(d.Get("description").(string)),
+		ForceOverwriteReplicaSecret: aws.Bool(d.Get("force_overwrite_replica_secret").(bool)),
+		Name:                        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("replica"); ok && v.(*schema.Set).Len() > 0 {
+		input.AddReplicaRegions = expandSecretsManagerReplicaRegionTypes(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSecretsManager(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Secrets Manager Secret: %s", input)
+
+	// Retry for secret recreation after deletion
+	var output *secretsmanager.CreateSecretOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateSecret(input)
+		// Temporarily retry on these errors to support immediate secret recreation:
+		// InvalidRequestException: You can't perform this operation on the secret because it was deleted.
+		// InvalidRequestException: You can't create this secret because a secret with this name is already scheduled for deletion.
+		if isAWSErr(err, secretsmanager.ErrCodeInvalidRequestException, "scheduled for deletion") || isAWSErr(err, secretsmanager.ErrCodeInvalidRequestException, "was deleted") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Secrets Manager Secret: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ARN))
+
+	if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
+		if err := putSecretsManagerSecretPolicy(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecretsManagerSecretRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Secrets Manager Secret (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret (%s): %s", d.Id(), err)
+	}
+
+	if output.DeletedDate != nil {
+		log.Printf("[WARN] Secrets Manager Secret (%s) is scheduled for deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.ARN)
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("name", output.Name)
+	d.Set("rotation_enabled", output.RotationEnabled)
+
+	if err := d.Set("replica", flattenSecretsManagerReplicationStatus(output.ReplicationStatus)); err != nil {
+		return fmt.Errorf("error setting replica: %s", err)
+	}
+
+	policyOutput, err := conn.GetResourcePolicy(&secretsmanager.GetResourcePolicyInput{
+		SecretId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret (%s) policy: %s", d.Id(), err)
+	}
+
+	if policyOutput.ResourcePolicy != nil {
+		policy, err := structure.NormalizeJsonString(aws.StringValue(policyOutput.ResourcePolicy))
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+		d.Set("policy", policy)
+	} else {
+		d.Set("policy", "")
+	}
+
+	d.Set("tags", tagsToMapSecretsManager(output.Tags))
+
+	return nil
+}
+
+func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	if d.HasChange("description") || d.HasChange("kms_key_id") {
+		input := &secretsmanager.UpdateSecretInput{
+			Description: aws.String(d.Get("description").(string)),
+			SecretId:    aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.KmsKeyId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Secrets Manager Secret: %s", input)
+		if _, err := conn.UpdateSecret(input); err != nil {
+			return fmt.Errorf("error updating Secrets Manager Secret (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
+			if err := putSecretsManagerSecretPolicy(conn, d.Id(), v.(string)); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Deleting Secrets Manager Secret (%s) policy", d.Id())
+			_, err := conn.DeleteResourcePolicy(&secretsmanager.DeleteResourcePolicyInput{
+				SecretId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error deleting Secrets Manager Secret (%s) policy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+		oldReplicas := secretsManagerReplicaSetToMap(o.(*schema.Set))
+		newReplicas := secretsManagerReplicaSetToMap(n.(*schema.Set))
+
+		// A replica's KMS key cannot be changed in place, so such replicas
+		// are removed and then replicated again.
+		var removeRegions []*string
+		var addReplicas []*secretsmanager.ReplicaRegionType
+
+		for region, oldKmsKeyID := range oldReplicas {
+			newKmsKeyID, ok := newReplicas[region]
+			if !ok || (newKmsKeyID != "" && newKmsKeyID != oldKmsKeyID) {
+				removeRegions = append(removeRegions, aws.String(region))
+			}
+		}
+
+		for region, newKmsKeyID := range newReplicas {
+			oldKmsKeyID, ok := oldReplicas[region]
+			if !ok || (newKmsKeyID != "" && newKmsKeyID != oldKmsKeyID) {
+				replica := &secretsmanager.ReplicaRegionType{
+					Region: aws.String(region),
+				}
+				if newKmsKeyID != "" {
+					replica.KmsKeyId = aws.String(newKmsKeyID)
+				}
+				addReplicas = append(addReplicas, replica)
+			}
+		}
+
+		if len(removeRegions) > 0 {
+			input := &secretsmanager.RemoveRegionsFromReplicationInput{
+				RemoveReplicaRegions: removeRegions,
+				SecretId:             aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Removing Secrets Manager Secret replicas: %s", input)
+			if _, err := conn.RemoveRegionsFromReplication(input); err != nil {
+				return fmt.Errorf("error removing Secrets Manager Secret (%s) replicas: %s", d.Id(), err)
+			}
+		}
+
+		if len(addReplicas) > 0 {
+			input := &secretsmanager.ReplicateSecretToRegionsInput{
+				AddReplicaRegions:           addReplicas,
+				ForceOverwriteReplicaSecret: aws.Bool(d.Get("force_overwrite_replica_secret").(bool)),
+				SecretId:                    aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Adding Secrets Manager Secret replicas: %s", input)
+			if _, err := conn.ReplicateSecretToRegions(input); err != nil {
+				return fmt.Errorf("error adding Secrets Manager Secret (%s) replicas: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := setTagsSecretsManager(conn, d); err != nil {
+		return fmt.Errorf("error updating Secrets Manager Secret (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSecretsManagerSecretRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	// A secret cannot be deleted while it is still replicated.
+	if v, ok := d.GetOk("replica"); ok && v.(*schema.Set).Len() > 0 {
+		var regions []*string
+		for region := range secretsManagerReplicaSetToMap(v.(*schema.Set)) {
+			regions = append(regions, aws.String(region))
+		}
+
+		log.Printf("[DEBUG] Removing Secrets Manager Secret (%s) replicas", d.Id())
+		_, err := conn.RemoveRegionsFromReplication(&secretsmanager.RemoveRegionsFromReplicationInput{
+			RemoveReplicaRegions: regions,
+			SecretId:             aws.String(d.Id()),
+		})
+
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing Secrets Manager Secret (%s) replicas: %s", d.Id(), err)
+		}
+	}
+
+	input := &secretsmanager.DeleteSecretInput{
+		SecretId: aws.String(d.Id()),
+	}
+
+	recoveryWindowInDays := d.Get("recovery_window_in_days").(int)
+	if recoveryWindowInDays == 0 {
+		input.ForceDeleteWithoutRecovery = aws.Bool(true)
+	} else {
+		input.RecoveryWindowInDays = aws.Int64(int64(recoveryWindowInDays))
+	}
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret: %s", input)
+	_, err := conn.DeleteSecret(input)
+
+	if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Secrets Manager Secret (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putSecretsManagerSecretPolicy(conn *secretsmanager.SecretsManager, secretID, policy string) error {
+	input := &secretsmanager.PutResourcePolicyInput{
+		ResourcePolicy: aws.String(policy),
+		SecretId:       aws.String(secretID),
+	}
+
+	log.Printf("[DEBUG] Setting Secrets Manager Secret (%s) policy: %s", secretID, input)
+
+	// IAM principals referenced in the policy may not be visible yet.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutResourcePolicy(input)
+
+		if isAWSErr(err, secretsmanager.ErrCodeMalformedPolicyDocumentException, "This resource policy contains an unsupported principal") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error setting Secrets Manager Secret (%s) policy: %s", secretID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecretsManagerSecretRotationCreate,
+		Read:   resourceAwsSecretsManagerSecretRotationRead,
+		Update: resourceAwsSecretsManagerSecretRotationUpdate,
+		Delete: resourceAwsSecretsManagerSecretRotationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecretsManagerSecretRotationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rotate_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rotation_lambda_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"rotation_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9]|1[0-9]|2[0-4])h$`), "must be a number of hours between 1h and 24h, e.g. 3h"),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecretsManagerSecretRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+	secretID := d.Get("secret_id").(string)
+
+	output, err := rotateSecretsManagerSecret(conn, d, secretID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(output.ARN))
+
+	return resourceAwsSecretsManagerSecretRotationRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Secrets Manager Secret (%s) not found, removing rotation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret (%s) rotation: %s", d.Id(), err)
+	}
+
+	if output.DeletedDate != nil {
+		log.Printf("[WARN] Secrets Manager Secret (%s) is scheduled for deletion, removing rotation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !aws.BoolValue(output.RotationEnabled) {
+		log.Printf("[WARN] Secrets Manager Secret (%s) rotation is disabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("rotation_enabled", output.RotationEnabled)
+	d.Set("rotation_lambda_arn", output.RotationLambdaARN)
+	d.Set("secret_id", output.ARN)
+
+	if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
+		return fmt.Errorf("error setting rotation_rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecretsManagerSecretRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	if d.HasChange("rotation_lambda_arn") || d.HasChange("rotation_rules") {
+		if _, err := rotateSecretsManagerSecret(conn, d, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecretsManagerSecretRotationRead(d, meta)
+}
+
+func resourceAwsSecretsManagerSecretRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	log.Printf("[DEBUG] Cancelling Secrets Manager Secret (%s) rotation", d.Id())
+	_, err := conn.CancelRotateSecret(&secretsmanager.CancelRotateSecretInput{
+		SecretId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling Secrets Manager Secret (%s) rotation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecretsManagerSecretRotationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// rotate_immediately only affects the RotateSecret call itself.
+	d.Set("rotate_immediately", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+func rotateSecretsManagerSecret(conn *secretsmanager.SecretsManager, d *schema.ResourceData, secretID string) (*secretsmanager.RotateSecretOutput, error) {
+	input := &secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
+		RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
+		SecretId:          aws.String(secretID),
+	}
+
+	if v, ok := d.GetOk("rotation_lambda_arn"); ok {
+		input.RotationLambdaARN = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
+
+	var output *secretsmanager.RotateSecretOutput
+
+	// Lambda permissions granted to Secrets Manager may take a moment to
+	// propagate.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.RotateSecret(input)
+
+		if isAWSErr(err, "AccessDeniedException", "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, secretsmanager.ErrCodeInvalidRequestException, "A previous rotation isn't complete") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error enabling Secrets Manager Secret (%s) rotation: %s", secretID, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSecretsManagerSecretRotation_basic(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotate_immediately", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_lambda_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "7"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", secretResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfig(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "14"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_immediately"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecretRotation_scheduleExpression(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfigScheduleExpression(rName, "rate(10 days)", "3h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.duration", "3h"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.schedule_expression", "rate(10 days)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecretsManagerSecretRotationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_rotation" {
+			continue
+		}
+
+		output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.DeletedDate == nil && aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secrets Manager Secret (%s) rotation still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSecretsManagerSecretRotationExists(resourceName string, secret *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Secrets Manager Secret ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+
+		output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || !aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secrets Manager Secret (%s) rotation not enabled", rs.Primary.ID)
+		}
+
+		*secret = *output
+
+		return nil
+	}
+}
+
+func testAccAwsSecretsManagerSecretRotationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "exports.example"
+  role          = "${aws_iam_role.test.arn}"
+  runtime       = "nodejs8.10"
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = "${aws_lambda_function.test.function_name}"
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretRotationConfig(rName string, automaticallyAfterDays int) string {
+	return testAccAwsSecretsManagerSecretRotationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_secretsmanager_secret_rotation" "test" {
+  rotate_immediately  = false
+  rotation_lambda_arn = "${aws_lambda_function.test.arn}"
+  secret_id           = "${aws_secretsmanager_secret.test.id}"
+
+  rotation_rules {
+    automatically_after_days = %[1]d
+  }
+
+  depends_on = ["aws_lambda_permission.test"]
+}
+`, automaticallyAfterDays)
+}
+
+func testAccAwsSecretsManagerSecretRotationConfigScheduleExpression(rName, scheduleExpression, duration string) string {
+	return testAccAwsSecretsManagerSecretRotationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_secretsmanager_secret_rotation" "test" {
+  rotate_immediately  = false
+  rotation_lambda_arn = "${aws_lambda_function.test.arn}"
+  secret_id           = "${aws_secretsmanager_secret.test.id}"
+
+  rotation_rules {
+    duration            = %[2]q
+    schedule_expression = %[1]q
+  }
+
+  depends_on = ["aws_lambda_permission.test"]
+}
+`, scheduleExpression, duration)
+}
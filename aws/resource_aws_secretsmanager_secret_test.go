@@ -0,0 +1,389 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSecretsManagerSecret_basic(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:secretsmanager:[^:]+:[^:]+:secret:%s-.+$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "recovery_window_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_overwrite_replica_secret", "recovery_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecret_withNamePrefix(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rPrefix := "tf-acc-test-prefix-"
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_withNamePrefix(rPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(fmt.Sprintf("^%s", rPrefix))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecret_Description(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecret_Policy(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"Action":"secretsmanager:GetSecretValue"`)),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecret_Replica(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+	replicaHash := secretsManagerReplicaHash(map[string]interface{}{"region": "us-east-1"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAwsSecretsManagerSecretDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Replica(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExistsWithProvider(resourceName, &secret, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("replica.%d.region", replicaHash), "us-east-1"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("replica.%d.kms_key_id", replicaHash)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("replica.%d.status", replicaHash)),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_ReplicaKmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExistsWithProvider(resourceName, &secret, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("replica.%d.region", replicaHash), "us-east-1"),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_NoReplica(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExistsWithProvider(resourceName, &secret, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretsManagerSecret_Tags(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Tags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tag1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_Tags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.tag1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecretsManagerSecretDestroy(s *terraform.State) error {
+	return testAccCheckAwsSecretsManagerSecretDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckAwsSecretsManagerSecretDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).secretsmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret" {
+			continue
+		}
+
+		output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.DeletedDate == nil {
+			return fmt.Errorf("Secrets Manager Secret (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSecretsManagerSecretExists(resourceName string, secret *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return testAccCheckAwsSecretsManagerSecretExistsWithProvider(resourceName, secret, func() *schema.Provider { return testAccProvider })
+}
+
+func testAccCheckAwsSecretsManagerSecretExistsWithProvider(resourceName string, secret *secretsmanager.DescribeSecretOutput, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Secrets Manager Secret ID is set")
+		}
+
+		provider := providerF()
+		if provider == nil {
+			return fmt.Errorf("No provider found for %s", resourceName)
+		}
+
+		conn := provider.Meta().(*AWSClient).secretsmanagerconn
+
+		output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.DeletedDate != nil {
+			return fmt.Errorf("Secrets Manager Secret (%s) not found", rs.Primary.ID)
+		}
+
+		*secret = *output
+
+		return nil
+	}
+}
+
+func testAccAwsSecretsManagerSecretConfig_Name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretConfig_withNamePrefix(rPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name_prefix             = %[1]q
+  recovery_window_in_days = 0
+}
+`, rPrefix)
+}
+
+func testAccAwsSecretsManagerSecretConfig_Description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  description             = %[2]q
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+`, rName, description)
+}
+
+func testAccAwsSecretsManagerSecretConfig_Policy(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"
+      },
+      "Action": "secretsmanager:GetSecretValue",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretConfig_Tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+
+  tags {
+    tag1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
+
+const testAccAwsSecretsManagerSecretConfig_ReplicaProviders = `
+provider "aws" {
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "replica"
+  region = "us-east-1"
+}
+`
+
+func testAccAwsSecretsManagerSecretConfig_NoReplica(rName string) string {
+	return testAccAwsSecretsManagerSecretConfig_ReplicaProviders + fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretConfig_Replica(rName string) string {
+	return testAccAwsSecretsManagerSecretConfig_ReplicaProviders + fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+
+  replica {
+    region = "us-east-1"
+  }
+}
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretConfig_ReplicaKmsKey(rName string) string {
+	return testAccAwsSecretsManagerSecretConfig_ReplicaProviders + fmt.Sprintf(`
+resource "aws_kms_key" "replica" {
+  provider = "aws.replica"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+
+  replica {
+    kms_key_id = "${aws_kms_key.replica.key_id}"
+    region     = "us-east-1"
+  }
+}
+`, rName)
+}
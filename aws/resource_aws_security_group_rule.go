@@ -24,6 +24,9 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 		Read:   resourceAwsSecurityGroupRuleRead,
 		Update: resourceAwsSecurityGroupRuleUpdate,
 		Delete: resourceAwsSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityGroupRuleImport,
+		},
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
@@ -112,6 +115,13 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateSecurityGroupRuleDescription,
 			},
+
+			"security_group_rule_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -145,6 +155,7 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	isVPC := sg.VpcId != nil && *sg.VpcId != ""
 
 	var autherr error
+	var authorizedRules []*ec2.SecurityGroupRule
 	switch ruleType {
 	case "ingress":
 		log.Printf("[DEBUG] Authorizing security group %s %s rule: %s",
@@ -160,7 +171,11 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 			req.GroupName = sg.GroupName
 		}
 
-		_, autherr = conn.AuthorizeSecurityGroupIngress(req)
+		var resp *ec2.AuthorizeSecurityGroupIngressOutput
+		resp, autherr = conn.AuthorizeSecurityGroupIngress(req)
+		if resp != nil {
+			authorizedRules = resp.SecurityGroupRules
+		}
 
 	case "egress":
 		log.Printf("[DEBUG] Authorizing security group %s %s rule: %#v",
@@ -171,7 +186,11 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 			IpPermissions: []*ec2.IpPermission{perm},
 		}
 
-		_, autherr = conn.AuthorizeSecurityGroupEgress(req)
+		var resp *ec2.AuthorizeSecurityGroupEgressOutput
+		resp, autherr = conn.AuthorizeSecurityGroupEgress(req)
+		if resp != nil {
+			authorizedRules = resp.SecurityGroupRules
+		}
 
 	default:
 		return fmt.Errorf("Security Group Rule must be type 'ingress' or type 'egress'")
@@ -193,10 +212,41 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 			ruleType, autherr)
 	}
 
+	var ruleIDs []*string
+	for _, rule := range authorizedRules {
+		ruleIDs = append(ruleIDs, rule.SecurityGroupRuleId)
+	}
+
+	// Rules are identified by their security group rule IDs. EC2-Classic
+	// does not report any, so those rules are still identified by a hash of
+	// their attributes.
 	id := ipPermissionIDHash(sg_id, ruleType, perm)
+	if len(ruleIDs) > 0 {
+		id = securityGroupRuleIDFromRuleIDs(ruleIDs)
+	}
 	log.Printf("[DEBUG] Computed group rule ID %s", id)
 
 	retErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if len(ruleIDs) > 0 {
+			rules, err := ec2DescribeSecurityGroupRules(conn, ruleIDs)
+
+			if isAWSErr(err, "InvalidSecurityGroupRuleId.NotFound", "") {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if len(rules) != len(ruleIDs) {
+				log.Printf("[DEBUG] Unable to find all %s Security Group Rules (%s) for Group %s",
+					ruleType, id, sg_id)
+				return resource.RetryableError(fmt.Errorf("No match found"))
+			}
+
+			return nil
+		}
+
 		sg, err := findResourceSecurityGroup(conn, sg_id)
 
 		if err != nil {
@@ -230,6 +280,8 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 	}
 
 	d.SetId(id)
+	d.Set("security_group_rule_ids", flattenStringList(ruleIDs))
+
 	return nil
 }
 
@@ -247,10 +299,44 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	}
 
 	isVPC := sg.VpcId != nil && *sg.VpcId != ""
+	ruleType := d.Get("type").(string)
+
+	if v := d.Get("security_group_rule_ids").(*schema.Set); v.Len() > 0 {
+		ruleIDs := expandStringSet(v)
+		securityGroupRules, err := ec2DescribeSecurityGroupRules(conn, ruleIDs)
+
+		if isAWSErr(err, "InvalidSecurityGroupRuleId.NotFound", "") {
+			log.Printf("[WARN] Security Group Rule (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Security Group Rule (%s): %s", d.Id(), err)
+		}
+
+		if len(securityGroupRules) != len(ruleIDs) {
+			log.Printf("[WARN] Security Group Rule (%s) only partially found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := setFromEc2SecurityGroupRules(d, securityGroupRules); err != nil {
+			return fmt.Errorf("error setting Security Group Rule (%s): %s", d.Id(), err)
+		}
+
+		d.SetId(securityGroupRuleIDFromRuleIDs(ruleIDs))
+
+		return nil
+	}
+
+	p, err := expandIPPerm(d, sg)
+	if err != nil {
+		return err
+	}
 
 	var rule *ec2.IpPermission
 	var rules []*ec2.IpPermission
-	ruleType := d.Get("type").(string)
 	switch ruleType {
 	case "ingress":
 		rules = sg.IpPermissions
@@ -258,11 +344,6 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 		rules = sg.IpPermissionsEgress
 	}
 
-	p, err := expandIPPerm(d, sg)
-	if err != nil {
-		return err
-	}
-
 	if len(rules) == 0 {
 		log.Printf("[WARN] No %s rules were found for Security Group (%s) looking for Security Group Rule (%s)",
 			ruleType, *sg.GroupName, d.Id())
@@ -288,6 +369,20 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("description", descriptionFromIPPerm(d, rule))
 
+	// Rules created before security group rule IDs were tracked are matched
+	// by attributes once and addressed by their rule IDs from then on.
+	if isVPC {
+		ruleIDs, err := ec2SecurityGroupRuleIDsForIPPerm(conn, sg_id, ruleType == "egress", p)
+		if err != nil {
+			return fmt.Errorf("error reading Security Group Rule (%s) IDs: %s", d.Id(), err)
+		}
+
+		if len(ruleIDs) > 0 {
+			d.SetId(securityGroupRuleIDFromRuleIDs(ruleIDs))
+		}
+		d.Set("security_group_rule_ids", flattenStringList(ruleIDs))
+	}
+
 	return nil
 }
 
@@ -315,11 +410,43 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	ruleType := d.Get("type").(string)
+
+	if v := d.Get("security_group_rule_ids").(*schema.Set); v.Len() > 0 {
+		ruleIDs := expandStringSet(v)
+
+		switch ruleType {
+		case "ingress":
+			log.Printf("[DEBUG] Revoking %s rules (%s) from security group %s", ruleType, d.Id(), sg_id)
+			_, err = conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              sg.GroupId,
+				SecurityGroupRuleIds: ruleIDs,
+			})
+		case "egress":
+			log.Printf("[DEBUG] Revoking %s rules (%s) from security group %s", ruleType, d.Id(), sg_id)
+			_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              sg.GroupId,
+				SecurityGroupRuleIds: ruleIDs,
+			})
+		}
+
+		if isAWSErr(err, "InvalidSecurityGroupRuleId.NotFound", "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf(
+				"Error revoking security group %s rules: %s",
+				sg_id, err)
+		}
+
+		return nil
+	}
+
 	perm, err := expandIPPerm(d, sg)
 	if err != nil {
 		return err
 	}
-	ruleType := d.Get("type").(string)
 	switch ruleType {
 	case "ingress":
 		log.Printf("[DEBUG] Revoking rule (%s) from security group %s:\n%s",
@@ -812,6 +939,47 @@ func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.Resourc
 		return err
 	}
 
+	if v := d.Get("security_group_rule_ids").(*schema.Set); v.Len() > 0 {
+		securityGroupRules, err := ec2DescribeSecurityGroupRules(conn, expandStringSet(v))
+		if err != nil {
+			return fmt.Errorf("error reading Security Group Rule (%s): %s", d.Id(), err)
+		}
+
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: sg.GroupId,
+		}
+
+		for _, rule := range securityGroupRules {
+			request := &ec2.SecurityGroupRuleRequest{
+				CidrIpv4:     rule.CidrIpv4,
+				CidrIpv6:     rule.CidrIpv6,
+				Description:  aws.String(d.Get("description").(string)),
+				FromPort:     rule.FromPort,
+				IpProtocol:   rule.IpProtocol,
+				PrefixListId: rule.PrefixListId,
+				ToPort:       rule.ToPort,
+			}
+
+			if rule.ReferencedGroupInfo != nil {
+				request.ReferencedGroupId = rule.ReferencedGroupInfo.GroupId
+			}
+
+			input.SecurityGroupRules = append(input.SecurityGroupRules, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRule:   request,
+				SecurityGroupRuleId: rule.SecurityGroupRuleId,
+			})
+		}
+
+		log.Printf("[DEBUG] Modifying Security Group Rules: %s", input)
+		if _, err := conn.ModifySecurityGroupRules(input); err != nil {
+			return fmt.Errorf(
+				"Error updating security group %s rule description: %s",
+				sg_id, err)
+		}
+
+		return nil
+	}
+
 	perm, err := expandIPPerm(d, sg)
 	if err != nil {
 		return err
@@ -848,3 +1016,266 @@ func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.Resourc
 
 	return nil
 }
+
+// resourceAwsSecurityGroupRuleImport imports one or more security group
+// rule IDs, separated by commas, that together make up a single rule resource.
+func resourceAwsSecurityGroupRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	ruleIDs := aws.StringSlice(strings.Split(d.Id(), ","))
+
+	securityGroupRules, err := ec2DescribeSecurityGroupRules(conn, ruleIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Security Group Rules (%s): %s", d.Id(), err)
+	}
+
+	if len(securityGroupRules) != len(ruleIDs) {
+		return nil, fmt.Errorf("error importing Security Group Rules (%s): expected %d rules, found %d", d.Id(), len(ruleIDs), len(securityGroupRules))
+	}
+
+	first := securityGroupRules[0]
+	sgID := aws.StringValue(first.GroupId)
+	var sourceSecurityGroupID string
+
+	for _, rule := range securityGroupRules {
+		if aws.StringValue(rule.GroupId) != sgID || aws.BoolValue(rule.IsEgress) != aws.BoolValue(first.IsEgress) ||
+			aws.StringValue(rule.IpProtocol) != aws.StringValue(first.IpProtocol) ||
+			aws.Int64Value(rule.FromPort) != aws.Int64Value(first.FromPort) || aws.Int64Value(rule.ToPort) != aws.Int64Value(first.ToPort) ||
+			aws.StringValue(rule.Description) != aws.StringValue(first.Description) {
+			return nil, fmt.Errorf("error importing Security Group Rules (%s): all rules must share the same group, type, protocol, ports and description", d.Id())
+		}
+
+		if rule.ReferencedGroupInfo != nil {
+			if sourceSecurityGroupID != "" {
+				return nil, fmt.Errorf("error importing Security Group Rules (%s): only one referenced security group is supported", d.Id())
+			}
+			sourceSecurityGroupID = aws.StringValue(rule.ReferencedGroupInfo.GroupId)
+		}
+	}
+
+	// A rule referencing its own group is imported as self = true.
+	d.Set("self", sourceSecurityGroupID == sgID)
+
+	if err := setFromEc2SecurityGroupRules(d, securityGroupRules); err != nil {
+		return nil, fmt.Errorf("error importing Security Group Rules (%s): %s", d.Id(), err)
+	}
+
+	d.Set("security_group_rule_ids", flattenStringList(ruleIDs))
+	d.SetId(securityGroupRuleIDFromRuleIDs(ruleIDs))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setFromEc2SecurityGroupRules sets the arguments of a rule from the individual
+// security group rules that together make it up.
+//
+// The rules normally agree on their group, type, protocol, ports and
+// description. Where one of them was modified outside of Terraform, the value
+// that differs from state is reported so that the change shows up in the plan.
+func setFromEc2SecurityGroupRules(d *schema.ResourceData, rules []*ec2.SecurityGroupRule) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("no security group rules found")
+	}
+
+	var sgID, ruleType, protocol, description string
+	// The ports of rules for all protocols are ignored by EC2, so the
+	// configured ones are kept.
+	fromPort := int64(d.Get("from_port").(int))
+	toPort := int64(d.Get("to_port").(int))
+	self := d.Get("self").(bool)
+
+	var cidrBlocks, ipv6CidrBlocks, prefixListIDs []string
+	var sourceSecurityGroupID string
+	selfFound := false
+
+	for i, rule := range rules {
+		ruleSgID := aws.StringValue(rule.GroupId)
+		ruleRuleType := "ingress"
+		if aws.BoolValue(rule.IsEgress) {
+			ruleRuleType = "egress"
+		}
+		ruleProtocol := protocolForValue(aws.StringValue(rule.IpProtocol))
+		ruleDescription := aws.StringValue(rule.Description)
+
+		if i == 0 || ruleSgID != d.Get("security_group_id").(string) {
+			sgID = ruleSgID
+		}
+		if i == 0 || ruleRuleType != d.Get("type").(string) {
+			ruleType = ruleRuleType
+		}
+		if i == 0 || ruleProtocol != d.Get("protocol").(string) {
+			protocol = ruleProtocol
+		}
+		if i == 0 || ruleDescription != d.Get("description").(string) {
+			description = ruleDescription
+		}
+
+		if ruleProtocol != "-1" {
+			if i == 0 || aws.Int64Value(rule.FromPort) != int64(d.Get("from_port").(int)) {
+				fromPort = aws.Int64Value(rule.FromPort)
+			}
+			if i == 0 || aws.Int64Value(rule.ToPort) != int64(d.Get("to_port").(int)) {
+				toPort = aws.Int64Value(rule.ToPort)
+			}
+		}
+
+		switch {
+		case rule.CidrIpv4 != nil:
+			cidrBlocks = append(cidrBlocks, aws.StringValue(rule.CidrIpv4))
+		case rule.CidrIpv6 != nil:
+			ipv6CidrBlocks = append(ipv6CidrBlocks, aws.StringValue(rule.CidrIpv6))
+		case rule.PrefixListId != nil:
+			prefixListIDs = append(prefixListIDs, aws.StringValue(rule.PrefixListId))
+		case rule.ReferencedGroupInfo != nil:
+			referencedGroupID := aws.StringValue(rule.ReferencedGroupInfo.GroupId)
+			if self && referencedGroupID == ruleSgID {
+				selfFound = true
+				continue
+			}
+
+			sourceSecurityGroupID = referencedGroupID
+			// Groups in other accounts may be referenced as <owner>/<group ID>.
+			if v := fmt.Sprintf("%s/%s", aws.StringValue(rule.ReferencedGroupInfo.UserId), referencedGroupID); v == d.Get("source_security_group_id").(string) {
+				sourceSecurityGroupID = v
+			}
+		}
+	}
+
+	d.Set("security_group_id", sgID)
+	d.Set("type", ruleType)
+	d.Set("protocol", protocol)
+	d.Set("from_port", fromPort)
+	d.Set("to_port", toPort)
+	d.Set("description", description)
+	d.Set("self", selfFound)
+	d.Set("source_security_group_id", sourceSecurityGroupID)
+
+	if err := d.Set("cidr_blocks", orderSecurityGroupRuleValues(d, "cidr_blocks", cidrBlocks)); err != nil {
+		return fmt.Errorf("error setting cidr_blocks: %s", err)
+	}
+	if err := d.Set("ipv6_cidr_blocks", orderSecurityGroupRuleValues(d, "ipv6_cidr_blocks", ipv6CidrBlocks)); err != nil {
+		return fmt.Errorf("error setting ipv6_cidr_blocks: %s", err)
+	}
+	if err := d.Set("prefix_list_ids", orderSecurityGroupRuleValues(d, "prefix_list_ids", prefixListIDs)); err != nil {
+		return fmt.Errorf("error setting prefix_list_ids: %s", err)
+	}
+
+	return nil
+}
+
+// orderSecurityGroupRuleValues orders the values reported by EC2 as they
+// appear in the given list attribute, so that the order in which EC2 returns
+// the rules does not show up as a difference. Values that are not in the
+// attribute are appended.
+func orderSecurityGroupRuleValues(d *schema.ResourceData, key string, values []string) []string {
+	found := make(map[string]bool, len(values))
+	for _, v := range values {
+		found[v] = true
+	}
+
+	var ordered []string
+	for _, v := range d.Get(key).([]interface{}) {
+		if s, ok := v.(string); ok && found[s] {
+			ordered = append(ordered, s)
+			delete(found, s)
+		}
+	}
+
+	for _, v := range values {
+		if found[v] {
+			ordered = append(ordered, v)
+			delete(found, v)
+		}
+	}
+
+	return ordered
+}
+
+// securityGroupRuleIDFromRuleIDs returns the ID of a rule made up of the given
+// security group rule IDs. It is also the format accepted on import.
+func securityGroupRuleIDFromRuleIDs(ruleIDs []*string) string {
+	ids := aws.StringValueSlice(ruleIDs)
+	sort.Strings(ids)
+
+	return strings.Join(ids, ",")
+}
+
+func ec2DescribeSecurityGroupRules(conn *ec2.EC2, ruleIDs []*string) ([]*ec2.SecurityGroupRule, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: ruleIDs,
+	}
+
+	var securityGroupRules []*ec2.SecurityGroupRule
+	err := conn.DescribeSecurityGroupRulesPages(input, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		securityGroupRules = append(securityGroupRules, page.SecurityGroupRules...)
+		return !lastPage
+	})
+
+	return securityGroupRules, err
+}
+
+// ec2SecurityGroupRuleIDsForIPPerm returns the IDs of the individual security
+// group rules in the given group that together make up the IP permission.
+func ec2SecurityGroupRuleIDsForIPPerm(conn *ec2.EC2, sgID string, isEgress bool, p *ec2.IpPermission) ([]*string, error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"group-id": sgID,
+		}),
+	}
+
+	var ruleIDs []*string
+	err := conn.DescribeSecurityGroupRulesPages(input, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		for _, rule := range page.SecurityGroupRules {
+			if securityGroupRuleMatchesIPPerm(rule, isEgress, p) {
+				ruleIDs = append(ruleIDs, rule.SecurityGroupRuleId)
+			}
+		}
+		return !lastPage
+	})
+
+	return ruleIDs, err
+}
+
+func securityGroupRuleMatchesIPPerm(rule *ec2.SecurityGroupRule, isEgress bool, p *ec2.IpPermission) bool {
+	if aws.BoolValue(rule.IsEgress) != isEgress {
+		return false
+	}
+
+	protocol := aws.StringValue(p.IpProtocol)
+	if aws.StringValue(rule.IpProtocol) != protocol {
+		return false
+	}
+
+	if protocol != "-1" && (aws.Int64Value(rule.FromPort) != aws.Int64Value(p.FromPort) || aws.Int64Value(rule.ToPort) != aws.Int64Value(p.ToPort)) {
+		return false
+	}
+
+	switch {
+	case rule.CidrIpv4 != nil:
+		for _, r := range p.IpRanges {
+			if aws.StringValue(r.CidrIp) == aws.StringValue(rule.CidrIpv4) {
+				return true
+			}
+		}
+	case rule.CidrIpv6 != nil:
+		for _, r := range p.Ipv6Ranges {
+			if aws.StringValue(r.CidrIpv6) == aws.StringValue(rule.CidrIpv6) {
+				return true
+			}
+		}
+	case rule.PrefixListId != nil:
+		for _, pl := range p.PrefixListIds {
+			if aws.StringValue(pl.PrefixListId) == aws.StringValue(rule.PrefixListId) {
+				return true
+			}
+		}
+	case rule.ReferencedGroupInfo != nil:
+		for _, pair := range p.UserIdGroupPairs {
+			if aws.StringValue(pair.GroupId) == aws.StringValue(rule.ReferencedGroupInfo.GroupId) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
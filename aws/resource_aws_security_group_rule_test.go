@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -108,6 +110,192 @@ func TestIpPermissionIDHash(t *testing.T) {
 	}
 }
 
+func TestSecurityGroupRuleMatchesIPPerm(t *testing.T) {
+	perm := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(80),
+		ToPort:     aws.Int64(8000),
+		IpRanges: []*ec2.IpRange{
+			{CidrIp: aws.String("10.0.0.0/8")},
+		},
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{
+			{GroupId: aws.String("sg-22222")},
+		},
+	}
+
+	allTraffic := &ec2.IpPermission{
+		IpProtocol: aws.String("-1"),
+		FromPort:   aws.Int64(0),
+		ToPort:     aws.Int64(0),
+		Ipv6Ranges: []*ec2.Ipv6Range{
+			{CidrIpv6: aws.String("::/0")},
+		},
+	}
+
+	cases := []struct {
+		Rule     *ec2.SecurityGroupRule
+		IsEgress bool
+		Perm     *ec2.IpPermission
+		Expected bool
+	}{
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:   aws.Bool(false),
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(80),
+				ToPort:     aws.Int64(8000),
+				CidrIpv4:   aws.String("10.0.0.0/8"),
+			},
+			Perm:     perm,
+			Expected: true,
+		},
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:            aws.Bool(false),
+				IpProtocol:          aws.String("tcp"),
+				FromPort:            aws.Int64(80),
+				ToPort:              aws.Int64(8000),
+				ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-22222")},
+			},
+			Perm:     perm,
+			Expected: true,
+		},
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:   aws.Bool(true),
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(80),
+				ToPort:     aws.Int64(8000),
+				CidrIpv4:   aws.String("10.0.0.0/8"),
+			},
+			Perm:     perm,
+			Expected: false,
+		},
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:   aws.Bool(false),
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				CidrIpv4:   aws.String("10.0.0.0/8"),
+			},
+			Perm:     perm,
+			Expected: false,
+		},
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:   aws.Bool(false),
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(80),
+				ToPort:     aws.Int64(8000),
+				CidrIpv4:   aws.String("192.168.0.0/16"),
+			},
+			Perm:     perm,
+			Expected: false,
+		},
+		{
+			Rule: &ec2.SecurityGroupRule{
+				IsEgress:   aws.Bool(true),
+				IpProtocol: aws.String("-1"),
+				FromPort:   aws.Int64(-1),
+				ToPort:     aws.Int64(-1),
+				CidrIpv6:   aws.String("::/0"),
+			},
+			IsEgress: true,
+			Perm:     allTraffic,
+			Expected: true,
+		},
+	}
+
+	for i, tc := range cases {
+		actual := securityGroupRuleMatchesIPPerm(tc.Rule, tc.IsEgress, tc.Perm)
+		if actual != tc.Expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestSetFromEc2SecurityGroupRules(t *testing.T) {
+	d := resourceAwsSecurityGroupRule().TestResourceData()
+	d.Set("security_group_id", "sg-11111")
+	d.Set("type", "ingress")
+	d.Set("protocol", "tcp")
+	d.Set("from_port", 80)
+	d.Set("to_port", 80)
+	d.Set("cidr_blocks", []string{"10.0.0.0/8", "192.168.0.0/16"})
+	d.Set("self", true)
+	d.Set("description", "web")
+
+	rules := []*ec2.SecurityGroupRule{
+		{
+			GroupId:             aws.String("sg-11111"),
+			IsEgress:            aws.Bool(false),
+			IpProtocol:          aws.String("tcp"),
+			FromPort:            aws.Int64(80),
+			ToPort:              aws.Int64(80),
+			ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-11111")},
+			Description:         aws.String("web"),
+		},
+		{
+			GroupId:     aws.String("sg-11111"),
+			IsEgress:    aws.Bool(false),
+			IpProtocol:  aws.String("tcp"),
+			FromPort:    aws.Int64(80),
+			ToPort:      aws.Int64(80),
+			CidrIpv4:    aws.String("192.168.0.0/16"),
+			Description: aws.String("web"),
+		},
+		// Modified outside of Terraform.
+		{
+			GroupId:     aws.String("sg-11111"),
+			IsEgress:    aws.Bool(false),
+			IpProtocol:  aws.String("tcp"),
+			FromPort:    aws.Int64(8080),
+			ToPort:      aws.Int64(8080),
+			CidrIpv4:    aws.String("10.0.0.0/8"),
+			Description: aws.String("changed"),
+		},
+		{
+			GroupId:     aws.String("sg-11111"),
+			IsEgress:    aws.Bool(false),
+			IpProtocol:  aws.String("tcp"),
+			FromPort:    aws.Int64(80),
+			ToPort:      aws.Int64(80),
+			CidrIpv4:    aws.String("172.16.0.0/12"),
+			Description: aws.String("web"),
+		},
+	}
+
+	if err := setFromEc2SecurityGroupRules(d, rules); err != nil {
+		t.Fatalf("error setting from rules: %s", err)
+	}
+
+	if v := d.Get("from_port").(int); v != 8080 {
+		t.Errorf("expected from_port 8080, got %d", v)
+	}
+	if v := d.Get("to_port").(int); v != 8080 {
+		t.Errorf("expected to_port 8080, got %d", v)
+	}
+	if v := d.Get("description").(string); v != "changed" {
+		t.Errorf("expected description %q, got %q", "changed", v)
+	}
+	if v := d.Get("self").(bool); !v {
+		t.Errorf("expected self to be true")
+	}
+	if v := d.Get("source_security_group_id").(string); v != "" {
+		t.Errorf("expected no source_security_group_id, got %q", v)
+	}
+
+	expected := []interface{}{"10.0.0.0/8", "192.168.0.0/16", "172.16.0.0/12"}
+	if v := d.Get("cidr_blocks").([]interface{}); !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected cidr_blocks %v, got %v", expected, v)
+	}
+
+	if v := securityGroupRuleIDFromRuleIDs(aws.StringSlice([]string{"sgr-2", "sgr-1"})); v != "sgr-1,sgr-2" {
+		t.Errorf("expected ID %q, got %q", "sgr-1,sgr-2", v)
+	}
+}
+
 func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 	var group ec2.SecurityGroup
 	rInt := acctest.RandInt()
@@ -139,9 +327,37 @@ func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 					testAccCheckAWSSecurityGroupRuleAttributes("aws_security_group_rule.ingress_1", &group, nil, "ingress"),
 					resource.TestCheckResourceAttr(
 						"aws_security_group_rule.ingress_1", "from_port", "80"),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.ingress_1", "security_group_rule_ids.#", "1"),
 					testRuleCount,
 				),
 			},
+			{
+				ResourceName:      "aws_security_group_rule.ingress_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRule_Ingress_ModifiedOutOfBand(t *testing.T) {
+	var group ec2.SecurityGroup
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRuleIngressConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					testAccCheckAWSSecurityGroupRuleModifyPorts("aws_security_group_rule.ingress_1", 443, 443),
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -642,6 +858,11 @@ func TestAccAWSSecurityGroupRule_IngressDescription_updates(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_security_group_rule.ingress_1", "description", "TF acceptance test ingress rule updated"),
 				),
 			},
+			{
+				ResourceName:      "aws_security_group_rule.ingress_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -672,6 +893,11 @@ func TestAccAWSSecurityGroupRule_EgressDescription_updates(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_security_group_rule.egress_1", "description", "TF acceptance test egress rule updated"),
 				),
 			},
+			{
+				ResourceName:      "aws_security_group_rule.egress_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -843,6 +1069,39 @@ func testAccCheckAWSSecurityGroupRuleDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckAWSSecurityGroupRuleModifyPorts changes the ports of the EC2
+// security group rules making up the given rule outside of Terraform.
+func testAccCheckAWSSecurityGroupRuleModifyPorts(n string, fromPort, toPort int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		var updates []*ec2.SecurityGroupRuleUpdate
+		for _, id := range strings.Split(rs.Primary.ID, ",") {
+			updates = append(updates, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRuleId: aws.String(id),
+				SecurityGroupRule: &ec2.SecurityGroupRuleRequest{
+					CidrIpv4:   aws.String(rs.Primary.Attributes["cidr_blocks.0"]),
+					FromPort:   aws.Int64(fromPort),
+					IpProtocol: aws.String(rs.Primary.Attributes["protocol"]),
+					ToPort:     aws.Int64(toPort),
+				},
+			})
+		}
+
+		_, err := conn.ModifySecurityGroupRules(&ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(rs.Primary.Attributes["security_group_id"]),
+			SecurityGroupRules: updates,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckAWSSecurityGroupRuleExists(n string, group *ec2.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1421,6 +1421,32 @@ func TestAccAWSSecurityGroup_egressWithPrefixList(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ingressWithManagedPrefixList(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigManagedPrefixListIngress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group.test", "ingress.#", "1"),
+					func(*terraform.State) error {
+						if len(group.IpPermissions) != 1 || len(group.IpPermissions[0].PrefixListIds) != 1 {
+							return fmt.Errorf("Expected 1 ingress rule with 1 prefix list, got %s", group.IpPermissions)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_emptyRuleDescription(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -2856,3 +2882,38 @@ resource "aws_security_group" "egress" {
     }
 }
 `
+
+func testAccAWSSecurityGroupConfigManagedPrefixListIngress(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 2
+  name           = %[1]q
+
+  entry {
+    cidr        = "10.1.0.0/16"
+    description = "office"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "${aws_vpc.test.id}"
+
+  ingress {
+    protocol        = "tcp"
+    from_port       = 443
+    to_port         = 443
+    prefix_list_ids = ["${aws_ec2_managed_prefix_list.test.id}"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubAccountCreate,
+		Read:   resourceAwsSecurityHubAccountRead,
+		Update: resourceAwsSecurityHubAccountUpdate,
+		Delete: resourceAwsSecurityHubAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityHubAccountImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_enable_controls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"control_finding_generator": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityhub.ControlFindingGeneratorSecurityControl,
+					securityhub.ControlFindingGeneratorStandardControl,
+				}, false),
+			},
+			"enable_default_standards": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.EnableSecurityHubInput{
+		EnableDefaultStandards: aws.Bool(d.Get("enable_default_standards").(bool)),
+	}
+
+	if v, ok := d.GetOk("control_finding_generator"); ok {
+		input.ControlFindingGenerator = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Enabling Security Hub for account: %s", input)
+	_, err := conn.EnableSecurityHub(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Security Hub for account: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	if !d.Get("auto_enable_controls").(bool) {
+		_, err := conn.UpdateSecurityHubConfiguration(&securityhub.UpdateSecurityHubConfigurationInput{
+			AutoEnableControls: aws.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Security Hub Account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSecurityHubAccountRead(d, meta)
+}
+
+func resourceAwsSecurityHubAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Checking if Security Hub is enabled")
+	output, err := conn.DescribeHub(&securityhub.DescribeHubInput{})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		log.Printf("[WARN] Security Hub not enabled, removing from state")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error checking if Security Hub is enabled: %s", err)
+	}
+
+	d.Set("arn", output.HubArn)
+	d.Set("auto_enable_controls", output.AutoEnableControls)
+	d.Set("control_finding_generator", output.ControlFindingGenerator)
+
+	return nil
+}
+
+func resourceAwsSecurityHubAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateSecurityHubConfigurationInput{
+		AutoEnableControls: aws.Bool(d.Get("auto_enable_controls").(bool)),
+	}
+
+	if d.HasChange("control_finding_generator") {
+		input.ControlFindingGenerator = aws.String(d.Get("control_finding_generator").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Account: %s", input)
+	_, err := conn.UpdateSecurityHubConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Account (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubAccountRead(d, meta)
+}
+
+func resourceAwsSecurityHubAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Disabling Security Hub for account")
+	_, err := conn.DisableSecurityHub(&securityhub.DisableSecurityHubInput{})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disabling Security Hub for account: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityHubAccountImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The default standards setting only applies when Security Hub is
+	// enabled and cannot be read back, so assume the argument default.
+	d.Set("enable_default_standards", true)
+
+	return []*schema.ResourceData{d}, nil
+}
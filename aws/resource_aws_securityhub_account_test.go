@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubAccount_basic(t *testing.T) {
+	resourceName := "aws_securityhub_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubAccountExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:securityhub:[^:]+:\d{12}:hub/default$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_controls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_standards", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubAccount_autoEnableControls(t *testing.T) {
+	resourceName := "aws_securityhub_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubAccountConfigAutoEnableControls(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_controls", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSecurityHubAccountConfigAutoEnableControls(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_controls", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubAccount_withoutDefaultStandards(t *testing.T) {
+	resourceName := "aws_securityhub_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubAccountConfigEnableDefaultStandards(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_standards", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enable_default_standards"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		_, err := conn.DescribeHub(&securityhub.DescribeHubInput{})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSecurityHubAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_account" {
+			continue
+		}
+
+		_, err := conn.DescribeHub(&securityhub.DescribeHubInput{})
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Hub Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubAccountConfig() string {
+	return `
+resource "aws_securityhub_account" "test" {}
+`
+}
+
+func testAccAWSSecurityHubAccountConfigAutoEnableControls(autoEnableControls bool) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {
+  auto_enable_controls = %[1]t
+}
+`, autoEnableControls)
+}
+
+func testAccAWSSecurityHubAccountConfigEnableDefaultStandards(enableDefaultStandards bool) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {
+  enable_default_standards = %[1]t
+}
+`, enableDefaultStandards)
+}
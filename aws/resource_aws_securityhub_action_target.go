@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubActionTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubActionTargetCreate,
+		Read:   resourceAwsSecurityHubActionTargetRead,
+		Update: resourceAwsSecurityHubActionTargetUpdate,
+		Delete: resourceAwsSecurityHubActionTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9]{1,20}$`),
+					"must contain only alphanumeric characters and be at most 20 characters long",
+				),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubActionTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.CreateActionTargetInput{
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(d.Get("identifier").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Action Target: %s", input)
+	output, err := conn.CreateActionTarget(input)
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Action Target: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ActionTargetArn))
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	identifier, err := decodeSecurityHubActionTargetIdentifier(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeActionTargets(&securityhub.DescribeActionTargetsInput{
+		ActionTargetArns: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Action Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.ActionTargets) == 0 {
+		log.Printf("[WARN] Security Hub Action Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	actionTarget := output.ActionTargets[0]
+
+	d.Set("arn", actionTarget.ActionTargetArn)
+	d.Set("description", actionTarget.Description)
+	d.Set("identifier", identifier)
+	d.Set("name", actionTarget.Name)
+
+	return nil
+}
+
+func resourceAwsSecurityHubActionTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+		Description:     aws.String(d.Get("description").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Action Target: %s", input)
+	_, err := conn.UpdateActionTarget(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Action Target: %s", d.Id())
+	_, err := conn.DeleteActionTarget(&securityhub.DeleteActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeSecurityHubActionTargetIdentifier extracts the custom action
+// identifier from an action target ARN of the form
+// arn:aws:securityhub:us-east-1:123456789012:action/custom/<identifier>.
+func decodeSecurityHubActionTargetIdentifier(arn string) (string, error) {
+	parts := strings.Split(arn, "/")
+	if len(parts) != 3 || parts[1] != "custom" || parts[2] == "" {
+		return "", fmt.Errorf("unexpected format of Security Hub Action Target ARN (%s), expected arn:PARTITION:securityhub:REGION:ACCOUNT:action/custom/IDENTIFIER", arn)
+	}
+
+	return parts[2], nil
+}
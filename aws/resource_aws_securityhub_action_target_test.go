@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeSecurityHubActionTargetIdentifier(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ErrExpected bool
+	}{
+		{
+			Input:    "arn:aws:securityhub:us-east-1:123456789012:action/custom/SendToChat",
+			Expected: "SendToChat",
+		},
+		{
+			Input:       "arn:aws:securityhub:us-east-1:123456789012:hub/default",
+			ErrExpected: true,
+		},
+		{
+			Input:       "arn:aws:securityhub:us-east-1:123456789012:action/custom/",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := decodeSecurityHubActionTargetIdentifier(tc.Input)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error for input %q", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+		if output != tc.Expected {
+			t.Fatalf("expected %q, got %q", tc.Expected, output)
+		}
+	}
+}
+
+func testAccAWSSecurityHubActionTarget_basic(t *testing.T) {
+	resourceName := "aws_securityhub_action_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubActionTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubActionTargetConfig("This is custom action sends selected findings to chat"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubActionTargetExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is custom action sends selected findings to chat"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", "SendToChat"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Send notification to chat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubActionTarget_description(t *testing.T) {
+	resourceName := "aws_securityhub_action_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubActionTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubActionTargetConfig("description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubActionTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccAwsSecurityHubActionTargetConfig("description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubActionTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecurityHubActionTargetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		output, err := conn.DescribeActionTargets(&securityhub.DescribeActionTargetsInput{
+			ActionTargetArns: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.ActionTargets) == 0 {
+			return fmt.Errorf("Security Hub Action Target (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsSecurityHubActionTargetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_action_target" {
+			continue
+		}
+
+		output, err := conn.DescribeActionTargets(&securityhub.DescribeActionTargetsInput{
+			ActionTargetArns: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.ActionTargets) > 0 {
+			return fmt.Errorf("Security Hub Action Target (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsSecurityHubActionTargetConfig(description string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_action_target" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  description = %[1]q
+  identifier  = "SendToChat"
+  name        = "Send notification to chat"
+}
+`, description)
+}
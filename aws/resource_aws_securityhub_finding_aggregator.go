@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	securityHubLinkingModeAllRegions                = "ALL_REGIONS"
+	securityHubLinkingModeAllRegionsExceptSpecified = "ALL_REGIONS_EXCEPT_SPECIFIED"
+	securityHubLinkingModeSpecifiedRegions          = "SPECIFIED_REGIONS"
+)
+
+func resourceAwsSecurityHubFindingAggregator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubFindingAggregatorCreate,
+		Read:   resourceAwsSecurityHubFindingAggregatorRead,
+		Update: resourceAwsSecurityHubFindingAggregatorUpdate,
+		Delete: resourceAwsSecurityHubFindingAggregatorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"linking_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityHubLinkingModeAllRegions,
+					securityHubLinkingModeAllRegionsExceptSpecified,
+					securityHubLinkingModeSpecifiedRegions,
+				}, false),
+			},
+			"specified_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubFindingAggregatorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.CreateFindingAggregatorInput{
+		RegionLinkingMode: aws.String(d.Get("linking_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("specified_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Regions = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Finding Aggregator: %s", input)
+	output, err := conn.CreateFindingAggregator(input)
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Finding Aggregator: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FindingAggregatorArn))
+
+	return resourceAwsSecurityHubFindingAggregatorRead(d, meta)
+}
+
+func resourceAwsSecurityHubFindingAggregatorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	output, err := conn.GetFindingAggregator(&securityhub.GetFindingAggregatorInput{
+		FindingAggregatorArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Finding Aggregator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Finding Aggregator (%s): %s", d.Id(), err)
+	}
+
+	d.Set("linking_mode", output.RegionLinkingMode)
+
+	if err := d.Set("specified_regions", schema.NewSet(schema.HashString, flattenStringList(output.Regions))); err != nil {
+		return fmt.Errorf("error setting specified_regions: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityHubFindingAggregatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateFindingAggregatorInput{
+		FindingAggregatorArn: aws.String(d.Id()),
+		RegionLinkingMode:    aws.String(d.Get("linking_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("specified_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Regions = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Finding Aggregator: %s", input)
+	_, err := conn.UpdateFindingAggregator(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Finding Aggregator (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubFindingAggregatorRead(d, meta)
+}
+
+func resourceAwsSecurityHubFindingAggregatorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Finding Aggregator: %s", d.Id())
+	_, err := conn.DeleteFindingAggregator(&securityhub.DeleteFindingAggregatorInput{
+		FindingAggregatorArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Finding Aggregator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubFindingAggregator_basic(t *testing.T) {
+	resourceName := "aws_securityhub_finding_aggregator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubFindingAggregatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubFindingAggregatorConfigAllRegions(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubFindingAggregatorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linking_mode", "ALL_REGIONS"),
+					resource.TestCheckResourceAttr(resourceName, "specified_regions.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSecurityHubFindingAggregatorConfigSpecifiedRegions("ALL_REGIONS_EXCEPT_SPECIFIED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubFindingAggregatorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linking_mode", "ALL_REGIONS_EXCEPT_SPECIFIED"),
+					resource.TestCheckResourceAttr(resourceName, "specified_regions.#", "2"),
+				),
+			},
+			{
+				Config: testAccAwsSecurityHubFindingAggregatorConfigSpecifiedRegions("SPECIFIED_REGIONS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubFindingAggregatorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linking_mode", "SPECIFIED_REGIONS"),
+					resource.TestCheckResourceAttr(resourceName, "specified_regions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecurityHubFindingAggregatorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		_, err := conn.GetFindingAggregator(&securityhub.GetFindingAggregatorInput{
+			FindingAggregatorArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsSecurityHubFindingAggregatorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_finding_aggregator" {
+			continue
+		}
+
+		_, err := conn.GetFindingAggregator(&securityhub.GetFindingAggregatorInput{
+			FindingAggregatorArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Hub Finding Aggregator (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsSecurityHubFindingAggregatorConfigAllRegions() string {
+	return `
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_finding_aggregator" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  linking_mode = "ALL_REGIONS"
+}
+`
+}
+
+func testAccAwsSecurityHubFindingAggregatorConfigSpecifiedRegions(linkingMode string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_finding_aggregator" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  linking_mode      = %[1]q
+  specified_regions = ["eu-west-1", "eu-west-2"]
+}
+`, linkingMode)
+}
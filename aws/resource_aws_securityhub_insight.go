@@ -0,0 +1,599 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The filter attributes supported by aws_securityhub_insight, keyed by
+// argument name within the filters block.
+var securityHubInsightStringFilterFields = map[string]func(*securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter{
+	"aws_account_id": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.AwsAccountId },
+	"company_name":   func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.CompanyName },
+	"compliance_status": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter {
+		return &f.ComplianceStatus
+	},
+	"description":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.Description },
+	"generator_id": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.GeneratorId },
+	"id":           func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.Id },
+	"product_arn":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.ProductArn },
+	"product_name": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.ProductName },
+	"record_state": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.RecordState },
+	"region":       func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.Region },
+	"resource_id":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.ResourceId },
+	"resource_partition": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter {
+		return &f.ResourcePartition
+	},
+	"resource_region": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.ResourceRegion },
+	"resource_type":   func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.ResourceType },
+	"severity_label":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.SeverityLabel },
+	"source_url":      func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.SourceUrl },
+	"title":           func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.Title },
+	"type":            func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.Type },
+	"verification_state": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter {
+		return &f.VerificationState
+	},
+	"workflow_status": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.StringFilter { return &f.WorkflowStatus },
+}
+
+var securityHubInsightNumberFilterFields = map[string]func(*securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter{
+	"confidence":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter { return &f.Confidence },
+	"criticality": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter { return &f.Criticality },
+	"network_destination_port": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter {
+		return &f.NetworkDestinationPort
+	},
+	"network_source_port": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter {
+		return &f.NetworkSourcePort
+	},
+	"severity_normalized": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter {
+		return &f.SeverityNormalized
+	},
+	"severity_product": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.NumberFilter { return &f.SeverityProduct },
+}
+
+var securityHubInsightDateFilterFields = map[string]func(*securityhub.AwsSecurityFindingFilters) *[]*securityhub.DateFilter{
+	"created_at":        func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.DateFilter { return &f.CreatedAt },
+	"first_observed_at": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.DateFilter { return &f.FirstObservedAt },
+	"last_observed_at":  func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.DateFilter { return &f.LastObservedAt },
+	"updated_at":        func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.DateFilter { return &f.UpdatedAt },
+}
+
+var securityHubInsightMapFilterFields = map[string]func(*securityhub.AwsSecurityFindingFilters) *[]*securityhub.MapFilter{
+	"product_fields":      func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.MapFilter { return &f.ProductFields },
+	"resource_tags":       func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.MapFilter { return &f.ResourceTags },
+	"user_defined_fields": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.MapFilter { return &f.UserDefinedFields },
+}
+
+var securityHubInsightIpFilterFields = map[string]func(*securityhub.AwsSecurityFindingFilters) *[]*securityhub.IpFilter{
+	"network_destination_ipv4": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.IpFilter {
+		return &f.NetworkDestinationIpV4
+	},
+	"network_source_ipv4": func(f *securityhub.AwsSecurityFindingFilters) *[]*securityhub.IpFilter { return &f.NetworkSourceIpV4 },
+}
+
+func resourceAwsSecurityHubInsight() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubInsightCreate,
+		Read:   resourceAwsSecurityHubInsightRead,
+		Update: resourceAwsSecurityHubInsightUpdate,
+		Delete: resourceAwsSecurityHubInsightDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: securityHubInsightFiltersSchema(),
+				},
+			},
+			"group_by_attribute": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func securityHubInsightFiltersSchema() map[string]*schema.Schema {
+	m := map[string]*schema.Schema{
+		"keyword": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	for k := range securityHubInsightStringFilterFields {
+		m[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							securityhub.StringFilterComparisonContains,
+							securityhub.StringFilterComparisonEquals,
+							securityhub.StringFilterComparisonNotContains,
+							securityhub.StringFilterComparisonNotEquals,
+							securityhub.StringFilterComparisonPrefix,
+							securityhub.StringFilterComparisonPrefixNotEquals,
+						}, false),
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	for k := range securityHubInsightNumberFilterFields {
+		m[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"eq": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTypeStringNullableFloat,
+					},
+					"gte": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTypeStringNullableFloat,
+					},
+					"lte": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTypeStringNullableFloat,
+					},
+				},
+			},
+		}
+	}
+
+	for k := range securityHubInsightDateFilterFields {
+		m[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"date_range": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"unit": {
+									Type:     schema.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										securityhub.DateRangeUnitDays,
+									}, false),
+								},
+								"value": {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+							},
+						},
+					},
+					"end": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateRFC3339TimeString,
+					},
+					"start": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateRFC3339TimeString,
+					},
+				},
+			},
+		}
+	}
+
+	for k := range securityHubInsightMapFilterFields {
+		m[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							securityhub.MapFilterComparisonContains,
+							securityhub.MapFilterComparisonEquals,
+							securityhub.MapFilterComparisonNotContains,
+							securityhub.MapFilterComparisonNotEquals,
+						}, false),
+					},
+					"key": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	for k := range securityHubInsightIpFilterFields {
+		m[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 20,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cidr": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateCIDRNetworkAddress,
+					},
+				},
+			},
+		}
+	}
+
+	return m
+}
+
+func resourceAwsSecurityHubInsightCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	filters, err := expandSecurityHubInsightFilters(d.Get("filters").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &securityhub.CreateInsightInput{
+		Filters:          filters,
+		GroupByAttribute: aws.String(d.Get("group_by_attribute").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Insight: %s", input)
+	output, err := conn.CreateInsight(input)
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Insight: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.InsightArn))
+
+	return resourceAwsSecurityHubInsightRead(d, meta)
+}
+
+func resourceAwsSecurityHubInsightRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	output, err := conn.GetInsights(&securityhub.GetInsightsInput{
+		InsightArns: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Insight (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.Insights) == 0 {
+		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	insight := output.Insights[0]
+
+	d.Set("arn", insight.InsightArn)
+	d.Set("group_by_attribute", insight.GroupByAttribute)
+	d.Set("name", insight.Name)
+
+	if err := d.Set("filters", flattenSecurityHubInsightFilters(insight.Filters)); err != nil {
+		return fmt.Errorf("error setting filters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityHubInsightUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateInsightInput{
+		InsightArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("filters") {
+		filters, err := expandSecurityHubInsightFilters(d.Get("filters").([]interface{}))
+		if err != nil {
+			return err
+		}
+		input.Filters = filters
+	}
+
+	if d.HasChange("group_by_attribute") {
+		input.GroupByAttribute = aws.String(d.Get("group_by_attribute").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Insight: %s", input)
+	_, err := conn.UpdateInsight(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Insight (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubInsightRead(d, meta)
+}
+
+func resourceAwsSecurityHubInsightDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Insight: %s", d.Id())
+	_, err := conn.DeleteInsight(&securityhub.DeleteInsightInput{
+		InsightArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Insight (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSecurityHubInsightFilters(l []interface{}) (*securityhub.AwsSecurityFindingFilters, error) {
+	filters := &securityhub.AwsSecurityFindingFilters{}
+
+	if len(l) == 0 || l[0] == nil {
+		return filters, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	for k, field := range securityHubInsightStringFilterFields {
+		for _, raw := range securityHubInsightFilterList(m, k) {
+			tfMap := raw.(map[string]interface{})
+			*field(filters) = append(*field(filters), &securityhub.StringFilter{
+				Comparison: aws.String(tfMap["comparison"].(string)),
+				Value:      aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	for k, field := range securityHubInsightNumberFilterFields {
+		for _, raw := range securityHubInsightFilterList(m, k) {
+			tfMap := raw.(map[string]interface{})
+			filter := &securityhub.NumberFilter{}
+
+			for attr, target := range map[string]**float64{"eq": &filter.Eq, "gte": &filter.Gte, "lte": &filter.Lte} {
+				v, ok := tfMap[attr].(string)
+				if !ok || v == "" {
+					continue
+				}
+
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing %s.%s value (%s): %s", k, attr, v, err)
+				}
+				*target = aws.Float64(f)
+			}
+
+			*field(filters) = append(*field(filters), filter)
+		}
+	}
+
+	for k, field := range securityHubInsightDateFilterFields {
+		for _, raw := range securityHubInsightFilterList(m, k) {
+			tfMap := raw.(map[string]interface{})
+			filter := &securityhub.DateFilter{}
+
+			if v, ok := tfMap["date_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				dateRange := v[0].(map[string]interface{})
+				filter.DateRange = &securityhub.DateRange{
+					Unit:  aws.String(dateRange["unit"].(string)),
+					Value: aws.Int64(int64(dateRange["value"].(int))),
+				}
+			}
+
+			if v, ok := tfMap["end"].(string); ok && v != "" {
+				filter.End = aws.String(v)
+			}
+
+			if v, ok := tfMap["start"].(string); ok && v != "" {
+				filter.Start = aws.String(v)
+			}
+
+			*field(filters) = append(*field(filters), filter)
+		}
+	}
+
+	for k, field := range securityHubInsightMapFilterFields {
+		for _, raw := range securityHubInsightFilterList(m, k) {
+			tfMap := raw.(map[string]interface{})
+			*field(filters) = append(*field(filters), &securityhub.MapFilter{
+				Comparison: aws.String(tfMap["comparison"].(string)),
+				Key:        aws.String(tfMap["key"].(string)),
+				Value:      aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	for k, field := range securityHubInsightIpFilterFields {
+		for _, raw := range securityHubInsightFilterList(m, k) {
+			tfMap := raw.(map[string]interface{})
+			*field(filters) = append(*field(filters), &securityhub.IpFilter{
+				Cidr: aws.String(tfMap["cidr"].(string)),
+			})
+		}
+	}
+
+	for _, raw := range securityHubInsightFilterList(m, "keyword") {
+		tfMap := raw.(map[string]interface{})
+		filters.Keyword = append(filters.Keyword, &securityhub.KeywordFilter{
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return filters, nil
+}
+
+func securityHubInsightFilterList(m map[string]interface{}, k string) []interface{} {
+	switch v := m[k].(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return nil
+}
+
+func flattenSecurityHubInsightFilters(filters *securityhub.AwsSecurityFindingFilters) []interface{} {
+	if filters == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	for k, field := range securityHubInsightStringFilterFields {
+		var l []interface{}
+		for _, filter := range *field(filters) {
+			l = append(l, map[string]interface{}{
+				"comparison": aws.StringValue(filter.Comparison),
+				"value":      aws.StringValue(filter.Value),
+			})
+		}
+		if len(l) > 0 {
+			m[k] = l
+		}
+	}
+
+	for k, field := range securityHubInsightNumberFilterFields {
+		var l []interface{}
+		for _, filter := range *field(filters) {
+			l = append(l, map[string]interface{}{
+				"eq":  flattenSecurityHubInsightNumber(filter.Eq),
+				"gte": flattenSecurityHubInsightNumber(filter.Gte),
+				"lte": flattenSecurityHubInsightNumber(filter.Lte),
+			})
+		}
+		if len(l) > 0 {
+			m[k] = l
+		}
+	}
+
+	for k, field := range securityHubInsightDateFilterFields {
+		var l []interface{}
+		for _, filter := range *field(filters) {
+			tfMap := map[string]interface{}{
+				"date_range": []interface{}{},
+				"end":        aws.StringValue(filter.End),
+				"start":      aws.StringValue(filter.Start),
+			}
+			if filter.DateRange != nil {
+				tfMap["date_range"] = []interface{}{
+					map[string]interface{}{
+						"unit":  aws.StringValue(filter.DateRange.Unit),
+						"value": int(aws.Int64Value(filter.DateRange.Value)),
+					},
+				}
+			}
+			l = append(l, tfMap)
+		}
+		if len(l) > 0 {
+			m[k] = l
+		}
+	}
+
+	for k, field := range securityHubInsightMapFilterFields {
+		var l []interface{}
+		for _, filter := range *field(filters) {
+			l = append(l, map[string]interface{}{
+				"comparison": aws.StringValue(filter.Comparison),
+				"key":        aws.StringValue(filter.Key),
+				"value":      aws.StringValue(filter.Value),
+			})
+		}
+		if len(l) > 0 {
+			m[k] = l
+		}
+	}
+
+	for k, field := range securityHubInsightIpFilterFields {
+		var l []interface{}
+		for _, filter := range *field(filters) {
+			l = append(l, map[string]interface{}{
+				"cidr": aws.StringValue(filter.Cidr),
+			})
+		}
+		if len(l) > 0 {
+			m[k] = l
+		}
+	}
+
+	var keywords []interface{}
+	for _, filter := range filters.Keyword {
+		keywords = append(keywords, map[string]interface{}{
+			"value": aws.StringValue(filter.Value),
+		})
+	}
+	if len(keywords) > 0 {
+		m["keyword"] = keywords
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSecurityHubInsightNumber(v *float64) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(aws.Float64Value(v), 'f', -1, 64)
+}
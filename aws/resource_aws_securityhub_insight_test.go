@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAWSSecurityHubInsight_expandFilters(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"aws_account_id": []interface{}{
+				map[string]interface{}{
+					"comparison": "EQUALS",
+					"value":      "123456789012",
+				},
+			},
+			"confidence": []interface{}{
+				map[string]interface{}{
+					"eq":  "0",
+					"gte": "",
+					"lte": "",
+				},
+			},
+			"created_at": []interface{}{
+				map[string]interface{}{
+					"date_range": []interface{}{
+						map[string]interface{}{
+							"unit":  "DAYS",
+							"value": 5,
+						},
+					},
+					"end":   "",
+					"start": "",
+				},
+			},
+			"keyword": []interface{}{
+				map[string]interface{}{
+					"value": "example",
+				},
+			},
+			"network_source_ipv4": []interface{}{
+				map[string]interface{}{
+					"cidr": "10.0.0.0/16",
+				},
+			},
+			"resource_tags": []interface{}{
+				map[string]interface{}{
+					"comparison": "EQUALS",
+					"key":        "Environment",
+					"value":      "Production",
+				},
+			},
+		},
+	}
+
+	expected := &securityhub.AwsSecurityFindingFilters{
+		AwsAccountId: []*securityhub.StringFilter{
+			{
+				Comparison: aws.String("EQUALS"),
+				Value:      aws.String("123456789012"),
+			},
+		},
+		Confidence: []*securityhub.NumberFilter{
+			{
+				Eq: aws.Float64(0),
+			},
+		},
+		CreatedAt: []*securityhub.DateFilter{
+			{
+				DateRange: &securityhub.DateRange{
+					Unit:  aws.String("DAYS"),
+					Value: aws.Int64(5),
+				},
+			},
+		},
+		Keyword: []*securityhub.KeywordFilter{
+			{
+				Value: aws.String("example"),
+			},
+		},
+		NetworkSourceIpV4: []*securityhub.IpFilter{
+			{
+				Cidr: aws.String("10.0.0.0/16"),
+			},
+		},
+		ResourceTags: []*securityhub.MapFilter{
+			{
+				Comparison: aws.String("EQUALS"),
+				Key:        aws.String("Environment"),
+				Value:      aws.String("Production"),
+			},
+		},
+	}
+
+	output, err := expandSecurityHubInsightFilters(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+
+	if flattened := flattenSecurityHubInsightFilters(output); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", flattened, input)
+	}
+}
+
+func testAccAWSSecurityHubInsight_basic(t *testing.T) {
+	resourceName := "aws_securityhub_insight.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfig(rName, "ResourceType"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.aws_account_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_by_attribute", "ResourceType"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSecurityHubInsightConfig(rName, "AwsAccountId"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_by_attribute", "AwsAccountId"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubInsight_numberFilters(t *testing.T) {
+	resourceName := "aws_securityhub_insight.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubInsightDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubInsightConfigNumberFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecurityHubInsightExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.confidence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.created_at.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSecurityHubInsightExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		output, err := conn.GetInsights(&securityhub.GetInsightsInput{
+			InsightArns: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Insights) == 0 {
+			return fmt.Errorf("Security Hub Insight (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsSecurityHubInsightDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_insight" {
+			continue
+		}
+
+		output, err := conn.GetInsights(&securityhub.GetInsightsInput{
+			InsightArns: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.Insights) > 0 {
+			return fmt.Errorf("Security Hub Insight (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsSecurityHubInsightConfig(rName, groupByAttribute string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  group_by_attribute = %[2]q
+  name               = %[1]q
+
+  filters {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "${data.aws_caller_identity.current.account_id}"
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "Production"
+    }
+  }
+}
+`, rName, groupByAttribute)
+}
+
+func testAccAwsSecurityHubInsightConfigNumberFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_insight" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  group_by_attribute = "AwsAccountId"
+  name               = %[1]q
+
+  filters {
+    confidence {
+      gte = "50"
+      lte = "100"
+    }
+
+    created_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityHubOrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubOrganizationAdminAccountCreate,
+		Read:   resourceAwsSecurityHubOrganizationAdminAccountRead,
+		Delete: resourceAwsSecurityHubOrganizationAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+	adminAccountID := d.Get("admin_account_id").(string)
+
+	input := &securityhub.EnableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(adminAccountID),
+	}
+
+	log.Printf("[DEBUG] Enabling Security Hub Organization Admin Account: %s", input)
+	_, err := conn.EnableOrganizationAdminAccount(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Security Hub Organization Admin Account (%s): %s", adminAccountID, err)
+	}
+
+	d.SetId(adminAccountID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityHubAdminAccountNotFound},
+		Target:  []string{securityhub.AdminStatusEnabled},
+		Refresh: securityHubAdminAccountStatusRefreshFunc(conn, d.Id()),
+		Timeout: securityHubAdminAccountEnableTimeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Security Hub Organization Admin Account (%s) to enable: %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubOrganizationAdminAccountRead(d, meta)
+}
+
+func resourceAwsSecurityHubOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	adminAccount, err := securityHubGetAdminAccount(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if adminAccount == nil {
+		log.Printf("[WARN] Security Hub Organization Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("admin_account_id", adminAccount.AccountId)
+
+	return nil
+}
+
+func resourceAwsSecurityHubOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.DisableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Disabling Security Hub Organization Admin Account: %s", input)
+	_, err := conn.DisableOrganizationAdminAccount(input)
+	if err != nil {
+		return fmt.Errorf("error disabling Security Hub Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityhub.AdminStatusEnabled, securityhub.AdminStatusDisableInProgress},
+		Target:  []string{securityHubAdminAccountNotFound},
+		Refresh: securityHubAdminAccountStatusRefreshFunc(conn, d.Id()),
+		Timeout: securityHubAdminAccountDisableTimeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Security Hub Organization Admin Account (%s) to disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
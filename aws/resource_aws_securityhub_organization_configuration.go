@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubOrganizationConfigurationUpdate,
+		Read:   resourceAwsSecurityHubOrganizationConfigurationRead,
+		Update: resourceAwsSecurityHubOrganizationConfigurationUpdate,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"auto_enable_standards": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityhub.AutoEnableStandardsDefault,
+					securityhub.AutoEnableStandardsNone,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubOrganizationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateOrganizationConfigurationInput{
+		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
+	}
+
+	if v, ok := d.GetOk("auto_enable_standards"); ok {
+		input.AutoEnableStandards = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Organization Configuration: %s", input)
+	_, err := conn.UpdateOrganizationConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Organization Configuration: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsSecurityHubOrganizationConfigurationRead(d, meta)
+}
+
+func resourceAwsSecurityHubOrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	output, err := conn.DescribeOrganizationConfiguration(&securityhub.DescribeOrganizationConfigurationInput{})
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_enable", output.AutoEnable)
+	d.Set("auto_enable_standards", output.AutoEnableStandards)
+
+	return nil
+}
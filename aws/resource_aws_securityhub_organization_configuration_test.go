@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccAWSSecurityHubOrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_securityhub_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAwsOrganizationsMasterAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecurityHubOrganizationAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecurityHubOrganizationConfigurationConfig(true, "DEFAULT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "DEFAULT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSecurityHubOrganizationConfigurationConfig(false, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSecurityHubOrganizationConfigurationConfig(autoEnable bool, autoEnableStandards string) string {
+	return testAccAwsSecurityHubOrganizationAdminAccountConfigSelf() + fmt.Sprintf(`
+resource "aws_securityhub_organization_configuration" "test" {
+  depends_on = ["aws_securityhub_organization_admin_account.test"]
+
+  auto_enable           = %[1]t
+  auto_enable_standards = %[2]q
+}
+`, autoEnable, autoEnableStandards)
+}
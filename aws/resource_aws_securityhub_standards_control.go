@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubStandardsControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubStandardsControlPut,
+		Read:   resourceAwsSecurityHubStandardsControlRead,
+		Update: resourceAwsSecurityHubStandardsControlPut,
+		Delete: resourceAwsSecurityHubStandardsControlDelete,
+
+		Schema: map[string]*schema.Schema{
+			"control_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityhub.ControlStatusDisabled,
+					securityhub.ControlStatusEnabled,
+				}, false),
+			},
+			"control_status_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"related_requirements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"remediation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity_rating": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standards_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubStandardsControlPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+	arn := d.Get("standards_control_arn").(string)
+
+	input := &securityhub.UpdateStandardsControlInput{
+		ControlStatus:       aws.String(d.Get("control_status").(string)),
+		StandardsControlArn: aws.String(arn),
+	}
+
+	if v, ok := d.GetOk("disabled_reason"); ok && d.Get("control_status").(string) == securityhub.ControlStatusDisabled {
+		input.DisabledReason = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Standards Control: %s", input)
+	_, err := conn.UpdateStandardsControl(input)
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Standards Control (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return resourceAwsSecurityHubStandardsControlRead(d, meta)
+}
+
+func resourceAwsSecurityHubStandardsControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	subscriptionArn, err := securityHubStandardsSubscriptionArnFromControlArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var control *securityhub.StandardsControl
+
+	input := &securityhub.DescribeStandardsControlsInput{
+		StandardsSubscriptionArn: aws.String(subscriptionArn),
+	}
+
+	err = conn.DescribeStandardsControlsPages(input, func(page *securityhub.DescribeStandardsControlsOutput, lastPage bool) bool {
+		for _, c := range page.Controls {
+			if aws.StringValue(c.StandardsControlArn) == d.Id() {
+				control = c
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Standards Subscription (%s) not found, removing Standards Control (%s) from state", subscriptionArn, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Standards Control (%s): %s", d.Id(), err)
+	}
+
+	if control == nil {
+		log.Printf("[WARN] Security Hub Standards Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("control_id", control.ControlId)
+	d.Set("control_status", control.ControlStatus)
+	d.Set("description", control.Description)
+	d.Set("disabled_reason", control.DisabledReason)
+	d.Set("remediation_url", control.RemediationUrl)
+	d.Set("severity_rating", control.SeverityRating)
+	d.Set("standards_control_arn", control.StandardsControlArn)
+	d.Set("title", control.Title)
+
+	if control.ControlStatusUpdatedAt != nil {
+		d.Set("control_status_updated_at", aws.TimeValue(control.ControlStatusUpdatedAt).Format(time.RFC3339))
+	}
+
+	if err := d.Set("related_requirements", flattenStringList(control.RelatedRequirements)); err != nil {
+		return fmt.Errorf("error setting related_requirements: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityHubStandardsControlDelete(d *schema.ResourceData, meta interface{}) error {
+	// Standards controls cannot be removed, only enabled or disabled. Leave
+	// the control in its current state and remove it from Terraform state.
+	log.Printf("[WARN] Security Hub Standards Control (%s) cannot be deleted, removing from state", d.Id())
+	return nil
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAWSSecurityHub_standardsSubscriptionArnFromControlArn(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ErrExpected bool
+	}{
+		{
+			Input:    "arn:aws:securityhub:us-east-1:123456789012:control/cis-aws-foundations-benchmark/v/1.2.0/1.10",
+			Expected: "arn:aws:securityhub:us-east-1:123456789012:subscription/cis-aws-foundations-benchmark/v/1.2.0",
+		},
+		{
+			Input:    "arn:aws:securityhub:us-east-1:123456789012:control/aws-foundational-security-best-practices/v/1.0.0/IAM.1",
+			Expected: "arn:aws:securityhub:us-east-1:123456789012:subscription/aws-foundational-security-best-practices/v/1.0.0",
+		},
+		{
+			Input:       "arn:aws:securityhub:us-east-1:123456789012:hub/default",
+			ErrExpected: true,
+		},
+		{
+			Input:       "arn:aws:securityhub:us-east-1:123456789012:control/1.10",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := securityHubStandardsSubscriptionArnFromControlArn(tc.Input)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error for input %q", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+		if output != tc.Expected {
+			t.Fatalf("expected %q, got %q", tc.Expected, output)
+		}
+	}
+}
+
+func testAccAWSSecurityHubStandardsControl_basic(t *testing.T) {
+	resourceName := "aws_securityhub_standards_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfig("DISABLED", "Not needed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "control_id", "CIS.1.10"),
+					resource.TestCheckResourceAttr(resourceName, "control_status", "DISABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "control_status_updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_reason", "Not needed"),
+					resource.TestCheckResourceAttr(resourceName, "related_requirements.0", "CIS AWS Foundations 1.10"),
+					resource.TestCheckResourceAttrSet(resourceName, "remediation_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "severity_rating"),
+					resource.TestCheckResourceAttrSet(resourceName, "title"),
+				),
+			},
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfig("ENABLED", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "control_status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubStandardsControlConfig(controlStatus, disabledReason string) string {
+	return testAccAWSSecurityHubStandardsSubscriptionConfig() + fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_securityhub_standards_control" "test" {
+  depends_on = ["aws_securityhub_standards_subscription.test"]
+
+  standards_control_arn = "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:control/cis-aws-foundations-benchmark/v/1.2.0/1.10"
+  control_status        = %[1]q
+  disabled_reason       = %[2]q
+}
+`, controlStatus, disabledReason)
+}
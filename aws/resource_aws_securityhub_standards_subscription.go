@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityHubStandardsSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubStandardsSubscriptionCreate,
+		Read:   resourceAwsSecurityHubStandardsSubscriptionRead,
+		Delete: resourceAwsSecurityHubStandardsSubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"standards_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubStandardsSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.BatchEnableStandardsInput{
+		StandardsSubscriptionRequests: []*securityhub.StandardsSubscriptionRequest{
+			{
+				StandardsArn: aws.String(d.Get("standards_arn").(string)),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Enabling Security Hub standard: %s", input)
+	output, err := conn.BatchEnableStandards(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Security Hub standard: %s", err)
+	}
+
+	if output == nil || len(output.StandardsSubscriptions) == 0 {
+		return fmt.Errorf("error enabling Security Hub standard: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.StandardsSubscriptions[0].StandardsSubscriptionArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityhub.StandardsStatusPending},
+		Target:  []string{securityhub.StandardsStatusReady, securityhub.StandardsStatusIncomplete},
+		Refresh: securityHubStandardsSubscriptionStatusRefreshFunc(conn, d.Id()),
+		Timeout: securityHubStandardsSubscriptionCreateTimeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Security Hub Standards Subscription (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubStandardsSubscriptionRead(d, meta)
+}
+
+func resourceAwsSecurityHubStandardsSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	subscription, err := securityHubGetStandardsSubscription(conn, d.Id())
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Standards Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Standards Subscription (%s): %s", d.Id(), err)
+	}
+
+	if subscription == nil || aws.StringValue(subscription.StandardsStatus) == securityhub.StandardsStatusDeleting {
+		log.Printf("[WARN] Security Hub Standards Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("standards_arn", subscription.StandardsArn)
+
+	return nil
+}
+
+func resourceAwsSecurityHubStandardsSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Disabling Security Hub Standards Subscription: %s", d.Id())
+	_, err := conn.BatchDisableStandards(&securityhub.BatchDisableStandardsInput{
+		StandardsSubscriptionArns: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disabling Security Hub Standards Subscription (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityhub.StandardsStatusReady, securityhub.StandardsStatusIncomplete, securityhub.StandardsStatusDeleting},
+		Target:  []string{securityHubStandardsStatusNotFound},
+		Refresh: securityHubStandardsSubscriptionStatusRefreshFunc(conn, d.Id()),
+		Timeout: securityHubStandardsSubscriptionDeleteTimeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Security Hub Standards Subscription (%s) to be disabled: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubStandardsSubscription_basic(t *testing.T) {
+	resourceName := "aws_securityhub_standards_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubStandardsSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsSubscriptionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsSubscriptionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "standards_arn", regexp.MustCompile(`:ruleset/cis-aws-foundations-benchmark/v/1.2.0$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubStandardsSubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		subscription, err := securityHubGetStandardsSubscription(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if subscription == nil {
+			return fmt.Errorf("Security Hub Standards Subscription (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubStandardsSubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_standards_subscription" {
+			continue
+		}
+
+		output, err := conn.GetEnabledStandards(&securityhub.GetEnabledStandardsInput{
+			StandardsSubscriptionArns: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") ||
+			isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.StandardsSubscriptions) > 0 {
+			return fmt.Errorf("Security Hub Standards Subscription (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccAWSSecurityHubStandardsSubscriptionConfigBase enables Security Hub
+// without the default standards so that the test can manage them.
+const testAccAWSSecurityHubStandardsSubscriptionConfigBase = `
+data "aws_partition" "current" {}
+
+resource "aws_securityhub_account" "test" {
+  enable_default_standards = false
+}
+`
+
+func testAccAWSSecurityHubStandardsSubscriptionConfig() string {
+	return testAccAWSSecurityHubStandardsSubscriptionConfigBase + `
+resource "aws_securityhub_standards_subscription" "test" {
+  depends_on = ["aws_securityhub_account.test"]
+
+  standards_arn = "arn:${data.aws_partition.current.partition}:securityhub:::ruleset/cis-aws-foundations-benchmark/v/1.2.0"
+}
+`
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSSecurityHub(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Account": {
+			"basic":                   testAccAWSSecurityHubAccount_basic,
+			"autoEnableControls":      testAccAWSSecurityHubAccount_autoEnableControls,
+			"withoutDefaultStandards": testAccAWSSecurityHubAccount_withoutDefaultStandards,
+		},
+		"ActionTarget": {
+			"basic":       testAccAWSSecurityHubActionTarget_basic,
+			"description": testAccAWSSecurityHubActionTarget_description,
+		},
+		"FindingAggregator": {
+			"basic": testAccAWSSecurityHubFindingAggregator_basic,
+		},
+		"Insight": {
+			"basic":         testAccAWSSecurityHubInsight_basic,
+			"numberFilters": testAccAWSSecurityHubInsight_numberFilters,
+		},
+		"OrganizationAdminAccount": {
+			"basic": testAccAWSSecurityHubOrganizationAdminAccount_basic,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccAWSSecurityHubOrganizationConfiguration_basic,
+		},
+		"StandardsControl": {
+			"basic": testAccAWSSecurityHubStandardsControl_basic,
+		},
+		"StandardsSubscription": {
+			"basic": testAccAWSSecurityHubStandardsSubscription_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.PrincipalTypeIam,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.PrincipalTypeIam,
+					servicecatalog.PrincipalTypeIamPattern,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalARN := d.Get("principal_arn").(string)
+
+	input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(d.Get("principal_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Principal Portfolio Association: %s", input)
+	_, err := conn.AssociatePrincipalWithPortfolio(input)
+	if err != nil {
+		return fmt.Errorf("error associating Service Catalog Principal (%s) with Portfolio (%s): %s", principalARN, portfolioID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", principalARN, portfolioID))
+
+	return resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	principalARN, portfolioID, err := decodeServiceCatalogID(d.Id(), "<principal-arn>,<portfolio-id>")
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	var principal *servicecatalog.Principal
+	err = conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, p := range page.Principals {
+			if aws.StringValue(p.PrincipalARN) == principalARN {
+				principal = p
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		principal = nil
+	} else if err != nil {
+		return fmt.Errorf("error reading Service Catalog Principal Portfolio Association (%s): %s", d.Id(), err)
+	}
+
+	if principal == nil {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_arn", principal.PrincipalARN)
+	d.Set("principal_type", principal.PrincipalType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	principalARN, portfolioID, err := decodeServiceCatalogID(d.Id(), "<principal-arn>,<portfolio-id>")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog Principal Portfolio Association: %s", d.Id())
+	_, err = conn.DisassociatePrincipalFromPortfolio(&servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(d.Get("principal_type").(string)),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Principal (%s) from Portfolio (%s): %s", principalARN, portfolioID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(8191),
+			},
+			"distributor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(8191),
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMaxLength(8191),
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMaxLength(8191),
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"disable_template_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"template_physical_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"provisioning_artifact_parameters.0.template_url"},
+						},
+						"template_url": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"provisioning_artifact_parameters.0.template_physical_id"},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+							}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(8191),
+			},
+			"support_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(254),
+			},
+			"support_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaxLength(2083),
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProductTypeCloudFormationTemplate,
+					servicecatalog.ProductTypeMarketplace,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	name := d.Get("name").(string)
+	input := &servicecatalog.CreateProductInput{
+		AcceptLanguage:                 aws.String("en"),
+		IdempotencyToken:               aws.String(resource.UniqueId()),
+		Name:                           aws.String(name),
+		Owner:                          aws.String(d.Get("owner").(string)),
+		ProductType:                    aws.String(d.Get("type").(string)),
+		ProvisioningArtifactParameters: expandServiceCatalogProvisioningArtifactProperties(d.Get("provisioning_artifact_parameters").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandServiceCatalogTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", input)
+	output, err := conn.CreateProduct(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Product (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProductViewDetail.ProductViewSummary.ProductId))
+
+	if err := waitForServiceCatalogProductAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Product (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ProductViewDetail == nil || output.ProductViewDetail.ProductViewSummary == nil {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	detail := output.ProductViewDetail
+	summary := detail.ProductViewSummary
+
+	d.Set("arn", detail.ProductARN)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("has_default_path", summary.HasDefaultPath)
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("status", detail.Status)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+	d.Set("type", summary.Type)
+
+	if err := d.Set("tags", flattenServiceCatalogTags(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProductInput{
+		AcceptLanguage:     aws.String("en"),
+		Description:        aws.String(d.Get("description").(string)),
+		Distributor:        aws.String(d.Get("distributor").(string)),
+		Id:                 aws.String(d.Id()),
+		Name:               aws.String(d.Get("name").(string)),
+		Owner:              aws.String(d.Get("owner").(string)),
+		SupportDescription: aws.String(d.Get("support_description").(string)),
+		SupportEmail:       aws.String(d.Get("support_email").(string)),
+		SupportUrl:         aws.String(d.Get("support_url").(string)),
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		input.AddTags, input.RemoveTags = tagUpdates(n.(map[string]interface{}), o.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Product: %s", input)
+	_, err := conn.UpdateProduct(input)
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog Product: %s", d.Id())
+	// Portfolio associations and constraints can take a moment to be released.
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteProduct(input)
+		if isAWSErr(err, servicecatalog.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningArtifactProperties(l []interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &servicecatalog.ProvisioningArtifactProperties{
+		DisableTemplateValidation: aws.Bool(m["disable_template_validation"].(bool)),
+		Info:                      make(map[string]*string),
+		Type:                      aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		properties.Description = aws.String(v)
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		properties.Name = aws.String(v)
+	}
+
+	if v, ok := m["template_url"].(string); ok && v != "" {
+		properties.Info["LoadTemplateFromURL"] = aws.String(v)
+	}
+
+	if v, ok := m["template_physical_id"].(string); ok && v != "" {
+		properties.Info["ImportFromPhysicalId"] = aws.String(v)
+	}
+
+	return properties
+}
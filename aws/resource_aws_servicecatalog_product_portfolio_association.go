@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProductPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogProductPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"product_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"source_portfolio_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.AssociateProductWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	if v, ok := d.GetOk("source_portfolio_id"); ok {
+		input.SourcePortfolioId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product Portfolio Association: %s", input)
+	_, err := conn.AssociateProductWithPortfolio(input)
+	if err != nil {
+		return fmt.Errorf("error associating Service Catalog Product (%s) with Portfolio (%s): %s", productID, portfolioID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", portfolioID, productID))
+
+	return resourceAwsServiceCatalogProductPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := decodeServiceCatalogID(d.Id(), "<portfolio-id>,<product-id>")
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		AcceptLanguage: aws.String("en"),
+		ProductId:      aws.String(productID),
+	}
+
+	found := false
+	err = conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		found = false
+	} else if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product Portfolio Association (%s): %s", d.Id(), err)
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := decodeServiceCatalogID(d.Id(), "<portfolio-id>,<product-id>")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog Product Portfolio Association: %s", d.Id())
+	_, err = conn.DisassociateProductFromPortfolio(&servicecatalog.DisassociateProductFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Product (%s) from Portfolio (%s): %s", productID, portfolioID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfig(rName, "beginning"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "beginning"),
+					resource.TestCheckResourceAttr(resourceName, "distributor", "distributör"),
+					resource.TestCheckResourceAttr(resourceName, "has_default_path", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "ägare"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProductTypeCloudFormationTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_artifact_parameters"},
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfig(rName, "middle"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "middle"),
+				),
+			},
+		},
+	})
+}
+
+func TestAWSServiceCatalogProduct_expandProvisioningArtifactProperties(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected *servicecatalog.ProvisioningArtifactProperties
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"description":                 "",
+					"disable_template_validation": false,
+					"name":                        "v1",
+					"template_physical_id":        "",
+					"template_url":                "https://s3.amazonaws.com/bucket/template.json",
+					"type":                        "CLOUD_FORMATION_TEMPLATE",
+				},
+			},
+			Expected: &servicecatalog.ProvisioningArtifactProperties{
+				DisableTemplateValidation: aws.Bool(false),
+				Info: map[string]*string{
+					"LoadTemplateFromURL": aws.String("https://s3.amazonaws.com/bucket/template.json"),
+				},
+				Name: aws.String("v1"),
+				Type: aws.String("CLOUD_FORMATION_TEMPLATE"),
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"description":                 "imported",
+					"disable_template_validation": true,
+					"name":                        "",
+					"template_physical_id":        "arn:aws:cloudformation:us-west-2:123456789012:stack/test/1234",
+					"template_url":                "",
+					"type":                        "CLOUD_FORMATION_TEMPLATE",
+				},
+			},
+			Expected: &servicecatalog.ProvisioningArtifactProperties{
+				Description:               aws.String("imported"),
+				DisableTemplateValidation: aws.Bool(true),
+				Info: map[string]*string{
+					"ImportFromPhysicalId": aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/test/1234"),
+				},
+				Type: aws.String("CLOUD_FORMATION_TEMPLATE"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandServiceCatalogProvisioningArtifactProperties(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Expected)
+		}
+	}
+}
+
+func TestAWSServiceCatalog_decodeID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		First       string
+		Second      string
+		ErrExpected bool
+	}{
+		{
+			ID:     "port-abcdefghijklm,prod-abcdefghijklm",
+			First:  "port-abcdefghijklm",
+			Second: "prod-abcdefghijklm",
+		},
+		{
+			ID:          "port-abcdefghijklm",
+			ErrExpected: true,
+		},
+		{
+			ID:          "port-abcdefghijklm,",
+			ErrExpected: true,
+		},
+		{
+			ID:          "a,b,c",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		first, second, err := decodeServiceCatalogID(tc.ID, "<first>,<second>")
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error for ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for ID %q: %s", tc.ID, err)
+		}
+		if first != tc.First || second != tc.Second {
+			t.Fatalf("got (%q, %q), expected (%q, %q)", first, second, tc.First, tc.Second)
+		}
+	}
+}
+
+func testAccCheckAwsServiceCatalogProductExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Product (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSServiceCatalogProductConfigTemplate uploads a minimal CloudFormation
+// template that products and provisioning artifacts can be created from.
+func testAccAWSServiceCatalogProductConfigTemplate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "%[1]s.json"
+
+  content = <<EOF
+{
+  "Resources": {
+    "MyVPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.1.0.0/16"
+      }
+    }
+  },
+  "Outputs": {
+    "VpcID": {
+      "Description": "VPC ID",
+      "Value": {
+        "Ref": "MyVPC"
+      }
+    }
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSServiceCatalogProductConfig(rName, description string) string {
+	return testAccAWSServiceCatalogProductConfigTemplate(rName) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  description         = %[2]q
+  distributor         = "distributör"
+  name                = %[1]q
+  owner               = "ägare"
+  support_description = "supportbeskrivning"
+  support_email       = "test@example.com"
+  support_url         = "http://example.com"
+  type                = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    description                 = "artefaktbeskrivning"
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_domain_name}/${aws_s3_bucket_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, description)
+}
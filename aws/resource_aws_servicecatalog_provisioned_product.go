@@ -0,0 +1,461 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisionedProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisionedProductCreate,
+		Read:   resourceAwsServiceCatalogProvisionedProductRead,
+		Update: resourceAwsServiceCatalogProvisionedProductUpdate,
+		Delete: resourceAwsServiceCatalogProvisionedProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_dashboard_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ignore_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_provisioning_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_successful_provisioning_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"notification_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"outputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"path_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"path_name"},
+			},
+			"path_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"path_id"},
+			},
+			"product_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"product_name"},
+			},
+			"product_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"product_id"},
+			},
+			"provisioning_artifact_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"provisioning_artifact_name"},
+			},
+			"provisioning_artifact_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"provisioning_artifact_id"},
+			},
+			"provisioning_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"use_previous_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"retain_physical_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisionedProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	name := d.Get("name").(string)
+	input := &servicecatalog.ProvisionProductInput{
+		AcceptLanguage:         aws.String("en"),
+		ProvisionToken:         aws.String(resource.UniqueId()),
+		ProvisionedProductName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("path_name"); ok {
+		input.PathName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_id"); ok {
+		input.ProductId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_name"); ok {
+		input.ProductName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_id"); ok {
+		input.ProvisioningArtifactId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_name"); ok {
+		input.ProvisioningArtifactName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok {
+		input.ProvisioningParameters = expandServiceCatalogProvisioningParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandServiceCatalogTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioned Product: %s", input)
+	var output *servicecatalog.ProvisionProductOutput
+	// Launch paths only become available once the principal and product
+	// portfolio associations have propagated.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.ProvisionProduct(input)
+		if isAWSErr(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") ||
+			isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error provisioning Service Catalog Product (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RecordDetail.ProvisionedProductId))
+
+	if err := waitForServiceCatalogProvisionedProductAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	output, err := conn.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	detail := output.ProvisionedProductDetail
+
+	d.Set("arn", detail.Arn)
+
+	dashboards := make([]interface{}, 0, len(output.CloudWatchDashboards))
+	for _, dashboard := range output.CloudWatchDashboards {
+		dashboards = append(dashboards, aws.StringValue(dashboard.Name))
+	}
+	if err := d.Set("cloudwatch_dashboard_names", schema.NewSet(schema.HashString, dashboards)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_dashboard_names: %s", err)
+	}
+
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("last_provisioning_record_id", detail.LastProvisioningRecordId)
+	d.Set("last_record_id", detail.LastRecordId)
+	d.Set("last_successful_provisioning_record_id", detail.LastSuccessfulProvisioningRecordId)
+	d.Set("launch_role_arn", detail.LaunchRoleArn)
+	d.Set("name", detail.Name)
+	d.Set("product_id", detail.ProductId)
+	d.Set("provisioning_artifact_id", detail.ProvisioningArtifactId)
+	d.Set("status", detail.Status)
+	d.Set("status_message", detail.StatusMessage)
+	d.Set("type", detail.Type)
+
+	// Outputs are only available once the product has been provisioned.
+	var outputs []*servicecatalog.RecordOutput
+	if aws.StringValue(detail.LastSuccessfulProvisioningRecordId) != "" {
+		err = conn.GetProvisionedProductOutputsPages(&servicecatalog.GetProvisionedProductOutputsInput{
+			AcceptLanguage:       aws.String("en"),
+			ProvisionedProductId: aws.String(d.Id()),
+		}, func(page *servicecatalog.GetProvisionedProductOutputsOutput, lastPage bool) bool {
+			outputs = append(outputs, page.Outputs...)
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) outputs: %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("outputs", flattenServiceCatalogRecordOutputs(outputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProvisionedProductInput{
+		AcceptLanguage:       aws.String("en"),
+		ProvisionedProductId: aws.String(d.Id()),
+		UpdateToken:          aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("path_name"); ok {
+		input.PathName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_name"); ok {
+		input.ProductName = aws.String(v.(string))
+	} else {
+		input.ProductId = aws.String(d.Get("product_id").(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_name"); ok {
+		input.ProvisioningArtifactName = aws.String(v.(string))
+	} else {
+		input.ProvisioningArtifactId = aws.String(d.Get("provisioning_artifact_id").(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok {
+		input.ProvisioningParameters = expandServiceCatalogUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		input.Tags = expandServiceCatalogTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioned Product: %s", input)
+	_, err := conn.UpdateProvisionedProduct(input)
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForServiceCatalogProvisionedProductAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.TerminateProvisionedProductInput{
+		AcceptLanguage:          aws.String("en"),
+		IgnoreErrors:            aws.Bool(d.Get("ignore_errors").(bool)),
+		ProvisionedProductId:    aws.String(d.Id()),
+		RetainPhysicalResources: aws.Bool(d.Get("retain_physical_resources").(bool)),
+		TerminateToken:          aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[INFO] Terminating Service Catalog Provisioned Product: %s", d.Id())
+	_, err := conn.TerminateProvisionedProduct(input)
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error terminating Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForServiceCatalogProvisionedProductTerminated(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) termination: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningParameters(l []interface{}) []*servicecatalog.ProvisioningParameter {
+	parameters := make([]*servicecatalog.ProvisioningParameter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, &servicecatalog.ProvisioningParameter{
+			Key:   aws.String(m["key"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	return parameters
+}
+
+func expandServiceCatalogUpdateProvisioningParameters(l []interface{}) []*servicecatalog.UpdateProvisioningParameter {
+	parameters := make([]*servicecatalog.UpdateProvisioningParameter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameter := &servicecatalog.UpdateProvisioningParameter{
+			Key: aws.String(m["key"].(string)),
+		}
+
+		if m["use_previous_value"].(bool) {
+			parameter.UsePreviousValue = aws.Bool(true)
+		} else {
+			parameter.Value = aws.String(m["value"].(string))
+		}
+
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
+
+func flattenServiceCatalogRecordOutputs(outputs []*servicecatalog.RecordOutput) []interface{} {
+	l := make([]interface{}, 0, len(outputs))
+
+	for _, output := range outputs {
+		if output == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"description": aws.StringValue(output.Description),
+			"key":         aws.StringValue(output.OutputKey),
+			"value":       aws.StringValue(output.OutputValue),
+		})
+	}
+
+	return l
+}
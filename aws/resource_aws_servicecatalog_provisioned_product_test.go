@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProvisionedProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfig(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_successful_provisioning_record_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.0.key", "VPCPrimaryCIDR"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.0.value", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "type", "CFN_STACK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ignore_errors",
+					"product_name",
+					"provisioning_artifact_name",
+					"provisioning_parameters",
+					"retain_physical_resources",
+				},
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfig(rName, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.0.value", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAWSServiceCatalogProvisionedProduct_expandUpdateProvisioningParameters(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"key":                "VPCPrimaryCIDR",
+			"use_previous_value": false,
+			"value":              "10.1.0.0/16",
+		},
+		map[string]interface{}{
+			"key":                "Environment",
+			"use_previous_value": true,
+			"value":              "ignored",
+		},
+	}
+
+	expected := []*servicecatalog.UpdateProvisioningParameter{
+		{
+			Key:   aws.String("VPCPrimaryCIDR"),
+			Value: aws.String("10.1.0.0/16"),
+		},
+		{
+			Key:              aws.String("Environment"),
+			UsePreviousValue: aws.Bool(true),
+		},
+	}
+
+	output := expandServiceCatalogUpdateProvisioningParameters(input)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Provisioned Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err := conn.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioned_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Provisioned Product (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfig(rName, cidr string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "%[1]s.json"
+
+  content = <<EOF
+{
+  "Parameters": {
+    "VPCPrimaryCIDR": {
+      "Type": "String",
+      "Default": "10.0.0.0/16"
+    }
+  },
+  "Resources": {
+    "MyVPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": { "Ref": "VPCPrimaryCIDR" }
+      }
+    }
+  },
+  "Outputs": {
+    "VpcID": {
+      "Description": "VPC ID",
+      "Value": { "Ref": "MyVPC" }
+    }
+  }
+}
+EOF
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  description   = %[1]q
+  provider_name = %[1]q
+}
+
+resource "aws_servicecatalog_product" "test" {
+  name  = %[1]q
+  owner = %[1]q
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_domain_name}/${aws_s3_bucket_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  product_id   = "${aws_servicecatalog_product.test.id}"
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = "${aws_servicecatalog_portfolio.test.id}"
+  principal_arn = "${data.aws_caller_identity.current.arn}"
+}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_name               = "${aws_servicecatalog_product.test.name}"
+  provisioning_artifact_name = %[1]q
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  depends_on = [
+    "aws_servicecatalog_product_portfolio_association.test",
+    "aws_servicecatalog_principal_portfolio_association.test",
+  ]
+}
+`, rName, cidr)
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"disable_template_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"guidance": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  servicecatalog.ProvisioningArtifactGuidanceDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactGuidanceDefault,
+					servicecatalog.ProvisioningArtifactGuidanceDeprecated,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"product_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_physical_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"template_url"},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"template_physical_id"},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID := d.Get("product_id").(string)
+	properties := &servicecatalog.ProvisioningArtifactProperties{
+		DisableTemplateValidation: aws.Bool(d.Get("disable_template_validation").(bool)),
+		Info:                      make(map[string]*string),
+		Type:                      aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		properties.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		properties.Info["LoadTemplateFromURL"] = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_physical_id"); ok {
+		properties.Info["ImportFromPhysicalId"] = aws.String(v.(string))
+	}
+
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       properties,
+		ProductId:        aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	output, err := conn.CreateProvisioningArtifact(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact for Product (%s): %s", productID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", aws.StringValue(output.ProvisioningArtifactDetail.Id), productID))
+
+	// Active and guidance can only be set after the artifact has been created.
+	if !d.Get("active").(bool) || d.Get("guidance").(string) != servicecatalog.ProvisioningArtifactGuidanceDefault {
+		if err := resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	artifactID, productID, err := decodeServiceCatalogID(d.Id(), "<provisioning-artifact-id>,<product-id>")
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	detail := output.ProvisioningArtifactDetail
+
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", detail.Description)
+	d.Set("guidance", detail.Guidance)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", detail.Id)
+	d.Set("type", detail.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	artifactID, productID, err := decodeServiceCatalogID(d.Id(), "<provisioning-artifact-id>,<product-id>")
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Guidance:               aws.String(d.Get("guidance").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	_, err = conn.UpdateProvisioningArtifact(input)
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	artifactID, productID, err := decodeServiceCatalogID(d.Id(), "<provisioning-artifact-id>,<product-id>")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog Provisioning Artifact: %s", d.Id())
+	_, err = conn.DeleteProvisioningArtifact(&servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
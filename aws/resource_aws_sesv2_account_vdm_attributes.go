@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2AccountVdmAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2AccountVdmAttributesPut,
+		Read:   resourceAwsSesV2AccountVdmAttributesRead,
+		Update: resourceAwsSesV2AccountVdmAttributesPut,
+		Delete: resourceAwsSesV2AccountVdmAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engagement_metrics": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"guardian_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"optimized_shared_delivery": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"vdm_enabled": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2AccountVdmAttributesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	attributes := &sesv2.VdmAttributes{
+		VdmEnabled: aws.String(d.Get("vdm_enabled").(string)),
+	}
+
+	if v, ok := d.GetOk("dashboard_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		attributes.DashboardAttributes = &sesv2.DashboardAttributes{}
+
+		if v, ok := tfMap["engagement_metrics"].(string); ok && v != "" {
+			attributes.DashboardAttributes.EngagementMetrics = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("guardian_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		attributes.GuardianAttributes = &sesv2.GuardianAttributes{}
+
+		if v, ok := tfMap["optimized_shared_delivery"].(string); ok && v != "" {
+			attributes.GuardianAttributes.OptimizedSharedDelivery = aws.String(v)
+		}
+	}
+
+	input := &sesv2.PutAccountVdmAttributesInput{
+		VdmAttributes: attributes,
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 account VDM attributes: %s", input)
+	_, err := conn.PutAccountVdmAttributes(input)
+	if err != nil {
+		return fmt.Errorf("error putting SESv2 account VDM attributes: %s", err)
+	}
+
+	if d.IsNewResource() {
+		// VDM is a per-region account setting.
+		d.SetId(meta.(*AWSClient).region)
+	}
+
+	return resourceAwsSesV2AccountVdmAttributesRead(d, meta)
+}
+
+func resourceAwsSesV2AccountVdmAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	output, err := conn.GetAccount(&sesv2.GetAccountInput{})
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 account: %s", err)
+	}
+
+	attributes := output.VdmAttributes
+	if attributes == nil {
+		attributes = &sesv2.VdmAttributes{
+			VdmEnabled: aws.String(sesv2.FeatureStatusDisabled),
+		}
+	}
+
+	d.Set("vdm_enabled", attributes.VdmEnabled)
+
+	var dashboardAttributes []interface{}
+	if v := attributes.DashboardAttributes; v != nil {
+		dashboardAttributes = []interface{}{map[string]interface{}{
+			"engagement_metrics": aws.StringValue(v.EngagementMetrics),
+		}}
+	}
+
+	if err := d.Set("dashboard_attributes", dashboardAttributes); err != nil {
+		return fmt.Errorf("error setting dashboard_attributes: %s", err)
+	}
+
+	var guardianAttributes []interface{}
+	if v := attributes.GuardianAttributes; v != nil {
+		guardianAttributes = []interface{}{map[string]interface{}{
+			"optimized_shared_delivery": aws.StringValue(v.OptimizedSharedDelivery),
+		}}
+	}
+
+	if err := d.Set("guardian_attributes", guardianAttributes); err != nil {
+		return fmt.Errorf("error setting guardian_attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2AccountVdmAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	input := &sesv2.PutAccountVdmAttributesInput{
+		VdmAttributes: &sesv2.VdmAttributes{
+			VdmEnabled: aws.String(sesv2.FeatureStatusDisabled),
+		},
+	}
+
+	log.Printf("[DEBUG] Disabling SESv2 account VDM: %s", input)
+	_, err := conn.PutAccountVdmAttributes(input)
+	if err != nil {
+		return fmt.Errorf("error disabling SESv2 account VDM: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2AccountVdmAttributes_basic(t *testing.T) {
+	resourceName := "aws_sesv2_account_vdm_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2AccountVdmAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2AccountVdmAttributesConfig_basic("ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2AccountVdmAttributesVdmEnabled("ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_enabled", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2AccountVdmAttributes_attributes(t *testing.T) {
+	resourceName := "aws_sesv2_account_vdm_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2AccountVdmAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2AccountVdmAttributesConfig_attributes("ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2AccountVdmAttributesVdmEnabled("ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.0.engagement_metrics", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.0.optimized_shared_delivery", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2AccountVdmAttributesConfig_attributes("DISABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2AccountVdmAttributesVdmEnabled("ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.0.engagement_metrics", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.0.optimized_shared_delivery", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2AccountVdmAttributesVdmEnabled(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		output, err := conn.GetAccount(&sesv2.GetAccountInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.VdmAttributes == nil {
+			return fmt.Errorf("SESv2 account VDM attributes not found")
+		}
+
+		if actual := aws.StringValue(output.VdmAttributes.VdmEnabled); actual != expected {
+			return fmt.Errorf("Expected SESv2 account VDM to be %q, got %q", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSESv2AccountVdmAttributesDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_account_vdm_attributes" {
+			continue
+		}
+
+		output, err := conn.GetAccount(&sesv2.GetAccountInput{})
+		if err != nil {
+			return err
+		}
+
+		if output.VdmAttributes != nil && aws.StringValue(output.VdmAttributes.VdmEnabled) != sesv2.FeatureStatusDisabled {
+			return fmt.Errorf("SESv2 account VDM still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2AccountVdmAttributesConfig_basic(vdmEnabled string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_vdm_attributes" "test" {
+  vdm_enabled = %[1]q
+}
+`, vdmEnabled)
+}
+
+func testAccAWSSESv2AccountVdmAttributesConfig_attributes(engagementMetrics, optimizedSharedDelivery string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_vdm_attributes" "test" {
+  vdm_enabled = "ENABLED"
+
+  dashboard_attributes {
+    engagement_metrics = %[1]q
+  }
+
+  guardian_attributes {
+    optimized_shared_delivery = %[2]q
+  }
+}
+`, engagementMetrics, optimizedSharedDelivery)
+}
@@ -0,0 +1,552 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2ConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ConfigurationSetCreate,
+		Read:   resourceAwsSesV2ConfigurationSetRead,
+		Update: resourceAwsSesV2ConfigurationSetUpdate,
+		Delete: resourceAwsSesV2ConfigurationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sesv2.TlsPolicyOptional,
+							ValidateFunc: validation.StringInSlice(sesv2.TlsPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"reputation_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_fresh_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reputation_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"sending_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"suppression_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed_reasons": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.SuppressionListReason_Values(), false),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vdm_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dashboard_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"engagement_metrics": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"guardian_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"optimized_shared_delivery": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("configuration_set_name").(string)
+	input := &sesv2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+		DeliveryOptions:      expandSesV2DeliveryOptions(d.Get("delivery_options").([]interface{})),
+		ReputationOptions:    expandSesV2ReputationOptions(d.Get("reputation_options").([]interface{})),
+		SendingOptions:       expandSesV2SendingOptions(d.Get("sending_options").([]interface{})),
+		SuppressionOptions:   expandSesV2SuppressionOptions(d.Get("suppression_options").([]interface{})),
+		TrackingOptions:      expandSesV2TrackingOptions(d.Get("tracking_options").([]interface{})),
+		VdmOptions:           expandSesV2VdmOptions(d.Get("vdm_options").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSESv2(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set: %s", input)
+	_, err := conn.CreateConfigurationSet(input)
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2ConfigurationSetRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	output, err := conn.GetConfigurationSet(&sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+
+	if err := d.Set("delivery_options", flattenSesV2DeliveryOptions(output.DeliveryOptions)); err != nil {
+		return fmt.Errorf("error setting delivery_options: %s", err)
+	}
+
+	if err := d.Set("reputation_options", flattenSesV2ReputationOptions(output.ReputationOptions)); err != nil {
+		return fmt.Errorf("error setting reputation_options: %s", err)
+	}
+
+	if err := d.Set("sending_options", flattenSesV2SendingOptions(output.SendingOptions)); err != nil {
+		return fmt.Errorf("error setting sending_options: %s", err)
+	}
+
+	if err := d.Set("suppression_options", flattenSesV2SuppressionOptions(output.SuppressionOptions)); err != nil {
+		return fmt.Errorf("error setting suppression_options: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapSESv2(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tracking_options", flattenSesV2TrackingOptions(output.TrackingOptions)); err != nil {
+		return fmt.Errorf("error setting tracking_options: %s", err)
+	}
+
+	if err := d.Set("vdm_options", flattenSesV2VdmOptions(output.VdmOptions)); err != nil {
+		return fmt.Errorf("error setting vdm_options: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("delivery_options") {
+		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v := expandSesV2DeliveryOptions(d.Get("delivery_options").([]interface{})); v != nil {
+			input.SendingPoolName = v.SendingPoolName
+			input.TlsPolicy = v.TlsPolicy
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set delivery options: %s", input)
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) delivery options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reputation_options") {
+		input := &sesv2.PutConfigurationSetReputationOptionsInput{
+			ConfigurationSetName:     aws.String(d.Id()),
+			ReputationMetricsEnabled: aws.Bool(false),
+		}
+
+		if v := expandSesV2ReputationOptions(d.Get("reputation_options").([]interface{})); v != nil {
+			input.ReputationMetricsEnabled = v.ReputationMetricsEnabled
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set reputation options: %s", input)
+		if _, err := conn.PutConfigurationSetReputationOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) reputation options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sending_options") {
+		input := &sesv2.PutConfigurationSetSendingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			SendingEnabled:       aws.Bool(true),
+		}
+
+		if v := expandSesV2SendingOptions(d.Get("sending_options").([]interface{})); v != nil {
+			input.SendingEnabled = v.SendingEnabled
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set sending options: %s", input)
+		if _, err := conn.PutConfigurationSetSendingOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) sending options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("suppression_options") {
+		input := &sesv2.PutConfigurationSetSuppressionOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v := expandSesV2SuppressionOptions(d.Get("suppression_options").([]interface{})); v != nil {
+			input.SuppressedReasons = v.SuppressedReasons
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set suppression options: %s", input)
+		if _, err := conn.PutConfigurationSetSuppressionOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) suppression options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v := expandSesV2TrackingOptions(d.Get("tracking_options").([]interface{})); v != nil {
+			input.CustomRedirectDomain = v.CustomRedirectDomain
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set tracking options: %s", input)
+		if _, err := conn.PutConfigurationSetTrackingOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tracking options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vdm_options") {
+		input := &sesv2.PutConfigurationSetVdmOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			VdmOptions:           expandSesV2VdmOptions(d.Get("vdm_options").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set VDM options: %s", input)
+		if _, err := conn.PutConfigurationSetVdmOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) VDM options: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsSESv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SESv2 Configuration Set (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSesV2ConfigurationSetRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSet(&sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2DeliveryOptions(l []interface{}) *sesv2.DeliveryOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &sesv2.DeliveryOptions{}
+
+	if v, ok := m["sending_pool_name"].(string); ok && v != "" {
+		options.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := m["tls_policy"].(string); ok && v != "" {
+		options.TlsPolicy = aws.String(v)
+	}
+
+	return options
+}
+
+func expandSesV2ReputationOptions(l []interface{}) *sesv2.ReputationOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sesv2.ReputationOptions{
+		ReputationMetricsEnabled: aws.Bool(m["reputation_metrics_enabled"].(bool)),
+	}
+}
+
+func expandSesV2SendingOptions(l []interface{}) *sesv2.SendingOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sesv2.SendingOptions{
+		SendingEnabled: aws.Bool(m["sending_enabled"].(bool)),
+	}
+}
+
+func expandSesV2SuppressionOptions(l []interface{}) *sesv2.SuppressionOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &sesv2.SuppressionOptions{}
+
+	if v, ok := m["suppressed_reasons"].(*schema.Set); ok {
+		options.SuppressedReasons = expandStringSet(v)
+	}
+
+	return options
+}
+
+func expandSesV2TrackingOptions(l []interface{}) *sesv2.TrackingOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sesv2.TrackingOptions{
+		CustomRedirectDomain: aws.String(m["custom_redirect_domain"].(string)),
+	}
+}
+
+func expandSesV2VdmOptions(l []interface{}) *sesv2.VdmOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &sesv2.VdmOptions{}
+
+	if v, ok := m["dashboard_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		options.DashboardOptions = &sesv2.DashboardOptions{}
+
+		if v, ok := tfMap["engagement_metrics"].(string); ok && v != "" {
+			options.DashboardOptions.EngagementMetrics = aws.String(v)
+		}
+	}
+
+	if v, ok := m["guardian_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		options.GuardianOptions = &sesv2.GuardianOptions{}
+
+		if v, ok := tfMap["optimized_shared_delivery"].(string); ok && v != "" {
+			options.GuardianOptions.OptimizedSharedDelivery = aws.String(v)
+		}
+	}
+
+	return options
+}
+
+func flattenSesV2DeliveryOptions(options *sesv2.DeliveryOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"sending_pool_name": aws.StringValue(options.SendingPoolName),
+		"tls_policy":        aws.StringValue(options.TlsPolicy),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesV2ReputationOptions(options *sesv2.ReputationOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"reputation_metrics_enabled": aws.BoolValue(options.ReputationMetricsEnabled),
+	}
+
+	if options.LastFreshStart != nil {
+		m["last_fresh_start"] = aws.TimeValue(options.LastFreshStart).Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesV2SendingOptions(options *sesv2.SendingOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"sending_enabled": aws.BoolValue(options.SendingEnabled),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesV2SuppressionOptions(options *sesv2.SuppressionOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"suppressed_reasons": schema.NewSet(schema.HashString, flattenStringList(options.SuppressedReasons)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesV2TrackingOptions(options *sesv2.TrackingOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"custom_redirect_domain": aws.StringValue(options.CustomRedirectDomain),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesV2VdmOptions(options *sesv2.VdmOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := options.DashboardOptions; v != nil {
+		m["dashboard_options"] = []interface{}{map[string]interface{}{
+			"engagement_metrics": aws.StringValue(v.EngagementMetrics),
+		}}
+	}
+
+	if v := options.GuardianOptions; v != nil {
+		m["guardian_options"] = []interface{}{map[string]interface{}{
+			"optimized_shared_delivery": aws.StringValue(v.OptimizedSharedDelivery),
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,371 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2ConfigurationSetEventDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ConfigurationSetEventDestinationCreate,
+		Read:   resourceAwsSesV2ConfigurationSetEventDestinationRead,
+		Update: resourceAwsSesV2ConfigurationSetEventDestinationUpdate,
+		Delete: resourceAwsSesV2ConfigurationSetEventDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_dimension_value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_value_source": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(sesv2.DimensionValueSource_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"kinesis_firehose_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ConflictsWith: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"matching_event_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.EventType_Values(), false),
+							},
+							Set: schema.HashString,
+						},
+						"pinpoint_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ConflictsWith: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"sns_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ConflictsWith: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+							},
+						},
+					},
+				},
+			},
+			"event_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+
+	input := &sesv2.CreateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandSesV2EventDestinationDefinition(d.Get("event_destination").([]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set Event Destination: %s", input)
+	_, err := conn.CreateConfigurationSetEventDestination(input)
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set (%s) Event Destination (%s): %s", configurationSetName, eventDestinationName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", configurationSetName, eventDestinationName))
+
+	return resourceAwsSesV2ConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName, eventDestinationName, err := decodeSesV2ConfigurationSetEventDestinationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetConfigurationSetEventDestinations(&sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", configurationSetName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s) Event Destinations: %s", configurationSetName, err)
+	}
+
+	var eventDestination *sesv2.EventDestination
+	for _, v := range output.EventDestinations {
+		if aws.StringValue(v.Name) == eventDestinationName {
+			eventDestination = v
+			break
+		}
+	}
+
+	if eventDestination == nil {
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("configuration_set_name", configurationSetName)
+	d.Set("event_destination_name", eventDestinationName)
+
+	if err := d.Set("event_destination", flattenSesV2EventDestination(eventDestination)); err != nil {
+		return fmt.Errorf("error setting event_destination: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	input := &sesv2.UpdateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(d.Get("configuration_set_name").(string)),
+		EventDestination:     expandSesV2EventDestinationDefinition(d.Get("event_destination").([]interface{})),
+		EventDestinationName: aws.String(d.Get("event_destination_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating SESv2 Configuration Set Event Destination: %s", input)
+	_, err := conn.UpdateConfigurationSetEventDestination(input)
+	if err != nil {
+		return fmt.Errorf("error updating SESv2 Configuration Set Event Destination (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSesV2ConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set Event Destination: %s", d.Id())
+	_, err := conn.DeleteConfigurationSetEventDestination(&sesv2.DeleteConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(d.Get("configuration_set_name").(string)),
+		EventDestinationName: aws.String(d.Get("event_destination_name").(string)),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set Event Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeSesV2ConfigurationSetEventDestinationID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected CONFIGURATION-SET-NAME/EVENT-DESTINATION-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandSesV2EventDestinationDefinition(l []interface{}) *sesv2.EventDestinationDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	definition := &sesv2.EventDestinationDefinition{
+		Enabled:            aws.Bool(m["enabled"].(bool)),
+		MatchingEventTypes: expandStringSet(m["matching_event_types"].(*schema.Set)),
+	}
+
+	if v, ok := m["cloud_watch_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition.CloudWatchDestination = &sesv2.CloudWatchDestination{}
+
+		for _, raw := range tfMap["dimension_configuration"].([]interface{}) {
+			dimension := raw.(map[string]interface{})
+
+			definition.CloudWatchDestination.DimensionConfigurations = append(definition.CloudWatchDestination.DimensionConfigurations, &sesv2.CloudWatchDimensionConfiguration{
+				DefaultDimensionValue: aws.String(dimension["default_dimension_value"].(string)),
+				DimensionName:         aws.String(dimension["dimension_name"].(string)),
+				DimensionValueSource:  aws.String(dimension["dimension_value_source"].(string)),
+			})
+		}
+	}
+
+	if v, ok := m["kinesis_firehose_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition.KinesisFirehoseDestination = &sesv2.KinesisFirehoseDestination{
+			DeliveryStreamArn: aws.String(tfMap["delivery_stream_arn"].(string)),
+			IamRoleArn:        aws.String(tfMap["iam_role_arn"].(string)),
+		}
+	}
+
+	if v, ok := m["pinpoint_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition.PinpointDestination = &sesv2.PinpointDestination{
+			ApplicationArn: aws.String(tfMap["application_arn"].(string)),
+		}
+	}
+
+	if v, ok := m["sns_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition.SnsDestination = &sesv2.SnsDestination{
+			TopicArn: aws.String(tfMap["topic_arn"].(string)),
+		}
+	}
+
+	return definition
+}
+
+func flattenSesV2EventDestination(destination *sesv2.EventDestination) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":              aws.BoolValue(destination.Enabled),
+		"matching_event_types": schema.NewSet(schema.HashString, flattenStringList(destination.MatchingEventTypes)),
+	}
+
+	if v := destination.CloudWatchDestination; v != nil {
+		dimensions := make([]interface{}, 0, len(v.DimensionConfigurations))
+		for _, dimension := range v.DimensionConfigurations {
+			dimensions = append(dimensions, map[string]interface{}{
+				"default_dimension_value": aws.StringValue(dimension.DefaultDimensionValue),
+				"dimension_name":          aws.StringValue(dimension.DimensionName),
+				"dimension_value_source":  aws.StringValue(dimension.DimensionValueSource),
+			})
+		}
+
+		m["cloud_watch_destination"] = []interface{}{map[string]interface{}{
+			"dimension_configuration": dimensions,
+		}}
+	}
+
+	if v := destination.KinesisFirehoseDestination; v != nil {
+		m["kinesis_firehose_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.StringValue(v.DeliveryStreamArn),
+			"iam_role_arn":        aws.StringValue(v.IamRoleArn),
+		}}
+	}
+
+	if v := destination.PinpointDestination; v != nil {
+		m["pinpoint_destination"] = []interface{}{map[string]interface{}{
+			"application_arn": aws.StringValue(v.ApplicationArn),
+		}}
+	}
+
+	if v := destination.SnsDestination; v != nil {
+		m["sns_destination"] = []interface{}{map[string]interface{}{
+			"topic_arn": aws.StringValue(v.TopicArn),
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2ConfigurationSetEventDestination_cloudWatch(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ConfigurationSetEventDestinationConfig_cloudWatch(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.matching_event_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2ConfigurationSetEventDestinationConfig_cloudWatch(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2ConfigurationSetEventDestination_sns(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ConfigurationSetEventDestinationConfig_sns(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.matching_event_types.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_destination.0.sns_destination.0.topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2ConfigurationSetEventDestinationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Configuration Set Event Destination ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		destination, err := testAccAWSSESv2ConfigurationSetEventDestinationFind(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if destination == nil {
+			return fmt.Errorf("SESv2 Configuration Set Event Destination %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSESv2ConfigurationSetEventDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set_event_destination" {
+			continue
+		}
+
+		destination, err := testAccAWSSESv2ConfigurationSetEventDestinationFind(conn, rs.Primary.ID)
+		if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if destination != nil {
+			return fmt.Errorf("SESv2 Configuration Set Event Destination %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2ConfigurationSetEventDestinationFind(conn *sesv2.SESV2, id string) (*sesv2.EventDestination, error) {
+	configurationSetName, eventDestinationName, err := decodeSesV2ConfigurationSetEventDestinationID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.GetConfigurationSetEventDestinations(&sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, destination := range output.EventDestinations {
+		if aws.StringValue(destination.Name) == eventDestinationName {
+			return destination, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func testAccAWSSESv2ConfigurationSetEventDestinationConfig_cloudWatch(rName, defaultDimensionValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = "${aws_sesv2_configuration_set.test.configuration_set_name}"
+  event_destination_name = %[1]q
+
+  event_destination {
+    enabled              = true
+    matching_event_types = ["SEND"]
+
+    cloud_watch_destination {
+      dimension_configuration {
+        default_dimension_value = %[2]q
+        dimension_name          = "test"
+        dimension_value_source  = "MESSAGE_TAG"
+      }
+    }
+  }
+}
+`, rName, defaultDimensionValue)
+}
+
+func testAccAWSSESv2ConfigurationSetEventDestinationConfig_sns(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = "${aws_sesv2_configuration_set.test.configuration_set_name}"
+  event_destination_name = %[1]q
+
+  event_destination {
+    enabled              = true
+    matching_event_types = ["BOUNCE", "COMPLAINT"]
+
+    sns_destination {
+      topic_arn = "${aws_sns_topic.test.arn}"
+    }
+  }
+}
+`, rName)
+}
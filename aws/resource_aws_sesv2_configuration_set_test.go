@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2ConfigurationSet_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ConfigurationSetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:ses:[^:]+:[^:]+:configuration-set/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2ConfigurationSet_options(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ConfigurationSetConfig_options(rName, "REQUIRE", true, false, "BOUNCE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", "REQUIRE"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.dashboard_options.0.engagement_metrics", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.guardian_options.0.optimized_shared_delivery", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2ConfigurationSetConfig_options(rName, "OPTIONAL", false, true, "COMPLAINT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2ConfigurationSet_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ConfigurationSetConfig_tags(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2ConfigurationSetConfig_tags(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2ConfigurationSetExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Configuration Set ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetConfigurationSet(&sesv2.GetConfigurationSetInput{
+			ConfigurationSetName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSESv2ConfigurationSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set" {
+			continue
+		}
+
+		_, err := conn.GetConfigurationSet(&sesv2.GetConfigurationSetInput{
+			ConfigurationSetName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Configuration Set %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2ConfigurationSetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESv2ConfigurationSetConfig_options(rName, tlsPolicy string, reputationMetricsEnabled, sendingEnabled bool, suppressedReason string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  delivery_options {
+    tls_policy = %[2]q
+  }
+
+  reputation_options {
+    reputation_metrics_enabled = %[3]t
+  }
+
+  sending_options {
+    sending_enabled = %[4]t
+  }
+
+  suppression_options {
+    suppressed_reasons = [%[5]q]
+  }
+
+  vdm_options {
+    dashboard_options {
+      engagement_metrics = "ENABLED"
+    }
+
+    guardian_options {
+      optimized_shared_delivery = "ENABLED"
+    }
+  }
+}
+`, rName, tlsPolicy, reputationMetricsEnabled, sendingEnabled, suppressedReason)
+}
+
+func testAccAWSSESv2ConfigurationSetConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags {
+    key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2ContactList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ContactListCreate,
+		Read:   resourceAwsSesV2ContactListRead,
+		Update: resourceAwsSesV2ContactListUpdate,
+		Delete: resourceAwsSesV2ContactListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_list_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"topic": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_subscription_status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.SubscriptionStatus_Values(), false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"topic_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ContactListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("contact_list_name").(string)
+	input := &sesv2.CreateContactListInput{
+		ContactListName: aws.String(name),
+		Topics:          expandSesV2Topics(d.Get("topic").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSESv2(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Contact List: %s", input)
+	_, err := conn.CreateContactList(input)
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Contact List (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2ContactListRead(d, meta)
+}
+
+func resourceAwsSesV2ContactListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	output, err := conn.GetContactList(&sesv2.GetContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] SESv2 Contact List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Contact List (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("contact-list/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("contact_list_name", output.ContactListName)
+	d.Set("description", output.Description)
+
+	if output.CreatedTimestamp != nil {
+		d.Set("created_timestamp", aws.TimeValue(output.CreatedTimestamp).Format(time.RFC3339))
+	}
+
+	if output.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(output.LastUpdatedTimestamp).Format(time.RFC3339))
+	}
+
+	if err := d.Set("topic", flattenSesV2Topics(output.Topics)); err != nil {
+		return fmt.Errorf("error setting topic: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapSESv2(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ContactListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("description") || d.HasChange("topic") {
+		input := &sesv2.UpdateContactListInput{
+			ContactListName: aws.String(d.Id()),
+			Topics:          expandSesV2Topics(d.Get("topic").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Contact List: %s", input)
+		if _, err := conn.UpdateContactList(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Contact List (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsSESv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SESv2 Contact List (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSesV2ContactListRead(d, meta)
+}
+
+func resourceAwsSesV2ContactListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Contact List: %s", d.Id())
+	_, err := conn.DeleteContactList(&sesv2.DeleteContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Contact List (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2Topics(l []interface{}) []*sesv2.Topic {
+	topics := make([]*sesv2.Topic, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		topic := &sesv2.Topic{
+			DefaultSubscriptionStatus: aws.String(tfMap["default_subscription_status"].(string)),
+			DisplayName:               aws.String(tfMap["display_name"].(string)),
+			TopicName:                 aws.String(tfMap["topic_name"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			topic.Description = aws.String(v)
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+func flattenSesV2Topics(topics []*sesv2.Topic) []interface{} {
+	l := make([]interface{}, 0, len(topics))
+
+	for _, topic := range topics {
+		if topic == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"default_subscription_status": aws.StringValue(topic.DefaultSubscriptionStatus),
+			"description":                 aws.StringValue(topic.Description),
+			"display_name":                aws.StringValue(topic.DisplayName),
+			"topic_name":                  aws.StringValue(topic.TopicName),
+		})
+	}
+
+	return l
+}
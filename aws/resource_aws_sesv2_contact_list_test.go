@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2ContactList_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_contact_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ContactListConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ContactListExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:ses:[^:]+:[^:]+:contact-list/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "contact_list_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2ContactList_topic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_contact_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2ContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2ContactListConfig_topic(rName, "description1", "OPT_IN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ContactListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2ContactListConfig_topic(rName, "description2", "OPT_OUT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2ContactListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2ContactListExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Contact List ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetContactList(&sesv2.GetContactListInput{
+			ContactListName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSESv2ContactListDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_contact_list" {
+			continue
+		}
+
+		_, err := conn.GetContactList(&sesv2.GetContactListInput{
+			ContactListName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Contact List %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2ContactListConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESv2ContactListConfig_topic(rName, description, defaultSubscriptionStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+  description       = %[2]q
+
+  topic {
+    default_subscription_status = %[3]q
+    description                 = "topic description"
+    display_name                = "Topic"
+    topic_name                  = "topic"
+  }
+}
+`, rName, description, defaultSubscriptionStatus)
+}
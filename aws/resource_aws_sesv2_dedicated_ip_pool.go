@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2DedicatedIpPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2DedicatedIpPoolCreate,
+		Read:   resourceAwsSesV2DedicatedIpPoolRead,
+		Update: resourceAwsSesV2DedicatedIpPoolUpdate,
+		Delete: resourceAwsSesV2DedicatedIpPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dedicated_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"warmup_percentage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"warmup_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scaling_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      sesv2.ScalingModeStandard,
+				ValidateFunc: validation.StringInSlice(sesv2.ScalingMode_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSesV2DedicatedIpPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("pool_name").(string)
+	input := &sesv2.CreateDedicatedIpPoolInput{
+		PoolName:    aws.String(name),
+		ScalingMode: aws.String(d.Get("scaling_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSESv2(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Dedicated IP Pool: %s", input)
+	_, err := conn.CreateDedicatedIpPool(input)
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Dedicated IP Pool (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	output, err := conn.GetDedicatedIpPool(&sesv2.GetDedicatedIpPoolInput{
+		PoolName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] SESv2 Dedicated IP Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Dedicated IP Pool (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("pool_name", output.DedicatedIpPool.PoolName)
+	d.Set("scaling_mode", output.DedicatedIpPool.ScalingMode)
+
+	// Warmup progress is reported per dedicated IP, not on the pool itself.
+	var dedicatedIps []map[string]interface{}
+	err = conn.GetDedicatedIpsPages(&sesv2.GetDedicatedIpsInput{
+		PoolName: aws.String(d.Id()),
+	}, func(page *sesv2.GetDedicatedIpsOutput, lastPage bool) bool {
+		for _, ip := range page.DedicatedIps {
+			dedicatedIps = append(dedicatedIps, map[string]interface{}{
+				"ip":                aws.StringValue(ip.Ip),
+				"warmup_percentage": int(aws.Int64Value(ip.WarmupPercentage)),
+				"warmup_status":     aws.StringValue(ip.WarmupStatus),
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Dedicated IP Pool (%s) dedicated IPs: %s", d.Id(), err)
+	}
+
+	if err := d.Set("dedicated_ips", dedicatedIps); err != nil {
+		return fmt.Errorf("error setting dedicated_ips: %s", err)
+	}
+
+	tags, err := conn.ListTagsForResource(&sesv2.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Dedicated IP Pool (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapSESv2(tags.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2DedicatedIpPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if err := setTagsSESv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SESv2 Dedicated IP Pool (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Dedicated IP Pool: %s", d.Id())
+	_, err := conn.DeleteDedicatedIpPool(&sesv2.DeleteDedicatedIpPoolInput{
+		PoolName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Dedicated IP Pool (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2DedicatedIpPool_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2DedicatedIpPoolConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2DedicatedIpPoolExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:ses:[^:]+:[^:]+:dedicated-ip-pool/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_ips.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_mode", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2DedicatedIpPool_scalingMode(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2DedicatedIpPoolConfig_scalingMode(rName, "MANAGED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_mode", "MANAGED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2DedicatedIpPool_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2DedicatedIpPoolConfig_tags(rName, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2DedicatedIpPoolConfig_tags(rName, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2DedicatedIpPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Dedicated IP Pool ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetDedicatedIpPool(&sesv2.GetDedicatedIpPoolInput{
+			PoolName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSESv2DedicatedIpPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_dedicated_ip_pool" {
+			continue
+		}
+
+		_, err := conn.GetDedicatedIpPool(&sesv2.GetDedicatedIpPoolInput{
+			PoolName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Dedicated IP Pool %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2DedicatedIpPoolConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESv2DedicatedIpPoolConfig_scalingMode(rName, scalingMode string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name    = %[1]q
+  scaling_mode = %[2]q
+}
+`, rName, scalingMode)
+}
+
+func testAccAWSSESv2DedicatedIpPoolConfig_tags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+
+  tags {
+    key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
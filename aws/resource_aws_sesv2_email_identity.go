@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSesV2EmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2EmailIdentityCreate,
+		Read:   resourceAwsSesV2EmailIdentityRead,
+		Update: resourceAwsSesV2EmailIdentityUpdate,
+		Delete: resourceAwsSesV2EmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_signing_key_length": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_signing_private_key": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringLenBetween(1, 20480),
+							ConflictsWith: []string{"dkim_signing_attributes.0.next_signing_key_length"},
+						},
+						"domain_signing_selector": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringLenBetween(1, 63),
+							ConflictsWith: []string{"dkim_signing_attributes.0.next_signing_key_length"},
+						},
+						"last_key_generation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_signing_key_length": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ValidateFunc:  validation.StringInSlice(sesv2.DkimSigningKeyLength_Values(), false),
+							ConflictsWith: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 320),
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2EmailIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.CreateEmailIdentityInput{
+		DkimSigningAttributes: expandSesV2DkimSigningAttributes(d.Get("dkim_signing_attributes").([]interface{})),
+		EmailIdentity:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration_set_name"); ok {
+		input.ConfigurationSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSESv2(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Email Identity: %s", input)
+	_, err := conn.CreateEmailIdentity(input)
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Email Identity (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2EmailIdentityRead(d, meta)
+}
+
+func resourceAwsSesV2EmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	output, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("identity/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+	d.Set("email_identity", d.Id())
+	d.Set("identity_type", output.IdentityType)
+	d.Set("verified_for_sending_status", output.VerifiedForSendingStatus)
+
+	if err := d.Set("dkim_signing_attributes", flattenSesV2DkimAttributes(output.DkimAttributes, d.Get("dkim_signing_attributes").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting dkim_signing_attributes: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapSESv2(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2EmailIdentityUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("configuration_set_name") {
+		input := &sesv2.PutEmailIdentityConfigurationSetAttributesInput{
+			EmailIdentity: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration_set_name"); ok {
+			input.ConfigurationSetName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity configuration set: %s", input)
+		if _, err := conn.PutEmailIdentityConfigurationSetAttributes(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) configuration set: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("dkim_signing_attributes") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: aws.String(sesv2.DkimSigningAttributesOriginAwsSes),
+		}
+
+		if v := expandSesV2DkimSigningAttributes(d.Get("dkim_signing_attributes").([]interface{})); v != nil {
+			input.SigningAttributes = v
+
+			// Bring Your Own DKIM is signalled by providing a private key.
+			if v.DomainSigningPrivateKey != nil {
+				input.SigningAttributesOrigin = aws.String(sesv2.DkimSigningAttributesOriginExternal)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity DKIM signing attributes: %s", input)
+		if _, err := conn.PutEmailIdentityDkimSigningAttributes(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) DKIM signing attributes: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsSESv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SESv2 Email Identity (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSesV2EmailIdentityRead(d, meta)
+}
+
+func resourceAwsSesV2EmailIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentity(&sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2DkimSigningAttributes(l []interface{}) *sesv2.DkimSigningAttributes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	attributes := &sesv2.DkimSigningAttributes{}
+
+	if v, ok := m["domain_signing_private_key"].(string); ok && v != "" {
+		attributes.DomainSigningPrivateKey = aws.String(v)
+	}
+
+	if v, ok := m["domain_signing_selector"].(string); ok && v != "" {
+		attributes.DomainSigningSelector = aws.String(v)
+	}
+
+	if v, ok := m["next_signing_key_length"].(string); ok && v != "" {
+		attributes.NextSigningKeyLength = aws.String(v)
+	}
+
+	return attributes
+}
+
+// The private key and selector are write-only, so they are carried over
+// from the configuration.
+func flattenSesV2DkimAttributes(attributes *sesv2.DkimAttributes, configured []interface{}) []interface{} {
+	if attributes == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"current_signing_key_length": aws.StringValue(attributes.CurrentSigningKeyLength),
+		"next_signing_key_length":    aws.StringValue(attributes.NextSigningKeyLength),
+		"signing_attributes_origin":  aws.StringValue(attributes.SigningAttributesOrigin),
+		"status":                     aws.StringValue(attributes.Status),
+		"tokens":                     flattenStringList(attributes.Tokens),
+	}
+
+	if attributes.LastKeyGenerationTimestamp != nil {
+		m["last_key_generation_timestamp"] = aws.TimeValue(attributes.LastKeyGenerationTimestamp).Format(time.RFC3339)
+	}
+
+	if len(configured) > 0 && configured[0] != nil {
+		tfMap := configured[0].(map[string]interface{})
+		m["domain_signing_private_key"] = tfMap["domain_signing_private_key"]
+		m["domain_signing_selector"] = tfMap["domain_signing_selector"]
+	}
+
+	return []interface{}{m}
+}
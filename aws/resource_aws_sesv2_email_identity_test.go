@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSESv2EmailIdentity_emailAddress(t *testing.T) {
+	emailIdentity := fmt.Sprintf("tf-acc-%s@example.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_basic(emailIdentity),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:ses:[^:]+:[^:]+:identity/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", emailIdentity),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", "EMAIL_ADDRESS"),
+					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2EmailIdentity_domain(t *testing.T) {
+	domain := fmt.Sprintf("tf-acc-%s.example.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_basic(domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", "DOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "AWS_SES"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2EmailIdentity_nextSigningKeyLength(t *testing.T) {
+	domain := fmt.Sprintf("tf-acc-%s.example.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_nextSigningKeyLength(domain, "RSA_1024_BIT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.next_signing_key_length", "RSA_1024_BIT"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_nextSigningKeyLength(domain, "RSA_2048_BIT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.next_signing_key_length", "RSA_2048_BIT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESv2EmailIdentity_configurationSetName(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	emailIdentity := fmt.Sprintf("%s@example.com", rName)
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSESv2EmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_configurationSetName(rName, emailIdentity),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", "aws_sesv2_configuration_set.test", "configuration_set_name"),
+				),
+			},
+			{
+				Config: testAccAWSSESv2EmailIdentityConfig_basic(emailIdentity),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSESv2EmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSESv2EmailIdentityExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+			EmailIdentity: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSSESv2EmailIdentityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity" {
+			continue
+		}
+
+		_, err := conn.GetEmailIdentity(&sesv2.GetEmailIdentityInput{
+			EmailIdentity: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, sesv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Email Identity %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSESv2EmailIdentityConfig_basic(emailIdentity string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+`, emailIdentity)
+}
+
+func testAccAWSSESv2EmailIdentityConfig_nextSigningKeyLength(domain, nextSigningKeyLength string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  dkim_signing_attributes {
+    next_signing_key_length = %[2]q
+  }
+}
+`, domain, nextSigningKeyLength)
+}
+
+func testAccAWSSESv2EmailIdentityConfig_configurationSetName(rName, emailIdentity string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_email_identity" "test" {
+  email_identity         = %[2]q
+  configuration_set_name = "${aws_sesv2_configuration_set.test.configuration_set_name}"
+}
+`, rName, emailIdentity)
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSfnAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSfnAliasCreate,
+		Read:   resourceAwsSfnAliasRead,
+		Update: resourceAwsSfnAliasUpdate,
+		Delete: resourceAwsSfnAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSfnStateMachineName,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSfnAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	name := d.Get("name").(string)
+	params := &sfn.CreateStateMachineAliasInput{
+		Name:                 aws.String(name),
+		RoutingConfiguration: expandAwsSfnAliasRoutingConfiguration(d.Get("routing_configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Step Function State Machine Alias: %s", name)
+	out, err := conn.CreateStateMachineAlias(params)
+	if err != nil {
+		return fmt.Errorf("error creating Step Function State Machine Alias (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(out.StateMachineAliasArn))
+
+	return resourceAwsSfnAliasRead(d, meta)
+}
+
+func resourceAwsSfnAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+	log.Printf("[DEBUG] Reading Step Function State Machine Alias: %s", d.Id())
+
+	out, err := conn.DescribeStateMachineAlias(&sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sfn.ErrCodeResourceNotFound, "") {
+		log.Printf("[WARN] Step Function State Machine Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Step Function State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.StateMachineAliasArn)
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+
+	if out.CreationDate != nil {
+		d.Set("creation_date", out.CreationDate.Format(time.RFC3339))
+	}
+
+	if err := d.Set("routing_configuration", flattenAwsSfnAliasRoutingConfiguration(out.RoutingConfiguration)); err != nil {
+		return fmt.Errorf("error setting routing_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSfnAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	params := &sfn.UpdateStateMachineAliasInput{
+		Description:          aws.String(d.Get("description").(string)),
+		RoutingConfiguration: expandAwsSfnAliasRoutingConfiguration(d.Get("routing_configuration").([]interface{})),
+		StateMachineAliasArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating Step Function State Machine Alias: %s", params)
+	_, err := conn.UpdateStateMachineAlias(params)
+	if err != nil {
+		return fmt.Errorf("error updating Step Function State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSfnAliasRead(d, meta)
+}
+
+func resourceAwsSfnAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+	log.Printf("[DEBUG] Deleting Step Function State Machine Alias: %s", d.Id())
+
+	_, err := conn.DeleteStateMachineAlias(&sfn.DeleteStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, sfn.ErrCodeResourceNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Step Function State Machine Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAwsSfnAliasRoutingConfiguration(l []interface{}) []*sfn.RoutingConfigurationListItem {
+	items := make([]*sfn.RoutingConfigurationListItem, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		items = append(items, &sfn.RoutingConfigurationListItem{
+			StateMachineVersionArn: aws.String(m["state_machine_version_arn"].(string)),
+			Weight:                 aws.Int64(int64(m["weight"].(int))),
+		})
+	}
+
+	return items
+}
+
+func flattenAwsSfnAliasRoutingConfiguration(items []*sfn.RoutingConfigurationListItem) []interface{} {
+	l := make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		l = append(l, map[string]interface{}{
+			"state_machine_version_arn": aws.StringValue(item.StateMachineVersionArn),
+			"weight":                    int(aws.Int64Value(item.Weight)),
+		})
+	}
+
+	return l
+}
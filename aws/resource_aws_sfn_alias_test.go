@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSfnAlias_basic(t *testing.T) {
+	resourceName := "aws_sfn_state_machine.test"
+	aliasResourceName := "aws_sfn_alias.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSfnStateMachineConfigPublishAlias(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestMatchResourceAttr(resourceName, "state_machine_version_arn", regexp.MustCompile(`:stateMachine:.+:\d+$`)),
+					resource.TestCheckResourceAttrSet(aliasResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(aliasResourceName, "creation_date"),
+					resource.TestCheckResourceAttr(aliasResourceName, "description", "first"),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.0.weight", "100"),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.0.state_machine_version_arn", resourceName, "state_machine_version_arn"),
+				),
+			},
+			{
+				ResourceName:      aliasResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSfnStateMachineConfigPublishAlias(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName),
+					resource.TestCheckResourceAttr(aliasResourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSfnAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sfn_alias" {
+			continue
+		}
+
+		_, err := conn.DescribeStateMachineAlias(&sfn.DescribeStateMachineAliasInput{
+			StateMachineAliasArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, sfn.ErrCodeResourceNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Step Function State Machine Alias (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSfnStateMachine() *schema.Resource {
@@ -27,7 +28,7 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 			"definition": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateMaxLength(1024 * 1024), // 1048576
+				ValidateFunc: validateSfnStateMachineDefinition,
 			},
 
 			"name": {
@@ -43,6 +44,80 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sfn.StateMachineTypeStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					sfn.StateMachineTypeStandard,
+					sfn.StateMachineTypeExpress,
+				}, false),
+			},
+
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_execution_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  sfn.LogLevelOff,
+							ValidateFunc: validation.StringInSlice([]string{
+								sfn.LogLevelAll,
+								sfn.LogLevelError,
+								sfn.LogLevelFatal,
+								sfn.LogLevelOff,
+							}, false),
+						},
+						"log_destination": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"tracing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,7 +138,21 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 	params := &sfn.CreateStateMachineInput{
 		Definition: aws.String(d.Get("definition").(string)),
 		Name:       aws.String(d.Get("name").(string)),
+		Publish:    aws.Bool(d.Get("publish").(bool)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("logging_configuration"); ok {
+		params.LoggingConfiguration = expandAwsSfnLoggingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tracing_configuration"); ok {
+		params.TracingConfiguration = expandAwsSfnTracingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		params.VersionDescription = aws.String(v.(string))
 	}
 
 	var activity *sfn.CreateStateMachineOutput
@@ -91,6 +180,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*activity.StateMachineArn)
+	d.Set("state_machine_version_arn", activity.StateMachineVersionArn)
 
 	return resourceAwsSfnStateMachineRead(d, meta)
 }
@@ -117,6 +207,15 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", sm.Name)
 	d.Set("role_arn", sm.RoleArn)
 	d.Set("status", sm.Status)
+	d.Set("type", sm.Type)
+
+	if err := d.Set("logging_configuration", flattenAwsSfnLoggingConfiguration(sm.LoggingConfiguration)); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %s", err)
+	}
+
+	if err := d.Set("tracing_configuration", flattenAwsSfnTracingConfiguration(sm.TracingConfiguration)); err != nil {
+		return fmt.Errorf("error setting tracing_configuration: %s", err)
+	}
 
 	if err := d.Set("creation_date", sm.CreationDate.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Error setting creation_date: %s", err)
@@ -131,10 +230,23 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 	params := &sfn.UpdateStateMachineInput{
 		StateMachineArn: aws.String(d.Id()),
 		Definition:      aws.String(d.Get("definition").(string)),
+		Publish:         aws.Bool(d.Get("publish").(bool)),
 		RoleArn:         aws.String(d.Get("role_arn").(string)),
 	}
 
-	_, err := conn.UpdateStateMachine(params)
+	if d.HasChange("logging_configuration") {
+		params.LoggingConfiguration = expandAwsSfnLoggingConfiguration(d.Get("logging_configuration").([]interface{}))
+	}
+
+	if d.HasChange("tracing_configuration") {
+		params.TracingConfiguration = expandAwsSfnTracingConfiguration(d.Get("tracing_configuration").([]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok && d.Get("publish").(bool) {
+		params.VersionDescription = aws.String(v.(string))
+	}
+
+	out, err := conn.UpdateStateMachine(params)
 
 	log.Printf("[DEBUG] Updating Step Function State Machine: %#v", params)
 
@@ -145,6 +257,10 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if out.StateMachineVersionArn != nil {
+		d.Set("state_machine_version_arn", out.StateMachineVersionArn)
+	}
+
 	return resourceAwsSfnStateMachineRead(d, meta)
 }
 
@@ -164,3 +280,75 @@ func resourceAwsSfnStateMachineDelete(d *schema.ResourceData, meta interface{})
 		return resource.NonRetryableError(err)
 	})
 }
+
+func expandAwsSfnLoggingConfiguration(l []interface{}) *sfn.LoggingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return &sfn.LoggingConfiguration{
+			Level: aws.String(sfn.LogLevelOff),
+		}
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &sfn.LoggingConfiguration{
+		IncludeExecutionData: aws.Bool(m["include_execution_data"].(bool)),
+		Level:                aws.String(m["level"].(string)),
+	}
+
+	if v, ok := m["log_destination"].(string); ok && v != "" {
+		config.Destinations = []*sfn.LogDestination{
+			{
+				CloudWatchLogsLogGroup: &sfn.CloudWatchLogsLogGroup{
+					LogGroupArn: aws.String(v),
+				},
+			},
+		}
+	}
+
+	return config
+}
+
+func flattenAwsSfnLoggingConfiguration(config *sfn.LoggingConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"include_execution_data": aws.BoolValue(config.IncludeExecutionData),
+		"level":                  aws.StringValue(config.Level),
+	}
+
+	for _, destination := range config.Destinations {
+		if destination != nil && destination.CloudWatchLogsLogGroup != nil {
+			m["log_destination"] = aws.StringValue(destination.CloudWatchLogsLogGroup.LogGroupArn)
+			break
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandAwsSfnTracingConfiguration(l []interface{}) *sfn.TracingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return &sfn.TracingConfiguration{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sfn.TracingConfiguration{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+}
+
+func flattenAwsSfnTracingConfiguration(config *sfn.TracingConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(config.Enabled),
+	}
+
+	return []interface{}{m}
+}
@@ -48,6 +48,45 @@ func TestAccAWSSfnStateMachine_createUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSSfnStateMachine_expressLogging(t *testing.T) {
+	resourceName := "aws_sfn_state_machine.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSfnStateMachineConfigExpressLogging(rName, sfn.LogLevelError),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", sfn.StateMachineTypeExpress),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelError),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "true"),
+					resource.TestMatchResourceAttr(resourceName, "logging_configuration.0.log_destination", regexp.MustCompile(`:\*$`)),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+			},
+			{
+				Config: testAccAWSSfnStateMachineConfigExpressLogging(rName, sfn.LogLevelAll),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelAll),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSfnExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -220,3 +259,130 @@ EOF
 
 `, rName, rName, rName, rName, rName, rName, rMaxAttempts)
 }
+
+func testAccAWSSfnStateMachineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "states.${data.aws_region.current.name}.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogDelivery",
+        "logs:GetLogDelivery",
+        "logs:UpdateLogDelivery",
+        "logs:DeleteLogDelivery",
+        "logs:ListLogDeliveries",
+        "logs:PutResourcePolicy",
+        "logs:DescribeResourcePolicies",
+        "logs:DescribeLogGroups",
+        "xray:PutTraceSegments",
+        "xray:PutTelemetryRecords",
+        "xray:GetSamplingRules",
+        "xray:GetSamplingTargets"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSSfnStateMachineConfigExpressLogging(rName, level string) string {
+	return testAccAWSSfnStateMachineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "StartAt": "Pass",
+  "States": {
+    "Pass": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    log_destination        = "${aws_cloudwatch_log_group.test.arn}:*"
+    include_execution_data = true
+    level                  = %[2]q
+  }
+
+  tracing_configuration {
+    enabled = true
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, level)
+}
+
+func testAccAWSSfnStateMachineConfigPublishAlias(rName, description string) string {
+	return testAccAWSSfnStateMachineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                = %[1]q
+  role_arn            = "${aws_iam_role.test.arn}"
+  publish             = true
+  version_description = %[2]q
+
+  definition = <<EOF
+{
+  "StartAt": "Pass",
+  "States": {
+    "Pass": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+
+resource "aws_sfn_alias" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  routing_configuration {
+    state_machine_version_arn = "${aws_sfn_state_machine.test.state_machine_version_arn}"
+    weight                    = 100
+  }
+}
+`, rName, description)
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsShieldProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProactiveEngagementPut,
+		Read:   resourceAwsShieldProactiveEngagementRead,
+		Update: resourceAwsShieldProactiveEngagementPut,
+		Delete: resourceAwsShieldProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProactiveEngagementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	// Proactive engagement can only be enabled once at least one emergency
+	// contact with a phone number is registered, so contacts always go first.
+	if d.IsNewResource() || d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandShieldEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Shield emergency contact settings: %s", input)
+		_, err := conn.UpdateEmergencyContactSettings(input)
+		if err != nil {
+			return fmt.Errorf("error updating Shield emergency contact settings: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("enabled") {
+		var err error
+		if d.Get("enabled").(bool) {
+			log.Printf("[DEBUG] Enabling Shield proactive engagement")
+			_, err = conn.EnableProactiveEngagement(&shield.EnableProactiveEngagementInput{})
+		} else {
+			log.Printf("[DEBUG] Disabling Shield proactive engagement")
+			_, err = conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+		}
+
+		// Enabling or disabling when already in that state is reported as an
+		// invalid operation, which is safe to ignore.
+		if err != nil && !isAWSErr(err, shield.ErrCodeInvalidOperationException, "") {
+			return fmt.Errorf("error updating Shield proactive engagement: %s", err)
+		}
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsShieldProactiveEngagementRead(d, meta)
+}
+
+func resourceAwsShieldProactiveEngagementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	subscription, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Advanced subscription not found, removing proactive engagement (%s) from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Shield subscription: %s", err)
+	}
+
+	contacts, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+	if err != nil {
+		return fmt.Errorf("error reading Shield emergency contact settings: %s", err)
+	}
+
+	d.Set("enabled", aws.StringValue(subscription.Subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+
+	if err := d.Set("emergency_contact", flattenShieldEmergencyContacts(contacts.EmergencyContactList)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsShieldProactiveEngagementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	log.Printf("[DEBUG] Disabling Shield proactive engagement: %s", d.Id())
+	_, err := conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+	if err != nil && !isAWSErr(err, shield.ErrCodeInvalidOperationException, "") {
+		return fmt.Errorf("error disabling Shield proactive engagement: %s", err)
+	}
+
+	_, err = conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+	if err != nil {
+		return fmt.Errorf("error removing Shield emergency contact settings: %s", err)
+	}
+
+	return nil
+}
+
+func expandShieldEmergencyContacts(l []interface{}) []*shield.EmergencyContact {
+	contacts := make([]*shield.EmergencyContact, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		contact := &shield.EmergencyContact{
+			EmailAddress: aws.String(m["email_address"].(string)),
+		}
+
+		if v, ok := m["contact_notes"].(string); ok && v != "" {
+			contact.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := m["phone_number"].(string); ok && v != "" {
+			contact.PhoneNumber = aws.String(v)
+		}
+
+		contacts = append(contacts, contact)
+	}
+
+	return contacts
+}
+
+func flattenShieldEmergencyContacts(contacts []*shield.EmergencyContact) []interface{} {
+	l := make([]interface{}, 0, len(contacts))
+
+	for _, contact := range contacts {
+		l = append(l, map[string]interface{}{
+			"contact_notes": aws.StringValue(contact.ContactNotes),
+			"email_address": aws.StringValue(contact.EmailAddress),
+			"phone_number":  aws.StringValue(contact.PhoneNumber),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProactiveEngagementConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProactiveEngagementStatus(shield.ProactiveEngagementStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "security@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+15555550100"),
+				),
+			},
+			{
+				Config: testAccAwsShieldProactiveEngagementConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProactiveEngagementStatus(shield.ProactiveEngagementStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsShieldProactiveEngagementStatus(status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+		if err != nil {
+			return err
+		}
+
+		if actual := aws.StringValue(output.Subscription.ProactiveEngagementStatus); actual != status {
+			return fmt.Errorf("expected Shield proactive engagement status %s, got %s", status, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsShieldProactiveEngagementDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(output.Subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+		return fmt.Errorf("Shield proactive engagement still enabled")
+	}
+
+	return nil
+}
+
+func testAccAwsShieldProactiveEngagementConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Security team"
+    email_address = "security@example.com"
+    phone_number  = "+15555550100"
+  }
+}
+`, enabled)
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsShieldProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionCreate,
+		Read:   resourceAwsShieldProtectionRead,
+		Update: resourceAwsShieldProtectionUpdate,
+		Delete: resourceAwsShieldProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.CreateProtectionInput{
+		Name:        aws.String(d.Get("name").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapShield(v)
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection: %s", input)
+	output, err := conn.CreateProtection(input)
+	if err != nil {
+		return fmt.Errorf("error creating Shield Protection: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ProtectionId))
+
+	return resourceAwsShieldProtectionRead(d, meta)
+}
+
+func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+		ProtectionId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Protection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection (%s): %s", d.Id(), err)
+	}
+
+	protection := output.Protection
+	arn := aws.StringValue(protection.ProtectionArn)
+
+	d.Set("arn", arn)
+	d.Set("name", protection.Name)
+	d.Set("resource_arn", protection.ResourceArn)
+
+	tags, err := getTagsShield(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Shield Protection (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsShieldProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	if err := setTagsShield(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Shield Protection (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsShieldProtectionRead(d, meta)
+}
+
+func resourceAwsShieldProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	log.Printf("[DEBUG] Deleting Shield Protection: %s", d.Id())
+	_, err := conn.DeleteProtection(&shield.DeleteProtectionInput{
+		ProtectionId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Protection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsShieldProtectionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionGroupCreate,
+		Read:   resourceAwsShieldProtectionGroupRead,
+		Update: resourceAwsShieldProtectionGroupUpdate,
+		Delete: resourceAwsShieldProtectionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(shield.ProtectionGroupAggregation_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(shield.ProtectionGroupPattern_Values(), false),
+			},
+			"protection_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9\-]{1,36}$`),
+					"must contain only alphanumeric characters and hyphens and be at most 36 characters long",
+				),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(shield.ProtectedResourceType_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsShieldProtectionGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionGroupID := d.Get("protection_group_id").(string)
+	input := &shield.CreateProtectionGroupInput{
+		Aggregation:       aws.String(d.Get("aggregation").(string)),
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		ProtectionGroupId: aws.String(protectionGroupID),
+	}
+
+	if v, ok := d.GetOk("members"); ok && v.(*schema.Set).Len() > 0 {
+		input.Members = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapShield(v)
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection Group: %s", input)
+	_, err := conn.CreateProtectionGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating Shield Protection Group (%s): %s", protectionGroupID, err)
+	}
+
+	d.SetId(protectionGroupID)
+
+	return resourceAwsShieldProtectionGroupRead(d, meta)
+}
+
+func resourceAwsShieldProtectionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.DescribeProtectionGroup(&shield.DescribeProtectionGroupInput{
+		ProtectionGroupId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Protection Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection Group (%s): %s", d.Id(), err)
+	}
+
+	group := output.ProtectionGroup
+	arn := aws.StringValue(group.ProtectionGroupArn)
+
+	d.Set("aggregation", group.Aggregation)
+	d.Set("arn", arn)
+	d.Set("pattern", group.Pattern)
+	d.Set("protection_group_id", group.ProtectionGroupId)
+	d.Set("resource_type", group.ResourceType)
+
+	if err := d.Set("members", schema.NewSet(schema.HashString, flattenStringList(group.Members))); err != nil {
+		return fmt.Errorf("error setting members: %s", err)
+	}
+
+	tags, err := getTagsShield(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for Shield Protection Group (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsShieldProtectionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	if d.HasChange("aggregation") || d.HasChange("members") || d.HasChange("pattern") || d.HasChange("resource_type") {
+		input := &shield.UpdateProtectionGroupInput{
+			Aggregation:       aws.String(d.Get("aggregation").(string)),
+			Pattern:           aws.String(d.Get("pattern").(string)),
+			ProtectionGroupId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("members"); ok && v.(*schema.Set).Len() > 0 {
+			input.Members = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_type"); ok {
+			input.ResourceType = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Shield Protection Group: %s", input)
+		_, err := conn.UpdateProtectionGroup(input)
+		if err != nil {
+			return fmt.Errorf("error updating Shield Protection Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsShield(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Shield Protection Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsShieldProtectionGroupRead(d, meta)
+}
+
+func resourceAwsShieldProtectionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	log.Printf("[DEBUG] Deleting Shield Protection Group: %s", d.Id())
+	_, err := conn.DeleteProtectionGroup(&shield.DeleteProtectionGroupInput{
+		ProtectionGroupId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Protection Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
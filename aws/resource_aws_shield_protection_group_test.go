@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldProtectionGroup_basic(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProtectionGroupConfigAll(rName, "MAX"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation", "MAX"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "protection_group_id", rName),
+				),
+			},
+			{
+				Config: testAccAwsShieldProtectionGroupConfigAll(rName, "SUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation", "SUM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtectionGroup_pattern(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProtectionGroupConfigByResourceType(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "BY_RESOURCE_TYPE"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "ELASTIC_IP_ALLOCATION"),
+				),
+			},
+			{
+				Config: testAccAwsShieldProtectionGroupConfigArbitrary(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ARBITRARY"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsShieldProtectionGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		_, err := conn.DescribeProtectionGroup(&shield.DescribeProtectionGroupInput{
+			ProtectionGroupId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsShieldProtectionGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection_group" {
+			continue
+		}
+
+		_, err := conn.DescribeProtectionGroup(&shield.DescribeProtectionGroupInput{
+			ProtectionGroupId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield Protection Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsShieldProtectionGroupConfigAll(rName, aggregation string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = %[2]q
+  pattern             = "ALL"
+}
+`, rName, aggregation)
+}
+
+func testAccAwsShieldProtectionGroupConfigByResourceType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = "MEAN"
+  pattern             = "BY_RESOURCE_TYPE"
+  resource_type       = "ELASTIC_IP_ALLOCATION"
+}
+`, rName)
+}
+
+func testAccAwsShieldProtectionGroupConfigArbitrary(rName string) string {
+	return testAccAwsShieldProtectionConfig(rName) + fmt.Sprintf(`
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = "MEAN"
+  pattern             = "ARBITRARY"
+  members             = ["${aws_shield_protection.test.resource_arn}"]
+}
+`, rName)
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsShieldProtectionHealthCheckAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionHealthCheckAssociationCreate,
+		Read:   resourceAwsShieldProtectionHealthCheckAssociationRead,
+		Delete: resourceAwsShieldProtectionHealthCheckAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"health_check_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"shield_protection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID := d.Get("shield_protection_id").(string)
+	healthCheckArn := d.Get("health_check_arn").(string)
+
+	input := &shield.AssociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckArn),
+		ProtectionId:   aws.String(protectionID),
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection Health Check Association: %s", input)
+	_, err := conn.AssociateHealthCheck(input)
+	if err != nil {
+		return fmt.Errorf("error creating Shield Protection Health Check Association: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", protectionID, healthCheckArn))
+
+	return resourceAwsShieldProtectionHealthCheckAssociationRead(d, meta)
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID, healthCheckArn, err := decodeShieldProtectionHealthCheckAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+		ProtectionId: aws.String(protectionID),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Protection (%s) not found, removing Health Check Association from state", protectionID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection Health Check Association (%s): %s", d.Id(), err)
+	}
+
+	// DescribeProtection only returns health check IDs, whereas associations
+	// are made by ARN, so compare against the ID suffix of the ARN.
+	healthCheckID := healthCheckArn[strings.LastIndex(healthCheckArn, "/")+1:]
+	found := false
+	for _, id := range output.Protection.HealthCheckIds {
+		if aws.StringValue(id) == healthCheckID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("health_check_arn", healthCheckArn)
+	d.Set("shield_protection_id", protectionID)
+
+	return nil
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID, healthCheckArn, err := decodeShieldProtectionHealthCheckAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Shield Protection Health Check Association: %s", d.Id())
+	_, err = conn.DisassociateHealthCheck(&shield.DisassociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckArn),
+		ProtectionId:   aws.String(protectionID),
+	})
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Protection Health Check Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeShieldProtectionHealthCheckAssociationID splits an ID of the form
+// PROTECTION_ID,HEALTH_CHECK_ARN.
+func decodeShieldProtectionHealthCheckAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected PROTECTION_ID,HEALTH_CHECK_ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldProtectionHealthCheckAssociation_basic(t *testing.T) {
+	resourceName := "aws_shield_protection_health_check_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProtectionHealthCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProtectionHealthCheckAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionHealthCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "shield_protection_id", "aws_shield_protection.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsShieldProtectionHealthCheckAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		protectionID, healthCheckArn, err := decodeShieldProtectionHealthCheckAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		output, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(protectionID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range output.Protection.HealthCheckIds {
+			if strings.HasSuffix(healthCheckArn, "/"+aws.StringValue(id)) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Shield Protection Health Check Association (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAwsShieldProtectionHealthCheckAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection_health_check_association" {
+			continue
+		}
+
+		protectionID, healthCheckArn, err := decodeShieldProtectionHealthCheckAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(protectionID),
+		})
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, id := range output.Protection.HealthCheckIds {
+			if strings.HasSuffix(healthCheckArn, "/"+aws.StringValue(id)) {
+				return fmt.Errorf("Shield Protection Health Check Association (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsShieldProtectionHealthCheckAssociationConfig(rName string) string {
+	return testAccAwsShieldProtectionConfig(rName) + fmt.Sprintf(`
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = %[1]q
+  cloudwatch_alarm_region         = "${data.aws_region.current.name}"
+  insufficient_data_health_status = "Healthy"
+}
+
+resource "aws_shield_protection_health_check_association" "test" {
+  shield_protection_id = "${aws_shield_protection.test.id}"
+  health_check_arn     = "arn:${data.aws_partition.current.partition}:route53:::healthcheck/${aws_route53_health_check.test.id}"
+}
+`, rName)
+}
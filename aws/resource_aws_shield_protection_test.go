@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Shield Advanced is a paid subscription with a one year commitment, so the
+// Shield tests can only run in accounts that are already subscribed.
+func testAccPreCheckAWSShield(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	_, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		t.Skip("No Shield Advanced subscription found")
+	}
+
+	if err != nil {
+		t.Fatalf("error describing Shield subscription: %s", err)
+	}
+}
+
+func TestAccAWSShieldProtection_basic(t *testing.T) {
+	resourceName := "aws_shield_protection.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProtectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`:protection/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "resource_arn", regexp.MustCompile(`:eip-allocation/eipalloc-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtection_tags(t *testing.T) {
+	resourceName := "aws_shield_protection.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsShieldProtectionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsShieldProtectionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsShieldProtectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsShieldProtectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		_, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsShieldProtectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection" {
+			continue
+		}
+
+		_, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield Protection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAwsShieldProtectionConfigBase protects an Elastic IP, the cheapest
+// resource type Shield Advanced supports.
+func testAccAwsShieldProtectionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAwsShieldProtectionConfig(rName string) string {
+	return testAccAwsShieldProtectionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+`, rName)
+}
+
+func testAccAwsShieldProtectionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsShieldProtectionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
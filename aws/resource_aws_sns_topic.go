@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -19,6 +20,7 @@ var SNSAttributeMap = map[string]string{
 	"application_success_feedback_role_arn":    "ApplicationSuccessFeedbackRoleArn",
 	"application_success_feedback_sample_rate": "ApplicationSuccessFeedbackSampleRate",
 	"arn":                                 "TopicArn",
+	"content_based_deduplication":         "ContentBasedDeduplication",
 	"delivery_policy":                     "DeliveryPolicy",
 	"display_name":                        "DisplayName",
 	"http_failure_feedback_role_arn":      "HTTPFailureFeedbackRoleArn",
@@ -43,6 +45,8 @@ func resourceAwsSnsTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsSnsTopicCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -74,6 +78,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 			"delivery_policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ForceNew:         false,
 				ValidateFunc:     validateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
@@ -81,6 +86,122 @@ func resourceAwsSnsTopic() *schema.Resource {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
+				Deprecated:    "use the http_delivery_policy configuration block instead",
+				ConflictsWith: []string{"http_delivery_policy"},
+			},
+			"http_delivery_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"delivery_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_subscription_overrides": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"healthy_retry_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_function": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "linear",
+										ValidateFunc: validation.StringInSlice([]string{
+											"arithmetic",
+											"exponential",
+											"geometric",
+											"linear",
+										}, false),
+									},
+									"max_delay_target": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      20,
+										ValidateFunc: validation.IntBetween(1, 3600),
+									},
+									"min_delay_target": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      20,
+										ValidateFunc: validation.IntBetween(1, 3600),
+									},
+									"num_max_delay_retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"num_min_delay_retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"num_no_delay_retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"num_retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+						"request_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"throttle_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_receives_per_second": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"fifo_topic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"content_based_deduplication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"data_protection_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
 			},
 			"application_success_feedback_role_arn": {
 				Type:     schema.TypeString,
@@ -145,13 +266,20 @@ func resourceAwsSnsTopic() *schema.Resource {
 func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	snsconn := meta.(*AWSClient).snsconn
 
+	fifoTopic := d.Get("fifo_topic").(bool)
+
 	var name string
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
 	} else {
-		name = resource.UniqueId()
+		if v, ok := d.GetOk("name_prefix"); ok {
+			name = resource.PrefixedUniqueId(v.(string))
+		} else {
+			name = resource.UniqueId()
+		}
+		if fifoTopic {
+			name += snsTopicFifoSuffix
+		}
 	}
 
 	log.Printf("[DEBUG] SNS create topic: %s", name)
@@ -160,6 +288,12 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 		Name: aws.String(name),
 	}
 
+	if fifoTopic {
+		req.Attributes = map[string]*string{
+			"FifoTopic": aws.String("true"),
+		}
+	}
+
 	output, err := snsconn.CreateTopic(req)
 	if err != nil {
 		return fmt.Errorf("Error creating SNS topic: %s", err)
@@ -183,6 +317,42 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("http_delivery_policy") {
+		if v, ok := d.GetOk("http_delivery_policy"); ok {
+			deliveryPolicy, err := expandSnsTopicHttpDeliveryPolicy(v.([]interface{}))
+			if err != nil {
+				return fmt.Errorf("error building SNS Topic (%s) delivery policy: %s", d.Id(), err)
+			}
+			if err := updateAwsSnsTopicAttribute(d.Id(), "DeliveryPolicy", deliveryPolicy, conn); err != nil {
+				return err
+			}
+		} else if !d.HasChange("delivery_policy") {
+			// The block was removed without switching to delivery_policy.
+			log.Printf("[DEBUG] Removing SNS Topic (%s) delivery policy", d.Id())
+			if err := updateAwsSnsTopicAttribute(d.Id(), "DeliveryPolicy", "", conn); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("data_protection_policy") {
+		input := &sns.PutDataProtectionPolicyInput{
+			ResourceArn: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("data_protection_policy"); ok {
+			input.DataProtectionPolicy = aws.String(v.(string))
+		} else {
+			// SNS has no delete call; putting an empty policy removes it.
+			log.Printf("[DEBUG] Removing SNS Topic (%s) data protection policy", d.Id())
+			input.DataProtectionPolicy = aws.String("")
+		}
+
+		if _, err := conn.PutDataProtectionPolicy(input); err != nil {
+			return fmt.Errorf("error setting SNS Topic (%s) data protection policy: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSnsTopicRead(d, meta)
 }
 
@@ -208,10 +378,46 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 		for terraformAttrName, snsAttrName := range SNSAttributeMap {
 			d.Set(terraformAttrName, attrmap[snsAttrName])
 		}
+		d.Set("fifo_topic", aws.StringValue(attrmap["FifoTopic"]) == "true")
 	} else {
 		for terraformAttrName, _ := range SNSAttributeMap {
 			d.Set(terraformAttrName, "")
 		}
+		d.Set("fifo_topic", false)
+	}
+
+	// http_delivery_policy is only refreshed when it manages the delivery
+	// policy, so that topics using delivery_policy do not show a diff.
+	if len(d.Get("http_delivery_policy").([]interface{})) > 0 {
+		httpDeliveryPolicy, err := flattenSnsTopicHttpDeliveryPolicy(d.Get("delivery_policy").(string))
+		if err != nil {
+			return fmt.Errorf("error reading SNS Topic (%s) delivery policy: %s", d.Id(), err)
+		}
+		if err := d.Set("http_delivery_policy", httpDeliveryPolicy); err != nil {
+			return fmt.Errorf("error setting http_delivery_policy: %s", err)
+		}
+	}
+
+	dataProtectionPolicyOutput, err := snsconn.GetDataProtectionPolicy(&sns.GetDataProtectionPolicyInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		// Some partitions do not support data protection policies yet, and
+		// callers without sns:GetDataProtectionPolicy cannot read them. Only
+		// fail when a policy is actually configured.
+		if d.Get("data_protection_policy").(string) != "" ||
+			(!isAWSErr(err, "InvalidAction", "") && !isAWSErr(err, sns.ErrCodeAuthorizationErrorException, "")) {
+			return fmt.Errorf("error reading SNS Topic (%s) data protection policy: %s", d.Id(), err)
+		}
+		log.Printf("[WARN] Unable to read SNS Topic (%s) data protection policy: %s", d.Id(), err)
+	} else if v := aws.StringValue(dataProtectionPolicyOutput.DataProtectionPolicy); v != "" {
+		policy, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return fmt.Errorf("SNS Topic (%s) data protection policy contains an invalid JSON: %s", d.Id(), err)
+		}
+		d.Set("data_protection_policy", policy)
+	} else {
+		d.Set("data_protection_policy", "")
 	}
 
 	// If we have no name set (import) then determine it from the ARN.
@@ -241,6 +447,152 @@ func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsSnsTopicCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	fifoTopic := diff.Get("fifo_topic").(bool)
+
+	if name := diff.Get("name").(string); name != "" {
+		if fifoTopic && !strings.HasSuffix(name, snsTopicFifoSuffix) {
+			return fmt.Errorf("FIFO SNS topic name must end with %q: %s", snsTopicFifoSuffix, name)
+		}
+		if !fifoTopic && strings.HasSuffix(name, snsTopicFifoSuffix) {
+			return fmt.Errorf("standard SNS topic name must not end with %q, set fifo_topic to create a FIFO topic: %s", snsTopicFifoSuffix, name)
+		}
+	}
+
+	if !fifoTopic && diff.Get("content_based_deduplication").(bool) {
+		return fmt.Errorf("content_based_deduplication can only be set for FIFO SNS topics")
+	}
+
+	return nil
+}
+
+const snsTopicFifoSuffix = ".fifo"
+
+type snsTopicDeliveryPolicy struct {
+	Http *snsTopicHttpDeliveryPolicy `json:"http,omitempty"`
+}
+
+type snsTopicHttpDeliveryPolicy struct {
+	DefaultHealthyRetryPolicy    *snsTopicHealthyRetryPolicy `json:"defaultHealthyRetryPolicy,omitempty"`
+	DefaultRequestPolicy         *snsTopicRequestPolicy      `json:"defaultRequestPolicy,omitempty"`
+	DefaultThrottlePolicy        *snsTopicThrottlePolicy     `json:"defaultThrottlePolicy,omitempty"`
+	DisableSubscriptionOverrides bool                        `json:"disableSubscriptionOverrides"`
+}
+
+type snsTopicHealthyRetryPolicy struct {
+	BackoffFunction    string `json:"backoffFunction,omitempty"`
+	MaxDelayTarget     int    `json:"maxDelayTarget"`
+	MinDelayTarget     int    `json:"minDelayTarget"`
+	NumMaxDelayRetries int    `json:"numMaxDelayRetries"`
+	NumMinDelayRetries int    `json:"numMinDelayRetries"`
+	NumNoDelayRetries  int    `json:"numNoDelayRetries"`
+	NumRetries         int    `json:"numRetries"`
+}
+
+type snsTopicRequestPolicy struct {
+	HeaderContentType string `json:"headerContentType,omitempty"`
+}
+
+type snsTopicThrottlePolicy struct {
+	MaxReceivesPerSecond int `json:"maxReceivesPerSecond"`
+}
+
+func expandSnsTopicHttpDeliveryPolicy(l []interface{}) (string, error) {
+	if len(l) == 0 || l[0] == nil {
+		return "", nil
+	}
+
+	m := l[0].(map[string]interface{})
+	httpPolicy := &snsTopicHttpDeliveryPolicy{
+		DisableSubscriptionOverrides: m["disable_subscription_overrides"].(bool),
+	}
+
+	if v, ok := m["healthy_retry_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rp := v[0].(map[string]interface{})
+		httpPolicy.DefaultHealthyRetryPolicy = &snsTopicHealthyRetryPolicy{
+			BackoffFunction:    rp["backoff_function"].(string),
+			MaxDelayTarget:     rp["max_delay_target"].(int),
+			MinDelayTarget:     rp["min_delay_target"].(int),
+			NumMaxDelayRetries: rp["num_max_delay_retries"].(int),
+			NumMinDelayRetries: rp["num_min_delay_retries"].(int),
+			NumNoDelayRetries:  rp["num_no_delay_retries"].(int),
+			NumRetries:         rp["num_retries"].(int),
+		}
+	}
+
+	if v, ok := m["request_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rp := v[0].(map[string]interface{})
+		httpPolicy.DefaultRequestPolicy = &snsTopicRequestPolicy{
+			HeaderContentType: rp["header_content_type"].(string),
+		}
+	}
+
+	if v, ok := m["throttle_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tp := v[0].(map[string]interface{})
+		httpPolicy.DefaultThrottlePolicy = &snsTopicThrottlePolicy{
+			MaxReceivesPerSecond: tp["max_receives_per_second"].(int),
+		}
+	}
+
+	b, err := json.Marshal(&snsTopicDeliveryPolicy{Http: httpPolicy})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenSnsTopicHttpDeliveryPolicy(deliveryPolicy string) ([]interface{}, error) {
+	if deliveryPolicy == "" {
+		return []interface{}{}, nil
+	}
+
+	policy := &snsTopicDeliveryPolicy{}
+	if err := json.Unmarshal([]byte(deliveryPolicy), policy); err != nil {
+		return nil, err
+	}
+
+	if policy.Http == nil {
+		return []interface{}{}, nil
+	}
+
+	m := map[string]interface{}{
+		"disable_subscription_overrides": policy.Http.DisableSubscriptionOverrides,
+	}
+
+	if rp := policy.Http.DefaultHealthyRetryPolicy; rp != nil {
+		m["healthy_retry_policy"] = []interface{}{
+			map[string]interface{}{
+				"backoff_function":      rp.BackoffFunction,
+				"max_delay_target":      rp.MaxDelayTarget,
+				"min_delay_target":      rp.MinDelayTarget,
+				"num_max_delay_retries": rp.NumMaxDelayRetries,
+				"num_min_delay_retries": rp.NumMinDelayRetries,
+				"num_no_delay_retries":  rp.NumNoDelayRetries,
+				"num_retries":           rp.NumRetries,
+			},
+		}
+	}
+
+	if rp := policy.Http.DefaultRequestPolicy; rp != nil {
+		m["request_policy"] = []interface{}{
+			map[string]interface{}{
+				"header_content_type": rp.HeaderContentType,
+			},
+		}
+	}
+
+	if tp := policy.Http.DefaultThrottlePolicy; tp != nil {
+		m["throttle_policy"] = []interface{}{
+			map[string]interface{}{
+				"max_receives_per_second": tp.MaxReceivesPerSecond,
+			},
+		}
+	}
+
+	return []interface{}{m}, nil
+}
+
 func updateAwsSnsTopicAttribute(topicArn, name string, value interface{}, conn *sns.SNS) error {
 	// Ignore an empty policy
 	if name == "Policy" && value == "" {
@@ -205,6 +205,131 @@ func TestAccAWSSNSTopic_deliveryStatus(t *testing.T) {
 	})
 }
 
+func TestAccAWSSNSTopic_fifoTopic(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test_topic"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfig_fifoTopic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+".fifo"),
+					resource.TestCheckResourceAttr(resourceName, "fifo_topic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_based_deduplication", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSNSTopicConfig_fifoTopic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "content_based_deduplication", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopic_fifoTopicInvalidName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSNSTopicConfig_fifoTopicInvalidName(rName),
+				ExpectError: regexp.MustCompile(`FIFO SNS topic name must end with ".fifo"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopic_httpDeliveryPolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test_topic"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfig_httpDeliveryPolicy(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.disable_subscription_overrides", "false"),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.healthy_retry_policy.0.num_retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.healthy_retry_policy.0.backoff_function", "exponential"),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.throttle_policy.0.max_receives_per_second", "5"),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.request_policy.0.header_content_type", "application/json"),
+				),
+			},
+			{
+				Config: testAccAWSSNSTopicConfig_httpDeliveryPolicy(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.0.healthy_retry_policy.0.num_retries", "10"),
+				),
+			},
+			{
+				Config: testAccAWSSNSTopicConfig_rName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "http_delivery_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopic_dataProtectionPolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test_topic"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfig_dataProtectionPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestMatchResourceAttr(resourceName, "data_protection_policy", regexp.MustCompile(`EmailAddress`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSNSTopicConfig_rName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "data_protection_policy", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSNSTopicHasPolicy(n string, expectedPolicyText string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -571,3 +696,84 @@ EOF
 }
 `, r, r, r)
 }
+
+func testAccAWSSNSTopicConfig_fifoTopic(rName string, contentBasedDeduplication bool) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name                        = "%[1]s.fifo"
+  fifo_topic                  = true
+  content_based_deduplication = %[2]t
+}
+`, rName, contentBasedDeduplication)
+}
+
+func testAccAWSSNSTopicConfig_fifoTopicInvalidName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name       = %[1]q
+  fifo_topic = true
+}
+`, rName)
+}
+
+func testAccAWSSNSTopicConfig_rName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSNSTopicConfig_httpDeliveryPolicy(rName string, numRetries int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name = %[1]q
+
+  http_delivery_policy {
+    healthy_retry_policy {
+      min_delay_target = 20
+      max_delay_target = 20
+      num_retries      = %[2]d
+      backoff_function = "exponential"
+    }
+
+    throttle_policy {
+      max_receives_per_second = 5
+    }
+
+    request_policy {
+      header_content_type = "application/json"
+    }
+  }
+}
+`, rName, numRetries)
+}
+
+func testAccAWSSNSTopicConfig_dataProtectionPolicy(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test_topic" {
+  name = %[1]q
+
+  data_protection_policy = <<EOF
+{
+  "Name": "data_protection_policy",
+  "Description": "Example data protection policy",
+  "Version": "2021-06-01",
+  "Statement": [
+    {
+      "DataDirection": "Inbound",
+      "Principal": ["*"],
+      "DataIdentifier": ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {
+        "Deny": {}
+      },
+      "Sid": "DenyInboundEmailAdressess"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
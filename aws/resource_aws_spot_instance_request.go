@@ -36,6 +36,22 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Spot Instances cannot be launched onto Dedicated Hosts or
+			// into a specific placement group partition
+			delete(s, "host_id")
+			delete(s, "host_resource_group_arn")
+			delete(s, "placement_partition_number")
+
+			// These instance options are not supported by the Spot Instance
+			// launch specification
+			delete(s, "cpu_core_count")
+			delete(s, "cpu_threads_per_core")
+			delete(s, "credit_specification")
+			delete(s, "hibernation")
+
+			// Spot Instances are never stopped to apply changes
+			delete(s, "allow_stop_for_update")
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 var sqsQueueAttributeMap = map[string]string{
@@ -26,11 +27,22 @@ var sqsQueueAttributeMap = map[string]string{
 	"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
 	"policy":                            sqs.QueueAttributeNamePolicy,
 	"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
+	"redrive_allow_policy":              sqs.QueueAttributeNameRedriveAllowPolicy,
 	"arn":                               sqs.QueueAttributeNameQueueArn,
 	"fifo_queue":                        sqs.QueueAttributeNameFifoQueue,
 	"content_based_deduplication":       sqs.QueueAttributeNameContentBasedDeduplication,
 	"kms_master_key_id":                 sqs.QueueAttributeNameKmsMasterKeyId,
 	"kms_data_key_reuse_period_seconds": sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds,
+	"sqs_managed_sse_enabled":           sqs.QueueAttributeNameSqsManagedSseEnabled,
+	"deduplication_scope":               sqs.QueueAttributeNameDeduplicationScope,
+	"fifo_throughput_limit":             sqs.QueueAttributeNameFifoThroughputLimit,
+}
+
+// Queue attributes holding JSON documents, which are normalized when read so
+// that key ordering returned by SQS does not produce spurious diffs.
+var sqsQueueJsonAttributes = map[string]bool{
+	"redrive_policy":       true,
+	"redrive_allow_policy": true,
 }
 
 // A number of these are marked as computed because if you don't
@@ -93,9 +105,20 @@ func resourceAwsSqsQueue() *schema.Resource {
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"redrive_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateJsonString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"redrive_allow_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
@@ -116,15 +139,40 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			"kms_master_key_id": {
+			"deduplication_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"messageGroup",
+					"queue",
+				}, false),
+			},
+			"fifo_throughput_limit": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"perMessageGroupId",
+					"perQueue",
+				}, false),
+			},
+			"kms_master_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"sqs_managed_sse_enabled"},
 			},
 			"kms_data_key_reuse_period_seconds": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Optional: true,
 			},
+			"sqs_managed_sse_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"kms_master_key_id"},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -159,6 +207,14 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Content based deduplication can only be set with FIFO queues")
 	}
 
+	if !fq {
+		for _, k := range []string{"deduplication_scope", "fifo_throughput_limit"} {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("%s can only be set with FIFO queues", k)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] SQS queue create: %s", name)
 
 	req := &sqs.CreateQueueInput{
@@ -300,7 +356,14 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 					log.Printf("[DEBUG] Reading %s => %s -> %t", iKey, oKey, value)
 				default:
 					log.Printf("[DEBUG] Reading %s => %s -> %s", iKey, oKey, *attrmap[oKey])
-					d.Set(iKey, *attrmap[oKey])
+					value := *attrmap[oKey]
+					if sqsQueueJsonAttributes[iKey] && value != "" {
+						value, err = structure.NormalizeJsonString(value)
+						if err != nil {
+							return fmt.Errorf("SQS queue attribute %s contains an invalid JSON: %s", oKey, err)
+						}
+					}
+					d.Set(iKey, value)
 				}
 			}
 		}
@@ -468,6 +468,109 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 	})
 }
 
+func TestAccAWSSQSQueue_SqsManagedEncryption(t *testing.T) {
+	resourceName := "aws_sqs_queue.queue"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithSqsManagedSse(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sqs_managed_sse_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSSQSConfigWithSqsManagedSse(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sqs_managed_sse_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_FIFOHighThroughput(t *testing.T) {
+	resourceName := "aws_sqs_queue.queue"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithFIFOThroughput(rName, "messageGroup", "perMessageGroupId"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deduplication_scope", "messageGroup"),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_limit", "perMessageGroupId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSQSConfigWithFIFOThroughput(rName, "queue", "perQueue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deduplication_scope", "queue"),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_limit", "perQueue"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_ExpectFIFOThroughputError(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSQSConfigWithStandardThroughput(rName),
+				ExpectError: regexp.MustCompile(`fifo_throughput_limit can only be set with FIFO queues`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_redriveAllowPolicy(t *testing.T) {
+	resourceName := "aws_sqs_queue.dlq"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithRedriveAllowPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+					resource.TestCheckResourceAttrSet("aws_sqs_queue.source", "redrive_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSSQSConfigWithDefaults(r string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "queue" {
@@ -650,3 +753,64 @@ resource "aws_sqs_queue" "queue" {
 }
 `, r)
 }
+
+func testAccAWSSQSConfigWithSqsManagedSse(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                    = %[1]q
+  sqs_managed_sse_enabled = %[2]t
+}
+`, rName, enabled)
+}
+
+func testAccAWSSQSConfigWithFIFOThroughput(rName, deduplicationScope, fifoThroughputLimit string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                  = "%[1]s.fifo"
+  fifo_queue            = true
+  deduplication_scope   = %[2]q
+  fifo_throughput_limit = %[3]q
+}
+`, rName, deduplicationScope, fifoThroughputLimit)
+}
+
+func testAccAWSSQSConfigWithStandardThroughput(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                  = %[1]q
+  fifo_throughput_limit = "perQueue"
+}
+`, rName)
+}
+
+func testAccAWSSQSConfigWithRedriveAllowPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "source" {
+  name = "%[1]s-source"
+
+  redrive_policy = <<EOF
+{
+  "maxReceiveCount": 3,
+  "deadLetterTargetArn": "${aws_sqs_queue.dlq.arn}"
+}
+EOF
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+
+  redrive_allow_policy = <<EOF
+{
+  "sourceQueueArns": ["arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-source"],
+  "redrivePermission": "byQueue"
+}
+EOF
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
@@ -3,7 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strconv"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,10 +16,6 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
-const (
-	MINIMUM_VERSIONED_SCHEMA = 2.0
-)
-
 func resourceAwsSsmDocument() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSsmDocumentCreate,
@@ -27,6 +23,8 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
 
+		CustomizeDiff: resourceAwsSsmDocumentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -37,9 +35,40 @@ func resourceAwsSsmDocument() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateAwsSSMName,
 			},
+			"attachments_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ssm.AttachmentsSourceKeyAttachmentReference,
+								ssm.AttachmentsSourceKeyS3fileUrl,
+								ssm.AttachmentsSourceKeySourceUrl,
+							}, false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]{3,128}$`), "must be 3-128 characters matching [a-zA-Z0-9_-.]"),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"content": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentSsmDocumentContentDiffs,
 			},
 			"document_format": {
 				Type:     schema.TypeString,
@@ -57,6 +86,7 @@ func resourceAwsSsmDocument() *schema.Resource {
 					ssm.DocumentTypeCommand,
 					ssm.DocumentTypePolicy,
 					ssm.DocumentTypeAutomation,
+					ssm.DocumentTypePackage,
 				}, false),
 			},
 			"schema_version": {
@@ -124,6 +154,11 @@ func resourceAwsSsmDocument() *schema.Resource {
 					},
 				},
 			},
+			"version_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]{1,128}$`), "must be 1-128 characters matching [a-zA-Z0-9_-.]"),
+			},
 			"permissions": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -156,6 +191,14 @@ func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) erro
 		DocumentType:   aws.String(d.Get("document_type").(string)),
 	}
 
+	if v, ok := d.GetOk("attachments_source"); ok {
+		docInput.Attachments = expandAwsSsmDocumentAttachmentsSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		docInput.VersionName = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Waiting for SSM Document %q to be created", d.Get("name").(string))
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, err := ssmconn.CreateDocument(docInput)
@@ -225,6 +268,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("status", doc.Status)
+	d.Set("version_name", doc.VersionName)
 
 	gp, err := getDocumentPermissions(d, meta)
 
@@ -282,17 +326,14 @@ func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Not setting document permissions on %q", d.Id())
 	}
 
-	if !d.HasChange("content") {
-		return nil
+	if !d.HasChange("content") && !d.HasChange("attachments_source") && !d.HasChange("version_name") {
+		return resourceAwsSsmDocumentRead(d, meta)
 	}
 
-	if schemaVersion, ok := d.GetOk("schemaVersion"); ok {
-		schemaNumber, _ := strconv.ParseFloat(schemaVersion.(string), 64)
-
-		if schemaNumber < MINIMUM_VERSIONED_SCHEMA {
-			log.Printf("[DEBUG] Skipping document update because document version is not 2.0 %q", d.Id())
-			return nil
-		}
+	// Changes to these documents are planned as a replacement, see
+	// resourceAwsSsmDocumentCustomizeDiff.
+	if schemaVersion := d.Get("schema_version").(string); isSsmDocumentSchemaVersion1(schemaVersion) {
+		return fmt.Errorf("SSM Document (%s) with schema version %s cannot be updated and must be replaced", d.Id(), schemaVersion)
 	}
 
 	if err := updateAwsSSMDocument(d, meta); err != nil {
@@ -302,6 +343,30 @@ func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) erro
 	return resourceAwsSsmDocumentRead(d, meta)
 }
 
+// resourceAwsSsmDocumentCustomizeDiff replaces documents with a 1.x schema
+// when their content changes, as SSM only versions later schemas.
+func resourceAwsSsmDocumentCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !isSsmDocumentSchemaVersion1(diff.Get("schema_version").(string)) {
+		return nil
+	}
+
+	for _, k := range []string{"attachments_source", "content", "version_name"} {
+		// Changes suppressed as equivalent are not part of the diff.
+		if len(diff.GetChangedKeysPrefix(k)) == 0 {
+			continue
+		}
+		if err := diff.ForceNew(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isSsmDocumentSchemaVersion1(schemaVersion string) bool {
+	return strings.HasPrefix(schemaVersion, "1.")
+}
+
 func resourceAwsSsmDocumentDelete(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -447,11 +512,21 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 
+	// Always update against $LATEST so that changes made outside of Terraform
+	// since the last default version bump do not cause a version conflict.
 	updateDocInput := &ssm.UpdateDocumentInput{
 		Name:            aws.String(name),
 		Content:         aws.String(d.Get("content").(string)),
 		DocumentFormat:  aws.String(d.Get("document_format").(string)),
-		DocumentVersion: aws.String(d.Get("default_version").(string)),
+		DocumentVersion: aws.String("$LATEST"),
+	}
+
+	if v, ok := d.GetOk("attachments_source"); ok {
+		updateDocInput.Attachments = expandAwsSsmDocumentAttachmentsSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		updateDocInput.VersionName = aws.String(v.(string))
 	}
 
 	newDefaultVersion := d.Get("default_version").(string)
@@ -483,3 +558,38 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+func expandAwsSsmDocumentAttachmentsSources(l []interface{}) []*ssm.AttachmentsSource {
+	sources := make([]*ssm.AttachmentsSource, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		source := &ssm.AttachmentsSource{
+			Key:    aws.String(m["key"].(string)),
+			Values: expandStringList(m["values"].([]interface{})),
+		}
+
+		if v, ok := m["name"].(string); ok && v != "" {
+			source.Name = aws.String(v)
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources
+}
+
+// suppressEquivalentSsmDocumentContentDiffs ignores whitespace and key
+// ordering differences in document content, using the parser matching the
+// configured document_format.
+func suppressEquivalentSsmDocumentContentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("document_format").(string) == ssm.DocumentFormatYaml {
+		return suppressEquivalentYamlDiffs(k, old, new, d)
+	}
+
+	return suppressEquivalentJsonDiffs(k, old, new, d)
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -63,6 +64,33 @@ func TestAccAWSSSMDocument_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMDocument_updateSchemaVersion1(t *testing.T) {
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocumentSchemaVersion1Config(name, "ifconfig"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "schema_version", "1.2"),
+				),
+			},
+			{
+				// Documents with a 1.x schema are replaced.
+				Config: testAccAWSSSMDocumentSchemaVersion1Config(name, "ip addr"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestMatchResourceAttr("aws_ssm_document.foo", "content", regexp.MustCompile(`ip addr`)),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "latest_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMDocument_permission(t *testing.T) {
 	name := acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -181,6 +209,96 @@ mainSteps:
 	})
 }
 
+func TestAccAWSSSMDocument_contentNormalization(t *testing.T) {
+	name := acctest.RandString(10)
+	content1 := `
+---
+schemaVersion: '2.2'
+description: Sample document
+mainSteps:
+- action: aws:runShellScript
+  name: runShellScript
+  inputs:
+    runCommand:
+      - hostname
+`
+	content2 := `
+description: Sample document
+schemaVersion: '2.2'
+mainSteps:
+  -   name: runShellScript
+      action: aws:runShellScript
+      inputs:
+        runCommand: [hostname]
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocumentConfig_DocumentFormat_YAML(name, content1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "latest_version", "1"),
+				),
+			},
+			{
+				Config:   testAccAWSSSMDocumentConfig_DocumentFormat_YAML(name, content2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMDocument_versionName(t *testing.T) {
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocumentConfigVersionName(name, "release-1", "hostname"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "version_name", "release-1"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "default_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSSSMDocumentConfigVersionName(name, "release-2", "uptime"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "version_name", "release-2"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "latest_version", "2"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "default_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMDocument_attachmentsSource(t *testing.T) {
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocumentConfigAttachmentsSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "document_type", "Package"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "attachments_source.#", "1"),
+					resource.TestCheckResourceAttr("aws_ssm_document.foo", "attachments_source.0.key", "S3FileUrl"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSSMDocumentExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -269,6 +387,35 @@ DOC
 `, rName)
 }
 
+func testAccAWSSSMDocumentSchemaVersion1Config(rName, runCommand string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "foo" {
+  name          = "test_document-%[1]s"
+  document_type = "Command"
+
+  content = <<DOC
+    {
+      "schemaVersion": "1.2",
+      "description": "Check ip configuration of a Linux instance.",
+      "parameters": {
+
+      },
+      "runtimeConfig": {
+        "aws:runShellScript": {
+          "properties": [
+            {
+              "id": "0.aws:runShellScript",
+              "runCommand": [%[2]q]
+            }
+          ]
+        }
+      }
+    }
+DOC
+}
+`, rName, runCommand)
+}
+
 func testAccAWSSSMDocument20Config(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "foo" {
@@ -515,3 +662,75 @@ DOC
 }
 `, rName, content)
 }
+
+func testAccAWSSSMDocumentConfigVersionName(rName, versionName, command string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "foo" {
+  document_format = "YAML"
+  document_type   = "Command"
+  name            = "test_document-%[1]s"
+  version_name    = %[2]q
+
+  content = <<DOC
+schemaVersion: '2.2'
+description: Sample document
+mainSteps:
+- action: aws:runShellScript
+  name: runShellScript
+  inputs:
+    runCommand:
+      - %[3]s
+DOC
+}
+`, rName, versionName, command)
+}
+
+func testAccAWSSSMDocumentConfigAttachmentsSource(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]s"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket       = "${aws_s3_bucket.object_bucket.bucket}"
+  key          = "test.zip"
+  content      = "%[1]s"
+  content_type = "binary/octet-stream"
+}
+
+resource "aws_ssm_document" "foo" {
+  name          = "test_document-%[1]s"
+  document_type = "Package"
+
+  attachments_source {
+    key    = "S3FileUrl"
+    values = ["s3://${aws_s3_bucket.object_bucket.bucket}/test.zip"]
+  }
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "version": "0.1.0",
+  "packages": {
+    "amazon": {
+      "_any": {
+        "x86_64": {
+          "file": "test.zip"
+        }
+      }
+    }
+  },
+  "files": {
+    "test.zip": {
+      "checksums": {
+        "sha256": "${sha256("%[1]s")}"
+      }
+    }
+  }
+}
+DOC
+
+  depends_on = ["aws_s3_bucket_object.object"]
+}
+`, rName)
+}
@@ -1,11 +1,13 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSsmMaintenanceWindow() *schema.Resource {
@@ -14,6 +16,9 @@ func resourceAwsSsmMaintenanceWindow() *schema.Resource {
 		Read:   resourceAwsSsmMaintenanceWindowRead,
 		Update: resourceAwsSsmMaintenanceWindowUpdate,
 		Delete: resourceAwsSsmMaintenanceWindowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -27,13 +32,15 @@ func resourceAwsSsmMaintenanceWindow() *schema.Resource {
 			},
 
 			"duration": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 24),
 			},
 
 			"cutoff": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
 			},
 
 			"allow_unassociated_targets": {
@@ -47,6 +54,37 @@ func resourceAwsSsmMaintenanceWindow() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"schedule_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+
+			"schedule_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -55,54 +93,102 @@ func resourceAwsSsmMaintenanceWindowCreate(d *schema.ResourceData, meta interfac
 	ssmconn := meta.(*AWSClient).ssmconn
 
 	params := &ssm.CreateMaintenanceWindowInput{
-		Name:     aws.String(d.Get("name").(string)),
-		Schedule: aws.String(d.Get("schedule").(string)),
-		Duration: aws.Int64(int64(d.Get("duration").(int))),
-		Cutoff:   aws.Int64(int64(d.Get("cutoff").(int))),
+		Name:                     aws.String(d.Get("name").(string)),
+		Schedule:                 aws.String(d.Get("schedule").(string)),
+		Duration:                 aws.Int64(int64(d.Get("duration").(int))),
+		Cutoff:                   aws.Int64(int64(d.Get("cutoff").(int))),
 		AllowUnassociatedTargets: aws.Bool(d.Get("allow_unassociated_targets").(bool)),
 	}
 
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		params.EndDate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		params.ScheduleOffset = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule_timezone"); ok {
+		params.ScheduleTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		params.StartDate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		params.Tags = tagsFromMapSSM(v.(map[string]interface{}))
+	}
+
 	resp, err := ssmconn.CreateMaintenanceWindow(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error creating SSM Maintenance Window: %s", err)
 	}
 
 	d.SetId(*resp.WindowId)
-	return resourceAwsSsmMaintenanceWindowUpdate(d, meta)
+
+	if !d.Get("enabled").(bool) {
+		_, err := ssmconn.UpdateMaintenanceWindow(&ssm.UpdateMaintenanceWindowInput{
+			WindowId: aws.String(d.Id()),
+			Enabled:  aws.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("error disabling SSM Maintenance Window (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSsmMaintenanceWindowRead(d, meta)
 }
 
 func resourceAwsSsmMaintenanceWindowUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
+	// Replace must be set, otherwise optional arguments removed from the
+	// configuration would be left untouched on the window.
 	params := &ssm.UpdateMaintenanceWindowInput{
-		WindowId: aws.String(d.Id()),
+		AllowUnassociatedTargets: aws.Bool(d.Get("allow_unassociated_targets").(bool)),
+		Cutoff:                   aws.Int64(int64(d.Get("cutoff").(int))),
+		Duration:                 aws.Int64(int64(d.Get("duration").(int))),
+		Enabled:                  aws.Bool(d.Get("enabled").(bool)),
+		Name:                     aws.String(d.Get("name").(string)),
+		Replace:                  aws.Bool(true),
+		Schedule:                 aws.String(d.Get("schedule").(string)),
+		WindowId:                 aws.String(d.Id()),
 	}
 
-	if d.HasChange("name") {
-		params.Name = aws.String(d.Get("name").(string))
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
 	}
 
-	if d.HasChange("schedule") {
-		params.Schedule = aws.String(d.Get("schedule").(string))
+	if v, ok := d.GetOk("end_date"); ok {
+		params.EndDate = aws.String(v.(string))
 	}
 
-	if d.HasChange("duration") {
-		params.Duration = aws.Int64(int64(d.Get("duration").(int)))
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		params.ScheduleOffset = aws.Int64(int64(v.(int)))
 	}
 
-	if d.HasChange("cutoff") {
-		params.Cutoff = aws.Int64(int64(d.Get("cutoff").(int)))
+	if v, ok := d.GetOk("schedule_timezone"); ok {
+		params.ScheduleTimezone = aws.String(v.(string))
 	}
 
-	if d.HasChange("allow_unassociated_targets") {
-		params.AllowUnassociatedTargets = aws.Bool(d.Get("allow_unassociated_targets").(bool))
+	if v, ok := d.GetOk("start_date"); ok {
+		params.StartDate = aws.String(v.(string))
 	}
 
-	params.Enabled = aws.Bool(d.Get("enabled").(bool))
-
 	_, err := ssmconn.UpdateMaintenanceWindow(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error updating SSM Maintenance Window (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingMaintenanceWindow); err != nil {
+			return fmt.Errorf("error updating SSM Maintenance Window (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsSsmMaintenanceWindowRead(d, meta)
@@ -116,8 +202,13 @@ func resourceAwsSsmMaintenanceWindowRead(d *schema.ResourceData, meta interface{
 	}
 
 	resp, err := ssmconn.GetMaintenanceWindow(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		log.Printf("[WARN] SSM Maintenance Window (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading SSM Maintenance Window (%s): %s", d.Id(), err)
 	}
 
 	d.Set("name", resp.Name)
@@ -126,6 +217,23 @@ func resourceAwsSsmMaintenanceWindowRead(d *schema.ResourceData, meta interface{
 	d.Set("enabled", resp.Enabled)
 	d.Set("allow_unassociated_targets", resp.AllowUnassociatedTargets)
 	d.Set("schedule", resp.Schedule)
+	d.Set("description", resp.Description)
+	d.Set("end_date", resp.EndDate)
+	d.Set("schedule_offset", resp.ScheduleOffset)
+	d.Set("schedule_timezone", resp.ScheduleTimezone)
+	d.Set("start_date", resp.StartDate)
+
+	tagList, err := ssmconn.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(d.Id()),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingMaintenanceWindow),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSM Maintenance Window (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapSSM(tagList.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -140,8 +248,11 @@ func resourceAwsSsmMaintenanceWindowDelete(d *schema.ResourceData, meta interfac
 	}
 
 	_, err := ssmconn.DeleteMaintenanceWindow(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error deleting SSM Maintenance Window (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -3,17 +3,24 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSsmMaintenanceWindowTargetCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTargetRead,
+		Update: resourceAwsSsmMaintenanceWindowTargetUpdate,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -26,12 +33,15 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssm.MaintenanceWindowResourceTypeInstance,
+					ssm.MaintenanceWindowResourceTypeResourceGroup,
+				}, false),
 			},
 
 			"targets": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -49,9 +59,21 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 			},
 
 			"owner_information": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]{3,128}$`), "must be 3-128 characters matching [a-zA-Z0-9_-.]"),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 		},
 	}
@@ -72,9 +94,17 @@ func resourceAwsSsmMaintenanceWindowTargetCreate(d *schema.ResourceData, meta in
 		params.OwnerInformation = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
 	resp, err := ssmconn.RegisterTargetWithMaintenanceWindow(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error registering SSM Maintenance Window Target: %s", err)
 	}
 
 	d.SetId(*resp.WindowTargetId)
@@ -96,8 +126,13 @@ func resourceAwsSsmMaintenanceWindowTargetRead(d *schema.ResourceData, meta inte
 	}
 
 	resp, err := ssmconn.DescribeMaintenanceWindowTargets(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		log.Printf("[WARN] SSM Maintenance Window (%s) not found, removing Target (%s) from state", d.Get("window_id").(string), d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading SSM Maintenance Window Target (%s): %s", d.Id(), err)
 	}
 
 	found := false
@@ -108,6 +143,8 @@ func resourceAwsSsmMaintenanceWindowTargetRead(d *schema.ResourceData, meta inte
 			d.Set("owner_information", t.OwnerInformation)
 			d.Set("window_id", t.WindowId)
 			d.Set("resource_type", t.ResourceType)
+			d.Set("name", t.Name)
+			d.Set("description", t.Description)
 
 			if err := d.Set("targets", flattenAwsSsmTargets(t.Targets)); err != nil {
 				return fmt.Errorf("[DEBUG] Error setting targets error: %#v", err)
@@ -124,6 +161,37 @@ func resourceAwsSsmMaintenanceWindowTargetRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsSsmMaintenanceWindowTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	ssmconn := meta.(*AWSClient).ssmconn
+
+	params := &ssm.UpdateMaintenanceWindowTargetInput{
+		Replace:        aws.Bool(true),
+		Targets:        expandAwsSsmTargets(d),
+		WindowId:       aws.String(d.Get("window_id").(string)),
+		WindowTargetId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("owner_information"); ok {
+		params.OwnerInformation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating SSM Maintenance Window Target: %s", params)
+	_, err := ssmconn.UpdateMaintenanceWindowTarget(params)
+	if err != nil {
+		return fmt.Errorf("error updating SSM Maintenance Window Target (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSsmMaintenanceWindowTargetRead(d, meta)
+}
+
 func resourceAwsSsmMaintenanceWindowTargetDelete(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -135,9 +203,24 @@ func resourceAwsSsmMaintenanceWindowTargetDelete(d *schema.ResourceData, meta in
 	}
 
 	_, err := ssmconn.DeregisterTargetFromMaintenanceWindow(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error deregistering SSM Maintenance Window Target (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceAwsSsmMaintenanceWindowTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected WINDOW_ID/WINDOW_TARGET_ID", d.Id())
+	}
+
+	d.Set("window_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -31,6 +31,53 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMMaintenanceWindowTarget_update(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_maintenance_window_target.target"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowTargetConfigNameDescription(name, "First description", "owner1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "target-"+name),
+					resource.TestCheckResourceAttr(resourceName, "description", "First description"),
+					resource.TestCheckResourceAttr(resourceName, "owner_information", "owner1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowTargetConfigNameDescription(name, "Second description", "owner2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second description"),
+					resource.TestCheckResourceAttr(resourceName, "owner_information", "owner2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckAWSSSMMaintenanceWindowTargetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -126,3 +173,27 @@ resource "aws_ssm_maintenance_window_target" "target" {
 }
 `, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowTargetConfigNameDescription(rName, description, ownerInformation string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "foo" {
+  name     = "maintenance-window-%[1]s"
+  schedule = "cron(0 16 ? * TUE *)"
+  duration = 3
+  cutoff   = 1
+}
+
+resource "aws_ssm_maintenance_window_target" "target" {
+  window_id         = "${aws_ssm_maintenance_window.foo.id}"
+  name              = "target-%[1]s"
+  description       = %[2]q
+  owner_information = %[3]q
+  resource_type     = "INSTANCE"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptance_test"]
+  }
+}
+`, rName, description, ownerInformation)
+}
@@ -3,17 +3,25 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSsmMaintenanceWindowTaskCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
+		Update: resourceAwsSsmMaintenanceWindowTaskUpdate,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTaskImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -25,48 +33,61 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 			"max_concurrency": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"max_errors": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"task_type": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssm.MaintenanceWindowTaskTypeAutomation,
+					ssm.MaintenanceWindowTaskTypeLambda,
+					ssm.MaintenanceWindowTaskTypeRunCommand,
+					ssm.MaintenanceWindowTaskTypeStepFunctions,
+				}, false),
 			},
 
 			"task_arn": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"service_role_arn": {
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]{3,128}$`), "must be 3-128 characters matching [a-zA-Z0-9_-.]"),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 
 			"targets": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
+				MaxItems: 5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"values": {
 							Type:     schema.TypeList,
 							Required: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
@@ -76,14 +97,12 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 			"priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"logging_info": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"s3_bucket_name": {
@@ -103,25 +122,154 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 			},
 
 			"task_parameters": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"task_invocation_parameters"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"values": {
 							Type:     schema.TypeList,
 							Required: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
 			},
+
+			"task_invocation_parameters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"task_parameters"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automation_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)$`), "must be $LATEST, $DEFAULT or a version number"),
+									},
+									"parameter": ssmMaintenanceWindowTaskInvocationParameterSchema(),
+								},
+							},
+						},
+						"run_command_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 100),
+									},
+									"document_hash": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"document_hash_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ssm.DocumentHashTypeSha256,
+											ssm.DocumentHashTypeSha1,
+										}, false),
+									},
+									"notification_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"notification_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateArn,
+												},
+												"notification_events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+														ValidateFunc: validation.StringInSlice([]string{
+															ssm.NotificationEventAll,
+															ssm.NotificationEventInProgress,
+															ssm.NotificationEventSuccess,
+															ssm.NotificationEventTimedOut,
+															ssm.NotificationEventCancelled,
+															ssm.NotificationEventFailed,
+														}, false),
+													},
+												},
+												"notification_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														ssm.NotificationTypeCommand,
+														ssm.NotificationTypeInvocation,
+													}, false),
+												},
+											},
+										},
+									},
+									"output_s3_bucket": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"output_s3_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 500),
+									},
+									"parameter": ssmMaintenanceWindowTaskInvocationParameterSchema(),
+									"service_role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(30, 2592000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ssmMaintenanceWindowTaskInvocationParameterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
 		},
 	}
 }
@@ -179,6 +327,175 @@ func flattenAwsSsmTaskParameters(taskParameters map[string]*ssm.MaintenanceWindo
 	return result
 }
 
+func expandAwsSsmTaskInvocationParameters(config []interface{}) *ssm.MaintenanceWindowTaskInvocationParameters {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	m := config[0].(map[string]interface{})
+	params := &ssm.MaintenanceWindowTaskInvocationParameters{}
+
+	if v, ok := m["automation_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		params.Automation = expandAwsSsmTaskInvocationAutomationParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["run_command_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		params.RunCommand = expandAwsSsmTaskInvocationRunCommandParameters(v[0].(map[string]interface{}))
+	}
+
+	return params
+}
+
+func expandAwsSsmTaskInvocationAutomationParameters(m map[string]interface{}) *ssm.MaintenanceWindowAutomationParameters {
+	params := &ssm.MaintenanceWindowAutomationParameters{}
+
+	if v, ok := m["document_version"].(string); ok && v != "" {
+		params.DocumentVersion = aws.String(v)
+	}
+
+	if v, ok := m["parameter"].([]interface{}); ok && len(v) > 0 {
+		params.Parameters = expandAwsSsmTaskInvocationCommonParameters(v)
+	}
+
+	return params
+}
+
+func expandAwsSsmTaskInvocationRunCommandParameters(m map[string]interface{}) *ssm.MaintenanceWindowRunCommandParameters {
+	params := &ssm.MaintenanceWindowRunCommandParameters{}
+
+	if v, ok := m["comment"].(string); ok && v != "" {
+		params.Comment = aws.String(v)
+	}
+
+	if v, ok := m["document_hash"].(string); ok && v != "" {
+		params.DocumentHash = aws.String(v)
+	}
+
+	if v, ok := m["document_hash_type"].(string); ok && v != "" {
+		params.DocumentHashType = aws.String(v)
+	}
+
+	if v, ok := m["notification_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		params.NotificationConfig = expandAwsSsmTaskInvocationRunCommandNotificationConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["output_s3_bucket"].(string); ok && v != "" {
+		params.OutputS3BucketName = aws.String(v)
+	}
+
+	if v, ok := m["output_s3_key_prefix"].(string); ok && v != "" {
+		params.OutputS3KeyPrefix = aws.String(v)
+	}
+
+	if v, ok := m["parameter"].([]interface{}); ok && len(v) > 0 {
+		params.Parameters = expandAwsSsmTaskInvocationCommonParameters(v)
+	}
+
+	if v, ok := m["service_role_arn"].(string); ok && v != "" {
+		params.ServiceRoleArn = aws.String(v)
+	}
+
+	if v, ok := m["timeout_seconds"].(int); ok && v != 0 {
+		params.TimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	return params
+}
+
+func expandAwsSsmTaskInvocationRunCommandNotificationConfig(m map[string]interface{}) *ssm.NotificationConfig {
+	config := &ssm.NotificationConfig{}
+
+	if v, ok := m["notification_arn"].(string); ok && v != "" {
+		config.NotificationArn = aws.String(v)
+	}
+
+	if v, ok := m["notification_events"].([]interface{}); ok && len(v) > 0 {
+		config.NotificationEvents = expandStringList(v)
+	}
+
+	if v, ok := m["notification_type"].(string); ok && v != "" {
+		config.NotificationType = aws.String(v)
+	}
+
+	return config
+}
+
+func expandAwsSsmTaskInvocationCommonParameters(config []interface{}) map[string][]*string {
+	params := make(map[string][]*string)
+
+	for _, raw := range config {
+		m := raw.(map[string]interface{})
+		params[m["name"].(string)] = expandStringList(m["values"].([]interface{}))
+	}
+
+	return params
+}
+
+func flattenAwsSsmTaskInvocationParameters(params *ssm.MaintenanceWindowTaskInvocationParameters) []interface{} {
+	if params == nil || (params.Automation == nil && params.RunCommand == nil) {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if params.Automation != nil {
+		m["automation_parameters"] = []interface{}{
+			map[string]interface{}{
+				"document_version": aws.StringValue(params.Automation.DocumentVersion),
+				"parameter":        flattenAwsSsmTaskInvocationCommonParameters(params.Automation.Parameters),
+			},
+		}
+	}
+
+	if params.RunCommand != nil {
+		rc := params.RunCommand
+		runCommand := map[string]interface{}{
+			"comment":              aws.StringValue(rc.Comment),
+			"document_hash":        aws.StringValue(rc.DocumentHash),
+			"document_hash_type":   aws.StringValue(rc.DocumentHashType),
+			"output_s3_bucket":     aws.StringValue(rc.OutputS3BucketName),
+			"output_s3_key_prefix": aws.StringValue(rc.OutputS3KeyPrefix),
+			"parameter":            flattenAwsSsmTaskInvocationCommonParameters(rc.Parameters),
+			"service_role_arn":     aws.StringValue(rc.ServiceRoleArn),
+			"timeout_seconds":      int(aws.Int64Value(rc.TimeoutSeconds)),
+		}
+
+		if nc := rc.NotificationConfig; nc != nil {
+			runCommand["notification_config"] = []interface{}{
+				map[string]interface{}{
+					"notification_arn":    aws.StringValue(nc.NotificationArn),
+					"notification_events": flattenStringList(nc.NotificationEvents),
+					"notification_type":   aws.StringValue(nc.NotificationType),
+				},
+			}
+		}
+
+		m["run_command_parameters"] = []interface{}{runCommand}
+	}
+
+	return []interface{}{m}
+}
+
+// flattenAwsSsmTaskInvocationCommonParameters sorts the parameters by name so
+// that the resulting list is stable between reads.
+func flattenAwsSsmTaskInvocationCommonParameters(params map[string][]*string) []interface{} {
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	result := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		result = append(result, map[string]interface{}{
+			"name":   name,
+			"values": flattenStringList(params[name]),
+		})
+	}
+
+	return result
+}
+
 func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -189,11 +506,22 @@ func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta inte
 		MaxConcurrency: aws.String(d.Get("max_concurrency").(string)),
 		MaxErrors:      aws.String(d.Get("max_errors").(string)),
 		TaskType:       aws.String(d.Get("task_type").(string)),
-		ServiceRoleArn: aws.String(d.Get("service_role_arn").(string)),
 		TaskArn:        aws.String(d.Get("task_arn").(string)),
 		Targets:        expandAwsSsmTargets(d),
 	}
 
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		params.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("priority"); ok {
 		params.Priority = aws.Int64(int64(v.(int)))
 	}
@@ -206,9 +534,13 @@ func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta inte
 		params.TaskParameters = expandAwsSsmTaskParameters(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("task_invocation_parameters"); ok {
+		params.TaskInvocationParameters = expandAwsSsmTaskInvocationParameters(v.([]interface{}))
+	}
+
 	resp, err := ssmconn.RegisterTaskWithMaintenanceWindow(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error registering SSM Maintenance Window Task: %s", err)
 	}
 
 	d.SetId(*resp.WindowTaskId)
@@ -218,56 +550,108 @@ func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta inte
 
 func resourceAwsSsmMaintenanceWindowTaskRead(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
+	windowID := d.Get("window_id").(string)
 
-	params := &ssm.DescribeMaintenanceWindowTasksInput{
-		WindowId: aws.String(d.Get("window_id").(string)),
+	params := &ssm.GetMaintenanceWindowTaskInput{
+		WindowId:     aws.String(windowID),
+		WindowTaskId: aws.String(d.Id()),
 	}
 
-	resp, err := ssmconn.DescribeMaintenanceWindowTasks(params)
+	t, err := ssmconn.GetMaintenanceWindowTask(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		log.Printf("[WARN] SSM Maintenance Window (%s) Task (%s) not found, removing from state", windowID, d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
-	}
-
-	found := false
-	for _, t := range resp.Tasks {
-		if *t.WindowTaskId == d.Id() {
-			found = true
-
-			d.Set("window_id", t.WindowId)
-			d.Set("max_concurrency", t.MaxConcurrency)
-			d.Set("max_errors", t.MaxErrors)
-			d.Set("task_type", t.Type)
-			d.Set("service_role_arn", t.ServiceRoleArn)
-			d.Set("task_arn", t.TaskArn)
-			d.Set("priority", t.Priority)
-
-			if t.LoggingInfo != nil {
-				if err := d.Set("logging_info", flattenAwsSsmMaintenanceWindowLoggingInfo(t.LoggingInfo)); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting logging_info error: %#v", err)
-				}
-			}
+		return fmt.Errorf("error reading SSM Maintenance Window (%s) Task (%s): %s", windowID, d.Id(), err)
+	}
 
-			if t.TaskParameters != nil {
-				if err := d.Set("task_parameters", flattenAwsSsmTaskParameters(t.TaskParameters)); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting task_parameters error: %#v", err)
-				}
-			}
+	d.Set("window_id", t.WindowId)
+	d.Set("max_concurrency", t.MaxConcurrency)
+	d.Set("max_errors", t.MaxErrors)
+	d.Set("task_type", t.TaskType)
+	d.Set("service_role_arn", t.ServiceRoleArn)
+	d.Set("task_arn", t.TaskArn)
+	d.Set("priority", t.Priority)
+	d.Set("name", t.Name)
+	d.Set("description", t.Description)
+
+	if t.LoggingInfo != nil {
+		if err := d.Set("logging_info", flattenAwsSsmMaintenanceWindowLoggingInfo(t.LoggingInfo)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting logging_info error: %#v", err)
+		}
+	}
 
-			if err := d.Set("targets", flattenAwsSsmTargets(t.Targets)); err != nil {
-				return fmt.Errorf("[DEBUG] Error setting targets error: %#v", err)
-			}
+	if t.TaskParameters != nil {
+		if err := d.Set("task_parameters", flattenAwsSsmTaskParameters(t.TaskParameters)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting task_parameters error: %#v", err)
 		}
 	}
 
-	if !found {
-		log.Printf("[INFO] Maintenance Window Target not found. Removing from state")
-		d.SetId("")
-		return nil
+	if err := d.Set("task_invocation_parameters", flattenAwsSsmTaskInvocationParameters(t.TaskInvocationParameters)); err != nil {
+		return fmt.Errorf("error setting task_invocation_parameters: %s", err)
+	}
+
+	if err := d.Set("targets", flattenAwsSsmTargets(t.Targets)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting targets error: %#v", err)
 	}
 
 	return nil
 }
 
+func resourceAwsSsmMaintenanceWindowTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	ssmconn := meta.(*AWSClient).ssmconn
+
+	// Replace requires every optional argument to be sent again, otherwise
+	// it is cleared on the task.
+	params := &ssm.UpdateMaintenanceWindowTaskInput{
+		MaxConcurrency: aws.String(d.Get("max_concurrency").(string)),
+		MaxErrors:      aws.String(d.Get("max_errors").(string)),
+		Replace:        aws.Bool(true),
+		Targets:        expandAwsSsmTargets(d),
+		TaskArn:        aws.String(d.Get("task_arn").(string)),
+		WindowId:       aws.String(d.Get("window_id").(string)),
+		WindowTaskId:   aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		params.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		params.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("logging_info"); ok {
+		params.LoggingInfo = expandAwsSsmMaintenanceWindowLoggingInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("task_parameters"); ok {
+		params.TaskParameters = expandAwsSsmTaskParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("task_invocation_parameters"); ok {
+		params.TaskInvocationParameters = expandAwsSsmTaskInvocationParameters(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating SSM Maintenance Window Task: %s", d.Id())
+	_, err := ssmconn.UpdateMaintenanceWindowTask(params)
+	if err != nil {
+		return fmt.Errorf("error updating SSM Maintenance Window Task (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSsmMaintenanceWindowTaskRead(d, meta)
+}
+
 func resourceAwsSsmMaintenanceWindowTaskDelete(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -279,9 +663,24 @@ func resourceAwsSsmMaintenanceWindowTaskDelete(d *schema.ResourceData, meta inte
 	}
 
 	_, err := ssmconn.DeregisterTaskFromMaintenanceWindow(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error deregistering SSM Maintenance Window Task (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceAwsSsmMaintenanceWindowTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected WINDOW_ID/WINDOW_TASK_ID", d.Id())
+	}
+
+	d.Set("window_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
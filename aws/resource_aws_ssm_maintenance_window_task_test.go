@@ -27,11 +27,17 @@ func TestAccAWSSSMMaintenanceWindowTask_basic(t *testing.T) {
 					testAccCheckAWSSSMMaintenanceWindowTaskExists("aws_ssm_maintenance_window_task.target", &task),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window_task.target",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc("aws_ssm_maintenance_window_task.target"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccAWSSSMMaintenanceWindowTask_updateForcesNewResource(t *testing.T) {
+func TestAccAWSSSMMaintenanceWindowTask_update(t *testing.T) {
 	var before, after ssm.MaintenanceWindowTask
 	name := acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -49,23 +55,111 @@ func TestAccAWSSSMMaintenanceWindowTask_updateForcesNewResource(t *testing.T) {
 				Config: testAccAWSSSMMaintenanceWindowTaskBasicConfigUpdated(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSSSMMaintenanceWindowTaskExists("aws_ssm_maintenance_window_task.target", &after),
-					testAccCheckAwsSsmWindowsTaskRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr("aws_ssm_maintenance_window_task.target", "task_parameters.0.values.0", "date"),
+					testAccCheckAwsSsmWindowsTaskNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMMaintenanceWindowTask_taskInvocationRunCommandParameters(t *testing.T) {
+	var task ssm.MaintenanceWindowTask
+	resourceName := "aws_ssm_maintenance_window_task.target"
+
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskRunCommandConfig(name, "test comment", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "name", "task-"+name),
+					resource.TestCheckResourceAttr(resourceName, "description", "Maintenance window task"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.comment", "test comment"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.timeout_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.document_hash_type", ssm.DocumentHashTypeSha256),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.parameter.0.name", "commands"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.parameter.0.values.0", "date"),
+				),
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskRunCommandConfig(name, "test comment update", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.comment", "test comment update"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.run_command_parameters.0.timeout_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMMaintenanceWindowTask_taskInvocationAutomationParameters(t *testing.T) {
+	var task ssm.MaintenanceWindowTask
+	resourceName := "aws_ssm_maintenance_window_task.target"
+
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskAutomationConfig(name, "$DEFAULT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "task_type", ssm.MaintenanceWindowTaskTypeAutomation),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.automation_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.automation_parameters.0.document_version", "$DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.automation_parameters.0.parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.automation_parameters.0.parameter.0.name", "InstanceId"),
+				),
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskAutomationConfig(name, "$LATEST"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "task_invocation_parameters.0.automation_parameters.0.document_version", "$LATEST"),
 				),
 			},
 		},
 	})
 }
 
-func testAccCheckAwsSsmWindowsTaskRecreated(t *testing.T,
+func testAccCheckAwsSsmWindowsTaskNotRecreated(t *testing.T,
 	before, after *ssm.MaintenanceWindowTask) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if before.WindowTaskId == after.WindowTaskId {
-			t.Fatalf("Expected change of Windows Task IDs, but both were %v", before.WindowTaskId)
+		if aws.StringValue(before.WindowTaskId) != aws.StringValue(after.WindowTaskId) {
+			t.Fatalf("Unexpected change of Windows Task IDs from %v to %v", aws.StringValue(before.WindowTaskId), aws.StringValue(after.WindowTaskId))
 		}
 		return nil
 	}
 }
 
+func testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckAWSSSMMaintenanceWindowTaskExists(n string, task *ssm.MaintenanceWindowTask) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -101,7 +195,7 @@ func testAccCheckAWSSSMMaintenanceWindowTaskDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ssmconn
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_ssm_maintenance_window_target" {
+		if rs.Type != "aws_ssm_maintenance_window_task" {
 			continue
 		}
 
@@ -270,3 +364,121 @@ EOF
 
 `, rName, rName, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowTaskConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "foo" {
+  name     = "maintenance-window-%[1]s"
+  schedule = "cron(0 16 ? * TUE *)"
+  duration = 3
+  cutoff   = 1
+}
+
+resource "aws_instance" "foo" {
+  ami           = "ami-4fccb37f"
+  instance_type = "m1.small"
+}
+
+resource "aws_iam_role" "ssm_role" {
+  name = "ssm-role-%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": ["events.amazonaws.com", "ssm.amazonaws.com"]
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "bar" {
+  name = "ssm_role_policy_%[1]s"
+  role = "${aws_iam_role.ssm_role.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "ssm:*",
+    "Resource": "*"
+  }
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAWSSSMMaintenanceWindowTaskRunCommandConfig(rName, comment string, timeoutSeconds int) string {
+	return testAccAWSSSMMaintenanceWindowTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ssm_maintenance_window_task" "target" {
+  window_id        = "${aws_ssm_maintenance_window.foo.id}"
+  name             = "task-%[1]s"
+  description      = "Maintenance window task"
+  task_type        = "RUN_COMMAND"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = "${aws_iam_role.ssm_role.arn}"
+  max_concurrency  = "2"
+  max_errors       = "1"
+
+  targets {
+    key    = "InstanceIds"
+    values = ["${aws_instance.foo.id}"]
+  }
+
+  task_invocation_parameters {
+    run_command_parameters {
+      comment            = %[2]q
+      document_hash      = "${sha256("COMMAND")}"
+      document_hash_type = "Sha256"
+      service_role_arn   = "${aws_iam_role.ssm_role.arn}"
+      timeout_seconds    = %[3]d
+
+      parameter {
+        name   = "commands"
+        values = ["date"]
+      }
+    }
+  }
+}
+`, rName, comment, timeoutSeconds)
+}
+
+func testAccAWSSSMMaintenanceWindowTaskAutomationConfig(rName, documentVersion string) string {
+	return testAccAWSSSMMaintenanceWindowTaskConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ssm_maintenance_window_task" "target" {
+  window_id        = "${aws_ssm_maintenance_window.foo.id}"
+  task_type        = "AUTOMATION"
+  task_arn         = "AWS-RestartEC2Instance"
+  priority         = 1
+  service_role_arn = "${aws_iam_role.ssm_role.arn}"
+  max_concurrency  = "2"
+  max_errors       = "1"
+
+  targets {
+    key    = "InstanceIds"
+    values = ["${aws_instance.foo.id}"]
+  }
+
+  task_invocation_parameters {
+    automation_parameters {
+      document_version = %[1]q
+
+      parameter {
+        name   = "InstanceId"
+        values = ["${aws_instance.foo.id}"]
+      }
+    }
+  }
+}
+`, documentVersion)
+}
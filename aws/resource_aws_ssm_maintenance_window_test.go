@@ -54,6 +54,72 @@ func TestAccAWSSSMMaintenanceWindow_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMMaintenanceWindow_scheduleOptions(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_maintenance_window.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowConfigScheduleOptions(name, "America/New_York", 2, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Patching window"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "2"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2030-01-01T00:00:00Z"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowConfigScheduleOptions(name, "Etc/UTC", 5, "2031-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_timezone", "Etc/UTC"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "5"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2031-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMMaintenanceWindow_tags(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_maintenance_window.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowConfigTags(name, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowConfigTags(name, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSSMMaintenanceWindowExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -145,3 +211,33 @@ resource "aws_ssm_maintenance_window" "foo" {
 
 `, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowConfigScheduleOptions(rName, timezone string, offset int, endDate string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "foo" {
+  name              = "maintenance-window-%[1]s"
+  description       = "Patching window"
+  schedule          = "cron(0 16 ? * TUE#3 *)"
+  schedule_timezone = %[2]q
+  schedule_offset   = %[3]d
+  end_date          = %[4]q
+  duration          = 3
+  cutoff            = 1
+}
+`, rName, timezone, offset, endDate)
+}
+
+func testAccAWSSSMMaintenanceWindowConfigTags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "foo" {
+  name     = "maintenance-window-%[1]s"
+  schedule = "cron(0 16 ? * TUE *)"
+  duration = 3
+  cutoff   = 1
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
@@ -23,6 +24,8 @@ func resourceAwsSsmParameter() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsSsmParameterCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -43,9 +46,35 @@ func resourceAwsSsmParameter() *schema.Resource {
 				}, false),
 			},
 			"value": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"write_only_value"},
+			},
+			// Only a hash of the value is kept in state; the value itself is
+			// never read back from SSM.
+			"write_only_value": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				StateFunc:     hashSum,
+				ConflictsWith: []string{"value"},
+			},
+			"tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ssm.ParameterTierStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssm.ParameterTierStandard,
+					ssm.ParameterTierAdvanced,
+					ssm.ParameterTierIntelligentTiering,
+				}, false),
+			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -105,7 +134,12 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	param := resp.Parameters[0]
 	d.Set("name", param.Name)
 	d.Set("type", param.Type)
-	d.Set("value", param.Value)
+
+	if _, ok := d.GetOk("write_only_value"); ok {
+		d.Set("value", "")
+	} else {
+		d.Set("value", param.Value)
+	}
 
 	describeParamsInput := &ssm.DescribeParametersInput{
 		Filters: []*ssm.ParametersFilter{
@@ -135,6 +169,18 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("description", detail.Description)
 	d.Set("allowed_pattern", detail.AllowedPattern)
 
+	// SSM reports the tier it picked for Intelligent-Tiering parameters, so
+	// the configured value is kept to show changes to or from it.
+	if d.Get("tier").(string) != ssm.ParameterTierIntelligentTiering {
+		d.Set("tier", detail.Tier)
+	}
+
+	policies, err := flattenSsmParameterPolicies(detail.Policies)
+	if err != nil {
+		return fmt.Errorf("error reading SSM parameter policies: %s", err)
+	}
+	d.Set("policies", policies)
+
 	if tagList, err := ssmconn.ListTagsForResource(&ssm.ListTagsForResourceInput{
 		ResourceId:   aws.String(d.Get("name").(string)),
 		ResourceType: aws.String("Parameter"),
@@ -177,12 +223,46 @@ func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Creating SSM Parameter: %s", d.Get("name").(string))
 
+	value := d.Get("value").(string)
+	if v, ok := d.GetOk("write_only_value"); ok {
+		if d.Get("type").(string) != ssm.ParameterTypeSecureString {
+			return fmt.Errorf("write_only_value can only be used with %s parameters", ssm.ParameterTypeSecureString)
+		}
+
+		// An unchanged write_only_value only holds the hash kept in state, so
+		// the current value is read back instead; PutParameter requires one.
+		if d.IsNewResource() || d.HasChange("write_only_value") {
+			value = v.(string)
+		} else {
+			resp, err := ssmconn.GetParameter(&ssm.GetParameterInput{
+				Name:           aws.String(d.Id()),
+				WithDecryption: aws.Bool(true),
+			})
+			if err != nil {
+				return fmt.Errorf("error reading SSM parameter (%s) value: %s", d.Id(), err)
+			}
+			value = aws.StringValue(resp.Parameter.Value)
+		}
+	}
+
+	if value == "" {
+		return fmt.Errorf("one of value or write_only_value must be set")
+	}
+
 	paramInput := &ssm.PutParameterInput{
 		Name:           aws.String(d.Get("name").(string)),
 		Type:           aws.String(d.Get("type").(string)),
-		Value:          aws.String(d.Get("value").(string)),
+		Value:          aws.String(value),
 		Overwrite:      aws.Bool(shouldUpdateSsmParameter(d)),
 		AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
+		Tier:           aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("policies"); ok {
+		paramInput.Policies = aws.String(v.(string))
+	} else if !d.IsNewResource() && d.HasChange("policies") {
+		// An empty list removes any existing policies.
+		paramInput.Policies = aws.String("[]")
 	}
 
 	if d.HasChange("description") {
@@ -219,3 +299,33 @@ func shouldUpdateSsmParameter(d *schema.ResourceData) bool {
 	// if it is not a new resource, otherwise overwrite should be set to false.
 	return !d.IsNewResource()
 }
+
+func resourceAwsSsmParameterCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// Advanced parameters cannot be reverted to the Standard tier.
+	if diff.Id() != "" && diff.HasChange("tier") {
+		o, n := diff.GetChange("tier")
+		if o.(string) == ssm.ParameterTierAdvanced && n.(string) == ssm.ParameterTierStandard {
+			return diff.ForceNew("tier")
+		}
+	}
+
+	return nil
+}
+
+// flattenSsmParameterPolicies returns the parameter policies as the JSON
+// array accepted by PutParameter.
+func flattenSsmParameterPolicies(policies []*ssm.ParameterInlinePolicy) (string, error) {
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	texts := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		texts = append(texts, aws.StringValue(policy.PolicyText))
+	}
+
+	return structure.NormalizeJsonString(fmt.Sprintf("[%s]", strings.Join(texts, ",")))
+}
@@ -230,6 +230,184 @@ func TestAccAWSSSMParameter_secure_keyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMParameter_tier(t *testing.T) {
+	var param ssm.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	resourceName := "aws_ssm_parameter.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+				),
+			},
+			{
+				// Downgrading recreates the parameter
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+				),
+			},
+			{
+				// Changing to Intelligent-Tiering is applied, not suppressed.
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierIntelligentTiering),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierIntelligentTiering),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameter_tierIntelligentTiering(t *testing.T) {
+	var param ssm.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	resourceName := "aws_ssm_parameter.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierIntelligentTiering),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierIntelligentTiering),
+				),
+			},
+			{
+				Config:   testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierIntelligentTiering),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameter_policies(t *testing.T) {
+	var param ssm.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	resourceName := "aws_ssm_parameter.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParameterConfigPolicies(name, 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"Type":"NoChangeNotification"`)),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigPolicies(name, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"After":"30"`)),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigTier(name, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameter_writeOnlyValue(t *testing.T) {
+	var param ssm.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	resourceName := "aws_ssm_parameter.secret_foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParameterConfigWriteOnlyValue(name, "secret1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					testAccCheckAWSSSMParameterValue(&param, "secret1"),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "write_only_value", hashSum("secret1")),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigWriteOnlyValue(name, "secret2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					testAccCheckAWSSSMParameterValue(&param, "secret2"),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "write_only_value", hashSum("secret2")),
+				),
+			},
+			{
+				Config: testAccAWSSSMParameterConfigWriteOnlyValueDescription(name, "secret2", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParameterExists(resourceName, &param),
+					testAccCheckAWSSSMParameterValue(&param, "secret2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "write_only_value", hashSum("secret2")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameter_writeOnlyValueRequiresSecureString(t *testing.T) {
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSSMParameterConfigWriteOnlyValueString(name),
+				ExpectError: regexp.MustCompile(`write_only_value can only be used with SecureString parameters`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSMParameterValue(param *ssm.Parameter, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(param.Value) != value {
+			return fmt.Errorf("SSM Param %s value does not match expected value", aws.StringValue(param.Name))
+		}
+		return nil
+	}
+}
+
 func testAccCheckAWSSSMParameterRecreated(t *testing.T,
 	before, after *ssm.Parameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -385,6 +563,72 @@ resource "aws_kms_alias" "test_alias" {
 `, rName, value, keyAlias)
 }
 
+func testAccAWSSSMParameterConfigTier(rName, tier string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "foo" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "bar"
+}
+`, rName, tier)
+}
+
+func testAccAWSSSMParameterConfigPolicies(rName string, after int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "foo" {
+  name  = %[1]q
+  tier  = "Advanced"
+  type  = "String"
+  value = "bar"
+
+  policies = <<POLICIES
+[
+  {
+    "Type": "NoChangeNotification",
+    "Version": "1.0",
+    "Attributes": {
+      "After": "%[2]d",
+      "Unit": "Days"
+    }
+  }
+]
+POLICIES
+}
+`, rName, after)
+}
+
+func testAccAWSSSMParameterConfigWriteOnlyValue(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "secret_foo" {
+  name             = %[1]q
+  type             = "SecureString"
+  write_only_value = %[2]q
+}
+`, rName, value)
+}
+
+func testAccAWSSSMParameterConfigWriteOnlyValueDescription(rName, value, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "secret_foo" {
+  name             = %[1]q
+  description      = %[3]q
+  type             = "SecureString"
+  write_only_value = %[2]q
+}
+`, rName, value, description)
+}
+
+func testAccAWSSSMParameterConfigWriteOnlyValueString(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "foo" {
+  name             = %[1]q
+  type             = "String"
+  write_only_value = "bar"
+}
+`, rName)
+}
+
 func TestAWSSSMParameterShouldUpdate(t *testing.T) {
 	data := resourceAwsSsmParameter().TestResourceData()
 	failure := false
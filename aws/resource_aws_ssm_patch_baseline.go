@@ -3,8 +3,10 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -22,6 +24,17 @@ var ssmPatchComplianceLevels = []string{
 var ssmPatchOSs = []string{
 	ssm.OperatingSystemWindows,
 	ssm.OperatingSystemAmazonLinux,
+	ssm.OperatingSystemAmazonLinux2,
+	ssm.OperatingSystemAmazonLinux2022,
+	ssm.OperatingSystemAmazonLinux2023,
+	ssm.OperatingSystemAlmaLinux,
+	ssm.OperatingSystemCentos,
+	ssm.OperatingSystemDebian,
+	ssm.OperatingSystemMacos,
+	ssm.OperatingSystemOracleLinux,
+	ssm.OperatingSystemRaspbian,
+	ssm.OperatingSystemRockyLinux,
+	ssm.OperatingSystemSuse,
 	ssm.OperatingSystemUbuntu,
 	ssm.OperatingSystemRedhatEnterpriseLinux,
 }
@@ -32,8 +45,16 @@ func resourceAwsSsmPatchBaseline() *schema.Resource {
 		Read:   resourceAwsSsmPatchBaselineRead,
 		Update: resourceAwsSsmPatchBaselineUpdate,
 		Delete: resourceAwsSsmPatchBaselineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -69,8 +90,21 @@ func resourceAwsSsmPatchBaseline() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"approve_after_days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 360),
+						},
+
+						"approve_until_date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`), "must be a date in YYYY-MM-DD format"),
+						},
+
+						"enable_non_security": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
 						},
 
 						"compliance_level": {
@@ -130,6 +164,50 @@ func resourceAwsSsmPatchBaseline() *schema.Resource {
 				Default:      ssm.PatchComplianceLevelUnspecified,
 				ValidateFunc: validation.StringInSlice(ssmPatchComplianceLevels, false),
 			},
+
+			"approved_patches_enable_non_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"rejected_patches_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ssm.PatchActionAllowAsDependency,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssm.PatchActionAllowAsDependency,
+					ssm.PatchActionBlock,
+				}, false),
+			},
+
+			"source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]{3,50}$`), "must be 3-50 characters matching [a-zA-Z0-9_-.]"),
+						},
+						"products": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -138,9 +216,19 @@ func resourceAwsSsmPatchBaselineCreate(d *schema.ResourceData, meta interface{})
 	ssmconn := meta.(*AWSClient).ssmconn
 
 	params := &ssm.CreatePatchBaselineInput{
-		Name: aws.String(d.Get("name").(string)),
-		ApprovedPatchesComplianceLevel: aws.String(d.Get("approved_patches_compliance_level").(string)),
-		OperatingSystem:                aws.String(d.Get("operating_system").(string)),
+		Name:                             aws.String(d.Get("name").(string)),
+		ApprovedPatchesComplianceLevel:   aws.String(d.Get("approved_patches_compliance_level").(string)),
+		ApprovedPatchesEnableNonSecurity: aws.Bool(d.Get("approved_patches_enable_non_security").(bool)),
+		OperatingSystem:                  aws.String(d.Get("operating_system").(string)),
+		RejectedPatchesAction:            aws.String(d.Get("rejected_patches_action").(string)),
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		params.Sources = expandAwsSsmPatchSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		params.Tags = tagsFromMapSSM(v.(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -165,7 +253,7 @@ func resourceAwsSsmPatchBaselineCreate(d *schema.ResourceData, meta interface{})
 
 	resp, err := ssmconn.CreatePatchBaseline(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error creating SSM Patch Baseline: %s", err)
 	}
 
 	d.SetId(*resp.BaselineId)
@@ -207,9 +295,25 @@ func resourceAwsSsmPatchBaselineUpdate(d *schema.ResourceData, meta interface{})
 		params.GlobalFilters = expandAwsSsmPatchFilterGroup(d)
 	}
 
+	if d.HasChange("approved_patches_enable_non_security") {
+		params.ApprovedPatchesEnableNonSecurity = aws.Bool(d.Get("approved_patches_enable_non_security").(bool))
+	}
+
+	if d.HasChange("rejected_patches_action") {
+		params.RejectedPatchesAction = aws.String(d.Get("rejected_patches_action").(string))
+	}
+
+	if d.HasChange("source") {
+		params.Sources = expandAwsSsmPatchSources(d.Get("source").([]interface{}))
+	}
+
 	_, err := ssmconn.UpdatePatchBaseline(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error updating SSM Patch Baseline (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingPatchBaseline); err != nil {
+		return fmt.Errorf("error updating SSM Patch Baseline (%s) tags: %s", d.Id(), err)
 	}
 
 	return resourceAwsSsmPatchBaselineRead(d, meta)
@@ -222,16 +326,32 @@ func resourceAwsSsmPatchBaselineRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	resp, err := ssmconn.GetPatchBaseline(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		log.Printf("[WARN] SSM Patch Baseline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading SSM Patch Baseline (%s): %s", d.Id(), err)
 	}
 
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Service:   "ssm",
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("patchbaseline/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
 	d.Set("name", resp.Name)
 	d.Set("description", resp.Description)
 	d.Set("operating_system", resp.OperatingSystem)
 	d.Set("approved_patches_compliance_level", resp.ApprovedPatchesComplianceLevel)
 	d.Set("approved_patches", flattenStringList(resp.ApprovedPatches))
 	d.Set("rejected_patches", flattenStringList(resp.RejectedPatches))
+	d.Set("approved_patches_enable_non_security", resp.ApprovedPatchesEnableNonSecurity)
+	d.Set("rejected_patches_action", resp.RejectedPatchesAction)
 
 	if err := d.Set("global_filter", flattenAwsSsmPatchFilterGroup(resp.GlobalFilters)); err != nil {
 		return fmt.Errorf("[DEBUG] Error setting global filters error: %#v", err)
@@ -241,6 +361,19 @@ func resourceAwsSsmPatchBaselineRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("[DEBUG] Error setting approval rules error: %#v", err)
 	}
 
+	if err := d.Set("source", flattenAwsSsmPatchSources(resp.Sources)); err != nil {
+		return fmt.Errorf("error setting source: %s", err)
+	}
+
+	tagList, err := ssmconn.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(d.Id()),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingPatchBaseline),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading SSM Patch Baseline (%s) tags: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapSSM(tagList.TagList))
+
 	return nil
 }
 
@@ -254,8 +387,11 @@ func resourceAwsSsmPatchBaselineDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	_, err := ssmconn.DeletePatchBaseline(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error deleting SSM Patch Baseline (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -327,9 +463,17 @@ func expandAwsSsmPatchRuleGroup(d *schema.ResourceData) *ssm.PatchRuleGroup {
 		}
 
 		rule := &ssm.PatchRule{
-			ApproveAfterDays: aws.Int64(int64(rCfg["approve_after_days"].(int))),
-			PatchFilterGroup: filterGroup,
-			ComplianceLevel:  aws.String(rCfg["compliance_level"].(string)),
+			PatchFilterGroup:  filterGroup,
+			ComplianceLevel:   aws.String(rCfg["compliance_level"].(string)),
+			EnableNonSecurity: aws.Bool(rCfg["enable_non_security"].(bool)),
+		}
+
+		// Rules either approve patches a number of days after release or
+		// up to a fixed date.
+		if v, ok := rCfg["approve_until_date"].(string); ok && v != "" {
+			rule.ApproveUntilDate = aws.String(v)
+		} else {
+			rule.ApproveAfterDays = aws.Int64(int64(rCfg["approve_after_days"].(int)))
 		}
 
 		rules = append(rules, rule)
@@ -349,11 +493,43 @@ func flattenAwsSsmPatchRuleGroup(group *ssm.PatchRuleGroup) []map[string]interfa
 
 	for _, rule := range group.PatchRules {
 		r := make(map[string]interface{})
-		r["approve_after_days"] = *rule.ApproveAfterDays
-		r["compliance_level"] = *rule.ComplianceLevel
+		r["approve_after_days"] = aws.Int64Value(rule.ApproveAfterDays)
+		r["approve_until_date"] = aws.StringValue(rule.ApproveUntilDate)
+		r["compliance_level"] = aws.StringValue(rule.ComplianceLevel)
+		r["enable_non_security"] = aws.BoolValue(rule.EnableNonSecurity)
 		r["patch_filter"] = flattenAwsSsmPatchFilterGroup(rule.PatchFilterGroup)
 		result = append(result, r)
 	}
 
 	return result
 }
+
+func expandAwsSsmPatchSources(config []interface{}) []*ssm.PatchSource {
+	sources := make([]*ssm.PatchSource, 0, len(config))
+
+	for _, raw := range config {
+		m := raw.(map[string]interface{})
+
+		sources = append(sources, &ssm.PatchSource{
+			Configuration: aws.String(m["configuration"].(string)),
+			Name:          aws.String(m["name"].(string)),
+			Products:      expandStringList(m["products"].([]interface{})),
+		})
+	}
+
+	return sources
+}
+
+func flattenAwsSsmPatchSources(sources []*ssm.PatchSource) []interface{} {
+	result := make([]interface{}, 0, len(sources))
+
+	for _, source := range sources {
+		result = append(result, map[string]interface{}{
+			"configuration": aws.StringValue(source.Configuration),
+			"name":          aws.StringValue(source.Name),
+			"products":      flattenStringList(source.Products),
+		})
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -108,6 +109,99 @@ func TestAccAWSSSMPatchBaselineWithOperatingSystem(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMPatchBaseline_approveUntilDate(t *testing.T) {
+	var baseline ssm.PatchBaselineIdentity
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_patch_baseline.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMPatchBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMPatchBaselineConfigApproveUntilDate(name, "2020-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchBaselineExists(resourceName, &baseline),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_until_date", "2020-01-01"),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.enable_non_security", "true"),
+					resource.TestCheckResourceAttr(resourceName, "approved_patches_enable_non_security", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", ssm.OperatingSystemAmazonLinux2),
+					resource.TestCheckResourceAttr(resourceName, "rejected_patches_action", ssm.PatchActionBlock),
+				),
+			},
+			{
+				Config: testAccAWSSSMPatchBaselineConfigApproveUntilDate(name, "2021-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchBaselineExists(resourceName, &baseline),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_until_date", "2021-01-01"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMPatchBaseline_source(t *testing.T) {
+	var baseline ssm.PatchBaselineIdentity
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_patch_baseline.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMPatchBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMPatchBaselineConfigSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchBaselineExists(resourceName, &baseline),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "My-AL2017.09"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.products.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.products.0", "AmazonLinux2017.09"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMPatchBaseline_tags(t *testing.T) {
+	var baseline ssm.PatchBaselineIdentity
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_patch_baseline.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMPatchBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMPatchBaselineConfigTags(name, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchBaselineExists(resourceName, &baseline),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:ssm:[^:]+:[0-9]{12}:patchbaseline/pb-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMPatchBaselineConfigTags(name, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchBaselineExists(resourceName, &baseline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsSsmPatchBaselineRecreated(t *testing.T,
 	before, after *ssm.PatchBaselineIdentity) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -262,3 +356,70 @@ resource "aws_ssm_patch_baseline" "foo" {
 
 `, rName)
 }
+
+func testAccAWSSSMPatchBaselineConfigApproveUntilDate(rName, approveUntilDate string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "foo" {
+  name                                 = "patch-baseline-%[1]s"
+  operating_system                     = "AMAZON_LINUX_2"
+  approved_patches                     = ["kernel"]
+  approved_patches_enable_non_security = true
+  rejected_patches                     = ["telnet"]
+  rejected_patches_action              = "BLOCK"
+
+  approval_rule {
+    approve_until_date  = %[2]q
+    compliance_level    = "CRITICAL"
+    enable_non_security = true
+
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["Security"]
+    }
+  }
+}
+`, rName, approveUntilDate)
+}
+
+func testAccAWSSSMPatchBaselineConfigSource(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "foo" {
+  name             = "patch-baseline-%[1]s"
+  operating_system = "AMAZON_LINUX"
+
+  approval_rule {
+    approve_after_days = 7
+
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["Security"]
+    }
+  }
+
+  source {
+    name     = "My-AL2017.09"
+    products = ["AmazonLinux2017.09"]
+
+    configuration = <<EOF
+[amzn-main]
+name=amzn-main-Base
+mirrorlist=http://repo./$awsregion./$awsdomain//$releasever/main/mirror.list
+enabled=1
+EOF
+  }
+}
+`, rName)
+}
+
+func testAccAWSSSMPatchBaselineConfigTags(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "foo" {
+  name             = "patch-baseline-%[1]s"
+  approved_patches = ["KB123456"]
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
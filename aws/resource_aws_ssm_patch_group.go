@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -13,6 +15,9 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Create: resourceAwsSsmPatchGroupCreate,
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
@@ -32,64 +37,107 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 func resourceAwsSsmPatchGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
+	baselineID := d.Get("baseline_id").(string)
+	patchGroup := d.Get("patch_group").(string)
+
 	params := &ssm.RegisterPatchBaselineForPatchGroupInput{
-		BaselineId: aws.String(d.Get("baseline_id").(string)),
-		PatchGroup: aws.String(d.Get("patch_group").(string)),
+		BaselineId: aws.String(baselineID),
+		PatchGroup: aws.String(patchGroup),
 	}
 
-	resp, err := ssmconn.RegisterPatchBaselineForPatchGroup(params)
+	_, err := ssmconn.RegisterPatchBaselineForPatchGroup(params)
 	if err != nil {
-		return err
+		return fmt.Errorf("error registering SSM Patch Baseline (%s) for Patch Group (%s): %s", baselineID, patchGroup, err)
 	}
 
-	d.SetId(*resp.PatchGroup)
+	// A patch group can be registered with one baseline per operating system,
+	// so both are needed to identify the registration.
+	d.SetId(fmt.Sprintf("%s,%s", patchGroup, baselineID))
 	return resourceAwsSsmPatchGroupRead(d, meta)
 }
 
 func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	params := &ssm.DescribePatchGroupsInput{}
-
-	resp, err := ssmconn.DescribePatchGroups(params)
+	patchGroup, baselineID, err := decodeSsmPatchGroupID(d)
 	if err != nil {
 		return err
 	}
 
-	found := false
-	for _, t := range resp.Mappings {
-		if *t.PatchGroup == d.Id() {
-			found = true
+	params := &ssm.DescribePatchGroupsInput{
+		Filters: []*ssm.PatchOrchestratorFilter{
+			{
+				Key:    aws.String("NAME_PREFIX"),
+				Values: []*string{aws.String(patchGroup)},
+			},
+		},
+	}
 
-			d.Set("patch_group", t.PatchGroup)
-			d.Set("baseline_id", t.BaselineIdentity.BaselineId)
+	var mapping *ssm.PatchGroupPatchBaselineMapping
+
+	err = ssmconn.DescribePatchGroupsPages(params, func(page *ssm.DescribePatchGroupsOutput, lastPage bool) bool {
+		for _, t := range page.Mappings {
+			if aws.StringValue(t.PatchGroup) == patchGroup && t.BaselineIdentity != nil && aws.StringValue(t.BaselineIdentity.BaselineId) == baselineID {
+				mapping = t
+				return false
+			}
 		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Patch Group (%s): %s", d.Id(), err)
 	}
 
-	if !found {
+	if mapping == nil {
 		log.Printf("[INFO] Patch Group not found. Removing from state")
 		d.SetId("")
 		return nil
 	}
 
-	return nil
+	d.Set("patch_group", mapping.PatchGroup)
+	d.Set("baseline_id", mapping.BaselineIdentity.BaselineId)
 
+	return nil
 }
 
 func resourceAwsSsmPatchGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
+	patchGroup, baselineID, err := decodeSsmPatchGroupID(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting SSM Patch Group: %s", d.Id())
 
 	params := &ssm.DeregisterPatchBaselineForPatchGroupInput{
-		BaselineId: aws.String(d.Get("baseline_id").(string)),
-		PatchGroup: aws.String(d.Get("patch_group").(string)),
+		BaselineId: aws.String(baselineID),
+		PatchGroup: aws.String(patchGroup),
 	}
 
-	_, err := ssmconn.DeregisterPatchBaselineForPatchGroup(params)
+	_, err = ssmconn.DeregisterPatchBaselineForPatchGroup(params)
+	if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+		return nil
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error deregistering SSM Patch Group (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+// decodeSsmPatchGroupID returns the patch group and baseline ID. Resources
+// created before the ID included the baseline only have the patch group.
+func decodeSsmPatchGroupID(d *schema.ResourceData) (string, string, error) {
+	if !strings.Contains(d.Id(), ",") {
+		return d.Id(), d.Get("baseline_id").(string), nil
+	}
+
+	parts := strings.SplitN(d.Id(), ",", 2)
+	if parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected PATCH_GROUP,BASELINE_ID", d.Id())
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -24,6 +24,29 @@ func TestAccAWSSSMPatchGroup_basic(t *testing.T) {
 					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.patchgroup"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_group.patchgroup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMPatchGroup_multipleOperatingSystems(t *testing.T) {
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMPatchGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMPatchGroupConfigMultipleOperatingSystems(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.windows"),
+					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.amazon_linux_2"),
+				),
+			},
 		},
 	})
 }
@@ -47,7 +70,7 @@ func testAccCheckAWSSSMPatchGroupExists(n string) resource.TestCheckFunc {
 		}
 
 		for _, i := range resp.Mappings {
-			if *i.BaselineIdentity.BaselineId == rs.Primary.Attributes["baseline_id"] && *i.PatchGroup == rs.Primary.ID {
+			if *i.BaselineIdentity.BaselineId == rs.Primary.Attributes["baseline_id"] && *i.PatchGroup == rs.Primary.Attributes["patch_group"] {
 				return nil
 			}
 		}
@@ -75,7 +98,7 @@ func testAccCheckAWSSSMPatchGroupDestroy(s *terraform.State) error {
 		}
 
 		for _, i := range resp.Mappings {
-			if *i.BaselineIdentity.BaselineId == rs.Primary.Attributes["baseline_id"] && *i.PatchGroup == rs.Primary.ID {
+			if *i.BaselineIdentity.BaselineId == rs.Primary.Attributes["baseline_id"] && *i.PatchGroup == rs.Primary.Attributes["patch_group"] {
 				return fmt.Errorf("Expected AWS SSM Patch Group to be gone, but was still found")
 			}
 		}
@@ -101,3 +124,28 @@ resource "aws_ssm_patch_group" "patchgroup" {
 
 `, rName)
 }
+
+func testAccAWSSSMPatchGroupConfigMultipleOperatingSystems(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "windows" {
+  name             = "patch-baseline-windows-%[1]s"
+  approved_patches = ["KB123456"]
+}
+
+resource "aws_ssm_patch_baseline" "amazon_linux_2" {
+  name             = "patch-baseline-al2-%[1]s"
+  operating_system = "AMAZON_LINUX_2"
+  approved_patches = ["kernel"]
+}
+
+resource "aws_ssm_patch_group" "windows" {
+  baseline_id = "${aws_ssm_patch_baseline.windows.id}"
+  patch_group = "patch-group-%[1]s"
+}
+
+resource "aws_ssm_patch_group" "amazon_linux_2" {
+  baseline_id = "${aws_ssm_patch_baseline.amazon_linux_2.id}"
+  patch_group = "patch-group-%[1]s"
+}
+`, rName)
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSsoAdminAccountAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminAccountAssignmentCreate,
+		Read:   resourceAwsSsoAdminAccountAssignmentRead,
+		Delete: resourceAwsSsoAdminAccountAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssoadmin.PrincipalTypeGroup,
+					ssoadmin.PrincipalTypeUser,
+				}, false),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ssoadmin.TargetTypeAwsAccount,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssoadmin.TargetTypeAwsAccount,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsSsoAdminAccountAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+	targetID := d.Get("target_id").(string)
+	targetType := d.Get("target_type").(string)
+
+	// CreateAccountAssignment succeeds for an assignment that already exists,
+	// which would leave two resources managing the same assignment.
+	existing, err := ssoadminDescribeAccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+	if err != nil {
+		return fmt.Errorf("error reading SSO Account Assignments for %s (%s): %s", principalType, principalID, err)
+	}
+
+	if existing != nil {
+		return fmt.Errorf("error creating SSO Account Assignment for %s (%s): already exists", principalType, principalID)
+	}
+
+	input := &ssoadmin.CreateAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		PrincipalId:      aws.String(principalID),
+		PrincipalType:    aws.String(principalType),
+		TargetId:         aws.String(targetID),
+		TargetType:       aws.String(targetType),
+	}
+
+	log.Printf("[DEBUG] Creating SSO Account Assignment: %s", input)
+	output, err := conn.CreateAccountAssignment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Account Assignment for %s (%s): %s", principalType, principalID, err)
+	}
+
+	if output == nil || output.AccountAssignmentCreationStatus == nil {
+		return fmt.Errorf("error creating SSO Account Assignment for %s (%s): empty output", principalType, principalID)
+	}
+
+	if err := waitForSsoAdminAccountAssignmentCreation(conn, instanceArn, aws.StringValue(output.AccountAssignmentCreationStatus.RequestId)); err != nil {
+		return fmt.Errorf("error waiting for SSO Account Assignment for %s (%s) creation: %s", principalType, principalID, err)
+	}
+
+	d.SetId(strings.Join([]string{principalID, principalType, targetID, targetType, permissionSetArn, instanceArn}, ","))
+
+	return resourceAwsSsoAdminAccountAssignmentRead(d, meta)
+}
+
+func resourceAwsSsoAdminAccountAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	idParts, err := decodeSsoAdminAccountAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	principalID, principalType, targetID, targetType, permissionSetArn, instanceArn := idParts[0], idParts[1], idParts[2], idParts[3], idParts[4], idParts[5]
+
+	assignment, err := ssoadminDescribeAccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Account Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Account Assignment (%s): %s", d.Id(), err)
+	}
+
+	if assignment == nil {
+		log.Printf("[WARN] SSO Account Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", assignment.PermissionSetArn)
+	d.Set("principal_id", assignment.PrincipalId)
+	d.Set("principal_type", assignment.PrincipalType)
+	d.Set("target_id", assignment.AccountId)
+	d.Set("target_type", targetType)
+
+	return nil
+}
+
+func resourceAwsSsoAdminAccountAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	idParts, err := decodeSsoAdminAccountAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	principalID, principalType, targetID, targetType, permissionSetArn, instanceArn := idParts[0], idParts[1], idParts[2], idParts[3], idParts[4], idParts[5]
+
+	input := &ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		PrincipalId:      aws.String(principalID),
+		PrincipalType:    aws.String(principalType),
+		TargetId:         aws.String(targetID),
+		TargetType:       aws.String(targetType),
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Account Assignment (%s)", d.Id())
+	output, err := conn.DeleteAccountAssignment(input)
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Account Assignment (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.AccountAssignmentDeletionStatus == nil {
+		return fmt.Errorf("error deleting SSO Account Assignment (%s): empty output", d.Id())
+	}
+
+	if err := waitForSsoAdminAccountAssignmentDeletion(conn, instanceArn, aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId)); err != nil {
+		return fmt.Errorf("error waiting for SSO Account Assignment (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
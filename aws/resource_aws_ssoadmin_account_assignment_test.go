@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSSOAdminAccountAssignment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentConfig(rName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", ssoadmin.PrincipalTypeGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", ssoadmin.TargetTypeAwsAccount),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Account Assignment ID is set")
+		}
+
+		idParts, err := decodeSsoAdminAccountAssignmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		assignment, err := ssoadminDescribeAccountAssignment(conn, idParts[0], idParts[1], idParts[2], idParts[4], idParts[5])
+
+		if err != nil {
+			return err
+		}
+
+		if assignment == nil {
+			return fmt.Errorf("SSO Account Assignment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_account_assignment" {
+			continue
+		}
+
+		idParts, err := decodeSsoAdminAccountAssignmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		assignment, err := ssoadminDescribeAccountAssignment(conn, idParts[0], idParts[1], idParts[2], idParts[4], idParts[5])
+
+		if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if assignment != nil {
+			return fmt.Errorf("SSO Account Assignment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSSOAdminAccountAssignmentConfig(rName, groupName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  identity_store_id = "${data.aws_ssoadmin_instances.test.identity_store_ids[0]}"
+
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[2]q
+  }
+}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = "${data.aws_ssoadmin_instances.test.arns[0]}"
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = "${aws_ssoadmin_permission_set.test.instance_arn}"
+  permission_set_arn = "${aws_ssoadmin_permission_set.test.arn}"
+
+  principal_id   = "${data.aws_identitystore_group.test.group_id}"
+  principal_type = "GROUP"
+
+  target_id   = "${data.aws_caller_identity.current.account_id}"
+  target_type = "AWS_ACCOUNT"
+}
+`, rName, groupName)
+}
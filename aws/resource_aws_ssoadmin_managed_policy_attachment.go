@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSsoAdminManagedPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminManagedPolicyAttachmentCreate,
+		Read:   resourceAwsSsoAdminManagedPolicyAttachmentRead,
+		Delete: resourceAwsSsoAdminManagedPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"managed_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"managed_policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+	instanceArn := d.Get("instance_arn").(string)
+	managedPolicyArn := d.Get("managed_policy_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+
+	input := &ssoadmin.AttachManagedPolicyToPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		ManagedPolicyArn: aws.String(managedPolicyArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	log.Printf("[DEBUG] Attaching Managed Policy to SSO Permission Set: %s", input)
+	if _, err := conn.AttachManagedPolicyToPermissionSet(input); err != nil {
+		return fmt.Errorf("error attaching Managed Policy (%s) to SSO Permission Set (%s): %s", managedPolicyArn, permissionSetArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", managedPolicyArn, permissionSetArn, instanceArn))
+
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return resourceAwsSsoAdminManagedPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	managedPolicyArn, permissionSetArn, instanceArn, err := decodeSsoAdminManagedPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var policy *ssoadmin.AttachedManagedPolicy
+
+	err = conn.ListManagedPoliciesInPermissionSetPages(input, func(page *ssoadmin.ListManagedPoliciesInPermissionSetOutput, lastPage bool) bool {
+		for _, v := range page.AttachedManagedPolicies {
+			if v != nil && aws.StringValue(v.Arn) == managedPolicyArn {
+				policy = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing Managed Policy Attachment (%s) from state", permissionSetArn, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Managed Policy Attachment (%s): %s", d.Id(), err)
+	}
+
+	if policy == nil {
+		log.Printf("[WARN] SSO Managed Policy Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("managed_policy_arn", policy.Arn)
+	d.Set("managed_policy_name", policy.Name)
+	d.Set("permission_set_arn", permissionSetArn)
+
+	return nil
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	managedPolicyArn, permissionSetArn, instanceArn, err := decodeSsoAdminManagedPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ssoadmin.DetachManagedPolicyFromPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		ManagedPolicyArn: aws.String(managedPolicyArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Managed Policy Attachment (%s)", d.Id())
+	_, err = conn.DetachManagedPolicyFromPermissionSet(input)
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Managed Policy Attachment (%s): %s", d.Id(), err)
+	}
+
+	return provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn)
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSSOAdminManagedPolicyAttachment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssoadmin_managed_policy_attachment.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminManagedPolicyAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_name", "ReadOnlyAccess"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSSOAdminManagedPolicyAttached(conn *ssoadmin.SSOAdmin, id string) (bool, error) {
+	managedPolicyArn, permissionSetArn, instanceArn, err := decodeSsoAdminManagedPolicyAttachmentID(id)
+	if err != nil {
+		return false, err
+	}
+
+	input := &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var found bool
+
+	err = conn.ListManagedPoliciesInPermissionSetPages(input, func(page *ssoadmin.ListManagedPoliciesInPermissionSetOutput, lastPage bool) bool {
+		for _, v := range page.AttachedManagedPolicies {
+			if aws.StringValue(v.Arn) == managedPolicyArn {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Managed Policy Attachment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		found, err := testAccAWSSSOAdminManagedPolicyAttached(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("SSO Managed Policy Attachment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_managed_policy_attachment" {
+			continue
+		}
+
+		found, err := testAccAWSSSOAdminManagedPolicyAttached(conn, rs.Primary.ID)
+
+		if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("SSO Managed Policy Attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSSOAdminManagedPolicyAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = "${data.aws_ssoadmin_instances.test.arns[0]}"
+}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = "${aws_ssoadmin_permission_set.test.instance_arn}"
+  managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"
+  permission_set_arn = "${aws_ssoadmin_permission_set.test.arn}"
+}
+`, rName)
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSsoAdminPermissionSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminPermissionSetCreate,
+		Read:   resourceAwsSsoAdminPermissionSetRead,
+		Update: resourceAwsSsoAdminPermissionSetUpdate,
+		Delete: resourceAwsSsoAdminPermissionSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 700),
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]{1,32}$`), "must be 1-32 characters matching [\\w+=,.@-]"),
+			},
+			"relay_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 240),
+			},
+			"session_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT1H",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^PT\d+H(\d+M)?$|^PT\d+M$`), "must be an ISO-8601 duration, e.g. PT1H"),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSsoAdminPermissionSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+	instanceArn := d.Get("instance_arn").(string)
+
+	input := &ssoadmin.CreatePermissionSetInput{
+		InstanceArn:     aws.String(instanceArn),
+		Name:            aws.String(d.Get("name").(string)),
+		SessionDuration: aws.String(d.Get("session_duration").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("relay_state"); ok {
+		input.RelayState = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSSOAdmin(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SSO Permission Set: %s", input)
+	output, err := conn.CreatePermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Permission Set: %s", err)
+	}
+
+	if output == nil || output.PermissionSet == nil {
+		return fmt.Errorf("error creating SSO Permission Set: empty output")
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", aws.StringValue(output.PermissionSet.PermissionSetArn), instanceArn))
+
+	return resourceAwsSsoAdminPermissionSetRead(d, meta)
+}
+
+func resourceAwsSsoAdminPermissionSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := decodeSsoAdminPermissionSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribePermissionSet(&ssoadmin.DescribePermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Permission Set (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.PermissionSet == nil {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	permissionSet := output.PermissionSet
+
+	d.Set("arn", permissionSet.PermissionSetArn)
+	d.Set("created_date", aws.TimeValue(permissionSet.CreatedDate).Format(time.RFC3339))
+	d.Set("description", permissionSet.Description)
+	d.Set("instance_arn", instanceArn)
+	d.Set("name", permissionSet.Name)
+	d.Set("relay_state", permissionSet.RelayState)
+	d.Set("session_duration", permissionSet.SessionDuration)
+
+	if err := getTagsSSOAdmin(conn, d, instanceArn, permissionSetArn); err != nil {
+		return fmt.Errorf("error reading SSO Permission Set (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsoAdminPermissionSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := decodeSsoAdminPermissionSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("description") || d.HasChange("relay_state") || d.HasChange("session_duration") {
+		input := &ssoadmin.UpdatePermissionSetInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+			SessionDuration:  aws.String(d.Get("session_duration").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("relay_state"); ok {
+			input.RelayState = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSO Permission Set: %s", input)
+		if _, err := conn.UpdatePermissionSet(input); err != nil {
+			return fmt.Errorf("error updating SSO Permission Set (%s): %s", d.Id(), err)
+		}
+
+		if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsSSOAdmin(conn, d, instanceArn, permissionSetArn); err != nil {
+		return fmt.Errorf("error updating SSO Permission Set (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsSsoAdminPermissionSetRead(d, meta)
+}
+
+func resourceAwsSsoAdminPermissionSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := decodeSsoAdminPermissionSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Permission Set (%s)", d.Id())
+	_, err = conn.DeletePermissionSet(&ssoadmin.DeletePermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Permission Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
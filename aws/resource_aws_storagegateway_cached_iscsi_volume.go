@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsStorageGatewayCachedIscsiVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayCachedIscsiVolumeCreate,
+		Read:   resourceAwsStorageGatewayCachedIscsiVolumeRead,
+		Update: resourceAwsStorageGatewayCachedIscsiVolumeUpdate,
+		Delete: resourceAwsStorageGatewayCachedIscsiVolumeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chap_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"lun_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_interface_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_volume_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": tagsSchema(),
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayCachedIscsiVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateCachediSCSIVolumeInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		GatewayARN:         aws.String(d.Get("gateway_arn").(string)),
+		NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+		Tags:               tagsFromMapStorageGateway(d.Get("tags").(map[string]interface{})),
+		TargetName:         aws.String(d.Get("target_name").(string)),
+		VolumeSizeInBytes:  aws.Int64(int64(d.Get("volume_size_in_bytes").(int))),
+	}
+
+	if v, ok := d.GetOk("kms_encrypted"); ok {
+		input.KMSEncrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_volume_arn"); ok {
+		input.SourceVolumeARN = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway cached iSCSI volume: %s", input)
+	output, err := conn.CreateCachediSCSIVolume(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway cached iSCSI volume: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VolumeARN))
+
+	return resourceAwsStorageGatewayCachedIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayCachedIscsiVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	volume, err := describeStorageGatewayCachedIscsiVolume(conn, d.Id())
+	if isStorageGatewayVolumeNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+		log.Printf("[WARN] Storage Gateway cached iSCSI volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway cached iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	if volume == nil {
+		log.Printf("[WARN] Storage Gateway cached iSCSI volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	gatewayARN, err := storageGatewayGatewayARNFromVolumeARN(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", volume.VolumeARN)
+	d.Set("gateway_arn", gatewayARN)
+	d.Set("kms_encrypted", volume.KMSKey != nil)
+	d.Set("kms_key", volume.KMSKey)
+	d.Set("snapshot_id", volume.SourceSnapshotId)
+	d.Set("target_name", volume.TargetName)
+	d.Set("volume_arn", volume.VolumeARN)
+	d.Set("volume_id", volume.VolumeId)
+	d.Set("volume_size_in_bytes", int(aws.Int64Value(volume.VolumeSizeInBytes)))
+
+	if attributes := volume.VolumeiSCSIAttributes; attributes != nil {
+		d.Set("chap_enabled", attributes.ChapEnabled)
+		d.Set("lun_number", attributes.LunNumber)
+		d.Set("network_interface_id", attributes.NetworkInterfaceId)
+		d.Set("network_interface_port", attributes.NetworkInterfacePort)
+		d.Set("target_arn", attributes.TargetARN)
+	}
+
+	tags, err := listStorageGatewayTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for Storage Gateway cached iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapStorageGateway(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayCachedIscsiVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if err := setTagsStorageGateway(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Storage Gateway cached iSCSI volume (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayCachedIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayCachedIscsiVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway cached iSCSI volume: %s", d.Id())
+	_, err := conn.DeleteVolume(&storagegateway.DeleteVolumeInput{
+		VolumeARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayVolumeNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway cached iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
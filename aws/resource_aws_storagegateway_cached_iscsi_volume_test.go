@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewayCachedIscsiVolume_basic(t *testing.T) {
+	var cachedIscsiVolume storagegateway.CachediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_cached_iscsi_volume.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayCachedIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayCachedIscsiVolumeConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(resourceName, &cachedIscsiVolume),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+/volume/vol-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "chap_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lun_number", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.test", "private_ip"),
+					resource.TestMatchResourceAttr(resourceName, "network_interface_port", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "target_arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+/target/iqn.1997-05.com.amazon:%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "target_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "volume_id", regexp.MustCompile(`^vol-.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "volume_size_in_bytes", "5368709120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayCachedIscsiVolume_tags(t *testing.T) {
+	var cachedIscsiVolume storagegateway.CachediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_cached_iscsi_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayCachedIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayCachedIscsiVolumeConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(resourceName, &cachedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayCachedIscsiVolumeConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(resourceName, &cachedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(n string, v *storagegateway.CachediSCSIVolume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Storage Gateway cached iSCSI volume ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		volume, err := describeStorageGatewayCachedIscsiVolume(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if volume == nil {
+			return fmt.Errorf("Storage Gateway cached iSCSI volume (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *volume
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayCachedIscsiVolumeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_cached_iscsi_volume" {
+			continue
+		}
+
+		volume, err := describeStorageGatewayCachedIscsiVolume(conn, rs.Primary.ID)
+		if isStorageGatewayVolumeNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if volume != nil {
+			return fmt.Errorf("Storage Gateway cached iSCSI volume (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayCachedIscsiVolumeConfigBase(rName string) string {
+	return testAccAWSStorageGatewayCacheConfig(rName)
+}
+
+func testAccAWSStorageGatewayCachedIscsiVolumeConfigBasic(rName string) string {
+	return testAccAWSStorageGatewayCachedIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_cached_iscsi_volume" "test" {
+  gateway_arn          = "${aws_storagegateway_cache.test.gateway_arn}"
+  network_interface_id = "${aws_instance.test.private_ip}"
+  target_name          = %[1]q
+  volume_size_in_bytes = 5368709120
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayCachedIscsiVolumeConfigTags(rName, tagValue string) string {
+	return testAccAWSStorageGatewayCachedIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_cached_iscsi_volume" "test" {
+  gateway_arn          = "${aws_storagegateway_cache.test.gateway_arn}"
+  network_interface_id = "${aws_instance.test.private_ip}"
+  target_name          = %[1]q
+  volume_size_in_bytes = 5368709120
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
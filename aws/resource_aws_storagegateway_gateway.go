@@ -0,0 +1,424 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsStorageGatewayGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayGatewayCreate,
+		Read:   resourceAwsStorageGatewayGatewayRead,
+		Update: resourceAwsStorageGatewayGatewayUpdate,
+		Delete: resourceAwsStorageGatewayGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"gateway_ip_address"},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_log_group_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"ec2_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_ip_address": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"activation_key"},
+			},
+			"gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(2, 255),
+			},
+			"gateway_network_interface": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"gateway_timezone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^GMT(?:[+-][0-9]{1,2}:[0-9]{2})?$`),
+					"must be GMT or a GMT offset such as GMT-5:00",
+				),
+			},
+			"gateway_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "STORED",
+				ValidateFunc: validation.StringInSlice([]string{
+					"CACHED",
+					"FILE_S3",
+					"STORED",
+					"VTL",
+				}, false),
+			},
+			"host_environment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"medium_changer_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AWS-Gateway-VTL",
+					"IBM-03584L32-0402",
+					"STK-L700",
+				}, false),
+			},
+			"smb_active_directory_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_directory_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_controllers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"organizational_unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"smb_guest_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[ -~]{6,512}$`),
+					"must be 6 to 512 printable ASCII characters",
+				),
+			},
+			"tags": tagsSchema(),
+			"tape_drive_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IBM-ULT3580-TD5"}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+	region := meta.(*AWSClient).region
+
+	activationKey := d.Get("activation_key").(string)
+	gatewayIPAddress := d.Get("gateway_ip_address").(string)
+
+	// Perform one time fetch of activation key from gateway IP address
+	if activationKey == "" {
+		if gatewayIPAddress == "" {
+			return fmt.Errorf("either activation_key or gateway_ip_address must be provided")
+		}
+
+		var err error
+		activationKey, err = storageGatewayActivationKey(gatewayIPAddress, region, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("error retrieving Storage Gateway activation key from IP Address (%s): %s", gatewayIPAddress, err)
+		}
+	}
+
+	input := &storagegateway.ActivateGatewayInput{
+		ActivationKey:   aws.String(activationKey),
+		GatewayRegion:   aws.String(region),
+		GatewayName:     aws.String(d.Get("gateway_name").(string)),
+		GatewayTimezone: aws.String(d.Get("gateway_timezone").(string)),
+		GatewayType:     aws.String(d.Get("gateway_type").(string)),
+		Tags:            tagsFromMapStorageGateway(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("medium_changer_type"); ok {
+		input.MediumChangerType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tape_drive_type"); ok {
+		input.TapeDriveType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Activating Storage Gateway gateway: %s", input)
+	output, err := conn.ActivateGateway(input)
+	if err != nil {
+		return fmt.Errorf("error activating Storage Gateway gateway: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GatewayARN))
+	d.Set("activation_key", activationKey)
+
+	if err := waitForStorageGatewayGatewayConnected(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway gateway (%s) to connect: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("smb_active_directory_settings"); ok {
+		if err := storageGatewayJoinDomain(conn, d.Id(), v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("smb_guest_password"); ok {
+		if err := storageGatewaySetSmbGuestPassword(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("cloudwatch_log_group_arn"); ok {
+		input := &storagegateway.UpdateGatewayInformationInput{
+			CloudWatchLogGroupARN: aws.String(v.(string)),
+			GatewayARN:            aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway gateway: %s", input)
+		if _, err := conn.UpdateGatewayInformation(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway gateway (%s) CloudWatch log group: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsStorageGatewayGatewayRead(d, meta)
+}
+
+func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	output, err := conn.DescribeGatewayInformation(&storagegateway.DescribeGatewayInformationInput{
+		GatewayARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayGatewayNotFound(err) {
+		log.Printf("[WARN] Storage Gateway gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway gateway (%s): %s", d.Id(), err)
+	}
+
+	// The activation key and IP address are only used during activation and
+	// cannot be read back from the API.
+	d.Set("arn", output.GatewayARN)
+	d.Set("cloudwatch_log_group_arn", output.CloudWatchLogGroupARN)
+	d.Set("ec2_instance_id", output.Ec2InstanceId)
+	d.Set("endpoint_type", output.EndpointType)
+	d.Set("gateway_id", output.GatewayId)
+	d.Set("gateway_name", output.GatewayName)
+	d.Set("gateway_timezone", output.GatewayTimezone)
+	d.Set("gateway_type", output.GatewayType)
+	d.Set("host_environment", output.HostEnvironment)
+
+	if err := d.Set("gateway_network_interface", flattenStorageGatewayNetworkInterfaces(output.GatewayNetworkInterfaces)); err != nil {
+		return fmt.Errorf("error setting gateway_network_interface: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapStorageGateway(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	// SMB settings only apply to file gateways.
+	if aws.StringValue(output.GatewayType) != "FILE_S3" {
+		d.Set("smb_active_directory_settings", nil)
+		return nil
+	}
+
+	smbSettings, err := conn.DescribeSMBSettings(&storagegateway.DescribeSMBSettingsInput{
+		GatewayARN: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway gateway (%s) SMB settings: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(smbSettings.DomainName) == "" {
+		d.Set("smb_active_directory_settings", nil)
+		return nil
+	}
+
+	// The credentials used to join the domain are not returned.
+	m := map[string]interface{}{
+		"active_directory_status": aws.StringValue(smbSettings.ActiveDirectoryStatus),
+		"domain_name":             aws.StringValue(smbSettings.DomainName),
+	}
+
+	if v, ok := d.GetOk("smb_active_directory_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		configured := v.([]interface{})[0].(map[string]interface{})
+
+		for _, k := range []string{"domain_controllers", "organizational_unit", "password", "timeout_in_seconds", "username"} {
+			m[k] = configured[k]
+		}
+	}
+
+	if err := d.Set("smb_active_directory_settings", []interface{}{m}); err != nil {
+		return fmt.Errorf("error setting smb_active_directory_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if d.HasChange("cloudwatch_log_group_arn") || d.HasChange("gateway_name") || d.HasChange("gateway_timezone") {
+		input := &storagegateway.UpdateGatewayInformationInput{
+			CloudWatchLogGroupARN: aws.String(d.Get("cloudwatch_log_group_arn").(string)),
+			GatewayARN:            aws.String(d.Id()),
+			GatewayName:           aws.String(d.Get("gateway_name").(string)),
+			GatewayTimezone:       aws.String(d.Get("gateway_timezone").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway gateway: %s", input)
+		if _, err := conn.UpdateGatewayInformation(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("smb_active_directory_settings") {
+		if err := storageGatewayJoinDomain(conn, d.Id(), d.Get("smb_active_directory_settings").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("smb_guest_password") {
+		if err := storageGatewaySetSmbGuestPassword(conn, d.Id(), d.Get("smb_guest_password").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsStorageGateway(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Storage Gateway gateway (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayGatewayRead(d, meta)
+}
+
+func resourceAwsStorageGatewayGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway gateway: %s", d.Id())
+	_, err := conn.DeleteGateway(&storagegateway.DeleteGatewayInput{
+		GatewayARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayGatewayNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway gateway (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func storageGatewayJoinDomain(conn *storagegateway.StorageGateway, gatewayARN string, l []interface{}) error {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	input := &storagegateway.JoinDomainInput{
+		DomainName:       aws.String(m["domain_name"].(string)),
+		GatewayARN:       aws.String(gatewayARN),
+		Password:         aws.String(m["password"].(string)),
+		TimeoutInSeconds: aws.Int64(int64(m["timeout_in_seconds"].(int))),
+		UserName:         aws.String(m["username"].(string)),
+	}
+
+	if v, ok := m["domain_controllers"].(*schema.Set); ok && v.Len() > 0 {
+		input.DomainControllers = expandStringSet(v)
+	}
+
+	if v, ok := m["organizational_unit"].(string); ok && v != "" {
+		input.OrganizationalUnit = aws.String(v)
+	}
+
+	log.Printf("[DEBUG] Joining Storage Gateway gateway (%s) to Active Directory domain: %s", gatewayARN, aws.StringValue(input.DomainName))
+	if _, err := conn.JoinDomain(input); err != nil {
+		return fmt.Errorf("error joining Storage Gateway gateway (%s) to Active Directory domain: %s", gatewayARN, err)
+	}
+
+	return nil
+}
+
+func storageGatewaySetSmbGuestPassword(conn *storagegateway.StorageGateway, gatewayARN, password string) error {
+	log.Printf("[DEBUG] Setting Storage Gateway gateway (%s) SMB guest password", gatewayARN)
+	_, err := conn.SetSMBGuestPassword(&storagegateway.SetSMBGuestPasswordInput{
+		GatewayARN: aws.String(gatewayARN),
+		Password:   aws.String(password),
+	})
+	if err != nil {
+		return fmt.Errorf("error setting Storage Gateway gateway (%s) SMB guest password: %s", gatewayARN, err)
+	}
+
+	return nil
+}
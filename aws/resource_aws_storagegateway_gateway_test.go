@@ -0,0 +1,415 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewayGateway_GatewayType_Cached(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigGatewayType(rName, "CACHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "gateway_id"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "gateway_timezone", "GMT"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_type", "CACHED"),
+					resource.TestCheckResourceAttr(resourceName, "medium_changer_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "smb_active_directory_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tape_drive_type", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayGateway_GatewayType_FileS3(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigGatewayType(rName, "FILE_S3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttrPair(resourceName, "ec2_instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_network_interface.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_network_interface.0.ipv4_address", "aws_instance.test", "private_ip"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_type", "FILE_S3"),
+					resource.TestCheckResourceAttr(resourceName, "host_environment", "EC2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayGateway_GatewayType_Stored(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigGatewayType(rName, "STORED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "gateway_type", "STORED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayGateway_GatewayName(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigGatewayName(rName1, rName1, "GMT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "gateway_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "gateway_timezone", "GMT"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigGatewayName(rName1, rName2, "GMT-5:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "gateway_name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "gateway_timezone", "GMT-5:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayGateway_SmbGuestPassword(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigSmbGuestPassword(rName, "myguestpassword1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password", "myguestpassword1"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigSmbGuestPassword(rName, "myguestpassword2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password", "myguestpassword2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayGateway_tags(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayGatewayConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayGatewayDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_gateway" {
+			continue
+		}
+
+		_, err := conn.DescribeGatewayInformation(&storagegateway.DescribeGatewayInformationInput{
+			GatewayARN: aws.String(rs.Primary.ID),
+		})
+		if isStorageGatewayGatewayNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Storage Gateway gateway (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSStorageGatewayGatewayExists(n string, v *storagegateway.DescribeGatewayInformationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Storage Gateway gateway ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		output, err := conn.DescribeGatewayInformation(&storagegateway.DescribeGatewayInformationInput{
+			GatewayARN: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccAWSStorageGatewayGatewayConfigVpcBase provides a public subnet the
+// gateway appliance can be activated from over HTTP.
+func testAccAWSStorageGatewayGatewayConfigVpcBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "${aws_internet_gateway.test.id}"
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = "${aws_route_table.test.id}"
+  subnet_id      = "${aws_subnet.test.id}"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayGatewayConfigInstanceBase(rName, amiNamePrefix string) string {
+	return testAccAWSStorageGatewayGatewayConfigVpcBase(rName) + fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["%[2]s-*"]
+  }
+}
+
+resource "aws_instance" "test" {
+  depends_on = ["aws_route_table_association.test"]
+
+  ami                         = "${data.aws_ami.test.id}"
+  associate_public_ip_address = true
+  instance_type               = "m4.xlarge"
+  subnet_id                   = "${aws_subnet.test.id}"
+  vpc_security_group_ids      = ["${aws_security_group.test.id}"]
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, amiNamePrefix)
+}
+
+// testAccAWSStorageGatewayGatewayConfigFileGatewayBase launches a file
+// gateway appliance.
+func testAccAWSStorageGatewayGatewayConfigFileGatewayBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfigInstanceBase(rName, "aws-thinstaller")
+}
+
+// testAccAWSStorageGatewayGatewayConfigTapeAndVolumeGatewayBase launches a
+// tape or volume gateway appliance.
+func testAccAWSStorageGatewayGatewayConfigTapeAndVolumeGatewayBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfigInstanceBase(rName, "aws-storage-gateway")
+}
+
+func testAccAWSStorageGatewayGatewayConfigGatewayType(rName, gatewayType string) string {
+	base := testAccAWSStorageGatewayGatewayConfigTapeAndVolumeGatewayBase(rName)
+	if gatewayType == "FILE_S3" {
+		base = testAccAWSStorageGatewayGatewayConfigFileGatewayBase(rName)
+	}
+
+	return base + fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = "${aws_instance.test.public_ip}"
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = %[2]q
+}
+`, rName, gatewayType)
+}
+
+func testAccAWSStorageGatewayGatewayConfigGatewayName(rName, gatewayName, gatewayTimezone string) string {
+	return testAccAWSStorageGatewayGatewayConfigFileGatewayBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = "${aws_instance.test.public_ip}"
+  gateway_name       = %[1]q
+  gateway_timezone   = %[2]q
+  gateway_type       = "FILE_S3"
+}
+`, gatewayName, gatewayTimezone)
+}
+
+func testAccAWSStorageGatewayGatewayConfigSmbGuestPassword(rName, smbGuestPassword string) string {
+	return testAccAWSStorageGatewayGatewayConfigFileGatewayBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = "${aws_instance.test.public_ip}"
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+  smb_guest_password = %[2]q
+}
+`, rName, smbGuestPassword)
+}
+
+func testAccAWSStorageGatewayGatewayConfigTags(rName, tagValue string) string {
+	return testAccAWSStorageGatewayGatewayConfigFileGatewayBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = "${aws_instance.test.public_ip}"
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,359 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsStorageGatewayNfsFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayNfsFileShareCreate,
+		Read:   resourceAwsStorageGatewayNfsFileShareRead,
+		Update: resourceAwsStorageGatewayNfsFileShareUpdate,
+		Delete: resourceAwsStorageGatewayNfsFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_attributes": storageGatewayCacheAttributesSchema(),
+			"client_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_storage_class": storageGatewayDefaultStorageClassSchema(),
+			"file_share_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"fileshare_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"guess_mime_type_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"location_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"nfs_file_share_defaults": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "0777",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-7]{4}$`), "must be a 4 digit octal mode"),
+						},
+						"file_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "0666",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-7]{4}$`), "must be a 4 digit octal mode"),
+						},
+						"group_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      65534,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"owner_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      65534,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"object_acl": storageGatewayObjectACLSchema(),
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"requester_pays": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"squash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "RootSquash",
+				ValidateFunc: validation.StringInSlice([]string{
+					"AllSquash",
+					"NoSquash",
+					"RootSquash",
+				}, false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// storageGatewayCacheAttributesSchema configures automatic refresh of a file
+// share's cache from its S3 bucket.
+func storageGatewayCacheAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cache_stale_timeout_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(300, 2592000),
+				},
+			},
+		},
+	}
+}
+
+func storageGatewayDefaultStorageClassSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "S3_STANDARD",
+		ValidateFunc: validation.StringInSlice([]string{
+			"S3_INTELLIGENT_TIERING",
+			"S3_ONEZONE_IA",
+			"S3_STANDARD",
+			"S3_STANDARD_IA",
+		}, false),
+	}
+}
+
+func storageGatewayObjectACLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      storagegateway.ObjectACLPrivate,
+		ValidateFunc: validation.StringInSlice(storagegateway.ObjectACL_Values(), false),
+	}
+}
+
+func resourceAwsStorageGatewayNfsFileShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateNFSFileShareInput{
+		CacheAttributes:      expandStorageGatewayCacheAttributes(d.Get("cache_attributes").([]interface{})),
+		ClientList:           expandStringSet(d.Get("client_list").(*schema.Set)),
+		ClientToken:          aws.String(resource.UniqueId()),
+		DefaultStorageClass:  aws.String(d.Get("default_storage_class").(string)),
+		GatewayARN:           aws.String(d.Get("gateway_arn").(string)),
+		GuessMIMETypeEnabled: aws.Bool(d.Get("guess_mime_type_enabled").(bool)),
+		KMSEncrypted:         aws.Bool(d.Get("kms_encrypted").(bool)),
+		LocationARN:          aws.String(d.Get("location_arn").(string)),
+		NFSFileShareDefaults: expandStorageGatewayNfsFileShareDefaults(d.Get("nfs_file_share_defaults").([]interface{})),
+		ObjectACL:            aws.String(d.Get("object_acl").(string)),
+		ReadOnly:             aws.Bool(d.Get("read_only").(bool)),
+		RequesterPays:        aws.Bool(d.Get("requester_pays").(bool)),
+		Role:                 aws.String(d.Get("role_arn").(string)),
+		Squash:               aws.String(d.Get("squash").(string)),
+		Tags:                 tagsFromMapStorageGateway(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("file_share_name"); ok {
+		input.FileShareName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway NFS file share: %s", input)
+	output, err := conn.CreateNFSFileShare(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway NFS file share: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FileShareARN))
+
+	if err := waitForStorageGatewayFileShareAvailable(d.Id(), refreshStorageGatewayNfsFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway NFS file share (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayNfsFileShareRead(d, meta)
+}
+
+func resourceAwsStorageGatewayNfsFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	fileShare, err := describeStorageGatewayNfsFileShare(conn, d.Id())
+	if isStorageGatewayFileShareNotFound(err) {
+		log.Printf("[WARN] Storage Gateway NFS file share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway NFS file share (%s): %s", d.Id(), err)
+	}
+
+	if fileShare == nil {
+		log.Printf("[WARN] Storage Gateway NFS file share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", fileShare.FileShareARN)
+	d.Set("default_storage_class", fileShare.DefaultStorageClass)
+	d.Set("file_share_name", fileShare.FileShareName)
+	d.Set("fileshare_id", fileShare.FileShareId)
+	d.Set("gateway_arn", fileShare.GatewayARN)
+	d.Set("guess_mime_type_enabled", fileShare.GuessMIMETypeEnabled)
+	d.Set("kms_encrypted", fileShare.KMSEncrypted)
+	d.Set("kms_key_arn", fileShare.KMSKey)
+	d.Set("location_arn", fileShare.LocationARN)
+	d.Set("object_acl", fileShare.ObjectACL)
+	d.Set("path", fileShare.Path)
+	d.Set("read_only", fileShare.ReadOnly)
+	d.Set("requester_pays", fileShare.RequesterPays)
+	d.Set("role_arn", fileShare.Role)
+	d.Set("squash", fileShare.Squash)
+
+	if err := d.Set("cache_attributes", flattenStorageGatewayCacheAttributes(fileShare.CacheAttributes)); err != nil {
+		return fmt.Errorf("error setting cache_attributes: %s", err)
+	}
+
+	if err := d.Set("client_list", schema.NewSet(schema.HashString, flattenStringList(fileShare.ClientList))); err != nil {
+		return fmt.Errorf("error setting client_list: %s", err)
+	}
+
+	if err := d.Set("nfs_file_share_defaults", flattenStorageGatewayNfsFileShareDefaults(fileShare.NFSFileShareDefaults)); err != nil {
+		return fmt.Errorf("error setting nfs_file_share_defaults: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapStorageGateway(fileShare.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayNfsFileShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if d.HasChange("cache_attributes") || d.HasChange("client_list") || d.HasChange("default_storage_class") ||
+		d.HasChange("file_share_name") || d.HasChange("guess_mime_type_enabled") || d.HasChange("kms_encrypted") ||
+		d.HasChange("kms_key_arn") || d.HasChange("nfs_file_share_defaults") || d.HasChange("object_acl") ||
+		d.HasChange("read_only") || d.HasChange("requester_pays") || d.HasChange("squash") {
+		input := &storagegateway.UpdateNFSFileShareInput{
+			CacheAttributes:      expandStorageGatewayCacheAttributes(d.Get("cache_attributes").([]interface{})),
+			ClientList:           expandStringSet(d.Get("client_list").(*schema.Set)),
+			DefaultStorageClass:  aws.String(d.Get("default_storage_class").(string)),
+			FileShareARN:         aws.String(d.Id()),
+			GuessMIMETypeEnabled: aws.Bool(d.Get("guess_mime_type_enabled").(bool)),
+			KMSEncrypted:         aws.Bool(d.Get("kms_encrypted").(bool)),
+			NFSFileShareDefaults: expandStorageGatewayNfsFileShareDefaults(d.Get("nfs_file_share_defaults").([]interface{})),
+			ObjectACL:            aws.String(d.Get("object_acl").(string)),
+			ReadOnly:             aws.Bool(d.Get("read_only").(bool)),
+			RequesterPays:        aws.Bool(d.Get("requester_pays").(bool)),
+			Squash:               aws.String(d.Get("squash").(string)),
+		}
+
+		if v, ok := d.GetOk("file_share_name"); ok {
+			input.FileShareName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key_arn"); ok {
+			input.KMSKey = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway NFS file share: %s", input)
+		if _, err := conn.UpdateNFSFileShare(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway NFS file share (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForStorageGatewayFileShareAvailable(d.Id(), refreshStorageGatewayNfsFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Storage Gateway NFS file share (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsStorageGateway(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Storage Gateway NFS file share (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayNfsFileShareRead(d, meta)
+}
+
+func resourceAwsStorageGatewayNfsFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway NFS file share: %s", d.Id())
+	_, err := conn.DeleteFileShare(&storagegateway.DeleteFileShareInput{
+		FileShareARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayFileShareNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway NFS file share (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForStorageGatewayFileShareDeletion(d.Id(), refreshStorageGatewayNfsFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway NFS file share (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
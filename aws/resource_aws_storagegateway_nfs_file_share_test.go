@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewayNfsFileShare_basic(t *testing.T) {
+	var fileShare storagegateway.NFSFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_nfs_file_share.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	iamResourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayNfsFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigClientList(rName, "0.0.0.0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:share/share-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "client_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_storage_class", "S3_STANDARD"),
+					resource.TestCheckResourceAttrSet(resourceName, "fileshare_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "guess_mime_type_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kms_encrypted", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "location_arn", bucketResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "object_acl", storagegateway.ObjectACLPrivate),
+					resource.TestCheckResourceAttrSet(resourceName, "path"),
+					resource.TestCheckResourceAttr(resourceName, "read_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "requester_pays", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", iamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "squash", "RootSquash"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigClientList(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "client_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayNfsFileShare_cacheAttributes(t *testing.T) {
+	var fileShare storagegateway.NFSFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_nfs_file_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayNfsFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigCacheAttributes(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigCacheAttributes(rName, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayNfsFileShare_nfsFileShareDefaults(t *testing.T) {
+	var fileShare storagegateway.NFSFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_nfs_file_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayNfsFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigNfsFileShareDefaults(rName, "0700", "0600", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.directory_mode", "0700"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.file_mode", "0600"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.group_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.owner_id", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigNfsFileShareDefaults(rName, "0770", "0660", 3, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.directory_mode", "0770"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.file_mode", "0660"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.group_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "nfs_file_share_defaults.0.owner_id", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayNfsFileShare_tags(t *testing.T) {
+	var fileShare storagegateway.NFSFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_nfs_file_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayNfsFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayNfsFileShareConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayNfsFileShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_nfs_file_share" {
+			continue
+		}
+
+		fileShare, err := describeStorageGatewayNfsFileShare(conn, rs.Primary.ID)
+		if isStorageGatewayFileShareNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if fileShare != nil {
+			return fmt.Errorf("Storage Gateway NFS file share (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSStorageGatewayNfsFileShareExists(n string, v *storagegateway.NFSFileShareInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Storage Gateway NFS file share ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		fileShare, err := describeStorageGatewayNfsFileShare(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if fileShare == nil {
+			return fmt.Errorf("Storage Gateway NFS file share (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *fileShare
+
+		return nil
+	}
+}
+
+// testAccAWSStorageGatewayFileShareConfigBase provides the bucket and role
+// backing a file share.
+func testAccAWSStorageGatewayFileShareConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "storagegateway.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = "${aws_iam_role.test.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayNfsFileShareConfigBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfigGatewayType(rName, "FILE_S3") + testAccAWSStorageGatewayFileShareConfigBase(rName)
+}
+
+func testAccAWSStorageGatewayNfsFileShareConfigClientList(rName, clientCidr string) string {
+	return testAccAWSStorageGatewayNfsFileShareConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_nfs_file_share" "test" {
+  client_list  = [%[1]q]
+  gateway_arn  = "${aws_storagegateway_gateway.test.arn}"
+  location_arn = "${aws_s3_bucket.test.arn}"
+  role_arn     = "${aws_iam_role.test.arn}"
+}
+`, clientCidr)
+}
+
+func testAccAWSStorageGatewayNfsFileShareConfigCacheAttributes(rName string, timeout int) string {
+	return testAccAWSStorageGatewayNfsFileShareConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_nfs_file_share" "test" {
+  client_list  = ["0.0.0.0/0"]
+  gateway_arn  = "${aws_storagegateway_gateway.test.arn}"
+  location_arn = "${aws_s3_bucket.test.arn}"
+  role_arn     = "${aws_iam_role.test.arn}"
+
+  cache_attributes {
+    cache_stale_timeout_in_seconds = %[1]d
+  }
+}
+`, timeout)
+}
+
+func testAccAWSStorageGatewayNfsFileShareConfigNfsFileShareDefaults(rName, directoryMode, fileMode string, groupID, ownerID int) string {
+	return testAccAWSStorageGatewayNfsFileShareConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_nfs_file_share" "test" {
+  client_list  = ["0.0.0.0/0"]
+  gateway_arn  = "${aws_storagegateway_gateway.test.arn}"
+  location_arn = "${aws_s3_bucket.test.arn}"
+  role_arn     = "${aws_iam_role.test.arn}"
+
+  nfs_file_share_defaults {
+    directory_mode = %[1]q
+    file_mode      = %[2]q
+    group_id       = %[3]d
+    owner_id       = %[4]d
+  }
+}
+`, directoryMode, fileMode, groupID, ownerID)
+}
+
+func testAccAWSStorageGatewayNfsFileShareConfigTags(rName, tagValue string) string {
+	return testAccAWSStorageGatewayNfsFileShareConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_nfs_file_share" "test" {
+  client_list  = ["0.0.0.0/0"]
+  gateway_arn  = "${aws_storagegateway_gateway.test.arn}"
+  location_arn = "${aws_s3_bucket.test.arn}"
+  role_arn     = "${aws_iam_role.test.arn}"
+
+  tags {
+    Key1 = %[1]q
+  }
+}
+`, tagValue)
+}
@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsStorageGatewaySmbFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewaySmbFileShareCreate,
+		Read:   resourceAwsStorageGatewaySmbFileShareRead,
+		Update: resourceAwsStorageGatewaySmbFileShareUpdate,
+		Delete: resourceAwsStorageGatewaySmbFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_based_enumeration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_user_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ActiveDirectory",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ActiveDirectory",
+					"GuestAccess",
+				}, false),
+			},
+			"cache_attributes": storageGatewayCacheAttributesSchema(),
+			"case_sensitivity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      storagegateway.CaseSensitivityClientSpecified,
+				ValidateFunc: validation.StringInSlice(storagegateway.CaseSensitivity_Values(), false),
+			},
+			"default_storage_class": storageGatewayDefaultStorageClassSchema(),
+			"file_share_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"fileshare_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"guess_mime_type_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"invalid_user_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"location_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"object_acl": storageGatewayObjectACLSchema(),
+			"oplocks_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"requester_pays": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"smb_acl_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
+			"valid_user_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewaySmbFileShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateSMBFileShareInput{
+		AccessBasedEnumeration: aws.Bool(d.Get("access_based_enumeration").(bool)),
+		AdminUserList:          expandStringSet(d.Get("admin_user_list").(*schema.Set)),
+		Authentication:         aws.String(d.Get("authentication").(string)),
+		CacheAttributes:        expandStorageGatewayCacheAttributes(d.Get("cache_attributes").([]interface{})),
+		CaseSensitivity:        aws.String(d.Get("case_sensitivity").(string)),
+		ClientToken:            aws.String(resource.UniqueId()),
+		DefaultStorageClass:    aws.String(d.Get("default_storage_class").(string)),
+		GatewayARN:             aws.String(d.Get("gateway_arn").(string)),
+		GuessMIMETypeEnabled:   aws.Bool(d.Get("guess_mime_type_enabled").(bool)),
+		InvalidUserList:        expandStringSet(d.Get("invalid_user_list").(*schema.Set)),
+		KMSEncrypted:           aws.Bool(d.Get("kms_encrypted").(bool)),
+		LocationARN:            aws.String(d.Get("location_arn").(string)),
+		ObjectACL:              aws.String(d.Get("object_acl").(string)),
+		ReadOnly:               aws.Bool(d.Get("read_only").(bool)),
+		RequesterPays:          aws.Bool(d.Get("requester_pays").(bool)),
+		Role:                   aws.String(d.Get("role_arn").(string)),
+		SMBACLEnabled:          aws.Bool(d.Get("smb_acl_enabled").(bool)),
+		Tags:                   tagsFromMapStorageGateway(d.Get("tags").(map[string]interface{})),
+		ValidUserList:          expandStringSet(d.Get("valid_user_list").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("file_share_name"); ok {
+		input.FileShareName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("oplocks_enabled"); ok {
+		input.OplocksEnabled = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway SMB file share: %s", input)
+	output, err := conn.CreateSMBFileShare(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway SMB file share: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FileShareARN))
+
+	if err := waitForStorageGatewayFileShareAvailable(d.Id(), refreshStorageGatewaySmbFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway SMB file share (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewaySmbFileShareRead(d, meta)
+}
+
+func resourceAwsStorageGatewaySmbFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	fileShare, err := describeStorageGatewaySmbFileShare(conn, d.Id())
+	if isStorageGatewayFileShareNotFound(err) {
+		log.Printf("[WARN] Storage Gateway SMB file share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway SMB file share (%s): %s", d.Id(), err)
+	}
+
+	if fileShare == nil {
+		log.Printf("[WARN] Storage Gateway SMB file share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_based_enumeration", fileShare.AccessBasedEnumeration)
+	d.Set("arn", fileShare.FileShareARN)
+	d.Set("authentication", fileShare.Authentication)
+	d.Set("case_sensitivity", fileShare.CaseSensitivity)
+	d.Set("default_storage_class", fileShare.DefaultStorageClass)
+	d.Set("file_share_name", fileShare.FileShareName)
+	d.Set("fileshare_id", fileShare.FileShareId)
+	d.Set("gateway_arn", fileShare.GatewayARN)
+	d.Set("guess_mime_type_enabled", fileShare.GuessMIMETypeEnabled)
+	d.Set("kms_encrypted", fileShare.KMSEncrypted)
+	d.Set("kms_key_arn", fileShare.KMSKey)
+	d.Set("location_arn", fileShare.LocationARN)
+	d.Set("object_acl", fileShare.ObjectACL)
+	d.Set("oplocks_enabled", fileShare.OplocksEnabled)
+	d.Set("path", fileShare.Path)
+	d.Set("read_only", fileShare.ReadOnly)
+	d.Set("requester_pays", fileShare.RequesterPays)
+	d.Set("role_arn", fileShare.Role)
+	d.Set("smb_acl_enabled", fileShare.SMBACLEnabled)
+
+	if err := d.Set("admin_user_list", schema.NewSet(schema.HashString, flattenStringList(fileShare.AdminUserList))); err != nil {
+		return fmt.Errorf("error setting admin_user_list: %s", err)
+	}
+
+	if err := d.Set("cache_attributes", flattenStorageGatewayCacheAttributes(fileShare.CacheAttributes)); err != nil {
+		return fmt.Errorf("error setting cache_attributes: %s", err)
+	}
+
+	if err := d.Set("invalid_user_list", schema.NewSet(schema.HashString, flattenStringList(fileShare.InvalidUserList))); err != nil {
+		return fmt.Errorf("error setting invalid_user_list: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapStorageGateway(fileShare.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("valid_user_list", schema.NewSet(schema.HashString, flattenStringList(fileShare.ValidUserList))); err != nil {
+		return fmt.Errorf("error setting valid_user_list: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewaySmbFileShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if d.HasChange("access_based_enumeration") || d.HasChange("admin_user_list") || d.HasChange("cache_attributes") ||
+		d.HasChange("case_sensitivity") || d.HasChange("default_storage_class") || d.HasChange("file_share_name") ||
+		d.HasChange("guess_mime_type_enabled") || d.HasChange("invalid_user_list") || d.HasChange("kms_encrypted") ||
+		d.HasChange("kms_key_arn") || d.HasChange("object_acl") || d.HasChange("oplocks_enabled") ||
+		d.HasChange("read_only") || d.HasChange("requester_pays") || d.HasChange("smb_acl_enabled") ||
+		d.HasChange("valid_user_list") {
+		input := &storagegateway.UpdateSMBFileShareInput{
+			AccessBasedEnumeration: aws.Bool(d.Get("access_based_enumeration").(bool)),
+			AdminUserList:          expandStringSet(d.Get("admin_user_list").(*schema.Set)),
+			CacheAttributes:        expandStorageGatewayCacheAttributes(d.Get("cache_attributes").([]interface{})),
+			CaseSensitivity:        aws.String(d.Get("case_sensitivity").(string)),
+			DefaultStorageClass:    aws.String(d.Get("default_storage_class").(string)),
+			FileShareARN:           aws.String(d.Id()),
+			GuessMIMETypeEnabled:   aws.Bool(d.Get("guess_mime_type_enabled").(bool)),
+			InvalidUserList:        expandStringSet(d.Get("invalid_user_list").(*schema.Set)),
+			KMSEncrypted:           aws.Bool(d.Get("kms_encrypted").(bool)),
+			ObjectACL:              aws.String(d.Get("object_acl").(string)),
+			OplocksEnabled:         aws.Bool(d.Get("oplocks_enabled").(bool)),
+			ReadOnly:               aws.Bool(d.Get("read_only").(bool)),
+			RequesterPays:          aws.Bool(d.Get("requester_pays").(bool)),
+			SMBACLEnabled:          aws.Bool(d.Get("smb_acl_enabled").(bool)),
+			ValidUserList:          expandStringSet(d.Get("valid_user_list").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("file_share_name"); ok {
+			input.FileShareName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key_arn"); ok {
+			input.KMSKey = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway SMB file share: %s", input)
+		if _, err := conn.UpdateSMBFileShare(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway SMB file share (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForStorageGatewayFileShareAvailable(d.Id(), refreshStorageGatewaySmbFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Storage Gateway SMB file share (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsStorageGateway(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Storage Gateway SMB file share (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewaySmbFileShareRead(d, meta)
+}
+
+func resourceAwsStorageGatewaySmbFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway SMB file share: %s", d.Id())
+	_, err := conn.DeleteFileShare(&storagegateway.DeleteFileShareInput{
+		FileShareARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayFileShareNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway SMB file share (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForStorageGatewayFileShareDeletion(d.Id(), refreshStorageGatewaySmbFileShareStatus(conn, d.Id()), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway SMB file share (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
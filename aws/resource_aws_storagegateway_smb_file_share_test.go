@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewaySmbFileShare_Authentication_GuestAccess(t *testing.T) {
+	var fileShare storagegateway.SMBFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_smb_file_share.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	iamResourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewaySmbFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewaySmbFileShareConfigAuthenticationGuestAccess(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "access_based_enumeration", "false"),
+					resource.TestCheckResourceAttr(resourceName, "admin_user_list.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:share/share-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication", "GuestAccess"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "case_sensitivity", storagegateway.CaseSensitivityClientSpecified),
+					resource.TestCheckResourceAttr(resourceName, "default_storage_class", "S3_STANDARD"),
+					resource.TestCheckResourceAttrSet(resourceName, "fileshare_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "guess_mime_type_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "invalid_user_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "kms_encrypted", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "location_arn", bucketResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "object_acl", storagegateway.ObjectACLPrivate),
+					resource.TestCheckResourceAttrSet(resourceName, "path"),
+					resource.TestCheckResourceAttr(resourceName, "read_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "requester_pays", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", iamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "smb_acl_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "valid_user_list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewaySmbFileShare_cacheAttributes(t *testing.T) {
+	var fileShare storagegateway.SMBFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_smb_file_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewaySmbFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewaySmbFileShareConfigCacheAttributes(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewaySmbFileShareConfigCacheAttributes(rName, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewaySmbFileShare_tags(t *testing.T) {
+	var fileShare storagegateway.SMBFileShareInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_smb_file_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewaySmbFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewaySmbFileShareConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewaySmbFileShareConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName, &fileShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewaySmbFileShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_smb_file_share" {
+			continue
+		}
+
+		fileShare, err := describeStorageGatewaySmbFileShare(conn, rs.Primary.ID)
+		if isStorageGatewayFileShareNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if fileShare != nil {
+			return fmt.Errorf("Storage Gateway SMB file share (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSStorageGatewaySmbFileShareExists(n string, v *storagegateway.SMBFileShareInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Storage Gateway SMB file share ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		fileShare, err := describeStorageGatewaySmbFileShare(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if fileShare == nil {
+			return fmt.Errorf("Storage Gateway SMB file share (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *fileShare
+
+		return nil
+	}
+}
+
+func testAccAWSStorageGatewaySmbFileShareConfigGuestAccessBase(rName string) string {
+	return testAccAWSStorageGatewayGatewayConfigSmbGuestPassword(rName, "smbguestpassword") + testAccAWSStorageGatewayFileShareConfigBase(rName)
+}
+
+func testAccAWSStorageGatewaySmbFileShareConfigAuthenticationGuestAccess(rName string) string {
+	return testAccAWSStorageGatewaySmbFileShareConfigGuestAccessBase(rName) + `
+resource "aws_storagegateway_smb_file_share" "test" {
+  authentication = "GuestAccess"
+  gateway_arn    = "${aws_storagegateway_gateway.test.arn}"
+  location_arn   = "${aws_s3_bucket.test.arn}"
+  role_arn       = "${aws_iam_role.test.arn}"
+}
+`
+}
+
+func testAccAWSStorageGatewaySmbFileShareConfigCacheAttributes(rName string, timeout int) string {
+	return testAccAWSStorageGatewaySmbFileShareConfigGuestAccessBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_smb_file_share" "test" {
+  authentication = "GuestAccess"
+  gateway_arn    = "${aws_storagegateway_gateway.test.arn}"
+  location_arn   = "${aws_s3_bucket.test.arn}"
+  role_arn       = "${aws_iam_role.test.arn}"
+
+  cache_attributes {
+    cache_stale_timeout_in_seconds = %[1]d
+  }
+}
+`, timeout)
+}
+
+func testAccAWSStorageGatewaySmbFileShareConfigTags(rName, tagValue string) string {
+	return testAccAWSStorageGatewaySmbFileShareConfigGuestAccessBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_smb_file_share" "test" {
+  authentication = "GuestAccess"
+  gateway_arn    = "${aws_storagegateway_gateway.test.arn}"
+  location_arn   = "${aws_s3_bucket.test.arn}"
+  role_arn       = "${aws_iam_role.test.arn}"
+
+  tags {
+    Key1 = %[1]q
+  }
+}
+`, tagValue)
+}
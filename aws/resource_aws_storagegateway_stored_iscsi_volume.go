@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsStorageGatewayStoredIscsiVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayStoredIscsiVolumeCreate,
+		Read:   resourceAwsStorageGatewayStoredIscsiVolumeRead,
+		Update: resourceAwsStorageGatewayStoredIscsiVolumeUpdate,
+		Delete: resourceAwsStorageGatewayStoredIscsiVolumeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chap_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"lun_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_interface_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"preserve_existing_data": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_attachment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateStorediSCSIVolumeInput{
+		DiskId:               aws.String(d.Get("disk_id").(string)),
+		GatewayARN:           aws.String(d.Get("gateway_arn").(string)),
+		NetworkInterfaceId:   aws.String(d.Get("network_interface_id").(string)),
+		PreserveExistingData: aws.Bool(d.Get("preserve_existing_data").(bool)),
+		Tags:                 tagsFromMapStorageGateway(d.Get("tags").(map[string]interface{})),
+		TargetName:           aws.String(d.Get("target_name").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_encrypted"); ok {
+		input.KMSEncrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway stored iSCSI volume: %s", input)
+	output, err := conn.CreateStorediSCSIVolume(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway stored iSCSI volume: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VolumeARN))
+
+	if err := waitForStorageGatewayStoredIscsiVolumeAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway stored iSCSI volume (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayStoredIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	volume, err := describeStorageGatewayStoredIscsiVolume(conn, d.Id())
+	if isStorageGatewayVolumeNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+		log.Printf("[WARN] Storage Gateway stored iSCSI volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway stored iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	if volume == nil {
+		log.Printf("[WARN] Storage Gateway stored iSCSI volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	gatewayARN, err := storageGatewayGatewayARNFromVolumeARN(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", volume.VolumeARN)
+	d.Set("disk_id", volume.VolumeDiskId)
+	d.Set("gateway_arn", gatewayARN)
+	d.Set("kms_encrypted", volume.KMSKey != nil)
+	d.Set("kms_key", volume.KMSKey)
+	d.Set("preserve_existing_data", volume.PreservedExistingData)
+	d.Set("snapshot_id", volume.SourceSnapshotId)
+	d.Set("target_name", volume.TargetName)
+	d.Set("volume_arn", volume.VolumeARN)
+	d.Set("volume_attachment_status", volume.VolumeAttachmentStatus)
+	d.Set("volume_id", volume.VolumeId)
+	d.Set("volume_size_in_bytes", int(aws.Int64Value(volume.VolumeSizeInBytes)))
+	d.Set("volume_status", volume.VolumeStatus)
+	d.Set("volume_type", volume.VolumeType)
+
+	if attributes := volume.VolumeiSCSIAttributes; attributes != nil {
+		d.Set("chap_enabled", attributes.ChapEnabled)
+		d.Set("lun_number", attributes.LunNumber)
+		d.Set("network_interface_id", attributes.NetworkInterfaceId)
+		d.Set("network_interface_port", attributes.NetworkInterfacePort)
+		d.Set("target_arn", attributes.TargetARN)
+	}
+
+	tags, err := listStorageGatewayTags(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing tags for Storage Gateway stored iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapStorageGateway(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if err := setTagsStorageGateway(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating Storage Gateway stored iSCSI volume (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayStoredIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway stored iSCSI volume: %s", d.Id())
+	_, err := conn.DeleteVolume(&storagegateway.DeleteVolumeInput{
+		VolumeARN: aws.String(d.Id()),
+	})
+	if isStorageGatewayVolumeNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway stored iSCSI volume (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_basic(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+	localDiskDataSourceName := "data.aws_storagegateway_local_disk.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+/volume/vol-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "chap_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_id", localDiskDataSourceName, "disk_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lun_number", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.test", "private_ip"),
+					resource.TestMatchResourceAttr(resourceName, "network_interface_port", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "preserve_existing_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "target_arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:storagegateway:[^:]+:\d{12}:gateway/sgw-.+/target/iqn.1997-05.com.amazon:%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "target_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "volume_id", regexp.MustCompile(`^vol-.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "volume_size_in_bytes", "10737418240"),
+					resource.TestCheckResourceAttr(resourceName, "volume_status", storageGatewayVolumeStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "STORED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_tags(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(n string, v *storagegateway.StorediSCSIVolume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Storage Gateway stored iSCSI volume ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		volume, err := describeStorageGatewayStoredIscsiVolume(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if volume == nil {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *volume
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_stored_iscsi_volume" {
+			continue
+		}
+
+		volume, err := describeStorageGatewayStoredIscsiVolume(conn, rs.Primary.ID)
+		if isStorageGatewayVolumeNotFound(err) || isStorageGatewayGatewayNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if volume != nil {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName string) string {
+	return testAccAWSStorageGatewayLocalDiskConfigBase(rName, "STORED") + `
+data "aws_storagegateway_local_disk" "test" {
+  disk_path   = "${aws_volume_attachment.test.device_name}"
+  gateway_arn = "${aws_storagegateway_gateway.test.arn}"
+}
+`
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigBasic(rName string) string {
+	return testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  disk_id                = "${data.aws_storagegateway_local_disk.test.id}"
+  gateway_arn            = "${aws_storagegateway_gateway.test.arn}"
+  network_interface_id   = "${aws_instance.test.private_ip}"
+  preserve_existing_data = false
+  target_name            = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigTags(rName, tagValue string) string {
+	return testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName) + fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  disk_id                = "${data.aws_storagegateway_local_disk.test.id}"
+  gateway_arn            = "${aws_storagegateway_gateway.test.arn}"
+  network_interface_id   = "${aws_instance.test.private_ip}"
+  preserve_existing_data = false
+  target_name            = %[1]q
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsStorageGatewayUploadBuffer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayUploadBufferCreate,
+		Read:   resourceAwsStorageGatewayUploadBufferRead,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayUploadBufferCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+	diskID := d.Get("disk_id").(string)
+	gatewayARN := d.Get("gateway_arn").(string)
+
+	input := &storagegateway.AddUploadBufferInput{
+		DiskIds:    []*string{aws.String(diskID)},
+		GatewayARN: aws.String(gatewayARN),
+	}
+
+	log.Printf("[DEBUG] Adding Storage Gateway upload buffer: %s", input)
+	if _, err := conn.AddUploadBuffer(input); err != nil {
+		return fmt.Errorf("error adding Storage Gateway upload buffer: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", gatewayARN, diskID))
+
+	return resourceAwsStorageGatewayUploadBufferRead(d, meta)
+}
+
+func resourceAwsStorageGatewayUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN, diskID, err := decodeStorageGatewayDiskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeUploadBuffer(&storagegateway.DescribeUploadBufferInput{
+		GatewayARN: aws.String(gatewayARN),
+	})
+	if isStorageGatewayGatewayNotFound(err) {
+		log.Printf("[WARN] Storage Gateway upload buffer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway upload buffer (%s): %s", d.Id(), err)
+	}
+
+	found := false
+	for _, v := range output.DiskIds {
+		if aws.StringValue(v) == diskID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Storage Gateway upload buffer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("disk_id", diskID)
+	d.Set("gateway_arn", gatewayARN)
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSubnet() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsEc2Ipv4IpamPoolCustomizeDiff,
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSubnetMigrateState,
 
@@ -33,11 +36,27 @@ func resourceAwsSubnet() *schema.Resource {
 			},
 
 			"cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ipv4_netmask_length"},
+			},
+
+			"ipv4_ipam_pool_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
+			"ipv4_netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(16, 28),
+				ConflictsWith: []string{"cidr_block"},
+			},
+
 			"ipv6_cidr_block": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -78,10 +97,29 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	createOpts := &ec2.CreateSubnetInput{
 		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
-		CidrBlock:        aws.String(d.Get("cidr_block").(string)),
 		VpcId:            aws.String(d.Get("vpc_id").(string)),
 	}
 
+	if v, ok := d.GetOk("cidr_block"); ok {
+		createOpts.CidrBlock = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_ipam_pool_id"); ok {
+		createOpts.Ipv4IpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_netmask_length"); ok {
+		createOpts.Ipv4NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if createOpts.CidrBlock == nil && createOpts.Ipv4IpamPoolId == nil {
+		return fmt.Errorf("Error creating subnet: one of cidr_block or ipv4_ipam_pool_id must be configured")
+	}
+
+	if createOpts.Ipv4NetmaskLength != nil && createOpts.Ipv4IpamPoolId == nil {
+		return fmt.Errorf("Error creating subnet: ipv4_netmask_length requires ipv4_ipam_pool_id")
+	}
+
 	if v, ok := d.GetOk("ipv6_cidr_block"); ok {
 		createOpts.Ipv6CidrBlock = aws.String(v.(string))
 	}
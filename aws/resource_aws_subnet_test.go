@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -164,6 +165,42 @@ func TestAccAWSSubnet_enableIpv6(t *testing.T) {
 	})
 }
 
+func TestAccAWSSubnet_ipv4IpamPool(t *testing.T) {
+	var v ec2.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_subnet.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetConfigIpv4IpamPool,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("aws_subnet.foo", &v),
+					resource.TestMatchResourceAttr("aws_subnet.foo", "cidr_block", regexp.MustCompile(`^10\.1\.\d{1,3}\.0/24$`)),
+					resource.TestCheckResourceAttrPair("aws_subnet.foo", "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.foo", "id"),
+					resource.TestCheckResourceAttr("aws_subnet.foo", "ipv4_netmask_length", "24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSubnet_ipv4NetmaskLengthWithoutIpamPool(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubnetConfigIpv4NetmaskLengthWithoutIpamPool,
+				ExpectError: regexp.MustCompile(`ipv4_netmask_length requires ipv4_ipam_pool_id`),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsSubnetIpv6BeforeUpdate(t *testing.T, subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -358,3 +395,69 @@ resource "aws_subnet" "foo" {
 	}
 }
 `
+
+const testAccSubnetConfigIpv4IpamPool = `
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-subnet-ipv4-ipam-pool"
+	}
+}
+
+resource "aws_vpc_ipam" "foo" {
+	operating_regions {
+		region_name = "${data.aws_region.current.name}"
+	}
+}
+
+// Subnet CIDRs are allocated from a resource planning pool sourced from the VPC.
+resource "aws_vpc_ipam_pool" "foo" {
+	address_family = "ipv4"
+	ipam_scope_id = "${aws_vpc_ipam.foo.private_default_scope_id}"
+	locale = "${data.aws_region.current.name}"
+
+	source_resource {
+		resource_id = "${aws_vpc.foo.id}"
+		resource_owner = "${data.aws_caller_identity.current.account_id}"
+		resource_region = "${data.aws_region.current.name}"
+		resource_type = "vpc"
+	}
+}
+
+resource "aws_vpc_ipam_pool_cidr" "foo" {
+	ipam_pool_id = "${aws_vpc_ipam_pool.foo.id}"
+	cidr = "${aws_vpc.foo.cidr_block}"
+}
+
+resource "aws_subnet" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+	ipv4_ipam_pool_id = "${aws_vpc_ipam_pool.foo.id}"
+	ipv4_netmask_length = 24
+	tags {
+		Name = "tf-acc-subnet-ipv4-ipam-pool"
+	}
+
+	depends_on = ["aws_vpc_ipam_pool_cidr.foo"]
+}
+`
+
+const testAccSubnetConfigIpv4NetmaskLengthWithoutIpamPool = `
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-subnet-ipv4-netmask-length-without-ipam-pool"
+	}
+}
+
+resource "aws_subnet" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+	ipv4_netmask_length = 24
+	tags {
+		Name = "tf-acc-subnet-ipv4-netmask-length-without-ipam-pool"
+	}
+}
+`
@@ -0,0 +1,704 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsTimestreamQueryScheduledQuery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamQueryScheduledQueryCreate,
+		Read:   resourceAwsTimestreamQueryScheduledQueryRead,
+		Update: resourceAwsTimestreamQueryScheduledQueryUpdate,
+		Delete: resourceAwsTimestreamQueryScheduledQueryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_report_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"encryption_option": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreamquery.S3EncryptionOption_Values(), false),
+									},
+									"object_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"next_invocation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"previous_invocation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"schedule_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(timestreamquery.ScheduledQueryState_Values(), false),
+			},
+			"tags": tagsSchema(),
+			"target_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestream_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dimension_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimension_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.DimensionValueType_Values(), false),
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"measure_name_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mixed_measure_mapping": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"measure_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.MeasureValueType_Values(), false),
+												},
+												"multi_measure_attribute_mapping": timestreamQueryMultiMeasureAttributeMappingSchema(false),
+												"source_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"target_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"multi_measure_mappings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"multi_measure_attribute_mapping": timestreamQueryMultiMeasureAttributeMappingSchema(true),
+												"target_multi_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"time_column": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func timestreamQueryMultiMeasureAttributeMappingSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"measure_value_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(timestreamquery.ScalarMeasureValueType_Values(), false),
+				},
+				"source_column": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"target_multi_measure_attribute_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsTimestreamQueryScheduledQueryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamqueryconn
+
+	input := &timestreamquery.CreateScheduledQueryInput{
+		ErrorReportConfiguration:       expandTimestreamQueryErrorReportConfiguration(d.Get("error_report_configuration").([]interface{})),
+		Name:                           aws.String(d.Get("name").(string)),
+		NotificationConfiguration:      expandTimestreamQueryNotificationConfiguration(d.Get("notification_configuration").([]interface{})),
+		QueryString:                    aws.String(d.Get("query_string").(string)),
+		ScheduleConfiguration:          expandTimestreamQueryScheduleConfiguration(d.Get("schedule_configuration").([]interface{})),
+		ScheduledQueryExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		Tags:                           tagsFromMapTimestreamQuery(d.Get("tags").(map[string]interface{})),
+		TargetConfiguration:            expandTimestreamQueryTargetConfiguration(d.Get("target_configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Scheduled Query: %s", input)
+	output, err := conn.CreateScheduledQuery(input)
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Scheduled Query: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	// Scheduled queries are always created enabled
+	if v, ok := d.GetOk("state"); ok && v.(string) != timestreamquery.ScheduledQueryStateEnabled {
+		if err := updateTimestreamQueryScheduledQueryState(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsTimestreamQueryScheduledQueryRead(d, meta)
+}
+
+func resourceAwsTimestreamQueryScheduledQueryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamqueryconn
+
+	output, err := conn.DescribeScheduledQuery(&timestreamquery.DescribeScheduledQueryInput{
+		ScheduledQueryArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamquery.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Timestream Scheduled Query (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	scheduledQuery := output.ScheduledQuery
+
+	d.Set("arn", scheduledQuery.Arn)
+	d.Set("execution_role_arn", scheduledQuery.ScheduledQueryExecutionRoleArn)
+	d.Set("kms_key_id", scheduledQuery.KmsKeyId)
+	d.Set("name", scheduledQuery.Name)
+	d.Set("query_string", scheduledQuery.QueryString)
+	d.Set("state", scheduledQuery.State)
+
+	if scheduledQuery.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(scheduledQuery.CreationTime).Format(time.RFC3339))
+	}
+
+	if scheduledQuery.NextInvocationTime != nil {
+		d.Set("next_invocation_time", aws.TimeValue(scheduledQuery.NextInvocationTime).Format(time.RFC3339))
+	}
+
+	if scheduledQuery.PreviousInvocationTime != nil {
+		d.Set("previous_invocation_time", aws.TimeValue(scheduledQuery.PreviousInvocationTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("error_report_configuration", flattenTimestreamQueryErrorReportConfiguration(scheduledQuery.ErrorReportConfiguration)); err != nil {
+		return fmt.Errorf("error setting error_report_configuration: %s", err)
+	}
+
+	if err := d.Set("notification_configuration", flattenTimestreamQueryNotificationConfiguration(scheduledQuery.NotificationConfiguration)); err != nil {
+		return fmt.Errorf("error setting notification_configuration: %s", err)
+	}
+
+	if err := d.Set("schedule_configuration", flattenTimestreamQueryScheduleConfiguration(scheduledQuery.ScheduleConfiguration)); err != nil {
+		return fmt.Errorf("error setting schedule_configuration: %s", err)
+	}
+
+	if err := d.Set("target_configuration", flattenTimestreamQueryTargetConfiguration(scheduledQuery.TargetConfiguration)); err != nil {
+		return fmt.Errorf("error setting target_configuration: %s", err)
+	}
+
+	tagsOut, err := conn.ListTagsForResource(&timestreamquery.ListTagsForResourceInput{
+		ResourceARN: scheduledQuery.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapTimestreamQuery(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamQueryScheduledQueryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamqueryconn
+
+	if d.HasChange("state") {
+		if err := updateTimestreamQueryScheduledQueryState(conn, d.Id(), d.Get("state").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsTimestreamQuery(conn, d, d.Id()); err != nil {
+			return fmt.Errorf("error updating Timestream Scheduled Query (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsTimestreamQueryScheduledQueryRead(d, meta)
+}
+
+func resourceAwsTimestreamQueryScheduledQueryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamqueryconn
+
+	log.Printf("[DEBUG] Deleting Timestream Scheduled Query (%s)", d.Id())
+	_, err := conn.DeleteScheduledQuery(&timestreamquery.DeleteScheduledQueryInput{
+		ScheduledQueryArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamquery.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateTimestreamQueryScheduledQueryState(conn *timestreamquery.TimestreamQuery, arn, state string) error {
+	input := &timestreamquery.UpdateScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+		State:             aws.String(state),
+	}
+
+	log.Printf("[DEBUG] Updating Timestream Scheduled Query: %s", input)
+	if _, err := conn.UpdateScheduledQuery(input); err != nil {
+		return fmt.Errorf("error updating Timestream Scheduled Query (%s) state: %s", arn, err)
+	}
+
+	return nil
+}
+
+func expandTimestreamQueryErrorReportConfiguration(l []interface{}) *timestreamquery.ErrorReportConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &timestreamquery.ErrorReportConfiguration{}
+
+	if v, ok := m["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		config.S3Configuration = &timestreamquery.S3Configuration{
+			BucketName: aws.String(s3["bucket_name"].(string)),
+		}
+
+		if v, ok := s3["encryption_option"].(string); ok && v != "" {
+			config.S3Configuration.EncryptionOption = aws.String(v)
+		}
+
+		if v, ok := s3["object_key_prefix"].(string); ok && v != "" {
+			config.S3Configuration.ObjectKeyPrefix = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+func flattenTimestreamQueryErrorReportConfiguration(config *timestreamquery.ErrorReportConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	s3 := []interface{}{}
+	if v := config.S3Configuration; v != nil {
+		s3 = append(s3, map[string]interface{}{
+			"bucket_name":       aws.StringValue(v.BucketName),
+			"encryption_option": aws.StringValue(v.EncryptionOption),
+			"object_key_prefix": aws.StringValue(v.ObjectKeyPrefix),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"s3_configuration": s3,
+		},
+	}
+}
+
+func expandTimestreamQueryNotificationConfiguration(l []interface{}) *timestreamquery.NotificationConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &timestreamquery.NotificationConfiguration{}
+
+	if v, ok := m["sns_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.SnsConfiguration = &timestreamquery.SnsConfiguration{
+			TopicArn: aws.String(v[0].(map[string]interface{})["topic_arn"].(string)),
+		}
+	}
+
+	return config
+}
+
+func flattenTimestreamQueryNotificationConfiguration(config *timestreamquery.NotificationConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	sns := []interface{}{}
+	if v := config.SnsConfiguration; v != nil {
+		sns = append(sns, map[string]interface{}{
+			"topic_arn": aws.StringValue(v.TopicArn),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"sns_configuration": sns,
+		},
+	}
+}
+
+func expandTimestreamQueryScheduleConfiguration(l []interface{}) *timestreamquery.ScheduleConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &timestreamquery.ScheduleConfiguration{
+		ScheduleExpression: aws.String(m["schedule_expression"].(string)),
+	}
+}
+
+func flattenTimestreamQueryScheduleConfiguration(config *timestreamquery.ScheduleConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"schedule_expression": aws.StringValue(config.ScheduleExpression),
+		},
+	}
+}
+
+func expandTimestreamQueryTargetConfiguration(l []interface{}) *timestreamquery.TargetConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tc, ok := l[0].(map[string]interface{})["timestream_configuration"].([]interface{})
+	if !ok || len(tc) == 0 || tc[0] == nil {
+		return nil
+	}
+
+	m := tc[0].(map[string]interface{})
+	config := &timestreamquery.TimestreamConfiguration{
+		DatabaseName: aws.String(m["database_name"].(string)),
+		TableName:    aws.String(m["table_name"].(string)),
+		TimeColumn:   aws.String(m["time_column"].(string)),
+	}
+
+	dimensionMappings := []*timestreamquery.DimensionMapping{}
+	for _, v := range m["dimension_mapping"].([]interface{}) {
+		dm := v.(map[string]interface{})
+		dimensionMappings = append(dimensionMappings, &timestreamquery.DimensionMapping{
+			DimensionValueType: aws.String(dm["dimension_value_type"].(string)),
+			Name:               aws.String(dm["name"].(string)),
+		})
+	}
+	config.DimensionMappings = dimensionMappings
+
+	if v, ok := m["measure_name_column"].(string); ok && v != "" {
+		config.MeasureNameColumn = aws.String(v)
+	}
+
+	if v, ok := m["mixed_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, raw := range v {
+			mm := raw.(map[string]interface{})
+			mapping := &timestreamquery.MixedMeasureMapping{
+				MeasureValueType: aws.String(mm["measure_value_type"].(string)),
+			}
+
+			if v, ok := mm["measure_name"].(string); ok && v != "" {
+				mapping.MeasureName = aws.String(v)
+			}
+
+			if v, ok := mm["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+				mapping.MultiMeasureAttributeMappings = expandTimestreamQueryMultiMeasureAttributeMappings(v)
+			}
+
+			if v, ok := mm["source_column"].(string); ok && v != "" {
+				mapping.SourceColumn = aws.String(v)
+			}
+
+			if v, ok := mm["target_measure_name"].(string); ok && v != "" {
+				mapping.TargetMeasureName = aws.String(v)
+			}
+
+			config.MixedMeasureMappings = append(config.MixedMeasureMappings, mapping)
+		}
+	}
+
+	if v, ok := m["multi_measure_mappings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mm := v[0].(map[string]interface{})
+		config.MultiMeasureMappings = &timestreamquery.MultiMeasureMappings{
+			MultiMeasureAttributeMappings: expandTimestreamQueryMultiMeasureAttributeMappings(mm["multi_measure_attribute_mapping"].([]interface{})),
+		}
+
+		if v, ok := mm["target_multi_measure_name"].(string); ok && v != "" {
+			config.MultiMeasureMappings.TargetMultiMeasureName = aws.String(v)
+		}
+	}
+
+	return &timestreamquery.TargetConfiguration{
+		TimestreamConfiguration: config,
+	}
+}
+
+func expandTimestreamQueryMultiMeasureAttributeMappings(l []interface{}) []*timestreamquery.MultiMeasureAttributeMapping {
+	mappings := make([]*timestreamquery.MultiMeasureAttributeMapping, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		mapping := &timestreamquery.MultiMeasureAttributeMapping{
+			MeasureValueType: aws.String(m["measure_value_type"].(string)),
+			SourceColumn:     aws.String(m["source_column"].(string)),
+		}
+
+		if v, ok := m["target_multi_measure_attribute_name"].(string); ok && v != "" {
+			mapping.TargetMultiMeasureAttributeName = aws.String(v)
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+func flattenTimestreamQueryTargetConfiguration(config *timestreamquery.TargetConfiguration) []interface{} {
+	if config == nil || config.TimestreamConfiguration == nil {
+		return []interface{}{}
+	}
+
+	tc := config.TimestreamConfiguration
+
+	dimensionMappings := make([]interface{}, 0, len(tc.DimensionMappings))
+	for _, dm := range tc.DimensionMappings {
+		dimensionMappings = append(dimensionMappings, map[string]interface{}{
+			"dimension_value_type": aws.StringValue(dm.DimensionValueType),
+			"name":                 aws.StringValue(dm.Name),
+		})
+	}
+
+	mixedMeasureMappings := make([]interface{}, 0, len(tc.MixedMeasureMappings))
+	for _, mm := range tc.MixedMeasureMappings {
+		mixedMeasureMappings = append(mixedMeasureMappings, map[string]interface{}{
+			"measure_name":                    aws.StringValue(mm.MeasureName),
+			"measure_value_type":              aws.StringValue(mm.MeasureValueType),
+			"multi_measure_attribute_mapping": flattenTimestreamQueryMultiMeasureAttributeMappings(mm.MultiMeasureAttributeMappings),
+			"source_column":                   aws.StringValue(mm.SourceColumn),
+			"target_measure_name":             aws.StringValue(mm.TargetMeasureName),
+		})
+	}
+
+	multiMeasureMappings := []interface{}{}
+	if mm := tc.MultiMeasureMappings; mm != nil {
+		multiMeasureMappings = append(multiMeasureMappings, map[string]interface{}{
+			"multi_measure_attribute_mapping": flattenTimestreamQueryMultiMeasureAttributeMappings(mm.MultiMeasureAttributeMappings),
+			"target_multi_measure_name":       aws.StringValue(mm.TargetMultiMeasureName),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"timestream_configuration": []interface{}{
+				map[string]interface{}{
+					"database_name":          aws.StringValue(tc.DatabaseName),
+					"dimension_mapping":      dimensionMappings,
+					"measure_name_column":    aws.StringValue(tc.MeasureNameColumn),
+					"mixed_measure_mapping":  mixedMeasureMappings,
+					"multi_measure_mappings": multiMeasureMappings,
+					"table_name":             aws.StringValue(tc.TableName),
+					"time_column":            aws.StringValue(tc.TimeColumn),
+				},
+			},
+		},
+	}
+}
+
+func flattenTimestreamQueryMultiMeasureAttributeMappings(mappings []*timestreamquery.MultiMeasureAttributeMapping) []interface{} {
+	l := make([]interface{}, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		l = append(l, map[string]interface{}{
+			"measure_value_type":                  aws.StringValue(mapping.MeasureValueType),
+			"source_column":                       aws.StringValue(mapping.SourceColumn),
+			"target_multi_measure_attribute_name": aws.StringValue(mapping.TargetMultiMeasureAttributeName),
+		})
+	}
+
+	return l
+}
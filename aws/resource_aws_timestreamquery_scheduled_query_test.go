@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSTimestreamQueryScheduledQuery_basic(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamQueryScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamQueryScheduledQueryConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamQueryScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:timestream:[^:]+:\d{12}:scheduled-query/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "error_report_configuration.0.s3_configuration.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.sns_configuration.0.topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.time_column", "binned_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Invocation times move with the schedule
+				ImportStateVerifyIgnore: []string{"next_invocation_time", "previous_invocation_time"},
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamQueryScheduledQuery_state(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamQueryScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamQueryScheduledQueryConfig(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamQueryScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccAWSTimestreamQueryScheduledQueryConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamQueryScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTimestreamQueryScheduledQueryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).timestreamqueryconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_timestreamquery_scheduled_query" {
+			continue
+		}
+
+		_, err := conn.DescribeScheduledQuery(&timestreamquery.DescribeScheduledQueryInput{
+			ScheduledQueryArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, timestreamquery.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Timestream Scheduled Query (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSTimestreamQueryScheduledQueryExists(n string, v *timestreamquery.ScheduledQueryDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Scheduled Query ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).timestreamqueryconn
+
+		output, err := conn.DescribeScheduledQuery(&timestreamquery.DescribeScheduledQueryInput{
+			ScheduledQueryArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output.ScheduledQuery
+
+		return nil
+	}
+}
+
+func testAccAWSTimestreamQueryScheduledQueryConfig(rName, state string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  topic_arn = "${aws_sns_topic.test.arn}"
+  protocol  = "sqs"
+  endpoint  = "${aws_sqs_queue.test.arn}"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "timestream.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "kms:Decrypt",
+        "sns:Publish",
+        "timestream:describeEndpoints",
+        "timestream:Select",
+        "timestream:SelectValues",
+        "timestream:WriteRecords",
+        "s3:PutObject"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_timestreamwrite_table" "source" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = "%[1]s-source"
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+}
+
+resource "aws_timestreamwrite_table" "target" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = "%[1]s-target"
+}
+
+resource "aws_timestreamquery_scheduled_query" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+  state              = %[2]q
+
+  query_string = <<EOF
+SELECT region, bin(time, 1h) as binned_time, avg(measure_value::double) as avg_cpu
+FROM "${aws_timestreamwrite_database.test.database_name}"."${aws_timestreamwrite_table.source.table_name}"
+WHERE measure_name = 'cpu' AND time > ago(1h)
+GROUP BY region, bin(time, 1h)
+EOF
+
+  error_report_configuration {
+    s3_configuration {
+      bucket_name = "${aws_s3_bucket.test.bucket}"
+    }
+  }
+
+  notification_configuration {
+    sns_configuration {
+      topic_arn = "${aws_sns_topic.test.arn}"
+    }
+  }
+
+  schedule_configuration {
+    schedule_expression = "rate(1 hour)"
+  }
+
+  target_configuration {
+    timestream_configuration {
+      database_name = "${aws_timestreamwrite_database.test.database_name}"
+      table_name    = "${aws_timestreamwrite_table.target.table_name}"
+      time_column   = "binned_time"
+
+      dimension_mapping {
+        name                 = "region"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "avg_cpu"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test", "aws_sns_topic_subscription.test"]
+}
+`, rName, state)
+}
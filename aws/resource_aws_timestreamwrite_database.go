@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsTimestreamWriteDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteDatabaseCreate,
+		Read:   resourceAwsTimestreamWriteDatabaseRead,
+		Update: resourceAwsTimestreamWriteDatabaseUpdate,
+		Delete: resourceAwsTimestreamWriteDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimestreamName,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"table_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	input := &timestreamwrite.CreateDatabaseInput{
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		Tags:         tagsFromMapTimestreamWrite(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Database: %s", input)
+	output, err := conn.CreateDatabase(input)
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Database: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Database.DatabaseName))
+
+	return resourceAwsTimestreamWriteDatabaseRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	output, err := conn.DescribeDatabase(&timestreamwrite.DescribeDatabaseInput{
+		DatabaseName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamwrite.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Timestream Database (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Timestream Database (%s): %s", d.Id(), err)
+	}
+
+	database := output.Database
+	arn := aws.StringValue(database.Arn)
+
+	d.Set("arn", arn)
+	d.Set("database_name", database.DatabaseName)
+	d.Set("kms_key_id", database.KmsKeyId)
+	d.Set("table_count", database.TableCount)
+
+	tagsOut, err := conn.ListTagsForResource(&timestreamwrite.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Database (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapTimestreamWrite(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChange("kms_key_id") {
+		input := &timestreamwrite.UpdateDatabaseInput{
+			DatabaseName: aws.String(d.Id()),
+			KmsKeyId:     aws.String(d.Get("kms_key_id").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Timestream Database: %s", input)
+		if _, err := conn.UpdateDatabase(input); err != nil {
+			return fmt.Errorf("error updating Timestream Database (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsTimestreamWrite(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Timestream Database (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteDatabaseRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	log.Printf("[DEBUG] Deleting Timestream Database (%s)", d.Id())
+	_, err := conn.DeleteDatabase(&timestreamwrite.DeleteDatabaseInput{
+		DatabaseName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamwrite.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Timestream Database (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsTimestreamWriteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteTableCreate,
+		Read:   resourceAwsTimestreamWriteTableRead,
+		Update: resourceAwsTimestreamWriteTableUpdate,
+		Delete: resourceAwsTimestreamWriteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimestreamName,
+			},
+			"magnetic_store_write_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"magnetic_store_rejected_data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"encryption_option": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(timestreamwrite.S3EncryptionOption_Values(), false),
+												},
+												"kms_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"object_key_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"magnetic_store_retention_period_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_store_retention_period_in_hours": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimestreamName,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	input := &timestreamwrite.CreateTableInput{
+		DatabaseName:                 aws.String(databaseName),
+		MagneticStoreWriteProperties: expandTimestreamWriteMagneticStoreWriteProperties(d.Get("magnetic_store_write_properties").([]interface{})),
+		RetentionProperties:          expandTimestreamWriteRetentionProperties(d.Get("retention_properties").([]interface{})),
+		TableName:                    aws.String(tableName),
+		Tags:                         tagsFromMapTimestreamWrite(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Table: %s", input)
+	if _, err := conn.CreateTable(input); err != nil {
+		return fmt.Errorf("error creating Timestream Table (%s): %s", tableName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", databaseName, tableName))
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	databaseName, tableName, err := decodeTimestreamWriteTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeTable(&timestreamwrite.DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamwrite.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Timestream Table (%s): %s", d.Id(), err)
+	}
+
+	table := output.Table
+	arn := aws.StringValue(table.Arn)
+
+	d.Set("arn", arn)
+	d.Set("database_name", table.DatabaseName)
+	d.Set("table_name", table.TableName)
+
+	if err := d.Set("magnetic_store_write_properties", flattenTimestreamWriteMagneticStoreWriteProperties(table.MagneticStoreWriteProperties)); err != nil {
+		return fmt.Errorf("error setting magnetic_store_write_properties: %s", err)
+	}
+
+	if err := d.Set("retention_properties", flattenTimestreamWriteRetentionProperties(table.RetentionProperties)); err != nil {
+		return fmt.Errorf("error setting retention_properties: %s", err)
+	}
+
+	tagsOut, err := conn.ListTagsForResource(&timestreamwrite.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Table (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapTimestreamWrite(tagsOut.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChange("magnetic_store_write_properties") || d.HasChange("retention_properties") {
+		input := &timestreamwrite.UpdateTableInput{
+			DatabaseName: aws.String(d.Get("database_name").(string)),
+			TableName:    aws.String(d.Get("table_name").(string)),
+		}
+
+		if d.HasChange("magnetic_store_write_properties") {
+			input.MagneticStoreWriteProperties = expandTimestreamWriteMagneticStoreWriteProperties(d.Get("magnetic_store_write_properties").([]interface{}))
+		}
+
+		if d.HasChange("retention_properties") {
+			input.RetentionProperties = expandTimestreamWriteRetentionProperties(d.Get("retention_properties").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Timestream Table: %s", input)
+		if _, err := conn.UpdateTable(input); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsTimestreamWrite(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	databaseName, tableName, err := decodeTimestreamWriteTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Timestream Table (%s)", d.Id())
+	_, err = conn.DeleteTable(&timestreamwrite.DeleteTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	})
+	if err != nil {
+		if isAWSErr(err, timestreamwrite.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Timestream Table (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeTimestreamWriteTableID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected DATABASE_NAME/TABLE_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandTimestreamWriteRetentionProperties(l []interface{}) *timestreamwrite.RetentionProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &timestreamwrite.RetentionProperties{
+		MagneticStoreRetentionPeriodInDays: aws.Int64(int64(m["magnetic_store_retention_period_in_days"].(int))),
+		MemoryStoreRetentionPeriodInHours:  aws.Int64(int64(m["memory_store_retention_period_in_hours"].(int))),
+	}
+}
+
+func flattenTimestreamWriteRetentionProperties(rp *timestreamwrite.RetentionProperties) []interface{} {
+	if rp == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"magnetic_store_retention_period_in_days": aws.Int64Value(rp.MagneticStoreRetentionPeriodInDays),
+		"memory_store_retention_period_in_hours":  aws.Int64Value(rp.MemoryStoreRetentionPeriodInHours),
+	}
+
+	return []interface{}{m}
+}
+
+func expandTimestreamWriteMagneticStoreWriteProperties(l []interface{}) *timestreamwrite.MagneticStoreWriteProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	props := &timestreamwrite.MagneticStoreWriteProperties{
+		EnableMagneticStoreWrites: aws.Bool(m["enable_magnetic_store_writes"].(bool)),
+	}
+
+	if v, ok := m["magnetic_store_rejected_data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := &timestreamwrite.MagneticStoreRejectedDataLocation{}
+
+		if s3, ok := v[0].(map[string]interface{})["s3_configuration"].([]interface{}); ok && len(s3) > 0 && s3[0] != nil {
+			location.S3Configuration = expandTimestreamWriteS3Configuration(s3[0].(map[string]interface{}))
+		}
+
+		props.MagneticStoreRejectedDataLocation = location
+	}
+
+	return props
+}
+
+func expandTimestreamWriteS3Configuration(m map[string]interface{}) *timestreamwrite.S3Configuration {
+	config := &timestreamwrite.S3Configuration{}
+
+	if v, ok := m["bucket_name"].(string); ok && v != "" {
+		config.BucketName = aws.String(v)
+	}
+
+	if v, ok := m["encryption_option"].(string); ok && v != "" {
+		config.EncryptionOption = aws.String(v)
+	}
+
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		config.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := m["object_key_prefix"].(string); ok && v != "" {
+		config.ObjectKeyPrefix = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenTimestreamWriteMagneticStoreWriteProperties(props *timestreamwrite.MagneticStoreWriteProperties) []interface{} {
+	if props == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_magnetic_store_writes":          aws.BoolValue(props.EnableMagneticStoreWrites),
+		"magnetic_store_rejected_data_location": []interface{}{},
+	}
+
+	if location := props.MagneticStoreRejectedDataLocation; location != nil {
+		s3 := []interface{}{}
+
+		if config := location.S3Configuration; config != nil {
+			s3 = append(s3, map[string]interface{}{
+				"bucket_name":       aws.StringValue(config.BucketName),
+				"encryption_option": aws.StringValue(config.EncryptionOption),
+				"kms_key_id":        aws.StringValue(config.KmsKeyId),
+				"object_key_prefix": aws.StringValue(config.ObjectKeyPrefix),
+			})
+		}
+
+		m["magnetic_store_rejected_data_location"] = []interface{}{
+			map[string]interface{}{
+				"s3_configuration": s3,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSTimestreamWriteTable_basic(t *testing.T) {
+	var table timestreamwrite.Table
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:timestream:[^:]+:\d{12}:database/.+/table/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.enable_magnetic_store_writes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_retentionProperties(t *testing.T) {
+	var table timestreamwrite.Table
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigRetentionProperties(rName, 30, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.magnetic_store_retention_period_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.memory_store_retention_period_in_hours", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTimestreamWriteTableConfigRetentionProperties(rName, 300, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.magnetic_store_retention_period_in_days", "300"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.memory_store_retention_period_in_hours", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_magneticStoreWriteProperties(t *testing.T) {
+	var table timestreamwrite.Table
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigMagneticStoreWriteProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.enable_magnetic_store_writes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.0.s3_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.0.s3_configuration.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.0.s3_configuration.0.encryption_option", "SSE_S3"),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.0.s3_configuration.0.object_key_prefix", "rejected/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTimestreamWriteTableConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_tags(t *testing.T) {
+	var table timestreamwrite.Table
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSTimestreamWriteTableConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTimestreamWriteTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_timestreamwrite_table" {
+			continue
+		}
+
+		databaseName, tableName, err := decodeTimestreamWriteTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeTable(&timestreamwrite.DescribeTableInput{
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		})
+		if isAWSErr(err, timestreamwrite.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Timestream Table (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSTimestreamWriteTableExists(n string, v *timestreamwrite.Table) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Table ID is set")
+		}
+
+		databaseName, tableName, err := decodeTimestreamWriteTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+
+		output, err := conn.DescribeTable(&timestreamwrite.DescribeTableInput{
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output.Table
+
+		return nil
+	}
+}
+
+func testAccAWSTimestreamWriteTableConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSTimestreamWriteTableConfig(rName string) string {
+	return testAccAWSTimestreamWriteTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSTimestreamWriteTableConfigRetentionProperties(rName string, magneticDays, memoryHours int) string {
+	return testAccAWSTimestreamWriteTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = %[1]q
+
+  retention_properties {
+    magnetic_store_retention_period_in_days = %[2]d
+    memory_store_retention_period_in_hours  = %[3]d
+  }
+}
+`, rName, magneticDays, memoryHours)
+}
+
+func testAccAWSTimestreamWriteTableConfigMagneticStoreWriteProperties(rName string) string {
+	return testAccAWSTimestreamWriteTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_timestreamwrite_table" "test" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+
+    magnetic_store_rejected_data_location {
+      s3_configuration {
+        bucket_name       = "${aws_s3_bucket.test.bucket}"
+        encryption_option = "SSE_S3"
+        object_key_prefix = "rejected/"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSTimestreamWriteTableConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSTimestreamWriteTableConfigBase(rName) + fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = "${aws_timestreamwrite_database.test.database_name}"
+  table_name    = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
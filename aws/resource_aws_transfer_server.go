@@ -0,0 +1,590 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsTransferServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTransferServerCreate,
+		Read:   resourceAwsTransferServerRead,
+		Update: resourceAwsTransferServerUpdate,
+		Delete: resourceAwsTransferServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      transfer.DomainS3,
+				ValidateFunc: validation.StringInSlice(transfer.Domain_Values(), false),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_allocation_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_endpoint_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      transfer.EndpointTypePublic,
+				ValidateFunc: validation.StringInSlice(transfer.EndpointType_Values(), false),
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"function": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"host_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"host_key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      transfer.IdentityProviderTypeServiceManaged,
+				ValidateFunc: validation.StringInSlice(transfer.IdentityProviderType_Values(), false),
+			},
+			"invocation_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"logging_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"post_authentication_login_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"pre_authentication_login_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"protocol_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"passive_ip": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"set_stat_option": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(transfer.SetStatOption_Values(), false),
+						},
+						"tls_session_resumption_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(transfer.TlsSessionResumptionMode_Values(), false),
+						},
+					},
+				},
+			},
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+				},
+			},
+			"security_policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "TransferSecurityPolicy-2018-11",
+			},
+			"tags": tagsSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"workflow_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_partial_upload": transferWorkflowDetailSchema(),
+						"on_upload":         transferWorkflowDetailSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func transferWorkflowDetailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"execution_role": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"workflow_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	input := &transfer.CreateServerInput{
+		Domain:                  aws.String(d.Get("domain").(string)),
+		EndpointType:            aws.String(d.Get("endpoint_type").(string)),
+		IdentityProviderDetails: expandTransferIdentityProviderDetails(d),
+		IdentityProviderType:    aws.String(d.Get("identity_provider_type").(string)),
+		SecurityPolicyName:      aws.String(d.Get("security_policy_name").(string)),
+		Tags:                    tagsFromMapTransfer(d.Get("tags").(map[string]interface{})),
+	}
+
+	if len(input.Tags) == 0 {
+		input.Tags = nil
+	}
+
+	if v, ok := d.GetOk("certificate"); ok {
+		input.Certificate = aws.String(v.(string))
+	}
+
+	// Elastic IPs can only be associated once the server is stopped, so they
+	// are attached after creation.
+	var addressAllocationIDs []*string
+	if v, ok := d.GetOk("endpoint_details"); ok {
+		input.EndpointDetails = expandTransferEndpointDetails(v.([]interface{}))
+
+		if input.EndpointDetails != nil && len(input.EndpointDetails.AddressAllocationIds) > 0 {
+			addressAllocationIDs = input.EndpointDetails.AddressAllocationIds
+			input.EndpointDetails.AddressAllocationIds = nil
+		}
+	}
+
+	if v, ok := d.GetOk("host_key"); ok {
+		input.HostKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_role"); ok {
+		input.LoggingRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("post_authentication_login_banner"); ok {
+		input.PostAuthenticationLoginBanner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pre_authentication_login_banner"); ok {
+		input.PreAuthenticationLoginBanner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("protocol_details"); ok {
+		input.ProtocolDetails = expandTransferProtocolDetails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("protocols"); ok {
+		input.Protocols = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("workflow_details"); ok {
+		input.WorkflowDetails = expandTransferWorkflowDetails(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Transfer server: %s", input)
+
+	// The logging and invocation roles may not have propagated yet.
+	var output *transfer.CreateServerOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateServer(input)
+
+		if isAWSErr(err, transfer.ErrCodeInvalidRequestException, "Unable to assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Transfer server: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ServerId))
+
+	if err := waitForTransferServerState(conn, d.Id(), []string{transfer.StateStarting}, []string{transfer.StateOnline}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Transfer server (%s) creation: %s", d.Id(), err)
+	}
+
+	if len(addressAllocationIDs) > 0 {
+		if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+
+		_, err := conn.UpdateServer(&transfer.UpdateServerInput{
+			EndpointDetails: &transfer.EndpointDetails{
+				AddressAllocationIds: addressAllocationIDs,
+			},
+			ServerId: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error associating Elastic IPs with Transfer server (%s): %s", d.Id(), err)
+		}
+
+		if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsTransferServerRead(d, meta)
+}
+
+func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	server, err := describeTransferServer(conn, d.Id())
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Transfer server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Transfer server (%s): %s", d.Id(), err)
+	}
+
+	if server == nil {
+		log.Printf("[WARN] Transfer server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", server.Arn)
+	d.Set("certificate", server.Certificate)
+	d.Set("domain", server.Domain)
+	d.Set("endpoint", fmt.Sprintf("%s.server.transfer.%s.amazonaws.com", d.Id(), meta.(*AWSClient).region))
+	d.Set("endpoint_type", server.EndpointType)
+	d.Set("host_key_fingerprint", server.HostKeyFingerprint)
+	d.Set("identity_provider_type", server.IdentityProviderType)
+	d.Set("logging_role", server.LoggingRole)
+	d.Set("post_authentication_login_banner", server.PostAuthenticationLoginBanner)
+	d.Set("pre_authentication_login_banner", server.PreAuthenticationLoginBanner)
+	d.Set("security_policy_name", server.SecurityPolicyName)
+
+	if details := server.IdentityProviderDetails; details != nil {
+		d.Set("directory_id", details.DirectoryId)
+		d.Set("function", details.Function)
+		d.Set("invocation_role", details.InvocationRole)
+		d.Set("url", details.Url)
+	} else {
+		d.Set("directory_id", "")
+		d.Set("function", "")
+		d.Set("invocation_role", "")
+		d.Set("url", "")
+	}
+
+	// Endpoint details are only meaningful for VPC endpoints; the API echoes
+	// an empty structure for public servers.
+	endpointDetails := server.EndpointDetails
+	if aws.StringValue(server.EndpointType) == transfer.EndpointTypePublic {
+		endpointDetails = nil
+	}
+	if err := d.Set("endpoint_details", flattenTransferEndpointDetails(endpointDetails)); err != nil {
+		return fmt.Errorf("error setting endpoint_details: %s", err)
+	}
+
+	if err := d.Set("protocol_details", flattenTransferProtocolDetails(server.ProtocolDetails)); err != nil {
+		return fmt.Errorf("error setting protocol_details: %s", err)
+	}
+
+	if err := d.Set("protocols", flattenStringList(server.Protocols)); err != nil {
+		return fmt.Errorf("error setting protocols: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapTransfer(server.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("workflow_details", flattenTransferWorkflowDetails(server.WorkflowDetails)); err != nil {
+		return fmt.Errorf("error setting workflow_details: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	if d.HasChange("certificate") || d.HasChange("endpoint_details") || d.HasChange("endpoint_type") ||
+		d.HasChange("function") || d.HasChange("host_key") || d.HasChange("invocation_role") ||
+		d.HasChange("logging_role") || d.HasChange("post_authentication_login_banner") ||
+		d.HasChange("pre_authentication_login_banner") || d.HasChange("protocol_details") ||
+		d.HasChange("protocols") || d.HasChange("security_policy_name") || d.HasChange("url") ||
+		d.HasChange("workflow_details") {
+		input := &transfer.UpdateServerInput{
+			ServerId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("certificate") {
+			input.Certificate = aws.String(d.Get("certificate").(string))
+		}
+
+		// Changing the Elastic IPs requires the server to be offline.
+		offlineUpdate := false
+		if d.HasChange("endpoint_details") || d.HasChange("endpoint_type") {
+			input.EndpointType = aws.String(d.Get("endpoint_type").(string))
+			input.EndpointDetails = expandTransferEndpointDetails(d.Get("endpoint_details").([]interface{}))
+
+			if d.HasChange("endpoint_details.0.address_allocation_ids") {
+				offlineUpdate = true
+			}
+		}
+
+		if d.HasChange("function") || d.HasChange("invocation_role") || d.HasChange("url") {
+			input.IdentityProviderDetails = expandTransferIdentityProviderDetails(d)
+		}
+
+		if d.HasChange("host_key") {
+			input.HostKey = aws.String(d.Get("host_key").(string))
+		}
+
+		if d.HasChange("logging_role") {
+			input.LoggingRole = aws.String(d.Get("logging_role").(string))
+		}
+
+		if d.HasChange("post_authentication_login_banner") {
+			input.PostAuthenticationLoginBanner = aws.String(d.Get("post_authentication_login_banner").(string))
+		}
+
+		if d.HasChange("pre_authentication_login_banner") {
+			input.PreAuthenticationLoginBanner = aws.String(d.Get("pre_authentication_login_banner").(string))
+		}
+
+		if d.HasChange("protocol_details") {
+			input.ProtocolDetails = expandTransferProtocolDetails(d.Get("protocol_details").([]interface{}))
+		}
+
+		if d.HasChange("protocols") {
+			input.Protocols = expandStringSet(d.Get("protocols").(*schema.Set))
+		}
+
+		if d.HasChange("security_policy_name") {
+			input.SecurityPolicyName = aws.String(d.Get("security_policy_name").(string))
+		}
+
+		if d.HasChange("workflow_details") {
+			input.WorkflowDetails = expandTransferWorkflowDetails(d.Get("workflow_details").([]interface{}))
+		}
+
+		if offlineUpdate {
+			if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Transfer server: %s", d.Id())
+		if _, err := conn.UpdateServer(input); err != nil {
+			return fmt.Errorf("error updating Transfer server (%s): %s", d.Id(), err)
+		}
+
+		if offlineUpdate {
+			if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := setTagsTransfer(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Transfer server (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsTransferServerRead(d, meta)
+}
+
+func resourceAwsTransferServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	if d.Get("force_destroy").(bool) && d.Get("identity_provider_type").(string) == transfer.IdentityProviderTypeServiceManaged {
+		if err := deleteTransferServerUsers(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Transfer server: %s", d.Id())
+	_, err := conn.DeleteServer(&transfer.DeleteServerInput{
+		ServerId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Transfer server (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForTransferServerDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Transfer server (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteTransferServerUsers(conn *transfer.Transfer, serverID string) error {
+	var userNames []*string
+	input := &transfer.ListUsersInput{
+		ServerId: aws.String(serverID),
+	}
+
+	err := conn.ListUsersPages(input, func(page *transfer.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			userNames = append(userNames, user.UserName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Transfer server (%s) users: %s", serverID, err)
+	}
+
+	for _, userName := range userNames {
+		log.Printf("[DEBUG] Deleting Transfer user: %s/%s", serverID, aws.StringValue(userName))
+		_, err := conn.DeleteUser(&transfer.DeleteUserInput{
+			ServerId: aws.String(serverID),
+			UserName: userName,
+		})
+		if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting Transfer user (%s/%s): %s", serverID, aws.StringValue(userName), err)
+		}
+	}
+
+	return nil
+}
+
+func expandTransferIdentityProviderDetails(d *schema.ResourceData) *transfer.IdentityProviderDetails {
+	details := &transfer.IdentityProviderDetails{}
+	empty := true
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		details.DirectoryId = aws.String(v.(string))
+		empty = false
+	}
+
+	if v, ok := d.GetOk("function"); ok {
+		details.Function = aws.String(v.(string))
+		empty = false
+	}
+
+	if v, ok := d.GetOk("invocation_role"); ok {
+		details.InvocationRole = aws.String(v.(string))
+		empty = false
+	}
+
+	if v, ok := d.GetOk("url"); ok {
+		details.Url = aws.String(v.(string))
+		empty = false
+	}
+
+	if empty {
+		return nil
+	}
+
+	return details
+}
@@ -0,0 +1,473 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSTransferServer_basic(t *testing.T) {
+	var server transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:transfer:[^:]+:\d{12}:server/s-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "domain", "S3"),
+					resource.TestMatchResourceAttr(resourceName, "endpoint", regexp.MustCompile(`^s-.+\.server\.transfer\..+\.amazonaws\.com$`)),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "PUBLIC"),
+					resource.TestCheckResourceAttrSet(resourceName, "host_key_fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "SERVICE_MANAGED"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_policy_name", "TransferSecurityPolicy-2018-11"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferServer_apiGateway(t *testing.T) {
+	var server transfer.DescribedServer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfigApiGateway(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "API_GATEWAY"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocation_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferServer_directoryService(t *testing.T) {
+	var server transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfigDirectoryService(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "AWS_DIRECTORY_SERVICE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferServer_vpcAddressAllocationIds(t *testing.T) {
+	var server transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfigVpcAddressAllocationIds("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "VPC"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.address_allocation_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_details.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccAWSTransferServerConfigVpcAddressAllocationIds("test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.address_allocation_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferServer_forceDestroy(t *testing.T) {
+	var server transfer.DescribedServer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfigForceDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					testAccCheckAWSTransferServerAddUser(&server, rName),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferServer_tags(t *testing.T) {
+	var server transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerConfigTags("value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSTransferServerConfigTags("value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_server" {
+			continue
+		}
+
+		_, err := describeTransferServer(conn, rs.Primary.ID)
+		if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transfer server (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSTransferServerExists(n string, v *transfer.DescribedServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer server ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+		server, err := describeTransferServer(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if server == nil {
+			return fmt.Errorf("Transfer server (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *server
+
+		return nil
+	}
+}
+
+// testAccCheckAWSTransferServerAddUser creates a user outside of Terraform so
+// that force_destroy has something to clean up.
+func testAccCheckAWSTransferServerAddUser(server *transfer.DescribedServer, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+		rs, ok := s.RootModule().Resources["aws_iam_role.test"]
+		if !ok {
+			return fmt.Errorf("Not found: aws_iam_role.test")
+		}
+
+		_, err := conn.CreateUser(&transfer.CreateUserInput{
+			Role:     aws.String(rs.Primary.Attributes["arn"]),
+			ServerId: server.ServerId,
+			UserName: aws.String(rName),
+		})
+
+		return err
+	}
+}
+
+const testAccAWSTransferServerConfig = `
+resource "aws_transfer_server" "test" {}
+`
+
+func testAccAWSTransferServerConfigTags(tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  tags {
+    Key1 = %[1]q
+  }
+}
+`, tagValue)
+}
+
+func testAccAWSTransferServerConfigRoleBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "execute-api:Invoke",
+        "apigateway:GET",
+        "s3:*"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccAWSTransferServerConfigForceDestroy(rName string) string {
+	return testAccAWSTransferServerConfigRoleBase(rName) + `
+resource "aws_transfer_server" "test" {
+  force_destroy = true
+}
+`
+}
+
+func testAccAWSTransferServerConfigApiGateway(rName string) string {
+	return testAccAWSTransferServerConfigRoleBase(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "test" {
+  parent_id   = "${aws_api_gateway_rest_api.test.root_resource_id}"
+  path_part   = "test"
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+}
+
+resource "aws_api_gateway_method" "test" {
+  authorization = "NONE"
+  http_method   = "GET"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+}
+
+resource "aws_api_gateway_integration" "test" {
+  http_method = "${aws_api_gateway_method.test.http_method}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  type        = "MOCK"
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  depends_on = ["aws_api_gateway_integration.test"]
+
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  stage_name  = "test"
+}
+
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "API_GATEWAY"
+  invocation_role        = "${aws_iam_role.test.arn}"
+  url                    = "${aws_api_gateway_deployment.test.invoke_url}"
+}
+`, rName)
+}
+
+func testAccAWSTransferServerConfigDirectoryService() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf-acc-transfer-server-directory-service"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.0.1.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-transfer-server-directory-service"
+  }
+}
+
+resource "aws_subnet" "test2" {
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+  cidr_block        = "10.0.2.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-transfer-server-directory-service"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+
+resource "aws_transfer_server" "test" {
+  directory_id           = "${aws_directory_service_directory.test.id}"
+  identity_provider_type = "AWS_DIRECTORY_SERVICE"
+}
+`
+}
+
+func testAccAWSTransferServerConfigVpcAddressAllocationIds(eipName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf-acc-transfer-server-vpc"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-transfer-server-vpc"
+  }
+}
+
+resource "aws_eip" "test1" {
+  vpc        = true
+  depends_on = ["aws_internet_gateway.test"]
+}
+
+resource "aws_eip" "test2" {
+  vpc        = true
+  depends_on = ["aws_internet_gateway.test"]
+}
+
+resource "aws_transfer_server" "test" {
+  endpoint_type = "VPC"
+
+  endpoint_details {
+    address_allocation_ids = ["${aws_eip.%[1]s.id}"]
+    subnet_ids             = ["${aws_subnet.test.id}"]
+    vpc_id                 = "${aws_vpc.test.id}"
+  }
+}
+`, eipName)
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsTransferSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTransferSshKeyCreate,
+		Read:   resourceAwsTransferSshKeyRead,
+		Delete: resourceAwsTransferSshKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: transferSshKeyBodyDiffSuppress,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ssh_public_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsTransferSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+
+	input := &transfer.ImportSshPublicKeyInput{
+		ServerId:         aws.String(serverID),
+		SshPublicKeyBody: aws.String(d.Get("body").(string)),
+		UserName:         aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Importing Transfer SSH public key: %s", input)
+	output, err := conn.ImportSshPublicKey(input)
+	if err != nil {
+		return fmt.Errorf("error importing Transfer SSH public key: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", serverID, userName, aws.StringValue(output.SshPublicKeyId)))
+
+	return resourceAwsTransferSshKeyRead(d, meta)
+}
+
+func resourceAwsTransferSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	serverID, userName, keyID, err := decodeTransferSshKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeUser(&transfer.DescribeUserInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	})
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Transfer SSH public key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Transfer user (%s/%s): %s", serverID, userName, err)
+	}
+
+	var key *transfer.SshPublicKey
+	if output.User != nil {
+		for _, k := range output.User.SshPublicKeys {
+			if aws.StringValue(k.SshPublicKeyId) == keyID {
+				key = k
+				break
+			}
+		}
+	}
+
+	if key == nil {
+		log.Printf("[WARN] Transfer SSH public key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("body", key.SshPublicKeyBody)
+	d.Set("server_id", serverID)
+	d.Set("ssh_public_key_id", key.SshPublicKeyId)
+	d.Set("user_name", userName)
+
+	return nil
+}
+
+func resourceAwsTransferSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	serverID, userName, keyID, err := decodeTransferSshKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Transfer SSH public key: %s", d.Id())
+	_, err = conn.DeleteSshPublicKey(&transfer.DeleteSshPublicKeyInput{
+		ServerId:       aws.String(serverID),
+		SshPublicKeyId: aws.String(keyID),
+		UserName:       aws.String(userName),
+	})
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Transfer SSH public key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSTransferSshKey_basic(t *testing.T) {
+	var key transfer.SshPublicKey
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_ssh_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferSshKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferSshKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferSshKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ssh_public_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_transfer_user.test", "user_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTransferSshKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_ssh_key" {
+			continue
+		}
+
+		key, err := testAccAWSTransferSshKeyFind(conn, rs.Primary.ID)
+		if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if key != nil {
+			return fmt.Errorf("Transfer SSH public key (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSTransferSshKeyExists(n string, v *transfer.SshPublicKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer SSH public key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+		key, err := testAccAWSTransferSshKeyFind(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return fmt.Errorf("Transfer SSH public key (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *key
+
+		return nil
+	}
+}
+
+func testAccAWSTransferSshKeyFind(conn *transfer.Transfer, id string) (*transfer.SshPublicKey, error) {
+	serverID, userName, keyID, err := decodeTransferSshKeyID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.DescribeUser(&transfer.DescribeUserInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range output.User.SshPublicKeys {
+		if aws.StringValue(key.SshPublicKeyId) == keyID {
+			return key, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func testAccAWSTransferSshKeyConfig(rName string) string {
+	return testAccAWSTransferUserConfigBase(rName) + fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  role      = "${aws_iam_role.test.arn}"
+  server_id = "${aws_transfer_server.test.id}"
+  user_name = %[1]q
+}
+
+resource "aws_transfer_ssh_key" "test" {
+  body      = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 tf-acc-test"
+  server_id = "${aws_transfer_server.test.id}"
+  user_name = "${aws_transfer_user.test.user_name}"
+}
+`, rName)
+}
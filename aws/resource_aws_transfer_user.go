@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsTransferUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTransferUserCreate,
+		Read:   resourceAwsTransferUserRead,
+		Update: resourceAwsTransferUserUpdate,
+		Delete: resourceAwsTransferUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"home_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"home_directory_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entry": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			"home_directory_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      transfer.HomeDirectoryTypePath,
+				ValidateFunc: validation.StringInSlice(transfer.HomeDirectoryType_Values(), false),
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"posix_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gid": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"secondary_gids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"uid": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[\w][\w@.-]{2,99}$`),
+					"must be 3 to 100 characters, start with a letter, digit or underscore and contain only letters, digits, underscores, hyphens, periods or at signs",
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+
+	input := &transfer.CreateUserInput{
+		HomeDirectoryType: aws.String(d.Get("home_directory_type").(string)),
+		Role:              aws.String(d.Get("role").(string)),
+		ServerId:          aws.String(serverID),
+		Tags:              tagsFromMapTransfer(d.Get("tags").(map[string]interface{})),
+		UserName:          aws.String(userName),
+	}
+
+	if len(input.Tags) == 0 {
+		input.Tags = nil
+	}
+
+	if v, ok := d.GetOk("home_directory"); ok {
+		input.HomeDirectory = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("home_directory_mappings"); ok {
+		input.HomeDirectoryMappings = expandTransferHomeDirectoryMappings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		input.Policy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("posix_profile"); ok {
+		input.PosixProfile = expandTransferPosixProfile(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Transfer user: %s", input)
+
+	// The user's role may not have propagated yet.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateUser(input)
+
+		if isAWSErr(err, transfer.ErrCodeInvalidRequestException, "Unable to assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Transfer user: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serverID, userName))
+
+	return resourceAwsTransferUserRead(d, meta)
+}
+
+func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	serverID, userName, err := decodeTransferUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeUser(&transfer.DescribeUserInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	})
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Transfer user (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Transfer user (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.User == nil {
+		log.Printf("[WARN] Transfer user (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	user := output.User
+
+	d.Set("arn", user.Arn)
+	d.Set("home_directory", user.HomeDirectory)
+	d.Set("home_directory_type", user.HomeDirectoryType)
+	d.Set("policy", user.Policy)
+	d.Set("role", user.Role)
+	d.Set("server_id", serverID)
+	d.Set("user_name", user.UserName)
+
+	if err := d.Set("home_directory_mappings", flattenTransferHomeDirectoryMappings(user.HomeDirectoryMappings)); err != nil {
+		return fmt.Errorf("error setting home_directory_mappings: %s", err)
+	}
+
+	if err := d.Set("posix_profile", flattenTransferPosixProfile(user.PosixProfile)); err != nil {
+		return fmt.Errorf("error setting posix_profile: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapTransfer(user.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	serverID, userName, err := decodeTransferUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("home_directory") || d.HasChange("home_directory_mappings") || d.HasChange("home_directory_type") ||
+		d.HasChange("policy") || d.HasChange("posix_profile") || d.HasChange("role") {
+		input := &transfer.UpdateUserInput{
+			ServerId: aws.String(serverID),
+			UserName: aws.String(userName),
+		}
+
+		if d.HasChange("home_directory") {
+			input.HomeDirectory = aws.String(d.Get("home_directory").(string))
+		}
+
+		if d.HasChange("home_directory_mappings") {
+			if mappings := expandTransferHomeDirectoryMappings(d.Get("home_directory_mappings").([]interface{})); len(mappings) > 0 {
+				input.HomeDirectoryMappings = mappings
+			}
+		}
+
+		if d.HasChange("home_directory_type") {
+			input.HomeDirectoryType = aws.String(d.Get("home_directory_type").(string))
+		}
+
+		if d.HasChange("policy") {
+			input.Policy = aws.String(d.Get("policy").(string))
+		}
+
+		if d.HasChange("posix_profile") {
+			input.PosixProfile = expandTransferPosixProfile(d.Get("posix_profile").([]interface{}))
+		}
+
+		if d.HasChange("role") {
+			input.Role = aws.String(d.Get("role").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Transfer user: %s", input)
+		if _, err := conn.UpdateUser(input); err != nil {
+			return fmt.Errorf("error updating Transfer user (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsTransfer(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Transfer user (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsTransferUserRead(d, meta)
+}
+
+func resourceAwsTransferUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+
+	serverID, userName, err := decodeTransferUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Transfer user: %s", d.Id())
+	_, err = conn.DeleteUser(&transfer.DeleteUserInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	})
+	if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Transfer user (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSTransferUser_basic(t *testing.T) {
+	var user transfer.DescribedUser
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferUserConfig(rName, "/home"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:transfer:[^:]+:\d{12}:user/s-.+/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "home_directory", "/home"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_type", "PATH"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTransferUserConfig(rName, "/home/tftest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "home_directory", "/home/tftest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferUser_homeDirectoryMappings(t *testing.T) {
+	var user transfer.DescribedUser
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferUserConfigHomeDirectoryMappings(rName, "/your-personal-report.pdf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_type", "LOGICAL"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.entry", "/your-personal-report.pdf"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.target", "/bucket3/customized-reports/tftestuser.pdf"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTransferUserConfigHomeDirectoryMappings(rName, "/my-report.pdf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.entry", "/my-report.pdf"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferUser_posixProfile(t *testing.T) {
+	var user transfer.DescribedUser
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferUserConfigPosixProfile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.gid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.secondary_gids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.uid", "1000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSTransferUser_tags(t *testing.T) {
+	var user transfer.DescribedUser
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferUserConfigTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSTransferUserConfigTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTransferUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_user" {
+			continue
+		}
+
+		serverID, userName, err := decodeTransferUserID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeUser(&transfer.DescribeUserInput{
+			ServerId: aws.String(serverID),
+			UserName: aws.String(userName),
+		})
+		if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transfer user (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSTransferUserExists(n string, v *transfer.DescribedUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer user ID is set")
+		}
+
+		serverID, userName, err := decodeTransferUserID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+		output, err := conn.DescribeUser(&transfer.DescribeUserInput{
+			ServerId: aws.String(serverID),
+			UserName: aws.String(userName),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output.User
+
+		return nil
+	}
+}
+
+func testAccAWSTransferUserConfigBase(rName string) string {
+	return testAccAWSTransferServerConfigRoleBase(rName) + `
+resource "aws_transfer_server" "test" {
+  force_destroy = true
+}
+`
+}
+
+func testAccAWSTransferUserConfig(rName, homeDirectory string) string {
+	return testAccAWSTransferUserConfigBase(rName) + fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  home_directory = %[2]q
+  role           = "${aws_iam_role.test.arn}"
+  server_id      = "${aws_transfer_server.test.id}"
+  user_name      = %[1]q
+}
+`, rName, homeDirectory)
+}
+
+func testAccAWSTransferUserConfigHomeDirectoryMappings(rName, entry string) string {
+	return testAccAWSTransferUserConfigBase(rName) + fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  home_directory_type = "LOGICAL"
+  role                = "${aws_iam_role.test.arn}"
+  server_id           = "${aws_transfer_server.test.id}"
+  user_name           = %[1]q
+
+  home_directory_mappings {
+    entry  = %[2]q
+    target = "/bucket3/customized-reports/tftestuser.pdf"
+  }
+}
+`, rName, entry)
+}
+
+func testAccAWSTransferUserConfigPosixProfile(rName string) string {
+	return testAccAWSTransferServerConfigRoleBase(rName) + fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  domain        = "EFS"
+  force_destroy = true
+}
+
+resource "aws_transfer_user" "test" {
+  role      = "${aws_iam_role.test.arn}"
+  server_id = "${aws_transfer_server.test.id}"
+  user_name = %[1]q
+
+  posix_profile {
+    gid            = 1000
+    secondary_gids = [2000]
+    uid            = 1000
+  }
+}
+`, rName)
+}
+
+func testAccAWSTransferUserConfigTags(rName, tagValue string) string {
+	return testAccAWSTransferUserConfigBase(rName) + fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  role      = "${aws_iam_role.test.arn}"
+  server_id = "${aws_transfer_server.test.id}"
+  user_name = %[1]q
+
+  tags {
+    Key1 = %[2]q
+  }
+}
+`, rName, tagValue)
+}
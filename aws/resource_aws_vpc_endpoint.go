@@ -38,6 +38,7 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Default:  ec2.VpcEndpointTypeGateway,
 				ValidateFunc: validation.StringInSlice([]string{
 					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeGatewayLoadBalancer,
 					ec2.VpcEndpointTypeInterface,
 				}, false),
 			},
@@ -130,14 +131,22 @@ func resourceAwsVpcEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		d.Get("security_group_ids").(*schema.Set).Len() == 0 {
 		return errors.New("An Interface VPC Endpoint must always have at least one Security Group")
 	}
+	if d.Get("vpc_endpoint_type").(string) == ec2.VpcEndpointTypeGatewayLoadBalancer &&
+		d.Get("subnet_ids").(*schema.Set).Len() != 1 {
+		return errors.New("A GatewayLoadBalancer VPC Endpoint must have exactly one Subnet")
+	}
 
 	conn := meta.(*AWSClient).ec2conn
 
 	req := &ec2.CreateVpcEndpointInput{
-		VpcId:             aws.String(d.Get("vpc_id").(string)),
-		VpcEndpointType:   aws.String(d.Get("vpc_endpoint_type").(string)),
-		ServiceName:       aws.String(d.Get("service_name").(string)),
-		PrivateDnsEnabled: aws.Bool(d.Get("private_dns_enabled").(bool)),
+		VpcId:           aws.String(d.Get("vpc_id").(string)),
+		VpcEndpointType: aws.String(d.Get("vpc_endpoint_type").(string)),
+		ServiceName:     aws.String(d.Get("service_name").(string)),
+	}
+
+	// Gateway Load Balancer endpoints do not support private DNS.
+	if d.Get("vpc_endpoint_type").(string) != ec2.VpcEndpointTypeGatewayLoadBalancer {
+		req.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
 	}
 
 	if v, ok := d.GetOk("policy"); ok {
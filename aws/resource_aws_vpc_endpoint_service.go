@@ -28,11 +28,20 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 				Required: true,
 			},
 			"network_load_balancer_arns": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"gateway_load_balancer_arns"},
+			},
+			"gateway_load_balancer_arns": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"network_load_balancer_arns"},
 			},
 			"allowed_principals": {
 				Type:     schema.TypeSet,
@@ -61,7 +70,32 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"private_dns_name_configuration": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			"base_endpoint_dns_names": {
 				Type:     schema.TypeSet,
@@ -76,9 +110,23 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 func resourceAwsVpcEndpointServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	nlbArns := d.Get("network_load_balancer_arns").(*schema.Set)
+	gwlbArns := d.Get("gateway_load_balancer_arns").(*schema.Set)
+	if nlbArns.Len() == 0 && gwlbArns.Len() == 0 {
+		return errors.New("Either network_load_balancer_arns or gateway_load_balancer_arns must be specified")
+	}
+
 	req := &ec2.CreateVpcEndpointServiceConfigurationInput{
-		AcceptanceRequired:      aws.Bool(d.Get("acceptance_required").(bool)),
-		NetworkLoadBalancerArns: expandStringSet(d.Get("network_load_balancer_arns").(*schema.Set)),
+		AcceptanceRequired: aws.Bool(d.Get("acceptance_required").(bool)),
+	}
+	if nlbArns.Len() > 0 {
+		req.NetworkLoadBalancerArns = expandStringSet(nlbArns)
+	}
+	if gwlbArns.Len() > 0 {
+		req.GatewayLoadBalancerArns = expandStringSet(gwlbArns)
+	}
+	if v, ok := d.GetOk("private_dns_name"); ok {
+		req.PrivateDnsName = aws.String(v.(string))
 	}
 
 	log.Printf("[DEBUG] Creating VPC Endpoint Service configuration: %#v", req)
@@ -136,6 +184,20 @@ func resourceAwsVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{
 		&modifyCfgReq.AddNetworkLoadBalancerArns, &modifyCfgReq.RemoveNetworkLoadBalancerArns) {
 		modifyCfg = true
 	}
+	if setVpcEndpointServiceUpdateLists(d, "gateway_load_balancer_arns",
+		&modifyCfgReq.AddGatewayLoadBalancerArns, &modifyCfgReq.RemoveGatewayLoadBalancerArns) {
+		modifyCfg = true
+	}
+	// The private DNS name is set at creation; only send it again when it has
+	// actually changed, as doing so restarts domain ownership verification.
+	if d.HasChange("private_dns_name") && !d.IsNewResource() {
+		if v := d.Get("private_dns_name").(string); v != "" {
+			modifyCfgReq.PrivateDnsName = aws.String(v)
+		} else {
+			modifyCfgReq.RemovePrivateDnsName = aws.Bool(true)
+		}
+		modifyCfg = true
+	}
 	if modifyCfg {
 		log.Printf("[DEBUG] Modifying VPC Endpoint Service configuration: %#v", modifyCfgReq)
 		if _, err := conn.ModifyVpcEndpointServiceConfiguration(modifyCfgReq); err != nil {
@@ -146,6 +208,8 @@ func resourceAwsVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{
 		}
 
 		d.SetPartial("network_load_balancer_arns")
+		d.SetPartial("gateway_load_balancer_arns")
+		d.SetPartial("private_dns_name")
 	}
 
 	modifyPermReq := &ec2.ModifyVpcEndpointServicePermissionsInput{
@@ -238,11 +302,15 @@ func vpcEndpointServiceWaitUntilAvailable(d *schema.ResourceData, conn *ec2.EC2)
 func vpcEndpointServiceAttributes(d *schema.ResourceData, svcCfg *ec2.ServiceConfiguration, conn *ec2.EC2) error {
 	d.Set("acceptance_required", svcCfg.AcceptanceRequired)
 	d.Set("network_load_balancer_arns", flattenStringList(svcCfg.NetworkLoadBalancerArns))
+	d.Set("gateway_load_balancer_arns", flattenStringList(svcCfg.GatewayLoadBalancerArns))
 	d.Set("state", svcCfg.ServiceState)
 	d.Set("service_name", svcCfg.ServiceName)
 	d.Set("service_type", svcCfg.ServiceType[0].ServiceType)
 	d.Set("availability_zones", flattenStringList(svcCfg.AvailabilityZones))
 	d.Set("private_dns_name", svcCfg.PrivateDnsName)
+	if err := d.Set("private_dns_name_configuration", flattenVpcEndpointServicePrivateDnsNameConfiguration(svcCfg.PrivateDnsNameConfiguration)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_configuration: %s", err)
+	}
 	d.Set("base_endpoint_dns_names", flattenStringList(svcCfg.BaseEndpointDnsNames))
 
 	resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
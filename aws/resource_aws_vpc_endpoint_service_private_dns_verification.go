@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointServicePrivateDnsVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServicePrivateDnsVerificationCreate,
+		Read:   resourceAwsVpcEndpointServicePrivateDnsVerificationRead,
+		Delete: resourceAwsVpcEndpointServicePrivateDnsVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	svcId := d.Get("service_id").(string)
+
+	log.Printf("[DEBUG] Starting VPC Endpoint Service (%s) private DNS verification", svcId)
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerification(&ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(svcId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error starting VPC Endpoint Service (%s) private DNS verification: %s", svcId, err)
+	}
+
+	d.SetId(svcId)
+
+	if d.Get("wait_for_verification").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{ec2.DnsNameStatePendingVerification},
+			Target:     []string{ec2.DnsNameStateVerified},
+			Refresh:    vpcEndpointServicePrivateDnsNameStateRefresh(conn, svcId),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for VPC Endpoint Service (%s) private DNS name to be verified: %s", svcId, err)
+		}
+	}
+
+	return resourceAwsVpcEndpointServicePrivateDnsVerificationRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	_, state, err := vpcEndpointServiceStateRefresh(conn, d.Id())()
+	if err != nil && state != ec2.ServiceStateFailed {
+		return fmt.Errorf("Error reading VPC Endpoint Service (%s): %s", d.Id(), err)
+	}
+
+	if state == ec2.ServiceStateDeleted || state == ec2.ServiceStateDeleting || state == ec2.ServiceStateFailed {
+		log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing private DNS verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_id", d.Id())
+
+	return nil
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Verification cannot be undone; removing the resource only drops it from state.
+	log.Printf("[DEBUG] Removing VPC Endpoint Service (%s) private DNS verification from state", d.Id())
+
+	return nil
+}
+
+func vpcEndpointServicePrivateDnsNameStateRefresh(conn *ec2.EC2, svcId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		svcCfg, _, err := vpcEndpointServiceStateRefresh(conn, svcId)()
+		if err != nil {
+			return nil, "", err
+		}
+
+		cfg, ok := svcCfg.(*ec2.ServiceConfiguration)
+		if !ok || cfg.PrivateDnsNameConfiguration == nil {
+			return nil, "", fmt.Errorf("VPC Endpoint Service (%s) has no private DNS name configuration", svcId)
+		}
+
+		return cfg, aws.StringValue(cfg.PrivateDnsNameConfiguration.State), nil
+	}
+}
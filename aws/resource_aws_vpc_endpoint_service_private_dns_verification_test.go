@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcEndpointServicePrivateDnsVerification_basic(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	lbName := fmt.Sprintf("testaccawsnlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	domainName := fmt.Sprintf("%s.example.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointServicePrivateDnsVerificationConfig_basic(lbName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceExists("aws_vpc_endpoint_service.foo", &svcCfg),
+					testAccCheckVpcEndpointServicePrivateDnsVerificationExists("aws_vpc_endpoint_service_private_dns_verification.foo"),
+					resource.TestCheckResourceAttrPair("aws_vpc_endpoint_service_private_dns_verification.foo", "service_id", "aws_vpc_endpoint_service.foo", "id"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service_private_dns_verification.foo", "wait_for_verification", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointServicePrivateDnsVerificationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Endpoint Service ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccVpcEndpointServicePrivateDnsVerificationConfig_basic(lbName, privateDnsName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "nlb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-service-private-dns-verification"
+  }
+}
+
+resource "aws_subnet" "nlb_test" {
+  vpc_id            = "${aws_vpc.nlb_test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-service-private-dns-verification"
+  }
+}
+
+resource "aws_lb" "nlb_test" {
+  name               = "%s"
+  load_balancer_type = "network"
+  internal           = true
+  subnets            = ["${aws_subnet.nlb_test.id}"]
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required        = false
+  network_load_balancer_arns = ["${aws_lb.nlb_test.arn}"]
+  private_dns_name           = "%s"
+}
+
+resource "aws_vpc_endpoint_service_private_dns_verification" "foo" {
+  service_id = "${aws_vpc_endpoint_service.foo.id}"
+}
+`, lbName, privateDnsName)
+}
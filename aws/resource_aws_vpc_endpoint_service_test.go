@@ -79,6 +79,64 @@ func TestAccAWSVpcEndpointService_removed(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpointService_gatewayLoadBalancerArns(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	lbName := fmt.Sprintf("testaccawsgwlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint_service.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointServiceConfig_gatewayLoadBalancerArns(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceExists("aws_vpc_endpoint_service.foo", &svcCfg),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "gateway_load_balancer_arns.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "network_load_balancer_arns.#", "0"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "service_type", "GatewayLoadBalancer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpointService_privateDnsName(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	lbName := fmt.Sprintf("testaccawsnlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	domainName1 := fmt.Sprintf("%s.example.com", acctest.RandString(10))
+	domainName2 := fmt.Sprintf("%s.example.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint_service.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointServiceConfig_privateDnsName(lbName, domainName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceExists("aws_vpc_endpoint_service.foo", &svcCfg),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "private_dns_name", domainName1),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "private_dns_name_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "private_dns_name_configuration.0.state", "pendingVerification"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "private_dns_name_configuration.0.type", "TXT"),
+					resource.TestCheckResourceAttrSet("aws_vpc_endpoint_service.foo", "private_dns_name_configuration.0.name"),
+					resource.TestCheckResourceAttrSet("aws_vpc_endpoint_service.foo", "private_dns_name_configuration.0.value"),
+				),
+			},
+			{
+				Config: testAccVpcEndpointServiceConfig_privateDnsName(lbName, domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceExists("aws_vpc_endpoint_service.foo", &svcCfg),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_service.foo", "private_dns_name", domainName2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcEndpointServiceDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -282,3 +340,74 @@ resource "aws_vpc_endpoint_service" "foo" {
 }
 	`, lb1Name, lb2Name)
 }
+
+func testAccVpcEndpointServiceConfig_gatewayLoadBalancerArns(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "gwlb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-service-gwlb"
+  }
+}
+
+resource "aws_subnet" "gwlb_test" {
+  vpc_id            = "${aws_vpc.gwlb_test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-service-gwlb"
+  }
+}
+
+resource "aws_lb" "gwlb_test" {
+  name               = "%s"
+  load_balancer_type = "gateway"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.gwlb_test.id}"
+  }
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required        = false
+  gateway_load_balancer_arns = ["${aws_lb.gwlb_test.arn}"]
+}
+`, lbName)
+}
+
+func testAccVpcEndpointServiceConfig_privateDnsName(lbName, privateDnsName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "nlb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-service-private-dns"
+  }
+}
+
+resource "aws_subnet" "nlb_test" {
+  vpc_id            = "${aws_vpc.nlb_test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-service-private-dns"
+  }
+}
+
+resource "aws_lb" "nlb_test" {
+  name               = "%s"
+  load_balancer_type = "network"
+  internal           = true
+  subnets            = ["${aws_subnet.nlb_test.id}"]
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required        = false
+  network_load_balancer_arns = ["${aws_lb.nlb_test.arn}"]
+  private_dns_name           = "%s"
+}
+`, lbName, privateDnsName)
+}
@@ -169,6 +169,31 @@ func TestAccAWSVpcEndpoint_interfaceNonAWSService(t *testing.T) {
 		},
 	})
 }
+func TestAccAWSVpcEndpoint_gatewayLoadBalancer(t *testing.T) {
+	lbName := fmt.Sprintf("testaccawsgwlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointConfig_gatewayLoadBalancer(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.foo", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "vpc_endpoint_type", "GatewayLoadBalancer"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -576,3 +601,46 @@ resource "aws_vpc_endpoint" "foo" {
 }
   `, lbName)
 }
+
+func testAccVpcEndpointConfig_gatewayLoadBalancer(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-gwlb"
+  }
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-gwlb"
+  }
+}
+
+resource "aws_lb" "gwlb_test" {
+  name               = "%s"
+  load_balancer_type = "gateway"
+
+  subnet_mapping {
+    subnet_id = "${aws_subnet.foo.id}"
+  }
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required        = false
+  gateway_load_balancer_arns = ["${aws_lb.gwlb_test.arn}"]
+}
+
+resource "aws_vpc_endpoint" "foo" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  service_name      = "${aws_vpc_endpoint_service.foo.service_name}"
+  vpc_endpoint_type = "GatewayLoadBalancer"
+  subnet_ids        = ["${aws_subnet.foo.id}"]
+}
+`, lbName)
+}
@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsVpcIpam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamCreate,
+		Read:   resourceAwsVpcIpamRead,
+		Update: resourceAwsVpcIpamUpdate,
+		Delete: resourceAwsVpcIpamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cascade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operating_regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"private_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.IpamTierAdvanced,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.IpamTierFree,
+					ec2.IpamTierAdvanced,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	currentRegion := meta.(*AWSClient).region
+
+	operatingRegions := d.Get("operating_regions").(*schema.Set).List()
+	if !vpcIpamOperatingRegionsContain(operatingRegions, currentRegion) {
+		return fmt.Errorf("error creating EC2 IPAM: operating_regions must include the current region (%s)", currentRegion)
+	}
+
+	input := &ec2.CreateIpamInput{
+		OperatingRegions:  expandVpcIpamAddOperatingRegions(operatingRegions),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeIpam),
+		Tier:              aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 IPAM: %s", input)
+	output, err := conn.CreateIpam(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 IPAM: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Ipam.IpamId))
+
+	if err := waitForEc2IpamCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamRead(d, meta)
+}
+
+func resourceAwsVpcIpamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	ipam, err := ec2DescribeIpam(conn, d.Id())
+
+	if isAWSErr(err, "InvalidIpamId.NotFound", "") {
+		log.Printf("[WARN] EC2 IPAM (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 IPAM (%s): %s", d.Id(), err)
+	}
+
+	if ipam == nil {
+		log.Printf("[WARN] EC2 IPAM (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(ipam.State) {
+	case ec2.IpamStateDeleteInProgress, ec2.IpamStateDeleteComplete:
+		log.Printf("[WARN] EC2 IPAM (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(ipam.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", ipam.IpamArn)
+	d.Set("description", ipam.Description)
+	d.Set("private_default_scope_id", ipam.PrivateDefaultScopeId)
+	d.Set("public_default_scope_id", ipam.PublicDefaultScopeId)
+	d.Set("scope_count", aws.Int64Value(ipam.ScopeCount))
+	d.Set("tier", ipam.Tier)
+
+	if err := d.Set("operating_regions", flattenVpcIpamOperatingRegions(ipam.OperatingRegions)); err != nil {
+		return fmt.Errorf("error setting operating_regions: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(ipam.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("description") || d.HasChange("operating_regions") || d.HasChange("tier") {
+		input := &ec2.ModifyIpamInput{
+			IpamId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("operating_regions") {
+			o, n := d.GetChange("operating_regions")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+
+			if !vpcIpamOperatingRegionsContain(ns.List(), meta.(*AWSClient).region) {
+				return fmt.Errorf("error updating EC2 IPAM (%s): operating_regions must include the current region (%s)", d.Id(), meta.(*AWSClient).region)
+			}
+
+			if add := ns.Difference(os).List(); len(add) > 0 {
+				input.AddOperatingRegions = expandVpcIpamAddOperatingRegions(add)
+			}
+
+			if remove := os.Difference(ns).List(); len(remove) > 0 {
+				input.RemoveOperatingRegions = expandVpcIpamRemoveOperatingRegions(remove)
+			}
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = aws.String(d.Get("tier").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 IPAM (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyIpam(input); err != nil {
+			return fmt.Errorf("error modifying EC2 IPAM (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2IpamModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 IPAM (%s) modification: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 IPAM (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamRead(d, meta)
+}
+
+func resourceAwsVpcIpamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteIpamInput{
+		IpamId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("cascade"); ok {
+		input.Cascade = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 IPAM (%s): %s", d.Id(), input)
+	_, err := conn.DeleteIpam(input)
+
+	if isAWSErr(err, "InvalidIpamId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 IPAM (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2IpamDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func vpcIpamOperatingRegionsContain(l []interface{}, region string) bool {
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tfMap["region_name"].(string) == region {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandVpcIpamAddOperatingRegions(l []interface{}) []*ec2.AddIpamOperatingRegion {
+	operatingRegions := make([]*ec2.AddIpamOperatingRegion, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		operatingRegions = append(operatingRegions, &ec2.AddIpamOperatingRegion{
+			RegionName: aws.String(tfMap["region_name"].(string)),
+		})
+	}
+
+	return operatingRegions
+}
+
+func expandVpcIpamRemoveOperatingRegions(l []interface{}) []*ec2.RemoveIpamOperatingRegion {
+	operatingRegions := make([]*ec2.RemoveIpamOperatingRegion, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		operatingRegions = append(operatingRegions, &ec2.RemoveIpamOperatingRegion{
+			RegionName: aws.String(tfMap["region_name"].(string)),
+		})
+	}
+
+	return operatingRegions
+}
+
+func flattenVpcIpamOperatingRegions(operatingRegions []*ec2.IpamOperatingRegion) []interface{} {
+	l := make([]interface{}, 0, len(operatingRegions))
+
+	for _, operatingRegion := range operatingRegions {
+		if operatingRegion == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"region_name": aws.StringValue(operatingRegion.RegionName),
+		})
+	}
+
+	return l
+}
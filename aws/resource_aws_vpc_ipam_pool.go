@@ -0,0 +1,435 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsVpcIpamPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamPoolCreate,
+		Read:   resourceAwsVpcIpamPoolRead,
+		Update: resourceAwsVpcIpamPoolUpdate,
+		Delete: resourceAwsVpcIpamPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AddressFamilyIpv4,
+					ec2.AddressFamilyIpv6,
+				}, false),
+			},
+			"allocation_default_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_max_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_min_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"aws_service": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.IpamPoolAwsServiceEc2,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipam_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pool_depth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"publicly_advertisable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_resource": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"resource_owner": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"resource_region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.IpamPoolSourceResourceTypeVpc,
+							}, false),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsVpcIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	addressFamily := d.Get("address_family").(string)
+
+	input := &ec2.CreateIpamPoolInput{
+		AddressFamily:     aws.String(addressFamily),
+		IpamScopeId:       aws.String(d.Get("ipam_scope_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeIpamPool),
+	}
+
+	if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+		input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_max_netmask_length"); ok {
+		input.AllocationMaxNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_min_netmask_length"); ok {
+		input.AllocationMinNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AllocationResourceTags = expandVpcIpamPoolResourceTags(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("auto_import"); ok {
+		input.AutoImport = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	// Only IPv6 pools can be publicly advertised.
+	if addressFamily == ec2.AddressFamilyIpv6 {
+		input.PubliclyAdvertisable = aws.Bool(d.Get("publicly_advertisable").(bool))
+	}
+
+	if v, ok := d.GetOk("source_ipam_pool_id"); ok {
+		input.SourceIpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_resource"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceResource = expandVpcIpamPoolSourceResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 IPAM Pool: %s", input)
+	output, err := conn.CreateIpamPool(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 IPAM Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamPool.IpamPoolId))
+
+	if err := waitForEc2IpamPoolCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Pool (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamPoolRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	ipamPool, err := ec2DescribeIpamPool(conn, d.Id())
+
+	if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+		log.Printf("[WARN] EC2 IPAM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 IPAM Pool (%s): %s", d.Id(), err)
+	}
+
+	if ipamPool == nil {
+		log.Printf("[WARN] EC2 IPAM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(ipamPool.State) {
+	case ec2.IpamPoolStateDeleteInProgress, ec2.IpamPoolStateDeleteComplete:
+		log.Printf("[WARN] EC2 IPAM Pool (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(ipamPool.State))
+		d.SetId("")
+		return nil
+	}
+
+	scopeID, err := vpcIpamScopeIDFromArn(aws.StringValue(ipamPool.IpamScopeArn))
+	if err != nil {
+		return err
+	}
+
+	d.Set("address_family", ipamPool.AddressFamily)
+	d.Set("allocation_default_netmask_length", aws.Int64Value(ipamPool.AllocationDefaultNetmaskLength))
+	d.Set("allocation_max_netmask_length", aws.Int64Value(ipamPool.AllocationMaxNetmaskLength))
+	d.Set("allocation_min_netmask_length", aws.Int64Value(ipamPool.AllocationMinNetmaskLength))
+	d.Set("arn", ipamPool.IpamPoolArn)
+	d.Set("auto_import", ipamPool.AutoImport)
+	d.Set("aws_service", ipamPool.AwsService)
+	d.Set("description", ipamPool.Description)
+	d.Set("ipam_scope_id", scopeID)
+	d.Set("ipam_scope_type", ipamPool.IpamScopeType)
+	d.Set("locale", ipamPool.Locale)
+	d.Set("pool_depth", aws.Int64Value(ipamPool.PoolDepth))
+	d.Set("publicly_advertisable", ipamPool.PubliclyAdvertisable)
+	d.Set("source_ipam_pool_id", ipamPool.SourceIpamPoolId)
+	d.Set("state", ipamPool.State)
+
+	if err := d.Set("source_resource", flattenVpcIpamPoolSourceResource(ipamPool.SourceResource)); err != nil {
+		return fmt.Errorf("error setting source_resource: %s", err)
+	}
+
+	if err := d.Set("allocation_resource_tags", flattenVpcIpamPoolResourceTags(ipamPool.AllocationResourceTags)); err != nil {
+		return fmt.Errorf("error setting allocation_resource_tags: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(ipamPool.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("allocation_default_netmask_length") || d.HasChange("allocation_max_netmask_length") ||
+		d.HasChange("allocation_min_netmask_length") || d.HasChange("allocation_resource_tags") ||
+		d.HasChange("auto_import") || d.HasChange("description") {
+		input := &ec2.ModifyIpamPoolInput{
+			IpamPoolId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allocation_default_netmask_length") {
+			if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+				input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+			} else {
+				input.ClearAllocationDefaultNetmaskLength = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("allocation_max_netmask_length") {
+			input.AllocationMaxNetmaskLength = aws.Int64(int64(d.Get("allocation_max_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_min_netmask_length") {
+			input.AllocationMinNetmaskLength = aws.Int64(int64(d.Get("allocation_min_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_resource_tags") {
+			o, n := d.GetChange("allocation_resource_tags")
+			om := o.(map[string]interface{})
+			nm := n.(map[string]interface{})
+
+			add := make(map[string]interface{})
+			remove := make(map[string]interface{})
+
+			for k, v := range om {
+				if nv, ok := nm[k]; !ok || nv != v {
+					remove[k] = v
+				}
+			}
+
+			for k, v := range nm {
+				if ov, ok := om[k]; !ok || ov != v {
+					add[k] = v
+				}
+			}
+
+			if len(add) > 0 {
+				input.AddAllocationResourceTags = expandVpcIpamPoolResourceTags(add)
+			}
+
+			if len(remove) > 0 {
+				input.RemoveAllocationResourceTags = expandVpcIpamPoolResourceTags(remove)
+			}
+		}
+
+		if d.HasChange("auto_import") {
+			input.AutoImport = aws.Bool(d.Get("auto_import").(bool))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 IPAM Pool (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyIpamPool(input); err != nil {
+			return fmt.Errorf("error modifying EC2 IPAM Pool (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2IpamPoolModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 IPAM Pool (%s) modification: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 IPAM Pool (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamPoolRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteIpamPoolInput{
+		IpamPoolId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 IPAM Pool (%s): %s", d.Id(), input)
+	_, err := conn.DeleteIpamPool(input)
+
+	if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 IPAM Pool (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2IpamPoolDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Pool (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVpcIpamPoolResourceTags(m map[string]interface{}) []*ec2.RequestIpamResourceTag {
+	tags := make([]*ec2.RequestIpamResourceTag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &ec2.RequestIpamResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenVpcIpamPoolResourceTags(tags []*ec2.IpamResourceTag) map[string]string {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func expandVpcIpamPoolSourceResource(tfMap map[string]interface{}) *ec2.IpamPoolSourceResourceRequest {
+	return &ec2.IpamPoolSourceResourceRequest{
+		ResourceId:     aws.String(tfMap["resource_id"].(string)),
+		ResourceOwner:  aws.String(tfMap["resource_owner"].(string)),
+		ResourceRegion: aws.String(tfMap["resource_region"].(string)),
+		ResourceType:   aws.String(tfMap["resource_type"].(string)),
+	}
+}
+
+func flattenVpcIpamPoolSourceResource(sourceResource *ec2.IpamPoolSourceResource) []interface{} {
+	if sourceResource == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"resource_id":     aws.StringValue(sourceResource.ResourceId),
+			"resource_owner":  aws.StringValue(sourceResource.ResourceOwner),
+			"resource_region": aws.StringValue(sourceResource.ResourceRegion),
+			"resource_type":   aws.StringValue(sourceResource.ResourceType),
+		},
+	}
+}
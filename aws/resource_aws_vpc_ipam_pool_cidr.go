@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsVpcIpamPoolCidr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamPoolCidrCreate,
+		Read:   resourceAwsVpcIpamPoolCidrRead,
+		Delete: resourceAwsVpcIpamPoolCidrDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"netmask_length"},
+				ValidateFunc:  validateCIDRNetworkAddress,
+			},
+			"cidr_authorization_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"signature": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_cidr_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validation.IntBetween(0, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpamPoolCidrCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	ipamPoolID := d.Get("ipam_pool_id").(string)
+
+	input := &ec2.ProvisionIpamPoolCidrInput{
+		IpamPoolId: aws.String(ipamPoolID),
+	}
+
+	if v, ok := d.GetOk("cidr"); ok {
+		input.Cidr = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.CidrAuthorizationContext = &ec2.IpamCidrAuthorizationContext{
+			Message:   aws.String(tfMap["message"].(string)),
+			Signature: aws.String(tfMap["signature"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Provisioning EC2 IPAM Pool (%s) CIDR: %s", ipamPoolID, input)
+	output, err := conn.ProvisionIpamPoolCidr(input)
+	if err != nil {
+		return fmt.Errorf("error provisioning EC2 IPAM Pool (%s) CIDR: %s", ipamPoolID, err)
+	}
+
+	// When provisioning by netmask length the CIDR is only known once the
+	// allocation has completed, so track it by its IPAM pool CIDR ID meanwhile.
+	ipamPoolCidr, err := waitForEc2IpamPoolCidrProvisioning(conn, ipamPoolID, aws.StringValue(output.IpamPoolCidr.Cidr), aws.StringValue(output.IpamPoolCidr.IpamPoolCidrId))
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Pool (%s) CIDR provisioning: %s", ipamPoolID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", aws.StringValue(ipamPoolCidr.Cidr), ipamPoolID))
+
+	return resourceAwsVpcIpamPoolCidrRead(d, meta)
+}
+
+func resourceAwsVpcIpamPoolCidrRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	cidr, ipamPoolID, err := decodeEc2IpamPoolCidrID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ipamPoolCidr, err := ec2DescribeIpamPoolCidr(conn, ipamPoolID, cidr, "")
+
+	if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+		log.Printf("[WARN] EC2 IPAM Pool (%s) not found, removing from state", ipamPoolID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 IPAM Pool CIDR (%s): %s", d.Id(), err)
+	}
+
+	if ipamPoolCidr == nil {
+		log.Printf("[WARN] EC2 IPAM Pool CIDR (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(ipamPoolCidr.State) {
+	case ec2.IpamPoolCidrStatePendingDeprovision, ec2.IpamPoolCidrStateDeprovisioned:
+		log.Printf("[WARN] EC2 IPAM Pool CIDR (%s) in deprovisioned state (%s), removing from state", d.Id(), aws.StringValue(ipamPoolCidr.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cidr", ipamPoolCidr.Cidr)
+	d.Set("ipam_pool_cidr_id", ipamPoolCidr.IpamPoolCidrId)
+	d.Set("ipam_pool_id", ipamPoolID)
+	d.Set("netmask_length", aws.Int64Value(ipamPoolCidr.NetmaskLength))
+
+	return nil
+}
+
+func resourceAwsVpcIpamPoolCidrDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	cidr, ipamPoolID, err := decodeEc2IpamPoolCidrID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DeprovisionIpamPoolCidrInput{
+		Cidr:       aws.String(cidr),
+		IpamPoolId: aws.String(ipamPoolID),
+	}
+
+	log.Printf("[DEBUG] Deprovisioning EC2 IPAM Pool CIDR (%s): %s", d.Id(), input)
+	_, err = conn.DeprovisionIpamPoolCidr(input)
+
+	if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deprovisioning EC2 IPAM Pool CIDR (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2IpamPoolCidrDeprovisioning(conn, ipamPoolID, cidr); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Pool CIDR (%s) deprovisioning: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcIpamPoolCidr_basic(t *testing.T) {
+	var ipamPoolCidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+	ipamPoolResourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrExists(resourceName, &ipamPoolCidr),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipam_pool_cidr_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", ipamPoolResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "16"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr_authorization_context"},
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPoolCidr_NetmaskLength(t *testing.T) {
+	var ipamPoolCidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test_child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolCidrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolCidrConfigNetmaskLength(24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolCidrExists(resourceName, &ipamPoolCidr),
+					resource.TestMatchResourceAttr(resourceName, "cidr", regexp.MustCompile(`^10\.0\.\d{1,3}\.0/24$`)),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamPoolCidrExists(resourceName string, ipamPoolCidr *ec2.IpamPoolCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 IPAM Pool CIDR ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		cidr, ipamPoolID, err := decodeEc2IpamPoolCidrID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := ec2DescribeIpamPoolCidr(conn, ipamPoolID, cidr, "")
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) != ec2.IpamPoolCidrStateProvisioned {
+			return fmt.Errorf("EC2 IPAM Pool CIDR (%s) not found", rs.Primary.ID)
+		}
+
+		*ipamPoolCidr = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamPoolCidrDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr" {
+			continue
+		}
+
+		cidr, ipamPoolID, err := decodeEc2IpamPoolCidrID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		ipamPoolCidr, err := ec2DescribeIpamPoolCidr(conn, ipamPoolID, cidr, "")
+
+		if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ipamPoolCidr == nil || aws.StringValue(ipamPoolCidr.State) == ec2.IpamPoolCidrStateDeprovisioned {
+			continue
+		}
+
+		return fmt.Errorf("EC2 IPAM Pool CIDR (%s) still exists in (%s) state", rs.Primary.ID, aws.StringValue(ipamPoolCidr.State))
+	}
+
+	return nil
+}
+
+func testAccAWSVpcIpamPoolCidrConfig(cidr string) string {
+	return testAccAWSVpcIpamPoolConfigIpv4() + fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = "${aws_vpc_ipam_pool.test.id}"
+  cidr         = %q
+}
+`, cidr)
+}
+
+func testAccAWSVpcIpamPoolCidrConfigNetmaskLength(netmaskLength int) string {
+	return testAccAWSVpcIpamPoolConfigSourceIpamPoolId() + fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test_child" {
+  ipam_pool_id   = "${aws_vpc_ipam_pool.test_child.id}"
+  netmask_length = %d
+}
+`, netmaskLength)
+}
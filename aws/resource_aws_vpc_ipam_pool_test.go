@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcIpamPool_basic(t *testing.T) {
+	var ipamPool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+	ipamResourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfigIpv4(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &ipamPool),
+					resource.TestCheckResourceAttr(resourceName, "address_family", ec2.AddressFamilyIpv4),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_scope_id", ipamResourceName, "private_default_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", ec2.IpamScopeTypePrivate),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.IpamPoolStateCreateComplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamPoolConfigIpv4Updates(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &ipamPool),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_max_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_min_netmask_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.test", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpamPool_SourceIpamPoolId(t *testing.T) {
+	var ipamPool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test_child"
+	parentResourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamPoolConfigSourceIpamPoolId(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamPoolExists(resourceName, &ipamPool),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ipam_pool_id", parentResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamPoolExists(resourceName string, ipamPool *ec2.IpamPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 IPAM Pool ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeIpamPool(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 IPAM Pool (%s) not found", rs.Primary.ID)
+		}
+
+		*ipamPool = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool" {
+			continue
+		}
+
+		ipamPool, err := ec2DescribeIpamPool(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidIpamPoolId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ipamPool == nil || aws.StringValue(ipamPool.State) == ec2.IpamPoolStateDeleteComplete {
+			continue
+		}
+
+		return fmt.Errorf("EC2 IPAM Pool (%s) still exists in (%s) state", rs.Primary.ID, aws.StringValue(ipamPool.State))
+	}
+
+	return nil
+}
+
+const testAccAWSVpcIpamPoolConfigBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+`
+
+func testAccAWSVpcIpamPoolConfigIpv4() string {
+	return testAccAWSVpcIpamPoolConfigBase + `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = "${aws_vpc_ipam.test.private_default_scope_id}"
+}
+`
+}
+
+func testAccAWSVpcIpamPoolConfigIpv4Updates() string {
+	return testAccAWSVpcIpamPoolConfigBase + `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family                    = "ipv4"
+  allocation_default_netmask_length = 32
+  allocation_max_netmask_length     = 32
+  allocation_min_netmask_length     = 24
+  auto_import                       = true
+  description                       = "test"
+  ipam_scope_id                     = "${aws_vpc_ipam.test.private_default_scope_id}"
+
+  allocation_resource_tags {
+    test = "1"
+  }
+}
+`
+}
+
+func testAccAWSVpcIpamPoolConfigSourceIpamPoolId() string {
+	return testAccAWSVpcIpamPoolConfigBase + `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = "${aws_vpc_ipam.test.private_default_scope_id}"
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = "${aws_vpc_ipam_pool.test.id}"
+  cidr         = "10.0.0.0/16"
+}
+
+resource "aws_vpc_ipam_pool" "test_child" {
+  address_family      = "ipv4"
+  ipam_scope_id       = "${aws_vpc_ipam.test.private_default_scope_id}"
+  locale              = "${data.aws_region.current.name}"
+  source_ipam_pool_id = "${aws_vpc_ipam_pool.test.id}"
+
+  depends_on = ["aws_vpc_ipam_pool_cidr.test"]
+}
+`
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcIpamScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpamScopeCreate,
+		Read:   resourceAwsVpcIpamScopeRead,
+		Update: resourceAwsVpcIpamScopeUpdate,
+		Delete: resourceAwsVpcIpamScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipam_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pool_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsVpcIpamScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateIpamScopeInput{
+		IpamId:            aws.String(d.Get("ipam_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeIpamScope),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 IPAM Scope: %s", input)
+	output, err := conn.CreateIpamScope(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 IPAM Scope: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamScope.IpamScopeId))
+
+	if err := waitForEc2IpamScopeCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Scope (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamScopeRead(d, meta)
+}
+
+func resourceAwsVpcIpamScopeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	ipamScope, err := ec2DescribeIpamScope(conn, d.Id())
+
+	if isAWSErr(err, "InvalidIpamScopeId.NotFound", "") {
+		log.Printf("[WARN] EC2 IPAM Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 IPAM Scope (%s): %s", d.Id(), err)
+	}
+
+	if ipamScope == nil {
+		log.Printf("[WARN] EC2 IPAM Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(ipamScope.State) {
+	case ec2.IpamScopeStateDeleteInProgress, ec2.IpamScopeStateDeleteComplete:
+		log.Printf("[WARN] EC2 IPAM Scope (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(ipamScope.State))
+		d.SetId("")
+		return nil
+	}
+
+	ipamID, err := vpcIpamIDFromArn(aws.StringValue(ipamScope.IpamArn))
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", ipamScope.IpamScopeArn)
+	d.Set("description", ipamScope.Description)
+	d.Set("ipam_arn", ipamScope.IpamArn)
+	d.Set("ipam_id", ipamID)
+	d.Set("ipam_scope_type", ipamScope.IpamScopeType)
+	d.Set("is_default", ipamScope.IsDefault)
+	d.Set("pool_count", aws.Int64Value(ipamScope.PoolCount))
+
+	if err := d.Set("tags", tagsToMap(ipamScope.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpamScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("description") {
+		input := &ec2.ModifyIpamScopeInput{
+			Description: aws.String(d.Get("description").(string)),
+			IpamScopeId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 IPAM Scope (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyIpamScope(input); err != nil {
+			return fmt.Errorf("error modifying EC2 IPAM Scope (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 IPAM Scope (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpamScopeRead(d, meta)
+}
+
+func resourceAwsVpcIpamScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteIpamScopeInput{
+		IpamScopeId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 IPAM Scope (%s): %s", d.Id(), input)
+	_, err := conn.DeleteIpamScope(input)
+
+	if isAWSErr(err, "InvalidIpamScopeId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 IPAM Scope (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2IpamScopeDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 IPAM Scope (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
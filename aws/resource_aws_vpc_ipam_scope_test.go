@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcIpamScope_basic(t *testing.T) {
+	var ipamScope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+	ipamResourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamScopeConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &ipamScope),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_arn", ipamResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", ipamResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", ec2.IpamScopeTypePrivate),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pool_count", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSVpcIpamScopeConfig("test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamScopeExists(resourceName, &ipamScope),
+					resource.TestCheckResourceAttr(resourceName, "description", "test updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamScopeExists(resourceName string, ipamScope *ec2.IpamScope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 IPAM Scope ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeIpamScope(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 IPAM Scope (%s) not found", rs.Primary.ID)
+		}
+
+		*ipamScope = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamScopeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_scope" {
+			continue
+		}
+
+		ipamScope, err := ec2DescribeIpamScope(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidIpamScopeId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ipamScope == nil || aws.StringValue(ipamScope.State) == ec2.IpamScopeStateDeleteComplete {
+			continue
+		}
+
+		return fmt.Errorf("EC2 IPAM Scope (%s) still exists in (%s) state", rs.Primary.ID, aws.StringValue(ipamScope.State))
+	}
+
+	return nil
+}
+
+func testAccAWSVpcIpamScopeConfig(description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+
+resource "aws_vpc_ipam_scope" "test" {
+  description = %q
+  ipam_id     = "${aws_vpc_ipam.test.id}"
+}
+`, description)
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcIpam_basic(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfigDescription("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:ec2::\d{12}:ipam/ipam-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "private_default_scope_id", regexp.MustCompile(`^ipam-scope-`)),
+					resource.TestMatchResourceAttr(resourceName, "public_default_scope_id", regexp.MustCompile(`^ipam-scope-`)),
+					resource.TestCheckResourceAttr(resourceName, "scope_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", ec2.IpamTierAdvanced),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade"},
+			},
+			{
+				Config: testAccAWSVpcIpamConfigDescription("test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "description", "test updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_OperatingRegions(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfigOperatingRegionsMultiple(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamConfigDescription("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcIpam_Tags(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcIpamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSVpcIpamConfigTags("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSVpcIpamConfigTags("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcIpamExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSVpcIpamExists(resourceName string, ipam *ec2.Ipam) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 IPAM ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeIpam(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 IPAM (%s) not found", rs.Primary.ID)
+		}
+
+		*ipam = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSVpcIpamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam" {
+			continue
+		}
+
+		ipam, err := ec2DescribeIpam(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidIpamId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ipam == nil || aws.StringValue(ipam.State) == ec2.IpamStateDeleteComplete {
+			continue
+		}
+
+		return fmt.Errorf("EC2 IPAM (%s) still exists in (%s) state", rs.Primary.ID, aws.StringValue(ipam.State))
+	}
+
+	return nil
+}
+
+func testAccAWSVpcIpamConfigDescription(description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = %q
+
+  operating_regions {
+    region_name = "${data.aws_region.current.name}"
+  }
+}
+`, description)
+}
+
+func testAccAWSVpcIpamConfigOperatingRegionsMultiple() string {
+	return `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = "${data.aws_region.current.name}"
+  }
+
+  operating_regions {
+    region_name = "us-east-1"
+  }
+}
+`
+}
+
+func testAccAWSVpcIpamConfigTags(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = "${data.aws_region.current.name}"
+  }
+
+  tags {
+    %s = %q
+  }
+}
+`, tagKey1, tagValue1)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -374,6 +375,49 @@ func TestAccAWSVpc_classiclinkDnsSupportOptionSet(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpc_ipv4IpamPool(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigIpv4IpamPoolNetmaskLength,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					resource.TestMatchResourceAttr("aws_vpc.foo", "cidr_block", regexp.MustCompile(`^172\.2\.\d{1,3}\.0/24$`)),
+					resource.TestCheckResourceAttrPair("aws_vpc.foo", "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.foo", "id"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "ipv4_netmask_length", "24"),
+				),
+			},
+			{
+				Config: testAccVpcConfigIpv4IpamPoolCidrBlock,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckVpcCidr(&vpc, "172.2.0.0/28"),
+					resource.TestCheckResourceAttrPair("aws_vpc.foo", "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpc_ipv4NetmaskLengthWithoutIpamPool(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcConfigIpv4NetmaskLengthWithoutIpamPool,
+				ExpectError: regexp.MustCompile(`ipv4_netmask_length requires ipv4_ipam_pool_id`),
+			},
+		},
+	})
+}
+
 const testAccVpcConfig = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
@@ -488,3 +532,57 @@ resource "aws_vpc" "bar" {
 	}
 }
 `
+
+const testAccVpcConfigIpv4IpamPoolBase = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "foo" {
+	operating_regions {
+		region_name = "${data.aws_region.current.name}"
+	}
+}
+
+resource "aws_vpc_ipam_pool" "foo" {
+	address_family = "ipv4"
+	ipam_scope_id = "${aws_vpc_ipam.foo.private_default_scope_id}"
+	locale = "${data.aws_region.current.name}"
+}
+
+resource "aws_vpc_ipam_pool_cidr" "foo" {
+	ipam_pool_id = "${aws_vpc_ipam_pool.foo.id}"
+	cidr = "172.2.0.0/16"
+}
+`
+
+const testAccVpcConfigIpv4IpamPoolNetmaskLength = testAccVpcConfigIpv4IpamPoolBase + `
+resource "aws_vpc" "foo" {
+	ipv4_ipam_pool_id = "${aws_vpc_ipam_pool.foo.id}"
+	ipv4_netmask_length = 24
+	tags {
+		Name = "terraform-testacc-vpc-ipv4-ipam-pool"
+	}
+
+	depends_on = ["aws_vpc_ipam_pool_cidr.foo"]
+}
+`
+
+const testAccVpcConfigIpv4IpamPoolCidrBlock = testAccVpcConfigIpv4IpamPoolBase + `
+resource "aws_vpc" "foo" {
+	cidr_block = "172.2.0.0/28"
+	ipv4_ipam_pool_id = "${aws_vpc_ipam_pool.foo.id}"
+	tags {
+		Name = "terraform-testacc-vpc-ipv4-ipam-pool"
+	}
+
+	depends_on = ["aws_vpc_ipam_pool_cidr.foo"]
+}
+`
+
+const testAccVpcConfigIpv4NetmaskLengthWithoutIpamPool = `
+resource "aws_vpc" "foo" {
+	ipv4_netmask_length = 24
+	tags {
+		Name = "terraform-testacc-vpc-ipv4-netmask-length-without-ipam-pool"
+	}
+}
+`
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 type XmlVpnConnectionConfig struct {
@@ -71,11 +72,21 @@ func resourceAwsVpnConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsVpnConnectionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"transit_gateway_id"},
+			},
+
+			"transit_gateway_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpn_gateway_id"},
 			},
 
 			"customer_gateway_id": {
@@ -97,11 +108,17 @@ func resourceAwsVpnConnection() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"enable_acceleration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"tunnel1_inside_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validateVpnConnectionTunnelInsideCIDR,
 			},
 
@@ -110,15 +127,118 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validateVpnConnectionTunnelPreSharedKey,
 			},
 
+			"tunnel1_dpd_timeout_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vpnTunnelOptionsDpdTimeoutActionClear,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsDpdTimeoutActions, false),
+			},
+
+			"tunnel1_dpd_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+
+			"tunnel1_ike_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIkeVersions, false),
+				},
+			},
+
+			"tunnel1_phase1_dh_group_numbers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInSlice(vpnTunnelOptionsPhase1DHGroupNumbers),
+				},
+			},
+
+			"tunnel1_phase1_encryption_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsEncryptionAlgorithms, false),
+				},
+			},
+
+			"tunnel1_phase1_integrity_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIntegrityAlgorithms, false),
+				},
+			},
+
+			"tunnel1_phase1_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      28800,
+				ValidateFunc: validation.IntBetween(900, 28800),
+			},
+
+			"tunnel1_phase2_dh_group_numbers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInSlice(vpnTunnelOptionsPhase2DHGroupNumbers),
+				},
+			},
+
+			"tunnel1_phase2_encryption_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsEncryptionAlgorithms, false),
+				},
+			},
+
+			"tunnel1_phase2_integrity_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIntegrityAlgorithms, false),
+				},
+			},
+
+			"tunnel1_phase2_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 3600),
+			},
+
+			"tunnel1_startup_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vpnTunnelOptionsStartupActionAdd,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsStartupActions, false),
+			},
+
 			"tunnel2_inside_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validateVpnConnectionTunnelInsideCIDR,
 			},
 
@@ -127,10 +247,114 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validateVpnConnectionTunnelPreSharedKey,
 			},
 
+			"tunnel2_dpd_timeout_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vpnTunnelOptionsDpdTimeoutActionClear,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsDpdTimeoutActions, false),
+			},
+
+			"tunnel2_dpd_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+
+			"tunnel2_ike_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIkeVersions, false),
+				},
+			},
+
+			"tunnel2_phase1_dh_group_numbers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInSlice(vpnTunnelOptionsPhase1DHGroupNumbers),
+				},
+			},
+
+			"tunnel2_phase1_encryption_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsEncryptionAlgorithms, false),
+				},
+			},
+
+			"tunnel2_phase1_integrity_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIntegrityAlgorithms, false),
+				},
+			},
+
+			"tunnel2_phase1_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      28800,
+				ValidateFunc: validation.IntBetween(900, 28800),
+			},
+
+			"tunnel2_phase2_dh_group_numbers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInSlice(vpnTunnelOptionsPhase2DHGroupNumbers),
+				},
+			},
+
+			"tunnel2_phase2_encryption_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsEncryptionAlgorithms, false),
+				},
+			},
+
+			"tunnel2_phase2_integrity_algorithms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIntegrityAlgorithms, false),
+				},
+			},
+
+			"tunnel2_phase2_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 3600),
+			},
+
+			"tunnel2_startup_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vpnTunnelOptionsStartupActionAdd,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsStartupActions, false),
+			},
+
 			"tags": tagsSchema(),
 
 			// Begin read only attributes
@@ -265,28 +489,35 @@ func resourceAwsVpnConnection() *schema.Resource {
 	}
 }
 
-func resourceAwsVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-
-	// Fill the tunnel options for the EC2 API
-	options := []*ec2.VpnTunnelOptionsSpecification{
-		{}, {},
+func resourceAwsVpnConnectionCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// A gateway ID that is not known yet has no value here but is still in
+	// the diff, so it counts as set.
+	isSet := func(k string) bool {
+		if _, ok := diff.GetOk(k); ok {
+			return true
+		}
+		return len(diff.GetChangedKeysPrefix(k)) > 0
 	}
 
-	if v, ok := d.GetOk("tunnel1_inside_cidr"); ok {
-		options[0].TunnelInsideCidr = aws.String(v.(string))
+	// ConflictsWith already rejects setting both.
+	if !isSet("vpn_gateway_id") && !isSet("transit_gateway_id") {
+		return fmt.Errorf("one of vpn_gateway_id or transit_gateway_id must be set")
 	}
 
-	if v, ok := d.GetOk("tunnel2_inside_cidr"); ok {
-		options[1].TunnelInsideCidr = aws.String(v.(string))
+	if v, ok := diff.GetOk("enable_acceleration"); ok && v.(bool) && !isSet("transit_gateway_id") {
+		return fmt.Errorf("enable_acceleration can only be set for connections to a transit gateway")
 	}
 
-	if v, ok := d.GetOk("tunnel1_preshared_key"); ok {
-		options[0].PreSharedKey = aws.String(v.(string))
-	}
+	return nil
+}
 
-	if v, ok := d.GetOk("tunnel2_preshared_key"); ok {
-		options[1].PreSharedKey = aws.String(v.(string))
+func resourceAwsVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Fill the tunnel options for the EC2 API
+	options := []*ec2.VpnTunnelOptionsSpecification{
+		expandVpnTunnelOptionsSpecification(d, "tunnel1_"),
+		expandVpnTunnelOptionsSpecification(d, "tunnel2_"),
 	}
 
 	connectOpts := &ec2.VpnConnectionOptionsSpecification{
@@ -294,11 +525,23 @@ func resourceAwsVpnConnectionCreate(d *schema.ResourceData, meta interface{}) er
 		TunnelOptions:    options,
 	}
 
+	// Acceleration is only supported for connections to a transit gateway.
+	if v, ok := d.GetOk("enable_acceleration"); ok {
+		connectOpts.EnableAcceleration = aws.Bool(v.(bool))
+	}
+
 	createOpts := &ec2.CreateVpnConnectionInput{
 		CustomerGatewayId: aws.String(d.Get("customer_gateway_id").(string)),
 		Options:           connectOpts,
 		Type:              aws.String(d.Get("type").(string)),
-		VpnGatewayId:      aws.String(d.Get("vpn_gateway_id").(string)),
+	}
+
+	if v, ok := d.GetOk("transit_gateway_id"); ok {
+		createOpts.TransitGatewayId = aws.String(v.(string))
+	} else if v, ok := d.GetOk("vpn_gateway_id"); ok {
+		createOpts.VpnGatewayId = aws.String(v.(string))
+	} else {
+		return fmt.Errorf("Error creating vpn connection: one of vpn_gateway_id or transit_gateway_id must be set")
 	}
 
 	// Create the VPN Connection
@@ -395,6 +638,7 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 
 	// Set attributes under the user's control.
 	d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId)
+	d.Set("transit_gateway_id", vpnConnection.TransitGatewayId)
 	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
 	d.Set("type", vpnConnection.Type)
 	d.Set("tags", tagsToMap(vpnConnection.Tags))
@@ -403,9 +647,11 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 		if err := d.Set("static_routes_only", vpnConnection.Options.StaticRoutesOnly); err != nil {
 			return err
 		}
+		d.Set("enable_acceleration", vpnConnection.Options.EnableAcceleration)
 	} else {
 		//If there no Options on the connection then we do not support *static_routes*
 		d.Set("static_routes_only", false)
+		d.Set("enable_acceleration", false)
 	}
 
 	// Set read only attributes.
@@ -427,6 +673,23 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("tunnel2_vgw_inside_address", tunnelInfo.Tunnel2VgwInsideAddress)
 			d.Set("tunnel2_bgp_asn", tunnelInfo.Tunnel2BGPASN)
 			d.Set("tunnel2_bgp_holdtime", tunnelInfo.Tunnel2BGPHoldTime)
+
+			// The tunnel options are keyed by outside address; match them
+			// to tunnels the same way the XML configuration is ordered.
+			if vpnConnection.Options != nil {
+				for _, option := range vpnConnection.Options.TunnelOptions {
+					switch aws.StringValue(option.OutsideIpAddress) {
+					case tunnelInfo.Tunnel1Address:
+						if err := flattenVpnTunnelOption(d, "tunnel1_", option); err != nil {
+							return err
+						}
+					case tunnelInfo.Tunnel2Address:
+						if err := flattenVpnTunnelOption(d, "tunnel2_", option); err != nil {
+							return err
+						}
+					}
+				}
+			}
 		}
 	}
 
@@ -443,6 +706,44 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	d.Partial(true)
+
+	// Tunnels are modified one at a time; each modification must complete
+	// before the next one is accepted.
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		if !vpnTunnelOptionsHasChange(d, prefix) {
+			continue
+		}
+
+		input := &ec2.ModifyVpnTunnelOptionsInput{
+			TunnelOptions:             expandModifyVpnTunnelOptionsSpecification(d, prefix),
+			VpnConnectionId:           aws.String(d.Id()),
+			VpnTunnelOutsideIpAddress: aws.String(d.Get(prefix + "address").(string)),
+		}
+
+		log.Printf("[DEBUG] Modifying VPN connection (%s) tunnel %s", d.Id(), d.Get(prefix+"address").(string))
+		if _, err := conn.ModifyVpnTunnelOptions(input); err != nil {
+			return fmt.Errorf("Error modifying VPN connection (%s) tunnel %s options: %s", d.Id(), d.Get(prefix+"address").(string), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{vpnConnectionStateModifying},
+			Target:     []string{ec2.VpnStateAvailable},
+			Refresh:    vpnConnectionRefreshFunc(conn, d.Id()),
+			Timeout:    40 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for VPN connection (%s) tunnel options to be modified: %s", d.Id(), err)
+		}
+
+		for _, k := range vpnTunnelOptionsKeys {
+			d.SetPartial(prefix + k)
+		}
+	}
+
 	// Update tags if required.
 	if err := setTags(conn, d); err != nil {
 		return err
@@ -450,6 +751,8 @@ func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetPartial("tags")
 
+	d.Partial(false)
+
 	return resourceAwsVpnConnectionRead(d, meta)
 }
 
@@ -557,6 +860,292 @@ func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
 	return &tunnelInfo, nil
 }
 
+// vpnConnectionStateModifying is reported while tunnel options are being
+// modified. It is not part of the VpnState enum.
+const vpnConnectionStateModifying = "modifying"
+
+const (
+	vpnTunnelOptionsDpdTimeoutActionClear = "clear"
+	vpnTunnelOptionsStartupActionAdd      = "add"
+)
+
+var vpnTunnelOptionsDpdTimeoutActions = []string{
+	vpnTunnelOptionsDpdTimeoutActionClear,
+	"none",
+	"restart",
+}
+
+var vpnTunnelOptionsStartupActions = []string{
+	vpnTunnelOptionsStartupActionAdd,
+	"start",
+}
+
+var vpnTunnelOptionsIkeVersions = []string{
+	"ikev1",
+	"ikev2",
+}
+
+var vpnTunnelOptionsEncryptionAlgorithms = []string{
+	"AES128",
+	"AES256",
+	"AES128-GCM-16",
+	"AES256-GCM-16",
+}
+
+var vpnTunnelOptionsIntegrityAlgorithms = []string{
+	"SHA1",
+	"SHA2-256",
+	"SHA2-384",
+	"SHA2-512",
+}
+
+var vpnTunnelOptionsPhase1DHGroupNumbers = []int{2, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
+
+var vpnTunnelOptionsPhase2DHGroupNumbers = []int{2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
+
+// vpnTunnelOptionsKeys are the per-tunnel attributes, without their
+// "tunnel1_" or "tunnel2_" prefix, that can be modified in place.
+var vpnTunnelOptionsKeys = []string{
+	"dpd_timeout_action",
+	"dpd_timeout_seconds",
+	"ike_versions",
+	"inside_cidr",
+	"phase1_dh_group_numbers",
+	"phase1_encryption_algorithms",
+	"phase1_integrity_algorithms",
+	"phase1_lifetime_seconds",
+	"phase2_dh_group_numbers",
+	"phase2_encryption_algorithms",
+	"phase2_integrity_algorithms",
+	"phase2_lifetime_seconds",
+	"preshared_key",
+	"startup_action",
+}
+
+func vpnTunnelOptionsHasChange(d *schema.ResourceData, prefix string) bool {
+	for _, k := range vpnTunnelOptionsKeys {
+		if d.HasChange(prefix + k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandVpnTunnelOptionsSpecification(d *schema.ResourceData, prefix string) *ec2.VpnTunnelOptionsSpecification {
+	options := &ec2.VpnTunnelOptionsSpecification{
+		DPDTimeoutAction:           aws.String(d.Get(prefix + "dpd_timeout_action").(string)),
+		DPDTimeoutSeconds:          aws.Int64(int64(d.Get(prefix + "dpd_timeout_seconds").(int))),
+		IKEVersions:                expandVpnTunnelIkeVersions(d.Get(prefix + "ike_versions").(*schema.Set)),
+		Phase1DHGroupNumbers:       expandVpnTunnelPhase1DHGroupNumbers(d.Get(prefix + "phase1_dh_group_numbers").(*schema.Set)),
+		Phase1EncryptionAlgorithms: expandVpnTunnelPhase1EncryptionAlgorithms(d.Get(prefix + "phase1_encryption_algorithms").(*schema.Set)),
+		Phase1IntegrityAlgorithms:  expandVpnTunnelPhase1IntegrityAlgorithms(d.Get(prefix + "phase1_integrity_algorithms").(*schema.Set)),
+		Phase1LifetimeSeconds:      aws.Int64(int64(d.Get(prefix + "phase1_lifetime_seconds").(int))),
+		Phase2DHGroupNumbers:       expandVpnTunnelPhase2DHGroupNumbers(d.Get(prefix + "phase2_dh_group_numbers").(*schema.Set)),
+		Phase2EncryptionAlgorithms: expandVpnTunnelPhase2EncryptionAlgorithms(d.Get(prefix + "phase2_encryption_algorithms").(*schema.Set)),
+		Phase2IntegrityAlgorithms:  expandVpnTunnelPhase2IntegrityAlgorithms(d.Get(prefix + "phase2_integrity_algorithms").(*schema.Set)),
+		Phase2LifetimeSeconds:      aws.Int64(int64(d.Get(prefix + "phase2_lifetime_seconds").(int))),
+		StartupAction:              aws.String(d.Get(prefix + "startup_action").(string)),
+	}
+
+	if v, ok := d.GetOk(prefix + "inside_cidr"); ok {
+		options.TunnelInsideCidr = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(prefix + "preshared_key"); ok {
+		options.PreSharedKey = aws.String(v.(string))
+	}
+
+	return options
+}
+
+// expandModifyVpnTunnelOptionsSpecification returns only the options that
+// have changed, as the API rejects requests that change nothing.
+func expandModifyVpnTunnelOptionsSpecification(d *schema.ResourceData, prefix string) *ec2.ModifyVpnTunnelOptionsSpecification {
+	options := &ec2.ModifyVpnTunnelOptionsSpecification{}
+
+	if d.HasChange(prefix + "dpd_timeout_action") {
+		options.DPDTimeoutAction = aws.String(d.Get(prefix + "dpd_timeout_action").(string))
+	}
+
+	if d.HasChange(prefix + "dpd_timeout_seconds") {
+		options.DPDTimeoutSeconds = aws.Int64(int64(d.Get(prefix + "dpd_timeout_seconds").(int)))
+	}
+
+	if d.HasChange(prefix + "ike_versions") {
+		options.IKEVersions = expandVpnTunnelIkeVersions(d.Get(prefix + "ike_versions").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "inside_cidr") {
+		options.TunnelInsideCidr = aws.String(d.Get(prefix + "inside_cidr").(string))
+	}
+
+	if d.HasChange(prefix + "phase1_dh_group_numbers") {
+		options.Phase1DHGroupNumbers = expandVpnTunnelPhase1DHGroupNumbers(d.Get(prefix + "phase1_dh_group_numbers").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase1_encryption_algorithms") {
+		options.Phase1EncryptionAlgorithms = expandVpnTunnelPhase1EncryptionAlgorithms(d.Get(prefix + "phase1_encryption_algorithms").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase1_integrity_algorithms") {
+		options.Phase1IntegrityAlgorithms = expandVpnTunnelPhase1IntegrityAlgorithms(d.Get(prefix + "phase1_integrity_algorithms").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase1_lifetime_seconds") {
+		options.Phase1LifetimeSeconds = aws.Int64(int64(d.Get(prefix + "phase1_lifetime_seconds").(int)))
+	}
+
+	if d.HasChange(prefix + "phase2_dh_group_numbers") {
+		options.Phase2DHGroupNumbers = expandVpnTunnelPhase2DHGroupNumbers(d.Get(prefix + "phase2_dh_group_numbers").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase2_encryption_algorithms") {
+		options.Phase2EncryptionAlgorithms = expandVpnTunnelPhase2EncryptionAlgorithms(d.Get(prefix + "phase2_encryption_algorithms").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase2_integrity_algorithms") {
+		options.Phase2IntegrityAlgorithms = expandVpnTunnelPhase2IntegrityAlgorithms(d.Get(prefix + "phase2_integrity_algorithms").(*schema.Set))
+	}
+
+	if d.HasChange(prefix + "phase2_lifetime_seconds") {
+		options.Phase2LifetimeSeconds = aws.Int64(int64(d.Get(prefix + "phase2_lifetime_seconds").(int)))
+	}
+
+	if d.HasChange(prefix + "preshared_key") {
+		options.PreSharedKey = aws.String(d.Get(prefix + "preshared_key").(string))
+	}
+
+	if d.HasChange(prefix + "startup_action") {
+		options.StartupAction = aws.String(d.Get(prefix + "startup_action").(string))
+	}
+
+	return options
+}
+
+func flattenVpnTunnelOption(d *schema.ResourceData, prefix string, option *ec2.TunnelOption) error {
+	d.Set(prefix+"dpd_timeout_action", option.DpdTimeoutAction)
+	d.Set(prefix+"dpd_timeout_seconds", option.DpdTimeoutSeconds)
+	d.Set(prefix+"inside_cidr", option.TunnelInsideCidr)
+	d.Set(prefix+"phase1_lifetime_seconds", option.Phase1LifetimeSeconds)
+	d.Set(prefix+"phase2_lifetime_seconds", option.Phase2LifetimeSeconds)
+	d.Set(prefix+"startup_action", option.StartupAction)
+
+	var ikeVersions []string
+	for _, v := range option.IkeVersions {
+		ikeVersions = append(ikeVersions, aws.StringValue(v.Value))
+	}
+	if err := d.Set(prefix+"ike_versions", ikeVersions); err != nil {
+		return fmt.Errorf("error setting %sike_versions: %s", prefix, err)
+	}
+
+	var phase1DHGroupNumbers []int
+	for _, v := range option.Phase1DHGroupNumbers {
+		phase1DHGroupNumbers = append(phase1DHGroupNumbers, int(aws.Int64Value(v.Value)))
+	}
+	if err := d.Set(prefix+"phase1_dh_group_numbers", phase1DHGroupNumbers); err != nil {
+		return fmt.Errorf("error setting %sphase1_dh_group_numbers: %s", prefix, err)
+	}
+
+	var phase1EncryptionAlgorithms []string
+	for _, v := range option.Phase1EncryptionAlgorithms {
+		phase1EncryptionAlgorithms = append(phase1EncryptionAlgorithms, aws.StringValue(v.Value))
+	}
+	if err := d.Set(prefix+"phase1_encryption_algorithms", phase1EncryptionAlgorithms); err != nil {
+		return fmt.Errorf("error setting %sphase1_encryption_algorithms: %s", prefix, err)
+	}
+
+	var phase1IntegrityAlgorithms []string
+	for _, v := range option.Phase1IntegrityAlgorithms {
+		phase1IntegrityAlgorithms = append(phase1IntegrityAlgorithms, aws.StringValue(v.Value))
+	}
+	if err := d.Set(prefix+"phase1_integrity_algorithms", phase1IntegrityAlgorithms); err != nil {
+		return fmt.Errorf("error setting %sphase1_integrity_algorithms: %s", prefix, err)
+	}
+
+	var phase2DHGroupNumbers []int
+	for _, v := range option.Phase2DHGroupNumbers {
+		phase2DHGroupNumbers = append(phase2DHGroupNumbers, int(aws.Int64Value(v.Value)))
+	}
+	if err := d.Set(prefix+"phase2_dh_group_numbers", phase2DHGroupNumbers); err != nil {
+		return fmt.Errorf("error setting %sphase2_dh_group_numbers: %s", prefix, err)
+	}
+
+	var phase2EncryptionAlgorithms []string
+	for _, v := range option.Phase2EncryptionAlgorithms {
+		phase2EncryptionAlgorithms = append(phase2EncryptionAlgorithms, aws.StringValue(v.Value))
+	}
+	if err := d.Set(prefix+"phase2_encryption_algorithms", phase2EncryptionAlgorithms); err != nil {
+		return fmt.Errorf("error setting %sphase2_encryption_algorithms: %s", prefix, err)
+	}
+
+	var phase2IntegrityAlgorithms []string
+	for _, v := range option.Phase2IntegrityAlgorithms {
+		phase2IntegrityAlgorithms = append(phase2IntegrityAlgorithms, aws.StringValue(v.Value))
+	}
+	if err := d.Set(prefix+"phase2_integrity_algorithms", phase2IntegrityAlgorithms); err != nil {
+		return fmt.Errorf("error setting %sphase2_integrity_algorithms: %s", prefix, err)
+	}
+
+	return nil
+}
+
+func expandVpnTunnelIkeVersions(s *schema.Set) []*ec2.IKEVersionsRequestListValue {
+	var l []*ec2.IKEVersionsRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.IKEVersionsRequestListValue{Value: aws.String(v.(string))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase1DHGroupNumbers(s *schema.Set) []*ec2.Phase1DHGroupNumbersRequestListValue {
+	var l []*ec2.Phase1DHGroupNumbersRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase1DHGroupNumbersRequestListValue{Value: aws.Int64(int64(v.(int)))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase1EncryptionAlgorithms(s *schema.Set) []*ec2.Phase1EncryptionAlgorithmsRequestListValue {
+	var l []*ec2.Phase1EncryptionAlgorithmsRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase1EncryptionAlgorithmsRequestListValue{Value: aws.String(v.(string))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase1IntegrityAlgorithms(s *schema.Set) []*ec2.Phase1IntegrityAlgorithmsRequestListValue {
+	var l []*ec2.Phase1IntegrityAlgorithmsRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase1IntegrityAlgorithmsRequestListValue{Value: aws.String(v.(string))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase2DHGroupNumbers(s *schema.Set) []*ec2.Phase2DHGroupNumbersRequestListValue {
+	var l []*ec2.Phase2DHGroupNumbersRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase2DHGroupNumbersRequestListValue{Value: aws.Int64(int64(v.(int)))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase2EncryptionAlgorithms(s *schema.Set) []*ec2.Phase2EncryptionAlgorithmsRequestListValue {
+	var l []*ec2.Phase2EncryptionAlgorithmsRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase2EncryptionAlgorithmsRequestListValue{Value: aws.String(v.(string))})
+	}
+	return l
+}
+
+func expandVpnTunnelPhase2IntegrityAlgorithms(s *schema.Set) []*ec2.Phase2IntegrityAlgorithmsRequestListValue {
+	var l []*ec2.Phase2IntegrityAlgorithmsRequestListValue
+	for _, v := range s.List() {
+		l = append(l, &ec2.Phase2IntegrityAlgorithmsRequestListValue{Value: aws.String(v.(string))})
+	}
+	return l
+}
+
 func validateVpnConnectionTunnelPreSharedKey(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -149,6 +149,114 @@ func TestAccAWSVpnConnection_tunnelOptions(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpnConnection_tunnelOptionsUpdate(t *testing.T) {
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	var vpn1, vpn2 ec2.VpnConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpn_connection.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccAwsVpnConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpnConnectionConfigTunnelOptions(rBgpAsn, "12345678", "169.254.8.0/30", "abcdefgh", "169.254.9.0/30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnection(
+						"aws_vpc.vpc",
+						"aws_vpn_gateway.vpn_gateway",
+						"aws_customer_gateway.customer_gateway",
+						"aws_vpn_connection.foo",
+						&vpn1,
+					),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_dpd_timeout_action", "clear"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_startup_action", "add"),
+				),
+			},
+			{
+				Config: testAccAwsVpnConnectionConfigTunnelOptionsModified(rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnection(
+						"aws_vpc.vpc",
+						"aws_vpn_gateway.vpn_gateway",
+						"aws_customer_gateway.customer_gateway",
+						"aws_vpn_connection.foo",
+						&vpn2,
+					),
+					testAccCheckAwsVpnConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_inside_cidr", "169.254.10.0/30"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_preshared_key", "87654321"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_dpd_timeout_action", "restart"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_dpd_timeout_seconds", "45"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_ike_versions.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase1_dh_group_numbers.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase1_encryption_algorithms.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase1_integrity_algorithms.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase1_lifetime_seconds", "3600"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase2_dh_group_numbers.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase2_encryption_algorithms.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase2_integrity_algorithms.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase2_lifetime_seconds", "1800"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_startup_action", "start"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel2_inside_cidr", "169.254.9.0/30"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel2_preshared_key", "abcdefgh"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel2_startup_action", "add"),
+				),
+			},
+			// Removing the algorithm and IKE version lists keeps their current values.
+			{
+				Config: testAccAwsVpnConnectionConfigTunnelOptions(rBgpAsn, "87654321", "169.254.10.0/30", "abcdefgh", "169.254.9.0/30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_ike_versions.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase1_dh_group_numbers.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "tunnel1_phase2_encryption_algorithms.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpnConnection_transitGatewayAcceleration(t *testing.T) {
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpn_connection.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccAwsVpnConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpnConnectionConfigTransitGatewayAcceleration(rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "enable_acceleration", "true"),
+					resource.TestCheckResourceAttrPair("aws_vpn_connection.foo", "transit_gateway_id", "aws_ec2_transit_gateway.test", "id"),
+					resource.TestCheckResourceAttr("aws_vpn_connection.foo", "vpn_gateway_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpnConnection_gatewayValidation(t *testing.T) {
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAwsVpnConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsVpnConnectionConfigNoGateway(rBgpAsn),
+				ExpectError: regexp.MustCompile(`one of vpn_gateway_id or transit_gateway_id must be set`),
+			},
+			{
+				Config:      testAccAwsVpnConnectionConfigVpnGatewayAcceleration(rBgpAsn),
+				ExpectError: regexp.MustCompile(`enable_acceleration can only be set for connections to a transit gateway`),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpnConnection_withoutStaticRoutes(t *testing.T) {
 	rInt := acctest.RandInt()
 	rBgpAsn := acctest.RandIntRange(64512, 65534)
@@ -246,6 +354,16 @@ func testAccAWSVpnConnectionDisappears(connection *ec2.VpnConnection) resource.T
 	}
 }
 
+func testAccCheckAwsVpnConnectionNotRecreated(before, after *ec2.VpnConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.VpnConnectionId) != aws.StringValue(after.VpnConnectionId) {
+			return fmt.Errorf("VPN connection recreated: %s -> %s", aws.StringValue(before.VpnConnectionId), aws.StringValue(after.VpnConnectionId))
+		}
+
+		return nil
+	}
+}
+
 func testAccAwsVpnConnectionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 	for _, rs := range s.RootModule().Resources {
@@ -483,6 +601,116 @@ resource "aws_vpn_connection" "foo" {
     `, rBgpAsn, tunnelCidr, psk, tunnelCidr2, psk2)
 }
 
+func testAccAwsVpnConnectionConfigTunnelOptionsModified(rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "vpn_gateway" {
+  tags {
+    Name = "vpn_gateway"
+  }
+}
+
+resource "aws_customer_gateway" "customer_gateway" {
+  bgp_asn = %d
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+  tags {
+    Name = "main-customer-gateway"
+  }
+}
+
+resource "aws_vpn_connection" "foo" {
+  vpn_gateway_id = "${aws_vpn_gateway.vpn_gateway.id}"
+  customer_gateway_id = "${aws_customer_gateway.customer_gateway.id}"
+  type = "ipsec.1"
+  static_routes_only = false
+
+  tunnel1_inside_cidr = "169.254.10.0/30"
+  tunnel1_preshared_key = "87654321"
+  tunnel1_dpd_timeout_action = "restart"
+  tunnel1_dpd_timeout_seconds = 45
+  tunnel1_ike_versions = ["ikev2"]
+  tunnel1_phase1_dh_group_numbers = [14, 20]
+  tunnel1_phase1_encryption_algorithms = ["AES256"]
+  tunnel1_phase1_integrity_algorithms = ["SHA2-256"]
+  tunnel1_phase1_lifetime_seconds = 3600
+  tunnel1_phase2_dh_group_numbers = [14]
+  tunnel1_phase2_encryption_algorithms = ["AES256-GCM-16"]
+  tunnel1_phase2_integrity_algorithms = ["SHA2-256"]
+  tunnel1_phase2_lifetime_seconds = 1800
+  tunnel1_startup_action = "start"
+
+  tunnel2_inside_cidr = "169.254.9.0/30"
+  tunnel2_preshared_key = "abcdefgh"
+}
+`, rBgpAsn)
+}
+
+func testAccAwsVpnConnectionConfigTransitGatewayAcceleration(rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_customer_gateway" "customer_gateway" {
+  bgp_asn = %d
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+  tags {
+    Name = "main-customer-gateway"
+  }
+}
+
+resource "aws_vpn_connection" "foo" {
+  transit_gateway_id = "${aws_ec2_transit_gateway.test.id}"
+  customer_gateway_id = "${aws_customer_gateway.customer_gateway.id}"
+  type = "ipsec.1"
+  enable_acceleration = true
+}
+`, rBgpAsn)
+}
+
+func testAccAwsVpnConnectionConfigNoGateway(rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_customer_gateway" "customer_gateway" {
+  bgp_asn = %d
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+  tags {
+    Name = "main-customer-gateway"
+  }
+}
+
+resource "aws_vpn_connection" "foo" {
+  customer_gateway_id = "${aws_customer_gateway.customer_gateway.id}"
+  type = "ipsec.1"
+}
+`, rBgpAsn)
+}
+
+func testAccAwsVpnConnectionConfigVpnGatewayAcceleration(rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "vpn_gateway" {
+  tags {
+    Name = "vpn_gateway"
+  }
+}
+
+resource "aws_customer_gateway" "customer_gateway" {
+  bgp_asn = %d
+  ip_address = "178.0.0.1"
+  type = "ipsec.1"
+  tags {
+    Name = "main-customer-gateway"
+  }
+}
+
+resource "aws_vpn_connection" "foo" {
+  vpn_gateway_id = "${aws_vpn_gateway.vpn_gateway.id}"
+  customer_gateway_id = "${aws_customer_gateway.customer_gateway.id}"
+  type = "ipsec.1"
+  enable_acceleration = true
+}
+`, rBgpAsn)
+}
+
 // Test our VPN tunnel config XML parsing
 const testAccAwsVpnTunnelInfoXML = `
 <vpn_connection id="vpn-abc123">
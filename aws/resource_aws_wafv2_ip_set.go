@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWafv2IPSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2IPSetCreate,
+		Read:   resourceAwsWafv2IPSetRead,
+		Update: resourceAwsWafv2IPSetUpdate,
+		Delete: resourceAwsWafv2IPSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafv2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10000,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"ip_address_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(wafv2.IPAddressVersion_Values(), false),
+			},
+			"lock_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name":  wafv2NameSchema(),
+			"scope": wafv2ScopeSchema(),
+			"tags":  tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWafv2IPSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.CreateIPSetInput{
+		Addresses:        expandStringSet(d.Get("addresses").(*schema.Set)),
+		IPAddressVersion: aws.String(d.Get("ip_address_version").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		Scope:            aws.String(d.Get("scope").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapWafv2(v)
+	}
+
+	log.Printf("[DEBUG] Creating WAFv2 IP Set: %s", input)
+	output, err := conn.CreateIPSet(input)
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 IP Set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Summary.Id))
+
+	return resourceAwsWafv2IPSetRead(d, meta)
+}
+
+func resourceAwsWafv2IPSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetIPSet(&wafv2.GetIPSetInput{
+		Id:    aws.String(d.Id()),
+		Name:  aws.String(d.Get("name").(string)),
+		Scope: aws.String(d.Get("scope").(string)),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 IP Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 IP Set (%s): %s", d.Id(), err)
+	}
+
+	ipSet := output.IPSet
+	arn := aws.StringValue(ipSet.ARN)
+
+	d.Set("arn", arn)
+	d.Set("description", ipSet.Description)
+	d.Set("ip_address_version", ipSet.IPAddressVersion)
+	d.Set("lock_token", output.LockToken)
+	d.Set("name", ipSet.Name)
+
+	if err := d.Set("addresses", schema.NewSet(schema.HashString, flattenStringList(ipSet.Addresses))); err != nil {
+		return fmt.Errorf("error setting addresses: %s", err)
+	}
+
+	tags, err := getTagsWafv2(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAFv2 IP Set (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafv2IPSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	if d.HasChange("addresses") || d.HasChange("description") {
+		input := &wafv2.UpdateIPSetInput{
+			Addresses: expandStringSet(d.Get("addresses").(*schema.Set)),
+			Id:        aws.String(d.Id()),
+			LockToken: aws.String(d.Get("lock_token").(string)),
+			Name:      aws.String(d.Get("name").(string)),
+			Scope:     aws.String(d.Get("scope").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating WAFv2 IP Set: %s", input)
+		_, err := conn.UpdateIPSet(input)
+		if err != nil {
+			return fmt.Errorf("error updating WAFv2 IP Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWafv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating WAFv2 IP Set (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWafv2IPSetRead(d, meta)
+}
+
+func resourceAwsWafv2IPSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.DeleteIPSetInput{
+		Id:        aws.String(d.Id()),
+		LockToken: aws.String(d.Get("lock_token").(string)),
+		Name:      aws.String(d.Get("name").(string)),
+		Scope:     aws.String(d.Get("scope").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting WAFv2 IP Set: %s", input)
+	err := wafv2DeleteAssociatedItem(func() error {
+		_, err := conn.DeleteIPSet(input)
+		return err
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 IP Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWafv2RegexPatternSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2RegexPatternSetCreate,
+		Read:   resourceAwsWafv2RegexPatternSetRead,
+		Update: resourceAwsWafv2RegexPatternSetUpdate,
+		Delete: resourceAwsWafv2RegexPatternSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafv2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"lock_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": wafv2NameSchema(),
+			"regular_expression": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"regex_string": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 200),
+						},
+					},
+				},
+			},
+			"scope": wafv2ScopeSchema(),
+			"tags":  tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWafv2RegexPatternSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.CreateRegexPatternSetInput{
+		Name:                  aws.String(d.Get("name").(string)),
+		RegularExpressionList: expandWafv2RegularExpressions(d.Get("regular_expression").(*schema.Set).List()),
+		Scope:                 aws.String(d.Get("scope").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapWafv2(v)
+	}
+
+	log.Printf("[DEBUG] Creating WAFv2 Regex Pattern Set: %s", input)
+	output, err := conn.CreateRegexPatternSet(input)
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 Regex Pattern Set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Summary.Id))
+
+	return resourceAwsWafv2RegexPatternSetRead(d, meta)
+}
+
+func resourceAwsWafv2RegexPatternSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetRegexPatternSet(&wafv2.GetRegexPatternSetInput{
+		Id:    aws.String(d.Id()),
+		Name:  aws.String(d.Get("name").(string)),
+		Scope: aws.String(d.Get("scope").(string)),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 Regex Pattern Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Regex Pattern Set (%s): %s", d.Id(), err)
+	}
+
+	regexPatternSet := output.RegexPatternSet
+	arn := aws.StringValue(regexPatternSet.ARN)
+
+	d.Set("arn", arn)
+	d.Set("description", regexPatternSet.Description)
+	d.Set("lock_token", output.LockToken)
+	d.Set("name", regexPatternSet.Name)
+
+	if err := d.Set("regular_expression", flattenWafv2RegularExpressions(regexPatternSet.RegularExpressionList)); err != nil {
+		return fmt.Errorf("error setting regular_expression: %s", err)
+	}
+
+	tags, err := getTagsWafv2(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAFv2 Regex Pattern Set (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafv2RegexPatternSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	if d.HasChange("description") || d.HasChange("regular_expression") {
+		input := &wafv2.UpdateRegexPatternSetInput{
+			Id:                    aws.String(d.Id()),
+			LockToken:             aws.String(d.Get("lock_token").(string)),
+			Name:                  aws.String(d.Get("name").(string)),
+			RegularExpressionList: expandWafv2RegularExpressions(d.Get("regular_expression").(*schema.Set).List()),
+			Scope:                 aws.String(d.Get("scope").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating WAFv2 Regex Pattern Set: %s", input)
+		_, err := conn.UpdateRegexPatternSet(input)
+		if err != nil {
+			return fmt.Errorf("error updating WAFv2 Regex Pattern Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWafv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating WAFv2 Regex Pattern Set (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWafv2RegexPatternSetRead(d, meta)
+}
+
+func resourceAwsWafv2RegexPatternSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.DeleteRegexPatternSetInput{
+		Id:        aws.String(d.Id()),
+		LockToken: aws.String(d.Get("lock_token").(string)),
+		Name:      aws.String(d.Get("name").(string)),
+		Scope:     aws.String(d.Get("scope").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting WAFv2 Regex Pattern Set: %s", input)
+	err := wafv2DeleteAssociatedItem(func() error {
+		_, err := conn.DeleteRegexPatternSet(input)
+		return err
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 Regex Pattern Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWafv2RegularExpressions(l []interface{}) []*wafv2.Regex {
+	regexes := make([]*wafv2.Regex, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		regexes = append(regexes, &wafv2.Regex{
+			RegexString: aws.String(m["regex_string"].(string)),
+		})
+	}
+
+	return regexes
+}
+
+func flattenWafv2RegularExpressions(regexes []*wafv2.Regex) []interface{} {
+	l := make([]interface{}, 0, len(regexes))
+
+	for _, regex := range regexes {
+		l = append(l, map[string]interface{}{
+			"regex_string": aws.StringValue(regex.RegexString),
+		})
+	}
+
+	return l
+}
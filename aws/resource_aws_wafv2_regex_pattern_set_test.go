@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafv2RegexPatternSet_basic(t *testing.T) {
+	resourceName := "aws_wafv2_regex_pattern_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWafv2RegexPatternSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWafv2RegexPatternSetConfig(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2RegexPatternSetExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`regional/regexpatternset/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "regular_expression.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "REGIONAL"),
+				),
+			},
+			{
+				Config: testAccAwsWafv2RegexPatternSetConfig(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2RegexPatternSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "regular_expression.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAwsWafv2ImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"lock_token"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWafv2RegexPatternSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+		_, err := conn.GetRegexPatternSet(&wafv2.GetRegexPatternSetInput{
+			Id:    aws.String(rs.Primary.ID),
+			Name:  aws.String(rs.Primary.Attributes["name"]),
+			Scope: aws.String(rs.Primary.Attributes["scope"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsWafv2RegexPatternSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafv2_regex_pattern_set" {
+			continue
+		}
+
+		_, err := conn.GetRegexPatternSet(&wafv2.GetRegexPatternSetInput{
+			Id:    aws.String(rs.Primary.ID),
+			Name:  aws.String(rs.Primary.Attributes["name"]),
+			Scope: aws.String(rs.Primary.Attributes["scope"]),
+		})
+		if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WAFv2 Regex Pattern Set (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsWafv2RegexPatternSetConfig(rName, pattern string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_regex_pattern_set" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  regular_expression {
+    regex_string = "^/admin/"
+  }
+
+  regular_expression {
+    regex_string = "^/%[2]s/"
+  }
+}
+`, rName, pattern)
+}
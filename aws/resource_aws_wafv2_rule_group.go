@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWafv2RuleGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2RuleGroupCreate,
+		Read:   resourceAwsWafv2RuleGroupRead,
+		Update: resourceAwsWafv2RuleGroupUpdate,
+		Delete: resourceAwsWafv2RuleGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafv2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"lock_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": wafv2NameSchema(),
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": wafv2RuleActionSchema(),
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"statement":         wafv2RuleGroupRootStatementSchema(wafv2StatementNestingLevel),
+						"visibility_config": wafv2VisibilityConfigSchema(),
+					},
+				},
+			},
+			"scope":             wafv2ScopeSchema(),
+			"tags":              tagsSchema(),
+			"visibility_config": wafv2VisibilityConfigSchema(),
+		},
+	}
+}
+
+func resourceAwsWafv2RuleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.CreateRuleGroupInput{
+		Capacity:         aws.Int64(int64(d.Get("capacity").(int))),
+		Name:             aws.String(d.Get("name").(string)),
+		Rules:            expandWafv2Rules(d.Get("rule").(*schema.Set).List()),
+		Scope:            aws.String(d.Get("scope").(string)),
+		VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapWafv2(v)
+	}
+
+	log.Printf("[DEBUG] Creating WAFv2 Rule Group: %s", input)
+	var output *wafv2.CreateRuleGroupOutput
+	err := wafv2RetryUnavailableEntity(func() error {
+		var err error
+		output, err = conn.CreateRuleGroup(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 Rule Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Summary.Id))
+
+	return resourceAwsWafv2RuleGroupRead(d, meta)
+}
+
+func resourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetRuleGroup(&wafv2.GetRuleGroupInput{
+		Id:    aws.String(d.Id()),
+		Name:  aws.String(d.Get("name").(string)),
+		Scope: aws.String(d.Get("scope").(string)),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 Rule Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Rule Group (%s): %s", d.Id(), err)
+	}
+
+	ruleGroup := output.RuleGroup
+	arn := aws.StringValue(ruleGroup.ARN)
+
+	d.Set("arn", arn)
+	d.Set("capacity", ruleGroup.Capacity)
+	d.Set("description", ruleGroup.Description)
+	d.Set("lock_token", output.LockToken)
+	d.Set("name", ruleGroup.Name)
+
+	if err := d.Set("rule", flattenWafv2Rules(ruleGroup.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	if err := d.Set("visibility_config", flattenWafv2VisibilityConfig(ruleGroup.VisibilityConfig)); err != nil {
+		return fmt.Errorf("error setting visibility_config: %s", err)
+	}
+
+	tags, err := getTagsWafv2(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAFv2 Rule Group (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafv2RuleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	if d.HasChange("description") || d.HasChange("rule") || d.HasChange("visibility_config") {
+		input := &wafv2.UpdateRuleGroupInput{
+			Id:               aws.String(d.Id()),
+			LockToken:        aws.String(d.Get("lock_token").(string)),
+			Name:             aws.String(d.Get("name").(string)),
+			Rules:            expandWafv2Rules(d.Get("rule").(*schema.Set).List()),
+			Scope:            aws.String(d.Get("scope").(string)),
+			VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating WAFv2 Rule Group: %s", input)
+		err := wafv2RetryUnavailableEntity(func() error {
+			_, err := conn.UpdateRuleGroup(input)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WAFv2 Rule Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWafv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating WAFv2 Rule Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWafv2RuleGroupRead(d, meta)
+}
+
+func resourceAwsWafv2RuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.DeleteRuleGroupInput{
+		Id:        aws.String(d.Id()),
+		LockToken: aws.String(d.Get("lock_token").(string)),
+		Name:      aws.String(d.Get("name").(string)),
+		Scope:     aws.String(d.Get("scope").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting WAFv2 Rule Group: %s", input)
+	err := wafv2DeleteAssociatedItem(func() error {
+		_, err := conn.DeleteRuleGroup(input)
+		return err
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 Rule Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWafv2WebACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2WebACLCreate,
+		Read:   resourceAwsWafv2WebACLRead,
+		Update: resourceAwsWafv2WebACLUpdate,
+		Delete: resourceAwsWafv2WebACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafv2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow": wafv2EmptySchema(),
+						"block": wafv2EmptySchema(),
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"lock_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": wafv2NameSchema(),
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allow": wafv2EmptySchema(),
+									"block": wafv2EmptySchema(),
+									"count": wafv2EmptySchema(),
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"override_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": wafv2EmptySchema(),
+									"none":  wafv2EmptySchema(),
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"statement":         wafv2WebACLRootStatementSchema(wafv2StatementNestingLevel),
+						"visibility_config": wafv2VisibilityConfigSchema(),
+					},
+				},
+			},
+			"scope":             wafv2ScopeSchema(),
+			"tags":              tagsSchema(),
+			"visibility_config": wafv2VisibilityConfigSchema(),
+		},
+	}
+}
+
+func resourceAwsWafv2WebACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.CreateWebACLInput{
+		DefaultAction:    expandWafv2DefaultAction(d.Get("default_action").([]interface{})),
+		Name:             aws.String(d.Get("name").(string)),
+		Rules:            expandWafv2Rules(d.Get("rule").(*schema.Set).List()),
+		Scope:            aws.String(d.Get("scope").(string)),
+		VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = tagsFromMapWafv2(v)
+	}
+
+	log.Printf("[DEBUG] Creating WAFv2 Web ACL: %s", input)
+	var output *wafv2.CreateWebACLOutput
+	err := wafv2RetryUnavailableEntity(func() error {
+		var err error
+		output, err = conn.CreateWebACL(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 Web ACL: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Summary.Id))
+
+	return resourceAwsWafv2WebACLRead(d, meta)
+}
+
+func resourceAwsWafv2WebACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetWebACL(&wafv2.GetWebACLInput{
+		Id:    aws.String(d.Id()),
+		Name:  aws.String(d.Get("name").(string)),
+		Scope: aws.String(d.Get("scope").(string)),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 Web ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Web ACL (%s): %s", d.Id(), err)
+	}
+
+	webACL := output.WebACL
+	arn := aws.StringValue(webACL.ARN)
+
+	d.Set("arn", arn)
+	d.Set("capacity", webACL.Capacity)
+	d.Set("description", webACL.Description)
+	d.Set("lock_token", output.LockToken)
+	d.Set("name", webACL.Name)
+
+	if err := d.Set("default_action", flattenWafv2DefaultAction(webACL.DefaultAction)); err != nil {
+		return fmt.Errorf("error setting default_action: %s", err)
+	}
+
+	if err := d.Set("rule", flattenWafv2Rules(webACL.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	if err := d.Set("visibility_config", flattenWafv2VisibilityConfig(webACL.VisibilityConfig)); err != nil {
+		return fmt.Errorf("error setting visibility_config: %s", err)
+	}
+
+	tags, err := getTagsWafv2(conn, arn)
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAFv2 Web ACL (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafv2WebACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	if d.HasChange("default_action") || d.HasChange("description") || d.HasChange("rule") || d.HasChange("visibility_config") {
+		input := &wafv2.UpdateWebACLInput{
+			DefaultAction:    expandWafv2DefaultAction(d.Get("default_action").([]interface{})),
+			Id:               aws.String(d.Id()),
+			LockToken:        aws.String(d.Get("lock_token").(string)),
+			Name:             aws.String(d.Get("name").(string)),
+			Rules:            expandWafv2Rules(d.Get("rule").(*schema.Set).List()),
+			Scope:            aws.String(d.Get("scope").(string)),
+			VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating WAFv2 Web ACL: %s", input)
+		err := wafv2RetryUnavailableEntity(func() error {
+			_, err := conn.UpdateWebACL(input)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WAFv2 Web ACL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWafv2(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating WAFv2 Web ACL (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWafv2WebACLRead(d, meta)
+}
+
+func resourceAwsWafv2WebACLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	input := &wafv2.DeleteWebACLInput{
+		Id:        aws.String(d.Id()),
+		LockToken: aws.String(d.Get("lock_token").(string)),
+		Name:      aws.String(d.Get("name").(string)),
+		Scope:     aws.String(d.Get("scope").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting WAFv2 Web ACL: %s", input)
+	err := wafv2DeleteAssociatedItem(func() error {
+		_, err := conn.DeleteWebACL(input)
+		return err
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 Web ACL (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWafv2DefaultAction(l []interface{}) *wafv2.DefaultAction {
+	action := &wafv2.DefaultAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allow"].([]interface{}); ok && len(v) > 0 {
+		action.Allow = &wafv2.AllowAction{}
+	}
+
+	if v, ok := m["block"].([]interface{}); ok && len(v) > 0 {
+		action.Block = &wafv2.BlockAction{}
+	}
+
+	return action
+}
+
+func flattenWafv2DefaultAction(action *wafv2.DefaultAction) []interface{} {
+	if action == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if action.Allow != nil {
+		m["allow"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if action.Block != nil {
+		m["block"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafv2WebACLAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2WebACLAssociationCreate,
+		Read:   resourceAwsWafv2WebACLAssociationRead,
+		Delete: resourceAwsWafv2WebACLAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"web_acl_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsWafv2WebACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	webACLArn := d.Get("web_acl_arn").(string)
+	resourceArn := d.Get("resource_arn").(string)
+
+	input := &wafv2.AssociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+		WebACLArn:   aws.String(webACLArn),
+	}
+
+	log.Printf("[DEBUG] Creating WAFv2 Web ACL Association: %s", input)
+	err := wafv2RetryUnavailableEntity(func() error {
+		_, err := conn.AssociateWebACL(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating WAFv2 Web ACL Association: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", webACLArn, resourceArn))
+
+	return resourceAwsWafv2WebACLAssociationRead(d, meta)
+}
+
+func resourceAwsWafv2WebACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	webACLArn, resourceArn, err := decodeWafv2WebACLAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 Web ACL Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Web ACL Association (%s): %s", d.Id(), err)
+	}
+
+	if output.WebACL == nil || aws.StringValue(output.WebACL.ARN) != webACLArn {
+		log.Printf("[WARN] WAFv2 Web ACL Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", resourceArn)
+	d.Set("web_acl_arn", webACLArn)
+
+	return nil
+}
+
+func resourceAwsWafv2WebACLAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	_, resourceArn, err := decodeWafv2WebACLAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting WAFv2 Web ACL Association: %s", d.Id())
+	_, err = conn.DisassociateWebACL(&wafv2.DisassociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 Web ACL Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeWafv2WebACLAssociationID splits an ID of the form
+// WEB_ACL_ARN,RESOURCE_ARN. A comma is used as both ARNs contain colons.
+func decodeWafv2WebACLAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected WEB_ACL_ARN,RESOURCE_ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafv2WebACLAssociation_basic(t *testing.T) {
+	resourceName := "aws_wafv2_web_acl_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWafv2WebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWafv2WebACLAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_lb.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWafv2WebACLAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		webACLArn, resourceArn, err := decodeWafv2WebACLAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+		output, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.WebACL == nil || aws.StringValue(output.WebACL.ARN) != webACLArn {
+			return fmt.Errorf("WAFv2 Web ACL Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsWafv2WebACLAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafv2_web_acl_association" {
+			continue
+		}
+
+		_, resourceArn, err := decodeWafv2WebACLAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if output.WebACL != nil {
+			return fmt.Errorf("WAFv2 Web ACL Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsWafv2WebACLAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.1.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+}
+
+resource "aws_lb" "test" {
+  name     = %[1]q
+  internal = true
+  subnets  = ["${aws_subnet.test.*.id}"]
+}
+
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = "${aws_lb.test.arn}"
+  web_acl_arn  = "${aws_wafv2_web_acl.test.arn}"
+}
+`, rName)
+}
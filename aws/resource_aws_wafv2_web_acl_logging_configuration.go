@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafv2WebACLLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafv2WebACLLoggingConfigurationPut,
+		Read:   resourceAwsWafv2WebACLLoggingConfigurationRead,
+		Update: resourceAwsWafv2WebACLLoggingConfigurationPut,
+		Delete: resourceAwsWafv2WebACLLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_destination_configs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"redacted_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method":        wafv2EmptySchema(),
+						"query_string":  wafv2EmptySchema(),
+						"single_header": wafv2NamedFieldSchema(),
+						"uri_path":      wafv2EmptySchema(),
+					},
+				},
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsWafv2WebACLLoggingConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	resourceArn := d.Get("resource_arn").(string)
+	config := &wafv2.LoggingConfiguration{
+		LogDestinationConfigs: expandStringSet(d.Get("log_destination_configs").(*schema.Set)),
+		ResourceArn:           aws.String(resourceArn),
+	}
+
+	for _, raw := range d.Get("redacted_fields").([]interface{}) {
+		config.RedactedFields = append(config.RedactedFields, expandWafv2FieldToMatch([]interface{}{raw}))
+	}
+
+	input := &wafv2.PutLoggingConfigurationInput{
+		LoggingConfiguration: config,
+	}
+
+	log.Printf("[DEBUG] Putting WAFv2 Web ACL Logging Configuration: %s", input)
+	_, err := conn.PutLoggingConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error putting WAFv2 Web ACL Logging Configuration (%s): %s", resourceArn, err)
+	}
+
+	d.SetId(resourceArn)
+
+	return resourceAwsWafv2WebACLLoggingConfigurationRead(d, meta)
+}
+
+func resourceAwsWafv2WebACLLoggingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetLoggingConfiguration(&wafv2.GetLoggingConfigurationInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAFv2 Web ACL Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Web ACL Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	config := output.LoggingConfiguration
+
+	d.Set("resource_arn", config.ResourceArn)
+
+	if err := d.Set("log_destination_configs", schema.NewSet(schema.HashString, flattenStringList(config.LogDestinationConfigs))); err != nil {
+		return fmt.Errorf("error setting log_destination_configs: %s", err)
+	}
+
+	redactedFields := make([]interface{}, 0, len(config.RedactedFields))
+	for _, field := range config.RedactedFields {
+		redactedFields = append(redactedFields, flattenWafv2FieldToMatch(field)...)
+	}
+
+	if err := d.Set("redacted_fields", redactedFields); err != nil {
+		return fmt.Errorf("error setting redacted_fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafv2WebACLLoggingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafv2conn
+
+	log.Printf("[DEBUG] Deleting WAFv2 Web ACL Logging Configuration: %s", d.Id())
+	_, err := conn.DeleteLoggingConfiguration(&wafv2.DeleteLoggingConfigurationInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WAFv2 Web ACL Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
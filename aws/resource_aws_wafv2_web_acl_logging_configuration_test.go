@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafv2WebACLLoggingConfiguration_basic(t *testing.T) {
+	resourceName := "aws_wafv2_web_acl_logging_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWafv2WebACLLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWafv2WebACLLoggingConfigurationConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_destination_configs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAwsWafv2WebACLLoggingConfigurationConfig(rName, testAccAwsWafv2WebACLLoggingConfigurationRedactedFields),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.0.uri_path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.1.single_header.0.name", "authorization"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWafv2WebACLLoggingConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+		_, err := conn.GetLoggingConfiguration(&wafv2.GetLoggingConfigurationInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsWafv2WebACLLoggingConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafv2_web_acl_logging_configuration" {
+			continue
+		}
+
+		_, err := conn.GetLoggingConfiguration(&wafv2.GetLoggingConfigurationInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WAFv2 Web ACL Logging Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAwsWafv2WebACLLoggingConfigurationRedactedFields = `
+  redacted_fields {
+    uri_path {}
+  }
+
+  redacted_fields {
+    single_header {
+      name = "authorization"
+    }
+  }
+`
+
+func testAccAwsWafv2WebACLLoggingConfigurationConfig(rName, redactedFields string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "firehose.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  # WAFv2 requires delivery stream names to start with aws-waf-logs-.
+  name        = "aws-waf-logs-%[1]s"
+  destination = "s3"
+
+  s3_configuration {
+    role_arn   = "${aws_iam_role.test.arn}"
+    bucket_arn = "${aws_s3_bucket.test.arn}"
+  }
+}
+
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_logging_configuration" "test" {
+  resource_arn            = "${aws_wafv2_web_acl.test.arn}"
+  log_destination_configs = ["${aws_kinesis_firehose_delivery_stream.test.arn}"]
+%[2]s
+}
+`, rName, redactedFields)
+}
@@ -0,0 +1,504 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesDirectoryCreate,
+		Read:   resourceAwsWorkspacesDirectoryRead,
+		Update: resourceAwsWorkspacesDirectoryUpdate,
+		Delete: resourceAwsWorkspacesDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"self_service_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"change_compute_type": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"increase_volume_size": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"rebuild_workspace": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"restart_workspace": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"switch_running_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"workspace_access_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_type_android":    workspacesAccessPropertySchema(),
+						"device_type_chromeos":   workspacesAccessPropertySchema(),
+						"device_type_ios":        workspacesAccessPropertySchema(),
+						"device_type_linux":      workspacesAccessPropertySchema(),
+						"device_type_osx":        workspacesAccessPropertySchema(),
+						"device_type_web":        workspacesAccessPropertySchema(),
+						"device_type_windows":    workspacesAccessPropertySchema(),
+						"device_type_zeroclient": workspacesAccessPropertySchema(),
+					},
+				},
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registration_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func workspacesAccessPropertySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+	}
+}
+
+func resourceAwsWorkspacesDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directoryId := d.Get("directory_id").(string)
+
+	input := &workspaces.RegisterWorkspaceDirectoryInput{
+		DirectoryId:       aws.String(directoryId),
+		EnableSelfService: aws.Bool(false), // this is handled separately below
+		EnableWorkDocs:    aws.Bool(false),
+		Tenancy:           aws.String(workspaces.TenancyShared),
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok {
+		input.SubnetIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapWorkspaces(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Registering WorkSpaces Directory: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.RegisterWorkspaceDirectory(input)
+		if err != nil {
+			// The directory may not be ready to be registered immediately after creation.
+			if isAWSErr(err, workspaces.ErrCodeInvalidResourceStateException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error registering WorkSpaces Directory (%s): %s", directoryId, err)
+	}
+
+	d.SetId(directoryId)
+
+	if err := waitForWorkspacesDirectoryState(conn, d.Id(),
+		[]string{workspaces.WorkspaceDirectoryStateRegistering},
+		[]string{workspaces.WorkspaceDirectoryStateRegistered}); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) registration: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("self_service_permissions"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) self-service permissions", d.Id())
+		_, err := conn.ModifySelfservicePermissions(&workspaces.ModifySelfservicePermissionsInput{
+			ResourceId:             aws.String(d.Id()),
+			SelfservicePermissions: expandWorkspacesSelfServicePermissions(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting WorkSpaces Directory (%s) self-service permissions: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("workspace_access_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) access properties", d.Id())
+		_, err := conn.ModifyWorkspaceAccessProperties(&workspaces.ModifyWorkspaceAccessPropertiesInput{
+			ResourceId:                aws.String(d.Id()),
+			WorkspaceAccessProperties: expandWorkspacesAccessProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting WorkSpaces Directory (%s) access properties: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("ip_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups", d.Id())
+		_, err := conn.AssociateIpGroups(&workspaces.AssociateIpGroupsInput{
+			DirectoryId: aws.String(d.Id()),
+			GroupIds:    expandStringSet(v.(*schema.Set)),
+		})
+		if err != nil {
+			return fmt.Errorf("error associating WorkSpaces Directory (%s) with IP Groups: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesDirectoryRead(d, meta)
+}
+
+func resourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directory, err := getWorkspacesDirectory(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+	if directory == nil || aws.StringValue(directory.State) == workspaces.WorkspaceDirectoryStateDeregistered {
+		log.Printf("[WARN] WorkSpaces Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", directory.DirectoryId)
+	d.Set("alias", directory.Alias)
+	d.Set("customer_user_name", directory.CustomerUserName)
+	d.Set("directory_name", directory.DirectoryName)
+	d.Set("directory_type", directory.DirectoryType)
+	d.Set("iam_role_id", directory.IamRoleId)
+	d.Set("registration_code", directory.RegistrationCode)
+	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+
+	if err := d.Set("subnet_ids", flattenStringList(directory.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+	if err := d.Set("ip_group_ids", flattenStringList(directory.IpGroupIds)); err != nil {
+		return fmt.Errorf("error setting ip_group_ids: %s", err)
+	}
+	if err := d.Set("dns_ip_addresses", flattenStringList(directory.DnsIpAddresses)); err != nil {
+		return fmt.Errorf("error setting dns_ip_addresses: %s", err)
+	}
+	if err := d.Set("self_service_permissions", flattenWorkspacesSelfServicePermissions(directory.SelfservicePermissions)); err != nil {
+		return fmt.Errorf("error setting self_service_permissions: %s", err)
+	}
+	if err := d.Set("workspace_access_properties", flattenWorkspacesAccessProperties(directory.WorkspaceAccessProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_access_properties: %s", err)
+	}
+
+	tags, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapWorkspaces(tags.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("self_service_permissions") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) self-service permissions", d.Id())
+		_, err := conn.ModifySelfservicePermissions(&workspaces.ModifySelfservicePermissionsInput{
+			ResourceId:             aws.String(d.Id()),
+			SelfservicePermissions: expandWorkspacesSelfServicePermissions(d.Get("self_service_permissions").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Directory (%s) self-service permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("workspace_access_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) access properties", d.Id())
+		_, err := conn.ModifyWorkspaceAccessProperties(&workspaces.ModifyWorkspaceAccessPropertiesInput{
+			ResourceId:                aws.String(d.Id()),
+			WorkspaceAccessProperties: expandWorkspacesAccessProperties(d.Get("workspace_access_properties").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Directory (%s) access properties: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ip_group_ids") {
+		o, n := d.GetChange("ip_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			log.Printf("[DEBUG] Disassociating WorkSpaces Directory (%s) from IP Groups", d.Id())
+			_, err := conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
+				DirectoryId: aws.String(d.Id()),
+				GroupIds:    expandStringSet(remove),
+			})
+			if err != nil {
+				return fmt.Errorf("error disassociating WorkSpaces Directory (%s) from IP Groups: %s", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups", d.Id())
+			_, err := conn.AssociateIpGroups(&workspaces.AssociateIpGroupsInput{
+				DirectoryId: aws.String(d.Id()),
+				GroupIds:    expandStringSet(add),
+			})
+			if err != nil {
+				return fmt.Errorf("error associating WorkSpaces Directory (%s) with IP Groups: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := setTagsWorkspaces(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating WorkSpaces Directory (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesDirectoryRead(d, meta)
+}
+
+func resourceAwsWorkspacesDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Deregistering WorkSpaces Directory: %s", d.Id())
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeregisterWorkspaceDirectory(&workspaces.DeregisterWorkspaceDirectoryInput{
+			DirectoryId: aws.String(d.Id()),
+		})
+		if err != nil {
+			// WorkSpaces in the directory may still be terminating.
+			if isAWSErr(err, workspaces.ErrCodeInvalidResourceStateException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deregistering WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForWorkspacesDirectoryState(conn, d.Id(),
+		[]string{workspaces.WorkspaceDirectoryStateRegistering, workspaces.WorkspaceDirectoryStateRegistered, workspaces.WorkspaceDirectoryStateDeregistering},
+		[]string{workspaces.WorkspaceDirectoryStateDeregistered}); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) deregistration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getWorkspacesDirectory(conn *workspaces.WorkSpaces, directoryId string) (*workspaces.WorkspaceDirectory, error) {
+	resp, err := conn.DescribeWorkspaceDirectories(&workspaces.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: []*string{aws.String(directoryId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Directories) == 0 {
+		return nil, nil
+	}
+
+	return resp.Directories[0], nil
+}
+
+func waitForWorkspacesDirectoryState(conn *workspaces.WorkSpaces, directoryId string, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			directory, err := getWorkspacesDirectory(conn, directoryId)
+			if err != nil {
+				return nil, "", err
+			}
+			if directory == nil {
+				return 42, workspaces.WorkspaceDirectoryStateDeregistered, nil
+			}
+
+			state := aws.StringValue(directory.State)
+			if state == workspaces.WorkspaceDirectoryStateError {
+				return directory, state, fmt.Errorf("%s", aws.StringValue(directory.ErrorMessage))
+			}
+
+			return directory, state, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandWorkspacesSelfServicePermissions(permissions []interface{}) *workspaces.SelfservicePermissions {
+	if len(permissions) == 0 || permissions[0] == nil {
+		return nil
+	}
+
+	m := permissions[0].(map[string]interface{})
+
+	return &workspaces.SelfservicePermissions{
+		ChangeComputeType:  aws.String(expandWorkspacesReconnectEnum(m["change_compute_type"].(bool))),
+		IncreaseVolumeSize: aws.String(expandWorkspacesReconnectEnum(m["increase_volume_size"].(bool))),
+		RebuildWorkspace:   aws.String(expandWorkspacesReconnectEnum(m["rebuild_workspace"].(bool))),
+		RestartWorkspace:   aws.String(expandWorkspacesReconnectEnum(m["restart_workspace"].(bool))),
+		SwitchRunningMode:  aws.String(expandWorkspacesReconnectEnum(m["switch_running_mode"].(bool))),
+	}
+}
+
+func expandWorkspacesReconnectEnum(enabled bool) string {
+	if enabled {
+		return workspaces.ReconnectEnumEnabled
+	}
+	return workspaces.ReconnectEnumDisabled
+}
+
+func flattenWorkspacesSelfServicePermissions(permissions *workspaces.SelfservicePermissions) []interface{} {
+	if permissions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"change_compute_type":  aws.StringValue(permissions.ChangeComputeType) == workspaces.ReconnectEnumEnabled,
+		"increase_volume_size": aws.StringValue(permissions.IncreaseVolumeSize) == workspaces.ReconnectEnumEnabled,
+		"rebuild_workspace":    aws.StringValue(permissions.RebuildWorkspace) == workspaces.ReconnectEnumEnabled,
+		"restart_workspace":    aws.StringValue(permissions.RestartWorkspace) == workspaces.ReconnectEnumEnabled,
+		"switch_running_mode":  aws.StringValue(permissions.SwitchRunningMode) == workspaces.ReconnectEnumEnabled,
+	}
+
+	return []interface{}{m}
+}
+
+func expandWorkspacesAccessProperties(properties []interface{}) *workspaces.WorkspaceAccessProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	m := properties[0].(map[string]interface{})
+	result := &workspaces.WorkspaceAccessProperties{}
+
+	if v, ok := m["device_type_android"].(string); ok && v != "" {
+		result.DeviceTypeAndroid = aws.String(v)
+	}
+	if v, ok := m["device_type_chromeos"].(string); ok && v != "" {
+		result.DeviceTypeChromeOs = aws.String(v)
+	}
+	if v, ok := m["device_type_ios"].(string); ok && v != "" {
+		result.DeviceTypeIos = aws.String(v)
+	}
+	if v, ok := m["device_type_linux"].(string); ok && v != "" {
+		result.DeviceTypeLinux = aws.String(v)
+	}
+	if v, ok := m["device_type_osx"].(string); ok && v != "" {
+		result.DeviceTypeOsx = aws.String(v)
+	}
+	if v, ok := m["device_type_web"].(string); ok && v != "" {
+		result.DeviceTypeWeb = aws.String(v)
+	}
+	if v, ok := m["device_type_windows"].(string); ok && v != "" {
+		result.DeviceTypeWindows = aws.String(v)
+	}
+	if v, ok := m["device_type_zeroclient"].(string); ok && v != "" {
+		result.DeviceTypeZeroClient = aws.String(v)
+	}
+
+	return result
+}
+
+func flattenWorkspacesAccessProperties(properties *workspaces.WorkspaceAccessProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"device_type_android":    aws.StringValue(properties.DeviceTypeAndroid),
+		"device_type_chromeos":   aws.StringValue(properties.DeviceTypeChromeOs),
+		"device_type_ios":        aws.StringValue(properties.DeviceTypeIos),
+		"device_type_linux":      aws.StringValue(properties.DeviceTypeLinux),
+		"device_type_osx":        aws.StringValue(properties.DeviceTypeOsx),
+		"device_type_web":        aws.StringValue(properties.DeviceTypeWeb),
+		"device_type_windows":    aws.StringValue(properties.DeviceTypeWindows),
+		"device_type_zeroclient": aws.StringValue(properties.DeviceTypeZeroClient),
+	}
+
+	return []interface{}{m}
+}
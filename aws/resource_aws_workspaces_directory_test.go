@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsWorkspacesDirectory_basic(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "directory_type", "SIMPLE_AD"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.change_compute_type", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.increase_volume_size", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.rebuild_workspace", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.restart_workspace", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.switch_running_mode", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ip_addresses.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_code"),
+					resource.TestCheckResourceAttrSet(resourceName, "workspace_security_group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesDirectory_selfServicePermissions(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_selfServicePermissions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.change_compute_type", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.increase_volume_size", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.rebuild_workspace", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.restart_workspace", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.switch_running_mode", "true"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_selfServicePermissions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.change_compute_type", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.increase_volume_size", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.rebuild_workspace", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.restart_workspace", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_permissions.0.switch_running_mode", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesDirectory_workspaceAccessProperties(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_workspaceAccessProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_android", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_ios", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_linux", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_web", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_zeroclient", "DENY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesDirectory_ipGroupIds(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_ipGroupIds(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ip_group_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesDirectoryConfig_ipGroupIds(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ip_group_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesDirectory_tags(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesDirectoryConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesDirectoryConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_directory" {
+			continue
+		}
+
+		directory, err := getWorkspacesDirectory(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if directory != nil && aws.StringValue(directory.State) != workspaces.WorkspaceDirectoryStateDeregistered {
+			return fmt.Errorf("WorkSpaces Directory %q still registered", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceDirectory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Directory ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		directory, err := getWorkspacesDirectory(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if directory == nil {
+			return fmt.Errorf("WorkSpaces Directory %q not found", rs.Primary.ID)
+		}
+
+		*v = *directory
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-workspaces-directory-%[1]s"
+  }
+}
+
+resource "aws_subnet" "primary" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2a"
+  cidr_block        = "10.0.1.0/24"
+
+  tags {
+    Name = "tf-acc-workspaces-directory-%[1]s-primary"
+  }
+}
+
+resource "aws_subnet" "secondary" {
+  vpc_id            = "${aws_vpc.test.id}"
+  availability_zone = "us-west-2b"
+  cidr_block        = "10.0.2.0/24"
+
+  tags {
+    Name = "tf-acc-workspaces-directory-%[1]s-secondary"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  name     = "corp.tf-acc-%[1]s.com"
+  password = "#S1ncerely"
+  size     = "Small"
+
+  vpc_settings {
+    vpc_id     = "${aws_vpc.test.id}"
+    subnet_ids = ["${aws_subnet.primary.id}", "${aws_subnet.secondary.id}"]
+  }
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesDirectoryConfig_basic(rName string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + `
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+}
+`
+}
+
+func testAccAwsWorkspacesDirectoryConfig_selfServicePermissions(rName string, enabled bool) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+
+  self_service_permissions {
+    change_compute_type  = %[1]t
+    increase_volume_size = %[1]t
+    rebuild_workspace    = %[1]t
+    restart_workspace    = %[2]t
+    switch_running_mode  = %[1]t
+  }
+}
+`, enabled, !enabled)
+}
+
+func testAccAwsWorkspacesDirectoryConfig_workspaceAccessProperties(rName string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + `
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+
+  workspace_access_properties {
+    device_type_android    = "ALLOW"
+    device_type_ios        = "ALLOW"
+    device_type_linux      = "DENY"
+    device_type_web        = "DENY"
+    device_type_zeroclient = "DENY"
+  }
+}
+`
+}
+
+func testAccAwsWorkspacesDirectoryConfig_ipGroupIds(rName, ipGroup string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "first" {
+  name = "tf-acc-test-%[1]s-first"
+
+  rules {
+    source = "10.0.0.0/16"
+  }
+}
+
+resource "aws_workspaces_ip_group" "second" {
+  name = "tf-acc-test-%[1]s-second"
+
+  rules {
+    source = "10.10.0.0/16"
+  }
+}
+
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+  ip_group_ids = ["${aws_workspaces_ip_group.%[2]s.id}"]
+}
+`, rName, ipGroup)
+}
+
+func testAccAwsWorkspacesDirectoryConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+
+  tags {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsWorkspacesDirectoryConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+
+  tags {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
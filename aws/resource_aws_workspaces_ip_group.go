@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWorkspacesIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupCreate,
+		Read:   resourceAwsWorkspacesIpGroupRead,
+		Update: resourceAwsWorkspacesIpGroupUpdate,
+		Delete: resourceAwsWorkspacesIpGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.CreateIpGroupInput{
+		GroupName: aws.String(d.Get("name").(string)),
+		UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.GroupDesc = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapWorkspaces(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces IP Group: %s", input)
+	resp, err := conn.CreateIpGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces IP Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.GroupId))
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	resp, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+		GroupIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.Result) == 0 {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ipGroup := resp.Result[0]
+
+	d.Set("name", ipGroup.GroupName)
+	d.Set("description", ipGroup.GroupDesc)
+	if err := d.Set("rules", flattenWorkspacesIpGroupRules(ipGroup.UserRules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	tags, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapWorkspaces(tags.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("rules") {
+		log.Printf("[DEBUG] Updating WorkSpaces IP Group (%s) rules", d.Id())
+		_, err := conn.UpdateRulesOfIpGroup(&workspaces.UpdateRulesOfIpGroupInput{
+			GroupId:   aws.String(d.Id()),
+			UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWorkspaces(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces IP Group: %s", d.Id())
+	_, err := conn.DeleteIpGroup(&workspaces.DeleteIpGroupInput{
+		GroupId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesIpGroupRules(rules []interface{}) []*workspaces.IpRuleItem {
+	result := make([]*workspaces.IpRuleItem, 0, len(rules))
+	for _, rule := range rules {
+		r := rule.(map[string]interface{})
+
+		item := &workspaces.IpRuleItem{
+			IpRule: aws.String(r["source"].(string)),
+		}
+		if v, ok := r["description"].(string); ok && v != "" {
+			item.RuleDesc = aws.String(v)
+		}
+
+		result = append(result, item)
+	}
+	return result
+}
+
+func flattenWorkspacesIpGroupRules(rules []*workspaces.IpRuleItem) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"source":      aws.StringValue(rule.IpRule),
+			"description": aws.StringValue(rule.RuleDesc),
+		}
+		result = append(result, r)
+	}
+	return result
+}
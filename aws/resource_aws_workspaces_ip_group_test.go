@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsWorkspacesIpGroup_basic(t *testing.T) {
+	var v workspaces.IpGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_workspaces_ip_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfig_rulesUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesIpGroup_tags(t *testing.T) {
+	var v workspaces.IpGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_workspaces_ip_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesIpGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_ip_group" {
+			continue
+		}
+
+		resp, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Result) > 0 {
+			return fmt.Errorf("WorkSpaces IP Group %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesIpGroupExists(n string, v *workspaces.IpGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces IP Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		resp, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Result) == 0 {
+			return fmt.Errorf("WorkSpaces IP Group %q not found", rs.Primary.ID)
+		}
+
+		*v = *resp.Result[0]
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesIpGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Terraform acceptance test"
+
+  rules {
+    source = "10.0.0.0/16"
+  }
+
+  rules {
+    source      = "10.0.0.1/32"
+    description = "Home"
+  }
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesIpGroupConfig_rulesUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Terraform acceptance test"
+
+  rules {
+    source      = "10.0.0.1/32"
+    description = "Office"
+  }
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
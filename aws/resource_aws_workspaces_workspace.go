@@ -0,0 +1,418 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.ComputeValue,
+							ValidateFunc: validation.StringInSlice(workspaces.Compute_Values(), false),
+						},
+						"root_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"running_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.RunningModeAlwaysOn,
+							ValidateFunc: validation.StringInSlice(workspaces.RunningMode_Values(), false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateWorkspacesRunningModeAutoStopTimeout,
+						},
+						"user_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		request.Tags = tagsFromMapWorkspaces(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", request)
+	resp, err := conn.CreateWorkspaces(&workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s", err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	if len(resp.PendingRequests) == 0 {
+		return fmt.Errorf("error creating WorkSpaces Workspace: no pending request returned")
+	}
+
+	d.SetId(aws.StringValue(resp.PendingRequests[0].WorkspaceId))
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(),
+		[]string{workspaces.WorkspaceStatePending, workspaces.WorkspaceStateStarting},
+		[]string{workspaces.WorkspaceStateAvailable, workspaces.WorkspaceStateStopped},
+		d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := getWorkspacesWorkspace(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+	if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("user_name", workspace.UserName)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("state", workspace.State)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tags, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapWorkspaces(tags.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	// WorkSpaces only allows a single property to be modified per request,
+	// so each changed property is sent separately.
+	if d.HasChange("workspace_properties.0.compute_type_name") {
+		if err := modifyWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			ComputeTypeName: aws.String(d.Get("workspace_properties.0.compute_type_name").(string)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.root_volume_size_gib") {
+		if err := modifyWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			RootVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.root_volume_size_gib").(int))),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.user_volume_size_gib") {
+		if err := modifyWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			UserVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.user_volume_size_gib").(int))),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.running_mode") || d.HasChange("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes") {
+		properties := &workspaces.WorkspaceProperties{
+			RunningMode: aws.String(d.Get("workspace_properties.0.running_mode").(string)),
+		}
+		if aws.StringValue(properties.RunningMode) == workspaces.RunningModeAutoStop {
+			if v, ok := d.GetOk("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes"); ok {
+				properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v.(int)))
+			}
+		}
+		if err := modifyWorkspacesWorkspaceProperties(conn, d, properties); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsWorkspaces(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", d.Id())
+	resp, err := conn.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(),
+		[]string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateRestoring,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateUpdating,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateTerminating,
+		},
+		[]string{workspaces.WorkspaceStateTerminated},
+		d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) termination: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func modifyWorkspacesWorkspaceProperties(conn *workspaces.WorkSpaces, d *schema.ResourceData, properties *workspaces.WorkspaceProperties) error {
+	log.Printf("[DEBUG] Modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), properties)
+	_, err := conn.ModifyWorkspaceProperties(&workspaces.ModifyWorkspacePropertiesInput{
+		WorkspaceId:         aws.String(d.Id()),
+		WorkspaceProperties: properties,
+	})
+	if err != nil {
+		return fmt.Errorf("error modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), err)
+	}
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(),
+		[]string{workspaces.WorkspaceStateUpdating},
+		[]string{workspaces.WorkspaceStateAvailable, workspaces.WorkspaceStateStopped},
+		d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) properties update: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getWorkspacesWorkspace(conn *workspaces.WorkSpaces, workspaceId string) (*workspaces.Workspace, error) {
+	resp, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: []*string{aws.String(workspaceId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Workspaces) == 0 {
+		return nil, nil
+	}
+
+	return resp.Workspaces[0], nil
+}
+
+func waitForWorkspacesWorkspaceState(conn *workspaces.WorkSpaces, workspaceId string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			workspace, err := getWorkspacesWorkspace(conn, workspaceId)
+			if err != nil {
+				return nil, "", err
+			}
+			if workspace == nil {
+				return 42, workspaces.WorkspaceStateTerminated, nil
+			}
+
+			state := aws.StringValue(workspace.State)
+			if state == workspaces.WorkspaceStateError {
+				return workspace, state, fmt.Errorf("%s: %s", aws.StringValue(workspace.ErrorCode), aws.StringValue(workspace.ErrorMessage))
+			}
+
+			return workspace, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func validateWorkspacesRunningModeAutoStopTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value <= 0 || value%60 != 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a positive multiple of 60, got: %d", k, value))
+	}
+	return
+}
+
+func expandWorkspacesWorkspaceProperties(properties []interface{}) *workspaces.WorkspaceProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	m := properties[0].(map[string]interface{})
+
+	result := &workspaces.WorkspaceProperties{
+		ComputeTypeName: aws.String(m["compute_type_name"].(string)),
+		RunningMode:     aws.String(m["running_mode"].(string)),
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		result.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		result.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+	if aws.StringValue(result.RunningMode) == workspaces.RunningModeAutoStop {
+		if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 {
+			result.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+		}
+	}
+
+	return result
+}
+
+func flattenWorkspacesWorkspaceProperties(properties *workspaces.WorkspaceProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(properties.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(properties.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(properties.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(properties.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(properties.UserVolumeSizeGib)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsWorkspacesWorkspace_basic(t *testing.T) {
+	var v workspaces.Workspace
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_workspaces_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", "wsb-bh8rsxt14"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "Administrator"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "user_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.compute_type_name", "VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "ALWAYS_ON"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesWorkspace_workspaceProperties(t *testing.T) {
+	var v workspaces.Workspace
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig_workspaceProperties(rName, "AUTO_STOP", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "AUTO_STOP"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfig_workspaceProperties(rName, "AUTO_STOP", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "AUTO_STOP"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesWorkspace_tags(t *testing.T) {
+	var v workspaces.Workspace
+	rName := acctest.RandString(8)
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateWorkspacesRunningModeAutoStopTimeout(t *testing.T) {
+	validValues := []int{60, 120, 180, 360}
+	for _, v := range validValues {
+		_, errors := validateWorkspacesRunningModeAutoStopTimeout(v, "running_mode_auto_stop_timeout_in_minutes")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid timeout: %q", v, errors)
+		}
+	}
+
+	invalidValues := []int{-60, 0, 59, 90}
+	for _, v := range invalidValues {
+		_, errors := validateWorkspacesRunningModeAutoStopTimeout(v, "running_mode_auto_stop_timeout_in_minutes")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid timeout", v)
+		}
+	}
+}
+
+func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := getWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace != nil && aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+			return fmt.Errorf("WorkSpaces Workspace %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesWorkspaceExists(n string, v *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Workspace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		workspace, err := getWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace == nil {
+			return fmt.Errorf("WorkSpaces Workspace %q not found", rs.Primary.ID)
+		}
+
+		*v = *workspace
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName string) string {
+	return testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName) + `
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+}
+`
+}
+
+func testAccAwsWorkspacesWorkspaceConfig_basic(rName string) string {
+	return testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName) + `
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "wsb-bh8rsxt14"
+  directory_id = "${aws_workspaces_directory.test.id}"
+
+  # NOTE: WorkSpaces API doesn't allow creating users in the directory.
+  # However, "Administrator" user is always present in a bare directory.
+  user_name = "Administrator"
+}
+`
+}
+
+func testAccAwsWorkspacesWorkspaceConfig_workspaceProperties(rName, runningMode string, timeout int) string {
+	return testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "wsb-bh8rsxt14"
+  directory_id = "${aws_workspaces_directory.test.id}"
+  user_name    = "Administrator"
+
+  workspace_properties {
+    compute_type_name                         = "VALUE"
+    running_mode                              = %[1]q
+    running_mode_auto_stop_timeout_in_minutes = %[2]d
+  }
+}
+`, runningMode, timeout)
+}
+
+func testAccAwsWorkspacesWorkspaceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName) + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "wsb-bh8rsxt14"
+  directory_id = "${aws_workspaces_directory.test.id}"
+  user_name    = "Administrator"
+
+  tags {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsXrayGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsXrayGroupCreate,
+		Read:   resourceAwsXrayGroupRead,
+		Update: resourceAwsXrayGroupUpdate,
+		Delete: resourceAwsXrayGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"filter_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"insights_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"insights_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"notifications_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	input := &xray.CreateGroupInput{
+		GroupName:        aws.String(d.Get("group_name").(string)),
+		FilterExpression: aws.String(d.Get("filter_expression").(string)),
+	}
+
+	if v, ok := d.GetOk("insights_configuration"); ok {
+		input.InsightsConfiguration = expandXrayGroupInsightsConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapXray(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating XRay Group: %s", input)
+	out, err := conn.CreateGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating XRay Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.Group.GroupARN))
+
+	return resourceAwsXrayGroupRead(d, meta)
+}
+
+func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	out, err := conn.GetGroup(&xray.GetGroupInput{
+		GroupARN: aws.String(d.Id()),
+	})
+	if isAWSErr(err, xray.ErrCodeInvalidRequestException, "Group not found") {
+		log.Printf("[WARN] XRay Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading XRay Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.Group.GroupARN)
+	d.Set("group_name", out.Group.GroupName)
+	d.Set("filter_expression", out.Group.FilterExpression)
+
+	if err := d.Set("insights_configuration", flattenXrayGroupInsightsConfiguration(out.Group.InsightsConfiguration)); err != nil {
+		return fmt.Errorf("error setting insights_configuration: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&xray.ListTagsForResourceInput{
+		ResourceARN: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for XRay Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapXray(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	if d.HasChange("filter_expression") || d.HasChange("insights_configuration") {
+		input := &xray.UpdateGroupInput{
+			GroupARN:         aws.String(d.Id()),
+			FilterExpression: aws.String(d.Get("filter_expression").(string)),
+		}
+
+		if v, ok := d.GetOk("insights_configuration"); ok {
+			input.InsightsConfiguration = expandXrayGroupInsightsConfiguration(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating XRay Group: %s", input)
+		_, err := conn.UpdateGroup(input)
+		if err != nil {
+			return fmt.Errorf("error updating XRay Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsXray(conn, d, d.Id()); err != nil {
+			return fmt.Errorf("error updating XRay Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsXrayGroupRead(d, meta)
+}
+
+func resourceAwsXrayGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	log.Printf("[INFO] Deleting XRay Group: %s", d.Id())
+	_, err := conn.DeleteGroup(&xray.DeleteGroupInput{
+		GroupARN: aws.String(d.Id()),
+	})
+	if isAWSErr(err, xray.ErrCodeInvalidRequestException, "Group not found") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting XRay Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandXrayGroupInsightsConfiguration(l []interface{}) *xray.InsightsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &xray.InsightsConfiguration{
+		InsightsEnabled: aws.Bool(m["insights_enabled"].(bool)),
+	}
+
+	if v, ok := m["notifications_enabled"].(bool); ok {
+		config.NotificationsEnabled = aws.Bool(v)
+	}
+
+	return config
+}
+
+func flattenXrayGroupInsightsConfiguration(config *xray.InsightsConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"insights_enabled":      aws.BoolValue(config.InsightsEnabled),
+		"notifications_enabled": aws.BoolValue(config.NotificationsEnabled),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSXrayGroup_basic(t *testing.T) {
+	var group xray.Group
+	resourceName := "aws_xray_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := rName[len(rName)-20:]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXrayGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayGroupConfig_basic(groupName, "responsetime > 5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:xray:[^:]+:\d{12}:group/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "group_name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression", "responsetime > 5"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXrayGroupConfig_basic(groupName, "responsetime > 10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression", "responsetime > 10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSXrayGroup_insights(t *testing.T) {
+	var group xray.Group
+	resourceName := "aws_xray_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := rName[len(rName)-20:]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXrayGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayGroupConfig_insights(groupName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.insights_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.notifications_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSXrayGroupConfig_insights(groupName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.insights_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.notifications_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSXrayGroup_tags(t *testing.T) {
+	var group xray.Group
+	resourceName := "aws_xray_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := rName[len(rName)-20:]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXrayGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayGroupConfig_tags1(groupName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSXrayGroupConfig_tags1(groupName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXrayGroupExists(n string, group *xray.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+		out, err := conn.GetGroup(&xray.GetGroupInput{
+			GroupARN: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*group = *out.Group
+
+		return nil
+	}
+}
+
+func testAccCheckAWSXrayGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_xray_group" {
+			continue
+		}
+
+		_, err := conn.GetGroup(&xray.GetGroupInput{
+			GroupARN: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, xray.ErrCodeInvalidRequestException, "Group not found") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("XRay Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSXrayGroupConfig_basic(groupName, filterExpression string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = %[2]q
+}
+`, groupName, filterExpression)
+}
+
+func testAccAWSXrayGroupConfig_insights(groupName string, insightsEnabled, notificationsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = "responsetime > 5"
+
+  insights_configuration {
+    insights_enabled      = %[2]t
+    notifications_enabled = %[3]t
+  }
+}
+`, groupName, insightsEnabled, notificationsEnabled)
+}
+
+func testAccAWSXrayGroupConfig_tags1(groupName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = "responsetime > 5"
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, groupName, tagKey1, tagValue1)
+}
@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsXraySamplingRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsXraySamplingRuleCreate,
+		Read:   resourceAwsXraySamplingRuleRead,
+		Update: resourceAwsXraySamplingRuleUpdate,
+		Delete: resourceAwsXraySamplingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 9999),
+			},
+			"fixed_rate": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"reservoir_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"service_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"http_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+			},
+			"url_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsXraySamplingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	samplingRule := &xray.SamplingRule{
+		RuleName:      aws.String(d.Get("rule_name").(string)),
+		ResourceARN:   aws.String(d.Get("resource_arn").(string)),
+		Priority:      aws.Int64(int64(d.Get("priority").(int))),
+		FixedRate:     aws.Float64(d.Get("fixed_rate").(float64)),
+		ReservoirSize: aws.Int64(int64(d.Get("reservoir_size").(int))),
+		ServiceName:   aws.String(d.Get("service_name").(string)),
+		ServiceType:   aws.String(d.Get("service_type").(string)),
+		Host:          aws.String(d.Get("host").(string)),
+		HTTPMethod:    aws.String(d.Get("http_method").(string)),
+		URLPath:       aws.String(d.Get("url_path").(string)),
+		Version:       aws.Int64(int64(d.Get("version").(int))),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		samplingRule.Attributes = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	input := &xray.CreateSamplingRuleInput{
+		SamplingRule: samplingRule,
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapXray(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating XRay Sampling Rule: %s", input)
+	out, err := conn.CreateSamplingRule(input)
+	if err != nil {
+		return fmt.Errorf("error creating XRay Sampling Rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.SamplingRuleRecord.SamplingRule.RuleName))
+
+	return resourceAwsXraySamplingRuleRead(d, meta)
+}
+
+func resourceAwsXraySamplingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	samplingRule, err := getXraySamplingRule(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	if samplingRule == nil {
+		log.Printf("[WARN] XRay Sampling Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("rule_name", samplingRule.RuleName)
+	d.Set("resource_arn", samplingRule.ResourceARN)
+	d.Set("priority", samplingRule.Priority)
+	d.Set("fixed_rate", samplingRule.FixedRate)
+	d.Set("reservoir_size", samplingRule.ReservoirSize)
+	d.Set("service_name", samplingRule.ServiceName)
+	d.Set("service_type", samplingRule.ServiceType)
+	d.Set("host", samplingRule.Host)
+	d.Set("http_method", samplingRule.HTTPMethod)
+	d.Set("url_path", samplingRule.URLPath)
+	d.Set("version", samplingRule.Version)
+	d.Set("arn", samplingRule.RuleARN)
+
+	if err := d.Set("attributes", aws.StringValueMap(samplingRule.Attributes)); err != nil {
+		return fmt.Errorf("error setting attributes: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&xray.ListTagsForResourceInput{
+		ResourceARN: samplingRule.RuleARN,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapXray(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsXraySamplingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	if d.HasChange("tags") {
+		if err := setTagsXray(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating XRay Sampling Rule (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	samplingRuleUpdate := &xray.SamplingRuleUpdate{
+		RuleName:      aws.String(d.Id()),
+		ResourceARN:   aws.String(d.Get("resource_arn").(string)),
+		Priority:      aws.Int64(int64(d.Get("priority").(int))),
+		FixedRate:     aws.Float64(d.Get("fixed_rate").(float64)),
+		ReservoirSize: aws.Int64(int64(d.Get("reservoir_size").(int))),
+		ServiceName:   aws.String(d.Get("service_name").(string)),
+		ServiceType:   aws.String(d.Get("service_type").(string)),
+		Host:          aws.String(d.Get("host").(string)),
+		HTTPMethod:    aws.String(d.Get("http_method").(string)),
+		URLPath:       aws.String(d.Get("url_path").(string)),
+	}
+
+	if d.HasChange("attributes") {
+		attributes := map[string]*string{}
+		if v, ok := d.GetOk("attributes"); ok {
+			attributes = stringMapToPointers(v.(map[string]interface{}))
+		}
+		samplingRuleUpdate.Attributes = attributes
+	}
+
+	input := &xray.UpdateSamplingRuleInput{
+		SamplingRuleUpdate: samplingRuleUpdate,
+	}
+
+	log.Printf("[DEBUG] Updating XRay Sampling Rule: %s", input)
+	_, err := conn.UpdateSamplingRule(input)
+	if err != nil {
+		return fmt.Errorf("error updating XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsXraySamplingRuleRead(d, meta)
+}
+
+func resourceAwsXraySamplingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	log.Printf("[INFO] Deleting XRay Sampling Rule: %s", d.Id())
+	_, err := conn.DeleteSamplingRule(&xray.DeleteSamplingRuleInput{
+		RuleName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, xray.ErrCodeInvalidRequestException, "Sampling rule does not exist") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getXraySamplingRule(conn *xray.XRay, ruleName string) (*xray.SamplingRule, error) {
+	var samplingRule *xray.SamplingRule
+
+	err := conn.GetSamplingRulesPages(&xray.GetSamplingRulesInput{}, func(page *xray.GetSamplingRulesOutput, lastPage bool) bool {
+		for _, record := range page.SamplingRuleRecords {
+			if record.SamplingRule != nil && aws.StringValue(record.SamplingRule.RuleName) == ruleName {
+				samplingRule = record.SamplingRule
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return samplingRule, err
+}
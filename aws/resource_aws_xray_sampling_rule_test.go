@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSXraySamplingRule_basic(t *testing.T) {
+	var samplingRule xray.SamplingRule
+	resourceName := "aws_xray_sampling_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	ruleName := rName[len(rName)-20:]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXraySamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXraySamplingRuleConfig_basic(ruleName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:xray:[^:]+:\d{12}:sampling-rule/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", ruleName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "5"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reservoir_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "*"),
+					resource.TestCheckResourceAttr(resourceName, "host", "*"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_rate", "0.3"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", "*"),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "service_type", "*"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Hello", "World"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXraySamplingRuleConfig_basic(ruleName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSXraySamplingRule_tags(t *testing.T) {
+	var samplingRule xray.SamplingRule
+	resourceName := "aws_xray_sampling_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	ruleName := rName[len(rName)-20:]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXraySamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXraySamplingRuleConfig_tags1(ruleName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSXraySamplingRuleConfig_tags1(ruleName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXraySamplingRuleExists(n string, samplingRule *xray.SamplingRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Sampling Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rule == nil {
+			return fmt.Errorf("XRay Sampling Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*samplingRule = *rule
+
+		return nil
+	}
+}
+
+func testAccCheckAWSXraySamplingRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_xray_sampling_rule" {
+			continue
+		}
+
+		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("XRay Sampling Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSXraySamplingRuleConfig_basic(ruleName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test" {
+  rule_name      = %[1]q
+  priority       = %[2]d
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+
+  attributes {
+    Hello = "World"
+  }
+}
+`, ruleName, priority)
+}
+
+func testAccAWSXraySamplingRuleConfig_tags1(ruleName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test" {
+  rule_name      = %[1]q
+  priority       = 5
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+
+  tags {
+    %[2]s = %[3]q
+  }
+}
+`, ruleName, tagKey1, tagValue1)
+}
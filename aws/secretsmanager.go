@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_secretsmanager_* family of resources.
+
+func expandSecretsManagerRotationRules(l []interface{}) *secretsmanager.RotationRulesType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rules := &secretsmanager.RotationRulesType{}
+
+	if v, ok := m["automatically_after_days"].(int); ok && v > 0 {
+		rules.AutomaticallyAfterDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		rules.Duration = aws.String(v)
+	}
+
+	if v, ok := m["schedule_expression"].(string); ok && v != "" {
+		rules.ScheduleExpression = aws.String(v)
+	}
+
+	return rules
+}
+
+func flattenSecretsManagerRotationRules(rules *secretsmanager.RotationRulesType) []interface{} {
+	if rules == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"automatically_after_days": int(aws.Int64Value(rules.AutomaticallyAfterDays)),
+		"duration":                 aws.StringValue(rules.Duration),
+		"schedule_expression":      aws.StringValue(rules.ScheduleExpression),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSecretsManagerReplicaRegionTypes(l []interface{}) []*secretsmanager.ReplicaRegionType {
+	replicas := make([]*secretsmanager.ReplicaRegionType, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		replica := &secretsmanager.ReplicaRegionType{
+			Region: aws.String(m["region"].(string)),
+		}
+
+		if v, ok := m["kms_key_id"].(string); ok && v != "" {
+			replica.KmsKeyId = aws.String(v)
+		}
+
+		replicas = append(replicas, replica)
+	}
+
+	return replicas
+}
+
+func flattenSecretsManagerReplicationStatus(statuses []*secretsmanager.ReplicationStatusType) []interface{} {
+	l := make([]interface{}, 0, len(statuses))
+
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"kms_key_id":     aws.StringValue(status.KmsKeyId),
+			"region":         aws.StringValue(status.Region),
+			"status":         aws.StringValue(status.Status),
+			"status_message": aws.StringValue(status.StatusMessage),
+		}
+
+		if status.LastAccessedDate != nil {
+			m["last_accessed_date"] = aws.TimeValue(status.LastAccessedDate).Format(time.RFC3339)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+// secretsManagerReplicaHash only hashes the Region, as a secret can be
+// replicated to each Region at most once.
+func secretsManagerReplicaHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["region"].(string)))
+	return hashcode.String(buf.String())
+}
+
+func secretsManagerReplicaSetToMap(s *schema.Set) map[string]string {
+	result := make(map[string]string)
+
+	for _, raw := range s.List() {
+		m := raw.(map[string]interface{})
+		result[m["region"].(string)] = m["kms_key_id"].(string)
+	}
+
+	return result
+}
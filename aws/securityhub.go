@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_securityhub_* family of resources.
+
+const (
+	securityHubAdminAccountEnableTimeout  = 5 * time.Minute
+	securityHubAdminAccountDisableTimeout = 5 * time.Minute
+	securityHubAdminAccountNotFound       = "NotFound"
+
+	securityHubStandardsSubscriptionCreateTimeout = 3 * time.Minute
+	securityHubStandardsSubscriptionDeleteTimeout = 3 * time.Minute
+	securityHubStandardsStatusNotFound            = "NotFound"
+)
+
+func securityHubGetAdminAccount(conn *securityhub.SecurityHub, adminAccountID string) (*securityhub.AdminAccount, error) {
+	var result *securityhub.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(&securityhub.ListOrganizationAdminAccountsInput{}, func(page *securityhub.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		for _, adminAccount := range page.AdminAccounts {
+			if aws.StringValue(adminAccount.AccountId) == adminAccountID {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func securityHubAdminAccountStatusRefreshFunc(conn *securityhub.SecurityHub, adminAccountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		adminAccount, err := securityHubGetAdminAccount(conn, adminAccountID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if adminAccount == nil {
+			return &securityhub.AdminAccount{}, securityHubAdminAccountNotFound, nil
+		}
+
+		return adminAccount, aws.StringValue(adminAccount.Status), nil
+	}
+}
+
+func securityHubGetStandardsSubscription(conn *securityhub.SecurityHub, arn string) (*securityhub.StandardsSubscription, error) {
+	output, err := conn.GetEnabledStandards(&securityhub.GetEnabledStandardsInput{
+		StandardsSubscriptionArns: []*string{aws.String(arn)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.StandardsSubscriptions) == 0 {
+		return nil, nil
+	}
+
+	return output.StandardsSubscriptions[0], nil
+}
+
+func securityHubStandardsSubscriptionStatusRefreshFunc(conn *securityhub.SecurityHub, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		subscription, err := securityHubGetStandardsSubscription(conn, arn)
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+			return &securityhub.StandardsSubscription{}, securityHubStandardsStatusNotFound, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if subscription == nil {
+			return &securityhub.StandardsSubscription{}, securityHubStandardsStatusNotFound, nil
+		}
+
+		return subscription, aws.StringValue(subscription.StandardsStatus), nil
+	}
+}
+
+// securityHubStandardsSubscriptionArnFromControlArn derives the standards
+// subscription ARN from a standards control ARN, e.g.
+// arn:aws:securityhub:us-east-1:123456789012:control/cis-aws-foundations-benchmark/v/1.2.0/1.1 becomes
+// arn:aws:securityhub:us-east-1:123456789012:subscription/cis-aws-foundations-benchmark/v/1.2.0.
+func securityHubStandardsSubscriptionArnFromControlArn(controlArn string) (string, error) {
+	parts := strings.SplitN(controlArn, ":", 6)
+	if len(parts) != 6 || !strings.HasPrefix(parts[5], "control/") {
+		return "", fmt.Errorf("unexpected format of Security Hub Standards Control ARN (%s)", controlArn)
+	}
+
+	resourceParts := strings.Split(strings.TrimPrefix(parts[5], "control/"), "/")
+	if len(resourceParts) < 2 {
+		return "", fmt.Errorf("unexpected format of Security Hub Standards Control ARN (%s)", controlArn)
+	}
+
+	parts[5] = "subscription/" + strings.Join(resourceParts[:len(resourceParts)-1], "/")
+
+	return strings.Join(parts, ":"), nil
+}
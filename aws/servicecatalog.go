@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_servicecatalog_* family of resources.
+
+func decodeServiceCatalogID(id, format string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected %s", id, format)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func expandServiceCatalogTags(m map[string]interface{}) []*servicecatalog.Tag {
+	tags := make([]*servicecatalog.Tag, 0, len(m))
+	for k, v := range m {
+		tags = append(tags, &servicecatalog.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenServiceCatalogTags(tags []*servicecatalog.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func servicecatalogProductStatusRefreshFunc(conn *servicecatalog.ServiceCatalog, productID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(productID),
+		})
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Service Catalog Product (%s): %s", productID, err)
+		}
+
+		if output == nil || output.ProductViewDetail == nil {
+			return nil, "", fmt.Errorf("error reading Service Catalog Product (%s): empty output", productID)
+		}
+
+		if status := aws.StringValue(output.ProductViewDetail.Status); status == servicecatalog.StatusFailed {
+			return output, status, fmt.Errorf("Service Catalog Product (%s) in %s state", productID, status)
+		}
+
+		return output, aws.StringValue(output.ProductViewDetail.Status), nil
+	}
+}
+
+func waitForServiceCatalogProductAvailable(conn *servicecatalog.ServiceCatalog, productID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicecatalog.StatusCreating},
+		Target:     []string{servicecatalog.StatusAvailable},
+		Refresh:    servicecatalogProductStatusRefreshFunc(conn, productID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func servicecatalogProvisionedProductStatusRefreshFunc(conn *servicecatalog.ServiceCatalog, provisionedProductID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(provisionedProductID),
+		})
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %s", provisionedProductID, err)
+		}
+
+		if output == nil || output.ProvisionedProductDetail == nil {
+			return nil, "", nil
+		}
+
+		detail := output.ProvisionedProductDetail
+
+		switch status := aws.StringValue(detail.Status); status {
+		case servicecatalog.ProvisionedProductStatusError, servicecatalog.ProvisionedProductStatusTainted:
+			return detail, status, fmt.Errorf("Service Catalog Provisioned Product (%s) in %s state: %s", provisionedProductID, status, aws.StringValue(detail.StatusMessage))
+		}
+
+		return detail, aws.StringValue(detail.Status), nil
+	}
+}
+
+func waitForServiceCatalogProvisionedProductAvailable(conn *servicecatalog.ServiceCatalog, provisionedProductID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.ProvisionedProductStatusPlanInProgress,
+			servicecatalog.ProvisionedProductStatusUnderChange,
+		},
+		Target:     []string{servicecatalog.ProvisionedProductStatusAvailable},
+		Refresh:    servicecatalogProvisionedProductStatusRefreshFunc(conn, provisionedProductID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForServiceCatalogProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, provisionedProductID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.ProvisionedProductStatusAvailable,
+			servicecatalog.ProvisionedProductStatusUnderChange,
+		},
+		Target:     []string{},
+		Refresh:    servicecatalogProvisionedProductStatusRefreshFunc(conn, provisionedProductID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
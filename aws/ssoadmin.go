@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_ssoadmin_* family of resources.
+
+func decodeSsoAdminPermissionSetID(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <permission-set-arn>,<instance-arn>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func decodeSsoAdminManagedPolicyAttachmentID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected <managed-policy-arn>,<permission-set-arn>,<instance-arn>", id)
+	}
+
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+func decodeSsoAdminAccountAssignmentID(id string) ([]string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 6 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <principal-id>,<principal-type>,<target-id>,<target-type>,<permission-set-arn>,<instance-arn>", id)
+	}
+
+	for _, part := range idParts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected <principal-id>,<principal-type>,<target-id>,<target-type>,<permission-set-arn>,<instance-arn>", id)
+		}
+	}
+
+	return idParts, nil
+}
+
+func ssoadminDescribeAccountAssignment(conn *ssoadmin.SSOAdmin, principalID, principalType, accountID, permissionSetArn, instanceArn string) (*ssoadmin.AccountAssignment, error) {
+	input := &ssoadmin.ListAccountAssignmentsInput{
+		AccountId:        aws.String(accountID),
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var result *ssoadmin.AccountAssignment
+
+	err := conn.ListAccountAssignmentsPages(input, func(page *ssoadmin.ListAccountAssignmentsOutput, lastPage bool) bool {
+		for _, assignment := range page.AccountAssignments {
+			if assignment == nil {
+				continue
+			}
+
+			if aws.StringValue(assignment.PrincipalId) == principalID && aws.StringValue(assignment.PrincipalType) == principalType {
+				result = assignment
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ssoadminPermissionSetProvisioningRefreshFunc(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
+			InstanceArn:                     aws.String(instanceArn),
+			ProvisionPermissionSetRequestId: aws.String(requestID),
+		}
+
+		output, err := conn.DescribePermissionSetProvisioningStatus(input)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading SSO Permission Set provisioning status (%s): %s", requestID, err)
+		}
+
+		if output == nil || output.PermissionSetProvisioningStatus == nil {
+			return nil, "", fmt.Errorf("error reading SSO Permission Set provisioning status (%s): empty output", requestID)
+		}
+
+		status := output.PermissionSetProvisioningStatus
+
+		if state := aws.StringValue(status.Status); state == ssoadmin.StatusValuesFailed {
+			return status, state, fmt.Errorf("SSO Permission Set provisioning (%s) in %s state: %s", requestID, state, aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+func ssoadminAccountAssignmentCreationRefreshFunc(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentCreationStatusInput{
+			AccountAssignmentCreationRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentCreationStatus(input)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading SSO Account Assignment creation status (%s): %s", requestID, err)
+		}
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return nil, "", fmt.Errorf("error reading SSO Account Assignment creation status (%s): empty output", requestID)
+		}
+
+		status := output.AccountAssignmentCreationStatus
+
+		if state := aws.StringValue(status.Status); state == ssoadmin.StatusValuesFailed {
+			return status, state, fmt.Errorf("SSO Account Assignment creation (%s) in %s state: %s", requestID, state, aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+func ssoadminAccountAssignmentDeletionRefreshFunc(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentDeletionStatusInput{
+			AccountAssignmentDeletionRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentDeletionStatus(input)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading SSO Account Assignment deletion status (%s): %s", requestID, err)
+		}
+
+		if output == nil || output.AccountAssignmentDeletionStatus == nil {
+			return nil, "", fmt.Errorf("error reading SSO Account Assignment deletion status (%s): empty output", requestID)
+		}
+
+		status := output.AccountAssignmentDeletionStatus
+
+		if state := aws.StringValue(status.Status); state == ssoadmin.StatusValuesFailed {
+			return status, state, fmt.Errorf("SSO Account Assignment deletion (%s) in %s state: %s", requestID, state, aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+// provisionSsoAdminPermissionSet pushes the latest version of a permission set
+// to every account it is already assigned to and waits for that to finish.
+// Changes to a permission set or its policies are not visible to users until
+// the permission set has been provisioned again.
+func provisionSsoAdminPermissionSet(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) error {
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		TargetType:       aws.String(ssoadmin.ProvisionTargetTypeAllProvisionedAccounts),
+	}
+
+	log.Printf("[DEBUG] Provisioning SSO Permission Set: %s", input)
+	output, err := conn.ProvisionPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error provisioning SSO Permission Set (%s): %s", permissionSetArn, err)
+	}
+
+	if output == nil || output.PermissionSetProvisioningStatus == nil {
+		return fmt.Errorf("error provisioning SSO Permission Set (%s): empty output", permissionSetArn)
+	}
+
+	requestID := aws.StringValue(output.PermissionSetProvisioningStatus.RequestId)
+
+	if err := waitForSsoAdminPermissionSetProvisioning(conn, instanceArn, requestID); err != nil {
+		return fmt.Errorf("error waiting for SSO Permission Set (%s) provisioning: %s", permissionSetArn, err)
+	}
+
+	return nil
+}
+
+func waitForSsoAdminPermissionSetProvisioning(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssoadmin.StatusValuesInProgress},
+		Target:  []string{ssoadmin.StatusValuesSucceeded},
+		Refresh: ssoadminPermissionSetProvisioningRefreshFunc(conn, instanceArn, requestID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for SSO Permission Set provisioning (%s)", requestID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForSsoAdminAccountAssignmentCreation(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssoadmin.StatusValuesInProgress},
+		Target:  []string{ssoadmin.StatusValuesSucceeded},
+		Refresh: ssoadminAccountAssignmentCreationRefreshFunc(conn, instanceArn, requestID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for SSO Account Assignment creation (%s)", requestID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForSsoAdminAccountAssignmentDeletion(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssoadmin.StatusValuesInProgress},
+		Target:  []string{ssoadmin.StatusValuesSucceeded},
+		Refresh: ssoadminAccountAssignmentDeletionRefreshFunc(conn, instanceArn, requestID),
+		Timeout: 5 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for SSO Account Assignment deletion (%s)", requestID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
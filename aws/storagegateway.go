@@ -0,0 +1,427 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Shared helpers for the aws_storagegateway_* family of resources.
+
+const (
+	storageGatewayFileShareStatusAvailable     = "AVAILABLE"
+	storageGatewayFileShareStatusCreating      = "CREATING"
+	storageGatewayFileShareStatusDeleting      = "DELETING"
+	storageGatewayFileShareStatusForceDeleting = "FORCE_DELETING"
+	storageGatewayFileShareStatusUpdating      = "UPDATING"
+
+	storageGatewayVolumeStatusAvailable     = "AVAILABLE"
+	storageGatewayVolumeStatusBootstrapping = "BOOTSTRAPPING"
+	storageGatewayVolumeStatusCreating      = "CREATING"
+	storageGatewayVolumeStatusRestoring     = "RESTORING"
+)
+
+// Storage Gateway reports most failures as InvalidGatewayRequestException and
+// only distinguishes them by message.
+
+func isStorageGatewayGatewayNotFound(err error) bool {
+	return isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found")
+}
+
+func isStorageGatewayGatewayNotConnected(err error) bool {
+	return isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway is not connected")
+}
+
+func isStorageGatewayFileShareNotFound(err error) bool {
+	return isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file share was not found")
+}
+
+func isStorageGatewayVolumeNotFound(err error) bool {
+	return isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified volume was not found")
+}
+
+// decodeStorageGatewayDiskID splits the GATEWAYARN:DISKID identifier used by
+// aws_storagegateway_cache and aws_storagegateway_upload_buffer. Disk IDs
+// may themselves contain colons, so only the first colon after the gateway
+// resource is significant.
+func decodeStorageGatewayDiskID(id string) (string, string, error) {
+	parsedARN, err := arn.Parse(id)
+	if err != nil {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected GATEWAYARN:DISKID: %s", id, err)
+	}
+
+	parts := strings.SplitN(parsedARN.Resource, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || !strings.HasPrefix(parts[0], "gateway/") {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected GATEWAYARN:DISKID", id)
+	}
+
+	parsedARN.Resource = parts[0]
+
+	return parsedARN.String(), parts[1], nil
+}
+
+// storageGatewayGatewayARNFromVolumeARN returns the ARN of the gateway that
+// owns a volume, e.g. arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678
+// for arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678/volume/vol-12345678.
+func storageGatewayGatewayARNFromVolumeARN(volumeARN string) (string, error) {
+	parts := strings.SplitN(volumeARN, "/volume/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format of Storage Gateway volume ARN (%s)", volumeARN)
+	}
+
+	return parts[0], nil
+}
+
+func listStorageGatewayTags(conn *storagegateway.StorageGateway, resourceARN string) ([]*storagegateway.Tag, error) {
+	input := &storagegateway.ListTagsForResourceInput{
+		ResourceARN: aws.String(resourceARN),
+	}
+	var tags []*storagegateway.Tag
+
+	err := conn.ListTagsForResourcePages(input, func(page *storagegateway.ListTagsForResourceOutput, lastPage bool) bool {
+		tags = append(tags, page.Tags...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// storageGatewayActivationKey retrieves an activation key from a gateway
+// appliance. The appliance answers an HTTP request on port 80 with a redirect
+// whose query string carries the key for the requested region.
+func storageGatewayActivationKey(gatewayIPAddress, region string, timeout time.Duration) (string, error) {
+	requestURL := fmt.Sprintf("http://%s/?activationRegion=%s", gatewayIPAddress, region)
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: 10 * time.Second,
+	}
+
+	var response *http.Response
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+
+		log.Printf("[DEBUG] Requesting Storage Gateway activation key: %s", requestURL)
+		response, err = client.Get(requestURL)
+
+		// The appliance may still be booting.
+		if _, ok := err.(net.Error); ok {
+			return resource.RetryableError(fmt.Errorf("error making HTTP request: %s", err))
+		}
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error making HTTP request: %s", err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusFound {
+		return "", fmt.Errorf("expected HTTP status code %d, received: %d", http.StatusFound, response.StatusCode)
+	}
+
+	redirectURL, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return "", fmt.Errorf("error parsing HTTP redirect location: %s", err)
+	}
+
+	activationKey := redirectURL.Query().Get("activationKey")
+	if activationKey == "" {
+		return "", fmt.Errorf("empty activationKey received from HTTP redirect location: %s", redirectURL)
+	}
+
+	return activationKey, nil
+}
+
+// waitForStorageGatewayGatewayConnected waits for a newly activated gateway
+// to report in, as most gateway operations fail until it has.
+func waitForStorageGatewayGatewayConnected(conn *storagegateway.StorageGateway, gatewayARN string, timeout time.Duration) error {
+	input := &storagegateway.DescribeGatewayInformationInput{
+		GatewayARN: aws.String(gatewayARN),
+	}
+
+	log.Printf("[DEBUG] Waiting for Storage Gateway gateway (%s) to connect", gatewayARN)
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.DescribeGatewayInformation(input)
+
+		if isStorageGatewayGatewayNotConnected(err) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func describeStorageGatewayNfsFileShare(conn *storagegateway.StorageGateway, fileShareARN string) (*storagegateway.NFSFileShareInfo, error) {
+	output, err := conn.DescribeNFSFileShares(&storagegateway.DescribeNFSFileSharesInput{
+		FileShareARNList: []*string{aws.String(fileShareARN)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileShare := range output.NFSFileShareInfoList {
+		if aws.StringValue(fileShare.FileShareARN) == fileShareARN {
+			return fileShare, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func describeStorageGatewaySmbFileShare(conn *storagegateway.StorageGateway, fileShareARN string) (*storagegateway.SMBFileShareInfo, error) {
+	output, err := conn.DescribeSMBFileShares(&storagegateway.DescribeSMBFileSharesInput{
+		FileShareARNList: []*string{aws.String(fileShareARN)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileShare := range output.SMBFileShareInfoList {
+		if aws.StringValue(fileShare.FileShareARN) == fileShareARN {
+			return fileShare, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func refreshStorageGatewayNfsFileShareStatus(conn *storagegateway.StorageGateway, fileShareARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fileShare, err := describeStorageGatewayNfsFileShare(conn, fileShareARN)
+		if isStorageGatewayFileShareNotFound(err) {
+			return "", "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fileShare == nil {
+			return "", "NotFound", nil
+		}
+
+		return fileShare, aws.StringValue(fileShare.FileShareStatus), nil
+	}
+}
+
+func refreshStorageGatewaySmbFileShareStatus(conn *storagegateway.StorageGateway, fileShareARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fileShare, err := describeStorageGatewaySmbFileShare(conn, fileShareARN)
+		if isStorageGatewayFileShareNotFound(err) {
+			return "", "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fileShare == nil {
+			return "", "NotFound", nil
+		}
+
+		return fileShare, aws.StringValue(fileShare.FileShareStatus), nil
+	}
+}
+
+func waitForStorageGatewayFileShareAvailable(fileShareARN string, refresh resource.StateRefreshFunc, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			storageGatewayFileShareStatusCreating,
+			storageGatewayFileShareStatusUpdating,
+		},
+		Target:     []string{storageGatewayFileShareStatusAvailable},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Storage Gateway file share (%s) to become available", fileShareARN)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForStorageGatewayFileShareDeletion(fileShareARN string, refresh resource.StateRefreshFunc, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			storageGatewayFileShareStatusAvailable,
+			storageGatewayFileShareStatusDeleting,
+			storageGatewayFileShareStatusForceDeleting,
+		},
+		Target:         []string{"NotFound"},
+		Refresh:        refresh,
+		Timeout:        timeout,
+		Delay:          5 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for Storage Gateway file share (%s) to delete", fileShareARN)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func describeStorageGatewayCachedIscsiVolume(conn *storagegateway.StorageGateway, volumeARN string) (*storagegateway.CachediSCSIVolume, error) {
+	output, err := conn.DescribeCachediSCSIVolumes(&storagegateway.DescribeCachediSCSIVolumesInput{
+		VolumeARNs: []*string{aws.String(volumeARN)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range output.CachediSCSIVolumes {
+		if aws.StringValue(volume.VolumeARN) == volumeARN {
+			return volume, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func describeStorageGatewayStoredIscsiVolume(conn *storagegateway.StorageGateway, volumeARN string) (*storagegateway.StorediSCSIVolume, error) {
+	output, err := conn.DescribeStorediSCSIVolumes(&storagegateway.DescribeStorediSCSIVolumesInput{
+		VolumeARNs: []*string{aws.String(volumeARN)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range output.StorediSCSIVolumes {
+		if aws.StringValue(volume.VolumeARN) == volumeARN {
+			return volume, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func refreshStorageGatewayStoredIscsiVolumeStatus(conn *storagegateway.StorageGateway, volumeARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, err := describeStorageGatewayStoredIscsiVolume(conn, volumeARN)
+		if isStorageGatewayVolumeNotFound(err) {
+			return "", "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if volume == nil {
+			return "", "NotFound", nil
+		}
+
+		return volume, aws.StringValue(volume.VolumeStatus), nil
+	}
+}
+
+// waitForStorageGatewayStoredIscsiVolumeAvailable waits for a stored volume
+// to finish bootstrapping from its local disk or restoring from a snapshot.
+func waitForStorageGatewayStoredIscsiVolumeAvailable(conn *storagegateway.StorageGateway, volumeARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			storageGatewayVolumeStatusBootstrapping,
+			storageGatewayVolumeStatusCreating,
+			storageGatewayVolumeStatusRestoring,
+		},
+		Target:     []string{storageGatewayVolumeStatusAvailable},
+		Refresh:    refreshStorageGatewayStoredIscsiVolumeStatus(conn, volumeARN),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Storage Gateway stored iSCSI volume (%s) to become available", volumeARN)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandStorageGatewayCacheAttributes(l []interface{}) *storagegateway.CacheAttributes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &storagegateway.CacheAttributes{
+		CacheStaleTimeoutInSeconds: aws.Int64(int64(m["cache_stale_timeout_in_seconds"].(int))),
+	}
+}
+
+func flattenStorageGatewayCacheAttributes(attributes *storagegateway.CacheAttributes) []interface{} {
+	if attributes == nil || attributes.CacheStaleTimeoutInSeconds == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cache_stale_timeout_in_seconds": int(aws.Int64Value(attributes.CacheStaleTimeoutInSeconds)),
+	}
+
+	return []interface{}{m}
+}
+
+func expandStorageGatewayNfsFileShareDefaults(l []interface{}) *storagegateway.NFSFileShareDefaults {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &storagegateway.NFSFileShareDefaults{
+		DirectoryMode: aws.String(m["directory_mode"].(string)),
+		FileMode:      aws.String(m["file_mode"].(string)),
+		GroupId:       aws.Int64(int64(m["group_id"].(int))),
+		OwnerId:       aws.Int64(int64(m["owner_id"].(int))),
+	}
+}
+
+func flattenStorageGatewayNfsFileShareDefaults(defaults *storagegateway.NFSFileShareDefaults) []interface{} {
+	if defaults == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"directory_mode": aws.StringValue(defaults.DirectoryMode),
+		"file_mode":      aws.StringValue(defaults.FileMode),
+		"group_id":       int(aws.Int64Value(defaults.GroupId)),
+		"owner_id":       int(aws.Int64Value(defaults.OwnerId)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenStorageGatewayNetworkInterfaces(networkInterfaces []*storagegateway.NetworkInterface) []interface{} {
+	l := make([]interface{}, 0, len(networkInterfaces))
+
+	for _, networkInterface := range networkInterfaces {
+		if networkInterface == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"ipv4_address": aws.StringValue(networkInterface.Ipv4Address),
+		})
+	}
+
+	return l
+}
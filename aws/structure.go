@@ -1193,6 +1193,7 @@ func flattenDSVpcSettings(
 		return nil
 	}
 
+	settings["availability_zones"] = schema.NewSet(schema.HashString, flattenStringList(s.AvailabilityZones))
 	settings["subnet_ids"] = schema.NewSet(schema.HashString, flattenStringList(s.SubnetIds))
 	settings["vpc_id"] = *s.VpcId
 
@@ -1252,6 +1253,7 @@ func flattenDSConnectSettings(
 
 	settings["customer_dns_ips"] = schema.NewSet(schema.HashString, flattenStringList(customerDnsIps))
 	settings["connect_ips"] = schema.NewSet(schema.HashString, flattenStringList(s.ConnectIps))
+	settings["availability_zones"] = schema.NewSet(schema.HashString, flattenStringList(s.AvailabilityZones))
 	settings["customer_username"] = *s.CustomerUserName
 	settings["subnet_ids"] = schema.NewSet(schema.HashString, flattenStringList(s.SubnetIds))
 	settings["vpc_id"] = *s.VpcId
@@ -1587,7 +1589,24 @@ func expandCloudWachLogMetricTransformations(m map[string]interface{}) ([]*cloud
 		MetricValue:     aws.String(m["value"].(string)),
 	}
 
-	if m["default_value"] != "" {
+	if v, ok := m["unit"].(string); ok && v != "" {
+		transformation.Unit = aws.String(v)
+	}
+
+	dimensions := m["dimensions"].(map[string]interface{})
+	if len(dimensions) > 3 {
+		return nil, fmt.Errorf("metric_transformation supports at most 3 dimensions, got %d", len(dimensions))
+	}
+
+	if len(dimensions) > 0 {
+		transformation.Dimensions = stringMapToPointers(dimensions)
+
+		// CloudWatch Logs rejects a default value on metrics that publish
+		// dimensions, so only send one when it has been explicitly set.
+		if v := m["default_value"].(float64); v != 0 {
+			transformation.DefaultValue = aws.Float64(v)
+		}
+	} else {
 		transformation.DefaultValue = aws.Float64(m["default_value"].(float64))
 	}
 
@@ -1606,6 +1625,14 @@ func flattenCloudWachLogMetricTransformations(ts []*cloudwatchlogs.MetricTransfo
 		m["default_value"] = *ts[0].DefaultValue
 	}
 
+	m["dimensions"] = pointersMapToStringList(ts[0].Dimensions)
+
+	if ts[0].Unit != nil {
+		m["unit"] = *ts[0].Unit
+	} else {
+		m["unit"] = cloudwatchlogs.StandardUnitNone
+	}
+
 	mts = append(mts, m)
 
 	return mts
@@ -2191,6 +2218,40 @@ func flattenCognitoIdentityProviders(ips []*cognitoidentity.Provider) []map[stri
 	return values
 }
 
+func expandCognitoUserPoolAccountRecoverySetting(config map[string]interface{}) *cognitoidentityprovider.AccountRecoverySettingType {
+	configs := &cognitoidentityprovider.AccountRecoverySettingType{}
+
+	if v, ok := config["recovery_mechanism"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			mechanism := raw.(map[string]interface{})
+
+			configs.RecoveryMechanisms = append(configs.RecoveryMechanisms, &cognitoidentityprovider.RecoveryOptionType{
+				Name:     aws.String(mechanism["name"].(string)),
+				Priority: aws.Int64(int64(mechanism["priority"].(int))),
+			})
+		}
+	}
+
+	return configs
+}
+
+func flattenCognitoUserPoolAccountRecoverySetting(s *cognitoidentityprovider.AccountRecoverySettingType) []map[string]interface{} {
+	if s == nil || len(s.RecoveryMechanisms) == 0 {
+		return nil
+	}
+
+	mechanisms := make([]interface{}, 0, len(s.RecoveryMechanisms))
+
+	for _, v := range s.RecoveryMechanisms {
+		mechanisms = append(mechanisms, map[string]interface{}{
+			"name":     aws.StringValue(v.Name),
+			"priority": int(aws.Int64Value(v.Priority)),
+		})
+	}
+
+	return []map[string]interface{}{{"recovery_mechanism": mechanisms}}
+}
+
 func flattenCognitoUserPoolEmailConfiguration(s *cognitoidentityprovider.EmailConfigurationType) []map[string]interface{} {
 	m := make(map[string]interface{}, 0)
 
@@ -2326,14 +2387,34 @@ func expandCognitoUserPoolLambdaConfig(config map[string]interface{}) *cognitoid
 		configs.CreateAuthChallenge = aws.String(v.(string))
 	}
 
+	if v, ok := config["custom_email_sender"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		configs.CustomEmailSender = &cognitoidentityprovider.CustomEmailLambdaVersionConfigType{
+			LambdaArn:     aws.String(m["lambda_arn"].(string)),
+			LambdaVersion: aws.String(m["lambda_version"].(string)),
+		}
+	}
+
 	if v, ok := config["custom_message"]; ok && v.(string) != "" {
 		configs.CustomMessage = aws.String(v.(string))
 	}
 
+	if v, ok := config["custom_sms_sender"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		configs.CustomSMSSender = &cognitoidentityprovider.CustomSMSLambdaVersionConfigType{
+			LambdaArn:     aws.String(m["lambda_arn"].(string)),
+			LambdaVersion: aws.String(m["lambda_version"].(string)),
+		}
+	}
+
 	if v, ok := config["define_auth_challenge"]; ok && v.(string) != "" {
 		configs.DefineAuthChallenge = aws.String(v.(string))
 	}
 
+	if v, ok := config["kms_key_id"]; ok && v.(string) != "" {
+		configs.KMSKeyID = aws.String(v.(string))
+	}
+
 	if v, ok := config["post_authentication"]; ok && v.(string) != "" {
 		configs.PostAuthentication = aws.String(v.(string))
 	}
@@ -2372,14 +2453,32 @@ func flattenCognitoUserPoolLambdaConfig(s *cognitoidentityprovider.LambdaConfigT
 		m["create_auth_challenge"] = *s.CreateAuthChallenge
 	}
 
+	if s.CustomEmailSender != nil {
+		m["custom_email_sender"] = []map[string]interface{}{{
+			"lambda_arn":     aws.StringValue(s.CustomEmailSender.LambdaArn),
+			"lambda_version": aws.StringValue(s.CustomEmailSender.LambdaVersion),
+		}}
+	}
+
 	if s.CustomMessage != nil {
 		m["custom_message"] = *s.CustomMessage
 	}
 
+	if s.CustomSMSSender != nil {
+		m["custom_sms_sender"] = []map[string]interface{}{{
+			"lambda_arn":     aws.StringValue(s.CustomSMSSender.LambdaArn),
+			"lambda_version": aws.StringValue(s.CustomSMSSender.LambdaVersion),
+		}}
+	}
+
 	if s.DefineAuthChallenge != nil {
 		m["define_auth_challenge"] = *s.DefineAuthChallenge
 	}
 
+	if s.KMSKeyID != nil {
+		m["kms_key_id"] = *s.KMSKeyID
+	}
+
 	if s.PostAuthentication != nil {
 		m["post_authentication"] = *s.PostAuthentication
 	}
@@ -3087,6 +3186,34 @@ func flattenCognitoUserPoolSmsConfiguration(s *cognitoidentityprovider.SmsConfig
 	return []map[string]interface{}{m}
 }
 
+func expandCognitoUserPoolSoftwareTokenMfaConfiguration(config map[string]interface{}) *cognitoidentityprovider.SoftwareTokenMfaConfigType {
+	return &cognitoidentityprovider.SoftwareTokenMfaConfigType{
+		Enabled: aws.Bool(config["enabled"].(bool)),
+	}
+}
+
+func flattenCognitoUserPoolSoftwareTokenMfaConfiguration(s *cognitoidentityprovider.SoftwareTokenMfaConfigType) []map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{"enabled": aws.BoolValue(s.Enabled)}}
+}
+
+func expandCognitoUserPoolUserPoolAddOns(config map[string]interface{}) *cognitoidentityprovider.UserPoolAddOnsType {
+	return &cognitoidentityprovider.UserPoolAddOnsType{
+		AdvancedSecurityMode: aws.String(config["advanced_security_mode"].(string)),
+	}
+}
+
+func flattenCognitoUserPoolUserPoolAddOns(s *cognitoidentityprovider.UserPoolAddOnsType) []map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{"advanced_security_mode": aws.StringValue(s.AdvancedSecurityMode)}}
+}
+
 func expandCognitoUserPoolVerificationMessageTemplate(config map[string]interface{}) *cognitoidentityprovider.VerificationMessageTemplateType {
 	verificationMessageTemplateType := &cognitoidentityprovider.VerificationMessageTemplateType{}
 
@@ -3882,6 +4009,21 @@ func flattenVpcEndpointServiceAllowedPrincipals(allowedPrincipals []*ec2.Allowed
 	return result
 }
 
+func flattenVpcEndpointServicePrivateDnsNameConfiguration(cfg *ec2.PrivateDnsNameConfiguration) []interface{} {
+	if cfg == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":  aws.StringValue(cfg.Name),
+			"state": aws.StringValue(cfg.State),
+			"type":  aws.StringValue(cfg.Type),
+			"value": aws.StringValue(cfg.Value),
+		},
+	}
+}
+
 func expandDynamoDbTableItemAttributes(input string) (map[string]*dynamodb.AttributeValue, error) {
 	var attributes map[string]*dynamodb.AttributeValue
 
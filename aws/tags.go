@@ -219,6 +219,21 @@ func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
 	return result
 }
 
+// ec2TagSpecificationsFromMap returns the tag specifications used to tag EC2
+// resources on creation, or nil when there are no tags.
+func ec2TagSpecificationsFromMap(m map[string]interface{}, resourceType string) []*ec2.TagSpecification {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(resourceType),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
 	result := make(map[string]string)
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsKeyspaces(conn *keyspaces.Keyspaces, d *schema.ResourceData, arn string) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsKeyspaces(tagsFromMapKeyspaces(o), tagsFromMapKeyspaces(n))
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			_, err := conn.UntagResource(&keyspaces.UntagResourceInput{
+				ResourceArn: aws.String(arn),
+				Tags:        remove,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+			_, err := conn.TagResource(&keyspaces.TagResourceInput{
+				ResourceArn: aws.String(arn),
+				Tags:        create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTagsKeyspaces returns the tags of the Keyspaces resource with the given ARN.
+func getTagsKeyspaces(conn *keyspaces.Keyspaces, arn string) (map[string]string, error) {
+	var tags []*keyspaces.Tag
+
+	err := conn.ListTagsForResourcePages(&keyspaces.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}, func(page *keyspaces.ListTagsForResourceOutput, lastPage bool) bool {
+		tags = append(tags, page.Tags...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tagsToMapKeyspaces(tags), nil
+}
+
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsKeyspaces(oldTags, newTags []*keyspaces.Tag) ([]*keyspaces.Tag, []*keyspaces.Tag) {
+	// First, we're creating everything we have
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = *t.Value
+	}
+
+	// Build the list of what to remove
+	var remove []*keyspaces.Tag
+	for _, t := range oldTags {
+		old, ok := create[*t.Key]
+		if !ok || old != *t.Value {
+			// Delete it!
+			remove = append(remove, t)
+		}
+	}
+
+	return tagsFromMapKeyspaces(create), remove
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapKeyspaces(m map[string]interface{}) []*keyspaces.Tag {
+	result := make([]*keyspaces.Tag, 0, len(m))
+	for k, v := range m {
+		t := &keyspaces.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		}
+		if !tagIgnoredKeyspaces(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapKeyspaces(ts []*keyspaces.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		if !tagIgnoredKeyspaces(t) {
+			result[*t.Key] = *t.Value
+		}
+	}
+
+	return result
+}
+
+// compare a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnoredKeyspaces(t *keyspaces.Tag) bool {
+	filter := []string{"^aws:"}
+	for _, v := range filter {
+		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
+		if r, _ := regexp.MatchString(v, *t.Key); r == true {
+			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
+			return true
+		}
+	}
+	return false
+}
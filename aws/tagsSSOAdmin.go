@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// getTags is a helper to get the tags for a resource. It expects the
+// tags field to be named "tags"
+func getTagsSSOAdmin(conn *ssoadmin.SSOAdmin, d *schema.ResourceData, instanceArn, resourceArn string) error {
+	var tags []*ssoadmin.Tag
+	err := conn.ListTagsForResourcePages(&ssoadmin.ListTagsForResourceInput{
+		InstanceArn: aws.String(instanceArn),
+		ResourceArn: aws.String(resourceArn),
+	}, func(page *ssoadmin.ListTagsForResourceOutput, lastPage bool) bool {
+		tags = append(tags, page.Tags...)
+		return !lastPage
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", tagsToMapSSOAdmin(tags)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsSSOAdmin(conn *ssoadmin.SSOAdmin, d *schema.ResourceData, instanceArn, resourceArn string) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsSSOAdmin(tagsFromMapSSOAdmin(o), tagsFromMapSSOAdmin(n))
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			k := make([]*string, len(remove), len(remove))
+			for i, t := range remove {
+				k[i] = t.Key
+			}
+
+			_, err := conn.UntagResource(&ssoadmin.UntagResourceInput{
+				InstanceArn: aws.String(instanceArn),
+				ResourceArn: aws.String(resourceArn),
+				TagKeys:     k,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+			_, err := conn.TagResource(&ssoadmin.TagResourceInput{
+				InstanceArn: aws.String(instanceArn),
+				ResourceArn: aws.String(resourceArn),
+				Tags:        create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsSSOAdmin(oldTags, newTags []*ssoadmin.Tag) ([]*ssoadmin.Tag, []*ssoadmin.Tag) {
+	// First, we're creating everything we have
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	// Build the list of what to remove
+	var remove []*ssoadmin.Tag
+	for _, t := range oldTags {
+		old, ok := create[aws.StringValue(t.Key)]
+		if !ok || old != aws.StringValue(t.Value) {
+			// Delete it!
+			remove = append(remove, t)
+		}
+	}
+
+	return tagsFromMapSSOAdmin(create), remove
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapSSOAdmin(m map[string]interface{}) []*ssoadmin.Tag {
+	result := make([]*ssoadmin.Tag, 0, len(m))
+	for k, v := range m {
+		t := &ssoadmin.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		}
+		if !tagIgnoredSSOAdmin(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapSSOAdmin(ts []*ssoadmin.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		if !tagIgnoredSSOAdmin(t) {
+			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+
+	return result
+}
+
+// compare a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnoredSSOAdmin(t *ssoadmin.Tag) bool {
+	filter := []string{"^aws:"}
+	for _, v := range filter {
+		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
+		if r, _ := regexp.MatchString(v, *t.Key); r == true {
+			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
+			return true
+		}
+	}
+	return false
+}
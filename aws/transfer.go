@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for the aws_transfer_* family of resources.
+
+func decodeTransferUserID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected SERVER-ID/USER-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func decodeTransferSshKeyID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected SERVER-ID/USER-NAME/SSH-PUBLIC-KEY-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// transferSshKeyBodyDiffSuppress compares only the key type and key data,
+// as the API drops any trailing comment from imported keys.
+func transferSshKeyBodyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldFields := strings.Fields(old)
+	newFields := strings.Fields(new)
+
+	if len(oldFields) < 2 || len(newFields) < 2 {
+		return old == new
+	}
+
+	return oldFields[0] == newFields[0] && oldFields[1] == newFields[1]
+}
+
+func describeTransferServer(conn *transfer.Transfer, id string) (*transfer.DescribedServer, error) {
+	output, err := conn.DescribeServer(&transfer.DescribeServerInput{
+		ServerId: aws.String(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Server == nil {
+		return nil, nil
+	}
+
+	return output.Server, nil
+}
+
+func refreshTransferServerState(conn *transfer.Transfer, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, err := describeTransferServer(conn, id)
+		if isAWSErr(err, transfer.ErrCodeResourceNotFoundException, "") {
+			return "", "NotFound", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if server == nil {
+			return "", "NotFound", nil
+		}
+
+		return server, aws.StringValue(server.State), nil
+	}
+}
+
+func waitForTransferServerState(conn *transfer.Transfer, id string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: refreshTransferServerState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func startTransferServer(conn *transfer.Transfer, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting Transfer server: %s", id)
+	if _, err := conn.StartServer(&transfer.StartServerInput{ServerId: aws.String(id)}); err != nil {
+		return fmt.Errorf("error starting Transfer server (%s): %s", id, err)
+	}
+
+	if err := waitForTransferServerState(conn, id, []string{transfer.StateStarting, transfer.StateOffline}, []string{transfer.StateOnline}, timeout); err != nil {
+		return fmt.Errorf("error waiting for Transfer server (%s) to start: %s", id, err)
+	}
+
+	return nil
+}
+
+func stopTransferServer(conn *transfer.Transfer, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping Transfer server: %s", id)
+	if _, err := conn.StopServer(&transfer.StopServerInput{ServerId: aws.String(id)}); err != nil {
+		return fmt.Errorf("error stopping Transfer server (%s): %s", id, err)
+	}
+
+	if err := waitForTransferServerState(conn, id, []string{transfer.StateStopping, transfer.StateOnline}, []string{transfer.StateOffline}, timeout); err != nil {
+		return fmt.Errorf("error waiting for Transfer server (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func waitForTransferServerDeletion(conn *transfer.Transfer, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for Transfer server (%s) to delete", id)
+	return waitForTransferServerState(conn, id, transfer.State_Values(), []string{"NotFound"}, timeout)
+}
+
+func expandTransferEndpointDetails(l []interface{}) *transfer.EndpointDetails {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	details := &transfer.EndpointDetails{}
+
+	if v, ok := m["address_allocation_ids"].(*schema.Set); ok && v.Len() > 0 {
+		details.AddressAllocationIds = expandStringSet(v)
+	}
+
+	if v, ok := m["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		details.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := m["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		details.SubnetIds = expandStringSet(v)
+	}
+
+	if v, ok := m["vpc_endpoint_id"].(string); ok && v != "" {
+		details.VpcEndpointId = aws.String(v)
+	}
+
+	if v, ok := m["vpc_id"].(string); ok && v != "" {
+		details.VpcId = aws.String(v)
+	}
+
+	return details
+}
+
+func flattenTransferEndpointDetails(details *transfer.EndpointDetails) []interface{} {
+	if details == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"address_allocation_ids": schema.NewSet(schema.HashString, flattenStringList(details.AddressAllocationIds)),
+		"security_group_ids":     schema.NewSet(schema.HashString, flattenStringList(details.SecurityGroupIds)),
+		"subnet_ids":             schema.NewSet(schema.HashString, flattenStringList(details.SubnetIds)),
+		"vpc_endpoint_id":        aws.StringValue(details.VpcEndpointId),
+		"vpc_id":                 aws.StringValue(details.VpcId),
+	}
+
+	return []interface{}{m}
+}
+
+func expandTransferProtocolDetails(l []interface{}) *transfer.ProtocolDetails {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	details := &transfer.ProtocolDetails{}
+
+	if v, ok := m["passive_ip"].(string); ok && v != "" {
+		details.PassiveIp = aws.String(v)
+	}
+
+	if v, ok := m["set_stat_option"].(string); ok && v != "" {
+		details.SetStatOption = aws.String(v)
+	}
+
+	if v, ok := m["tls_session_resumption_mode"].(string); ok && v != "" {
+		details.TlsSessionResumptionMode = aws.String(v)
+	}
+
+	return details
+}
+
+func flattenTransferProtocolDetails(details *transfer.ProtocolDetails) []interface{} {
+	if details == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"passive_ip":                  aws.StringValue(details.PassiveIp),
+		"set_stat_option":             aws.StringValue(details.SetStatOption),
+		"tls_session_resumption_mode": aws.StringValue(details.TlsSessionResumptionMode),
+	}
+
+	return []interface{}{m}
+}
+
+// expandTransferWorkflowDetails always returns a non-nil value so that
+// removing the configuration detaches any workflows on update.
+func expandTransferWorkflowDetails(l []interface{}) *transfer.WorkflowDetails {
+	details := &transfer.WorkflowDetails{
+		OnPartialUpload: []*transfer.WorkflowDetail{},
+		OnUpload:        []*transfer.WorkflowDetail{},
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return details
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["on_partial_upload"].([]interface{}); ok {
+		details.OnPartialUpload = expandTransferWorkflowDetailList(v)
+	}
+
+	if v, ok := m["on_upload"].([]interface{}); ok {
+		details.OnUpload = expandTransferWorkflowDetailList(v)
+	}
+
+	return details
+}
+
+func expandTransferWorkflowDetailList(l []interface{}) []*transfer.WorkflowDetail {
+	details := []*transfer.WorkflowDetail{}
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		details = append(details, &transfer.WorkflowDetail{
+			ExecutionRole: aws.String(m["execution_role"].(string)),
+			WorkflowId:    aws.String(m["workflow_id"].(string)),
+		})
+	}
+
+	return details
+}
+
+func flattenTransferWorkflowDetails(details *transfer.WorkflowDetails) []interface{} {
+	if details == nil || (len(details.OnPartialUpload) == 0 && len(details.OnUpload) == 0) {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"on_partial_upload": flattenTransferWorkflowDetailList(details.OnPartialUpload),
+		"on_upload":         flattenTransferWorkflowDetailList(details.OnUpload),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenTransferWorkflowDetailList(details []*transfer.WorkflowDetail) []interface{} {
+	l := []interface{}{}
+
+	for _, detail := range details {
+		if detail == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"execution_role": aws.StringValue(detail.ExecutionRole),
+			"workflow_id":    aws.StringValue(detail.WorkflowId),
+		})
+	}
+
+	return l
+}
+
+func expandTransferHomeDirectoryMappings(l []interface{}) []*transfer.HomeDirectoryMapEntry {
+	mappings := []*transfer.HomeDirectoryMapEntry{}
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		mappings = append(mappings, &transfer.HomeDirectoryMapEntry{
+			Entry:  aws.String(m["entry"].(string)),
+			Target: aws.String(m["target"].(string)),
+		})
+	}
+
+	return mappings
+}
+
+func flattenTransferHomeDirectoryMappings(mappings []*transfer.HomeDirectoryMapEntry) []interface{} {
+	l := []interface{}{}
+
+	for _, mapping := range mappings {
+		if mapping == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"entry":  aws.StringValue(mapping.Entry),
+			"target": aws.StringValue(mapping.Target),
+		})
+	}
+
+	return l
+}
+
+func expandTransferPosixProfile(l []interface{}) *transfer.PosixProfile {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	profile := &transfer.PosixProfile{
+		Gid: aws.Int64(int64(m["gid"].(int))),
+		Uid: aws.Int64(int64(m["uid"].(int))),
+	}
+
+	if v, ok := m["secondary_gids"].(*schema.Set); ok && v.Len() > 0 {
+		for _, gid := range v.List() {
+			profile.SecondaryGids = append(profile.SecondaryGids, aws.Int64(int64(gid.(int))))
+		}
+	}
+
+	return profile
+}
+
+func flattenTransferPosixProfile(profile *transfer.PosixProfile) []interface{} {
+	if profile == nil {
+		return []interface{}{}
+	}
+
+	secondaryGids := make([]interface{}, 0, len(profile.SecondaryGids))
+	for _, gid := range profile.SecondaryGids {
+		secondaryGids = append(secondaryGids, int(aws.Int64Value(gid)))
+	}
+
+	m := map[string]interface{}{
+		"gid":            int(aws.Int64Value(profile.Gid)),
+		"secondary_gids": secondaryGids,
+		"uid":            int(aws.Int64Value(profile.Uid)),
+	}
+
+	return []interface{}{m}
+}
@@ -1,11 +1,13 @@
 package aws
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +33,26 @@ func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []e
 	return
 }
 
+// validateTypeStringNullableFloat accepts an empty string or a string that
+// parses as a float, for arguments where an explicit zero is meaningful.
+func validateTypeStringNullableFloat(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		errors = append(errors, fmt.Errorf("%q: cannot parse '%s' as float: %s", k, value, err))
+	}
+
+	return
+}
+
 func validateRdsIdentifier(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
@@ -113,6 +135,51 @@ func validateASGScheduleTimestamp(v interface{}, k string) (ws []string, errors
 	return
 }
 
+// validateASGScheduleRecurrence checks that a scheduled action recurrence is a
+// five field Unix cron expression: minute, hour, day of month, month and day of
+// week. Month and day of week may also be given by name, e.g. "JAN" or "MON-FRI".
+func validateASGScheduleRecurrence(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	fields := strings.Fields(value)
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be a cron expression with 5 fields, got %d", k, value, len(fields)))
+		return
+	}
+
+	bounds := []struct {
+		name     string
+		min, max int
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 7},
+	}
+
+	itemRe := regexp.MustCompile(`^(\*|([0-9]+|[A-Za-z]{3})(-([0-9]+|[A-Za-z]{3}))?)(/[0-9]+)?$`)
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if !itemRe.MatchString(item) {
+				errors = append(errors, fmt.Errorf(
+					"%q (%q) has an invalid %s field: %q", k, value, bounds[i].name, field))
+				break
+			}
+
+			for _, n := range regexp.MustCompile(`[0-9]+`).FindAllString(strings.SplitN(item, "/", 2)[0], -1) {
+				if n, _ := strconv.Atoi(n); n < bounds[i].min || n > bounds[i].max {
+					errors = append(errors, fmt.Errorf(
+						"%q (%q) %s must be between %d and %d, got %d", k, value, bounds[i].name, bounds[i].min, bounds[i].max, n))
+				}
+			}
+		}
+	}
+
+	return
+}
+
 // validateTagFilters confirms the "value" component of a tag filter is one of
 // AWS's three allowed types.
 func validateTagFilters(v interface{}, k string) (ws []string, errors []error) {
@@ -271,6 +338,24 @@ func validateCloudWatchEventRuleName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateCloudWatchEventBusName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 256 characters: %q", k, value))
+	}
+
+	// http://docs.aws.amazon.com/eventbridge/latest/APIReference/API_CreateEventBus.html
+	pattern := `^[/\.\-_A-Za-z0-9]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateCloudWatchLogResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutResourcePolicy.html
@@ -302,6 +387,20 @@ func validateIntegerInRange(min, max int) schema.SchemaValidateFunc {
 	}
 }
 
+func validateIntegerInSlice(valid []int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		for _, i := range valid {
+			if value == i {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %v: %d", k, valid, value))
+		return
+	}
+}
+
 func validateCloudWatchEventTargetId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 64 {
@@ -368,6 +467,86 @@ func validateLambdaPermissionAction(v interface{}, k string) (ws []string, error
 	return
 }
 
+func validateAccessAnalyzerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 255 characters in length", k))
+	}
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with a letter and contain only alphanumeric characters, underscores, periods and hyphens", k))
+	}
+	return
+}
+
+func validateTimestreamName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 256 characters in length", k))
+	}
+	if !regexp.MustCompile(`^[A-Za-z0-9_.-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain only alphanumeric characters, underscores, periods and hyphens", k))
+	}
+	return
+}
+
+func validateKeyspacesName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_]{0,47}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be 1 to 48 alphanumeric characters or underscores and start with an alphanumeric character", k))
+	}
+	return
+}
+
+func validateMemoryDBName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 40 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 40 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
+	}
+	if !regexp.MustCompile(`^[a-z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"first character of %q must be a letter", k))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain two consecutive hyphens", k))
+	}
+	if regexp.MustCompile(`-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot end with a hyphen", k))
+	}
+	return
+}
+
+func validateMemoryDBNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	// resource.PrefixedUniqueId appends 26 characters to the prefix
+	if len(value) > 14 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 14 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
+	}
+	if !regexp.MustCompile(`^[a-z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"first character of %q must be a letter", k))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain two consecutive hyphens", k))
+	}
+	return
+}
+
 func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -585,6 +764,65 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+// iamPolicyConditionOperators are the base condition operators IAM accepts,
+// before any set operator prefix or IfExists suffix is applied.
+var iamPolicyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+func validateIamPolicyConditionOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	operator := value
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if strings.HasPrefix(operator, prefix) {
+			operator = strings.TrimPrefix(operator, prefix)
+			break
+		}
+	}
+
+	// Null cannot be combined with IfExists.
+	if operator == "Null" {
+		return
+	}
+
+	operator = strings.TrimSuffix(operator, "IfExists")
+
+	for _, valid := range iamPolicyConditionOperators {
+		if operator == valid {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q contains an invalid IAM condition operator %q", k, value))
+	return
+}
+
 func validateCloudFormationTemplate(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -766,6 +1004,175 @@ func validateSfnStateMachineName(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+// validateSfnStateMachineDefinition performs a local structural validation of an
+// Amazon States Language definition so that malformed state machines are
+// reported at plan time rather than when calling the Step Functions API.
+func validateSfnStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 1024*1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, 1024*1024))
+		return
+	}
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	for _, err := range validateSfnStates(definition, "") {
+		errors = append(errors, fmt.Errorf("%q is not a valid Amazon States Language definition: %s", k, err))
+	}
+	return
+}
+
+var sfnStateTypes = map[string]bool{
+	"Choice":   true,
+	"Fail":     true,
+	"Map":      true,
+	"Parallel": true,
+	"Pass":     true,
+	"Succeed":  true,
+	"Task":     true,
+	"Wait":     true,
+}
+
+// validateSfnStates validates a States Language object holding StartAt and
+// States, recursing into Parallel branches and Map iterators.
+func validateSfnStates(m map[string]interface{}, path string) []error {
+	var errors []error
+
+	startAt, ok := m["StartAt"].(string)
+	if !ok || startAt == "" {
+		errors = append(errors, fmt.Errorf("%sStartAt must be a non-empty string", path))
+	}
+
+	states, ok := m["States"].(map[string]interface{})
+	if !ok || len(states) == 0 {
+		errors = append(errors, fmt.Errorf("%sStates must be a non-empty object", path))
+		return errors
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			errors = append(errors, fmt.Errorf("%sStartAt %q does not match any state", path, startAt))
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checkTransition := func(name, field string, target interface{}) {
+		next, ok := target.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%sstate %q %s must be a string", path, name, field))
+			return
+		}
+		if _, ok := states[next]; !ok {
+			errors = append(errors, fmt.Errorf("%sstate %q %s %q does not match any state", path, name, field, next))
+		}
+	}
+
+	for _, name := range names {
+		if len(name) > 80 {
+			errors = append(errors, fmt.Errorf("%sstate name %q cannot be longer than 80 characters", path, name))
+		}
+
+		state, ok := states[name].(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("%sstate %q must be an object", path, name))
+			continue
+		}
+
+		stateType, _ := state["Type"].(string)
+		if !sfnStateTypes[stateType] {
+			errors = append(errors, fmt.Errorf("%sstate %q has an invalid Type %q", path, name, stateType))
+			continue
+		}
+
+		next, hasNext := state["Next"]
+		end, _ := state["End"].(bool)
+
+		switch stateType {
+		case "Choice":
+			choices, ok := state["Choices"].([]interface{})
+			if !ok || len(choices) == 0 {
+				errors = append(errors, fmt.Errorf("%sstate %q must have a non-empty Choices list", path, name))
+			}
+			for _, raw := range choices {
+				choice, ok := raw.(map[string]interface{})
+				if !ok {
+					errors = append(errors, fmt.Errorf("%sstate %q choice rules must be objects", path, name))
+					continue
+				}
+				checkTransition(name, "choice Next", choice["Next"])
+			}
+			if v, ok := state["Default"]; ok {
+				checkTransition(name, "Default", v)
+			}
+			if hasNext || end {
+				errors = append(errors, fmt.Errorf("%sstate %q of type Choice cannot have Next or End", path, name))
+			}
+		case "Fail", "Succeed":
+			if hasNext || end {
+				errors = append(errors, fmt.Errorf("%sstate %q of type %s cannot have Next or End", path, name, stateType))
+			}
+		default:
+			switch {
+			case hasNext && end:
+				errors = append(errors, fmt.Errorf("%sstate %q cannot have both Next and End", path, name))
+			case hasNext:
+				checkTransition(name, "Next", next)
+			case !end:
+				errors = append(errors, fmt.Errorf("%sstate %q must have either Next or End set to true", path, name))
+			}
+		}
+
+		if catchers, ok := state["Catch"].([]interface{}); ok {
+			for _, raw := range catchers {
+				if catcher, ok := raw.(map[string]interface{}); ok {
+					checkTransition(name, "Catch Next", catcher["Next"])
+				}
+			}
+		}
+
+		switch stateType {
+		case "Task":
+			if resource, ok := state["Resource"].(string); !ok || resource == "" {
+				errors = append(errors, fmt.Errorf("%sstate %q of type Task must have a Resource", path, name))
+			}
+		case "Parallel":
+			branches, ok := state["Branches"].([]interface{})
+			if !ok || len(branches) == 0 {
+				errors = append(errors, fmt.Errorf("%sstate %q of type Parallel must have a non-empty Branches list", path, name))
+			}
+			for i, raw := range branches {
+				branch, ok := raw.(map[string]interface{})
+				if !ok {
+					errors = append(errors, fmt.Errorf("%sstate %q branch %d must be an object", path, name, i))
+					continue
+				}
+				errors = append(errors, validateSfnStates(branch, fmt.Sprintf("%sstate %q branch %d: ", path, name, i))...)
+			}
+		case "Map":
+			processor, ok := state["ItemProcessor"].(map[string]interface{})
+			if !ok {
+				processor, ok = state["Iterator"].(map[string]interface{})
+			}
+			if !ok {
+				errors = append(errors, fmt.Errorf("%sstate %q of type Map must have an ItemProcessor or Iterator", path, name))
+				continue
+			}
+			errors = append(errors, validateSfnStates(processor, fmt.Sprintf("%sstate %q processor: ", path, name))...)
+		}
+	}
+
+	return errors
+}
+
 func validateDmsCertificateId(v interface{}, k string) (ws []string, es []error) {
 	val := v.(string)
 
@@ -1740,3 +2147,44 @@ func validateDynamoDbTableAttributes(d *schema.ResourceDiff) error {
 
 	return nil
 }
+
+func validateSecretsManagerSecretName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z/_+=.@-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and /_+=.@- special characters are allowed in %q", k))
+	}
+	if len(value) > 512 {
+		errors = append(errors, fmt.Errorf("%q cannot be greater than 512 characters", k))
+	}
+	return
+}
+
+func validateSecretsManagerSecretNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z/_+=.@-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and /_+=.@- special characters are allowed in %q", k))
+	}
+	if len(value) > 486 {
+		errors = append(errors, fmt.Errorf("%q cannot be greater than 486 characters", k))
+	}
+	return
+}
+
+func validateSagemakerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q, and it must not begin or end with a hyphen", k))
+	}
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 63 characters", k))
+	}
+	return
+}
+
+func validateSagemakerServerlessMemorySize(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1024 || value > 6144 || value%1024 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be one of 1024, 2048, 3072, 4096, 5120 or 6144, got %d", k, value))
+	}
+	return
+}
@@ -9,6 +9,56 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 )
 
+func TestValidateTypeStringNullableFloat(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "",
+		},
+		{
+			val: "0",
+		},
+		{
+			val: "1.5",
+		},
+		{
+			val: "-10",
+		},
+		{
+			val:         "one",
+			expectedErr: regexp.MustCompile(`cannot parse 'one' as float`),
+		},
+	}
+
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range testCases {
+		_, errs := validateTypeStringNullableFloat(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
 func TestValidateRFC3339TimeString(t *testing.T) {
 	testCases := []struct {
 		val         interface{}
@@ -175,6 +225,33 @@ func TestValidateCloudWatchEventRuleName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchEventBusName(t *testing.T) {
+	validNames := []string{
+		"default",
+		"HelloWorl_d",
+		"hello-world",
+		"aws.partner/example.com/123/my-source",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CW event bus name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"special@character",
+		"",
+		strings.Repeat("W", 257),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CW event bus name", v)
+		}
+	}
+}
+
 func TestValidateLambdaFunctionName(t *testing.T) {
 	validNames := []string{
 		"arn:aws:lambda:us-west-2:123456789012:function:ThumbNail",
@@ -266,6 +343,42 @@ func TestValidateLambdaPermissionAction(t *testing.T) {
 	}
 }
 
+func TestValidateASGScheduleRecurrence(t *testing.T) {
+	validRecurrences := []string{
+		"0 8 * * *",
+		"*/15 * * * *",
+		"30 18 * * MON-FRI",
+		"0 0 1,15 * *",
+		"0 6-18/2 * JAN-MAR 0",
+		"59 23 31 12 7",
+	}
+	for _, v := range validRecurrences {
+		_, errors := validateASGScheduleRecurrence(v, "recurrence")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid recurrence: %q", v, errors)
+		}
+	}
+
+	invalidRecurrences := []string{
+		"",
+		"0 8 * *",         // too few fields
+		"0 8 * * * 2020",  // too many fields
+		"60 8 * * *",      // minute out of range
+		"0 24 * * *",      // hour out of range
+		"0 8 0 * *",       // day of month out of range
+		"0 8 * 13 *",      // month out of range
+		"0 8 * * 8",       // day of week out of range
+		"0 8 ? * MON",     // "?" is not Unix cron syntax
+		"cron(0 8 * * *)", // CloudWatch Events syntax
+	}
+	for _, v := range invalidRecurrences {
+		_, errors := validateASGScheduleRecurrence(v, "recurrence")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid recurrence", v)
+		}
+	}
+}
+
 func TestValidateAwsAccountId(t *testing.T) {
 	validNames := []string{
 		"123456789012",
@@ -568,6 +681,24 @@ func TestValidateIntegerInRange(t *testing.T) {
 	}
 }
 
+func TestValidateIntegerInSlice(t *testing.T) {
+	valid := []int{1500, 9001}
+	for _, v := range valid {
+		_, errors := validateIntegerInSlice(valid)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be one of %v: %q", v, valid, errors)
+		}
+	}
+
+	invalidIntegers := []int{0, 1501, 8500, 9000}
+	for _, v := range invalidIntegers {
+		_, errors := validateIntegerInSlice(valid)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%d should not be one of %v", v, valid)
+		}
+	}
+}
+
 func TestResourceAWSElastiCacheClusterIdValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -755,6 +886,189 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateIamPolicyConditionOperator(t *testing.T) {
+	validOperators := []string{
+		"StringEquals",
+		"StringLikeIfExists",
+		"ForAllValues:StringEquals",
+		"ForAnyValue:ArnLike",
+		"ForAnyValue:StringNotEqualsIgnoreCaseIfExists",
+		"Bool",
+		"Null",
+		"IpAddress",
+		"NumericLessThanEquals",
+	}
+	for _, v := range validOperators {
+		_, errors := validateIamPolicyConditionOperator(v, "test")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM condition operator: %q", v, errors)
+		}
+	}
+
+	invalidOperators := []string{
+		"",
+		"StringEqual",
+		"stringequals",
+		"StringEqualsIfExistsIfExists",
+		"NullIfExists",
+		"ForAllValue:StringEquals",
+		"ForAllValues:ForAnyValue:StringEquals",
+		"IfExists",
+	}
+	for _, v := range invalidOperators {
+		_, errors := validateIamPolicyConditionOperator(v, "test")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM condition operator", v)
+		}
+	}
+}
+
+func TestValidateAccessAnalyzerName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"example",
+		"Example_analyzer-1.0",
+		strings.Repeat("a", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateAccessAnalyzerName(v, "analyzer_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Access Analyzer name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1example",
+		"_example",
+		"example analyzer",
+		"example/analyzer",
+		strings.Repeat("a", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAccessAnalyzerName(v, "analyzer_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Access Analyzer name", v)
+		}
+	}
+}
+
+func TestValidateTimestreamName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"example",
+		"Example_database-1.0",
+		strings.Repeat("a", 256),
+	}
+	for _, v := range validNames {
+		_, errors := validateTimestreamName(v, "database_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Timestream name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"example database",
+		"example/database",
+		strings.Repeat("a", 257),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateTimestreamName(v, "database_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Timestream name", v)
+		}
+	}
+}
+
+func TestValidateKeyspacesName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"example",
+		"Example_keyspace_1",
+		"1example",
+		strings.Repeat("a", 48),
+	}
+	for _, v := range validNames {
+		_, errors := validateKeyspacesName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Keyspaces name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"_example",
+		"example-keyspace",
+		"example keyspace",
+		strings.Repeat("a", 49),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateKeyspacesName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Keyspaces name", v)
+		}
+	}
+}
+
+func TestValidateMemoryDBName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"example",
+		"tf-acc-test-1",
+		strings.Repeat("a", 40),
+	}
+	for _, v := range validNames {
+		_, errors := validateMemoryDBName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid MemoryDB name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"1example",
+		"Example",
+		"example--name",
+		"example-",
+		"example_name",
+		strings.Repeat("a", 41),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateMemoryDBName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid MemoryDB name", v)
+		}
+	}
+}
+
+func TestValidateMemoryDBNamePrefix(t *testing.T) {
+	validPrefixes := []string{
+		"a",
+		"tf-acc-",
+		strings.Repeat("a", 14),
+	}
+	for _, v := range validPrefixes {
+		_, errors := validateMemoryDBNamePrefix(v, "name_prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid MemoryDB name prefix: %q", v, errors)
+		}
+	}
+
+	invalidPrefixes := []string{
+		"-example",
+		"Example",
+		"example--",
+		strings.Repeat("a", 15),
+	}
+	for _, v := range invalidPrefixes {
+		_, errors := validateMemoryDBNamePrefix(v, "name_prefix")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid MemoryDB name prefix", v)
+		}
+	}
+}
+
 func TestValidateCloudFormationTemplate(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -1104,6 +1418,111 @@ func TestValidateStepFunctionStateMachineName(t *testing.T) {
 	}
 }
 
+func TestValidateSfnStateMachineDefinition(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: `{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:hello",
+      "Next": "Done"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}`,
+			ErrCount: 0,
+		},
+		{
+			Value: `{
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Fanout"}],
+      "Default": "Failed"
+    },
+    "Fanout": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}
+      ],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Wait", "Seconds": 1, "End": true}}},
+      "End": true
+    },
+    "Failed": {
+      "Type": "Fail"
+    }
+  }
+}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"States": {"A": {"Type": "Pass", "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "B", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Bogus", "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Missing"}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Task", "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "X", "States": {}}], "End": true}}}`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Next": "Nowhere"}]}}}`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSfnStateMachineDefinition(tc.Value, "definition")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors, got %d for %s: %s", tc.ErrCount, len(errors), tc.Value, errors)
+		}
+	}
+}
+
 func TestValidateEmrCustomAmiId(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -2521,3 +2940,62 @@ func TestValidateAmazonSideAsn(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSecretsManagerSecretName(t *testing.T) {
+	validNames := []string{
+		"tf-acc-test",
+		"prod/db/password",
+		"a_b+c=d.e@f",
+		strings.Repeat("W", 512),
+	}
+	for _, v := range validNames {
+		_, errors := validateSecretsManagerSecretName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Secrets Manager secret name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"has space",
+		"special!",
+		strings.Repeat("W", 513),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateSecretsManagerSecretName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Secrets Manager secret name", v)
+		}
+	}
+}
+
+func TestValidateSagemakerName(t *testing.T) {
+	validNames := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+	for _, v := range validNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"Invalid name",
+		"-invalid-name",
+		"invalid-name-",
+		"invalid_name",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}
@@ -0,0 +1,1158 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Shared helpers for the aws_wafv2_* family of resources.
+
+// wafv2StatementNestingLevel bounds how deeply and_statement, or_statement
+// and not_statement blocks can be nested within a rule.
+const wafv2StatementNestingLevel = 3
+
+const (
+	wafv2UnavailableEntityTimeout = 5 * time.Minute
+	wafv2AssociatedItemTimeout    = 5 * time.Minute
+)
+
+// decodeWafv2ResourceID splits an import ID of the form ID/NAME/SCOPE.
+func decodeWafv2ResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected ID/NAME/SCOPE", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// resourceAwsWafv2Import sets the name and scope needed by the Get* API
+// calls from an import ID of the form ID/NAME/SCOPE.
+func resourceAwsWafv2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, name, scope, err := decodeWafv2ResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("name", name)
+	d.Set("scope", scope)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// wafv2DeleteAssociatedItem retries a delete while the item is still
+// referenced, since WAF releases references from deleted or updated rules
+// asynchronously.
+func wafv2DeleteAssociatedItem(f func() error) error {
+	return resource.Retry(wafv2AssociatedItemTimeout, func() *resource.RetryError {
+		err := f()
+
+		if isAWSErr(err, wafv2.ErrCodeWAFAssociatedItemException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// wafv2RetryUnavailableEntity retries a create or update while referenced
+// entities, such as newly created IP sets, are not yet usable.
+func wafv2RetryUnavailableEntity(f func() error) error {
+	return resource.Retry(wafv2UnavailableEntityTimeout, func() *resource.RetryError {
+		err := f()
+
+		if isAWSErr(err, wafv2.ErrCodeWAFUnavailableEntityException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func wafv2NameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z0-9-_]{1,128}$`),
+			"must contain only alphanumeric characters, hyphens and underscores and be at most 128 characters long",
+		),
+	}
+}
+
+func wafv2ScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(wafv2.Scope_Values(), false),
+	}
+}
+
+func wafv2EmptySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func wafv2RuleActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allow": wafv2EmptySchema(),
+				"block": wafv2EmptySchema(),
+				"count": wafv2EmptySchema(),
+			},
+		},
+	}
+}
+
+func wafv2VisibilityConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloudwatch_metrics_enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"metric_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[a-zA-Z0-9-_]{1,128}$`),
+						"must contain only alphanumeric characters, hyphens and underscores and be at most 128 characters long",
+					),
+				},
+				"sampled_requests_enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func wafv2FieldToMatchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"all_query_arguments":   wafv2EmptySchema(),
+				"body":                  wafv2EmptySchema(),
+				"method":                wafv2EmptySchema(),
+				"query_string":          wafv2EmptySchema(),
+				"single_header":         wafv2NamedFieldSchema(),
+				"single_query_argument": wafv2NamedFieldSchema(),
+				"uri_path":              wafv2EmptySchema(),
+			},
+		},
+	}
+}
+
+func wafv2NamedFieldSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[a-z0-9-_]{1,40}$`),
+						"must contain only lowercase alphanumeric characters, hyphens and underscores and be at most 40 characters long",
+					),
+				},
+			},
+		},
+	}
+}
+
+func wafv2TextTransformationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"priority": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.TextTransformationType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func wafv2ForwardedIPConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fallback_behavior": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.FallbackBehavior_Values(), false),
+				},
+				"header_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func wafv2IPSetForwardedIPConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fallback_behavior": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.FallbackBehavior_Values(), false),
+				},
+				"header_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"position": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.ForwardedIPPosition_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func wafv2RuleActionOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 100,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action_to_use": wafv2RuleActionSchema(),
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+// wafv2StatementSchema returns a single statement block whose and, or and
+// not statements may be nested up to level deep.
+func wafv2StatementSchema(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: wafv2StatementSchemaMap(level),
+		},
+	}
+}
+
+func wafv2StatementSchemaMap(level int) map[string]*schema.Schema {
+	m := map[string]*schema.Schema{
+		"byte_match_statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"field_to_match": wafv2FieldToMatchSchema(),
+					"positional_constraint": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(wafv2.PositionalConstraint_Values(), false),
+					},
+					"search_string": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 200),
+					},
+					"text_transformation": wafv2TextTransformationSchema(),
+				},
+			},
+		},
+		"geo_match_statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"country_codes": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(wafv2.CountryCode_Values(), false),
+						},
+					},
+					"forwarded_ip_config": wafv2ForwardedIPConfigSchema(),
+				},
+			},
+		},
+		"ip_set_reference_statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateArn,
+					},
+					"ip_set_forwarded_ip_config": wafv2IPSetForwardedIPConfigSchema(),
+				},
+			},
+		},
+		"regex_pattern_set_reference_statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateArn,
+					},
+					"field_to_match":      wafv2FieldToMatchSchema(),
+					"text_transformation": wafv2TextTransformationSchema(),
+				},
+			},
+		},
+	}
+
+	if level > 1 {
+		m["and_statement"] = wafv2StatementListSchema(level - 1)
+		m["or_statement"] = wafv2StatementListSchema(level - 1)
+		m["not_statement"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"statement": wafv2StatementSchema(level - 1),
+				},
+			},
+		}
+	}
+
+	return m
+}
+
+func wafv2StatementListSchema(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"statement": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 2,
+					Elem: &schema.Resource{
+						Schema: wafv2StatementSchemaMap(level),
+					},
+				},
+			},
+		},
+	}
+}
+
+func wafv2RateBasedStatementSchema(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"aggregate_key_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  wafv2.RateBasedStatementAggregateKeyTypeIp,
+					ValidateFunc: validation.StringInSlice([]string{
+						wafv2.RateBasedStatementAggregateKeyTypeForwardedIp,
+						wafv2.RateBasedStatementAggregateKeyTypeIp,
+					}, false),
+				},
+				"forwarded_ip_config": wafv2ForwardedIPConfigSchema(),
+				"limit": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(100, 2000000000),
+				},
+				"scope_down_statement": wafv2ScopeDownStatementSchema(level - 1),
+			},
+		},
+	}
+}
+
+func wafv2ScopeDownStatementSchema(level int) *schema.Schema {
+	s := wafv2StatementSchema(level)
+	s.Required = false
+	s.Optional = true
+	return s
+}
+
+// wafv2RuleGroupRootStatementSchema returns the statement block allowed at
+// the top level of a rule group rule.
+func wafv2RuleGroupRootStatementSchema(level int) *schema.Schema {
+	m := wafv2StatementSchemaMap(level)
+	m["rate_based_statement"] = wafv2RateBasedStatementSchema(level)
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: m,
+		},
+	}
+}
+
+// wafv2WebACLRootStatementSchema returns the statement block allowed at the
+// top level of a web ACL rule, which may additionally reference managed and
+// custom rule groups.
+func wafv2WebACLRootStatementSchema(level int) *schema.Schema {
+	m := wafv2StatementSchemaMap(level)
+	m["rate_based_statement"] = wafv2RateBasedStatementSchema(level)
+	m["managed_rule_group_statement"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"rule_action_override": wafv2RuleActionOverrideSchema(),
+				"scope_down_statement": wafv2ScopeDownStatementSchema(level - 1),
+				"vendor_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	m["rule_group_reference_statement"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"rule_action_override": wafv2RuleActionOverrideSchema(),
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: m,
+		},
+	}
+}
+
+func expandWafv2Rules(l []interface{}) []*wafv2.Rule {
+	rules := make([]*wafv2.Rule, 0, len(l))
+
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+
+		rule := &wafv2.Rule{
+			Name:             aws.String(m["name"].(string)),
+			Priority:         aws.Int64(int64(m["priority"].(int))),
+			Statement:        expandWafv2StatementBlock(m["statement"].([]interface{})),
+			VisibilityConfig: expandWafv2VisibilityConfig(m["visibility_config"].([]interface{})),
+		}
+
+		if v, ok := m["action"].([]interface{}); ok && len(v) > 0 {
+			rule.Action = expandWafv2RuleAction(v)
+		}
+
+		if v, ok := m["override_action"].([]interface{}); ok && len(v) > 0 {
+			rule.OverrideAction = expandWafv2OverrideAction(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandWafv2RuleAction(l []interface{}) *wafv2.RuleAction {
+	action := &wafv2.RuleAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allow"].([]interface{}); ok && len(v) > 0 {
+		action.Allow = &wafv2.AllowAction{}
+	}
+
+	if v, ok := m["block"].([]interface{}); ok && len(v) > 0 {
+		action.Block = &wafv2.BlockAction{}
+	}
+
+	if v, ok := m["count"].([]interface{}); ok && len(v) > 0 {
+		action.Count = &wafv2.CountAction{}
+	}
+
+	return action
+}
+
+func expandWafv2OverrideAction(l []interface{}) *wafv2.OverrideAction {
+	action := &wafv2.OverrideAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["count"].([]interface{}); ok && len(v) > 0 {
+		action.Count = &wafv2.CountAction{}
+	}
+
+	if v, ok := m["none"].([]interface{}); ok && len(v) > 0 {
+		action.None = &wafv2.NoneAction{}
+	}
+
+	return action
+}
+
+func expandWafv2VisibilityConfig(l []interface{}) *wafv2.VisibilityConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &wafv2.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(m["cloudwatch_metrics_enabled"].(bool)),
+		MetricName:               aws.String(m["metric_name"].(string)),
+		SampledRequestsEnabled:   aws.Bool(m["sampled_requests_enabled"].(bool)),
+	}
+}
+
+// expandWafv2StatementBlock expands a single-item statement block.
+func expandWafv2StatementBlock(l []interface{}) *wafv2.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return expandWafv2Statement(l[0].(map[string]interface{}))
+}
+
+func expandWafv2Statements(l []interface{}) []*wafv2.Statement {
+	statements := make([]*wafv2.Statement, 0, len(l))
+
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		statements = append(statements, expandWafv2Statement(raw.(map[string]interface{})))
+	}
+
+	return statements
+}
+
+func expandWafv2Statement(m map[string]interface{}) *wafv2.Statement {
+	statement := &wafv2.Statement{}
+
+	if v, ok := m["and_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.AndStatement = &wafv2.AndStatement{
+			Statements: expandWafv2Statements(v[0].(map[string]interface{})["statement"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["or_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.OrStatement = &wafv2.OrStatement{
+			Statements: expandWafv2Statements(v[0].(map[string]interface{})["statement"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["not_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.NotStatement = &wafv2.NotStatement{
+			Statement: expandWafv2StatementBlock(v[0].(map[string]interface{})["statement"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["byte_match_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.ByteMatchStatement = expandWafv2ByteMatchStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["geo_match_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.GeoMatchStatement = expandWafv2GeoMatchStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["ip_set_reference_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.IPSetReferenceStatement = expandWafv2IPSetReferenceStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["regex_pattern_set_reference_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.RegexPatternSetReferenceStatement = expandWafv2RegexPatternSetReferenceStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["rate_based_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.RateBasedStatement = expandWafv2RateBasedStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["managed_rule_group_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.ManagedRuleGroupStatement = expandWafv2ManagedRuleGroupStatement(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["rule_group_reference_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.RuleGroupReferenceStatement = expandWafv2RuleGroupReferenceStatement(v[0].(map[string]interface{}))
+	}
+
+	return statement
+}
+
+func expandWafv2ByteMatchStatement(m map[string]interface{}) *wafv2.ByteMatchStatement {
+	return &wafv2.ByteMatchStatement{
+		FieldToMatch:         expandWafv2FieldToMatch(m["field_to_match"].([]interface{})),
+		PositionalConstraint: aws.String(m["positional_constraint"].(string)),
+		SearchString:         []byte(m["search_string"].(string)),
+		TextTransformations:  expandWafv2TextTransformations(m["text_transformation"].(*schema.Set).List()),
+	}
+}
+
+func expandWafv2GeoMatchStatement(m map[string]interface{}) *wafv2.GeoMatchStatement {
+	statement := &wafv2.GeoMatchStatement{
+		CountryCodes: expandStringList(m["country_codes"].([]interface{})),
+	}
+
+	if v, ok := m["forwarded_ip_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.ForwardedIPConfig = expandWafv2ForwardedIPConfig(v[0].(map[string]interface{}))
+	}
+
+	return statement
+}
+
+func expandWafv2IPSetReferenceStatement(m map[string]interface{}) *wafv2.IPSetReferenceStatement {
+	statement := &wafv2.IPSetReferenceStatement{
+		ARN: aws.String(m["arn"].(string)),
+	}
+
+	if v, ok := m["ip_set_forwarded_ip_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config := v[0].(map[string]interface{})
+		statement.IPSetForwardedIPConfig = &wafv2.IPSetForwardedIPConfig{
+			FallbackBehavior: aws.String(config["fallback_behavior"].(string)),
+			HeaderName:       aws.String(config["header_name"].(string)),
+			Position:         aws.String(config["position"].(string)),
+		}
+	}
+
+	return statement
+}
+
+func expandWafv2RegexPatternSetReferenceStatement(m map[string]interface{}) *wafv2.RegexPatternSetReferenceStatement {
+	return &wafv2.RegexPatternSetReferenceStatement{
+		ARN:                 aws.String(m["arn"].(string)),
+		FieldToMatch:        expandWafv2FieldToMatch(m["field_to_match"].([]interface{})),
+		TextTransformations: expandWafv2TextTransformations(m["text_transformation"].(*schema.Set).List()),
+	}
+}
+
+func expandWafv2RateBasedStatement(m map[string]interface{}) *wafv2.RateBasedStatement {
+	statement := &wafv2.RateBasedStatement{
+		AggregateKeyType: aws.String(m["aggregate_key_type"].(string)),
+		Limit:            aws.Int64(int64(m["limit"].(int))),
+	}
+
+	if v, ok := m["forwarded_ip_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statement.ForwardedIPConfig = expandWafv2ForwardedIPConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["scope_down_statement"].([]interface{}); ok && len(v) > 0 {
+		statement.ScopeDownStatement = expandWafv2StatementBlock(v)
+	}
+
+	return statement
+}
+
+func expandWafv2ManagedRuleGroupStatement(m map[string]interface{}) *wafv2.ManagedRuleGroupStatement {
+	statement := &wafv2.ManagedRuleGroupStatement{
+		Name:       aws.String(m["name"].(string)),
+		VendorName: aws.String(m["vendor_name"].(string)),
+	}
+
+	if v, ok := m["rule_action_override"].([]interface{}); ok && len(v) > 0 {
+		statement.RuleActionOverrides = expandWafv2RuleActionOverrides(v)
+	}
+
+	if v, ok := m["scope_down_statement"].([]interface{}); ok && len(v) > 0 {
+		statement.ScopeDownStatement = expandWafv2StatementBlock(v)
+	}
+
+	if v, ok := m["version"].(string); ok && v != "" {
+		statement.Version = aws.String(v)
+	}
+
+	return statement
+}
+
+func expandWafv2RuleGroupReferenceStatement(m map[string]interface{}) *wafv2.RuleGroupReferenceStatement {
+	statement := &wafv2.RuleGroupReferenceStatement{
+		ARN: aws.String(m["arn"].(string)),
+	}
+
+	if v, ok := m["rule_action_override"].([]interface{}); ok && len(v) > 0 {
+		statement.RuleActionOverrides = expandWafv2RuleActionOverrides(v)
+	}
+
+	return statement
+}
+
+func expandWafv2RuleActionOverrides(l []interface{}) []*wafv2.RuleActionOverride {
+	overrides := make([]*wafv2.RuleActionOverride, 0, len(l))
+
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+
+		overrides = append(overrides, &wafv2.RuleActionOverride{
+			ActionToUse: expandWafv2RuleAction(m["action_to_use"].([]interface{})),
+			Name:        aws.String(m["name"].(string)),
+		})
+	}
+
+	return overrides
+}
+
+func expandWafv2ForwardedIPConfig(m map[string]interface{}) *wafv2.ForwardedIPConfig {
+	return &wafv2.ForwardedIPConfig{
+		FallbackBehavior: aws.String(m["fallback_behavior"].(string)),
+		HeaderName:       aws.String(m["header_name"].(string)),
+	}
+}
+
+func expandWafv2FieldToMatch(l []interface{}) *wafv2.FieldToMatch {
+	fieldToMatch := &wafv2.FieldToMatch{}
+
+	if len(l) == 0 || l[0] == nil {
+		return fieldToMatch
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["all_query_arguments"].([]interface{}); ok && len(v) > 0 {
+		fieldToMatch.AllQueryArguments = &wafv2.AllQueryArguments{}
+	}
+
+	if v, ok := m["body"].([]interface{}); ok && len(v) > 0 {
+		fieldToMatch.Body = &wafv2.Body{}
+	}
+
+	if v, ok := m["method"].([]interface{}); ok && len(v) > 0 {
+		fieldToMatch.Method = &wafv2.Method{}
+	}
+
+	if v, ok := m["query_string"].([]interface{}); ok && len(v) > 0 {
+		fieldToMatch.QueryString = &wafv2.QueryString{}
+	}
+
+	if v, ok := m["single_header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fieldToMatch.SingleHeader = &wafv2.SingleHeader{
+			Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+		}
+	}
+
+	if v, ok := m["single_query_argument"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fieldToMatch.SingleQueryArgument = &wafv2.SingleQueryArgument{
+			Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+		}
+	}
+
+	if v, ok := m["uri_path"].([]interface{}); ok && len(v) > 0 {
+		fieldToMatch.UriPath = &wafv2.UriPath{}
+	}
+
+	return fieldToMatch
+}
+
+func expandWafv2TextTransformations(l []interface{}) []*wafv2.TextTransformation {
+	transformations := make([]*wafv2.TextTransformation, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		transformations = append(transformations, &wafv2.TextTransformation{
+			Priority: aws.Int64(int64(m["priority"].(int))),
+			Type:     aws.String(m["type"].(string)),
+		})
+	}
+
+	return transformations
+}
+
+func flattenWafv2Rules(rules []*wafv2.Rule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		m := map[string]interface{}{
+			"name":              aws.StringValue(rule.Name),
+			"priority":          int(aws.Int64Value(rule.Priority)),
+			"statement":         flattenWafv2StatementBlock(rule.Statement),
+			"visibility_config": flattenWafv2VisibilityConfig(rule.VisibilityConfig),
+		}
+
+		if rule.Action != nil {
+			m["action"] = flattenWafv2RuleAction(rule.Action)
+		}
+
+		if rule.OverrideAction != nil {
+			m["override_action"] = flattenWafv2OverrideAction(rule.OverrideAction)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenWafv2RuleAction(action *wafv2.RuleAction) []interface{} {
+	if action == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if action.Allow != nil {
+		m["allow"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if action.Block != nil {
+		m["block"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if action.Count != nil {
+		m["count"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2OverrideAction(action *wafv2.OverrideAction) []interface{} {
+	if action == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if action.Count != nil {
+		m["count"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if action.None != nil {
+		m["none"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2VisibilityConfig(config *wafv2.VisibilityConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cloudwatch_metrics_enabled": aws.BoolValue(config.CloudWatchMetricsEnabled),
+			"metric_name":                aws.StringValue(config.MetricName),
+			"sampled_requests_enabled":   aws.BoolValue(config.SampledRequestsEnabled),
+		},
+	}
+}
+
+func flattenWafv2StatementBlock(statement *wafv2.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{flattenWafv2Statement(statement)}
+}
+
+func flattenWafv2Statements(statements []*wafv2.Statement) []interface{} {
+	l := make([]interface{}, 0, len(statements))
+
+	for _, statement := range statements {
+		l = append(l, flattenWafv2Statement(statement))
+	}
+
+	return l
+}
+
+// flattenWafv2Statement only sets the statement types that are present, as
+// the nested statement blocks do not all support every statement type.
+func flattenWafv2Statement(statement *wafv2.Statement) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := statement.AndStatement; v != nil {
+		m["and_statement"] = []interface{}{
+			map[string]interface{}{
+				"statement": flattenWafv2Statements(v.Statements),
+			},
+		}
+	}
+
+	if v := statement.OrStatement; v != nil {
+		m["or_statement"] = []interface{}{
+			map[string]interface{}{
+				"statement": flattenWafv2Statements(v.Statements),
+			},
+		}
+	}
+
+	if v := statement.NotStatement; v != nil {
+		m["not_statement"] = []interface{}{
+			map[string]interface{}{
+				"statement": flattenWafv2StatementBlock(v.Statement),
+			},
+		}
+	}
+
+	if v := statement.ByteMatchStatement; v != nil {
+		m["byte_match_statement"] = []interface{}{
+			map[string]interface{}{
+				"field_to_match":        flattenWafv2FieldToMatch(v.FieldToMatch),
+				"positional_constraint": aws.StringValue(v.PositionalConstraint),
+				"search_string":         string(v.SearchString),
+				"text_transformation":   flattenWafv2TextTransformations(v.TextTransformations),
+			},
+		}
+	}
+
+	if v := statement.GeoMatchStatement; v != nil {
+		m["geo_match_statement"] = []interface{}{
+			map[string]interface{}{
+				"country_codes":       flattenStringList(v.CountryCodes),
+				"forwarded_ip_config": flattenWafv2ForwardedIPConfig(v.ForwardedIPConfig),
+			},
+		}
+	}
+
+	if v := statement.IPSetReferenceStatement; v != nil {
+		config := []interface{}{}
+		if c := v.IPSetForwardedIPConfig; c != nil {
+			config = []interface{}{
+				map[string]interface{}{
+					"fallback_behavior": aws.StringValue(c.FallbackBehavior),
+					"header_name":       aws.StringValue(c.HeaderName),
+					"position":          aws.StringValue(c.Position),
+				},
+			}
+		}
+
+		m["ip_set_reference_statement"] = []interface{}{
+			map[string]interface{}{
+				"arn":                        aws.StringValue(v.ARN),
+				"ip_set_forwarded_ip_config": config,
+			},
+		}
+	}
+
+	if v := statement.RegexPatternSetReferenceStatement; v != nil {
+		m["regex_pattern_set_reference_statement"] = []interface{}{
+			map[string]interface{}{
+				"arn":                 aws.StringValue(v.ARN),
+				"field_to_match":      flattenWafv2FieldToMatch(v.FieldToMatch),
+				"text_transformation": flattenWafv2TextTransformations(v.TextTransformations),
+			},
+		}
+	}
+
+	if v := statement.RateBasedStatement; v != nil {
+		m["rate_based_statement"] = []interface{}{
+			map[string]interface{}{
+				"aggregate_key_type":   aws.StringValue(v.AggregateKeyType),
+				"forwarded_ip_config":  flattenWafv2ForwardedIPConfig(v.ForwardedIPConfig),
+				"limit":                int(aws.Int64Value(v.Limit)),
+				"scope_down_statement": flattenWafv2StatementBlock(v.ScopeDownStatement),
+			},
+		}
+	}
+
+	if v := statement.ManagedRuleGroupStatement; v != nil {
+		m["managed_rule_group_statement"] = []interface{}{
+			map[string]interface{}{
+				"name":                 aws.StringValue(v.Name),
+				"rule_action_override": flattenWafv2RuleActionOverrides(v.RuleActionOverrides),
+				"scope_down_statement": flattenWafv2StatementBlock(v.ScopeDownStatement),
+				"vendor_name":          aws.StringValue(v.VendorName),
+				"version":              aws.StringValue(v.Version),
+			},
+		}
+	}
+
+	if v := statement.RuleGroupReferenceStatement; v != nil {
+		m["rule_group_reference_statement"] = []interface{}{
+			map[string]interface{}{
+				"arn":                  aws.StringValue(v.ARN),
+				"rule_action_override": flattenWafv2RuleActionOverrides(v.RuleActionOverrides),
+			},
+		}
+	}
+
+	return m
+}
+
+func flattenWafv2RuleActionOverrides(overrides []*wafv2.RuleActionOverride) []interface{} {
+	l := make([]interface{}, 0, len(overrides))
+
+	for _, override := range overrides {
+		l = append(l, map[string]interface{}{
+			"action_to_use": flattenWafv2RuleAction(override.ActionToUse),
+			"name":          aws.StringValue(override.Name),
+		})
+	}
+
+	return l
+}
+
+func flattenWafv2ForwardedIPConfig(config *wafv2.ForwardedIPConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fallback_behavior": aws.StringValue(config.FallbackBehavior),
+			"header_name":       aws.StringValue(config.HeaderName),
+		},
+	}
+}
+
+func flattenWafv2FieldToMatch(fieldToMatch *wafv2.FieldToMatch) []interface{} {
+	if fieldToMatch == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if fieldToMatch.AllQueryArguments != nil {
+		m["all_query_arguments"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if fieldToMatch.Body != nil {
+		m["body"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if fieldToMatch.Method != nil {
+		m["method"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if fieldToMatch.QueryString != nil {
+		m["query_string"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := fieldToMatch.SingleHeader; v != nil {
+		m["single_header"] = []interface{}{
+			map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			},
+		}
+	}
+
+	if v := fieldToMatch.SingleQueryArgument; v != nil {
+		m["single_query_argument"] = []interface{}{
+			map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			},
+		}
+	}
+
+	if fieldToMatch.UriPath != nil {
+		m["uri_path"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2TextTransformations(transformations []*wafv2.TextTransformation) []interface{} {
+	l := make([]interface{}, 0, len(transformations))
+
+	for _, transformation := range transformations {
+		l = append(l, map[string]interface{}{
+			"priority": int(aws.Int64Value(transformation.Priority)),
+			"type":     aws.StringValue(transformation.Type),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+func TestDecodeWafv2ResourceID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ID          string
+		Name        string
+		Scope       string
+		ErrExpected bool
+	}{
+		{
+			Input: "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111/example/REGIONAL",
+			ID:    "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			Name:  "example",
+			Scope: "REGIONAL",
+		},
+		{
+			Input:       "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111/example",
+			ErrExpected: true,
+		},
+		{
+			Input:       "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111//REGIONAL",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		id, name, scope, err := decodeWafv2ResourceID(tc.Input)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Fatalf("expected error for input %q", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+		if id != tc.ID || name != tc.Name || scope != tc.Scope {
+			t.Fatalf("expected (%q, %q, %q), got (%q, %q, %q)", tc.ID, tc.Name, tc.Scope, id, name, scope)
+		}
+	}
+}
+
+func TestAWSWafv2_expandStatement(t *testing.T) {
+	ipSetArn := "arn:aws:wafv2:us-west-2:123456789012:regional/ipset/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+
+	input := []interface{}{
+		map[string]interface{}{
+			"rate_based_statement": []interface{}{
+				map[string]interface{}{
+					"aggregate_key_type":  "IP",
+					"forwarded_ip_config": []interface{}{},
+					"limit":               2000,
+					"scope_down_statement": []interface{}{
+						map[string]interface{}{
+							"or_statement": []interface{}{
+								map[string]interface{}{
+									"statement": []interface{}{
+										map[string]interface{}{
+											"geo_match_statement": []interface{}{
+												map[string]interface{}{
+													"country_codes":       []interface{}{"US", "CA"},
+													"forwarded_ip_config": []interface{}{},
+												},
+											},
+										},
+										map[string]interface{}{
+											"not_statement": []interface{}{
+												map[string]interface{}{
+													"statement": []interface{}{
+														map[string]interface{}{
+															"ip_set_reference_statement": []interface{}{
+																map[string]interface{}{
+																	"arn":                        ipSetArn,
+																	"ip_set_forwarded_ip_config": []interface{}{},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := &wafv2.Statement{
+		RateBasedStatement: &wafv2.RateBasedStatement{
+			AggregateKeyType: aws.String("IP"),
+			Limit:            aws.Int64(2000),
+			ScopeDownStatement: &wafv2.Statement{
+				OrStatement: &wafv2.OrStatement{
+					Statements: []*wafv2.Statement{
+						{
+							GeoMatchStatement: &wafv2.GeoMatchStatement{
+								CountryCodes: []*string{aws.String("US"), aws.String("CA")},
+							},
+						},
+						{
+							NotStatement: &wafv2.NotStatement{
+								Statement: &wafv2.Statement{
+									IPSetReferenceStatement: &wafv2.IPSetReferenceStatement{
+										ARN: aws.String(ipSetArn),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	output := expandWafv2StatementBlock(input)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+
+	if flattened := flattenWafv2StatementBlock(output); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", flattened, input)
+	}
+}
+
+func TestAWSWafv2_expandManagedRuleGroupStatement(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"managed_rule_group_statement": []interface{}{
+				map[string]interface{}{
+					"name": "AWSManagedRulesCommonRuleSet",
+					"rule_action_override": []interface{}{
+						map[string]interface{}{
+							"action_to_use": []interface{}{
+								map[string]interface{}{
+									"count": []interface{}{map[string]interface{}{}},
+								},
+							},
+							"name": "SizeRestrictions_QUERYSTRING",
+						},
+					},
+					"scope_down_statement": []interface{}{},
+					"vendor_name":          "AWS",
+					"version":              "",
+				},
+			},
+		},
+	}
+
+	expected := &wafv2.Statement{
+		ManagedRuleGroupStatement: &wafv2.ManagedRuleGroupStatement{
+			Name: aws.String("AWSManagedRulesCommonRuleSet"),
+			RuleActionOverrides: []*wafv2.RuleActionOverride{
+				{
+					ActionToUse: &wafv2.RuleAction{
+						Count: &wafv2.CountAction{},
+					},
+					Name: aws.String("SizeRestrictions_QUERYSTRING"),
+				},
+			},
+			VendorName: aws.String("AWS"),
+		},
+	}
+
+	output := expandWafv2StatementBlock(input)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+
+	if flattened := flattenWafv2StatementBlock(output); !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", flattened, input)
+	}
+}